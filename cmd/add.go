@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/i18n"
+	"github.com/francknouama/go-starter/internal/ui"
+)
+
+// addCmd represents the add command
+var addCmd = &cobra.Command{
+	Use:   "add <database|auth|docker|option> [value]",
+	Short: "Add a database, authentication, docker files or an option to a generated project",
+	Long: `Add a feature to a project generated by go-starter. The blueprint and options of
+the project are read from its generation manifest, and only the files the
+feature adds or changes are written:
+
+  - missing files are created
+  - files still as generated are updated
+  - go.mod gets the new requirements, keeping the versions already required
+  - files edited since generation are kept, and their new version is written
+    next to them with the ` + generator.AddConflictSuffix + ` suffix to merge by hand
+
+The options set by a boolean flag of 'new', such as observability or
+admin-endpoints, are added by their flag name when the blueprint offers them.`,
+	Example: `  go-starter add database postgres --orm=gorm
+  go-starter add auth jwt
+  go-starter add docker --dry-run
+  go-starter add observability`,
+	Args:      cobra.RangeArgs(1, 2),
+	ValidArgs: slices.Concat(generator.AddFeatures, generator.AddOptions()),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		request := generator.AddRequest{Feature: args[0]}
+		if len(args) == 2 {
+			request.Value = args[1]
+		}
+		request.ORM, _ = cmd.Flags().GetString("orm")
+		projectPath, _ := cmd.Flags().GetString("dir")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		return runAdd(cmd, projectPath, request, dryRun)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(addCmd)
+
+	addCmd.Flags().String("orm", "", "Database ORM/query builder (gorm, sqlx), for database")
+	addCmd.Flags().StringP("dir", "C", ".", "Directory of the generated project")
+	addCmd.Flags().Bool("dry-run", false, "Show the changes without writing them")
+}
+
+// runAdd plans the addition of a feature to the project at projectPath and applies it
+func runAdd(cmd *cobra.Command, projectPath string, request generator.AddRequest, dryRun bool) error {
+	gen := generator.New()
+	plan, err := gen.PlanAdd(cmd.Context(), projectPath, request)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("no %s in %s, features can only be added to projects generated by go-starter with a manifest", generator.ManifestFile, projectPath)
+		}
+		return err
+	}
+
+	w := cmd.OutOrStdout()
+	feature := strings.TrimSpace(request.Feature + " " + request.Value)
+	if plan.Empty() {
+		_, _ = fmt.Fprintln(w, ui.Text(i18n.T("add.nothing", feature)))
+		return nil
+	}
+	printAddPlan(w, feature, plan)
+
+	if dryRun {
+		_, _ = fmt.Fprintln(w, i18n.T("add.dry_run"))
+		return nil
+	}
+	if err := gen.ApplyAdd(plan); err != nil {
+		return fmt.Errorf("failed to add %s: %w", feature, err)
+	}
+
+	_, _ = fmt.Fprintln(w, ui.Text(i18n.T("add.done", feature)))
+	if len(plan.Merge) > 0 {
+		_, _ = fmt.Fprintln(w, i18n.T("add.next_tidy"))
+	}
+	if len(plan.Conflicts) > 0 {
+		_, _ = fmt.Fprintln(w, i18n.T("add.next_conflicts", generator.AddConflictSuffix))
+	}
+	return nil
+}
+
+// printAddPlan lists the files an addition creates, updates, merges or leaves to merge by hand
+func printAddPlan(w io.Writer, feature string, plan *generator.AddPlan) {
+	_, _ = fmt.Fprintln(w, i18n.T("add.plan", feature, plan.Project, plan.Blueprint))
+	for _, file := range plan.Create {
+		_, _ = fmt.Fprintln(w, i18n.T("add.create", file))
+	}
+	for _, file := range plan.Update {
+		_, _ = fmt.Fprintln(w, i18n.T("add.update", file))
+	}
+	for _, file := range plan.Merge {
+		_, _ = fmt.Fprintln(w, i18n.T("add.merge", file))
+	}
+	for _, file := range plan.Conflicts {
+		_, _ = fmt.Fprintln(w, ui.Text(i18n.T("add.conflict", file, file+generator.AddConflictSuffix)))
+	}
+}
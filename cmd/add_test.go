@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// writeTestProject writes a web-app project without a database as generated
+func writeTestProject(t *testing.T) string {
+	t.Helper()
+
+	files, err := generator.New().GenerateInMemoryFiles(context.Background(), &types.ProjectConfig{
+		Name:   "shop",
+		Module: "github.com/acme/shop",
+		Type:   "web-app",
+		Logger: "slog",
+	}, "web-app")
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	for name, file := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, file.Content, 0644))
+	}
+	return dir
+}
+
+func TestRunAdd(t *testing.T) {
+	setupTestBlueprints(t)
+	dir := writeTestProject(t)
+
+	var out bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	cmd.SetContext(context.Background())
+
+	request := generator.AddRequest{Feature: generator.AddDatabase, Value: "sqlite"}
+	require.NoError(t, runAdd(cmd, dir, request, true))
+	assert.Contains(t, out.String(), "Adding database sqlite to")
+	assert.Contains(t, out.String(), "merge      go.mod")
+	assert.Contains(t, out.String(), "Dry run")
+	manifest, err := generator.ReadManifest(dir)
+	require.NoError(t, err)
+	assert.Nil(t, manifest.Config.Features, "a dry run leaves the project alone")
+
+	out.Reset()
+	require.NoError(t, runAdd(cmd, dir, request, false))
+	assert.Contains(t, out.String(), "Added database sqlite")
+	assert.Contains(t, out.String(), "go mod tidy")
+	goMod, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+	require.NoError(t, err)
+	assert.Contains(t, string(goMod), "github.com/mattn/go-sqlite3")
+
+	out.Reset()
+	require.NoError(t, runAdd(cmd, dir, generator.AddRequest{Feature: generator.AddDocker}, false))
+	assert.Contains(t, out.String(), "Nothing to add")
+
+	err = runAdd(cmd, t.TempDir(), request, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), generator.ManifestFile)
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/i18n"
+	"github.com/francknouama/go-starter/internal/ui"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// auditCmd represents the audit command
+var auditCmd = &cobra.Command{
+	Use:   "audit [project-dir]",
+	Short: "Flag generated projects that use deprecated blueprints or options",
+	Long: `Read the generation manifest of a project and report the deprecated blueprint
+and options it was generated with, checked against the blueprints of this
+version of go-starter. Exits with an error when anything deprecated is in use,
+so it can run in CI.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectPath := "."
+		if len(args) == 1 {
+			projectPath = args[0]
+		}
+		output, _ := cmd.Flags().GetString("output")
+		return runAudit(cmd.OutOrStdout(), projectPath, output)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(auditCmd)
+
+	auditCmd.Flags().StringP("output", "o", "console", "Output format (console, json)")
+}
+
+// AuditReport is the result of auditing a generated project
+type AuditReport struct {
+	Project   string `json:"project"`
+	Blueprint string `json:"blueprint"`
+	// BlueprintRemoved is set when the blueprint no longer ships with go-starter
+	BlueprintRemoved bool                      `json:"blueprint_removed"`
+	Deprecations     []types.DeprecationNotice `json:"deprecations"`
+}
+
+// runAudit audits the project at projectPath and prints the report
+func runAudit(w io.Writer, projectPath, format string) error {
+	manifest, err := generator.ReadManifest(projectPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("no %s in %s, only projects generated by go-starter with a manifest can be audited", generator.ManifestFile, projectPath)
+		}
+		return err
+	}
+
+	report := auditProject(generator.New(), projectPath, manifest)
+
+	if format == "json" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode report: %w", err)
+		}
+		_, _ = fmt.Fprintln(w, string(data))
+	} else {
+		printAuditReport(w, manifest, report)
+	}
+
+	if report.BlueprintRemoved || len(report.Deprecations) > 0 {
+		return fmt.Errorf("project %s uses deprecated blueprints or options", projectPath)
+	}
+	return nil
+}
+
+// auditProject checks the manifest against the current blueprints. Deprecations
+// declared since generation are found by re-evaluating the recorded configuration;
+// those recorded at generation time are kept when the blueprint no longer has them.
+func auditProject(gen *generator.Generator, projectPath string, manifest *generator.Manifest) AuditReport {
+	report := AuditReport{Project: projectPath, Blueprint: manifest.Blueprint, Deprecations: []types.DeprecationNotice{}}
+
+	current, err := gen.Deprecations(manifest.Config, manifest.Blueprint)
+	if err != nil {
+		report.BlueprintRemoved = true
+	}
+
+	seen := make(map[string]bool)
+	for _, notices := range [][]types.DeprecationNotice{current, manifest.Deprecations} {
+		for _, notice := range notices {
+			key := notice.Variable + "=" + notice.Value
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			report.Deprecations = append(report.Deprecations, notice)
+		}
+	}
+	return report
+}
+
+// printAuditReport prints an audit report for humans
+func printAuditReport(w io.Writer, manifest *generator.Manifest, report AuditReport) {
+	_, _ = fmt.Fprintln(w, i18n.T("audit.project", report.Project, report.Blueprint, manifest.GeneratedAt.Format(types.SunsetLayout)))
+
+	if report.BlueprintRemoved {
+		_, _ = fmt.Fprintln(w, ui.Text(i18n.T("audit.blueprint_removed", report.Blueprint)))
+	}
+	printDeprecationWarnings(w, report.Deprecations, time.Now())
+
+	if !report.BlueprintRemoved && len(report.Deprecations) == 0 {
+		_, _ = fmt.Fprintln(w, ui.Text(i18n.T("audit.clean")))
+	}
+}
+
+// printDeprecationWarnings explains each deprecated blueprint or option and what replaces it
+func printDeprecationWarnings(w io.Writer, notices []types.DeprecationNotice, now time.Time) {
+	for _, notice := range notices {
+		if notice.Variable == "" {
+			_, _ = fmt.Fprintln(w, ui.Text(i18n.T("deprecation.blueprint", notice.Blueprint)))
+		} else {
+			_, _ = fmt.Fprintln(w, ui.Text(i18n.T("deprecation.choice", notice.Variable, notice.Value, notice.Blueprint)))
+		}
+
+		if notice.Message != "" {
+			_, _ = fmt.Fprintln(w, ui.Text(i18n.T("deprecation.message", notice.Message)))
+		}
+		if notice.Since != "" {
+			_, _ = fmt.Fprintln(w, ui.Text(i18n.T("deprecation.since", notice.Since)))
+		}
+		if notice.Replacement != "" {
+			_, _ = fmt.Fprintln(w, ui.Text(i18n.T("deprecation.replacement", notice.Replacement)))
+		}
+		switch {
+		case notice.SunsetPassed(now):
+			_, _ = fmt.Fprintln(w, ui.Text(i18n.T("deprecation.sunset_passed", notice.Sunset)))
+		case notice.Sunset != "":
+			_, _ = fmt.Fprintln(w, ui.Text(i18n.T("deprecation.sunset", notice.Sunset)))
+		}
+	}
+}
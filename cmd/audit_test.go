@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func setupAuditTestBlueprints(t *testing.T) {
+	t.Helper()
+
+	templates.SetTemplatesFS(fstest.MapFS{
+		"legacy-api/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "legacy-api"
+name: "legacy-api"
+type: "web-api"
+deprecated:
+  replacement: "web-api-standard"
+  sunset: "2027-01-01"
+variables:
+  - name: "Logger"
+    choices: ["slog", "logrus"]
+    deprecated_choices:
+      logrus:
+        message: "logrus is in maintenance mode"
+        replacement: "slog"
+`)},
+		"cli/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "cli"
+name: "cli"
+type: "cli"
+`)},
+	})
+	t.Cleanup(func() { setupTestBlueprints(t) })
+}
+
+func writeTestManifest(t *testing.T, manifest generator.Manifest) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	data, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, generator.ManifestFile), data, 0644))
+	return dir
+}
+
+func TestAuditProject(t *testing.T) {
+	setupAuditTestBlueprints(t)
+
+	t.Run("deprecated since generation", func(t *testing.T) {
+		manifest := &generator.Manifest{Blueprint: "legacy-api", Config: types.ProjectConfig{Name: "api", Type: "web-api", Logger: "logrus"}}
+
+		report := auditProject(generator.New(), "api", manifest)
+		assert.False(t, report.BlueprintRemoved)
+		require.Len(t, report.Deprecations, 2)
+		assert.Equal(t, "web-api-standard", report.Deprecations[0].Replacement)
+		assert.Equal(t, "logrus", report.Deprecations[1].Value)
+	})
+
+	t.Run("recorded deprecations are kept", func(t *testing.T) {
+		manifest := &generator.Manifest{
+			Blueprint:    "cli",
+			Config:       types.ProjectConfig{Name: "tool", Type: "cli"},
+			Deprecations: []types.DeprecationNotice{{Blueprint: "cli", Variable: "Framework", Value: "urfave"}},
+		}
+
+		report := auditProject(generator.New(), "tool", manifest)
+		require.Len(t, report.Deprecations, 1)
+		assert.Equal(t, "urfave", report.Deprecations[0].Value)
+	})
+
+	t.Run("removed blueprint", func(t *testing.T) {
+		manifest := &generator.Manifest{Blueprint: "web-api-martini", Config: types.ProjectConfig{Name: "api", Type: "web-api"}}
+
+		report := auditProject(generator.New(), "api", manifest)
+		assert.True(t, report.BlueprintRemoved)
+		assert.Empty(t, report.Deprecations)
+	})
+}
+
+func TestRunAudit(t *testing.T) {
+	setupAuditTestBlueprints(t)
+
+	deprecated := writeTestManifest(t, generator.Manifest{Blueprint: "legacy-api", Config: types.ProjectConfig{Name: "api", Type: "web-api", Logger: "slog"}})
+	var out bytes.Buffer
+	err := runAudit(&out, deprecated, "console")
+	require.Error(t, err)
+	assert.Contains(t, out.String(), "legacy-api")
+	assert.Contains(t, out.String(), "web-api-standard")
+	assert.NotContains(t, out.String(), "logrus")
+
+	out.Reset()
+	require.Error(t, runAudit(&out, deprecated, "json"))
+	var report AuditReport
+	require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+	assert.Len(t, report.Deprecations, 1)
+
+	clean := writeTestManifest(t, generator.Manifest{Blueprint: "cli", Config: types.ProjectConfig{Name: "tool", Type: "cli"}})
+	out.Reset()
+	require.NoError(t, runAudit(&out, clean, "console"))
+
+	err = runAudit(&out, t.TempDir(), "console")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), generator.ManifestFile)
+}
+
+func TestPrintDeprecationWarnings(t *testing.T) {
+	now := time.Date(2027, 6, 1, 0, 0, 0, 0, time.UTC)
+	notices := []types.DeprecationNotice{
+		{Blueprint: "legacy-api", Deprecation: types.Deprecation{Replacement: "web-api-standard", Sunset: "2027-01-01"}},
+		{Blueprint: "legacy-api", Variable: "Logger", Value: "logrus", Deprecation: types.Deprecation{Sunset: "2028-01-01"}},
+	}
+
+	var out bytes.Buffer
+	printDeprecationWarnings(&out, notices, now)
+	assert.Contains(t, out.String(), "Blueprint legacy-api is deprecated")
+	assert.Contains(t, out.String(), "Use web-api-standard instead")
+	assert.Contains(t, out.String(), "sunset date 2027-01-01 has passed")
+	assert.Contains(t, out.String(), `Logger "logrus" is deprecated`)
+	assert.Contains(t, out.String(), "Scheduled for removal on 2028-01-01")
+}
@@ -0,0 +1,362 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/francknouama/go-starter/internal/blueprint"
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// blueprintCmd represents the blueprint command
+var blueprintCmd = &cobra.Command{
+	Use:   "blueprint",
+	Short: "Author custom blueprints",
+	Long: `Create, test and share your own blueprints.
+
+Available subcommands:
+  new      - Scaffold a blueprint with example templated files, sample variables and docs
+  lint     - Check a blueprint's template.yaml, templates, variables and conditions
+  test     - Render a blueprint with its sample variables and check the generated files
+  search   - Find blueprints in the registries of your organization
+  install  - Install a blueprint from a registry for 'new --blueprint <id>'
+  publish  - Add a release of a blueprint to a registry index
+  index    - Write the metadata index of a blueprints directory`,
+}
+
+// blueprintNewCmd represents the blueprint new command
+var blueprintNewCmd = &cobra.Command{
+	Use:   "new <name>",
+	Short: "Scaffold a new blueprint",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		root, _ := cmd.Flags().GetString("blueprints")
+		opts := blueprint.ScaffoldOptions{Name: args[0]}
+		opts.Type, _ = cmd.Flags().GetString("type")
+		opts.Description, _ = cmd.Flags().GetString("description")
+		opts.Author, _ = cmd.Flags().GetString("author")
+		return runBlueprintNew(root, opts)
+	},
+}
+
+// blueprintLintCmd represents the blueprint lint command
+var blueprintLintCmd = &cobra.Command{
+	Use:     "lint <dir>",
+	Aliases: []string{"validate"},
+	Short:   "Check a blueprint for mistakes",
+	Long: `Check a blueprint without generating a project from it:
+
+  schema      template.yaml only uses known keys, and its variables, files,
+              dependencies and hooks are complete and consistent
+  templates   every .tmpl parses, including those no file entry uses
+  variables   every variable the templates use is defined, and every declared
+              variable is used
+  style       no TODO or FIXME outside of template comments, no indentation
+              mixing spaces and tabs, rendered files end with a newline and
+              rendered Go files are indented with tabs
+  features    the files generated in every project do not import, outside of
+              an {{if}}, a package the sample variables generate no file of
+  conditions  every condition evaluates for the sample variables
+  render      the Go files rendered for the sample variables parse with gofmt
+  context     the rendered repositories take a context.Context, and the rendered
+              functions receiving a request neither call context.Background()
+              nor a service or repository method without the request context
+  generate    the rendered go:generate directives pin the version of the
+              tools they run, the Makefile has a generate target and a
+              workflow runs make generate-check
+
+The sample variables are the cases of testdata/cases.yaml, or a project named
+sample with the blueprint's defaults. Only errors fail the lint.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		output, _ := cmd.Flags().GetString("output")
+		return runBlueprintLint(cmd, args[0], output)
+	},
+}
+
+// blueprintTestCmd represents the blueprint test command
+var blueprintTestCmd = &cobra.Command{
+	Use:   "test <dir>",
+	Short: "Render a blueprint with its sample variables",
+	Long: `Render a blueprint once per case of its testdata/cases.yaml and check that
+each case produces the files it expects. With --build every case is also
+generated to disk, built and tested.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		build, _ := cmd.Flags().GetBool("build")
+		return runBlueprintTest(cmd, args[0], build)
+	},
+}
+
+// blueprintSearchCmd represents the blueprint search command
+var blueprintSearchCmd = &cobra.Command{
+	Use:   "search [query]",
+	Short: "Find blueprints in registries",
+	Long: `List the blueprints of the registries whose ID, name, description, type or
+tags contain the query, or all of them without a query.
+
+Registries are the --registry flags, or the registries list of ~/.go-starter.yaml:
+
+  registries:
+    - https://blueprints.example.com
+    - https://raw.githubusercontent.com/org/blueprint-catalog/main`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		query := ""
+		if len(args) == 1 {
+			query = args[0]
+		}
+		output, _ := cmd.Flags().GetString("output")
+		return runBlueprintSearch(cmd, registries(cmd), query, output)
+	},
+}
+
+// blueprintInstallCmd represents the blueprint install command
+var blueprintInstallCmd = &cobra.Command{
+	Use:   "install <id>[@version]",
+	Short: "Install a blueprint from a registry",
+	Long: `Fetch the latest release, or the given version, of a blueprint from the first
+registry publishing it. The release is checked against the checksum of the
+registry and cached, and 'go-starter new --blueprint <id>' generates from it.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		refresh, _ := cmd.Flags().GetBool("refresh")
+		return runBlueprintInstall(cmd, registries(cmd), args[0], refresh)
+	},
+}
+
+// blueprintPublishCmd represents the blueprint publish command
+var blueprintPublishCmd = &cobra.Command{
+	Use:   "publish <host/org/repo//dir@tag>",
+	Short: "Add a release of a blueprint to a registry index",
+	Long: `Fetch the blueprint at a git tag, lint it, and add it with the checksum of its
+directory to the index.json of a registry kept in a local directory. Upload or
+commit that directory wherever the registry is served from: the index is a
+static file. A published version cannot be published again with other content.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		index, _ := cmd.Flags().GetString("index")
+		opts := blueprint.PublishOptions{}
+		opts.Version, _ = cmd.Flags().GetString("version")
+		opts.Tags, _ = cmd.Flags().GetStringSlice("tag")
+		return runBlueprintPublish(cmd, index, args[0], opts)
+	},
+}
+
+// blueprintIndexCmd represents the blueprint index command
+var blueprintIndexCmd = &cobra.Command{
+	Use:   "index [dir]",
+	Short: "Write the metadata index of a blueprints directory",
+	Long: `Write the index.yaml of a directory of blueprints, blueprints by default:
+the ID, name, description, type and directory of each blueprint. With it,
+go-starter reads only the template.yaml of the blueprint a command uses
+instead of every blueprint at startup. Run it after changing the metadata of
+a blueprint, or adding or removing one; go generate runs it for the embedded
+blueprints.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := "blueprints"
+		if len(args) == 1 {
+			dir = args[0]
+		}
+		index, err := templates.WriteIndex(dir)
+		if err != nil {
+			return fmt.Errorf("failed to index %s: %w", dir, err)
+		}
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "✓ Indexed %d blueprints in %s\n", len(index.Blueprints), filepath.Join(dir, templates.IndexFile))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(blueprintCmd)
+	blueprintCmd.AddCommand(blueprintNewCmd)
+	blueprintCmd.AddCommand(blueprintLintCmd)
+	blueprintCmd.AddCommand(blueprintTestCmd)
+	blueprintCmd.AddCommand(blueprintSearchCmd)
+	blueprintCmd.AddCommand(blueprintInstallCmd)
+	blueprintCmd.AddCommand(blueprintPublishCmd)
+	blueprintCmd.AddCommand(blueprintIndexCmd)
+
+	blueprintNewCmd.Flags().String("blueprints", "blueprints", "Directory the blueprint is created in")
+	blueprintNewCmd.Flags().String("type", "cli", "Project type of the blueprint")
+	blueprintNewCmd.Flags().String("description", "", "Description of the blueprint")
+	blueprintNewCmd.Flags().String("author", "", "Author of the blueprint")
+
+	blueprintLintCmd.Flags().StringP("output", "o", "console", "Output format (console, json)")
+
+	blueprintTestCmd.Flags().Bool("build", false, "Also build every generated case and run its tests")
+
+	for _, cmd := range []*cobra.Command{blueprintSearchCmd, blueprintInstallCmd} {
+		cmd.Flags().StringSlice("registry", nil, "Registry URL or directory, searched in order (default: registries of ~/.go-starter.yaml)")
+	}
+	blueprintSearchCmd.Flags().StringP("output", "o", "console", "Output format (console, json)")
+	blueprintInstallCmd.Flags().Bool("refresh", false, "Clone the release again even when it is cached")
+	blueprintPublishCmd.Flags().String("index", ".", "Directory of the registry, its index.json is created when missing")
+	blueprintPublishCmd.Flags().String("version", "", "Version of the release (default: the tag)")
+	blueprintPublishCmd.Flags().StringSlice("tag", nil, "Search tags of the blueprint, replacing the published ones")
+}
+
+// registries returns the --registry flags, or the registries of the config file
+func registries(cmd *cobra.Command) []string {
+	if flagged, _ := cmd.Flags().GetStringSlice("registry"); len(flagged) > 0 {
+		return flagged
+	}
+	return viper.GetStringSlice("registries")
+}
+
+// runBlueprintNew scaffolds a blueprint and lists its files
+func runBlueprintNew(root string, opts blueprint.ScaffoldOptions) error {
+	written, err := blueprint.Scaffold(root, opts)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(root, opts.Name)
+	fmt.Printf("✓ Created blueprint %s in %s\n", opts.Name, dir)
+	for _, file := range written {
+		fmt.Printf("  %s\n", file)
+	}
+	fmt.Printf("\nNext: edit %s, then run 'go-starter blueprint test %s'\n", filepath.Join(dir, "template.yaml"), dir)
+	return nil
+}
+
+// runBlueprintLint lints the blueprint in dir and prints its findings
+func runBlueprintLint(cmd *cobra.Command, dir, format string) error {
+	findings, err := blueprint.Lint(cmd.Context(), dir)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "json":
+		if findings == nil {
+			findings = []blueprint.Finding{}
+		}
+		data, err := json.MarshalIndent(findings, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode findings: %w", err)
+		}
+		fmt.Println(string(data))
+	case "console":
+		errors := 0
+		for _, finding := range findings {
+			icon := "⚠️ "
+			if finding.Severity == blueprint.SeverityError {
+				icon = "❌"
+				errors++
+			}
+			fmt.Printf("%s [%s] %s\n", icon, finding.Check, finding.Message)
+		}
+		if len(findings) == 0 {
+			fmt.Printf("✓ %s has no problems\n", dir)
+		} else {
+			fmt.Printf("\n%d errors, %d warnings\n", errors, len(findings)-errors)
+		}
+	default:
+		return fmt.Errorf("unsupported output format %q (console, json)", format)
+	}
+
+	if blueprint.HasErrors(findings) {
+		return fmt.Errorf("blueprint %s has errors", dir)
+	}
+	return nil
+}
+
+// runBlueprintTest renders the blueprint in dir and reports each case
+func runBlueprintTest(cmd *cobra.Command, dir string, build bool) error {
+	results, err := blueprint.Test(cmd.Context(), dir, build)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Passed() {
+			fmt.Printf("✓ %s: %d files\n", result.Case.Name, len(result.Files))
+			continue
+		}
+		failed++
+		fmt.Printf("❌ %s\n", result.Case.Name)
+		if result.Err != nil {
+			fmt.Printf("   %v\n", result.Err)
+		}
+		for _, problem := range result.Problems {
+			fmt.Printf("   %s\n", problem)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d blueprint cases failed", failed, len(results))
+	}
+	return nil
+}
+
+// runBlueprintSearch lists the blueprints of the registries matching the query
+func runBlueprintSearch(cmd *cobra.Command, registries []string, query, format string) error {
+	results, err := blueprint.Search(cmd.Context(), registries, query)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "json":
+		entries := make([]blueprint.IndexEntry, 0, len(results))
+		for _, result := range results {
+			entries = append(entries, result.IndexEntry)
+		}
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode blueprints: %w", err)
+		}
+		fmt.Println(string(data))
+	case "console":
+		if len(results) == 0 {
+			fmt.Println("No blueprints found")
+			return nil
+		}
+		for _, result := range results {
+			fmt.Printf("%s %s (%s)\n", result.ID, result.Latest().Version, result.Type)
+			if result.Description != "" {
+				fmt.Printf("   %s\n", result.Description)
+			}
+			if len(result.Tags) > 0 {
+				fmt.Printf("   tags: %s\n", strings.Join(result.Tags, ", "))
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported output format %q (console, json)", format)
+	}
+	return nil
+}
+
+// runBlueprintInstall installs a blueprint from the registries
+func runBlueprintInstall(cmd *cobra.Command, registries []string, ref string, refresh bool) error {
+	installed, remote, err := blueprint.Install(cmd.Context(), registries, ref, blueprint.FetchOptions{Refresh: refresh})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✓ Installed %s %s from %s\n", installed.ID, installed.Version, installed.Registry)
+	fmt.Printf("  %s at %s (%s)\n", installed.Source, shortCommit(remote.Commit), installed.Checksum)
+	fmt.Printf("\nNext: go-starter new my-project --blueprint %s\n", installed.ID)
+	return nil
+}
+
+// runBlueprintPublish adds a release of the blueprint at source to the index in dir
+func runBlueprintPublish(cmd *cobra.Command, dir, source string, opts blueprint.PublishOptions) error {
+	parsed, err := blueprint.ParseSource(source)
+	if err != nil {
+		return err
+	}
+	entry, release, err := blueprint.Publish(cmd.Context(), dir, parsed, opts)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✓ Published %s %s (%s) to %s\n", entry.ID, release.Version, release.Checksum, filepath.Join(dir, blueprint.IndexFile))
+	fmt.Printf("\nNext: upload %s to the registry, then run 'go-starter blueprint install %s@%s'\n", blueprint.IndexFile, entry.ID, release.Version)
+	return nil
+}
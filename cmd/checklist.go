@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/i18n"
+	"github.com/francknouama/go-starter/internal/ui"
+)
+
+// checklistCmd represents the checklist command
+var checklistCmd = &cobra.Command{
+	Use:   "checklist [project-dir]",
+	Short: "Report the production readiness of a generated project",
+	Long: `Inspect a project generated by go-starter, its generation manifest and its files,
+and report the recommended features of a production service it lacks: health
+checks, metrics, alerts, rate limits, database migrations and backups,
+authentication, a container image and CI. Each missing feature comes with the
+go-starter add command adding it, when the blueprint of the project offers it,
+or with what to do by hand.`,
+	Example: `  go-starter checklist
+  go-starter checklist ./my-api -o json
+  go-starter checklist ./my-api --strict`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectPath := "."
+		if len(args) == 1 {
+			projectPath = args[0]
+		}
+		output, _ := cmd.Flags().GetString("output")
+		strict, _ := cmd.Flags().GetBool("strict")
+		return runChecklist(cmd.OutOrStdout(), projectPath, output, strict)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(checklistCmd)
+
+	checklistCmd.Flags().StringP("output", "o", "console", "Output format (console, json)")
+	checklistCmd.Flags().Bool("strict", false, "Exit with an error when a recommended feature is missing")
+}
+
+// runChecklist checks the project at projectPath and prints the report
+func runChecklist(w io.Writer, projectPath, format string, strict bool) error {
+	checklist, err := generator.New().Checklist(projectPath)
+	if err != nil {
+		return err
+	}
+
+	if format == "json" {
+		data, err := json.MarshalIndent(checklist, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode report: %w", err)
+		}
+		_, _ = fmt.Fprintln(w, string(data))
+	} else {
+		printChecklist(w, checklist)
+	}
+
+	if missing := checklist.Missing(); strict && len(missing) > 0 {
+		return fmt.Errorf("project %s lacks %d recommended features for production", projectPath, len(missing))
+	}
+	return nil
+}
+
+// printChecklist prints a checklist for humans, the missing items with how to add them
+func printChecklist(w io.Writer, checklist *generator.Checklist) {
+	if len(checklist.Items) == 0 {
+		_, _ = fmt.Fprintln(w, i18n.T("checklist.none", checklist.Project, checklist.Blueprint))
+		return
+	}
+
+	_, _ = fmt.Fprintln(w, i18n.T("checklist.project", checklist.Project, checklist.Blueprint))
+	for _, item := range checklist.Items {
+		if item.Status == generator.ChecklistOK {
+			_, _ = fmt.Fprintln(w, ui.Text(i18n.T("checklist.ok", item.Title)))
+			continue
+		}
+		_, _ = fmt.Fprintln(w, ui.Text(i18n.T("checklist.missing", item.Title)))
+		if item.Command != "" {
+			_, _ = fmt.Fprintln(w, i18n.T("checklist.command", item.Command))
+		} else {
+			_, _ = fmt.Fprintln(w, i18n.T("checklist.advice", item.Advice))
+		}
+	}
+
+	if len(checklist.Missing()) == 0 {
+		_, _ = fmt.Fprintln(w, ui.Text(i18n.T("checklist.ready")))
+	}
+}
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/generator"
+)
+
+func TestRunChecklist(t *testing.T) {
+	setupTestBlueprints(t)
+	dir := writeTestProject(t)
+
+	var out bytes.Buffer
+	require.NoError(t, runChecklist(&out, dir, "console", false))
+	assert.Contains(t, out.String(), "Production readiness of "+dir+" (blueprint web-app):")
+	assert.Contains(t, out.String(), "❌ Metrics\n       by hand: ", "web-app offers no observability option")
+
+	out.Reset()
+	err := runChecklist(&out, dir, "json", true)
+	assert.ErrorContains(t, err, "recommended features for production", "--strict fails on missing features")
+	var checklist generator.Checklist
+	require.NoError(t, json.Unmarshal(out.Bytes(), &checklist))
+	assert.Equal(t, "web-app", checklist.Blueprint)
+	assert.NotEmpty(t, checklist.Missing())
+}
@@ -0,0 +1,289 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/francknouama/go-starter/internal/blueprint"
+	"github.com/francknouama/go-starter/internal/deps"
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/internal/ui"
+	"github.com/francknouama/go-starter/internal/utils"
+	"github.com/francknouama/go-starter/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+const defaultSnapshotFile = "blueprints/dependencies.snapshot.json"
+
+// depsCmd represents the deps command
+var depsCmd = &cobra.Command{
+	Use:   "deps",
+	Short: "Track and refresh the dependency versions pinned by blueprints",
+	Long: `Track the dependency versions each blueprint pins in its generated go.mod,
+report outdated or vulnerable pins, and bump them across all blueprints.
+
+Available subcommands:
+  snapshot  - Record (or verify) the pinned dependency set of every blueprint
+  check     - Report outdated pins (module proxy) and known vulnerabilities (govulncheck)
+  bump      - Move outdated pins to their latest version, optionally verifying compilation`,
+}
+
+// depsSnapshotCmd represents the deps snapshot command
+var depsSnapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Write or verify the blueprint dependency snapshot",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		root, _ := cmd.Flags().GetString("blueprints")
+		file, _ := cmd.Flags().GetString("file")
+		verify, _ := cmd.Flags().GetBool("verify")
+		return runDepsSnapshot(root, file, verify)
+	},
+}
+
+// depsCheckCmd represents the deps check command
+var depsCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Report outdated and vulnerable dependency pins",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		root, _ := cmd.Flags().GetString("blueprints")
+		proxy, _ := cmd.Flags().GetString("proxy")
+		vuln, _ := cmd.Flags().GetBool("vuln")
+		output, _ := cmd.Flags().GetString("output")
+		failOnOutdated, _ := cmd.Flags().GetBool("fail-on-outdated")
+
+		reports, err := checkDependencies(cmd.Context(), root, proxy, vuln)
+		if err != nil {
+			return err
+		}
+		if err := printDependencyReports(reports, output); err != nil {
+			return err
+		}
+
+		for _, report := range reports {
+			if len(report.Vulnerabilities) > 0 {
+				return fmt.Errorf("vulnerable dependency pins found")
+			}
+			if failOnOutdated && report.Outdated {
+				return fmt.Errorf("outdated dependency pins found")
+			}
+		}
+		return nil
+	},
+}
+
+// depsBumpCmd represents the deps bump command
+var depsBumpCmd = &cobra.Command{
+	Use:   "bump",
+	Short: "Bump outdated dependency pins to their latest versions",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		root, _ := cmd.Flags().GetString("blueprints")
+		proxy, _ := cmd.Flags().GetString("proxy")
+		file, _ := cmd.Flags().GetString("file")
+		verify, _ := cmd.Flags().GetBool("verify")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		return runDepsBump(cmd.Context(), root, proxy, file, verify, dryRun)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(depsCmd)
+	depsCmd.AddCommand(depsSnapshotCmd)
+	depsCmd.AddCommand(depsCheckCmd)
+	depsCmd.AddCommand(depsBumpCmd)
+
+	depsCmd.PersistentFlags().String("blueprints", "blueprints", "Blueprints directory")
+
+	depsSnapshotCmd.Flags().String("file", defaultSnapshotFile, "Snapshot file")
+	depsSnapshotCmd.Flags().Bool("verify", false, "Fail if the snapshot does not match the blueprints (for CI)")
+
+	depsCheckCmd.Flags().String("proxy", deps.DefaultProxyURL, "Go module proxy used for latest version lookups")
+	depsCheckCmd.Flags().Bool("vuln", true, "Scan pins with govulncheck, which must be in PATH")
+	depsCheckCmd.Flags().StringP("output", "o", "console", "Output format (console, json)")
+	depsCheckCmd.Flags().Bool("fail-on-outdated", false, "Exit with an error when outdated pins are found")
+
+	depsBumpCmd.Flags().String("proxy", deps.DefaultProxyURL, "Go module proxy used for latest version lookups")
+	depsBumpCmd.Flags().String("file", defaultSnapshotFile, "Snapshot file to refresh after bumping")
+	depsBumpCmd.Flags().Bool("verify", true, "Generate and compile every affected blueprint after bumping")
+	depsBumpCmd.Flags().Bool("dry-run", false, "Only print the planned updates")
+}
+
+// runDepsSnapshot writes the current snapshot or compares it with the recorded one
+func runDepsSnapshot(root, file string, verify bool) error {
+	snapshot, err := deps.Scan(os.DirFS(root))
+	if err != nil {
+		return err
+	}
+
+	if !verify {
+		if err := deps.WriteSnapshot(file, snapshot); err != nil {
+			return err
+		}
+		fmt.Printf("✓ Recorded %d dependency pins (%d unique module versions) in %s\n", len(snapshot.Pins), len(snapshot.Modules()), file)
+		return nil
+	}
+
+	recorded, err := deps.LoadSnapshot(file)
+	if err != nil {
+		return err
+	}
+
+	added, removed := deps.Diff(recorded, snapshot)
+	if len(added) == 0 && len(removed) == 0 {
+		fmt.Println("✓ Dependency snapshot is up to date")
+		return nil
+	}
+
+	for _, pin := range removed {
+		fmt.Println(ui.DiffLine(ui.DiffRemoved, fmt.Sprintf("%s %s (%s)", pin.Key(), pin.Blueprint, pin.Source)))
+	}
+	for _, pin := range added {
+		fmt.Println(ui.DiffLine(ui.DiffAdded, fmt.Sprintf("%s %s (%s)", pin.Key(), pin.Blueprint, pin.Source)))
+	}
+	return fmt.Errorf("dependency snapshot %s is stale, run 'go-starter deps snapshot' to refresh it", file)
+}
+
+// checkDependencies scans the blueprints and checks every pin for freshness and vulnerabilities
+func checkDependencies(ctx context.Context, root, proxy string, vuln bool) ([]deps.Report, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	snapshot, err := deps.Scan(os.DirFS(root))
+	if err != nil {
+		return nil, err
+	}
+
+	checker := &deps.Checker{Proxy: deps.NewProxyClient(proxy)}
+	if vuln {
+		// A report claiming no vulnerabilities must come from a scan
+		scanner := deps.NewGovulncheckScanner()
+		if !scanner.Available() {
+			return nil, fmt.Errorf("govulncheck not found in PATH, install it with 'go install golang.org/x/vuln/cmd/govulncheck@latest' or skip the scan with --vuln=false")
+		}
+		checker.Scanner = scanner
+	}
+
+	return checker.Check(ctx, snapshot)
+}
+
+// printDependencyReports prints dependency reports in the requested format
+func printDependencyReports(reports []deps.Report, format string) error {
+	if format == "json" {
+		data, err := json.MarshalIndent(reports, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode report: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	outdated, vulnerable := 0, 0
+	for _, report := range reports {
+		switch {
+		case report.Error != "":
+			fmt.Printf("⚠️  %s %s: %s\n", report.Module, report.Version, report.Error)
+		case len(report.Vulnerabilities) > 0:
+			vulnerable++
+			fmt.Printf("❌ %s %s vulnerable (%v), latest %s - used by %v\n", report.Module, report.Version, report.Vulnerabilities, report.Latest, report.Blueprints)
+		case report.Outdated:
+			outdated++
+			fmt.Printf("⬆️  %s %s -> %s - used by %v\n", report.Module, report.Version, report.Latest, report.Blueprints)
+		}
+	}
+
+	fmt.Printf("\nChecked %d module versions: %d outdated, %d vulnerable\n", len(reports), outdated, vulnerable)
+	return nil
+}
+
+// runDepsBump bumps outdated pins and verifies the affected blueprints still compile
+func runDepsBump(ctx context.Context, root, proxy, file string, verify, dryRun bool) error {
+	reports, err := checkDependencies(ctx, root, proxy, false)
+	if err != nil {
+		return err
+	}
+
+	updates := deps.UpdatesFromReports(reports)
+	if len(updates) == 0 {
+		fmt.Println("✓ All dependency pins are up to date")
+		return nil
+	}
+
+	for _, update := range updates {
+		fmt.Printf("⬆️  %s %s -> %s\n", update.Module, update.From, update.To)
+	}
+	if dryRun {
+		return nil
+	}
+
+	snapshot, err := deps.Scan(os.DirFS(root))
+	if err != nil {
+		return err
+	}
+
+	files, blueprintIDs, err := deps.Bump(root, snapshot, updates)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✓ Updated %d files across %d blueprints\n", len(files), len(blueprintIDs))
+
+	if verify {
+		for _, id := range blueprintIDs {
+			fmt.Printf("Verifying %s compiles...\n", id)
+			if err := verifyBlueprintCompiles(root, id); err != nil {
+				return fmt.Errorf("blueprint %s no longer compiles after bump: %w", id, err)
+			}
+		}
+	}
+
+	refreshed, err := deps.Scan(os.DirFS(root))
+	if err != nil {
+		return err
+	}
+	return deps.WriteSnapshot(file, refreshed)
+}
+
+// verifyBlueprintCompiles generates a blueprint with its default variables and builds it
+func verifyBlueprintCompiles(root, blueprintID string) error {
+	registry, err := templates.NewRegistryWithFS(os.DirFS(root))
+	if err != nil {
+		return err
+	}
+	tmpl, err := registry.Get(blueprintID)
+	if err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp("", "go-starter-deps-*")
+	if err != nil {
+		return fmt.Errorf("failed to create verification directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	config := types.ProjectConfig{
+		Name:         "depcheck",
+		Module:       "example.com/depcheck",
+		Type:         tmpl.Type,
+		Architecture: tmpl.Architecture,
+		Framework:    blueprint.OrDefault("", tmpl, "Framework"),
+		GoVersion:    blueprint.OrDefault("", tmpl, "GoVersion"),
+		Logger:       "slog",
+		Variables:    map[string]string{"blueprint_id": tmpl.ID},
+	}
+
+	outputPath := filepath.Join(dir, config.Name)
+	start := time.Now()
+	if _, err := generator.NewWithRegistry(registry).Generate(config, types.GenerationOptions{OutputPath: outputPath, NoGit: true}); err != nil {
+		return err
+	}
+	if err := utils.GoBuild(outputPath, ""); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ %s compiled in %s\n", blueprintID, time.Since(start).Round(time.Millisecond))
+	return nil
+}
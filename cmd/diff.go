@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/i18n"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// diffCmd represents the diff command
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show what changes between the projects of two configurations",
+	Long: `Generate two configurations in memory and print a unified diff of the
+resulting projects, to see what an option actually changes before choosing it.
+
+Both sides start from the configuration of the flags shared with 'new'. --from
+and --to then set options on one side only, as key=value pairs: the keys are
+the flags of 'new' (architecture, framework, logger, go-version, database-driver,
+database-orm, auth-type, type) or the name of any blueprint variable.
+Nothing is written to disk.`,
+	Example: `  go-starter diff --type=web-api --from architecture=standard --to architecture=hexagonal
+  go-starter diff --type=web-api --architecture=clean --to database-driver=postgres --to database-orm=gorm --stat
+  go-starter diff --type=cli --from logger=slog --to logger=zap -o json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		from, _ := cmd.Flags().GetStringArray("from")
+		to, _ := cmd.Flags().GetStringArray("to")
+		stat, _ := cmd.Flags().GetBool("stat")
+		output, _ := cmd.Flags().GetString("output")
+
+		base := types.ProjectConfig{Variables: map[string]string{}}
+		for _, option := range []string{"name", "module", "type", "architecture", "framework", "logger", "go-version", "database-driver", "database-orm", "auth-type"} {
+			if value, _ := cmd.Flags().GetString(option); value != "" {
+				setDiffOption(&base, option, value)
+			}
+		}
+		if base.Module == "" {
+			base.Module = "github.com/username/" + base.Name
+		}
+		return runDiff(cmd, base, from, to, stat, output)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+
+	diffCmd.Flags().String("name", "myapp", "Project name of both sides")
+	diffCmd.Flags().String("module", "", "Go module path of both sides (default github.com/username/<name>)")
+	diffCmd.Flags().String("type", "", "Project type of both sides")
+	diffCmd.Flags().String("architecture", "", "Architecture pattern of both sides")
+	diffCmd.Flags().String("framework", "", "Framework of both sides")
+	diffCmd.Flags().String("logger", "", "Logger of both sides")
+	diffCmd.Flags().String("go-version", "", "Go version of both sides")
+	diffCmd.Flags().String("database-driver", "", "Database driver of both sides")
+	diffCmd.Flags().String("database-orm", "", "Database ORM of both sides")
+	diffCmd.Flags().String("auth-type", "", "Authentication type of both sides")
+	diffCmd.Flags().StringArray("from", nil, "Option of the left side only, as key=value (repeatable)")
+	diffCmd.Flags().StringArray("to", nil, "Option of the right side only, as key=value (repeatable)")
+	diffCmd.Flags().Bool("stat", false, "Only list the changed files with their number of changed lines")
+	diffCmd.Flags().StringP("output", "o", "console", "Output format (console, json)")
+	_ = diffCmd.MarkFlagRequired("type")
+}
+
+// runDiff generates base with the from and to options applied and prints the changes
+func runDiff(cmd *cobra.Command, base types.ProjectConfig, from, to []string, stat bool, format string) error {
+	if len(from)+len(to) == 0 {
+		return fmt.Errorf("nothing to compare, set the options of each side with --from and --to")
+	}
+	left, err := diffSide(base, from)
+	if err != nil {
+		return err
+	}
+	right, err := diffSide(base, to)
+	if err != nil {
+		return err
+	}
+
+	diff, err := generator.New().DiffConfigs(cmd.Context(), left, right)
+	if err != nil {
+		return err
+	}
+
+	w := cmd.OutOrStdout()
+	if format == "json" {
+		data, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode diff: %w", err)
+		}
+		_, _ = fmt.Fprintln(w, string(data))
+		return nil
+	}
+	printConfigDiff(w, diff, diffLabel(diff.From, from), diffLabel(diff.To, to), stat)
+	return nil
+}
+
+// diffSide returns base with key=value options applied
+func diffSide(base types.ProjectConfig, options []string) (types.ProjectConfig, error) {
+	side := base
+	side.Variables = make(map[string]string, len(base.Variables)+len(options))
+	for name, value := range base.Variables {
+		side.Variables[name] = value
+	}
+	if base.Features != nil {
+		features := *base.Features
+		side.Features = &features
+	}
+
+	for _, option := range options {
+		key, value, ok := strings.Cut(option, "=")
+		if !ok || key == "" {
+			return side, fmt.Errorf("invalid option %q, expected key=value", option)
+		}
+		setDiffOption(&side, key, value)
+	}
+	return side, nil
+}
+
+// setDiffOption sets a flag of new, or a blueprint variable, on config
+func setDiffOption(config *types.ProjectConfig, key, value string) {
+	if config.Features == nil && (key == "database-driver" || key == "database-orm" || key == "auth-type") {
+		config.Features = &types.Features{}
+	}
+	switch key {
+	case "name":
+		config.Name = value
+	case "module":
+		config.Module = value
+	case "type":
+		config.Type = value
+	case "architecture":
+		config.Architecture = value
+	case "framework":
+		config.Framework = value
+	case "logger":
+		config.Logger = value
+	case "go-version":
+		config.GoVersion = value
+	case "database-driver":
+		config.Features.Database.Driver = value
+		config.Variables["DatabaseDriver"] = value
+	case "database-orm":
+		config.Features.Database.ORM = value
+		config.Variables["DatabaseORM"] = value
+	case "auth-type":
+		config.Features.Authentication.Type = value
+		config.Variables["AuthType"] = value
+	default:
+		config.Variables[key] = value
+	}
+}
+
+// diffLabel names a side by its blueprint and the options that set it apart
+func diffLabel(blueprint string, options []string) string {
+	if len(options) == 0 {
+		return blueprint
+	}
+	return blueprint + " (" + strings.Join(options, ", ") + ")"
+}
+
+// printConfigDiff prints the changed files, as patches or with --stat as counts
+func printConfigDiff(w io.Writer, diff *generator.ConfigDiff, from, to string, stat bool) {
+	counts := map[string]int{}
+	for _, change := range diff.Changes {
+		counts[change.Status]++
+	}
+	_, _ = fmt.Fprintln(w, i18n.T("configdiff.summary", from, to, counts[generator.FileAdded], counts[generator.FileRemoved], counts[generator.FileModified], diff.Unchanged))
+	if len(diff.Changes) == 0 {
+		_, _ = fmt.Fprintln(w, i18n.T("configdiff.identical"))
+		return
+	}
+
+	for _, change := range diff.Changes {
+		switch {
+		case stat && change.Binary:
+			_, _ = fmt.Fprintf(w, " %-8s %s (binary)\n", change.Status, change.Path)
+		case stat:
+			_, _ = fmt.Fprintf(w, " %-8s %s +%d -%d\n", change.Status, change.Path, change.Insertions, change.Deletions)
+		case change.Binary:
+			_, _ = fmt.Fprintf(w, "\nBinary files a/%s and b/%s differ\n", change.Path, change.Path)
+		default:
+			_, _ = fmt.Fprint(w, "\n"+change.Patch)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestRunDiff(t *testing.T) {
+	setupTestBlueprints(t)
+
+	var out bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	cmd.SetContext(context.Background())
+
+	base := types.ProjectConfig{Name: "tool", Module: "github.com/test/tool", Type: "cli", Variables: map[string]string{}}
+	require.NoError(t, runDiff(cmd, base, []string{"logger=slog"}, []string{"logger=zap"}, false, "console"))
+	assert.Contains(t, out.String(), "Comparing cli (logger=slog) with cli (logger=zap)")
+	assert.Contains(t, out.String(), "+++ b/go.mod")
+	assert.Contains(t, out.String(), "go.uber.org/zap")
+
+	out.Reset()
+	require.NoError(t, runDiff(cmd, base, nil, []string{"logger=zap"}, true, "console"))
+	assert.Contains(t, out.String(), "modified")
+	assert.NotContains(t, out.String(), "+++ b/go.mod")
+
+	out.Reset()
+	require.NoError(t, runDiff(cmd, base, []string{"logger=slog"}, []string{"logger=slog"}, false, "json"))
+	assert.Contains(t, out.String(), `"changes": []`)
+
+	err := runDiff(cmd, base, nil, nil, false, "console")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nothing to compare")
+
+	err = runDiff(cmd, base, []string{"logger"}, nil, false, "console")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid option "logger"`)
+}
+
+func TestDiffSide(t *testing.T) {
+	base := types.ProjectConfig{Type: "web-api", Variables: map[string]string{"custom": "a"}}
+
+	side, err := diffSide(base, []string{"architecture=hexagonal", "database-driver=postgres", "custom=b"})
+	require.NoError(t, err)
+	assert.Equal(t, "hexagonal", side.Architecture)
+	assert.Equal(t, "postgres", side.Features.Database.Driver)
+	assert.Equal(t, "postgres", side.Variables["DatabaseDriver"])
+	assert.Equal(t, "b", side.Variables["custom"])
+
+	// The options of one side never leak into base
+	assert.Equal(t, "a", base.Variables["custom"])
+	assert.Nil(t, base.Features)
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/francknouama/go-starter/internal/doctor"
+	"github.com/francknouama/go-starter/internal/i18n"
+	"github.com/francknouama/go-starter/internal/ui"
+)
+
+// doctorCmd represents the doctor command
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the environment go-starter and generated projects need",
+	Long: `Check the tools and access that go-starter and the projects it generates rely
+on, and explain how to fix what is missing:
+
+  go          Go is installed and recent enough for the blueprints
+  git         git is installed and has an identity to commit with
+  gobin       binaries installed with go install can be run by name
+  docker      a Docker daemon runs the testcontainers of integration tests
+  network     the Go module proxy answers, for go mod tidy
+  blueprints  every blueprint loads, with all its files present and parsing
+
+Exits with an error when a check fails. Warnings only affect some projects.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		output, _ := cmd.Flags().GetString("output")
+		results := doctor.New().Check(cmd.Context())
+		return printDoctorResults(cmd.OutOrStdout(), results, output)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+
+	doctorCmd.Flags().StringP("output", "o", "console", "Output format (console, json)")
+}
+
+// doctorStatusKeys are the messages of the check statuses
+var doctorStatusKeys = map[string]string{
+	doctor.StatusOK:   "doctor.ok",
+	doctor.StatusWarn: "doctor.warn",
+	doctor.StatusFail: "doctor.fail",
+}
+
+// maxDoctorDetails is how many problems of a check the console lists
+const maxDoctorDetails = 10
+
+// printDoctorResults prints the results and fails when a check failed
+func printDoctorResults(w io.Writer, results []doctor.Result, format string) error {
+	counts := map[string]int{}
+	for _, result := range results {
+		counts[result.Status]++
+	}
+
+	if format == "json" {
+		data, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode results: %w", err)
+		}
+		_, _ = fmt.Fprintln(w, string(data))
+	} else {
+		for _, result := range results {
+			_, _ = fmt.Fprintln(w, ui.Text(i18n.T(doctorStatusKeys[result.Status], result.Check, result.Message)))
+			for i, detail := range result.Details {
+				if i == maxDoctorDetails {
+					_, _ = fmt.Fprintln(w, i18n.T("doctor.more", len(result.Details)-i))
+					break
+				}
+				_, _ = fmt.Fprintln(w, i18n.T("doctor.detail", detail))
+			}
+			if result.Fix != "" {
+				_, _ = fmt.Fprintln(w, i18n.T("doctor.fix", result.Fix))
+			}
+		}
+		_, _ = fmt.Fprintln(w)
+		_, _ = fmt.Fprintln(w, i18n.T("doctor.summary", counts[doctor.StatusOK], counts[doctor.StatusWarn], counts[doctor.StatusFail]))
+	}
+
+	if counts[doctor.StatusFail] > 0 {
+		return fmt.Errorf("%d environment checks failed", counts[doctor.StatusFail])
+	}
+	return nil
+}
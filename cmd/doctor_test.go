@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/doctor"
+)
+
+func TestPrintDoctorResults(t *testing.T) {
+	var details []string
+	for i := 0; i < 12; i++ {
+		details = append(details, fmt.Sprintf("cli: file%d.tmpl is missing", i))
+	}
+	results := []doctor.Result{
+		{Check: "go", Status: doctor.StatusOK, Message: "Go 1.24.1"},
+		{Check: "blueprints", Status: doctor.StatusWarn, Message: "12 problems", Details: details, Fix: "Reinstall go-starter"},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, printDoctorResults(&out, results, "console"))
+	assert.Contains(t, out.String(), "go: Go 1.24.1")
+	assert.Contains(t, out.String(), "   - cli: file9.tmpl is missing")
+	assert.NotContains(t, out.String(), "file10")
+	assert.Contains(t, out.String(), "and 2 more")
+	assert.Contains(t, out.String(), "   Fix: Reinstall go-starter")
+	assert.Contains(t, out.String(), "1 passed, 1 warnings, 0 failed.")
+
+	results = append(results, doctor.Result{Check: "git", Status: doctor.StatusFail, Message: "broken"})
+	out.Reset()
+	err := printDoctorResults(&out, results, "json")
+	require.EqualError(t, err, "1 environment checks failed")
+	assert.Contains(t, out.String(), `"details": [`)
+	assert.Contains(t, out.String(), `"status": "fail"`)
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/francknouama/go-starter/internal/i18n"
+	"github.com/francknouama/go-starter/internal/telemetry"
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// experimentalCmd represents the experimental command
+var experimentalCmd = &cobra.Command{
+	Use:   "experimental",
+	Short: "List the experimental blueprint features and how often they were used",
+	Long: `List the experimental features blueprints ship behind flags. Enable one for a
+generation with --experimental=<feature> or GO_STARTER_EXPERIMENTAL.
+
+The usage column counts the generations that used the feature among the events
+of the file telemetry sink, recorded once you opt in to usage telemetry.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		output, _ := cmd.Flags().GetString("output")
+		return printExperiments(cmd.OutOrStdout(), collectExperiments(templates.NewRegistry().List(), experimentUsage()), output)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(experimentalCmd)
+
+	experimentalCmd.Flags().StringP("output", "o", "console", "Output format (console, json)")
+}
+
+// ExperimentInfo describes an experimental feature and where it is used
+type ExperimentInfo struct {
+	types.Experiment
+	Blueprints  []string `json:"blueprints"`
+	Generations int      `json:"generations"`
+}
+
+// experimentUsage counts the generations that used each experimental feature among
+// the events of the file telemetry sink, none for other sinks
+func experimentUsage() map[string]int {
+	usage := make(map[string]int)
+	sink, err := telemetry.Open(telemetryConfig())
+	fileSink, ok := sink.(*telemetry.FileSink)
+	if err != nil || !ok {
+		return usage
+	}
+	events, err := fileSink.Events()
+	if err != nil {
+		return usage
+	}
+	for _, event := range events {
+		for _, name := range event.Experiments {
+			usage[name]++
+		}
+	}
+	return usage
+}
+
+// collectExperiments gathers the experiments declared by blueprints, sorted by name
+func collectExperiments(blueprints []types.Template, usage map[string]int) []ExperimentInfo {
+	byName := make(map[string]*ExperimentInfo)
+	for _, blueprint := range blueprints {
+		for _, experiment := range blueprint.Experiments {
+			info, ok := byName[experiment.Name]
+			if !ok {
+				info = &ExperimentInfo{Experiment: experiment, Generations: usage[experiment.Name]}
+				byName[experiment.Name] = info
+			}
+			info.Blueprints = append(info.Blueprints, blueprint.ID)
+		}
+	}
+
+	infos := make([]ExperimentInfo, 0, len(byName))
+	for _, info := range byName {
+		sort.Strings(info.Blueprints)
+		infos = append(infos, *info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// printExperiments prints the experiments in the requested format
+func printExperiments(w io.Writer, infos []ExperimentInfo, format string) error {
+	if format == "json" {
+		data, err := json.MarshalIndent(infos, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode experiments: %w", err)
+		}
+		_, _ = fmt.Fprintln(w, string(data))
+		return nil
+	}
+
+	if len(infos) == 0 {
+		_, _ = fmt.Fprintln(w, i18n.T("experimental.none"))
+		return nil
+	}
+
+	for _, info := range infos {
+		status := i18n.T("experimental.status")
+		if info.Since != "" {
+			status = i18n.T("experimental.since", info.Since)
+		}
+		if info.Graduated != "" {
+			status = i18n.T("experimental.graduated", info.Graduated)
+		}
+		_, _ = fmt.Fprintln(w, i18n.T("experimental.feature", info.Name, status, info.Generations))
+		if info.Description != "" {
+			_, _ = fmt.Fprintln(w, i18n.T("experimental.description", info.Description))
+		}
+		_, _ = fmt.Fprintln(w, i18n.T("experimental.blueprints", strings.Join(info.Blueprints, ", ")))
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestCollectExperiments(t *testing.T) {
+	blueprints := []types.Template{
+		{ID: "web-api-standard", Experiments: []types.Experiment{{Name: "framework.fuego", Since: "2.1.0"}}},
+		{ID: "microservice-standard", Experiments: []types.Experiment{{Name: "framework.fuego"}, {Name: "feature.outbox", Graduated: "2.2.0"}}},
+		{ID: "cli"},
+	}
+	usage := map[string]int{"framework.fuego": 3}
+
+	infos := collectExperiments(blueprints, usage)
+	require.Len(t, infos, 2)
+	assert.Equal(t, "feature.outbox", infos[0].Name)
+	assert.Equal(t, "framework.fuego", infos[1].Name)
+	assert.Equal(t, []string{"microservice-standard", "web-api-standard"}, infos[1].Blueprints)
+	assert.Equal(t, 3, infos[1].Generations)
+
+	var out bytes.Buffer
+	require.NoError(t, printExperiments(&out, infos, "console"))
+	assert.Contains(t, out.String(), "feature.outbox (graduated in 2.2.0, used in 0 generations)")
+	assert.Contains(t, out.String(), "framework.fuego (experimental since 2.1.0, used in 3 generations)")
+
+	out.Reset()
+	require.NoError(t, printExperiments(&out, infos, "json"))
+	var decoded []ExperimentInfo
+	require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	assert.Equal(t, infos, decoded)
+}
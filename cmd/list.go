@@ -1,14 +1,18 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/francknouama/go-starter/internal/ascii"
+	"github.com/francknouama/go-starter/internal/generator"
 	"github.com/francknouama/go-starter/internal/templates"
 	"github.com/francknouama/go-starter/pkg/types"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 // listCmd represents the list command
@@ -18,14 +22,44 @@ var listCmd = &cobra.Command{
 	Long: `Display all available project blueprints with their descriptions.
 
 This command shows all blueprints that can be used to generate new projects,
-including their type, architecture, and a brief description.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		listBlueprints()
+including their type, architecture, and a brief description.
+
+With --output json or yaml it prints the full catalog instead, for IDE extensions
+and CI scripts: every blueprint with the options go-starter new accepts for it,
+their flags, defaults and choices, and the requirements between them.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		output, _ := cmd.Flags().GetString("output")
+		if output == "console" {
+			listBlueprints()
+			return nil
+		}
+		return printCatalog(cmd.OutOrStdout(), generator.New().Catalog(), output)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(listCmd)
+
+	listCmd.Flags().StringP("output", "o", "console", "Output format (console, json, yaml)")
+}
+
+// printCatalog prints the blueprint catalog as json or yaml
+func printCatalog(w io.Writer, catalog generator.Catalog, format string) error {
+	var data []byte
+	var err error
+	switch format {
+	case "json":
+		data, err = json.MarshalIndent(catalog, "", "  ")
+	case "yaml":
+		data, err = yaml.Marshal(catalog)
+	default:
+		return fmt.Errorf("unsupported output format %q (console, json, yaml)", format)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to encode catalog: %w", err)
+	}
+	_, err = fmt.Fprintln(w, strings.TrimSuffix(string(data), "\n"))
+	return err
 }
 
 func listBlueprints() {
@@ -51,8 +85,9 @@ func listBlueprints() {
 		fmt.Println()
 	}
 	
+	// The summaries of the index are enough, the blueprints need not be loaded
 	registry := templates.NewRegistry()
-	blueprintList := registry.List()
+	blueprintList := registry.Summaries()
 
 	if len(blueprintList) == 0 {
 		noTemplatesStyle := lipgloss.NewStyle().
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -9,6 +10,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/francknouama/go-starter/internal/generator"
 	"github.com/francknouama/go-starter/internal/templates"
 	"github.com/francknouama/go-starter/pkg/types"
 )
@@ -236,7 +241,7 @@ func TestListCmd_Usage(t *testing.T) {
 	assert.Equal(t, "list", listCmd.Use)
 	assert.Equal(t, "List available project blueprints", listCmd.Short)
 	assert.NotEmpty(t, listCmd.Long)
-	assert.NotNil(t, listCmd.Run)
+	assert.NotNil(t, listCmd.RunE)
 }
 
 func TestListCmd_Execution(t *testing.T) {
@@ -248,7 +253,7 @@ func TestListCmd_Execution(t *testing.T) {
 		os.Stdout = w
 
 		// Execute the command function
-		listCmd.Run(listCmd, []string{})
+		_ = listCmd.RunE(listCmd, []string{})
 
 		// Restore stdout
 		_ = w.Close()
@@ -262,4 +267,42 @@ func TestListCmd_Execution(t *testing.T) {
 		// Should produce some output (either blueprint list or no blueprints message)
 		assert.True(t, len(output) >= 0) // At minimum, shouldn't crash
 	})
-}
\ No newline at end of file
+}
+func TestPrintCatalog(t *testing.T) {
+	catalog := generator.Catalog{Blueprints: []generator.CatalogEntry{{
+		ID:   "cli",
+		Name: "cli-standard",
+		Type: "cli",
+		Options: []generator.Option{
+			{Name: "Logger", Flag: "logger", Type: "string", Default: "slog", Choices: []string{"slog", "zap"}},
+		},
+	}}}
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, printCatalog(&buf, catalog, "json"))
+
+		var decoded generator.Catalog
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		require.Len(t, decoded.Blueprints, 1)
+		assert.Equal(t, "logger", decoded.Blueprints[0].Options[0].Flag)
+		assert.Equal(t, []string{"slog", "zap"}, decoded.Blueprints[0].Options[0].Choices)
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, printCatalog(&buf, catalog, "yaml"))
+		assert.Contains(t, buf.String(), "flag: logger")
+
+		var decoded generator.Catalog
+		require.NoError(t, yaml.Unmarshal(buf.Bytes(), &decoded))
+		require.Len(t, decoded.Blueprints, 1)
+		assert.Equal(t, "slog", decoded.Blueprints[0].Options[0].Default)
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		err := printCatalog(&bytes.Buffer{}, catalog, "xml")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported output format")
+	})
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/i18n"
+	"github.com/francknouama/go-starter/internal/ui"
+)
+
+// migrateArchCmd represents the migrate-arch command
+var migrateArchCmd = &cobra.Command{
+	Use:   "migrate-arch [project-dir]",
+	Short: "Move a generated web API to another architecture",
+	Long: `Restructure a web API generated with one architecture into the layout of another:
+
+  - the packages of each layer (handlers, services, repositories, models,
+    middleware, database, config, logger) move to their directory in the new layout
+  - the package clauses and the imports of the Go files follow them
+  - ` + generator.ArchMigrationReportFile + ` lists what is left to do by hand: the code that
+    crosses the boundaries of the new architecture, and the scripts, comments
+    and documentation still referring to the previous directories
+
+Supported architectures: ` + strings.Join(generator.ArchMigrations(), ", ") + `. The architecture of the
+project is read from its generation manifest unless --from is given.`,
+	Example: `  go-starter migrate-arch --to=hexagonal --dry-run
+  go-starter migrate-arch ./my-api --from=standard --to=clean`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectPath := "."
+		if len(args) == 1 {
+			projectPath = args[0]
+		}
+		from, _ := cmd.Flags().GetString("from")
+		to, _ := cmd.Flags().GetString("to")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		output, _ := cmd.Flags().GetString("output")
+		return runMigrateArch(cmd, projectPath, from, to, dryRun, output)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(migrateArchCmd)
+
+	migrateArchCmd.Flags().String("from", "", "Architecture of the project, read from its generation manifest when empty")
+	migrateArchCmd.Flags().String("to", "", "Architecture to move the project to ("+strings.Join(generator.ArchMigrations(), ", ")+")")
+	migrateArchCmd.Flags().Bool("dry-run", false, "Show the changes without writing them")
+	migrateArchCmd.Flags().StringP("output", "o", "console", "Output format (console, json)")
+	_ = migrateArchCmd.MarkFlagRequired("to")
+}
+
+// runMigrateArch plans the migration of the project at projectPath and applies it
+func runMigrateArch(cmd *cobra.Command, projectPath, from, to string, dryRun bool, format string) error {
+	gen := generator.New()
+	plan, err := gen.PlanArchMigration(projectPath, from, to)
+	if err != nil {
+		return err
+	}
+
+	w := cmd.OutOrStdout()
+	if format == "json" {
+		data, err := json.MarshalIndent(plan, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode plan: %w", err)
+		}
+		_, _ = fmt.Fprintln(w, string(data))
+	} else {
+		printArchMigrationPlan(w, plan)
+	}
+
+	if dryRun {
+		if format != "json" {
+			_, _ = fmt.Fprintln(w, i18n.T("add.dry_run"))
+		}
+		return nil
+	}
+	if err := gen.ApplyArchMigration(plan); err != nil {
+		return fmt.Errorf("failed to migrate %s: %w", projectPath, err)
+	}
+	if format == "json" {
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(w, ui.Text(i18n.T("migrate_arch.done", plan.To)))
+	_, _ = fmt.Fprintln(w, i18n.T("migrate_arch.next", generator.ArchMigrationReportFile))
+	return nil
+}
+
+// printArchMigrationPlan lists the packages a migration moves, the files it
+// rewrites and what it leaves to do by hand
+func printArchMigrationPlan(w io.Writer, plan *generator.ArchMigrationPlan) {
+	_, _ = fmt.Fprintln(w, i18n.T("migrate_arch.plan", plan.Project, plan.From, plan.To))
+	for _, move := range plan.Moves {
+		_, _ = fmt.Fprintln(w, i18n.T("migrate_arch.move", move.From, move.To, move.Files))
+	}
+	for _, file := range plan.Rewritten {
+		_, _ = fmt.Fprintln(w, i18n.T("migrate_arch.rewrite", file))
+	}
+	if len(plan.TODOs) > 0 {
+		_, _ = fmt.Fprintln(w, ui.Text(i18n.T("migrate_arch.todos", len(plan.TODOs), generator.ArchMigrationReportFile)))
+	}
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/generator"
+)
+
+func TestRunMigrateArch(t *testing.T) {
+	setupTestBlueprints(t)
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"go.mod":                     "module github.com/test/shop\n\ngo 1.21\n",
+		"internal/handlers/users.go": "package handlers\n\nimport \"github.com/test/shop/internal/services\"\n\nvar _ services.Users\n",
+		"internal/services/users.go": "package services\n\n// Users manages the users\ntype Users struct{}\n",
+	} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	var out bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	cmd.SetContext(context.Background())
+
+	require.NoError(t, runMigrateArch(cmd, dir, "standard", "clean", true, "console"))
+	assert.Contains(t, out.String(), "internal/handlers → internal/adapters/controllers (1 files)")
+	assert.Contains(t, out.String(), "rewrite    internal/adapters/controllers/users.go")
+	assert.Contains(t, out.String(), "Dry run")
+	assert.DirExists(t, filepath.Join(dir, "internal/handlers"))
+
+	out.Reset()
+	require.NoError(t, runMigrateArch(cmd, dir, "standard", "clean", false, "console"))
+	assert.Contains(t, out.String(), "Migrated to the clean architecture")
+	assert.FileExists(t, filepath.Join(dir, "internal/domain/usecases/users.go"))
+	assert.FileExists(t, filepath.Join(dir, generator.ArchMigrationReportFile))
+
+	out.Reset()
+	require.NoError(t, runMigrateArch(cmd, dir, "clean", "hexagonal", true, "json"))
+	assert.Contains(t, out.String(), `"to": "hexagonal"`)
+
+	err := runMigrateArch(cmd, dir, "", "standard", false, "console")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--from")
+}
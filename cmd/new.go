@@ -1,22 +1,35 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
-	"github.com/charmbracelet/huh/spinner"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/francknouama/go-starter/internal/ascii"
+	"github.com/francknouama/go-starter/internal/availability"
+	"github.com/francknouama/go-starter/internal/blueprint"
 	"github.com/francknouama/go-starter/internal/config"
+	"github.com/francknouama/go-starter/internal/experimental"
 	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/i18n"
+	"github.com/francknouama/go-starter/internal/naming"
+	"github.com/francknouama/go-starter/internal/outputfs"
+	"github.com/francknouama/go-starter/internal/plugin"
 	"github.com/francknouama/go-starter/internal/prompts"
+	"github.com/francknouama/go-starter/internal/prompts/wizard"
+	"github.com/francknouama/go-starter/internal/ui"
 	"github.com/francknouama/go-starter/internal/utils"
 	"github.com/francknouama/go-starter/pkg/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"golang.org/x/term"
 )
 
 var (
@@ -35,12 +48,64 @@ var (
 	basic          bool
 	complexity     string
 	dryRun         bool
+	showFile       string
+	openWeb        bool
+	checkAvailable bool
 	noGit          bool
+	strict         bool
+	jsonProgress   bool
+	keepPartial    bool
+	force          bool
+	noFormat       bool
+	formatters     []string
+	intoExisting   bool
+	branch         string
 	randomName     bool
 	quiet          bool
 	noBanner       bool
 	bannerStyle    string
 	assetPipeline  string
+	broker         string
+	schemaFormat   string
+	saga           string
+	deploymentTool string
+	telemetryURL   string
+	adminEndpoints bool
+	lockoutStore   string
+	readModels     bool
+	refreshStore   string
+	jwtAlgorithm   string
+	platform       string
+	pubsub         string
+	dataPrivacy    bool
+	clientSDK      string
+	e2eTests       bool
+	benchmarks     bool
+	coordination   string
+	leaderElection bool
+	releaseTooling bool
+	team           string
+	di             string
+	idStrategy     string
+	entrypoints    string
+	apiSurfaces    string
+	observability  bool
+	sloSpecs       string
+	runtimeConfig  bool
+	chaos          bool
+	pluginVars     map[string]string
+	ciProvider     string
+	profileName    string
+	interactive    string
+	experiments    []string
+	fromLock       string
+	resumePath     string
+	allowDrift     bool
+
+	blueprintSource   string
+	blueprintChecksum string
+	blueprintRefresh  bool
+	allowHooks        bool
 )
 
 // newCmd represents the new command
@@ -54,9 +119,11 @@ Examples:
   go-starter new my-api                                          # Interactive mode (basic)
   go-starter new my-api --type=web-api --framework=gin           # Direct mode (basic)
   go-starter new my-cli --type=cli --complexity=simple           # Simple CLI project
+  go-starter new my-cli --type=cli --complexity=advanced         # CLI with plugins, layered config and self-update
   
   # Advanced usage (all options)
   go-starter new my-api --advanced                               # Interactive mode (advanced)
+  go-starter new --interactive=tui                               # Full-screen wizard with a live file tree preview
   go-starter new my-api --type=web-api --logger=zap --advanced   # Direct mode (advanced)
   go-starter new my-cli --complexity=standard                    # Standard CLI project
   
@@ -65,6 +132,9 @@ Examples:
   go-starter new my-app --complexity=standard                    # Balanced structure
   go-starter new my-enterprise --complexity=advanced             # Enterprise structure
 
+  # Profiles of ~/.go-starter/config.yaml
+  go-starter new billing --type=web-api --profile=work           # Module prefix, license and flags of the work profile
+
 The command will guide you through the project configuration process
 or use the provided flags for direct project generation.`,
 	Args: cobra.MaximumNArgs(1),
@@ -80,16 +150,50 @@ func init() {
 	// Project configuration flags
 	newCmd.Flags().StringVar(&projectName, "name", "", "Project name")
 	newCmd.Flags().StringVar(&projectModule, "module", "", "Go module path (e.g., github.com/user/project)")
-	newCmd.Flags().StringVar(&projectType, "type", "", "Project type (web-api, cli, library, lambda)")
-	newCmd.Flags().StringVar(&architecture, "architecture", "", "Architecture pattern (standard, clean, ddd, hexagonal)")
+	newCmd.Flags().StringVar(&projectType, "type", "", "Project type (web-api, cli, cli-advanced, library, lambda, grpc-service, event-service, terraform-provider, tui, bot, web-app, realtime, gateway, desktop, workflow)")
+	newCmd.Flags().StringVar(&blueprintSource, "blueprint", "", "Generate from a blueprint in a git repository, host/org/repo//dir@ref (e.g. github.com/org/custom-blueprints//web-api@v1.2.0) cached under ~/.go-starter/cache, or the ID of a blueprint installed with 'blueprint install'")
+	newCmd.Flags().StringVar(&blueprintChecksum, "blueprint-checksum", "", "Expected h1: checksum of the --blueprint directory, generation fails when it differs")
+	newCmd.Flags().BoolVar(&blueprintRefresh, "blueprint-refresh", false, "Clone the --blueprint again even when its ref is cached")
+	newCmd.Flags().BoolVar(&allowHooks, "allow-hooks", false, "Run the hooks of a --blueprint that is not built in, which run commands such as make, go, npm and curl on this machine")
+	newCmd.Flags().StringVar(&architecture, "architecture", "", "Architecture pattern (standard, clean, ddd, hexagonal, vertical-slice)")
 	newCmd.Flags().StringVarP(&goVersion, "go-version", "g", "", "Go version to use (auto, 1.23, 1.22, 1.21)")
 	newCmd.Flags().StringVar(&framework, "framework", "", "Framework to use (gin, echo, cobra, etc.)")
 	newCmd.Flags().StringVar(&logger, "logger", "", "Logger to use (slog, zap, logrus, zerolog)")
-	newCmd.Flags().StringVar(&outputDir, "output", ".", "Output directory")
+	newCmd.Flags().StringVar(&outputDir, "output", ".", "Output directory, or ssh://[user@]host[:port]/path to generate on a remote host")
 	newCmd.Flags().StringVar(&databaseDriver, "database-driver", "", "Database driver (postgres, mysql, sqlite)")
 	newCmd.Flags().StringVar(&databaseORM, "database-orm", "", "Database ORM/query builder (gorm, sqlx)")
 	newCmd.Flags().StringVar(&authType, "auth-type", "", "Authentication type (jwt, oauth2, session)")
 	newCmd.Flags().StringVar(&assetPipeline, "asset-pipeline", "", "Asset build system (embedded, webpack, vite, esbuild)")
+	newCmd.Flags().StringVar(&broker, "broker", "", "Message broker for event-service (kafka, nats)")
+	newCmd.Flags().StringVar(&schemaFormat, "schema-format", "", "Event schemas of event-service kept in a schema registry, with typed serializers (avro, protobuf, json-schema)")
+	newCmd.Flags().StringVar(&saga, "saga", "", "Role of an event-service in a saga, with persisted state, an outbox and compensations (orchestrator, participant)")
+	newCmd.Flags().StringVar(&deploymentTool, "deployment-tool", "", "Deployment tool for lambda (sam, serverless)")
+	newCmd.Flags().StringVar(&telemetryURL, "telemetry-endpoint", "", "Include the opt-in telemetry module, reporting anonymous adoption pings to this URL you control")
+	newCmd.Flags().BoolVar(&adminEndpoints, "admin-endpoints", false, "Generate role-guarded admin user endpoints (web-api, needs --database-driver and --auth-type)")
+	newCmd.Flags().StringVar(&lockoutStore, "lockout-store", "", "Failed login store for account lockout (memory, redis, database)")
+	newCmd.Flags().BoolVar(&readModels, "read-models", false, "Serve queries from CQRS read models kept up to date by projections (web-api hexagonal, needs --database-driver)")
+	newCmd.Flags().StringVar(&refreshStore, "refresh-token-store", "", "Refresh token and revocation store (database, redis, memory)")
+	newCmd.Flags().StringVar(&jwtAlgorithm, "jwt-algorithm", "", "Algorithm of the JWT signing keys published on the JWKS endpoint (RS256, EdDSA)")
+	newCmd.Flags().StringVar(&platform, "platform", "", "Chat platform of the bot blueprint (slack, discord)")
+	newCmd.Flags().StringVar(&pubsub, "pubsub", "", "Message fan-out of the realtime blueprint (memory, redis)")
+	newCmd.Flags().BoolVar(&dataPrivacy, "data-privacy", false, "Generate personal data export and account deletion flows (clean web-api, needs --database-driver and --auth-type)")
+	newCmd.Flags().StringVar(&clientSDK, "client-sdk", "", "Generate typed API clients from the OpenAPI spec into the client submodule (go, go,typescript)")
+	newCmd.Flags().BoolVar(&benchmarks, "benchmarks", false, "Generate benchmarks of the hot paths and a CI job failing on performance regressions (clean web-api, needs a postgres or mysql --database-driver)")
+	newCmd.Flags().StringVar(&coordination, "coordination", "", "Distributed locks shared by the replicas of the clean web-api (redis, postgres; postgres needs --database-driver postgres)")
+	newCmd.Flags().BoolVar(&leaderElection, "leader-election", false, "Run the background jobs of the clean web-api on the replica holding a Kubernetes Lease")
+	newCmd.Flags().BoolVar(&e2eTests, "e2e", false, "Generate an end-to-end suite run through the generated Go client against docker-compose (clean web-api on gin, needs --client-sdk, --database-driver and --auth-type)")
+	newCmd.Flags().BoolVar(&releaseTooling, "release-tooling", false, "Generate Conventional Commits linting, a git-cliff changelog and a CI workflow bumping the version and tagging releases (cli, library)")
+	newCmd.Flags().StringVar(&di, "di", "", "Dependency injection of the container of the clean and hexagonal web-api (manual, wire, fx, do)")
+	newCmd.Flags().StringVar(&idStrategy, "id-strategy", "", "Primary keys of the models of the standard web-api, across migrations, DTOs and URL parsing (serial, uuidv7, ulid, snowflake)")
+	newCmd.Flags().StringVar(&entrypoints, "entrypoints", "", "Binaries of the standard web-api sharing its internal packages, each with its Docker target and Make targets (server, server,cli, server,cli,worker)")
+	newCmd.Flags().StringVar(&apiSurfaces, "api-surfaces", "", "Presentation adapters of the hexagonal web-api driving the same user service (rest, rest,graphql; graphql needs --database-driver)")
+	newCmd.Flags().BoolVar(&observability, "observability", false, "Expose Prometheus request metrics on /metrics with availability and latency SLOs, their burn-rate alerts and runbook stubs (standard web-api)")
+	newCmd.Flags().StringVar(&sloSpecs, "slo-specs", "", "Specifications the SLOs are also written in with --observability (openslo, sloth, openslo,sloth, none)")
+	newCmd.Flags().BoolVar(&runtimeConfig, "runtime-config", false, "Serve /ops/config, authenticated by an ops token, to change the log level and feature flags of the running service with an audit log (standard web-api)")
+	newCmd.Flags().BoolVar(&chaos, "chaos", false, "Generate a development-only middleware injecting latency, errors and connection resets per route or request headers, with a chaos test driver (standard web-api)")
+	newCmd.Flags().StringToStringVar(&pluginVars, "plugin-var", nil, "Answer a prompt of an installed plugin, NAME=VALUE (repeatable)")
+	newCmd.Flags().StringVar(&ciProvider, "ci", "", "CI provider of the project (github, none leaves the CI workflows out)")
+	newCmd.Flags().StringVar(&team, "team", "", "Code owners of the repository (@org/team, @user or emails, comma-separated), generating CODEOWNERS, pull request and issue templates and branch protection settings")
 
 	// Progressive disclosure options
 	newCmd.Flags().BoolVar(&basic, "basic", false, "Show only essential options (default)")
@@ -97,9 +201,26 @@ func init() {
 	newCmd.Flags().StringVar(&complexity, "complexity", "", "Complexity level (simple, standard, advanced, expert)")
 	
 	// Generation options
+	newCmd.Flags().StringVar(&interactive, "interactive", "prompts", "Questions asked for the options not given: prompts, one at a time, or tui, a full-screen wizard previewing the file tree and reviewing the choices before generating")
+	newCmd.Flags().StringVar(&profileName, "profile", "", "Profile of ~/.go-starter/config.yaml predefining the module prefix, license, logger, CI provider and default flags (default: the profile of the working directory, else current_profile)")
 	newCmd.Flags().BoolVar(&dryRun, "dry-run", false, "Preview project structure without creating files")
+	newCmd.Flags().StringVar(&showFile, "show", "", "Print a single rendered file, by path in the project, without creating files (implies --dry-run)")
+	newCmd.Flags().BoolVar(&openWeb, "open-web", false, "Open the web UI pre-filled with the other flags instead of generating (GO_STARTER_WEB_URL sets the UI, default "+defaultWebURL+")")
 	newCmd.Flags().BoolVar(&noGit, "no-git", false, "Skip git repository initialization")
+	newCmd.Flags().BoolVar(&strict, "strict", false, "Fail on template references to undefined variables instead of rendering them empty")
 	newCmd.Flags().BoolVar(&randomName, "random-name", false, "Generate a random project name (GitHub-style)")
+	newCmd.Flags().BoolVar(&jsonProgress, "json-progress", false, "Stream generation progress as JSON lines on stdout instead of the progress bar")
+	newCmd.Flags().BoolVar(&checkAvailable, "check-availability", true, "Warn when the project name collides with a reserved name or the module path already exists on the Go module proxy or GitHub")
+	newCmd.Flags().BoolVar(&force, "force", false, "Generate even when the target is inside a git repository with uncommitted changes")
+	newCmd.Flags().BoolVar(&intoExisting, "into-existing", false, "Generate into the repository already cloned at <output>/<name>, keeping its .git, license, README and .gitignore, and commit the project on a new branch")
+	newCmd.Flags().StringVar(&branch, "branch", generator.DefaultExistingBranch, "Branch the project is committed on with --into-existing")
+	newCmd.Flags().BoolVar(&keepPartial, "keep-partial", false, "Keep the partially generated project when generation fails or is interrupted")
+	newCmd.Flags().BoolVar(&noFormat, "no-format", false, "Leave the generated Go files as rendered instead of formatting them with gofmt and goimports")
+	newCmd.Flags().StringSliceVar(&formatters, "formatter", nil, "Also run these formatters over the generated Go files once written (gofumpt, golines), from the PATH")
+	newCmd.Flags().StringSliceVar(&experiments, "experimental", nil, "Enable experimental blueprint features (e.g. framework.fuego), see 'go-starter experimental'")
+	newCmd.Flags().StringVar(&fromLock, "from-lock", "", "Generate the project a "+generator.LockFile+" records again, with its blueprint and variables; the file or the project directory holding it")
+	newCmd.Flags().StringVar(&resumePath, "resume", "", "Complete the generation interrupted in this project directory, kept with --keep-partial, leaving the files it already wrote as they are")
+	newCmd.Flags().BoolVar(&allowDrift, "allow-drift", false, "Generate from --from-lock even when go-starter, the blueprint version or its templates differ from those of the lock")
 	
 	// Banner control options
 	newCmd.Flags().BoolVarP(&quiet, "quiet", "q", false, "Suppress all output except errors")
@@ -108,6 +229,35 @@ func init() {
 }
 
 func runNew(cmd *cobra.Command, args []string) error {
+	// The profile fills in the flags left unset, before anything reads them
+	selectedProfile, profile, err := applyProfile(cmd)
+	if err != nil {
+		printErrorMessage(i18n.T("error.load_profile"), err)
+		return fmt.Errorf("failed to load profile: %w", err)
+	}
+
+	// The web UI takes over the selection, nothing is generated here
+	if openWeb {
+		return runOpenWeb(cmd, args)
+	}
+
+	// A lock holds the whole configuration, nothing is asked
+	if fromLock != "" {
+		return runNewFromLock(cmd, fromLock)
+	}
+
+	// So does the checkpoint of an interrupted generation
+	if resumePath != "" {
+		return runNewResume(cmd, resumePath)
+	}
+
+	if interactive != "prompts" && interactive != "tui" {
+		return fmt.Errorf("invalid --interactive %q (supported: prompts, tui)", interactive)
+	}
+	if interactive == "tui" && (ui.Plain() || !isTerminal(os.Stdout)) {
+		return fmt.Errorf("--interactive=tui needs a terminal, use --interactive=prompts")
+	}
+
 	// Validate complexity flag if provided
 	if complexity != "" {
 		if _, err := prompts.ParseComplexityLevel(complexity); err != nil {
@@ -118,8 +268,15 @@ func runNew(cmd *cobra.Command, args []string) error {
 	// Determine disclosure mode based on flags
 	disclosureMode := prompts.DetermineDisclosureMode(basic, advanced, complexity)
 	
+	// JSON progress and --show own stdout, so they imply quiet output
+	quietOutput := quiet || jsonProgress || showFile != ""
+
 	// Configure banner display
-	bannerConfig := ascii.GetBannerConfig(quiet, noBanner, bannerStyle)
+	// Plain mode drops the ASCII art, which screen readers cannot make sense of
+	bannerConfig := ascii.GetBannerConfig(quietOutput, noBanner || ui.Plain(), bannerStyle)
+	if ui.NoColor() {
+		bannerConfig.Colors = false
+	}
 	
 	// Show welcome banner for new project generation
 	if !bannerConfig.Quiet && bannerConfig.Enabled {
@@ -141,7 +298,7 @@ func runNew(cmd *cobra.Command, args []string) error {
 			minimalConfig := *bannerConfig
 			minimalConfig.Style = ascii.StyleMinimal
 			fmt.Print(ascii.BannerWithConfig(&minimalConfig))
-			fmt.Println(welcomeStyle.Render("🚀 Generating new Go project..."))
+			fmt.Println(welcomeStyle.Render(i18n.T("new.generating")))
 			fmt.Println()
 		}
 	}
@@ -154,8 +311,92 @@ func runNew(cmd *cobra.Command, args []string) error {
 	// Generate random name if requested and no name provided
 	if randomName && projectName == "" {
 		projectName = utils.GenerateRandomProjectName()
-		if !quiet {
-			fmt.Printf("🎲 Generated random project name: %s\n", projectName)
+		if !quietOutput {
+			fmt.Println(ui.Text(i18n.T("new.random_name", projectName)))
+		}
+	}
+
+	// Normalize names typed on the command line (unicode, spaces, path characters)
+	if projectName != "" {
+		normalized, err := naming.Normalize(projectName)
+		if err != nil {
+			printErrorMessage(i18n.T("error.invalid_project_name"), err)
+			return fmt.Errorf("invalid project name: %w", err)
+		}
+		if normalized != projectName && !quietOutput {
+			fmt.Println(ui.Text(i18n.T("new.name_normalized", normalized, projectName)))
+		}
+		projectName = normalized
+	}
+
+	// A clone generated into knows its module path from its remote
+	if intoExisting && projectModule == "" && projectName != "" {
+		module, err := generator.RepositoryModulePath(filepath.Join(outputDir, projectName))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		} else {
+			projectModule = module
+		}
+	}
+
+	if profile != nil {
+		if !quietOutput {
+			fmt.Println(ui.Text(i18n.T("new.profile", selectedProfile)))
+		}
+		// The module path of the profile stands in for the placeholder module below
+		if projectModule == "" {
+			projectModule = profile.ModulePath(projectName)
+		}
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// Installed plugins add prompts, template functions, post-generation steps and blueprints
+	plugins, err := plugin.List("")
+	if err != nil {
+		return fmt.Errorf("failed to load plugins: %w", err)
+	}
+
+	// A remote blueprint stands in for the built-in blueprints, its type drives the defaults below
+	var remote *blueprint.Remote
+	if blueprintSource != "" {
+		var owner *plugin.Plugin
+		if remote, owner, err = plugin.Blueprint(plugins, blueprintSource); err != nil {
+			return err
+		}
+		if remote != nil && !quietOutput {
+			fmt.Println(ui.Text(i18n.T("new.plugin_blueprint", remote.Template.ID, owner.Name, remote.Checksum)))
+		}
+	}
+	if blueprintSource != "" && remote == nil {
+		// An ID without a repository names a blueprint installed from a registry
+		location, checksum := blueprintSource, blueprintChecksum
+		if installed, ok, err := blueprint.LookupInstalled("", blueprintSource); err == nil && ok {
+			location = installed.Source
+			if checksum == "" {
+				checksum = installed.Checksum
+			}
+		}
+		source, err := blueprint.ParseSource(location)
+		if err == nil {
+			remote, err = blueprint.Fetch(ctx, source, blueprint.FetchOptions{Checksum: checksum, Refresh: blueprintRefresh})
+		}
+		if err == nil && projectType != "" && projectType != remote.Template.Type {
+			err = fmt.Errorf("blueprint %s is a %s blueprint, not %s", source, remote.Template.Type, projectType)
+		}
+		if err != nil {
+			printErrorMessage(i18n.T("error.fetch_blueprint"), err)
+			return fmt.Errorf("failed to fetch blueprint: %w", err)
+		}
+		projectType = remote.Template.Type
+		if architecture == "" {
+			architecture = remote.Template.Architecture
+		}
+		if !quietOutput {
+			fmt.Println(ui.Text(i18n.T("new.remote_blueprint", source, shortCommit(remote.Commit), remote.Checksum)))
 		}
 	}
 
@@ -197,7 +438,7 @@ func runNew(cmd *cobra.Command, args []string) error {
 			switch projectType {
 			case "cli":
 				actualFramework = "cobra"
-			case "web-api", "monolith":
+			case "web-api", "monolith", "workspace":
 				actualFramework = "gin"
 			case "microservice":
 				actualFramework = "gin"
@@ -246,10 +487,29 @@ func runNew(cmd *cobra.Command, args []string) error {
 		},
 	}
 
+	if profile != nil {
+		initialConfig.Author = profile.Author
+		initialConfig.Email = profile.Email
+		initialConfig.License = profile.License
+	}
+
 	// Use new disclosure-aware method if available, fallback to old method
 	var config types.ProjectConfig
-	var err error
-	if disclosurePrompter, ok := prompter.(interface {
+	if interactive == "tui" {
+		config, err = wizard.Run(ctx, initialConfig, func(ctx context.Context, cfg types.ProjectConfig) (string, error) {
+			if err := validateConfig(cfg); err != nil {
+				return "", err
+			}
+			if remote != nil {
+				cfg.Variables["blueprint_id"] = remote.Template.ID
+			}
+			gen, err := newGenerator(remote, plugins)
+			if err != nil {
+				return "", err
+			}
+			return gen.PreviewTree(ctx, cfg)
+		})
+	} else if disclosurePrompter, ok := prompter.(interface {
 		GetProjectConfigWithDisclosure(types.ProjectConfig, prompts.DisclosureMode, prompts.ComplexityLevel) (types.ProjectConfig, error)
 	}); ok {
 		config, err = disclosurePrompter.GetProjectConfigWithDisclosure(initialConfig, disclosureMode, complexityLevel)
@@ -258,60 +518,383 @@ func runNew(cmd *cobra.Command, args []string) error {
 		config, err = prompter.GetProjectConfig(initialConfig, advanced)
 	}
 	if err != nil {
-		printErrorMessage("Failed to get project configuration", err)
+		printErrorMessage(i18n.T("error.get_configuration"), err)
 		return fmt.Errorf("failed to get project configuration: %w", err)
 	}
 
 	// Blueprint type was already adjusted before prompting
 
+	// Only set the broker when given so the blueprint default applies otherwise
+	if broker != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables["Broker"] = broker
+	}
+
+	// Events are plain JSON unless a schema format is chosen
+	if schemaFormat != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.SchemaFormatVariable] = schemaFormat
+	}
+
+	// Event services take no part in a saga unless a role is chosen
+	if saga != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.SagaVariable] = saga
+	}
+
+	// Likewise for the lambda deployment tool
+	if deploymentTool != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables["DeploymentTool"] = deploymentTool
+	}
+
+	// The telemetry module is only generated when asked for with an endpoint
+	if telemetryURL != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.TelemetryVariable] = telemetryURL
+	}
+
+	// Admin endpoints are opt-in, the blueprints leave them out by default
+	if adminEndpoints {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.AdminEndpointsVariable] = "true"
+	}
+
+	// The failed login store defaults to memory in the blueprints
+	if lockoutStore != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.LockoutStoreVariable] = lockoutStore
+	}
+
+	// Read models are opt-in, queries go through the user service by default
+	if readModels {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.ReadModelsVariable] = "true"
+	}
+
+	// The refresh token store defaults to the database in the blueprints
+	if refreshStore != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.RefreshTokenStoreVariable] = refreshStore
+	}
+
+	// The JWT signing algorithm defaults to RS256 in the blueprints
+	if jwtAlgorithm != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.JWTAlgorithmVariable] = jwtAlgorithm
+	}
+
+	// The bot blueprint connects to Slack by default
+	if platform != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.PlatformVariable] = platform
+	}
+
+	// The realtime blueprint runs a single instance in memory by default
+	if pubsub != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.PubSubVariable] = pubsub
+	}
+
+	// Data export and account deletion are opt-in, like the admin endpoints
+	if dataPrivacy {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.DataPrivacyVariable] = "true"
+	}
+
+	// API clients are opt-in, only the projects that publish them carry the client submodule
+	if clientSDK != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.ClientSDKVariable] = clientSDK
+	}
+
+	// Benchmarks are opt-in, their repository benchmarks need Docker
+	if benchmarks {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.BenchmarksVariable] = "true"
+	}
+
+	// The end-to-end suite is opt-in, it needs Docker to run
+	if e2eTests {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.E2EVariable] = "true"
+	}
+
+	// A single replica needs no coordination, locks and leader election are opt-in
+	if coordination != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.CoordinationVariable] = coordination
+	}
+	if leaderElection {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.LeaderElectionVariable] = "true"
+	}
+
+	// Release tooling is opt-in, it changes how the project writes its commit messages
+	if releaseTooling {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.ReleaseToolingVariable] = "true"
+	}
+
+	// Code ownership is only generated for the owners given
+	if team != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.TeamVariable] = team
+	}
+
+	// The blueprints default to their hand-written container
+	if di != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.DIVariable] = di
+	}
+
+	// The blueprints keep their serial keys unless another strategy is chosen
+	if idStrategy != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.IDStrategyVariable] = idStrategy
+	}
+
+	// Metrics and SLOs are opt-in, the SLO specifications default to OpenSLO
+	if observability {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.ObservabilityVariable] = "true"
+	}
+	if sloSpecs != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.SLOSpecsVariable] = sloSpecs
+	}
+
+	// The runtime config endpoint is opt-in, it changes the service while it runs
+	if runtimeConfig {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.RuntimeConfigVariable] = "true"
+	}
+
+	// Fault injection is opt-in, and off in the generated configuration
+	if chaos {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.ChaosVariable] = "true"
+	}
+
+	// The server is the only binary unless the admin CLI or the worker are asked for
+	if entrypoints != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.EntrypointsVariable] = entrypoints
+	}
+
+	// The user service is served over REST unless GraphQL is asked for next to it
+	if apiSurfaces != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.APISurfacesVariable] = apiSurfaces
+	}
+
+	// The blueprints ship GitHub Actions workflows unless another provider is chosen
+	if ciProvider != "" {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[generator.CIVariable] = ciProvider
+	}
+
+	// The prompts pick among the built-in blueprints, the remote one is kept
+	if remote != nil {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables["blueprint_id"] = remote.Template.ID
+	}
+
+	// Experimental features come from the flags and GO_STARTER_EXPERIMENTAL
+	config.Experimental = experimental.Enabled(experiments)
+
 	// Validate the configuration
 	if err := validateConfig(config); err != nil {
-		printErrorMessage("Invalid configuration", err)
+		printErrorMessage(i18n.T("error.invalid_configuration"), err)
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	// The prompts of the plugins are answered by their flags, or asked on a terminal
+	var ask func(*plugin.Plugin, types.TemplateVariable) (string, error)
+	if term.IsTerminal(int(os.Stdin.Fd())) && !jsonProgress {
+		ask = askPluginPrompt
+	}
+	answers, err := plugin.Answers(plugins, pluginVars, ask)
+	if err != nil {
+		printErrorMessage(i18n.T("error.invalid_configuration"), err)
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+	for name, value := range answers {
+		if config.Variables == nil {
+			config.Variables = make(map[string]string)
+		}
+		config.Variables[name] = value
+	}
+
 	// Initialize the generator
-	gen := generator.New()
+	gen, err := newGenerator(remote, plugins)
+	if err != nil {
+		return err
+	}
+
+	// Warn about deprecated blueprints and options before anything is generated
+	if notices, err := gen.Deprecations(config, ""); err == nil && !jsonProgress {
+		printDeprecationWarnings(os.Stderr, notices, time.Now())
+	}
+
+	// Warn about taken project names and module paths, the lookups are best effort
+	if checkAvailable && !jsonProgress {
+		if warnings := availability.NewChecker().Check(ctx, config.Name, config.Module); len(warnings) > 0 {
+			for _, warning := range warnings {
+				fmt.Fprintln(os.Stderr, ui.Text(i18n.T("availability.warning", warning.Message)))
+			}
+			fmt.Fprintln(os.Stderr, i18n.T("availability.hint"))
+		}
+	}
 
-	// Handle dry run mode
-	if dryRun {
-		return gen.Preview(config, outputDir)
+	// Handle dry run mode, --show prints a single rendered file
+	if dryRun || showFile != "" {
+		return gen.PreviewTo(ctx, cmd.OutOrStdout(), config, outputDir, showFile)
 	}
 
+	// Open the output target, connecting to it when it is remote
+	target, err := outputfs.Open(ctx, outputDir)
+	if err != nil {
+		return fmt.Errorf("failed to open output target: %w", err)
+	}
+	defer func() { _ = target.Close() }()
+
 	// Determine output path
-	projectPath := filepath.Join(outputDir, config.Name)
+	projectPath := target.Join(config.Name)
 
 	// Generate the project
 	options := types.GenerationOptions{
-		OutputPath: projectPath,
-		DryRun:     dryRun,
-		NoGit:      noGit,
-		Verbose:    cmd.Flag("verbose").Changed,
+		OutputPath:  projectPath,
+		Output:      target.FS,
+		DryRun:      dryRun,
+		NoGit:       noGit,
+		Verbose:     cmd.Flag("verbose").Changed,
+		Strict:      strict,
+		KeepPartial: keepPartial,
+		Force:       force,
+		NoFormat:    noFormat,
+		Formatters:  formatters,
+		// Only built-in blueprints run hooks without consent
+		DenyHooks: remote != nil && !allowHooks,
+
+		IntoExisting: intoExisting,
+		Branch:       branch,
 	}
 
-	// Generate the project with spinner
-	var result *types.GenerationResult
-	err = spinner.New().
-		Title("🚀 Generating your Go project...").
-		Action(func() {
-			result, err = gen.Generate(config, options)
-		}).
-		Run()
+	// Report progress as JSON for tooling, or as a progress bar for humans
+	switch {
+	case jsonProgress:
+		options.Progress = newJSONProgress(os.Stdout)
+	case !quietOutput:
+		options.Progress = newProgressBar(os.Stderr).Report
+	}
 
+	started := time.Now()
+	result, err := gen.GenerateContext(ctx, config, options)
+	recordTelemetry(cmd, gen.BlueprintID(config), remote == nil, config, result, time.Since(started), err)
 	if err != nil {
-		printErrorMessage("Failed to generate project", err)
+		if errors.Is(err, context.Canceled) {
+			if !jsonProgress {
+				printInterruptedMessage(projectPath, keepPartial)
+			}
+			return fmt.Errorf("project generation interrupted: %w", err)
+		}
+		if !jsonProgress {
+			printErrorMessage(i18n.T("error.generate_project"), err)
+			if keepPartial && !intoExisting && outputfs.IsLocal(target.FS) {
+				fmt.Fprintln(os.Stderr, i18n.T("interrupted.resume_hint", projectPath))
+			}
+		}
 		return fmt.Errorf("failed to generate project: %w", err)
 	}
 
-	// Print success message
-	printSuccessMessage(config, result)
+	// The done event already summarizes the generation in JSON mode
+	if !jsonProgress {
+		printSuccessMessage(config, result)
+	}
 	return nil
 }
 
+// newGenerator returns the generator of the remote blueprint, or of the built-in
+// blueprints, extended with the plugins
+func newGenerator(remote *blueprint.Remote, plugins []*plugin.Plugin) (*generator.Generator, error) {
+	gen := generator.New()
+	if remote != nil {
+		gen = generator.NewWithRegistry(remote.Registry)
+	}
+	if err := plugin.Extend(gen, plugins); err != nil {
+		return nil, err
+	}
+	return gen, nil
+}
+
 func validateConfig(cfg types.ProjectConfig) error {
 	if cfg.Name == "" {
 		return types.NewValidationError("project name is required", nil)
 	}
+	if err := naming.Validate(cfg.Name); err != nil {
+		return err
+	}
 	if cfg.Module == "" {
 		return types.NewValidationError("module path is required", nil)
 	}
@@ -326,6 +909,101 @@ func validateConfig(cfg types.ProjectConfig) error {
 		}
 	}
 
+	// Validate broker if provided
+	if err := config.ValidateBroker(cfg.Variables["Broker"]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate deployment tool if provided
+	if err := config.ValidateDeploymentTool(cfg.Variables["DeploymentTool"]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate lockout store if provided
+	if err := config.ValidateLockoutStore(cfg.Variables[generator.LockoutStoreVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate refresh token store if provided
+	if err := config.ValidateRefreshTokenStore(cfg.Variables[generator.RefreshTokenStoreVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate JWT algorithm if provided
+	if err := config.ValidateJWTAlgorithm(cfg.Variables[generator.JWTAlgorithmVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate chat platform if provided
+	if err := config.ValidatePlatform(cfg.Variables[generator.PlatformVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate realtime fan-out if provided
+	if err := config.ValidatePubSub(cfg.Variables[generator.PubSubVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate event schema format if provided
+	if err := config.ValidateSchemaFormat(cfg.Variables[generator.SchemaFormatVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate saga role if provided
+	if err := config.ValidateSaga(cfg.Variables[generator.SagaVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate distributed locks if provided
+	if err := config.ValidateCoordination(cfg.Variables[generator.CoordinationVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate client SDK languages if provided
+	if err := config.ValidateClientSDK(cfg.Variables[generator.ClientSDKVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate entrypoints if provided
+	if err := config.ValidateEntrypoints(cfg.Variables[generator.EntrypointsVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate API surfaces if provided
+	if err := config.ValidateAPISurfaces(cfg.Variables[generator.APISurfacesVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate dependency injection if provided
+	if err := config.ValidateDI(cfg.Variables[generator.DIVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate ID strategy if provided
+	if err := config.ValidateIDStrategy(cfg.Variables[generator.IDStrategyVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate SLO specifications if provided
+	if err := config.ValidateSLOSpecs(cfg.Variables[generator.SLOSpecsVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate CI provider if provided
+	if err := config.ValidateCI(cfg.Variables[generator.CIVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate code owners if provided
+	if err := config.ValidateTeam(cfg.Variables[generator.TeamVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
+	// Validate telemetry endpoint if provided
+	if err := config.ValidateTelemetryEndpoint(cfg.Variables[generator.TelemetryVariable]); err != nil {
+		return types.NewValidationError(err.Error(), nil)
+	}
+
 	// Validate Go version if provided
 	if cfg.GoVersion != "" {
 		if err := prompts.ValidateGoVersion(cfg.GoVersion); err != nil {
@@ -337,6 +1015,11 @@ func validateConfig(cfg types.ProjectConfig) error {
 }
 
 func printSuccessMessage(config types.ProjectConfig, result *types.GenerationResult) {
+	if ui.Plain() {
+		printPlainSuccessMessage(os.Stdout, config, result)
+		return
+	}
+
 	// Define beautiful styles
 	successStyle := lipgloss.NewStyle().
 		Bold(true).
@@ -371,42 +1054,45 @@ func printSuccessMessage(config types.ProjectConfig, result *types.GenerationRes
 		MarginLeft(2)
 
 	// Print success header
-	fmt.Println(successStyle.Render("🎉 Project Created Successfully!"))
+	fmt.Println(successStyle.Render(i18n.T("summary.title")))
 
 	// Print project details
-	fmt.Println(headerStyle.Render("📋 Project Details"))
-	fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render("Name:") + " " + valueStyle.Render(config.Name))
-	fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render("Type:") + " " + valueStyle.Render(config.Type))
+	fmt.Println(headerStyle.Render(i18n.T("summary.details")))
+	fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render(i18n.T("summary.name")) + " " + valueStyle.Render(config.Name))
+	fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render(i18n.T("summary.type")) + " " + valueStyle.Render(config.Type))
 
 	if config.GoVersion != "" {
-		fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render("Go Version:") + " " + valueStyle.Render(config.GoVersion))
+		fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render(i18n.T("summary.go_version")) + " " + valueStyle.Render(config.GoVersion))
 	}
 	if config.Framework != "" {
-		fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render("Framework:") + " " + valueStyle.Render(config.Framework))
+		fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render(i18n.T("summary.framework")) + " " + valueStyle.Render(config.Framework))
 	}
 	if config.Logger != "" {
-		fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render("Logger:") + " " + valueStyle.Render(config.Logger))
+		fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render(i18n.T("summary.logger")) + " " + valueStyle.Render(config.Logger))
 	}
 
-	fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render("Module:") + " " + valueStyle.Render(config.Module))
-	fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render("Files created:") + " " + valueStyle.Render(fmt.Sprintf("%d", len(result.FilesCreated))))
+	fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render(i18n.T("summary.module")) + " " + valueStyle.Render(config.Module))
+	fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render(i18n.T("summary.files_created")) + " " + valueStyle.Render(fmt.Sprintf("%d", len(result.FilesCreated))))
 
-	if !noGit {
-		fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render("Git repository:") + " " + valueStyle.Render("Initialized"))
+	switch {
+	case result.Branch != "":
+		fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render(i18n.T("summary.git_repository")) + " " + valueStyle.Render(i18n.T("summary.git_committed", result.Branch)))
+	case !noGit && !intoExisting:
+		fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render(i18n.T("summary.git_repository")) + " " + valueStyle.Render(i18n.T("summary.git_initialized")))
 	}
 
-	fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render("Duration:") + " " + valueStyle.Render(result.Duration.String()))
+	fmt.Println(checkStyle.Render("✓") + " " + labelStyle.Render(i18n.T("summary.duration")) + " " + valueStyle.Render(result.Duration.String()))
 
 	// Print next steps
 	fmt.Println()
-	fmt.Println(headerStyle.Render("🚀 Next Steps"))
+	fmt.Println(headerStyle.Render(i18n.T("summary.next_steps")))
 
 	// Check if Go is available and provide appropriate next steps
 	if isGoAvailable() {
 		fmt.Println(commandStyle.Render("cd " + config.Name))
 		fmt.Println(commandStyle.Render("make run"))
 	} else {
-		fmt.Println(labelStyle.Render("# Install Go first, then run:"))
+		fmt.Println(labelStyle.Render(i18n.T("summary.install_go_first")))
 		fmt.Println(commandStyle.Render("cd " + config.Name))
 		fmt.Println(commandStyle.Render("go mod tidy"))
 		fmt.Println(commandStyle.Render("make run"))
@@ -419,7 +1105,50 @@ func printSuccessMessage(config types.ProjectConfig, result *types.GenerationRes
 		Italic(true).
 		MarginLeft(2)
 
-	fmt.Println(tipStyle.Render("💡 Tip: Run 'make help' inside your project to see all available commands"))
+	fmt.Println(tipStyle.Render(i18n.T("summary.tip_make_help")))
+}
+
+// printPlainSuccessMessage prints the generation summary as undecorated lines
+func printPlainSuccessMessage(w io.Writer, config types.ProjectConfig, result *types.GenerationResult) {
+	line := func(label, value string) {
+		_, _ = fmt.Fprintf(w, "%s %s\n", i18n.T(label), value)
+	}
+
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, ui.Text(i18n.T("summary.title")))
+	_, _ = fmt.Fprintln(w, ui.Text(i18n.T("summary.details")))
+	line("summary.name", config.Name)
+	line("summary.type", config.Type)
+	if config.GoVersion != "" {
+		line("summary.go_version", config.GoVersion)
+	}
+	if config.Framework != "" {
+		line("summary.framework", config.Framework)
+	}
+	if config.Logger != "" {
+		line("summary.logger", config.Logger)
+	}
+	line("summary.module", config.Module)
+	line("summary.files_created", fmt.Sprintf("%d", len(result.FilesCreated)))
+	switch {
+	case result.Branch != "":
+		line("summary.git_repository", i18n.T("summary.git_committed", result.Branch))
+	case !noGit && !intoExisting:
+		line("summary.git_repository", i18n.T("summary.git_initialized"))
+	}
+	line("summary.duration", result.Duration.String())
+
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, ui.Text(i18n.T("summary.next_steps")))
+	if !isGoAvailable() {
+		_, _ = fmt.Fprintln(w, i18n.T("summary.install_go_first"))
+		_, _ = fmt.Fprintln(w, "cd "+config.Name)
+		_, _ = fmt.Fprintln(w, "go mod tidy")
+	} else {
+		_, _ = fmt.Fprintln(w, "cd "+config.Name)
+	}
+	_, _ = fmt.Fprintln(w, "make run")
+	_, _ = fmt.Fprintln(w, ui.Text(i18n.T("summary.tip_make_help")))
 }
 
 // progressiveHelpFunc provides progressive disclosure for help output
@@ -588,8 +1317,38 @@ func isGoAvailable() bool {
 	return cmd.Run() == nil
 }
 
+// printInterruptedMessage explains what was left on disk after an interrupted generation
+func printInterruptedMessage(projectPath string, keptPartial bool) {
+	fmt.Fprintln(os.Stderr)
+	if keptPartial {
+		fmt.Fprintln(os.Stderr, ui.Text(i18n.T("interrupted.kept", projectPath)))
+		fmt.Fprintln(os.Stderr, ui.Text(i18n.T("interrupted.kept_state", generator.PartialStateFile)))
+		fmt.Fprintln(os.Stderr, i18n.T("interrupted.resume_hint", projectPath))
+		return
+	}
+	fmt.Fprintln(os.Stderr, ui.Text(i18n.T("interrupted.removed", projectPath)))
+	fmt.Fprintln(os.Stderr, ui.Text(i18n.T("interrupted.keep_hint")))
+}
+
+// shortCommit abbreviates a commit hash the way git log --oneline does
+func shortCommit(commit string) string {
+	if len(commit) > 7 {
+		return commit[:7]
+	}
+	return commit
+}
+
 // printErrorMessage prints a beautiful error message using lipgloss styling
 func printErrorMessage(title string, err error) {
+	if ui.Plain() {
+		fmt.Println()
+		fmt.Println(ui.Text(title))
+		if err != nil {
+			fmt.Println(i18n.T("error.label", err.Error()))
+		}
+		return
+	}
+
 	// Define error styles
 	errorStyle := lipgloss.NewStyle().
 		Bold(true).
@@ -615,7 +1374,7 @@ func printErrorMessage(title string, err error) {
 	fmt.Println()
 	fmt.Println(errorStyle.Render(iconStyle.Render("❌") + " " + titleStyle.Render(title)))
 	if err != nil {
-		fmt.Println(messageStyle.Render("Error: " + err.Error()))
+		fmt.Println(messageStyle.Render(i18n.T("error.label", err.Error())))
 	}
 	fmt.Println()
 }
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/outputfs"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// runNewFromLock generates the project recorded by the lock at path again. The
+// lock must match this go-starter and its blueprints, unless drift is allowed.
+func runNewFromLock(cmd *cobra.Command, path string) error {
+	lock, err := generator.ReadLock(path)
+	if err != nil {
+		return err
+	}
+
+	gen := generator.New()
+	drift, err := gen.LockDrift(lock)
+	if err != nil {
+		return err
+	}
+	if len(drift) > 0 {
+		if !allowDrift {
+			return fmt.Errorf("the project of %s cannot be reproduced, use --allow-drift to generate it anyway: %s", path, strings.Join(drift, "; "))
+		}
+		if !jsonProgress {
+			fmt.Fprintf(os.Stderr, "Warning: generating from %s with %s\n", path, strings.Join(drift, ", "))
+		}
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	config := lock.Config
+	if dryRun || showFile != "" {
+		return gen.PreviewTo(ctx, cmd.OutOrStdout(), config, outputDir, showFile)
+	}
+
+	target, err := outputfs.Open(ctx, outputDir)
+	if err != nil {
+		return fmt.Errorf("failed to open output target: %w", err)
+	}
+	defer func() { _ = target.Close() }()
+
+	projectPath := target.Join(config.Name)
+	options := types.GenerationOptions{
+		OutputPath:  projectPath,
+		Output:      target.FS,
+		NoGit:       noGit,
+		Verbose:     cmd.Flag("verbose").Changed,
+		Strict:      strict,
+		KeepPartial: keepPartial,
+		Force:       force,
+		NoFormat:    noFormat,
+		Formatters:  formatters,
+	}
+	switch {
+	case jsonProgress:
+		options.Progress = newJSONProgress(os.Stdout)
+	case !quiet:
+		options.Progress = newProgressBar(os.Stderr).Report
+	}
+
+	started := time.Now()
+	result, err := gen.GenerateContext(ctx, config, options)
+	recordTelemetry(cmd, gen.BlueprintID(config), true, config, result, time.Since(started), err)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			if !jsonProgress {
+				printInterruptedMessage(projectPath, keepPartial)
+			}
+			return fmt.Errorf("project generation interrupted: %w", err)
+		}
+		return fmt.Errorf("failed to generate project: %w", err)
+	}
+
+	if !jsonProgress && !quiet {
+		printSuccessMessage(config, result)
+	}
+	return nil
+}
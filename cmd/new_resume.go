@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/i18n"
+	"github.com/francknouama/go-starter/internal/ui"
+)
+
+// runNewResume completes the interrupted generation kept in projectPath with
+// --keep-partial, from its checkpoint and with the options it was started with
+func runNewResume(cmd *cobra.Command, projectPath string) error {
+	state, err := generator.ReadPartialState(projectPath)
+	if err != nil {
+		return fmt.Errorf("no interrupted generation to resume in %s, only generations kept with --keep-partial can be resumed: %w", projectPath, err)
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	options := state.GenerationOptions(projectPath)
+	options.Verbose = cmd.Flag("verbose").Changed
+	switch {
+	case jsonProgress:
+		options.Progress = newJSONProgress(os.Stdout)
+	case !quiet:
+		options.Progress = newProgressBar(os.Stderr).Report
+		fmt.Fprintln(os.Stderr, ui.Text(i18n.T("resume.start", projectPath, state.Blueprint, state.Phase)))
+	}
+
+	config := state.Config
+	started := time.Now()
+	result, err := generator.New().GenerateContext(ctx, config, options)
+	recordTelemetry(cmd, state.Blueprint, true, config, result, time.Since(started), err)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			if !jsonProgress {
+				printInterruptedMessage(projectPath, true)
+			}
+			return fmt.Errorf("project generation interrupted: %w", err)
+		}
+		if !jsonProgress {
+			printErrorMessage(i18n.T("error.generate_project"), err)
+			fmt.Fprintln(os.Stderr, i18n.T("interrupted.resume_hint", projectPath))
+		}
+		return fmt.Errorf("failed to generate project: %w", err)
+	}
+
+	if !jsonProgress && !quiet {
+		fmt.Fprintln(os.Stderr, i18n.T("resume.skipped", len(result.Resumed), len(result.FilesCreated)))
+		printSuccessMessage(config, result)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/i18n"
+)
+
+const (
+	// webURLEnv sets the web UI go-starter new --open-web opens, such as a hosted one
+	webURLEnv     = "GO_STARTER_WEB_URL"
+	defaultWebURL = "http://localhost:8080"
+)
+
+// openBrowser opens a link in the default browser, replaced in tests
+var openBrowser = func(link string) error {
+	var command *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		command = exec.Command("open", link)
+	case "windows":
+		command = exec.Command("rundll32", "url.dll,FileProtocolHandler", link)
+	default:
+		command = exec.Command("xdg-open", link)
+	}
+	return command.Start()
+}
+
+// runOpenWeb opens the web UI pre-filled with the flags of new that a spec carries.
+// The link is printed too, to share it or open it by hand.
+func runOpenWeb(cmd *cobra.Command, args []string) error {
+	values := url.Values{}
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		if !generator.IsSpecFlag(flag.Name) {
+			return
+		}
+		value := flag.Value.String()
+		if slice, ok := flag.Value.(pflag.SliceValue); ok {
+			value = strings.Join(slice.GetSlice(), ",")
+		}
+		values.Set(flag.Name, value)
+	})
+	if len(args) > 0 {
+		values.Set("name", args[0])
+	}
+
+	config, err := generator.ParseSpec(values)
+	if err != nil {
+		return fmt.Errorf("invalid selection: %w", err)
+	}
+	base := os.Getenv(webURLEnv)
+	if base == "" {
+		base = defaultWebURL
+	}
+	link, _ := generator.SpecURL(base, config)
+
+	_, _ = fmt.Fprintln(cmd.OutOrStdout(), i18n.T("new.open_web", link))
+	if err := openBrowser(link); err != nil {
+		_, _ = fmt.Fprintln(cmd.ErrOrStderr(), i18n.T("new.open_web_failed", err))
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunOpenWeb(t *testing.T) {
+	original := openBrowser
+	t.Cleanup(func() { openBrowser = original })
+	var opened string
+	openBrowser = func(link string) error {
+		opened = link
+		return nil
+	}
+	t.Setenv(webURLEnv, "https://starter.example.com/")
+
+	var out, errOut bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+	cmd.Flags().String("type", "", "")
+	cmd.Flags().String("architecture", "", "")
+	cmd.Flags().Bool("admin-endpoints", false, "")
+	cmd.Flags().StringSlice("experimental", nil, "")
+	cmd.Flags().Bool("dry-run", false, "")
+	require.NoError(t, cmd.ParseFlags([]string{"--type=web-api", "--architecture", "clean", "--admin-endpoints", "--experimental=a,b", "--dry-run"}))
+
+	require.NoError(t, runOpenWeb(cmd, []string{"shop"}))
+	assert.Equal(t, "https://starter.example.com/?admin-endpoints=true&architecture=clean&experimental=a%2Cb&name=shop&type=web-api", opened)
+	assert.Contains(t, out.String(), opened)
+	assert.Empty(t, errOut.String())
+
+	// Without a browser the link is still there to open by hand
+	openBrowser = func(string) error { return errors.New("no display") }
+	out.Reset()
+	require.NoError(t, runOpenWeb(cmd, nil))
+	assert.Contains(t, out.String(), "https://starter.example.com/?")
+	assert.Contains(t, errOut.String(), "no display")
+}
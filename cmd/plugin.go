@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/francknouama/go-starter/internal/plugin"
+	"github.com/francknouama/go-starter/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+// pluginCmd represents the plugin command
+var pluginCmd = &cobra.Command{
+	Use:   "plugin",
+	Short: "Manage generator plugins",
+	Long: `Extend go-starter without forking it. Plugins are installed in
+~/.go-starter/plugins and add prompts, set with 'new --plugin-var', template
+functions, post-generation steps and blueprints, generated with
+'new --blueprint <id>'.
+
+Available subcommands:
+  list     - List the installed plugins and what they add
+  install  - Install a plugin from a directory or a git repository`,
+}
+
+// pluginListCmd represents the plugin list command
+var pluginListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the installed plugins",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		output, _ := cmd.Flags().GetString("output")
+		return runPluginList(output)
+	},
+}
+
+// pluginInstallCmd represents the plugin install command
+var pluginInstallCmd = &cobra.Command{
+	Use:   "install <dir | host/org/repo//dir@ref>",
+	Short: "Install a plugin",
+	Long: `Copy the plugin of a local directory, or fetch it from a git repository,
+into ~/.go-starter/plugins and run the build command of its plugin.yaml.
+Installing a plugin again replaces it.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runPluginInstall(cmd, args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(pluginCmd)
+	pluginCmd.AddCommand(pluginListCmd)
+	pluginCmd.AddCommand(pluginInstallCmd)
+
+	pluginListCmd.Flags().StringP("output", "o", "console", "Output format (console, json)")
+}
+
+// pluginListing is a plugin as listed by plugin list -o json
+type pluginListing struct {
+	Name         string   `json:"name"`
+	Version      string   `json:"version,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Capabilities []string `json:"capabilities"`
+	Dir          string   `json:"dir"`
+	Source       string   `json:"source,omitempty"`
+}
+
+// runPluginList lists the installed plugins
+func runPluginList(format string) error {
+	plugins, err := plugin.List("")
+	if err != nil {
+		return err
+	}
+	listings := make([]pluginListing, 0, len(plugins))
+	for _, p := range plugins {
+		listing := pluginListing{
+			Name:         p.Name,
+			Version:      p.Version,
+			Description:  p.Description,
+			Capabilities: p.Capabilities(),
+			Dir:          p.Dir,
+		}
+		if installed, err := p.ReadInstalled(); err == nil && installed != nil {
+			listing.Source = installed.Source
+		}
+		listings = append(listings, listing)
+	}
+
+	switch format {
+	case "json":
+		data, err := json.MarshalIndent(listings, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode plugins: %w", err)
+		}
+		fmt.Println(string(data))
+	case "console":
+		if len(listings) == 0 {
+			fmt.Println("No plugins installed")
+			return nil
+		}
+		for _, listing := range listings {
+			fmt.Printf("%s %s (%s)\n", listing.Name, listing.Version, strings.Join(listing.Capabilities, ", "))
+			if listing.Description != "" {
+				fmt.Printf("   %s\n", listing.Description)
+			}
+			if listing.Source != "" {
+				fmt.Printf("   from %s\n", listing.Source)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported output format %q (console, json)", format)
+	}
+	return nil
+}
+
+// runPluginInstall installs the plugin of source
+func runPluginInstall(cmd *cobra.Command, source string) error {
+	p, installed, err := plugin.Install(cmd.Context(), "", source)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✓ Installed plugin %s %s from %s\n", p.Name, p.Version, installed.Source)
+	if installed.Commit != "" {
+		fmt.Printf("  at %s\n", shortCommit(installed.Commit))
+	}
+	if len(p.Prompts) > 0 {
+		names := make([]string, 0, len(p.Prompts))
+		for _, prompt := range p.Prompts {
+			names = append(names, prompt.Name)
+		}
+		fmt.Printf("  prompts: %s, set with --plugin-var\n", strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// askPluginPrompt asks a prompt of a plugin on the terminal
+func askPluginPrompt(p *plugin.Plugin, prompt types.TemplateVariable) (string, error) {
+	message := fmt.Sprintf("%s (plugin %s):", prompt.Description, p.Name)
+	if prompt.Description == "" {
+		message = fmt.Sprintf("%s (plugin %s):", prompt.Name, p.Name)
+	}
+	defaultValue := ""
+	if prompt.Default != nil {
+		defaultValue = fmt.Sprint(prompt.Default)
+	}
+
+	var question survey.Prompt = &survey.Input{Message: message, Default: defaultValue}
+	if len(prompt.Choices) > 0 {
+		selection := &survey.Select{Message: message, Options: prompt.Choices}
+		if defaultValue != "" {
+			selection.Default = defaultValue
+		}
+		question = selection
+	}
+	var answer string
+	if err := survey.AskOne(question, &answer); err != nil {
+		return "", fmt.Errorf("failed to ask %s of plugin %s: %w", prompt.Name, p.Name, err)
+	}
+	return answer, nil
+}
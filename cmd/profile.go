@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/francknouama/go-starter/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// applyProfile selects the profile of ~/.go-starter/config.yaml named by --profile,
+// or by the working directory, and sets its default flags on the flags of the
+// command left unset. Without a configuration file there is no profile, unless
+// --profile asks for one.
+func applyProfile(cmd *cobra.Command) (string, *config.Profile, error) {
+	path, err := config.DefaultPath()
+	if err != nil {
+		return "", nil, err
+	}
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		if profileName != "" {
+			return "", nil, fmt.Errorf("profile '%s' not found, %s does not exist", profileName, path)
+		}
+		return "", nil, nil
+	}
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		return "", nil, err
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", nil, err
+	}
+	name, profile, err := cfg.SelectProfile(profileName, dir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Flags given on the command line win over the profile
+	for flagName, value := range profile.Flags {
+		flag := cmd.Flags().Lookup(flagName)
+		if flag == nil {
+			return "", nil, fmt.Errorf("profile '%s' sets unknown flag --%s", name, flagName)
+		}
+		if flag.Changed {
+			continue
+		}
+		if err := cmd.Flags().Set(flagName, value); err != nil {
+			return "", nil, fmt.Errorf("profile '%s' sets invalid --%s: %w", name, flagName, err)
+		}
+	}
+	if !cmd.Flags().Changed("logger") && profile.Defaults.Logger != "" {
+		logger = profile.Defaults.Logger
+	}
+	if !cmd.Flags().Changed("ci") && profile.CI != "" {
+		ciProvider = profile.CI
+	}
+	return name, profile, nil
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/francknouama/go-starter/internal/i18n"
+	"github.com/francknouama/go-starter/internal/ui"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// progressBarWidth is the number of cells in the terminal progress bar
+const progressBarWidth = 30
+
+// progressRedrawInterval limits how often the progress bar is redrawn
+const progressRedrawInterval = 100 * time.Millisecond
+
+// newJSONProgress writes every progress event as one JSON object per line, the
+// format consumed by the web UI and other tooling
+func newJSONProgress(w io.Writer) types.ProgressFunc {
+	encoder := json.NewEncoder(w)
+	return func(event types.ProgressEvent) {
+		_ = encoder.Encode(event)
+	}
+}
+
+// progressBar renders generation progress for humans. On a terminal it redraws a bar
+// with an ETA in place; elsewhere, and in plain mode, it prints one summary line per
+// finished phase.
+type progressBar struct {
+	w           io.Writer
+	interactive bool
+	lastDraw    time.Time
+}
+
+// newProgressBar creates a progress bar writing to w
+func newProgressBar(w io.Writer) *progressBar {
+	return &progressBar{w: w, interactive: isTerminal(w) && !ui.Plain()}
+}
+
+// Report handles a progress event
+func (p *progressBar) Report(event types.ProgressEvent) {
+	switch event.Type {
+	case types.ProgressPhaseStart:
+		p.lastDraw = time.Time{}
+		p.draw(event)
+	case types.ProgressStep:
+		if event.Current == event.Total || time.Since(p.lastDraw) >= progressRedrawInterval {
+			p.draw(event)
+		}
+	case types.ProgressPhaseEnd:
+		p.clear()
+		if ui.Plain() {
+			_, _ = fmt.Fprintf(p.w, "%s: %s\n", event.Phase, phaseSummary(event))
+			return
+		}
+		_, _ = fmt.Fprintf(p.w, "✓ %-10s %s\n", event.Phase, phaseSummary(event))
+	case types.ProgressError:
+		p.clear()
+	}
+}
+
+func (p *progressBar) draw(event types.ProgressEvent) {
+	if !p.interactive {
+		return
+	}
+	p.lastDraw = time.Now()
+
+	line := fmt.Sprintf("  %-10s %s %d/%d", event.Phase, renderBar(event.Current, event.Total), event.Current, event.Total)
+	if event.ETAMS > 0 {
+		line += fmt.Sprintf("  ETA %s", event.ETA().Round(time.Second))
+	}
+	_, _ = fmt.Fprintf(p.w, "\r\033[K%s", line)
+}
+
+func (p *progressBar) clear() {
+	if p.interactive {
+		_, _ = fmt.Fprint(p.w, "\r\033[K")
+	}
+}
+
+// renderBar draws a bar filled in proportion to current/total
+func renderBar(current, total int) string {
+	filled := progressBarWidth
+	if total > 0 {
+		filled = current * progressBarWidth / total
+	}
+	return "[" + strings.Repeat("=", filled) + strings.Repeat(" ", progressBarWidth-filled) + "]"
+}
+
+// phaseSummary describes a finished phase, e.g. "120 files in 1.2s"
+func phaseSummary(event types.ProgressEvent) string {
+	unit := i18n.T("progress.unit.steps")
+	switch event.Phase {
+	case types.PhaseRender, types.PhaseWrite:
+		unit = i18n.T("progress.unit.files")
+	case types.PhaseTidy:
+		unit = i18n.T("progress.unit.dependencies")
+	case types.PhasePreHooks, types.PhaseHooks:
+		unit = i18n.T("progress.unit.hooks")
+	}
+	return i18n.T("progress.phase_summary", event.Total, unit, formatPhaseDuration(event.Elapsed()))
+}
+
+// formatPhaseDuration rounds a duration for display
+func formatPhaseDuration(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(time.Millisecond).String()
+	}
+	return d.Round(100 * time.Millisecond).String()
+}
+
+// isTerminal reports whether w is an interactive terminal
+func isTerminal(w io.Writer) bool {
+	file, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
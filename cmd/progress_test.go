@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/francknouama/go-starter/internal/ui"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestJSONProgress(t *testing.T) {
+	var buf bytes.Buffer
+	report := newJSONProgress(&buf)
+
+	report(types.ProgressEvent{Type: types.ProgressPhaseStart, Phase: types.PhaseRender, Total: 2})
+	report(types.ProgressEvent{Type: types.ProgressStep, Phase: types.PhaseRender, Current: 1, Total: 2, Item: "main.go.tmpl", ETAMS: 10})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected one JSON line per event, got %d: %q", len(lines), buf.String())
+	}
+
+	var event types.ProgressEvent
+	if err := json.Unmarshal([]byte(lines[1]), &event); err != nil {
+		t.Fatalf("invalid JSON line %q: %v", lines[1], err)
+	}
+	if event.Type != types.ProgressStep || event.Item != "main.go.tmpl" || event.ETAMS != 10 {
+		t.Errorf("unexpected event: %+v", event)
+	}
+}
+
+func TestProgressBar_NonInteractive(t *testing.T) {
+	var buf bytes.Buffer
+	bar := newProgressBar(&buf)
+
+	bar.Report(types.ProgressEvent{Type: types.ProgressPhaseStart, Phase: types.PhaseRender, Total: 3})
+	bar.Report(types.ProgressEvent{Type: types.ProgressStep, Phase: types.PhaseRender, Current: 3, Total: 3})
+	bar.Report(types.ProgressEvent{Type: types.ProgressPhaseEnd, Phase: types.PhaseRender, Current: 3, Total: 3, ElapsedMS: 1500})
+
+	// Without a terminal only the phase summaries are printed
+	if got, want := buf.String(), "✓ render     3 files in 1.5s\n"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestRenderBar(t *testing.T) {
+	if got := renderBar(0, 0); got != "["+strings.Repeat("=", progressBarWidth)+"]" {
+		t.Errorf("empty phase should render as complete, got %q", got)
+	}
+	if got := renderBar(1, 2); strings.Count(got, "=") != progressBarWidth/2 {
+		t.Errorf("expected a half full bar, got %q", got)
+	}
+}
+
+func TestProgressBar_Plain(t *testing.T) {
+	ui.Configure(false, true)
+	t.Cleanup(func() { ui.Configure(false, false) })
+
+	var buf bytes.Buffer
+	bar := newProgressBar(&buf)
+
+	bar.Report(types.ProgressEvent{Type: types.ProgressPhaseStart, Phase: types.PhaseRender, Total: 3})
+	bar.Report(types.ProgressEvent{Type: types.ProgressStep, Phase: types.PhaseRender, Current: 3, Total: 3})
+	bar.Report(types.ProgressEvent{Type: types.ProgressPhaseEnd, Phase: types.PhaseRender, Current: 3, Total: 3, ElapsedMS: 1500})
+
+	if got, want := buf.String(), "render: 3 files in 1.5s\n"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
@@ -5,16 +5,26 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 
 	"github.com/charmbracelet/fang"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/francknouama/go-starter/internal/ascii"
+	"github.com/francknouama/go-starter/internal/i18n"
 	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/internal/ui"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
-var cfgFile string
+var (
+	cfgFile     string
+	lang        string
+	noColor     bool
+	plainOutput bool
+)
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
@@ -74,8 +84,13 @@ func buildLongDescription() string {
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
+	// Cancel running commands on Ctrl-C or SIGTERM; a second signal terminates immediately
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	context.AfterFunc(ctx, stop)
+
 	// Use Fang for enhanced CLI experience with styled output
-	if err := fang.Execute(context.Background(), rootCmd); err != nil {
+	if err := fang.Execute(ctx, rootCmd); err != nil {
 		os.Exit(1)
 	}
 }
@@ -92,11 +107,16 @@ func init() {
 			fmt.Fprintf(os.Stderr, "Error initializing configuration: %v\n", err)
 			os.Exit(1)
 		}
+		initLocale()
+		ui.Configure(noColor, plainOutput)
 	})
 
 	// Global flags
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.go-starter.yaml)")
 	rootCmd.PersistentFlags().Bool("verbose", false, "verbose output")
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "disable colored output (also enabled by NO_COLOR)")
+	rootCmd.PersistentFlags().BoolVar(&plainOutput, "plain", false, "screen-reader friendly output: sequential text prompts, no colors, animations or redrawn lines (also enabled by GO_STARTER_PLAIN)")
+	rootCmd.PersistentFlags().StringVar(&lang, "lang", "", "language for messages (en, fr, es); detected from GO_STARTER_LANG, LC_ALL, LC_MESSAGES or LANG by default")
 
 	// Bind flags to viper
 	if err := viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose")); err != nil {
@@ -106,6 +126,21 @@ func init() {
 	}
 }
 
+// initLocale selects the message language from --lang, the "lang" config key or the environment
+func initLocale() {
+	requested := lang
+	if requested == "" {
+		requested = viper.GetString("lang")
+	}
+	if requested == "" {
+		i18n.SetLocale(i18n.Detect())
+		return
+	}
+	if locale := i18n.SetLocale(requested); locale == i18n.DefaultLocale && !strings.HasPrefix(strings.ToLower(requested), i18n.DefaultLocale) {
+		fmt.Fprintf(os.Stderr, "Warning: no translations for %q, using %s (available: %s)\n", requested, locale, strings.Join(i18n.Available(), ", "))
+	}
+}
+
 // initConfig reads in config file and ENV variables if set.
 func initConfig() error {
 	if cfgFile != "" {
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/francknouama/go-starter/internal/i18n"
+	"github.com/francknouama/go-starter/internal/telemetry"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// telemetryConfig reads the telemetry section of ~/.go-starter.yaml, overridden by
+// the GO_STARTER_TELEMETRY environment variables
+func telemetryConfig() telemetry.Config {
+	var config telemetry.Config
+	_ = viper.UnmarshalKey("telemetry", &config)
+	return config.WithEnv()
+}
+
+// recordTelemetry records a generation of the blueprint and the experimental
+// features it used when the user opted in to telemetry. Recording is best effort
+// and never fails the command.
+func recordTelemetry(cmd *cobra.Command, blueprint string, builtin bool, config types.ProjectConfig, result *types.GenerationResult, duration time.Duration, err error) {
+	settings := telemetryConfig()
+	if !settings.Active() {
+		return
+	}
+
+	var flags []string
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		flags = append(flags, flag.Name)
+	})
+	event := telemetry.NewEvent(cmd.Name(), blueprint, builtin, config, flags, duration, err)
+	if result != nil {
+		event.Experiments = result.Experiments
+	}
+	if err := telemetry.Record(context.Background(), settings, event); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, i18n.T("telemetry.record_failed", err))
+	}
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/telemetry"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestRecordTelemetry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "telemetry.jsonl")
+	t.Setenv("DO_NOT_TRACK", "")
+	t.Setenv(telemetry.EnvVar, "")
+	t.Setenv(telemetry.PathEnvVar, path)
+
+	cmd := &cobra.Command{Use: "new"}
+	cmd.Flags().String("framework", "", "")
+	cmd.Flags().String("module", "", "")
+	cmd.Flags().Bool("no-git", false, "")
+	require.NoError(t, cmd.Flags().Parse([]string{"--framework", "echo", "--module", "github.com/acme/orders"}))
+	config := types.ProjectConfig{Name: "orders", Module: "github.com/acme/orders", Type: "web-api", Framework: "echo"}
+
+	recordTelemetry(cmd, "web-api-standard", true, config, nil, time.Second, nil)
+	_, err := os.Stat(path)
+	assert.ErrorIs(t, err, os.ErrNotExist, "telemetry is opt-in")
+
+	t.Setenv(telemetry.EnvVar, "true")
+	recordTelemetry(cmd, "web-api-standard", true, config, &types.GenerationResult{Experiments: []string{"framework.fuego"}}, time.Second, nil)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "acme", "flag values are never recorded")
+
+	var event telemetry.Event
+	require.NoError(t, json.Unmarshal(data, &event))
+	assert.Equal(t, "new", event.Command)
+	assert.Equal(t, "web-api-standard", event.Blueprint)
+	assert.Equal(t, "echo", event.Framework)
+	assert.Equal(t, []string{"framework", "module"}, event.Flags)
+	assert.True(t, event.Success)
+	assert.Equal(t, []string{"framework.fuego"}, event.Experiments)
+	assert.Equal(t, map[string]int{"framework.fuego": 1}, experimentUsage(), "the experimental command counts the recorded experiments")
+}
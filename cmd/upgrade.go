@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+
+	"github.com/spf13/cobra"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/i18n"
+	"github.com/francknouama/go-starter/internal/ui"
+)
+
+// upgradeCmd represents the upgrade command
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade [project-dir]",
+	Short: "Upgrade a generated project to the current version of its blueprint",
+	Long: `Render the blueprint of a generated project again, with the options recorded in
+its generation manifest, and apply the changes of the new blueprint version:
+
+  - new files are created
+  - files still as generated are updated
+  - go.mod gets the new requirements and raised versions
+  - files edited since generation are kept, and the changes they miss are
+    written next to them as a ` + generator.UpgradeRejectSuffix + ` patch. With --overwrite-conflicts the new
+    version replaces them instead, and the edited file is kept as ` + generator.UpgradeOriginalSuffix + `
+
+Files deleted from the project are not restored, and files the new version no
+longer generates are listed but left in place.`,
+	Example: `  go-starter upgrade --dry-run
+  go-starter upgrade ./my-api --overwrite-conflicts`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectPath := "."
+		if len(args) == 1 {
+			projectPath = args[0]
+		}
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		overwrite, _ := cmd.Flags().GetBool("overwrite-conflicts")
+		output, _ := cmd.Flags().GetString("output")
+		return runUpgrade(cmd, projectPath, dryRun, overwrite, output)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(upgradeCmd)
+
+	upgradeCmd.Flags().Bool("dry-run", false, "Show the changes without writing them")
+	upgradeCmd.Flags().Bool("overwrite-conflicts", false, "Replace edited files with the new version, keeping them as "+generator.UpgradeOriginalSuffix)
+	upgradeCmd.Flags().StringP("output", "o", "console", "Output format (console, json)")
+}
+
+// runUpgrade plans the upgrade of the project at projectPath and applies it
+func runUpgrade(cmd *cobra.Command, projectPath string, dryRun, overwrite bool, format string) error {
+	gen := generator.New()
+	plan, err := gen.PlanUpgrade(cmd.Context(), projectPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("no %s in %s, only projects generated by go-starter with a manifest can be upgraded", generator.ManifestFile, projectPath)
+		}
+		return err
+	}
+
+	w := cmd.OutOrStdout()
+	if format == "json" {
+		data, err := json.MarshalIndent(plan, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode plan: %w", err)
+		}
+		_, _ = fmt.Fprintln(w, string(data))
+	} else {
+		printUpgradePlan(w, plan, overwrite)
+	}
+
+	if plan.Empty() {
+		return nil
+	}
+	if dryRun {
+		if format != "json" {
+			_, _ = fmt.Fprintln(w, i18n.T("add.dry_run"))
+		}
+		return nil
+	}
+	if err := gen.ApplyUpgrade(plan, overwrite); err != nil {
+		return fmt.Errorf("failed to upgrade %s: %w", projectPath, err)
+	}
+	if format == "json" {
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(w, ui.Text(i18n.T("upgrade.done", plan.Blueprint, plan.ToVersion)))
+	if len(plan.Merge) > 0 {
+		_, _ = fmt.Fprintln(w, i18n.T("add.next_tidy"))
+	}
+	if len(plan.Conflicts) > 0 && overwrite {
+		_, _ = fmt.Fprintln(w, i18n.T("upgrade.next_originals", generator.UpgradeOriginalSuffix))
+	} else if len(plan.Conflicts) > 0 {
+		_, _ = fmt.Fprintln(w, i18n.T("upgrade.next_rejects", generator.UpgradeRejectSuffix))
+	}
+	return nil
+}
+
+// printUpgradePlan lists the files an upgrade creates, updates, merges or leaves to
+// merge by hand
+func printUpgradePlan(w io.Writer, plan *generator.UpgradePlan, overwrite bool) {
+	_, _ = fmt.Fprintln(w, i18n.T("upgrade.plan", plan.Project, plan.Blueprint, versionOrUnknown(plan.FromVersion), versionOrUnknown(plan.ToVersion)))
+	if !plan.Tracked {
+		_, _ = fmt.Fprintln(w, ui.Text(i18n.T("upgrade.untracked")))
+	}
+	for _, file := range plan.Create {
+		_, _ = fmt.Fprintln(w, i18n.T("add.create", file))
+	}
+	for _, file := range plan.Update {
+		_, _ = fmt.Fprintln(w, i18n.T("add.update", file))
+	}
+	for _, file := range plan.Merge {
+		_, _ = fmt.Fprintln(w, i18n.T("add.merge", file))
+	}
+	for _, file := range plan.Conflicts {
+		if overwrite {
+			_, _ = fmt.Fprintln(w, ui.Text(i18n.T("upgrade.overwrite", file, file+generator.UpgradeOriginalSuffix)))
+		} else {
+			_, _ = fmt.Fprintln(w, ui.Text(i18n.T("upgrade.conflict", file, file+generator.UpgradeRejectSuffix)))
+		}
+	}
+	for _, file := range plan.Deleted {
+		_, _ = fmt.Fprintln(w, i18n.T("upgrade.deleted", file))
+	}
+	for _, file := range plan.Obsolete {
+		_, _ = fmt.Fprintln(w, i18n.T("upgrade.obsolete", file))
+	}
+	if plan.Empty() {
+		_, _ = fmt.Fprintln(w, ui.Text(i18n.T("upgrade.up_to_date")))
+	}
+}
+
+// versionOrUnknown returns version, or a placeholder for blueprints without one
+func versionOrUnknown(version string) string {
+	if version == "" {
+		return "?"
+	}
+	return version
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/generator"
+)
+
+func TestRunUpgrade(t *testing.T) {
+	setupTestBlueprints(t)
+	dir := writeTestProject(t)
+
+	var out bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	cmd.SetContext(context.Background())
+
+	require.NoError(t, runUpgrade(cmd, dir, false, false, "console"))
+	assert.Contains(t, out.String(), "up to date")
+
+	// An edited file the current blueprint renders differently is a conflict
+	readme := filepath.Join(dir, "README.md")
+	require.NoError(t, os.WriteFile(readme, []byte("# shop\n"), 0644))
+
+	out.Reset()
+	require.NoError(t, runUpgrade(cmd, dir, true, false, "console"))
+	assert.Contains(t, out.String(), "README.md, the changes it misses are written to README.md.rej")
+	assert.Contains(t, out.String(), "Dry run")
+	assert.NoFileExists(t, readme+generator.UpgradeRejectSuffix)
+
+	out.Reset()
+	require.NoError(t, runUpgrade(cmd, dir, false, false, "console"))
+	assert.Contains(t, out.String(), "Upgraded to web-app")
+	assert.FileExists(t, readme+generator.UpgradeRejectSuffix)
+
+	out.Reset()
+	require.NoError(t, runUpgrade(cmd, dir, true, false, "json"))
+	assert.Contains(t, out.String(), `"conflicts": [`)
+
+	err := runUpgrade(cmd, t.TempDir(), false, false, "console")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), generator.ManifestFile)
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/francknouama/go-starter/internal/ascii"
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/telemetry"
 	"github.com/spf13/cobra"
 )
 
@@ -30,6 +32,10 @@ var versionCmd = &cobra.Command{
 
 func init() {
 	rootCmd.AddCommand(versionCmd)
+
+	// Lock files record the version of go-starter that generated the project
+	generator.ToolVersion = Version
+	telemetry.Version = Version
 }
 
 func showVersion() {
@@ -2,27 +2,44 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 
+	"github.com/francknouama/go-starter/internal/generator"
 	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/internal/web/assets"
+	"github.com/francknouama/go-starter/internal/web/auth"
 	"github.com/francknouama/go-starter/internal/web/handlers"
+	"github.com/francknouama/go-starter/internal/web/history"
 	"github.com/francknouama/go-starter/internal/web/middleware"
+	"github.com/francknouama/go-starter/internal/web/storage"
 	"github.com/francknouama/go-starter/internal/web/websocket"
+	"github.com/francknouama/go-starter/web"
 )
 
 func main() {
-	// Initialize the templates filesystem for development
-	// Using os.DirFS to access blueprints from the filesystem
-	templatesFS := os.DirFS("blueprints")
-	templates.SetTemplatesFS(templatesFS)
+	blueprintsDir := flag.String("blueprints", "blueprints", "directory containing the blueprints")
+	watch := flag.Bool("watch", false, "reload blueprints automatically when they change (development)")
+	watchInterval := flag.Duration("watch-interval", time.Second, "how often to check blueprints for changes in watch mode")
+	historyFile := flag.String("history", "", "JSON lines file keeping the generation history of the statistics endpoints across restarts (kept in memory when empty)")
+	embedOrigins := flag.String("embed-origins", "", "comma-separated origins allowed to embed the generator widget served at /embed, * for any (embed mode is off when empty)")
+	webDir := flag.String("web-dir", "web/dist", "directory of the built web UI, served from disk when the binary does not embed it (release builds do)")
+	projectStorage := flag.String("project-storage", "", "where the archives of generated projects are kept: a directory, s3://bucket/prefix or gs://bucket/prefix (kept in memory when empty)")
+	projectDB := flag.String("project-db", "", "database indexing the generated projects: a SQLite file or a postgres:// URL (kept in memory when empty)")
+	projectTTL := flag.Duration("project-ttl", storage.DefaultTTL, "how long a generated project can be downloaded")
+	apiKeys := flag.String("api-keys", "", `file of the API keys accepted by the generation API, one "name key" pair per line`)
+	oidcIssuer := flag.String("oidc-issuer", "", "URL of the OpenID Connect provider whose bearer tokens the generation API accepts")
+	oidcAudience := flag.String("oidc-audience", "", "audience the OIDC tokens must be issued for, the client ID of the web server")
+	flag.Parse()
 
 	// Initialize logger
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
@@ -30,6 +47,82 @@ func main() {
 	}))
 	slog.SetDefault(logger)
 
+	// Initialize the templates filesystem for development
+	// Using os.DirFS to access blueprints from the filesystem
+	templatesFS := os.DirFS(*blueprintsDir)
+	templates.SetTemplatesFS(templatesFS)
+
+	// All handlers share one registry so that reloads are seen everywhere at once
+	registry, err := templates.NewRegistryWithFS(templatesFS)
+	if err != nil {
+		slog.Error("Failed to load blueprints", "dir", *blueprintsDir, "error", err)
+		os.Exit(1)
+	}
+
+	// The parsed blueprint files are shared by generations and previews; a reload
+	// of the blueprints starts the cache over
+	templateCache := generator.NewTemplateCache()
+
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+
+	if *watch {
+		go func() {
+			slog.Info("Watching blueprints for changes", "dir", *blueprintsDir, "interval", watchInterval.String())
+			err := templates.Watch(ctx, registry, *blueprintsDir, *watchInterval, func(count int, err error) {
+				if err != nil {
+					slog.Error("Failed to reload blueprints, keeping previous set", "error", err)
+					return
+				}
+				slog.Info("Blueprints reloaded", "count", count, "version", registry.Version())
+			})
+			if err != nil {
+				slog.Error("Blueprint watcher stopped", "error", err)
+			}
+		}()
+	}
+
+	// The generation history behind the statistics endpoints
+	generations := history.NewStore(history.DefaultLimit)
+	if *historyFile != "" {
+		if generations, err = history.Open(*historyFile, history.DefaultLimit); err != nil {
+			slog.Error("Failed to open generation history", "file", *historyFile, "error", err)
+			os.Exit(1)
+		}
+	}
+	defer generations.Close()
+
+	// The generated projects, downloadable by ID until they expire
+	projects, err := storage.Open(ctx, *projectStorage, *projectDB, *projectTTL)
+	if err != nil {
+		slog.Error("Failed to open project storage", "storage", *projectStorage, "error", err)
+		os.Exit(1)
+	}
+	defer projects.Close()
+	if (*projectStorage == "") != (*projectDB == "") {
+		slog.Warn("Generated projects do not survive restarts unless both -project-storage and -project-db are set")
+	}
+	go projects.Run(ctx, storage.SweepInterval)
+
+	// Callers of the generation API authenticate once the server has API keys or
+	// an OIDC issuer, and only get back the projects they generated
+	authConfig := auth.Config{APIKeysFile: *apiKeys, OIDCIssuer: *oidcIssuer, OIDCAudience: *oidcAudience}
+	var authenticate []gin.HandlerFunc
+	if authConfig.Enabled() {
+		// The widget sends no credentials, its generations would bypass authentication
+		if *embedOrigins != "" {
+			slog.Error("Embed mode cannot be enabled with authentication, unset -embed-origins or -api-keys and -oidc-issuer")
+			os.Exit(1)
+		}
+		authenticator, err := auth.New(ctx, authConfig)
+		if err != nil {
+			slog.Error("Failed to set up authentication", "error", err)
+			os.Exit(1)
+		}
+		authenticate = append(authenticate, middleware.Auth(authenticator))
+		slog.Info("Authentication enabled", "api_keys", *apiKeys != "", "oidc_issuer", *oidcIssuer)
+	}
+
 	// Create Gin router
 	router := gin.New()
 
@@ -37,12 +130,20 @@ func main() {
 	config := cors.Config{
 		AllowOrigins:     []string{"http://localhost:5173"}, // React dev server
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", auth.APIKeyHeader},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}
-	router.Use(cors.New(config))
+	appCORS := cors.New(config)
+
+	// Embed mode answers its own origins, without credentials
+	origins := splitOrigins(*embedOrigins)
+	if len(origins) > 0 {
+		router.Use(middleware.Embed(origins))
+		appCORS = middleware.SkipEmbed(appCORS)
+	}
+	router.Use(appCORS)
 
 	// Middleware
 	router.Use(middleware.Logger())
@@ -50,13 +151,16 @@ func main() {
 	router.Use(middleware.RequestID())
 
 	// Initialize handlers
-	blueprintHandler := handlers.NewBlueprintHandler()
-	generatorHandler := handlers.NewGeneratorHandler()
+	blueprintHandler := handlers.NewBlueprintHandler(registry)
 	healthHandler := handlers.NewHealthHandler()
+	specHandler := handlers.NewSpecHandler()
+	statsHandler := handlers.NewStatsHandler(generations)
 
 	// Initialize WebSocket hub
 	wsHub := websocket.NewHub()
+	wsHub.Handle("preview", handlers.NewPreviewHandler(registry, templateCache))
 	go wsHub.Run()
+	generatorHandler := handlers.NewGeneratorHandler(registry, templateCache, wsHub, generations, projects)
 
 	wsHandler := handlers.NewWebSocketHandler(wsHub)
 
@@ -70,26 +174,69 @@ func main() {
 		// Blueprint endpoints
 		v1.GET("/blueprints", blueprintHandler.ListBlueprints)
 		v1.GET("/blueprints/:id", blueprintHandler.GetBlueprint)
+		v1.GET("/blueprints/:id/options", blueprintHandler.GetBlueprintOptions)
 
-		// Generation endpoints
-		v1.POST("/validate", generatorHandler.ValidateConfig)
-		v1.POST("/generate", generatorHandler.GenerateProject)
-		v1.GET("/download/:id", generatorHandler.DownloadProject)
-		v1.DELETE("/projects/:id", generatorHandler.CleanupProject)
+		// Statistics of what is generated, without who generated it
+		v1.GET("/stats/blueprints", statsHandler.BlueprintStats)
+		v1.GET("/stats/options", statsHandler.OptionStats)
+
+		// Handoff between the web UI and go-starter new
+		v1.GET("/spec", specHandler.Decode)
+		v1.POST("/command", specHandler.Command)
 
 		// WebSocket endpoints
 		v1.GET("/ws/generate", wsHandler.HandleGenerateWS)
 		v1.GET("/ws/preview", wsHandler.HandlePreviewWS)
 	}
 
-	// Serve static files (for development, serve from filesystem)
-	// TODO: In production, this should use embedded files
-	router.Static("/static", "web/dist")
-	router.Static("/assets", "web/dist/assets")
-	
-	// Serve index.html for SPA routing
+	// Endpoints that generate or hand out projects, authenticated when enabled
+	api := v1.Group("", authenticate...)
+	{
+		// Admin endpoints
+		api.POST("/admin/blueprints/reload", blueprintHandler.ReloadBlueprints)
+
+		// Generation endpoints
+		api.POST("/validate", generatorHandler.ValidateConfig)
+		api.POST("/generate", generatorHandler.GenerateProject)
+		api.GET("/download/:id", generatorHandler.DownloadProject)
+		api.GET("/projects/:id", generatorHandler.GetProject)
+		api.DELETE("/projects/:id", generatorHandler.CleanupProject)
+		if authConfig.Enabled() {
+			api.GET("/projects", generatorHandler.ListProjects)
+		}
+	}
+
+	// Embeddable widget and the JSON API it uses
+	if len(origins) > 0 {
+		embedHandler := handlers.NewEmbedHandler(origins)
+		router.GET(middleware.EmbedPrefix, embedHandler.Widget)
+
+		embed := router.Group(middleware.EmbedPrefix + "/v1")
+		{
+			embed.GET("/blueprints", blueprintHandler.ListBlueprints)
+			embed.GET("/blueprints/:id/options", blueprintHandler.GetBlueprintOptions)
+			embed.POST("/validate", generatorHandler.ValidateConfig)
+			embed.POST("/generate", generatorHandler.GenerateProject)
+			embed.GET("/download/:id", generatorHandler.DownloadProject)
+		}
+		slog.Info("Embed mode enabled", "origins", origins)
+	}
+
+	// Serve the web UI, embedded in release builds, and index.html for its routes
+	ui, err := webUI(*webDir)
+	if err != nil {
+		slog.Error("Failed to load the embedded web UI", "error", err)
+		os.Exit(1)
+	}
 	router.NoRoute(func(c *gin.Context) {
-		c.File("web/dist/index.html")
+		if strings.HasPrefix(c.Request.URL.Path, "/api/") {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": "Endpoint not found",
+				"code":  "NOT_FOUND",
+			})
+			return
+		}
+		ui.ServeHTTP(c.Writer, c.Request)
 	})
 
 	// Create HTTP server
@@ -113,15 +260,38 @@ func main() {
 	<-quit
 
 	slog.Info("Shutting down server...")
+	stop()
 
 	// Graceful shutdown with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	if err := server.Shutdown(ctx); err != nil {
+	if err := server.Shutdown(shutdownCtx); err != nil {
 		slog.Error("Server forced to shutdown", "error", err)
 		os.Exit(1)
 	}
 
 	slog.Info("Server stopped")
-}
\ No newline at end of file
+}
+
+// webUI returns the handler of the web UI embedded in the binary, or of the UI
+// built into dir during development
+func webUI(dir string) (*assets.Handler, error) {
+	if web.Dist != nil {
+		slog.Info("Serving the embedded web UI")
+		return assets.New(web.Dist)
+	}
+	slog.Info("Serving the web UI from disk", "dir", dir)
+	return assets.NewDir(os.DirFS(dir)), nil
+}
+
+// splitOrigins parses the comma-separated -embed-origins flag
+func splitOrigins(value string) []string {
+	var origins []string
+	for _, origin := range strings.Split(value, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
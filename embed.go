@@ -12,3 +12,6 @@ import (
 //
 //go:embed all:blueprints
 var TemplatesFS embed.FS
+
+// The index lets commands load only the blueprint they use
+//go:generate go run . blueprint index blueprints
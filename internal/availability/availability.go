@@ -0,0 +1,166 @@
+// Package availability warns about project names and module paths that are
+// already taken: names colliding with the standard library or the go command,
+// and module paths already published on the Go module proxy or GitHub.
+package availability
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/francknouama/go-starter/internal/deps"
+	"github.com/francknouama/go-starter/internal/naming"
+)
+
+// DefaultGitHubURL is the GitHub API queried for existing repositories
+const DefaultGitHubURL = "https://api.github.com"
+
+// DefaultTimeout bounds the remote lookups of a check, availability is advisory
+// and must not hold up generation
+const DefaultTimeout = 3 * time.Second
+
+// Warning is an availability problem of a project name or module path
+type Warning struct {
+	// Field is "project_name" or "module_url"
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Checker looks up whether project names and module paths are taken. Lookups that
+// fail, because the network is down or a rate limit is hit, are not reported.
+type Checker struct {
+	// Proxy looks up module paths, nil skips the module proxy
+	Proxy *deps.ProxyClient
+	// GitHubURL is the GitHub API base URL, empty skips GitHub
+	GitHubURL  string
+	HTTPClient *http.Client
+	// Timeout bounds the lookups of a check, zero leaves them to the context
+	Timeout time.Duration
+
+	repos map[string]bool
+	mutex sync.Mutex
+}
+
+// NewChecker creates a checker querying the first proxy of GOPROXY and GitHub.
+// The module proxy is skipped when GOPROXY is off or direct.
+func NewChecker() *Checker {
+	checker := &Checker{
+		GitHubURL:  DefaultGitHubURL,
+		HTTPClient: &http.Client{Timeout: DefaultTimeout},
+		Timeout:    DefaultTimeout,
+	}
+	if proxy := deps.GoProxy(os.Getenv("GOPROXY")); proxy != "" {
+		checker.Proxy = deps.NewProxyClient(proxy)
+	}
+	return checker
+}
+
+// Check returns the availability warnings of a project name and module path. Empty
+// values are not checked.
+func (c *Checker) Check(ctx context.Context, name, module string) []Warning {
+	var warnings []Warning
+	if name != "" {
+		if collision := naming.Collision(name); collision != "" {
+			warnings = append(warnings, Warning{
+				Field:   "project_name",
+				Message: fmt.Sprintf("Project name %q collides with a reserved name: %s", name, collision),
+			})
+		}
+	}
+	if module == "" {
+		return warnings
+	}
+
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	if c.Proxy != nil {
+		latest, err := c.Proxy.Latest(ctx, module)
+		if err == nil {
+			return append(warnings, Warning{
+				Field:   "module_url",
+				Message: fmt.Sprintf("Module %s already exists on the Go module proxy (latest %s), pick another path unless you are regenerating it", module, latest),
+			})
+		}
+	}
+
+	if repo, ok := gitHubRepository(module); ok && c.GitHubURL != "" {
+		if exists, err := c.repositoryExists(ctx, repo); err == nil && exists {
+			warnings = append(warnings, Warning{
+				Field:   "module_url",
+				Message: fmt.Sprintf("Repository github.com/%s already exists on GitHub", repo),
+			})
+		}
+	}
+	return warnings
+}
+
+// gitHubRepository returns the owner/name of the GitHub repository of a module path
+func gitHubRepository(module string) (string, bool) {
+	parts := strings.Split(module, "/")
+	if len(parts) < 3 || !strings.EqualFold(parts[0], "github.com") || parts[1] == "" || parts[2] == "" {
+		return "", false
+	}
+	return parts[1] + "/" + parts[2], true
+}
+
+// repositoryExists reports whether the GitHub repository owner/name exists
+func (c *Checker) repositoryExists(ctx context.Context, repo string) (bool, error) {
+	c.mutex.Lock()
+	exists, ok := c.repos[repo]
+	c.mutex.Unlock()
+	if ok {
+		return exists, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(c.GitHubURL, "/")+"/repos/"+repo, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// Renamed repositories answer with their new name, which is not taken by this path
+		var info struct {
+			FullName string `json:"full_name"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+			return false, err
+		}
+		exists = strings.EqualFold(info.FullName, repo)
+	case http.StatusNotFound:
+		exists = false
+	default:
+		return false, fmt.Errorf("GitHub lookup of %s returned %s", repo, resp.Status)
+	}
+
+	c.mutex.Lock()
+	if c.repos == nil {
+		c.repos = make(map[string]bool)
+	}
+	c.repos[repo] = exists
+	c.mutex.Unlock()
+	return exists, nil
+}
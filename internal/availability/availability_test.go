@@ -0,0 +1,62 @@
+package availability
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/deps"
+)
+
+func TestChecker_Check(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/proxy/github.com/spf13/cobra/@latest":
+			_, _ = w.Write([]byte(`{"Version":"v1.9.1"}`))
+		case "/github/repos/acme/taken":
+			_, _ = w.Write([]byte(`{"full_name":"acme/taken"}`))
+		case "/github/repos/acme/renamed":
+			_, _ = w.Write([]byte(`{"full_name":"acme/new-name"}`))
+		case "/github/repos/acme/limited":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	checker := &Checker{Proxy: deps.NewProxyClient(server.URL + "/proxy"), GitHubURL: server.URL + "/github"}
+
+	tests := map[string]struct {
+		name, module string
+		want         []string
+	}{
+		"free":               {"orders", "github.com/acme/orders", nil},
+		"published module":   {"cobra", "github.com/spf13/cobra", []string{"module_url: Module github.com/spf13/cobra already exists on the Go module proxy (latest v1.9.1)"}},
+		"existing repo":      {"taken", "github.com/acme/taken/v2", []string{"module_url: Repository github.com/acme/taken already exists on GitHub"}},
+		"renamed repo":       {"renamed", "github.com/acme/renamed", nil},
+		"rate limited":       {"limited", "github.com/acme/limited", nil},
+		"not on GitHub":      {"svc", "gitlab.com/acme/svc", nil},
+		"stdlib name":        {"http", "", []string{`project_name: Project name "http" collides with a reserved name: package http shadows`}},
+		"go command pattern": {"std", "", []string{`project_name: Project name "std" collides with a reserved name: "std" is a package pattern`}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			warnings := checker.Check(context.Background(), tt.name, tt.module)
+			require.Len(t, warnings, len(tt.want))
+			for i, want := range tt.want {
+				assert.Contains(t, warnings[i].Field+": "+warnings[i].Message, want)
+			}
+		})
+	}
+
+	before := requests
+	checker.Check(context.Background(), "", "github.com/acme/taken")
+	assert.Equal(t, before+1, requests, "GitHub lookups are cached, the proxy is asked again")
+}
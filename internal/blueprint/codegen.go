@@ -0,0 +1,61 @@
+package blueprint
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/francknouama/go-starter/internal/generator"
+)
+
+// generateDirective matches the command of a go:generate directive
+var generateDirective = regexp.MustCompile(`(?m)^//go:generate[ \t]+(.+)$`)
+
+// latestTool matches a go run of a tool at its latest version
+var latestTool = regexp.MustCompile(`\bgo[ \t]+run[ \t]+(?:-\S+[ \t]+)*(\S+@latest)\b`)
+
+// generateTarget matches a Makefile generate target whose recipe runs go generate
+var generateTarget = regexp.MustCompile(`(?m)^generate:.*\n(?:\t.*\n)*?\t.*(?:go|\$\(GO\)) generate\b`)
+
+// checkGenerate reports, in projects with go:generate directives, the directives
+// running a tool at its latest version, whose output then changes with the
+// releases of the tool, a Makefile without a generate target running go
+// generate, and workflows that never run make generate-check, which lets the
+// committed code drift from the sources it is generated from
+func (l *linter) checkGenerate(c Case, files map[string]generator.GeneratedFile, paths []string) {
+	var generated []string
+	var workflows, checked bool
+	for _, path := range paths {
+		file := files[path]
+		if file.Binary || file.Symlink != "" {
+			continue
+		}
+		if strings.HasPrefix(path, ".github/workflows/") {
+			workflows = true
+			checked = checked || bytes.Contains(file.Content, []byte("make generate-check"))
+			continue
+		}
+		if !strings.HasSuffix(path, ".go") {
+			continue
+		}
+		for _, directive := range generateDirective.FindAllSubmatch(file.Content, -1) {
+			if len(generated) == 0 || generated[len(generated)-1] != path {
+				generated = append(generated, path)
+			}
+			if tool := latestTool.FindSubmatch(directive[1]); tool != nil && !l.seen(CheckGenerate, "latest "+path) {
+				l.add(SeverityWarning, CheckGenerate, fmt.Sprintf("case %s: %s runs %s in a go:generate directive, pin a version for the generated code to be reproducible", c.Name, path, tool[1]))
+			}
+		}
+	}
+	if len(generated) == 0 {
+		return
+	}
+
+	if makefile, ok := files["Makefile"]; ok && !generateTarget.Match(makefile.Content) && !l.seen(CheckGenerate, "makefile") {
+		l.add(SeverityWarning, CheckGenerate, fmt.Sprintf("case %s: the Makefile has no generate target running go generate, for the go:generate directives of %s", c.Name, strings.Join(generated, ", ")))
+	}
+	if workflows && !checked && !l.seen(CheckGenerate, "workflows") {
+		l.add(SeverityWarning, CheckGenerate, fmt.Sprintf("case %s: no workflow runs make generate-check, so the code generated by %s can drift from its sources", c.Name, strings.Join(generated, ", ")))
+	}
+}
@@ -0,0 +1,323 @@
+package blueprint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/francknouama/go-starter/internal/generator"
+)
+
+// dependencyField matches the fields holding the services, repositories and
+// ports a handler or a service calls on the way to the database
+var dependencyField = regexp.MustCompile(`(?i)(service|svc|repo|repository|store|port|usecase)s?$`)
+
+// requestTypes are the parameter types carrying the context of a request
+var requestTypes = map[string]bool{
+	"context.Context": true,
+	"*http.Request":   true,
+	"*gin.Context":    true,
+	"echo.Context":    true,
+	"*fiber.Ctx":      true,
+}
+
+// contextCalls are the methods returning the context of a request
+var contextCalls = map[string]bool{
+	"Context":     true,
+	"UserContext": true,
+}
+
+// checkContext reports, in the rendered Go files, the repository methods that do
+// not take a context.Context, so the queries they run on behalf of a request
+// cannot be cancelled with it, and the functions that receive the context of a
+// request and drop it: calling context.Background() or context.TODO(), which
+// outlive the request and lose its deadline and values, or calling a service,
+// repository or port method declared with a context without passing one on.
+// Function literals without a context of their own, such as goroutines outliving
+// the request, are left out.
+func (l *linter) checkContext(c Case, files map[string]generator.GeneratedFile, paths []string) {
+	fset := token.NewFileSet()
+	parsed := make(map[string]*ast.File)
+	for _, path := range paths {
+		file := files[path]
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") || file.Symlink != "" {
+			continue
+		}
+		// The files that do not parse are reported by the render check
+		if f, err := parser.ParseFile(fset, path, file.Content, parser.SkipObjectResolution); err == nil {
+			parsed[path] = f
+		}
+	}
+	methods := contextMethods(parsed)
+
+	for _, path := range paths {
+		f, ok := parsed[path]
+		if !ok {
+			continue
+		}
+		for _, method := range contextlessRepositoryMethods(f) {
+			key := fmt.Sprintf("%s:%d", path, fset.Position(method.pos).Line)
+			if !l.seen(CheckContext, key) {
+				l.add(SeverityError, CheckContext, fmt.Sprintf("case %s: %s: %s does not take a context.Context first, its queries outlive the requests they run for", c.Name, key, method.name))
+			}
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			var typ *ast.FuncType
+			var body *ast.BlockStmt
+			var name string
+			switch fn := n.(type) {
+			case *ast.FuncDecl:
+				typ, body, name = fn.Type, fn.Body, fn.Name.Name
+			case *ast.FuncLit:
+				typ, body, name = fn.Type, fn.Body, "function literal"
+			default:
+				return true
+			}
+			if body == nil {
+				return false
+			}
+			request := requestParams(typ)
+			if len(request) == 0 {
+				return true
+			}
+			for _, problem := range droppedContexts(body, request, methods) {
+				position := fset.Position(problem.pos)
+				key := fmt.Sprintf("%s:%d", path, position.Line)
+				if !l.seen(CheckContext, key) {
+					l.add(problem.severity, CheckContext, fmt.Sprintf("case %s: %s: %s %s", c.Name, key, name, problem.message))
+				}
+			}
+			return true
+		})
+	}
+}
+
+// contextProblem is a context dropped by a function
+type contextProblem struct {
+	pos      token.Pos
+	severity string
+	message  string
+}
+
+// repositoryMethod is an exported method of a repository
+type repositoryMethod struct {
+	name string
+	pos  token.Pos
+}
+
+// contextlessRepositoryMethods lists the exported methods of the repository
+// interfaces and types of the file, named after Repository, taking parameters
+// but not a context.Context first. Accessors and Close take no parameter.
+func contextlessRepositoryMethods(f *ast.File) []repositoryMethod {
+	var methods []repositoryMethod
+	takesContext := func(typ *ast.FuncType) bool {
+		return len(typ.Params.List) == 0 || exprString(typ.Params.List[0].Type) == "context.Context"
+	}
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				spec, ok := spec.(*ast.TypeSpec)
+				if !ok || !isRepository(spec.Name.Name) {
+					continue
+				}
+				iface, ok := spec.Type.(*ast.InterfaceType)
+				if !ok {
+					continue
+				}
+				for _, method := range iface.Methods.List {
+					typ, ok := method.Type.(*ast.FuncType)
+					if !ok || takesContext(typ) {
+						continue
+					}
+					for _, name := range method.Names {
+						if name.IsExported() {
+							methods = append(methods, repositoryMethod{name: spec.Name.Name + "." + name.Name, pos: name.Pos()})
+						}
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Recv == nil || len(decl.Recv.List) == 0 || !decl.Name.IsExported() || takesContext(decl.Type) {
+				continue
+			}
+			receiver := strings.TrimPrefix(exprString(decl.Recv.List[0].Type), "*")
+			if isRepository(receiver) {
+				methods = append(methods, repositoryMethod{name: receiver + "." + decl.Name.Name, pos: decl.Name.Pos()})
+			}
+		}
+	}
+	return methods
+}
+
+// isRepository reports whether the type name is the name of a repository
+func isRepository(name string) bool {
+	return strings.HasSuffix(name, "Repository") || strings.HasSuffix(name, "repository")
+}
+
+// requestParams are the names of the parameters of typ carrying a request context
+func requestParams(typ *ast.FuncType) []string {
+	var names []string
+	for _, field := range typ.Params.List {
+		if !requestTypes[exprString(field.Type)] {
+			continue
+		}
+		for _, name := range field.Names {
+			if name.Name != "_" {
+				names = append(names, name.Name)
+			}
+		}
+	}
+	return names
+}
+
+// contextMethods are the names of the methods whose every declaration, in an
+// interface or on a type, takes a context.Context first
+func contextMethods(files map[string]*ast.File) map[string]bool {
+	methods := make(map[string]bool)
+	declare := func(name string, typ *ast.FuncType) {
+		first := len(typ.Params.List) > 0 && exprString(typ.Params.List[0].Type) == "context.Context"
+		if takes, ok := methods[name]; ok {
+			first = first && takes
+		}
+		methods[name] = first
+	}
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch decl := n.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv != nil {
+					declare(decl.Name.Name, decl.Type)
+				}
+			case *ast.InterfaceType:
+				for _, method := range decl.Methods.List {
+					if typ, ok := method.Type.(*ast.FuncType); ok {
+						for _, name := range method.Names {
+							declare(name.Name, typ)
+						}
+					}
+				}
+			}
+			return true
+		})
+	}
+	return methods
+}
+
+// droppedContexts walks the body of a function receiving the request params,
+// without entering the function literals, which are checked on their own
+func droppedContexts(body *ast.BlockStmt, request []string, methods map[string]bool) []contextProblem {
+	// Contexts are the context.Context params and the variables derived from a request
+	contexts := make(map[string]bool)
+	for _, name := range request {
+		contexts[name] = true
+	}
+	ast.Inspect(body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		if assign, ok := n.(*ast.AssignStmt); ok && len(assign.Rhs) > 0 && isContext(assign.Rhs[0], contexts) {
+			if ident, ok := assign.Lhs[0].(*ast.Ident); ok {
+				contexts[ident.Name] = true
+			}
+		}
+		return true
+	})
+
+	var problems []contextProblem
+	ast.Inspect(body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		callee := exprString(call.Fun)
+		switch {
+		case callee == "context.Background" || callee == "context.TODO":
+			problems = append(problems, contextProblem{
+				pos:      call.Pos(),
+				severity: SeverityError,
+				message:  fmt.Sprintf("receives the request context in %s but calls %s(), pass it on instead", strings.Join(request, ", "), callee),
+			})
+		case isDependencyCall(call, methods) && !passesContext(call, contexts) && !passesBackground(call):
+			problems = append(problems, contextProblem{
+				pos:      call.Pos(),
+				severity: SeverityWarning,
+				message:  fmt.Sprintf("calls %s without the request context", callee),
+			})
+		}
+		return true
+	})
+	return problems
+}
+
+// isDependencyCall reports whether the call is to a method taking a context of
+// a service, repository or port field, as in h.userService.Create(ctx, ...)
+func isDependencyCall(call *ast.CallExpr, methods map[string]bool) bool {
+	method, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || !methods[method.Sel.Name] {
+		return false
+	}
+	field, ok := method.X.(*ast.SelectorExpr)
+	return ok && dependencyField.MatchString(field.Sel.Name)
+}
+
+// passesContext reports whether an argument of the call is a context
+func passesContext(call *ast.CallExpr, contexts map[string]bool) bool {
+	for _, arg := range call.Args {
+		if isContext(arg, contexts) {
+			return true
+		}
+	}
+	return false
+}
+
+// passesBackground reports whether an argument of the call is a call to
+// context.Background() or context.TODO(), reported on its own
+func passesBackground(call *ast.CallExpr) bool {
+	for _, arg := range call.Args {
+		if inner, ok := arg.(*ast.CallExpr); ok {
+			if callee := exprString(inner.Fun); callee == "context.Background" || callee == "context.TODO" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isContext reports whether expr is one of the contexts, the context of a
+// request, or a context derived from them with context.WithX
+func isContext(expr ast.Expr, contexts map[string]bool) bool {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return contexts[e.Name]
+	case *ast.CallExpr:
+		if fn, ok := e.Fun.(*ast.SelectorExpr); ok {
+			if contextCalls[fn.Sel.Name] && len(e.Args) == 0 {
+				return true
+			}
+			if pkg, ok := fn.X.(*ast.Ident); ok && pkg.Name == "context" && strings.HasPrefix(fn.Sel.Name, "With") && len(e.Args) > 0 {
+				return isContext(e.Args[0], contexts)
+			}
+		}
+	}
+	return false
+}
+
+// exprString prints the identifiers, selectors and pointers of a type or callee
+func exprString(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return exprString(e.X) + "." + e.Sel.Name
+	case *ast.StarExpr:
+		return "*" + exprString(e.X)
+	}
+	return ""
+}
@@ -0,0 +1,197 @@
+package blueprint
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/internal/utils"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// CasesFile holds the sample variables of a blueprint, relative to its directory
+const CasesFile = "testdata/cases.yaml"
+
+// Case is a set of sample variables the blueprint is rendered with, and the files
+// the rendering must and must not produce
+type Case struct {
+	Name      string            `yaml:"name"`
+	Project   string            `yaml:"project"`
+	Module    string            `yaml:"module"`
+	Framework string            `yaml:"framework"`
+	Logger    string            `yaml:"logger"`
+	GoVersion string            `yaml:"go_version"`
+	Variables map[string]string `yaml:"variables"`
+	Expect    []string          `yaml:"expect"`
+	Absent    []string          `yaml:"absent"`
+}
+
+// CaseResult is the outcome of rendering one case
+type CaseResult struct {
+	Case  Case
+	Files []string
+	// Problems lists the expectations the rendered files do not meet
+	Problems []string
+	// Err is set when the case fails to render or, with build, to compile or pass its tests
+	Err error
+}
+
+// Passed reports whether the case rendered as expected
+func (r CaseResult) Passed() bool {
+	return r.Err == nil && len(r.Problems) == 0
+}
+
+// LoadCases reads the sample variables of the blueprint in dir
+func LoadCases(dir string) ([]Case, error) {
+	data, err := os.ReadFile(filepath.Join(dir, CasesFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sample variables: %w", err)
+	}
+
+	var file struct {
+		Cases []Case `yaml:"cases"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", CasesFile, err)
+	}
+	if len(file.Cases) == 0 {
+		return nil, types.NewValidationError(fmt.Sprintf("%s declares no cases", CasesFile), nil)
+	}
+	for i, c := range file.Cases {
+		if c.Project == "" || c.Module == "" {
+			return nil, types.NewValidationError(fmt.Sprintf("case %d of %s needs a project and a module", i+1, CasesFile), nil)
+		}
+	}
+	return file.Cases, nil
+}
+
+// Test renders the blueprint in dir once per case of its sample variables. The
+// blueprint is loaded together with its siblings, so it may use shared sources
+// such as ../shared. With build, every case is also generated to disk, built
+// and tested with the go command.
+func Test(ctx context.Context, dir string, build bool) ([]CaseResult, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	cases, err := LoadCases(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := templates.NewRegistryWithFS(os.DirFS(filepath.Dir(dir)))
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := findTemplate(registry, filepath.Base(dir))
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]CaseResult, 0, len(cases))
+	for _, c := range cases {
+		result := CaseResult{Case: c}
+		config := caseConfig(c, tmpl)
+
+		files, err := generator.NewWithRegistry(registry).GenerateInMemoryFiles(ctx, &config, tmpl.ID)
+		if err != nil {
+			result.Err = err
+			results = append(results, result)
+			continue
+		}
+		for path := range files {
+			result.Files = append(result.Files, filepath.ToSlash(path))
+		}
+		sort.Strings(result.Files)
+		result.Problems = checkExpectations(c, files)
+
+		if build && result.Passed() {
+			result.Err = buildCase(ctx, registry, config)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// findTemplate returns the blueprint loaded from the directory name
+func findTemplate(registry *templates.Registry, name string) (types.Template, error) {
+	for _, tmpl := range registry.List() {
+		if path, _ := tmpl.Metadata["path"].(string); path == name {
+			return tmpl, nil
+		}
+	}
+	return types.Template{}, types.NewValidationError(fmt.Sprintf("no template.yaml found in %s", name), nil)
+}
+
+// caseConfig is the project configuration of a case. The framework and logger
+// the case leaves out are the defaults of the blueprint, as on the command line.
+func caseConfig(c Case, tmpl types.Template) types.ProjectConfig {
+	variables := map[string]string{"blueprint_id": tmpl.ID}
+	for name, value := range c.Variables {
+		variables[name] = value
+	}
+	return types.ProjectConfig{
+		Name:         c.Project,
+		Module:       c.Module,
+		Type:         tmpl.Type,
+		Architecture: tmpl.Architecture,
+		Framework:    OrDefault(c.Framework, tmpl, "Framework"),
+		Logger:       OrDefault(c.Logger, tmpl, "Logger"),
+		GoVersion:    c.GoVersion,
+		Variables:    variables,
+	}
+}
+
+// OrDefault is value, or the string default of the blueprint variable when it is empty
+func OrDefault(value string, tmpl types.Template, variable string) string {
+	if value != "" {
+		return value
+	}
+	for _, v := range tmpl.Variables {
+		if def, ok := v.Default.(string); ok && v.Name == variable {
+			return def
+		}
+	}
+	return ""
+}
+
+// checkExpectations lists the expected files that are missing and the absent ones that are present
+func checkExpectations(c Case, files map[string]generator.GeneratedFile) []string {
+	var problems []string
+	for _, path := range c.Expect {
+		if _, ok := files[path]; !ok {
+			problems = append(problems, fmt.Sprintf("expected %s to be generated", path))
+		}
+	}
+	for _, path := range c.Absent {
+		if _, ok := files[path]; ok {
+			problems = append(problems, fmt.Sprintf("expected %s not to be generated", path))
+		}
+	}
+	return problems
+}
+
+// buildCase generates the case into a temporary directory, builds it and runs its tests
+func buildCase(ctx context.Context, registry *templates.Registry, config types.ProjectConfig) error {
+	dir, err := os.MkdirTemp("", "go-starter-blueprint-*")
+	if err != nil {
+		return fmt.Errorf("failed to create build directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	outputPath := filepath.Join(dir, config.Name)
+	if _, err := generator.NewWithRegistry(registry).GenerateContext(ctx, config, types.GenerationOptions{OutputPath: outputPath, NoGit: true}); err != nil {
+		return err
+	}
+	if err := utils.GoBuild(outputPath, ""); err != nil {
+		return err
+	}
+	return utils.GoTest(outputPath)
+}
@@ -0,0 +1,363 @@
+package blueprint
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Finding severities; only errors fail the lint
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Lint checks, in the order they run
+const (
+	CheckSchema     = "schema"
+	CheckTemplates  = "templates"
+	CheckVariables  = "variables"
+	CheckStyle      = "style"
+	CheckFeatures   = "features"
+	CheckConditions = "conditions"
+	CheckRender     = "render"
+	CheckContext    = "context"
+	CheckGenerate   = "generate"
+)
+
+// variableTypes are the types a blueprint variable may declare
+var variableTypes = map[string]bool{
+	"":        true,
+	"string":  true,
+	"bool":    true,
+	"boolean": true,
+	"int":     true,
+	"select":  true,
+	"object":  true,
+}
+
+// Finding is a problem found in a blueprint
+type Finding struct {
+	Severity string `json:"severity"`
+	Check    string `json:"check"`
+	Message  string `json:"message"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s [%s] %s", f.Severity, f.Check, f.Message)
+}
+
+// HasErrors reports whether any of the findings is an error
+func HasErrors(findings []Finding) bool {
+	for _, finding := range findings {
+		if finding.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultCase is the sample variable set of blueprints without testdata/cases.yaml
+var defaultCase = Case{Name: "default", Project: "sample", Module: "example.com/sample"}
+
+// Lint checks the blueprint in dir: its template.yaml against the blueprint schema,
+// that every .tmpl parses, that the variables it declares are used and those its
+// templates use are defined, the style of its templates, that the files generated
+// in every project do not import packages generated only with a feature, that its
+// conditions evaluate, and that the Go files it renders for its sample variables
+// parse with gofmt. The blueprint is loaded together with its siblings, so it may
+// use shared sources such as ../shared.
+func Lint(ctx context.Context, dir string) ([]Finding, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	l := &linter{dir: dir}
+
+	data, err := os.ReadFile(filepath.Join(dir, "template.yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template.yaml: %w", err)
+	}
+	l.checkSchema(data)
+
+	loader := templates.NewTemplateLoaderWithFS(os.DirFS(filepath.Dir(dir)))
+	tmpl, err := loader.LoadTemplate(filepath.Base(dir))
+	if err != nil {
+		l.add(SeverityError, CheckSchema, err.Error())
+		return l.findings, nil
+	}
+	l.checkDeclarations(tmpl, loader)
+	if err := l.checkSources(tmpl); err != nil {
+		return nil, err
+	}
+
+	registry, err := templates.NewRegistryWithFS(os.DirFS(filepath.Dir(dir)))
+	if err != nil {
+		return nil, err
+	}
+	gen := generator.NewWithRegistry(registry)
+	// The functions of plugins are checked without the plugins installed
+	if err := gen.Extend(generator.StandInFuncs(tmpl.Functions)); err != nil {
+		return nil, err
+	}
+
+	issues, err := gen.AnalyzeTemplateVariables(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	for _, issue := range issues {
+		switch issue.Kind {
+		case generator.IssueInvalidTemplate:
+			l.add(SeverityError, CheckTemplates, issue.String())
+		case generator.IssueUndefinedVariable:
+			l.add(SeverityError, CheckVariables, issue.String())
+		case generator.IssueUnusedVariable:
+			l.add(SeverityWarning, CheckVariables, issue.String())
+		}
+	}
+
+	sources := loadSources(tmpl, registry.Loader())
+	l.checkStyle(tmpl, sources)
+	l.collectFeatureImports(tmpl, sources)
+
+	cases := []Case{defaultCase}
+	if _, err := os.Stat(filepath.Join(dir, CasesFile)); err == nil {
+		if cases, err = LoadCases(dir); err != nil {
+			l.add(SeverityError, CheckSchema, err.Error())
+			return l.findings, nil
+		}
+	}
+
+	for _, c := range cases {
+		config := caseConfig(c, tmpl)
+		for _, issue := range gen.CheckConditions(tmpl, config) {
+			l.add(SeverityError, CheckConditions, fmt.Sprintf("case %s: %s", c.Name, issue))
+		}
+		if !HasErrors(l.findings) {
+			l.checkRender(ctx, gen, tmpl, c, config)
+		}
+	}
+
+	return l.findings, nil
+}
+
+// linter collects the findings of one blueprint
+type linter struct {
+	dir      string
+	findings []Finding
+	// imports are the imports of packages generated with a feature only
+	imports []moduleImport
+	// reported holds the keys of the findings reported once for all cases
+	reported map[string]bool
+}
+
+func (l *linter) add(severity, check, message string) {
+	l.findings = append(l.findings, Finding{Severity: severity, Check: check, Message: message})
+}
+
+// checkSchema decodes template.yaml rejecting the keys the blueprint format does not know
+func (l *linter) checkSchema(data []byte) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var tmpl types.Template
+	if err := decoder.Decode(&tmpl); err != nil {
+		l.add(SeverityError, CheckSchema, fmt.Sprintf("template.yaml: %v", err))
+	}
+}
+
+// checkDeclarations checks the metadata, variables, files, dependencies and hooks the blueprint declares
+func (l *linter) checkDeclarations(tmpl types.Template, loader *templates.TemplateLoader) {
+	if tmpl.Name == "" {
+		l.add(SeverityError, CheckSchema, "name is required")
+	}
+	if tmpl.Type == "" {
+		l.add(SeverityError, CheckSchema, "type is required")
+	}
+	if tmpl.Description == "" {
+		l.add(SeverityWarning, CheckSchema, "description is empty")
+	}
+
+	names := make(map[string]bool, len(tmpl.Variables))
+	for i, variable := range tmpl.Variables {
+		if variable.Name == "" {
+			l.add(SeverityError, CheckSchema, fmt.Sprintf("variable %d has no name", i+1))
+			continue
+		}
+		if names[variable.Name] {
+			l.add(SeverityError, CheckSchema, fmt.Sprintf("variable %q is declared twice", variable.Name))
+		}
+		names[variable.Name] = true
+
+		if !variableTypes[variable.Type] {
+			l.add(SeverityError, CheckSchema, fmt.Sprintf("variable %q has unknown type %q", variable.Name, variable.Type))
+		}
+		if variable.Validation != "" {
+			if _, err := regexp.Compile(variable.Validation); err != nil {
+				l.add(SeverityError, CheckSchema, fmt.Sprintf("variable %q has an invalid validation pattern: %v", variable.Name, err))
+			}
+		}
+		if def, ok := variable.Default.(string); ok && def != "" && len(variable.Choices) > 0 && !slices.Contains(variable.Choices, def) {
+			l.add(SeverityError, CheckSchema, fmt.Sprintf("default %q of variable %q is not one of its choices %v", def, variable.Name, variable.Choices))
+		}
+	}
+
+	dir := filepath.Base(l.dir)
+	destinations := make(map[string]bool, len(tmpl.Files))
+	for i, file := range tmpl.Files {
+		if file.Destination == "" {
+			l.add(SeverityError, CheckSchema, fmt.Sprintf("file %d has no destination", i+1))
+			continue
+		}
+		if !file.IsSymlink() {
+			switch {
+			case file.Source == "":
+				l.add(SeverityError, CheckSchema, fmt.Sprintf("%s has no source", file.Destination))
+			case !loader.FileExists(dir, file.Source):
+				l.add(SeverityError, CheckSchema, fmt.Sprintf("source %s of %s does not exist", file.Source, file.Destination))
+			}
+		}
+		if _, err := file.FileMode(); err != nil {
+			l.add(SeverityError, CheckSchema, err.Error())
+		}
+		// Alternative sources of a file are told apart by their conditions
+		if file.Condition == "" {
+			if destinations[file.Destination] {
+				l.add(SeverityError, CheckSchema, fmt.Sprintf("%s is generated twice without a condition", file.Destination))
+			}
+			destinations[file.Destination] = true
+		}
+	}
+
+	for _, dep := range tmpl.Dependencies {
+		if dep.Module == "" || dep.Version == "" {
+			l.add(SeverityError, CheckSchema, fmt.Sprintf("dependency %q needs a module and a version", dep.Module))
+		}
+	}
+	for _, hook := range append(tmpl.PreGenerationHooks(), tmpl.PostGenerationHooks()...) {
+		var invalid *types.GoStarterError
+		if err := generator.CheckHook(hook); errors.As(err, &invalid) {
+			l.add(SeverityError, CheckSchema, invalid.Message)
+		}
+	}
+
+	for i, derived := range tmpl.Derived {
+		switch {
+		case !generator.ValidFunctionName(derived.Name):
+			l.add(SeverityError, CheckSchema, fmt.Sprintf("derived variable %d has an invalid name %q", i+1, derived.Name))
+		case names[derived.Name]:
+			l.add(SeverityError, CheckSchema, fmt.Sprintf("derived variable %q is already declared", derived.Name))
+		case derived.Expression == "":
+			l.add(SeverityError, CheckSchema, fmt.Sprintf("derived variable %q has no expression", derived.Name))
+		}
+		names[derived.Name] = true
+	}
+	for i, dir := range tmpl.Directories {
+		switch {
+		case dir.Path == "" || dir.Condition == "":
+			l.add(SeverityError, CheckSchema, fmt.Sprintf("directory %d needs a path and a condition", i+1))
+		case !slices.ContainsFunc(tmpl.Files, func(file types.TemplateFile) bool { return generator.InDirectory(file.Source, dir.Path) }):
+			l.add(SeverityWarning, CheckSchema, fmt.Sprintf("no file has its source under directory %s", dir.Path))
+		}
+	}
+
+	builtin := generator.TemplateFuncs(nil)
+	functions := make(map[string]bool, len(tmpl.Functions))
+	for i, function := range tmpl.Functions {
+		switch {
+		case !generator.ValidFunctionName(function.Name):
+			l.add(SeverityError, CheckSchema, fmt.Sprintf("function %d has an invalid name %q", i+1, function.Name))
+		case functions[function.Name]:
+			l.add(SeverityError, CheckSchema, fmt.Sprintf("function %q is declared twice", function.Name))
+		case builtin[function.Name] != nil:
+			l.add(SeverityWarning, CheckSchema, fmt.Sprintf("function %q is a Sprig or go-starter function, it need not be declared", function.Name))
+		}
+		functions[function.Name] = true
+	}
+}
+
+// checkSources warns about the .tmpl files of the blueprint directory, partials
+// aside, that no file entry uses, and about those of them that would not parse if
+// one did
+func (l *linter) checkSources(tmpl types.Template) error {
+	used := make(map[string]bool, len(tmpl.Files))
+	for _, file := range tmpl.Files {
+		used[filepath.ToSlash(filepath.Clean(file.Source))] = true
+	}
+
+	var unused []string
+	err := filepath.WalkDir(l.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".tmpl") {
+			return err
+		}
+		rel, _ := filepath.Rel(l.dir, path)
+		// Partials are used by the templates including them
+		if rel = filepath.ToSlash(rel); !used[rel] && !strings.HasPrefix(rel, templates.PartialPrefix) {
+			unused = append(unused, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk blueprint: %w", err)
+	}
+
+	sort.Strings(unused)
+	for _, rel := range unused {
+		l.add(SeverityWarning, CheckTemplates, fmt.Sprintf("%s is not used by any file entry", rel))
+		content, err := os.ReadFile(filepath.Join(l.dir, rel))
+		if err != nil {
+			return err
+		}
+		if _, err := template.New(rel).Funcs(generator.TemplateFuncs(tmpl.Functions)).Parse(string(content)); err != nil {
+			l.add(SeverityWarning, CheckTemplates, fmt.Sprintf("%s does not parse: %v", rel, err))
+		}
+	}
+	return nil
+}
+
+// checkRender renders a case and checks that every generated Go file parses with gofmt
+func (l *linter) checkRender(ctx context.Context, gen *generator.Generator, tmpl types.Template, c Case, config types.ProjectConfig) {
+	files, err := gen.GenerateInMemoryFiles(ctx, &config, tmpl.ID)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+		l.add(SeverityError, CheckRender, fmt.Sprintf("case %s: %v", c.Name, err))
+		return
+	}
+
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		file := files[path]
+		if !strings.HasSuffix(path, ".go") || file.Symlink != "" {
+			continue
+		}
+		if _, err := format.Source(file.Content); err != nil {
+			l.add(SeverityError, CheckRender, fmt.Sprintf("case %s: %s does not parse: %v", c.Name, path, err))
+		}
+	}
+	l.checkRenderedStyle(c, files, paths)
+	l.checkFeatures(c, paths)
+	l.checkContext(c, files, paths)
+	l.checkGenerate(c, files, paths)
+}
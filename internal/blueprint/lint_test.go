@@ -0,0 +1,351 @@
+package blueprint
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint_ScaffoldedBlueprint(t *testing.T) {
+	root := t.TempDir()
+	_, err := Scaffold(root, ScaffoldOptions{Name: "greeter", Description: "Greets"})
+	require.NoError(t, err)
+
+	findings, err := Lint(context.Background(), filepath.Join(root, "greeter"))
+	require.NoError(t, err)
+	assert.Empty(t, findings)
+}
+
+func TestLint_ReportsProblems(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "broken")
+	writeBlueprint(t, dir, map[string]string{
+		"template.yaml": `name: "broken"
+type: "cli"
+hook:
+  - name: "format"
+hooks:
+  post_generation:
+    - name: "cleanup"
+      command: "rm -rf vendor"
+    - name: "format"
+      command: "go fmt ./..."
+      on_failure: "retry"
+variables:
+  - name: "Unused"
+    type: "text"
+    default: "maybe"
+    choices: ["yes", "no"]
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+  - source: "missing.go.tmpl"
+    destination: "missing.go"
+    condition: "{{.Enabled}}"
+  - source: "invalid.txt.tmpl"
+    destination: "invalid.txt"
+`,
+		"main.go.tmpl":     "package main\n\nfunc main() {\n\tprintln(\"{{.ProjectNmae}}\")\n",
+		"invalid.txt.tmpl": "{{if .ProjectName}}\n",
+		"stale.go.tmpl":    "{{end}}",
+	})
+
+	findings, err := Lint(context.Background(), dir)
+	require.NoError(t, err)
+	assert.True(t, HasErrors(findings))
+
+	messages := make([]string, 0, len(findings))
+	for _, finding := range findings {
+		messages = append(messages, finding.String())
+	}
+	report := strings.Join(messages, "\n")
+
+	assert.Contains(t, report, "error [schema] template.yaml: yaml: unmarshal errors")
+	assert.Contains(t, report, "field hook not found")
+	assert.Contains(t, report, `error [schema] hook cleanup runs "rm", hooks may only run`)
+	assert.Contains(t, report, `error [schema] invalid on_failure "retry" of hook format (warn, fail, ignore)`)
+	assert.Contains(t, report, `error [schema] variable "Unused" has unknown type "text"`)
+	assert.Contains(t, report, `error [schema] default "maybe" of variable "Unused" is not one of its choices`)
+	assert.Contains(t, report, "error [schema] source missing.go.tmpl of missing.go does not exist")
+	assert.Contains(t, report, "warning [templates] stale.go.tmpl is not used by any file entry")
+	assert.Contains(t, report, "warning [templates] stale.go.tmpl does not parse")
+	assert.Contains(t, report, "error [templates] invalid.txt.tmpl")
+	assert.Contains(t, report, `error [variables] main.go.tmpl:4: undefined variable "ProjectNmae"`)
+	assert.Contains(t, report, `error [variables] template.yaml:1: undefined variable "Enabled"`)
+	assert.Contains(t, report, `warning [variables] variable "Unused" is declared but never used`)
+	assert.Contains(t, report, "error [conditions] case default: template.yaml: condition of missing.go")
+}
+
+func TestLint_RenderedGoMustParse(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "unparsable")
+	writeBlueprint(t, dir, map[string]string{
+		"template.yaml": `name: "unparsable"
+description: "Renders Go that does not parse without a greeting"
+type: "cli"
+variables:
+  - name: "Greeting"
+    type: "string"
+    default: ""
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+`,
+		"main.go.tmpl": "package main\n\nvar greeting = {{.Greeting}}\n\nfunc main() {}\n",
+	})
+
+	findings, err := Lint(context.Background(), dir)
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	assert.Equal(t, CheckRender, findings[0].Check)
+	assert.Contains(t, findings[0].Message, "case default: main.go does not parse")
+}
+
+func TestLint_TemplateQuality(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "sloppy")
+	writeBlueprint(t, dir, map[string]string{
+		"template.yaml": `name: "sloppy"
+description: "Renders a project that builds only with a store"
+type: "cli"
+format:
+  disabled: true
+variables:
+  - name: "Store"
+    type: "bool"
+    default: "false"
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+  - source: "store.go.tmpl"
+    destination: "internal/store/store.go"
+    condition: "{{eq .Store \"true\"}}"
+  - source: "config.yaml.tmpl"
+    destination: "config.yaml"
+`,
+		"main.go.tmpl": "package main\n\nimport (\n\t\"{{.ModulePath}}/internal/store\"\n)\n\n" +
+			"{{/* TODO: stays in the blueprint */}}\n" +
+			"// TODO: reaches the project\n" +
+			"func main() {\n    store.Open()\n\tprintln(`\n    raw strings keep their spaces`)\n}\n",
+		"store.go.tmpl":    "package store\n\nfunc Open() {}\n",
+		"config.yaml.tmpl": "name: {{.ProjectName}}\nlevels:\n \t- debug",
+	})
+
+	findings, err := Lint(context.Background(), dir)
+	require.NoError(t, err)
+	assert.False(t, HasErrors(findings))
+
+	messages := make([]string, 0, len(findings))
+	for _, finding := range findings {
+		messages = append(messages, finding.String())
+	}
+	assert.ElementsMatch(t, []string{
+		"warning [style] main.go.tmpl:8: TODO reaches the generated projects, put it in a {{/* */}} comment",
+		"warning [style] config.yaml.tmpl:3: indentation mixes spaces and tabs",
+		"warning [style] case default: config.yaml does not end with a newline",
+		"warning [style] case default: main.go:10 is indented with spaces, gofmt indents with tabs",
+		"warning [features] case default: main.go.tmpl:4 imports internal/store outside of a condition, but the case generates no file of it",
+	}, messages)
+}
+
+func TestLint_RequestContext(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "contextless")
+	writeBlueprint(t, dir, map[string]string{
+		"template.yaml": `name: "contextless"
+description: "Renders handlers dropping the context of their requests"
+type: "web-api"
+files:
+  - source: "handlers.go.tmpl"
+    destination: "internal/handlers/handlers.go"
+`,
+		"handlers.go.tmpl": `package handlers
+
+import (
+	"context"
+	"net/http"
+)
+
+type UserRepository interface {
+	Count(active bool) (int, error)
+	Delete(ctx context.Context, id int) error
+}
+
+type UserService interface {
+	Delete(ctx context.Context, id int) error
+}
+
+type Handler struct {
+	userService UserService
+}
+
+func (h *Handler) Remove(w http.ResponseWriter, r *http.Request) {
+	_ = h.userService.Delete(context.Background(), 1)
+	_ = h.userService.Delete(r.Context(), 2)
+}
+
+func (h *Handler) Purge(ctx context.Context) {
+	_ = h.userService.Delete(nil, 3)
+	go func() {
+		_ = h.userService.Delete(context.Background(), 4)
+	}()
+}
+`,
+	})
+
+	findings, err := Lint(context.Background(), dir)
+	require.NoError(t, err)
+
+	messages := make([]string, 0, len(findings))
+	for _, finding := range findings {
+		messages = append(messages, finding.String())
+	}
+	assert.ElementsMatch(t, []string{
+		"error [context] case default: internal/handlers/handlers.go:9: UserRepository.Count does not take a context.Context first, its queries outlive the requests they run for",
+		"error [context] case default: internal/handlers/handlers.go:22: Remove receives the request context in r but calls context.Background(), pass it on instead",
+		"warning [context] case default: internal/handlers/handlers.go:27: Purge calls h.userService.Delete without the request context",
+	}, messages)
+}
+
+func writeBlueprint(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+func TestLint_DeclaredFunctions(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "licensed")
+	writeBlueprint(t, dir, map[string]string{
+		"template.yaml": `name: "licensed"
+description: "Uses the functions of go-starter and of a plugin"
+type: "cli"
+functions:
+  - name: "licenseHeader"
+    plugin: "license"
+  - name: "licenseHeader"
+  - name: "upper"
+  - name: "license-header"
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+`,
+		"main.go.tmpl": "{{licenseHeader .ProjectName}}\npackage {{modulePathToPackage .ModulePath}}\n\nfunc main() {}\n",
+	})
+
+	findings, err := Lint(context.Background(), dir)
+	require.NoError(t, err)
+	messages := make([]string, 0, len(findings))
+	for _, finding := range findings {
+		messages = append(messages, finding.String())
+	}
+	assert.Equal(t, []string{
+		`error [schema] function "licenseHeader" is declared twice`,
+		`warning [schema] function "upper" is a Sprig or go-starter function, it need not be declared`,
+		`error [schema] function 4 has an invalid name "license-header"`,
+	}, messages, "templates using declared functions parse and render without the plugin")
+}
+
+func TestLint_DerivedVariablesAndDirectories(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "derived")
+	writeBlueprint(t, dir, map[string]string{
+		"template.yaml": `name: "derived"
+description: "Derives variables and toggles directories"
+type: "cli"
+variables:
+  - name: "Queue"
+    default: ""
+derived:
+  - name: "HasWorker"
+    expression: 'Queue != ""'
+  - name: "Queue"
+    expression: "true"
+  - name: "HasBroker"
+    expression: 'Queue in ["kafka" "nats"]'
+  - name: "Retries"
+    expression: "Backoff"
+directories:
+  - path: "worker"
+    condition: "Missing or HasWorker"
+  - path: "jobs"
+    condition: "HasWorker"
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+  - source: "worker/worker.go.tmpl"
+    destination: "worker/worker.go"
+`,
+		"main.go.tmpl":          "package main\n\nfunc main() {}\n",
+		"worker/worker.go.tmpl": "package worker\n",
+	})
+
+	findings, err := Lint(context.Background(), dir)
+	require.NoError(t, err)
+	messages := make([]string, 0, len(findings))
+	for _, finding := range findings {
+		messages = append(messages, finding.String())
+	}
+	assert.Equal(t, []string{
+		`error [schema] derived variable "Queue" is already declared`,
+		`warning [schema] no file has its source under directory jobs`,
+		`error [variables] template.yaml: undefined variable "Backoff"`,
+		`error [variables] template.yaml: undefined variable "Missing"`,
+		`error [conditions] case default: template.yaml: derived variable HasBroker: failed to parse condition: unexpected "nats" at column 19`,
+		`error [conditions] case default: template.yaml: derived variable Retries: failed to evaluate condition: undefined variable "Backoff"`,
+		`error [conditions] case default: template.yaml: condition of directory worker: failed to evaluate condition: undefined variable "Missing"`,
+	}, messages)
+}
+
+func TestLint_GenerateDirectives(t *testing.T) {
+	lint := func(makefile, workflow string) []string {
+		t.Helper()
+		dir := filepath.Join(t.TempDir(), "codegen")
+		writeBlueprint(t, dir, map[string]string{
+			"template.yaml": `name: "codegen"
+description: "Generates code"
+type: "cli"
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+  - source: "mocks/mocks.go.tmpl"
+    destination: "internal/mocks/mocks.go"
+  - source: "Makefile.tmpl"
+    destination: "Makefile"
+  - source: "ci.yml.tmpl"
+    destination: ".github/workflows/ci.yml"
+`,
+			"main.go.tmpl":        "package main\n\n//go:generate go run -C . ./cmd/envdocs\n\nfunc main() {}\n",
+			"mocks/mocks.go.tmpl": "package mocks\n\n//go:generate go run github.com/matryer/moq@latest -out store.go . Store\n//go:generate go run github.com/matryer/moq@v0.5.3 -out clock.go . Clock\n",
+			"Makefile.tmpl":       makefile,
+			"ci.yml.tmpl":         workflow,
+		})
+		findings, err := Lint(context.Background(), dir)
+		require.NoError(t, err)
+		var messages []string
+		for _, finding := range findings {
+			if finding.Check == CheckGenerate {
+				messages = append(messages, finding.String())
+			}
+		}
+		return messages
+	}
+
+	assert.Equal(t, []string{
+		"warning [generate] case default: internal/mocks/mocks.go runs github.com/matryer/moq@latest in a go:generate directive, pin a version for the generated code to be reproducible",
+		"warning [generate] case default: the Makefile has no generate target running go generate, for the go:generate directives of internal/mocks/mocks.go, main.go",
+		"warning [generate] case default: no workflow runs make generate-check, so the code generated by internal/mocks/mocks.go, main.go can drift from its sources",
+	}, lint("build:\n\tgo build ./...\n\ngenerate:\n\t@echo nothing\n", "jobs: {}\n"))
+
+	assert.Equal(t, []string{
+		"warning [generate] case default: internal/mocks/mocks.go runs github.com/matryer/moq@latest in a go:generate directive, pin a version for the generated code to be reproducible",
+	}, lint("generate: ## Run go generate\n\t@echo generating\n\t$(GO) generate ./...\n", "steps:\n  - run: make generate-check\n"))
+}
@@ -0,0 +1,290 @@
+package blueprint
+
+import (
+	"bytes"
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"path"
+	"regexp"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"unicode/utf8"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// leakedNote matches the notes authors leave for themselves; in template
+// comments they are stripped, anywhere else they reach the generated projects
+var leakedNote = regexp.MustCompile(`\b(TODO|FIXME)\b`)
+
+// source is a text template of the blueprint, parsed
+type source struct {
+	name    string
+	content string
+	trees   []*parse.Tree
+}
+
+// line is the line of the source at pos
+func (s source) line(pos parse.Pos) int {
+	return strings.Count(s.content[:pos], "\n") + 1
+}
+
+// loadSources parses the text templates the file entries of the blueprint use,
+// once each. Binary assets, symbolic links and templates that do not parse,
+// reported by the templates check, are left out.
+func loadSources(tmpl types.Template, loader *templates.TemplateLoader) map[string]source {
+	dir, _ := tmpl.Metadata["path"].(string)
+	sources := make(map[string]source, len(tmpl.Files))
+	for _, file := range tmpl.Files {
+		if file.IsSymlink() || file.Binary {
+			continue
+		}
+		if _, ok := sources[file.Source]; ok {
+			continue
+		}
+		content, err := loader.LoadFile(dir, file.Source)
+		if err != nil || bytes.IndexByte(content, 0) >= 0 || !utf8.Valid(content) {
+			continue
+		}
+		parsed, err := template.New(file.Source).Funcs(generator.TemplateFuncs(tmpl.Functions)).Parse(string(content))
+		if err != nil {
+			continue
+		}
+		s := source{name: file.Source, content: string(content)}
+		for _, t := range parsed.Templates() {
+			if t.Tree != nil && t.Root != nil {
+				s.trees = append(s.trees, t.Tree)
+			}
+		}
+		sources[file.Source] = s
+	}
+	return sources
+}
+
+// checkStyle reports the notes left in the text of the templates, indentation
+// mixing spaces and tabs, and YAML indented with tabs
+func (l *linter) checkStyle(tmpl types.Template, sources map[string]source) {
+	for _, file := range tmpl.Files {
+		s, ok := sources[file.Source]
+		if !ok || l.seen(CheckStyle, s.name) {
+			continue
+		}
+
+		// Template comments are not in the parse tree, the notes they hold stay in the blueprint
+		for _, tree := range s.trees {
+			walkText(tree.Root, func(text *parse.TextNode) {
+				offset := 0
+				for _, line := range strings.SplitAfter(string(text.Text), "\n") {
+					if note := leakedNote.FindString(line); note != "" {
+						l.add(SeverityWarning, CheckStyle, fmt.Sprintf("%s:%d: %s reaches the generated projects, put it in a {{/* */}} comment", s.name, s.line(text.Pos+parse.Pos(offset)), note))
+					}
+					offset += len(line)
+				}
+			})
+		}
+
+		yaml := strings.HasSuffix(file.Destination, ".yaml") || strings.HasSuffix(file.Destination, ".yml")
+		for i, line := range strings.Split(s.content, "\n") {
+			indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+			switch {
+			case strings.Contains(indent, " \t"):
+				l.add(SeverityWarning, CheckStyle, fmt.Sprintf("%s:%d: indentation mixes spaces and tabs", s.name, i+1))
+			case yaml && strings.Contains(indent, "\t"):
+				l.add(SeverityWarning, CheckStyle, fmt.Sprintf("%s:%d: YAML is indented with tabs", s.name, i+1))
+			}
+		}
+	}
+}
+
+// collectFeatureImports lists the imports of the Go templates generated in every
+// project that, outside of any {{if}}, import a package of the project whose files
+// are all generated under a condition. checkFeatures then reports those of them a
+// case generates no file of: the projects without the feature do not build.
+func (l *linter) collectFeatureImports(tmpl types.Template, sources map[string]source) {
+	// The packages of the project, and whether all their files are generated under a condition
+	optional := make(map[string]bool)
+	for _, file := range tmpl.Files {
+		if strings.Contains(file.Destination, "{{") || !strings.HasSuffix(file.Destination, ".go") {
+			continue
+		}
+		dir := path.Dir(file.Destination)
+		if file.Condition == "" {
+			optional[dir] = false
+		} else if _, ok := optional[dir]; !ok {
+			optional[dir] = true
+		}
+	}
+
+	for _, file := range tmpl.Files {
+		s, ok := sources[file.Source]
+		if !ok || file.Condition != "" || !strings.HasSuffix(file.Destination, ".go") {
+			continue
+		}
+		for _, tree := range s.trees {
+			for _, imported := range moduleImports(tree.Root) {
+				if optional[imported.pkg] {
+					imported.source = fmt.Sprintf("%s:%d", s.name, s.line(imported.pos))
+					l.imports = append(l.imports, imported)
+				}
+			}
+		}
+	}
+}
+
+// checkFeatures reports the imports of collectFeatureImports whose package has no
+// file in the rendered case
+func (l *linter) checkFeatures(c Case, paths []string) {
+	generated := make(map[string]bool, len(paths))
+	for _, p := range paths {
+		generated[path.Dir(p)] = true
+	}
+	for _, imported := range l.imports {
+		if !generated[imported.pkg] && !l.seen(CheckFeatures, imported.source+" "+imported.pkg) {
+			l.add(SeverityWarning, CheckFeatures, fmt.Sprintf("case %s: %s imports %s outside of a condition, but the case generates no file of it", c.Name, imported.source, imported.pkg))
+		}
+	}
+}
+
+// moduleImport is a package of the project a template imports
+type moduleImport struct {
+	pkg string
+	pos parse.Pos
+	// source is the template and line of the import
+	source string
+}
+
+// moduleImports lists the "{{.ModulePath}}/dir" imports of a template that are
+// not inside an {{if}}, {{with}} or {{range}}
+func moduleImports(root *parse.ListNode) []moduleImport {
+	var imports []moduleImport
+	nodes := root.Nodes
+	for i := 1; i+1 < len(nodes); i++ {
+		action, ok := nodes[i].(*parse.ActionNode)
+		if !ok || !isField(action, "ModulePath") {
+			continue
+		}
+		before, ok := nodes[i-1].(*parse.TextNode)
+		if !ok || !bytes.HasSuffix(before.Text, []byte(`"`)) {
+			continue
+		}
+		after, ok := nodes[i+1].(*parse.TextNode)
+		if !ok || !bytes.HasPrefix(after.Text, []byte("/")) {
+			continue
+		}
+		end := bytes.IndexByte(after.Text, '"')
+		if end < 0 {
+			continue
+		}
+		imports = append(imports, moduleImport{pkg: string(after.Text[1:end]), pos: action.Pos})
+	}
+	return imports
+}
+
+// isField reports whether the action only prints the field name of the root context
+func isField(action *parse.ActionNode, name string) bool {
+	if action.Pipe == nil || len(action.Pipe.Decl) > 0 || len(action.Pipe.Cmds) != 1 || len(action.Pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+	field, ok := action.Pipe.Cmds[0].Args[0].(*parse.FieldNode)
+	return ok && len(field.Ident) == 1 && field.Ident[0] == name
+}
+
+// walkText calls fn with every text node of the tree
+func walkText(node parse.Node, fn func(*parse.TextNode)) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			walkText(child, fn)
+		}
+	case *parse.TextNode:
+		fn(n)
+	case *parse.IfNode:
+		walkText(n.List, fn)
+		walkText(n.ElseList, fn)
+	case *parse.WithNode:
+		walkText(n.List, fn)
+		walkText(n.ElseList, fn)
+	case *parse.RangeNode:
+		walkText(n.List, fn)
+		walkText(n.ElseList, fn)
+	}
+}
+
+// checkRenderedStyle reports the rendered text files that do not end with a
+// newline, and the Go files indented with spaces where gofmt indents with tabs
+func (l *linter) checkRenderedStyle(c Case, files map[string]generator.GeneratedFile, paths []string) {
+	for _, path := range paths {
+		file := files[path]
+		if file.Binary || file.Symlink != "" || len(file.Content) == 0 || !utf8.Valid(file.Content) {
+			continue
+		}
+		if !bytes.HasSuffix(file.Content, []byte("\n")) && !l.seen(CheckStyle, "eof "+path) {
+			l.add(SeverityWarning, CheckStyle, fmt.Sprintf("case %s: %s does not end with a newline", c.Name, path))
+		}
+		if strings.HasSuffix(path, ".go") {
+			if lines, first := spaceIndentedLines(file.Content); lines > 0 && !l.seen(CheckStyle, "indent "+path) {
+				message := fmt.Sprintf("case %s: %s:%d is indented with spaces, gofmt indents with tabs", c.Name, path, first)
+				if lines > 1 {
+					message += fmt.Sprintf(" (%d more lines)", lines-1)
+				}
+				l.add(SeverityWarning, CheckStyle, message)
+			}
+		}
+	}
+}
+
+// spaceIndentedLines counts the lines of Go source whose indentation holds a
+// space, skipping those inside raw strings and comments, and returns the first
+func spaceIndentedLines(content []byte) (count, first int) {
+	fset := token.NewFileSet()
+	file := fset.AddFile("", -1, len(content))
+	var s scanner.Scanner
+	s.Init(file, content, nil, scanner.ScanComments)
+
+	lines := bytes.Split(content, []byte("\n"))
+	last := 0
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok == token.SEMICOLON && lit == "\n" {
+			continue
+		}
+		position := file.Position(pos)
+		if position.Line != last {
+			indent := lines[position.Line-1][:position.Column-1]
+			if bytes.IndexByte(indent, ' ') >= 0 {
+				count++
+				if first == 0 {
+					first = position.Line
+				}
+			}
+		}
+		last = position.Line
+		if n := strings.Count(lit, "\n"); n > 0 && (tok == token.STRING || tok == token.COMMENT) {
+			last += n
+		}
+	}
+	return count, first
+}
+
+// seen reports whether the key of the check was already reported, and records it
+func (l *linter) seen(check, key string) bool {
+	if l.reported == nil {
+		l.reported = make(map[string]bool)
+	}
+	key = check + " " + key
+	if l.reported[key] {
+		return true
+	}
+	l.reported[key] = true
+	return false
+}
@@ -0,0 +1,425 @@
+package blueprint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/semver"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+const (
+	// IndexFile is the catalog a registry serves, at the root of its URL
+	IndexFile = "index.json"
+	// IndexVersion is the version of the index format written by Publish
+	IndexVersion = 1
+	// installedFile records the installed blueprints, in the cache directory
+	installedFile = "installed.json"
+)
+
+// Index is the catalog of a registry. It is a static JSON file, so any HTTP
+// server, object store or git forge serving raw files can host a registry.
+type Index struct {
+	Version    int          `json:"version"`
+	Blueprints []IndexEntry `json:"blueprints"`
+}
+
+// IndexEntry is a blueprint of a registry and its published releases
+type IndexEntry struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Type         string   `json:"type"`
+	Architecture string   `json:"architecture,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	// Releases are sorted from the newest version
+	Releases []Release `json:"releases"`
+}
+
+// Release is a published version of a blueprint, pinned to a git ref and the
+// checksum of its directory at that ref
+type Release struct {
+	Version     string    `json:"version"`
+	Source      string    `json:"source"`
+	Checksum    string    `json:"checksum"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// Latest returns the newest release of the blueprint
+func (e IndexEntry) Latest() Release {
+	if len(e.Releases) == 0 {
+		return Release{}
+	}
+	return e.Releases[0]
+}
+
+// matches reports whether the query appears in the ID, name, description, type or tags
+func (e IndexEntry) matches(query string) bool {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return true
+	}
+	fields := append([]string{e.ID, e.Name, e.Description, e.Type, e.Architecture}, e.Tags...)
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// SearchResult is a blueprint found in a registry
+type SearchResult struct {
+	Registry string
+	IndexEntry
+}
+
+// indexURL is where the index of a registry is read from: registries are URLs
+// or local directories, and may name their index file directly
+func indexURL(registry string) string {
+	if strings.HasSuffix(registry, ".json") {
+		return registry
+	}
+	if strings.HasPrefix(registry, "http://") || strings.HasPrefix(registry, "https://") {
+		return strings.TrimSuffix(registry, "/") + "/" + IndexFile
+	}
+	return filepath.Join(strings.TrimPrefix(registry, "file://"), IndexFile)
+}
+
+// LoadIndex reads the index of a registry
+func LoadIndex(ctx context.Context, registry string) (*Index, error) {
+	location := indexURL(registry)
+
+	var data []byte
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid registry %s: %w", registry, err)
+		}
+		req.Header.Set("Accept", "application/json")
+		client := &http.Client{Timeout: 30 * time.Second}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read registry %s: %w", registry, err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("failed to read registry %s: %s", registry, resp.Status)
+		}
+		if data, err = io.ReadAll(io.LimitReader(resp.Body, 16<<20)); err != nil {
+			return nil, fmt.Errorf("failed to read registry %s: %w", registry, err)
+		}
+	} else {
+		var err error
+		if data, err = os.ReadFile(strings.TrimPrefix(location, "file://")); err != nil {
+			return nil, fmt.Errorf("failed to read registry %s: %w", registry, err)
+		}
+	}
+
+	var index Index
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("invalid index in registry %s: %w", registry, err)
+	}
+	if index.Version > IndexVersion {
+		return nil, types.NewValidationError(fmt.Sprintf("registry %s uses index version %d, upgrade go-starter to read it", registry, index.Version), nil)
+	}
+	return &index, nil
+}
+
+// Search lists the blueprints of the registries matching the query, all of
+// them for an empty query. A blueprint published to several registries is
+// listed once, from the first registry.
+func Search(ctx context.Context, registries []string, query string) ([]SearchResult, error) {
+	if len(registries) == 0 {
+		return nil, types.NewValidationError("no blueprint registry configured, pass --registry or add registries to ~/.go-starter.yaml", nil)
+	}
+
+	var results []SearchResult
+	seen := map[string]bool{}
+	for _, registry := range registries {
+		index, err := LoadIndex(ctx, registry)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range index.Blueprints {
+			if seen[entry.ID] || !entry.matches(query) {
+				continue
+			}
+			seen[entry.ID] = true
+			results = append(results, SearchResult{Registry: registry, IndexEntry: entry})
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].ID < results[j].ID })
+	return results, nil
+}
+
+// canonicalVersion writes versions as semver does, 1.2.0 as v1.2.0
+func canonicalVersion(version string) (string, error) {
+	if version != "" && !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	if !semver.IsValid(version) {
+		return "", types.NewValidationError(fmt.Sprintf("invalid blueprint version %q: use a semantic version like v1.2.0", strings.TrimPrefix(version, "v")), nil)
+	}
+	return semver.Canonical(version), nil
+}
+
+// splitRef splits id@version, the version is empty for the latest release
+func splitRef(ref string) (string, string, error) {
+	id, version, _ := strings.Cut(ref, "@")
+	if !namePattern.MatchString(id) {
+		return "", "", types.NewValidationError(fmt.Sprintf("invalid blueprint %q: use an ID like web-api or web-api@v1.2.0", ref), nil)
+	}
+	if version == "" {
+		return id, "", nil
+	}
+	version, err := canonicalVersion(version)
+	return id, version, err
+}
+
+// Resolve finds the release of ref, id or id@version, in the first registry publishing the blueprint
+func Resolve(ctx context.Context, registries []string, ref string) (SearchResult, Release, error) {
+	id, version, err := splitRef(ref)
+	if err != nil {
+		return SearchResult{}, Release{}, err
+	}
+	results, err := Search(ctx, registries, "")
+	if err != nil {
+		return SearchResult{}, Release{}, err
+	}
+	for _, result := range results {
+		if result.ID != id {
+			continue
+		}
+		if version == "" && len(result.Releases) > 0 {
+			return result, result.Latest(), nil
+		}
+		for _, release := range result.Releases {
+			if release.Version == version {
+				return result, release, nil
+			}
+		}
+		return SearchResult{}, Release{}, types.NewValidationError(fmt.Sprintf("blueprint %s has no release %s in %s", id, version, result.Registry), nil)
+	}
+	return SearchResult{}, Release{}, types.NewValidationError(fmt.Sprintf("blueprint %s not found in %s", id, strings.Join(registries, ", ")), nil)
+}
+
+// Installed is a blueprint installed from a registry
+type Installed struct {
+	ID       string `json:"id"`
+	Version  string `json:"version"`
+	Registry string `json:"registry"`
+	Source   string `json:"source"`
+	Checksum string `json:"checksum"`
+}
+
+// Install resolves ref in the registries and fetches the release into the
+// cache, checking its checksum, so 'new --blueprint <id>' generates from it.
+// Installing another version of a blueprint replaces the installed one.
+func Install(ctx context.Context, registries []string, ref string, opts FetchOptions) (*Installed, *Remote, error) {
+	result, release, err := Resolve(ctx, registries, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	source, err := ParseSource(release.Source)
+	if err != nil {
+		return nil, nil, fmt.Errorf("blueprint %s@%s: %w", result.ID, release.Version, err)
+	}
+	opts.Checksum = release.Checksum
+	remote, err := Fetch(ctx, source, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	if remote.Template.ID != result.ID {
+		return nil, nil, types.NewValidationError(fmt.Sprintf("registry %s lists %s, but its source holds blueprint %s", result.Registry, result.ID, remote.Template.ID), nil)
+	}
+
+	installed := Installed{ID: result.ID, Version: release.Version, Registry: result.Registry, Source: release.Source, Checksum: release.Checksum}
+	all, err := ListInstalled(opts.CacheDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	replaced := false
+	for i := range all {
+		if all[i].ID == installed.ID {
+			all[i], replaced = installed, true
+		}
+	}
+	if !replaced {
+		all = append(all, installed)
+		sort.Slice(all, func(i, j int) bool { return all[i].ID < all[j].ID })
+	}
+	if err := writeJSON(installedPath(opts.CacheDir), all); err != nil {
+		return nil, nil, fmt.Errorf("failed to record installed blueprint: %w", err)
+	}
+	return &installed, remote, nil
+}
+
+// installedPath is the record of the installed blueprints in cacheDir, DefaultCacheDir when empty
+func installedPath(cacheDir string) string {
+	if cacheDir == "" {
+		cacheDir, _ = DefaultCacheDir()
+	}
+	return filepath.Join(cacheDir, installedFile)
+}
+
+// ListInstalled returns the blueprints installed in cacheDir, DefaultCacheDir when empty
+func ListInstalled(cacheDir string) ([]Installed, error) {
+	data, err := os.ReadFile(installedPath(cacheDir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read installed blueprints: %w", err)
+	}
+	var installed []Installed
+	if err := json.Unmarshal(data, &installed); err != nil {
+		return nil, fmt.Errorf("failed to read installed blueprints: %w", err)
+	}
+	return installed, nil
+}
+
+// LookupInstalled finds an installed blueprint by ID
+func LookupInstalled(cacheDir, id string) (Installed, bool, error) {
+	all, err := ListInstalled(cacheDir)
+	if err != nil {
+		return Installed{}, false, err
+	}
+	for _, installed := range all {
+		if installed.ID == id {
+			return installed, true, nil
+		}
+	}
+	return Installed{}, false, nil
+}
+
+// PublishOptions describe the release to publish
+type PublishOptions struct {
+	// Version of the release, the ref of the source when empty
+	Version string
+	Tags    []string
+	// CacheDir holds the checkout of the source, DefaultCacheDir when empty
+	CacheDir string
+}
+
+// Publish adds a release of the blueprint at source to the index in dir,
+// creating it if needed, and returns the blueprint and the release. The source is fetched and linted first, and the
+// checksum of its directory is recorded so installs get exactly that content.
+// Releases are immutable: publishing a version again with other content fails.
+func Publish(ctx context.Context, dir string, source Source, opts PublishOptions) (*IndexEntry, Release, error) {
+	if strings.HasPrefix(dir, "http://") || strings.HasPrefix(dir, "https://") {
+		return nil, Release{}, types.NewValidationError(fmt.Sprintf("cannot publish to %s: publish to a local copy of the registry, then upload its %s", dir, IndexFile), nil)
+	}
+	if source.Ref == "" {
+		return nil, Release{}, types.NewValidationError(fmt.Sprintf("blueprint %s is not pinned, add @<tag> so the release does not follow a branch", source), nil)
+	}
+	version := opts.Version
+	if version == "" {
+		version = source.Ref
+	}
+	version, err := canonicalVersion(version)
+	if err != nil {
+		return nil, Release{}, err
+	}
+
+	remote, err := Fetch(ctx, source, FetchOptions{CacheDir: opts.CacheDir, Refresh: true})
+	if err != nil {
+		return nil, Release{}, err
+	}
+	findings, err := Lint(ctx, remote.Dir)
+	if err != nil {
+		return nil, Release{}, err
+	}
+	if HasErrors(findings) {
+		return nil, Release{}, types.NewValidationError(fmt.Sprintf("blueprint %s has lint errors, run 'go-starter blueprint lint' on it", source), nil)
+	}
+
+	path := indexURL(dir)
+	index := &Index{}
+	if _, err := os.Stat(path); err == nil {
+		if index, err = LoadIndex(ctx, dir); err != nil {
+			return nil, Release{}, err
+		}
+	}
+	index.Version = IndexVersion
+
+	tmpl := remote.Template
+	release := Release{Version: version, Source: source.String(), Checksum: remote.Checksum, PublishedAt: time.Now().UTC()}
+	var entry *IndexEntry
+	for i := range index.Blueprints {
+		if index.Blueprints[i].ID == tmpl.ID {
+			entry = &index.Blueprints[i]
+		}
+	}
+	if entry == nil {
+		index.Blueprints = append(index.Blueprints, IndexEntry{ID: tmpl.ID})
+		entry = &index.Blueprints[len(index.Blueprints)-1]
+	}
+	entry.Name, entry.Description, entry.Type, entry.Architecture = tmpl.Name, tmpl.Description, tmpl.Type, tmpl.Architecture
+	if len(opts.Tags) > 0 {
+		entry.Tags = opts.Tags
+	}
+
+	for _, existing := range entry.Releases {
+		if existing.Version != version {
+			continue
+		}
+		if existing.Checksum != release.Checksum {
+			return nil, Release{}, types.NewValidationError(fmt.Sprintf("blueprint %s %s is already published with checksum %s, publish a new version", tmpl.ID, version, existing.Checksum), nil)
+		}
+		release = existing
+	}
+	releases := []Release{release}
+	for _, existing := range entry.Releases {
+		if existing.Version != version {
+			releases = append(releases, existing)
+		}
+	}
+	sort.SliceStable(releases, func(i, j int) bool { return semver.Compare(releases[i].Version, releases[j].Version) > 0 })
+	entry.Releases = releases
+	published := *entry
+	sort.Slice(index.Blueprints, func(i, j int) bool { return index.Blueprints[i].ID < index.Blueprints[j].ID })
+
+	if err := writeJSON(path, index); err != nil {
+		return nil, Release{}, fmt.Errorf("failed to write index: %w", err)
+	}
+	return &published, release, nil
+}
+
+// writeJSON writes v to path through a temporary file, so readers never see a partial file
+func writeJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if err := tmp.Chmod(types.DefaultFileMode); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,106 @@
+package blueprint
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishSearchInstall(t *testing.T) {
+	ctx := context.Background()
+	repo := gitRepository(t)
+	cache := t.TempDir()
+	catalog := t.TempDir()
+
+	source, err := ParseSource("file://" + filepath.ToSlash(repo) + "//blueprints/greeter@v1.0.0")
+	require.NoError(t, err)
+	entry, release, err := Publish(ctx, catalog, source, PublishOptions{Tags: []string{"demo"}, CacheDir: cache})
+	require.NoError(t, err)
+	assert.Equal(t, "greeter", entry.ID)
+	require.Len(t, entry.Releases, 1)
+	assert.Equal(t, entry.Latest(), release)
+	assert.Equal(t, "v1.0.0", release.Version)
+	assert.Equal(t, source.String(), entry.Latest().Source)
+
+	t.Run("publishing the same release again changes nothing", func(t *testing.T) {
+		again, _, err := Publish(ctx, catalog, source, PublishOptions{CacheDir: cache})
+		require.NoError(t, err)
+		assert.Equal(t, entry.Releases, again.Releases)
+		assert.Equal(t, []string{"demo"}, again.Tags)
+	})
+
+	t.Run("releases are immutable", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(repo, "blueprints", "greeter", "BLUEPRINT.md"), []byte("# greeter\n"), 0644))
+		for _, args := range [][]string{{"commit", "--quiet", "-am", "Update docs"}, {"tag", "v1.1.0"}} {
+			cmd := exec.Command("git", append([]string{"-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)...)
+			cmd.Dir = repo
+			out, err := cmd.CombinedOutput()
+			require.NoError(t, err, string(out))
+		}
+		changed, err := ParseSource("file://" + filepath.ToSlash(repo) + "//blueprints/greeter@v1.1.0")
+		require.NoError(t, err)
+
+		_, _, err = Publish(ctx, catalog, changed, PublishOptions{Version: "1.0.0", CacheDir: cache})
+		assert.ErrorContains(t, err, "already published")
+
+		newer, _, err := Publish(ctx, catalog, changed, PublishOptions{CacheDir: cache})
+		require.NoError(t, err)
+		require.Len(t, newer.Releases, 2)
+		assert.Equal(t, "v1.1.0", newer.Latest().Version)
+	})
+
+	server := httptest.NewServer(http.FileServer(http.Dir(catalog)))
+	defer server.Close()
+	registries := []string{server.URL}
+
+	results, err := Search(ctx, registries, "DEMO")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, server.URL, results[0].Registry)
+	results, err = Search(ctx, registries, "payments")
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	installed, remote, err := Install(ctx, registries, "greeter@1.0.0", FetchOptions{CacheDir: cache})
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0.0", installed.Version)
+	assert.Equal(t, entry.Latest().Checksum, remote.Checksum)
+
+	latest, _, err := Install(ctx, registries, "greeter", FetchOptions{CacheDir: cache})
+	require.NoError(t, err)
+	assert.Equal(t, "v1.1.0", latest.Version)
+
+	found, ok, err := LookupInstalled(cache, "greeter")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, *latest, found, "installing another version replaces the installed one")
+
+	_, _, err = Install(ctx, registries, "greeter@v2.0.0", FetchOptions{CacheDir: cache})
+	assert.ErrorContains(t, err, "has no release v2.0.0")
+	_, _, err = Install(ctx, registries, "payments", FetchOptions{CacheDir: cache})
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestSearch_RegistryErrors(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := Search(ctx, nil, "")
+	assert.ErrorContains(t, err, "no blueprint registry configured")
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	_, err = Search(ctx, []string{server.URL}, "")
+	assert.ErrorContains(t, err, "404")
+
+	future := t.TempDir()
+	require.NoError(t, writeJSON(filepath.Join(future, IndexFile), Index{Version: IndexVersion + 1}))
+	_, err = Search(ctx, []string{future}, "")
+	assert.ErrorContains(t, err, "upgrade go-starter")
+}
@@ -0,0 +1,309 @@
+package blueprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/sumdb/dirhash"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// remoteRecord is written next to each cached checkout
+const remoteRecord = "remote.json"
+
+// Source is a blueprint kept in a git repository, written
+// host/org/repo//dir@ref like github.com/org/custom-blueprints//web-api@v1.2.0
+type Source struct {
+	// Repository is the URL the repository is cloned from
+	Repository string
+	// Dir is the directory of the blueprint in the repository, empty for its root
+	Dir string
+	// Ref is the tag, branch or commit the blueprint is pinned to, empty for the default branch
+	Ref string
+}
+
+// ParseSource parses a remote blueprint. Repositories without a scheme are
+// cloned over https, except scp-like git@host:org/repo addresses.
+func ParseSource(s string) (Source, error) {
+	rest := strings.TrimSpace(s)
+	if strings.HasSuffix(rest, "@") {
+		return Source{}, types.NewValidationError(fmt.Sprintf("invalid blueprint source %q: the ref after @ is empty", s), nil)
+	}
+	scheme := ""
+	if i := strings.Index(rest, "://"); i >= 0 {
+		scheme, rest = rest[:i+3], rest[i+3:]
+	}
+
+	// The ref follows the last @ of the blueprint directory, so branches may hold
+	// slashes; without a directory it follows the repository name.
+	var source Source
+	repository := rest
+	if i := strings.Index(rest, "//"); i >= 0 {
+		repository, source.Dir = rest[:i], rest[i+2:]
+		if j := strings.LastIndex(source.Dir, "@"); j >= 0 {
+			source.Dir, source.Ref = source.Dir[:j], source.Dir[j+1:]
+		}
+	} else if j := strings.LastIndex(repository, "@"); j > strings.LastIndex(repository, "/") && !strings.Contains(repository[j:], ":") {
+		repository, source.Ref = repository[:j], repository[j+1:]
+	}
+
+	if repository == "" || (scheme == "" && !strings.Contains(repository, "/")) {
+		return Source{}, types.NewValidationError(fmt.Sprintf("invalid blueprint source %q: use host/org/repo//dir@ref", s), nil)
+	}
+	if source.Dir != "" {
+		dir := path.Clean(source.Dir)
+		if path.IsAbs(dir) || dir == ".." || strings.HasPrefix(dir, "../") {
+			return Source{}, types.NewValidationError(fmt.Sprintf("invalid blueprint source %q: %s is outside the repository", s, source.Dir), nil)
+		}
+		if dir == "." {
+			dir = ""
+		}
+		source.Dir = dir
+	}
+
+	switch {
+	case scheme != "":
+		source.Repository = scheme + repository
+	case strings.HasPrefix(repository, "git@"):
+		source.Repository = repository
+	default:
+		source.Repository = "https://" + repository
+	}
+	return source, nil
+}
+
+// String formats the source the way ParseSource reads it
+func (s Source) String() string {
+	out := s.Repository
+	if s.Dir != "" {
+		out += "//" + s.Dir
+	}
+	if s.Ref != "" {
+		out += "@" + s.Ref
+	}
+	return out
+}
+
+// cacheKey is the directory of the source in the cache, one per repository and ref
+func (s Source) cacheKey() string {
+	repository := s.Repository
+	if i := strings.Index(repository, "://"); i >= 0 {
+		repository = repository[i+3:]
+	}
+	repository = strings.TrimPrefix(repository, "git@")
+	repository = strings.TrimSuffix(repository, ".git")
+	repository = strings.Trim(strings.ReplaceAll(repository, ":", "/"), "/")
+
+	ref := s.Ref
+	if ref == "" {
+		ref = "HEAD"
+	}
+	return filepath.FromSlash(repository) + "@" + url.PathEscape(ref)
+}
+
+// DefaultCacheDir is where remote blueprints are cached, ~/.go-starter/cache
+func DefaultCacheDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the home directory: %w", err)
+	}
+	return filepath.Join(home, ".go-starter", "cache"), nil
+}
+
+// FetchOptions control how a remote blueprint is fetched
+type FetchOptions struct {
+	// CacheDir holds the checkouts, DefaultCacheDir when empty
+	CacheDir string
+	// Checksum is the h1: hash the blueprint must have, any hash is accepted when empty
+	Checksum string
+	// Refresh clones the blueprint again even when its ref is cached
+	Refresh bool
+}
+
+// Remote is a fetched remote blueprint
+type Remote struct {
+	Source Source
+	// Dir is the blueprint directory in the cache
+	Dir string
+	// Commit is the commit the blueprint was checked out at
+	Commit string
+	// Checksum is the h1: hash of the blueprint directory, as go.sum hashes modules
+	Checksum string
+	// Registry holds the blueprint together with the blueprints next to it
+	Registry *templates.Registry
+	Template types.Template
+}
+
+// record is the content of remoteRecord
+type record struct {
+	Source    string    `json:"source"`
+	Commit    string    `json:"commit"`
+	Checksum  string    `json:"checksum"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// Fetch returns the blueprint of source, cloning its repository into the cache
+// unless the ref is already there. A ref left out follows the default branch,
+// so it is cloned again on every fetch. The checksum of a cached blueprint is
+// checked against the one recorded when it was cloned, and against the
+// expected checksum of the options.
+func Fetch(ctx context.Context, source Source, opts FetchOptions) (*Remote, error) {
+	cacheDir := opts.CacheDir
+	if cacheDir == "" {
+		var err error
+		if cacheDir, err = DefaultCacheDir(); err != nil {
+			return nil, err
+		}
+	}
+
+	entry := filepath.Join(cacheDir, source.cacheKey())
+	checkout := filepath.Join(entry, "repo")
+	rec, err := readRecord(entry)
+	if err != nil || opts.Refresh || source.Ref == "" {
+		if rec, err = clone(ctx, source, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	dir := filepath.Join(checkout, filepath.FromSlash(source.Dir))
+	checksum, err := dirhash.HashDir(dir, "blueprint", dirhash.Hash1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash blueprint %s: %w", source, err)
+	}
+	if checksum != rec.Checksum {
+		return nil, types.NewValidationError(fmt.Sprintf("cached blueprint %s was modified (%s, recorded %s), fetch it again with --blueprint-refresh", source, checksum, rec.Checksum), nil)
+	}
+	if opts.Checksum != "" && checksum != opts.Checksum {
+		return nil, types.NewValidationError(fmt.Sprintf("checksum mismatch for blueprint %s: got %s, want %s", source, checksum, opts.Checksum), nil)
+	}
+
+	// Like blueprint test, the blueprint is loaded together with its siblings
+	registry, err := templates.NewRegistryWithFS(os.DirFS(filepath.Dir(dir)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load blueprint %s: %w", source, err)
+	}
+	tmpl, err := findTemplate(registry, filepath.Base(dir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load blueprint %s: %w", source, err)
+	}
+
+	return &Remote{
+		Source:   source,
+		Dir:      dir,
+		Commit:   rec.Commit,
+		Checksum: checksum,
+		Registry: registry,
+		Template: tmpl,
+	}, nil
+}
+
+// readRecord reads the record of a cached checkout
+func readRecord(entry string) (record, error) {
+	var rec record
+	data, err := os.ReadFile(filepath.Join(entry, remoteRecord))
+	if err != nil {
+		return rec, err
+	}
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return rec, err
+	}
+	return rec, nil
+}
+
+// clone checks the ref of the source out into entry. The checkout is made in a
+// temporary directory and moved into place, so a failed or concurrent clone
+// never leaves a partial blueprint in the cache.
+func clone(ctx context.Context, source Source, entry string) (record, error) {
+	if err := os.MkdirAll(filepath.Dir(entry), 0755); err != nil {
+		return record{}, fmt.Errorf("failed to create blueprint cache: %w", err)
+	}
+	tmp, err := os.MkdirTemp(filepath.Dir(entry), ".fetch-*")
+	if err != nil {
+		return record{}, fmt.Errorf("failed to create blueprint cache: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+
+	checkout := filepath.Join(tmp, "repo")
+	if err := os.Mkdir(checkout, 0755); err != nil {
+		return record{}, fmt.Errorf("failed to create blueprint cache: %w", err)
+	}
+	commit, err := Checkout(ctx, source, checkout)
+	if err != nil {
+		return record{}, fmt.Errorf("failed to fetch blueprint %s: %w", source, err)
+	}
+
+	dir := filepath.Join(checkout, filepath.FromSlash(source.Dir))
+	if _, err := os.Stat(filepath.Join(dir, "template.yaml")); err != nil {
+		return record{}, types.NewValidationError(fmt.Sprintf("no template.yaml found in %s at %s", source.Dir, source), nil)
+	}
+	checksum, err := dirhash.HashDir(dir, "blueprint", dirhash.Hash1)
+	if err != nil {
+		return record{}, fmt.Errorf("failed to hash blueprint %s: %w", source, err)
+	}
+
+	rec := record{Source: source.String(), Commit: commit, Checksum: checksum, FetchedAt: time.Now().UTC()}
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return record{}, err
+	}
+	if err := os.WriteFile(filepath.Join(tmp, remoteRecord), data, 0644); err != nil {
+		return record{}, fmt.Errorf("failed to write blueprint cache: %w", err)
+	}
+
+	if err := os.RemoveAll(entry); err != nil {
+		return record{}, fmt.Errorf("failed to replace cached blueprint: %w", err)
+	}
+	if err := os.Rename(tmp, entry); err != nil {
+		return record{}, fmt.Errorf("failed to write blueprint cache: %w", err)
+	}
+	return rec, nil
+}
+
+// Checkout checks the ref of the source repository out into the empty directory
+// dir, without its git metadata, and returns the commit checked out
+func Checkout(ctx context.Context, source Source, dir string) (string, error) {
+	ref := source.Ref
+	if ref == "" {
+		ref = "HEAD"
+	}
+	steps := [][]string{
+		{"init", "--quiet"},
+		{"fetch", "--quiet", "--depth", "1", source.Repository, ref},
+		{"checkout", "--quiet", "--detach", "FETCH_HEAD"},
+	}
+	for _, args := range steps {
+		if _, err := runGit(ctx, dir, args...); err != nil {
+			return "", err
+		}
+	}
+	commit, err := runGit(ctx, dir, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	if err := os.RemoveAll(filepath.Join(dir, ".git")); err != nil {
+		return "", err
+	}
+	return commit, nil
+}
+
+// runGit runs git in dir without prompting for credentials and returns its trimmed output
+func runGit(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(string(out)))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,129 @@
+package blueprint
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSource(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Source
+	}{
+		{"github.com/org/custom-blueprints//web-api@v1.2.0", Source{Repository: "https://github.com/org/custom-blueprints", Dir: "web-api", Ref: "v1.2.0"}},
+		{"github.com/org/blueprint", Source{Repository: "https://github.com/org/blueprint"}},
+		{"github.com/org/blueprints//team/web-api/", Source{Repository: "https://github.com/org/blueprints", Dir: "team/web-api"}},
+		{"git@github.com:org/blueprints.git//cli@main", Source{Repository: "git@github.com:org/blueprints.git", Dir: "cli", Ref: "main"}},
+		{"file:///srv/blueprints//cli@3f2c1ab", Source{Repository: "file:///srv/blueprints", Dir: "cli", Ref: "3f2c1ab"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseSource(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, in := range []string{"", "web-api", "github.com/org/repo@", "github.com/org/repo//../etc"} {
+		_, err := ParseSource(in)
+		assert.Error(t, err, in)
+	}
+}
+
+func TestSource_CacheKey(t *testing.T) {
+	source, err := ParseSource("github.com/org/blueprints//web-api@release/1.x")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.FromSlash("github.com/org/blueprints")+"@release%2F1.x", source.cacheKey())
+
+	source, err = ParseSource("git@github.com:org/blueprints.git//web-api")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.FromSlash("github.com/org/blueprints")+"@HEAD", source.cacheKey())
+}
+
+// gitRepository commits a scaffolded greeter blueprint under blueprints/ and tags it v1.0.0
+func gitRepository(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	repo := t.TempDir()
+	_, err := Scaffold(filepath.Join(repo, "blueprints"), ScaffoldOptions{Name: "greeter"})
+	require.NoError(t, err)
+
+	git := func(args ...string) {
+		args = append([]string{"-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	git("init", "--quiet")
+	git("add", ".")
+	git("commit", "--quiet", "-m", "Add greeter blueprint")
+	git("tag", "v1.0.0")
+	return repo
+}
+
+func TestFetch(t *testing.T) {
+	repo := gitRepository(t)
+	cache := t.TempDir()
+	source, err := ParseSource("file://" + filepath.ToSlash(repo) + "//blueprints/greeter@v1.0.0")
+	require.NoError(t, err)
+
+	remote, err := Fetch(context.Background(), source, FetchOptions{CacheDir: cache})
+	require.NoError(t, err)
+	assert.Equal(t, "greeter", remote.Template.ID)
+	assert.True(t, strings.HasPrefix(remote.Checksum, "h1:"), remote.Checksum)
+	assert.Len(t, remote.Commit, 40)
+	assert.FileExists(t, filepath.Join(remote.Dir, "template.yaml"))
+	assert.NoDirExists(t, filepath.Join(cache, source.cacheKey(), "repo", ".git"))
+
+	t.Run("reuses the cached ref", func(t *testing.T) {
+		require.NoError(t, os.RemoveAll(repo))
+		cached, err := Fetch(context.Background(), source, FetchOptions{CacheDir: cache, Checksum: remote.Checksum})
+		require.NoError(t, err)
+		assert.Equal(t, remote.Commit, cached.Commit)
+		assert.Equal(t, remote.Checksum, cached.Checksum)
+	})
+
+	t.Run("rejects another checksum", func(t *testing.T) {
+		_, err := Fetch(context.Background(), source, FetchOptions{CacheDir: cache, Checksum: "h1:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "checksum mismatch")
+	})
+
+	t.Run("rejects a modified cache", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(remote.Dir, "main.go.tmpl"), []byte("package main\n"), 0644))
+		_, err := Fetch(context.Background(), source, FetchOptions{CacheDir: cache})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "was modified")
+	})
+}
+
+func TestFetch_Errors(t *testing.T) {
+	repo := gitRepository(t)
+
+	for _, in := range []string{
+		"file://" + filepath.ToSlash(repo) + "//blueprints/greeter@v9.9.9",
+		"file://" + filepath.ToSlash(repo) + "//blueprints/missing@v1.0.0",
+	} {
+		source, err := ParseSource(in)
+		require.NoError(t, err)
+		cache := t.TempDir()
+
+		_, err = Fetch(context.Background(), source, FetchOptions{CacheDir: cache})
+		assert.Error(t, err, in)
+
+		entries, err := os.ReadDir(filepath.Dir(filepath.Join(cache, source.cacheKey())))
+		require.NoError(t, err)
+		assert.Empty(t, entries, "a failed fetch leaves nothing in the cache")
+	}
+}
@@ -0,0 +1,94 @@
+// Package blueprint holds the tooling for blueprint authors: scaffolding a new
+// blueprint, rendering it with sample variables to test it, fetching
+// published blueprints from git repositories, and the registries cataloguing them.
+package blueprint
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"text/template"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// skeleton is the blueprint written by Scaffold. Its files are rendered with
+// [[ ]] delimiters so the {{ }} actions of the blueprint itself are kept.
+//
+//go:embed all:skeleton
+var skeleton embed.FS
+
+// namePattern is the form of blueprint IDs, which also name their directory
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)
+
+// ScaffoldOptions describe the blueprint to scaffold
+type ScaffoldOptions struct {
+	Name        string
+	Type        string
+	Description string
+	Author      string
+}
+
+// Scaffold writes a new blueprint into root/<name>: its template.yaml, example
+// templated files, the sample variables it is tested with and a documentation
+// stub. It returns the written files, relative to the blueprint directory.
+func Scaffold(root string, opts ScaffoldOptions) ([]string, error) {
+	if !namePattern.MatchString(opts.Name) {
+		return nil, types.NewValidationError(fmt.Sprintf("invalid blueprint name %q: use lowercase letters, digits and dashes", opts.Name), nil)
+	}
+	if opts.Type == "" {
+		opts.Type = "cli"
+	}
+	if opts.Description == "" {
+		opts.Description = fmt.Sprintf("%s blueprint", opts.Name)
+	}
+
+	dir := filepath.Join(root, opts.Name)
+	if _, err := os.Stat(dir); err == nil {
+		return nil, types.NewValidationError(fmt.Sprintf("%s already exists", dir), nil)
+	}
+
+	data := struct {
+		ScaffoldOptions
+		Dir string
+	}{opts, dir}
+
+	var written []string
+	err := fs.WalkDir(skeleton, "skeleton", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, _ := filepath.Rel("skeleton", path)
+
+		content, err := skeleton.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		tmpl, err := template.New(rel).Delims("[[", "]]").Parse(string(content))
+		if err != nil {
+			return fmt.Errorf("failed to parse skeleton file %s: %w", rel, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return fmt.Errorf("failed to render skeleton file %s: %w", rel, err)
+		}
+
+		target := filepath.Join(dir, rel)
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", rel, err)
+		}
+		if err := os.WriteFile(target, buf.Bytes(), types.DefaultFileMode); err != nil {
+			return fmt.Errorf("failed to write %s: %w", rel, err)
+		}
+		written = append(written, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return written, nil
+}
@@ -0,0 +1,82 @@
+package blueprint
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScaffold(t *testing.T) {
+	root := t.TempDir()
+
+	written, err := Scaffold(root, ScaffoldOptions{Name: "my-service", Type: "web-api", Author: "Jane"})
+	require.NoError(t, err)
+	assert.Contains(t, written, "template.yaml")
+	assert.Contains(t, written, "main.go.tmpl")
+	assert.Contains(t, written, ".gitignore.tmpl")
+	assert.Contains(t, written, CasesFile)
+	assert.Contains(t, written, "BLUEPRINT.md")
+
+	manifest, err := os.ReadFile(filepath.Join(root, "my-service", "template.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(manifest), `id: "my-service"`)
+	assert.Contains(t, string(manifest), `type: "web-api"`)
+	assert.Contains(t, string(manifest), `author: "Jane"`)
+	assert.Contains(t, string(manifest), `condition: "{{eq .Docker \"true\"}}"`)
+
+	_, err = Scaffold(root, ScaffoldOptions{Name: "my-service"})
+	assert.Error(t, err, "an existing blueprint is not overwritten")
+
+	_, err = Scaffold(root, ScaffoldOptions{Name: "My Service"})
+	assert.Error(t, err)
+}
+
+func TestTest_ScaffoldedBlueprint(t *testing.T) {
+	root := t.TempDir()
+	_, err := Scaffold(root, ScaffoldOptions{Name: "greeter"})
+	require.NoError(t, err)
+
+	results, err := Test(context.Background(), filepath.Join(root, "greeter"), false)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		assert.True(t, result.Passed(), "case %s: %v %v", result.Case.Name, result.Err, result.Problems)
+	}
+	assert.NotContains(t, results[0].Files, "Dockerfile")
+	assert.Contains(t, results[1].Files, "Dockerfile")
+}
+
+func TestTest_ReportsUnmetExpectations(t *testing.T) {
+	root := t.TempDir()
+	_, err := Scaffold(root, ScaffoldOptions{Name: "greeter"})
+	require.NoError(t, err)
+
+	cases := "cases:\n  - name: wrong\n    project: sample\n    module: example.com/sample\n    expect: [Dockerfile]\n    absent: [main.go]\n"
+	require.NoError(t, os.WriteFile(filepath.Join(root, "greeter", CasesFile), []byte(cases), 0644))
+
+	results, err := Test(context.Background(), filepath.Join(root, "greeter"), false)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.False(t, results[0].Passed())
+	assert.Equal(t, []string{"expected Dockerfile to be generated", "expected main.go not to be generated"}, results[0].Problems)
+}
+
+func TestTest_Build(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the generated projects")
+	}
+
+	root := t.TempDir()
+	_, err := Scaffold(root, ScaffoldOptions{Name: "greeter"})
+	require.NoError(t, err)
+
+	results, err := Test(context.Background(), filepath.Join(root, "greeter"), true)
+	require.NoError(t, err)
+	for _, result := range results {
+		assert.True(t, result.Passed(), "case %s: %v %v", result.Case.Name, result.Err, result.Problems)
+	}
+}
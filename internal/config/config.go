@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/viper"
 )
@@ -24,6 +25,14 @@ type Profile struct {
 	Email string `yaml:"email" mapstructure:"email"`
 	// License is the default license type
 	License string `yaml:"license" mapstructure:"license"`
+	// ModulePrefix is prepended to the project name to form the module path
+	ModulePrefix string `yaml:"module_prefix" mapstructure:"module_prefix"`
+	// CI is the CI provider of the generated projects (github, none)
+	CI string `yaml:"ci" mapstructure:"ci"`
+	// Directories select the profile for the projects generated inside them
+	Directories []string `yaml:"directories" mapstructure:"directories"`
+	// Flags are the default values of the flags of go-starter new, by flag name
+	Flags map[string]string `yaml:"flags" mapstructure:"flags"`
 	// Defaults contains default values for project generation
 	Defaults ProfileDefaults `yaml:"defaults" mapstructure:"defaults"`
 }
@@ -105,25 +114,29 @@ var (
 	}
 )
 
+// DefaultPath returns the path of the configuration file, ~/.go-starter/config.yaml
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(home, ".go-starter", "config.yaml"), nil
+}
+
 // Load loads the configuration from the config file
 func Load(configFile string) (*Config, error) {
 	v := viper.New()
 
-	// Set config file if provided
-	if configFile != "" {
-		v.SetConfigFile(configFile)
-	} else {
-		// Set default config locations
-		home, err := os.UserHomeDir()
+	// Fall back to the default config location
+	if configFile == "" {
+		path, err := DefaultPath()
 		if err != nil {
-			return nil, fmt.Errorf("failed to get user home directory: %w", err)
+			return nil, err
 		}
-
-		v.SetConfigName(".go-starter")
-		v.SetConfigType("yaml")
-		v.AddConfigPath(home)
-		v.AddConfigPath(".")
+		configFile = path
 	}
+	v.SetConfigFile(configFile)
+	v.SetConfigType("yaml")
 
 	// Set environment variable prefix
 	v.SetEnvPrefix("GO_STARTER")
@@ -164,15 +177,13 @@ func Load(configFile string) (*Config, error) {
 // Save saves the configuration to the specified file
 func (c *Config) Save(configFile string) error {
 	// Determine config file path
-	var filePath string
-	if configFile != "" {
-		filePath = configFile
-	} else {
-		home, err := os.UserHomeDir()
+	filePath := configFile
+	if filePath == "" {
+		path, err := DefaultPath()
 		if err != nil {
-			return fmt.Errorf("failed to get user home directory: %w", err)
+			return err
 		}
-		filePath = filepath.Join(home, ".go-starter.yaml")
+		filePath = path
 	}
 
 	// Create directory if it doesn't exist
@@ -198,11 +209,7 @@ func (c *Config) Save(configFile string) error {
 
 // GetCurrentProfile returns the current active profile
 func (c *Config) GetCurrentProfile() (*Profile, error) {
-	profile, exists := c.Profiles[c.CurrentProfile]
-	if !exists {
-		return nil, fmt.Errorf("profile '%s' not found", c.CurrentProfile)
-	}
-	return &profile, nil
+	return c.profile(c.CurrentProfile)
 }
 
 // SetCurrentProfile sets the current active profile
@@ -214,6 +221,74 @@ func (c *Config) SetCurrentProfile(name string) error {
 	return nil
 }
 
+// SelectProfile picks the profile of a generation: the named one when name is set,
+// else the profile with the innermost of its directories holding dir, else the
+// current profile. It returns the name of the profile with it.
+func (c *Config) SelectProfile(name, dir string) (string, *Profile, error) {
+	if name != "" {
+		profile, err := c.profile(name)
+		if err != nil {
+			return "", nil, err
+		}
+		return name, profile, nil
+	}
+
+	selected, depth := c.CurrentProfile, -1
+	for profileName, profile := range c.Profiles {
+		for _, root := range profile.Directories {
+			root = filepath.Clean(expandHome(root))
+			if !withinDir(root, dir) {
+				continue
+			}
+			// The innermost directory wins, ties go to the first name so the choice is stable
+			if len(root) > depth || (len(root) == depth && profileName < selected) {
+				selected, depth = profileName, len(root)
+			}
+		}
+	}
+
+	profile, err := c.profile(selected)
+	if err != nil {
+		return "", nil, err
+	}
+	return selected, profile, nil
+}
+
+// profile returns the named profile
+func (c *Config) profile(name string) (*Profile, error) {
+	profile, exists := c.Profiles[name]
+	if !exists {
+		return nil, fmt.Errorf("profile '%s' not found", name)
+	}
+	return &profile, nil
+}
+
+// ModulePath returns the module path of a project of the profile, empty without a module prefix
+func (p Profile) ModulePath(name string) string {
+	if p.ModulePrefix == "" || name == "" {
+		return ""
+	}
+	return strings.TrimSuffix(p.ModulePrefix, "/") + "/" + name
+}
+
+// withinDir reports whether path is root or inside it
+func withinDir(root, path string) bool {
+	rel, err := filepath.Rel(root, filepath.Clean(path))
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// expandHome replaces a leading ~ of path with the home directory
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
+
 // AddProfile adds a new profile to the configuration
 func (c *Config) AddProfile(name string, profile Profile) {
 	if c.Profiles == nil {
@@ -319,6 +394,18 @@ func (c *Config) validate() error {
 
 // validateProfile validates a single profile
 func validateProfile(_ string, profile Profile) error {
+	// Validate CI provider options
+	if err := ValidateCI(profile.CI); err != nil {
+		return err
+	}
+
+	// Validate module prefix, the module paths start with it
+	if profile.ModulePrefix != "" {
+		if err := ValidateModulePath(strings.TrimSuffix(profile.ModulePrefix, "/") + "/project"); err != nil {
+			return fmt.Errorf("invalid module prefix: %w", err)
+		}
+	}
+
 	// Validate Go version format
 	if profile.Defaults.GoVersion != "" {
 		// Simple validation - should be in format "1.xx"
@@ -342,12 +429,13 @@ func validateProfile(_ string, profile Profile) error {
 
 	// Validate architecture options
 	validArchitectures := map[string]bool{
-		"standard":     true,
-		"clean":        true,
-		"ddd":          true,
-		"hexagonal":    true,
-		"event-driven": true,
-		"":             true, // empty is allowed
+		"standard":       true,
+		"clean":          true,
+		"ddd":            true,
+		"hexagonal":      true,
+		"event-driven":   true,
+		"vertical-slice": true,
+		"":               true, // empty is allowed
 	}
 	if !validArchitectures[profile.Defaults.Architecture] {
 		return fmt.Errorf("invalid architecture: %s", profile.Defaults.Architecture)
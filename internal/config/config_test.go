@@ -338,6 +338,20 @@ func TestValidateProfile(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid CI provider",
+			profile: Profile{
+				CI: "jenkins",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid module prefix",
+			profile: Profile{
+				ModulePrefix: "acme",
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid architecture",
 			profile: Profile{
@@ -360,3 +374,67 @@ func TestValidateProfile(t *testing.T) {
 		})
 	}
 }
+
+func TestLoad_Profiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	configFile := filepath.Join(tmpDir, "config.yaml")
+	content := `current_profile: oss
+profiles:
+  oss:
+    license: MIT
+    module_prefix: github.com/jane
+  work:
+    license: Proprietary
+    module_prefix: git.acme.com/platform/
+    ci: none
+    directories:
+      - ` + filepath.Join(tmpDir, "acme") + `
+    flags:
+      framework: echo
+      di: wire
+`
+	if err := os.WriteFile(configFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	config, err := Load(configFile)
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+
+	work := config.Profiles["work"]
+	if work.CI != "none" || work.Flags["framework"] != "echo" || work.Flags["di"] != "wire" {
+		t.Errorf("Expected the CI provider and flags of the work profile, got %+v", work)
+	}
+	if got := work.ModulePath("billing"); got != "git.acme.com/platform/billing" {
+		t.Errorf("Expected module path 'git.acme.com/platform/billing', got '%s'", got)
+	}
+
+	tests := []struct {
+		name    string
+		profile string
+		dir     string
+		want    string
+	}{
+		{name: "flag", profile: "work", dir: tmpDir, want: "work"},
+		{name: "profile directory", dir: filepath.Join(tmpDir, "acme"), want: "work"},
+		{name: "inside profile directory", dir: filepath.Join(tmpDir, "acme", "services"), want: "work"},
+		{name: "sibling of profile directory", dir: filepath.Join(tmpDir, "acme-oss"), want: "oss"},
+		{name: "current profile", dir: tmpDir, want: "oss"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, profile, err := config.SelectProfile(tt.profile, tt.dir)
+			if err != nil {
+				t.Fatalf("SelectProfile() error = %v", err)
+			}
+			if name != tt.want || profile == nil {
+				t.Errorf("Expected profile '%s', got '%s'", tt.want, name)
+			}
+		})
+	}
+
+	if _, _, err := config.SelectProfile("missing", tmpDir); err == nil {
+		t.Error("Expected an error for an unknown profile")
+	}
+}
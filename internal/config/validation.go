@@ -3,9 +3,12 @@ package config
 import (
 	"fmt"
 	"net/mail"
+	"net/url"
 	"path/filepath"
 	"regexp"
 	"strings"
+
+	"github.com/francknouama/go-starter/internal/naming"
 )
 
 // ValidateProjectName validates a project name
@@ -32,14 +35,7 @@ func ValidateProjectName(name string) error {
 	}
 
 	// Check for reserved names
-	reservedNames := map[string]bool{
-		"con": true, "prn": true, "aux": true, "nul": true,
-		"com1": true, "com2": true, "com3": true, "com4": true,
-		"com5": true, "com6": true, "com7": true, "com8": true, "com9": true,
-		"lpt1": true, "lpt2": true, "lpt3": true, "lpt4": true,
-		"lpt5": true, "lpt6": true, "lpt7": true, "lpt8": true, "lpt9": true,
-	}
-	if reservedNames[strings.ToLower(name)] {
+	if naming.IsReservedName(name) {
 		return fmt.Errorf("project name '%s' is reserved", name)
 	}
 
@@ -182,15 +178,26 @@ func ValidateGoVersion(version string) error {
 // ValidateTemplateType validates a template type
 func ValidateTemplateType(templateType string) error {
 	validTypes := map[string]bool{
-		"web-api":      true,
-		"cli":          true,
-		"library":      true,
-		"lambda":       true,
-		"lambda-proxy": true,
-		"event-driven": true,
-		"microservice": true,
-		"monolith":     true,
-		"workspace":    true,
+		"web-api":            true,
+		"cli":                true,
+		"cli-advanced":       true,
+		"library":            true,
+		"lambda":             true,
+		"lambda-proxy":       true,
+		"event-driven":       true,
+		"microservice":       true,
+		"grpc-service":       true,
+		"event-service":      true,
+		"terraform-provider": true,
+		"tui":                true,
+		"bot":                true,
+		"web-app":            true,
+		"realtime":           true,
+		"gateway":            true,
+		"desktop":            true,
+		"workflow":           true,
+		"monolith":           true,
+		"workspace":          true,
 	}
 
 	if !validTypes[templateType] {
@@ -221,12 +228,13 @@ func ValidateFramework(framework string) error {
 // ValidateArchitecture validates an architecture pattern
 func ValidateArchitecture(architecture string) error {
 	validArchitectures := map[string]bool{
-		"standard":     true,
-		"clean":        true,
-		"ddd":          true,
-		"hexagonal":    true,
-		"event-driven": true,
-		"":             true, // empty is allowed
+		"standard":       true,
+		"clean":          true,
+		"ddd":            true,
+		"hexagonal":      true,
+		"event-driven":   true,
+		"vertical-slice": true,
+		"":               true, // empty is allowed
 	}
 
 	if !validArchitectures[architecture] {
@@ -305,6 +313,171 @@ func ValidateLogger(logger string) error {
 	return nil
 }
 
+// ValidateBroker validates a message broker
+func ValidateBroker(broker string) error {
+	validBrokers := map[string]bool{
+		"kafka": true,
+		"nats":  true,
+		"":      true, // empty is allowed (will use the blueprint default)
+	}
+
+	if !validBrokers[broker] {
+		return fmt.Errorf("invalid broker '%s' (supported: kafka, nats)", broker)
+	}
+
+	return nil
+}
+
+// ValidateDeploymentTool validates the deployment tool of the lambda blueprint
+func ValidateDeploymentTool(tool string) error {
+	validTools := map[string]bool{
+		"sam":        true,
+		"serverless": true,
+		"":           true, // empty is allowed (will use the blueprint default)
+	}
+
+	if !validTools[tool] {
+		return fmt.Errorf("invalid deployment tool '%s' (supported: sam, serverless)", tool)
+	}
+
+	return nil
+}
+
+// ValidateLockoutStore validates the failed login store of the web-api blueprints
+func ValidateLockoutStore(store string) error {
+	validStores := map[string]bool{
+		"memory":   true,
+		"redis":    true,
+		"database": true,
+		"":         true, // empty is allowed (will use the blueprint default)
+	}
+
+	if !validStores[store] {
+		return fmt.Errorf("invalid lockout store '%s' (supported: memory, redis, database)", store)
+	}
+
+	return nil
+}
+
+// ValidateIDStrategy validates the primary keys of the generated models
+func ValidateIDStrategy(strategy string) error {
+	validStrategies := map[string]bool{
+		"serial":    true,
+		"uuidv7":    true,
+		"ulid":      true,
+		"snowflake": true,
+		"":          true, // empty is allowed (will use the blueprint default)
+	}
+
+	if !validStrategies[strategy] {
+		return fmt.Errorf("invalid ID strategy '%s' (supported: serial, uuidv7, ulid, snowflake)", strategy)
+	}
+
+	return nil
+}
+
+// ValidateEntrypoints validates the comma-separated binaries of the standard web-api,
+// which always include the server
+func ValidateEntrypoints(entrypoints string) error {
+	if entrypoints == "" {
+		return nil // empty is allowed (only the server is generated)
+	}
+
+	validEntrypoints := map[string]bool{
+		"server": true,
+		"cli":    true,
+		"worker": true,
+	}
+
+	seen := make(map[string]bool)
+	for _, entrypoint := range strings.Split(entrypoints, ",") {
+		if !validEntrypoints[entrypoint] {
+			return fmt.Errorf("invalid entrypoint '%s' (supported: server, cli, worker)", entrypoint)
+		}
+		if seen[entrypoint] {
+			return fmt.Errorf("entrypoint '%s' is listed twice", entrypoint)
+		}
+		seen[entrypoint] = true
+	}
+	if !seen["server"] {
+		return fmt.Errorf("invalid entrypoints '%s': the server is always generated, add it (server,cli,worker)", entrypoints)
+	}
+
+	return nil
+}
+
+// ValidateSLOSpecs validates the comma-separated specifications the SLOs of the
+// standard web-api are written in, next to their Prometheus rules
+func ValidateSLOSpecs(specs string) error {
+	if specs == "" || specs == "none" {
+		return nil // empty is allowed (will use the blueprint default)
+	}
+
+	validSpecs := map[string]bool{
+		"openslo": true,
+		"sloth":   true,
+	}
+
+	seen := make(map[string]bool)
+	for _, spec := range strings.Split(specs, ",") {
+		if !validSpecs[spec] {
+			return fmt.Errorf("invalid SLO specification '%s' (supported: openslo, sloth, none)", spec)
+		}
+		if seen[spec] {
+			return fmt.Errorf("SLO specification '%s' is listed twice", spec)
+		}
+		seen[spec] = true
+	}
+
+	return nil
+}
+
+// ValidateDI validates the dependency injection of the web-api containers
+func ValidateDI(di string) error {
+	validStyles := map[string]bool{
+		"manual": true,
+		"wire":   true,
+		"fx":     true,
+		"do":     true,
+		"":       true, // empty is allowed (will use the blueprint default)
+	}
+
+	if !validStyles[di] {
+		return fmt.Errorf("invalid dependency injection '%s' (supported: manual, wire, fx, do)", di)
+	}
+
+	return nil
+}
+
+// ValidateCI validates the CI provider of the generated project
+func ValidateCI(provider string) error {
+	validProviders := map[string]bool{
+		"github": true,
+		"none":   true,
+		"":       true, // empty is allowed (keeps the workflows of the blueprint)
+	}
+
+	if !validProviders[provider] {
+		return fmt.Errorf("invalid CI provider '%s' (supported: github, none)", provider)
+	}
+
+	return nil
+}
+
+// ValidateTelemetryEndpoint validates the endpoint of the opt-in telemetry module
+func ValidateTelemetryEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return nil // empty leaves the telemetry module out
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid telemetry endpoint '%s' (must be an http or https URL)", endpoint)
+	}
+
+	return nil
+}
+
 // ValidateLogLevel validates a log level
 func ValidateLogLevel(level string) error {
 	validLevels := map[string]bool{
@@ -356,3 +529,163 @@ func ValidateFeatures(features map[string]interface{}) error {
 
 	return nil
 }
+
+// ValidateRefreshTokenStore validates the refresh token store of the web-api blueprints
+func ValidateRefreshTokenStore(store string) error {
+	validStores := map[string]bool{
+		"database": true,
+		"redis":    true,
+		"memory":   true,
+		"":         true, // empty is allowed (will use the blueprint default)
+	}
+
+	if !validStores[store] {
+		return fmt.Errorf("invalid refresh token store '%s' (supported: database, redis, memory)", store)
+	}
+
+	return nil
+}
+
+// ValidateJWTAlgorithm validates the algorithm of the JWT signing keys of the web-api blueprints
+func ValidateJWTAlgorithm(algorithm string) error {
+	validAlgorithms := map[string]bool{
+		"RS256": true,
+		"EdDSA": true,
+		"":      true, // empty is allowed (will use the blueprint default)
+	}
+
+	if !validAlgorithms[algorithm] {
+		return fmt.Errorf("invalid JWT algorithm '%s' (supported: RS256, EdDSA)", algorithm)
+	}
+
+	return nil
+}
+
+// ValidateClientSDK validates the languages of the API clients of the web-api blueprints
+func ValidateClientSDK(languages string) error {
+	validLanguages := map[string]bool{
+		"go":            true,
+		"go,typescript": true,
+		"":              true, // empty is allowed (no client is generated)
+	}
+
+	if !validLanguages[languages] {
+		return fmt.Errorf("invalid client SDK '%s' (supported: go, go,typescript)", languages)
+	}
+
+	return nil
+}
+
+// ValidateAPISurfaces validates the presentation adapters of the hexagonal web-api
+func ValidateAPISurfaces(surfaces string) error {
+	validSurfaces := map[string]bool{
+		"rest":         true,
+		"rest,graphql": true,
+		"":             true, // empty is allowed (will use the blueprint default)
+	}
+
+	if !validSurfaces[surfaces] {
+		return fmt.Errorf("invalid API surfaces '%s' (supported: rest, rest,graphql)", surfaces)
+	}
+
+	return nil
+}
+
+// ValidatePlatform validates the chat platform of the bot blueprint
+func ValidatePlatform(platform string) error {
+	validPlatforms := map[string]bool{
+		"slack":   true,
+		"discord": true,
+		"":        true, // empty is allowed (will use the blueprint default)
+	}
+
+	if !validPlatforms[platform] {
+		return fmt.Errorf("invalid platform '%s' (supported: slack, discord)", platform)
+	}
+
+	return nil
+}
+
+// ValidatePubSub validates the message fan-out of the realtime blueprint
+func ValidatePubSub(pubsub string) error {
+	validPubSubs := map[string]bool{
+		"memory": true,
+		"redis":  true,
+		"":       true, // empty is allowed (will use the blueprint default)
+	}
+
+	if !validPubSubs[pubsub] {
+		return fmt.Errorf("invalid pubsub '%s' (supported: memory, redis)", pubsub)
+	}
+
+	return nil
+}
+
+// ValidateSchemaFormat validates the event schema format of the event-service blueprint
+func ValidateSchemaFormat(format string) error {
+	validFormats := map[string]bool{
+		"avro":        true,
+		"protobuf":    true,
+		"json-schema": true,
+		"":            true, // empty is allowed (plain JSON events)
+	}
+
+	if !validFormats[format] {
+		return fmt.Errorf("invalid schema format '%s' (supported: avro, protobuf, json-schema)", format)
+	}
+
+	return nil
+}
+
+// ValidateSaga validates the saga role of the event-service blueprint
+func ValidateSaga(role string) error {
+	validRoles := map[string]bool{
+		"orchestrator": true,
+		"participant":  true,
+		"":             true, // empty is allowed (no saga)
+	}
+
+	if !validRoles[role] {
+		return fmt.Errorf("invalid saga role '%s' (supported: orchestrator, participant)", role)
+	}
+
+	return nil
+}
+
+// ValidateCoordination validates the distributed locks of the clean web-api blueprint
+func ValidateCoordination(coordination string) error {
+	validCoordinations := map[string]bool{
+		"redis":    true,
+		"postgres": true,
+		"":         true, // empty is allowed (no locks)
+	}
+
+	if !validCoordinations[coordination] {
+		return fmt.Errorf("invalid coordination '%s' (supported: redis, postgres)", coordination)
+	}
+
+	return nil
+}
+
+// codeOwnerPattern matches the GitHub users (@user) and teams (@org/team) of CODEOWNERS
+var codeOwnerPattern = regexp.MustCompile(`^@[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?(?:/[a-zA-Z0-9._-]+)?$`)
+
+// ValidateTeam validates the comma-separated code owners of the generated repository
+func ValidateTeam(team string) error {
+	if team == "" {
+		return nil // empty leaves code ownership out
+	}
+
+	for _, owner := range strings.Split(team, ",") {
+		owner = strings.TrimSpace(owner)
+		if codeOwnerPattern.MatchString(owner) {
+			continue
+		}
+		if address, err := mail.ParseAddress(owner); err == nil && address.Address == owner {
+			continue
+		}
+		return fmt.Errorf("invalid code owner '%s' (use @org/team, @user or an email)", owner)
+	}
+
+	return nil
+}
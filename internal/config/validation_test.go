@@ -273,6 +273,261 @@ func TestValidateLogger(t *testing.T) {
 	}
 }
 
+func TestValidateBroker(t *testing.T) {
+	tests := []struct {
+		name          string
+		broker        string
+		shouldError   bool
+		errorContains string
+	}{
+		{
+			name:        "valid kafka broker",
+			broker:      "kafka",
+			shouldError: false,
+		},
+		{
+			name:        "valid nats broker",
+			broker:      "nats",
+			shouldError: false,
+		},
+		{
+			name:        "empty broker uses the blueprint default",
+			broker:      "",
+			shouldError: false,
+		},
+		{
+			name:          "unsupported broker",
+			broker:        "rabbitmq",
+			shouldError:   true,
+			errorContains: "invalid broker 'rabbitmq'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBroker(tt.broker)
+
+			if tt.shouldError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDeploymentTool(t *testing.T) {
+	for _, tool := range []string{"", "sam", "serverless"} {
+		assert.NoError(t, ValidateDeploymentTool(tool), tool)
+	}
+
+	err := ValidateDeploymentTool("terraform")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid deployment tool 'terraform'")
+}
+
+func TestValidateLockoutStore(t *testing.T) {
+	for _, store := range []string{"", "memory", "redis", "database"} {
+		assert.NoError(t, ValidateLockoutStore(store), store)
+	}
+
+	err := ValidateLockoutStore("file")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid lockout store 'file'")
+}
+
+func TestValidateDI(t *testing.T) {
+	for _, di := range []string{"", "manual", "wire", "fx", "do"} {
+		assert.NoError(t, ValidateDI(di), di)
+	}
+
+	err := ValidateDI("dig")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid dependency injection 'dig'")
+}
+
+func TestValidateCI(t *testing.T) {
+	for _, provider := range []string{"", "github", "none"} {
+		assert.NoError(t, ValidateCI(provider), provider)
+	}
+
+	err := ValidateCI("jenkins")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid CI provider 'jenkins'")
+}
+
+func TestValidateIDStrategy(t *testing.T) {
+	for _, strategy := range []string{"", "serial", "uuidv7", "ulid", "snowflake"} {
+		assert.NoError(t, ValidateIDStrategy(strategy), strategy)
+	}
+
+	err := ValidateIDStrategy("uuidv4")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid ID strategy 'uuidv4'")
+}
+
+func TestValidateRefreshTokenStore(t *testing.T) {
+	for _, store := range []string{"", "database", "redis", "memory"} {
+		assert.NoError(t, ValidateRefreshTokenStore(store), store)
+	}
+
+	err := ValidateRefreshTokenStore("cookie")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid refresh token store 'cookie'")
+}
+
+func TestValidateJWTAlgorithm(t *testing.T) {
+	for _, algorithm := range []string{"", "RS256", "EdDSA"} {
+		assert.NoError(t, ValidateJWTAlgorithm(algorithm), algorithm)
+	}
+
+	err := ValidateJWTAlgorithm("HS256")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid JWT algorithm 'HS256'")
+}
+
+func TestValidatePlatform(t *testing.T) {
+	for _, platform := range []string{"", "slack", "discord"} {
+		assert.NoError(t, ValidatePlatform(platform), platform)
+	}
+
+	err := ValidatePlatform("teams")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid platform 'teams'")
+}
+
+func TestValidatePubSub(t *testing.T) {
+	for _, pubsub := range []string{"", "memory", "redis"} {
+		assert.NoError(t, ValidatePubSub(pubsub), pubsub)
+	}
+
+	err := ValidatePubSub("kafka")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid pubsub 'kafka'")
+}
+
+func TestValidateSchemaFormat(t *testing.T) {
+	for _, format := range []string{"", "avro", "protobuf", "json-schema"} {
+		assert.NoError(t, ValidateSchemaFormat(format), format)
+	}
+
+	err := ValidateSchemaFormat("thrift")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid schema format 'thrift'")
+}
+
+func TestValidateSaga(t *testing.T) {
+	for _, role := range []string{"", "orchestrator", "participant"} {
+		assert.NoError(t, ValidateSaga(role), role)
+	}
+
+	err := ValidateSaga("choreography")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid saga role 'choreography'")
+}
+
+func TestValidateCoordination(t *testing.T) {
+	for _, coordination := range []string{"", "redis", "postgres"} {
+		assert.NoError(t, ValidateCoordination(coordination), coordination)
+	}
+
+	err := ValidateCoordination("etcd")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid coordination 'etcd'")
+}
+
+func TestValidateClientSDK(t *testing.T) {
+	for _, languages := range []string{"", "go", "go,typescript"} {
+		assert.NoError(t, ValidateClientSDK(languages), languages)
+	}
+
+	err := ValidateClientSDK("typescript")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid client SDK 'typescript'")
+}
+
+func TestValidateAPISurfaces(t *testing.T) {
+	for _, surfaces := range []string{"", "rest", "rest,graphql"} {
+		assert.NoError(t, ValidateAPISurfaces(surfaces), surfaces)
+	}
+
+	err := ValidateAPISurfaces("graphql")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid API surfaces 'graphql'")
+}
+
+func TestValidateEntrypoints(t *testing.T) {
+	for _, entrypoints := range []string{"", "server", "server,cli", "server,cli,worker", "worker,server"} {
+		assert.NoError(t, ValidateEntrypoints(entrypoints), entrypoints)
+	}
+
+	invalid := map[string]string{
+		"server,admin":   "invalid entrypoint 'admin'",
+		"server, cli":    "invalid entrypoint ' cli'",
+		"server,cli,cli": "entrypoint 'cli' is listed twice",
+		"cli,worker":     "the server is always generated",
+		"server,,worker": "invalid entrypoint ''",
+	}
+	for entrypoints, message := range invalid {
+		err := ValidateEntrypoints(entrypoints)
+		if assert.Error(t, err, entrypoints) {
+			assert.Contains(t, err.Error(), message)
+		}
+	}
+}
+
+func TestValidateSLOSpecs(t *testing.T) {
+	for _, specs := range []string{"", "none", "openslo", "sloth", "openslo,sloth"} {
+		assert.NoError(t, ValidateSLOSpecs(specs), specs)
+	}
+
+	invalid := map[string]string{
+		"prometheus":     "invalid SLO specification 'prometheus'",
+		"openslo,none":   "invalid SLO specification 'none'",
+		"sloth,sloth":    "SLO specification 'sloth' is listed twice",
+		"openslo, sloth": "invalid SLO specification ' sloth'",
+	}
+	for specs, message := range invalid {
+		err := ValidateSLOSpecs(specs)
+		if assert.Error(t, err, specs) {
+			assert.Contains(t, err.Error(), message)
+		}
+	}
+}
+
+func TestValidateTelemetryEndpoint(t *testing.T) {
+	valid := []string{"", "https://telemetry.example.com/pings", "http://collector.internal:8080/v1/pings"}
+	invalid := []string{"telemetry.example.com", "ftp://example.com/pings", "https://", "://bad"}
+
+	for _, endpoint := range valid {
+		assert.NoError(t, ValidateTelemetryEndpoint(endpoint), endpoint)
+	}
+	for _, endpoint := range invalid {
+		err := ValidateTelemetryEndpoint(endpoint)
+		assert.Error(t, err, endpoint)
+		if err != nil {
+			assert.Contains(t, err.Error(), "invalid telemetry endpoint")
+		}
+	}
+}
+
+func TestValidateTeam(t *testing.T) {
+	valid := []string{"", "@acme/payments", "@octocat", "@acme/payments, @acme/platform-sre", "owner@example.com"}
+	invalid := []string{"acme/payments", "@acme/", "@-octocat", "@acme/payments,", "Jane <jane@example.com>", "@acme/pay ments"}
+
+	for _, team := range valid {
+		assert.NoError(t, ValidateTeam(team), team)
+	}
+	for _, team := range invalid {
+		err := ValidateTeam(team)
+		assert.Error(t, err, team)
+		if err != nil {
+			assert.Contains(t, err.Error(), "invalid code owner")
+		}
+	}
+}
+
 func TestValidateAuthor(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -483,6 +738,16 @@ func TestValidateTemplateType(t *testing.T) {
 			templateType: "lambda",
 			shouldError:  false,
 		},
+		{
+			name:         "valid web-app template",
+			templateType: "web-app",
+			shouldError:  false,
+		},
+		{
+			name:         "valid realtime template",
+			templateType: "realtime",
+			shouldError:  false,
+		},
 
 		// Invalid template types
 		{
@@ -493,7 +758,7 @@ func TestValidateTemplateType(t *testing.T) {
 		},
 		{
 			name:          "unsupported template type should error",
-			templateType:  "desktop",
+			templateType:  "mobile",
 			shouldError:   true,
 			errorContains: "invalid template type",
 		},
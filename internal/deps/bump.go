@@ -0,0 +1,140 @@
+package deps
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Update moves every pin of Module at version From to version To
+type Update struct {
+	Module string `json:"module"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+// UpdatesFromReports turns outdated reports into updates to their latest version
+func UpdatesFromReports(reports []Report) []Update {
+	var updates []Update
+	for _, report := range reports {
+		if report.Outdated && report.Latest != "" {
+			updates = append(updates, Update{Module: report.Module, From: report.Version, To: report.Latest})
+		}
+	}
+	return updates
+}
+
+// Bump rewrites the pinned versions in the blueprint sources below root.
+// It returns the changed files and the IDs of the blueprints they belong to.
+func Bump(root string, snapshot *Snapshot, updates []Update) (files []string, blueprints []string, err error) {
+	byKey := make(map[string]Update, len(updates))
+	for _, update := range updates {
+		byKey[update.Module+"@"+update.From] = update
+	}
+
+	// Group the updates that apply to each source file
+	perFile := make(map[string][]Update)
+	touched := make(map[string]bool)
+	for _, pin := range snapshot.Pins {
+		update, ok := byKey[pin.Key()]
+		if !ok {
+			continue
+		}
+		file := filepath.Join(root, filepath.FromSlash(pin.Source))
+		perFile[file] = append(perFile[file], update)
+		touched[pin.Blueprint] = true
+	}
+
+	for file, fileUpdates := range perFile {
+		changed, err := rewriteFile(file, fileUpdates)
+		if err != nil {
+			return nil, nil, err
+		}
+		if changed {
+			files = append(files, file)
+		}
+	}
+
+	for id := range touched {
+		blueprints = append(blueprints, id)
+	}
+	sort.Strings(files)
+	sort.Strings(blueprints)
+	return files, blueprints, nil
+}
+
+// rewriteFile applies updates to a go.mod template or a YAML dependency list
+func rewriteFile(file string, updates []Update) (bool, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", file, err)
+	}
+
+	content := string(data)
+	var updated string
+	if strings.HasSuffix(file, ".yaml") || strings.HasSuffix(file, ".yml") {
+		updated = rewriteYAMLDependencies(content, updates)
+	} else {
+		updated = rewriteGoModTemplate(content, updates)
+	}
+
+	if updated == content {
+		return false, nil
+	}
+
+	info, err := os.Stat(file)
+	if err != nil {
+		return false, fmt.Errorf("failed to stat %s: %w", file, err)
+	}
+	if err := os.WriteFile(file, []byte(updated), info.Mode().Perm()); err != nil {
+		return false, fmt.Errorf("failed to write %s: %w", file, err)
+	}
+	return true, nil
+}
+
+// rewriteGoModTemplate replaces `module vFrom` occurrences in a go.mod template
+func rewriteGoModTemplate(content string, updates []Update) string {
+	for _, update := range updates {
+		pattern := regexp.MustCompile(`(?m)^(\s*(?:require\s+)?` + regexp.QuoteMeta(update.Module) + `\s+)` + regexp.QuoteMeta(update.From) + `(\s|$)`)
+		content = pattern.ReplaceAllString(content, "${1}"+update.To+"${2}")
+	}
+	return content
+}
+
+// yamlModulePattern matches a `module:` key in a dependency list entry
+var yamlModulePattern = regexp.MustCompile(`^\s*-?\s*module:\s*"?([^"\s]+)"?\s*$`)
+
+// yamlVersionPattern matches a `version:` key in a dependency list entry
+var yamlVersionPattern = regexp.MustCompile(`^(\s*version:\s*"?)([^"\s]+)("?\s*)$`)
+
+// rewriteYAMLDependencies replaces the version following a matching module entry
+func rewriteYAMLDependencies(content string, updates []Update) string {
+	lines := strings.Split(content, "\n")
+	currentModule := ""
+
+	for i, line := range lines {
+		if match := yamlModulePattern.FindStringSubmatch(line); match != nil {
+			currentModule = match[1]
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), "- ") || (line != "" && line[0] != ' ' && line[0] != '\t') {
+			currentModule = ""
+			continue
+		}
+		match := yamlVersionPattern.FindStringSubmatch(line)
+		if match == nil || currentModule == "" {
+			continue
+		}
+		for _, update := range updates {
+			if update.Module == currentModule && update.From == match[2] {
+				lines[i] = match[1] + update.To + match[3]
+				break
+			}
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,247 @@
+package deps
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// DefaultProxyURL is the public Go module proxy queried for latest versions
+const DefaultProxyURL = "https://proxy.golang.org"
+
+// ErrModuleNotFound is returned by ProxyClient.Latest for modules the proxy does not know
+var ErrModuleNotFound = errors.New("module not found")
+
+// ProxyClient looks up module versions on a Go module proxy
+type ProxyClient struct {
+	BaseURL    string
+	HTTPClient *http.Client
+
+	cache map[string]string
+	mutex sync.Mutex
+}
+
+// NewProxyClient creates a proxy client for the given base URL
+func NewProxyClient(baseURL string) *ProxyClient {
+	if baseURL == "" {
+		baseURL = DefaultProxyURL
+	}
+	return &ProxyClient{
+		BaseURL:    strings.TrimSuffix(baseURL, "/"),
+		HTTPClient: &http.Client{Timeout: 15 * time.Second},
+		cache:      make(map[string]string),
+	}
+}
+
+// Latest returns the latest released version of module known to the proxy
+func (c *ProxyClient) Latest(ctx context.Context, module string) (string, error) {
+	c.mutex.Lock()
+	if version, ok := c.cache[module]; ok {
+		c.mutex.Unlock()
+		return version, nil
+	}
+	c.mutex.Unlock()
+
+	url := fmt.Sprintf("%s/%s/@latest", c.BaseURL, escapeModulePath(module))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build proxy request: %w", err)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("proxy lookup for %s failed: %w", module, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusGone:
+		return "", fmt.Errorf("proxy lookup for %s returned %s: %w", module, resp.Status, ErrModuleNotFound)
+	default:
+		return "", fmt.Errorf("proxy lookup for %s returned %s", module, resp.Status)
+	}
+
+	var info struct {
+		Version string `json:"Version"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return "", fmt.Errorf("failed to decode proxy response for %s: %w", module, err)
+	}
+
+	c.mutex.Lock()
+	c.cache[module] = info.Version
+	c.mutex.Unlock()
+
+	return info.Version, nil
+}
+
+// GoProxy returns the first proxy URL of a GOPROXY list, the default proxy when the
+// list is empty, and "" when the list starts with off or direct
+func GoProxy(list string) string {
+	proxies := strings.FieldsFunc(list, func(r rune) bool { return r == ',' || r == '|' })
+	if len(proxies) == 0 {
+		return DefaultProxyURL
+	}
+	first := strings.TrimSpace(proxies[0])
+	if first == "off" || first == "direct" {
+		return ""
+	}
+	return first
+}
+
+// escapeModulePath applies the module proxy case-encoding (uppercase letters become !lower)
+func escapeModulePath(module string) string {
+	var b strings.Builder
+	for _, r := range module {
+		if unicode.IsUpper(r) {
+			b.WriteByte('!')
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Report describes the freshness and vulnerability status of a pinned module version
+type Report struct {
+	Module          string   `json:"module"`
+	Version         string   `json:"version"`
+	Latest          string   `json:"latest,omitempty"`
+	Outdated        bool     `json:"outdated"`
+	Vulnerabilities []string `json:"vulnerabilities,omitempty"`
+	Blueprints      []string `json:"blueprints"`
+	Error           string   `json:"error,omitempty"`
+}
+
+// VulnerabilityScanner reports known vulnerabilities for a set of pins, keyed by module@version
+type VulnerabilityScanner interface {
+	Scan(ctx context.Context, pins []Pin) (map[string][]string, error)
+}
+
+// Checker combines proxy lookups and an optional vulnerability scan
+type Checker struct {
+	Proxy   *ProxyClient
+	Scanner VulnerabilityScanner
+}
+
+// Check reports the status of every unique module@version in the snapshot
+func (c *Checker) Check(ctx context.Context, snapshot *Snapshot) ([]Report, error) {
+	byKey := make(map[string]*Report)
+	for _, pin := range snapshot.Pins {
+		report, ok := byKey[pin.Key()]
+		if !ok {
+			report = &Report{Module: pin.Module, Version: pin.Version}
+			byKey[pin.Key()] = report
+		}
+		if !containsString(report.Blueprints, pin.Blueprint) {
+			report.Blueprints = append(report.Blueprints, pin.Blueprint)
+		}
+	}
+
+	if c.Proxy != nil {
+		for _, report := range byKey {
+			latest, err := c.Proxy.Latest(ctx, report.Module)
+			if err != nil {
+				report.Error = err.Error()
+				continue
+			}
+			report.Latest = latest
+			report.Outdated = CompareVersions(report.Version, latest) < 0
+		}
+	}
+
+	if c.Scanner != nil {
+		findings, err := c.Scanner.Scan(ctx, snapshot.Pins)
+		if err != nil {
+			return nil, fmt.Errorf("vulnerability scan failed: %w", err)
+		}
+		for key, ids := range findings {
+			if report, ok := byKey[key]; ok {
+				report.Vulnerabilities = ids
+			}
+		}
+	}
+
+	reports := make([]Report, 0, len(byKey))
+	for _, report := range byKey {
+		sort.Strings(report.Blueprints)
+		reports = append(reports, *report)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].Module != reports[j].Module {
+			return reports[i].Module < reports[j].Module
+		}
+		return CompareVersions(reports[i].Version, reports[j].Version) < 0
+	})
+
+	return reports, nil
+}
+
+// CompareVersions compares two semantic versions ("v1.2.3", "v1.2.3-rc.1").
+// It returns -1, 0 or 1 like strings.Compare.
+func CompareVersions(a, b string) int {
+	coreA, preA := splitVersion(a)
+	coreB, preB := splitVersion(b)
+
+	for i := 0; i < 3; i++ {
+		if coreA[i] != coreB[i] {
+			if coreA[i] < coreB[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case preA == preB:
+		return 0
+	case preA == "":
+		return 1
+	case preB == "":
+		return -1
+	case preA < preB:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// splitVersion parses the numeric core and prerelease part of a version
+func splitVersion(version string) ([3]int, string) {
+	var core [3]int
+	version = strings.TrimPrefix(version, "v")
+	if idx := strings.Index(version, "+"); idx >= 0 {
+		version = version[:idx]
+	}
+
+	prerelease := ""
+	if idx := strings.Index(version, "-"); idx >= 0 {
+		prerelease = version[idx+1:]
+		version = version[:idx]
+	}
+
+	for i, part := range strings.SplitN(version, ".", 3) {
+		n, _ := strconv.Atoi(part)
+		core[i] = n
+	}
+	return core, prerelease
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,183 @@
+package deps
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeScanner map[string][]string
+
+func (f fakeScanner) Scan(_ context.Context, _ []Pin) (map[string][]string, error) {
+	return f, nil
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"v1.2.3", "v1.2.3", 0},
+		{"v1.2.3", "v1.2.4", -1},
+		{"v1.10.0", "v1.9.9", 1},
+		{"v2.0.0", "v1.99.0", 1},
+		{"v1.0.0-rc.1", "v1.0.0", -1},
+		{"v1.0.0", "v1.0.0-rc.1", 1},
+		{"v0.0.0-20230101-abcdef", "v0.0.0-20240101-abcdef", -1},
+		{"v1.2.3+incompatible", "v1.2.3", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.want, CompareVersions(tt.a, tt.b))
+		})
+	}
+}
+
+func TestProxyClient_Latest(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/github.com/!alec!aivazis/survey/v2/@latest":
+			_, _ = w.Write([]byte(`{"Version":"v2.3.7"}`))
+		case "/github.com/spf13/cobra/@latest":
+			_, _ = w.Write([]byte(`{"Version":"v1.9.1"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewProxyClient(server.URL)
+
+	version, err := client.Latest(context.Background(), "github.com/AlecAivazis/survey/v2")
+	require.NoError(t, err)
+	assert.Equal(t, "v2.3.7", version)
+
+	_, err = client.Latest(context.Background(), "github.com/spf13/cobra")
+	require.NoError(t, err)
+	_, err = client.Latest(context.Background(), "github.com/spf13/cobra")
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests, "latest versions should be cached")
+
+	_, err = client.Latest(context.Background(), "example.com/missing")
+	assert.ErrorIs(t, err, ErrModuleNotFound)
+}
+
+func TestGoProxy(t *testing.T) {
+	assert.Equal(t, DefaultProxyURL, GoProxy(""))
+	assert.Equal(t, "https://goproxy.io", GoProxy("https://goproxy.io,direct"))
+	assert.Equal(t, "https://corp.example.com", GoProxy("https://corp.example.com|https://proxy.golang.org"))
+	assert.Empty(t, GoProxy("off"))
+	assert.Empty(t, GoProxy("direct"))
+}
+
+func TestChecker_Check(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/github.com/spf13/cobra/@latest":
+			_, _ = w.Write([]byte(`{"Version":"v1.9.1"}`))
+		case "/github.com/stretchr/testify/@latest":
+			_, _ = w.Write([]byte(`{"Version":"v1.8.4"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	snapshot := &Snapshot{Pins: []Pin{
+		{Blueprint: "cli", Module: "github.com/spf13/cobra", Version: "v1.8.0"},
+		{Blueprint: "web-api", Module: "github.com/spf13/cobra", Version: "v1.8.0"},
+		{Blueprint: "cli", Module: "github.com/stretchr/testify", Version: "v1.8.4"},
+		{Blueprint: "cli", Module: "example.com/missing", Version: "v0.1.0"},
+	}}
+
+	checker := &Checker{
+		Proxy:   NewProxyClient(server.URL),
+		Scanner: fakeScanner{"github.com/spf13/cobra@v1.8.0": {"GO-2099-0001"}},
+	}
+
+	reports, err := checker.Check(context.Background(), snapshot)
+	require.NoError(t, err)
+	require.Len(t, reports, 3)
+
+	assert.Equal(t, "example.com/missing", reports[0].Module)
+	assert.NotEmpty(t, reports[0].Error)
+
+	assert.Equal(t, "github.com/spf13/cobra", reports[1].Module)
+	assert.True(t, reports[1].Outdated)
+	assert.Equal(t, "v1.9.1", reports[1].Latest)
+	assert.Equal(t, []string{"cli", "web-api"}, reports[1].Blueprints)
+	assert.Equal(t, []string{"GO-2099-0001"}, reports[1].Vulnerabilities)
+
+	assert.False(t, reports[2].Outdated)
+	assert.Equal(t, []Update{{Module: "github.com/spf13/cobra", From: "v1.8.0", To: "v1.9.1"}}, UpdatesFromReports(reports))
+}
+
+func TestParseGovulncheckJSON(t *testing.T) {
+	stream := `{"config":{"protocol_version":"v1.0.0"}}
+{"osv":{"id":"GO-2024-0001"}}
+{
+  "finding": {
+    "osv": "GO-2024-0001",
+    "fixed_version": "v1.9.2",
+    "trace": [{"module": "github.com/gin-gonic/gin", "version": "v1.9.1"}]
+  }
+}
+{"finding":{"osv":"GO-2024-0001","trace":[{"module":"github.com/gin-gonic/gin","version":"v1.9.1"}]}}`
+
+	findings, err := parseGovulncheckJSON(strings.NewReader(stream))
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"github.com/gin-gonic/gin@v1.9.1": {"GO-2024-0001"}}, findings)
+}
+
+func TestSplitLayers(t *testing.T) {
+	layers := splitLayers([]Pin{
+		{Module: "a", Version: "v1.0.0"},
+		{Module: "a", Version: "v1.1.0"},
+		{Module: "b", Version: "v1.0.0"},
+		{Module: "a", Version: "v1.0.0"},
+	})
+	require.Len(t, layers, 2)
+	assert.Len(t, layers[0], 2)
+	assert.Len(t, layers[1], 1)
+}
+
+func TestBump(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "web-api-standard")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "config"), 0755))
+
+	goMod := "module {{.ModulePath}}\n\nrequire (\n\tgithub.com/gin-gonic/gin v1.9.1\n\tgithub.com/gin-gonic/gin-extra v1.9.1\n)\n"
+	deps := "dependencies:\n  - module: \"github.com/gin-gonic/gin\"\n    version: \"v1.9.1\"\n    condition: \"{{eq .Framework \\\"gin\\\"}}\"\n  - module: \"github.com/spf13/viper\"\n    version: \"v1.9.1\"\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod.tmpl"), []byte(goMod), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config", "dependencies.yaml"), []byte(deps), 0644))
+
+	snapshot := &Snapshot{Pins: []Pin{
+		{Blueprint: "web-api", Module: "github.com/gin-gonic/gin", Version: "v1.9.1", Source: "web-api-standard/go.mod.tmpl"},
+		{Blueprint: "web-api", Module: "github.com/gin-gonic/gin", Version: "v1.9.1", Source: "web-api-standard/config/dependencies.yaml"},
+	}}
+
+	files, blueprints, err := Bump(root, snapshot, []Update{{Module: "github.com/gin-gonic/gin", From: "v1.9.1", To: "v1.10.1"}})
+	require.NoError(t, err)
+	assert.Len(t, files, 2)
+	assert.Equal(t, []string{"web-api"}, blueprints)
+
+	updatedGoMod, err := os.ReadFile(filepath.Join(dir, "go.mod.tmpl"))
+	require.NoError(t, err)
+	assert.Contains(t, string(updatedGoMod), "github.com/gin-gonic/gin v1.10.1\n")
+	assert.Contains(t, string(updatedGoMod), "github.com/gin-gonic/gin-extra v1.9.1\n")
+
+	updatedDeps, err := os.ReadFile(filepath.Join(dir, "config", "dependencies.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(updatedDeps), "version: \"v1.10.1\"")
+	assert.Contains(t, string(updatedDeps), "module: \"github.com/spf13/viper\"\n    version: \"v1.9.1\"")
+}
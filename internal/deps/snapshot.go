@@ -0,0 +1,214 @@
+package deps
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// SnapshotVersion is the format version written into snapshot files
+const SnapshotVersion = 1
+
+// requireLinePattern matches a single `module version` requirement inside a go.mod template
+var requireLinePattern = regexp.MustCompile(`^\s*(?:require\s+)?([A-Za-z0-9][A-Za-z0-9._~\-]*\.[A-Za-z0-9._~\-/]+)\s+(v\d+\.\d+\.\d+[0-9A-Za-z.\-+]*)\s*(?://.*)?$`)
+
+// Pin is a single dependency version pinned by a blueprint. Source is the file
+// declaring it, relative to the blueprints root.
+type Pin struct {
+	Blueprint string `json:"blueprint"`
+	Module    string `json:"module"`
+	Version   string `json:"version"`
+	Source    string `json:"source"`
+}
+
+// Key returns the module@version identifier of the pin
+func (p Pin) Key() string {
+	return p.Module + "@" + p.Version
+}
+
+// Snapshot is the full set of dependency pins across all blueprints
+type Snapshot struct {
+	Version int   `json:"version"`
+	Pins    []Pin `json:"pins"`
+}
+
+// Modules returns the unique module@version keys in the snapshot, sorted
+func (s *Snapshot) Modules() []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, pin := range s.Pins {
+		if !seen[pin.Key()] {
+			seen[pin.Key()] = true
+			keys = append(keys, pin.Key())
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Scan collects every dependency pin declared by the blueprints in fsys, both from
+// template.yaml dependency lists and from the require blocks of go.mod templates
+func Scan(fsys fs.FS) (*Snapshot, error) {
+	loader := templates.NewTemplateLoaderWithFS(fsys)
+	blueprints, err := loader.LoadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load blueprints: %w", err)
+	}
+
+	snapshot := &Snapshot{Version: SnapshotVersion}
+	seen := make(map[Pin]bool)
+	add := func(pin Pin) {
+		if !seen[pin] {
+			seen[pin] = true
+			snapshot.Pins = append(snapshot.Pins, pin)
+		}
+	}
+
+	for _, blueprint := range blueprints {
+		dir, _ := blueprint.Metadata["path"].(string)
+
+		for _, dep := range blueprint.Dependencies {
+			if dep.Version == "" {
+				continue
+			}
+			add(Pin{
+				Blueprint: blueprint.ID,
+				Module:    dep.Module,
+				Version:   dep.Version,
+				Source:    path.Join(dir, dependencySource(blueprint.Include)),
+			})
+		}
+
+		pins, err := scanGoModTemplates(fsys, dir, blueprint.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, pin := range pins {
+			add(pin)
+		}
+	}
+
+	sort.Slice(snapshot.Pins, func(i, j int) bool {
+		a, b := snapshot.Pins[i], snapshot.Pins[j]
+		if a.Blueprint != b.Blueprint {
+			return a.Blueprint < b.Blueprint
+		}
+		if a.Module != b.Module {
+			return a.Module < b.Module
+		}
+		if a.Source != b.Source {
+			return a.Source < b.Source
+		}
+		return a.Version < b.Version
+	})
+
+	return snapshot, nil
+}
+
+// dependencySource returns the blueprint-relative file declaring template dependencies
+func dependencySource(include *types.TemplateIncludes) string {
+	if include != nil && include.Dependencies != "" {
+		return include.Dependencies
+	}
+	return "template.yaml"
+}
+
+// scanGoModTemplates parses all go.mod templates below dir
+func scanGoModTemplates(fsys fs.FS, dir, blueprintID string) ([]Pin, error) {
+	var pins []Pin
+
+	err := fs.WalkDir(fsys, dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || path.Base(p) != "go.mod.tmpl" {
+			return nil
+		}
+
+		file, err := fsys.Open(p)
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", p, err)
+		}
+		defer func() { _ = file.Close() }()
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.Contains(line, "{{") {
+				continue
+			}
+			match := requireLinePattern.FindStringSubmatch(line)
+			if match == nil {
+				continue
+			}
+			pins = append(pins, Pin{
+				Blueprint: blueprintID,
+				Module:    match[1],
+				Version:   match[2],
+				Source:    p,
+			})
+		}
+		return scanner.Err()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan go.mod templates of %s: %w", blueprintID, err)
+	}
+
+	return pins, nil
+}
+
+// LoadSnapshot reads a snapshot previously written with WriteSnapshot
+func LoadSnapshot(file string) (*Snapshot, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// WriteSnapshot writes the snapshot as indented JSON
+func WriteSnapshot(file string, snapshot *Snapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	if err := os.WriteFile(file, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// Diff compares two snapshots and returns the pins that were added and removed
+func Diff(previous, current *Snapshot) (added, removed []Pin) {
+	before := make(map[Pin]bool, len(previous.Pins))
+	for _, pin := range previous.Pins {
+		before[pin] = true
+	}
+	after := make(map[Pin]bool, len(current.Pins))
+	for _, pin := range current.Pins {
+		after[pin] = true
+		if !before[pin] {
+			added = append(added, pin)
+		}
+	}
+	for _, pin := range previous.Pins {
+		if !after[pin] {
+			removed = append(removed, pin)
+		}
+	}
+	return added, removed
+}
@@ -0,0 +1,80 @@
+package deps
+
+import (
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBlueprintsFS() fstest.MapFS {
+	return fstest.MapFS{
+		"cli-simple/template.yaml": &fstest.MapFile{Data: []byte(`
+id: cli-simple
+name: cli-simple
+type: cli
+dependencies:
+  - module: "github.com/spf13/cobra"
+    version: "v1.8.0"
+  - module: "github.com/example/unversioned"
+`)},
+		"cli-simple/go.mod.tmpl": &fstest.MapFile{Data: []byte(`module {{.ModulePath}}
+
+go {{.GoVersion}}
+
+require (
+	github.com/spf13/cobra v1.8.0
+{{- if eq .Logger "zap"}}
+	go.uber.org/zap v1.26.0 // indirect
+{{- end}}
+)
+
+require github.com/stretchr/testify v1.8.4
+`)},
+		"shared/README.md": &fstest.MapFile{Data: []byte("# shared")},
+	}
+}
+
+func TestScan(t *testing.T) {
+	snapshot, err := Scan(testBlueprintsFS())
+	require.NoError(t, err)
+
+	assert.Equal(t, SnapshotVersion, snapshot.Version)
+	assert.Equal(t, []Pin{
+		{Blueprint: "cli-simple", Module: "github.com/spf13/cobra", Version: "v1.8.0", Source: "cli-simple/go.mod.tmpl"},
+		{Blueprint: "cli-simple", Module: "github.com/spf13/cobra", Version: "v1.8.0", Source: "cli-simple/template.yaml"},
+		{Blueprint: "cli-simple", Module: "github.com/stretchr/testify", Version: "v1.8.4", Source: "cli-simple/go.mod.tmpl"},
+		{Blueprint: "cli-simple", Module: "go.uber.org/zap", Version: "v1.26.0", Source: "cli-simple/go.mod.tmpl"},
+	}, snapshot.Pins)
+	assert.Equal(t, []string{
+		"github.com/spf13/cobra@v1.8.0",
+		"github.com/stretchr/testify@v1.8.4",
+		"go.uber.org/zap@v1.26.0",
+	}, snapshot.Modules())
+}
+
+func TestSnapshotRoundTripAndDiff(t *testing.T) {
+	snapshot, err := Scan(testBlueprintsFS())
+	require.NoError(t, err)
+
+	file := filepath.Join(t.TempDir(), "snapshot.json")
+	require.NoError(t, WriteSnapshot(file, snapshot))
+
+	loaded, err := LoadSnapshot(file)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, loaded)
+
+	added, removed := Diff(loaded, snapshot)
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+
+	changed := &Snapshot{Version: SnapshotVersion, Pins: append([]Pin{}, snapshot.Pins...)}
+	changed.Pins[0].Version = "v1.9.0"
+	added, removed = Diff(loaded, changed)
+	require.Len(t, added, 1)
+	require.Len(t, removed, 1)
+	assert.Equal(t, "v1.9.0", added[0].Version)
+	assert.Equal(t, "v1.8.0", removed[0].Version)
+}
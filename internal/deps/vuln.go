@@ -0,0 +1,166 @@
+package deps
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// GovulncheckScanner runs govulncheck in module mode against synthetic modules
+// requiring the pinned versions
+type GovulncheckScanner struct {
+	Binary string
+}
+
+// NewGovulncheckScanner creates a scanner using govulncheck from PATH
+func NewGovulncheckScanner() *GovulncheckScanner {
+	return &GovulncheckScanner{Binary: "govulncheck"}
+}
+
+// Available reports whether the govulncheck binary can be found
+func (s *GovulncheckScanner) Available() bool {
+	_, err := exec.LookPath(s.Binary)
+	return err == nil
+}
+
+// Scan checks all pins for known vulnerabilities. Pins are split into layers so that
+// each synthetic go.mod requires at most one version of every module.
+func (s *GovulncheckScanner) Scan(ctx context.Context, pins []Pin) (map[string][]string, error) {
+	if !s.Available() {
+		return nil, fmt.Errorf("%s not found in PATH (install with: go install golang.org/x/vuln/cmd/govulncheck@latest)", s.Binary)
+	}
+
+	findings := make(map[string][]string)
+	for _, layer := range splitLayers(pins) {
+		layerFindings, err := s.scanLayer(ctx, layer)
+		if err != nil {
+			return nil, err
+		}
+		for key, ids := range layerFindings {
+			findings[key] = appendUnique(findings[key], ids...)
+		}
+	}
+
+	for key := range findings {
+		sort.Strings(findings[key])
+	}
+	return findings, nil
+}
+
+// splitLayers groups pins so no layer contains two versions of the same module
+func splitLayers(pins []Pin) [][]Pin {
+	var layers [][]Pin
+	var used []map[string]bool
+	seen := make(map[string]bool)
+
+	for _, pin := range pins {
+		if seen[pin.Key()] {
+			continue
+		}
+		seen[pin.Key()] = true
+
+		placed := false
+		for i := range layers {
+			if !used[i][pin.Module] {
+				layers[i] = append(layers[i], pin)
+				used[i][pin.Module] = true
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			layers = append(layers, []Pin{pin})
+			used = append(used, map[string]bool{pin.Module: true})
+		}
+	}
+	return layers
+}
+
+// scanLayer writes a throwaway module for the layer and runs govulncheck on it
+func (s *GovulncheckScanner) scanLayer(ctx context.Context, layer []Pin) (map[string][]string, error) {
+	dir, err := os.MkdirTemp("", "go-starter-vulncheck-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scan directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	var gomod strings.Builder
+	gomod.WriteString("module example.com/go-starter-vulncheck\n\ngo 1.21\n\nrequire (\n")
+	for _, pin := range layer {
+		fmt.Fprintf(&gomod, "\t%s %s\n", pin.Module, pin.Version)
+	}
+	gomod.WriteString(")\n")
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(gomod.String()), 0644); err != nil {
+		return nil, fmt.Errorf("failed to write scan go.mod: %w", err)
+	}
+
+	download := exec.CommandContext(ctx, "go", "mod", "download")
+	download.Dir = dir
+	if output, err := download.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("go mod download failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	cmd := exec.CommandContext(ctx, s.Binary, "-scan", "module", "-format", "json")
+	cmd.Dir = dir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		// govulncheck exits non-zero when it finds vulnerabilities; only fail on empty output
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) || stdout.Len() == 0 {
+			return nil, fmt.Errorf("govulncheck failed: %v: %s", err, strings.TrimSpace(stderr.String()))
+		}
+	}
+
+	return parseGovulncheckJSON(&stdout)
+}
+
+// parseGovulncheckJSON extracts module@version -> OSV IDs from a govulncheck JSON stream
+func parseGovulncheckJSON(r io.Reader) (map[string][]string, error) {
+	type frame struct {
+		Module  string `json:"module"`
+		Version string `json:"version"`
+	}
+	type message struct {
+		Finding *struct {
+			OSV   string  `json:"osv"`
+			Trace []frame `json:"trace"`
+		} `json:"finding"`
+	}
+
+	findings := make(map[string][]string)
+	decoder := json.NewDecoder(r)
+	for {
+		var msg message
+		if err := decoder.Decode(&msg); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to parse govulncheck output: %w", err)
+		}
+		if msg.Finding == nil || len(msg.Finding.Trace) == 0 {
+			continue
+		}
+		top := msg.Finding.Trace[0]
+		key := top.Module + "@" + top.Version
+		findings[key] = appendUnique(findings[key], msg.Finding.OSV)
+	}
+	return findings, nil
+}
+
+func appendUnique(values []string, more ...string) []string {
+	for _, v := range more {
+		if !containsString(values, v) {
+			values = append(values, v)
+		}
+	}
+	return values
+}
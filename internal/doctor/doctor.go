@@ -0,0 +1,332 @@
+// Package doctor diagnoses the environment that go-starter and the projects it
+// generates rely on, and explains how to fix what is missing.
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/semver"
+
+	"github.com/francknouama/go-starter/internal/deps"
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/templates"
+)
+
+// Statuses of a Result
+const (
+	StatusOK   = "ok"
+	StatusWarn = "warn"
+	StatusFail = "fail"
+)
+
+// MinGoVersion is the oldest Go release the blueprints generate projects for
+const MinGoVersion = "1.21"
+
+// DefaultTimeout bounds each command and network lookup of a check
+const DefaultTimeout = 5 * time.Second
+
+// probeModule is looked up on the module proxy to check network access
+const probeModule = "golang.org/x/mod"
+
+// Result is the outcome of a check. Fix explains how to resolve a warning or
+// a failure: failures break go-starter, warnings break some generated projects.
+type Result struct {
+	Check   string `json:"check"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	// Details lists the individual problems behind the message
+	Details []string `json:"details,omitempty"`
+	Fix     string   `json:"fix,omitempty"`
+}
+
+// Doctor runs the checks. Its fields default to the real environment and are
+// replaced in tests.
+type Doctor struct {
+	// Run runs a command and returns its trimmed standard output
+	Run func(ctx context.Context, name string, args ...string) (string, error)
+	// LookPath finds an executable in PATH
+	LookPath func(file string) (string, error)
+	Getenv   func(key string) string
+	// Blueprints holds the blueprints checked for integrity
+	Blueprints fs.FS
+	HTTPClient *http.Client
+	Timeout    time.Duration
+}
+
+// New creates a doctor for the current environment and the blueprints go-starter ships
+func New() *Doctor {
+	return &Doctor{
+		Run:        runCommand,
+		LookPath:   exec.LookPath,
+		Getenv:     os.Getenv,
+		Blueprints: templates.GetTemplatesFS(),
+		HTTPClient: &http.Client{Timeout: DefaultTimeout},
+		Timeout:    DefaultTimeout,
+	}
+}
+
+func runCommand(ctx context.Context, name string, args ...string) (string, error) {
+	output, err := exec.CommandContext(ctx, name, args...).Output()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+		err = fmt.Errorf("%w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return strings.TrimSpace(string(output)), err
+}
+
+// Check runs every check in order
+func (d *Doctor) Check(ctx context.Context) []Result {
+	return []Result{
+		d.checkGo(ctx),
+		d.checkGit(ctx),
+		d.checkGoBin(ctx),
+		d.checkDocker(ctx),
+		d.checkNetwork(ctx),
+		d.checkBlueprints(),
+	}
+}
+
+// run runs a command within the timeout of a check
+func (d *Doctor) run(ctx context.Context, name string, args ...string) (string, error) {
+	if d.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.Timeout)
+		defer cancel()
+	}
+	return d.Run(ctx, name, args...)
+}
+
+var goVersionPattern = regexp.MustCompile(`go(\d+\.\d+(?:\.\d+)?)`)
+
+func (d *Doctor) checkGo(ctx context.Context) Result {
+	result := Result{Check: "go"}
+	output, err := d.run(ctx, "go", "version")
+	if err != nil {
+		result.Status = StatusFail
+		result.Message = fmt.Sprintf("go is not available: %v", err)
+		result.Fix = "Install Go from https://go.dev/dl/ and make sure the go command is in PATH"
+		return result
+	}
+
+	matches := goVersionPattern.FindStringSubmatch(output)
+	if matches == nil {
+		result.Status = StatusWarn
+		result.Message = fmt.Sprintf("could not read the Go version from %q", output)
+		return result
+	}
+	if semver.Compare("v"+matches[1], "v"+MinGoVersion) < 0 {
+		result.Status = StatusFail
+		result.Message = fmt.Sprintf("Go %s is older than Go %s, the oldest release the generated projects build with", matches[1], MinGoVersion)
+		result.Fix = "Upgrade Go from https://go.dev/dl/"
+		return result
+	}
+	result.Status = StatusOK
+	result.Message = "Go " + matches[1]
+	return result
+}
+
+func (d *Doctor) checkGit(ctx context.Context) Result {
+	result := Result{Check: "git"}
+	if _, err := d.LookPath("git"); err != nil {
+		result.Status = StatusWarn
+		result.Message = "git is not in PATH, projects are generated without a repository"
+		result.Fix = "Install git from https://git-scm.com/downloads, or pass --no-git to go-starter new"
+		return result
+	}
+
+	version, err := d.run(ctx, "git", "--version")
+	if err != nil {
+		result.Status = StatusWarn
+		result.Message = fmt.Sprintf("git does not run: %v", err)
+		result.Fix = "Reinstall git from https://git-scm.com/downloads"
+		return result
+	}
+
+	name, _ := d.run(ctx, "git", "config", "user.name")
+	email, _ := d.run(ctx, "git", "config", "user.email")
+	if name == "" || email == "" {
+		result.Status = StatusWarn
+		result.Message = version + ", but without an identity the first commit of a generated project fails"
+		result.Fix = `Run git config --global user.name "Your Name" and git config --global user.email you@example.com`
+		return result
+	}
+	result.Status = StatusOK
+	result.Message = version
+	return result
+}
+
+// checkGoBin checks that the binaries go install writes can be run, which the
+// Makefiles of generated projects rely on for their tools
+func (d *Doctor) checkGoBin(ctx context.Context) Result {
+	result := Result{Check: "gobin"}
+	// Read separately: an empty GOPATH line would be lost in the trimmed output
+	// of a single go env GOPATH GOBIN
+	gopath, err := d.run(ctx, "go", "env", "GOPATH")
+	var gobin string
+	if err == nil {
+		gobin, err = d.run(ctx, "go", "env", "GOBIN")
+	}
+	if err != nil {
+		result.Status = StatusWarn
+		result.Message = fmt.Sprintf("could not read GOPATH and GOBIN: %v", err)
+		result.Fix = "Fix the go command first"
+		return result
+	}
+
+	dir := gobin
+	if dir == "" {
+		if gopath == "" {
+			result.Status = StatusWarn
+			result.Message = "neither GOPATH nor GOBIN is set, go install has nowhere to write binaries"
+			result.Fix = "Set GOPATH, for example with go env -w GOPATH=$HOME/go"
+			return result
+		}
+		dir = filepath.Join(filepath.SplitList(gopath)[0], "bin")
+	}
+
+	if !slices.Contains(filepath.SplitList(d.Getenv("PATH")), dir) {
+		result.Status = StatusWarn
+		result.Message = fmt.Sprintf("%s is not in PATH, binaries installed with go install cannot be run by name", dir)
+		result.Fix = fmt.Sprintf(`Add it to PATH in your shell profile: export PATH="$PATH:%s"`, dir)
+		return result
+	}
+	result.Status = StatusOK
+	result.Message = "go install writes to " + dir + ", which is in PATH"
+	return result
+}
+
+// checkDocker checks the Docker daemon that testcontainers, the end-to-end suites
+// and the benchmarks of generated projects start their databases with
+func (d *Doctor) checkDocker(ctx context.Context) Result {
+	result := Result{Check: "docker"}
+	if _, err := d.LookPath("docker"); err != nil {
+		result.Status = StatusWarn
+		result.Message = "docker is not in PATH, the integration tests of generated projects cannot start their testcontainers"
+		result.Fix = "Install Docker from https://docs.docker.com/get-docker/"
+		return result
+	}
+
+	version, err := d.run(ctx, "docker", "info", "--format", "{{.ServerVersion}}")
+	if err != nil || version == "" {
+		result.Status = StatusWarn
+		result.Message = "the Docker daemon is not reachable, testcontainers cannot start containers"
+		result.Fix = "Start Docker, or point DOCKER_HOST at a running daemon"
+		return result
+	}
+	result.Status = StatusOK
+	result.Message = "Docker " + version
+	return result
+}
+
+// checkNetwork checks that the module proxy go mod tidy downloads from answers
+func (d *Doctor) checkNetwork(ctx context.Context) Result {
+	result := Result{Check: "network"}
+	goproxy, err := d.run(ctx, "go", "env", "GOPROXY")
+	if err != nil {
+		goproxy = d.Getenv("GOPROXY")
+	}
+
+	proxy := deps.GoProxy(goproxy)
+	if proxy == "" {
+		if strings.HasPrefix(strings.TrimSpace(goproxy), "off") {
+			result.Status = StatusWarn
+			result.Message = "GOPROXY=off, dependencies of generated projects cannot be downloaded"
+			result.Fix = "Unset GOPROXY, or set it to a reachable proxy such as https://proxy.golang.org"
+			return result
+		}
+		result.Status = StatusOK
+		result.Message = "GOPROXY=direct, modules are downloaded from their repositories"
+		return result
+	}
+
+	client := deps.NewProxyClient(proxy)
+	if d.HTTPClient != nil {
+		client.HTTPClient = d.HTTPClient
+	}
+	if d.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.Timeout)
+		defer cancel()
+	}
+	if _, err := client.Latest(ctx, probeModule); err != nil {
+		result.Status = StatusWarn
+		result.Message = fmt.Sprintf("%s is not reachable, go mod tidy fails in generated projects: %v", proxy, err)
+		result.Fix = "Check your connection; behind a corporate proxy set HTTPS_PROXY, or set GOPROXY to a reachable mirror"
+		return result
+	}
+	result.Status = StatusOK
+	result.Message = proxy + " is reachable"
+	return result
+}
+
+// checkBlueprints loads every blueprint and checks that its files exist and parse.
+// Broken blueprints are a warning as long as others can be generated.
+func (d *Doctor) checkBlueprints() Result {
+	result := Result{Check: "blueprints", Status: StatusFail, Fix: "Reinstall go-starter with go install github.com/francknouama/go-starter@latest"}
+	if d.Blueprints == nil {
+		result.Message = "no blueprints are available"
+		return result
+	}
+	registry, err := templates.NewRegistryWithFS(d.Blueprints)
+	if err != nil {
+		result.Message = fmt.Sprintf("the blueprints do not load: %v", err)
+		return result
+	}
+	blueprints := registry.List()
+	if len(blueprints) == 0 {
+		result.Message = "no blueprints are available"
+		return result
+	}
+
+	gen := generator.NewWithRegistry(registry)
+	var problems, broken []string
+	for _, tmpl := range blueprints {
+		before := len(problems)
+		dir, _ := tmpl.Metadata["path"].(string)
+		for _, file := range tmpl.Files {
+			if !registry.Loader().FileExists(dir, file.Source) {
+				problems = append(problems, fmt.Sprintf("%s: %s is missing", tmpl.ID, file.Source))
+			}
+		}
+		issues, err := gen.AnalyzeTemplateVariables(tmpl)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", tmpl.ID, err))
+			continue
+		}
+		for _, issue := range issues {
+			if issue.Kind == generator.IssueInvalidTemplate {
+				problems = append(problems, fmt.Sprintf("%s: %s", tmpl.ID, issue))
+			}
+		}
+		if len(problems) > before {
+			broken = append(broken, tmpl.ID)
+		}
+	}
+
+	if len(problems) > 0 {
+		slices.Sort(problems)
+		slices.Sort(broken)
+		result.Message = fmt.Sprintf("%d problems in %d of %d blueprints (%s), generating them fails", len(problems), len(broken), len(blueprints), strings.Join(broken, ", "))
+		result.Details = problems
+		if len(broken) < len(blueprints) {
+			result.Status = StatusWarn
+		}
+		result.Fix += ", and report the problems at https://github.com/francknouama/go-starter/issues if they remain"
+		return result
+	}
+	result.Status = StatusOK
+	result.Message = fmt.Sprintf("%d blueprints, every file present and parsing", len(blueprints))
+	result.Fix = ""
+	return result
+}
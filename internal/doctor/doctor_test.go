@@ -0,0 +1,159 @@
+package doctor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEnvironment answers commands from a table keyed by the command line, and
+// finds in PATH the executables it has commands for
+type fakeEnvironment map[string]string
+
+func (e fakeEnvironment) run(_ context.Context, name string, args ...string) (string, error) {
+	output, ok := e[strings.Join(append([]string{name}, args...), " ")]
+	if !ok {
+		return "", errors.New("exit status 1")
+	}
+	return output, nil
+}
+
+func (e fakeEnvironment) lookPath(file string) (string, error) {
+	for command := range e {
+		if strings.HasPrefix(command, file+" ") {
+			return "/usr/bin/" + file, nil
+		}
+	}
+	return "", errors.New("executable file not found in $PATH")
+}
+
+var testBlueprints = fstest.MapFS{
+	"cli/template.yaml": {Data: []byte("name: cli\ntype: cli\nfiles:\n  - source: main.go.tmpl\n    destination: main.go\n")},
+	"cli/main.go.tmpl":  {Data: []byte("package main\n")},
+}
+
+func newTestDoctor(t *testing.T, env fakeEnvironment, path string) *Doctor {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/golang.org/x/mod/@latest" {
+			_, _ = w.Write([]byte(`{"Version":"v0.20.0"}`))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	t.Cleanup(proxy.Close)
+	if _, ok := env["go env GOPROXY"]; !ok {
+		env["go env GOPROXY"] = proxy.URL + ",direct"
+	}
+
+	return &Doctor{
+		Run:        env.run,
+		LookPath:   env.lookPath,
+		Getenv:     func(key string) string { return map[string]string{"PATH": path}[key] },
+		Blueprints: testBlueprints,
+		HTTPClient: proxy.Client(),
+	}
+}
+
+func TestDoctor_Check_Healthy(t *testing.T) {
+	d := newTestDoctor(t, fakeEnvironment{
+		"go version":            "go version go1.24.1 linux/amd64",
+		"go env GOPATH":         "/home/dev/go",
+		"go env GOBIN":          "",
+		"git --version":         "git version 2.45.0",
+		"git config user.name":  "Dev",
+		"git config user.email": "dev@example.com",
+		"docker info --format {{.ServerVersion}}": "27.1.1",
+	}, "/usr/bin:/home/dev/go/bin")
+
+	results := d.Check(context.Background())
+	require.Len(t, results, 6)
+	for _, result := range results {
+		assert.Equal(t, StatusOK, result.Status, "%s: %s", result.Check, result.Message)
+		assert.Empty(t, result.Fix, result.Check)
+	}
+	assert.Equal(t, "Go 1.24.1", results[0].Message)
+	assert.Equal(t, "git version 2.45.0", results[1].Message)
+	assert.Equal(t, "go install writes to /home/dev/go/bin, which is in PATH", results[2].Message)
+	assert.Equal(t, "Docker 27.1.1", results[3].Message)
+	assert.Contains(t, results[4].Message, "is reachable")
+	assert.Equal(t, "1 blueprints, every file present and parsing", results[5].Message)
+}
+
+func TestDoctor_Check_Problems(t *testing.T) {
+	d := newTestDoctor(t, fakeEnvironment{
+		"go version":     "go version go1.19.3 linux/amd64",
+		"go env GOPATH":  "",
+		"go env GOBIN":   "/opt/gobin",
+		"go env GOPROXY": "off",
+		"git --version":  "git version 2.45.0",
+		"docker ps":      "",
+	}, "/usr/bin")
+	d.Blueprints = fstest.MapFS{
+		"cli/template.yaml": {Data: []byte("name: cli\ntype: cli\nfiles:\n  - source: main.go.tmpl\n    destination: main.go\n  - source: missing.tmpl\n    destination: x.go\n")},
+		"cli/main.go.tmpl":  {Data: []byte("package main\n{{ if }}\n")},
+	}
+
+	results := d.Check(context.Background())
+	require.Len(t, results, 6)
+
+	assert.Equal(t, StatusFail, results[0].Status)
+	assert.Contains(t, results[0].Message, "Go 1.19.3 is older than Go 1.21")
+
+	assert.Equal(t, StatusWarn, results[1].Status)
+	assert.Contains(t, results[1].Message, "without an identity")
+	assert.Contains(t, results[1].Fix, "git config --global user.email")
+
+	assert.Equal(t, StatusWarn, results[2].Status)
+	assert.Equal(t, `Add it to PATH in your shell profile: export PATH="$PATH:/opt/gobin"`, results[2].Fix)
+
+	assert.Equal(t, StatusWarn, results[3].Status)
+	assert.Contains(t, results[3].Message, "daemon is not reachable")
+
+	assert.Equal(t, StatusWarn, results[4].Status)
+	assert.Contains(t, results[4].Message, "GOPROXY=off")
+
+	assert.Equal(t, StatusFail, results[5].Status, "no blueprint can be generated")
+	assert.Equal(t, "2 problems in 1 of 1 blueprints (cli), generating them fails", results[5].Message)
+	require.Len(t, results[5].Details, 2)
+	assert.Contains(t, results[5].Details[0], "cli: main.go.tmpl")
+	assert.Equal(t, "cli: missing.tmpl is missing", results[5].Details[1])
+
+	// Other blueprints still work
+	d.Blueprints = fstest.MapFS{
+		"cli/template.yaml":     {Data: []byte("name: cli\ntype: cli\nfiles:\n  - source: missing.tmpl\n    destination: x.go\n")},
+		"library/template.yaml": {Data: []byte("name: library\ntype: library\nfiles:\n  - source: main.go.tmpl\n    destination: main.go\n")},
+		"library/main.go.tmpl":  {Data: []byte("package library\n")},
+	}
+	result := d.checkBlueprints()
+	assert.Equal(t, StatusWarn, result.Status)
+	assert.Equal(t, "1 problems in 1 of 2 blueprints (cli), generating them fails", result.Message)
+}
+
+func TestDoctor_Check_Missing(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer proxy.Close()
+
+	d := newTestDoctor(t, fakeEnvironment{"go env GOPROXY": proxy.URL}, "/usr/bin")
+	d.Blueprints = fstest.MapFS{}
+
+	results := d.Check(context.Background())
+	assert.Equal(t, StatusFail, results[0].Status)
+	assert.Contains(t, results[0].Fix, "https://go.dev/dl/")
+	assert.Equal(t, StatusWarn, results[1].Status)
+	assert.Contains(t, results[1].Fix, "--no-git")
+	assert.Equal(t, StatusWarn, results[3].Status)
+	assert.Contains(t, results[3].Message, "testcontainers")
+	assert.Equal(t, StatusWarn, results[4].Status)
+	assert.Contains(t, results[4].Message, "503")
+	assert.Equal(t, StatusFail, results[5].Status)
+	assert.Equal(t, "no blueprints are available", results[5].Message)
+}
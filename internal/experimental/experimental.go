@@ -0,0 +1,34 @@
+// Package experimental resolves which experimental blueprint features are enabled.
+// How often each one is used is recorded with the telemetry of the generations,
+// which is what maintainers look at when deciding whether a feature is ready to
+// graduate.
+package experimental
+
+import (
+	"os"
+	"sort"
+	"strings"
+)
+
+// EnvVar enables experimental features from the environment, comma separated
+const EnvVar = "GO_STARTER_EXPERIMENTAL"
+
+// Enabled merges the --experimental flag values with GO_STARTER_EXPERIMENTAL. Both
+// accept comma separated names; the result is sorted and free of duplicates.
+func Enabled(flags []string) []string {
+	values := append([]string{os.Getenv(EnvVar)}, flags...)
+
+	seen := make(map[string]bool)
+	var enabled []string
+	for _, value := range values {
+		for _, name := range strings.Split(value, ",") {
+			name = strings.TrimSpace(name)
+			if name != "" && !seen[name] {
+				seen[name] = true
+				enabled = append(enabled, name)
+			}
+		}
+	}
+	sort.Strings(enabled)
+	return enabled
+}
@@ -0,0 +1,17 @@
+package experimental
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnabled(t *testing.T) {
+	t.Setenv(EnvVar, "feature.outbox, framework.fuego")
+
+	enabled := Enabled([]string{"architecture.cqrs", "framework.fuego,feature.audit", " "})
+	assert.Equal(t, []string{"architecture.cqrs", "feature.audit", "feature.outbox", "framework.fuego"}, enabled)
+
+	t.Setenv(EnvVar, "")
+	assert.Empty(t, Enabled(nil))
+}
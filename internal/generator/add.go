@@ -0,0 +1,314 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Features go-starter add can add to a generated project
+const (
+	AddDatabase = "database"
+	AddAuth     = "auth"
+	AddDocker   = "docker"
+)
+
+// AddFeatures lists the features go-starter add can add, besides the switch
+// options of AddOptions
+var AddFeatures = []string{AddDatabase, AddAuth, AddDocker}
+
+// AddOptions lists the flags of the options set by a boolean flag, such as
+// observability, which go-starter add turns on in the projects whose blueprint
+// declares them
+func AddOptions() []string {
+	flags := make([]string, 0, len(switchOptions))
+	for variable := range switchOptions {
+		flags = append(flags, optionFlags[variable])
+	}
+	sort.Strings(flags)
+	return flags
+}
+
+// switchOption returns the variable of the switch option of flag
+func switchOption(flag string) (string, bool) {
+	for variable := range switchOptions {
+		if optionFlags[variable] == flag {
+			return variable, true
+		}
+	}
+	return "", false
+}
+
+// AddConflictSuffix is appended to the new version of a file the feature changes
+// but that was edited since generation
+const AddConflictSuffix = ".go-starter-new"
+
+// AddRequest is a feature to add to a generated project
+type AddRequest struct {
+	// Feature is one of AddFeatures or AddOptions
+	Feature string
+	// Value is the database driver or the authentication type
+	Value string
+	// ORM is the database ORM, empty for the blueprint default
+	ORM string
+}
+
+// AddPlan lists the changes adding a feature makes to a generated project
+type AddPlan struct {
+	Project   string `json:"project"`
+	Blueprint string `json:"blueprint"`
+	Feature   string `json:"feature"`
+	// Create lists the files the feature adds
+	Create []string `json:"create"`
+	// Update lists the files the feature changes that are still as generated
+	Update []string `json:"update"`
+	// Merge lists the files the feature changes that are merged instead, such as
+	// the requirements of go.mod
+	Merge []string `json:"merge"`
+	// Conflicts lists the files the feature changes that were edited since
+	// generation. They are kept and their new version is written next to them
+	// with AddConflictSuffix.
+	Conflicts []string `json:"conflicts"`
+
+	manifest Manifest
+	lock     GeneratedFile
+	files    map[string]GeneratedFile
+}
+
+// Empty reports whether the plan changes nothing
+func (p *AddPlan) Empty() bool {
+	return len(p.Create)+len(p.Update)+len(p.Merge)+len(p.Conflicts) == 0
+}
+
+// PlanAdd works out how adding a feature changes the project at projectPath. The
+// blueprint and configuration come from the generation manifest of the project:
+// its blueprint is rendered with and without the feature, and only the files the
+// feature adds or changes are planned.
+func (g *Generator) PlanAdd(ctx context.Context, projectPath string, request AddRequest) (*AddPlan, error) {
+	manifest, err := ReadManifest(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := g.registry.Get(manifest.Blueprint)
+	if err != nil {
+		return nil, fmt.Errorf("blueprint %s of the project is not available in this version of go-starter: %w", manifest.Blueprint, err)
+	}
+
+	config, err := addFeature(tmpl, manifest.Config, g.createTemplateContext(manifest.Config, tmpl), request)
+	if err != nil {
+		return nil, err
+	}
+
+	current := manifest.Config
+	before, err := g.GenerateInMemoryFiles(ctx, &current, manifest.Blueprint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render the project as generated: %w", err)
+	}
+	after, err := g.GenerateInMemoryFiles(ctx, &config, manifest.Blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []string
+	for file, generated := range after {
+		if isMetadataFile(file) {
+			continue
+		}
+		if request.Feature == AddDocker {
+			if isDockerFile(file) {
+				candidates = append(candidates, file)
+			}
+			continue
+		}
+		if previous, ok := before[file]; !ok || !sameFile(previous, generated) {
+			candidates = append(candidates, file)
+		}
+	}
+	if request.Feature == AddDocker && len(candidates) == 0 {
+		return nil, fmt.Errorf("blueprint %s has no docker files", manifest.Blueprint)
+	}
+	sort.Strings(candidates)
+
+	manifest.Config = config
+	plan := &AddPlan{
+		Project:   projectPath,
+		Blueprint: manifest.Blueprint,
+		Feature:   request.Feature,
+		Create:    []string{},
+		Update:    []string{},
+		Merge:     []string{},
+		Conflicts: []string{},
+		manifest:  *manifest,
+		lock:      after[LockFile],
+		files:     make(map[string]GeneratedFile),
+	}
+	for _, file := range candidates {
+		generated := after[file]
+		existing, err := readProjectFile(projectPath, file)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			plan.Create = append(plan.Create, file)
+		case err != nil:
+			return nil, types.NewFileSystemError("failed to read "+file, err)
+		case sameFile(existing, generated):
+			continue
+		case request.Feature == AddDocker:
+			// Docker files are only restored when missing
+			continue
+		case file == "go.mod":
+			merged, err := mergeGoMod(existing.Content, generated.Content, false)
+			if err != nil {
+				return nil, err
+			}
+			if bytes.Equal(merged, existing.Content) {
+				continue
+			}
+			plan.Merge = append(plan.Merge, file)
+			generated = GeneratedFile{Content: merged, Mode: existing.Mode}
+		case sameFile(existing, before[file]):
+			plan.Update = append(plan.Update, file)
+		default:
+			plan.Conflicts = append(plan.Conflicts, file)
+		}
+		plan.files[file] = generated
+	}
+	return plan, nil
+}
+
+// ApplyAdd writes the changes of plan to the project and records the feature in
+// its generation manifest and lock
+func (g *Generator) ApplyAdd(plan *AddPlan) error {
+	manifest := plan.manifest
+	manifest.Files = maps.Clone(manifest.Files)
+	if manifest.Files == nil {
+		manifest.Files = make(map[string]string)
+	}
+
+	for _, file := range slices.Concat(plan.Create, plan.Update, plan.Merge) {
+		if err := g.writeProjectFile(plan.Project, file, plan.files[file]); err != nil {
+			return err
+		}
+		manifest.Files[file] = checksum(plan.files[file])
+	}
+	for _, file := range plan.Conflicts {
+		if err := g.writeProjectFile(plan.Project, file+AddConflictSuffix, plan.files[file]); err != nil {
+			return err
+		}
+	}
+	if err := g.writeManifest(plan.Project, manifest); err != nil {
+		return err
+	}
+	return g.writeProjectFile(plan.Project, LockFile, plan.lock)
+}
+
+// addFeature returns config with the feature of request added, after checking the
+// blueprint offers it and the project, rendered with context, does not have it yet
+func addFeature(tmpl types.Template, config types.ProjectConfig, context map[string]any, request AddRequest) (types.ProjectConfig, error) {
+	features := types.Features{}
+	if config.Features != nil {
+		features = *config.Features
+	}
+	variables := make(map[string]string, len(config.Variables)+2)
+	for name, value := range config.Variables {
+		variables[name] = value
+	}
+
+	switch request.Feature {
+	case AddDatabase:
+		if err := checkAddChoice(tmpl, "DatabaseDriver", "a database", request.Value); err != nil {
+			return config, err
+		}
+		if driver, _ := context["DatabaseDriver"].(string); driver != "" {
+			return config, fmt.Errorf("the project already has a %s database", driver)
+		}
+		if request.ORM != "" {
+			if err := checkAddChoice(tmpl, "DatabaseORM", "a database ORM", request.ORM); err != nil {
+				return config, err
+			}
+		}
+		features.Database.Driver = request.Value
+		features.Database.ORM = request.ORM
+		variables["DatabaseDriver"] = request.Value
+		variables["DatabaseORM"] = request.ORM
+	case AddAuth:
+		if request.ORM != "" {
+			return config, fmt.Errorf("--orm only applies to %s", AddDatabase)
+		}
+		if err := checkAddChoice(tmpl, "AuthType", "authentication", request.Value); err != nil {
+			return config, err
+		}
+		if authType, _ := context["AuthType"].(string); authType != "" && authType != "none" {
+			return config, fmt.Errorf("the project already has %s authentication", authType)
+		}
+		features.Authentication.Type = request.Value
+		variables["AuthType"] = request.Value
+	case AddDocker:
+		if request.Value != "" || request.ORM != "" {
+			return config, fmt.Errorf("%s takes no value", AddDocker)
+		}
+	default:
+		variable, ok := switchOption(request.Feature)
+		if !ok {
+			return config, fmt.Errorf("unknown feature %q, expected one of %s", request.Feature, strings.Join(slices.Concat(AddFeatures, AddOptions()), ", "))
+		}
+		if request.Value != "" || request.ORM != "" {
+			return config, fmt.Errorf("%s takes no value", request.Feature)
+		}
+		if !slices.ContainsFunc(tmpl.Variables, func(v types.TemplateVariable) bool { return v.Name == variable }) {
+			return config, fmt.Errorf("blueprint %s does not offer %s", tmpl.ID, request.Feature)
+		}
+		if fmt.Sprint(context[variable]) == "true" {
+			return config, fmt.Errorf("the project already has %s", request.Feature)
+		}
+		variables[variable] = "true"
+	}
+
+	config.Features = &features
+	config.Variables = variables
+	return config, nil
+}
+
+// checkAddChoice checks the blueprint declares variable and offers value for it
+func checkAddChoice(tmpl types.Template, variable, feature, value string) error {
+	for _, v := range tmpl.Variables {
+		if v.Name != variable {
+			continue
+		}
+		if value == "" {
+			return fmt.Errorf("%s needs a value, one of %s", feature, strings.Join(nonEmpty(v.Choices), ", "))
+		}
+		if len(v.Choices) > 0 && !slices.Contains(v.Choices, value) {
+			return fmt.Errorf("blueprint %s does not offer %q for %s, expected one of %s", tmpl.ID, value, feature, strings.Join(nonEmpty(v.Choices), ", "))
+		}
+		return nil
+	}
+	return fmt.Errorf("blueprint %s does not offer %s", tmpl.ID, feature)
+}
+
+// isDockerFile reports whether a generated file belongs to the docker setup
+func isDockerFile(file string) bool {
+	name := strings.ToLower(path.Base(file))
+	return strings.HasPrefix(name, "dockerfile") ||
+		name == ".dockerignore" ||
+		strings.HasPrefix(name, "docker-compose") ||
+		strings.HasPrefix(name, "compose.")
+}
+
+func nonEmpty(values []string) []string {
+	var result []string
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,193 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func setupAddTestTemplates(t *testing.T) *templates.Registry {
+	t.Helper()
+
+	return newTestRegistry(t, fstest.MapFS{
+		"add-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "add-test"
+name: "add-test"
+type: "web-api"
+variables:
+  - name: "DatabaseDriver"
+    default: ""
+    choices: ["", "postgres", "sqlite"]
+  - name: "DatabaseORM"
+    default: ""
+    choices: ["", "gorm"]
+  - name: "Observability"
+    default: "false"
+files:
+  - source: "go.mod.tmpl"
+    destination: "go.mod"
+  - source: "main.go.tmpl"
+    destination: "main.go"
+  - source: "config.go.tmpl"
+    destination: "config.go"
+  - source: "routes.go.tmpl"
+    destination: "routes.go"
+  - source: "db.go.tmpl"
+    destination: "internal/db/db.go"
+    condition: "{{ne .DatabaseDriver \"\"}}"
+  - source: "Dockerfile.tmpl"
+    destination: "Dockerfile"
+  - source: "metrics.go.tmpl"
+    destination: "metrics.go"
+    condition: "{{eq .Observability \"true\"}}"
+`)},
+		"add-test/go.mod.tmpl":     &fstest.MapFile{Data: []byte("module {{.ModulePath}}\n\ngo 1.22\n\nrequire github.com/google/uuid v1.6.0\n{{if eq .DatabaseORM \"gorm\"}}\nrequire gorm.io/gorm v1.25.0\n{{end}}")},
+		"add-test/main.go.tmpl":    &fstest.MapFile{Data: []byte("package main\n")},
+		"add-test/config.go.tmpl":  &fstest.MapFile{Data: []byte("package main\n\nconst driver = \"{{.DatabaseDriver}}\"\n")},
+		"add-test/routes.go.tmpl":  &fstest.MapFile{Data: []byte("package main\n\n// database: {{.DatabaseDriver}}\n")},
+		"add-test/db.go.tmpl":      &fstest.MapFile{Data: []byte("package db\n\n// {{.DatabaseORM}}\n")},
+		"add-test/Dockerfile.tmpl": &fstest.MapFile{Data: []byte("FROM golang:1.22\n")},
+		"add-test/metrics.go.tmpl": &fstest.MapFile{Data: []byte("package main\n")},
+	})
+}
+
+func generateAddTestProject(t *testing.T, registry *templates.Registry) string {
+	t.Helper()
+
+	outputPath := filepath.Join(t.TempDir(), "svc")
+	_, err := NewWithRegistry(registry).Generate(types.ProjectConfig{
+		Name:      "svc",
+		Module:    "github.com/test/svc",
+		Type:      "web-api",
+		Variables: map[string]string{"blueprint_id": "add-test"},
+	}, types.GenerationOptions{OutputPath: outputPath, NoGit: true})
+	require.NoError(t, err)
+	return outputPath
+}
+
+func TestPlanAdd_Database(t *testing.T) {
+	registry := setupAddTestTemplates(t)
+	projectPath := generateAddTestProject(t, registry)
+
+	// config.go is edited since generation, routes.go is not
+	require.NoError(t, os.WriteFile(filepath.Join(projectPath, "config.go"), []byte("package main\n\nconst driver = \"\" // edited\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(projectPath, "go.mod"), []byte("module github.com/test/svc\n\ngo 1.22\n\nrequire (\n\tgithub.com/google/uuid v1.6.1\n\tgithub.com/stretchr/testify v1.10.0\n)\n"), 0644))
+
+	gen := NewWithRegistry(registry)
+	plan, err := gen.PlanAdd(context.Background(), projectPath, AddRequest{Feature: AddDatabase, Value: "postgres", ORM: "gorm"})
+	require.NoError(t, err)
+	assert.Equal(t, "add-test", plan.Blueprint)
+	assert.Equal(t, []string{"internal/db/db.go"}, plan.Create)
+	assert.Equal(t, []string{"routes.go"}, plan.Update)
+	assert.Equal(t, []string{"go.mod"}, plan.Merge)
+	assert.Equal(t, []string{"config.go"}, plan.Conflicts)
+
+	require.NoError(t, gen.ApplyAdd(plan))
+
+	db, err := os.ReadFile(filepath.Join(projectPath, "internal/db/db.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "package db\n\n// gorm\n", string(db))
+
+	routes, err := os.ReadFile(filepath.Join(projectPath, "routes.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(routes), "database: postgres")
+
+	config, err := os.ReadFile(filepath.Join(projectPath, "config.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(config), "// edited", "edited files are kept")
+	proposed, err := os.ReadFile(filepath.Join(projectPath, "config.go"+AddConflictSuffix))
+	require.NoError(t, err)
+	assert.Contains(t, string(proposed), `const driver = "postgres"`)
+
+	goMod, err := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+	require.NoError(t, err)
+	assert.Contains(t, string(goMod), "gorm.io/gorm v1.25.0")
+	assert.Contains(t, string(goMod), "github.com/google/uuid v1.6.1", "required versions are kept")
+	assert.Contains(t, string(goMod), "github.com/stretchr/testify v1.10.0")
+
+	manifest, err := ReadManifest(projectPath)
+	require.NoError(t, err)
+	assert.Equal(t, "postgres", manifest.Config.Features.Database.Driver)
+	assert.Equal(t, "gorm", manifest.Config.Variables["DatabaseORM"])
+
+	_, err = gen.PlanAdd(context.Background(), projectPath, AddRequest{Feature: AddDatabase, Value: "sqlite"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already has a postgres database")
+}
+
+func TestPlanAdd_Docker(t *testing.T) {
+	registry := setupAddTestTemplates(t)
+	projectPath := generateAddTestProject(t, registry)
+
+	plan, err := NewWithRegistry(registry).PlanAdd(context.Background(), projectPath, AddRequest{Feature: AddDocker})
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), "nothing to add while the docker files are there")
+
+	require.NoError(t, os.Remove(filepath.Join(projectPath, "Dockerfile")))
+	plan, err = NewWithRegistry(registry).PlanAdd(context.Background(), projectPath, AddRequest{Feature: AddDocker})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Dockerfile"}, plan.Create)
+	assert.Empty(t, plan.Update)
+
+	require.NoError(t, NewWithRegistry(registry).ApplyAdd(plan))
+	assert.FileExists(t, filepath.Join(projectPath, "Dockerfile"))
+}
+
+func TestPlanAdd_Option(t *testing.T) {
+	registry := setupAddTestTemplates(t)
+	projectPath := generateAddTestProject(t, registry)
+
+	plan, err := NewWithRegistry(registry).PlanAdd(context.Background(), projectPath, AddRequest{Feature: "observability"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"metrics.go"}, plan.Create)
+	require.NoError(t, NewWithRegistry(registry).ApplyAdd(plan))
+	assert.FileExists(t, filepath.Join(projectPath, "metrics.go"))
+
+	manifest, err := ReadManifest(projectPath)
+	require.NoError(t, err)
+	assert.Equal(t, "true", manifest.Config.Variables[ObservabilityVariable])
+	lock, err := ReadLock(projectPath)
+	require.NoError(t, err)
+	assert.Equal(t, "true", lock.Variables[ObservabilityVariable], "the lock follows the project")
+
+	_, err = NewWithRegistry(registry).PlanAdd(context.Background(), projectPath, AddRequest{Feature: "observability"})
+	assert.ErrorContains(t, err, "the project already has observability")
+}
+
+func TestPlanAdd_Rejects(t *testing.T) {
+	registry := setupAddTestTemplates(t)
+	projectPath := generateAddTestProject(t, registry)
+
+	tests := map[string]struct {
+		request AddRequest
+		message string
+	}{
+		"missing value":    {AddRequest{Feature: AddDatabase}, "a database needs a value, one of postgres, sqlite"},
+		"unknown choice":   {AddRequest{Feature: AddDatabase, Value: "oracle"}, `does not offer "oracle" for a database`},
+		"unknown ORM":      {AddRequest{Feature: AddDatabase, Value: "sqlite", ORM: "ent"}, `does not offer "ent" for a database ORM`},
+		"not offered":      {AddRequest{Feature: AddAuth, Value: "jwt"}, "blueprint add-test does not offer authentication"},
+		"docker value":     {AddRequest{Feature: AddDocker, Value: "alpine"}, "docker takes no value"},
+		"unknown feature":  {AddRequest{Feature: "cache"}, `unknown feature "cache"`},
+		"ORM without a db": {AddRequest{Feature: AddAuth, Value: "jwt", ORM: "gorm"}, "--orm only applies to database"},
+		"option value":     {AddRequest{Feature: "observability", Value: "prometheus"}, "observability takes no value"},
+		"option offered":   {AddRequest{Feature: "chaos"}, "blueprint add-test does not offer chaos"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewWithRegistry(registry).PlanAdd(context.Background(), projectPath, tt.request)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+
+	_, err := NewWithRegistry(registry).PlanAdd(context.Background(), t.TempDir(), AddRequest{Feature: AddDocker})
+	require.Error(t, err, "projects without a manifest are rejected")
+}
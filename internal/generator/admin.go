@@ -0,0 +1,38 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// AdminEndpointsVariable is the blueprint variable that turns on the role-guarded
+// admin user endpoints. Blueprints offer them by declaring it.
+const AdminEndpointsVariable = "AdminEndpoints"
+
+// checkAdminEndpoints rejects admin endpoints for blueprints that do not offer them,
+// and for projects without the users and authentication they manage
+func checkAdminEndpoints(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[AdminEndpointsVariable] != "true" {
+		return nil
+	}
+
+	declared := false
+	for _, variable := range tmpl.Variables {
+		if variable.Name == AdminEndpointsVariable {
+			declared = true
+			break
+		}
+	}
+	if !declared {
+		return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer admin endpoints, remove --admin-endpoints", tmpl.ID), nil)
+	}
+
+	if config.Features == nil || !config.Features.Database.HasDatabase() {
+		return types.NewValidationError("admin endpoints manage stored users and need a database, set --database-driver", nil)
+	}
+	if auth := config.Features.Authentication.Type; auth == "" || auth == "none" {
+		return types.NewValidationError("admin endpoints are role-guarded and need authentication, set --auth-type", nil)
+	}
+	return nil
+}
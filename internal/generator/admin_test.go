@@ -0,0 +1,66 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_AdminEndpoints(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(admin, driver, auth string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:         "inventory",
+			Module:       "github.com/test/inventory",
+			Type:         "web-api",
+			Architecture: "hexagonal",
+			Framework:    "gin",
+			Logger:       "slog",
+			Variables:    map[string]string{AdminEndpointsVariable: admin},
+			Features: &types.Features{
+				Database:       types.DatabaseConfig{Driver: driver, ORM: "gorm"},
+				Authentication: types.AuthConfig{Type: auth},
+			},
+		}
+	}
+
+	t.Run("endpoints are left out by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("false", "postgres", "jwt"), "web-api-hexagonal")
+		require.NoError(t, err)
+		assert.NotContains(t, files, "internal/adapters/primary/http/admin_handler.go")
+		assert.NotContains(t, files, "migrations/002_add_user_roles.up.sql")
+	})
+
+	t.Run("flag adds guarded admin routes and the role migration", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("true", "postgres", "jwt"), "web-api-hexagonal")
+		require.NoError(t, err)
+		require.Contains(t, files, "internal/application/services/admin_service.go")
+		require.Contains(t, files, "migrations/002_add_user_roles.up.sql")
+
+		routes := string(files["internal/adapters/primary/http/gin_adapter.go"].Content)
+		assert.Contains(t, routes, `adminRoutes.POST("/:id/disable", g.adaptHandler(adminHandler.Guard(adminHandler.HandleDisable)))`)
+		assert.Contains(t, string(files["internal/adapters/primary/http/middleware/auth.go"].Content), "config.AdminPort.Authorize(")
+	})
+
+	t.Run("endpoints need a database and authentication", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("true", "", "jwt"), "web-api-hexagonal")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "need a database")
+
+		_, err = New().GenerateInMemoryFiles(ctx, config("true", "postgres", ""), "web-api-hexagonal")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "need authentication")
+	})
+
+	t.Run("blueprints without admin endpoints reject the flag", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("true", "postgres", "jwt"), "grpc-service")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer admin endpoints")
+	})
+}
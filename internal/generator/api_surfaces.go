@@ -0,0 +1,38 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// APISurfacesVariable is the blueprint variable that lists the presentation
+// adapters driving the user service: rest, or rest,graphql to serve GraphQL next
+// to the REST routes. Blueprints offer other surfaces than REST by declaring it.
+const APISurfacesVariable = "APISurfaces"
+
+// checkAPISurfaces rejects GraphQL for blueprints that do not offer it, and for
+// projects without the database of the users it serves
+func checkAPISurfaces(tmpl types.Template, config types.ProjectConfig) error {
+	surfaces := config.Variables[APISurfacesVariable]
+	if surfaces == "" || surfaces == "rest" {
+		return nil
+	}
+
+	declared := false
+	for _, variable := range tmpl.Variables {
+		if variable.Name == APISurfacesVariable {
+			declared = true
+			break
+		}
+	}
+	if !declared {
+		return types.NewValidationError(fmt.Sprintf("blueprint %s only serves REST, remove --api-surfaces", tmpl.ID), nil)
+	}
+
+	if strings.Contains(surfaces, "graphql") && (config.Features == nil || !config.Features.Database.HasDatabase()) {
+		return types.NewValidationError("the GraphQL surface serves the stored users and needs a database, set --database-driver", nil)
+	}
+	return nil
+}
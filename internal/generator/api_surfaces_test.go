@@ -0,0 +1,75 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_APISurfaces(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(surfaces, framework, driver string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:         "inventory",
+			Module:       "github.com/test/inventory",
+			Type:         "web-api",
+			Architecture: "hexagonal",
+			Framework:    framework,
+			Logger:       "slog",
+			Variables:    map[string]string{APISurfacesVariable: surfaces},
+			Features: &types.Features{
+				Database: types.DatabaseConfig{Driver: driver, ORM: "gorm"},
+			},
+		}
+	}
+
+	t.Run("REST alone by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("", "gin", "postgres"), "web-api-hexagonal")
+		require.NoError(t, err)
+		assert.NotContains(t, files, "internal/adapters/primary/graphql/resolver.go")
+		assert.NotContains(t, string(files["go.mod"].Content), "graphql-go")
+		assert.NotContains(t, string(files["internal/adapters/primary/http/gin_adapter.go"].Content), "/graphql")
+	})
+
+	t.Run("GraphQL next to REST on the same port", func(t *testing.T) {
+		routes := map[string]string{
+			"gin":  `api.Any("/graphql", gin.WrapH(graphql.NewHandler(g.userPort, g.logger)))`,
+			"chi":  `r.Handle("/graphql", graphql.NewHandler(c.userPort, c.logger))`,
+			"echo": `api.Any("/graphql", echo.WrapHandler(graphql.NewHandler(e.userPort, e.logger)))`,
+		}
+		for framework, route := range routes {
+			files, err := New().GenerateInMemoryFiles(ctx, config("rest,graphql", framework, "postgres"), "web-api-hexagonal")
+			require.NoError(t, err, framework)
+			require.Contains(t, files, "internal/adapters/primary/graphql/schema.graphql")
+			require.Contains(t, files, "internal/adapters/primary/graphql/resolver_test.go")
+
+			adapter := string(files["internal/adapters/primary/http/"+framework+"_adapter.go"].Content)
+			assert.Contains(t, adapter, route, framework)
+			assert.Contains(t, adapter, `"github.com/test/inventory/internal/adapters/primary/graphql"`, framework)
+			assert.Contains(t, adapter, "NewUserHandler(", "the REST routes stay")
+
+			resolver := string(files["internal/adapters/primary/graphql/resolver.go"].Content)
+			assert.Contains(t, resolver, "r.userPort.ListUsers(ctx, req)")
+			assert.Contains(t, string(files["internal/adapters/primary/graphql/schema.graphql"].Content), "createUser(input: CreateUserInput!): User!")
+			assert.Contains(t, string(files["go.mod"].Content), "github.com/graph-gophers/graphql-go")
+		}
+	})
+
+	t.Run("GraphQL needs a database", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("rest,graphql", "gin", ""), "web-api-hexagonal")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "needs a database")
+	})
+
+	t.Run("blueprints serving REST only reject GraphQL", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("rest,graphql", "gin", "postgres"), "web-api-clean")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "only serves REST")
+	})
+}
@@ -0,0 +1,64 @@
+package generator
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// isBinaryContent reports whether content cannot be a text template: it contains
+// NUL bytes or is not valid UTF-8
+func isBinaryContent(content []byte) bool {
+	return bytes.IndexByte(content, 0) >= 0 || !utf8.Valid(content)
+}
+
+// isBinaryAsset reports whether a blueprint file is copied verbatim instead of rendered
+func isBinaryAsset(file types.TemplateFile, content []byte) bool {
+	return file.Binary || isBinaryContent(content)
+}
+
+// verifyChecksum compares content against the SHA-256 digest declared for the file
+func verifyChecksum(file types.TemplateFile, content []byte) error {
+	if file.SHA256 == "" {
+		return nil
+	}
+
+	sum := sha256.Sum256(content)
+	actual := hex.EncodeToString(sum[:])
+	if !strings.EqualFold(actual, file.SHA256) {
+		return types.NewGenerationError(fmt.Sprintf("integrity check failed for %s: expected sha256 %s, got %s", file.Source, file.SHA256, actual), nil)
+	}
+	return nil
+}
+
+// loadSource loads a blueprint file, verifies its declared checksum and reports
+// whether it is a binary asset
+func (g *Generator) loadSource(templateDir string, file types.TemplateFile) ([]byte, bool, error) {
+	content, err := g.loader.LoadFile(templateDir, file.Source)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to load template file: %w", err)
+	}
+	if err := verifyChecksum(file, content); err != nil {
+		return nil, false, err
+	}
+	return content, isBinaryAsset(file, content), nil
+}
+
+// verifyTemplateAssets checks every declared checksum before any file is written
+func (g *Generator) verifyTemplateAssets(tmpl types.Template) error {
+	templateDir, _ := tmpl.Metadata["path"].(string)
+	for _, file := range tmpl.Files {
+		if file.SHA256 == "" {
+			continue
+		}
+		if _, _, err := g.loadSource(templateDir, file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
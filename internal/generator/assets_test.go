@@ -0,0 +1,108 @@
+package generator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pngHeader is the start of a PNG file; it contains a NUL byte and invalid UTF-8
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR{{not a template")
+
+func TestIsBinaryContent(t *testing.T) {
+	assert.True(t, isBinaryContent(pngHeader))
+	assert.True(t, isBinaryContent([]byte{0xff, 0xfe, 'a'}))
+	assert.False(t, isBinaryContent([]byte("package {{.ProjectPackage}}\n")))
+	assert.False(t, isBinaryContent([]byte("héllo")))
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	sum := sha256.Sum256(pngHeader)
+	digest := hex.EncodeToString(sum[:])
+
+	assert.NoError(t, verifyChecksum(types.TemplateFile{Source: "logo.png"}, pngHeader))
+	assert.NoError(t, verifyChecksum(types.TemplateFile{Source: "logo.png", SHA256: digest}, pngHeader))
+	err := verifyChecksum(types.TemplateFile{Source: "logo.png", SHA256: digest}, []byte("tampered"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "integrity check failed for logo.png")
+}
+
+func TestGenerate_BinaryAssets(t *testing.T) {
+	sum := sha256.Sum256(pngHeader)
+	registry := setupAssetTestTemplates(t, hex.EncodeToString(sum[:]))
+
+	config := types.ProjectConfig{
+		Name:      "assets",
+		Module:    "github.com/test/assets",
+		Type:      "cli",
+		Variables: map[string]string{"blueprint_id": "assets-test"},
+	}
+
+	t.Run("copies assets byte for byte", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "assets")
+		_, err := NewWithRegistry(registry).Generate(config, types.GenerationOptions{OutputPath: outputPath, NoGit: true})
+		require.NoError(t, err)
+
+		logo, err := os.ReadFile(filepath.Join(outputPath, "static/logo.png"))
+		require.NoError(t, err)
+		assert.Equal(t, pngHeader, logo)
+
+		// Declared binary files are not rendered even if they are valid text
+		example, err := os.ReadFile(filepath.Join(outputPath, "docs/example.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "{{.ProjectName}}\n", string(example))
+	})
+
+	t.Run("in memory", func(t *testing.T) {
+		files, err := NewWithRegistry(registry).GenerateInMemoryFiles(context.Background(), &config, "assets-test")
+		require.NoError(t, err)
+		assert.Equal(t, pngHeader, files["static/logo.png"].Content)
+		assert.True(t, files["static/logo.png"].Binary)
+		assert.False(t, files["README.md"].Binary)
+	})
+
+	t.Run("checksum mismatch fails generation", func(t *testing.T) {
+		registry := setupAssetTestTemplates(t, "0000")
+
+		outputPath := filepath.Join(t.TempDir(), "assets")
+		_, err := NewWithRegistry(registry).Generate(config, types.GenerationOptions{OutputPath: outputPath, NoGit: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "integrity check failed")
+		assert.NoDirExists(t, outputPath)
+	})
+}
+
+// setupAssetTestTemplates returns a registry of a blueprint with binary assets
+func setupAssetTestTemplates(t *testing.T, logoDigest string) *templates.Registry {
+	t.Helper()
+
+	return newTestRegistry(t, fstest.MapFS{
+		"assets-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "assets-test"
+name: "assets-test"
+type: "cli"
+architecture: "standard"
+files:
+  - source: "README.md.tmpl"
+    destination: "README.md"
+  - source: "static/logo.png"
+    destination: "static/logo.png"
+    sha256: "` + logoDigest + `"
+  - source: "docs/example.txt"
+    destination: "docs/example.txt"
+    binary: true
+`)},
+		"assets-test/README.md.tmpl":   &fstest.MapFile{Data: []byte("# {{.ProjectName}}\n")},
+		"assets-test/static/logo.png":  &fstest.MapFile{Data: pngHeader},
+		"assets-test/docs/example.txt": &fstest.MapFile{Data: []byte("{{.ProjectName}}\n")},
+	})
+}
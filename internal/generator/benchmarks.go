@@ -0,0 +1,38 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// BenchmarksVariable is the blueprint variable that turns on the benchmarks and the
+// performance budget checked in CI. Blueprints offer them by declaring it.
+const BenchmarksVariable = "Benchmarks"
+
+// checkBenchmarks rejects the benchmarks for blueprints that do not offer them, and for
+// projects without the database container their repository benchmarks run against
+func checkBenchmarks(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[BenchmarksVariable] != "true" {
+		return nil
+	}
+
+	declared := false
+	for _, variable := range tmpl.Variables {
+		if variable.Name == BenchmarksVariable {
+			declared = true
+			break
+		}
+	}
+	if !declared {
+		return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer benchmarks, remove --benchmarks", tmpl.ID), nil)
+	}
+
+	if config.Features == nil || !config.Features.Database.HasDatabase() {
+		return types.NewValidationError("the benchmarks measure the user endpoints and need a database, set --database-driver", nil)
+	}
+	if driver := config.Features.Database.PrimaryDriver(); driver != "postgres" && driver != "mysql" {
+		return types.NewValidationError(fmt.Sprintf("the repository benchmarks run against a database container, which supports postgres and mysql but not %s", driver), nil)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_Benchmarks(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(benchmarks, driver string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:         "inventory",
+			Module:       "github.com/test/inventory",
+			Type:         "web-api",
+			Architecture: "clean",
+			Framework:    "gin",
+			Logger:       "slog",
+			Variables:    map[string]string{BenchmarksVariable: benchmarks},
+			Features: &types.Features{
+				Database: types.DatabaseConfig{Driver: driver, ORM: "gorm"},
+			},
+		}
+	}
+
+	t.Run("benchmarks are left out by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("false", "postgres"), "web-api-clean")
+		require.NoError(t, err)
+		assert.NotContains(t, files, "tests/benchmarks/json_test.go")
+		assert.NotContains(t, files, "cmd/benchcheck/main.go")
+		assert.NotContains(t, string(files["go.mod"].Content), "testcontainers")
+		assert.NotContains(t, string(files["Makefile"].Content), "bench-compare")
+	})
+
+	t.Run("flag adds the benchmarks and the budget check", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("true", "mysql"), "web-api-clean")
+		require.NoError(t, err)
+		for _, path := range []string{
+			"tests/benchmarks/json_test.go",
+			"tests/benchmarks/middleware_test.go",
+			"cmd/benchcheck/main.go",
+			"cmd/benchcheck/main_test.go",
+		} {
+			assert.Contains(t, files, path)
+		}
+
+		repository := string(files["tests/benchmarks/repository_test.go"].Content)
+		assert.Contains(t, repository, `testcontainers.Run(ctx, "mysql:8.0"`)
+		assert.Contains(t, string(files["go.mod"].Content), "github.com/testcontainers/testcontainers-go v0.38.0")
+		assert.Contains(t, string(files["Makefile"].Content), "go run ./cmd/benchcheck -threshold $(BENCH_THRESHOLD)")
+		assert.Contains(t, string(files[".github/workflows/ci.yml"].Content), "make bench-compare BENCH_BASE=origin/${{ github.base_ref }}")
+		assert.Contains(t, string(files[".gitignore"].Content), ".bench/")
+	})
+
+	t.Run("benchmarks need a database container", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("true", ""), "web-api-clean")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "need a database")
+
+		_, err = New().GenerateInMemoryFiles(ctx, config("true", "sqlite"), "web-api-clean")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not sqlite")
+	})
+
+	t.Run("blueprints without benchmarks reject the flag", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("true", "postgres"), "web-api-hexagonal")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer benchmarks")
+	})
+}
@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_Bot(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(variables map[string]string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:      "helper",
+			Module:    "github.com/test/helper",
+			Type:      "bot",
+			Logger:    "slog",
+			Variables: variables,
+		}
+	}
+
+	t.Run("slack by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{}), "bot")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "internal/slack/handler.go")
+		assert.Contains(t, files, "internal/slack/manifest.go")
+		assert.NotContains(t, files, "internal/discord/handler.go")
+		assert.Contains(t, string(files["internal/config/config.go"].Content), "SLACK_SIGNING_SECRET")
+		assert.Contains(t, string(files["cmd/bot/main.go"].Content), `case "manifest":`)
+	})
+
+	t.Run("discord", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{PlatformVariable: "discord"}), "bot")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "internal/discord/handler.go")
+		assert.NotContains(t, files, "internal/slack/handler.go")
+		assert.Contains(t, string(files["internal/config/config.go"].Content), "DISCORD_PUBLIC_KEY")
+		assert.Contains(t, string(files["cmd/bot/main.go"].Content), `case "register":`)
+	})
+
+	t.Run("commands are shared", func(t *testing.T) {
+		for _, platform := range []string{"slack", "discord"} {
+			files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{PlatformVariable: platform}), "bot")
+			require.NoError(t, err)
+			for _, path := range []string{"internal/commands/registry.go", "internal/commands/ping.go", "internal/commands/poll.go"} {
+				assert.Contains(t, files, path, platform)
+			}
+		}
+	})
+
+	t.Run("other blueprints reject a platform", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, &types.ProjectConfig{
+			Name:      "tasks",
+			Module:    "github.com/test/tasks",
+			Type:      "tui",
+			Logger:    "slog",
+			Variables: map[string]string{PlatformVariable: "slack"},
+		}, "tui")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "remove --platform")
+	})
+}
@@ -0,0 +1,111 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/francknouama/go-starter/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateContext_Cancellation(t *testing.T) {
+	registry := setupFileModeTestTemplates(t)
+
+	config := types.ProjectConfig{
+		Name:      "modes",
+		Module:    "github.com/test/modes",
+		Type:      "cli",
+		Variables: map[string]string{"blueprint_id": "modes-test"},
+	}
+
+	// cancelAfterFirstWrite cancels the generation once the first file is on disk
+	cancelAfterFirstWrite := func(cancel context.CancelFunc) types.ProgressFunc {
+		return func(event types.ProgressEvent) {
+			if event.Type == types.ProgressStep && event.Phase == types.PhaseWrite {
+				cancel()
+			}
+		}
+	}
+
+	t.Run("cancelled before start", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "modes")
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := NewWithRegistry(registry).GenerateContext(ctx, config, types.GenerationOptions{OutputPath: outputPath, NoGit: true})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.NoDirExists(t, outputPath)
+	})
+
+	t.Run("partial output is removed", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "modes")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		_, err := NewWithRegistry(registry).GenerateContext(ctx, config, types.GenerationOptions{
+			OutputPath: outputPath,
+			NoGit:      true,
+			Progress:   cancelAfterFirstWrite(cancel),
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.NoDirExists(t, outputPath)
+	})
+
+	t.Run("existing empty directory is kept", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "modes")
+		require.NoError(t, os.Mkdir(outputPath, 0755))
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		_, err := NewWithRegistry(registry).GenerateContext(ctx, config, types.GenerationOptions{
+			OutputPath: outputPath,
+			NoGit:      true,
+			Progress:   cancelAfterFirstWrite(cancel),
+		})
+		require.Error(t, err)
+
+		entries, err := os.ReadDir(outputPath)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("keep partial records state", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "modes")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		_, err := NewWithRegistry(registry).GenerateContext(ctx, config, types.GenerationOptions{
+			OutputPath:  outputPath,
+			NoGit:       true,
+			KeepPartial: true,
+			Progress:    cancelAfterFirstWrite(cancel),
+		})
+		require.Error(t, err)
+
+		state, err := ReadPartialState(outputPath)
+		require.NoError(t, err)
+		assert.Equal(t, "modes-test", state.Blueprint)
+		assert.Equal(t, types.PhaseWrite, state.Phase)
+		assert.Equal(t, "modes", state.Config.Name)
+		require.Len(t, state.FilesWritten, 1)
+		assert.FileExists(t, filepath.Join(outputPath, state.FilesWritten[0]))
+		assert.NoFileExists(t, filepath.Join(outputPath, "secrets.env"))
+	})
+}
+
+func TestGenerationTransaction_RollbackClaimedOutput(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "project")
+	require.NoError(t, os.MkdirAll(filepath.Join(outputPath, "internal", "app"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(outputPath, "go.sum"), []byte("untracked"), 0644))
+
+	tx := NewGenerationTransaction(outputPath)
+	tx.ClaimOutput(true)
+	require.NoError(t, tx.Rollback())
+	assert.NoDirExists(t, outputPath)
+}
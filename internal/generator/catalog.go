@@ -0,0 +1,46 @@
+package generator
+
+import (
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Catalog is the machine-readable list of the blueprints, for IDE extensions and
+// CI scripts that build go-starter new command lines
+type Catalog struct {
+	Blueprints []CatalogEntry `json:"blueprints" yaml:"blueprints"`
+}
+
+// CatalogEntry describes a blueprint and the options go-starter new accepts for it
+type CatalogEntry struct {
+	ID           string             `json:"id" yaml:"id"`
+	Name         string             `json:"name" yaml:"name"`
+	Description  string             `json:"description" yaml:"description"`
+	Type         string             `json:"type" yaml:"type"`
+	Architecture string             `json:"architecture,omitempty" yaml:"architecture,omitempty"`
+	Version      string             `json:"version,omitempty" yaml:"version,omitempty"`
+	Deprecated   *types.Deprecation `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+	// Experimental is the experiment the blueprint is gated behind, if any
+	Experimental string   `json:"experimental,omitempty" yaml:"experimental,omitempty"`
+	Options      []Option `json:"options" yaml:"options"`
+}
+
+// Catalog lists the blueprints in the order of go-starter list, each with its
+// options, their flags, defaults and choices, and the requirements between them
+func (g *Generator) Catalog() Catalog {
+	blueprints := g.registry.List()
+	catalog := Catalog{Blueprints: make([]CatalogEntry, 0, len(blueprints))}
+	for _, tmpl := range blueprints {
+		catalog.Blueprints = append(catalog.Blueprints, CatalogEntry{
+			ID:           tmpl.ID,
+			Name:         tmpl.Name,
+			Description:  tmpl.Description,
+			Type:         tmpl.Type,
+			Architecture: tmpl.Architecture,
+			Version:      tmpl.Version,
+			Deprecated:   tmpl.Deprecated,
+			Experimental: tmpl.Experimental,
+			Options:      blueprintOptions(tmpl),
+		})
+	}
+	return catalog
+}
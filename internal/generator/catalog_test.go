@@ -0,0 +1,34 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalog(t *testing.T) {
+	setupTestTemplates(t)
+
+	catalog := New().Catalog()
+	require.NotEmpty(t, catalog.Blueprints)
+	assert.Equal(t, "cli-simple", catalog.Blueprints[0].ID)
+
+	byID := make(map[string]CatalogEntry)
+	for _, entry := range catalog.Blueprints {
+		byID[entry.ID] = entry
+	}
+
+	clean, ok := byID["web-api-clean"]
+	require.True(t, ok)
+	assert.Equal(t, "web-api", clean.Type)
+	assert.Equal(t, "clean", clean.Architecture)
+
+	options := make(map[string]Option)
+	for _, option := range clean.Options {
+		options[option.Name] = option
+	}
+	assert.Equal(t, "framework", options["Framework"].Flag)
+	assert.Equal(t, "bool", options[BenchmarksVariable].Type)
+	assert.NotEmpty(t, options[BenchmarksVariable].Requires, "requirements between options are part of the catalog")
+}
@@ -0,0 +1,27 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// ChaosVariable is the blueprint variable that turns on the development-only
+// middleware injecting latency, errors and connection resets in the requests of
+// the service, and the command driving chaos tests against it. Blueprints offer
+// it by declaring it.
+const ChaosVariable = "Chaos"
+
+// checkChaos rejects fault injection for blueprints that do not offer it
+func checkChaos(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[ChaosVariable] != "true" {
+		return nil
+	}
+
+	for _, variable := range tmpl.Variables {
+		if variable.Name == ChaosVariable {
+			return nil
+		}
+	}
+	return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer fault injection, remove --chaos", tmpl.ID), nil)
+}
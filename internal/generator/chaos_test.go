@@ -0,0 +1,68 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_Chaos(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(framework string, variables map[string]string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:      "order-api",
+			Module:    "github.com/test/order-api",
+			Type:      "web-api",
+			Framework: framework,
+			Logger:    "slog",
+			Variables: variables,
+			Features:  &types.Features{},
+		}
+	}
+
+	t.Run("fault injection is left out by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("gin", map[string]string{}), "web-api")
+		require.NoError(t, err)
+		assert.NotContains(t, files, "internal/chaos/chaos.go")
+		assert.NotContains(t, files, "cmd/chaos/main.go")
+		assert.NotContains(t, string(files["cmd/server/main.go"].Content), "chaos")
+		assert.NotContains(t, string(files["Makefile"].Content), "chaos")
+	})
+
+	for framework, middleware := range map[string]string{
+		"gin":    "router.Use(chaosInjector.Gin())",
+		"echo":   "router.Use(chaosInjector.Echo())",
+		"fiber":  "router.Use(chaosInjector.Fiber())",
+		"chi":    "router.Use(chaosInjector.Middleware)",
+		"stdlib": "securedMux = chaosInjector.Middleware(securedMux)",
+	} {
+		t.Run("the "+framework+" server injects the configured faults", func(t *testing.T) {
+			files, err := New().GenerateInMemoryFiles(ctx, config(framework, map[string]string{ChaosVariable: "true"}), "web-api")
+			require.NoError(t, err)
+
+			assert.Contains(t, files, "internal/chaos/chaos_test.go")
+			assert.Contains(t, string(files["cmd/chaos/main.go"].Content), `"github.com/test/order-api/internal/chaos"`)
+			main := string(files["cmd/server/main.go"].Content)
+			assert.Contains(t, main, "chaosInjector := chaos.New(cfg.Chaos)")
+			assert.Contains(t, main, middleware)
+			cfg := string(files["internal/config/config.go"].Content)
+			assert.Contains(t, cfg, "Chaos       chaos.Config")
+			assert.Contains(t, cfg, "cannot be enabled in production")
+			assert.Contains(t, string(files[".env.example"].Content), "ORDER_API_CHAOS_ERROR_RATE=0")
+			assert.Contains(t, string(files["README.md"].Content), "## Chaos Testing")
+			assert.Contains(t, string(files["Makefile"].Content), "go run ./cmd/chaos -url $(CHAOS_URL)")
+		})
+	}
+
+	t.Run("blueprints without fault injection reject the flag", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("gin", map[string]string{ChaosVariable: "true"}), "grpc-service")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer fault injection")
+	})
+}
@@ -0,0 +1,332 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Statuses of the items of a production readiness checklist
+const (
+	ChecklistOK      = "ok"
+	ChecklistMissing = "missing"
+)
+
+// Checklist is the production readiness report of a generated project
+type Checklist struct {
+	Project   string          `json:"project"`
+	Blueprint string          `json:"blueprint"`
+	Items     []ChecklistItem `json:"items"`
+}
+
+// ChecklistItem is a recommended feature of a production service
+type ChecklistItem struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+	// Command is the go-starter add command adding the missing feature, when the
+	// blueprint of the project offers it
+	Command string `json:"command,omitempty"`
+	// Advice explains how to add the missing feature by hand otherwise
+	Advice string `json:"advice,omitempty"`
+}
+
+// Missing returns the items of the checklist the project lacks
+func (c *Checklist) Missing() []ChecklistItem {
+	var missing []ChecklistItem
+	for _, item := range c.Items {
+		if item.Status == ChecklistMissing {
+			missing = append(missing, item)
+		}
+	}
+	return missing
+}
+
+// serviceTypes are the project types that run as long-lived services, which the
+// service items of the checklist apply to
+var serviceTypes = []string{
+	"web-api", "web-app", "microservice", "grpc-service", "grpc-gateway", "gateway",
+	"event-service", "event-driven", "monolith", "realtime", "workflow",
+}
+
+// checklistProject is what the checks of the checklist know about a project
+type checklistProject struct {
+	context map[string]any
+	// tmpl is the blueprint of the project, nil when it no longer ships with go-starter
+	tmpl *types.Template
+	// files holds the content of the text files of the project by slash path
+	files map[string][]byte
+}
+
+// hasDatabase reports whether the project was generated with a database
+func (p *checklistProject) hasDatabase() bool {
+	driver, _ := p.context["DatabaseDriver"].(string)
+	return driver != "" && driver != "none"
+}
+
+// hasPath reports whether a file path of the project satisfies match
+func (p *checklistProject) hasPath(match func(string) bool) bool {
+	for name := range p.files {
+		if match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// contains reports whether a file of the project contains one of the markers
+func (p *checklistProject) contains(markers ...string) bool {
+	for _, content := range p.files {
+		for _, marker := range markers {
+			if bytes.Contains(content, []byte(marker)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// offers reports whether the blueprint of the project declares variable
+func (p *checklistProject) offers(variable string) bool {
+	return p.tmpl != nil && slices.ContainsFunc(p.tmpl.Variables, func(v types.TemplateVariable) bool { return v.Name == variable })
+}
+
+// checklistCheck is an item of the checklist and how to tell whether a project has it
+type checklistCheck struct {
+	id, title string
+	// applies is nil for the checks of every service
+	applies func(p *checklistProject) bool
+	found   func(p *checklistProject) bool
+	// fix returns the arguments of go-starter add adding the item, empty when the
+	// blueprint does not offer it
+	fix    func(p *checklistProject) []string
+	advice string
+}
+
+// observabilityFix adds the observability option, when the blueprint offers it
+func observabilityFix(p *checklistProject) []string {
+	if p.offers(ObservabilityVariable) {
+		return []string{optionFlags[ObservabilityVariable]}
+	}
+	return nil
+}
+
+var checklistChecks = []checklistCheck{
+	{
+		id:    "health",
+		title: "Health checks",
+		found: func(p *checklistProject) bool { return p.contains(`"/health`, `"/healthz`, "grpc_health_v1") },
+		advice: "serve a /health endpoint that checks the dependencies of the service, for the probes of " +
+			"the load balancer and the orchestrator",
+	},
+	{
+		id:    "metrics",
+		title: "Metrics",
+		found: func(p *checklistProject) bool {
+			return p.contains("prometheus/client_golang", "go.opentelemetry.io/otel/metric")
+		},
+		fix:    observabilityFix,
+		advice: "export request rates, errors and latencies with the Prometheus client or OpenTelemetry metrics",
+	},
+	{
+		id:    "alerts",
+		title: "Alerts",
+		found: func(p *checklistProject) bool {
+			for name, content := range p.files {
+				if ext := path.Ext(name); (ext == ".yml" || ext == ".yaml") && bytes.Contains(content, []byte("alert:")) {
+					return true
+				}
+			}
+			return false
+		},
+		fix:    observabilityFix,
+		advice: "write alerting rules on the error rate and latency of the service, with a runbook for each",
+	},
+	{
+		id:    "rate-limits",
+		title: "Rate limits",
+		found: func(p *checklistProject) bool {
+			return p.contains("golang.org/x/time/rate", "ulule/limiter", "RateLimit", "rate_limit")
+		},
+		advice: "limit the requests of each client in a middleware, with golang.org/x/time/rate or at the gateway",
+	},
+	{
+		id:      "migrations",
+		title:   "Database migrations",
+		applies: (*checklistProject).hasDatabase,
+		found: func(p *checklistProject) bool {
+			return p.hasPath(func(name string) bool { return strings.Contains("/"+name, "/migrations/") }) ||
+				p.contains("golang-migrate/migrate", "pressly/goose", "AutoMigrate")
+		},
+		advice: "version the schema with migrations, with golang-migrate or goose, and run them on deploy",
+	},
+	{
+		id:      "backups",
+		title:   "Database backups",
+		applies: (*checklistProject).hasDatabase,
+		found: func(p *checklistProject) bool {
+			return p.hasPath(func(name string) bool { return strings.Contains(strings.ToLower(name), "backup") }) ||
+				p.contains("pg_dump", "mysqldump", "backup_retention")
+		},
+		advice: "schedule backups of the database, with a retention period and a tested restore",
+	},
+	{
+		id:    "auth",
+		title: "Authentication",
+		applies: func(p *checklistProject) bool {
+			return p.offers("AuthType")
+		},
+		found: func(p *checklistProject) bool {
+			authType, _ := p.context["AuthType"].(string)
+			return authType != "" && authType != "none"
+		},
+		fix: func(p *checklistProject) []string {
+			for _, v := range p.tmpl.Variables {
+				if v.Name != "AuthType" {
+					continue
+				}
+				for _, choice := range nonEmpty(v.Choices) {
+					if choice != "none" {
+						return []string{AddAuth, choice}
+					}
+				}
+			}
+			return nil
+		},
+		advice: "authenticate the clients of the service",
+	},
+	{
+		id:    "docker",
+		title: "Container image",
+		found: func(p *checklistProject) bool { return p.hasPath(isDockerFile) },
+		fix: func(p *checklistProject) []string {
+			if p.tmpl != nil {
+				return []string{AddDocker}
+			}
+			return nil
+		},
+		advice: "build a container image of the service with a multi-stage Dockerfile",
+	},
+	{
+		id:    "ci",
+		title: "Continuous integration",
+		found: func(p *checklistProject) bool {
+			return p.hasPath(func(name string) bool {
+				return strings.HasPrefix(name, ".github/workflows/") || name == ".gitlab-ci.yml" || strings.HasPrefix(name, ".circleci/")
+			})
+		},
+		advice: "run the tests, the linters and the vulnerability checks on every change",
+	},
+}
+
+// checklistFileLimit is the size above which the files of a project are not
+// searched, such as binaries and data files
+const checklistFileLimit = 1 << 20
+
+// Checklist inspects the project generated at projectPath, its manifest and its
+// files, and reports the recommended features of a production service it lacks,
+// with the go-starter add command adding each of those its blueprint offers.
+// Projects that are not services, such as CLIs and libraries, have no items.
+func (g *Generator) Checklist(projectPath string) (*Checklist, error) {
+	manifest, err := ReadManifest(projectPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("no %s in %s, only projects generated by go-starter with a manifest can be checked", ManifestFile, projectPath)
+		}
+		return nil, err
+	}
+
+	checklist := &Checklist{Project: projectPath, Blueprint: manifest.Blueprint, Items: []ChecklistItem{}}
+	project := &checklistProject{context: map[string]any{}}
+	for name, value := range manifest.Config.Variables {
+		project.context[name] = value
+	}
+	if tmpl, err := g.registry.Get(manifest.Blueprint); err == nil {
+		project.tmpl = &tmpl
+		project.context = g.createTemplateContext(manifest.Config, tmpl)
+	}
+	if !slices.Contains(serviceTypes, manifest.Config.Type) {
+		return checklist, nil
+	}
+
+	if project.files, err = readProjectFiles(projectPath); err != nil {
+		return nil, err
+	}
+
+	for _, check := range checklistChecks {
+		if check.applies != nil && !check.applies(project) {
+			continue
+		}
+		item := ChecklistItem{ID: check.id, Title: check.title, Status: ChecklistOK}
+		if !check.found(project) {
+			item.Status = ChecklistMissing
+			var args []string
+			if check.fix != nil {
+				args = check.fix(project)
+			}
+			if len(args) > 0 {
+				item.Command = addCommand(projectPath, args)
+			} else {
+				item.Advice = check.advice
+			}
+		}
+		checklist.Items = append(checklist.Items, item)
+	}
+	return checklist, nil
+}
+
+// addCommand returns the go-starter add command with args for the project at projectPath
+func addCommand(projectPath string, args []string) string {
+	command := "go-starter add " + strings.Join(args, " ")
+	if filepath.Clean(projectPath) != "." {
+		command += " -C " + projectPath
+	}
+	return command
+}
+
+// readProjectFiles returns the content of the files of the project at projectPath
+// by slash path, leaving out the repositories, dependencies and large files
+func readProjectFiles(projectPath string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	err := filepath.WalkDir(projectPath, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			switch entry.Name() {
+			case ".git", "vendor", "node_modules":
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(projectPath, name)
+		if err != nil || isMetadataFile(rel) {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil || info.Size() > checklistFileLimit {
+			return err
+		}
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = content
+		return nil
+	})
+	if err != nil {
+		return nil, types.NewFileSystemError("failed to read project files", err)
+	}
+	return files, nil
+}
@@ -0,0 +1,76 @@
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// checklistStatuses returns the status of each item of the checklist by ID
+func checklistStatuses(checklist *Checklist) map[string]string {
+	statuses := make(map[string]string, len(checklist.Items))
+	for _, item := range checklist.Items {
+		statuses[item.ID] = item.Status
+	}
+	return statuses
+}
+
+func TestChecklist(t *testing.T) {
+	registry := setupAddTestTemplates(t)
+	projectPath := generateAddTestProject(t, registry)
+
+	checklist, err := NewWithRegistry(registry).Checklist(projectPath)
+	require.NoError(t, err)
+	assert.Equal(t, "add-test", checklist.Blueprint)
+	assert.Equal(t, map[string]string{
+		"health":      ChecklistMissing,
+		"metrics":     ChecklistMissing,
+		"alerts":      ChecklistMissing,
+		"rate-limits": ChecklistMissing,
+		"docker":      ChecklistOK,
+		"ci":          ChecklistMissing,
+	}, checklistStatuses(checklist), "the database items apply once the project has one, the auth item when the blueprint offers it")
+
+	metrics := checklist.Items[1]
+	assert.Equal(t, "go-starter add observability -C "+projectPath, metrics.Command)
+	assert.Empty(t, metrics.Advice)
+	rateLimits := checklist.Items[3]
+	assert.Empty(t, rateLimits.Command, "no option adds rate limits")
+	assert.NotEmpty(t, rateLimits.Advice)
+
+	plan, err := NewWithRegistry(registry).PlanAdd(context.Background(), projectPath, AddRequest{Feature: AddDatabase, Value: "postgres"})
+	require.NoError(t, err)
+	require.NoError(t, NewWithRegistry(registry).ApplyAdd(plan))
+	require.NoError(t, os.MkdirAll(filepath.Join(projectPath, "migrations"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(projectPath, "migrations", "0001_users.up.sql"), []byte("CREATE TABLE users ();\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(projectPath, "health.go"), []byte("package main\n\nconst healthPath = \"/health\"\n"), 0644))
+
+	checklist, err = NewWithRegistry(registry).Checklist(projectPath)
+	require.NoError(t, err)
+	statuses := checklistStatuses(checklist)
+	assert.Equal(t, ChecklistOK, statuses["health"])
+	assert.Equal(t, ChecklistOK, statuses["migrations"])
+	assert.Equal(t, ChecklistMissing, statuses["backups"])
+	assert.Len(t, checklist.Missing(), 5)
+}
+
+func TestChecklist_NotAService(t *testing.T) {
+	dir := t.TempDir()
+	data, err := json.Marshal(Manifest{Blueprint: "cli", Config: types.ProjectConfig{Name: "tool", Type: "cli"}})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ManifestFile), data, 0644))
+
+	checklist, err := New().Checklist(dir)
+	require.NoError(t, err)
+	assert.Empty(t, checklist.Items)
+
+	_, err = New().Checklist(t.TempDir())
+	assert.ErrorContains(t, err, "only projects generated by go-starter with a manifest can be checked")
+}
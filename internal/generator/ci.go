@@ -0,0 +1,23 @@
+package generator
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// CIVariable is the generation variable choosing the CI provider of the project.
+// The blueprints ship GitHub Actions workflows; "none" leaves them out.
+const CIVariable = "CI"
+
+// ciWorkflows is the directory of the CI workflows the blueprints generate
+const ciWorkflows = ".github/workflows"
+
+// skipCI reports whether the file at destination is a CI workflow left out of the project
+func skipCI(destination string, config types.ProjectConfig) bool {
+	if config.Variables[CIVariable] != "none" {
+		return false
+	}
+	return strings.HasPrefix(filepath.ToSlash(destination), ciWorkflows+"/")
+}
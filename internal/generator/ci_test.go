@@ -0,0 +1,39 @@
+package generator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_CI(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	generate := func(ci string) map[string]GeneratedFile {
+		files, err := New().GenerateInMemoryFiles(ctx, &types.ProjectConfig{
+			Name:      "toolbox",
+			Module:    "github.com/test/toolbox",
+			Type:      "library",
+			Logger:    "slog",
+			Variables: map[string]string{CIVariable: ci},
+		}, "library")
+		require.NoError(t, err)
+		return files
+	}
+
+	for _, ci := range []string{"", "github"} {
+		assert.Contains(t, generate(ci), ".github/workflows/ci.yml", ci)
+	}
+
+	files := generate("none")
+	for path := range files {
+		assert.False(t, strings.HasPrefix(path, ".github/workflows/"), path)
+	}
+	assert.Contains(t, files, "go.mod")
+}
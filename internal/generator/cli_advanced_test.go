@@ -0,0 +1,59 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/prompts"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_CLIAdvanced(t *testing.T) {
+	setupTestTemplates(t)
+
+	config := &types.ProjectConfig{
+		Name:   "my-tool",
+		Module: "github.com/acme/my-tool",
+		Type:   prompts.SelectBlueprintForComplexity("cli", prompts.ComplexityAdvanced),
+		Logger: "zap",
+	}
+	assert.Equal(t, "cli-advanced", New().getTemplateID(*config))
+
+	files, err := New().GenerateInMemoryFiles(context.Background(), config, "cli-advanced")
+	require.NoError(t, err)
+
+	for _, file := range []string{"cmd/plugin.go", "cmd/selfupdate.go", "internal/plugin/plugin.go", "internal/update/update.go", ".github/workflows/release.yml"} {
+		assert.Contains(t, files, file)
+	}
+	assert.Contains(t, files, "internal/logger/zap.go")
+	assert.NotContains(t, files, "internal/logger/slog.go")
+
+	cfg := string(files["internal/config/config.go"].Content)
+	assert.Contains(t, cfg, `const EnvPrefix = "MY_TOOL_"`)
+	assert.Contains(t, cfg, `UpdateRepository: "acme/my-tool"`)
+
+	plugin := string(files["internal/plugin/plugin.go"].Content)
+	assert.Contains(t, plugin, `const Prefix = "my-tool-"`)
+
+	goMod := string(files["go.mod"].Content)
+	assert.Contains(t, goMod, "github.com/spf13/cobra")
+	assert.Contains(t, goMod, "go.uber.org/zap")
+}
+
+func TestGenerateInMemoryFiles_CLIAdvancedOutsideGitHub(t *testing.T) {
+	setupTestTemplates(t)
+
+	files, err := New().GenerateInMemoryFiles(context.Background(), &types.ProjectConfig{
+		Name:   "my-tool",
+		Module: "gitlab.com/acme/my-tool",
+		Type:   "cli-advanced",
+		Logger: "slog",
+	}, "cli-advanced")
+	require.NoError(t, err)
+
+	cfg := string(files["internal/config/config.go"].Content)
+	assert.Contains(t, cfg, `UpdateRepository: "",`, "self-update needs a repository set in the configuration")
+}
@@ -0,0 +1,25 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// ClientSDKVariable is the blueprint variable that lists the languages of the typed
+// API clients generated into the client submodule. Blueprints offer them by declaring it.
+const ClientSDKVariable = "ClientSDK"
+
+// checkClientSDK rejects API clients for blueprints that do not offer them
+func checkClientSDK(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[ClientSDKVariable] == "" {
+		return nil
+	}
+
+	for _, variable := range tmpl.Variables {
+		if variable.Name == ClientSDKVariable {
+			return nil
+		}
+	}
+	return types.NewValidationError(fmt.Sprintf("blueprint %s has no OpenAPI spec to generate clients from, remove --client-sdk", tmpl.ID), nil)
+}
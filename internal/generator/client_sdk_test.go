@@ -0,0 +1,81 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_ClientSDK(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(languages string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:      "shop",
+			Module:    "github.com/test/shop",
+			Type:      "web-api",
+			Framework: "gin",
+			Logger:    "slog",
+			Variables: map[string]string{ClientSDKVariable: languages},
+		}
+	}
+
+	for _, blueprint := range []string{"web-api", "web-api-clean", "web-api-ddd", "web-api-hexagonal"} {
+		t.Run(blueprint, func(t *testing.T) {
+			files, err := New().GenerateInMemoryFiles(ctx, config(""), blueprint)
+			require.NoError(t, err)
+			assert.NotContains(t, files, "cmd/clientgen/main.go", "clients are left out by default")
+			assert.NotContains(t, files, "client/go.mod")
+			assert.NotContains(t, string(files["Makefile"].Content), "clientgen")
+
+			files, err = New().GenerateInMemoryFiles(ctx, config("go,typescript"), blueprint)
+			require.NoError(t, err)
+			for _, path := range []string{
+				"cmd/clientgen/main.go",
+				"internal/openapi/spec.go",
+				"cmd/clientgen/model.go",
+				"cmd/clientgen/golang.go",
+				"cmd/clientgen/typescript.go",
+				"cmd/clientgen/clientgen_test.go",
+				"client/README.md",
+			} {
+				assert.Contains(t, files, path)
+			}
+			assert.Contains(t, string(files["client/go.mod"].Content), "module github.com/test/shop/client")
+			assert.Contains(t, string(files["cmd/clientgen/main.go"].Content), `flag.String("lang", "go,typescript"`)
+			assert.Contains(t, string(files["client/README.md"].Content), "## TypeScript")
+			assert.Contains(t, string(files["Makefile"].Content), "go run ./cmd/clientgen -spec api/openapi.yaml -out client")
+			assert.Contains(t, string(files["README.md"].Content), "make client")
+		})
+	}
+
+	t.Run("the Go client alone", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("go"), "web-api")
+		require.NoError(t, err)
+		assert.Contains(t, string(files["cmd/clientgen/main.go"].Content), `flag.String("lang", "go"`)
+		assert.NotContains(t, string(files["client/README.md"].Content), "TypeScript")
+	})
+
+	t.Run("blueprints without an OpenAPI spec reject the flag", func(t *testing.T) {
+		cfg := config("go")
+		cfg.Type = "cli"
+		_, err := New().GenerateInMemoryFiles(ctx, cfg, "cli-simple")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has no OpenAPI spec to generate clients from")
+	})
+}
+
+func TestHookApplies(t *testing.T) {
+	g := New()
+	hook := types.Hook{Name: "generate_client", Command: "go run ./cmd/clientgen", Condition: `{{ne .ClientSDK ""}}`}
+
+	assert.True(t, g.hookApplies(types.Hook{Name: "tidy", Command: "go mod tidy"}, map[string]any{}), "hooks without a condition always run")
+	assert.True(t, g.hookApplies(hook, map[string]any{"ClientSDK": "go"}))
+	assert.False(t, g.hookApplies(hook, map[string]any{"ClientSDK": ""}))
+	assert.False(t, g.hookApplies(types.Hook{Name: "broken", Condition: "{{if}}"}, map[string]any{}), "hooks whose condition fails to evaluate are skipped")
+}
@@ -0,0 +1,139 @@
+package generator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_Clock(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	for _, di := range []string{"manual", "wire", "fx", "do"} {
+		t.Run(di, func(t *testing.T) {
+			files, err := New().GenerateInMemoryFiles(ctx, &types.ProjectConfig{
+				Name:         "orders",
+				Module:       "github.com/test/orders",
+				Type:         "web-api",
+				Architecture: "clean",
+				Framework:    "gin",
+				Logger:       "slog",
+				Variables:    map[string]string{DIVariable: di, DataPrivacyVariable: "true"},
+				Features: &types.Features{
+					Database:       types.DatabaseConfig{Driver: "postgres", ORM: "gorm"},
+					Authentication: types.AuthConfig{Type: "jwt"},
+				},
+			}, "web-api-clean")
+			require.NoError(t, err)
+
+			clock := string(files["internal/clock/clock.go"].Content)
+			assert.Contains(t, clock, "type Clock interface")
+			assert.Contains(t, clock, "func NewFake(now time.Time) *Fake")
+			assert.Contains(t, files, "tests/unit/token_service_test.go")
+
+			// Only the clock reads the time of the domain, use cases and token service
+			for path, file := range files {
+				if strings.HasPrefix(path, "internal/domain/") || path == "internal/infrastructure/services/auth_service.go" {
+					assert.NotContains(t, string(file.Content), "time.Now()", path)
+				}
+			}
+			assert.Contains(t, string(files["internal/domain/usecases/auth_usecase.go"].Content), "clock clock.Clock")
+
+			wiring := map[string]string{
+				"manual": "c.Clock = clock.New()",
+				"wire":   "clockClock := clock.New()",
+				"fx":     "clock.New,",
+				"do":     "return clock.New(), nil",
+			}
+			var container strings.Builder
+			for path, file := range files {
+				if strings.HasPrefix(path, "internal/infrastructure/container/") {
+					container.Write(file.Content)
+				}
+			}
+			assert.Contains(t, container.String(), wiring[di])
+		})
+	}
+}
+
+func TestGenerateInMemoryFiles_ClockWebAPIBlueprints(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(architecture, di string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:         "orders",
+			Module:       "github.com/test/orders",
+			Type:         "web-api",
+			Architecture: architecture,
+			Framework:    "gin",
+			Logger:       "slog",
+			Variables:    map[string]string{DIVariable: di},
+			Features: &types.Features{
+				Database:       types.DatabaseConfig{Driver: "postgres", ORM: "gorm"},
+				Authentication: types.AuthConfig{Type: "jwt"},
+			},
+		}
+	}
+
+	tests := []struct {
+		blueprint    string
+		architecture string
+		// Files whose code only asks the clock for the time
+		clocked []string
+		// Where the clock of the system is created
+		wiring, wiringFile string
+	}{
+		{"web-api", "standard", []string{"internal/services/auth.go", "internal/handlers/handlers.go"}, "clk := clock.New()", "cmd/server/main.go"},
+		{"web-api-ddd", "ddd", []string{"internal/domain/user/entity.go", "internal/application/auth/auth_service.go"}, "clk := clock.New()", "cmd/server/main.go"},
+		{"web-api-vertical-slice", "vertical-slice", []string{"internal/features/users/createuser/service.go", "internal/platform/database/migrate.go"}, "clk := clock.New()", "cmd/server/main.go"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.blueprint, func(t *testing.T) {
+			files, err := New().GenerateInMemoryFiles(ctx, config(tt.architecture, ""), tt.blueprint)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(files["internal/clock/clock.go"].Content), "func NewFake(now time.Time) *Fake")
+			for _, path := range tt.clocked {
+				require.Contains(t, files, path)
+				assert.NotContains(t, string(files[path].Content), "time.Now()", path)
+			}
+			assert.Contains(t, string(files[tt.wiringFile].Content), tt.wiring)
+		})
+	}
+
+	wiring := map[string]string{
+		"manual": "c.clock = clock.New()",
+		"wire":   "clockClock := clock.New()",
+		"fx":     "clock.New,",
+		"do":     "return clock.New(), nil",
+	}
+	for _, di := range []string{"manual", "wire", "fx", "do"} {
+		t.Run("web-api-hexagonal/"+di, func(t *testing.T) {
+			files, err := New().GenerateInMemoryFiles(ctx, config("hexagonal", di), "web-api-hexagonal")
+			require.NoError(t, err)
+
+			assert.Contains(t, string(files["internal/clock/clock.go"].Content), "func NewFake(now time.Time) *Fake")
+			for path, file := range files {
+				if strings.HasPrefix(path, "internal/domain/") && !strings.HasSuffix(path, "_test.go") {
+					assert.NotContains(t, string(file.Content), "time.Now()", path)
+				}
+			}
+			assert.Contains(t, string(files["internal/application/services/auth_service.go"].Content), "s.clock.Now()")
+
+			var container strings.Builder
+			for path, file := range files {
+				if strings.HasPrefix(path, "internal/infrastructure/container/") {
+					container.Write(file.Content)
+				}
+			}
+			assert.Contains(t, container.String(), wiring[di])
+		})
+	}
+}
@@ -0,0 +1,643 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Conditions are either Go templates, such as {{eq .Framework "gin"}}, or
+// expressions of the condition language:
+//
+//	Framework in ["gin", "echo"] and not (DatabaseDriver == "" or AuthType == "none")
+//
+// Expressions compare variables, named as in templates with or without their
+// leading dot, to string, number and boolean literals with == and !=, test
+// membership with in and not in, and combine with and, or and not (&&, || and !
+// also work). Values compare as text, so that 8080 equals "8080". A string on the
+// right of in is read as a comma-separated list. A value is true unless it is
+// false, empty, zero or undefined, as the output of a template condition is.
+
+// isTemplateExpression reports whether expression is a Go template
+func isTemplateExpression(expression string) bool {
+	return strings.Contains(expression, "{{")
+}
+
+// evaluateExpression returns the value of a condition or derived variable: the
+// output of a template, or the value of an expression. Undefined variables fail
+// the evaluation when strict is set.
+func (g *Generator) evaluateExpression(expression string, context map[string]any, strict bool) (any, error) {
+	if isTemplateExpression(expression) {
+		missingKey := "missingkey=default"
+		if strict {
+			missingKey = "missingkey=error"
+		}
+		tmpl, err := template.New("condition").Funcs(g.funcMap()).Option(missingKey).Parse(expression)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse condition: %w", err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, context); err != nil {
+			return nil, fmt.Errorf("failed to execute condition: %w", err)
+		}
+		return strings.TrimSpace(buf.String()), nil
+	}
+
+	expr, err := parseCondition(expression)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse condition: %w", err)
+	}
+	value, err := expr.eval(context, strict)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate condition: %w", err)
+	}
+	return value, nil
+}
+
+// truthy reports whether a value holds as a condition
+func truthy(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return truthyText(v)
+	}
+	switch rv := reflect.ValueOf(value); rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return rv.Len() > 0
+	case reflect.Pointer:
+		return !rv.IsNil()
+	}
+	return truthyText(fmt.Sprint(value))
+}
+
+// truthyText reports whether the output of a condition holds: "true", a non-zero
+// number or any other non-empty text but "false"
+func truthyText(result string) bool {
+	if result == "true" {
+		return true
+	}
+	if result == "false" {
+		return false
+	}
+	if num, err := strconv.Atoi(result); err == nil {
+		return num != 0
+	}
+	return result != ""
+}
+
+// addDerivedVariables adds the derived variables of the blueprint to the context,
+// in order so that each can use the previous ones. Variables are read leniently
+// here; those whose expression fails are left out, strict generation and lint
+// report them.
+func (g *Generator) addDerivedVariables(context map[string]any, tmpl types.Template) {
+	for _, derived := range tmpl.Derived {
+		value, err := g.evaluateExpression(derived.Expression, context, false)
+		if err != nil {
+			continue
+		}
+		context[derived.Name] = value
+	}
+}
+
+// checkConditionSyntax fails the generation of a blueprint whose derived variables
+// or directory conditions are malformed, before any file is rendered
+func checkConditionSyntax(tmpl types.Template) error {
+	for _, derived := range tmpl.Derived {
+		if !ValidFunctionName(derived.Name) {
+			return types.NewValidationError(fmt.Sprintf("blueprint %s derives a variable with an invalid name %q", tmpl.ID, derived.Name), nil)
+		}
+		if isTemplateExpression(derived.Expression) {
+			continue
+		}
+		if _, err := parseCondition(derived.Expression); err != nil {
+			return types.NewValidationError(fmt.Sprintf("derived variable %s of blueprint %s: %v", derived.Name, tmpl.ID, err), err)
+		}
+	}
+	for _, dir := range tmpl.Directories {
+		if isTemplateExpression(dir.Condition) {
+			continue
+		}
+		if _, err := parseCondition(dir.Condition); err != nil {
+			return types.NewValidationError(fmt.Sprintf("condition of directory %s of blueprint %s: %v", dir.Path, tmpl.ID, err), err)
+		}
+	}
+	return nil
+}
+
+// includeFile reports whether a file of the blueprint is generated: its own
+// condition and those of the directories holding its source must all hold
+func (g *Generator) includeFile(tmpl types.Template, file types.TemplateFile, context map[string]any) (bool, error) {
+	for _, dir := range tmpl.Directories {
+		if !InDirectory(file.Source, dir.Path) {
+			continue
+		}
+		include, err := g.evaluateCondition(dir.Condition, context)
+		if err != nil {
+			return false, fmt.Errorf("directory %s: %w", dir.Path, err)
+		}
+		if !include {
+			return false, nil
+		}
+	}
+	if file.Condition == "" {
+		return true, nil
+	}
+	return g.evaluateCondition(file.Condition, context)
+}
+
+// InDirectory reports whether source is under dir, both relative to the blueprint
+func InDirectory(source, dir string) bool {
+	dir = path.Clean(strings.TrimSuffix(dir, "/"))
+	if dir == "." {
+		return true
+	}
+	return strings.HasPrefix(path.Clean(source)+"/", dir+"/")
+}
+
+// conditionVariableRefs returns the variables a condition references. Malformed
+// expressions reference none: evaluating them reports the error.
+func conditionVariableRefs(condition string, funcs template.FuncMap) ([]variableRef, error) {
+	if isTemplateExpression(condition) {
+		return templateVariableRefs("template.yaml", condition, funcs)
+	}
+	if strings.TrimSpace(condition) == "" {
+		return nil, nil
+	}
+	expr, err := parseCondition(condition)
+	if err != nil {
+		return nil, nil
+	}
+	var refs []variableRef
+	expr.walk(func(e conditionExpr) {
+		if v, ok := e.(conditionVariable); ok {
+			refs = append(refs, variableRef{name: v.path[0]})
+		}
+	})
+	return refs, nil
+}
+
+// conditionExpr is a node of a parsed condition
+type conditionExpr interface {
+	eval(context map[string]any, strict bool) (any, error)
+	walk(visit func(conditionExpr))
+}
+
+type (
+	conditionLiteral  struct{ value any }
+	conditionVariable struct{ path []string }
+	conditionList     struct{ items []conditionExpr }
+	conditionNot      struct{ x conditionExpr }
+	conditionLogical  struct {
+		and  bool
+		x, y conditionExpr
+	}
+	conditionCompare struct {
+		equal bool
+		x, y  conditionExpr
+	}
+	conditionIn struct {
+		negated bool
+		x, set  conditionExpr
+	}
+)
+
+func (e conditionLiteral) eval(map[string]any, bool) (any, error) { return e.value, nil }
+
+func (e conditionVariable) eval(context map[string]any, strict bool) (any, error) {
+	var value any = context
+	for i, name := range e.path {
+		next, ok := lookupField(value, name)
+		if !ok {
+			if strict {
+				return nil, fmt.Errorf("undefined variable %q", strings.Join(e.path[:i+1], "."))
+			}
+			return nil, nil
+		}
+		value = next
+	}
+	return value, nil
+}
+
+// lookupField returns the entry of a map or the field of a struct named name
+func lookupField(value any, name string) (any, bool) {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil, false
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+		entry := rv.MapIndex(reflect.ValueOf(name).Convert(rv.Type().Key()))
+		if !entry.IsValid() {
+			return nil, false
+		}
+		return entry.Interface(), true
+	case reflect.Struct:
+		field := rv.FieldByName(name)
+		if !field.IsValid() || !field.CanInterface() {
+			return nil, false
+		}
+		return field.Interface(), true
+	}
+	return nil, false
+}
+
+func (e conditionList) eval(context map[string]any, strict bool) (any, error) {
+	items := make([]any, len(e.items))
+	for i, item := range e.items {
+		value, err := item.eval(context, strict)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = value
+	}
+	return items, nil
+}
+
+func (e conditionNot) eval(context map[string]any, strict bool) (any, error) {
+	value, err := e.x.eval(context, strict)
+	if err != nil {
+		return nil, err
+	}
+	return !truthy(value), nil
+}
+
+func (e conditionLogical) eval(context map[string]any, strict bool) (any, error) {
+	x, err := e.x.eval(context, strict)
+	if err != nil {
+		return nil, err
+	}
+	// Like and and or of templates, the right operand is only evaluated when needed
+	if truthy(x) != e.and {
+		return truthy(x), nil
+	}
+	y, err := e.y.eval(context, strict)
+	if err != nil {
+		return nil, err
+	}
+	return truthy(y), nil
+}
+
+func (e conditionCompare) eval(context map[string]any, strict bool) (any, error) {
+	x, err := e.x.eval(context, strict)
+	if err != nil {
+		return nil, err
+	}
+	y, err := e.y.eval(context, strict)
+	if err != nil {
+		return nil, err
+	}
+	return (conditionText(x) == conditionText(y)) == e.equal, nil
+}
+
+func (e conditionIn) eval(context map[string]any, strict bool) (any, error) {
+	x, err := e.x.eval(context, strict)
+	if err != nil {
+		return nil, err
+	}
+	set, err := e.set.eval(context, strict)
+	if err != nil {
+		return nil, err
+	}
+	text := conditionText(x)
+	found := false
+	for _, item := range conditionItems(set) {
+		if conditionText(item) == text {
+			found = true
+			break
+		}
+	}
+	return found != e.negated, nil
+}
+
+// conditionText returns the text values compare as, undefined values being empty
+func conditionText(value any) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// conditionItems returns the items of the right operand of in: those of a list,
+// the comma-separated values of a string, or the value itself
+func conditionItems(set any) []any {
+	switch v := set.(type) {
+	case nil:
+		return nil
+	case string:
+		if v == "" {
+			return nil
+		}
+		var items []any
+		for _, item := range strings.Split(v, ",") {
+			items = append(items, strings.TrimSpace(item))
+		}
+		return items
+	}
+	rv := reflect.ValueOf(set)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []any{set}
+	}
+	items := make([]any, rv.Len())
+	for i := range items {
+		items[i] = rv.Index(i).Interface()
+	}
+	return items
+}
+
+func (e conditionLiteral) walk(visit func(conditionExpr))  { visit(e) }
+func (e conditionVariable) walk(visit func(conditionExpr)) { visit(e) }
+
+func (e conditionList) walk(visit func(conditionExpr)) {
+	visit(e)
+	for _, item := range e.items {
+		item.walk(visit)
+	}
+}
+
+func (e conditionNot) walk(visit func(conditionExpr)) {
+	visit(e)
+	e.x.walk(visit)
+}
+
+func (e conditionLogical) walk(visit func(conditionExpr)) {
+	visit(e)
+	e.x.walk(visit)
+	e.y.walk(visit)
+}
+
+func (e conditionCompare) walk(visit func(conditionExpr)) {
+	visit(e)
+	e.x.walk(visit)
+	e.y.walk(visit)
+}
+
+func (e conditionIn) walk(visit func(conditionExpr)) {
+	visit(e)
+	e.x.walk(visit)
+	e.set.walk(visit)
+}
+
+// conditionToken is a token of a condition; kind is one of "ident", "string",
+// "number" or the operator or punctuation itself, and "" at the end
+type conditionToken struct {
+	kind string
+	text string
+	pos  int
+}
+
+// conditionParser parses a condition by recursive descent:
+//
+//	or      = and { ("or" | "||") and }
+//	and     = not { ("and" | "&&") not }
+//	not     = ("not" | "!") not | compare
+//	compare = operand [ ("==" | "!=") operand | ["not"] "in" operand ]
+//	operand = literal | variable | list | "(" or ")"
+type conditionParser struct {
+	tokens []conditionToken
+	next   int
+}
+
+// parseCondition parses an expression of the condition language
+func parseCondition(condition string) (conditionExpr, error) {
+	tokens, err := tokenizeCondition(condition)
+	if err != nil {
+		return nil, err
+	}
+	p := &conditionParser{tokens: tokens}
+	if p.peek().kind == "" {
+		return nil, fmt.Errorf("empty condition")
+	}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token := p.peek(); token.kind != "" {
+		return nil, p.unexpected(token)
+	}
+	return expr, nil
+}
+
+func (p *conditionParser) peek() conditionToken {
+	return p.tokens[p.next]
+}
+
+// accept consumes the next token when it is one of the keywords or operators
+func (p *conditionParser) accept(words ...string) bool {
+	token := p.peek()
+	for _, word := range words {
+		if token.kind == word || token.kind == "ident" && token.text == word {
+			p.next++
+			return true
+		}
+	}
+	return false
+}
+
+func (p *conditionParser) unexpected(token conditionToken) error {
+	if token.kind == "" {
+		return fmt.Errorf("unexpected end of condition")
+	}
+	return fmt.Errorf("unexpected %q at column %d", token.text, token.pos+1)
+}
+
+func (p *conditionParser) parseOr() (conditionExpr, error) {
+	x, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("or", "||") {
+		y, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		x = conditionLogical{x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *conditionParser) parseAnd() (conditionExpr, error) {
+	x, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("and", "&&") {
+		y, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		x = conditionLogical{and: true, x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *conditionParser) parseNot() (conditionExpr, error) {
+	if p.accept("not", "!") {
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return conditionNot{x: x}, nil
+	}
+	return p.parseCompare()
+}
+
+func (p *conditionParser) parseCompare() (conditionExpr, error) {
+	x, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case p.accept("==", "!="):
+		equal := p.tokens[p.next-1].kind == "=="
+		y, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return conditionCompare{equal: equal, x: x, y: y}, nil
+	case p.accept("in"):
+		set, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return conditionIn{x: x, set: set}, nil
+	case p.peek().text == "not" && p.next+1 < len(p.tokens) && p.tokens[p.next+1].text == "in":
+		p.next += 2
+		set, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return conditionIn{negated: true, x: x, set: set}, nil
+	}
+	return x, nil
+}
+
+func (p *conditionParser) parseOperand() (conditionExpr, error) {
+	token := p.peek()
+	switch token.kind {
+	case "string":
+		p.next++
+		return conditionLiteral{value: token.text}, nil
+	case "number":
+		p.next++
+		return conditionLiteral{value: token.text}, nil
+	case "ident":
+		switch token.text {
+		case "true", "false":
+			p.next++
+			return conditionLiteral{value: token.text == "true"}, nil
+		case "and", "or", "not", "in":
+			return nil, p.unexpected(token)
+		}
+		p.next++
+		return conditionVariable{path: strings.Split(strings.TrimPrefix(token.text, "."), ".")}, nil
+	case "(":
+		p.next++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, p.unexpected(p.peek())
+		}
+		return expr, nil
+	case "[":
+		p.next++
+		var list conditionList
+		for !p.accept("]") {
+			if len(list.items) > 0 && !p.accept(",") {
+				return nil, p.unexpected(p.peek())
+			}
+			item, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			list.items = append(list.items, item)
+		}
+		return list, nil
+	}
+	return nil, p.unexpected(token)
+}
+
+// tokenizeCondition splits a condition into tokens, ending with an end token
+func tokenizeCondition(condition string) ([]conditionToken, error) {
+	var tokens []conditionToken
+	runes := []rune(condition)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"' || r == '\'':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				if runes[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at column %d", i+1)
+			}
+			text := string(runes[i+1 : end])
+			if r == '"' {
+				unquoted, err := strconv.Unquote(string(runes[i : end+1]))
+				if err != nil {
+					return nil, fmt.Errorf("invalid string at column %d", i+1)
+				}
+				text = unquoted
+			}
+			tokens = append(tokens, conditionToken{kind: "string", text: text, pos: i})
+			i = end + 1
+		case unicode.IsDigit(r) || r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1]):
+			end := i + 1
+			for end < len(runes) && (unicode.IsDigit(runes[end]) || runes[end] == '.') {
+				end++
+			}
+			tokens = append(tokens, conditionToken{kind: "number", text: string(runes[i:end]), pos: i})
+			i = end
+		case unicode.IsLetter(r) || r == '_' || r == '.':
+			end := i + 1
+			for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) || runes[end] == '_' || runes[end] == '.') {
+				end++
+			}
+			text := string(runes[i:end])
+			if strings.HasSuffix(text, ".") || strings.Contains(text, "..") || text == "." {
+				return nil, fmt.Errorf("invalid variable %q at column %d", text, i+1)
+			}
+			tokens = append(tokens, conditionToken{kind: "ident", text: text, pos: i})
+			i = end
+		default:
+			two := ""
+			if i+1 < len(runes) {
+				two = string(runes[i : i+2])
+			}
+			switch {
+			case two == "==" || two == "!=" || two == "&&" || two == "||":
+				tokens = append(tokens, conditionToken{kind: two, text: two, pos: i})
+				i += 2
+			case strings.ContainsRune("!()[],", r):
+				tokens = append(tokens, conditionToken{kind: string(r), text: string(r), pos: i})
+				i++
+			default:
+				return nil, fmt.Errorf("unexpected %q at column %d", string(r), i+1)
+			}
+		}
+	}
+	return append(tokens, conditionToken{pos: len(runes)}), nil
+}
@@ -0,0 +1,215 @@
+package generator
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestEvaluateCondition_Expressions(t *testing.T) {
+	vars := map[string]any{
+		"Framework":       "gin",
+		"DatabaseDriver":  "",
+		"AuthType":        "jwt",
+		"Port":            8080,
+		"RuntimeConfig":   "false",
+		"HasDatabase":     false,
+		"DatabaseDrivers": []string{"postgres", "redis"},
+		"Entrypoints":     "api, worker",
+		"Features":        &types.Features{Database: types.DatabaseConfig{ORM: "gorm"}},
+	}
+
+	tests := []struct {
+		condition string
+		want      bool
+	}{
+		{`Framework == "gin"`, true},
+		{`.Framework != "gin"`, false},
+		{`Framework in ["gin", "echo"]`, true},
+		{`Framework not in ["gin", "echo"]`, false},
+		{`Framework in []`, false},
+		{`"redis" in DatabaseDrivers`, true},
+		{`"worker" in Entrypoints`, true},
+		{`"cli" in Entrypoints`, false},
+		{`Port == 8080 and Port == "8080"`, true},
+		{`AuthType != "" && DatabaseDriver == ""`, true},
+		{`DatabaseDriver or AuthType == "session"`, false},
+		{`not HasDatabase`, true},
+		{`!RuntimeConfig`, true},
+		{`not (Framework == "echo" or AuthType == "none") and true`, true},
+		{`Features.Database.ORM == 'gorm'`, true},
+		{`Undefined`, false},
+		{`Undefined == ""`, true},
+		{`Framework`, true},
+	}
+	g := &Generator{}
+	for _, tt := range tests {
+		got, err := g.evaluateCondition(tt.condition, vars)
+		require.NoError(t, err, tt.condition)
+		assert.Equal(t, tt.want, got, tt.condition)
+	}
+
+	// Template conditions are evaluated as before
+	got, err := g.evaluateCondition(`{{eq .Framework "gin"}}`, vars)
+	require.NoError(t, err)
+	assert.True(t, got)
+}
+
+func TestEvaluateCondition_Errors(t *testing.T) {
+	tests := map[string]string{
+		`Framework ==`:              "unexpected end of condition",
+		`Framework = "gin"`:         `unexpected "=" at column 11`,
+		`Framework in ["gin" "x"]`:  `unexpected "x" at column 21`,
+		`(Framework == "gin"`:       "unexpected end of condition",
+		`Framework == "gin`:         "unterminated string at column 14",
+		`Framework == "gin" and or`: `unexpected "or" at column 24`,
+		`Framework.`:                `invalid variable "Framework."`,
+		`   `:                       "empty condition",
+	}
+	g := &Generator{}
+	for condition, message := range tests {
+		_, err := g.evaluateCondition(condition, map[string]any{"Framework": "gin"})
+		require.Error(t, err, condition)
+		assert.Contains(t, err.Error(), message, condition)
+	}
+
+	strict := &Generator{strict: true}
+	_, err := strict.evaluateCondition(`Framework == "gin" or Missing`, map[string]any{"Framework": "echo"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `undefined variable "Missing"`)
+	// The right operand is not evaluated once the left one decides
+	got, err := strict.evaluateCondition(`Framework == "gin" or Missing`, map[string]any{"Framework": "gin"})
+	require.NoError(t, err)
+	assert.True(t, got)
+}
+
+func TestGenerateInMemoryFiles_DerivedVariablesAndDirectories(t *testing.T) {
+	registry := newTestRegistry(t, fstest.MapFS{
+		"dsl-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "dsl-test"
+name: "dsl-test"
+type: "web-api"
+variables:
+  - name: "Router"
+    default: "gin"
+  - name: "Queue"
+    default: ""
+derived:
+  - name: "HasRouter"
+    expression: 'Router in ["gin", "echo", "chi"]'
+  - name: "HasWorker"
+    expression: 'Queue != "" and HasRouter'
+  - name: "WorkerName"
+    expression: '{{.ProjectName}}-worker'
+directories:
+  - path: "worker"
+    condition: "HasWorker"
+  - path: "worker/kafka/"
+    condition: 'Queue == "kafka"'
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+  - source: "worker/worker.go.tmpl"
+    destination: "internal/worker/worker.go"
+  - source: "worker/kafka/consumer.go.tmpl"
+    destination: "internal/worker/kafka/consumer.go"
+  - source: "worker/kafka/consumer_test.go.tmpl"
+    destination: "internal/worker/kafka/consumer_test.go"
+    condition: "not SkipTests"
+  - source: "workers.md.tmpl"
+    destination: "WORKERS.md"
+`)},
+		"dsl-test/main.go.tmpl":                       &fstest.MapFile{Data: []byte("package main // {{.HasRouter}} {{.HasWorker}}\n")},
+		"dsl-test/worker/worker.go.tmpl":              &fstest.MapFile{Data: []byte("package worker // {{.WorkerName}}\n")},
+		"dsl-test/worker/kafka/consumer.go.tmpl":      &fstest.MapFile{Data: []byte("package kafka\n")},
+		"dsl-test/worker/kafka/consumer_test.go.tmpl": &fstest.MapFile{Data: []byte("package kafka\n")},
+		"dsl-test/workers.md.tmpl":                    &fstest.MapFile{Data: []byte("# Workers\n")},
+	})
+
+	generate := func(variables map[string]string) map[string]GeneratedFile {
+		t.Helper()
+		config := &types.ProjectConfig{Name: "shop", Module: "example.com/shop", Type: "web-api", Variables: variables}
+		files, err := NewWithRegistry(registry).GenerateInMemoryFiles(context.Background(), config, "dsl-test")
+		require.NoError(t, err)
+		return files
+	}
+
+	files := generate(nil)
+	assert.Equal(t, "package main // true false\n", string(files["main.go"].Content))
+	assert.Contains(t, files, "WORKERS.md", "worker/ does not hold workers.md")
+	assert.NotContains(t, files, "internal/worker/worker.go")
+	assert.NotContains(t, files, "internal/worker/kafka/consumer.go")
+
+	files = generate(map[string]string{"Queue": "nats"})
+	assert.Equal(t, "package worker // shop-worker\n", string(files["internal/worker/worker.go"].Content))
+	assert.NotContains(t, files, "internal/worker/kafka/consumer.go")
+
+	files = generate(map[string]string{"Queue": "kafka"})
+	assert.Contains(t, files, "internal/worker/kafka/consumer.go")
+	assert.Contains(t, files, "internal/worker/kafka/consumer_test.go")
+
+	files = generate(map[string]string{"Queue": "kafka", "Router": "fiber"})
+	assert.NotContains(t, files, "internal/worker/worker.go")
+	assert.NotContains(t, files, "internal/worker/kafka/consumer.go", "the conditions of every enclosing directory apply")
+}
+
+func TestCheckConditionSyntax(t *testing.T) {
+	tmpl := types.Template{ID: "bp", Derived: []types.DerivedVariable{{Name: "HasRouter", Expression: `Framework in ["gin"`}}}
+	err := checkConditionSyntax(tmpl)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "derived variable HasRouter of blueprint bp: unexpected end of condition")
+
+	tmpl = types.Template{ID: "bp", Derived: []types.DerivedVariable{{Name: "has-router", Expression: "true"}}}
+	assert.ErrorContains(t, checkConditionSyntax(tmpl), `invalid name "has-router"`)
+
+	tmpl = types.Template{ID: "bp", Directories: []types.DirectoryCondition{{Path: "worker", Condition: "Queue =="}}}
+	assert.ErrorContains(t, checkConditionSyntax(tmpl), "condition of directory worker of blueprint bp")
+
+	tmpl = types.Template{
+		ID:          "bp",
+		Derived:     []types.DerivedVariable{{Name: "HasRouter", Expression: "{{.Framework}}"}},
+		Directories: []types.DirectoryCondition{{Path: "worker", Condition: "HasRouter and not Skip"}},
+	}
+	assert.NoError(t, checkConditionSyntax(tmpl))
+}
+
+func TestInDirectory(t *testing.T) {
+	assert.True(t, InDirectory("worker/worker.go.tmpl", "worker"))
+	assert.True(t, InDirectory("worker/kafka/consumer.go.tmpl", "worker/"))
+	assert.True(t, InDirectory("./worker/worker.go.tmpl", "worker"))
+	assert.False(t, InDirectory("workers.md.tmpl", "worker"))
+	assert.False(t, InDirectory("cmd/worker/main.go.tmpl", "worker"))
+	assert.True(t, InDirectory("main.go.tmpl", "."))
+}
+
+func TestAnalyzeTemplateVariables_Expressions(t *testing.T) {
+	setupTestTemplates(t)
+	g := New()
+	tmpl := types.Template{
+		ID:        "bp",
+		Variables: []types.TemplateVariable{{Name: "Queue"}},
+		Derived:   []types.DerivedVariable{{Name: "HasWorker", Expression: `Queue != "" and Brokers`}},
+		Directories: []types.DirectoryCondition{
+			{Path: "worker", Condition: "HasWorker and .Retries"},
+		},
+		Files: []types.TemplateFile{
+			{Source: "missing.go.tmpl", Destination: "main.go", Condition: `not (Framework in ["gin"] or Tracing)`},
+		},
+	}
+
+	issues, err := g.AnalyzeTemplateVariables(tmpl)
+	require.NoError(t, err)
+	var undefined []string
+	for _, issue := range UndefinedVariables(issues) {
+		undefined = append(undefined, issue.Variable)
+	}
+	assert.ElementsMatch(t, []string{"Brokers", "Retries", "Tracing"}, undefined)
+	for _, issue := range issues {
+		assert.NotEqual(t, "Queue", issue.Variable, "Queue is used by an expression")
+	}
+}
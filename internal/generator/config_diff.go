@@ -0,0 +1,112 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Statuses of a FileChange
+const (
+	FileAdded    = "added"
+	FileRemoved  = "removed"
+	FileModified = "modified"
+)
+
+// FileChange is a file that differs between the projects of two configurations
+type FileChange struct {
+	Path string `json:"path"`
+	// Status is FileAdded, FileRemoved or FileModified
+	Status string `json:"status"`
+	// Binary files come without a patch
+	Binary bool   `json:"binary,omitempty"`
+	Patch  string `json:"patch,omitempty"`
+	// Insertions and Deletions count the changed lines of the patch
+	Insertions int `json:"insertions"`
+	Deletions  int `json:"deletions"`
+}
+
+// ConfigDiff is what changes between the projects two configurations generate
+type ConfigDiff struct {
+	From      string       `json:"from"`
+	To        string       `json:"to"`
+	Changes   []FileChange `json:"changes"`
+	Unchanged int          `json:"unchanged"`
+}
+
+// DiffConfigs generates the projects of from and to in memory, each from the
+// blueprint its configuration selects, and lists the files that differ in path
+// order. The generation manifest, which always differs, and lock are left out.
+func (g *Generator) DiffConfigs(ctx context.Context, from, to types.ProjectConfig) (*ConfigDiff, error) {
+	diff := &ConfigDiff{From: g.getTemplateID(from), To: g.getTemplateID(to), Changes: []FileChange{}}
+
+	before, err := g.GenerateInMemoryFiles(ctx, &from, diff.From)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s: %w", diff.From, err)
+	}
+	after, err := g.GenerateInMemoryFiles(ctx, &to, diff.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s: %w", diff.To, err)
+	}
+
+	paths := slices.Collect(maps.Keys(before))
+	for path := range after {
+		if _, ok := before[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	slices.Sort(paths)
+	for _, path := range paths {
+		if isMetadataFile(path) {
+			continue
+		}
+		old, existed := before[path]
+		current, exists := after[path]
+
+		change := FileChange{Path: path, Binary: old.Binary || current.Binary}
+		fromName, toName := "a/"+path, "b/"+path
+		switch {
+		case !existed:
+			change.Status, fromName = FileAdded, "/dev/null"
+		case !exists:
+			change.Status, toName = FileRemoved, "/dev/null"
+		case sameFile(old, current):
+			diff.Unchanged++
+			continue
+		default:
+			change.Status = FileModified
+		}
+		if !change.Binary {
+			change.Patch = unifiedDiff(fromName, toName, diffContent(old), diffContent(current))
+			change.Insertions, change.Deletions = countChanges(change.Patch)
+		}
+		diff.Changes = append(diff.Changes, change)
+	}
+	return diff, nil
+}
+
+// diffContent is the content of a file as diffed, a symlink diffs as its target
+func diffContent(file GeneratedFile) []byte {
+	if file.Symlink != "" {
+		return []byte("symlink -> " + file.Symlink + "\n")
+	}
+	return file.Content
+}
+
+// countChanges counts the inserted and deleted lines of a unified diff
+func countChanges(patch string) (insertions, deletions int) {
+	for _, line := range strings.Split(patch, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+		case strings.HasPrefix(line, "+"):
+			insertions++
+		case strings.HasPrefix(line, "-"):
+			deletions++
+		}
+	}
+	return insertions, deletions
+}
@@ -0,0 +1,57 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerator_DiffConfigs(t *testing.T) {
+	registry := setupAddTestTemplates(t)
+
+	config := func(driver string) types.ProjectConfig {
+		return types.ProjectConfig{
+			Name:      "svc",
+			Module:    "github.com/test/svc",
+			Type:      "web-api",
+			Variables: map[string]string{"blueprint_id": "add-test", "DatabaseDriver": driver},
+		}
+	}
+
+	diff, err := NewWithRegistry(registry).DiffConfigs(context.Background(), config(""), config("postgres"))
+	require.NoError(t, err)
+	assert.Equal(t, "add-test", diff.From)
+	assert.Equal(t, "add-test", diff.To)
+	assert.Equal(t, 3, diff.Unchanged, "go.mod, main.go and the Dockerfile do not change, the manifest is left out")
+	require.Len(t, diff.Changes, 3)
+
+	assert.Equal(t, "config.go", diff.Changes[0].Path)
+	assert.Equal(t, FileModified, diff.Changes[0].Status)
+	assert.Contains(t, diff.Changes[0].Patch, "--- a/config.go\n+++ b/config.go\n")
+	assert.Contains(t, diff.Changes[0].Patch, "-const driver = \"\"\n+const driver = \"postgres\"\n")
+	assert.Equal(t, 1, diff.Changes[0].Insertions)
+	assert.Equal(t, 1, diff.Changes[0].Deletions)
+
+	assert.Equal(t, FileChange{
+		Path:       "internal/db/db.go",
+		Status:     FileAdded,
+		Patch:      "--- /dev/null\n+++ b/internal/db/db.go\n@@ -0,0 +1,4 @@\n+package db\n+\n+//\n+\n",
+		Insertions: 4,
+	}, diff.Changes[1])
+	assert.Equal(t, "routes.go", diff.Changes[2].Path)
+
+	diff, err = NewWithRegistry(registry).DiffConfigs(context.Background(), config("postgres"), config(""))
+	require.NoError(t, err)
+	assert.Equal(t, FileRemoved, diff.Changes[1].Status)
+	assert.Contains(t, diff.Changes[1].Patch, "--- a/internal/db/db.go\n+++ /dev/null\n")
+
+	missing := config("")
+	missing.Variables["blueprint_id"] = "missing"
+	_, err = NewWithRegistry(registry).DiffConfigs(context.Background(), config(""), missing)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to generate missing")
+}
@@ -0,0 +1,41 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// CoordinationVariable is the blueprint variable that selects the distributed locks
+// the replicas of a service share. Blueprints offer the choice by declaring it.
+const CoordinationVariable = "Coordination"
+
+// LeaderElectionVariable is the blueprint variable that runs the background jobs
+// on the replica holding a Kubernetes Lease
+const LeaderElectionVariable = "LeaderElection"
+
+// checkCoordination rejects distributed locks and leader election for blueprints that
+// do not offer them, and PostgreSQL advisory locks for projects without PostgreSQL
+func checkCoordination(tmpl types.Template, config types.ProjectConfig) error {
+	locks := config.Variables[CoordinationVariable]
+	leaderElection := config.Variables[LeaderElectionVariable] == "true"
+	if locks == "" && !leaderElection {
+		return nil
+	}
+
+	declared := false
+	for _, variable := range tmpl.Variables {
+		if variable.Name == CoordinationVariable {
+			declared = true
+			break
+		}
+	}
+	if !declared {
+		return types.NewValidationError(fmt.Sprintf("blueprint %s does not coordinate replicas, remove --coordination and --leader-election", tmpl.ID), nil)
+	}
+
+	if locks == "postgres" && (config.Features == nil || config.Features.Database.PrimaryDriver() != "postgres") {
+		return types.NewValidationError("postgres locks are advisory locks of the project database and need --database-driver postgres", nil)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_Coordination(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(variables map[string]string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:         "orders",
+			Module:       "github.com/test/orders",
+			Type:         "web-api",
+			Architecture: "clean",
+			Framework:    "gin",
+			Logger:       "slog",
+			Variables:    variables,
+			Features: &types.Features{
+				Database:       types.DatabaseConfig{Driver: "postgres", ORM: "gorm"},
+				Authentication: types.AuthConfig{Type: "jwt"},
+			},
+		}
+	}
+
+	t.Run("single replica by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{DataPrivacyVariable: "true"}), "web-api-clean")
+		require.NoError(t, err)
+
+		assert.NotContains(t, files, "internal/infrastructure/coordination/coordination.go")
+		assert.NotContains(t, string(files["internal/infrastructure/jobs/privacy_jobs.go"].Content), "coordination.WithLock")
+	})
+
+	t.Run("redis locks guard the background jobs", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{
+			CoordinationVariable: "redis",
+			DataPrivacyVariable:  "true",
+		}), "web-api-clean")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "internal/infrastructure/coordination/redis.go")
+		assert.Contains(t, files, "tests/unit/redis_locker_test.go")
+		assert.NotContains(t, files, "internal/infrastructure/coordination/postgres.go")
+		assert.NotContains(t, files, "internal/infrastructure/coordination/leader.go")
+		assert.Contains(t, string(files["internal/infrastructure/jobs/privacy_jobs.go"].Content), "coordination.WithLock")
+		assert.Contains(t, string(files["internal/infrastructure/container/container.go"].Content), "coordination.NewRedisLocker")
+		assert.Contains(t, string(files["go.mod"].Content), "github.com/redis/go-redis/v9")
+	})
+
+	t.Run("postgres locks are advisory locks of the database", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{CoordinationVariable: "postgres"}), "web-api-clean")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "internal/infrastructure/coordination/postgres.go")
+		assert.Contains(t, files, "tests/integration/postgres_locker_test.go")
+		assert.Contains(t, string(files["internal/infrastructure/container/container.go"].Content), "coordination.NewPostgresLocker")
+		assert.NotContains(t, string(files["go.mod"].Content), "go-redis")
+	})
+
+	t.Run("leader election runs the jobs on one replica", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{
+			LeaderElectionVariable: "true",
+			DataPrivacyVariable:    "true",
+			"DeploymentTarget":     "kubernetes",
+		}), "web-api-clean")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "internal/infrastructure/coordination/leader.go")
+		assert.Contains(t, files, "tests/unit/leader_election_test.go")
+		assert.Contains(t, string(files["cmd/server/main.go"].Content), "app.Elector.Run(jobsCtx, app.PrivacyJobs.Run)")
+		assert.Contains(t, string(files["go.mod"].Content), "k8s.io/client-go")
+
+		assert.Contains(t, string(files["deployments/k8s/rbac.yaml"].Content), `resources: ["leases"]`)
+		deployment := string(files["deployments/k8s/deployment.yaml"].Content)
+		assert.Contains(t, deployment, "serviceAccountName: orders")
+		assert.Contains(t, deployment, "fieldPath: metadata.namespace")
+	})
+
+	t.Run("postgres locks need a postgres database", func(t *testing.T) {
+		cfg := config(map[string]string{CoordinationVariable: "postgres"})
+		cfg.Features.Database.Driver = "mysql"
+		_, err := New().GenerateInMemoryFiles(ctx, cfg, "web-api-clean")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--database-driver postgres")
+	})
+
+	t.Run("other blueprints reject coordination", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, &types.ProjectConfig{
+			Name:      "helper",
+			Module:    "github.com/test/helper",
+			Type:      "bot",
+			Logger:    "slog",
+			Variables: map[string]string{LeaderElectionVariable: "true"},
+		}, "bot")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "remove --coordination and --leader-election")
+	})
+}
@@ -0,0 +1,38 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// DataPrivacyVariable is the blueprint variable that turns on the personal data
+// export and account deletion flows. Blueprints offer them by declaring it.
+const DataPrivacyVariable = "DataPrivacy"
+
+// checkDataPrivacy rejects the data privacy flows for blueprints that do not offer
+// them, and for projects without the stored, authenticated users they serve
+func checkDataPrivacy(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[DataPrivacyVariable] != "true" {
+		return nil
+	}
+
+	declared := false
+	for _, variable := range tmpl.Variables {
+		if variable.Name == DataPrivacyVariable {
+			declared = true
+			break
+		}
+	}
+	if !declared {
+		return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer data export and account deletion, remove --data-privacy", tmpl.ID), nil)
+	}
+
+	if config.Features == nil || !config.Features.Database.HasDatabase() {
+		return types.NewValidationError("data export and account deletion work on stored users and need a database, set --database-driver", nil)
+	}
+	if auth := config.Features.Authentication.Type; auth == "" || auth == "none" {
+		return types.NewValidationError("data export and account deletion serve logged-in users and need authentication, set --auth-type", nil)
+	}
+	return nil
+}
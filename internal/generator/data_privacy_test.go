@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_DataPrivacy(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(privacy, driver, auth string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:         "inventory",
+			Module:       "github.com/test/inventory",
+			Type:         "web-api",
+			Architecture: "clean",
+			Framework:    "gin",
+			Logger:       "slog",
+			Variables:    map[string]string{DataPrivacyVariable: privacy},
+			Features: &types.Features{
+				Database:       types.DatabaseConfig{Driver: driver, ORM: "gorm"},
+				Authentication: types.AuthConfig{Type: auth},
+			},
+		}
+	}
+
+	t.Run("flows are left out by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("false", "postgres", "jwt"), "web-api-clean")
+		require.NoError(t, err)
+		assert.NotContains(t, files, "internal/domain/usecases/privacy_usecase.go")
+		assert.NotContains(t, files, "internal/infrastructure/jobs/privacy_jobs.go")
+		assert.NotContains(t, string(files["internal/infrastructure/persistence/user_repository.go"].Content), "PurgeAt")
+	})
+
+	t.Run("flag adds the endpoints, jobs and audit log", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("true", "postgres", "jwt"), "web-api-clean")
+		require.NoError(t, err)
+		require.Contains(t, files, "internal/domain/usecases/privacy_usecase.go")
+		require.Contains(t, files, "internal/infrastructure/persistence/audit_log_repository.go")
+		require.Contains(t, files, "tests/unit/privacy_usecase_test.go")
+
+		routes := string(files["internal/infrastructure/web/router.go"].Content)
+		assert.Contains(t, routes, `protected.GET("/exports/:id/download", controller.DownloadExport)`)
+		assert.Contains(t, string(files["cmd/server/main.go"].Content), "app.PrivacyJobs.Run(jobsCtx)")
+		assert.Contains(t, string(files["internal/infrastructure/persistence/migrations.go"].Content), "&AuditEventModel{}")
+	})
+
+	t.Run("flows need a database and authentication", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("true", "", "jwt"), "web-api-clean")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "need a database")
+
+		_, err = New().GenerateInMemoryFiles(ctx, config("true", "postgres", ""), "web-api-clean")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "need authentication")
+	})
+
+	t.Run("blueprints without the flows reject the flag", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("true", "postgres", "jwt"), "web-api-hexagonal")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer data export and account deletion")
+	})
+}
@@ -0,0 +1,58 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_Desktop(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	files, err := New().GenerateInMemoryFiles(ctx, &types.ProjectConfig{
+		Name:   "notes",
+		Module: "github.com/test/notes",
+		Type:   "desktop",
+		Logger: "zap",
+	}, "desktop")
+	require.NoError(t, err)
+
+	for _, path := range []string{
+		"main.go",
+		"wails.json",
+		"frontend/dist/index.html",
+		"frontend/dist/main.js",
+		"internal/app/app.go",
+		"internal/app/api.go",
+		"internal/app/app_test.go",
+		"internal/logger/wails.go",
+		"internal/logger/zap.go",
+		".github/workflows/build.yml",
+	} {
+		assert.Contains(t, files, path)
+	}
+	assert.NotContains(t, files, "internal/logger/slog.go")
+
+	main := string(files["main.go"].Content)
+	assert.Contains(t, main, "//go:embed all:frontend/dist")
+	assert.Contains(t, main, "OnStartup:        application.Startup")
+	assert.Contains(t, main, "Logger:             logger.NewWailsLogger(log)")
+
+	wails := string(files["wails.json"].Content)
+	assert.Contains(t, wails, `"name": "notes"`)
+	assert.Contains(t, wails, `"assetdir": "frontend/dist"`)
+
+	assert.Contains(t, string(files["frontend/dist/main.js"].Content), "window.go.app.API")
+	assert.Contains(t, string(files["Makefile"].Content), "-platform darwin/universal")
+	assert.Contains(t, string(files[".github/workflows/build.yml"].Content), "platform: windows/amd64")
+
+	goMod := string(files["go.mod"].Content)
+	assert.Contains(t, goMod, "go 1.22")
+	assert.Contains(t, goMod, "github.com/wailsapp/wails/v2 v2.10.2")
+	assert.Contains(t, goMod, "go.uber.org/zap")
+}
@@ -0,0 +1,25 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// DIVariable is the blueprint variable that selects how the container wires the
+// dependencies: the hand-written container, google/wire, uber/fx or samber/do.
+// Blueprints with a composition root offer the choice by declaring it.
+const DIVariable = "DI"
+
+// checkDI rejects a dependency injection style for blueprints that do not offer one
+func checkDI(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[DIVariable] == "" {
+		return nil
+	}
+	for _, variable := range tmpl.Variables {
+		if variable.Name == DIVariable {
+			return nil
+		}
+	}
+	return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer a choice of dependency injection, remove --di", tmpl.ID), nil)
+}
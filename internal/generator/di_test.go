@@ -0,0 +1,101 @@
+package generator
+
+import (
+	"context"
+	"go/format"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_DI(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(architecture, di string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:         "orders",
+			Module:       "github.com/test/orders",
+			Type:         "web-api",
+			Architecture: architecture,
+			Framework:    "gin",
+			Logger:       "slog",
+			Variables:    map[string]string{DIVariable: di, AdminEndpointsVariable: "true"},
+			Features: &types.Features{
+				Database:       types.DatabaseConfig{Driver: "postgres", ORM: "gorm"},
+				Authentication: types.AuthConfig{Type: "jwt"},
+			},
+		}
+	}
+
+	const dir = "internal/infrastructure/container/"
+	wiring := map[string][]string{
+		"manual": nil,
+		"wire":   {"providers.go", "wire.go", "wire_gen.go"},
+		"fx":     {"providers.go", "fx.go"},
+		"do":     {"providers.go", "do.go"},
+	}
+	modules := map[string]string{
+		"wire": "github.com/google/wire",
+		"fx":   "go.uber.org/fx",
+		"do":   "github.com/samber/do",
+	}
+
+	for _, blueprint := range []string{"web-api-clean", "web-api-hexagonal"} {
+		architecture := strings.TrimPrefix(blueprint, "web-api-")
+		for di, generated := range wiring {
+			t.Run(blueprint+" with "+di, func(t *testing.T) {
+				files, err := New().GenerateInMemoryFiles(ctx, config(architecture, di), blueprint)
+				require.NoError(t, err)
+
+				for _, name := range []string{"providers.go", "wire.go", "wire_gen.go", "fx.go", "do.go"} {
+					if slices.Contains(generated, name) {
+						assert.Contains(t, files, dir+name)
+					} else {
+						assert.NotContains(t, files, dir+name)
+					}
+				}
+				for _, name := range append(generated, "container.go") {
+					_, err := format.Source(files[dir+name].Content)
+					assert.NoError(t, err, name)
+				}
+
+				container := string(files[dir+"container.go"].Content)
+				goMod := string(files["go.mod"].Content)
+				if di == "manual" {
+					assert.NotContains(t, container, "build(")
+				} else {
+					assert.Contains(t, container, "build(")
+					assert.Contains(t, goMod, modules[di])
+				}
+				for other, module := range modules {
+					if other != di {
+						assert.NotContains(t, goMod, module)
+					}
+				}
+			})
+		}
+	}
+
+	t.Run("wire keeps the injector out of regular builds", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("hexagonal", "wire"), "web-api-hexagonal")
+		require.NoError(t, err)
+
+		assert.True(t, strings.HasPrefix(string(files[dir+"wire.go"].Content), "//go:build wireinject"))
+		generated := string(files[dir+"wire_gen.go"].Content)
+		assert.Contains(t, generated, "//go:build !wireinject")
+		assert.Contains(t, generated, "ProvideAuthService(")
+		assert.Contains(t, generated, "NewAdminUserService(")
+	})
+
+	t.Run("blueprints without a container reject the flag", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("standard", "wire"), "web-api")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer a choice of dependency injection")
+	})
+}
@@ -0,0 +1,8 @@
+//go:build !linux && !darwin
+
+package generator
+
+// diskFree cannot determine free space on this platform
+func diskFree(string) int64 {
+	return -1
+}
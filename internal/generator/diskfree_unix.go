@@ -0,0 +1,14 @@
+//go:build linux || darwin
+
+package generator
+
+import "syscall"
+
+// diskFree returns the bytes available to unprivileged users on the volume holding dir
+func diskFree(dir string) int64 {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return -1
+	}
+	return int64(stat.Bavail) * int64(stat.Bsize)
+}
@@ -0,0 +1,48 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// E2EVariable is the blueprint variable that turns on the end-to-end suite run
+// through the generated Go client. Blueprints offer it by declaring it.
+const E2EVariable = "E2E"
+
+// checkE2E rejects the end-to-end suite for blueprints that do not offer it, and for
+// projects missing the Go client, the users or the docker-compose database it drives
+func checkE2E(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[E2EVariable] != "true" {
+		return nil
+	}
+
+	declared := false
+	for _, variable := range tmpl.Variables {
+		if variable.Name == E2EVariable {
+			declared = true
+			break
+		}
+	}
+	if !declared {
+		return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer an end-to-end suite, remove --e2e", tmpl.ID), nil)
+	}
+
+	if config.Framework != "" && config.Framework != "gin" {
+		return types.NewValidationError(fmt.Sprintf("the end-to-end suite is generated for the gin adapter, not %s, set --framework gin", config.Framework), nil)
+	}
+	if !strings.Contains(config.Variables[ClientSDKVariable], "go") {
+		return types.NewValidationError("the end-to-end suite drives the service through the generated Go client, set --client-sdk go", nil)
+	}
+	if config.Features == nil || !config.Features.Database.HasDatabase() {
+		return types.NewValidationError("the end-to-end suite signs up users and needs a database, set --database-driver", nil)
+	}
+	if driver := config.Features.Database.PrimaryDriver(); driver != "postgres" && driver != "mysql" {
+		return types.NewValidationError(fmt.Sprintf("the end-to-end stack runs its database in docker-compose, which supports postgres and mysql but not %s", driver), nil)
+	}
+	if auth := config.Features.Authentication.Type; auth == "" || auth == "none" {
+		return types.NewValidationError("the end-to-end suite logs users in and needs authentication, set --auth-type", nil)
+	}
+	return nil
+}
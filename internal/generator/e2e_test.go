@@ -0,0 +1,89 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_E2E(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(e2e, client, driver, auth string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:         "inventory",
+			Module:       "github.com/test/inventory",
+			Type:         "web-api",
+			Architecture: "clean",
+			Framework:    "gin",
+			Logger:       "slog",
+			Variables:    map[string]string{E2EVariable: e2e, ClientSDKVariable: client},
+			Features: &types.Features{
+				Database:       types.DatabaseConfig{Driver: driver, ORM: "gorm"},
+				Authentication: types.AuthConfig{Type: auth},
+			},
+		}
+	}
+
+	t.Run("suite is left out by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("false", "go", "postgres", "jwt"), "web-api-clean")
+		require.NoError(t, err)
+		assert.NotContains(t, files, "e2e/go.mod")
+		assert.NotContains(t, string(files["Makefile"].Content), "e2e-up")
+		assert.NotContains(t, string(files[".github/workflows/ci.yml"].Content), "e2e")
+	})
+
+	t.Run("flag adds the suite, its stack and the CI job", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("true", "go", "mysql", "jwt"), "web-api-clean")
+		require.NoError(t, err)
+		for _, path := range []string{"e2e/main_test.go", "e2e/auth_test.go", "e2e/users_test.go"} {
+			assert.Contains(t, files, path)
+		}
+
+		gomod := string(files["e2e/go.mod"].Content)
+		assert.Contains(t, gomod, "module github.com/test/inventory/e2e")
+		assert.Contains(t, gomod, "replace github.com/test/inventory/client => ../client")
+
+		compose := string(files["e2e/docker-compose.yml"].Content)
+		assert.Contains(t, compose, "image: mysql:8.0")
+		assert.Contains(t, compose, "DB_AUTO_MIGRATE=true")
+
+		assert.Contains(t, string(files["Makefile"].Content), "docker compose -f e2e/docker-compose.yml -p $(APP_NAME)-e2e")
+		assert.Contains(t, string(files[".github/workflows/ci.yml"].Content), "run: make e2e-down")
+	})
+
+	t.Run("suite needs gin, the Go client, a database and authentication", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("true", "", "postgres", "jwt"), "web-api-clean")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "set --client-sdk go")
+
+		_, err = New().GenerateInMemoryFiles(ctx, config("true", "go", "", "jwt"), "web-api-clean")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "needs a database")
+
+		_, err = New().GenerateInMemoryFiles(ctx, config("true", "go", "sqlite", "jwt"), "web-api-clean")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not sqlite")
+
+		_, err = New().GenerateInMemoryFiles(ctx, config("true", "go", "postgres", ""), "web-api-clean")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "needs authentication")
+
+		cfg := config("true", "go", "postgres", "jwt")
+		cfg.Framework = "echo"
+		_, err = New().GenerateInMemoryFiles(ctx, cfg, "web-api-clean")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "set --framework gin")
+	})
+
+	t.Run("blueprints without the suite reject the flag", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("true", "go", "postgres", "jwt"), "web-api-hexagonal")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer an end-to-end suite")
+	})
+}
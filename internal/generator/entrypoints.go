@@ -0,0 +1,25 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// EntrypointsVariable is the blueprint variable that lists the binaries generated
+// under cmd/ next to each other, sharing the internal packages: the server, an
+// admin CLI and a background worker. Blueprints offer them by declaring it.
+const EntrypointsVariable = "Entrypoints"
+
+// checkEntrypoints rejects entrypoints for blueprints that do not offer them
+func checkEntrypoints(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[EntrypointsVariable] == "" {
+		return nil
+	}
+	for _, variable := range tmpl.Variables {
+		if variable.Name == EntrypointsVariable {
+			return nil
+		}
+	}
+	return types.NewValidationError(fmt.Sprintf("blueprint %s does not generate other entrypoints, remove --entrypoints", tmpl.ID), nil)
+}
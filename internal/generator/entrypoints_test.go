@@ -0,0 +1,84 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_Entrypoints(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(entrypoints, driver string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:      "shop",
+			Module:    "github.com/test/shop",
+			Type:      "web-api",
+			Framework: "gin",
+			Logger:    "slog",
+			Variables: map[string]string{EntrypointsVariable: entrypoints, "DatabaseDriver": driver, "AuthType": "jwt"},
+		}
+	}
+
+	t.Run("the server alone by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("", "postgres"), "web-api")
+		require.NoError(t, err)
+		assert.Contains(t, files, "cmd/server/main.go")
+		assert.NotContains(t, files, "cmd/admin/main.go")
+		assert.NotContains(t, files, "cmd/worker/main.go")
+		assert.NotContains(t, files, "internal/worker/worker.go")
+
+		dockerfile := string(files["Dockerfile"].Content)
+		assert.Contains(t, dockerfile, "FROM runtime AS server")
+		assert.NotContains(t, dockerfile, "AS admin")
+		assert.NotContains(t, string(files["Makefile"].Content), "build-worker")
+	})
+
+	t.Run("the admin CLI and the worker", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("server,cli,worker", "postgres"), "web-api")
+		require.NoError(t, err)
+		for _, path := range []string{"cmd/server/main.go", "cmd/admin/main.go", "cmd/worker/main.go", "internal/worker/worker.go", "internal/worker/worker_test.go"} {
+			assert.Contains(t, files, path)
+		}
+
+		admin := string(files["cmd/admin/main.go"].Content)
+		assert.Contains(t, admin, `"migrate": {"Apply the pending database migrations", runMigrate}`)
+		assert.Contains(t, admin, "bcrypt.GenerateFromPassword", "seeded passwords are stored hashed with authentication")
+		assert.Contains(t, string(files["cmd/worker/main.go"].Content), `Name:     "user-count"`)
+
+		dockerfile := string(files["Dockerfile"].Content)
+		assert.Contains(t, dockerfile, "-o admin ./cmd/admin")
+		assert.Contains(t, dockerfile, "-o worker ./cmd/worker")
+		assert.Contains(t, dockerfile, "FROM runtime AS admin")
+		assert.Contains(t, dockerfile, "FROM runtime AS worker")
+		assert.Regexp(t, `FROM runtime AS server\n[^F]*CMD \["./main"\]\s*$`, dockerfile, "the server stays the default target")
+
+		makefile := string(files["Makefile"].Content)
+		for _, target := range []string{"build-admin:", "build-worker:", "run-worker:", "seed:", "docker-build-admin:", "docker-build-worker:"} {
+			assert.Contains(t, makefile, target)
+		}
+		assert.Contains(t, makefile, "go run ./cmd/admin migrate")
+		assert.Contains(t, string(files["README.md"].Content), "make run-worker")
+	})
+
+	t.Run("the admin CLI alone", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("server,cli", "sqlite"), "web-api")
+		require.NoError(t, err)
+		assert.Contains(t, string(files["cmd/admin/main.go"].Content), "cfg.Database.Name)")
+		assert.NotContains(t, files, "cmd/worker/main.go")
+		assert.NotContains(t, string(files["Dockerfile"].Content), "AS worker")
+	})
+
+	t.Run("other blueprints reject the flag", func(t *testing.T) {
+		cfg := config("server,worker", "postgres")
+		cfg.Architecture = "clean"
+		_, err := New().GenerateInMemoryFiles(ctx, cfg, "web-api-clean")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not generate other entrypoints")
+	})
+}
@@ -0,0 +1,59 @@
+package generator
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// TestGenerateInMemoryFiles_EnvDocs checks that the environment variable reference
+// of the README and .env.example list the same variables, as cmd/envdocs writes
+// them; the generated projects check them against their configuration code
+func TestGenerateInMemoryFiles_EnvDocs(t *testing.T) {
+	setupTestTemplates(t)
+	exampleVariable := regexp.MustCompile(`(?m)^([A-Z0-9_]+)=`)
+	tableVariable := regexp.MustCompile("(?m)^\\| `([A-Z0-9_]+)` \\|")
+
+	for name, features := range map[string]*types.Features{
+		"with the default database": {},
+		"with sqlite and JWT": {
+			Database:       types.DatabaseConfig{Driver: "sqlite"},
+			Authentication: types.AuthConfig{Type: "jwt"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			files, err := New().GenerateInMemoryFiles(context.Background(), &types.ProjectConfig{
+				Name:      "order-api",
+				Module:    "github.com/test/order-api",
+				Type:      "web-api",
+				Framework: "gin",
+				Logger:    "slog",
+				Variables: map[string]string{RuntimeConfigVariable: "true", ChaosVariable: "true"},
+				Features:  features,
+			}, "web-api")
+			require.NoError(t, err)
+			require.Contains(t, files, "cmd/envdocs/main.go")
+
+			readme := string(files["README.md"].Content)
+			start, end := strings.Index(readme, "<!-- env-docs:start -->"), strings.Index(readme, "<!-- env-docs:end -->")
+			require.True(t, start >= 0 && end > start, "the README has the env-docs markers")
+
+			var example, table []string
+			for _, match := range exampleVariable.FindAllStringSubmatch(string(files[".env.example"].Content), -1) {
+				example = append(example, match[1])
+			}
+			for _, match := range tableVariable.FindAllStringSubmatch(readme[start:end], -1) {
+				table = append(table, match[1])
+			}
+			assert.Equal(t, example, table)
+			assert.Contains(t, example, "ORDER_API_OPS_TOKEN")
+			assert.Equal(t, features.Database.Driver == "sqlite", strings.Contains(string(files[".env.example"].Content), "ORDER_API_DATABASE_NAME=order-api.db\n"))
+		})
+	}
+}
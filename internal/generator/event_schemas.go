@@ -0,0 +1,25 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// SchemaFormatVariable is the blueprint variable that selects the format of the
+// event schemas kept in a schema registry. Blueprints offer the choice by declaring it.
+const SchemaFormatVariable = "SchemaFormat"
+
+// checkSchemaFormat rejects an event schema format for blueprints that do not offer one
+func checkSchemaFormat(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[SchemaFormatVariable] == "" {
+		return nil
+	}
+
+	for _, variable := range tmpl.Variables {
+		if variable.Name == SchemaFormatVariable {
+			return nil
+		}
+	}
+	return types.NewValidationError(fmt.Sprintf("blueprint %s does not publish typed events, remove --schema-format", tmpl.ID), nil)
+}
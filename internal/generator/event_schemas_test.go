@@ -0,0 +1,105 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_EventSchemas(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(variables map[string]string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:      "orders",
+			Module:    "github.com/test/orders",
+			Type:      "event-service",
+			Logger:    "slog",
+			Variables: variables,
+		}
+	}
+
+	t.Run("plain JSON events by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{}), "event-service")
+		require.NoError(t, err)
+
+		assert.NotContains(t, files, "schemas/schemas.go")
+		assert.NotContains(t, files, "internal/schema/registry.go")
+		assert.NotContains(t, files, "cmd/schemas/main.go")
+		assert.Contains(t, string(files["internal/handler/orders.go"].Content), "json.Unmarshal(msg.Value, &order)")
+		assert.NotContains(t, string(files["docker-compose.yml"].Content), "schema-registry")
+	})
+
+	t.Run("avro", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{SchemaFormatVariable: "avro"}), "event-service")
+		require.NoError(t, err)
+
+		for _, path := range []string{
+			"schemas/order_placed.avsc",
+			"schemas/order_confirmed.avsc",
+			"internal/events/events.gen.go",
+			"internal/schema/avro.go",
+			"internal/schema/client.go",
+			"internal/schema/serde_test.go",
+			"cmd/schemas/main.go",
+		} {
+			assert.Contains(t, files, path)
+		}
+		assert.NotContains(t, files, "internal/schema/protobuf.go")
+
+		assert.Contains(t, string(files["internal/schema/registry.go"].Content), `const Type = "AVRO"`)
+		assert.Contains(t, string(files["internal/events/generate.go"].Content), "hamba/avro/v2/cmd/avrogen")
+		assert.Contains(t, string(files["internal/handler/orders.go"].Content), "schema.NewDeserializer[events.OrderPlaced]")
+		assert.Contains(t, string(files["cmd/service/main.go"].Content), "schema.NewClient(cfg.SchemaRegistryURL)")
+		assert.Contains(t, string(files["docker-compose.yml"].Content), "schema-registry:")
+		assert.Contains(t, string(files[".github/workflows/ci.yml"].Content), "go run ./cmd/schemas check")
+
+		goMod := string(files["go.mod"].Content)
+		assert.Contains(t, goMod, "go 1.22", "hamba/avro needs Go 1.22")
+		assert.Contains(t, goMod, "github.com/hamba/avro/v2 v2.27.0")
+	})
+
+	t.Run("protobuf", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{SchemaFormatVariable: "protobuf"}), "event-service")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "schemas/orders/v1/orders.proto")
+		assert.Contains(t, files, "buf.gen.yaml")
+		assert.Contains(t, files, "internal/events/orders/v1/orders.pb.go")
+		assert.Contains(t, files, "internal/schema/protobuf.go")
+		assert.NotContains(t, files, "internal/events/events.gen.go")
+
+		assert.Contains(t, string(files["buf.gen.yaml"].Content), "github.com/test/orders/internal/events/orders/v1;ordersv1")
+		assert.Contains(t, string(files["internal/handler/orders.go"].Content), `events "github.com/test/orders/internal/events/orders/v1"`)
+		assert.Contains(t, string(files[".github/workflows/ci.yml"].Content), "buf lint schemas")
+		assert.Contains(t, string(files["go.mod"].Content), "google.golang.org/protobuf v1.34.2")
+	})
+
+	t.Run("json-schema", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{SchemaFormatVariable: "json-schema"}), "event-service")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "schemas/order_placed.json")
+		assert.Contains(t, files, "internal/schema/jsonschema.go")
+		assert.Contains(t, string(files["schemas/order_placed.json"].Content), `"$id": "https://github.com/test/orders/schemas/order_placed.json"`)
+		assert.Contains(t, string(files["internal/schema/registry.go"].Content), `const Type = "JSON"`)
+		assert.Contains(t, string(files["go.mod"].Content), "github.com/santhosh-tekuri/jsonschema/v6 v6.0.2")
+	})
+
+	t.Run("other blueprints reject a schema format", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, &types.ProjectConfig{
+			Name:      "chat",
+			Module:    "github.com/test/chat",
+			Type:      "realtime",
+			Logger:    "slog",
+			Variables: map[string]string{SchemaFormatVariable: "avro"},
+		}, "realtime")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "remove --schema-format")
+	})
+}
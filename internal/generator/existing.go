@@ -0,0 +1,260 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// DefaultExistingBranch is the branch the project generated into an existing
+// repository is committed on when none is given
+const DefaultExistingBranch = "go-starter/scaffold"
+
+// remoteURLPattern matches the URLs of a repository cloned over HTTPS or SSH:
+// https://github.com/acme/shop.git, git@github.com:acme/shop.git and
+// ssh://git@github.com/acme/shop
+var remoteURLPattern = regexp.MustCompile(`^(?:https?://(?:[^@/]+@)?|ssh://(?:[^@/]+@)?|[^@/]+@)([^/:]+)(?::\d+)?[:/](.+?)(?:\.git)?/?$`)
+
+// ModulePathFromRemote returns the module path of a repository from the URL of
+// its remote: github.com/acme/shop for git@github.com:acme/shop.git
+func ModulePathFromRemote(url string) (string, bool) {
+	match := remoteURLPattern.FindStringSubmatch(strings.TrimSpace(url))
+	if match == nil || !strings.Contains(match[2], "/") {
+		return "", false
+	}
+	return strings.ToLower(match[1]) + "/" + match[2], true
+}
+
+// RepositoryModulePath returns the module path of the repository cloned in dir,
+// derived from the URL of its origin remote
+func RepositoryModulePath(dir string) (string, error) {
+	out, err := exec.Command("git", "-C", dir, "remote", "get-url", "origin").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to read the origin remote of %s: %w", dir, err)
+	}
+	module, ok := ModulePathFromRemote(string(out))
+	if !ok {
+		return "", fmt.Errorf("cannot derive a module path from the origin remote %s", strings.TrimSpace(string(out)))
+	}
+	return module, nil
+}
+
+// checkExistingRepository checks that outputPath is a clone to generate into: a
+// directory holding a git repository
+func (g *Generator) checkExistingRepository(outputPath string) error {
+	info, err := os.Stat(outputPath)
+	if os.IsNotExist(err) {
+		return types.NewValidationError(fmt.Sprintf("directory '%s' does not exist, clone the repository first", outputPath), nil)
+	}
+	if err != nil {
+		return types.NewFileSystemError("failed to check output directory", err)
+	}
+	if !info.IsDir() {
+		return types.NewValidationError(fmt.Sprintf("output path '%s' exists but is not a directory", outputPath), nil)
+	}
+	if !g.hasGitRepository(outputPath) {
+		return types.NewValidationError(fmt.Sprintf("directory '%s' is not a git repository, --into-existing generates into a clone", outputPath), nil)
+	}
+	return nil
+}
+
+// prepareExisting readies the project generated in staging to be moved into the
+// repository at outputPath. Files of the repository are never overwritten: its
+// license is kept, its README gives its description to the generated one, and
+// its .gitignore gets the generated patterns it lacks. Any other file of the
+// repository the project would replace fails the generation.
+func prepareExisting(staging, outputPath string) error {
+	licenses, _ := filepath.Glob(filepath.Join(outputPath, "LICENSE*"))
+
+	var conflicts []string
+	err := filepath.WalkDir(staging, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(staging, path)
+		if err != nil {
+			return err
+		}
+		existing := filepath.Join(outputPath, rel)
+
+		switch rel = filepath.ToSlash(rel); {
+		case strings.HasPrefix(rel, "LICENSE") && !strings.Contains(rel, "/") && len(licenses) > 0:
+			return os.Remove(path)
+		case rel == "README.md":
+			return mergeReadme(path, existing)
+		case rel == ".gitignore":
+			return mergeGitignore(path, existing)
+		}
+		if _, err := os.Lstat(existing); err == nil {
+			conflicts = append(conflicts, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return types.NewFileSystemError("failed to prepare the generated project", err)
+	}
+	if len(conflicts) > 0 {
+		return types.NewValidationError(fmt.Sprintf("the repository already has %s, which the project would overwrite; remove them or generate into another directory", strings.Join(conflicts, ", ")), nil)
+	}
+	return nil
+}
+
+// mergeReadme writes the description of the existing README, the text under
+// its title, under the title of the generated one
+func mergeReadme(generated, existing string) error {
+	current, err := os.ReadFile(existing)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	description := strings.TrimSpace(string(current))
+	if title, rest, _ := strings.Cut(description, "\n"); strings.HasPrefix(title, "# ") {
+		description = strings.TrimSpace(rest)
+	}
+	if description == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(generated)
+	if err != nil {
+		return err
+	}
+	title, rest, _ := strings.Cut(string(content), "\n")
+	merged := title + "\n\n" + description + "\n" + rest
+	return os.WriteFile(generated, []byte(merged), types.DefaultFileMode)
+}
+
+// mergeGitignore appends to the existing .gitignore the generated patterns it lacks
+func mergeGitignore(generated, existing string) error {
+	current, err := os.ReadFile(existing)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(generated)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool)
+	for _, line := range strings.Split(string(current), "\n") {
+		known[strings.TrimSpace(line)] = true
+	}
+	var added []string
+	for _, line := range strings.Split(string(content), "\n") {
+		pattern := strings.TrimSpace(line)
+		if pattern == "" || strings.HasPrefix(pattern, "#") || known[pattern] {
+			continue
+		}
+		known[pattern] = true
+		added = append(added, pattern)
+	}
+
+	merged := bytes.TrimRight(current, "\n")
+	if len(added) > 0 {
+		merged = append(merged, "\n\n# Added by go-starter\n"+strings.Join(added, "\n")...)
+	}
+	return os.WriteFile(generated, append(merged, '\n'), types.DefaultFileMode)
+}
+
+// publishInto moves the files of the project generated in staging into the
+// repository at outputPath and returns their paths relative to it. A failure
+// moves back what was moved and restores the files it replaced, the README and
+// .gitignore merged by prepareExisting, for the rollback to find the repository
+// as it was.
+func publishInto(staging, outputPath string) ([]string, error) {
+	backup, err := os.MkdirTemp(filepath.Dir(outputPath), "."+filepath.Base(outputPath)+".go-starter-backup-*")
+	if err != nil {
+		return nil, types.NewFileSystemError("failed to publish generated project", err)
+	}
+	defer os.RemoveAll(backup)
+
+	var moved, replaced []string
+	undo := func() {
+		for _, rel := range slices.Backward(moved) {
+			_ = os.Rename(filepath.Join(outputPath, rel), filepath.Join(staging, rel))
+		}
+		for _, rel := range replaced {
+			_ = os.Rename(filepath.Join(backup, rel), filepath.Join(outputPath, rel))
+		}
+	}
+	err = filepath.WalkDir(staging, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(staging, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(outputPath, rel)
+		if _, err := os.Lstat(target); err == nil {
+			if err := os.MkdirAll(filepath.Dir(filepath.Join(backup, rel)), 0700); err != nil {
+				return err
+			}
+			if err := os.Rename(target, filepath.Join(backup, rel)); err != nil {
+				return err
+			}
+			replaced = append(replaced, rel)
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		if err := os.Rename(path, target); err != nil {
+			return err
+		}
+		moved = append(moved, rel)
+		return nil
+	})
+	if err != nil {
+		undo()
+		return nil, types.NewFileSystemError("failed to publish generated project", err)
+	}
+	if err := os.RemoveAll(staging); err != nil {
+		return nil, types.NewFileSystemError("failed to publish generated project", err)
+	}
+	return moved, nil
+}
+
+// commitGenerated commits the generated files of the repository at outputPath
+// on a new branch, leaving the other changes of its working tree alone
+func commitGenerated(outputPath, branch, message string, files []string) error {
+	git := func(args ...string) error {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = outputPath
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("git %s: %s", args[0], strings.TrimSpace(string(out)))
+		}
+		return nil
+	}
+
+	// Files the .gitignore of the project ignores, such as .env, stay out of the commit
+	check := exec.Command("git", "check-ignore", "--stdin")
+	check.Dir = outputPath
+	check.Stdin = strings.NewReader(strings.Join(files, "\n"))
+	out, err := check.Output()
+	if exit, ok := err.(*exec.ExitError); err != nil && !(ok && exit.ExitCode() == 1) {
+		return fmt.Errorf("git check-ignore: %w", err)
+	}
+	ignored := strings.Fields(string(out))
+	files = slices.DeleteFunc(slices.Clone(files), func(file string) bool { return slices.Contains(ignored, file) })
+
+	if err := git("checkout", "--quiet", "-b", branch); err != nil {
+		return err
+	}
+	if err := git(append([]string{"add", "--"}, files...)...); err != nil {
+		return err
+	}
+	return git("commit", "--quiet", "-m", message)
+}
@@ -0,0 +1,247 @@
+package generator
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestModulePathFromRemote(t *testing.T) {
+	tests := map[string]string{
+		"https://github.com/acme/shop.git":         "github.com/acme/shop",
+		"https://github.com/acme/shop":             "github.com/acme/shop",
+		"https://token@GitHub.com/acme/shop.git/":  "github.com/acme/shop",
+		"git@github.com:acme/shop.git":             "github.com/acme/shop",
+		"ssh://git@github.com/acme/shop":           "github.com/acme/shop",
+		"ssh://git@gitlab.example.com:2222/a/b/c":  "gitlab.example.com/a/b/c",
+		"https://gitlab.com/group/subgroup/shop\n": "gitlab.com/group/subgroup/shop",
+	}
+	for url, want := range tests {
+		got, ok := ModulePathFromRemote(url)
+		assert.True(t, ok, url)
+		assert.Equal(t, want, got, url)
+	}
+
+	for _, url := range []string{"", "/srv/git/shop.git", "https://github.com/shop", "file:///srv/git/shop"} {
+		_, ok := ModulePathFromRemote(url)
+		assert.False(t, ok, url)
+	}
+}
+
+// writeTree writes files, by path relative to dir
+func writeTree(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+func TestPrepareExisting(t *testing.T) {
+	t.Run("the license, README and .gitignore of the repository are kept", func(t *testing.T) {
+		staging, repo := t.TempDir(), t.TempDir()
+		writeTree(t, staging, map[string]string{
+			"LICENSE":    "MIT License\n",
+			"README.md":  "# shop\n\nA web API.\n",
+			".gitignore": "# Binaries\nbin/\n.env\n",
+			"main.go":    "package main\n",
+		})
+		writeTree(t, repo, map[string]string{
+			"LICENSE.md": "Apache License\n",
+			"README.md":  "# shop\n\nThe shop of Acme.\n",
+			".gitignore": ".idea/\n.env\n",
+		})
+
+		require.NoError(t, prepareExisting(staging, repo))
+
+		assert.NoFileExists(t, filepath.Join(staging, "LICENSE"))
+		readme, err := os.ReadFile(filepath.Join(staging, "README.md"))
+		require.NoError(t, err)
+		assert.Equal(t, "# shop\n\nThe shop of Acme.\n\nA web API.\n", string(readme))
+		gitignore, err := os.ReadFile(filepath.Join(staging, ".gitignore"))
+		require.NoError(t, err)
+		assert.Equal(t, ".idea/\n.env\n\n# Added by go-starter\nbin/\n", string(gitignore))
+	})
+
+	t.Run("a README with only a title adds nothing", func(t *testing.T) {
+		staging, repo := t.TempDir(), t.TempDir()
+		writeTree(t, staging, map[string]string{"README.md": "# shop\n\nA web API.\n"})
+		writeTree(t, repo, map[string]string{"README.md": "# shop\n"})
+
+		require.NoError(t, prepareExisting(staging, repo))
+		readme, err := os.ReadFile(filepath.Join(staging, "README.md"))
+		require.NoError(t, err)
+		assert.Equal(t, "# shop\n\nA web API.\n", string(readme))
+	})
+
+	t.Run("other files of the repository are never overwritten", func(t *testing.T) {
+		staging, repo := t.TempDir(), t.TempDir()
+		writeTree(t, staging, map[string]string{"main.go": "package main\n", "go.mod": "module shop\n", "cmd/root.go": "package cmd\n"})
+		writeTree(t, repo, map[string]string{"main.go": "package main\n", "cmd/root.go": "package cmd\n"})
+
+		err := prepareExisting(staging, repo)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the repository already has cmd/root.go, main.go")
+	})
+}
+
+func TestPublishInto(t *testing.T) {
+	staging, repo := t.TempDir(), t.TempDir()
+	writeTree(t, staging, map[string]string{"README.md": "# generated\n", "internal/app/app.go": "package app\n"})
+	writeTree(t, repo, map[string]string{"README.md": "# existing\n", "NOTES": "notes\n"})
+
+	published, err := publishInto(staging, repo)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"README.md", filepath.Join("internal", "app", "app.go")}, published)
+	assert.NoDirExists(t, staging)
+
+	readme, err := os.ReadFile(filepath.Join(repo, "README.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "# generated\n", string(readme))
+	assert.FileExists(t, filepath.Join(repo, "NOTES"))
+	assert.FileExists(t, filepath.Join(repo, "internal", "app", "app.go"))
+
+	backups, err := filepath.Glob(filepath.Join(filepath.Dir(repo), "*go-starter-backup*"))
+	require.NoError(t, err)
+	assert.Empty(t, backups)
+}
+
+// cloneRepository creates a repository standing for a clone of github.com/acme/shop
+// holding a README, a license and a .gitignore
+func cloneRepository(t *testing.T) string {
+	t.Helper()
+	t.Setenv("GIT_AUTHOR_NAME", "Test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+
+	repo := filepath.Join(t.TempDir(), "shop")
+	require.NoError(t, os.MkdirAll(repo, 0755))
+	writeTree(t, repo, map[string]string{
+		"README.md":  "# shop\n\nThe shop of Acme.\n",
+		"LICENSE":    "MIT License\n",
+		".gitignore": ".idea/\n",
+	})
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"remote", "add", "origin", "git@github.com:acme/shop.git"},
+		{"add", "--all"},
+		{"commit", "--quiet", "-m", "Initial commit"},
+	} {
+		out, err := exec.Command("git", append([]string{"-C", repo}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	return repo
+}
+
+func git(t *testing.T, repo string, args ...string) string {
+	t.Helper()
+	out, err := exec.Command("git", append([]string{"-C", repo}, args...)...).CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+func TestGenerate_IntoExisting(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	registry := newTestRegistry(t, fstest.MapFS{
+		"existing-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "existing-test"
+name: "existing-test"
+type: "cli"
+architecture: "standard"
+files:
+  - source: "README.md.tmpl"
+    destination: "README.md"
+  - source: "LICENSE.tmpl"
+    destination: "LICENSE"
+  - source: "gitignore.tmpl"
+    destination: ".gitignore"
+  - source: "env.tmpl"
+    destination: ".env"
+  - source: "main.go.tmpl"
+    destination: "main.go"
+`)},
+		"existing-test/README.md.tmpl": &fstest.MapFile{Data: []byte("# {{.ProjectName}}\n\nModule {{.ModulePath}}.\n")},
+		"existing-test/LICENSE.tmpl":   &fstest.MapFile{Data: []byte("Apache License\n")},
+		"existing-test/gitignore.tmpl": &fstest.MapFile{Data: []byte("bin/\n.env\n")},
+		"existing-test/env.tmpl":       &fstest.MapFile{Data: []byte("SECRET=changeme\n")},
+		"existing-test/main.go.tmpl":   &fstest.MapFile{Data: []byte("package main\n")},
+	})
+
+	generate := func(repo string, options types.GenerationOptions) (*types.GenerationResult, error) {
+		options.OutputPath = repo
+		options.IntoExisting = true
+		return NewWithRegistry(registry).Generate(types.ProjectConfig{
+			Name:      "shop",
+			Module:    "github.com/acme/shop",
+			Type:      "cli",
+			Variables: map[string]string{"blueprint_id": "existing-test"},
+		}, options)
+	}
+
+	t.Run("the project is committed on a new branch around the files of the clone", func(t *testing.T) {
+		repo := cloneRepository(t)
+		module, err := RepositoryModulePath(repo)
+		require.NoError(t, err)
+		assert.Equal(t, "github.com/acme/shop", module)
+
+		result, err := generate(repo, types.GenerationOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, DefaultExistingBranch, result.Branch)
+
+		assert.Equal(t, DefaultExistingBranch, git(t, repo, "branch", "--show-current"))
+		assert.Equal(t, "Generate shop from the existing-test blueprint of go-starter", git(t, repo, "log", "-1", "--format=%s"))
+		assert.Equal(t, "2", git(t, repo, "rev-list", "--count", "HEAD"))
+		assert.Empty(t, git(t, repo, "status", "--porcelain"))
+		assert.FileExists(t, filepath.Join(repo, ".env"))
+		assert.NotContains(t, strings.Split(git(t, repo, "ls-files"), "\n"), ".env", "ignored files are not committed")
+
+		license, err := os.ReadFile(filepath.Join(repo, "LICENSE"))
+		require.NoError(t, err)
+		assert.Equal(t, "MIT License\n", string(license))
+		readme, err := os.ReadFile(filepath.Join(repo, "README.md"))
+		require.NoError(t, err)
+		assert.Equal(t, "# shop\n\nThe shop of Acme.\n\nModule github.com/acme/shop.\n", string(readme))
+		assert.FileExists(t, filepath.Join(repo, "main.go"))
+	})
+
+	t.Run("the branch can be chosen", func(t *testing.T) {
+		repo := cloneRepository(t)
+		result, err := generate(repo, types.GenerationOptions{Branch: "scaffold"})
+		require.NoError(t, err)
+		assert.Equal(t, "scaffold", result.Branch)
+		assert.Equal(t, "scaffold", git(t, repo, "branch", "--show-current"))
+	})
+
+	t.Run("a conflict leaves the clone untouched", func(t *testing.T) {
+		repo := cloneRepository(t)
+		writeTree(t, repo, map[string]string{"main.go": "package app\n"})
+		git(t, repo, "add", "main.go")
+		git(t, repo, "commit", "--quiet", "-m", "Add main")
+
+		_, err := generate(repo, types.GenerationOptions{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the repository already has main.go")
+		assert.Empty(t, git(t, repo, "status", "--porcelain"))
+		assert.NotEqual(t, DefaultExistingBranch, git(t, repo, "branch", "--show-current"))
+		assert.NoFileExists(t, filepath.Join(repo, ".env"))
+	})
+
+	t.Run("a directory that is not a repository is refused", func(t *testing.T) {
+		_, err := generate(t.TempDir(), types.GenerationOptions{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is not a git repository")
+	})
+}
@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// experimentsContext returns the Experimental template variable: the features
+// enabled for the generation plus the blueprint's graduated ones
+func experimentsContext(config types.ProjectConfig, tmpl types.Template) map[string]bool {
+	enabled := make(map[string]bool, len(config.Experimental))
+	for _, name := range config.Experimental {
+		enabled[name] = true
+	}
+	for _, experiment := range tmpl.Experiments {
+		if experiment.Graduated != "" {
+			enabled[experiment.Name] = true
+		}
+	}
+	return enabled
+}
+
+// checkExperiments rejects unknown experimental features and experimental
+// blueprints or choices that were not enabled. It returns the experimental
+// features of tmpl the generation uses.
+func (g *Generator) checkExperiments(tmpl types.Template, config types.ProjectConfig) ([]string, error) {
+	known := g.knownExperiments()
+	for _, name := range config.Experimental {
+		if !types.ValidExperimentName(name) {
+			return nil, types.NewValidationError(fmt.Sprintf("invalid experimental feature %q, names are namespaced like framework.fuego", name), nil)
+		}
+		experiment, ok := known[name]
+		if !ok {
+			return nil, types.NewValidationError(fmt.Sprintf("unknown experimental feature %q, run 'go-starter experimental' to list them", name), nil)
+		}
+		if experiment.Graduated != "" {
+			fmt.Fprintf(os.Stderr, "Note: %s graduated in go-starter %s and no longer needs --experimental\n", name, experiment.Graduated)
+		}
+	}
+
+	enabled := experimentsContext(config, tmpl)
+	used := tmpl.ExperimentsUsed(g.createTemplateContext(config, tmpl))
+	for _, name := range used {
+		if !enabled[name] {
+			return nil, types.NewValidationError(fmt.Sprintf("%s is experimental and not enabled, pass --experimental=%s to use it", name, name), nil)
+		}
+	}
+
+	// Enabled experiments can also switch on branches inside the blueprint's files
+	for _, name := range config.Experimental {
+		if experiment, ok := tmpl.Experiment(name); ok && experiment.Graduated == "" && !containsExperiment(used, name) {
+			used = append(used, name)
+		}
+	}
+	return used, nil
+}
+
+// knownExperiments collects the experiments declared by all blueprints
+func (g *Generator) knownExperiments() map[string]types.Experiment {
+	known := make(map[string]types.Experiment)
+	for _, tmpl := range g.registry.List() {
+		for _, experiment := range tmpl.Experiments {
+			known[experiment.Name] = experiment
+		}
+	}
+	return known
+}
+
+func containsExperiment(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package generator
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func setupExperimentalTestTemplates(t *testing.T) *templates.Registry {
+	t.Helper()
+
+	return newTestRegistry(t, fstest.MapFS{
+		"api-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "api-test"
+name: "api-test"
+type: "web-api"
+experiments:
+  - name: "framework.fuego"
+    description: "Fuego framework support"
+  - name: "feature.outbox"
+    description: "Transactional outbox"
+  - name: "feature.healthz"
+    graduated: "2.0.0"
+variables:
+  - name: "Framework"
+    choices: ["gin", "fuego"]
+    experimental_choices:
+      fuego: "framework.fuego"
+files:
+  - source: "README.md.tmpl"
+    destination: "README.md"
+  - source: "outbox.go.tmpl"
+    destination: "outbox.go"
+    condition: '{{ index .Experimental "feature.outbox" }}'
+  - source: "healthz.go.tmpl"
+    destination: "healthz.go"
+    condition: '{{ index .Experimental "feature.healthz" }}'
+`)},
+		"api-test/README.md.tmpl":  &fstest.MapFile{Data: []byte("# {{.ProjectName}}\n")},
+		"api-test/outbox.go.tmpl":  &fstest.MapFile{Data: []byte("package main\n")},
+		"api-test/healthz.go.tmpl": &fstest.MapFile{Data: []byte("package main\n")},
+		"cqrs-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "cqrs-test"
+name: "cqrs-test"
+type: "web-api"
+experimental: "architecture.cqrs"
+experiments:
+  - name: "architecture.cqrs"
+files:
+  - source: "README.md.tmpl"
+    destination: "README.md"
+`)},
+		"cqrs-test/README.md.tmpl": &fstest.MapFile{Data: []byte("# {{.ProjectName}}\n")},
+	})
+}
+
+func experimentalTestConfig(framework string, experimental ...string) *types.ProjectConfig {
+	return &types.ProjectConfig{
+		Name:         "api",
+		Module:       "github.com/test/api",
+		Type:         "web-api",
+		Framework:    framework,
+		Experimental: experimental,
+	}
+}
+
+func TestGenerateInMemoryFiles_Experimental(t *testing.T) {
+	registry := setupExperimentalTestTemplates(t)
+	ctx := context.Background()
+
+	t.Run("experimental blueprint needs its flag", func(t *testing.T) {
+		_, err := NewWithRegistry(registry).GenerateInMemoryFiles(ctx, experimentalTestConfig(""), "cqrs-test")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--experimental=architecture.cqrs")
+
+		files, err := NewWithRegistry(registry).GenerateInMemoryFiles(ctx, experimentalTestConfig("", "architecture.cqrs"), "cqrs-test")
+		require.NoError(t, err)
+		assert.Contains(t, files, "README.md")
+	})
+
+	t.Run("experimental choice needs its flag", func(t *testing.T) {
+		_, err := NewWithRegistry(registry).GenerateInMemoryFiles(ctx, experimentalTestConfig("fuego"), "api-test")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "framework.fuego")
+
+		_, err = NewWithRegistry(registry).GenerateInMemoryFiles(ctx, experimentalTestConfig("fuego", "framework.fuego"), "api-test")
+		require.NoError(t, err)
+	})
+
+	t.Run("flags switch on template branches", func(t *testing.T) {
+		files, err := NewWithRegistry(registry).GenerateInMemoryFiles(ctx, experimentalTestConfig("gin"), "api-test")
+		require.NoError(t, err)
+		assert.NotContains(t, files, "outbox.go")
+		assert.Contains(t, files, "healthz.go", "graduated features are always enabled")
+
+		files, err = NewWithRegistry(registry).GenerateInMemoryFiles(ctx, experimentalTestConfig("gin", "feature.outbox"), "api-test")
+		require.NoError(t, err)
+		assert.Contains(t, files, "outbox.go")
+	})
+
+	t.Run("unknown and malformed flags are rejected", func(t *testing.T) {
+		_, err := NewWithRegistry(registry).GenerateInMemoryFiles(ctx, experimentalTestConfig("gin", "feature.teleport"), "api-test")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown experimental feature")
+
+		_, err = NewWithRegistry(registry).GenerateInMemoryFiles(ctx, experimentalTestConfig("gin", "outbox"), "api-test")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "namespaced")
+	})
+}
+
+func TestCheckExperiments(t *testing.T) {
+	registry := setupExperimentalTestTemplates(t)
+	g := NewWithRegistry(registry)
+	tmpl, err := g.registry.Get("api-test")
+	require.NoError(t, err)
+
+	used, err := g.checkExperiments(tmpl, *experimentalTestConfig("fuego", "framework.fuego", "feature.outbox", "architecture.cqrs"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"framework.fuego", "feature.outbox"}, used, "flags of other blueprints are accepted but not used")
+
+	used, err = g.checkExperiments(tmpl, *experimentalTestConfig("gin", "feature.healthz"))
+	require.NoError(t, err)
+	assert.Empty(t, used, "graduated features are not experimental anymore")
+}
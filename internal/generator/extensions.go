@@ -0,0 +1,82 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/template"
+	"time"
+
+	"github.com/francknouama/go-starter/internal/outputfs"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// PostStep is a step an extension runs once the project is generated, after the
+// hooks of its blueprint
+type PostStep struct {
+	Name string
+	Run  func(ctx context.Context, config types.ProjectConfig, outputPath string) error
+}
+
+// Extend adds template functions, available to the files, paths and conditions of
+// every blueprint next to the Sprig and go-starter functions, and post-generation
+// steps. The functions of an extension cannot replace those, and must be safe for
+// concurrent use: the files of a generation are rendered at once.
+func (g *Generator) Extend(funcs template.FuncMap, steps ...PostStep) error {
+	builtin := TemplateFuncs(nil)
+	for name := range funcs {
+		if !ValidFunctionName(name) {
+			return types.NewValidationError(fmt.Sprintf("template function name %q is not valid", name), nil)
+		}
+		if _, ok := builtin[name]; ok {
+			return types.NewValidationError(fmt.Sprintf("template function %s is already defined", name), nil)
+		}
+		if _, ok := g.funcs[name]; ok {
+			return types.NewValidationError(fmt.Sprintf("template function %s is registered twice", name), nil)
+		}
+	}
+	if g.funcs == nil {
+		g.funcs = make(template.FuncMap, len(funcs))
+	}
+	for name, fn := range funcs {
+		g.funcs[name] = fn
+	}
+	g.postSteps = append(g.postSteps, steps...)
+	return nil
+}
+
+// PinTime makes the now function of the blueprints return t, so that projects
+// rendering dates are the same whenever they are generated
+func (g *Generator) PinTime(t time.Time) {
+	g.now = t
+}
+
+// funcMap returns the functions the blueprints are rendered with
+func (g *Generator) funcMap() template.FuncMap {
+	funcs := TemplateFuncs(nil)
+	for name, fn := range g.funcs {
+		funcs[name] = fn
+	}
+	if now := g.now; !now.IsZero() {
+		funcs["now"] = func() time.Time { return now }
+	}
+	return funcs
+}
+
+// executePostSteps runs the post-generation steps of the extensions, reporting
+// them as the steps of the hooks phase after the first done ones; like hooks,
+// their failures are reported as warnings and only cancellation is an error
+func (g *Generator) executePostSteps(ctx context.Context, config types.ProjectConfig, outputPath string, done int) error {
+	for i, step := range g.postSteps {
+		if err := checkCancelled(ctx); err != nil {
+			return err
+		}
+		if !outputfs.IsLocal(g.out) {
+			fmt.Fprintf(os.Stderr, "Note: skipped step '%s' on the remote target\n", step.Name)
+		} else if err := step.Run(ctx, config, outputPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Step '%s' failed: %v\n", step.Name, err)
+		}
+		g.progress.step(done+i+1, step.Name)
+	}
+	return nil
+}
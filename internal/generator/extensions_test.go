@@ -0,0 +1,50 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestExtend(t *testing.T) {
+	setupTestTemplates(t)
+
+	t.Run("functions render next to the Sprig functions", func(t *testing.T) {
+		g := New()
+		require.NoError(t, g.Extend(template.FuncMap{"greet": func(name string) string { return "hello " + name }}))
+
+		tmpl, err := template.New("test").Funcs(g.funcMap()).Parse(`{{greet .Name | upper}}`)
+		require.NoError(t, err)
+		var out strings.Builder
+		require.NoError(t, tmpl.Execute(&out, map[string]string{"Name": "gopher"}))
+		assert.Equal(t, "HELLO GOPHER", out.String())
+	})
+
+	t.Run("Sprig functions cannot be replaced", func(t *testing.T) {
+		err := New().Extend(template.FuncMap{"upper": func(s string) string { return s }})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "template function upper is already defined")
+	})
+
+	t.Run("post steps run in order and failures are warnings", func(t *testing.T) {
+		var ran []string
+		step := func(name string, err error) PostStep {
+			return PostStep{Name: name, Run: func(ctx context.Context, config types.ProjectConfig, outputPath string) error {
+				ran = append(ran, name+" "+outputPath)
+				return err
+			}}
+		}
+		g := New()
+		require.NoError(t, g.Extend(nil, step("first", errors.New("boom")), step("second", nil)))
+
+		require.NoError(t, g.executePostSteps(context.Background(), types.ProjectConfig{}, "/tmp/app", 0))
+		assert.Equal(t, []string{"first /tmp/app", "second /tmp/app"}, ran)
+	})
+}
@@ -0,0 +1,60 @@
+package generator
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// GeneratedFile is a file produced by in-memory generation
+type GeneratedFile struct {
+	Content []byte
+	Mode    fs.FileMode
+	// Symlink is the link target when the file is a symbolic link
+	Symlink string
+	// Binary is set for assets copied verbatim from the blueprint
+	Binary bool
+}
+
+// validateSymlinkTarget checks that a link at linkPath (slash separated, relative to
+// the project root) points at a relative target that stays inside the project
+func validateSymlinkTarget(linkPath, target string) error {
+	if target == "" {
+		return types.NewValidationError(fmt.Sprintf("symlink %s has an empty target", linkPath), nil)
+	}
+	if path.IsAbs(target) || filepath.IsAbs(target) || filepath.VolumeName(target) != "" {
+		return types.NewValidationError(fmt.Sprintf("symlink %s must use a relative target, got %q", linkPath, target), nil)
+	}
+
+	resolved := path.Join(path.Dir(linkPath), filepath.ToSlash(target))
+	if resolved == ".." || strings.HasPrefix(resolved, "../") {
+		return types.NewValidationError(fmt.Sprintf("symlink %s points outside the project (%q)", linkPath, target), nil)
+	}
+	return nil
+}
+
+// generateSymlink renders and creates a symlink entry below outputPath
+func (g *Generator) generateSymlink(templateFile types.TemplateFile, destPath, outputPath string, config types.ProjectConfig, tmpl *types.Template) (string, error) {
+	target := g.processTemplatePath(templateFile.Symlink, config, tmpl)
+	if err := validateSymlinkTarget(filepath.ToSlash(destPath), target); err != nil {
+		return "", err
+	}
+
+	fullDestPath := filepath.Join(outputPath, destPath)
+	if err := g.output().MkdirAll(filepath.Dir(fullDestPath), 0755); err != nil {
+		return "", types.NewFileSystemError("failed to create directory", err)
+	}
+	if err := g.output().Symlink(target, fullDestPath); err != nil {
+		return "", types.NewFileSystemError(fmt.Sprintf("failed to create symlink %s", destPath), err)
+	}
+
+	// Track file creation for rollback if transaction is active
+	if g.currentTransaction != nil {
+		g.currentTransaction.AddFile(fullDestPath)
+	}
+	return fullDestPath, nil
+}
@@ -0,0 +1,99 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"testing/fstest"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSymlinkTarget(t *testing.T) {
+	assert.NoError(t, validateSymlinkTarget("bin/run", "../scripts/run.sh"))
+	assert.NoError(t, validateSymlinkTarget("README", "docs/README.md"))
+	assert.Error(t, validateSymlinkTarget("bin/run", "../../etc/passwd"))
+	assert.Error(t, validateSymlinkTarget("run", "/usr/bin/env"))
+	assert.Error(t, validateSymlinkTarget("run", ""))
+}
+
+func TestGenerate_FileModesAndSymlinks(t *testing.T) {
+	registry := setupFileModeTestTemplates(t)
+
+	config := types.ProjectConfig{
+		Name:      "modes",
+		Module:    "github.com/test/modes",
+		Type:      "cli",
+		Variables: map[string]string{"blueprint_id": "modes-test"},
+	}
+
+	t.Run("on disk", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "modes")
+		_, err := NewWithRegistry(registry).Generate(config, types.GenerationOptions{OutputPath: outputPath, NoGit: true})
+		require.NoError(t, err)
+
+		if runtime.GOOS != "windows" {
+			assertMode(t, filepath.Join(outputPath, "README.md"), 0644)
+			assertMode(t, filepath.Join(outputPath, "scripts/dev.sh"), 0755)
+			assertMode(t, filepath.Join(outputPath, "secrets.env"), 0600)
+
+			target, err := os.Readlink(filepath.Join(outputPath, "bin/dev"))
+			require.NoError(t, err)
+			assert.Equal(t, filepath.FromSlash("../scripts/dev.sh"), target)
+		}
+
+		content, err := os.ReadFile(filepath.Join(outputPath, "bin/dev"))
+		require.NoError(t, err)
+		assert.Equal(t, "#!/bin/sh\necho modes\n", string(content))
+	})
+
+	t.Run("in memory", func(t *testing.T) {
+		files, err := NewWithRegistry(registry).GenerateInMemoryFiles(context.Background(), &config, "modes-test")
+		require.NoError(t, err)
+
+		assert.Equal(t, os.FileMode(0755), files["scripts/dev.sh"].Mode)
+		assert.Equal(t, os.FileMode(0600), files["secrets.env"].Mode)
+		assert.Equal(t, "../scripts/dev.sh", files["bin/dev"].Symlink)
+		assert.Equal(t, os.ModeSymlink, files["bin/dev"].Mode&os.ModeSymlink)
+	})
+}
+
+func assertMode(t *testing.T, path string, want os.FileMode) {
+	t.Helper()
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, want, info.Mode().Perm(), path)
+}
+
+// setupFileModeTestTemplates returns a registry of a blueprint declaring file modes and a symlink
+func setupFileModeTestTemplates(t *testing.T) *templates.Registry {
+	t.Helper()
+
+	return newTestRegistry(t, fstest.MapFS{
+		"modes-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "modes-test"
+name: "modes-test"
+type: "cli"
+architecture: "standard"
+files:
+  - source: "README.md.tmpl"
+    destination: "README.md"
+  - source: "dev.sh.tmpl"
+    destination: "scripts/dev.sh"
+    executable: true
+  - source: "secrets.env.tmpl"
+    destination: "secrets.env"
+    mode: "0600"
+  - destination: "bin/dev"
+    symlink: "../scripts/dev.sh"
+`)},
+		"modes-test/README.md.tmpl":   &fstest.MapFile{Data: []byte("# {{.ProjectName}}\n")},
+		"modes-test/dev.sh.tmpl":      &fstest.MapFile{Data: []byte("#!/bin/sh\necho {{.ProjectName}}\n")},
+		"modes-test/secrets.env.tmpl": &fstest.MapFile{Data: []byte("TOKEN=changeme\n")},
+	})
+}
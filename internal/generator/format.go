@@ -0,0 +1,121 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/imports"
+
+	"github.com/francknouama/go-starter/internal/outputfs"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Formatters are the optional formatters run over the Go files of a project once
+// it is written, after the built-in gofmt and goimports formatting. They are run
+// from the PATH, with the command installing each when it is missing.
+var Formatters = map[string]string{
+	"gofumpt": "go install mvdan.cc/gofumpt@latest",
+	"golines": "go install github.com/segmentio/golines@latest",
+}
+
+// checkFormatters rejects formatters go-starter does not know
+func checkFormatters(formatters []string) error {
+	for _, name := range formatters {
+		if _, ok := Formatters[name]; !ok {
+			return types.NewValidationError(fmt.Sprintf("unknown formatter %q, expected one of %s", name, strings.Join(keys(Formatters), ", ")), nil)
+		}
+	}
+	return nil
+}
+
+// formats reports whether the rendered file at destPath is formatted: a Go file
+// of a blueprint that does not opt out of formatting, nor exclude the file
+func (g *Generator) formats(tmpl types.Template, destPath string) bool {
+	if g.noFormat || tmpl.Format.Disabled || filepath.Ext(destPath) != ".go" {
+		return false
+	}
+	slashPath := filepath.ToSlash(destPath)
+	return !slices.ContainsFunc(tmpl.Format.Exclude, func(pattern string) bool {
+		matched, _ := path.Match(pattern, slashPath)
+		return matched
+	})
+}
+
+// formatGo formats a rendered Go file like gofmt, with its imports sorted and
+// grouped like goimports. Imports are neither added nor removed, so that the
+// result does not depend on the packages installed. Files that do not parse are
+// returned as rendered, for the compiler to report.
+func formatGo(destPath string, content []byte) []byte {
+	formatted, err := imports.Process(destPath, content, &imports.Options{
+		Comments:   true,
+		TabIndent:  true,
+		TabWidth:   8,
+		FormatOnly: true,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %s is not valid Go, it is left unformatted: %v\n", destPath, err)
+		return content
+	}
+	return formatted
+}
+
+// runFormatters runs the optional formatters over the Go files written in
+// outputPath, and updates their checksums for the manifest. A missing formatter
+// or a failure is a warning: the project is still usable.
+func (g *Generator) runFormatters(ctx context.Context, outputPath string) error {
+	if len(g.formatters) == 0 {
+		return nil
+	}
+	if !outputfs.IsLocal(g.out) {
+		fmt.Fprintf(os.Stderr, "Note: skipped %s on the remote target\n", strings.Join(g.formatters, " and "))
+		return nil
+	}
+
+	var files []string
+	for file := range g.checksums {
+		if path.Ext(file) == ".go" {
+			files = append(files, filepath.FromSlash(file))
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	slices.Sort(files)
+
+	for _, name := range g.formatters {
+		if err := checkCancelled(ctx); err != nil {
+			return err
+		}
+		if _, err := exec.LookPath(name); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %s is not installed, the project is formatted with gofmt only. Install it with: %s\n", name, Formatters[name])
+			continue
+		}
+		cmd := exec.CommandContext(ctx, name, append([]string{"-w"}, files...)...)
+		cmd.Dir = outputPath
+		if output, err := cmd.CombinedOutput(); err != nil {
+			if cancelErr := checkCancelled(ctx); cancelErr != nil {
+				return cancelErr
+			}
+			fmt.Fprintf(os.Stderr, "Warning: %s failed: %v\n%s", name, err, output)
+		}
+	}
+
+	for _, file := range files {
+		content, err := os.ReadFile(filepath.Join(outputPath, file))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return types.NewFileSystemError("failed to read formatted file", err)
+		}
+		g.checksums[filepath.ToSlash(file)] = checksum(GeneratedFile{Content: content})
+	}
+	return nil
+}
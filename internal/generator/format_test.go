@@ -0,0 +1,111 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// unformattedMain is a rendered Go file as templates leave them
+const unformattedMain = "package main\n\nimport (\n\t\"os\"\n\t\"fmt\"\n)\n\n\n\nfunc main()  {\n    fmt.Println(os.Args)\n}\n"
+
+const formattedMain = "package main\n\nimport (\n\t\"fmt\"\n\t\"os\"\n)\n\nfunc main() {\n\tfmt.Println(os.Args)\n}\n"
+
+func setupFormatTestTemplates(t *testing.T, format string) *templates.Registry {
+	t.Helper()
+
+	return newTestRegistry(t, fstest.MapFS{
+		"format-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "format-test"
+name: "format-test"
+type: "cli"
+` + format + `
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+  - source: "main.go.tmpl"
+    destination: "internal/gen/gen.go"
+  - source: "broken.go.tmpl"
+    destination: "broken.go"
+  - source: "main.go.tmpl"
+    destination: "README.md"
+`)},
+		"format-test/main.go.tmpl":   &fstest.MapFile{Data: []byte(unformattedMain)},
+		"format-test/broken.go.tmpl": &fstest.MapFile{Data: []byte("package main\n\nfunc {\n")},
+	})
+}
+
+func formatTestConfig() *types.ProjectConfig {
+	return &types.ProjectConfig{Name: "tool", Module: "example.com/tool", Type: "cli", Variables: map[string]string{"blueprint_id": "format-test"}}
+}
+
+func TestGenerateInMemoryFiles_FormatsGo(t *testing.T) {
+	registry := setupFormatTestTemplates(t, "")
+
+	files, err := NewWithRegistry(registry).GenerateInMemoryFiles(context.Background(), formatTestConfig(), "format-test")
+	require.NoError(t, err)
+	assert.Equal(t, formattedMain, string(files["main.go"].Content), "gofmt and goimports run over the Go files")
+	assert.Equal(t, formattedMain, string(files["internal/gen/gen.go"].Content))
+	assert.Equal(t, "package main\n\nfunc {\n", string(files["broken.go"].Content), "invalid Go is left to the compiler")
+	assert.Equal(t, unformattedMain, string(files["README.md"].Content), "only Go files are formatted")
+}
+
+func TestGenerateInMemoryFiles_FormatOptOut(t *testing.T) {
+	registry := setupFormatTestTemplates(t, "format:\n  exclude: [\"internal/gen/*.go\"]")
+	files, err := NewWithRegistry(registry).GenerateInMemoryFiles(context.Background(), formatTestConfig(), "format-test")
+	require.NoError(t, err)
+	assert.Equal(t, formattedMain, string(files["main.go"].Content))
+	assert.Equal(t, unformattedMain, string(files["internal/gen/gen.go"].Content), "excluded files are left as rendered")
+
+	registry = setupFormatTestTemplates(t, "format:\n  disabled: true")
+	files, err = NewWithRegistry(registry).GenerateInMemoryFiles(context.Background(), formatTestConfig(), "format-test")
+	require.NoError(t, err)
+	assert.Equal(t, unformattedMain, string(files["main.go"].Content), "blueprints can opt out of formatting")
+}
+
+func TestGenerate_NoFormat(t *testing.T) {
+	registry := setupFormatTestTemplates(t, "")
+	outputPath := filepath.Join(t.TempDir(), "tool")
+
+	_, err := NewWithRegistry(registry).Generate(*formatTestConfig(), types.GenerationOptions{OutputPath: outputPath, NoGit: true, NoFormat: true})
+	require.NoError(t, err)
+	content, err := os.ReadFile(filepath.Join(outputPath, "main.go"))
+	require.NoError(t, err)
+	assert.Equal(t, unformattedMain, string(content))
+}
+
+func TestGenerate_Formatters(t *testing.T) {
+	registry := setupFormatTestTemplates(t, "")
+
+	_, err := NewWithRegistry(registry).Generate(*formatTestConfig(), types.GenerationOptions{OutputPath: filepath.Join(t.TempDir(), "tool"), NoGit: true, Formatters: []string{"gofmt"}})
+	assert.ErrorContains(t, err, `unknown formatter "gofmt", expected one of gofumpt, golines`)
+
+	// A stand-in gofumpt marks the files it is run over
+	bin := t.TempDir()
+	script := "#!/bin/sh\nshift\nfor f in \"$@\"; do echo '// gofumpt' >> \"$f\"; done\n"
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "gofumpt"), []byte(script), 0755))
+	t.Setenv("PATH", bin)
+
+	outputPath := filepath.Join(t.TempDir(), "tool")
+	_, err = NewWithRegistry(registry).Generate(*formatTestConfig(), types.GenerationOptions{OutputPath: outputPath, NoGit: true, Formatters: []string{"gofumpt", "golines"}})
+	require.NoError(t, err, "a missing formatter is a warning")
+
+	content, err := os.ReadFile(filepath.Join(outputPath, "internal", "gen", "gen.go"))
+	require.NoError(t, err)
+	assert.Equal(t, formattedMain+"// gofumpt\n", string(content))
+	readme, err := os.ReadFile(filepath.Join(outputPath, "README.md"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(readme), "gofumpt")
+
+	manifest, err := ReadManifest(outputPath)
+	require.NoError(t, err)
+	assert.Equal(t, checksum(GeneratedFile{Content: content}), manifest.Files["internal/gen/gen.go"], "the manifest records the files as formatted")
+}
@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_Gateway(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	files, err := New().GenerateInMemoryFiles(ctx, &types.ProjectConfig{
+		Name:   "edge",
+		Module: "github.com/test/edge",
+		Type:   "gateway",
+		Logger: "slog",
+	}, "gateway")
+	require.NoError(t, err)
+
+	for _, path := range []string{
+		"cmd/gateway/main.go",
+		"configs/gateway.yaml",
+		"internal/gateway/table.go",
+		"internal/gateway/upstream.go",
+		"internal/gateway/middleware.go",
+		"internal/gateway/proxy.go",
+		"internal/gateway/gateway.go",
+		"internal/gateway/admin.go",
+		"internal/gateway/watcher.go",
+		"internal/gateway/gateway_test.go",
+		"internal/logger/slog.go",
+	} {
+		assert.Contains(t, files, path)
+	}
+	assert.NotContains(t, files, "internal/logger/zap.go")
+
+	routes := string(files["configs/gateway.yaml"].Content)
+	assert.Contains(t, routes, "health_check:")
+	assert.Contains(t, routes, "secret_env: JWT_SECRET")
+
+	main := string(files["cmd/gateway/main.go"].Content)
+	assert.Contains(t, main, "gateway.WatchFile(ctx, cfg.RoutesFile, reload)")
+	assert.Contains(t, main, "syscall.SIGHUP")
+
+	goMod := string(files["go.mod"].Content)
+	assert.Contains(t, goMod, "go 1.22")
+	assert.Contains(t, goMod, "github.com/fsnotify/fsnotify")
+	assert.Contains(t, goMod, "golang.org/x/time")
+}
@@ -2,31 +2,38 @@ package generator
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"strconv"
 	"strings"
+	"sync"
 	"text/template"
 	"time"
 
-	"github.com/Masterminds/sprig/v3"
+	"github.com/francknouama/go-starter/internal/naming"
+	"github.com/francknouama/go-starter/internal/outputfs"
 	"github.com/francknouama/go-starter/internal/templates"
 	"github.com/francknouama/go-starter/pkg/types"
 )
 
 // GenerationTransaction tracks operations that can be rolled back
 type GenerationTransaction struct {
+	fs            types.OutputFS
 	outputPath    string
 	filesCreated  []string
 	dirsCreated   []string
 	hooksExecuted []string
+	ownsOutput    bool
+	createdOutput bool
 }
 
 // NewGenerationTransaction creates a new transaction for rollback support
 func NewGenerationTransaction(outputPath string) *GenerationTransaction {
 	return &GenerationTransaction{
+		fs:            outputfs.Local{},
 		outputPath:    outputPath,
 		filesCreated:  make([]string, 0),
 		dirsCreated:   make([]string, 0),
@@ -44,6 +51,14 @@ func (tx *GenerationTransaction) AddDirectory(path string) {
 	tx.dirsCreated = append(tx.dirsCreated, path)
 }
 
+// ClaimOutput marks the output directory as owned by this generation, so that a
+// rollback also removes untracked content (go.sum, hook output) and, when created
+// is true, the directory itself
+func (tx *GenerationTransaction) ClaimOutput(created bool) {
+	tx.ownsOutput = true
+	tx.createdOutput = created
+}
+
 // AddHook tracks an executed hook for logging
 func (tx *GenerationTransaction) AddHook(hookName string) {
 	tx.hooksExecuted = append(tx.hooksExecuted, hookName)
@@ -51,18 +66,22 @@ func (tx *GenerationTransaction) AddHook(hookName string) {
 
 // Rollback removes all created files and directories
 func (tx *GenerationTransaction) Rollback() error {
-	if len(tx.filesCreated) == 0 && len(tx.dirsCreated) == 0 {
+	if len(tx.filesCreated) == 0 && len(tx.dirsCreated) == 0 && !tx.ownsOutput {
 		return nil
 	}
 
-	fmt.Printf("Rolling back failed generation at %s...\n", tx.outputPath)
+	fmt.Fprintf(os.Stderr, "Rolling back failed generation at %s...\n", tx.outputPath)
+
+	if tx.ownsOutput {
+		return tx.removeOutput()
+	}
 
 	var rollbackErrors []string
 
 	// Remove created files in reverse order
 	for i := len(tx.filesCreated) - 1; i >= 0; i-- {
 		file := tx.filesCreated[i]
-		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+		if err := tx.fs.Remove(file); err != nil && !os.IsNotExist(err) {
 			rollbackErrors = append(rollbackErrors, fmt.Sprintf("file %s: %v", file, err))
 		}
 	}
@@ -70,7 +89,7 @@ func (tx *GenerationTransaction) Rollback() error {
 	// Remove directories in reverse order (only if empty)
 	for i := len(tx.dirsCreated) - 1; i >= 0; i-- {
 		dir := tx.dirsCreated[i]
-		if err := os.Remove(dir); err != nil && !os.IsNotExist(err) {
+		if err := tx.fs.Remove(dir); err != nil && !os.IsNotExist(err) {
 			// Don't report errors for non-empty directories - that's expected
 			if !strings.Contains(err.Error(), "directory not empty") {
 				rollbackErrors = append(rollbackErrors, fmt.Sprintf("dir %s: %v", dir, err))
@@ -79,11 +98,36 @@ func (tx *GenerationTransaction) Rollback() error {
 	}
 
 	if len(rollbackErrors) > 0 {
-		fmt.Printf("Rollback completed with %d errors\n", len(rollbackErrors))
+		fmt.Fprintf(os.Stderr, "Rollback completed with %d errors\n", len(rollbackErrors))
 		return fmt.Errorf("rollback errors: %s", strings.Join(rollbackErrors, "; "))
 	}
 
-	fmt.Println("Rollback completed successfully")
+	fmt.Fprintln(os.Stderr, "Rollback completed successfully")
+	return nil
+}
+
+// removeOutput empties the claimed output directory and removes it if this generation created it
+func (tx *GenerationTransaction) removeOutput() error {
+	var err error
+	if tx.createdOutput {
+		err = tx.fs.RemoveAll(tx.outputPath)
+	} else {
+		entries, readErr := tx.fs.ReadDir(tx.outputPath)
+		if readErr != nil && !os.IsNotExist(readErr) {
+			err = readErr
+		}
+		for _, entry := range entries {
+			if removeErr := tx.fs.RemoveAll(filepath.Join(tx.outputPath, entry.Name())); removeErr != nil && err == nil {
+				err = removeErr
+			}
+		}
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Rollback completed with errors")
+		return fmt.Errorf("rollback errors: %w", err)
+	}
+	fmt.Fprintln(os.Stderr, "Rollback completed successfully")
 	return nil
 }
 
@@ -92,6 +136,33 @@ type Generator struct {
 	registry           *templates.Registry
 	loader             *templates.TemplateLoader
 	currentTransaction *GenerationTransaction
+	strict             bool
+	// noGit leaves out the git initialization and the fallback .gitignore
+	noGit    bool
+	progress           *progressTracker
+	out                types.OutputFS
+	// checksums of the files written by the last generation, for its manifest
+	checksums map[string]string
+	// funcs and postSteps are added by the extensions, see Extend
+	funcs     template.FuncMap
+	postSteps []PostStep
+	// partials of the blueprint being generated, see loadPartials
+	partials   *partialSet
+	partialsMu sync.Mutex
+	// workers is the number of files rendered at once, see renderWorkers
+	workers int
+	// cache holds the files parsed by the generators sharing it, see UseTemplateCache
+	cache *TemplateCache
+	// now is the time the blueprints render when set, see PinTime
+	now time.Time
+	// noFormat leaves the Go files as rendered, and formatters are the optional
+	// formatters run over them once written, see format.go
+	noFormat   bool
+	formatters []string
+	// resume is the checkpoint of the interrupted generation being resumed, and
+	// resumedFiles the files it had already written
+	resume       *PartialState
+	resumedFiles []string
 }
 
 // New creates a new Generator instance
@@ -102,8 +173,47 @@ func New() *Generator {
 	}
 }
 
+// NewWithRegistry creates a Generator that resolves blueprints from a shared registry
+func NewWithRegistry(registry *templates.Registry) *Generator {
+	return &Generator{
+		registry: registry,
+		loader:   registry.Loader(),
+	}
+}
+
+// output returns the file system generated files are written to
+func (g *Generator) output() types.OutputFS {
+	if g.out == nil {
+		return outputfs.Local{}
+	}
+	return g.out
+}
+
 // Generate generates a new project based on the configuration
 func (g *Generator) Generate(config types.ProjectConfig, options types.GenerationOptions) (*types.GenerationResult, error) {
+	return g.GenerateContext(context.Background(), config, options)
+}
+
+// GenerateContext generates a new project and stops as soon as ctx is cancelled.
+// The output of a failed or cancelled generation is removed unless
+// options.KeepPartial is set, in which case it is left in the output directory
+// together with a PartialStateFile describing it.
+func (g *Generator) GenerateContext(ctx context.Context, config types.ProjectConfig, options types.GenerationOptions) (*types.GenerationResult, error) {
+	g.progress = newProgressTracker(options.Progress)
+	g.out = options.Output
+	defer func() { g.progress, g.out = nil, nil }()
+
+	result, err := g.generate(ctx, config, options)
+	if err != nil {
+		g.progress.fail(err)
+		return result, err
+	}
+	g.progress.done(len(result.FilesCreated))
+	return result, nil
+}
+
+// generate performs the generation, Generate wraps it with progress reporting
+func (g *Generator) generate(ctx context.Context, config types.ProjectConfig, options types.GenerationOptions) (*types.GenerationResult, error) {
 	startTime := time.Now()
 
 	result := &types.GenerationResult{
@@ -115,12 +225,18 @@ func (g *Generator) Generate(config types.ProjectConfig, options types.Generatio
 	// Create transaction for rollback support
 	tx := NewGenerationTransaction(options.OutputPath)
 
+	// A resumed generation is kept again when it fails
+	g.resume, g.resumedFiles = nil, nil
+	if options.Resume {
+		options.KeepPartial = true
+	}
+
 	// Set up recovery mechanism
 	defer func() {
 		if r := recover(); r != nil {
-			fmt.Printf("Generation panic occurred: %v\n", r)
+			fmt.Fprintf(os.Stderr, "Generation panic occurred: %v\n", r)
 			if rollbackErr := tx.Rollback(); rollbackErr != nil {
-				fmt.Printf("Rollback failed: %v\n", rollbackErr)
+				fmt.Fprintf(os.Stderr, "Rollback failed: %v\n", rollbackErr)
 			}
 			panic(r) // Re-panic after cleanup
 		}
@@ -133,11 +249,63 @@ func (g *Generator) Generate(config types.ProjectConfig, options types.Generatio
 	}
 
 	// Check if template exists
-	template, err := g.registry.Get(g.getTemplateID(config))
+	template, loader, err := g.registry.Lookup(g.getTemplateID(config))
 	if err != nil {
 		// For Phase 0, we'll show a helpful message about upcoming templates
 		return g.handleMissingTemplate(config, result)
 	}
+	// Read files from the same template set even if the registry reloads meanwhile
+	g.loader = loader
+
+	// Experimental blueprints and choices must be enabled explicitly
+	result.Experiments, err = g.checkExperiments(template, config)
+	if err != nil {
+		result.Error = err
+		return result, err
+	}
+	if err := checkOptions(template, config); err != nil {
+		result.Error = err
+		return result, err
+	}
+	if err := g.checkVariableRules(template, config); err != nil {
+		result.Error = err
+		return result, err
+	}
+	if err := g.checkFunctions(template); err != nil {
+		result.Error = err
+		return result, err
+	}
+	if err := checkConditionSyntax(template); err != nil {
+		result.Error = err
+		return result, err
+	}
+	if err := checkHooks(template); err != nil {
+		result.Error = err
+		return result, err
+	}
+	// A repository generated into is already under git
+	g.noGit = options.NoGit || options.IntoExisting
+	g.noFormat = options.NoFormat
+	if err := checkFormatters(options.Formatters); err != nil {
+		result.Error = err
+		return result, err
+	}
+	g.formatters = options.Formatters
+
+	// In strict mode, reject blueprints that reference undefined variables up front
+	g.strict = options.Strict
+	if g.strict {
+		if err := g.validateStrictTemplate(template); err != nil {
+			result.Error = err
+			return result, err
+		}
+	}
+
+	// Reject blueprints whose assets do not match their declared checksums
+	if err := g.verifyTemplateAssets(template); err != nil {
+		result.Error = err
+		return result, err
+	}
 
 	// Skip file system operations in dry run mode
 	if options.DryRun {
@@ -147,36 +315,167 @@ func (g *Generator) Generate(config types.ProjectConfig, options types.Generatio
 		return result, nil
 	}
 
+	// Hooks run commands on this machine, only with the consent of the user
+	if err := checkHookConsent(template, options); err != nil {
+		result.Error = err
+		return result, err
+	}
+
 	// Check if output directory already exists and validate it
-	if err := g.checkOutputDirectory(options.OutputPath); err != nil {
+	if options.Resume {
+		if g.resume, err = g.checkResume(template, options.OutputPath, options.IntoExisting); err != nil {
+			result.Error = err
+			return result, err
+		}
+	} else if options.IntoExisting {
+		if !outputfs.IsLocal(g.out) {
+			err := types.NewValidationError("--into-existing only generates into local repositories", nil)
+			result.Error = err
+			return result, err
+		}
+		if err := g.checkExistingRepository(options.OutputPath); err != nil {
+			result.Error = err
+			return result, err
+		}
+	} else if err := g.checkOutputDirectory(options.OutputPath); err != nil {
 		result.Error = err
 		return result, err
 	}
 
-	// Create output directory
-	if err := os.MkdirAll(options.OutputPath, 0755); err != nil {
-		result.Error = types.NewFileSystemError("failed to create output directory", err)
-		return result, result.Error
+	// Fail fast on unwritable, unsuitable or full targets; remote targets are
+	// checked by creating the output directory
+	if outputfs.IsLocal(g.out) {
+		// The project being resumed is uncommitted by nature
+		if err := g.preflight(template, options.OutputPath, options.Force || options.Resume); err != nil {
+			result.Error = err
+			return result, err
+		}
+	}
+
+	if err := checkCancelled(ctx); err != nil {
+		result.Error = err
+		return result, err
+	}
+
+	// Local projects are generated in a staging directory moved to the output
+	// directory once complete, so a failure or a crash never leaves a broken
+	// project behind. The output directory is known to be missing or empty.
+	workPath := options.OutputPath
+	switch {
+	case options.Resume:
+		// The project is completed where it was kept
+		tx.fs = g.output()
+	case outputfs.IsLocal(g.out):
+		staging, err := newStagingDir(options.OutputPath)
+		if err != nil {
+			result.Error = err
+			return result, err
+		}
+		workPath = staging
+		tx.fs = g.output()
+		tx.outputPath = staging
+		tx.ClaimOutput(true)
+	default:
+		_, statErr := g.output().Stat(options.OutputPath)
+		if err := g.output().MkdirAll(options.OutputPath, 0755); err != nil {
+			result.Error = types.NewFileSystemError("failed to create output directory", err)
+			return result, result.Error
+		}
+		tx.fs = g.output()
+		tx.AddDirectory(options.OutputPath)
+		tx.ClaimOutput(os.IsNotExist(statErr))
 	}
-	tx.AddDirectory(options.OutputPath)
 
 	// Generate project files with transaction tracking
-	filesCreated, err := g.generateProjectFilesWithTransaction(template, config, options.OutputPath, tx)
+	filesCreated, err := g.generateProjectFilesWithTransaction(ctx, template, config, workPath, tx)
+	if err == nil && !options.NoGit {
+		err = checkCancelled(ctx)
+	}
+
+	// Record how the project was generated, including anything deprecated it uses
+	if err == nil {
+		context := g.createTemplateContext(config, template)
+		result.Deprecations = template.Deprecations(context)
+		var manifest []byte
+		manifest, err = newManifest(template, config, result.Deprecations, result.Experiments, g.checksums).encode()
+		if err == nil {
+			manifestPath := filepath.Join(workPath, ManifestFile)
+			if err = g.output().WriteFile(manifestPath, manifest, types.DefaultFileMode); err != nil {
+				err = types.NewFileSystemError("failed to write generation manifest", err)
+			} else {
+				tx.AddFile(manifestPath)
+			}
+		}
+
+		// And pin it, for the project to be generated again the same way
+		var lock Lock
+		if err == nil {
+			templateDir, _ := template.Metadata["path"].(string)
+			lock, err = g.newLock(template, templateDir, config, context)
+		}
+		var data []byte
+		if err == nil {
+			data, err = lock.encode()
+		}
+		if err == nil {
+			lockPath := filepath.Join(workPath, LockFile)
+			if err = g.output().WriteFile(lockPath, data, types.DefaultFileMode); err != nil {
+				err = types.NewFileSystemError("failed to write generation lock", err)
+			} else {
+				tx.AddFile(lockPath)
+			}
+		}
+	}
+
+	var published []string
+	switch {
+	case err == nil && options.IntoExisting:
+		if err = prepareExisting(workPath, options.OutputPath); err == nil {
+			published, err = publishInto(workPath, options.OutputPath)
+		}
+		filesCreated = rebase(filesCreated, workPath, options.OutputPath)
+	case err == nil && workPath != options.OutputPath:
+		err = publishStaging(workPath, options.OutputPath)
+		filesCreated = rebase(filesCreated, workPath, options.OutputPath)
+	}
 	if err != nil {
 		result.Error = err
+		if options.KeepPartial {
+			keepIn := options.OutputPath
+			if options.IntoExisting {
+				// A partial project is never mixed with the files of the repository
+				keepIn = workPath
+			}
+			g.keepPartial(template.ID, config, options, workPath, keepIn, tx.filesCreated, err)
+			return result, err
+		}
 		// Perform rollback on failure
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
-			fmt.Printf("Rollback failed: %v\n", rollbackErr)
+			fmt.Fprintf(os.Stderr, "Rollback failed: %v\n", rollbackErr)
 		}
 		return result, err
 	}
 	result.FilesCreated = filesCreated
+	result.Resumed = g.resumedFiles
 
-	// Initialize git repository if requested
-	if !options.NoGit {
-		if err := g.initGitRepository(options.OutputPath); err != nil {
-			// Git init failure is not fatal, just log it
-			fmt.Printf("Warning: failed to initialize git repository: %v\n", err)
+	// The checkpoint of a resumed generation is done with
+	if options.Resume {
+		if err := g.output().Remove(filepath.Join(options.OutputPath, PartialStateFile)); err != nil && !os.IsNotExist(err) {
+			fmt.Fprintf(os.Stderr, "Warning: failed to remove %s: %v\n", PartialStateFile, err)
+		}
+	}
+
+	// The project is in the repository whether or not it can be committed
+	if options.IntoExisting {
+		branch := options.Branch
+		if branch == "" {
+			branch = DefaultExistingBranch
+		}
+		message := fmt.Sprintf("Generate %s from the %s blueprint of go-starter", config.Name, template.ID)
+		if err := commitGenerated(options.OutputPath, branch, message, published); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: the project is generated but was not committed: %v\n", err)
+		} else {
+			result.Branch = branch
 		}
 	}
 
@@ -185,93 +484,155 @@ func (g *Generator) Generate(config types.ProjectConfig, options types.Generatio
 	return result, nil
 }
 
-// GenerateInMemory generates a project in memory and returns the file contents
+// GenerateInMemory generates a project in memory and returns the file contents.
+// Symlinks are returned with their target as content.
 func (g *Generator) GenerateInMemory(config *types.ProjectConfig, blueprintID string) (map[string][]byte, error) {
+	generated, err := g.GenerateInMemoryFiles(context.Background(), config, blueprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte, len(generated))
+	for path, file := range generated {
+		if file.Symlink != "" {
+			files[path] = []byte(file.Symlink)
+			continue
+		}
+		files[path] = file.Content
+	}
+	return files, nil
+}
+
+// GenerateInMemoryFiles generates a project in memory, keeping each file's permissions
+// and symlink target so that archives can reproduce them
+func (g *Generator) GenerateInMemoryFiles(ctx context.Context, config *types.ProjectConfig, blueprintID string) (map[string]GeneratedFile, error) {
 	// Validate configuration
 	if err := g.validateConfig(*config); err != nil {
 		return nil, err
 	}
 
 	// Get template
-	tmpl, err := g.registry.Get(blueprintID)
+	tmpl, loader, err := g.registry.Lookup(blueprintID)
 	if err != nil {
 		return nil, fmt.Errorf("template not found: %s", blueprintID)
 	}
+	g.loader = loader
+
+	experiments, err := g.checkExperiments(tmpl, *config)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOptions(tmpl, *config); err != nil {
+		return nil, err
+	}
+	if err := g.checkVariableRules(tmpl, *config); err != nil {
+		return nil, err
+	}
+	if err := g.checkFunctions(tmpl); err != nil {
+		return nil, err
+	}
+	if err := checkConditionSyntax(tmpl); err != nil {
+		return nil, err
+	}
+
+	// Standard blueprints are registered under their type, not their directory
+	templateDir := blueprintID
+	if path, ok := tmpl.Metadata["path"].(string); ok {
+		templateDir = path
+	}
 
 	// Generate files in memory
-	files := make(map[string][]byte)
 	context := g.createTemplateContext(*config, tmpl)
 
-	for _, file := range tmpl.Files {
+	// Select the files and their paths, then render them concurrently
+	type pendingFile struct {
+		destPath string
+		file     GeneratedFile
+	}
+	pending := make([]*pendingFile, len(tmpl.Files))
+	for i, file := range tmpl.Files {
+		if err := checkCancelled(ctx); err != nil {
+			return nil, err
+		}
+
 		// Skip files with failing conditions
-		if file.Condition != "" {
-			shouldInclude, err := g.evaluateCondition(file.Condition, context)
-			if err != nil {
-				fmt.Printf("Warning: Failed to evaluate condition %q: %v\n", file.Condition, err)
-				continue
-			}
-			if !shouldInclude {
-				continue
-			}
+		shouldInclude, err := g.includeFile(tmpl, file, context)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Failed to evaluate condition of %s: %v\n", file.Source, err)
+			continue
+		}
+		if !shouldInclude {
+			continue
 		}
 
 		// Process destination path
 		destPath := g.processTemplatePath(file.Destination, *config, &tmpl)
+		if skipCI(destPath, *config) {
+			continue
+		}
 
-		// Load and process template content
-		content, err := g.loader.LoadTemplateFile(blueprintID, file.Source)
-		if err != nil {
-			return nil, fmt.Errorf("failed to load template %s: %w", file.Source, err)
+		if file.IsSymlink() {
+			target := g.processTemplatePath(file.Symlink, *config, &tmpl)
+			if err := validateSymlinkTarget(filepath.ToSlash(destPath), target); err != nil {
+				return nil, err
+			}
+			pending[i] = &pendingFile{destPath: destPath, file: GeneratedFile{Mode: fs.ModeSymlink | 0777, Symlink: target}}
+			continue
 		}
 
-		// Execute template
-		goTmpl, err := template.New(file.Source).Funcs(sprig.TxtFuncMap()).Parse(content)
+		mode, err := file.FileMode()
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse template %s: %w", file.Source, err)
+			return nil, err
 		}
+		pending[i] = &pendingFile{destPath: destPath, file: GeneratedFile{Mode: mode}}
+	}
 
-		var buf bytes.Buffer
-		if err := goTmpl.Execute(&buf, context); err != nil {
-			return nil, fmt.Errorf("failed to execute template %s: %w", file.Source, err)
+	err = g.renderInOrder(ctx, len(pending), func(i int) error {
+		entry := pending[i]
+		if entry == nil || entry.file.Symlink != "" {
+			return nil
 		}
-
-		files[destPath] = buf.Bytes()
+		file := tmpl.Files[i]
+		content, err := g.renderFile(templateDir, tmpl.Version, file, context)
+		if err != nil {
+			return fmt.Errorf("failed to process template %s: %w", file.Source, err)
+		}
+		if g.formats(tmpl, entry.destPath) {
+			content = formatGo(entry.destPath, content)
+		}
+		entry.file.Content = content
+		entry.file.Binary = isBinaryAsset(file, content)
+		return nil
+	}, func(int) {})
+	if err != nil {
+		return nil, err
 	}
 
-	return files, nil
-}
-
-// Preview shows what would be generated without creating files
-func (g *Generator) Preview(config types.ProjectConfig, outputDir string) error {
-	fmt.Printf("Preview for project '%s':\n", config.Name)
-	fmt.Printf("  Type: %s\n", config.Type)
-	fmt.Printf("  Module: %s\n", config.Module)
-
-	if config.Framework != "" {
-		fmt.Printf("  Framework: %s\n", config.Framework)
-	}
-	if config.Architecture != "" {
-		fmt.Printf("  Architecture: %s\n", config.Architecture)
+	// Later files win over earlier ones with the same destination
+	files := make(map[string]GeneratedFile)
+	for _, entry := range pending {
+		if entry != nil {
+			files[entry.destPath] = entry.file
+		}
 	}
 
-	outputPath := filepath.Join(outputDir, config.Name)
-	fmt.Printf("  Output path: %s\n", outputPath)
-
-	templateID := g.getTemplateID(config)
-	template, err := g.registry.Get(templateID)
+	manifest, err := newManifest(tmpl, *config, tmpl.Deprecations(context), experiments, checksums(files)).encode()
 	if err != nil {
-		fmt.Printf("\nTemplate '%s' not found.\n", templateID)
-		fmt.Printf("Use 'go-starter list' to see available blueprints.\n")
-		return nil
+		return nil, err
 	}
+	files[ManifestFile] = GeneratedFile{Content: manifest, Mode: types.DefaultFileMode}
 
-	fmt.Printf("\nFiles to be generated:\n")
-	for _, file := range template.Files {
-		destination := g.processTemplatePath(file.Destination, config, &template)
-		fmt.Printf("  %s\n", destination)
+	lock, err := g.newLock(tmpl, templateDir, *config, context)
+	if err != nil {
+		return nil, err
+	}
+	data, err := lock.encode()
+	if err != nil {
+		return nil, err
 	}
+	files[LockFile] = GeneratedFile{Content: data, Mode: types.DefaultFileMode}
 
-	return nil
+	return files, nil
 }
 
 // validateConfig validates the project configuration
@@ -285,7 +646,27 @@ func (g *Generator) validateConfig(config types.ProjectConfig) error {
 	if config.Type == "" {
 		return types.NewValidationError("project type is required", nil)
 	}
-	return nil
+	return naming.Validate(config.Name)
+}
+
+// addNameVariables adds the identifiers derived from the project name to a template context
+func addNameVariables(context map[string]any, name string) {
+	context["ProjectPackage"] = naming.PackageName(name)
+	context["ProjectIdentifier"] = naming.GoIdentifier(name)
+	context["DockerImage"] = naming.DockerImageName(name)
+	context["KubernetesName"] = naming.KubernetesName(name)
+	context["EnvPrefix"] = naming.EnvPrefix(name)
+}
+
+// moduleArchitectureTypes are the project types whose single blueprint lays out
+// its modules in the requested architecture instead of having one blueprint per architecture
+var moduleArchitectureTypes = map[string]bool{
+	"workspace": true,
+}
+
+// BlueprintID returns the ID of the blueprint a generation with config uses
+func (g *Generator) BlueprintID(config types.ProjectConfig) string {
+	return g.getTemplateID(config)
 }
 
 // getTemplateID maps project configuration to template ID
@@ -298,7 +679,7 @@ func (g *Generator) getTemplateID(config types.ProjectConfig) string {
 	}
 	
 	// Fall back to architecture-based selection
-	if config.Architecture != "" && config.Architecture != "standard" {
+	if config.Architecture != "" && config.Architecture != "standard" && !moduleArchitectureTypes[config.Type] {
 		return fmt.Sprintf("%s-%s", config.Type, config.Architecture)
 	}
 	return config.Type
@@ -308,18 +689,18 @@ func (g *Generator) getTemplateID(config types.ProjectConfig) string {
 func (g *Generator) handleMissingTemplate(config types.ProjectConfig, result *types.GenerationResult) (*types.GenerationResult, error) {
 	templateID := g.getTemplateID(config)
 
-	fmt.Printf("Template '%s' not found.\n", templateID)
-	fmt.Println("\nAvailable templates:")
+	fmt.Fprintf(os.Stderr, "Template '%s' not found.\n", templateID)
+	fmt.Fprintln(os.Stderr, "\nAvailable templates:")
 	
 	// Get list of available templates from registry
 	templates := g.registry.List()
 	if len(templates) > 0 {
 		for _, tmpl := range templates {
-			fmt.Printf("  • %s - %s\n", tmpl.ID, tmpl.Description)
+			fmt.Fprintf(os.Stderr, "  • %s - %s\n", tmpl.ID, tmpl.Description)
 		}
-		fmt.Printf("\nUse 'go-starter list' to see all available blueprints with detailed descriptions.\n")
+		fmt.Fprintf(os.Stderr, "\nUse 'go-starter list' to see all available blueprints with detailed descriptions.\n")
 	} else {
-		fmt.Println("  No templates currently available.")
+		fmt.Fprintln(os.Stderr, "  No templates currently available.")
 	}
 
 	err := types.NewTemplateNotFoundError(templateID)
@@ -328,21 +709,46 @@ func (g *Generator) handleMissingTemplate(config types.ProjectConfig, result *ty
 }
 
 // generateProjectFiles generates all files for the project
+// keepPartial leaves the output of a failed generation in outputPath, moving it
+// there from the staging directory workPath, with a PartialStateFile describing it
+func (g *Generator) keepPartial(blueprintID string, config types.ProjectConfig, options types.GenerationOptions, workPath, outputPath string, files []string, reason error) {
+	partialOptions := PartialOptions{NoGit: options.NoGit, NoFormat: options.NoFormat, Formatters: options.Formatters, Strict: options.Strict}
+	// A resumed generation failing again still has the files of the first one
+	if g.resume != nil {
+		for _, file := range g.resume.FilesWritten {
+			files = append(files, filepath.Join(workPath, filepath.FromSlash(file)))
+		}
+	}
+	phase := g.resume.furthestPhase(g.progress.currentPhase())
+	if err := writePartialState(g.output(), workPath, blueprintID, config, partialOptions, phase, files, reason); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to record partial generation state: %v\n", err)
+	}
+	if workPath == outputPath {
+		return
+	}
+	if err := publishStaging(workPath, outputPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: the partial project is left in %s: %v\n", workPath, err)
+	}
+}
+
 // generateProjectFilesWithTransaction generates project files with rollback support
-func (g *Generator) generateProjectFilesWithTransaction(tmpl types.Template, config types.ProjectConfig, outputPath string, tx *GenerationTransaction) ([]string, error) {
+func (g *Generator) generateProjectFilesWithTransaction(ctx context.Context, tmpl types.Template, config types.ProjectConfig, outputPath string, tx *GenerationTransaction) ([]string, error) {
 	// Set the transaction in generator for file tracking
 	g.currentTransaction = tx
 	defer func() { g.currentTransaction = nil }()
 
 	// Use the existing generateProjectFiles function
-	return g.generateProjectFiles(tmpl, config, outputPath)
+	return g.generateProjectFiles(ctx, tmpl, config, outputPath)
 }
 
-func (g *Generator) generateProjectFiles(tmpl types.Template, config types.ProjectConfig, outputPath string) ([]string, error) {
+func (g *Generator) generateProjectFiles(ctx context.Context, tmpl types.Template, config types.ProjectConfig, outputPath string) ([]string, error) {
 	var filesCreated []string
 
 	// Create template context with all variables
 	context := g.createTemplateContext(config, tmpl)
+	if g.strict {
+		addStrictDefaults(context)
+	}
 
 	// Get template directory from metadata
 	templateDir, ok := tmpl.Metadata["path"].(string)
@@ -350,50 +756,156 @@ func (g *Generator) generateProjectFiles(tmpl types.Template, config types.Proje
 		return nil, fmt.Errorf("template metadata missing path")
 	}
 
-	// Process each file in the template
-	for _, templateFile := range tmpl.Files {
-		// Evaluate condition if present
-		if templateFile.Condition != "" {
-			shouldGenerate, err := g.evaluateCondition(templateFile.Condition, context)
-			if err != nil {
-				return nil, fmt.Errorf("failed to evaluate condition for %s: %w", templateFile.Source, err)
-			}
-			if !shouldGenerate {
-				continue
-			}
+	// Render every file before writing any, so template errors leave nothing behind
+	type pendingFile struct {
+		file     types.TemplateFile
+		destPath string
+		content  []byte
+		mode     fs.FileMode
+	}
+	selected := make([]*pendingFile, len(tmpl.Files))
+	for i, templateFile := range tmpl.Files {
+		if err := checkCancelled(ctx); err != nil {
+			return nil, err
+		}
+
+		// Evaluate the conditions of the file and its directories
+		shouldGenerate, err := g.includeFile(tmpl, templateFile, context)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate condition for %s: %w", templateFile.Source, err)
+		}
+		if !shouldGenerate {
+			continue
 		}
 
 		// Process template path with variables
 		destPath := g.processTemplatePath(templateFile.Destination, config, &tmpl)
-		fullDestPath := filepath.Join(outputPath, destPath)
+		if skipCI(destPath, config) {
+			continue
+		}
+		entry := &pendingFile{file: templateFile, destPath: destPath}
+		if !templateFile.IsSymlink() {
+			if entry.mode, err = templateFile.FileMode(); err != nil {
+				return nil, err
+			}
+		}
+		selected[i] = entry
+	}
 
-		// Create directory if it doesn't exist
-		if err := os.MkdirAll(filepath.Dir(fullDestPath), 0755); err != nil {
-			return nil, types.NewFileSystemError("failed to create directory", err)
+	// Files are rendered concurrently and reported in the order of the blueprint
+	g.progress.start(types.PhaseRender, len(tmpl.Files))
+	err := g.renderInOrder(ctx, len(selected), func(i int) error {
+		entry := selected[i]
+		if entry == nil || entry.file.IsSymlink() {
+			return nil
+		}
+		content, err := g.renderFile(templateDir, tmpl.Version, entry.file, context)
+		if err != nil {
+			return fmt.Errorf("failed to process template file %s: %w", entry.file.Source, err)
 		}
+		if g.formats(tmpl, entry.destPath) {
+			content = formatGo(entry.destPath, content)
+		}
+		entry.content = content
+		return nil
+	}, func(i int) {
+		g.progress.step(i+1, tmpl.Files[i].Source)
+	})
+	if err != nil {
+		return nil, err
+	}
+	g.progress.end()
 
-		// Generate file from template
-		if err := g.processTemplateFile(templateDir, templateFile.Source, fullDestPath, context); err != nil {
-			return nil, fmt.Errorf("failed to process template file %s: %w", templateFile.Source, err)
+	var pending []pendingFile
+	g.checksums = make(map[string]string)
+	for _, entry := range selected {
+		if entry == nil {
+			continue
+		}
+		// Symlinks are created once all regular files exist
+		if entry.file.IsSymlink() {
+			target := g.processTemplatePath(entry.file.Symlink, config, &tmpl)
+			g.checksums[filepath.ToSlash(entry.destPath)] = checksum(GeneratedFile{Symlink: target})
+		} else {
+			g.checksums[filepath.ToSlash(entry.destPath)] = checksum(GeneratedFile{Content: entry.content})
 		}
+		pending = append(pending, *entry)
+	}
 
-		// Set executable permission if needed
-		if templateFile.Executable {
-			if err := os.Chmod(fullDestPath, 0755); err != nil {
-				return nil, types.NewFileSystemError("failed to set executable permission", err)
-			}
+	// Pre-generation hooks run in the empty project directory, once
+	if !g.resume.completed(types.PhasePreHooks) {
+		if err := g.executePreHooks(ctx, tmpl, config, outputPath, context); err != nil {
+			return nil, err
 		}
+	}
 
+	g.progress.start(types.PhaseWrite, len(pending))
+	written := 0
+	for _, entry := range pending {
+		if entry.file.IsSymlink() {
+			continue
+		}
+		if err := checkCancelled(ctx); err != nil {
+			return filesCreated, err
+		}
+		fullDestPath := filepath.Join(outputPath, entry.destPath)
+		if g.resumed(fullDestPath, entry.content, "") {
+			g.resumedFiles = append(g.resumedFiles, fullDestPath)
+		} else if err := g.writeGeneratedFile(fullDestPath, entry.content, entry.mode); err != nil {
+			return nil, err
+		}
 		filesCreated = append(filesCreated, fullDestPath)
+		written++
+		g.progress.step(written, entry.destPath)
+	}
+	for _, entry := range pending {
+		if !entry.file.IsSymlink() {
+			continue
+		}
+		fullDestPath := filepath.Join(outputPath, entry.destPath)
+		if g.resumed(fullDestPath, nil, g.processTemplatePath(entry.file.Symlink, config, &tmpl)) {
+			g.resumedFiles = append(g.resumedFiles, fullDestPath)
+		} else {
+			// A resumed generation replaces whatever the link is left as
+			if g.resume != nil {
+				_ = g.output().Remove(fullDestPath)
+			}
+			if _, err := g.generateSymlink(entry.file, entry.destPath, outputPath, config, &tmpl); err != nil {
+				return nil, err
+			}
+		}
+		filesCreated = append(filesCreated, fullDestPath)
+		written++
+		g.progress.step(written, entry.destPath)
+	}
+	g.progress.end()
+
+	if err := g.runFormatters(ctx, outputPath); err != nil {
+		return filesCreated, err
+	}
+
+	// Projects put under git get a .gitignore, unless the blueprint writes one
+	if !g.noGit && outputfs.IsLocal(g.out) {
+		if _, err := os.Lstat(filepath.Join(outputPath, ".gitignore")); os.IsNotExist(err) {
+			if err := g.createGitignore(outputPath); err != nil {
+				return nil, err
+			}
+			filesCreated = append(filesCreated, filepath.Join(outputPath, ".gitignore"))
+		}
 	}
 
 	// Process dependencies
-	if err := g.processDependencies(tmpl, config, outputPath, context); err != nil {
+	if err := g.processDependencies(ctx, tmpl, config, outputPath, context); err != nil {
+		if cancelErr := checkCancelled(ctx); cancelErr != nil {
+			return filesCreated, cancelErr
+		}
 		return nil, fmt.Errorf("failed to process dependencies: %w", err)
 	}
 
 	// Execute post-generation hooks
-	g.executeHooks(tmpl, config, outputPath, context)
+	if err := g.executeHooks(ctx, tmpl, config, outputPath, context); err != nil {
+		return filesCreated, err
+	}
 
 	return filesCreated, nil
 }
@@ -414,36 +926,6 @@ func (g *Generator) createGoMod(config types.ProjectConfig, path string) error {
 	return nil
 }
 
-// initGitRepository initializes a git repository
-func (g *Generator) initGitRepository(projectPath string) error {
-	return g.initGit(projectPath)
-}
-
-// initGit handles the actual git initialization
-func (g *Generator) initGit(projectPath string) error {
-	// Check if git is available
-	if !g.isGitAvailable() {
-		return types.NewFileSystemError("git is not available in PATH", nil)
-	}
-
-	// Check if directory already has git
-	if g.hasGitRepository(projectPath) {
-		return nil // Already a git repository
-	}
-
-	// Initialize git repository
-	if err := g.runGitCommand(projectPath, "init"); err != nil {
-		return types.NewFileSystemError("failed to initialize git repository", err)
-	}
-
-	// Create .gitignore file
-	if err := g.createGitignore(projectPath); err != nil {
-		return types.NewFileSystemError("failed to create .gitignore", err)
-	}
-
-	return nil
-}
-
 // processTemplatePath processes template variables in file paths
 func (g *Generator) processTemplatePath(path string, config types.ProjectConfig, tmplObj *types.Template) string {
 	// Create a comprehensive context for path processing (same as file content)
@@ -458,6 +940,7 @@ func (g *Generator) processTemplatePath(path string, config types.ProjectConfig,
 		"Email":        config.Email,
 		"License":      config.License,
 	}
+	addNameVariables(context, config.Name)
 
 	// Add variables from config.Variables map
 	if config.Variables != nil {
@@ -479,20 +962,21 @@ func (g *Generator) processTemplatePath(path string, config types.ProjectConfig,
 				context[variable.Name] = variable.Default
 			}
 		}
+		addTerraformProviderVariables(context, config, *tmplObj)
 	}
 
 	// Use text/template to process the path
-	tmpl, err := template.New("path").Funcs(sprig.FuncMap()).Parse(path)
+	tmpl, err := template.New("path").Funcs(g.funcMap()).Parse(path)
 	if err != nil {
 		// Log the error but continue with original path for backwards compatibility
-		fmt.Printf("Warning: Failed to parse template path %q: %v\n", path, err)
+		fmt.Fprintf(os.Stderr, "Warning: Failed to parse template path %q: %v\n", path, err)
 		return path
 	}
 
 	var buf bytes.Buffer
 	if err := tmpl.Execute(&buf, context); err != nil {
 		// Log the error but continue with original path for backwards compatibility
-		fmt.Printf("Warning: Failed to execute template path %q: %v\n", path, err)
+		fmt.Fprintf(os.Stderr, "Warning: Failed to execute template path %q: %v\n", path, err)
 		return path
 	}
 
@@ -518,8 +1002,18 @@ func (g *Generator) createTemplateContext(config types.ProjectConfig, tmpl types
 		"License":      config.License,
 		"Type":         config.Type,
 		"Logger":       config.Logger,
+		// The blueprint the project is generated from, for generated code that reports it
+		"BlueprintID":      tmpl.ID,
+		"BlueprintVersion": tmpl.Version,
 	}
 
+	// Add identifiers derived from the project name
+	addNameVariables(context, config.Name)
+
+	// Add the enabled experimental features, for conditions such as
+	// {{ index .Experimental "architecture.cqrs" }}
+	context["Experimental"] = experimentsContext(config, tmpl)
+
 	// Add features from the config
 	if config.Features != nil {
 		context["Features"] = config.Features
@@ -580,6 +1074,12 @@ func (g *Generator) createTemplateContext(config types.ProjectConfig, tmpl types
 		}
 	}
 
+	// Add the provider type name and registry namespace of terraform providers
+	addTerraformProviderVariables(context, config, tmpl)
+
+	// Add the deprecated modules and Go versions of workspaces
+	addWorkspaceVariables(context, tmpl)
+
 	// Add convenience variables for common patterns
 	// Check Features struct first, then fall back to Variables map, then template defaults
 	dbDriver := g.getFeatureValue(config, "database", "driver", "")
@@ -705,6 +1205,9 @@ func (g *Generator) createTemplateContext(config types.ProjectConfig, tmpl types
 	context["ORM"] = ormValue
 	context["DatabaseORM"] = ormValue
 
+	// Add the variables the blueprint derives from the others
+	g.addDerivedVariables(context, tmpl)
+
 	return context
 }
 
@@ -766,28 +1269,15 @@ func (g *Generator) getDatabaseDrivers(config types.ProjectConfig) []string {
 	return config.Features.Database.GetDrivers()
 }
 
-// processTemplateFile processes a single template file
-func (g *Generator) processTemplateFile(templateDir, sourceFile, destPath string, context map[string]any) error {
-	// Load template content
-	content, err := g.loader.LoadTemplateFile(templateDir, sourceFile)
-	if err != nil {
-		return fmt.Errorf("failed to load template file: %w", err)
-	}
-
-	// Parse template with Sprig functions
-	tmpl, err := template.New(sourceFile).Funcs(sprig.FuncMap()).Parse(content)
-	if err != nil {
-		return fmt.Errorf("failed to parse template: %w", err)
-	}
-
-	// Execute template
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, context); err != nil {
-		return fmt.Errorf("failed to execute template: %w", err)
+// writeGeneratedFile writes a rendered file with the given permissions, creating its directory
+func (g *Generator) writeGeneratedFile(destPath string, content []byte, mode fs.FileMode) error {
+	// Create directory if it doesn't exist
+	if err := g.output().MkdirAll(filepath.Dir(destPath), 0755); err != nil {
+		return types.NewFileSystemError("failed to create directory", err)
 	}
 
 	// Write to destination
-	if err := os.WriteFile(destPath, buf.Bytes(), 0644); err != nil {
+	if err := g.output().WriteFile(destPath, content, mode); err != nil {
 		return types.NewFileSystemError("failed to write file", err)
 	}
 
@@ -799,43 +1289,71 @@ func (g *Generator) processTemplateFile(templateDir, sourceFile, destPath string
 	return nil
 }
 
-// evaluateCondition evaluates a template condition
-func (g *Generator) evaluateCondition(condition string, context map[string]any) (bool, error) {
-	// Parse condition as a template
-	tmpl, err := template.New("condition").Funcs(sprig.FuncMap()).Parse(condition)
+// renderFile loads a blueprint file and executes it as a template. Binary assets are
+// returned unchanged.
+func (g *Generator) renderFile(templateDir, version string, file types.TemplateFile, context map[string]any) ([]byte, error) {
+	content, binary, err := g.loadSource(templateDir, file)
 	if err != nil {
-		return false, fmt.Errorf("failed to parse condition: %w", err)
+		return nil, err
+	}
+	if binary {
+		return content, nil
+	}
+
+	tmpl, err := g.parseFile(templateDir, version, file, string(content), context)
+	if err != nil {
+		return nil, err
 	}
 
-	// Execute condition
+	// Execute template
 	var buf bytes.Buffer
 	if err := tmpl.Execute(&buf, context); err != nil {
-		return false, fmt.Errorf("failed to execute condition: %w", err)
+		return nil, newRenderError(file.Source, "execute", err, context, g.funcMap())
 	}
+	return buf.Bytes(), nil
+}
 
-	// Parse result
-	result := strings.TrimSpace(buf.String())
+// parseFile parses a blueprint file with Sprig functions, and the partials it
+// includes, or returns it from the template cache of the generator
+func (g *Generator) parseFile(templateDir, version string, file types.TemplateFile, content string, context map[string]any) (*template.Template, error) {
+	cache := g.templateCache()
+	key := templateKey{blueprint: templateDir, version: version, source: file.Source, strict: g.strict}
+	if cache != nil {
+		if tmpl := cache.get(g.loader, key); tmpl != nil {
+			return tmpl, nil
+		}
+	}
 
-	// Handle boolean values
-	if result == "true" {
-		return true, nil
+	funcs := g.funcMap()
+	tmpl := template.New(file.Source).Funcs(funcs).Option(g.missingKeyOption())
+	if includesPartials(content) {
+		if err := g.addPartials(tmpl, templateDir, content, context); err != nil {
+			return nil, err
+		}
 	}
-	if result == "false" {
-		return false, nil
+	if _, err := tmpl.Parse(content); err != nil {
+		return nil, newRenderError(file.Source, "parse", err, context, funcs)
 	}
-
-	// Handle numeric values (0 = false, non-zero = true)
-	if num, err := strconv.Atoi(result); err == nil {
-		return num != 0, nil
+	if cache != nil {
+		cache.put(g.loader, key, tmpl)
 	}
+	return tmpl, nil
+}
 
-	// Handle string values (empty = false, non-empty = true)
-	return result != "", nil
+// evaluateCondition evaluates a condition, a template or an expression, see condition.go
+func (g *Generator) evaluateCondition(condition string, context map[string]any) (bool, error) {
+	value, err := g.evaluateExpression(condition, context, g.strict)
+	if err != nil {
+		return false, err
+	}
+	return truthy(value), nil
 }
 
 // processDependencies processes template dependencies
-func (g *Generator) processDependencies(tmpl types.Template, _ types.ProjectConfig, outputPath string, context map[string]any) error {
+func (g *Generator) processDependencies(ctx context.Context, tmpl types.Template, _ types.ProjectConfig, outputPath string, context map[string]any) error {
 	if len(tmpl.Dependencies) == 0 {
+		g.progress.start(types.PhaseTidy, 0)
+		g.progress.end()
 		return nil
 	}
 
@@ -862,16 +1380,27 @@ func (g *Generator) processDependencies(tmpl types.Template, _ types.ProjectConf
 		}
 	}
 
+	g.progress.start(types.PhaseTidy, len(dependencies))
+
 	// If we have dependencies, add them to go.mod
 	if len(dependencies) > 0 {
-		return g.addDependencies(outputPath, dependencies)
+		if err := g.addDependencies(ctx, outputPath, dependencies); err != nil {
+			return err
+		}
 	}
 
+	g.progress.end()
 	return nil
 }
 
 // addDependencies adds dependencies to go.mod
-func (g *Generator) addDependencies(projectPath string, dependencies []string) error {
+func (g *Generator) addDependencies(ctx context.Context, projectPath string, dependencies []string) error {
+	// Commands cannot run on remote targets, leave instructions instead
+	if !outputfs.IsLocal(g.out) {
+		g.logRemoteDependenciesNotice(dependencies)
+		return nil
+	}
+
 	// Check if Go is available before trying to add dependencies
 	if !g.isGoAvailable() {
 		// Go is not available - generate a warning and instructions instead of failing
@@ -879,8 +1408,11 @@ func (g *Generator) addDependencies(projectPath string, dependencies []string) e
 		return nil
 	}
 
-	for _, dep := range dependencies {
-		cmd := exec.Command("go", "get", dep)
+	for i, dep := range dependencies {
+		if err := checkCancelled(ctx); err != nil {
+			return err
+		}
+		cmd := exec.CommandContext(ctx, "go", "get", dep)
 		cmd.Dir = projectPath
 
 		if output, err := cmd.CombinedOutput(); err != nil {
@@ -891,6 +1423,7 @@ func (g *Generator) addDependencies(projectPath string, dependencies []string) e
 			}
 			return fmt.Errorf("failed to add dependency %q: %s", dep, outputStr)
 		}
+		g.progress.step(i+1, dep)
 	}
 	return nil
 }
@@ -901,73 +1434,97 @@ func (g *Generator) isGoAvailable() bool {
 	return cmd.Run() == nil
 }
 
+// logRemoteDependenciesNotice tells how to finish a project generated on a remote target
+func (g *Generator) logRemoteDependenciesNotice(dependencies []string) {
+	fmt.Fprintln(os.Stderr, "Note: dependencies were not installed on the remote target.")
+	fmt.Fprintln(os.Stderr, "   Run the following commands in the project directory there:")
+	fmt.Fprintln(os.Stderr)
+	for _, dep := range dependencies {
+		fmt.Fprintf(os.Stderr, "      go get %s\n", dep)
+	}
+	fmt.Fprintln(os.Stderr, "      go mod tidy")
+	fmt.Fprintln(os.Stderr)
+}
+
 // logGoUnavailableWarning logs a warning when Go is not available
 func (g *Generator) logGoUnavailableWarning(dependencies []string) {
-	fmt.Println("⚠️  Warning: Go is not installed or not available in PATH")
-	fmt.Println("   Project structure has been generated successfully, but dependencies were not installed.")
-	fmt.Println()
-	fmt.Println("   To complete the setup:")
-	fmt.Println("   1. Install Go from https://golang.org/dl/")
-	fmt.Println("   2. Navigate to your project directory")
-	fmt.Println("   3. Run the following commands:")
-	fmt.Println()
+	fmt.Fprintln(os.Stderr, "⚠️  Warning: Go is not installed or not available in PATH")
+	fmt.Fprintln(os.Stderr, "   Project structure has been generated successfully, but dependencies were not installed.")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "   To complete the setup:")
+	fmt.Fprintln(os.Stderr, "   1. Install Go from https://golang.org/dl/")
+	fmt.Fprintln(os.Stderr, "   2. Navigate to your project directory")
+	fmt.Fprintln(os.Stderr, "   3. Run the following commands:")
+	fmt.Fprintln(os.Stderr)
 	for _, dep := range dependencies {
-		fmt.Printf("      go get %s\n", dep)
+		fmt.Fprintf(os.Stderr, "      go get %s\n", dep)
 	}
-	fmt.Println("      go mod tidy")
-	fmt.Println()
+	fmt.Fprintln(os.Stderr, "      go mod tidy")
+	fmt.Fprintln(os.Stderr)
 }
 
-// executeHooks executes post-generation hooks
-func (g *Generator) executeHooks(tmpl types.Template, config types.ProjectConfig, outputPath string, _ map[string]any) {
-	for _, hook := range tmpl.PostHooks {
-		// Check condition if present - for now, we'll execute all hooks
-		// In the future, we can add conditional hook execution based on hook.Name
-
-		// Determine working directory
-		workDir := outputPath
-		if hook.WorkDir != "" {
-			// Handle special case of {{.OutputPath}} in hook work directory
-			if hook.WorkDir == "{{.OutputPath}}" {
-				workDir = outputPath
-			} else {
-				// Process other template variables in work directory
-				workDir = g.processTemplatePath(hook.WorkDir, config, &tmpl)
-				if !filepath.IsAbs(workDir) {
-					workDir = filepath.Join(outputPath, workDir)
-				}
-			}
-		}
+// executeHooks executes post-generation hooks, then the post-generation steps of
+// the extensions; only cancellation and the failure of a hook with the fail
+// policy are reported as errors
+func (g *Generator) executeHooks(ctx context.Context, tmpl types.Template, config types.ProjectConfig, outputPath string, context map[string]any) error {
+	hooks := g.postGenerationHooks(tmpl, outputPath)
+	g.progress.start(types.PhaseHooks, len(hooks)+len(g.postSteps))
+	if err := g.runHooks(ctx, hooks, tmpl, config, outputPath, context); err != nil {
+		return err
+	}
+	if err := g.executePostSteps(ctx, config, outputPath, len(hooks)); err != nil {
+		return err
+	}
+	g.progress.end()
+	return checkCancelled(ctx)
+}
 
-		// Execute command
-		var cmd *exec.Cmd
-		if len(hook.Args) > 0 {
-			cmd = exec.Command(hook.Command, hook.Args...)
+// hookApplies reports whether the condition of hook, if any, holds for the project
+func (g *Generator) hookApplies(hook types.Hook, context map[string]any) bool {
+	if hook.Condition == "" {
+		return true
+	}
+	applies, err := g.evaluateCondition(hook.Condition, context)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Failed to evaluate condition of hook '%s': %v\n", hook.Name, err)
+		return false
+	}
+	return applies
+}
+
+// executeHook runs a single hook; its failure is reported according to its
+// failure policy, and is an error with the fail policy
+func (g *Generator) executeHook(ctx context.Context, hook types.Hook, tmpl types.Template, config types.ProjectConfig, outputPath string) error {
+	// Determine working directory
+	workDir := outputPath
+	if hook.WorkDir != "" {
+		// Handle special case of {{.OutputPath}} in hook work directory
+		if hook.WorkDir == "{{.OutputPath}}" {
+			workDir = outputPath
 		} else {
-			// Check if command contains shell metacharacters that need expansion
-			if strings.Contains(hook.Command, "*") || strings.Contains(hook.Command, "?") || strings.Contains(hook.Command, "[") {
-				// Use shell for wildcard expansion
-				cmd = exec.Command("sh", "-c", hook.Command)
-			} else {
-				// Split command string if no explicit args
-				parts := strings.Fields(hook.Command)
-				if len(parts) == 0 {
-					continue
-				}
-				cmd = exec.Command(parts[0], parts[1:]...)
+			// Process other template variables in work directory
+			workDir = g.processTemplatePath(hook.WorkDir, config, &tmpl)
+			if !filepath.IsAbs(workDir) {
+				workDir = filepath.Join(outputPath, workDir)
 			}
 		}
+	}
 
-		cmd.Dir = workDir
-		if output, err := cmd.CombinedOutput(); err != nil {
-			// Don't fail the generation for hook errors, just warn
-			if len(output) > 0 {
-				fmt.Printf("Warning: Hook '%s' failed with error: %v\nOutput: %s\n", hook.Name, err, string(output))
-			} else {
-				fmt.Printf("Warning: Hook '%s' failed with error: %v\n", hook.Name, err)
-			}
-		}
+	output, err := hookCommand(ctx, hook, workDir).CombinedOutput()
+	if err == nil || ctx.Err() != nil {
+		return nil
+	}
+	if trimmed := strings.TrimSpace(string(output)); trimmed != "" {
+		err = fmt.Errorf("%w\nOutput: %s", err, trimmed)
 	}
+	switch hook.FailurePolicy() {
+	case types.HookFail:
+		return types.NewGenerationError(fmt.Sprintf("hook '%s' failed", hook.Name), err)
+	case types.HookIgnore:
+	default:
+		fmt.Fprintf(os.Stderr, "Warning: Hook '%s' failed with error: %v\n", hook.Name, err)
+	}
+	return nil
 }
 
 // isGitAvailable checks if git is available in the system PATH
@@ -983,19 +1540,6 @@ func (g *Generator) hasGitRepository(projectPath string) bool {
 	return err == nil
 }
 
-// runGitCommand executes a git command in the specified directory
-func (g *Generator) runGitCommand(projectPath string, args ...string) error {
-	cmd := exec.Command("git", args...)
-	cmd.Dir = projectPath
-
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("git command failed: %s, output: %s", err, string(output))
-	}
-
-	return nil
-}
-
 // createGitignore creates a basic .gitignore file for Go projects
 func (g *Generator) createGitignore(projectPath string) error {
 	gitignoreContent := `# Binaries for programs and plugins
@@ -1067,7 +1611,7 @@ temp/
 // checkOutputDirectory validates the output directory before generation
 func (g *Generator) checkOutputDirectory(outputPath string) error {
 	// Check if the path exists
-	info, err := os.Stat(outputPath)
+	info, err := g.output().Stat(outputPath)
 	if os.IsNotExist(err) {
 		// Directory doesn't exist, this is fine
 		return nil
@@ -1082,7 +1626,7 @@ func (g *Generator) checkOutputDirectory(outputPath string) error {
 	}
 
 	// Check if directory is empty
-	entries, err := os.ReadDir(outputPath)
+	entries, err := g.output().ReadDir(outputPath)
 	if err != nil {
 		return types.NewFileSystemError("failed to read output directory", err)
 	}
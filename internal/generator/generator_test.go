@@ -6,12 +6,13 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"testing/fstest"
 
 	"github.com/francknouama/go-starter/internal/templates"
 	"github.com/francknouama/go-starter/pkg/types"
 )
 
-func setupTestTemplates(t *testing.T) {
+func setupTestTemplates(t testing.TB) {
 	t.Helper()
 
 	// Get the project root for tests
@@ -28,6 +29,18 @@ func setupTestTemplates(t *testing.T) {
 	templates.SetTemplatesFS(os.DirFS(templatesDir))
 }
 
+// newTestRegistry returns a registry of the blueprints written for a test in
+// fsys, leaving the blueprints of the other tests in place
+func newTestRegistry(t testing.TB, fsys fstest.MapFS) *templates.Registry {
+	t.Helper()
+
+	registry, err := templates.NewRegistryWithFS(fsys)
+	if err != nil {
+		t.Fatalf("Failed to load test blueprints: %v", err)
+	}
+	return registry
+}
+
 func TestNew(t *testing.T) {
 	setupTestTemplates(t)
 
@@ -189,6 +202,14 @@ func TestGenerator_getTemplateID(t *testing.T) {
 			},
 			expected: "cli-hexagonal",
 		},
+		{
+			name: "workspace lays out its modules itself",
+			config: types.ProjectConfig{
+				Type:         "workspace",
+				Architecture: "ddd",
+			},
+			expected: "workspace",
+		},
 	}
 
 	for _, tt := range tests {
@@ -895,59 +916,6 @@ func TestGenerator_isGoAvailable(t *testing.T) {
 	}
 }
 
-func TestGenerator_runGitCommand(t *testing.T) {
-	setupTestTemplates(t)
-
-	generator := New()
-
-	// Skip test if git is not available
-	if !generator.isGitAvailable() {
-		t.Skip("Git is not available, skipping git command test")
-	}
-
-	// Create temporary directory
-	tempDir, err := os.MkdirTemp("", "go-starter-test")
-	if err != nil {
-		t.Fatalf("Failed to create temp dir: %v", err)
-	}
-	defer func() {
-		if err := os.RemoveAll(tempDir); err != nil {
-			t.Logf("Warning: failed to remove temp dir: %v", err)
-		}
-	}()
-
-	tests := []struct {
-		name    string
-		args    []string
-		wantErr bool
-	}{
-		{
-			name:    "git init command",
-			args:    []string{"init"},
-			wantErr: false,
-		},
-		{
-			name:    "git status command",
-			args:    []string{"status", "--porcelain"},
-			wantErr: false,
-		},
-		{
-			name:    "invalid git command",
-			args:    []string{"invalid-command"},
-			wantErr: true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := generator.runGitCommand(tempDir, tt.args...)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("runGitCommand() error = %v, wantErr %v", err, tt.wantErr)
-			}
-		})
-	}
-}
-
 func TestGenerator_checkOutputDirectory(t *testing.T) {
 	setupTestTemplates(t)
 
@@ -0,0 +1,64 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_GracefulShutdown(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(target string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:         "orders",
+			Module:       "github.com/test/orders",
+			Type:         "web-api",
+			Architecture: "clean",
+			Framework:    "gin",
+			Logger:       "slog",
+			Variables:    map[string]string{"DeploymentTarget": target},
+			Features: &types.Features{
+				Database:       types.DatabaseConfig{Driver: "postgres", ORM: "gorm"},
+				Authentication: types.AuthConfig{Type: "jwt"},
+			},
+		}
+	}
+
+	t.Run("shutdown goes through the lifecycle manager", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("none"), "web-api-clean")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "internal/infrastructure/lifecycle/manager.go")
+		assert.Contains(t, files, "tests/unit/lifecycle_test.go")
+		assert.Contains(t, string(files["internal/adapters/controllers/health_controller.go"].Content), "c.readiness.Ready()")
+
+		main := string(files["cmd/server/main.go"].Content)
+		assert.Contains(t, main, "server.SetKeepAlivesEnabled(false)")
+		assert.Contains(t, main, `app.Lifecycle.OnShutdown("HTTP server", server.Shutdown)`)
+		assert.Contains(t, main, "app.Lifecycle.Shutdown(drainCtx)")
+
+		assert.NotContains(t, files, "deployments/k8s/deployment.yaml")
+	})
+
+	t.Run("kubernetes manifests wait for the endpoints to drain", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("kubernetes"), "web-api-clean")
+		require.NoError(t, err)
+
+		deployment := string(files["deployments/k8s/deployment.yaml"].Content)
+		assert.Contains(t, deployment, "name: orders\n")
+		assert.Contains(t, deployment, "preStop:")
+		assert.Contains(t, deployment, `command: ["sleep", "5"]`)
+		assert.Contains(t, deployment, "terminationGracePeriodSeconds: 45")
+		assert.Contains(t, deployment, "path: /ready")
+		assert.Contains(t, deployment, "name: orders-secrets")
+		assert.Contains(t, files, "deployments/k8s/service.yaml")
+		assert.Contains(t, files, "deployments/k8s/pdb.yaml")
+		assert.Contains(t, string(files[".github/workflows/deploy.yml"].Content), "kubectl apply -f deployments/k8s/")
+	})
+}
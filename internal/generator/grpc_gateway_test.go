@@ -0,0 +1,56 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_GRPCGateway(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(features *types.Features) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:      "shop",
+			Module:    "github.com/test/shop",
+			Type:      "grpc-gateway",
+			Logger:    "slog",
+			Features:  features,
+			Variables: map[string]string{},
+		}
+	}
+
+	t.Run("both transports share one server", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(nil), "grpc-gateway")
+		require.NoError(t, err)
+
+		for _, path := range []string{"internal/server/server.go", "internal/middleware/chain.go", "api/embed.go"} {
+			assert.Contains(t, files, path)
+		}
+		assert.Contains(t, files, "tests/integration/gateway_test.go", "the integration tests run on the in-memory repository")
+
+		main := string(files["cmd/server/main.go"].Content)
+		assert.Contains(t, main, "srv.Shutdown(cfg.Server.ShutdownTimeout)")
+		assert.NotContains(t, main, "AuthMiddleware")
+
+		chain := string(files["internal/middleware/chain.go"].Content)
+		assert.NotContains(t, chain, "UnaryInterceptor()")
+	})
+
+	t.Run("authentication runs as an interceptor", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(&types.Features{
+			Authentication: types.AuthConfig{Type: "jwt"},
+		}), "grpc-gateway")
+		require.NoError(t, err)
+
+		assert.Contains(t, string(files["internal/middleware/chain.go"].Content), "c.auth.UnaryInterceptor()")
+		assert.Contains(t, string(files["internal/middleware/auth.go"].Content), "codes.Unauthenticated")
+		assert.Contains(t, string(files["proto/user/v1/user.proto"].Content), `security_definitions`)
+		assert.Contains(t, string(files["cmd/server/main.go"].Content), "middleware.NewAuthMiddleware(cfg.Auth, appLogger)")
+	})
+}
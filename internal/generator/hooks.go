@@ -0,0 +1,172 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/francknouama/go-starter/internal/outputfs"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// HookCommands are the only commands hooks may run. Hooks are run without a
+// shell, but make, go, npm and curl run whatever the project or the network
+// hands them, so the hooks of a blueprint that is not built in only run once
+// the user allows them, see GenerationOptions.DenyHooks.
+var HookCommands = []string{"buf", "chmod", "curl", "git", "go", "gofmt", "goimports", "make", "npm", "protoc"}
+
+// gitInitHook initializes the git repository of the project unless git is
+// disabled; a blueprint replaces it with a post-generation hook of the same name
+var gitInitHook = types.Hook{
+	Name:        "git_init",
+	Description: "Initialize the git repository",
+	Command:     "git",
+	Args:        []string{"init", "--quiet"},
+}
+
+// CheckHook checks that a hook names an allowed command and a known failure policy
+func CheckHook(hook types.Hook) error {
+	if hook.Name == "" || hook.Command == "" {
+		return types.NewValidationError(fmt.Sprintf("hook %q needs a name and a command", hook.Name), nil)
+	}
+	if command := hookProgram(hook); !slices.Contains(HookCommands, command) {
+		return types.NewValidationError(fmt.Sprintf("hook %s runs %q, hooks may only run %s", hook.Name, command, strings.Join(HookCommands, ", ")), nil)
+	}
+	switch hook.FailurePolicy() {
+	case types.HookWarn, types.HookFail, types.HookIgnore:
+	default:
+		return types.NewValidationError(fmt.Sprintf("invalid on_failure %q of hook %s (warn, fail, ignore)", hook.OnFailure, hook.Name), nil)
+	}
+	return nil
+}
+
+// checkHooks rejects blueprints with a hook CheckHook rejects, before anything is generated
+func checkHooks(tmpl types.Template) error {
+	for _, hook := range append(tmpl.PreGenerationHooks(), tmpl.PostGenerationHooks()...) {
+		if err := CheckHook(hook); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkHookConsent refuses a blueprint declaring hooks when they are denied,
+// naming them so that the user can review them before allowing them
+func checkHookConsent(tmpl types.Template, options types.GenerationOptions) error {
+	hooks := append(tmpl.PreGenerationHooks(), tmpl.PostGenerationHooks()...)
+	if !options.DenyHooks || len(hooks) == 0 {
+		return nil
+	}
+	commands := make([]string, 0, len(hooks))
+	for _, hook := range hooks {
+		commands = append(commands, strings.TrimSpace(hook.Command+" "+strings.Join(hook.Args, " ")))
+	}
+	return types.NewValidationError(fmt.Sprintf("blueprint %s runs hooks (%s), allow them with --allow-hooks", tmpl.ID, strings.Join(commands, "; ")), nil)
+}
+
+// hookProgram returns the program a hook runs, the first word of its command
+// when it has no arguments
+func hookProgram(hook types.Hook) string {
+	if len(hook.Args) > 0 {
+		return hook.Command
+	}
+	if fields := strings.Fields(hook.Command); len(fields) > 0 {
+		return fields[0]
+	}
+	return ""
+}
+
+// postGenerationHooks returns the post-generation hooks of the blueprint, then
+// the git initialization unless disabled, declared by the blueprint or already
+// done by a pre-generation hook
+func (g *Generator) postGenerationHooks(tmpl types.Template, outputPath string) []types.Hook {
+	hooks := tmpl.PostGenerationHooks()
+	if g.noGit || slices.ContainsFunc(hooks, func(hook types.Hook) bool { return hook.Name == gitInitHook.Name }) {
+		return hooks
+	}
+	if outputfs.IsLocal(g.out) && g.hasGitRepository(outputPath) {
+		return hooks
+	}
+	if outputfs.IsLocal(g.out) && !g.isGitAvailable() {
+		fmt.Fprintln(os.Stderr, "Warning: git is not available in PATH, the git repository was not initialized")
+		return hooks
+	}
+	return append(hooks, gitInitHook)
+}
+
+// executePreHooks runs the pre-generation hooks of the blueprint in the empty
+// project directory; the phase is only reported for blueprints declaring some
+func (g *Generator) executePreHooks(ctx context.Context, tmpl types.Template, config types.ProjectConfig, outputPath string, context map[string]any) error {
+	hooks := tmpl.PreGenerationHooks()
+	if len(hooks) == 0 {
+		return nil
+	}
+	g.progress.start(types.PhasePreHooks, len(hooks))
+	if err := g.runHooks(ctx, hooks, tmpl, config, outputPath, context); err != nil {
+		return err
+	}
+	g.progress.end()
+	return checkCancelled(ctx)
+}
+
+// runHooks runs the hooks whose condition holds, reporting them as the steps
+// of the current phase; only cancellation and the failure of a hook with the
+// fail policy are errors
+func (g *Generator) runHooks(ctx context.Context, hooks []types.Hook, tmpl types.Template, config types.ProjectConfig, outputPath string, context map[string]any) error {
+	for i, hook := range hooks {
+		if err := checkCancelled(ctx); err != nil {
+			return err
+		}
+		switch {
+		case !g.hookApplies(hook, context):
+			// The condition of the hook does not hold for this project
+		case !outputfs.IsLocal(g.out):
+			fmt.Fprintf(os.Stderr, "Note: skipped hook '%s' on the remote target\n", hook.Name)
+		default:
+			if err := g.executeHook(ctx, hook, tmpl, config, outputPath); err != nil {
+				return err
+			}
+		}
+		g.progress.step(i+1, hook.Name)
+	}
+	return nil
+}
+
+// hookCommand returns the command of a hook run in workDir, with the glob
+// patterns of its arguments expanded as a shell would
+func hookCommand(ctx context.Context, hook types.Hook, workDir string) *exec.Cmd {
+	args := hook.Args
+	program := hook.Command
+	if len(args) == 0 {
+		fields := strings.Fields(hook.Command)
+		program, args = fields[0], fields[1:]
+	}
+
+	expanded := make([]string, 0, len(args))
+	for _, arg := range args {
+		if strings.ContainsAny(arg, "*?[") {
+			pattern := arg
+			if !filepath.IsAbs(pattern) {
+				pattern = filepath.Join(workDir, arg)
+			}
+			if matches, err := filepath.Glob(pattern); err == nil && len(matches) > 0 {
+				for _, match := range matches {
+					if rel, err := filepath.Rel(workDir, match); err == nil && !filepath.IsAbs(arg) {
+						match = rel
+					}
+					expanded = append(expanded, match)
+				}
+				continue
+			}
+		}
+		expanded = append(expanded, arg)
+	}
+
+	cmd := exec.CommandContext(ctx, program, expanded...)
+	cmd.Dir = workDir
+	return cmd
+}
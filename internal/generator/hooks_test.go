@@ -0,0 +1,200 @@
+package generator
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// setupHookTestTemplates returns a registry of a hooks-test blueprint declaring the hooks
+func setupHookTestTemplates(t *testing.T, hooks string) *templates.Registry {
+	t.Helper()
+
+	return newTestRegistry(t, fstest.MapFS{
+		"hooks-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "hooks-test"
+name: "hooks-test"
+type: "cli"
+architecture: "standard"
+files:
+  - source: "README.md.tmpl"
+    destination: "README.md"
+  - source: "dev.sh.tmpl"
+    destination: "scripts/dev.sh"
+` + hooks)},
+		"hooks-test/README.md.tmpl": &fstest.MapFile{Data: []byte("# {{.ProjectName}}\n")},
+		"hooks-test/dev.sh.tmpl":    &fstest.MapFile{Data: []byte("#!/bin/sh\necho {{.ProjectName}}\n")},
+	})
+}
+
+func generateHookTest(t *testing.T, registry *templates.Registry, noGit bool) (string, error) {
+	t.Helper()
+	outputPath := filepath.Join(t.TempDir(), "hooked")
+	_, err := NewWithRegistry(registry).Generate(types.ProjectConfig{
+		Name:      "hooked",
+		Module:    "github.com/test/hooked",
+		Type:      "cli",
+		Variables: map[string]string{"blueprint_id": "hooks-test"},
+	}, types.GenerationOptions{OutputPath: outputPath, NoGit: noGit})
+	return outputPath, err
+}
+
+func TestCheckHook(t *testing.T) {
+	tests := []struct {
+		name string
+		hook types.Hook
+		want string
+	}{
+		{"allowed command", types.Hook{Name: "tidy", Command: "go mod tidy"}, ""},
+		{"allowed program with arguments", types.Hook{Name: "proto", Command: "buf", Args: []string{"generate"}, OnFailure: types.HookFail}, ""},
+		{"missing command", types.Hook{Name: "empty"}, `hook "empty" needs a name and a command`},
+		{"shell", types.Hook{Name: "shell", Command: "sh -c 'rm -rf /'"}, `hook shell runs "sh", hooks may only run buf, chmod`},
+		{"path to an allowed command", types.Hook{Name: "path", Command: "/tmp/go version"}, `hook path runs "/tmp/go"`},
+		{"unknown failure policy", types.Hook{Name: "retry", Command: "make", OnFailure: "retry"}, `invalid on_failure "retry" of hook retry`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckHook(tt.hook)
+			if tt.want == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestTemplate_PostGenerationHooks(t *testing.T) {
+	tmpl := types.Template{
+		PostHooks: []types.Hook{{Name: "tidy"}, {Name: "format", Order: 20}},
+		Hooks: types.TemplateHooks{PostGeneration: []types.Hook{
+			{Name: "generate", Order: -10},
+			{Name: "lint"},
+		}},
+	}
+	var names []string
+	for _, hook := range tmpl.PostGenerationHooks() {
+		names = append(names, hook.Name)
+	}
+	assert.Equal(t, []string{"generate", "tidy", "lint", "format"}, names)
+}
+
+func TestGenerate_Hooks(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	t.Run("hooks run allowlisted commands with their globs expanded", func(t *testing.T) {
+		registry := setupHookTestTemplates(t, `
+hooks:
+  pre_generation:
+    - name: "init_repository"
+      command: "git init --quiet"
+  post_generation:
+    - name: "make_scripts_executable"
+      command: "chmod +x scripts/*.sh"
+`)
+		outputPath, err := generateHookTest(t, registry, true)
+		require.NoError(t, err)
+
+		info, err := os.Stat(filepath.Join(outputPath, "scripts", "dev.sh"))
+		require.NoError(t, err)
+		assert.NotZero(t, info.Mode()&0100, "the glob of the hook was not expanded")
+		assert.DirExists(t, filepath.Join(outputPath, ".git"), "pre-generation hooks run even with --no-git")
+	})
+
+	t.Run("git_init runs unless disabled and writes a .gitignore", func(t *testing.T) {
+		registry := setupHookTestTemplates(t, "")
+		outputPath, err := generateHookTest(t, registry, false)
+		require.NoError(t, err)
+		assert.DirExists(t, filepath.Join(outputPath, ".git"))
+		assert.FileExists(t, filepath.Join(outputPath, ".gitignore"))
+
+		outputPath, err = generateHookTest(t, registry, true)
+		require.NoError(t, err)
+		assert.NoDirExists(t, filepath.Join(outputPath, ".git"))
+		assert.NoFileExists(t, filepath.Join(outputPath, ".gitignore"))
+	})
+
+	t.Run("a hook named git_init replaces the built-in one", func(t *testing.T) {
+		registry := setupHookTestTemplates(t, `
+hooks:
+  post_generation:
+    - name: "git_init"
+      command: "git init --quiet"
+      condition: "{{eq .ProjectName \"other\"}}"
+`)
+		outputPath, err := generateHookTest(t, registry, false)
+		require.NoError(t, err)
+		assert.NoDirExists(t, filepath.Join(outputPath, ".git"))
+	})
+
+	t.Run("a failing hook with the fail policy rolls the project back", func(t *testing.T) {
+		registry := setupHookTestTemplates(t, `
+hooks:
+  post_generation:
+    - name: "missing_target"
+      command: "make missing-target"
+      on_failure: "fail"
+`)
+		outputPath, err := generateHookTest(t, registry, true)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "hook 'missing_target' failed")
+		assert.NoDirExists(t, outputPath)
+	})
+
+	t.Run("failing hooks with the warn and ignore policies do not", func(t *testing.T) {
+		registry := setupHookTestTemplates(t, `
+hooks:
+  post_generation:
+    - name: "warned"
+      command: "make missing-target"
+    - name: "ignored"
+      command: "make missing-target"
+      on_failure: "ignore"
+`)
+		outputPath, err := generateHookTest(t, registry, true)
+		require.NoError(t, err)
+		assert.FileExists(t, filepath.Join(outputPath, "README.md"))
+	})
+
+	t.Run("commands outside the allowlist are refused before generating", func(t *testing.T) {
+		registry := setupHookTestTemplates(t, `
+post_hooks:
+  - name: "cleanup"
+    command: "rm -rf ."
+`)
+		outputPath, err := generateHookTest(t, registry, true)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `hook cleanup runs "rm"`)
+		assert.NoDirExists(t, outputPath)
+	})
+
+	t.Run("denied hooks are refused before generating", func(t *testing.T) {
+		registry := setupHookTestTemplates(t, `
+hooks:
+  post_generation:
+    - name: "tidy"
+      command: "go mod tidy"
+`)
+		outputPath := filepath.Join(t.TempDir(), "hooked")
+		_, err := NewWithRegistry(registry).Generate(types.ProjectConfig{
+			Name:      "hooked",
+			Module:    "github.com/test/hooked",
+			Type:      "cli",
+			Variables: map[string]string{"blueprint_id": "hooks-test"},
+		}, types.GenerationOptions{OutputPath: outputPath, NoGit: true, DenyHooks: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "blueprint hooks-test runs hooks (go mod tidy), allow them with --allow-hooks")
+		assert.NoDirExists(t, outputPath)
+	})
+}
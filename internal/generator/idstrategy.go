@@ -0,0 +1,25 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// IDStrategyVariable is the blueprint variable that selects the primary keys of
+// the generated models: serial integers, UUIDv7, ULID or snowflakes. Blueprints
+// whose models, migrations and handlers follow it offer the choice by declaring it.
+const IDStrategyVariable = "IDStrategy"
+
+// checkIDStrategy rejects an ID strategy for blueprints that do not offer one
+func checkIDStrategy(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[IDStrategyVariable] == "" {
+		return nil
+	}
+	for _, variable := range tmpl.Variables {
+		if variable.Name == IDStrategyVariable {
+			return nil
+		}
+	}
+	return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer a choice of ID strategy, remove --id-strategy", tmpl.ID), nil)
+}
@@ -0,0 +1,101 @@
+package generator
+
+import (
+	"context"
+	"go/format"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_IDStrategy(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(architecture, strategy, orm string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:         "orders",
+			Module:       "github.com/test/orders",
+			Type:         "web-api",
+			Architecture: architecture,
+			Framework:    "gin",
+			Logger:       "slog",
+			Variables:    map[string]string{IDStrategyVariable: strategy, AdminEndpointsVariable: "true"},
+			Features: &types.Features{
+				Database:       types.DatabaseConfig{Driver: "postgres", ORM: orm},
+				Authentication: types.AuthConfig{Type: "jwt"},
+			},
+		}
+	}
+
+	tests := []struct {
+		strategy string
+		idType   string
+		column   string
+		schema   string
+		module   string
+	}{
+		{"serial", "type ID = uint", "id SERIAL PRIMARY KEY", "type: integer", ""},
+		{"uuidv7", "type ID = string", "id UUID PRIMARY KEY", "format: uuid", "github.com/google/uuid"},
+		{"ulid", "type ID = string", "id CHAR(26) PRIMARY KEY", "pattern: \"^[0-9A-HJKMNP-TV-Z]{26}$\"", "github.com/oklog/ulid/v2"},
+		{"snowflake", "type ID = int64", "id BIGINT PRIMARY KEY", "pattern: \"^[0-9]+$\"", ""},
+	}
+
+	for _, tt := range tests {
+		for _, orm := range []string{"gorm", ""} {
+			t.Run(tt.strategy+" with orm "+orm, func(t *testing.T) {
+				files, err := New().GenerateInMemoryFiles(ctx, config("standard", tt.strategy, orm), "web-api")
+				require.NoError(t, err)
+
+				for path, file := range files {
+					if strings.HasSuffix(path, ".go") {
+						_, err := format.Source(file.Content)
+						assert.NoError(t, err, path)
+					}
+				}
+
+				ids := string(files["internal/models/id.go"].Content)
+				assert.Contains(t, ids, tt.idType)
+				assert.Contains(t, ids, "func ParseID(s string) (ID, error)")
+				assert.Contains(t, string(files["migrations/001_create_users.up.sql"].Content), tt.column)
+				assert.Contains(t, string(files["api/openapi.yaml"].Content), tt.schema)
+
+				// Handlers parse the path parameters, and services take the keys, as models.ID
+				admin := string(files["internal/handlers/admin.go"].Content)
+				assert.Contains(t, admin, "models.ParseID(")
+				assert.NotContains(t, admin, "uint")
+				assert.Contains(t, string(files["internal/services/user.go"].Content), "GetUserByID(ctx context.Context, id models.ID)")
+				assert.Contains(t, string(files["internal/services/auth.go"].Content), "UserID models.ID")
+
+				goMod := string(files["go.mod"].Content)
+				for _, module := range []string{"github.com/google/uuid", "github.com/oklog/ulid/v2"} {
+					if module == tt.module {
+						assert.Contains(t, goMod, module)
+					} else {
+						assert.NotContains(t, goMod, module)
+					}
+				}
+
+				user := string(files["internal/models/user.go"].Content)
+				if tt.strategy != "serial" && orm == "gorm" {
+					assert.Contains(t, user, "func (u *User) BeforeCreate(")
+				} else {
+					assert.NotContains(t, user, "BeforeCreate")
+				}
+				if tt.strategy == "snowflake" {
+					assert.Contains(t, user, `json:"id,string"`)
+				}
+			})
+		}
+	}
+
+	t.Run("blueprints with their own keys reject the flag", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("clean", "uuidv7", "gorm"), "web-api-clean")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer a choice of ID strategy")
+	})
+}
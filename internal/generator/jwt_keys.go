@@ -0,0 +1,35 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// JWTAlgorithmVariable is the blueprint variable that selects the algorithm of the key
+// pairs signing JWT tokens. Blueprints offer the choice by declaring it.
+const JWTAlgorithmVariable = "JWTAlgorithm"
+
+// checkJWTAlgorithm rejects a JWT algorithm for blueprints that do not offer one,
+// and for projects without authentication
+func checkJWTAlgorithm(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[JWTAlgorithmVariable] == "" {
+		return nil
+	}
+
+	declared := false
+	for _, variable := range tmpl.Variables {
+		if variable.Name == JWTAlgorithmVariable {
+			declared = true
+			break
+		}
+	}
+	if !declared {
+		return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer a JWT signing algorithm, remove --jwt-algorithm", tmpl.ID), nil)
+	}
+
+	if config.Features == nil || config.Features.Authentication.Type == "" || config.Features.Authentication.Type == "none" {
+		return types.NewValidationError("JWT tokens are issued by authentication, set --auth-type", nil)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_JWTAlgorithm(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(algorithm, auth string) *types.ProjectConfig {
+		variables := map[string]string{}
+		if algorithm != "" {
+			variables[JWTAlgorithmVariable] = algorithm
+		}
+		return &types.ProjectConfig{
+			Name:         "inventory",
+			Module:       "github.com/test/inventory",
+			Type:         "web-api",
+			Architecture: "standard",
+			Framework:    "gin",
+			Logger:       "slog",
+			Variables:    variables,
+			Features: &types.Features{
+				Database:       types.DatabaseConfig{Driver: "postgres", ORM: "gorm"},
+				Authentication: types.AuthConfig{Type: auth},
+			},
+		}
+	}
+
+	t.Run("tokens are signed with RS256 keys published on the JWKS endpoint", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("", "jwt"), "web-api")
+		require.NoError(t, err)
+		require.Contains(t, files, "internal/jwks/keyset.go")
+		require.Contains(t, files, "internal/jwks/remote.go")
+		require.Contains(t, files, "internal/handlers/jwks.go")
+		assert.Contains(t, files, "tests/unit/jwks_test.go")
+
+		main := string(files["cmd/server/main.go"].Content)
+		assert.Contains(t, main, `router.GET("/.well-known/jwks.json", handlers.NewJWKSHandler(signingKeys).JWKS)`)
+		assert.Contains(t, main, "jwks.LoadDir(cfg.JWT.KeysDir, cfg.JWT.ActiveKID)")
+		assert.Contains(t, string(files["internal/config/config.go"].Content), `v.SetDefault("jwt.algorithm", "RS256")`)
+		assert.NotContains(t, string(files["internal/services/auth.go"].Content), "SigningMethodHS256")
+		assert.Contains(t, string(files["Makefile"].Content), "rsa_keygen_bits:2048")
+	})
+
+	t.Run("EdDSA keys", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("EdDSA", "jwt"), "web-api")
+		require.NoError(t, err)
+		assert.Contains(t, string(files["internal/config/config.go"].Content), `v.SetDefault("jwt.algorithm", "EdDSA")`)
+		assert.Contains(t, string(files["Makefile"].Content), "-algorithm ed25519")
+	})
+
+	t.Run("the algorithm needs authentication", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("EdDSA", ""), "web-api")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "set --auth-type")
+	})
+
+	t.Run("blueprints without signing keys reject the flag", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("EdDSA", "jwt"), "grpc-service")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer a JWT signing algorithm")
+	})
+}
@@ -0,0 +1,195 @@
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sort"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// LockFile is written into every generated project next to the manifest. Unlike
+// the manifest it holds nothing that changes from one generation to the next, so
+// two generations from the same lock with the same go-starter give the same lock
+// and the same project.
+const LockFile = ".go-starter.lock"
+
+// ToolVersion is the version of go-starter recorded in lock files. The command
+// sets it to its build version.
+var ToolVersion = "dev"
+
+// Lock pins what a project was generated from: the blueprint version, the values
+// of its variables, the checksums of its templates and the tools that rendered them
+type Lock struct {
+	Blueprint        string `json:"blueprint"`
+	BlueprintVersion string `json:"blueprint_version"`
+	// Config is the configuration the project was generated with, and regenerates with
+	Config types.ProjectConfig `json:"config"`
+	// Variables holds the value of every variable of the blueprint, those left to
+	// their default included
+	Variables map[string]string `json:"variables"`
+	// Templates holds the checksum of each file of the blueprint by source path,
+	// its template.yaml and the partials its files can include
+	Templates map[string]string `json:"templates"`
+	Tools     LockTools         `json:"tools"`
+}
+
+// LockTools are the versions of the tools a project was generated with
+type LockTools struct {
+	GoStarter string `json:"go_starter"`
+	Go        string `json:"go"`
+}
+
+// ReadLock loads a lock file, or the lock file of the project when path is a
+// directory
+func ReadLock(path string) (*Lock, error) {
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		path = filepath.Join(path, LockFile)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, types.NewFileSystemError("failed to read generation lock", err)
+	}
+
+	var lock Lock
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return nil, types.NewFileSystemError("failed to parse generation lock", err)
+	}
+	if lock.Blueprint == "" {
+		return nil, types.NewValidationError(fmt.Sprintf("%s names no blueprint", path), nil)
+	}
+	return &lock, nil
+}
+
+// newLock builds the lock of a generation from tmpl, in templateDir of the loader
+// of the generator, with config and its template context
+func (g *Generator) newLock(tmpl types.Template, templateDir string, config types.ProjectConfig, context map[string]any) (Lock, error) {
+	sums, err := templateChecksums(g.loader, templateDir, tmpl)
+	if err != nil {
+		return Lock{}, err
+	}
+
+	variables := make(map[string]string, len(tmpl.Variables))
+	for _, variable := range tmpl.Variables {
+		if value, ok := context[variable.Name]; ok && value != nil {
+			variables[variable.Name] = fmt.Sprint(value)
+		}
+	}
+
+	return Lock{
+		Blueprint:        tmpl.ID,
+		BlueprintVersion: tmpl.Version,
+		Config:           config,
+		Variables:        variables,
+		Templates:        sums,
+		Tools:            LockTools{GoStarter: ToolVersion, Go: runtime.Version()},
+	}, nil
+}
+
+// templateChecksums returns the checksum of the template.yaml of a blueprint, of
+// each of its files and of each partial its files can include
+func templateChecksums(loader *templates.TemplateLoader, templateDir string, tmpl types.Template) (map[string]string, error) {
+	sums := make(map[string]string, len(tmpl.Files)+1)
+	sum := func(content []byte) string {
+		hash := sha256.Sum256(content)
+		return hex.EncodeToString(hash[:])
+	}
+
+	// Blueprints registered in code have no template.yaml
+	if content, err := loader.LoadFile(templateDir, "template.yaml"); err == nil {
+		sums["template.yaml"] = sum(content)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	for _, file := range tmpl.Files {
+		if file.IsSymlink() || file.Source == "" {
+			continue
+		}
+		if _, ok := sums[file.Source]; ok {
+			continue
+		}
+		content, err := loader.LoadFile(templateDir, file.Source)
+		if err != nil {
+			return nil, err
+		}
+		sums[file.Source] = sum(content)
+	}
+
+	partials, err := loader.LoadPartials(templateDir)
+	if err != nil {
+		return nil, err
+	}
+	for name, content := range partials {
+		sums[name+".tmpl"] = sum([]byte(content))
+	}
+	return sums, nil
+}
+
+// encode returns the lock as indented JSON, its maps in key order
+func (l Lock) encode() ([]byte, error) {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return nil, types.NewGenerationError("failed to encode generation lock", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// LockDrift lists how generating with the configuration of lock would differ from
+// the generation it records: another go-starter or blueprint version, templates
+// changed, added or removed since. An empty list means the generation is
+// reproduced as it was.
+func (g *Generator) LockDrift(lock *Lock) ([]string, error) {
+	tmpl, loader, err := g.registry.Lookup(lock.Blueprint)
+	if err != nil {
+		return nil, fmt.Errorf("blueprint %s of the lock is not available in this version of go-starter: %w", lock.Blueprint, err)
+	}
+	templateDir := lock.Blueprint
+	if path, ok := tmpl.Metadata["path"].(string); ok {
+		templateDir = path
+	}
+	current, err := templateChecksums(loader, templateDir, tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	var drift []string
+	if lock.Tools.GoStarter != ToolVersion {
+		drift = append(drift, fmt.Sprintf("go-starter %s instead of %s", ToolVersion, lock.Tools.GoStarter))
+	}
+	if lock.BlueprintVersion != tmpl.Version {
+		drift = append(drift, fmt.Sprintf("blueprint %s %s instead of %s", tmpl.ID, tmpl.Version, lock.BlueprintVersion))
+	}
+
+	var changes []string
+	for source, sum := range lock.Templates {
+		switch recorded, ok := current[source]; {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("template %s removed", source))
+		case recorded != sum:
+			changes = append(changes, fmt.Sprintf("template %s changed", source))
+		}
+	}
+	for source := range current {
+		if _, ok := lock.Templates[source]; !ok {
+			changes = append(changes, fmt.Sprintf("template %s added", source))
+		}
+	}
+	sort.Strings(changes)
+	return slices.Concat(drift, changes), nil
+}
+
+// isMetadataFile reports whether a generated file records the generation rather
+// than belonging to the project
+func isMetadataFile(path string) bool {
+	return path == ManifestFile || path == LockFile
+}
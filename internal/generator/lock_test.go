@@ -0,0 +1,102 @@
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func lockTestConfig() types.ProjectConfig {
+	return types.ProjectConfig{Name: "demo", Module: "example.com/demo", Type: "cli", Variables: map[string]string{"blueprint_id": "cache-test"}}
+}
+
+func TestGenerateInMemoryFiles_IncludesLock(t *testing.T) {
+	registry, err := templates.NewRegistryWithFS(cacheTestFS("hello"))
+	require.NoError(t, err)
+
+	render := func() []byte {
+		config := lockTestConfig()
+		files, err := NewWithRegistry(registry).GenerateInMemoryFiles(context.Background(), &config, "cache-test")
+		require.NoError(t, err)
+		require.Contains(t, files, LockFile)
+		return files[LockFile].Content
+	}
+	data := render()
+	assert.Equal(t, string(data), string(render()), "the lock of a generation is the same every time")
+
+	var lock Lock
+	require.NoError(t, json.Unmarshal(data, &lock))
+	assert.Equal(t, "cache-test", lock.Blueprint)
+	assert.Equal(t, "1.0.0", lock.BlueprintVersion)
+	assert.Equal(t, "demo", lock.Config.Name)
+	assert.ElementsMatch(t, []string{"template.yaml", "main.go.tmpl", "partials/greeting.tmpl"}, keys(lock.Templates))
+	assert.Equal(t, LockTools{GoStarter: ToolVersion, Go: runtime.Version()}, lock.Tools)
+}
+
+func TestGenerate_WritesLock(t *testing.T) {
+	registry := setupDeprecatedTestTemplates(t)
+	outputPath := filepath.Join(t.TempDir(), "legacy")
+
+	_, err := NewWithRegistry(registry).Generate(legacyTestConfig("slog"), types.GenerationOptions{OutputPath: outputPath, NoGit: true})
+	require.NoError(t, err)
+
+	lock, err := ReadLock(outputPath)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy-test", lock.Blueprint)
+	assert.Equal(t, "slog", lock.Variables["Logger"])
+	assert.Contains(t, lock.Templates, "README.md.tmpl")
+
+	fromFile, err := ReadLock(filepath.Join(outputPath, LockFile))
+	require.NoError(t, err)
+	assert.Equal(t, lock, fromFile)
+
+	manifest, err := ReadManifest(outputPath)
+	require.NoError(t, err)
+	assert.NotContains(t, manifest.Files, LockFile, "the lock is not a file of the project")
+}
+
+func TestLockDrift(t *testing.T) {
+	fsys := cacheTestFS("hello")
+	registry, err := templates.NewRegistryWithFS(fsys)
+	require.NoError(t, err)
+	config := lockTestConfig()
+	files, err := NewWithRegistry(registry).GenerateInMemoryFiles(context.Background(), &config, "cache-test")
+	require.NoError(t, err)
+
+	var lock Lock
+	require.NoError(t, json.Unmarshal(files[LockFile].Content, &lock))
+
+	drift, err := NewWithRegistry(registry).LockDrift(&lock)
+	require.NoError(t, err)
+	assert.Empty(t, drift, "a fresh lock is reproduced")
+
+	fsys["cache-test/partials/greeting.tmpl"] = cacheTestFS("goodbye")["cache-test/partials/greeting.tmpl"]
+	fsys["cache-test/partials/farewell.tmpl"] = &fstest.MapFile{Data: []byte("bye\n")}
+	_, err = registry.Reload()
+	require.NoError(t, err)
+	older := lock
+	older.BlueprintVersion = "0.9.0"
+	older.Tools.GoStarter = "0.1.0"
+
+	drift, err = NewWithRegistry(registry).LockDrift(&older)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"go-starter " + ToolVersion + " instead of 0.1.0",
+		"blueprint cache-test 1.0.0 instead of 0.9.0",
+		"template partials/farewell.tmpl added",
+		"template partials/greeting.tmpl changed",
+	}, drift)
+
+	older.Blueprint = "missing"
+	_, err = NewWithRegistry(registry).LockDrift(&older)
+	assert.Error(t, err)
+}
@@ -0,0 +1,39 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// LockoutStoreVariable is the blueprint variable that selects where failed logins
+// are tracked for account lockout. Blueprints offer the choice by declaring it.
+const LockoutStoreVariable = "LockoutStore"
+
+// checkLockoutStore rejects a lockout store for blueprints that do not offer one,
+// and a database store for projects without a database
+func checkLockoutStore(tmpl types.Template, config types.ProjectConfig) error {
+	store := config.Variables[LockoutStoreVariable]
+	if store == "" {
+		return nil
+	}
+
+	declared := false
+	for _, variable := range tmpl.Variables {
+		if variable.Name == LockoutStoreVariable {
+			declared = true
+			break
+		}
+	}
+	if !declared {
+		return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer account lockout, remove --lockout-store", tmpl.ID), nil)
+	}
+
+	if config.Features == nil || config.Features.Authentication.Type == "" || config.Features.Authentication.Type == "none" {
+		return types.NewValidationError("account lockout protects logins and needs authentication, set --auth-type", nil)
+	}
+	if store == "database" && !config.Features.Database.HasDatabase() {
+		return types.NewValidationError("the database lockout store needs a database, set --database-driver", nil)
+	}
+	return nil
+}
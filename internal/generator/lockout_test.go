@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_LockoutStore(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(store, driver, auth string) *types.ProjectConfig {
+		variables := map[string]string{}
+		if store != "" {
+			variables[LockoutStoreVariable] = store
+		}
+		return &types.ProjectConfig{
+			Name:         "inventory",
+			Module:       "github.com/test/inventory",
+			Type:         "web-api",
+			Architecture: "hexagonal",
+			Framework:    "gin",
+			Logger:       "slog",
+			Variables:    variables,
+			Features: &types.Features{
+				Database:       types.DatabaseConfig{Driver: driver, ORM: "gorm"},
+				Authentication: types.AuthConfig{Type: auth},
+			},
+		}
+	}
+
+	t.Run("failed logins are tracked in memory by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("", "postgres", "jwt"), "web-api-hexagonal")
+		require.NoError(t, err)
+		require.Contains(t, files, "internal/adapters/secondary/lockout/memory_store.go")
+		assert.NotContains(t, files, "internal/adapters/secondary/lockout/redis_store.go")
+		assert.NotContains(t, files, "migrations/003_create_login_attempts.up.sql")
+		assert.Contains(t, string(files["internal/infrastructure/container/container.go"].Content), "c.loginAttempts = lockout.NewMemoryStore(c.clock)")
+	})
+
+	t.Run("redis store adds the client and its dependency", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("redis", "postgres", "jwt"), "web-api-hexagonal")
+		require.NoError(t, err)
+		require.Contains(t, files, "internal/adapters/secondary/lockout/redis_store.go")
+		assert.NotContains(t, files, "internal/adapters/secondary/lockout/memory_store.go")
+		assert.Contains(t, string(files["go.mod"].Content), "github.com/redis/go-redis/v9")
+		assert.Contains(t, string(files["internal/infrastructure/config/config.go"].Content), `mapstructure:"redis_url"`)
+	})
+
+	t.Run("database store adds the table", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("database", "postgres", "jwt"), "web-api-hexagonal")
+		require.NoError(t, err)
+		require.Contains(t, files, "internal/adapters/secondary/lockout/database_store.go")
+		require.Contains(t, files, "migrations/003_create_login_attempts.up.sql")
+		assert.Contains(t, string(files["internal/adapters/secondary/persistence/database.go"].Content), "&LoginAttemptModel{},")
+	})
+
+	t.Run("lockout needs authentication and the database store a database", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("memory", "postgres", ""), "web-api-hexagonal")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "needs authentication")
+
+		_, err = New().GenerateInMemoryFiles(ctx, config("database", "", "jwt"), "web-api-hexagonal")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "needs a database")
+	})
+
+	t.Run("blueprints without lockout reject the flag", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("redis", "postgres", "jwt"), "grpc-service")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer account lockout")
+	})
+}
@@ -0,0 +1,103 @@
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// ManifestFile is written into every generated project and records how it was generated
+const ManifestFile = ".go-starter-manifest.json"
+
+// Manifest describes the blueprint and configuration a project was generated from
+type Manifest struct {
+	Blueprint        string              `json:"blueprint"`
+	BlueprintVersion string              `json:"blueprint_version,omitempty"`
+	Config           types.ProjectConfig `json:"config"`
+	GeneratedAt      time.Time           `json:"generated_at"`
+	// Deprecations lists the deprecated blueprint and choices in use at generation time
+	Deprecations []types.DeprecationNotice `json:"deprecations,omitempty"`
+	// Experiments lists the experimental features the project was generated with
+	Experiments []string `json:"experiments,omitempty"`
+	// Files holds the checksum of each generated file by path, so that upgrades can
+	// tell the files still as generated from those edited since
+	Files map[string]string `json:"files,omitempty"`
+	// UpgradedAt is set when the project was last upgraded to a newer blueprint
+	UpgradedAt *time.Time `json:"upgraded_at,omitempty"`
+}
+
+// ReadManifest loads the manifest of the project generated at projectPath
+func ReadManifest(projectPath string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(projectPath, ManifestFile))
+	if err != nil {
+		return nil, types.NewFileSystemError("failed to read generation manifest", err)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, types.NewFileSystemError("failed to parse generation manifest", err)
+	}
+	return &manifest, nil
+}
+
+// newManifest builds the manifest of a generation from tmpl with config
+func newManifest(tmpl types.Template, config types.ProjectConfig, deprecations []types.DeprecationNotice, experiments []string, files map[string]string) Manifest {
+	return Manifest{
+		Blueprint:        tmpl.ID,
+		BlueprintVersion: tmpl.Version,
+		Config:           config,
+		GeneratedAt:      time.Now().UTC(),
+		Deprecations:     deprecations,
+		Experiments:      experiments,
+		Files:            files,
+	}
+}
+
+// checksum identifies the content of a generated file, or the target of a symlink
+func checksum(file GeneratedFile) string {
+	content := file.Content
+	if file.Symlink != "" {
+		content = []byte(file.Symlink)
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// checksums returns the checksum of each generated file but the manifest and lock
+func checksums(files map[string]GeneratedFile) map[string]string {
+	sums := make(map[string]string, len(files))
+	for path, file := range files {
+		if !isMetadataFile(path) {
+			sums[filepath.ToSlash(path)] = checksum(file)
+		}
+	}
+	return sums
+}
+
+// encode returns the manifest as indented JSON
+func (m Manifest) encode() ([]byte, error) {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, types.NewGenerationError("failed to encode generation manifest", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// Deprecations lists the deprecated blueprint and choices a generation with config
+// would use, so callers can warn before generating. An empty blueprintID selects
+// the blueprint from config like Generate does.
+func (g *Generator) Deprecations(config types.ProjectConfig, blueprintID string) ([]types.DeprecationNotice, error) {
+	if blueprintID == "" {
+		blueprintID = g.getTemplateID(config)
+	}
+	tmpl, err := g.registry.Get(blueprintID)
+	if err != nil {
+		return nil, err
+	}
+	return tmpl.Deprecations(g.createTemplateContext(config, tmpl)), nil
+}
@@ -0,0 +1,105 @@
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func setupDeprecatedTestTemplates(t *testing.T) *templates.Registry {
+	t.Helper()
+
+	return newTestRegistry(t, fstest.MapFS{
+		"legacy-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "legacy-test"
+name: "legacy-test"
+type: "cli"
+version: "1.2.0"
+deprecated:
+  message: "superseded by the cobra based CLI"
+  replacement: "cli-standard"
+  since: "2.0.0"
+  sunset: "2027-01-01"
+variables:
+  - name: "Logger"
+    choices: ["slog", "logrus"]
+    deprecated_choices:
+      logrus:
+        replacement: "slog"
+files:
+  - source: "README.md.tmpl"
+    destination: "README.md"
+`)},
+		"legacy-test/README.md.tmpl": &fstest.MapFile{Data: []byte("# {{.ProjectName}}\n")},
+	})
+}
+
+func legacyTestConfig(logger string) types.ProjectConfig {
+	return types.ProjectConfig{
+		Name:      "legacy",
+		Module:    "github.com/test/legacy",
+		Type:      "cli",
+		Logger:    logger,
+		Variables: map[string]string{"blueprint_id": "legacy-test"},
+	}
+}
+
+func TestGenerate_WritesManifest(t *testing.T) {
+	registry := setupDeprecatedTestTemplates(t)
+	outputPath := filepath.Join(t.TempDir(), "legacy")
+
+	result, err := NewWithRegistry(registry).Generate(legacyTestConfig("logrus"), types.GenerationOptions{OutputPath: outputPath, NoGit: true})
+	require.NoError(t, err)
+	require.Len(t, result.Deprecations, 2)
+
+	manifest, err := ReadManifest(outputPath)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy-test", manifest.Blueprint)
+	assert.Equal(t, "1.2.0", manifest.BlueprintVersion)
+	assert.Equal(t, "legacy", manifest.Config.Name)
+	assert.False(t, manifest.GeneratedAt.IsZero())
+	require.Len(t, manifest.Deprecations, 2)
+	assert.Equal(t, "cli-standard", manifest.Deprecations[0].Replacement)
+	assert.Equal(t, "Logger", manifest.Deprecations[1].Variable)
+	assert.Equal(t, "logrus", manifest.Deprecations[1].Value)
+}
+
+func TestGenerateInMemoryFiles_IncludesManifest(t *testing.T) {
+	registry := setupDeprecatedTestTemplates(t)
+	config := legacyTestConfig("slog")
+
+	files, err := NewWithRegistry(registry).GenerateInMemoryFiles(context.Background(), &config, "legacy-test")
+	require.NoError(t, err)
+	require.Contains(t, files, ManifestFile)
+
+	var manifest Manifest
+	require.NoError(t, json.Unmarshal(files[ManifestFile].Content, &manifest))
+	assert.Equal(t, "legacy-test", manifest.Blueprint)
+	require.Len(t, manifest.Deprecations, 1, "slog is not deprecated")
+}
+
+func TestGenerator_Deprecations(t *testing.T) {
+	registry := setupDeprecatedTestTemplates(t)
+
+	notices, err := NewWithRegistry(registry).Deprecations(legacyTestConfig("logrus"), "")
+	require.NoError(t, err)
+	require.Len(t, notices, 2)
+	assert.Equal(t, "2027-01-01", notices[0].Sunset)
+	assert.Equal(t, "slog", notices[1].Replacement)
+
+	_, err = NewWithRegistry(registry).Deprecations(legacyTestConfig("slog"), "missing")
+	assert.Error(t, err)
+}
+
+func TestReadManifest_Missing(t *testing.T) {
+	_, err := ReadManifest(t.TempDir())
+	assert.Error(t, err)
+}
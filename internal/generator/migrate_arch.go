@@ -0,0 +1,594 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/modfile"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// ArchMigrationReportFile is written into a project migrated to another
+// architecture and lists what the migration leaves to do by hand
+const ArchMigrationReportFile = "ARCHITECTURE_MIGRATION.md"
+
+// The layers of the web-api architectures, whose packages move between layouts
+const (
+	layerHandlers     = "handlers"
+	layerMiddleware   = "middleware"
+	layerServices     = "services"
+	layerRepositories = "repositories"
+	layerDatabase     = "database"
+	layerModels       = "models"
+	layerConfig       = "config"
+	layerLogger       = "logger"
+)
+
+// archLayers are the layers in the order migrations list them
+var archLayers = []string{layerHandlers, layerMiddleware, layerServices, layerRepositories, layerDatabase, layerModels, layerConfig, layerLogger}
+
+// archLayouts are the directories of the layers of the web-api architectures that
+// organize the code by layer. DDD and vertical slices organize it by feature
+// instead, moving directories does not turn one into the other.
+var archLayouts = map[string]map[string]string{
+	"standard": {
+		layerHandlers:     "internal/handlers",
+		layerMiddleware:   "internal/middleware",
+		layerServices:     "internal/services",
+		layerRepositories: "internal/repository",
+		layerDatabase:     "internal/database",
+		layerModels:       "internal/models",
+		layerConfig:       "internal/config",
+		layerLogger:       "internal/logger",
+	},
+	"clean": {
+		layerHandlers:     "internal/adapters/controllers",
+		layerMiddleware:   "internal/infrastructure/web/middleware",
+		layerServices:     "internal/domain/usecases",
+		layerRepositories: "internal/infrastructure/persistence",
+		layerDatabase:     "internal/infrastructure/database",
+		layerModels:       "internal/domain/entities",
+		layerConfig:       "internal/infrastructure/config",
+		layerLogger:       "internal/infrastructure/logger",
+	},
+	"hexagonal": {
+		layerHandlers:     "internal/adapters/primary/http",
+		layerMiddleware:   "internal/adapters/primary/http/middleware",
+		layerServices:     "internal/application/services",
+		layerRepositories: "internal/adapters/secondary/persistence",
+		layerDatabase:     "internal/adapters/secondary/database",
+		layerModels:       "internal/domain/entities",
+		layerConfig:       "internal/infrastructure/config",
+		layerLogger:       "internal/adapters/secondary/logger",
+	},
+}
+
+// archBlueprints are the blueprints generating the architectures of archLayouts
+var archBlueprints = map[string]string{
+	"standard":  "web-api",
+	"clean":     "web-api-clean",
+	"hexagonal": "web-api-hexagonal",
+}
+
+// archPorts are the directories of the interfaces the core of an architecture
+// declares for the adapters it uses
+var archPorts = map[string]string{
+	"clean":     "internal/domain/ports",
+	"hexagonal": "internal/application/ports/output",
+}
+
+// ArchMigrations lists the architectures migrate-arch moves projects between
+func ArchMigrations() []string {
+	return slices.Sorted(maps.Keys(archLayouts))
+}
+
+// ArchMigrationPlan lists the changes migrating a project from one architecture
+// to another makes
+type ArchMigrationPlan struct {
+	Project string `json:"project"`
+	Module  string `json:"module"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+	// Moves lists the packages moved, by directory
+	Moves []PackageMove `json:"moves"`
+	// Rewritten lists the Go files whose package clause or imports change, by their
+	// path after the migration
+	Rewritten []string `json:"rewritten"`
+	// TODOs lists what the migration leaves to do by hand
+	TODOs []MigrationTODO `json:"todos"`
+
+	// files holds the content of the moved and rewritten files by their new path,
+	// removed the paths of the moved files before the migration
+	files    map[string]GeneratedFile
+	removed  []string
+	manifest *Manifest
+}
+
+// PackageMove is a package directory moved by a migration
+type PackageMove struct {
+	Layer string `json:"layer"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Files int    `json:"files"`
+}
+
+// MigrationTODO is a change a migration leaves to do by hand
+type MigrationTODO struct {
+	File    string `json:"file"`
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+// oldPathRef matches a directory of the previous layout in the text of a file
+const oldPathRef = `(^|[^\w.-])%s($|[^\w-])`
+
+// PlanArchMigration works out how moving the project at projectPath from the
+// from architecture to the to architecture changes it. The packages of each layer
+// move to the directory of the layer in the new layout, the Go files of the
+// project import them from there, and the code that does not fit the new
+// architecture is listed for the report. An empty from is read from the generation
+// manifest.
+func (g *Generator) PlanArchMigration(projectPath, from, to string) (*ArchMigrationPlan, error) {
+	manifest, err := ReadManifest(projectPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	if manifest != nil && from == "" {
+		from = manifest.Config.Architecture
+		if from == "" && manifest.Config.Type == "web-api" {
+			from = "standard"
+		}
+	}
+	if from == "" {
+		return nil, types.NewValidationError(fmt.Sprintf("no %s in %s, set the architecture of the project with --from", ManifestFile, projectPath), nil)
+	}
+	for _, arch := range []string{from, to} {
+		if _, ok := archLayouts[arch]; !ok {
+			return nil, types.NewValidationError(fmt.Sprintf("cannot migrate %s projects (supported: %s); ddd and vertical-slice group the code by feature rather than by layer", arch, strings.Join(ArchMigrations(), ", ")), nil)
+		}
+	}
+	if from == to {
+		return nil, types.NewValidationError(fmt.Sprintf("the project already has the %s architecture", to), nil)
+	}
+
+	goMod, err := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+	if err != nil {
+		return nil, types.NewFileSystemError("failed to read go.mod", err)
+	}
+	module := modfile.ModulePath(goMod)
+	if module == "" {
+		return nil, types.NewFileSystemError("go.mod has no module path", nil)
+	}
+
+	plan := &ArchMigrationPlan{
+		Project:   projectPath,
+		Module:    module,
+		From:      from,
+		To:        to,
+		Moves:     []PackageMove{},
+		Rewritten: []string{},
+		TODOs:     []MigrationTODO{},
+		files:     make(map[string]GeneratedFile),
+	}
+	for _, layer := range archLayers {
+		source, target := archLayouts[from][layer], archLayouts[to][layer]
+		if source == target || !isDir(filepath.Join(projectPath, filepath.FromSlash(source))) {
+			continue
+		}
+		plan.Moves = append(plan.Moves, PackageMove{Layer: layer, From: source, To: target})
+	}
+	if len(plan.Moves) == 0 {
+		return nil, types.NewValidationError(fmt.Sprintf("%s does not have the directories of the %s layout", projectPath, from), nil)
+	}
+
+	files, err := projectFiles(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	// The directories of the new layout must be free, but for the files moving out of them
+	moving := make(map[string]bool)
+	for _, file := range files {
+		if plan.move(file) >= 0 {
+			moving[file] = true
+		}
+	}
+	for _, move := range plan.Moves {
+		for _, file := range files {
+			if strings.HasPrefix(file, move.To+"/") && !moving[file] {
+				return nil, types.NewValidationError(fmt.Sprintf("%s already exists in the project, move it out of the way of %s", file, move.To), nil)
+			}
+		}
+	}
+
+	for _, file := range files {
+		existing, err := readProjectFile(projectPath, file)
+		if err != nil {
+			return nil, types.NewFileSystemError("failed to read "+file, err)
+		}
+		target := file
+		if i := plan.move(file); i >= 0 {
+			plan.Moves[i].Files++
+			target = plan.Moves[i].To + strings.TrimPrefix(file, plan.Moves[i].From)
+			plan.removed = append(plan.removed, file)
+			plan.files[target] = existing
+		}
+		if existing.Symlink != "" || bytes.IndexByte(existing.Content, 0) >= 0 {
+			continue
+		}
+
+		content := existing.Content
+		if strings.HasSuffix(file, ".go") {
+			rewritten, changed, err := plan.rewriteGoFile(file, target, content)
+			if err != nil {
+				return nil, err
+			}
+			if changed {
+				content = rewritten
+				plan.Rewritten = append(plan.Rewritten, target)
+				plan.files[target] = GeneratedFile{Content: content, Mode: existing.Mode}
+			}
+			plan.findMisfits(target, content)
+		}
+		plan.findOldPaths(target, content)
+	}
+
+	if manifest != nil {
+		migrated := *manifest
+		migrated.Config.Architecture = to
+		if manifest.Blueprint == archBlueprints[from] {
+			migrated.Blueprint = archBlueprints[to]
+		}
+		// The files no longer are those of the blueprint, upgrades treat them all as edited
+		migrated.Files = nil
+		plan.manifest = &migrated
+	}
+
+	sort.Strings(plan.Rewritten)
+	sort.SliceStable(plan.TODOs, func(i, j int) bool {
+		if plan.TODOs[i].File != plan.TODOs[j].File {
+			return plan.TODOs[i].File < plan.TODOs[j].File
+		}
+		return plan.TODOs[i].Line < plan.TODOs[j].Line
+	})
+	return plan, nil
+}
+
+// move returns the index of the move of the package of file, the one of the
+// deepest directory, or -1 when the file does not move
+func (p *ArchMigrationPlan) move(file string) int {
+	found := -1
+	for i, move := range p.Moves {
+		if strings.HasPrefix(file, move.From+"/") && (found < 0 || len(move.From) > len(p.Moves[found].From)) {
+			found = i
+		}
+	}
+	return found
+}
+
+// importPath returns the import path of a package of the project after the
+// migration, and whether it moves
+func (p *ArchMigrationPlan) importPath(importPath string) (string, bool) {
+	if !strings.HasPrefix(importPath, p.Module+"/") {
+		return importPath, false
+	}
+	dir := strings.TrimPrefix(importPath, p.Module+"/")
+	i := p.move(dir + "/")
+	if i < 0 {
+		return importPath, false
+	}
+	return p.Module + "/" + p.Moves[i].To + strings.TrimPrefix(dir, p.Moves[i].From), true
+}
+
+// layer returns the layer whose package holds file in the new layout
+func (p *ArchMigrationPlan) layer(file string) string {
+	dir := path.Dir(file)
+	for _, layer := range archLayers {
+		if archLayouts[p.To][layer] == dir {
+			return layer
+		}
+	}
+	return ""
+}
+
+// rewriteGoFile renames the package of a moved file after its new directory and
+// imports the moved packages from their new path. References to a package whose
+// name changes are renamed, unless the new name is taken by another import of the
+// file: the import then keeps the previous name.
+func (p *ArchMigrationPlan) rewriteGoFile(file, target string, content []byte) ([]byte, bool, error) {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, file, content, parser.ParseComments)
+	if err != nil {
+		return nil, false, types.NewFileSystemError("failed to parse "+file, err)
+	}
+
+	changed := false
+	if file != target && parsed.Name.Name != "main" {
+		oldName, newName := packageName(path.Dir(file)), packageName(path.Dir(target))
+		switch parsed.Name.Name {
+		case oldName:
+			parsed.Name.Name, changed = newName, oldName != newName
+		case oldName + "_test":
+			parsed.Name.Name, changed = newName+"_test", oldName != newName
+		}
+	}
+
+	names := make(map[string]bool)
+	for _, imported := range parsed.Imports {
+		names[importName(imported)] = true
+	}
+	renamed := make(map[string]string)
+	for _, imported := range parsed.Imports {
+		oldPath, _ := strconv.Unquote(imported.Path.Value)
+		newPath, moved := p.importPath(oldPath)
+		if !moved {
+			continue
+		}
+		imported.Path.Value = strconv.Quote(newPath)
+		changed = true
+
+		oldName, newName := path.Base(oldPath), path.Base(newPath)
+		if imported.Name != nil || oldName == newName {
+			continue
+		}
+		if names[newName] {
+			imported.Name = ast.NewIdent(oldName)
+			continue
+		}
+		names[newName] = true
+		renamed[oldName] = newName
+	}
+	if len(renamed) > 0 {
+		ast.Inspect(parsed, func(node ast.Node) bool {
+			if selector, ok := node.(*ast.SelectorExpr); ok {
+				// Identifiers declared in the file, which may shadow the package, are resolved
+				if ident, ok := selector.X.(*ast.Ident); ok && ident.Obj == nil { //nolint:staticcheck // a parse without type checking
+					if newName, ok := renamed[ident.Name]; ok {
+						ident.Name = newName
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	if !changed {
+		return content, false, nil
+	}
+	var out bytes.Buffer
+	if err := format.Node(&out, fset, parsed); err != nil {
+		return nil, false, types.NewGenerationError("failed to format "+target, err)
+	}
+	return out.Bytes(), true, nil
+}
+
+// findMisfits lists the imports of a Go file that cross the boundaries of the new
+// architecture. The standard layout has none to keep.
+func (p *ArchMigrationPlan) findMisfits(file string, content []byte) {
+	ports, ok := archPorts[p.To]
+	if !ok {
+		return
+	}
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, file, content, parser.ImportsOnly)
+	if err != nil {
+		return
+	}
+	layer := p.layer(file)
+	for _, imported := range parsed.Imports {
+		importPath, _ := strconv.Unquote(imported.Path.Value)
+		dependency := p.layer(strings.TrimPrefix(importPath, p.Module+"/") + "/")
+		line := fset.Position(imported.Pos()).Line
+
+		var message string
+		switch {
+		case layer == layerServices && (dependency == layerRepositories || dependency == layerDatabase):
+			message = fmt.Sprintf("services depend on the %s adapter, declare the interfaces they use in %s and inject their implementations", dependency, ports)
+		case layer == layerHandlers && (dependency == layerRepositories || dependency == layerDatabase):
+			message = fmt.Sprintf("handlers reach the %s adapter, go through the %s instead", dependency, archLayouts[p.To][layerServices])
+		case layer == layerModels && (dependency != "" || strings.HasPrefix(importPath, "gorm.io/")):
+			message = fmt.Sprintf("entities import %s, keep the domain free of adapters and frameworks", importPath)
+		default:
+			continue
+		}
+		p.TODOs = append(p.TODOs, MigrationTODO{File: file, Line: line, Message: message})
+	}
+}
+
+// findOldPaths lists the lines of a file that still refer to a directory of the
+// previous layout, in comments, strings, scripts or documentation
+func (p *ArchMigrationPlan) findOldPaths(file string, content []byte) {
+	for _, move := range p.Moves {
+		ref := regexp.MustCompile(fmt.Sprintf(oldPathRef, regexp.QuoteMeta(move.From)))
+		for i, line := range strings.Split(string(content), "\n") {
+			if ref.MatchString(line) {
+				p.TODOs = append(p.TODOs, MigrationTODO{File: file, Line: i + 1, Message: fmt.Sprintf("refers to %s, now %s", move.From, move.To)})
+			}
+		}
+	}
+}
+
+// ApplyArchMigration moves the files of plan, writes the rewritten ones and the
+// report, and records the new architecture in the generation manifest
+func (g *Generator) ApplyArchMigration(plan *ArchMigrationPlan) error {
+	for _, file := range slices.Sorted(maps.Keys(plan.files)) {
+		if err := g.writeProjectFile(plan.Project, file, plan.files[file]); err != nil {
+			return err
+		}
+	}
+	for _, file := range plan.removed {
+		if _, moved := plan.files[file]; moved {
+			continue
+		}
+		if err := g.output().Remove(filepath.Join(plan.Project, filepath.FromSlash(file))); err != nil {
+			return types.NewFileSystemError("failed to remove "+file, err)
+		}
+	}
+	for _, move := range plan.Moves {
+		if err := g.removeEmptyDirs(filepath.Join(plan.Project, filepath.FromSlash(move.From))); err != nil {
+			return err
+		}
+		// The parents of the layer, such as internal/adapters, go with it when it was their last package
+		for dir := path.Dir(move.From); dir != "internal" && dir != "."; dir = path.Dir(dir) {
+			name := filepath.Join(plan.Project, filepath.FromSlash(dir))
+			if entries, err := g.output().ReadDir(name); err != nil || len(entries) > 0 {
+				break
+			}
+			if err := g.output().Remove(name); err != nil {
+				return types.NewFileSystemError("failed to remove "+dir, err)
+			}
+		}
+	}
+
+	report := GeneratedFile{Content: []byte(plan.Report(time.Now().UTC())), Mode: types.DefaultFileMode}
+	if err := g.writeProjectFile(plan.Project, ArchMigrationReportFile, report); err != nil {
+		return err
+	}
+	if plan.manifest != nil {
+		return g.writeManifest(plan.Project, *plan.manifest)
+	}
+	return nil
+}
+
+// removeEmptyDirs removes dir and its subdirectories when no file is left in them
+func (g *Generator) removeEmptyDirs(dir string) error {
+	entries, err := g.output().ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return types.NewFileSystemError("failed to read "+dir, err)
+	}
+	left := len(entries)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if err := g.removeEmptyDirs(filepath.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+		if _, err := g.output().Stat(filepath.Join(dir, entry.Name())); errors.Is(err, fs.ErrNotExist) {
+			left--
+		}
+	}
+	if left > 0 {
+		return nil
+	}
+	if err := g.output().Remove(dir); err != nil {
+		return types.NewFileSystemError("failed to remove "+dir, err)
+	}
+	return nil
+}
+
+// Report is the Markdown report of the migration written into the project
+func (p *ArchMigrationPlan) Report(migratedAt time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Architecture Migration: %s → %s\n\n", p.From, p.To)
+	fmt.Fprintf(&b, "Migrated by `go-starter migrate-arch` on %s.\n\n", migratedAt.Format("2006-01-02"))
+
+	b.WriteString("## Moved Packages\n\n| Layer | From | To | Files |\n|---|---|---|---|\n")
+	for _, move := range p.Moves {
+		fmt.Fprintf(&b, "| %s | `%s` | `%s` | %d |\n", move.Layer, move.From, move.To, move.Files)
+	}
+
+	if len(p.Rewritten) > 0 {
+		fmt.Fprintf(&b, "\n## Rewritten Files\n\nThe package clause or the imports of these files follow the new layout:\n\n")
+		for _, file := range p.Rewritten {
+			fmt.Fprintf(&b, "- `%s`\n", file)
+		}
+	}
+
+	b.WriteString("\n## To Do by Hand\n\n")
+	if len(p.TODOs) == 0 {
+		b.WriteString("Nothing left, the code follows the new layout.\n")
+	}
+	for _, todo := range p.TODOs {
+		location := todo.File
+		if todo.Line > 0 {
+			location = fmt.Sprintf("%s:%d", todo.File, todo.Line)
+		}
+		fmt.Fprintf(&b, "- [ ] `%s`: %s\n", location, todo.Message)
+	}
+
+	b.WriteString("\nCheck the migration with `go build ./... && go test ./...`.")
+	if p.manifest != nil {
+		fmt.Fprintf(&b, " The generation manifest now records blueprint %s; `go-starter upgrade` treats every file as edited and writes the changes of new blueprint versions next to them.", p.manifest.Blueprint)
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// projectFiles lists the files of a project by slash path, leaving out version
+// control, vendored dependencies and the generation manifest
+func projectFiles(projectPath string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(projectPath, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			switch entry.Name() {
+			case ".git", "vendor", "node_modules":
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(projectPath, name)
+		if err != nil {
+			return err
+		}
+		if rel = filepath.ToSlash(rel); !isMetadataFile(rel) && rel != ArchMigrationReportFile {
+			files = append(files, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, types.NewFileSystemError("failed to list the files of "+projectPath, err)
+	}
+	return files, nil
+}
+
+// packageName is the name of the package of a directory
+func packageName(dir string) string {
+	return strings.ReplaceAll(path.Base(dir), "-", "")
+}
+
+// importName is the name a file refers to an imported package by: its alias, or
+// the last element of its path that is not a major version
+func importName(imported *ast.ImportSpec) string {
+	if imported.Name != nil {
+		return imported.Name.Name
+	}
+	importPath, _ := strconv.Unquote(imported.Path.Value)
+	elements := strings.Split(importPath, "/")
+	name := elements[len(elements)-1]
+	if len(elements) > 1 && regexp.MustCompile(`^v[0-9]+$`).MatchString(name) {
+		name = elements[len(elements)-2]
+	}
+	if i := strings.IndexByte(name, '.'); i > 0 {
+		name = name[:i] // gopkg.in/yaml.v3
+	}
+	return strings.ReplaceAll(name, "-", "")
+}
+
+// isDir reports whether name is a directory
+func isDir(name string) bool {
+	info, err := os.Stat(name)
+	return err == nil && info.IsDir()
+}
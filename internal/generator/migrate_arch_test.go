@@ -0,0 +1,181 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeStandardProject writes a small web API with the standard layout
+func writeStandardProject(t *testing.T, manifest bool) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module github.com/test/shop\n\ngo 1.21\n",
+		"cmd/server/main.go": `package main
+
+import (
+	"net/http"
+
+	"github.com/test/shop/internal/handlers"
+	"github.com/test/shop/internal/repository"
+	"github.com/test/shop/internal/services"
+)
+
+func main() {
+	users := services.NewUsers(repository.NewUsers())
+	_ = http.ListenAndServe(":8080", handlers.New(users))
+}
+`,
+		"internal/handlers/users.go": `package handlers
+
+import (
+	"net/http"
+
+	"github.com/test/shop/internal/services"
+)
+
+// New serves the users
+func New(users *services.Users) http.Handler {
+	return http.NotFoundHandler()
+}
+`,
+		"internal/handlers/users_test.go": "package handlers_test\n\nimport (\n\t\"testing\"\n\n\t\"github.com/test/shop/internal/handlers\"\n)\n\nfunc TestNew(t *testing.T) {\n\t_ = handlers.New(nil)\n}\n",
+		"internal/services/users.go": `package services
+
+import (
+	"github.com/test/shop/internal/models"
+	"github.com/test/shop/internal/repository"
+)
+
+// Users manages the users
+type Users struct{ repo *repository.Users }
+
+// NewUsers creates the service
+func NewUsers(repo *repository.Users) *Users { return &Users{repo: repo} }
+
+// Get returns a user
+func (u *Users) Get(id int) models.User {
+	repository := u.repo // shadows the package
+	return repository.Find(id)
+}
+`,
+		"internal/repository/users.go": "package repository\n\nimport \"github.com/test/shop/internal/models\"\n\n// Users stores the users\ntype Users struct{}\n\n// NewUsers creates the repository\nfunc NewUsers() *Users { return &Users{} }\n\n// Find returns a user\nfunc (*Users) Find(id int) models.User { return models.User{ID: id} }\n",
+		"internal/models/user.go":      "package models\n\n// User is a user\ntype User struct{ ID int }\n",
+		"internal/errors/errors.go":    "package errors\n",
+		"Makefile":                     "lint:\n\tgolangci-lint run ./internal/handlers/...\n",
+	}
+	if manifest {
+		files[ManifestFile] = `{"blueprint": "web-api", "config": {"name": "shop", "type": "web-api", "architecture": "standard"}, "files": {"go.mod": "x"}}`
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return dir
+}
+
+func TestPlanArchMigration(t *testing.T) {
+	setupTestTemplates(t)
+	dir := writeStandardProject(t, true)
+
+	plan, err := New().PlanArchMigration(dir, "", "hexagonal")
+	require.NoError(t, err)
+	assert.Equal(t, "standard", plan.From, "the architecture is read from the manifest")
+	assert.Equal(t, []PackageMove{
+		{Layer: layerHandlers, From: "internal/handlers", To: "internal/adapters/primary/http", Files: 2},
+		{Layer: layerServices, From: "internal/services", To: "internal/application/services", Files: 1},
+		{Layer: layerRepositories, From: "internal/repository", To: "internal/adapters/secondary/persistence", Files: 1},
+		{Layer: layerModels, From: "internal/models", To: "internal/domain/entities", Files: 1},
+	}, plan.Moves)
+	assert.Contains(t, plan.Rewritten, "cmd/server/main.go")
+	assert.NotContains(t, plan.Rewritten, "internal/errors/errors.go")
+
+	// Services depending on the repository are left to declare ports for, and
+	// scripts referring to the previous directories are listed
+	assert.Contains(t, plan.TODOs, MigrationTODO{File: "internal/application/services/users.go", Line: 4, Message: "services depend on the repositories adapter, declare the interfaces they use in internal/application/ports/output and inject their implementations"})
+	assert.Contains(t, plan.TODOs, MigrationTODO{File: "Makefile", Line: 2, Message: "refers to internal/handlers, now internal/adapters/primary/http"})
+	_, err = os.Stat(filepath.Join(dir, "internal/adapters"))
+	assert.True(t, os.IsNotExist(err), "planning changes nothing")
+
+	require.NoError(t, New().ApplyArchMigration(plan))
+	assert.NoDirExists(t, filepath.Join(dir, "internal/handlers"))
+	assert.NoDirExists(t, filepath.Join(dir, "internal/models"))
+	assert.DirExists(t, filepath.Join(dir, "internal/errors"))
+	assert.FileExists(t, filepath.Join(dir, ArchMigrationReportFile))
+
+	read := func(name string) string {
+		content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		require.NoError(t, err)
+		return string(content)
+	}
+
+	// http is taken by net/http in main.go, the handlers keep their name there
+	main := read("cmd/server/main.go")
+	assert.Contains(t, main, `handlers "github.com/test/shop/internal/adapters/primary/http"`)
+	assert.Contains(t, main, `"github.com/test/shop/internal/adapters/secondary/persistence"`)
+	assert.Contains(t, main, "services.NewUsers(persistence.NewUsers())")
+
+	handlers := read("internal/adapters/primary/http/users.go")
+	assert.Contains(t, handlers, "package http\n")
+	assert.Contains(t, read("internal/adapters/primary/http/users_test.go"), "package http_test\n")
+	assert.Contains(t, read("internal/adapters/primary/http/users_test.go"), "_ = http.New(nil)")
+
+	services := read("internal/application/services/users.go")
+	assert.Contains(t, services, "repo *persistence.Users")
+	assert.Contains(t, services, "func (u *Users) Get(id int) entities.User")
+	assert.Contains(t, services, "return repository.Find(id)", "local variables shadowing a package are left alone")
+	assert.Contains(t, read("internal/domain/entities/user.go"), "package entities\n")
+
+	manifest, err := ReadManifest(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "web-api-hexagonal", manifest.Blueprint)
+	assert.Equal(t, "hexagonal", manifest.Config.Architecture)
+	assert.Nil(t, manifest.Files, "the files no longer are those of the blueprint")
+
+	report := read(ArchMigrationReportFile)
+	assert.Contains(t, report, "# Architecture Migration: standard → hexagonal")
+	assert.Contains(t, report, "| handlers | `internal/handlers` | `internal/adapters/primary/http` | 2 |")
+	assert.Contains(t, report, "- [ ] `Makefile:2`: refers to internal/handlers")
+
+	// And back to the standard layout
+	plan, err = New().PlanArchMigration(dir, "", "standard")
+	require.NoError(t, err)
+	assert.Empty(t, plan.TODOs, "the standard layout has no boundaries to keep")
+	require.NoError(t, New().ApplyArchMigration(plan))
+	assert.NoDirExists(t, filepath.Join(dir, "internal/adapters"))
+	assert.NoDirExists(t, filepath.Join(dir, "internal/application"))
+	assert.Contains(t, read("cmd/server/main.go"), `"github.com/test/shop/internal/handlers"`)
+	assert.Contains(t, read("internal/services/users.go"), "repo *repository.Users")
+}
+
+func TestPlanArchMigration_Errors(t *testing.T) {
+	setupTestTemplates(t)
+	dir := writeStandardProject(t, false)
+
+	_, err := New().PlanArchMigration(dir, "", "clean")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "set the architecture of the project with --from")
+
+	_, err = New().PlanArchMigration(dir, "standard", "ddd")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot migrate ddd projects (supported: clean, hexagonal, standard)")
+
+	_, err = New().PlanArchMigration(dir, "standard", "standard")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already has the standard architecture")
+
+	_, err = New().PlanArchMigration(dir, "clean", "hexagonal")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not have the directories of the clean layout")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "internal/domain/entities"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "internal/domain/entities/order.go"), []byte("package entities\n"), 0644))
+	_, err = New().PlanArchMigration(dir, "standard", "clean")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "internal/domain/entities/order.go already exists")
+}
@@ -0,0 +1,45 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_MockServer(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	for _, blueprint := range []string{"web-api", "web-api-clean", "web-api-ddd", "web-api-hexagonal"} {
+		t.Run(blueprint, func(t *testing.T) {
+			config := &types.ProjectConfig{
+				Name:      "shop",
+				Module:    "github.com/test/shop",
+				Type:      "web-api",
+				Framework: "gin",
+				Logger:    "slog",
+				Variables: map[string]string{},
+			}
+			files, err := New().GenerateInMemoryFiles(ctx, config, blueprint)
+			require.NoError(t, err)
+
+			for _, path := range []string{
+				"cmd/mockserver/main.go",
+				"internal/openapi/spec.go",
+				"cmd/mockserver/mock.go",
+				"cmd/mockserver/validate.go",
+				"cmd/mockserver/mock_test.go",
+			} {
+				assert.Contains(t, files, path)
+			}
+			assert.Contains(t, files, "api/openapi.yaml")
+			assert.Contains(t, string(files["cmd/mockserver/main.go"].Content), "serves the API of shop")
+			assert.Contains(t, string(files["go.mod"].Content), "gopkg.in/yaml.v3")
+			assert.Contains(t, string(files["Makefile"].Content), "./cmd/mockserver")
+		})
+	}
+}
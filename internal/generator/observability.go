@@ -0,0 +1,34 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// ObservabilityVariable is the blueprint variable that turns on the request metrics
+// and the SLOs computed from them: Prometheus recording and alerting rules and
+// runbook stubs. Blueprints offer them by declaring it.
+const ObservabilityVariable = "Observability"
+
+// SLOSpecsVariable is the blueprint variable listing the specifications the SLOs
+// are also written in, openslo and sloth, or none
+const SLOSpecsVariable = "SLOSpecs"
+
+// checkObservability rejects observability for blueprints that do not offer it,
+// and SLO specifications without the SLOs they describe
+func checkObservability(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[SLOSpecsVariable] != "" && config.Variables[ObservabilityVariable] != "true" {
+		return types.NewValidationError("SLO specifications describe the SLOs generated with observability, set --observability", nil)
+	}
+	if config.Variables[ObservabilityVariable] != "true" {
+		return nil
+	}
+
+	for _, variable := range tmpl.Variables {
+		if variable.Name == ObservabilityVariable {
+			return nil
+		}
+	}
+	return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer observability, remove --observability", tmpl.ID), nil)
+}
@@ -0,0 +1,85 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_Observability(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(variables map[string]string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:      "order-api",
+			Module:    "github.com/test/order-api",
+			Type:      "web-api",
+			Framework: "chi",
+			Logger:    "slog",
+			Variables: variables,
+			Features:  &types.Features{},
+		}
+	}
+
+	t.Run("metrics and SLOs are left out by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{}), "web-api")
+		require.NoError(t, err)
+		assert.NotContains(t, files, "internal/middleware/metrics.go")
+		assert.NotContains(t, files, "monitoring/prometheus/slo-rules.yml")
+		assert.NotContains(t, string(files["cmd/server/main.go"].Content), "/metrics")
+	})
+
+	t.Run("rules and SLOs select the generated metrics", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{ObservabilityVariable: "true"}), "web-api")
+		require.NoError(t, err)
+
+		metrics := string(files["internal/middleware/metrics.go"].Content)
+		assert.Contains(t, metrics, `"order_api_http_requests_total"`)
+		assert.Contains(t, metrics, `"order_api_http_request_duration_seconds"`)
+		main := string(files["cmd/server/main.go"].Content)
+		assert.Contains(t, main, "router.Use(internalMiddleware.ChiMetrics)")
+		assert.Contains(t, main, `router.Handle("/metrics", internalMiddleware.MetricsHandler())`)
+		assert.Contains(t, string(files["go.mod"].Content), "github.com/prometheus/client_golang")
+
+		rules := string(files["monitoring/prometheus/slo-rules.yml"].Content)
+		assert.Contains(t, rules, `sum(rate(order_api_http_requests_total{status_code=~"5.."}[1h]))`)
+		assert.Contains(t, rules, `order_api_http_request_duration_seconds_bucket{le="0.25"}`)
+		assert.Contains(t, rules, "alert: OrderApiAvailabilityErrorBudgetBurn\n")
+		assert.Contains(t, rules, `runbook: "docs/runbooks/latency.md"`)
+		assert.Contains(t, files, "docs/runbooks/availability.md")
+		assert.Contains(t, files, "docs/runbooks/latency.md")
+
+		assert.Contains(t, string(files["monitoring/slo/openslo.yaml"].Content), "query: sum(order_api_http_requests_total)")
+		assert.NotContains(t, files, "monitoring/slo/sloth.yaml")
+	})
+
+	t.Run("SLO specifications are chosen", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{ObservabilityVariable: "true", SLOSpecsVariable: "sloth"}), "web-api")
+		require.NoError(t, err)
+		assert.NotContains(t, files, "monitoring/slo/openslo.yaml")
+		assert.Contains(t, string(files["monitoring/slo/sloth.yaml"].Content), "[{{.window}}]")
+
+		files, err = New().GenerateInMemoryFiles(ctx, config(map[string]string{ObservabilityVariable: "true", SLOSpecsVariable: "none"}), "web-api")
+		require.NoError(t, err)
+		assert.NotContains(t, files, "monitoring/slo/openslo.yaml")
+		assert.NotContains(t, files, "monitoring/slo/sloth.yaml")
+		assert.Contains(t, files, "monitoring/prometheus/slo-rules.yml")
+	})
+
+	t.Run("SLO specifications need observability", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{SLOSpecsVariable: "openslo"}), "web-api")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "set --observability")
+	})
+
+	t.Run("blueprints without observability reject the flag", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{ObservabilityVariable: "true"}), "grpc-service")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer observability")
+	})
+}
@@ -0,0 +1,231 @@
+package generator
+
+import (
+	"slices"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Option is a variable of a blueprint as a form field: its flag on go-starter new,
+// its default and choices, and what it requires from the other options
+type Option struct {
+	Name        string   `json:"name" yaml:"name"`
+	Flag        string   `json:"flag,omitempty" yaml:"flag,omitempty"`
+	Type        string   `json:"type" yaml:"type"`
+	Description string   `json:"description" yaml:"description"`
+	Default     any      `json:"default,omitempty" yaml:"default,omitempty"`
+	Required    bool     `json:"required" yaml:"required"`
+	Choices     []string `json:"choices,omitempty" yaml:"choices,omitempty"`
+	Validation  string   `json:"validation,omitempty" yaml:"validation,omitempty"`
+	// Enum, Min and Max are the limits of the values generation accepts
+	Enum []string `json:"enum,omitempty" yaml:"enum,omitempty"`
+	Min  *float64 `json:"min,omitempty" yaml:"min,omitempty"`
+	Max  *float64 `json:"max,omitempty" yaml:"max,omitempty"`
+	// DeprecatedChoices and ExperimentalChoices are keyed by choice
+	DeprecatedChoices   map[string]types.Deprecation `json:"deprecated_choices,omitempty" yaml:"deprecated_choices,omitempty"`
+	ExperimentalChoices map[string]string            `json:"experimental_choices,omitempty" yaml:"experimental_choices,omitempty"`
+	// Requires lists the conditions the other options must meet once this one is set
+	Requires []OptionRequirement `json:"requires,omitempty" yaml:"requires,omitempty"`
+}
+
+// OptionRequirement is a condition on another option that generation enforces once
+// an option is set: to a non-empty value, or to "true" for the switches
+type OptionRequirement struct {
+	// When restricts the requirement to these values of the option, all values when empty
+	When []string `json:"when,omitempty" yaml:"when,omitempty"`
+	// Option is the name of the option the condition is on
+	Option string `json:"option" yaml:"option"`
+	// OneOf lists the values Option must take; without it, Option must be set
+	OneOf []string `json:"one_of,omitempty" yaml:"one_of,omitempty"`
+	// Unset requires Option to be left empty instead
+	Unset bool `json:"unset,omitempty" yaml:"unset,omitempty"`
+	// Message is the error generation reports when the condition is not met
+	Message string `json:"message" yaml:"message"`
+}
+
+// optionFlags are the flags of go-starter new setting the blueprint variables.
+// Variables without a flag keep their blueprint default on the command line.
+var optionFlags = map[string]string{
+	"ProjectName":             "name",
+	"ModulePath":              "module",
+	"GoVersion":               "go-version",
+	"Framework":               "framework",
+	"Logger":                  "logger",
+	"DatabaseDriver":          "database-driver",
+	"DatabaseORM":             "database-orm",
+	"AuthType":                "auth-type",
+	"AssetPipeline":           "asset-pipeline",
+	"Broker":                  "broker",
+	"DeploymentTool":          "deployment-tool",
+	TelemetryVariable:         "telemetry-endpoint",
+	AdminEndpointsVariable:    "admin-endpoints",
+	LockoutStoreVariable:      "lockout-store",
+	ReadModelsVariable:        "read-models",
+	RefreshTokenStoreVariable: "refresh-token-store",
+	JWTAlgorithmVariable:      "jwt-algorithm",
+	PlatformVariable:          "platform",
+	PubSubVariable:            "pubsub",
+	SchemaFormatVariable:      "schema-format",
+	SagaVariable:              "saga",
+	DataPrivacyVariable:       "data-privacy",
+	ClientSDKVariable:         "client-sdk",
+	BenchmarksVariable:        "benchmarks",
+	E2EVariable:               "e2e",
+	CoordinationVariable:      "coordination",
+	LeaderElectionVariable:    "leader-election",
+	ReleaseToolingVariable:    "release-tooling",
+	TeamVariable:              "team",
+	DIVariable:                "di",
+	IDStrategyVariable:        "id-strategy",
+	EntrypointsVariable:       "entrypoints",
+	APISurfacesVariable:       "api-surfaces",
+	ObservabilityVariable:     "observability",
+	SLOSpecsVariable:          "slo-specs",
+	RuntimeConfigVariable:     "runtime-config",
+	ChaosVariable:             "chaos",
+}
+
+// switchOptions are the options set by a boolean flag, which count as set when "true"
+var switchOptions = map[string]bool{
+	AdminEndpointsVariable: true,
+	ReadModelsVariable:     true,
+	DataPrivacyVariable:    true,
+	BenchmarksVariable:     true,
+	E2EVariable:            true,
+	LeaderElectionVariable: true,
+	ReleaseToolingVariable: true,
+	ObservabilityVariable:  true,
+	RuntimeConfigVariable:  true,
+	ChaosVariable:          true,
+}
+
+// optionRequirements mirror the checks run by GenerateInMemoryFiles, so that forms
+// can show them before generation rejects a configuration. TestOptionRequirements
+// keeps both in step.
+var optionRequirements = map[string][]OptionRequirement{
+	AdminEndpointsVariable: {
+		{Option: "DatabaseDriver", Message: "admin endpoints manage stored users and need a database"},
+		{Option: "AuthType", Message: "admin endpoints are role-guarded and need authentication"},
+	},
+	LockoutStoreVariable: {
+		{Option: "AuthType", Message: "account lockout protects logins and needs authentication"},
+		{When: []string{"database"}, Option: "DatabaseDriver", Message: "the database lockout store needs a database"},
+	},
+	ReadModelsVariable: {
+		{Option: "DatabaseDriver", Message: "read models are stored alongside the users and need a database"},
+	},
+	RefreshTokenStoreVariable: {
+		{Option: "AuthType", Message: "refresh tokens are issued by authentication"},
+		{When: []string{"database"}, Option: "DatabaseDriver", Message: "the database refresh token store needs a database"},
+	},
+	JWTAlgorithmVariable: {
+		{Option: "AuthType", Message: "JWT tokens are issued by authentication"},
+	},
+	SagaVariable: {
+		{Option: SchemaFormatVariable, Unset: true, Message: "--saga cannot be combined with --schema-format"},
+	},
+	DataPrivacyVariable: {
+		{Option: "DatabaseDriver", Message: "data export and account deletion work on stored users and need a database"},
+		{Option: "AuthType", Message: "data export and account deletion serve logged-in users and need authentication"},
+	},
+	BenchmarksVariable: {
+		{Option: "DatabaseDriver", OneOf: []string{"postgres", "mysql"}, Message: "the repository benchmarks run against a database container, which supports postgres and mysql"},
+	},
+	E2EVariable: {
+		{Option: "Framework", OneOf: []string{"gin"}, Message: "the end-to-end suite is generated for the gin adapter"},
+		{Option: ClientSDKVariable, OneOf: []string{"go", "go,typescript"}, Message: "the end-to-end suite drives the service through the generated Go client"},
+		{Option: "DatabaseDriver", OneOf: []string{"postgres", "mysql"}, Message: "the end-to-end stack runs its database in docker-compose, which supports postgres and mysql"},
+		{Option: "AuthType", Message: "the end-to-end suite logs users in and needs authentication"},
+	},
+	APISurfacesVariable: {
+		{When: []string{"rest,graphql"}, Option: "DatabaseDriver", Message: "the GraphQL surface serves the stored users and needs a database"},
+	},
+	CoordinationVariable: {
+		{When: []string{"postgres"}, Option: "DatabaseDriver", OneOf: []string{"postgres"}, Message: "postgres locks are advisory locks of the project database"},
+	},
+	SLOSpecsVariable: {
+		{Option: ObservabilityVariable, OneOf: []string{"true"}, Message: "SLO specifications describe the SLOs generated with observability"},
+	},
+}
+
+// Options lists the options of a blueprint in the order it declares its variables
+func (g *Generator) Options(blueprintID string) ([]Option, error) {
+	tmpl, err := g.registry.Get(blueprintID)
+	if err != nil {
+		return nil, err
+	}
+	return blueprintOptions(tmpl), nil
+}
+
+func blueprintOptions(tmpl types.Template) []Option {
+	options := make([]Option, 0, len(tmpl.Variables))
+	for _, variable := range tmpl.Variables {
+		option := Option{
+			Name:                variable.Name,
+			Flag:                optionFlags[variable.Name],
+			Type:                variable.Type,
+			Description:         variable.Description,
+			Default:             variable.Default,
+			Required:            variable.Required,
+			Choices:             variable.Choices,
+			Validation:          variable.Validation,
+			Enum:                variable.Enum,
+			Min:                 variable.Min,
+			Max:                 variable.Max,
+			DeprecatedChoices:   variable.DeprecatedChoices,
+			ExperimentalChoices: variable.ExperimentalChoices,
+		}
+		if switchOptions[variable.Name] {
+			option.Type = "bool"
+		}
+		option.Requires = slices.Clone(optionRequirements[variable.Name])
+		for _, requirement := range variable.Requires {
+			option.Requires = append(option.Requires, OptionRequirement{
+				When:    requirement.When,
+				Option:  requirement.Variable,
+				OneOf:   requirement.OneOf,
+				Unset:   requirement.Unset,
+				Message: requirement.Explain(variable.Name),
+			})
+		}
+		options = append(options, option)
+	}
+	return options
+}
+
+// checkOptions runs the checks of the options a configuration sets against the
+// blueprint it is generated with
+func checkOptions(tmpl types.Template, config types.ProjectConfig) error {
+	checks := []func(types.Template, types.ProjectConfig) error{
+		checkTelemetry,
+		checkAdminEndpoints,
+		checkLockoutStore,
+		checkReadModels,
+		checkRefreshTokenStore,
+		checkJWTAlgorithm,
+		checkPlatform,
+		checkPubSub,
+		checkSchemaFormat,
+		checkSaga,
+		checkDataPrivacy,
+		checkClientSDK,
+		checkBenchmarks,
+		checkE2E,
+		checkCoordination,
+		checkReleaseTooling,
+		checkDI,
+		checkIDStrategy,
+		checkEntrypoints,
+		checkAPISurfaces,
+		checkTeam,
+		checkObservability,
+		checkRuntimeConfig,
+		checkChaos,
+	}
+	for _, check := range checks {
+		if err := check(tmpl, config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// setOption sets an option of config the way go-starter new does for its flag
+func setOption(config *types.ProjectConfig, name, value string) {
+	switch name {
+	case "Framework":
+		config.Framework = value
+	case "DatabaseDriver":
+		config.Features.Database.Driver = value
+	case "AuthType":
+		config.Features.Authentication.Type = value
+	}
+	config.Variables[name] = value
+}
+
+// optionValue is a value setting the option in the blueprint, one its requirements apply to
+func optionValue(option Option) string {
+	if option.Type == "bool" {
+		return "true"
+	}
+	for _, requirement := range option.Requires {
+		if len(requirement.When) > 0 {
+			return requirement.When[0]
+		}
+	}
+	for _, choice := range option.Choices {
+		if choice != "" {
+			return choice
+		}
+	}
+	return "https://example.com"
+}
+
+// meet returns a value of the required option meeting the requirement, empty for Unset
+func meet(requirement OptionRequirement) string {
+	switch {
+	case requirement.Unset:
+		return ""
+	case len(requirement.OneOf) > 0:
+		return requirement.OneOf[0]
+	case requirement.Option == "AuthType":
+		return "jwt"
+	default:
+		return "postgres"
+	}
+}
+
+// violate returns a value of the required option breaking the requirement
+func violate(requirement OptionRequirement) string {
+	switch {
+	case requirement.Unset:
+		return "json"
+	case len(requirement.OneOf) > 0:
+		return "other"
+	default:
+		return ""
+	}
+}
+
+// TestOptionRequirements checks that every requirement listed for an option is
+// enforced by generation, with its message, and that meeting them all is enough
+func TestOptionRequirements(t *testing.T) {
+	setupTestTemplates(t)
+	g := New()
+
+	tested := make(map[string]bool)
+	for _, tmpl := range g.registry.List() {
+		for _, option := range blueprintOptions(tmpl) {
+			if len(option.Requires) == 0 {
+				continue
+			}
+			tested[option.Name] = true
+
+			base := func() types.ProjectConfig {
+				config := types.ProjectConfig{
+					Name:      "my-project",
+					Module:    "github.com/test/my-project",
+					Type:      tmpl.Type,
+					Features:  &types.Features{},
+					Variables: map[string]string{},
+				}
+				setOption(&config, option.Name, optionValue(option))
+				for _, requirement := range option.Requires {
+					setOption(&config, requirement.Option, meet(requirement))
+				}
+				return config
+			}
+
+			// The requirements of optionRequirements are checked in code, those the
+			// blueprint declares by its rules
+			check := func(config types.ProjectConfig) error {
+				if err := checkOptions(tmpl, config); err != nil {
+					return err
+				}
+				return g.checkVariableRules(tmpl, config)
+			}
+
+			t.Run(tmpl.ID+"/"+option.Name, func(t *testing.T) {
+				require.NoError(t, check(base()), "meeting the requirements of %s is enough", option.Name)
+
+				for _, requirement := range option.Requires {
+					config := base()
+					setOption(&config, requirement.Option, violate(requirement))
+
+					err := check(config)
+					require.Error(t, err, "%s requires %s", option.Name, requirement.Option)
+					assert.Contains(t, err.Error(), requirement.Message)
+				}
+			})
+		}
+	}
+
+	for name := range optionRequirements {
+		assert.True(t, tested[name], "no blueprint declares %s", name)
+	}
+}
+
+func TestOptions(t *testing.T) {
+	setupTestTemplates(t)
+
+	options, err := New().Options("web-api-clean")
+	require.NoError(t, err)
+
+	byName := make(map[string]Option, len(options))
+	for _, option := range options {
+		byName[option.Name] = option
+	}
+	assert.Equal(t, "ProjectName", options[0].Name, "options keep the order of the blueprint")
+
+	driver := byName["DatabaseDriver"]
+	assert.Equal(t, "database-driver", driver.Flag)
+	assert.Equal(t, "postgres", driver.Default)
+	assert.Contains(t, driver.Choices, "mysql")
+
+	e2e := byName[E2EVariable]
+	assert.Equal(t, "bool", e2e.Type)
+	assert.Equal(t, "e2e", e2e.Flag)
+	assert.Len(t, e2e.Requires, 4)
+
+	assert.Empty(t, byName["License"].Flag, "variables without a flag keep their default on the command line")
+
+	_, err = New().Options("missing")
+	assert.Error(t, err)
+}
@@ -0,0 +1,58 @@
+package generator
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/francknouama/go-starter/internal/outputfs"
+	"github.com/francknouama/go-starter/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// remoteFS stands in for a remote target by mapping its paths below a local root
+type remoteFS struct {
+	root  string
+	local outputfs.Local
+}
+
+func (r remoteFS) path(p string) string { return filepath.Join(r.root, p) }
+
+func (r remoteFS) MkdirAll(p string, perm fs.FileMode) error {
+	return r.local.MkdirAll(r.path(p), perm)
+}
+func (r remoteFS) WriteFile(p string, data []byte, perm fs.FileMode) error {
+	return r.local.WriteFile(r.path(p), data, perm)
+}
+func (r remoteFS) Symlink(target, link string) error       { return r.local.Symlink(target, r.path(link)) }
+func (r remoteFS) Remove(p string) error                   { return r.local.Remove(r.path(p)) }
+func (r remoteFS) RemoveAll(p string) error                { return r.local.RemoveAll(r.path(p)) }
+func (r remoteFS) Stat(p string) (fs.FileInfo, error)      { return r.local.Stat(r.path(p)) }
+func (r remoteFS) ReadDir(p string) ([]fs.DirEntry, error) { return r.local.ReadDir(r.path(p)) }
+
+func TestGenerate_OutputFS(t *testing.T) {
+	registry := setupFileModeTestTemplates(t)
+
+	root := t.TempDir()
+	outputPath := filepath.Join(string(filepath.Separator), "srv", "go-starter-remote-test", "modes")
+
+	result, err := NewWithRegistry(registry).Generate(types.ProjectConfig{
+		Name:      "modes",
+		Module:    "github.com/test/modes",
+		Type:      "cli",
+		Variables: map[string]string{"blueprint_id": "modes-test"},
+	}, types.GenerationOptions{OutputPath: outputPath, Output: remoteFS{root: root}})
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+
+	assert.FileExists(t, filepath.Join(root, outputPath, "README.md"))
+	if runtime.GOOS != "windows" {
+		assertMode(t, filepath.Join(root, outputPath, "scripts/dev.sh"), 0755)
+	}
+	assert.NoDirExists(t, filepath.Join(root, outputPath, ".git"), "git is not initialized on remote targets")
+	_, err = os.Stat(outputPath)
+	assert.True(t, os.IsNotExist(err), "nothing is written to the local disk")
+}
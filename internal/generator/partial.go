@@ -0,0 +1,156 @@
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/francknouama/go-starter/internal/outputfs"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// PartialStateFile is written into the output directory when an interrupted
+// generation is kept with KeepPartial
+const PartialStateFile = ".go-starter-partial.json"
+
+// PartialState describes an interrupted generation left on disk, the checkpoint
+// it is resumed from
+type PartialState struct {
+	Blueprint     string              `json:"blueprint"`
+	Config        types.ProjectConfig `json:"config"`
+	Options       PartialOptions      `json:"options"`
+	Phase         string              `json:"phase,omitempty"`
+	FilesWritten  []string            `json:"files_written"`
+	InterruptedAt time.Time           `json:"interrupted_at"`
+	Reason        string              `json:"reason"`
+}
+
+// PartialOptions are the generation options an interrupted generation is resumed with
+type PartialOptions struct {
+	NoGit      bool     `json:"no_git,omitempty"`
+	NoFormat   bool     `json:"no_format,omitempty"`
+	Formatters []string `json:"formatters,omitempty"`
+	Strict     bool     `json:"strict,omitempty"`
+}
+
+// GenerationOptions returns the options resuming the interrupted generation in
+// projectPath with the options it was started with
+func (s *PartialState) GenerationOptions(projectPath string) types.GenerationOptions {
+	return types.GenerationOptions{
+		OutputPath:  projectPath,
+		NoGit:       s.Options.NoGit,
+		NoFormat:    s.Options.NoFormat,
+		Formatters:  s.Options.Formatters,
+		Strict:      s.Options.Strict,
+		KeepPartial: true,
+		Resume:      true,
+	}
+}
+
+// phaseOrder lists the phases of a generation in the order they run
+var phaseOrder = []string{types.PhaseRender, types.PhasePreHooks, types.PhaseWrite, types.PhaseTidy, types.PhaseHooks}
+
+// completed reports whether the interrupted generation got past phase
+func (s *PartialState) completed(phase string) bool {
+	return s.furthestPhase(phase) != phase
+}
+
+// furthestPhase returns the phase of a resumed generation interrupted again: the
+// phases the first generation completed stay completed
+func (s *PartialState) furthestPhase(phase string) string {
+	if s != nil && slices.Index(phaseOrder, s.Phase) > slices.Index(phaseOrder, phase) {
+		return s.Phase
+	}
+	return phase
+}
+
+// checkResume loads the checkpoint of the interrupted generation of tmpl in
+// outputPath, which must be a local directory left by KeepPartial
+func (g *Generator) checkResume(tmpl types.Template, outputPath string, intoExisting bool) (*PartialState, error) {
+	if !outputfs.IsLocal(g.out) || intoExisting {
+		return nil, types.NewValidationError("only generations kept in a local directory can be resumed", nil)
+	}
+	state, err := ReadPartialState(outputPath)
+	if err != nil {
+		return nil, types.NewValidationError(fmt.Sprintf("'%s' holds no interrupted generation to resume, generate it with --keep-partial", outputPath), err)
+	}
+	if state.Blueprint != tmpl.ID {
+		return nil, types.NewValidationError(fmt.Sprintf("'%s' was generated from blueprint %s, not %s", outputPath, state.Blueprint, tmpl.ID), nil)
+	}
+	return state, nil
+}
+
+// resumed reports whether a resumed generation finds path already written with
+// content, or already linked to target, so that it is left as it is
+func (g *Generator) resumed(path string, content []byte, target string) bool {
+	if g.resume == nil {
+		return false
+	}
+	if target != "" {
+		existing, err := os.Readlink(path)
+		return err == nil && existing == target
+	}
+	info, err := os.Lstat(path)
+	if err != nil || !info.Mode().IsRegular() {
+		return false
+	}
+	existing, err := os.ReadFile(path)
+	return err == nil && checksum(GeneratedFile{Content: existing}) == checksum(GeneratedFile{Content: content})
+}
+
+// ReadPartialState loads the state of an interrupted generation from projectPath
+func ReadPartialState(projectPath string) (*PartialState, error) {
+	data, err := os.ReadFile(filepath.Join(projectPath, PartialStateFile))
+	if err != nil {
+		return nil, types.NewFileSystemError("failed to read partial generation state", err)
+	}
+
+	var state PartialState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, types.NewFileSystemError("failed to parse partial generation state", err)
+	}
+	return &state, nil
+}
+
+// writePartialState records which files an interrupted generation already wrote
+func writePartialState(fsys types.OutputFS, outputPath, blueprintID string, config types.ProjectConfig, options PartialOptions, phase string, files []string, reason error) error {
+	written := make([]string, 0, len(files))
+	for _, file := range files {
+		rel, err := filepath.Rel(outputPath, file)
+		if err != nil {
+			rel = file
+		}
+		written = append(written, filepath.ToSlash(rel))
+	}
+	sort.Strings(written)
+	written = slices.Compact(written)
+
+	state := PartialState{
+		Blueprint:     blueprintID,
+		Config:        config,
+		Options:       options,
+		Phase:         phase,
+		FilesWritten:  written,
+		InterruptedAt: time.Now().UTC(),
+		Reason:        reason.Error(),
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fsys.WriteFile(filepath.Join(outputPath, PartialStateFile), append(data, '\n'), 0644)
+}
+
+// checkCancelled returns a generation error wrapping ctx.Err() once ctx is done
+func checkCancelled(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return types.NewGenerationError("generation cancelled", err)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// PlatformVariable is the blueprint variable that selects the chat platform of
+// the bot blueprint. Blueprints offer the choice by declaring it.
+const PlatformVariable = "Platform"
+
+// checkPlatform rejects a chat platform for blueprints that do not offer one
+func checkPlatform(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[PlatformVariable] == "" {
+		return nil
+	}
+
+	for _, variable := range tmpl.Variables {
+		if variable.Name == PlatformVariable {
+			return nil
+		}
+	}
+	return types.NewValidationError(fmt.Sprintf("blueprint %s does not connect to a chat platform, remove --platform", tmpl.ID), nil)
+}
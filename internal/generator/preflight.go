@@ -0,0 +1,182 @@
+package generator
+
+import (
+	"fmt"
+	"go/build"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// diskSpaceHeadroom is required on top of the estimated project size to leave
+// room for git metadata and go.sum
+const diskSpaceHeadroom = 1 << 20
+
+// availableDiskSpace reports the free bytes on the volume holding dir, or -1 when
+// the platform cannot tell. It is a variable so that tests can fake a full disk.
+var availableDiskSpace = diskFree
+
+// preflight fails fast when the project cannot or should not be written to outputPath:
+// the target is not writable, lives in the module cache, sits in a git repository
+// with uncommitted changes (unless force is set), or the disk is too full
+func (g *Generator) preflight(tmpl types.Template, outputPath string, force bool) error {
+	absPath, err := filepath.Abs(outputPath)
+	if err != nil {
+		return types.NewFileSystemError("failed to resolve output path", err)
+	}
+
+	existing, err := nearestExistingDir(absPath)
+	if err != nil {
+		return err
+	}
+
+	if cache := moduleCacheDir(); cache != "" && isWithin(existing, cache) {
+		return types.NewValidationError(fmt.Sprintf("output path '%s' is inside the Go module cache (%s), choose a directory outside of it", outputPath, cache), nil)
+	}
+
+	if err := checkWritable(existing); err != nil {
+		return err
+	}
+
+	if !force {
+		if dirty, repo := uncommittedChanges(existing); dirty {
+			return types.NewValidationError(fmt.Sprintf("output path '%s' is inside git repository '%s' which has uncommitted changes, commit or stash them first or use --force", outputPath, repo), nil)
+		}
+	}
+
+	estimate, err := g.estimateOutputSize(tmpl)
+	if err != nil {
+		return err
+	}
+	if free := availableDiskSpace(existing); free >= 0 && free < estimate+diskSpaceHeadroom {
+		return types.NewFileSystemError(fmt.Sprintf("not enough disk space in '%s': the project needs about %s, %s available", existing, formatBytes(estimate+diskSpaceHeadroom), formatBytes(free)), nil)
+	}
+
+	return nil
+}
+
+// estimateOutputSize sums the sizes of the blueprint's source files, which is close
+// to the rendered size for all but heavily looping templates
+func (g *Generator) estimateOutputSize(tmpl types.Template) (int64, error) {
+	templateDir, _ := tmpl.Metadata["path"].(string)
+
+	var total int64
+	for _, file := range tmpl.Files {
+		if file.IsSymlink() {
+			continue
+		}
+		content, err := g.loader.LoadFile(templateDir, file.Source)
+		if err != nil {
+			// Missing sources are reported by generation itself
+			continue
+		}
+		total += int64(len(content))
+	}
+	return total, nil
+}
+
+// nearestExistingDir returns path or its closest ancestor that exists
+func nearestExistingDir(path string) (string, error) {
+	for dir := path; ; dir = filepath.Dir(dir) {
+		info, err := os.Stat(dir)
+		if err == nil {
+			if !info.IsDir() {
+				return "", types.NewValidationError(fmt.Sprintf("'%s' exists but is not a directory", dir), nil)
+			}
+			if resolved, err := filepath.EvalSymlinks(dir); err == nil {
+				dir = resolved
+			}
+			return dir, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", types.NewFileSystemError("failed to check output path", err)
+		}
+		if parent := filepath.Dir(dir); parent == dir {
+			return "", types.NewFileSystemError(fmt.Sprintf("no existing parent directory for '%s'", path), err)
+		}
+	}
+}
+
+// checkWritable verifies that files can be created in dir
+func checkWritable(dir string) error {
+	probe, err := os.CreateTemp(dir, ".go-starter-preflight-*")
+	if err != nil {
+		return types.NewFileSystemError(fmt.Sprintf("directory '%s' is not writable", dir), err)
+	}
+	name := probe.Name()
+	_ = probe.Close()
+	_ = os.Remove(name)
+	return nil
+}
+
+// moduleCacheDir returns the Go module cache directory
+func moduleCacheDir() string {
+	if cache := os.Getenv("GOMODCACHE"); cache != "" {
+		return resolvePath(cache)
+	}
+	gopath := os.Getenv("GOPATH")
+	if gopath == "" {
+		gopath = build.Default.GOPATH
+	}
+	if entries := filepath.SplitList(gopath); len(entries) > 0 && entries[0] != "" {
+		return resolvePath(filepath.Join(entries[0], "pkg", "mod"))
+	}
+	return ""
+}
+
+// uncommittedChanges reports whether dir belongs to a git work tree with
+// uncommitted changes, along with the work tree root
+func uncommittedChanges(dir string) (bool, string) {
+	if _, err := exec.LookPath("git"); err != nil {
+		return false, ""
+	}
+
+	root, err := exec.Command("git", "-C", dir, "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		// Not a git repository
+		return false, ""
+	}
+
+	status, err := exec.Command("git", "-C", dir, "status", "--porcelain").Output()
+	if err != nil {
+		return false, ""
+	}
+	return len(strings.TrimSpace(string(status))) > 0, strings.TrimSpace(string(root))
+}
+
+// resolvePath makes path absolute and resolves symlinks where possible
+func resolvePath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+	return path
+}
+
+// isWithin reports whether path is parent or one of its descendants
+func isWithin(path, parent string) bool {
+	rel, err := filepath.Rel(parent, path)
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
+
+// formatBytes renders a byte count for humans
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
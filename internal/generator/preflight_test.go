@@ -0,0 +1,102 @@
+package generator
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/francknouama/go-starter/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreflight(t *testing.T) {
+	registry := setupFileModeTestTemplates(t)
+
+	g := NewWithRegistry(registry)
+	tmpl, err := g.registry.Get("modes-test")
+	require.NoError(t, err)
+
+	t.Run("writable target passes", func(t *testing.T) {
+		assert.NoError(t, g.preflight(tmpl, filepath.Join(t.TempDir(), "a", "b", "project"), false))
+	})
+
+	t.Run("module cache is rejected", func(t *testing.T) {
+		cache := t.TempDir()
+		t.Setenv("GOMODCACHE", cache)
+
+		err := g.preflight(tmpl, filepath.Join(cache, "example.com", "project"), false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "module cache")
+	})
+
+	t.Run("full disk is rejected", func(t *testing.T) {
+		availableDiskSpace = func(string) int64 { return 1024 }
+		t.Cleanup(func() { availableDiskSpace = diskFree })
+
+		err := g.preflight(tmpl, filepath.Join(t.TempDir(), "project"), false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not enough disk space")
+	})
+
+	t.Run("dirty git repository requires force", func(t *testing.T) {
+		if _, err := exec.LookPath("git"); err != nil {
+			t.Skip("git not available")
+		}
+
+		repo := t.TempDir()
+		require.NoError(t, exec.Command("git", "-C", repo, "init", "-q").Run())
+		require.NoError(t, os.WriteFile(filepath.Join(repo, "notes.txt"), []byte("wip"), 0644))
+
+		outputPath := filepath.Join(repo, "project")
+		err := g.preflight(tmpl, outputPath, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "uncommitted changes")
+
+		assert.NoError(t, g.preflight(tmpl, outputPath, true))
+	})
+}
+
+func TestEstimateOutputSize(t *testing.T) {
+	registry := setupFileModeTestTemplates(t)
+
+	g := NewWithRegistry(registry)
+	tmpl, err := g.registry.Get("modes-test")
+	require.NoError(t, err)
+
+	size, err := g.estimateOutputSize(tmpl)
+	require.NoError(t, err)
+	assert.Positive(t, size)
+}
+
+func TestGenerate_PreflightFailsBeforeWriting(t *testing.T) {
+	registry := setupFileModeTestTemplates(t)
+
+	cache := t.TempDir()
+	t.Setenv("GOMODCACHE", cache)
+
+	outputPath := filepath.Join(cache, "modes")
+	_, err := NewWithRegistry(registry).Generate(types.ProjectConfig{
+		Name:      "modes",
+		Module:    "github.com/test/modes",
+		Type:      "cli",
+		Variables: map[string]string{"blueprint_id": "modes-test"},
+	}, types.GenerationOptions{OutputPath: outputPath, NoGit: true})
+	require.Error(t, err)
+	assert.NoDirExists(t, outputPath)
+}
+
+func TestIsWithin(t *testing.T) {
+	parent := filepath.FromSlash("/home/user/go/pkg/mod")
+	assert.True(t, isWithin(parent, parent))
+	assert.True(t, isWithin(filepath.Join(parent, "example.com"), parent))
+	assert.False(t, isWithin(filepath.FromSlash("/home/user/go/pkg/modules"), parent))
+	assert.False(t, isWithin(filepath.FromSlash("/home/user"), parent))
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "512 B", formatBytes(512))
+	assert.Equal(t, "1.5 KiB", formatBytes(1536))
+	assert.Equal(t, "2.0 MiB", formatBytes(2<<20))
+}
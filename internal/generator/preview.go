@@ -0,0 +1,134 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Preview shows what would be generated without creating files
+func (g *Generator) Preview(config types.ProjectConfig, outputDir string) error {
+	return g.PreviewTo(context.Background(), os.Stdout, config, outputDir, "")
+}
+
+// PreviewTo renders the project config would generate in memory and writes its
+// file tree, with the size of each file, to w. When show is set only the rendered
+// content of that file, by path in the project, is written.
+func (g *Generator) PreviewTo(ctx context.Context, w io.Writer, config types.ProjectConfig, outputDir, show string) error {
+	files, err := g.GenerateInMemoryFiles(ctx, &config, g.getTemplateID(config))
+	if err != nil {
+		return err
+	}
+
+	if show != "" {
+		name := path.Clean(strings.TrimPrefix(filepath.ToSlash(show), config.Name+"/"))
+		file, ok := files[name]
+		if !ok {
+			return types.NewValidationError(fmt.Sprintf("%s is not generated for project '%s', the dry run without --show lists its files", show, config.Name), nil)
+		}
+		if file.Symlink != "" {
+			_, err = fmt.Fprintf(w, "%s -> %s\n", name, file.Symlink)
+		} else {
+			_, err = w.Write(file.Content)
+		}
+		return err
+	}
+
+	_, _ = fmt.Fprintf(w, "Preview for project '%s':\n", config.Name)
+	_, _ = fmt.Fprintf(w, "  Type: %s\n", config.Type)
+	_, _ = fmt.Fprintf(w, "  Module: %s\n", config.Module)
+	if config.Framework != "" {
+		_, _ = fmt.Fprintf(w, "  Framework: %s\n", config.Framework)
+	}
+	if config.Architecture != "" {
+		_, _ = fmt.Fprintf(w, "  Architecture: %s\n", config.Architecture)
+	}
+	_, _ = fmt.Fprintf(w, "  Output path: %s\n", filepath.Join(outputDir, config.Name))
+
+	_, _ = fmt.Fprintf(w, "\nFiles to be generated:\n")
+	_, _ = fmt.Fprintf(w, "%s/\n", config.Name)
+	var total int64
+	for _, file := range files {
+		total += int64(len(file.Content))
+	}
+	writeFileTree(w, files)
+	_, _ = fmt.Fprintf(w, "\n%d files, %s. Nothing was written, use --show <path> to print a file.\n", len(files), formatBytes(total))
+	return nil
+}
+
+// PreviewTree renders the project config would generate in memory and returns
+// its file tree, as the dry run writes it
+func (g *Generator) PreviewTree(ctx context.Context, config types.ProjectConfig) (string, error) {
+	files, err := g.GenerateInMemoryFiles(ctx, &config, g.getTemplateID(config))
+	if err != nil {
+		return "", err
+	}
+	var tree strings.Builder
+	_, _ = fmt.Fprintf(&tree, "%s/\n", config.Name)
+	writeFileTree(&tree, files)
+	return tree.String(), nil
+}
+
+// previewDir is a directory of the preview file tree
+type previewDir struct {
+	dirs  map[string]*previewDir
+	files map[string]GeneratedFile
+}
+
+// writeFileTree writes files as a tree, directories first, each level in name order
+func writeFileTree(w io.Writer, files map[string]GeneratedFile) {
+	root := &previewDir{dirs: map[string]*previewDir{}, files: map[string]GeneratedFile{}}
+	for name, file := range files {
+		dir := root
+		parts := strings.Split(name, "/")
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := dir.dirs[part]
+			if !ok {
+				next = &previewDir{dirs: map[string]*previewDir{}, files: map[string]GeneratedFile{}}
+				dir.dirs[part] = next
+			}
+			dir = next
+		}
+		dir.files[parts[len(parts)-1]] = file
+	}
+	root.write(w, "")
+}
+
+func (d *previewDir) write(w io.Writer, indent string) {
+	dirs := make([]string, 0, len(d.dirs))
+	for name := range d.dirs {
+		dirs = append(dirs, name)
+	}
+	sort.Strings(dirs)
+	files := make([]string, 0, len(d.files))
+	for name := range d.files {
+		files = append(files, name)
+	}
+	sort.Strings(files)
+
+	count := len(dirs) + len(files)
+	for i, name := range append(dirs, files...) {
+		branch, next := "├── ", "│   "
+		if i == count-1 {
+			branch, next = "└── ", "    "
+		}
+		if i < len(dirs) {
+			_, _ = fmt.Fprintf(w, "%s%s%s/\n", indent, branch, name)
+			d.dirs[name].write(w, indent+next)
+			continue
+		}
+		file := d.files[name]
+		if file.Symlink != "" {
+			_, _ = fmt.Fprintf(w, "%s%s%s -> %s\n", indent, branch, name, file.Symlink)
+		} else {
+			_, _ = fmt.Fprintf(w, "%s%s%s (%s)\n", indent, branch, name, formatBytes(int64(len(file.Content))))
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerator_PreviewTo(t *testing.T) {
+	registry := setupAddTestTemplates(t)
+
+	outputDir := t.TempDir()
+	config := types.ProjectConfig{
+		Name:      "svc",
+		Module:    "github.com/test/svc",
+		Type:      "web-api",
+		Variables: map[string]string{"blueprint_id": "add-test", "DatabaseDriver": "postgres"},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, NewWithRegistry(registry).PreviewTo(context.Background(), &out, config, outputDir, ""))
+	assert.Contains(t, out.String(), "Preview for project 'svc':")
+	assert.Contains(t, out.String(), "svc/\n├── internal/\n│   └── db/\n│       └── db.go (15 B)\n├── .go-starter-manifest.json (")
+	assert.Contains(t, out.String(), "└── routes.go (36 B)\n")
+	assert.Contains(t, out.String(), "\n8 files, ")
+	assert.NoDirExists(t, filepath.Join(outputDir, "svc"), "nothing is written")
+
+	out.Reset()
+	require.NoError(t, NewWithRegistry(registry).PreviewTo(context.Background(), &out, config, outputDir, "svc/routes.go"))
+	assert.Equal(t, "package main\n\n// database: postgres\n", out.String())
+
+	err := NewWithRegistry(registry).PreviewTo(context.Background(), &out, config, outputDir, "internal/cache.go")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "internal/cache.go is not generated")
+
+	entries, err := os.ReadDir(outputDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	tree, err := NewWithRegistry(registry).PreviewTree(context.Background(), config)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(tree, "svc/\n├── internal/\n│   └── db/\n│       └── db.go (15 B)\n"), tree)
+	assert.True(t, strings.HasSuffix(tree, "└── routes.go (36 B)\n"), tree)
+}
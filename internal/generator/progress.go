@@ -0,0 +1,106 @@
+package generator
+
+import (
+	"time"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// progressTracker turns generation steps into progress events. A nil tracker or a
+// tracker without a callback reports nothing.
+type progressTracker struct {
+	report     types.ProgressFunc
+	started    time.Time
+	phase      string
+	total      int
+	phaseStart time.Time
+	now        func() time.Time
+}
+
+// newProgressTracker creates a tracker reporting to fn
+func newProgressTracker(fn types.ProgressFunc) *progressTracker {
+	p := &progressTracker{report: fn, now: time.Now}
+	p.started = p.now()
+	return p
+}
+
+func (p *progressTracker) enabled() bool {
+	return p != nil && p.report != nil
+}
+
+func (p *progressTracker) emit(event types.ProgressEvent) {
+	event.Time = p.now()
+	p.report(event)
+}
+
+// start begins a phase of total steps
+func (p *progressTracker) start(phase string, total int) {
+	if p == nil {
+		return
+	}
+	p.phase = phase
+	p.total = total
+	p.phaseStart = p.now()
+	if !p.enabled() {
+		return
+	}
+	p.emit(types.ProgressEvent{Type: types.ProgressPhaseStart, Phase: phase, Total: total})
+}
+
+// currentPhase returns the phase in progress, even when nothing is reported
+func (p *progressTracker) currentPhase() string {
+	if p == nil {
+		return ""
+	}
+	return p.phase
+}
+
+// step reports that current of the phase's steps are done, the last one being item
+func (p *progressTracker) step(current int, item string) {
+	if !p.enabled() {
+		return
+	}
+	elapsed := p.now().Sub(p.phaseStart)
+	event := types.ProgressEvent{
+		Type:      types.ProgressStep,
+		Phase:     p.phase,
+		Current:   current,
+		Total:     p.total,
+		Item:      item,
+		ElapsedMS: elapsed.Milliseconds(),
+	}
+	if current > 0 && current < p.total {
+		event.ETAMS = (elapsed / time.Duration(current) * time.Duration(p.total-current)).Milliseconds()
+	}
+	p.emit(event)
+}
+
+// end finishes the current phase
+func (p *progressTracker) end() {
+	if !p.enabled() {
+		return
+	}
+	p.emit(types.ProgressEvent{
+		Type:      types.ProgressPhaseEnd,
+		Phase:     p.phase,
+		Current:   p.total,
+		Total:     p.total,
+		ElapsedMS: p.now().Sub(p.phaseStart).Milliseconds(),
+	})
+}
+
+// done reports a successful generation of files files
+func (p *progressTracker) done(files int) {
+	if !p.enabled() {
+		return
+	}
+	p.emit(types.ProgressEvent{Type: types.ProgressDone, Total: files, ElapsedMS: p.now().Sub(p.started).Milliseconds()})
+}
+
+// fail reports a failed generation
+func (p *progressTracker) fail(err error) {
+	if !p.enabled() {
+		return
+	}
+	p.emit(types.ProgressEvent{Type: types.ProgressError, Phase: p.phase, Message: err.Error(), ElapsedMS: p.now().Sub(p.started).Milliseconds()})
+}
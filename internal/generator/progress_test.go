@@ -0,0 +1,80 @@
+package generator
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/francknouama/go-starter/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressTracker_ETA(t *testing.T) {
+	var events []types.ProgressEvent
+	p := newProgressTracker(func(e types.ProgressEvent) { events = append(events, e) })
+
+	now := time.Unix(0, 0)
+	p.now = func() time.Time { return now }
+
+	p.start(types.PhaseRender, 4)
+	now = now.Add(2 * time.Second)
+	p.step(1, "a.tmpl")
+	now = now.Add(2 * time.Second)
+	p.end()
+
+	require.Len(t, events, 3)
+	assert.Equal(t, int64(2000), events[1].ElapsedMS)
+	assert.Equal(t, int64(6000), events[1].ETAMS, "three steps left at two seconds each")
+	assert.Equal(t, int64(4000), events[2].ElapsedMS)
+}
+
+func TestProgressTracker_Nil(t *testing.T) {
+	var p *progressTracker
+	assert.NotPanics(t, func() {
+		p.start(types.PhaseRender, 1)
+		p.step(1, "a")
+		p.end()
+		p.done(1)
+	})
+}
+
+func TestGenerate_Progress(t *testing.T) {
+	registry := setupFileModeTestTemplates(t)
+
+	var events []types.ProgressEvent
+	config := types.ProjectConfig{
+		Name:      "progress",
+		Module:    "github.com/test/progress",
+		Type:      "cli",
+		Variables: map[string]string{"blueprint_id": "modes-test"},
+	}
+	options := types.GenerationOptions{
+		OutputPath: filepath.Join(t.TempDir(), "progress"),
+		NoGit:      true,
+		Progress:   func(e types.ProgressEvent) { events = append(events, e) },
+	}
+
+	result, err := NewWithRegistry(registry).Generate(config, options)
+	require.NoError(t, err)
+
+	var phases []string
+	for _, e := range events {
+		if e.Type == types.ProgressPhaseStart {
+			phases = append(phases, e.Phase)
+		}
+	}
+	assert.Equal(t, []string{types.PhaseRender, types.PhaseWrite, types.PhaseTidy, types.PhaseHooks}, phases)
+
+	last := events[len(events)-1]
+	assert.Equal(t, types.ProgressDone, last.Type)
+	assert.Equal(t, len(result.FilesCreated), last.Total)
+
+	var writeSteps int
+	for _, e := range events {
+		if e.Type == types.ProgressStep && e.Phase == types.PhaseWrite {
+			writeSteps++
+		}
+	}
+	assert.Equal(t, 4, writeSteps)
+}
@@ -0,0 +1,133 @@
+package generator
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// readProjectFile reads a file of a generated project, by slash path, as a
+// GeneratedFile so that it compares with the files of a render
+func readProjectFile(projectPath, file string) (GeneratedFile, error) {
+	name := filepath.Join(projectPath, filepath.FromSlash(file))
+	info, err := os.Lstat(name)
+	if err != nil {
+		return GeneratedFile{}, err
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		target, err := os.Readlink(name)
+		return GeneratedFile{Mode: info.Mode(), Symlink: filepath.ToSlash(target)}, err
+	}
+	content, err := os.ReadFile(name)
+	return GeneratedFile{Content: content, Mode: info.Mode()}, err
+}
+
+// sameFile compares the content or symlink target of two files
+func sameFile(a, b GeneratedFile) bool {
+	return a.Symlink == b.Symlink && bytes.Equal(a.Content, b.Content)
+}
+
+// writeProjectFile writes a file, by slash path, into an existing project
+func (g *Generator) writeProjectFile(projectPath, file string, generated GeneratedFile) error {
+	name := filepath.Join(projectPath, filepath.FromSlash(file))
+	if err := g.output().MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return types.NewFileSystemError("failed to create directory", err)
+	}
+	if generated.Symlink != "" {
+		if err := g.output().Remove(name); err != nil && !os.IsNotExist(err) {
+			return types.NewFileSystemError("failed to replace symlink "+file, err)
+		}
+		if err := g.output().Symlink(generated.Symlink, name); err != nil {
+			return types.NewFileSystemError("failed to create symlink "+file, err)
+		}
+		return nil
+	}
+	if err := g.output().WriteFile(name, generated.Content, generated.Mode.Perm()); err != nil {
+		return types.NewFileSystemError("failed to write "+file, err)
+	}
+	return nil
+}
+
+// writeManifest replaces the generation manifest of a project
+func (g *Generator) writeManifest(projectPath string, manifest Manifest) error {
+	data, err := manifest.encode()
+	if err != nil {
+		return err
+	}
+	if err := g.output().WriteFile(filepath.Join(projectPath, ManifestFile), data, types.DefaultFileMode); err != nil {
+		return types.NewFileSystemError("failed to write generation manifest", err)
+	}
+	return nil
+}
+
+// mergeGoMod adds the requirements of a generated go.mod missing from the go.mod of
+// the project. The versions the project requires are kept, unless raise is set and
+// the generated go.mod requires a newer one.
+func mergeGoMod(project, generated []byte, raise bool) ([]byte, error) {
+	current, err := modfile.Parse("go.mod", project, nil)
+	if err != nil {
+		return nil, types.NewFileSystemError("failed to parse go.mod", err)
+	}
+	wanted, err := modfile.Parse("go.mod", generated, nil)
+	if err != nil {
+		return nil, types.NewGenerationError("failed to parse generated go.mod", err)
+	}
+
+	required := make(map[string]string, len(current.Require))
+	for _, r := range current.Require {
+		required[r.Mod.Path] = r.Mod.Version
+	}
+	for _, r := range wanted.Require {
+		version, ok := required[r.Mod.Path]
+		switch {
+		case !ok:
+			current.AddNewRequire(r.Mod.Path, r.Mod.Version, r.Indirect)
+		case raise && semver.Compare(r.Mod.Version, version) > 0:
+			if err := current.AddRequire(r.Mod.Path, r.Mod.Version); err != nil {
+				return nil, types.NewGenerationError("failed to update go.mod", err)
+			}
+		}
+	}
+	current.Cleanup()
+
+	merged, err := current.Format()
+	if err != nil {
+		return nil, types.NewGenerationError("failed to format go.mod", err)
+	}
+	return merged, nil
+}
+
+// UnifiedDiff returns the changes from before to after of a file as a unified diff
+// with three lines of context
+func UnifiedDiff(path string, before, after []byte) string {
+	return unifiedDiff("a/"+path, "b/"+path, before, after)
+}
+
+// unifiedDiff is UnifiedDiff with the file names of both sides, /dev/null for a
+// side that does not exist
+func unifiedDiff(from, to string, before, after []byte) string {
+	var a, b []string
+	if len(before) > 0 {
+		a = difflib.SplitLines(string(before))
+	}
+	if len(after) > 0 {
+		b = difflib.SplitLines(string(after))
+	}
+	patch, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        a,
+		B:        b,
+		FromFile: from,
+		ToFile:   to,
+		Context:  3,
+	})
+	if err != nil {
+		return ""
+	}
+	return patch
+}
@@ -0,0 +1,25 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// PubSubVariable is the blueprint variable that selects how the realtime
+// blueprint fans messages out to its instances. Blueprints offer the choice by declaring it.
+const PubSubVariable = "PubSub"
+
+// checkPubSub rejects a message fan-out for blueprints that do not offer one
+func checkPubSub(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[PubSubVariable] == "" {
+		return nil
+	}
+
+	for _, variable := range tmpl.Variables {
+		if variable.Name == PubSubVariable {
+			return nil
+		}
+	}
+	return types.NewValidationError(fmt.Sprintf("blueprint %s does not fan messages out, remove --pubsub", tmpl.ID), nil)
+}
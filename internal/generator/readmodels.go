@@ -0,0 +1,36 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// ReadModelsVariable is the blueprint variable that turns on the CQRS read models,
+// denormalized views kept up to date by projections of the domain events.
+// Blueprints offer them by declaring it.
+const ReadModelsVariable = "ReadModels"
+
+// checkReadModels rejects read models for blueprints that do not offer them,
+// and for projects without the database the views are stored in
+func checkReadModels(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[ReadModelsVariable] != "true" {
+		return nil
+	}
+
+	declared := false
+	for _, variable := range tmpl.Variables {
+		if variable.Name == ReadModelsVariable {
+			declared = true
+			break
+		}
+	}
+	if !declared {
+		return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer read models, remove --read-models", tmpl.ID), nil)
+	}
+
+	if config.Features == nil || !config.Features.Database.HasDatabase() {
+		return types.NewValidationError("read models are stored alongside the users and need a database, set --database-driver", nil)
+	}
+	return nil
+}
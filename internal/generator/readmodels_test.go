@@ -0,0 +1,65 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_ReadModels(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(readModels, driver string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:         "inventory",
+			Module:       "github.com/test/inventory",
+			Type:         "web-api",
+			Architecture: "hexagonal",
+			Framework:    "gin",
+			Logger:       "slog",
+			Variables:    map[string]string{ReadModelsVariable: readModels},
+			Features: &types.Features{
+				Database:       types.DatabaseConfig{Driver: driver, ORM: "gorm"},
+				Authentication: types.AuthConfig{Type: "jwt"},
+			},
+		}
+	}
+
+	t.Run("read models are left out by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("false", "postgres"), "web-api-hexagonal")
+		require.NoError(t, err)
+		assert.NotContains(t, files, "internal/application/projections/user_projection.go")
+		assert.NotContains(t, files, "migrations/004_create_user_views.up.sql")
+		assert.NotContains(t, string(files["internal/adapters/primary/http/gin_adapter.go"].Content), "/queries/")
+	})
+
+	t.Run("flag adds the projection, the query routes and the views migration", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("true", "postgres"), "web-api-hexagonal")
+		require.NoError(t, err)
+		require.Contains(t, files, "internal/application/projections/user_projection.go")
+		require.Contains(t, files, "internal/application/queries/user_queries.go")
+		require.Contains(t, files, "internal/adapters/secondary/persistence/user_view_repository.go")
+		require.Contains(t, files, "migrations/004_create_user_views.up.sql")
+
+		routes := string(files["internal/adapters/primary/http/gin_adapter.go"].Content)
+		assert.Contains(t, routes, `api.Group("/queries/users")`)
+		assert.Contains(t, string(files["internal/infrastructure/container/container.go"].Content), "Projection.Subscribe(ctx, c.eventPublisher)")
+	})
+
+	t.Run("read models need a database", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("true", ""), "web-api-hexagonal")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "need a database")
+	})
+
+	t.Run("blueprints without read models reject the flag", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("true", "postgres"), "grpc-service")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer read models")
+	})
+}
@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_Realtime(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(variables map[string]string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:      "chat",
+			Module:    "github.com/test/chat",
+			Type:      "realtime",
+			Logger:    "slog",
+			Variables: variables,
+		}
+	}
+
+	t.Run("single instance by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{}), "realtime")
+		require.NoError(t, err)
+
+		for _, path := range []string{
+			"internal/realtime/hub.go",
+			"internal/realtime/client.go",
+			"internal/realtime/presence.go",
+			"internal/server/api.go",
+			"internal/server/demo.html",
+		} {
+			assert.Contains(t, files, path)
+		}
+		assert.NotContains(t, files, "internal/realtime/redis.go")
+		assert.NotContains(t, files, "docker-compose.yml")
+		assert.Contains(t, string(files["cmd/server/main.go"].Content), "realtime.NewMemoryBroker()")
+		assert.NotContains(t, string(files["go.mod"].Content), "go-redis")
+		assert.Contains(t, string(files["go.mod"].Content), "go 1.22", "the routes need Go 1.22")
+	})
+
+	t.Run("redis", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{PubSubVariable: "redis"}), "realtime")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "internal/realtime/redis.go")
+		assert.Contains(t, files, "internal/realtime/redis_test.go")
+		assert.Contains(t, files, "docker-compose.yml")
+		assert.Contains(t, string(files["cmd/server/main.go"].Content), "realtime.NewRedisPresence(rdb, cfg.InstanceID)")
+		assert.Contains(t, string(files["internal/config/config.go"].Content), "REDIS_URL")
+		assert.Contains(t, string(files["go.mod"].Content), "github.com/redis/go-redis/v9")
+	})
+
+	t.Run("other blueprints reject a pubsub", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, &types.ProjectConfig{
+			Name:      "helper",
+			Module:    "github.com/test/helper",
+			Type:      "bot",
+			Logger:    "slog",
+			Variables: map[string]string{PubSubVariable: "redis"},
+		}, "bot")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "remove --pubsub")
+	})
+}
@@ -0,0 +1,39 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// RefreshTokenStoreVariable is the blueprint variable that selects where refresh tokens
+// and their revocations are stored. Blueprints offer the choice by declaring it.
+const RefreshTokenStoreVariable = "RefreshTokenStore"
+
+// checkRefreshTokenStore rejects a refresh token store for blueprints that do not offer one,
+// and a database store for projects without a database
+func checkRefreshTokenStore(tmpl types.Template, config types.ProjectConfig) error {
+	store := config.Variables[RefreshTokenStoreVariable]
+	if store == "" {
+		return nil
+	}
+
+	declared := false
+	for _, variable := range tmpl.Variables {
+		if variable.Name == RefreshTokenStoreVariable {
+			declared = true
+			break
+		}
+	}
+	if !declared {
+		return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer a refresh token store, remove --refresh-token-store", tmpl.ID), nil)
+	}
+
+	if config.Features == nil || config.Features.Authentication.Type == "" || config.Features.Authentication.Type == "none" {
+		return types.NewValidationError("refresh tokens are issued by authentication, set --auth-type", nil)
+	}
+	if store == "database" && !config.Features.Database.HasDatabase() {
+		return types.NewValidationError("the database refresh token store needs a database, set --database-driver", nil)
+	}
+	return nil
+}
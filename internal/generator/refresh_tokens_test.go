@@ -0,0 +1,80 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_RefreshTokenStore(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(store, driver, auth string) *types.ProjectConfig {
+		variables := map[string]string{}
+		if store != "" {
+			variables[RefreshTokenStoreVariable] = store
+		}
+		return &types.ProjectConfig{
+			Name:         "inventory",
+			Module:       "github.com/test/inventory",
+			Type:         "web-api",
+			Architecture: "ddd",
+			Framework:    "gin",
+			Logger:       "slog",
+			Variables:    variables,
+			Features: &types.Features{
+				Database:       types.DatabaseConfig{Driver: driver, ORM: "gorm"},
+				Authentication: types.AuthConfig{Type: auth},
+			},
+		}
+	}
+
+	t.Run("refresh tokens are stored in the database by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("", "postgres", "jwt"), "web-api-ddd")
+		require.NoError(t, err)
+		require.Contains(t, files, "internal/infrastructure/persistence/refresh_token_repository.go")
+		require.Contains(t, files, "migrations/003_create_refresh_tokens.up.sql")
+		assert.NotContains(t, files, "internal/infrastructure/persistence/refresh_token_redis_repository.go")
+		assert.NotContains(t, files, "internal/infrastructure/persistence/refresh_token_memory_repository.go")
+		assert.Contains(t, files, "tests/unit/application/refresh_token_test.go")
+		assert.Contains(t, string(files["cmd/server/main.go"].Content), "persistence.NewRefreshTokenRepository(db.DB, appLogger)")
+	})
+
+	t.Run("redis store adds the client and its dependency", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("redis", "postgres", "jwt"), "web-api-ddd")
+		require.NoError(t, err)
+		require.Contains(t, files, "internal/infrastructure/persistence/refresh_token_redis_repository.go")
+		assert.NotContains(t, files, "migrations/003_create_refresh_tokens.up.sql")
+		assert.Contains(t, string(files["go.mod"].Content), "github.com/redis/go-redis/v9")
+		assert.Contains(t, string(files["internal/infrastructure/config/config.go"].Content), `mapstructure:"redis_url"`)
+	})
+
+	t.Run("memory store needs no infrastructure", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("memory", "postgres", "jwt"), "web-api-ddd")
+		require.NoError(t, err)
+		require.Contains(t, files, "internal/infrastructure/persistence/refresh_token_memory_repository.go")
+		assert.NotContains(t, files, "internal/infrastructure/persistence/refresh_token_repository.go")
+		assert.NotContains(t, string(files["go.mod"].Content), "github.com/redis/go-redis/v9")
+	})
+
+	t.Run("refresh tokens need authentication and the database store a database", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("redis", "postgres", ""), "web-api-ddd")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "set --auth-type")
+
+		_, err = New().GenerateInMemoryFiles(ctx, config("database", "", "jwt"), "web-api-ddd")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "needs a database")
+	})
+
+	t.Run("blueprints without a refresh token store reject the flag", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("redis", "postgres", "jwt"), "grpc-service")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer a refresh token store")
+	})
+}
@@ -0,0 +1,26 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// ReleaseToolingVariable is the blueprint variable that turns on the Conventional
+// Commits linting, the git-cliff changelog and the version bump workflow. Blueprints
+// offer them by declaring it.
+const ReleaseToolingVariable = "ReleaseTooling"
+
+// checkReleaseTooling rejects the release tooling for blueprints that do not offer it,
+// so opting in never silently does nothing
+func checkReleaseTooling(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[ReleaseToolingVariable] != "true" {
+		return nil
+	}
+	for _, variable := range tmpl.Variables {
+		if variable.Name == ReleaseToolingVariable {
+			return nil
+		}
+	}
+	return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer release tooling, remove --release-tooling", tmpl.ID), nil)
+}
@@ -0,0 +1,71 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_ReleaseTooling(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(projectType, releaseTooling string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:      "toolbox",
+			Module:    "github.com/test/toolbox",
+			Type:      projectType,
+			Framework: "cobra",
+			Logger:    "slog",
+			Variables: map[string]string{ReleaseToolingVariable: releaseTooling},
+		}
+	}
+
+	t.Run("tooling is left out by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("library", "false"), "library")
+		require.NoError(t, err)
+		assert.NotContains(t, files, "cliff.toml")
+		assert.NotContains(t, files, ".github/workflows/version.yml")
+		assert.Contains(t, files, "CHANGELOG.md")
+		assert.NotContains(t, string(files["Makefile"].Content), "git-cliff")
+	})
+
+	for _, blueprint := range []string{"cli", "library"} {
+		t.Run("flag adds the tooling to "+blueprint, func(t *testing.T) {
+			files, err := New().GenerateInMemoryFiles(ctx, config(blueprint, "true"), blueprint)
+			require.NoError(t, err)
+			for _, path := range []string{
+				".commitlintrc.yml",
+				"cliff.toml",
+				".github/workflows/commitlint.yml",
+				".github/workflows/version.yml",
+			} {
+				assert.Contains(t, files, path)
+			}
+
+			assert.Contains(t, string(files["cliff.toml"].Content), "All notable changes to toolbox")
+			assert.Contains(t, string(files["cliff.toml"].Content), `## [{{ version | trim_start_matches(pat="v") }}]`)
+			workflow := string(files[".github/workflows/version.yml"].Content)
+			assert.Contains(t, workflow, "git-cliff --bumped-version")
+			assert.Contains(t, workflow, "token: ${{ secrets.RELEASE_TOKEN || secrets.GITHUB_TOKEN }}")
+			assert.Contains(t, string(files["Makefile"].Content), "git-cliff --output CHANGELOG.md")
+			assert.Contains(t, string(files["README.md"].Content), "RELEASE_TOKEN")
+		})
+	}
+
+	t.Run("the changelog is written from the history", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("library", "true"), "library")
+		require.NoError(t, err)
+		assert.NotContains(t, files, "CHANGELOG.md")
+	})
+
+	t.Run("blueprints without the tooling reject the flag", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("cli", "true"), "cli-simple")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer release tooling")
+	})
+}
@@ -0,0 +1,135 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/francknouama/go-starter/internal/templates"
+)
+
+// RenderError reports a template of a blueprint that failed to parse or execute,
+// with the line, the variable involved and a way to fix it when they can be told
+// from the error
+type RenderError struct {
+	// File is the source of the template in the blueprint
+	File string
+	// Phase is "parse" or "execute"
+	Phase      string
+	Line       int
+	Variable   string
+	Message    string
+	Suggestion string
+	Err        error
+}
+
+func (e *RenderError) Error() string {
+	return fmt.Sprintf("failed to %s template: %v", e.Phase, e.Err)
+}
+
+func (e *RenderError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	// templateErrorPattern matches "template: name:line[:col]: message"
+	templateErrorPattern = regexp.MustCompile(`(?s)^template: (.*?):(\d+)(?::\d+)?: (.*)$`)
+	// executingPattern matches the message of an execution error
+	executingPattern     = regexp.MustCompile(`(?s)^executing "[^"]*" at <(.*?)>: (.*)$`)
+	fieldPattern         = regexp.MustCompile(`\.([A-Za-z_]\w*)`)
+	missingKeyPattern    = regexp.MustCompile(`map has no entry for key "([^"]+)"`)
+	undefinedFuncPattern = regexp.MustCompile(`function "([^"]+)" not defined`)
+)
+
+// newRenderError locates the error text/template returned for the template
+// source, suggesting the closest variable of the context or function of funcs
+func newRenderError(source, phase string, err error, context map[string]any, funcs map[string]any) *RenderError {
+	renderErr := &RenderError{File: source, Phase: phase, Message: err.Error(), Err: err}
+
+	if match := templateErrorPattern.FindStringSubmatch(err.Error()); match != nil {
+		// Errors raised in an included partial are located in the partial
+		if strings.HasPrefix(match[1], templates.PartialPrefix) {
+			renderErr.File = match[1] + ".tmpl"
+		}
+		renderErr.Line, _ = strconv.Atoi(match[2])
+		renderErr.Message = match[3]
+	}
+	if match := executingPattern.FindStringSubmatch(renderErr.Message); match != nil {
+		if field := fieldPattern.FindStringSubmatch(match[1]); field != nil {
+			renderErr.Variable = field[1]
+		}
+		renderErr.Message = match[2]
+	}
+
+	switch {
+	case missingKeyPattern.MatchString(renderErr.Message):
+		renderErr.Variable = missingKeyPattern.FindStringSubmatch(renderErr.Message)[1]
+		if closest := closestName(renderErr.Variable, keys(context)); closest != "" {
+			renderErr.Suggestion = fmt.Sprintf("did you mean .%s?", closest)
+		} else {
+			renderErr.Suggestion = fmt.Sprintf("declare %s in the variables of template.yaml", renderErr.Variable)
+		}
+	case undefinedFuncPattern.MatchString(renderErr.Message):
+		name := undefinedFuncPattern.FindStringSubmatch(renderErr.Message)[1]
+		if closest := closestName(name, keys(funcs)); closest != "" {
+			renderErr.Suggestion = fmt.Sprintf("did you mean %s?", closest)
+		} else {
+			renderErr.Suggestion = "use a Sprig function, or one a plugin adds"
+		}
+	case strings.Contains(renderErr.Message, "nil pointer") && renderErr.Variable != "":
+		renderErr.Suggestion = fmt.Sprintf("%s is not set for this configuration, guard it with {{if .%s}}", renderErr.Variable, renderErr.Variable)
+	case strings.Contains(renderErr.Message, `template "`+templates.PartialPrefix) && strings.HasSuffix(renderErr.Message, "not defined"):
+		renderErr.Suggestion = "add the partial to blueprints/shared/partials or to the partials directory of the blueprint"
+	case strings.Contains(renderErr.Message, "unexpected EOF"):
+		renderErr.Suggestion = "close every {{if}}, {{range}} and {{with}} with {{end}}"
+	}
+	return renderErr
+}
+
+// closestName returns the candidate nearest to name, or "" when none is close
+// enough to be a typo of it
+func closestName(name string, candidates []string) string {
+	best, bestDistance := "", len(name)/3+1
+	for _, candidate := range candidates {
+		if strings.EqualFold(candidate, name) {
+			return candidate
+		}
+		if distance := editDistance(strings.ToLower(name), strings.ToLower(candidate)); distance <= bestDistance && (best == "" || distance < bestDistance) {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance of a and b
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
+
+// keys returns the keys of m sorted, so that suggestions are stable
+func keys[V any](m map[string]V) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRenderError(t *testing.T) {
+	context := map[string]any{"ProjectName": "demo", "Features": (*struct{ Name string })(nil)}
+	funcs := (&Generator{}).funcMap()
+
+	render := func(content, missingKey string) *RenderError {
+		t.Helper()
+		tmpl, err := template.New("main.go.tmpl").Funcs(funcs).Option(missingKey).Parse(content)
+		if err != nil {
+			return newRenderError("main.go.tmpl", "parse", err, context, funcs)
+		}
+		err = tmpl.Execute(io.Discard, context)
+		require.Error(t, err)
+		return newRenderError("main.go.tmpl", "execute", err, context, funcs)
+	}
+
+	tests := []struct {
+		name       string
+		content    string
+		missingKey string
+		want       RenderError
+	}{
+		{
+			name:       "misspelled variable",
+			content:    "package main\n\n// {{.ProjectNmae}}\n",
+			missingKey: "missingkey=error",
+			want:       RenderError{Phase: "execute", Line: 3, Variable: "ProjectNmae", Message: `map has no entry for key "ProjectNmae"`, Suggestion: "did you mean .ProjectName?"},
+		},
+		{
+			name:    "misspelled function",
+			content: "{{.ProjectName}}\n{{.ProjectName | uper}}",
+			want:    RenderError{Phase: "parse", Line: 2, Message: `function "uper" not defined`, Suggestion: "did you mean upper?"},
+		},
+		{
+			name:    "unset variable",
+			content: "{{.Features.Name}}",
+			want:    RenderError{Phase: "execute", Line: 1, Variable: "Features", Message: "nil pointer evaluating interface {}.Name", Suggestion: "Features is not set for this configuration, guard it with {{if .Features}}"},
+		},
+		{
+			name:    "unclosed action",
+			content: "{{if .ProjectName}}\nname\n",
+			want:    RenderError{Phase: "parse", Line: 3, Message: "unexpected EOF", Suggestion: "close every {{if}}, {{range}} and {{with}} with {{end}}"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			missingKey := tt.missingKey
+			if missingKey == "" {
+				missingKey = "missingkey=default"
+			}
+			renderErr := render(tt.content, missingKey)
+			assert.NotNil(t, errors.Unwrap(renderErr))
+			renderErr.Err = nil
+			tt.want.File = "main.go.tmpl"
+			assert.Equal(t, tt.want, *renderErr)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package generator
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// renderWorkers returns the number of files rendered at once: one per CPU unless
+// the generator sets another number
+func (g *Generator) renderWorkers() int {
+	if g.workers > 0 {
+		return g.workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// renderInOrder calls render for each of the n files of a generation from a pool
+// of workers, and done for each file in order once it and the files before it
+// are rendered, so that progress is reported in the order of the blueprint
+// whatever the order the files finish in. Files are handed out in order, and a
+// failure stops handing them out: the error returned is the one of the first
+// failing file, as when files are rendered one after the other.
+func (g *Generator) renderInOrder(ctx context.Context, n int, render func(i int) error, done func(i int)) error {
+	if n == 0 {
+		return nil
+	}
+	workers := min(g.renderWorkers(), n)
+
+	dispatch, stop := context.WithCancel(ctx)
+	defer stop()
+
+	errs := make([]error, n)
+	next := make(chan int)
+	finished := make(chan int)
+	go func() {
+		defer close(next)
+		for i := range n {
+			select {
+			case next <- i:
+			case <-dispatch.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				errs[i] = render(i)
+				finished <- i
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(finished)
+	}()
+
+	rendered := make([]bool, n)
+	reported := 0
+	var failed error
+	for i := range finished {
+		rendered[i] = true
+		if errs[i] != nil {
+			stop()
+		}
+		for failed == nil && reported < n && rendered[reported] {
+			if failed = errs[reported]; failed == nil {
+				done(reported)
+				reported++
+			}
+		}
+	}
+
+	if failed != nil {
+		return failed
+	}
+	if reported < n {
+		return checkCancelled(ctx)
+	}
+	return nil
+}
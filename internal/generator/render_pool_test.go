@@ -0,0 +1,121 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestRenderInOrder(t *testing.T) {
+	t.Run("files are reported in order whatever the order they finish in", func(t *testing.T) {
+		g := &Generator{workers: 4}
+		var done []int
+		err := g.renderInOrder(context.Background(), 8, func(i int) error {
+			time.Sleep(time.Duration(8-i) * time.Millisecond)
+			return nil
+		}, func(i int) { done = append(done, i) })
+		require.NoError(t, err)
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7}, done)
+	})
+
+	t.Run("the first failing file fails the rendering", func(t *testing.T) {
+		g := &Generator{workers: 4}
+		var rendered atomic.Int32
+		var done []int
+		err := g.renderInOrder(context.Background(), 100, func(i int) error {
+			rendered.Add(1)
+			switch i {
+			case 3:
+				time.Sleep(5 * time.Millisecond)
+				return errors.New("file 3")
+			case 5:
+				return errors.New("file 5")
+			}
+			return nil
+		}, func(i int) { done = append(done, i) })
+		assert.EqualError(t, err, "file 3")
+		assert.Equal(t, []int{0, 1, 2}, done)
+		assert.Less(t, int(rendered.Load()), 100, "files are no longer handed out after a failure")
+	})
+
+	t.Run("cancellation stops the rendering", func(t *testing.T) {
+		g := &Generator{workers: 2}
+		ctx, cancel := context.WithCancel(context.Background())
+		err := g.renderInOrder(ctx, 100, func(i int) error {
+			if i == 10 {
+				cancel()
+			}
+			return nil
+		}, func(int) {})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func hexagonalConfig() *types.ProjectConfig {
+	return &types.ProjectConfig{
+		Name:         "inventory",
+		Module:       "github.com/test/inventory",
+		Type:         "web-api",
+		Architecture: "hexagonal",
+		Framework:    "gin",
+		Logger:       "slog",
+		Features: &types.Features{
+			Database:       types.DatabaseConfig{Driver: "postgres", ORM: "gorm"},
+			Authentication: types.AuthConfig{Type: "jwt"},
+		},
+	}
+}
+
+func TestGenerateInMemoryFiles_ParallelMatchesSequential(t *testing.T) {
+	setupTestTemplates(t)
+
+	generate := func(workers int) map[string]GeneratedFile {
+		g := New()
+		g.workers = workers
+		files, err := g.GenerateInMemoryFiles(context.Background(), hexagonalConfig(), "web-api-hexagonal")
+		require.NoError(t, err)
+		// The manifest holds the time of the generation
+		delete(files, ManifestFile)
+		return files
+	}
+
+	sequential := generate(1)
+	require.NotEmpty(t, sequential)
+	for _, workers := range []int{2, 16} {
+		assert.Equal(t, sequential, generate(workers), fmt.Sprintf("%d workers", workers))
+	}
+}
+
+// BenchmarkGenerateInMemoryFiles_WebAPIHexagonal compares rendering the files of a
+// large blueprint one after the other and with a worker per CPU:
+//
+//	go test ./internal/generator -run '^$' -bench WebAPIHexagonal
+func BenchmarkGenerateInMemoryFiles_WebAPIHexagonal(b *testing.B) {
+	setupTestTemplates(b)
+
+	for _, bench := range []struct {
+		name    string
+		workers int
+	}{
+		{"sequential", 1},
+		{"parallel", 0},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			g := New()
+			g.workers = bench.workers
+			for b.Loop() {
+				if _, err := g.GenerateInMemoryFiles(context.Background(), hexagonalConfig(), "web-api-hexagonal"); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/outputfs"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateContext_Resume(t *testing.T) {
+	registry := setupFileModeTestTemplates(t)
+
+	config := types.ProjectConfig{
+		Name:      "modes",
+		Module:    "github.com/test/modes",
+		Type:      "cli",
+		Variables: map[string]string{"blueprint_id": "modes-test"},
+	}
+	outputPath := filepath.Join(t.TempDir(), "modes")
+
+	// Interrupt the generation once its first file is written
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err := NewWithRegistry(registry).GenerateContext(ctx, config, types.GenerationOptions{
+		OutputPath:  outputPath,
+		NoGit:       true,
+		KeepPartial: true,
+		Formatters:  []string{"gofumpt"},
+		Progress: func(event types.ProgressEvent) {
+			if event.Type == types.ProgressStep && event.Phase == types.PhaseWrite {
+				cancel()
+			}
+		},
+	})
+	require.Error(t, err)
+
+	state, err := ReadPartialState(outputPath)
+	require.NoError(t, err)
+	assert.Equal(t, PartialOptions{NoGit: true, Formatters: []string{"gofumpt"}}, state.Options)
+	require.Len(t, state.FilesWritten, 1)
+	kept := filepath.Join(outputPath, state.FilesWritten[0])
+
+	options := state.GenerationOptions(outputPath)
+	assert.True(t, options.Resume)
+	assert.True(t, options.NoGit)
+	options.Formatters = nil
+	var rewritten []string
+	options.Progress = func(event types.ProgressEvent) {
+		if event.Type == types.ProgressStep && event.Phase == types.PhaseWrite {
+			rewritten = append(rewritten, event.Item)
+		}
+	}
+
+	result, err := NewWithRegistry(registry).GenerateContext(context.Background(), state.Config, options)
+	require.NoError(t, err)
+	assert.Equal(t, []string{kept}, result.Resumed, "files written with the rendered content are kept")
+	assert.Contains(t, result.FilesCreated, kept)
+	assert.NoFileExists(t, filepath.Join(outputPath, PartialStateFile), "the checkpoint is removed once the project is complete")
+	assert.FileExists(t, filepath.Join(outputPath, ManifestFile))
+	assert.FileExists(t, filepath.Join(outputPath, "secrets.env"))
+	target, err := os.Readlink(filepath.Join(outputPath, "bin", "dev"))
+	require.NoError(t, err)
+	assert.Equal(t, "../scripts/dev.sh", target)
+	assert.NotEmpty(t, rewritten)
+
+	_, err = NewWithRegistry(registry).GenerateContext(context.Background(), state.Config, options)
+	assert.ErrorContains(t, err, "holds no interrupted generation to resume")
+}
+
+func TestGenerateContext_ResumeRewritesChangedFiles(t *testing.T) {
+	registry := setupFileModeTestTemplates(t)
+
+	config := types.ProjectConfig{
+		Name:      "modes",
+		Module:    "github.com/test/modes",
+		Type:      "cli",
+		Variables: map[string]string{"blueprint_id": "modes-test"},
+	}
+	outputPath := filepath.Join(t.TempDir(), "modes")
+	_, err := NewWithRegistry(registry).Generate(config, types.GenerationOptions{OutputPath: outputPath, NoGit: true})
+	require.NoError(t, err)
+
+	// A generation interrupted after writing a truncated README and a stray link
+	readme := filepath.Join(outputPath, "README.md")
+	rendered, err := os.ReadFile(readme)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(readme, rendered[:len(rendered)/2], 0644))
+	link := filepath.Join(outputPath, "bin", "dev")
+	require.NoError(t, os.Remove(link))
+	require.NoError(t, os.Symlink("elsewhere", link))
+	require.NoError(t, os.Remove(filepath.Join(outputPath, ManifestFile)))
+	require.NoError(t, writePartialState(outputfs.Local{}, outputPath, "modes-test", config, PartialOptions{NoGit: true}, types.PhaseWrite, []string{readme, link}, context.Canceled))
+
+	state, err := ReadPartialState(outputPath)
+	require.NoError(t, err)
+	result, err := NewWithRegistry(registry).Generate(state.Config, state.GenerationOptions(outputPath))
+	require.NoError(t, err)
+
+	assert.NotContains(t, result.Resumed, readme)
+	assert.NotContains(t, result.Resumed, link)
+	content, err := os.ReadFile(readme)
+	require.NoError(t, err)
+	assert.Equal(t, rendered, content)
+	target, err := os.Readlink(link)
+	require.NoError(t, err)
+	assert.Equal(t, "../scripts/dev.sh", target)
+}
+
+func TestGenerateContext_ResumeFailsAgain(t *testing.T) {
+	registry := setupFailingTemplates(t)
+	t.Setenv("GOPROXY", "off")
+
+	config := types.ProjectConfig{
+		Name:      "failing",
+		Module:    "github.com/test/failing",
+		Type:      "cli",
+		Variables: map[string]string{"blueprint_id": "failing-test"},
+	}
+	outputPath := filepath.Join(t.TempDir(), "failing")
+	_, err := NewWithRegistry(registry).Generate(config, types.GenerationOptions{OutputPath: outputPath, NoGit: true, KeepPartial: true})
+	require.Error(t, err)
+	state, err := ReadPartialState(outputPath)
+	require.NoError(t, err)
+	assert.Equal(t, types.PhaseTidy, state.Phase)
+
+	result, err := NewWithRegistry(registry).Generate(state.Config, state.GenerationOptions(outputPath))
+	require.Error(t, err, "the dependency still cannot be resolved")
+	assert.Nil(t, result.Resumed)
+
+	again, err := ReadPartialState(outputPath)
+	require.NoError(t, err, "a resumed generation failing again keeps its checkpoint")
+	assert.Equal(t, types.PhaseTidy, again.Phase)
+	assert.Contains(t, again.FilesWritten, "README.md")
+
+	require.NoError(t, writePartialState(outputfs.Local{}, outputPath, "cli", config, PartialOptions{}, types.PhaseTidy, nil, context.Canceled))
+	_, err = NewWithRegistry(registry).Generate(config, state.GenerationOptions(outputPath))
+	assert.ErrorContains(t, err, "was generated from blueprint cli, not failing-test")
+}
+
+func TestPartialState_Completed(t *testing.T) {
+	var none *PartialState
+	assert.False(t, none.completed(types.PhasePreHooks))
+
+	state := &PartialState{Phase: types.PhaseTidy}
+	assert.True(t, state.completed(types.PhasePreHooks), "pre-generation hooks are not run again")
+	assert.True(t, state.completed(types.PhaseWrite))
+	assert.False(t, state.completed(types.PhaseTidy))
+	assert.Equal(t, types.PhaseTidy, state.furthestPhase(types.PhaseRender), "a resumed generation failing early keeps the progress of the first")
+	assert.Equal(t, types.PhaseHooks, state.furthestPhase(types.PhaseHooks))
+}
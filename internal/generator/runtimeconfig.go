@@ -0,0 +1,26 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// RuntimeConfigVariable is the blueprint variable that turns on the ops endpoint
+// adjusting the log level and the feature flags of a running service, with an
+// audit log of the changes. Blueprints offer it by declaring it.
+const RuntimeConfigVariable = "RuntimeConfig"
+
+// checkRuntimeConfig rejects the runtime config endpoint for blueprints that do not offer it
+func checkRuntimeConfig(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[RuntimeConfigVariable] != "true" {
+		return nil
+	}
+
+	for _, variable := range tmpl.Variables {
+		if variable.Name == RuntimeConfigVariable {
+			return nil
+		}
+	}
+	return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer a runtime config endpoint, remove --runtime-config", tmpl.ID), nil)
+}
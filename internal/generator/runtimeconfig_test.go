@@ -0,0 +1,58 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_RuntimeConfig(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(variables map[string]string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:      "order-api",
+			Module:    "github.com/test/order-api",
+			Type:      "web-api",
+			Framework: "gin",
+			Logger:    "zap",
+			Variables: variables,
+			Features:  &types.Features{},
+		}
+	}
+
+	t.Run("the endpoint is left out by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{}), "web-api")
+		require.NoError(t, err)
+		assert.NotContains(t, files, "internal/ops/ops.go")
+		assert.NotContains(t, files, "internal/flags/flags.go")
+		assert.NotContains(t, string(files["cmd/server/main.go"].Content), "ops.Path")
+		assert.NotContains(t, string(files["internal/config/config.go"].Content), "OpsConfig")
+	})
+
+	t.Run("the endpoint is mounted with the configured token and flags", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{RuntimeConfigVariable: "true"}), "web-api")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "internal/ops/ops_test.go")
+		assert.Contains(t, string(files["internal/ops/ops.go"].Content), `"github.com/test/order-api/internal/flags"`)
+		assert.Contains(t, string(files["internal/flags/flags.go"].Content), "type Provider interface")
+		main := string(files["cmd/server/main.go"].Content)
+		assert.Contains(t, main, "featureFlags := flags.NewMemory(cfg.Ops.Flags)")
+		assert.Contains(t, main, "router.Any(ops.Path, gin.WrapH(opsHandler))")
+		assert.Contains(t, string(files["internal/config/config.go"].Content), "Ops         OpsConfig")
+		assert.Contains(t, string(files["configs/config.prod.yaml"].Content), "ORDER_API_OPS_TOKEN")
+		assert.Contains(t, string(files["internal/logger/logger.go"].Content), "zap.NewAtomicLevelAt")
+	})
+
+	t.Run("blueprints without the endpoint reject the flag", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{RuntimeConfigVariable: "true"}), "grpc-service")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer a runtime config endpoint")
+	})
+}
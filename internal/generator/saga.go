@@ -0,0 +1,30 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// SagaVariable is the blueprint variable that selects the role of an event service in
+// a saga: the orchestrator running the workflow or a participant answering its commands.
+// Blueprints offer the choice by declaring it.
+const SagaVariable = "Saga"
+
+// checkSaga rejects a saga role for blueprints that do not offer one, and for event
+// services with typed event schemas, which the saga example does not handle yet
+func checkSaga(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[SagaVariable] == "" {
+		return nil
+	}
+
+	for _, variable := range tmpl.Variables {
+		if variable.Name == SagaVariable {
+			if config.Variables[SchemaFormatVariable] != "" {
+				return types.NewValidationError("--saga cannot be combined with --schema-format", nil)
+			}
+			return nil
+		}
+	}
+	return types.NewValidationError(fmt.Sprintf("blueprint %s does not run sagas, remove --saga", tmpl.ID), nil)
+}
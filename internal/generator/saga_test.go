@@ -0,0 +1,94 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_Saga(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(name string, variables map[string]string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:      name,
+			Module:    "github.com/test/" + name,
+			Type:      "event-service",
+			Logger:    "slog",
+			Variables: variables,
+		}
+	}
+
+	t.Run("no saga by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("orders", map[string]string{}), "event-service")
+		require.NoError(t, err)
+
+		assert.NotContains(t, files, "internal/saga/protocol.go")
+		assert.NotContains(t, files, "internal/outbox/outbox.go")
+		assert.NotContains(t, string(files["go.mod"].Content), "github.com/lib/pq")
+	})
+
+	t.Run("orchestrator", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("orders", map[string]string{"Broker": "nats", SagaVariable: "orchestrator"}), "event-service")
+		require.NoError(t, err)
+
+		for _, path := range []string{
+			"internal/saga/protocol.go",
+			"internal/saga/orchestrator.go",
+			"internal/saga/orchestrator_test.go",
+			"internal/saga/postgres.go",
+			"internal/saga/store_test.go",
+			"internal/outbox/outbox.go",
+			"internal/outbox/outbox_test.go",
+		} {
+			assert.Contains(t, files, path)
+		}
+		assert.NotContains(t, files, "internal/handler/payments.go")
+
+		assert.Contains(t, string(files["internal/handler/orders.go"].Content), "func NewOrderSaga(")
+		main := string(files["cmd/service/main.go"].Content)
+		assert.Contains(t, main, "consumer.HandlerFunc(orchestrator.HandleReply)")
+		assert.Contains(t, main, "outbox.NewRelay(store, publisher, cfg.OutboxInterval, log)")
+		assert.Contains(t, string(files["internal/config/config.go"].Content), "return []string{c.InputTopic, c.OutputTopic, c.DLQTopic, c.SagaReplyTopic}")
+		assert.Contains(t, string(files["docker-compose.yml"].Content), "postgres:16-alpine")
+		assert.Contains(t, string(files["go.mod"].Content), "github.com/lib/pq v1.10.9")
+	})
+
+	t.Run("participant", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("payments", map[string]string{SagaVariable: "participant"}), "event-service")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "internal/saga/protocol.go")
+		assert.Contains(t, files, "internal/handler/payments_test.go")
+		assert.NotContains(t, files, "internal/saga/orchestrator.go")
+		assert.NotContains(t, files, "internal/outbox/outbox.go")
+		assert.NotContains(t, files, "internal/handler/orders.go")
+
+		assert.Contains(t, string(files["cmd/service/main.go"].Content), "handler.NewPayments(publisher, cfg.PaymentLimit, log)")
+		assert.Contains(t, string(files["internal/config/config.go"].Content), `getEnv("INPUT_TOPIC", "payments.commands")`)
+		assert.NotContains(t, string(files["go.mod"].Content), "github.com/lib/pq")
+	})
+
+	t.Run("rejected with a schema format", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("orders", map[string]string{SagaVariable: "orchestrator", SchemaFormatVariable: "avro"}), "event-service")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--saga cannot be combined with --schema-format")
+	})
+
+	t.Run("other blueprints reject a saga role", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, &types.ProjectConfig{
+			Name:      "chat",
+			Module:    "github.com/test/chat",
+			Type:      "realtime",
+			Logger:    "slog",
+			Variables: map[string]string{SagaVariable: "orchestrator"},
+		}, "realtime")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "remove --saga")
+	})
+}
@@ -0,0 +1,188 @@
+package generator
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/francknouama/go-starter/internal/utils"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// A spec is a project selection written as the flags of go-starter new, which the
+// CLI and the web UI exchange as the query of a link: keys are flag names, switches
+// are "true" and experimental features are comma-separated. The CLI opens the web
+// UI on the spec of its flags, the web UI turns its form back into a command line.
+
+// specFields are the flags setting the configuration fields, in command line order
+var specFields = []string{"name", "module", "type", "architecture", "go-version", "framework", "logger", "database-driver", "database-orm", "auth-type"}
+
+// specExperimental is the flag listing the experimental features
+const specExperimental = "experimental"
+
+// specVariable returns the blueprint variable a flag sets, for the flags that are
+// not a configuration field
+func specVariable(flag string) (string, bool) {
+	if slices.Contains(specFields, flag) {
+		return "", false
+	}
+	for name, variableFlag := range optionFlags {
+		if variableFlag == flag {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// IsSpecFlag reports whether a flag of go-starter new is part of a spec
+func IsSpecFlag(flag string) bool {
+	_, variable := specVariable(flag)
+	return variable || flag == specExperimental || slices.Contains(specFields, flag)
+}
+
+// ParseSpec reads the configuration a spec selects. Like repeated flags, the last
+// value of a key wins.
+func ParseSpec(values url.Values) (types.ProjectConfig, error) {
+	config := types.ProjectConfig{Features: &types.Features{}, Variables: map[string]string{}}
+	for flag, list := range values {
+		value := strings.TrimSpace(list[len(list)-1])
+		switch flag {
+		case "name":
+			config.Name = value
+		case "module":
+			config.Module = value
+		case "type":
+			config.Type = value
+		case "architecture":
+			config.Architecture = value
+		case "go-version":
+			config.GoVersion = value
+		case "framework":
+			config.Framework = value
+		case "logger":
+			config.Logger = value
+		case "database-driver":
+			config.Features.Database.Driver = value
+		case "database-orm":
+			config.Features.Database.ORM = value
+		case "auth-type":
+			config.Features.Authentication.Type = value
+		case specExperimental:
+			for _, feature := range strings.Split(value, ",") {
+				if feature = strings.TrimSpace(feature); feature != "" {
+					config.Experimental = append(config.Experimental, feature)
+				}
+			}
+		default:
+			name, ok := specVariable(flag)
+			if !ok {
+				return config, fmt.Errorf("unknown option %q", flag)
+			}
+			if switchOptions[name] {
+				on, err := strconv.ParseBool(value)
+				if err != nil {
+					return config, fmt.Errorf("option %q is a switch, got %q", flag, value)
+				}
+				if !on {
+					continue
+				}
+				value = "true"
+			}
+			if value != "" {
+				config.Variables[name] = value
+			}
+		}
+	}
+	return config, nil
+}
+
+// EncodeSpec writes the spec of a configuration. It also returns the variables that
+// have no flag on go-starter new, which a spec cannot carry.
+func EncodeSpec(config types.ProjectConfig) (url.Values, []string) {
+	values := url.Values{}
+	set := func(flag, value string) {
+		if value != "" && values.Get(flag) == "" {
+			values.Set(flag, value)
+		}
+	}
+
+	set("name", config.Name)
+	set("module", config.Module)
+	set("type", config.Type)
+	set("architecture", config.Architecture)
+	set("go-version", config.GoVersion)
+	set("framework", config.Framework)
+	set("logger", config.Logger)
+	if config.Features != nil {
+		set("database-driver", config.Features.Database.PrimaryDriver())
+		set("database-orm", config.Features.Database.ORM)
+		set("auth-type", config.Features.Authentication.Type)
+	}
+	set(specExperimental, strings.Join(config.Experimental, ","))
+
+	var omitted []string
+	for _, name := range slices.Sorted(maps.Keys(config.Variables)) {
+		value := config.Variables[name]
+		flag, ok := optionFlags[name]
+		switch {
+		case value == "" || (switchOptions[name] && value != "true"):
+		case !ok:
+			omitted = append(omitted, name)
+		default:
+			set(flag, value)
+		}
+	}
+	return values, omitted
+}
+
+// SpecCommand writes the go-starter new command line of a configuration, with the
+// variables a spec cannot carry
+func SpecCommand(config types.ProjectConfig) (string, []string) {
+	values, omitted := EncodeSpec(config)
+
+	args := []string{"go-starter", "new"}
+	if name := values.Get("name"); name != "" {
+		args = append(args, utils.ShellQuote(name))
+	}
+	for _, flag := range specOrder(values) {
+		if flag == "name" {
+			continue
+		}
+		if name, ok := specVariable(flag); ok && switchOptions[name] {
+			args = append(args, "--"+flag)
+			continue
+		}
+		args = append(args, "--"+flag+"="+utils.ShellQuote(values.Get(flag)))
+	}
+	return strings.Join(args, " "), omitted
+}
+
+// SpecURL returns the link opening the web UI served at base on a configuration,
+// with the variables a spec cannot carry
+func SpecURL(base string, config types.ProjectConfig) (string, []string) {
+	values, omitted := EncodeSpec(config)
+	link := strings.TrimSuffix(base, "/") + "/"
+	if len(values) > 0 {
+		link += "?" + values.Encode()
+	}
+	return link, omitted
+}
+
+// specOrder lists the flags of a spec, the configuration fields first
+func specOrder(values url.Values) []string {
+	var flags []string
+	for _, flag := range specFields {
+		if values.Get(flag) != "" {
+			flags = append(flags, flag)
+		}
+	}
+	for _, flag := range slices.Sorted(maps.Keys(values)) {
+		if !slices.Contains(specFields, flag) {
+			flags = append(flags, flag)
+		}
+	}
+	return flags
+}
@@ -0,0 +1,71 @@
+package generator
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestParseSpec(t *testing.T) {
+	values, err := url.ParseQuery("name=shop&type=web-api&architecture=hexagonal&database-driver=postgres&auth-type=jwt&admin-endpoints=true&read-models=false&broker=nats&experimental=framework.fuego,+x&logger=zap&logger=slog")
+	require.NoError(t, err)
+
+	config, err := ParseSpec(values)
+	require.NoError(t, err)
+	assert.Equal(t, "shop", config.Name)
+	assert.Equal(t, "web-api", config.Type)
+	assert.Equal(t, "hexagonal", config.Architecture)
+	assert.Equal(t, "slog", config.Logger, "the last value wins")
+	assert.Equal(t, "postgres", config.Features.Database.Driver)
+	assert.Equal(t, "jwt", config.Features.Authentication.Type)
+	assert.Equal(t, []string{"framework.fuego", "x"}, config.Experimental)
+	assert.Equal(t, map[string]string{AdminEndpointsVariable: "true", "Broker": "nats"}, config.Variables)
+
+	_, err = ParseSpec(url.Values{"output": {"/tmp"}})
+	assert.EqualError(t, err, `unknown option "output"`)
+
+	_, err = ParseSpec(url.Values{"e2e": {"maybe"}})
+	assert.EqualError(t, err, `option "e2e" is a switch, got "maybe"`)
+}
+
+func TestSpecCommand(t *testing.T) {
+	config := types.ProjectConfig{
+		Name:         "shop",
+		Module:       "github.com/acme/shop",
+		Type:         "web-api",
+		Architecture: "clean",
+		Features:     &types.Features{Database: types.DatabaseConfig{Driver: "postgres"}},
+		Variables: map[string]string{
+			DataPrivacyVariable:    "true",
+			BenchmarksVariable:     "false",
+			TelemetryVariable:      "https://example.com/ping?id=1&x='y'",
+			"TemplateEngine":       "templ",
+			"DatabaseDriver":       "mysql",
+			LeaderElectionVariable: "",
+		},
+		Experimental: []string{"framework.fuego"},
+	}
+
+	command, omitted := SpecCommand(config)
+	assert.Equal(t, "go-starter new shop --module=github.com/acme/shop --type=web-api --architecture=clean --database-driver=postgres --data-privacy --experimental=framework.fuego --telemetry-endpoint='https://example.com/ping?id=1&x='\\''y'\\'''", command)
+	assert.Equal(t, []string{"TemplateEngine"}, omitted)
+
+	// The link carries the same selection back
+	link, _ := SpecURL("http://localhost:8080/", config)
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	assert.Equal(t, "/", parsed.Path)
+	back, err := ParseSpec(parsed.Query())
+	require.NoError(t, err)
+	assert.Equal(t, "clean", back.Architecture)
+	assert.Equal(t, "postgres", back.Features.Database.Driver)
+	assert.Equal(t, "true", back.Variables[DataPrivacyVariable])
+	assert.Equal(t, config.Variables[TelemetryVariable], back.Variables[TelemetryVariable])
+
+	link, _ = SpecURL("https://go-starter.dev", types.ProjectConfig{})
+	assert.Equal(t, "https://go-starter.dev/", link)
+}
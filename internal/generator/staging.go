@@ -0,0 +1,71 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// newStagingDir creates the hidden directory a local project is generated in
+// before it is moved to outputPath. It is created next to outputPath, on the same
+// file system, so that moving it is a rename.
+func newStagingDir(outputPath string) (string, error) {
+	parent := filepath.Dir(outputPath)
+	if err := os.MkdirAll(parent, 0755); err != nil {
+		return "", types.NewFileSystemError("failed to create output directory", err)
+	}
+	staging, err := os.MkdirTemp(parent, "."+filepath.Base(outputPath)+".go-starter-*")
+	if err != nil {
+		return "", types.NewFileSystemError("failed to create staging directory", err)
+	}
+	return staging, nil
+}
+
+// publishStaging moves the project generated in staging to outputPath. A
+// missing output directory is replaced in a single rename; the entries of an
+// existing empty one, which keeps its permissions and may be a mount point,
+// are moved into it.
+func publishStaging(staging, outputPath string) error {
+	if _, err := os.Stat(outputPath); os.IsNotExist(err) {
+		// MkdirTemp creates the directory for its owner only
+		if err := os.Chmod(staging, 0755); err != nil {
+			return types.NewFileSystemError("failed to publish generated project", err)
+		}
+		if err := os.Rename(staging, outputPath); err != nil {
+			return types.NewFileSystemError("failed to publish generated project", err)
+		}
+		return nil
+	}
+
+	entries, err := os.ReadDir(staging)
+	if err != nil {
+		return types.NewFileSystemError("failed to publish generated project", err)
+	}
+	for i, entry := range entries {
+		if err := os.Rename(filepath.Join(staging, entry.Name()), filepath.Join(outputPath, entry.Name())); err != nil {
+			// Move back what was moved, for the rollback to find it
+			for _, moved := range entries[:i] {
+				_ = os.Rename(filepath.Join(outputPath, moved.Name()), filepath.Join(staging, moved.Name()))
+			}
+			return types.NewFileSystemError("failed to publish generated project", err)
+		}
+	}
+	if err := os.Remove(staging); err != nil {
+		return types.NewFileSystemError("failed to publish generated project", err)
+	}
+	return nil
+}
+
+// rebase replaces the staging prefix of the generated paths with outputPath
+func rebase(paths []string, staging, outputPath string) []string {
+	rebased := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if rel, err := filepath.Rel(staging, path); err == nil && !strings.HasPrefix(rel, "..") {
+			path = filepath.Join(outputPath, rel)
+		}
+		rebased = append(rebased, path)
+	}
+	return rebased
+}
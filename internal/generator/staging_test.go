@@ -0,0 +1,130 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate_Staging(t *testing.T) {
+	registry := setupFileModeTestTemplates(t)
+
+	config := types.ProjectConfig{
+		Name:      "modes",
+		Module:    "github.com/test/modes",
+		Type:      "cli",
+		Variables: map[string]string{"blueprint_id": "modes-test"},
+	}
+
+	t.Run("output appears once complete", func(t *testing.T) {
+		parent := t.TempDir()
+		outputPath := filepath.Join(parent, "modes")
+		var staged []os.DirEntry
+		result, err := NewWithRegistry(registry).Generate(config, types.GenerationOptions{
+			OutputPath: outputPath,
+			NoGit:      true,
+			Progress: func(event types.ProgressEvent) {
+				if event.Type == types.ProgressStep && event.Phase == types.PhaseWrite {
+					assert.NoDirExists(t, outputPath)
+					staged, _ = os.ReadDir(parent)
+				}
+			},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, staged, 1)
+		assert.Regexp(t, `^\.modes\.go-starter-`, staged[0].Name())
+		entries, err := os.ReadDir(parent)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "modes", entries[0].Name())
+
+		info, err := os.Stat(outputPath)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+		assert.Contains(t, result.FilesCreated, filepath.Join(outputPath, "README.md"))
+		target, err := os.Readlink(filepath.Join(outputPath, "bin", "dev"))
+		require.NoError(t, err)
+		assert.Equal(t, "../scripts/dev.sh", target)
+	})
+
+	t.Run("existing empty directory is filled", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "modes")
+		require.NoError(t, os.Mkdir(outputPath, 0700))
+
+		_, err := NewWithRegistry(registry).Generate(config, types.GenerationOptions{OutputPath: outputPath, NoGit: true})
+		require.NoError(t, err)
+
+		info, err := os.Stat(outputPath)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0700), info.Mode().Perm())
+		assert.FileExists(t, filepath.Join(outputPath, "README.md"))
+		assert.FileExists(t, filepath.Join(outputPath, ManifestFile))
+	})
+}
+
+// setupFailingTemplates returns a registry of a blueprint whose generation fails resolving
+// its dependency once the files are written
+func setupFailingTemplates(t *testing.T) *templates.Registry {
+	t.Helper()
+
+	return newTestRegistry(t, fstest.MapFS{
+		"failing-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "failing-test"
+name: "failing-test"
+type: "cli"
+architecture: "standard"
+files:
+  - source: "README.md.tmpl"
+    destination: "README.md"
+dependencies:
+  - module: "example.com/does-not-exist"
+    version: "v0.0.0"
+`)},
+		"failing-test/README.md.tmpl": &fstest.MapFile{Data: []byte("# {{.ProjectName}}\n")},
+	})
+}
+
+func TestGenerate_FailureRollsBack(t *testing.T) {
+	registry := setupFailingTemplates(t)
+
+	config := types.ProjectConfig{
+		Name:      "failing",
+		Module:    "github.com/test/failing",
+		Type:      "cli",
+		Variables: map[string]string{"blueprint_id": "failing-test"},
+	}
+	t.Setenv("GOPROXY", "off")
+
+	t.Run("nothing is left behind", func(t *testing.T) {
+		parent := t.TempDir()
+		_, err := NewWithRegistry(registry).Generate(config, types.GenerationOptions{OutputPath: filepath.Join(parent, "failing"), NoGit: true})
+		require.Error(t, err)
+
+		entries, err := os.ReadDir(parent)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("keep partial moves the output in place", func(t *testing.T) {
+		parent := t.TempDir()
+		outputPath := filepath.Join(parent, "failing")
+		_, err := NewWithRegistry(registry).Generate(config, types.GenerationOptions{OutputPath: outputPath, NoGit: true, KeepPartial: true})
+		require.Error(t, err)
+
+		entries, err := os.ReadDir(parent)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		state, err := ReadPartialState(outputPath)
+		require.NoError(t, err)
+		assert.Equal(t, "failing-test", state.Blueprint)
+		assert.Contains(t, state.FilesWritten, "README.md")
+		assert.FileExists(t, filepath.Join(outputPath, "README.md"))
+	})
+}
@@ -0,0 +1,461 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Variable issue severities reported by the template analyzer
+const (
+	IssueUndefinedVariable = "undefined"
+	IssueUnusedVariable    = "unused"
+	IssueInvalidTemplate   = "invalid"
+	IssueInvalidCondition  = "condition"
+)
+
+// VariableIssue describes a mismatch between the variables a blueprint declares
+// and the variables its templates reference
+type VariableIssue struct {
+	Kind     string `json:"kind"`
+	Variable string `json:"variable,omitempty"`
+	File     string `json:"file,omitempty"`
+	Line     int    `json:"line,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+func (i VariableIssue) String() string {
+	switch {
+	case i.Kind == IssueInvalidTemplate, i.Kind == IssueInvalidCondition:
+		return fmt.Sprintf("%s: %s", i.File, i.Message)
+	case i.Kind == IssueUnusedVariable:
+		return fmt.Sprintf("variable %q is declared but never used", i.Variable)
+	case i.Line > 0:
+		return fmt.Sprintf("%s:%d: undefined variable %q", i.File, i.Line, i.Variable)
+	default:
+		return fmt.Sprintf("%s: undefined variable %q", i.File, i.Variable)
+	}
+}
+
+// AnalyzeTemplateVariables statically cross-checks every variable referenced by the
+// blueprint's files, paths and conditions against the declared blueprint variables
+// and the built-in generation context
+func (g *Generator) AnalyzeTemplateVariables(tmpl types.Template) ([]VariableIssue, error) {
+	templateDir, _ := tmpl.Metadata["path"].(string)
+
+	known := g.builtinContextKeys(tmpl)
+	funcs := g.funcMap()
+	declared := make(map[string]bool, len(tmpl.Variables))
+	for _, variable := range tmpl.Variables {
+		declared[variable.Name] = true
+		known[variable.Name] = true
+	}
+	for _, derived := range tmpl.Derived {
+		known[derived.Name] = true
+	}
+
+	used := make(map[string]bool)
+	var issues []VariableIssue
+
+	check := func(file string, refs []variableRef) {
+		for _, ref := range refs {
+			used[ref.name] = true
+			if !known[ref.name] {
+				issues = append(issues, VariableIssue{Kind: IssueUndefinedVariable, Variable: ref.name, File: file, Line: ref.line})
+			}
+		}
+	}
+
+	var includes []partialRef
+	seenSources := make(map[string]bool)
+	for _, file := range tmpl.Files {
+		refs, err := conditionVariableRefs(file.Condition, funcs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze %q: %w", file.Condition, err)
+		}
+		check("template.yaml", refs)
+		for _, expr := range []string{file.Destination, file.Symlink} {
+			refs, err := templateVariableRefs("template.yaml", expr, funcs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to analyze %q: %w", expr, err)
+			}
+			check("template.yaml", refs)
+		}
+
+		if seenSources[file.Source] {
+			continue
+		}
+		seenSources[file.Source] = true
+
+		content, err := g.loader.LoadFile(templateDir, file.Source)
+		if err != nil {
+			// Missing sources are reported by generation itself
+			continue
+		}
+		if isBinaryAsset(file, content) {
+			continue
+		}
+		refs, partials, err := templateRefs(file.Source, string(content), funcs)
+		if err != nil {
+			issues = append(issues, VariableIssue{Kind: IssueInvalidTemplate, File: file.Source, Message: err.Error()})
+			continue
+		}
+		check(file.Source, refs)
+		includes = append(includes, partials...)
+	}
+
+	// Partials included with the root context are checked as the files including them
+	if len(includes) > 0 {
+		partials, err := g.loadPartials(templateDir)
+		if err != nil {
+			return nil, err
+		}
+		analyzed := make(map[string]bool)
+		for len(includes) > 0 {
+			include := includes[0]
+			includes = includes[1:]
+			content, ok := partials[include.name]
+			if !ok {
+				issues = append(issues, VariableIssue{Kind: IssueInvalidTemplate, File: include.file, Message: fmt.Sprintf("line %d includes %s, which does not exist", include.line, include.name)})
+				continue
+			}
+			if !include.root || analyzed[include.name] {
+				continue
+			}
+			analyzed[include.name] = true
+
+			source := include.name + ".tmpl"
+			refs, nested, err := templateRefs(source, content, funcs)
+			if err != nil {
+				issues = append(issues, VariableIssue{Kind: IssueInvalidTemplate, File: source, Message: err.Error()})
+				continue
+			}
+			check(source, refs)
+			includes = append(includes, nested...)
+		}
+	}
+
+	for _, dep := range tmpl.Dependencies {
+		refs, err := conditionVariableRefs(dep.Condition, funcs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze dependency condition %q: %w", dep.Condition, err)
+		}
+		check("template.yaml", refs)
+	}
+	for _, dir := range tmpl.Directories {
+		refs, err := conditionVariableRefs(dir.Condition, funcs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze directory condition %q: %w", dir.Condition, err)
+		}
+		check("template.yaml", refs)
+	}
+	for _, derived := range tmpl.Derived {
+		refs, err := conditionVariableRefs(derived.Expression, funcs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze derived variable %q: %w", derived.Expression, err)
+		}
+		check("template.yaml", refs)
+	}
+
+	for name := range declared {
+		if !used[name] {
+			issues = append(issues, VariableIssue{Kind: IssueUnusedVariable, Variable: name})
+		}
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Kind != issues[j].Kind {
+			return issues[i].Kind < issues[j].Kind
+		}
+		if issues[i].File != issues[j].File {
+			return issues[i].File < issues[j].File
+		}
+		if issues[i].Line != issues[j].Line {
+			return issues[i].Line < issues[j].Line
+		}
+		return issues[i].Variable < issues[j].Variable
+	})
+
+	return issues, nil
+}
+
+// CheckConditions evaluates every condition of the blueprint, on files, directories,
+// dependencies, hooks and features, and its derived variables, against the context
+// of config and reports those that do not parse or evaluate. References to
+// undefined variables fail as in strict mode.
+func (g *Generator) CheckConditions(tmpl types.Template, config types.ProjectConfig) []VariableIssue {
+	context := g.createTemplateContext(config, tmpl)
+	addStrictDefaults(context)
+	strict := &Generator{strict: true}
+
+	var issues []VariableIssue
+	check := func(owner, condition string) {
+		if condition == "" {
+			return
+		}
+		if _, err := strict.evaluateCondition(condition, context); err != nil {
+			issues = append(issues, VariableIssue{Kind: IssueInvalidCondition, File: "template.yaml", Message: fmt.Sprintf("condition of %s: %v", owner, err)})
+		}
+	}
+
+	for _, derived := range tmpl.Derived {
+		if _, err := strict.evaluateExpression(derived.Expression, context, true); err != nil {
+			issues = append(issues, VariableIssue{Kind: IssueInvalidCondition, File: "template.yaml", Message: fmt.Sprintf("derived variable %s: %v", derived.Name, err)})
+		}
+	}
+	for _, dir := range tmpl.Directories {
+		check("directory "+dir.Path, dir.Condition)
+	}
+	for _, file := range tmpl.Files {
+		check(file.Destination, file.Condition)
+	}
+	for _, dep := range tmpl.Dependencies {
+		check("dependency "+dep.Module, dep.Condition)
+	}
+	for _, hook := range append(tmpl.PreGenerationHooks(), tmpl.PostGenerationHooks()...) {
+		check("hook "+hook.Name, hook.Condition)
+	}
+	for _, feature := range tmpl.Features {
+		check("feature "+feature.Name, feature.EnabledWhen)
+	}
+	return issues
+}
+
+// UndefinedVariables filters the issues down to undefined variable references
+func UndefinedVariables(issues []VariableIssue) []VariableIssue {
+	var undefined []VariableIssue
+	for _, issue := range issues {
+		if issue.Kind == IssueUndefinedVariable {
+			undefined = append(undefined, issue)
+		}
+	}
+	return undefined
+}
+
+// validateStrictTemplate fails when the blueprint references undefined variables
+func (g *Generator) validateStrictTemplate(tmpl types.Template) error {
+	issues, err := g.AnalyzeTemplateVariables(tmpl)
+	if err != nil {
+		return types.NewGenerationError("strict template analysis failed", err)
+	}
+
+	undefined := UndefinedVariables(issues)
+	if len(undefined) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(undefined))
+	for _, issue := range undefined {
+		messages = append(messages, issue.String())
+	}
+	return types.NewGenerationError(fmt.Sprintf("blueprint %s references undefined variables:\n  %s", tmpl.ID, strings.Join(messages, "\n  ")), nil)
+}
+
+// strictDefaults are context keys the generator only sets when they apply; strict mode
+// fills them in so that templates can test them without tripping missingkey=error
+var strictDefaults = map[string]any{
+	"UseSlog":              false,
+	"UseZap":               false,
+	"UseLogrus":            false,
+	"UseZerolog":           false,
+	"HasPostgreSQL":        false,
+	"HasMySQL":             false,
+	"HasMongoDB":           false,
+	"HasSQLite":            false,
+	"HasRedis":             false,
+	"HasMultipleDatabases": false,
+	"HasRedisCache":        false,
+	"HasMongoAnalytics":    false,
+	"LoggerType":           "",
+}
+
+// addStrictDefaults fills in the conditional context keys that are not set
+func addStrictDefaults(context map[string]any) {
+	for key, value := range strictDefaults {
+		if _, exists := context[key]; !exists {
+			context[key] = value
+		}
+	}
+	if _, exists := context["Features"]; !exists {
+		context["Features"] = &types.Features{}
+	}
+}
+
+// missingKeyOption returns the text/template option matching the generation mode
+func (g *Generator) missingKeyOption() string {
+	if g.strict {
+		return "missingkey=error"
+	}
+	return "missingkey=default"
+}
+
+// builtinContextKeys returns the keys the generator provides to templates
+func (g *Generator) builtinContextKeys(tmpl types.Template) map[string]bool {
+	representative := types.ProjectConfig{
+		Name:   "project",
+		Module: "example.com/project",
+		Type:   tmpl.Type,
+		Logger: "slog",
+		Features: &types.Features{
+			Logging: types.LoggingConfig{Type: "slog"},
+		},
+	}
+
+	context := g.createTemplateContext(representative, tmpl)
+	addStrictDefaults(context)
+
+	keys := make(map[string]bool)
+	for key := range context {
+		keys[key] = true
+	}
+	// Set by the CLI to force a specific blueprint
+	keys["blueprint_id"] = true
+	return keys
+}
+
+// variableRef is a top-level context variable referenced by a template
+type variableRef struct {
+	name string
+	line int
+}
+
+// partialRef is a partial included by a template
+type partialRef struct {
+	name string
+	file string
+	line int
+	// root is set when the partial is given the root context, whose variables it
+	// then references as the template including it
+	root bool
+}
+
+// templateVariableRefs parses content with funcs and returns the root-context fields it references
+func templateVariableRefs(name, content string, funcs template.FuncMap) ([]variableRef, error) {
+	refs, _, err := templateRefs(name, content, funcs)
+	return refs, err
+}
+
+// templateRefs parses content with funcs and returns the root-context fields it
+// references and the partials it includes
+func templateRefs(name, content string, funcs template.FuncMap) ([]variableRef, []partialRef, error) {
+	if !strings.Contains(content, "{{") {
+		return nil, nil, nil
+	}
+
+	tmpl, err := template.New(name).Funcs(funcs).Parse(content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var refs []variableRef
+	var partials []partialRef
+	for _, t := range tmpl.Templates() {
+		if t.Tree == nil || t.Root == nil {
+			continue
+		}
+		collector := &refCollector{tree: t.Tree, file: name}
+		collector.walk(t.Root, true)
+		refs = append(refs, collector.refs...)
+		partials = append(partials, collector.partials...)
+	}
+	return refs, partials, nil
+}
+
+// refCollector walks a parse tree tracking whether dot still refers to the root context
+type refCollector struct {
+	tree     *parse.Tree
+	file     string
+	refs     []variableRef
+	partials []partialRef
+}
+
+// isRootArgument reports whether pipe passes the root context: dot while it
+// still refers to it, or $
+func isRootArgument(pipe *parse.PipeNode, rootDot bool) bool {
+	if pipe == nil || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+	switch arg := pipe.Cmds[0].Args[0].(type) {
+	case *parse.DotNode:
+		return rootDot
+	case *parse.VariableNode:
+		return len(arg.Ident) == 1 && arg.Ident[0] == "$"
+	}
+	return false
+}
+
+func (c *refCollector) add(name string, pos parse.Pos) {
+	c.refs = append(c.refs, variableRef{name: name, line: lineOf(c.tree, pos)})
+}
+
+// lineOf resolves a parse position to its line number within the template source
+func lineOf(tree *parse.Tree, pos parse.Pos) int {
+	location, _ := tree.ErrorContext(&parse.TextNode{NodeType: parse.NodeText, Pos: pos})
+	// location has the form "name:line:col"
+	parts := strings.Split(location, ":")
+	if len(parts) < 3 {
+		return 0
+	}
+	var line int
+	_, _ = fmt.Sscanf(parts[len(parts)-2], "%d", &line)
+	return line
+}
+
+func (c *refCollector) walk(node parse.Node, rootDot bool) {
+	switch n := node.(type) {
+	case nil:
+		return
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			c.walk(child, rootDot)
+		}
+	case *parse.ActionNode:
+		c.walk(n.Pipe, rootDot)
+	case *parse.IfNode:
+		c.walk(n.Pipe, rootDot)
+		c.walk(n.List, rootDot)
+		c.walk(n.ElseList, rootDot)
+	case *parse.RangeNode:
+		c.walk(n.Pipe, rootDot)
+		c.walk(n.List, false)
+		c.walk(n.ElseList, rootDot)
+	case *parse.WithNode:
+		c.walk(n.Pipe, rootDot)
+		c.walk(n.List, false)
+		c.walk(n.ElseList, rootDot)
+	case *parse.TemplateNode:
+		if strings.HasPrefix(n.Name, templates.PartialPrefix) {
+			c.partials = append(c.partials, partialRef{name: n.Name, file: c.file, line: lineOf(c.tree, n.Pos), root: isRootArgument(n.Pipe, rootDot)})
+		}
+		c.walk(n.Pipe, rootDot)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			c.walk(cmd, rootDot)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			c.walk(arg, rootDot)
+		}
+	case *parse.ChainNode:
+		c.walk(n.Node, rootDot)
+	case *parse.FieldNode:
+		if rootDot && len(n.Ident) > 0 {
+			c.add(n.Ident[0], n.Pos)
+		}
+	case *parse.VariableNode:
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			c.add(n.Ident[1], n.Pos)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateVariableRefs(t *testing.T) {
+	content := `module {{.ModulePath}}
+{{- if .HasRedis}}{{.RedisURL}}{{end}}
+{{- range .Items}}{{.Name}} {{$.ProjectName}}{{end}}
+{{- with .Config}}{{.Port}}{{else}}{{.Fallback}}{{end}}
+{{- define "helper"}}{{.InHelper}}{{end}}
+runs-on: ${{"{{"}} matrix.os {{"}}"}} {{` + "`{{ .Runtime }}`" + `}}`
+
+	refs, err := templateVariableRefs("go.mod.tmpl", content, New().funcMap())
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(refs))
+	lines := make(map[string]int, len(refs))
+	for _, ref := range refs {
+		names = append(names, ref.name)
+		lines[ref.name] = ref.line
+	}
+	assert.ElementsMatch(t, []string{"ModulePath", "HasRedis", "RedisURL", "Items", "ProjectName", "Config", "Fallback", "InHelper"}, names)
+	assert.Equal(t, 1, lines["ModulePath"])
+	assert.Equal(t, 4, lines["Fallback"])
+}
+
+func TestAnalyzeTemplateVariables(t *testing.T) {
+	registry := setupStrictTestTemplates(t)
+
+	tmpl, err := NewWithRegistry(registry).registry.Get("strict-test")
+	require.NoError(t, err)
+
+	issues, err := NewWithRegistry(registry).AnalyzeTemplateVariables(tmpl)
+	require.NoError(t, err)
+
+	assert.Equal(t, []VariableIssue{
+		{Kind: IssueUndefinedVariable, Variable: "ProjectNmae", File: "main.go.tmpl", Line: 3},
+		{Kind: IssueUnusedVariable, Variable: "Unused"},
+	}, issues)
+}
+
+func TestCheckConditions(t *testing.T) {
+	registry := setupStrictTestTemplates(t)
+
+	tmpl, err := NewWithRegistry(registry).registry.Get("strict-test")
+	require.NoError(t, err)
+	tmpl.Dependencies = []types.Dependency{{Module: "example.com/dep", Version: "v1.0.0", Condition: "{{.DatabseDriver}}"}}
+	tmpl.Features = []types.TemplateFeature{{Name: "broken", EnabledWhen: "{{if .ProjectName}}"}}
+
+	config := types.ProjectConfig{Name: "demo", Module: "example.com/demo", Type: "cli"}
+	issues := NewWithRegistry(registry).CheckConditions(tmpl, config)
+
+	require.Len(t, issues, 2)
+	assert.Equal(t, IssueInvalidCondition, issues[0].Kind)
+	assert.Contains(t, issues[0].String(), "template.yaml: condition of dependency example.com/dep")
+	assert.Contains(t, issues[0].String(), "DatabseDriver")
+	assert.Contains(t, issues[1].String(), "condition of feature broken")
+}
+
+func TestGenerate_StrictMode(t *testing.T) {
+	registry := setupStrictTestTemplates(t)
+
+	config := types.ProjectConfig{
+		Name:      "strict-project",
+		Module:    "github.com/test/strict-project",
+		Type:      "cli",
+		Variables: map[string]string{"blueprint_id": "strict-test"},
+	}
+
+	t.Run("lenient mode renders typos as empty values", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "strict-project")
+		result, err := NewWithRegistry(registry).Generate(config, types.GenerationOptions{OutputPath: outputPath, NoGit: true})
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+
+		content, err := os.ReadFile(filepath.Join(outputPath, "main.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "<no value>")
+	})
+
+	t.Run("strict mode rejects undefined variables", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "strict-project")
+		_, err := NewWithRegistry(registry).Generate(config, types.GenerationOptions{OutputPath: outputPath, NoGit: true, Strict: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `main.go.tmpl:3: undefined variable "ProjectNmae"`)
+		assert.NoDirExists(t, outputPath)
+	})
+}
+
+func TestRenderFile_StrictMissingKey(t *testing.T) {
+	registry := setupStrictTestTemplates(t)
+
+	g := NewWithRegistry(registry)
+	g.strict = true
+
+	context := map[string]any{"ProjectName": "demo"}
+	addStrictDefaults(context)
+
+	_, err := g.renderFile("strict-test", "", types.TemplateFile{Source: "main.go.tmpl"}, context)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ProjectNmae")
+}
+
+// TestBlueprintVariables is the blueprint CI check: every blueprint must only
+// reference variables it declares or that the generator provides
+func TestBlueprintVariables(t *testing.T) {
+	setupTestTemplates(t)
+
+	// Blueprints whose files still embed unescaped runtime templates
+	knownUnescaped := map[string]bool{
+		"monolith": true,
+	}
+
+	g := New()
+	for _, tmpl := range g.registry.List() {
+		t.Run(tmpl.ID, func(t *testing.T) {
+			if knownUnescaped[tmpl.ID] {
+				t.Skip("blueprint embeds unescaped runtime templates")
+			}
+
+			issues, err := g.AnalyzeTemplateVariables(tmpl)
+			require.NoError(t, err)
+
+			var problems []string
+			for _, issue := range issues {
+				if issue.Kind != IssueUnusedVariable {
+					problems = append(problems, issue.String())
+				}
+			}
+			assert.Empty(t, problems, strings.Join(problems, "\n"))
+		})
+	}
+}
+
+// setupStrictTestTemplates returns a registry of a blueprint containing a variable typo
+func setupStrictTestTemplates(t *testing.T) *templates.Registry {
+	t.Helper()
+
+	return newTestRegistry(t, fstest.MapFS{
+		"strict-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "strict-test"
+name: "strict-test"
+type: "cli"
+architecture: "standard"
+variables:
+  - name: "ProjectName"
+    type: "string"
+    required: true
+  - name: "Unused"
+    type: "string"
+    default: "value"
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+  - source: "redis.go.tmpl"
+    destination: "internal/{{.ProjectName}}/redis.go"
+    condition: "{{.HasRedis}}"
+`)},
+		"strict-test/main.go.tmpl": &fstest.MapFile{Data: []byte(`package main
+
+// {{.ProjectNmae}}
+func main() {}
+`)},
+		"strict-test/redis.go.tmpl": &fstest.MapFile{Data: []byte("package redis\n")},
+	})
+}
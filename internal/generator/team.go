@@ -0,0 +1,26 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// TeamVariable is the blueprint variable holding the code owners of the generated
+// repository. Blueprints offer the CODEOWNERS file, the pull request and issue
+// templates and the branch protection settings by declaring it.
+const TeamVariable = "Team"
+
+// checkTeam rejects code owners for blueprints without the review policy files,
+// so opting in never silently does nothing
+func checkTeam(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[TeamVariable] == "" {
+		return nil
+	}
+	for _, variable := range tmpl.Variables {
+		if variable.Name == TeamVariable {
+			return nil
+		}
+	}
+	return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer code ownership files, remove --team", tmpl.ID), nil)
+}
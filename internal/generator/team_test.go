@@ -0,0 +1,91 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_Team(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(projectType, team string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:      "payments",
+			Module:    "github.com/test/payments",
+			Type:      projectType,
+			Framework: "gin",
+			Logger:    "slog",
+			Variables: map[string]string{TeamVariable: team},
+		}
+	}
+
+	policyFiles := []string{
+		".github/CODEOWNERS",
+		".github/pull_request_template.md",
+		".github/ISSUE_TEMPLATE/bug_report.yml",
+		".github/ISSUE_TEMPLATE/feature_request.yml",
+		".github/ISSUE_TEMPLATE/config.yml",
+		".github/settings.yml",
+	}
+
+	t.Run("files are left out without a team", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("web-api", ""), "web-api-clean")
+		require.NoError(t, err)
+		for _, path := range policyFiles {
+			assert.NotContains(t, files, path)
+		}
+	})
+
+	for _, blueprint := range []string{"web-api-clean", "cli", "workspace"} {
+		t.Run("team adds the review policy to "+blueprint, func(t *testing.T) {
+			files, err := New().GenerateInMemoryFiles(ctx, config(blueprint, "@acme/payments, @octocat"), blueprint)
+			require.NoError(t, err)
+			for _, path := range policyFiles {
+				assert.Contains(t, files, path)
+			}
+			assert.Contains(t, string(files[".github/CODEOWNERS"].Content), "*                   @acme/payments @octocat\n")
+		})
+	}
+
+	t.Run("settings grant the owner teams write access", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("web-api", "@acme/payments,@octocat,@acme/sre"), "web-api-clean")
+		require.NoError(t, err)
+
+		var settings struct {
+			Teams []struct {
+				Name       string `yaml:"name"`
+				Permission string `yaml:"permission"`
+			} `yaml:"teams"`
+			Branches []struct {
+				Name       string         `yaml:"name"`
+				Protection map[string]any `yaml:"protection"`
+			} `yaml:"branches"`
+		}
+		require.NoError(t, yaml.Unmarshal(files[".github/settings.yml"].Content, &settings))
+		require.Len(t, settings.Teams, 2)
+		assert.Equal(t, "payments", settings.Teams[0].Name)
+		assert.Equal(t, "sre", settings.Teams[1].Name)
+		require.Len(t, settings.Branches, 1)
+		reviews := settings.Branches[0].Protection["required_pull_request_reviews"].(map[string]any)
+		assert.Equal(t, true, reviews["require_code_owner_reviews"])
+	})
+
+	t.Run("users only need no team access", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("web-api", "@octocat"), "web-api-clean")
+		require.NoError(t, err)
+		assert.NotContains(t, string(files[".github/settings.yml"].Content), "teams:")
+	})
+
+	t.Run("blueprints without the files reject a team", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("tui", "@acme/payments"), "tui")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer code ownership files")
+	})
+}
@@ -0,0 +1,25 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// TelemetryVariable is the blueprint variable holding the endpoint of the opt-in
+// telemetry module. Blueprints offer the module by declaring it.
+const TelemetryVariable = "TelemetryEndpoint"
+
+// checkTelemetry rejects a telemetry endpoint for blueprints without the telemetry
+// module, so opting in never silently does nothing
+func checkTelemetry(tmpl types.Template, config types.ProjectConfig) error {
+	if config.Variables[TelemetryVariable] == "" {
+		return nil
+	}
+	for _, variable := range tmpl.Variables {
+		if variable.Name == TelemetryVariable {
+			return nil
+		}
+	}
+	return types.NewValidationError(fmt.Sprintf("blueprint %s does not offer the telemetry module, remove --telemetry-endpoint", tmpl.ID), nil)
+}
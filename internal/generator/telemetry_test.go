@@ -0,0 +1,50 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_Telemetry(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(projectType, endpoint string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:      "inventory",
+			Module:    "github.com/test/inventory",
+			Type:      projectType,
+			Logger:    "slog",
+			Variables: map[string]string{TelemetryVariable: endpoint},
+		}
+	}
+
+	t.Run("module is left out unless an endpoint is given", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("grpc-service", ""), "grpc-service")
+		require.NoError(t, err)
+		assert.NotContains(t, files, "internal/telemetry/telemetry.go")
+		assert.NotContains(t, string(files["cmd/server/main.go"].Content), "telemetry")
+	})
+
+	t.Run("endpoint includes the module", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("grpc-service", "https://telemetry.example.com/pings"), "grpc-service")
+		require.NoError(t, err)
+		require.Contains(t, files, "internal/telemetry/telemetry.go")
+
+		module := string(files["internal/telemetry/telemetry.go"].Content)
+		assert.Contains(t, module, `DefaultEndpoint = "https://telemetry.example.com/pings"`)
+		assert.Contains(t, module, `Blueprint:        "grpc-service"`)
+		assert.Contains(t, string(files["cmd/server/main.go"].Content), "telemetry.Start(")
+	})
+
+	t.Run("blueprints without the module reject an endpoint", func(t *testing.T) {
+		_, err := New().GenerateInMemoryFiles(ctx, config("cli", "https://telemetry.example.com/pings"), "cli-simple")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not offer the telemetry module")
+	})
+}
@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"sync"
+	"text/template"
+
+	"github.com/francknouama/go-starter/internal/templates"
+)
+
+// TemplateCache keeps the files of the blueprints parsed, with the partials they
+// include, for the generators sharing it to parse each file once rather than on
+// every generation. Its entries belong to the template set of one loader: once
+// the registry reloads its blueprints, generators look them up with the loader
+// of the new set and the cache starts over. It is safe for concurrent use.
+type TemplateCache struct {
+	mu      sync.Mutex
+	loader  *templates.TemplateLoader
+	entries map[templateKey]*template.Template
+}
+
+// templateKey identifies a parsed file: its blueprint, by directory, and version,
+// its source, and whether it renders in strict mode
+type templateKey struct {
+	blueprint string
+	version   string
+	source    string
+	strict    bool
+}
+
+// NewTemplateCache returns an empty template cache
+func NewTemplateCache() *TemplateCache {
+	return &TemplateCache{}
+}
+
+// Len returns the number of parsed files the cache holds
+func (c *TemplateCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// get returns the file parsed from the template set of loader
+func (c *TemplateCache) get(loader *templates.TemplateLoader, key templateKey) *template.Template {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.loader != loader {
+		return nil
+	}
+	return c.entries[key]
+}
+
+// put keeps a file parsed from the template set of loader, dropping those of the
+// set it replaces
+func (c *TemplateCache) put(loader *templates.TemplateLoader, key templateKey, tmpl *template.Template) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.loader != loader {
+		c.loader = loader
+		c.entries = make(map[templateKey]*template.Template)
+	}
+	c.entries[key] = tmpl
+}
+
+// UseTemplateCache makes the generator keep the files it parses in cache, and
+// parse only those the cache does not hold. Generators with the functions of
+// extensions or a pinned time parse their files themselves, see Extend and PinTime.
+func (g *Generator) UseTemplateCache(cache *TemplateCache) {
+	g.cache = cache
+}
+
+// templateCache returns the cache the files of the generator are kept in, nil
+// when they are not
+func (g *Generator) templateCache() *TemplateCache {
+	if len(g.funcs) > 0 || !g.now.IsZero() {
+		return nil
+	}
+	return g.cache
+}
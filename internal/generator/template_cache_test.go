@@ -0,0 +1,111 @@
+package generator
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// cacheTestFS is a blueprint whose file includes a partial
+func cacheTestFS(greeting string) fstest.MapFS {
+	return fstest.MapFS{
+		"cache-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "cache-test"
+name: "cache-test"
+type: "cli"
+version: "1.0.0"
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+`)},
+		"cache-test/main.go.tmpl":           &fstest.MapFile{Data: []byte(`package main // {{template "partials/greeting" .}}` + "\n")},
+		"cache-test/partials/greeting.tmpl": &fstest.MapFile{Data: []byte(greeting + " {{.ProjectName}}\n")},
+	}
+}
+
+func TestTemplateCache(t *testing.T) {
+	config := &types.ProjectConfig{Name: "demo", Module: "example.com/demo", Type: "cli"}
+	render := func(t *testing.T, registry *templates.Registry, cache *TemplateCache, funcs template.FuncMap) string {
+		t.Helper()
+		g := NewWithRegistry(registry)
+		if funcs != nil {
+			require.NoError(t, g.Extend(funcs))
+		}
+		g.UseTemplateCache(cache)
+		files, err := g.GenerateInMemoryFiles(context.Background(), config, "cache-test")
+		require.NoError(t, err)
+		return string(files["main.go"].Content)
+	}
+
+	t.Run("generations sharing the cache parse each file once", func(t *testing.T) {
+		registry, err := templates.NewRegistryWithFS(cacheTestFS("hello"))
+		require.NoError(t, err)
+		cache := NewTemplateCache()
+
+		assert.Equal(t, "package main // hello demo\n", render(t, registry, cache, nil))
+		require.Equal(t, 1, cache.Len())
+		parsed := cache.get(registry.Loader(), templateKey{blueprint: "cache-test", version: "1.0.0", source: "main.go.tmpl"})
+		require.NotNil(t, parsed)
+
+		assert.Equal(t, "package main // hello demo\n", render(t, registry, cache, nil))
+		assert.Same(t, parsed, cache.get(registry.Loader(), templateKey{blueprint: "cache-test", version: "1.0.0", source: "main.go.tmpl"}))
+	})
+
+	t.Run("a reload of the blueprints starts the cache over", func(t *testing.T) {
+		fsys := cacheTestFS("hello")
+		registry, err := templates.NewRegistryWithFS(fsys)
+		require.NoError(t, err)
+		cache := NewTemplateCache()
+		assert.Equal(t, "package main // hello demo\n", render(t, registry, cache, nil))
+
+		// The version is unchanged, the partial is
+		fsys["cache-test/partials/greeting.tmpl"] = cacheTestFS("goodbye")["cache-test/partials/greeting.tmpl"]
+		_, err = registry.Reload()
+		require.NoError(t, err)
+		assert.Equal(t, "package main // goodbye demo\n", render(t, registry, cache, nil))
+		assert.Equal(t, 1, cache.Len())
+	})
+
+	t.Run("generators with extensions parse their files themselves", func(t *testing.T) {
+		registry, err := templates.NewRegistryWithFS(cacheTestFS("hello"))
+		require.NoError(t, err)
+		cache := NewTemplateCache()
+
+		render(t, registry, cache, template.FuncMap{"shout": func(s string) string { return s + "!" }})
+		assert.Equal(t, 0, cache.Len())
+	})
+}
+
+// BenchmarkGenerateInMemoryFiles_TemplateCache compares the generations of the web
+// server parsing every file with those sharing a template cache:
+//
+//	go test ./internal/generator -run '^$' -bench TemplateCache
+func BenchmarkGenerateInMemoryFiles_TemplateCache(b *testing.B) {
+	setupTestTemplates(b)
+	registry := templates.NewRegistry()
+
+	for _, bench := range []struct {
+		name  string
+		cache *TemplateCache
+	}{
+		{"uncached", nil},
+		{"cached", NewTemplateCache()},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			for b.Loop() {
+				g := NewWithRegistry(registry)
+				g.UseTemplateCache(bench.cache)
+				if _, err := g.GenerateInMemoryFiles(context.Background(), hexagonalConfig(), "web-api-hexagonal"); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/Masterminds/sprig/v3"
+
+	"github.com/francknouama/go-starter/internal/naming"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// builtinFuncs are the template functions of go-starter, available to every
+// blueprint next to the Sprig functions
+var builtinFuncs = template.FuncMap{
+	"toSnakeCase":         toSnakeCase,
+	"toGoIdentifier":      naming.GoIdentifier,
+	"modulePathToPackage": modulePathToPackage,
+	"semverNext":          semverNext,
+}
+
+// functionNamePattern matches the names text/template accepts for functions
+var functionNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// majorVersionSuffix matches the /vN element ending the path of a module from v2 on
+var majorVersionSuffix = regexp.MustCompile(`^v[2-9][0-9]*$`)
+
+// TemplateFuncs returns the functions the templates of a blueprint parse with:
+// the Sprig functions, those of go-starter, and stand-ins for the functions it
+// declares, so that templates can be checked without the plugins providing them
+func TemplateFuncs(declared []types.TemplateFunction) template.FuncMap {
+	funcs := sprig.TxtFuncMap()
+	for name, fn := range builtinFuncs {
+		funcs[name] = fn
+	}
+	for name, fn := range StandInFuncs(declared) {
+		funcs[name] = fn
+	}
+	return funcs
+}
+
+// StandInFuncs returns functions rendering as empty strings for the declared
+// functions that are not Sprig or go-starter ones; those with invalid names are
+// left out
+func StandInFuncs(declared []types.TemplateFunction) template.FuncMap {
+	builtin := sprig.TxtFuncMap()
+	funcs := make(template.FuncMap, len(declared))
+	for _, function := range declared {
+		if _, ok := builtin[function.Name]; ok || builtinFuncs[function.Name] != nil || !ValidFunctionName(function.Name) {
+			continue
+		}
+		funcs[function.Name] = func(...any) (string, error) { return "", nil }
+	}
+	return funcs
+}
+
+// ValidFunctionName reports whether name can name a template function
+func ValidFunctionName(name string) bool {
+	return functionNamePattern.MatchString(name)
+}
+
+// checkFunctions fails the generation of a blueprint that declares template
+// functions no installed plugin provides, before any file is rendered
+func (g *Generator) checkFunctions(tmpl types.Template) error {
+	funcs := g.funcMap()
+	for _, function := range tmpl.Functions {
+		if _, ok := funcs[function.Name]; ok {
+			continue
+		}
+		message := fmt.Sprintf("blueprint %s needs the template function %s", tmpl.ID, function.Name)
+		if function.Plugin != "" {
+			message += fmt.Sprintf(", install the %s plugin providing it with go-starter plugin install", function.Plugin)
+		}
+		return types.NewValidationError(message, nil)
+	}
+	return nil
+}
+
+// toSnakeCase returns name in lower snake case, splitting words on separators
+// and case changes: "UserProfile", "user-profile" and "userProfile" all give
+// "user_profile", and "HTTPServer" gives "http_server"
+func toSnakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if b.Len() > 0 && !strings.HasSuffix(b.String(), "_") {
+				b.WriteByte('_')
+			}
+			continue
+		}
+		if unicode.IsUpper(r) && i > 0 && b.Len() > 0 && !strings.HasSuffix(b.String(), "_") {
+			previous := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && nextIsLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return strings.TrimSuffix(b.String(), "_")
+}
+
+// modulePathToPackage returns the package name of the root of a module:
+// "github.com/acme/order-service/v2" gives "orderservice"
+func modulePathToPackage(modulePath string) string {
+	modulePath = strings.TrimSuffix(modulePath, "/")
+	base := path.Base(modulePath)
+	if majorVersionSuffix.MatchString(base) && path.Dir(modulePath) != "." {
+		base = path.Base(path.Dir(modulePath))
+	}
+	return naming.PackageName(base)
+}
+
+// semverNext returns the version following version once its major, minor or
+// patch part is bumped, keeping its "v" prefix: semverNext "v1.4.2" "minor"
+// gives "v1.5.0"
+func semverNext(version, part string) (string, error) {
+	current, err := semver.StrictNewVersion(strings.TrimPrefix(version, "v"))
+	if err != nil {
+		return "", fmt.Errorf("semverNext: invalid version %q", version)
+	}
+
+	var next semver.Version
+	switch part {
+	case "major":
+		next = current.IncMajor()
+	case "minor":
+		next = current.IncMinor()
+	case "patch":
+		next = current.IncPatch()
+	default:
+		return "", fmt.Errorf("semverNext: part must be major, minor or patch, not %q", part)
+	}
+	if strings.HasPrefix(version, "v") {
+		return "v" + next.String(), nil
+	}
+	return next.String(), nil
+}
@@ -0,0 +1,75 @@
+package generator
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestBuiltinFuncs(t *testing.T) {
+	for _, tc := range []struct {
+		template string
+		want     string
+	}{
+		{`{{toSnakeCase "UserProfile"}}`, "user_profile"},
+		{`{{toSnakeCase "HTTPServer"}}`, "http_server"},
+		{`{{toSnakeCase "userID2FA"}}`, "user_id2_fa"},
+		{`{{toSnakeCase "order-service v2"}}`, "order_service_v2"},
+		{`{{toGoIdentifier "order-service"}}`, "OrderService"},
+		{`{{toGoIdentifier "3d-printer"}}`, "App3dPrinter"},
+		{`{{modulePathToPackage "github.com/acme/order-service"}}`, "orderservice"},
+		{`{{modulePathToPackage "github.com/acme/order-service/v2"}}`, "orderservice"},
+		{`{{modulePathToPackage "example.com/go"}}`, "appgo"},
+		{`{{semverNext "v1.4.2" "minor"}}`, "v1.5.0"},
+		{`{{semverNext "1.4.2" "major"}}`, "2.0.0"},
+		{`{{semverNext "v1.4.2-rc.1" "patch"}}`, "v1.4.2"},
+	} {
+		var out strings.Builder
+		tmpl := template.Must(template.New("func").Funcs(TemplateFuncs(nil)).Parse(tc.template))
+		require.NoError(t, tmpl.Execute(&out, nil), tc.template)
+		assert.Equal(t, tc.want, out.String(), tc.template)
+	}
+
+	for _, call := range []string{`{{semverNext "1.4" "minor"}}`, `{{semverNext "v1.4.2" "build"}}`} {
+		tmpl := template.Must(template.New("func").Funcs(TemplateFuncs(nil)).Parse(call))
+		assert.Error(t, tmpl.Execute(&strings.Builder{}, nil), call)
+	}
+}
+
+func TestCheckFunctions(t *testing.T) {
+	registry := newTestRegistry(t, fstest.MapFS{
+		"licensed-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "licensed-test"
+name: "licensed-test"
+type: "cli"
+functions:
+  - name: "licenseHeader"
+    plugin: "license"
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+`)},
+		"licensed-test/main.go.tmpl": &fstest.MapFile{Data: []byte("{{licenseHeader .ProjectName}}\npackage {{toSnakeCase .ProjectName}}\n")},
+	})
+	config := &types.ProjectConfig{Name: "BillingAPI", Module: "example.com/billing", Type: "cli"}
+
+	_, err := NewWithRegistry(registry).GenerateInMemoryFiles(context.Background(), config, "licensed-test")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "blueprint licensed-test needs the template function licenseHeader, install the license plugin providing it with go-starter plugin install")
+
+	gen := NewWithRegistry(registry)
+	require.NoError(t, gen.Extend(template.FuncMap{"licenseHeader": func(name string) string { return "// Copyright " + name }}))
+	files, err := gen.GenerateInMemoryFiles(context.Background(), config, "licensed-test")
+	require.NoError(t, err)
+	assert.Equal(t, "// Copyright BillingAPI\npackage billing_api\n", string(files["main.go"].Content))
+
+	assert.Error(t, NewWithRegistry(registry).Extend(template.FuncMap{"toSnakeCase": strings.ToLower}), "extensions cannot replace the functions of go-starter")
+	assert.Error(t, NewWithRegistry(registry).Extend(template.FuncMap{"license-header": strings.ToLower}))
+}
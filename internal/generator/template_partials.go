@@ -0,0 +1,71 @@
+package generator
+
+import (
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/francknouama/go-starter/internal/templates"
+)
+
+// partialSet caches the partials of a blueprint, loaded once per generation
+// rather than for every file including them
+type partialSet struct {
+	loader   *templates.TemplateLoader
+	dir      string
+	partials map[string]string
+}
+
+// partialNamePattern matches the names of the partials a template includes
+var partialNamePattern = regexp.MustCompile(`"(` + regexp.QuoteMeta(templates.PartialPrefix) + `[^"]+)"`)
+
+// includesPartials reports whether a template may include partials
+func includesPartials(content string) bool {
+	return strings.Contains(content, `"`+templates.PartialPrefix)
+}
+
+// loadPartials returns the partials the blueprint in templateDir can include; the
+// files of a generation rendered at once share them
+func (g *Generator) loadPartials(templateDir string) (map[string]string, error) {
+	g.partialsMu.Lock()
+	defer g.partialsMu.Unlock()
+	if g.partials != nil && g.partials.loader == g.loader && g.partials.dir == templateDir {
+		return g.partials.partials, nil
+	}
+	partials, err := g.loader.LoadPartials(templateDir)
+	if err != nil {
+		return nil, err
+	}
+	g.partials = &partialSet{loader: g.loader, dir: templateDir, partials: partials}
+	return partials, nil
+}
+
+// addPartials associates with tmpl the partials of the blueprint that content
+// includes, directly or through other partials, so that it can include them with
+// {{template "partials/<name>" .}}. The partials it does not reach are not parsed,
+// so that a broken one only fails the files including it.
+func (g *Generator) addPartials(tmpl *template.Template, templateDir, content string, context map[string]any) error {
+	partials, err := g.loadPartials(templateDir)
+	if err != nil {
+		return err
+	}
+	funcs := g.funcMap()
+	pending := []string{content}
+	for len(pending) > 0 {
+		source := pending[0]
+		pending = pending[1:]
+		for _, match := range partialNamePattern.FindAllStringSubmatch(source, -1) {
+			name := match[1]
+			partial, ok := partials[name]
+			// Missing partials fail the execution, which locates the include
+			if !ok || tmpl.Lookup(name) != nil {
+				continue
+			}
+			if _, err := tmpl.New(name).Parse(partial); err != nil {
+				return newRenderError(name+".tmpl", "parse", err, context, funcs)
+			}
+			pending = append(pending, partial)
+		}
+	}
+	return nil
+}
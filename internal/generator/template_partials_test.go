@@ -0,0 +1,103 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// setupPartialTestTemplates returns a registry of a partials-test blueprint whose main.go
+// includes the partials, next to shared partials
+func setupPartialTestTemplates(t *testing.T, main string) *templates.Registry {
+	t.Helper()
+
+	return newTestRegistry(t, fstest.MapFS{
+		"partials-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "partials-test"
+name: "partials-test"
+type: "cli"
+architecture: "standard"
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+`)},
+		"partials-test/main.go.tmpl":         &fstest.MapFile{Data: []byte(main)},
+		"partials-test/partials/banner.tmpl": &fstest.MapFile{Data: []byte("// {{.ProjectName}} banner\n")},
+		"shared/partials/banner.tmpl":        &fstest.MapFile{Data: []byte("// shared banner\n")},
+		"shared/partials/shutdown.tmpl":      &fstest.MapFile{Data: []byte("\tstop() // {{.ProjectName}}\n{{template \"partials/logger/setup\" .}}\n")},
+		"shared/partials/logger/setup.tmpl":  &fstest.MapFile{Data: []byte("\tlog := {{.Missing}}\n")},
+		"shared/partials/unused/broken.tmpl": &fstest.MapFile{Data: []byte("{{if}}")},
+	})
+}
+
+func generatePartialTest(t *testing.T, registry *templates.Registry) (map[string]GeneratedFile, error) {
+	t.Helper()
+	return NewWithRegistry(registry).GenerateInMemoryFiles(context.Background(), &types.ProjectConfig{
+		Name:   "demo",
+		Module: "example.com/demo",
+		Type:   "cli",
+	}, "partials-test")
+}
+
+func TestLoadPartials(t *testing.T) {
+	registry := setupPartialTestTemplates(t, "")
+
+	partials, err := registry.Loader().LoadPartials("partials-test")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"partials/banner", "partials/logger/setup", "partials/shutdown", "partials/unused/broken"}, keys(partials))
+	assert.Equal(t, "// {{.ProjectName}} banner", partials["partials/banner"], "the partials of the blueprint replace shared ones")
+}
+
+func TestRenderFile_Partials(t *testing.T) {
+	t.Run("partials render with the context of the file", func(t *testing.T) {
+		registry := setupPartialTestTemplates(t, "package main\n\n{{template \"partials/banner\" .}}\nfunc main() {}\n")
+		files, err := generatePartialTest(t, registry)
+		require.NoError(t, err)
+		assert.Equal(t, "package main\n\n// demo banner\nfunc main() {}\n", string(files["main.go"].Content))
+	})
+
+	t.Run("errors are located in the partial", func(t *testing.T) {
+		registry := setupPartialTestTemplates(t, "package main\n\nfunc main() {\n{{template \"partials/banner\" .}}\n{{template \"partials/missing\" .}}\n}\n")
+		_, err := generatePartialTest(t, registry)
+		var renderErr *RenderError
+		require.True(t, errors.As(err, &renderErr), err)
+		assert.Equal(t, "main.go.tmpl", renderErr.File)
+		assert.Equal(t, 5, renderErr.Line)
+		assert.Contains(t, renderErr.Suggestion, "blueprints/shared/partials")
+	})
+
+	t.Run("only the partials included are parsed", func(t *testing.T) {
+		registry := setupPartialTestTemplates(t, "{{template \"partials/unused/broken\" .}}")
+		_, err := generatePartialTest(t, registry)
+		var renderErr *RenderError
+		require.True(t, errors.As(err, &renderErr), err)
+		assert.Equal(t, "partials/unused/broken.tmpl", renderErr.File)
+		assert.Equal(t, "parse", renderErr.Phase)
+	})
+}
+
+func TestAnalyzeTemplateVariables_Partials(t *testing.T) {
+	registry := setupPartialTestTemplates(t, "{{template \"partials/shutdown\" .}}\n{{template \"partials/banner\" dict \"Name\" .ProjectName}}\n{{template \"partials/absent\" .}}\n")
+
+	g := NewWithRegistry(registry)
+	tmpl, err := g.registry.Get("partials-test")
+	require.NoError(t, err)
+	issues, err := g.AnalyzeTemplateVariables(tmpl)
+	require.NoError(t, err)
+
+	var messages []string
+	for _, issue := range issues {
+		messages = append(messages, issue.String())
+	}
+	assert.Equal(t, []string{
+		"main.go.tmpl: line 3 includes partials/absent, which does not exist",
+		`partials/logger/setup.tmpl:1: undefined variable "Missing"`,
+	}, messages, "nested partials given the root context are checked, the others only exist")
+}
@@ -0,0 +1,45 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/francknouama/go-starter/internal/naming"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Blueprint variables of the terraform-provider blueprint, derived from the project
+// name and module path unless set explicitly
+const (
+	ProviderNameVariable      = "ProviderName"
+	RegistryNamespaceVariable = "RegistryNamespace"
+)
+
+// addTerraformProviderVariables fills in the provider type name and the registry
+// namespace of the terraform-provider blueprint. The namespace is the owner of a
+// github.com/<owner>/terraform-provider-<name> style module path.
+func addTerraformProviderVariables(context map[string]any, config types.ProjectConfig, tmpl types.Template) {
+	if tmpl.Type != "terraform-provider" {
+		return
+	}
+
+	if value, _ := context[ProviderNameVariable].(string); value == "" {
+		context[ProviderNameVariable] = naming.TerraformProviderName(config.Name)
+	}
+	if value, _ := context[RegistryNamespaceVariable].(string); value == "" {
+		context[RegistryNamespaceVariable] = registryNamespace(config.Module)
+	}
+}
+
+// registryNamespace derives the Terraform registry namespace from a module path
+func registryNamespace(module string) string {
+	parts := strings.Split(module, "/")
+	if len(parts) < 3 || !strings.Contains(parts[0], ".") {
+		return "example"
+	}
+
+	namespace := strings.ToLower(parts[1])
+	if namespace == "" {
+		return "example"
+	}
+	return namespace
+}
@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_TerraformProvider(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(variables map[string]string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:      "terraform-provider-acme",
+			Module:    "github.com/AcmeCorp/terraform-provider-acme",
+			Type:      "terraform-provider",
+			Variables: variables,
+		}
+	}
+
+	t.Run("provider name and namespace are derived", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{}), "terraform-provider")
+		require.NoError(t, err)
+
+		assert.Contains(t, string(files["main.go"].Content), `"registry.terraform.io/acmecorp/acme"`)
+		assert.Contains(t, string(files["internal/provider/provider.go"].Content), `resp.TypeName = "acme"`)
+		assert.Contains(t, files, "examples/resources/acme_item/resource.tf")
+		assert.Contains(t, files, "examples/data-sources/acme_item/data-source.tf")
+		assert.Contains(t, string(files[".goreleaser.yml"].Content), "name_template: '{{ .ProjectName }}_{{ .Version }}_SHA256SUMS'")
+	})
+
+	t.Run("explicit variables win", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config(map[string]string{
+			ProviderNameVariable:      "widgets",
+			RegistryNamespaceVariable: "example-org",
+		}), "terraform-provider")
+		require.NoError(t, err)
+
+		assert.Contains(t, string(files["main.go"].Content), `"registry.terraform.io/example-org/widgets"`)
+		assert.Contains(t, files, "examples/resources/widgets_item/resource.tf")
+	})
+}
+
+func TestRegistryNamespace(t *testing.T) {
+	assert.Equal(t, "acme", registryNamespace("github.com/acme/terraform-provider-acme"))
+	assert.Equal(t, "acme", registryNamespace("gitlab.com/Acme/group/terraform-provider-acme"))
+	assert.Equal(t, "example", registryNamespace("terraform-provider-acme"))
+	assert.Equal(t, "example", registryNamespace("acme/terraform-provider-acme"))
+}
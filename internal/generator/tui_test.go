@@ -0,0 +1,66 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_TUI(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(logger, goVersion string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:      "tasks",
+			Module:    "github.com/test/tasks",
+			Type:      "tui",
+			Logger:    logger,
+			GoVersion: goVersion,
+			Variables: map[string]string{},
+		}
+	}
+
+	t.Run("logs go to a file", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("zap", ""), "tui")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "internal/logger/zap.go")
+		assert.NotContains(t, files, "internal/logger/slog.go")
+		main := string(files["cmd/app/main.go"].Content)
+		assert.Contains(t, main, "CreateWithOutput")
+		assert.NotContains(t, main, "os.Stdout")
+		assert.Contains(t, string(files["go.mod"].Content), "go.uber.org/zap")
+	})
+
+	t.Run("screens, keys and themes", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("slog", ""), "tui")
+		require.NoError(t, err)
+
+		for _, path := range []string{
+			"internal/app/app.go",
+			"internal/app/keys.go",
+			"internal/screens/menu.go",
+			"internal/screens/tasks.go",
+			"internal/screens/settings.go",
+			"internal/theme/theme.go",
+		} {
+			assert.Contains(t, files, path)
+		}
+		assert.Contains(t, string(files["internal/app/app.go"].Content), `Render("tasks")`)
+	})
+
+	t.Run("go version is at least 1.23", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("slog", "1.21"), "tui")
+		require.NoError(t, err)
+		assert.Contains(t, string(files["go.mod"].Content), "\ngo 1.23\n")
+
+		files, err = New().GenerateInMemoryFiles(ctx, config("slog", "1.24"), "tui")
+		require.NoError(t, err)
+		assert.Contains(t, string(files["go.mod"].Content), "\ngo 1.24\n")
+	})
+}
@@ -0,0 +1,213 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Suffixes of the files an upgrade writes next to a file edited since generation
+const (
+	// UpgradeRejectSuffix holds the changes of the new blueprint version that could
+	// not be applied, as a unified diff
+	UpgradeRejectSuffix = ".rej"
+	// UpgradeOriginalSuffix holds the edited file an upgrade overwrote
+	UpgradeOriginalSuffix = ".orig"
+)
+
+// UpgradePlan lists the changes upgrading a generated project to the current
+// version of its blueprint makes
+type UpgradePlan struct {
+	Project     string `json:"project"`
+	Blueprint   string `json:"blueprint"`
+	FromVersion string `json:"from_version"`
+	ToVersion   string `json:"to_version"`
+	// Tracked is false for projects generated before the manifest recorded file
+	// checksums. Every changed file of such a project is a conflict, as there is no
+	// telling whether it was edited.
+	Tracked bool `json:"tracked"`
+	// Create lists the files the new version adds
+	Create []string `json:"create"`
+	// Update lists the files the new version changes that are still as generated
+	Update []string `json:"update"`
+	// Merge lists the files merged instead, such as the requirements of go.mod
+	Merge []string `json:"merge"`
+	// Conflicts lists the files the new version changes that were edited since
+	// generation
+	Conflicts []string `json:"conflicts"`
+	// Deleted lists the generated files removed from the project, which are not
+	// restored
+	Deleted []string `json:"deleted"`
+	// Obsolete lists the generated files the new version no longer has. They are
+	// left in the project to remove by hand.
+	Obsolete []string `json:"obsolete"`
+
+	manifest Manifest
+	lock     GeneratedFile
+	files    map[string]GeneratedFile
+	existing map[string]GeneratedFile
+}
+
+// Empty reports whether the plan changes nothing
+func (p *UpgradePlan) Empty() bool {
+	return len(p.Create)+len(p.Update)+len(p.Merge)+len(p.Conflicts) == 0
+}
+
+// PlanUpgrade works out how upgrading the project at projectPath to the current
+// version of its blueprint changes it. The blueprint is rendered with the
+// configuration of the generation manifest and compared file by file with the
+// project; the checksums of the manifest tell the files still as generated from
+// those edited since.
+func (g *Generator) PlanUpgrade(ctx context.Context, projectPath string) (*UpgradePlan, error) {
+	manifest, err := ReadManifest(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := g.registry.Get(manifest.Blueprint)
+	if err != nil {
+		return nil, fmt.Errorf("blueprint %s of the project is not available in this version of go-starter: %w", manifest.Blueprint, err)
+	}
+
+	config := manifest.Config
+	files, err := g.GenerateInMemoryFiles(ctx, &config, manifest.Blueprint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render blueprint %s %s: %w", manifest.Blueprint, tmpl.Version, err)
+	}
+
+	// The manifest of the render carries the deprecations and experiments of the
+	// new version
+	var rendered Manifest
+	if err := json.Unmarshal(files[ManifestFile].Content, &rendered); err != nil {
+		return nil, types.NewGenerationError("failed to parse generation manifest", err)
+	}
+	upgraded := *manifest
+	upgraded.BlueprintVersion = rendered.BlueprintVersion
+	upgraded.Deprecations = rendered.Deprecations
+	upgraded.Experiments = rendered.Experiments
+
+	plan := &UpgradePlan{
+		Project:     projectPath,
+		Blueprint:   manifest.Blueprint,
+		FromVersion: manifest.BlueprintVersion,
+		ToVersion:   tmpl.Version,
+		Tracked:     manifest.Files != nil,
+		Create:      []string{},
+		Update:      []string{},
+		Merge:       []string{},
+		Conflicts:   []string{},
+		Deleted:     []string{},
+		Obsolete:    []string{},
+		manifest:    upgraded,
+		lock:        files[LockFile],
+		files:       make(map[string]GeneratedFile),
+		existing:    make(map[string]GeneratedFile),
+	}
+
+	paths := slices.Sorted(maps.Keys(files))
+	for _, file := range paths {
+		if isMetadataFile(file) {
+			continue
+		}
+		generated := files[file]
+		recorded, tracked := manifest.Files[file]
+		existing, err := readProjectFile(projectPath, file)
+		switch {
+		case errors.Is(err, fs.ErrNotExist) && tracked:
+			plan.Deleted = append(plan.Deleted, file)
+			continue
+		case errors.Is(err, fs.ErrNotExist):
+			plan.Create = append(plan.Create, file)
+		case err != nil:
+			return nil, types.NewFileSystemError("failed to read "+file, err)
+		case sameFile(existing, generated):
+			continue
+		case file == "go.mod":
+			merged, err := mergeGoMod(existing.Content, generated.Content, true)
+			if err != nil {
+				return nil, err
+			}
+			if bytes.Equal(merged, existing.Content) {
+				continue
+			}
+			plan.Merge = append(plan.Merge, file)
+			generated = GeneratedFile{Content: merged, Mode: existing.Mode}
+		case tracked && checksum(existing) == recorded:
+			plan.Update = append(plan.Update, file)
+		default:
+			plan.Conflicts = append(plan.Conflicts, file)
+			plan.existing[file] = existing
+		}
+		plan.files[file] = generated
+	}
+
+	for file := range manifest.Files {
+		if _, ok := files[file]; ok {
+			continue
+		}
+		if _, err := readProjectFile(projectPath, file); err == nil {
+			plan.Obsolete = append(plan.Obsolete, file)
+		}
+	}
+	sort.Strings(plan.Obsolete)
+	return plan, nil
+}
+
+// ApplyUpgrade writes the changes of plan to the project and records the new
+// blueprint version in its generation manifest and lock. Edited files are kept and the
+// changes of the new version are written next to them with UpgradeRejectSuffix,
+// unless overwrite is set: the new version then replaces them and the edited file
+// is kept with UpgradeOriginalSuffix.
+func (g *Generator) ApplyUpgrade(plan *UpgradePlan, overwrite bool) error {
+	manifest := plan.manifest
+	manifest.Files = maps.Clone(manifest.Files)
+	if manifest.Files == nil {
+		manifest.Files = make(map[string]string)
+	}
+
+	for _, file := range slices.Concat(plan.Create, plan.Update, plan.Merge) {
+		if err := g.writeProjectFile(plan.Project, file, plan.files[file]); err != nil {
+			return err
+		}
+		manifest.Files[file] = checksum(plan.files[file])
+	}
+	for _, file := range plan.Conflicts {
+		generated, existing := plan.files[file], plan.existing[file]
+		if overwrite {
+			if err := g.writeProjectFile(plan.Project, file+UpgradeOriginalSuffix, existing); err != nil {
+				return err
+			}
+			if err := g.writeProjectFile(plan.Project, file, generated); err != nil {
+				return err
+			}
+			manifest.Files[file] = checksum(generated)
+			continue
+		}
+
+		reject := generated
+		if !generated.Binary && generated.Symlink == "" && existing.Symlink == "" {
+			reject = GeneratedFile{Content: []byte(UnifiedDiff(file, existing.Content, generated.Content)), Mode: types.DefaultFileMode}
+		}
+		if err := g.writeProjectFile(plan.Project, file+UpgradeRejectSuffix, reject); err != nil {
+			return err
+		}
+	}
+	for _, file := range plan.Obsolete {
+		delete(manifest.Files, file)
+	}
+
+	upgradedAt := time.Now().UTC()
+	manifest.UpgradedAt = &upgradedAt
+	if err := g.writeManifest(plan.Project, manifest); err != nil {
+		return err
+	}
+	return g.writeProjectFile(plan.Project, LockFile, plan.lock)
+}
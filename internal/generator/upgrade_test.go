@@ -0,0 +1,138 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// upgradeTestBlueprint returns the upgrade-test blueprint at version with files
+func upgradeTestBlueprint(version string, files map[string]string) fstest.MapFS {
+	fsys := fstest.MapFS{}
+	manifest := "id: \"upgrade-test\"\nname: \"upgrade-test\"\ntype: \"web-api\"\nversion: \"" + version + "\"\nfiles:\n"
+	for destination, content := range files {
+		manifest += "  - source: \"" + destination + ".tmpl\"\n    destination: \"" + destination + "\"\n"
+		fsys["upgrade-test/"+destination+".tmpl"] = &fstest.MapFile{Data: []byte(content)}
+	}
+	fsys["upgrade-test/template.yaml"] = &fstest.MapFile{Data: []byte(manifest)}
+	return fsys
+}
+
+func TestPlanUpgrade(t *testing.T) {
+	registry := newTestRegistry(t, upgradeTestBlueprint("1.0.0", map[string]string{
+		"go.mod":    "module {{.ModulePath}}\n\ngo 1.22\n\nrequire github.com/google/uuid v1.5.0\n",
+		"main.go":   "package main\n\nfunc main() {}\n",
+		"server.go": "package main\n\n// server v1\n",
+		"legacy.go": "package main\n",
+		"README.md": "# {{.ProjectName}}\n",
+		"notes.md":  "v1\n",
+	}))
+
+	projectPath := filepath.Join(t.TempDir(), "svc")
+	_, err := NewWithRegistry(registry).Generate(types.ProjectConfig{
+		Name:      "svc",
+		Module:    "github.com/test/svc",
+		Type:      "web-api",
+		Variables: map[string]string{"blueprint_id": "upgrade-test"},
+	}, types.GenerationOptions{OutputPath: projectPath, NoGit: true})
+	require.NoError(t, err)
+
+	// main.go is edited and notes.md deleted since generation
+	require.NoError(t, os.WriteFile(filepath.Join(projectPath, "main.go"), []byte("package main\n\nfunc main() { run() }\n"), 0644))
+	require.NoError(t, os.Remove(filepath.Join(projectPath, "notes.md")))
+
+	// The blueprint is released again
+	upgraded := newTestRegistry(t, upgradeTestBlueprint("1.1.0", map[string]string{
+		"go.mod":    "module {{.ModulePath}}\n\ngo 1.22\n\nrequire (\n\tgithub.com/google/uuid v1.6.0\n\tgolang.org/x/sync v0.8.0\n)\n",
+		"main.go":   "package main\n\nfunc main() { serve() }\n",
+		"server.go": "package main\n\n// server v2\n",
+		"README.md": "# {{.ProjectName}}\n",
+		"notes.md":  "v2\n",
+		"health.go": "package main\n",
+	}))
+
+	gen := NewWithRegistry(upgraded)
+	plan, err := gen.PlanUpgrade(context.Background(), projectPath)
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0", plan.FromVersion)
+	assert.Equal(t, "1.1.0", plan.ToVersion)
+	assert.True(t, plan.Tracked)
+	assert.Equal(t, []string{"health.go"}, plan.Create)
+	assert.Equal(t, []string{"server.go"}, plan.Update)
+	assert.Equal(t, []string{"go.mod"}, plan.Merge)
+	assert.Equal(t, []string{"main.go"}, plan.Conflicts)
+	assert.Equal(t, []string{"notes.md"}, plan.Deleted)
+	assert.Equal(t, []string{"legacy.go"}, plan.Obsolete)
+
+	require.NoError(t, gen.ApplyUpgrade(plan, false))
+
+	server, err := os.ReadFile(filepath.Join(projectPath, "server.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(server), "server v2")
+	assert.FileExists(t, filepath.Join(projectPath, "health.go"))
+	assert.NoFileExists(t, filepath.Join(projectPath, "notes.md"), "deleted files are not restored")
+	assert.FileExists(t, filepath.Join(projectPath, "legacy.go"), "obsolete files are left to remove by hand")
+
+	goMod, err := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+	require.NoError(t, err)
+	assert.Contains(t, string(goMod), "github.com/google/uuid v1.6.0", "required versions are raised")
+	assert.Contains(t, string(goMod), "golang.org/x/sync v0.8.0")
+
+	main, err := os.ReadFile(filepath.Join(projectPath, "main.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(main), "run()", "edited files are kept")
+	reject, err := os.ReadFile(filepath.Join(projectPath, "main.go"+UpgradeRejectSuffix))
+	require.NoError(t, err)
+	assert.Contains(t, string(reject), "--- a/main.go\n+++ b/main.go\n")
+	assert.Contains(t, string(reject), "-func main() { run() }\n+func main() { serve() }\n")
+
+	manifest, err := ReadManifest(projectPath)
+	require.NoError(t, err)
+	assert.Equal(t, "1.1.0", manifest.BlueprintVersion)
+	require.NotNil(t, manifest.UpgradedAt)
+	assert.NotContains(t, manifest.Files, "legacy.go")
+	assert.Contains(t, manifest.Files, "health.go")
+
+	// Only the conflict is left, and overwriting keeps the edited file aside
+	plan, err = gen.PlanUpgrade(context.Background(), projectPath)
+	require.NoError(t, err)
+	assert.Empty(t, plan.Update)
+	assert.Equal(t, []string{"main.go"}, plan.Conflicts)
+
+	require.NoError(t, gen.ApplyUpgrade(plan, true))
+	main, err = os.ReadFile(filepath.Join(projectPath, "main.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(main), "serve()")
+	original, err := os.ReadFile(filepath.Join(projectPath, "main.go"+UpgradeOriginalSuffix))
+	require.NoError(t, err)
+	assert.Contains(t, string(original), "run()")
+
+	plan, err = gen.PlanUpgrade(context.Background(), projectPath)
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), "the project is up to date")
+}
+
+func TestPlanUpgrade_Untracked(t *testing.T) {
+	registry := setupAddTestTemplates(t)
+	projectPath := generateAddTestProject(t, registry)
+
+	// Manifests written before checksums were recorded have no files
+	manifest, err := ReadManifest(projectPath)
+	require.NoError(t, err)
+	manifest.Files = nil
+	require.NoError(t, NewWithRegistry(registry).writeManifest(projectPath, *manifest))
+	require.NoError(t, os.WriteFile(filepath.Join(projectPath, "main.go"), []byte("package main // edited\n"), 0644))
+
+	plan, err := NewWithRegistry(registry).PlanUpgrade(context.Background(), projectPath)
+	require.NoError(t, err)
+	assert.False(t, plan.Tracked)
+	assert.Equal(t, []string{"main.go"}, plan.Conflicts)
+	assert.Empty(t, plan.Update)
+}
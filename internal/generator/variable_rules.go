@@ -0,0 +1,37 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// VariableViolations lists the rules of its variables the blueprint would reject
+// a generation with config for, so that forms can show them before generating.
+// An empty blueprintID selects the blueprint from config like Generate does.
+func (g *Generator) VariableViolations(config types.ProjectConfig, blueprintID string) ([]types.VariableViolation, error) {
+	if blueprintID == "" {
+		blueprintID = g.getTemplateID(config)
+	}
+	tmpl, err := g.registry.Get(blueprintID)
+	if err != nil {
+		return nil, err
+	}
+	return tmpl.CheckVariables(g.createTemplateContext(config, tmpl)), nil
+}
+
+// checkVariableRules rejects a configuration breaking the validation rules the
+// blueprint declares for its variables, with every rule it breaks
+func (g *Generator) checkVariableRules(tmpl types.Template, config types.ProjectConfig) error {
+	violations := tmpl.CheckVariables(g.createTemplateContext(config, tmpl))
+	if len(violations) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(violations))
+	for i, violation := range violations {
+		messages[i] = violation.Message
+	}
+	return types.NewValidationError(fmt.Sprintf("invalid options for blueprint %s: %s", tmpl.ID, strings.Join(messages, "; ")), nil)
+}
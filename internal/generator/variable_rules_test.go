@@ -0,0 +1,93 @@
+package generator
+
+import (
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func setupRuleTestTemplates(t *testing.T) *templates.Registry {
+	t.Helper()
+
+	return newTestRegistry(t, fstest.MapFS{
+		"rules-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "rules-test"
+name: "rules-test"
+type: "web-api"
+variables:
+  - name: "Port"
+    type: "int"
+    default: 8080
+    min: 1024
+    max: 65535
+  - name: "Region"
+    default: "eu"
+    enum: ["eu", "us"]
+  - name: "DatabaseDriver"
+    default: ""
+  - name: "DatabaseORM"
+    default: ""
+    requires:
+      - variable: "DatabaseDriver"
+        message: "a database ORM needs a database"
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+`)},
+		"rules-test/main.go.tmpl": &fstest.MapFile{Data: []byte("package main\n\n// {{.Port}}\n")},
+	})
+}
+
+// ruleTestConfig configures a rules-test project with variables and the database
+// driver and ORM
+func ruleTestConfig(variables map[string]string, driver, orm string) types.ProjectConfig {
+	config := types.ProjectConfig{
+		Name:      "orders",
+		Module:    "example.com/orders",
+		Type:      "web-api",
+		Variables: map[string]string{"blueprint_id": "rules-test"},
+		Features:  &types.Features{},
+	}
+	for name, value := range variables {
+		config.Variables[name] = value
+	}
+	config.Features.Database.Driver = driver
+	config.Features.Database.ORM = orm
+	return config
+}
+
+func TestGenerate_VariableRules(t *testing.T) {
+	registry := setupRuleTestTemplates(t)
+
+	_, err := NewWithRegistry(registry).Generate(ruleTestConfig(nil, "postgres", "gorm"), types.GenerationOptions{OutputPath: filepath.Join(t.TempDir(), "valid"), NoGit: true})
+	require.NoError(t, err)
+
+	outputPath := filepath.Join(t.TempDir(), "orders")
+	_, err = NewWithRegistry(registry).Generate(ruleTestConfig(map[string]string{"Port": "80", "Region": "ap"}, "", "gorm"), types.GenerationOptions{OutputPath: outputPath, NoGit: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "VALIDATION_ERROR")
+	assert.Contains(t, err.Error(), `invalid options for blueprint rules-test: Port "80" must be at least 1024; Region "ap" is not one of "eu", "us"; a database ORM needs a database`)
+	assert.NoDirExists(t, outputPath, "nothing is written for a configuration the blueprint rejects")
+}
+
+func TestVariableViolations(t *testing.T) {
+	registry := setupRuleTestTemplates(t)
+
+	violations, err := NewWithRegistry(registry).VariableViolations(ruleTestConfig(nil, "", "gorm"), "")
+	require.NoError(t, err)
+	assert.Equal(t, []types.VariableViolation{{Variable: "DatabaseORM", Value: "gorm", Message: "a database ORM needs a database"}}, violations)
+
+	options, err := NewWithRegistry(registry).Options("rules-test")
+	require.NoError(t, err)
+	assert.Equal(t, []OptionRequirement{{Option: "DatabaseDriver", Message: "a database ORM needs a database"}}, options[3].Requires, "forms show the requirements of the blueprint")
+	assert.Equal(t, 1024.0, *options[0].Min)
+
+	_, err = NewWithRegistry(registry).VariableViolations(ruleTestConfig(nil, "", ""), "missing")
+	assert.Error(t, err)
+}
@@ -0,0 +1,76 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_VerticalSlice(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := &types.ProjectConfig{
+		Name:         "my-api",
+		Module:       "github.com/test/my-api",
+		Type:         "web-api",
+		Architecture: "vertical-slice",
+		Framework:    "echo",
+		Logger:       "slog",
+		Variables:    map[string]string{"DatabaseDriver": "postgres"},
+	}
+	assert.Equal(t, "web-api-vertical-slice", New().getTemplateID(*config))
+
+	files, err := New().GenerateInMemoryFiles(ctx, config, "web-api-vertical-slice")
+	require.NoError(t, err)
+
+	for _, slice := range []string{"createuser", "getuser", "listusers", "deleteuser"} {
+		for _, file := range []string{"handler.go", "request.go", "service.go", "service_test.go", "repository.go"} {
+			assert.Contains(t, files, "internal/features/users/"+slice+"/"+file)
+		}
+	}
+	assert.Contains(t, files, "internal/mediator/mediator.go")
+	assert.Contains(t, files, "internal/platform/database/migrations/001_create_users.sql")
+
+	router := string(files["internal/platform/server/router.go"].Content)
+	assert.Contains(t, router, "github.com/labstack/echo/v4")
+	assert.Contains(t, router, "r.SetPathValue(name, values[i])")
+
+	repository := string(files["internal/features/users/getuser/repository.go"].Content)
+	assert.Contains(t, repository, "WHERE id = $1")
+
+	features := string(files["internal/features/features.go"].Content)
+	assert.Contains(t, features, "createuser.Register(m, createuser.NewRepository(db), clk)")
+
+	goMod := string(files["go.mod"].Content)
+	assert.Contains(t, goMod, "go 1.22")
+	assert.Contains(t, goMod, "github.com/lib/pq")
+	assert.NotContains(t, goMod, "go-sqlite3")
+}
+
+func TestGenerateInMemoryFiles_VerticalSliceDefaults(t *testing.T) {
+	setupTestTemplates(t)
+
+	files, err := New().GenerateInMemoryFiles(context.Background(), &types.ProjectConfig{
+		Name:         "my-api",
+		Module:       "github.com/test/my-api",
+		Type:         "web-api",
+		Architecture: "vertical-slice",
+		Framework:    "stdlib",
+		Logger:       "slog",
+	}, "web-api-vertical-slice")
+	require.NoError(t, err)
+
+	router := string(files["internal/platform/server/router.go"].Content)
+	assert.Contains(t, router, `mux.HandleFunc(route.Method+" "+route.Path, route.Handler)`)
+
+	// SQLite is the default database, with ? placeholders and cgo in the image
+	repository := string(files["internal/features/users/getuser/repository.go"].Content)
+	assert.Contains(t, repository, "WHERE id = ?")
+	assert.Contains(t, string(files["go.mod"].Content), "github.com/mattn/go-sqlite3")
+	assert.Contains(t, string(files["Dockerfile"].Content), "CGO_ENABLED=1")
+}
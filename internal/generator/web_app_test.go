@@ -0,0 +1,75 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_WebApp(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(driver, orm string) *types.ProjectConfig {
+		cfg := &types.ProjectConfig{
+			Name:      "notes",
+			Module:    "github.com/test/notes",
+			Type:      "web-app",
+			Logger:    "slog",
+			Variables: map[string]string{},
+		}
+		if driver != "" {
+			cfg.Features = &types.Features{Database: types.DatabaseConfig{Drivers: []string{driver}, ORM: orm}}
+		}
+		return cfg
+	}
+
+	t.Run("in memory by default", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("", ""), "web-app")
+		require.NoError(t, err)
+
+		for _, path := range []string{
+			"internal/views/layout.templ",
+			"internal/views/todos.templ",
+			"internal/session/session.go",
+			"internal/web/handlers.go",
+			"static/static.go",
+			"static/js/app.js",
+		} {
+			assert.Contains(t, files, path)
+		}
+		assert.NotContains(t, files, "internal/database/database.go")
+		assert.NotContains(t, files, "docker-compose.yml")
+		assert.Contains(t, string(files["cmd/server/main.go"].Content), "todo.NewMemoryStore()")
+		assert.Contains(t, string(files["go.mod"].Content), "go 1.23", "templ needs Go 1.23")
+		assert.Contains(t, string(files["go.mod"].Content), "github.com/a-h/templ")
+	})
+
+	t.Run("database/sql", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("postgres", ""), "web-app")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "internal/todo/sql.go")
+		assert.NotContains(t, files, "internal/todo/gorm.go")
+		assert.Contains(t, files, "docker-compose.yml")
+		assert.Contains(t, string(files["internal/todo/sql.go"].Content), "RETURNING id")
+		assert.Contains(t, string(files["cmd/server/main.go"].Content), "todo.NewSQLStore(db)")
+		assert.Contains(t, string(files["go.mod"].Content), "github.com/lib/pq")
+	})
+
+	t.Run("gorm", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("sqlite", "gorm"), "web-app")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "internal/todo/gorm.go")
+		assert.NotContains(t, files, "internal/todo/sql.go")
+		assert.NotContains(t, files, "docker-compose.yml")
+		assert.Contains(t, string(files["internal/database/database.go"].Content), `"gorm.io/driver/sqlite"`)
+		assert.Contains(t, string(files["cmd/server/main.go"].Content), "todo.NewGormStore(db)")
+		assert.Contains(t, string(files["Dockerfile"].Content), "CGO_ENABLED=1")
+	})
+}
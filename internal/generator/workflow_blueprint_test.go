@@ -0,0 +1,55 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_Workflow(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	files, err := New().GenerateInMemoryFiles(ctx, &types.ProjectConfig{
+		Name:   "orders",
+		Module: "github.com/test/orders",
+		Type:   "workflow",
+		Logger: "zap",
+	}, "workflow")
+	require.NoError(t, err)
+
+	for _, path := range []string{
+		"cmd/worker/main.go",
+		"cmd/starter/main.go",
+		"docker-compose.yml",
+		"internal/orders/workflow.go",
+		"internal/orders/activities.go",
+		"internal/orders/policies.go",
+		"internal/orders/workflow_test.go",
+		"internal/orders/replay_test.go",
+		"internal/orders/testdata/histories/README.md",
+		"internal/logger/zap.go",
+	} {
+		assert.Contains(t, files, path)
+	}
+	assert.NotContains(t, files, "internal/logger/slog.go")
+
+	policies := string(files["internal/orders/policies.go"].Content)
+	assert.Contains(t, policies, "NonRetryableErrorTypes: []string{ErrTypeInvalidOrder, ErrTypePaymentDeclined}")
+
+	worker := string(files["cmd/worker/main.go"].Content)
+	assert.Contains(t, worker, "orders.Register(w, activities)")
+	assert.Contains(t, worker, "w.Run(worker.InterruptCh())")
+
+	compose := string(files["docker-compose.yml"].Content)
+	assert.Contains(t, compose, "temporalio/auto-setup")
+
+	goMod := string(files["go.mod"].Content)
+	assert.Contains(t, goMod, "go 1.22")
+	assert.Contains(t, goMod, "go.temporal.io/sdk v1.33.0")
+	assert.Contains(t, goMod, "go.uber.org/zap")
+}
@@ -0,0 +1,81 @@
+package generator
+
+import (
+	"fmt"
+	"go/version"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Variables of the workspace blueprint computed from the options it keeps from the
+// 1.x workspace, which are strings when set on the command line and booleans when
+// defaulted, so that its templates can test them with {{if}}
+const (
+	WorkspaceHasCLIVariable           = "HasCLI"
+	WorkspaceHasServicesVariable      = "HasServices"
+	WorkspaceHasStorageVariable       = "HasStorage"
+	WorkspaceHasEventsVariable        = "HasEvents"
+	WorkspaceHasLegacyModulesVariable = "HasLegacyModules"
+	WorkspaceHasKubernetesVariable    = "HasKubernetes"
+	WorkspaceLegacyGoVersionVariable  = "LegacyGoVersion"
+	WorkspaceGoVersionVariable        = "WorkspaceGoVersion"
+)
+
+// legacyModulesMinGoVersion is the Go version the dependencies of the deprecated
+// workspace modules require
+const legacyModulesMinGoVersion = "1.23.0"
+
+// addWorkspaceVariables tells the workspace templates which deprecated modules to
+// generate, along with the pkg/shared and pkg/models modules they build on, and
+// the Go versions of those modules and of go.work
+func addWorkspaceVariables(context map[string]any, tmpl types.Template) {
+	if tmpl.Type != "workspace" {
+		return
+	}
+
+	hasCLI := contextValue(context, "EnableCLI") == "true"
+	hasServices := contextValue(context, "EnableMicroservices") == "true"
+	hasStorage := contextChoice(context, "DatabaseType")
+	hasEvents := contextChoice(context, "MessageQueue")
+	hasLegacyModules := hasCLI || hasServices || hasStorage || hasEvents
+
+	context[WorkspaceHasCLIVariable] = hasCLI
+	context[WorkspaceHasServicesVariable] = hasServices
+	context[WorkspaceHasStorageVariable] = hasStorage
+	context[WorkspaceHasEventsVariable] = hasEvents
+	context[WorkspaceHasLegacyModulesVariable] = hasLegacyModules
+	context[WorkspaceHasKubernetesVariable] = contextValue(context, "EnableKubernetes") == "true"
+
+	goVersion := contextValue(context, "GoVersion")
+	legacyGoVersion := goVersion
+	if version.Compare("go"+goVersion, "go"+legacyModulesMinGoVersion) < 0 {
+		legacyGoVersion = legacyModulesMinGoVersion
+	}
+	context[WorkspaceLegacyGoVersionVariable] = legacyGoVersion
+
+	// go.work needs the highest Go version of its modules; the api, worker and
+	// shared modules route with the patterns of Go 1.22
+	switch {
+	case hasLegacyModules:
+		context[WorkspaceGoVersionVariable] = legacyGoVersion
+	case goVersion == "1.21":
+		context[WorkspaceGoVersionVariable] = "1.22"
+	default:
+		context[WorkspaceGoVersionVariable] = goVersion
+	}
+}
+
+// contextValue returns a context variable as text, empty when it is not set
+func contextValue(context map[string]any, name string) string {
+	value, ok := context[name]
+	if !ok || value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// contextChoice reports whether a choice variable is set to something other than none
+func contextChoice(context map[string]any, name string) bool {
+	value := contextValue(context, name)
+	return value != "" && value != "none"
+}
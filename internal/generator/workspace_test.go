@@ -0,0 +1,127 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestGenerateInMemoryFiles_Workspace(t *testing.T) {
+	setupTestTemplates(t)
+	ctx := context.Background()
+
+	config := func(architecture, framework, logger string) *types.ProjectConfig {
+		return &types.ProjectConfig{
+			Name:         "platform",
+			Module:       "github.com/test/platform",
+			Type:         "workspace",
+			Architecture: architecture,
+			Framework:    framework,
+			Logger:       logger,
+			Variables:    map[string]string{},
+		}
+	}
+
+	t.Run("go.work ties the modules together", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("standard", "gin", "slog"), "workspace")
+		require.NoError(t, err)
+
+		work := string(files["go.work"].Content)
+		for _, module := range []string{"./api", "./shared", "./worker"} {
+			assert.Contains(t, work, module)
+		}
+		assert.Contains(t, string(files["Makefile"].Content), "MODULES := shared api worker")
+		assert.Contains(t, string(files["shared/go.mod"].Content), "module github.com/test/platform/shared")
+		for _, module := range []string{"api", "worker"} {
+			goMod := string(files[module+"/go.mod"].Content)
+			assert.Contains(t, goMod, "github.com/test/platform/shared v0.0.0")
+			assert.Contains(t, goMod, "replace github.com/test/platform/shared => ../shared")
+		}
+		assert.Contains(t, files, "api/internal/handlers/task_handler.go")
+		assert.Contains(t, files, "worker/internal/jobs/runner.go")
+	})
+
+	t.Run("modules follow the architecture", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("clean", "chi", "slog"), "workspace")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "api/internal/domain/usecases/task_usecase.go")
+		assert.Contains(t, files, "api/internal/adapters/controllers/task_controller.go")
+		assert.Contains(t, files, "worker/internal/domain/usecases/run_jobs.go")
+		assert.NotContains(t, files, "api/internal/handlers/task_handler.go")
+		assert.Contains(t, string(files["api/internal/server/router.go"].Content), "chi.URLParam")
+
+		files, err = New().GenerateInMemoryFiles(ctx, config("hexagonal", "stdlib", "slog"), "workspace")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "api/internal/application/ports/input/task_service.go")
+		assert.Contains(t, files, "api/internal/adapters/primary/rest/task_handler.go")
+		assert.Contains(t, files, "worker/internal/adapters/primary/scheduler/scheduler.go")
+		assert.NotContains(t, files, "api/internal/domain/usecases/task_usecase.go")
+		assert.Contains(t, string(files["api/internal/server/router.go"].Content), `"GET /api/v1/tasks/{id}"`)
+	})
+
+	t.Run("shared logger uses the logger flag", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("ddd", "gin", "zap"), "workspace")
+		require.NoError(t, err)
+
+		assert.Contains(t, files, "shared/logger/zap.go")
+		assert.NotContains(t, files, "shared/logger/slog.go")
+		assert.Contains(t, string(files["shared/go.mod"].Content), "go.uber.org/zap")
+		assert.NotContains(t, string(files["api/go.mod"].Content), "go.uber.org/zap")
+	})
+	t.Run("deprecated options generate the 1.x modules", func(t *testing.T) {
+		files, err := New().GenerateInMemoryFiles(ctx, config("standard", "gin", "slog"), "workspace")
+		require.NoError(t, err)
+
+		assert.NotContains(t, files, "pkg/shared/go.mod")
+		assert.NotContains(t, files, "cmd/cli/go.mod")
+		assert.NotContains(t, files, "deployments/k8s/namespace.yaml")
+
+		legacy := config("standard", "echo", "zap")
+		legacy.Variables = map[string]string{
+			"EnableCLI": "true", "EnableMicroservices": "true", "DatabaseType": "mysql",
+			"MessageQueue": "redis", "EnableKubernetes": "true",
+		}
+		files, err = New().GenerateInMemoryFiles(ctx, legacy, "workspace")
+		require.NoError(t, err)
+
+		for _, module := range []string{"pkg/shared", "pkg/models", "pkg/storage", "pkg/events", "cmd/cli", "cmd/user-service", "cmd/notification-service"} {
+			assert.Contains(t, files, module+"/go.mod")
+			assert.Contains(t, string(files["go.work"].Content), "./"+module)
+			assert.Contains(t, string(files["Makefile"].Content), " "+module)
+		}
+		assert.Contains(t, string(files["pkg/storage/database.go"].Content), "github.com/go-sql-driver/mysql")
+		assert.Contains(t, string(files["pkg/events/event_bus.go"].Content), "github.com/redis/go-redis/v9")
+		assert.Contains(t, string(files["cmd/user-service/go.mod"].Content), "github.com/labstack/echo/v4")
+		assert.Contains(t, string(files["docker-compose.yml"].Content), "mysql:8.0")
+		assert.Contains(t, string(files["deployments/k8s/configmap.yaml"].Content), "PLATFORM_DATABASE_HOST: mysql")
+		assert.Contains(t, files, "deployments/k8s/user-service-deployment.yaml")
+	})
+
+	t.Run("deprecated modules raise the Go version of go.work", func(t *testing.T) {
+		disabled := config("standard", "gin", "slog")
+		disabled.GoVersion = "1.22"
+		disabled.Variables = map[string]string{"EnableCLI": "false", "DatabaseType": "none"}
+		files, err := New().GenerateInMemoryFiles(ctx, disabled, "workspace")
+		require.NoError(t, err)
+
+		assert.NotContains(t, files, "cmd/cli/go.mod")
+		assert.Contains(t, string(files["go.work"].Content), "go 1.22\n")
+
+		legacy := config("standard", "gin", "slog")
+		legacy.GoVersion = "1.22"
+		legacy.Variables = map[string]string{"DatabaseType": "sqlite"}
+		files, err = New().GenerateInMemoryFiles(ctx, legacy, "workspace")
+		require.NoError(t, err)
+
+		assert.Contains(t, string(files["go.work"].Content), "go 1.23.0\n")
+		assert.Contains(t, string(files["pkg/storage/go.mod"].Content), "go 1.23.0\n")
+		assert.Contains(t, string(files["api/go.mod"].Content), "go 1.22\n")
+		assert.NotContains(t, files, "cmd/cli/go.mod")
+	})
+}
@@ -0,0 +1,246 @@
+// Package golden renders blueprints with sets of variables and compares the
+// projects with golden trees committed under testdata, so that a change to a
+// template shows up as a diff of the files it generates rather than as a
+// failure of the generated project at runtime.
+//
+// The trees are rewritten from the current blueprints with
+//
+//	go test ./internal/golden -update
+package golden
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/francknouama/go-starter/internal/blueprint"
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Project names the projects rendered for the golden trees
+const (
+	ProjectName   = "golden"
+	ProjectModule = "github.com/example/golden"
+)
+
+// Time is the time the blueprints render, for dates in licenses and changelogs
+var Time = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Case is a set of variables a blueprint is rendered with
+type Case struct {
+	// Name names the golden tree of the case, in the directory of its blueprint
+	Name      string
+	Framework string
+	Logger    string
+	Variables map[string]string
+}
+
+// Config returns the project configuration of the case for tmpl. The framework
+// and logger the case leaves out are the defaults of the blueprint.
+func (c Case) Config(tmpl types.Template) types.ProjectConfig {
+	variables := map[string]string{"blueprint_id": tmpl.ID}
+	for name, value := range c.Variables {
+		variables[name] = value
+	}
+	return types.ProjectConfig{
+		Name:         ProjectName,
+		Module:       ProjectModule,
+		Type:         tmpl.Type,
+		Architecture: tmpl.Architecture,
+		Framework:    blueprint.OrDefault(c.Framework, tmpl, "Framework"),
+		Logger:       blueprint.OrDefault(c.Logger, tmpl, "Logger"),
+		GoVersion:    "1.23",
+		Variables:    variables,
+	}
+}
+
+// Render renders the case of the blueprint in memory, the symlinks as their
+// target. The generation manifest and lock are left out: they record the time of
+// the generation and the versions of the tools.
+func Render(ctx context.Context, registry *templates.Registry, blueprintID string, c Case) (map[string][]byte, error) {
+	tmpl, err := registry.Get(blueprintID)
+	if err != nil {
+		return nil, err
+	}
+	config := c.Config(tmpl)
+
+	gen := generator.NewWithRegistry(registry)
+	gen.PinTime(Time)
+	generated, err := gen.GenerateInMemoryFiles(ctx, &config, blueprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte, len(generated))
+	for path, file := range generated {
+		if path == generator.ManifestFile || path == generator.LockFile {
+			continue
+		}
+		content := file.Content
+		if file.Symlink != "" {
+			content = []byte(file.Symlink)
+		}
+		files[filepath.ToSlash(path)] = content
+	}
+	return files, nil
+}
+
+// Kinds of differences between a rendered project and its golden tree
+const (
+	Missing    = "missing"
+	Unexpected = "unexpected"
+	Changed    = "changed"
+)
+
+// Difference is a file of the golden tree the rendered project does not match
+type Difference struct {
+	Path string
+	// Kind is Missing for the files no longer rendered, Unexpected for those not
+	// in the golden tree, Changed for the others
+	Kind string
+	// Diff is the unified diff from the golden file to the rendered one
+	Diff string
+}
+
+// String describes the difference, with its diff
+func (d Difference) String() string {
+	switch d.Kind {
+	case Missing:
+		return d.Path + " is no longer generated"
+	case Unexpected:
+		return d.Path + " is generated but not in the golden tree"
+	}
+	return d.Path + " changed:\n" + d.Diff
+}
+
+// Compare lists the differences between the rendered files and the golden tree
+// in dir, in path order. A missing tree is an error.
+func Compare(dir string, files map[string][]byte) ([]Difference, error) {
+	golden, err := read(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := slices.Collect(maps.Keys(golden))
+	for path := range files {
+		if _, ok := golden[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var differences []Difference
+	for _, path := range paths {
+		want, inTree := golden[path]
+		got, rendered := files[path]
+		switch {
+		case !rendered:
+			differences = append(differences, Difference{Path: path, Kind: Missing})
+		case !inTree:
+			differences = append(differences, Difference{Path: path, Kind: Unexpected})
+		case string(want) != string(got):
+			differences = append(differences, Difference{Path: path, Kind: Changed, Diff: generator.UnifiedDiff(path, want, got)})
+		}
+	}
+	return differences, nil
+}
+
+// escapedSuffix is added to the git files of the rendered projects in the golden
+// trees, which would otherwise apply to the repository holding them
+const escapedSuffix = ".golden"
+
+// escape returns the name of a rendered file in a golden tree
+func escape(path string) string {
+	switch filepath.Base(path) {
+	case ".gitignore", ".gitattributes":
+		return path + escapedSuffix
+	}
+	return path
+}
+
+// unescape returns the name of the rendered file of a file of a golden tree
+func unescape(path string) string {
+	if trimmed := strings.TrimSuffix(path, escapedSuffix); escape(trimmed) == path {
+		return trimmed
+	}
+	return path
+}
+
+// read returns the files of the golden tree in dir by slash path
+func read(dir string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	err := filepath.WalkDir(dir, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, name)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		files[unescape(filepath.ToSlash(rel))] = content
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("no golden tree in %s, create it with -update", dir)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read golden tree %s: %w", dir, err)
+	}
+	return files, nil
+}
+
+// Write replaces the golden tree in dir with the rendered files. Their .gitignore
+// and .gitattributes files are written with a .golden suffix.
+func Write(dir string, files map[string][]byte) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to remove golden tree %s: %w", dir, err)
+	}
+	for path, content := range files {
+		name := filepath.Join(dir, filepath.FromSlash(escape(path)))
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			return fmt.Errorf("failed to write golden tree %s: %w", dir, err)
+		}
+		if err := os.WriteFile(name, content, 0644); err != nil {
+			return fmt.Errorf("failed to write golden tree %s: %w", dir, err)
+		}
+	}
+	return nil
+}
+
+// Check compares the rendered files with the golden tree in dir and reports
+// every difference as an error of t, or rewrites the tree when update is set
+func Check(t testing.TB, dir string, files map[string][]byte, update bool) {
+	t.Helper()
+	if update {
+		if err := Write(dir, files); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	differences, err := Compare(dir, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, difference := range differences {
+		t.Error(difference)
+	}
+	if len(differences) > 0 {
+		t.Logf("%d files differ from %s, review the diffs and run go test ./internal/golden -update if they are intended", len(differences), dir)
+	}
+}
@@ -0,0 +1,129 @@
+package golden
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/templates"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden trees from the current blueprints")
+
+// defaultCase renders a blueprint with the defaults of its variables
+var defaultCase = Case{Name: "default"}
+
+// matrix is the cases every blueprint is rendered with, by blueprint ID
+var matrix = map[string][]Case{
+	"bot":                {defaultCase, {Name: "discord", Variables: map[string]string{"Platform": "discord"}}},
+	"cli":                {defaultCase, {Name: "zap", Logger: "zap"}},
+	"cli-advanced":       {defaultCase},
+	"cli-simple":         {defaultCase},
+	"desktop":            {defaultCase},
+	"event-service":      {defaultCase, {Name: "nats", Variables: map[string]string{"Broker": "nats"}}},
+	"gateway":            {defaultCase},
+	"grpc-gateway":       {defaultCase},
+	"grpc-service":       {defaultCase},
+	"lambda":             {defaultCase, {Name: "serverless", Variables: map[string]string{"DeploymentTool": "serverless"}}},
+	"library":            {defaultCase},
+	"realtime":           {defaultCase},
+	"terraform-provider": {defaultCase},
+	"tui":                {defaultCase},
+	"web-api": {defaultCase, {
+		Name:      "echo-postgres-jwt",
+		Framework: "echo",
+		Variables: map[string]string{"DatabaseDriver": "postgres", "DatabaseORM": "gorm", "AuthType": "jwt"},
+	}},
+	"web-api-clean": {defaultCase, {
+		Name:      "postgres-jwt",
+		Variables: map[string]string{"DatabaseDriver": "postgres", "AuthType": "jwt"},
+	}},
+	"web-api-ddd":            {defaultCase},
+	"web-api-hexagonal":      {defaultCase},
+	"web-api-vertical-slice": {defaultCase},
+	"web-app":                {defaultCase},
+	"workflow":               {defaultCase},
+	"workspace": {defaultCase, {
+		Name: "legacy",
+		Variables: map[string]string{
+			"EnableCLI": "true", "EnableMicroservices": "true", "DatabaseType": "postgres",
+			"MessageQueue": "nats", "EnableKubernetes": "true",
+		},
+	}},
+}
+
+// broken are the blueprints that fail to render, with the reason, until they are fixed
+var broken = map[string]string{
+	"event-driven": "go.mod.tmpl is missing",
+	"lambda-proxy": "scripts/local-dev.sh.tmpl is missing",
+	"microservice": "scripts/generate.sh.tmpl is missing",
+	"monolith":     "partials/footer.html is not defined",
+}
+
+// blueprints returns a registry of the blueprints of the repository
+func blueprints(t *testing.T) *templates.Registry {
+	t.Helper()
+	_, file, _, _ := runtime.Caller(0)
+	registry, err := templates.NewRegistryWithFS(os.DirFS(filepath.Join(filepath.Dir(file), "..", "..", "blueprints")))
+	require.NoError(t, err)
+	return registry
+}
+
+func TestBlueprints(t *testing.T) {
+	registry := blueprints(t)
+	for id := range matrix {
+		if !registry.Exists(id) {
+			t.Errorf("the matrix has cases for blueprint %s, which does not exist", id)
+		}
+	}
+
+	for _, tmpl := range registry.List() {
+		if reason, ok := broken[tmpl.ID]; ok {
+			t.Run(tmpl.ID, func(t *testing.T) { t.Skipf("blueprint does not render: %s", reason) })
+			continue
+		}
+		cases, ok := matrix[tmpl.ID]
+		if !ok {
+			t.Errorf("blueprint %s has no golden cases, add it to the matrix", tmpl.ID)
+			continue
+		}
+		for _, c := range cases {
+			t.Run(tmpl.ID+"/"+c.Name, func(t *testing.T) {
+				files, err := Render(context.Background(), registry, tmpl.ID, c)
+				require.NoError(t, err)
+				Check(t, filepath.Join("testdata", tmpl.ID, c.Name), files, *update)
+			})
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, Write(dir, map[string][]byte{
+		"main.go":    []byte("package main\n"),
+		".gitignore": []byte("bin/\n"),
+		"old.txt":    []byte("old\n"),
+	}))
+	assert.FileExists(t, filepath.Join(dir, ".gitignore.golden"), "git files do not apply to the golden tree")
+
+	differences, err := Compare(dir, map[string][]byte{
+		"main.go":    []byte("package app\n"),
+		".gitignore": []byte("bin/\n"),
+		"new.txt":    []byte("new\n"),
+	})
+	require.NoError(t, err)
+	require.Len(t, differences, 3)
+	assert.Equal(t, Difference{Path: "main.go", Kind: Changed, Diff: differences[0].Diff}, differences[0])
+	assert.Contains(t, differences[0].Diff, "-package main\n+package app\n")
+	assert.Equal(t, Difference{Path: "new.txt", Kind: Unexpected}, differences[1])
+	assert.Equal(t, Difference{Path: "old.txt", Kind: Missing}, differences[2])
+
+	_, err = Compare(filepath.Join(dir, "missing"), nil)
+	assert.ErrorContains(t, err, "-update")
+}
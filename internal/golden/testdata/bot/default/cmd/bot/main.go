@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/example/golden/internal/commands"
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/slack"
+)
+
+const usage = "usage: golden [serve | manifest -url <public URL>]"
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "golden: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	command := "serve"
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	log, err := logger.NewFactory().Create(cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+	log = log.With("service", "golden")
+
+	registry, err := commands.Builtin()
+	if err != nil {
+		return fmt.Errorf("invalid command: %w", err)
+	}
+
+	switch command {
+	case "serve":
+		return serve(cfg, log, registry)
+	case "manifest":
+		return manifest(cfg, log, registry, args)
+	default:
+		return errors.New(usage)
+	}
+}
+
+// serve answers the slack requests until SIGINT or SIGTERM
+func serve(cfg *config.Config, log logger.Logger, registry *commands.Registry) error {
+	if err := cfg.ValidateServe(); err != nil {
+		return err
+	}
+
+	bot := slack.NewHandler(slack.NewVerifier(cfg.SlackSigningSecret), registry, slack.NewClient(cfg.SlackBotToken), log)
+
+	mux := http.NewServeMux()
+	mux.Handle("/slack/", bot)
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	srv := &http.Server{
+		Addr:              cfg.Address(),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	log.Info("Listening", "address", cfg.Address(), "commands", len(registry.Commands()))
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("server stopped: %w", err)
+	case <-ctx.Done():
+	}
+
+	log.Info("Shutting down", "timeout", cfg.ShutdownTimeout.String())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down: %w", err)
+	}
+	// Let the replies in flight reach slack
+	bot.Wait()
+	log.Info("Server stopped")
+	return nil
+}
+
+// manifest prints the app manifest for the commands and events of the bot,
+// with the request URLs under the public URL of the server
+func manifest(cfg *config.Config, log logger.Logger, registry *commands.Registry, args []string) error {
+	flags := flag.NewFlagSet("manifest", flag.ContinueOnError)
+	publicURL := flags.String("url", "", "public URL of the bot, such as https://bot.example.com")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *publicURL == "" {
+		return errors.New(usage)
+	}
+
+	bot := slack.NewHandler(slack.NewVerifier(cfg.SlackSigningSecret), registry, slack.NewClient(cfg.SlackBotToken), log)
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(slack.NewManifest("golden", *publicURL, registry, bot.EventTypes()))
+}
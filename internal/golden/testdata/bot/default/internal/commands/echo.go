@@ -0,0 +1,16 @@
+package commands
+
+import "context"
+
+func init() {
+	register(Command{
+		Name:        "echo",
+		Description: "Repeat a message in the channel",
+		Options: []Option{
+			{Name: "text", Description: "The message to repeat", Required: true},
+		},
+		Handle: func(ctx context.Context, req Request) (Response, error) {
+			return Response{Text: req.Arg("text")}, nil
+		},
+	})
+}
@@ -0,0 +1,13 @@
+package commands
+
+import "context"
+
+func init() {
+	register(Command{
+		Name:        "ping",
+		Description: "Check that the bot is up",
+		Handle: func(ctx context.Context, req Request) (Response, error) {
+			return Response{Text: "pong", Ephemeral: true}, nil
+		},
+	})
+}
@@ -0,0 +1,211 @@
+// Package commands holds the slash commands of the bot, independent of the chat
+// platform. Each command lives in its own file and adds itself to the builtin
+// commands from an init function; the slack handler translates requests
+// and responses to and from the platform format.
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// ErrUnknownCommand is returned for a command or action nobody registered
+var ErrUnknownCommand = errors.New("unknown command")
+
+// Both platforms accept these names for slash commands; action IDs follow the same rule
+var namePattern = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+
+// Option is an argument of a command
+type Option struct {
+	Name        string
+	Description string
+	Required    bool
+}
+
+// Command is a slash command
+type Command struct {
+	// Name is the command name, without the leading slash
+	Name        string
+	Description string
+	Options     []Option
+
+	// Handle answers the command
+	Handle func(ctx context.Context, req Request) (Response, error)
+
+	// Actions answer clicks on the buttons of the command responses, by action ID
+	Actions map[string]ActionHandler
+}
+
+// Request is an invocation of a command
+type Request struct {
+	Command   string
+	Args      map[string]string
+	UserID    string
+	UserName  string
+	ChannelID string
+}
+
+// Arg returns the value of the option called name, empty when not given
+func (r Request) Arg(name string) string {
+	return r.Args[name]
+}
+
+// Action is a click on a button of a previous response
+type Action struct {
+	ID        string
+	Value     string
+	UserID    string
+	UserName  string
+	ChannelID string
+}
+
+// ActionHandler answers an action
+type ActionHandler func(ctx context.Context, action Action) (Response, error)
+
+// Response is the message a command or an action replies with
+type Response struct {
+	Text string
+	// Ephemeral responses are only shown to the user who sent the command
+	Ephemeral bool
+	Buttons   []Button
+}
+
+// Button is an interactive button of a response
+type Button struct {
+	// ActionID selects the ActionHandler answering the click
+	ActionID string
+	Label    string
+	Value    string
+	Style    ButtonStyle
+}
+
+// ButtonStyle is the color of a button
+type ButtonStyle string
+
+// Button styles supported by both platforms
+const (
+	ButtonDefault ButtonStyle = ""
+	ButtonPrimary ButtonStyle = "primary"
+	ButtonDanger  ButtonStyle = "danger"
+)
+
+// Registry holds the commands and actions of the bot
+type Registry struct {
+	commands map[string]Command
+	actions  map[string]ActionHandler
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		commands: make(map[string]Command),
+		actions:  make(map[string]ActionHandler),
+	}
+}
+
+// Register adds a command and its actions
+func (r *Registry) Register(cmd Command) error {
+	if !namePattern.MatchString(cmd.Name) {
+		return fmt.Errorf("invalid command name %q: use 1 to 32 lowercase letters, digits, - or _", cmd.Name)
+	}
+	if cmd.Handle == nil {
+		return fmt.Errorf("command %q has no handler", cmd.Name)
+	}
+	if _, exists := r.commands[cmd.Name]; exists {
+		return fmt.Errorf("command %q is already registered", cmd.Name)
+	}
+	for _, option := range cmd.Options {
+		if !namePattern.MatchString(option.Name) {
+			return fmt.Errorf("invalid option name %q of command %q", option.Name, cmd.Name)
+		}
+	}
+	for id := range cmd.Actions {
+		if !namePattern.MatchString(id) {
+			return fmt.Errorf("invalid action ID %q of command %q", id, cmd.Name)
+		}
+		if _, exists := r.actions[id]; exists {
+			return fmt.Errorf("action %q of command %q is already registered", id, cmd.Name)
+		}
+	}
+
+	r.commands[cmd.Name] = cmd
+	for id, handler := range cmd.Actions {
+		r.actions[id] = handler
+	}
+	return nil
+}
+
+// Commands returns the registered commands sorted by name
+func (r *Registry) Commands() []Command {
+	commands := make([]Command, 0, len(r.commands))
+	for _, cmd := range r.commands {
+		commands = append(commands, cmd)
+	}
+	sort.Slice(commands, func(i, j int) bool { return commands[i].Name < commands[j].Name })
+	return commands
+}
+
+// Lookup returns the command called name
+func (r *Registry) Lookup(name string) (Command, bool) {
+	cmd, ok := r.commands[name]
+	return cmd, ok
+}
+
+// Run answers a command, checking its required options
+func (r *Registry) Run(ctx context.Context, req Request) (Response, error) {
+	cmd, ok := r.commands[req.Command]
+	if !ok {
+		return Response{}, fmt.Errorf("%w: /%s", ErrUnknownCommand, req.Command)
+	}
+	for _, option := range cmd.Options {
+		if option.Required && req.Args[option.Name] == "" {
+			return Response{Text: fmt.Sprintf("Missing %s: %s", option.Name, Usage(cmd)), Ephemeral: true}, nil
+		}
+	}
+	return cmd.Handle(ctx, req)
+}
+
+// Act answers an action
+func (r *Registry) Act(ctx context.Context, action Action) (Response, error) {
+	handler, ok := r.actions[action.ID]
+	if !ok {
+		return Response{}, fmt.Errorf("%w: action %s", ErrUnknownCommand, action.ID)
+	}
+	return handler(ctx, action)
+}
+
+// Usage describes how to call cmd, such as "/echo <text>"
+func Usage(cmd Command) string {
+	usage := "/" + cmd.Name
+	for _, option := range cmd.Options {
+		if option.Required {
+			usage += " <" + option.Name + ">"
+		} else {
+			usage += " [" + option.Name + "]"
+		}
+	}
+	return usage
+}
+
+// builtins are the commands of this bot, added by the init function of each
+// command file
+var builtins []Command
+
+// register adds a command to the builtin commands
+func register(cmd Command) {
+	builtins = append(builtins, cmd)
+}
+
+// Builtin returns a registry holding every command of the bot
+func Builtin() (*Registry, error) {
+	registry := NewRegistry()
+	for _, cmd := range builtins {
+		if err := registry.Register(cmd); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
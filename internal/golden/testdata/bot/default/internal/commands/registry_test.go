@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltin(t *testing.T) {
+	registry, err := Builtin()
+	require.NoError(t, err)
+
+	var names []string
+	for _, cmd := range registry.Commands() {
+		names = append(names, cmd.Name)
+	}
+	assert.Equal(t, []string{"echo", "help", "ping", "poll"}, names)
+}
+
+func TestRegistry_Register(t *testing.T) {
+	handle := func(ctx context.Context, req Request) (Response, error) { return Response{}, nil }
+	registry := NewRegistry()
+
+	require.NoError(t, registry.Register(Command{Name: "deploy", Handle: handle}))
+	assert.Error(t, registry.Register(Command{Name: "deploy", Handle: handle}), "duplicate name")
+	assert.Error(t, registry.Register(Command{Name: "Deploy Now", Handle: handle}), "invalid name")
+	assert.Error(t, registry.Register(Command{Name: "status"}), "no handler")
+	assert.Error(t, registry.Register(Command{
+		Name:    "rollback",
+		Handle:  handle,
+		Actions: map[string]ActionHandler{"bad:id": nil},
+	}), "invalid action ID")
+}
+
+func TestRegistry_Run(t *testing.T) {
+	registry, err := Builtin()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	resp, err := registry.Run(ctx, Request{Command: "echo", Args: map[string]string{"text": "hello"}})
+	require.NoError(t, err)
+	assert.Equal(t, Response{Text: "hello"}, resp)
+
+	resp, err = registry.Run(ctx, Request{Command: "echo"})
+	require.NoError(t, err)
+	assert.True(t, resp.Ephemeral)
+	assert.Contains(t, resp.Text, "/echo <text>")
+
+	_, err = registry.Run(ctx, Request{Command: "missing"})
+	assert.True(t, errors.Is(err, ErrUnknownCommand))
+}
+
+func TestRegistry_Act(t *testing.T) {
+	registry, err := Builtin()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	resp, err := registry.Run(ctx, Request{Command: "poll", UserID: "U1", Args: map[string]string{"question": "Lunch?"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Buttons, 2)
+
+	yes := resp.Buttons[0]
+	resp, err = registry.Act(ctx, Action{ID: yes.ActionID, Value: yes.Value, UserID: "U2"})
+	require.NoError(t, err)
+	assert.Equal(t, `<@U2> voted yes on "Lunch?"`, resp.Text)
+
+	_, err = registry.Act(ctx, Action{ID: "missing"})
+	assert.True(t, errors.Is(err, ErrUnknownCommand))
+}
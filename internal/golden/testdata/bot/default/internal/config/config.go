@@ -0,0 +1,89 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config holds the bot configuration, read from the environment
+type Config struct {
+	// Port the HTTP server receiving the slack requests listens on (PORT)
+	Port int
+	// LogLevel is one of debug, info, warn or error (LOG_LEVEL)
+	LogLevel string
+	// LogFormat is json or console (LOG_FORMAT)
+	LogFormat string
+	// ShutdownTimeout bounds the graceful shutdown (SHUTDOWN_TIMEOUT)
+	ShutdownTimeout time.Duration
+
+	// SlackSigningSecret verifies that requests come from Slack (SLACK_SIGNING_SECRET)
+	SlackSigningSecret string
+	// SlackBotToken posts messages through the Web API (SLACK_BOT_TOKEN)
+	SlackBotToken string
+}
+
+// Load reads the configuration from the environment, applying defaults
+func Load() (*Config, error) {
+	cfg := &Config{
+		Port:            8080,
+		LogLevel:        getEnv("LOG_LEVEL", "info"),
+		LogFormat:       getEnv("LOG_FORMAT", "json"),
+		ShutdownTimeout: 15 * time.Second,
+
+		SlackSigningSecret: os.Getenv("SLACK_SIGNING_SECRET"),
+		SlackBotToken:      os.Getenv("SLACK_BOT_TOKEN"),
+	}
+
+	var err error
+	if cfg.Port, err = getEnvInt("PORT", cfg.Port); err != nil {
+		return nil, err
+	}
+	if value := os.Getenv("SHUTDOWN_TIMEOUT"); value != "" {
+		if cfg.ShutdownTimeout, err = time.ParseDuration(value); err != nil {
+			return nil, fmt.Errorf("invalid SHUTDOWN_TIMEOUT %q: %w", value, err)
+		}
+	}
+
+	if cfg.Port < 1 || cfg.Port > 65535 {
+		return nil, fmt.Errorf("invalid PORT %d: must be between 1 and 65535", cfg.Port)
+	}
+	return cfg, nil
+}
+
+// ValidateServe checks the settings needed to answer slack requests
+func (c *Config) ValidateServe() error {
+	if c.SlackSigningSecret == "" {
+		return errors.New("SLACK_SIGNING_SECRET is required, copy it from the Basic Information page of the app")
+	}
+	if c.SlackBotToken == "" {
+		return errors.New("SLACK_BOT_TOKEN is required, copy it from the OAuth & Permissions page of the app")
+	}
+	return nil
+}
+
+// Address returns the address the server listens on
+func (c *Config) Address() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_Defaults(t *testing.T) {
+	for _, key := range []string{"PORT", "LOG_LEVEL", "LOG_FORMAT", "SHUTDOWN_TIMEOUT"} {
+		t.Setenv(key, "")
+	}
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, ":8080", cfg.Address())
+	assert.Equal(t, "info", cfg.LogLevel)
+	assert.Equal(t, 15*time.Second, cfg.ShutdownTimeout)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	t.Setenv("PORT", "70000")
+	_, err := Load()
+	assert.Error(t, err)
+
+	t.Setenv("PORT", "8080")
+	t.Setenv("SHUTDOWN_TIMEOUT", "soon")
+	_, err = Load()
+	assert.Error(t, err)
+}
+
+func TestValidateServe(t *testing.T) {
+	t.Setenv("SLACK_SIGNING_SECRET", "")
+	t.Setenv("SLACK_BOT_TOKEN", "xoxb-test")
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Error(t, cfg.ValidateServe())
+
+	t.Setenv("SLACK_SIGNING_SECRET", "secret")
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.NoError(t, cfg.ValidateServe())
+}
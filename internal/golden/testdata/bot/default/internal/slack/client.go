@@ -0,0 +1,90 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const defaultBaseURL = "https://slack.com/api"
+
+// Client posts messages through the Slack Web API and response URLs
+type Client struct {
+	token   string
+	baseURL string
+	http    *http.Client
+}
+
+// NewClient creates a client authenticated with the bot token
+func NewClient(botToken string) *Client {
+	return &Client{
+		token:   botToken,
+		baseURL: defaultBaseURL,
+		http:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// WithBaseURL points the client at another Web API, for tests
+func (c *Client) WithBaseURL(baseURL string) *Client {
+	c.baseURL = baseURL
+	return c
+}
+
+// PostMessage posts msg to its channel with chat.postMessage
+func (c *Client) PostMessage(ctx context.Context, msg Message) error {
+	var result struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	body, err := c.post(ctx, c.baseURL+"/chat.postMessage", msg, true)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("chat.postMessage: invalid response: %w", err)
+	}
+	if !result.OK {
+		return fmt.Errorf("chat.postMessage: %s", result.Error)
+	}
+	return nil
+}
+
+// Respond answers an interaction through its response URL
+func (c *Client) Respond(ctx context.Context, responseURL string, msg Message) error {
+	_, err := c.post(ctx, responseURL, msg, false)
+	return err
+}
+
+func (c *Client) post(ctx context.Context, url string, msg Message, authenticated bool) ([]byte, error) {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if authenticated {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("slack returned %s: %s", resp.Status, body)
+	}
+	return body, nil
+}
@@ -0,0 +1,47 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+)
+
+// Event is an event of the Events API, such as app_mention
+type Event struct {
+	Type     string `json:"type"`
+	User     string `json:"user"`
+	BotID    string `json:"bot_id"`
+	Text     string `json:"text"`
+	Channel  string `json:"channel"`
+	TS       string `json:"ts"`
+	ThreadTS string `json:"thread_ts"`
+}
+
+// EventHandler reacts to an event
+type EventHandler func(ctx context.Context, ev Event) error
+
+// Events returns the handlers of the events the bot subscribes to, by event type.
+// The app manifest subscribes to the same types.
+func Events(client *Client) map[string]EventHandler {
+	return map[string]EventHandler{
+		// Reply in a thread when someone mentions the bot
+		"app_mention": func(ctx context.Context, ev Event) error {
+			thread := ev.ThreadTS
+			if thread == "" {
+				thread = ev.TS
+			}
+			return client.PostMessage(ctx, Message{
+				Channel:  ev.Channel,
+				ThreadTS: thread,
+				Text:     fmt.Sprintf("Hi <@%s>! Type /help to see what I can do.", ev.User),
+			})
+		},
+
+		// Welcome the people joining a channel the bot is in
+		"member_joined_channel": func(ctx context.Context, ev Event) error {
+			return client.PostMessage(ctx, Message{
+				Channel: ev.Channel,
+				Text:    fmt.Sprintf("Welcome <@%s>!", ev.User),
+			})
+		},
+	}
+}
@@ -0,0 +1,256 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/example/golden/internal/commands"
+	"github.com/example/golden/internal/logger"
+)
+
+// Request URLs of the app, configured in the manifest
+const (
+	CommandsPath     = "/slack/commands"
+	InteractionsPath = "/slack/interactions"
+	EventsPath       = "/slack/events"
+)
+
+// replyTimeout bounds the replies sent after a request was acknowledged
+const replyTimeout = 10 * time.Second
+
+// Handler answers the requests of the Slack app. Slack expects an answer within
+// three seconds: commands are answered directly, actions and events are
+// acknowledged first and answered in the background.
+type Handler struct {
+	verifier *Verifier
+	registry *commands.Registry
+	client   *Client
+	events   map[string]EventHandler
+	log      logger.Logger
+	mux      *http.ServeMux
+	pending  sync.WaitGroup
+}
+
+// NewHandler creates the handler of the app requests
+func NewHandler(verifier *Verifier, registry *commands.Registry, client *Client, log logger.Logger) *Handler {
+	h := &Handler{
+		verifier: verifier,
+		registry: registry,
+		client:   client,
+		events:   Events(client),
+		log:      log,
+		mux:      http.NewServeMux(),
+	}
+	h.mux.HandleFunc(CommandsPath, h.verified(h.handleCommand))
+	h.mux.HandleFunc(InteractionsPath, h.verified(h.handleInteraction))
+	h.mux.HandleFunc(EventsPath, h.verified(h.handleEvent))
+	return h
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// EventTypes returns the event types the bot handles
+func (h *Handler) EventTypes() []string {
+	types := make([]string, 0, len(h.events))
+	for eventType := range h.events {
+		types = append(types, eventType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Wait blocks until the replies sent in the background are done
+func (h *Handler) Wait() {
+	h.pending.Wait()
+}
+
+// verified only passes POST requests signed by Slack, with their body
+func (h *Handler) verified(next func(http.ResponseWriter, *http.Request, []byte)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := h.verifier.Verify(r)
+		if err != nil {
+			h.log.Warn("Rejected request", "path", r.URL.Path, "error", err.Error())
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+		next(w, r, body)
+	}
+}
+
+func (h *Handler) handleCommand(w http.ResponseWriter, r *http.Request, body []byte) {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	name := strings.TrimPrefix(form.Get("command"), "/")
+	req := commands.Request{
+		Command:   name,
+		UserID:    form.Get("user_id"),
+		UserName:  form.Get("user_name"),
+		ChannelID: form.Get("channel_id"),
+	}
+	if cmd, ok := h.registry.Lookup(name); ok {
+		req.Args = parseArgs(cmd.Options, form.Get("text"))
+	}
+
+	resp, err := h.registry.Run(r.Context(), req)
+	if err != nil {
+		resp = h.failure("command", name, err)
+	}
+	writeJSON(w, NewMessage(resp))
+}
+
+// interaction is the payload of a click on an interactive message
+type interaction struct {
+	Type string `json:"type"`
+	User struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Channel struct {
+		ID string `json:"id"`
+	} `json:"channel"`
+	Actions []struct {
+		ActionID string `json:"action_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+	ResponseURL string `json:"response_url"`
+}
+
+func (h *Handler) handleInteraction(w http.ResponseWriter, r *http.Request, body []byte) {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+	var payload interaction
+	if err := json.Unmarshal([]byte(form.Get("payload")), &payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+
+	if payload.Type != "block_actions" {
+		return
+	}
+	for _, clicked := range payload.Actions {
+		action := commands.Action{
+			ID:        clicked.ActionID,
+			Value:     clicked.Value,
+			UserID:    payload.User.ID,
+			UserName:  payload.User.Username,
+			ChannelID: payload.Channel.ID,
+		}
+		h.background(func(ctx context.Context) {
+			resp, err := h.registry.Act(ctx, action)
+			if err != nil {
+				resp = h.failure("action", action.ID, err)
+			}
+			if err := h.client.Respond(ctx, payload.ResponseURL, NewMessage(resp)); err != nil {
+				h.log.Error("Failed to answer action", "action", action.ID, "error", err.Error())
+			}
+		})
+	}
+}
+
+// envelope wraps the events of the Events API
+type envelope struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	EventID   string `json:"event_id"`
+	Event     Event  `json:"event"`
+}
+
+func (h *Handler) handleEvent(w http.ResponseWriter, r *http.Request, body []byte) {
+	var env envelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		http.Error(w, "invalid event", http.StatusBadRequest)
+		return
+	}
+
+	// Slack checks the request URL before sending events to it
+	if env.Type == "url_verification" {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(env.Challenge))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+
+	// Retries follow slow acknowledgements of events already being handled;
+	// messages of bots, this one included, would start reply loops
+	if r.Header.Get("X-Slack-Retry-Num") != "" || env.Type != "event_callback" || env.Event.BotID != "" {
+		return
+	}
+	handler, ok := h.events[env.Event.Type]
+	if !ok {
+		h.log.Debug("Ignoring event", "type", env.Event.Type)
+		return
+	}
+	h.background(func(ctx context.Context) {
+		if err := handler(ctx, env.Event); err != nil {
+			h.log.Error("Failed to handle event", "type", env.Event.Type, "event_id", env.EventID, "error", err.Error())
+		}
+	})
+}
+
+// background runs a reply after the request was acknowledged
+func (h *Handler) background(reply func(ctx context.Context)) {
+	h.pending.Add(1)
+	go func() {
+		defer h.pending.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), replyTimeout)
+		defer cancel()
+		reply(ctx)
+	}()
+}
+
+// failure logs a failed command or action and returns the reply telling the user
+func (h *Handler) failure(kind, name string, err error) commands.Response {
+	if errors.Is(err, commands.ErrUnknownCommand) {
+		return commands.Response{Text: "Sorry, I don't know that one. Type /help to see what I can do.", Ephemeral: true}
+	}
+	h.log.Error("Failed to answer "+kind, kind, name, "error", err.Error())
+	return commands.Response{Text: "Something went wrong, please try again.", Ephemeral: true}
+}
+
+// parseArgs splits the text typed after a command over its options; the last
+// option takes the rest of the text
+func parseArgs(options []commands.Option, text string) map[string]string {
+	args := make(map[string]string, len(options))
+	for i, option := range options {
+		text = strings.TrimSpace(text)
+		if text == "" {
+			break
+		}
+		if i == len(options)-1 {
+			args[option.Name] = text
+			break
+		}
+		word, rest, _ := strings.Cut(text, " ")
+		args[option.Name] = word
+		text = rest
+	}
+	return args
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,164 @@
+package slack
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/commands"
+	"github.com/example/golden/internal/logger"
+)
+
+const signingSecret = "test-secret"
+
+// fakeSlack records the messages posted to the Web API and the response URLs
+type fakeSlack struct {
+	*httptest.Server
+	mu       sync.Mutex
+	messages []Message
+	auth     []string
+}
+
+func newFakeSlack(t *testing.T) *fakeSlack {
+	f := &fakeSlack{}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg Message
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		f.mu.Lock()
+		f.messages = append(f.messages, msg)
+		f.auth = append(f.auth, r.Header.Get("Authorization"))
+		f.mu.Unlock()
+		_, _ = w.Write([]byte(`{"ok": true}`))
+	}))
+	t.Cleanup(f.Close)
+	return f
+}
+
+func newTestHandler(t *testing.T, api *fakeSlack) *Handler {
+	t.Helper()
+	registry, err := commands.Builtin()
+	require.NoError(t, err)
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: "error"}, io.Discard)
+	require.NoError(t, err)
+
+	return NewHandler(NewVerifier(signingSecret), registry, NewClient("xoxb-test").WithBaseURL(api.URL), log)
+}
+
+func signedRequest(path, contentType, body string) *http.Request {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Slack-Request-Timestamp", timestamp)
+	req.Header.Set("X-Slack-Signature", NewVerifier(signingSecret).Sign(timestamp, []byte(body)))
+	return req
+}
+
+func serve(h http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_RejectsUnsignedRequests(t *testing.T) {
+	h := newTestHandler(t, newFakeSlack(t))
+
+	req := signedRequest(CommandsPath, "application/x-www-form-urlencoded", "command=/ping")
+	req.Header.Set("X-Slack-Signature", "v0=forged")
+	assert.Equal(t, http.StatusUnauthorized, serve(h, req).Code)
+
+	stale := signedRequest(CommandsPath, "application/x-www-form-urlencoded", "command=/ping")
+	stale.Header.Set("X-Slack-Request-Timestamp", strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10))
+	assert.Equal(t, http.StatusUnauthorized, serve(h, stale).Code)
+
+	get := httptest.NewRequest(http.MethodGet, CommandsPath, nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(h, get).Code)
+}
+
+func TestHandler_Command(t *testing.T) {
+	h := newTestHandler(t, newFakeSlack(t))
+
+	form := url.Values{"command": {"/echo"}, "text": {"hello world"}, "user_id": {"U1"}}
+	rec := serve(h, signedRequest(CommandsPath, "application/x-www-form-urlencoded", form.Encode()))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var msg Message
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &msg))
+	assert.Equal(t, "hello world", msg.Text)
+	assert.Equal(t, "in_channel", msg.ResponseType)
+
+	form = url.Values{"command": {"/missing"}}
+	rec = serve(h, signedRequest(CommandsPath, "application/x-www-form-urlencoded", form.Encode()))
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &msg))
+	assert.Equal(t, "ephemeral", msg.ResponseType)
+}
+
+func TestHandler_Interaction(t *testing.T) {
+	api := newFakeSlack(t)
+	h := newTestHandler(t, api)
+
+	payload := `{"type": "block_actions", "user": {"id": "U2"}, "channel": {"id": "C1"},
+		"actions": [{"action_id": "poll-yes", "value": "Lunch?"}], "response_url": "` + api.URL + `/respond"}`
+	form := url.Values{"payload": {payload}}
+	rec := serve(h, signedRequest(InteractionsPath, "application/x-www-form-urlencoded", form.Encode()))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	h.Wait()
+	require.Len(t, api.messages, 1)
+	assert.Equal(t, `<@U2> voted yes on "Lunch?"`, api.messages[0].Text)
+	assert.Empty(t, api.auth[0], "response URLs need no token")
+}
+
+func TestHandler_Events(t *testing.T) {
+	api := newFakeSlack(t)
+	h := newTestHandler(t, api)
+
+	rec := serve(h, signedRequest(EventsPath, "application/json", `{"type": "url_verification", "challenge": "abc123"}`))
+	assert.Equal(t, "abc123", rec.Body.String())
+
+	mention := `{"type": "event_callback", "event_id": "Ev1", "event": {"type": "app_mention", "user": "U1", "channel": "C1", "ts": "1.2"}}`
+	rec = serve(h, signedRequest(EventsPath, "application/json", mention))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// Retries of an event already handled are acknowledged and dropped
+	retry := signedRequest(EventsPath, "application/json", mention)
+	retry.Header.Set("X-Slack-Retry-Num", "1")
+	serve(h, retry)
+
+	h.Wait()
+	require.Len(t, api.messages, 1)
+	assert.Equal(t, "C1", api.messages[0].Channel)
+	assert.Equal(t, "1.2", api.messages[0].ThreadTS)
+	assert.Equal(t, "Bearer xoxb-test", api.auth[0])
+}
+
+func TestParseArgs(t *testing.T) {
+	options := []commands.Option{
+		{Name: "service"},
+		{Name: "reason"},
+	}
+
+	assert.Equal(t, map[string]string{"service": "api", "reason": "hot fix for login"}, parseArgs(options, " api  hot fix for login"))
+	assert.Equal(t, map[string]string{"service": "api"}, parseArgs(options, "api"))
+	assert.Empty(t, parseArgs(options, ""))
+}
+
+func TestNewManifest(t *testing.T) {
+	registry, err := commands.Builtin()
+	require.NoError(t, err)
+
+	m := NewManifest("golden", "https://bot.example.com/", registry, []string{"app_mention"})
+	require.NotEmpty(t, m.Features.SlashCommands)
+	assert.Equal(t, "https://bot.example.com/slack/commands", m.Features.SlashCommands[0].URL)
+	assert.Equal(t, "https://bot.example.com/slack/events", m.Settings.EventSubscriptions.RequestURL)
+	assert.Contains(t, m.OAuthConfig.Scopes.Bot, "app_mentions:read")
+}
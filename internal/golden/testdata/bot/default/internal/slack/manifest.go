@@ -0,0 +1,83 @@
+package slack
+
+import (
+	"strings"
+
+	"github.com/example/golden/internal/commands"
+)
+
+// Manifest is a Slack app manifest, pasted in the app settings to configure
+// the slash commands, interactivity and event subscriptions at once
+type Manifest struct {
+	DisplayInformation struct {
+		Name string `json:"name"`
+	} `json:"display_information"`
+	Features struct {
+		BotUser struct {
+			DisplayName  string `json:"display_name"`
+			AlwaysOnline bool   `json:"always_online"`
+		} `json:"bot_user"`
+		SlashCommands []slashCommand `json:"slash_commands"`
+	} `json:"features"`
+	OAuthConfig struct {
+		Scopes struct {
+			Bot []string `json:"bot"`
+		} `json:"scopes"`
+	} `json:"oauth_config"`
+	Settings struct {
+		EventSubscriptions struct {
+			RequestURL string   `json:"request_url"`
+			BotEvents  []string `json:"bot_events"`
+		} `json:"event_subscriptions"`
+		Interactivity struct {
+			IsEnabled  bool   `json:"is_enabled"`
+			RequestURL string `json:"request_url"`
+		} `json:"interactivity"`
+	} `json:"settings"`
+}
+
+type slashCommand struct {
+	Command     string `json:"command"`
+	URL         string `json:"url"`
+	Description string `json:"description"`
+	UsageHint   string `json:"usage_hint,omitempty"`
+}
+
+// eventScopes are the bot scopes each event subscription needs
+var eventScopes = map[string]string{
+	"app_mention":           "app_mentions:read",
+	"member_joined_channel": "channels:read",
+}
+
+// NewManifest describes the app named name, served at baseURL, with the
+// registered commands and the handled event types
+func NewManifest(name, baseURL string, registry *commands.Registry, eventTypes []string) Manifest {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	var m Manifest
+	m.DisplayInformation.Name = name
+	m.Features.BotUser.DisplayName = name
+	m.Features.BotUser.AlwaysOnline = true
+
+	for _, cmd := range registry.Commands() {
+		m.Features.SlashCommands = append(m.Features.SlashCommands, slashCommand{
+			Command:     "/" + cmd.Name,
+			URL:         baseURL + CommandsPath,
+			Description: cmd.Description,
+			UsageHint:   strings.TrimSpace(strings.TrimPrefix(commands.Usage(cmd), "/"+cmd.Name)),
+		})
+	}
+
+	m.OAuthConfig.Scopes.Bot = []string{"commands", "chat:write"}
+	for _, eventType := range eventTypes {
+		if scope, ok := eventScopes[eventType]; ok {
+			m.OAuthConfig.Scopes.Bot = append(m.OAuthConfig.Scopes.Bot, scope)
+		}
+	}
+
+	m.Settings.EventSubscriptions.RequestURL = baseURL + EventsPath
+	m.Settings.EventSubscriptions.BotEvents = eventTypes
+	m.Settings.Interactivity.IsEnabled = true
+	m.Settings.Interactivity.RequestURL = baseURL + InteractionsPath
+	return m
+}
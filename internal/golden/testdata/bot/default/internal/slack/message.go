@@ -0,0 +1,61 @@
+package slack
+
+import "github.com/example/golden/internal/commands"
+
+// Message is a Slack message with Block Kit blocks
+type Message struct {
+	Channel  string  `json:"channel,omitempty"`
+	ThreadTS string  `json:"thread_ts,omitempty"`
+	Text     string  `json:"text"`
+	Blocks   []block `json:"blocks,omitempty"`
+
+	// ResponseType is in_channel or ephemeral, for command and action responses
+	ResponseType    string `json:"response_type,omitempty"`
+	ReplaceOriginal bool   `json:"replace_original,omitempty"`
+}
+
+type block struct {
+	Type     string    `json:"type"`
+	Text     *text     `json:"text,omitempty"`
+	Elements []element `json:"elements,omitempty"`
+}
+
+type text struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type element struct {
+	Type     string `json:"type"`
+	Text     text   `json:"text"`
+	ActionID string `json:"action_id"`
+	Value    string `json:"value,omitempty"`
+	Style    string `json:"style,omitempty"`
+}
+
+// NewMessage converts a command response, its buttons becoming an actions block
+func NewMessage(resp commands.Response) Message {
+	msg := Message{Text: resp.Text, ResponseType: "in_channel"}
+	if resp.Ephemeral {
+		msg.ResponseType = "ephemeral"
+	}
+	if len(resp.Buttons) == 0 {
+		return msg
+	}
+
+	buttons := make([]element, len(resp.Buttons))
+	for i, button := range resp.Buttons {
+		buttons[i] = element{
+			Type:     "button",
+			Text:     text{Type: "plain_text", Text: button.Label},
+			ActionID: button.ActionID,
+			Value:    button.Value,
+			Style:    string(button.Style),
+		}
+	}
+	msg.Blocks = []block{
+		{Type: "section", Text: &text{Type: "mrkdwn", Text: resp.Text}},
+		{Type: "actions", Elements: buttons},
+	}
+	return msg
+}
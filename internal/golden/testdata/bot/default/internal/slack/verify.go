@@ -0,0 +1,65 @@
+// Package slack connects the commands to a Slack app over HTTP: slash commands,
+// interactive messages and the Events API, each on its own request URL.
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxBodySize bounds the requests read before their signature is checked
+const maxBodySize = 1 << 20
+
+// maxRequestAge rejects replayed requests
+const maxRequestAge = 5 * time.Minute
+
+// ErrInvalidSignature is returned for requests not signed with the signing secret
+var ErrInvalidSignature = errors.New("invalid request signature")
+
+// Verifier checks the signature Slack puts on every request
+type Verifier struct {
+	secret []byte
+	now    func() time.Time
+}
+
+// NewVerifier creates a verifier for the signing secret of the app
+func NewVerifier(signingSecret string) *Verifier {
+	return &Verifier{secret: []byte(signingSecret), now: time.Now}
+}
+
+// Verify reads the body of r and checks its signature, returning the body
+func (v *Verifier) Verify(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the request: %w", err)
+	}
+
+	timestamp := r.Header.Get("X-Slack-Request-Timestamp")
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	if age := v.now().Sub(time.Unix(seconds, 0)); age > maxRequestAge || age < -maxRequestAge {
+		return nil, ErrInvalidSignature
+	}
+
+	if !hmac.Equal([]byte(r.Header.Get("X-Slack-Signature")), []byte(v.Sign(timestamp, body))) {
+		return nil, ErrInvalidSignature
+	}
+	return body, nil
+}
+
+// Sign returns the signature of a request body sent at timestamp
+func (v *Verifier) Sign(timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, v.secret)
+	fmt.Fprintf(mac, "v0:%s:", timestamp)
+	mac.Write(body)
+	return "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
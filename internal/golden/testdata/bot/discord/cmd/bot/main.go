@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/example/golden/internal/commands"
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/discord"
+	"github.com/example/golden/internal/logger"
+)
+
+const usage = "usage: golden [serve | register]"
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "golden: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	command := "serve"
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	log, err := logger.NewFactory().Create(cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+	log = log.With("service", "golden")
+
+	registry, err := commands.Builtin()
+	if err != nil {
+		return fmt.Errorf("invalid command: %w", err)
+	}
+
+	switch command {
+	case "serve":
+		return serve(cfg, log, registry)
+	case "register":
+		return register(cfg, log, registry)
+	default:
+		return errors.New(usage)
+	}
+}
+
+// serve answers the discord requests until SIGINT or SIGTERM
+func serve(cfg *config.Config, log logger.Logger, registry *commands.Registry) error {
+	if err := cfg.ValidateServe(); err != nil {
+		return err
+	}
+
+	verifier, err := discord.NewVerifier(cfg.DiscordPublicKey)
+	if err != nil {
+		return err
+	}
+	bot := discord.NewHandler(verifier, registry, log)
+
+	mux := http.NewServeMux()
+	mux.Handle("/discord/", bot)
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	srv := &http.Server{
+		Addr:              cfg.Address(),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	log.Info("Listening", "address", cfg.Address(), "commands", len(registry.Commands()))
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("server stopped: %w", err)
+	case <-ctx.Done():
+	}
+
+	log.Info("Shutting down", "timeout", cfg.ShutdownTimeout.String())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down: %w", err)
+	}
+	// Let the replies in flight reach discord
+	bot.Wait()
+	log.Info("Server stopped")
+	return nil
+}
+
+// register publishes the slash commands of the bot to Discord
+func register(cfg *config.Config, log logger.Logger, registry *commands.Registry) error {
+	if err := cfg.ValidateRegister(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	client := discord.NewClient(cfg.DiscordBotToken)
+	if err := client.RegisterCommands(ctx, cfg.DiscordApplicationID, cfg.DiscordGuildID, registry); err != nil {
+		return fmt.Errorf("failed to register the commands: %w", err)
+	}
+
+	scope := "globally"
+	if cfg.DiscordGuildID != "" {
+		scope = "in guild " + cfg.DiscordGuildID
+	}
+	log.Info("Registered commands "+scope, "commands", len(registry.Commands()))
+	return nil
+}
@@ -0,0 +1,22 @@
+package commands
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+func init() {
+	register(Command{
+		Name:        "help",
+		Description: "List the commands of the bot",
+		Handle: func(ctx context.Context, req Request) (Response, error) {
+			lines := make([]string, 0, len(builtins))
+			for _, cmd := range builtins {
+				lines = append(lines, Usage(cmd)+" - "+cmd.Description)
+			}
+			sort.Strings(lines)
+			return Response{Text: strings.Join(lines, "\n"), Ephemeral: true}, nil
+		},
+	})
+}
@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+)
+
+// poll shows interactive messages: the response carries buttons and the
+// actions answer the clicks
+func init() {
+	vote := func(answer string) ActionHandler {
+		return func(ctx context.Context, action Action) (Response, error) {
+			return Response{Text: fmt.Sprintf("<@%s> voted %s on %q", action.UserID, answer, action.Value)}, nil
+		}
+	}
+
+	register(Command{
+		Name:        "poll",
+		Description: "Ask the channel a yes or no question",
+		Options: []Option{
+			{Name: "question", Description: "The question to ask", Required: true},
+		},
+		Handle: func(ctx context.Context, req Request) (Response, error) {
+			question := req.Arg("question")
+			return Response{
+				Text: fmt.Sprintf("<@%s> asks: %s", req.UserID, question),
+				Buttons: []Button{
+					{ActionID: "poll-yes", Label: "Yes", Value: question, Style: ButtonPrimary},
+					{ActionID: "poll-no", Label: "No", Value: question, Style: ButtonDanger},
+				},
+			}, nil
+		},
+		Actions: map[string]ActionHandler{
+			"poll-yes": vote("yes"),
+			"poll-no":  vote("no"),
+		},
+	})
+}
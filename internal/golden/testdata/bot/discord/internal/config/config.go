@@ -0,0 +1,104 @@
+package config
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config holds the bot configuration, read from the environment
+type Config struct {
+	// Port the HTTP server receiving the discord requests listens on (PORT)
+	Port int
+	// LogLevel is one of debug, info, warn or error (LOG_LEVEL)
+	LogLevel string
+	// LogFormat is json or console (LOG_FORMAT)
+	LogFormat string
+	// ShutdownTimeout bounds the graceful shutdown (SHUTDOWN_TIMEOUT)
+	ShutdownTimeout time.Duration
+
+	// DiscordPublicKey verifies that requests come from Discord, hex encoded (DISCORD_PUBLIC_KEY)
+	DiscordPublicKey string
+	// DiscordApplicationID identifies the application when registering commands (DISCORD_APPLICATION_ID)
+	DiscordApplicationID string
+	// DiscordBotToken authenticates the command registration (DISCORD_BOT_TOKEN)
+	DiscordBotToken string
+	// DiscordGuildID registers the commands in one server, where they show up at
+	// once, instead of globally (DISCORD_GUILD_ID)
+	DiscordGuildID string
+}
+
+// Load reads the configuration from the environment, applying defaults
+func Load() (*Config, error) {
+	cfg := &Config{
+		Port:            8080,
+		LogLevel:        getEnv("LOG_LEVEL", "info"),
+		LogFormat:       getEnv("LOG_FORMAT", "json"),
+		ShutdownTimeout: 15 * time.Second,
+
+		DiscordPublicKey:     os.Getenv("DISCORD_PUBLIC_KEY"),
+		DiscordApplicationID: os.Getenv("DISCORD_APPLICATION_ID"),
+		DiscordBotToken:      os.Getenv("DISCORD_BOT_TOKEN"),
+		DiscordGuildID:       os.Getenv("DISCORD_GUILD_ID"),
+	}
+
+	var err error
+	if cfg.Port, err = getEnvInt("PORT", cfg.Port); err != nil {
+		return nil, err
+	}
+	if value := os.Getenv("SHUTDOWN_TIMEOUT"); value != "" {
+		if cfg.ShutdownTimeout, err = time.ParseDuration(value); err != nil {
+			return nil, fmt.Errorf("invalid SHUTDOWN_TIMEOUT %q: %w", value, err)
+		}
+	}
+
+	if cfg.Port < 1 || cfg.Port > 65535 {
+		return nil, fmt.Errorf("invalid PORT %d: must be between 1 and 65535", cfg.Port)
+	}
+	return cfg, nil
+}
+
+// ValidateServe checks the settings needed to answer discord requests
+func (c *Config) ValidateServe() error {
+	key, err := hex.DecodeString(c.DiscordPublicKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return errors.New("DISCORD_PUBLIC_KEY must be the hex encoded public key of the General Information page of the application")
+	}
+	return nil
+}
+
+// ValidateRegister checks the settings needed to register the slash commands
+func (c *Config) ValidateRegister() error {
+	if c.DiscordApplicationID == "" || c.DiscordBotToken == "" {
+		return errors.New("DISCORD_APPLICATION_ID and DISCORD_BOT_TOKEN are required to register the commands")
+	}
+	return nil
+}
+
+// Address returns the address the server listens on
+func (c *Config) Address() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_Defaults(t *testing.T) {
+	for _, key := range []string{"PORT", "LOG_LEVEL", "LOG_FORMAT", "SHUTDOWN_TIMEOUT"} {
+		t.Setenv(key, "")
+	}
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, ":8080", cfg.Address())
+	assert.Equal(t, "info", cfg.LogLevel)
+	assert.Equal(t, 15*time.Second, cfg.ShutdownTimeout)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	t.Setenv("PORT", "70000")
+	_, err := Load()
+	assert.Error(t, err)
+
+	t.Setenv("PORT", "8080")
+	t.Setenv("SHUTDOWN_TIMEOUT", "soon")
+	_, err = Load()
+	assert.Error(t, err)
+}
+
+func TestValidateServe(t *testing.T) {
+	t.Setenv("DISCORD_PUBLIC_KEY", "not-hex")
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Error(t, cfg.ValidateServe())
+
+	t.Setenv("DISCORD_PUBLIC_KEY", "6a6c8a5e1b1f2f0d9d3a8b2e7c4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f")
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.NoError(t, cfg.ValidateServe())
+}
+
+func TestValidateRegister(t *testing.T) {
+	t.Setenv("DISCORD_APPLICATION_ID", "123")
+	t.Setenv("DISCORD_BOT_TOKEN", "")
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Error(t, cfg.ValidateRegister())
+
+	t.Setenv("DISCORD_BOT_TOKEN", "token")
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.NoError(t, cfg.ValidateRegister())
+}
@@ -0,0 +1,103 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/example/golden/internal/commands"
+)
+
+const defaultBaseURL = "https://discord.com/api/v10"
+
+// Application command and option types
+const (
+	commandChatInput = 1
+	optionString     = 3
+)
+
+// Client calls the Discord REST API with the bot token
+type Client struct {
+	token   string
+	baseURL string
+	http    *http.Client
+}
+
+// NewClient creates a client authenticated with the bot token
+func NewClient(botToken string) *Client {
+	return &Client{
+		token:   botToken,
+		baseURL: defaultBaseURL,
+		http:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// WithBaseURL points the client at another API, for tests
+func (c *Client) WithBaseURL(baseURL string) *Client {
+	c.baseURL = baseURL
+	return c
+}
+
+type applicationCommand struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Type        int             `json:"type"`
+	Options     []commandOption `json:"options,omitempty"`
+}
+
+type commandOption struct {
+	Type        int    `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Required    bool   `json:"required"`
+}
+
+// RegisterCommands replaces the slash commands of the application with the
+// registered ones. Commands registered in a guild show up at once; global
+// commands, with an empty guildID, can take up to an hour.
+func (c *Client) RegisterCommands(ctx context.Context, applicationID, guildID string, registry *commands.Registry) error {
+	var body []applicationCommand
+	for _, cmd := range registry.Commands() {
+		command := applicationCommand{Name: cmd.Name, Description: cmd.Description, Type: commandChatInput}
+		for _, option := range cmd.Options {
+			command.Options = append(command.Options, commandOption{
+				Type:        optionString,
+				Name:        option.Name,
+				Description: option.Description,
+				Required:    option.Required,
+			})
+		}
+		body = append(body, command)
+	}
+
+	url := fmt.Sprintf("%s/applications/%s/commands", c.baseURL, applicationID)
+	if guildID != "" {
+		url = fmt.Sprintf("%s/applications/%s/guilds/%s/commands", c.baseURL, applicationID, guildID)
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bot "+c.token)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+		return fmt.Errorf("discord returned %s: %s", resp.Status, message)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// Event is a webhook event, such as APPLICATION_AUTHORIZED
+type Event struct {
+	Type      string          `json:"type"`
+	Timestamp string          `json:"timestamp"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// EventHandler reacts to an event
+type EventHandler func(ctx context.Context, ev Event) error
+
+// Events returns the handlers of the events the application subscribes to, by
+// event type. Select the same types on the Webhooks page of the application.
+func Events(log logger.Logger) map[string]EventHandler {
+	return map[string]EventHandler{
+		// The application was added to a server or to a user account
+		"APPLICATION_AUTHORIZED": func(ctx context.Context, ev Event) error {
+			var data struct {
+				User  user `json:"user"`
+				Guild *struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"guild"`
+			}
+			if err := json.Unmarshal(ev.Data, &data); err != nil {
+				return err
+			}
+			if data.Guild != nil {
+				log.Info("Added to a server", "guild_id", data.Guild.ID, "guild", data.Guild.Name, "by", data.User.ID)
+				return nil
+			}
+			log.Info("Added to a user account", "user_id", data.User.ID)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,187 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/example/golden/internal/commands"
+	"github.com/example/golden/internal/logger"
+)
+
+// URLs of the application: set InteractionsPath as the Interactions Endpoint
+// URL and EventsPath as the Webhook Events URL
+const (
+	InteractionsPath = "/discord/interactions"
+	EventsPath       = "/discord/events"
+)
+
+// Webhook event payload types
+const (
+	webhookPing  = 0
+	webhookEvent = 1
+)
+
+// eventTimeout bounds the handling of an event after it was acknowledged
+const eventTimeout = 10 * time.Second
+
+// Handler answers the requests of the Discord application. Interactions are
+// answered directly, within the three seconds Discord allows; events are
+// acknowledged first and handled in the background.
+type Handler struct {
+	verifier *Verifier
+	registry *commands.Registry
+	events   map[string]EventHandler
+	log      logger.Logger
+	mux      *http.ServeMux
+	pending  sync.WaitGroup
+}
+
+// NewHandler creates the handler of the application requests
+func NewHandler(verifier *Verifier, registry *commands.Registry, log logger.Logger) *Handler {
+	h := &Handler{
+		verifier: verifier,
+		registry: registry,
+		events:   Events(log),
+		log:      log,
+		mux:      http.NewServeMux(),
+	}
+	h.mux.HandleFunc(InteractionsPath, h.verified(h.handleInteraction))
+	h.mux.HandleFunc(EventsPath, h.verified(h.handleEvent))
+	return h
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// EventTypes returns the event types the application handles
+func (h *Handler) EventTypes() []string {
+	types := make([]string, 0, len(h.events))
+	for eventType := range h.events {
+		types = append(types, eventType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Wait blocks until the events handled in the background are done
+func (h *Handler) Wait() {
+	h.pending.Wait()
+}
+
+// verified only passes POST requests signed by Discord, with their body.
+// Discord checks that unsigned requests are rejected before saving the URLs.
+func (h *Handler) verified(next func(http.ResponseWriter, *http.Request, []byte)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := h.verifier.Verify(r)
+		if err != nil {
+			h.log.Warn("Rejected request", "path", r.URL.Path, "error", err.Error())
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+		next(w, r, body)
+	}
+}
+
+func (h *Handler) handleInteraction(w http.ResponseWriter, r *http.Request, body []byte) {
+	var in interaction
+	if err := json.Unmarshal(body, &in); err != nil {
+		http.Error(w, "invalid interaction", http.StatusBadRequest)
+		return
+	}
+	sender := in.sender()
+
+	switch in.Type {
+	case interactionPing:
+		writeJSON(w, interactionResponse{Type: responsePong})
+
+	case interactionApplicationCmd:
+		resp, err := h.registry.Run(r.Context(), commands.Request{
+			Command:   in.Data.Name,
+			Args:      in.Data.args(),
+			UserID:    sender.ID,
+			UserName:  sender.Username,
+			ChannelID: in.ChannelID,
+		})
+		if err != nil {
+			resp = h.failure("command", in.Data.Name, err)
+		}
+		writeJSON(w, newMessage(resp))
+
+	case interactionMessageComponent:
+		actionID, value := parseCustomID(in.Data.CustomID)
+		resp, err := h.registry.Act(r.Context(), commands.Action{
+			ID:        actionID,
+			Value:     value,
+			UserID:    sender.ID,
+			UserName:  sender.Username,
+			ChannelID: in.ChannelID,
+		})
+		if err != nil {
+			resp = h.failure("action", actionID, err)
+		}
+		writeJSON(w, newMessage(resp))
+
+	default:
+		http.Error(w, "unsupported interaction", http.StatusBadRequest)
+	}
+}
+
+// webhook wraps the webhook events
+type webhook struct {
+	Type  int   `json:"type"`
+	Event Event `json:"event"`
+}
+
+func (h *Handler) handleEvent(w http.ResponseWriter, r *http.Request, body []byte) {
+	var payload webhook
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, "invalid event", http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+
+	if payload.Type != webhookEvent {
+		return
+	}
+	handler, ok := h.events[payload.Event.Type]
+	if !ok {
+		h.log.Debug("Ignoring event", "type", payload.Event.Type)
+		return
+	}
+
+	h.pending.Add(1)
+	go func() {
+		defer h.pending.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), eventTimeout)
+		defer cancel()
+		if err := handler(ctx, payload.Event); err != nil {
+			h.log.Error("Failed to handle event", "type", payload.Event.Type, "error", err.Error())
+		}
+	}()
+}
+
+// failure logs a failed command or action and returns the reply telling the user
+func (h *Handler) failure(kind, name string, err error) commands.Response {
+	if errors.Is(err, commands.ErrUnknownCommand) {
+		return commands.Response{Text: "Sorry, I don't know that one. Type /help to see what I can do.", Ephemeral: true}
+	}
+	h.log.Error("Failed to answer "+kind, kind, name, "error", err.Error())
+	return commands.Response{Text: "Something went wrong, please try again.", Ephemeral: true}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
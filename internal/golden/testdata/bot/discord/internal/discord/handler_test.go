@@ -0,0 +1,153 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/commands"
+	"github.com/example/golden/internal/logger"
+)
+
+type testApp struct {
+	handler *Handler
+	private ed25519.PrivateKey
+}
+
+func newTestApp(t *testing.T) testApp {
+	t.Helper()
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	verifier, err := NewVerifier(hex.EncodeToString(public))
+	require.NoError(t, err)
+	registry, err := commands.Builtin()
+	require.NoError(t, err)
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: "error"}, io.Discard)
+	require.NoError(t, err)
+
+	return testApp{handler: NewHandler(verifier, registry, log), private: private}
+}
+
+// post sends a request signed like Discord does
+func (a testApp) post(path, body string) *httptest.ResponseRecorder {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+	req.Header.Set("X-Signature-Timestamp", timestamp)
+	req.Header.Set("X-Signature-Ed25519", hex.EncodeToString(ed25519.Sign(a.private, []byte(timestamp+body))))
+
+	rec := httptest.NewRecorder()
+	a.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func decode(t *testing.T, rec *httptest.ResponseRecorder) interactionResponse {
+	t.Helper()
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp interactionResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp
+}
+
+func TestHandler_RejectsUnsignedRequests(t *testing.T) {
+	app := newTestApp(t)
+
+	req := httptest.NewRequest(http.MethodPost, InteractionsPath, bytes.NewBufferString(`{"type": 1}`))
+	req.Header.Set("X-Signature-Timestamp", "1")
+	req.Header.Set("X-Signature-Ed25519", hex.EncodeToString(make([]byte, ed25519.SignatureSize)))
+	rec := httptest.NewRecorder()
+	app.handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestHandler_Ping(t *testing.T) {
+	app := newTestApp(t)
+
+	resp := decode(t, app.post(InteractionsPath, `{"type": 1}`))
+	assert.Equal(t, responsePong, resp.Type)
+}
+
+func TestHandler_Command(t *testing.T) {
+	app := newTestApp(t)
+
+	resp := decode(t, app.post(InteractionsPath, `{"type": 2, "channel_id": "C1",
+		"member": {"user": {"id": "U1", "username": "ada"}},
+		"data": {"name": "echo", "options": [{"name": "text", "type": 3, "value": "hello"}]}}`))
+	assert.Equal(t, responseChannelMessage, resp.Type)
+	assert.Equal(t, "hello", resp.Data.Content)
+	assert.Zero(t, resp.Data.Flags)
+
+	resp = decode(t, app.post(InteractionsPath, `{"type": 2, "data": {"name": "missing"}}`))
+	assert.Equal(t, flagEphemeral, resp.Data.Flags)
+}
+
+func TestHandler_Component(t *testing.T) {
+	app := newTestApp(t)
+
+	poll := decode(t, app.post(InteractionsPath, `{"type": 2, "member": {"user": {"id": "U1"}},
+		"data": {"name": "poll", "options": [{"name": "question", "type": 3, "value": "Lunch?"}]}}`))
+	require.Len(t, poll.Data.Components, 1)
+	yes := poll.Data.Components[0].Components[0]
+	assert.Equal(t, "poll-yes:Lunch?", yes.CustomID)
+	assert.Equal(t, buttonPrimary, yes.Style)
+
+	// Direct messages carry the user instead of the member
+	resp := decode(t, app.post(InteractionsPath, `{"type": 3, "user": {"id": "U2"}, "data": {"custom_id": "`+yes.CustomID+`"}}`))
+	assert.Equal(t, `<@U2> voted yes on "Lunch?"`, resp.Data.Content)
+}
+
+func TestHandler_Events(t *testing.T) {
+	app := newTestApp(t)
+
+	rec := app.post(EventsPath, `{"version": 1, "type": 0}`)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = app.post(EventsPath, `{"version": 1, "type": 1, "event": {"type": "APPLICATION_AUTHORIZED",
+		"data": {"integration_type": 0, "user": {"id": "U1"}, "guild": {"id": "G1", "name": "Test"}}}}`)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	app.handler.Wait()
+
+	assert.Equal(t, []string{"APPLICATION_AUTHORIZED"}, app.handler.EventTypes())
+}
+
+func TestCustomID(t *testing.T) {
+	long := commands.Button{ActionID: "poll-yes", Value: string(bytes.Repeat([]byte("é"), 100))}
+	id := customID(long)
+	assert.LessOrEqual(t, len(id), maxCustomIDLength)
+
+	actionID, _ := parseCustomID(id)
+	assert.Equal(t, "poll-yes", actionID)
+}
+
+func TestClient_RegisterCommands(t *testing.T) {
+	var got []applicationCommand
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/applications/A1/guilds/G1/commands", r.URL.Path)
+		assert.Equal(t, "Bot token", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer api.Close()
+
+	registry, err := commands.Builtin()
+	require.NoError(t, err)
+	require.NoError(t, NewClient("token").WithBaseURL(api.URL).RegisterCommands(context.Background(), "A1", "G1", registry))
+
+	require.Len(t, got, len(registry.Commands()))
+	assert.Equal(t, "echo", got[0].Name)
+	assert.Equal(t, []commandOption{
+		{Type: optionString, Name: "text", Description: "The message to repeat", Required: true},
+	}, got[0].Options)
+}
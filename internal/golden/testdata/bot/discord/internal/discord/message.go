@@ -0,0 +1,152 @@
+package discord
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/example/golden/internal/commands"
+)
+
+// Interaction types received on the interactions endpoint
+const (
+	interactionPing             = 1
+	interactionApplicationCmd   = 2
+	interactionMessageComponent = 3
+)
+
+// Interaction response types
+const (
+	responsePong           = 1
+	responseChannelMessage = 4
+)
+
+// flagEphemeral shows a message to the user who sent the command only
+const flagEphemeral = 1 << 6
+
+// Component types and button styles
+const (
+	componentActionRow = 1
+	componentButton    = 2
+
+	buttonPrimary   = 1
+	buttonSecondary = 2
+	buttonDanger    = 4
+)
+
+// maxCustomIDLength is the Discord limit of component IDs
+const maxCustomIDLength = 100
+
+type interaction struct {
+	Type      int             `json:"type"`
+	Data      interactionData `json:"data"`
+	ChannelID string          `json:"channel_id"`
+	// Member is set in servers, User in direct messages
+	Member *struct {
+		User user `json:"user"`
+	} `json:"member"`
+	User *user `json:"user"`
+}
+
+type interactionData struct {
+	Name     string   `json:"name"`
+	Options  []option `json:"options"`
+	CustomID string   `json:"custom_id"`
+}
+
+type option struct {
+	Name  string `json:"name"`
+	Value any    `json:"value"`
+}
+
+type user struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+}
+
+// sender returns the user who sent the interaction
+func (i interaction) sender() user {
+	if i.Member != nil {
+		return i.Member.User
+	}
+	if i.User != nil {
+		return *i.User
+	}
+	return user{}
+}
+
+// args returns the options of a slash command by name
+func (d interactionData) args() map[string]string {
+	args := make(map[string]string, len(d.Options))
+	for _, option := range d.Options {
+		args[option.Name] = fmt.Sprint(option.Value)
+	}
+	return args
+}
+
+type interactionResponse struct {
+	Type int          `json:"type"`
+	Data *messageData `json:"data,omitempty"`
+}
+
+type messageData struct {
+	Content    string      `json:"content"`
+	Flags      int         `json:"flags,omitempty"`
+	Components []component `json:"components,omitempty"`
+}
+
+type component struct {
+	Type       int         `json:"type"`
+	Style      int         `json:"style,omitempty"`
+	Label      string      `json:"label,omitempty"`
+	CustomID   string      `json:"custom_id,omitempty"`
+	Components []component `json:"components,omitempty"`
+}
+
+// newMessage converts a command response, its buttons becoming an action row.
+// A button carries its action ID and value in its custom ID.
+func newMessage(resp commands.Response) interactionResponse {
+	data := &messageData{Content: resp.Text}
+	if resp.Ephemeral {
+		data.Flags = flagEphemeral
+	}
+	if len(resp.Buttons) > 0 {
+		row := component{Type: componentActionRow}
+		for _, button := range resp.Buttons {
+			row.Components = append(row.Components, component{
+				Type:     componentButton,
+				Style:    buttonStyle(button.Style),
+				Label:    button.Label,
+				CustomID: customID(button),
+			})
+		}
+		data.Components = []component{row}
+	}
+	return interactionResponse{Type: responseChannelMessage, Data: data}
+}
+
+func buttonStyle(style commands.ButtonStyle) int {
+	switch style {
+	case commands.ButtonPrimary:
+		return buttonPrimary
+	case commands.ButtonDanger:
+		return buttonDanger
+	default:
+		return buttonSecondary
+	}
+}
+
+// customID joins the action ID and value of a button, cutting the value to the
+// length Discord allows
+func customID(button commands.Button) string {
+	id := button.ActionID + ":" + button.Value
+	if len(id) > maxCustomIDLength {
+		id = strings.ToValidUTF8(id[:maxCustomIDLength], "")
+	}
+	return id
+}
+
+// parseCustomID splits a custom ID into the action ID and value
+func parseCustomID(id string) (actionID, value string) {
+	actionID, value, _ = strings.Cut(id, ":")
+	return actionID, value
+}
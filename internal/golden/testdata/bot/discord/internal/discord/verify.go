@@ -0,0 +1,51 @@
+// Package discord connects the commands to a Discord application over HTTP:
+// slash commands and message components on the interactions endpoint, and
+// webhook events on their own URL.
+package discord
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// maxBodySize bounds the requests read before their signature is checked
+const maxBodySize = 1 << 20
+
+// ErrInvalidSignature is returned for requests not signed by Discord
+var ErrInvalidSignature = errors.New("invalid request signature")
+
+// Verifier checks the Ed25519 signature Discord puts on every request
+type Verifier struct {
+	publicKey ed25519.PublicKey
+}
+
+// NewVerifier creates a verifier for the hex encoded public key of the application
+func NewVerifier(publicKey string) (*Verifier, error) {
+	key, err := hex.DecodeString(publicKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid public key: expected 64 hex characters")
+	}
+	return &Verifier{publicKey: key}, nil
+}
+
+// Verify reads the body of r and checks its signature, returning the body
+func (v *Verifier) Verify(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the request: %w", err)
+	}
+
+	signature, err := hex.DecodeString(r.Header.Get("X-Signature-Ed25519"))
+	if err != nil || len(signature) != ed25519.SignatureSize {
+		return nil, ErrInvalidSignature
+	}
+	message := append([]byte(r.Header.Get("X-Signature-Timestamp")), body...)
+	if !ed25519.Verify(v.publicKey, message, signature) {
+		return nil, ErrInvalidSignature
+	}
+	return body, nil
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/golden/internal/config"
+)
+
+func newConfigCommand(app *App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect and create the configuration",
+	}
+	cmd.AddCommand(newConfigShowCommand(app), newConfigPathCommand(app), newConfigInitCommand())
+	return cmd
+}
+
+func newConfigShowCommand(app *App) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show",
+		Short: "Print every setting with its value and where it comes from",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			values := app.Config.Values()
+			if app.Config.Output == "json" {
+				return printJSON(cmd.OutOrStdout(), values)
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "KEY\tVALUE\tSOURCE")
+			for _, v := range values {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", v.Key, v.Value, v.Source)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+func newConfigPathCommand(app *App) *cobra.Command {
+	return &cobra.Command{
+		Use:   "path",
+		Short: "Print the config file read by this invocation",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, _ := config.Path(cmd.Flags(), os.LookupEnv)
+			if app.Config.File == "" {
+				path += " (not found)"
+			}
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), path)
+			return err
+		},
+	}
+}
+
+func newConfigInitCommand() *cobra.Command {
+	var force bool
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Write a config file holding the defaults",
+		Args:  cobra.NoArgs,
+		// The file to write may not exist yet, so it is not loaded
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error { return nil },
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, _ := config.Path(cmd.Flags(), os.LookupEnv)
+			if _, err := os.Stat(path); err == nil && !force {
+				return fmt.Errorf("%s already exists, use --force to overwrite it", path)
+			}
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				return fmt.Errorf("failed to create config directory: %w", err)
+			}
+			if err := os.WriteFile(path, []byte(config.Template()), 0o600); err != nil {
+				return fmt.Errorf("failed to write config file: %w", err)
+			}
+			_, err := fmt.Fprintf(cmd.OutOrStdout(), "Wrote %s\n", path)
+			return err
+		},
+	}
+	cmd.Flags().BoolVar(&force, "force", false, "overwrite an existing config file")
+	return cmd
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/golden/internal/plugin"
+)
+
+func newPluginCommand(app *App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plugin",
+		Short: "Manage the plugins found on PATH",
+		Long: `Plugins are executables named ` + plugin.Prefix + `<name> in the directories of PATH.
+"golden <name> args..." runs the first one found with args, the standard
+streams and GOLDEN_BIN set to this executable. Built-in commands win over
+plugins of the same name.`,
+	}
+	cmd.AddCommand(newPluginListCommand(app))
+	return cmd
+}
+
+func newPluginListCommand(app *App) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the plugins found on PATH",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			plugins := plugin.Discover(plugin.Prefix, os.Getenv("PATH"))
+			if app.Config.Output == "json" {
+				if plugins == nil {
+					plugins = []plugin.Plugin{}
+				}
+				return printJSON(cmd.OutOrStdout(), plugins)
+			}
+			if len(plugins) == 0 {
+				_, err := fmt.Fprintf(cmd.OutOrStdout(), "No plugins: add executables named %s<name> to PATH\n", plugin.Prefix)
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tPATH\tNOTES")
+			for _, p := range plugins {
+				var notes []string
+				if builtin(cmd.Root(), p.Name) {
+					notes = append(notes, "never runs: a built-in command has this name")
+				}
+				for _, shadowed := range p.Shadowed {
+					notes = append(notes, "shadows "+shadowed)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\n", p.Name, p.Path, strings.Join(notes, "; "))
+			}
+			return w.Flush()
+		},
+	}
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/plugin"
+)
+
+// App is the state shared by the commands, set up before any of them runs
+type App struct {
+	Config *config.Config
+	Logger logger.Logger
+}
+
+// NewRootCommand creates the golden command and its subcommands
+func NewRootCommand(app *App) *cobra.Command {
+	root := &cobra.Command{
+		Use:   "golden",
+		Short: "golden command-line application",
+		Long: `golden command-line application.
+
+Settings come from flags, else GOLDEN_* environment variables, else the
+config file, else their defaults. Executables named golden-<name> on PATH
+run as the subcommand <name>.`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return app.setup(cmd)
+		},
+	}
+	config.RegisterFlags(root.PersistentFlags())
+
+	root.AddCommand(
+		newVersionCommand(app),
+		newConfigCommand(app),
+		newPluginCommand(app),
+		newSelfUpdateCommand(app),
+	)
+	return root
+}
+
+// setup loads the configuration of the invocation and creates its logger
+func (a *App) setup(cmd *cobra.Command) error {
+	cfg, err := config.Load(cmd.Flags(), os.LookupEnv)
+	if err != nil {
+		return err
+	}
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: cfg.LogLevel, Format: "console"}, cmd.ErrOrStderr())
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+	a.Config, a.Logger = cfg, log
+	a.Logger.Debug("configuration loaded", "file", cfg.File, "command", cmd.CommandPath())
+	return nil
+}
+
+// Execute runs the command line args. When the first argument names neither a
+// built-in command nor a flag, it runs the plugin of that name
+func Execute(ctx context.Context, args []string) error {
+	root := NewRootCommand(&App{})
+	root.SetArgs(args)
+
+	if name, ok := pluginCall(root, args); ok {
+		p, err := plugin.Find(plugin.Prefix, name, os.Getenv("PATH"))
+		if err == nil {
+			return plugin.Run(p, args[1:], pluginEnv())
+		}
+		if !errors.Is(err, plugin.ErrNotFound) {
+			return err
+		}
+		// Let cobra report the unknown command and its suggestions
+	}
+	return root.ExecuteContext(ctx)
+}
+
+// pluginCall returns the plugin args call, ok when their first argument is not a built-in command
+func pluginCall(root *cobra.Command, args []string) (string, bool) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return "", false
+	}
+	// help and completion are added when the command runs, add them now so
+	// that plugins cannot take their names
+	root.InitDefaultHelpCmd()
+	root.InitDefaultCompletionCmd()
+	if _, _, err := root.Find(args); err == nil {
+		return "", false
+	}
+	return args[0], true
+}
+
+// pluginEnv is added to the environment of the plugins, so that they can call back
+func pluginEnv() []string {
+	executable, err := os.Executable()
+	if err != nil {
+		return nil
+	}
+	return []string{"GOLDEN_BIN=" + executable}
+}
+
+// builtin reports whether name is a command of root, which wins over a plugin of the same name
+func builtin(root *cobra.Command, name string) bool {
+	root.InitDefaultHelpCmd()
+	root.InitDefaultCompletionCmd()
+	for _, c := range root.Commands() {
+		if c.Name() == name || c.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// printJSON writes v indented, for the json output
+func printJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/plugin"
+)
+
+// isolate empties the user configuration directory and PATH of the test
+func isolate(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("AppData", dir)
+	t.Setenv("PATH", dir)
+	for _, value := range config.Default().Values() {
+		t.Setenv(config.EnvPrefix+strings.ToUpper(value.Key), "")
+		os.Unsetenv(config.EnvPrefix + strings.ToUpper(value.Key))
+	}
+	return dir
+}
+
+func run(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	var out bytes.Buffer
+	root := NewRootCommand(&App{})
+	root.SetArgs(args)
+	root.SetOut(&out)
+	root.SetErr(&out)
+	err := root.ExecuteContext(context.Background())
+	return out.String(), err
+}
+
+func TestConfigShow_Sources(t *testing.T) {
+	dir := isolate(t)
+	file := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("timeout: 1m0s\n"), 0o600))
+	t.Setenv(config.ConfigEnv, file)
+	t.Setenv(config.EnvPrefix+"LOG_LEVEL", "info")
+
+	out, err := run(t, "config", "show", "--output", "text")
+	require.NoError(t, err)
+
+	assert.Regexp(t, `log_level\s+info\s+env`, out)
+	assert.Regexp(t, `output\s+text\s+flag`, out)
+	assert.Regexp(t, `timeout\s+1m0s\s+file`, out)
+}
+
+func TestConfigInit(t *testing.T) {
+	dir := isolate(t)
+	file := filepath.Join(dir, "nested", "config.yaml")
+
+	_, err := run(t, "config", "init", "--config", file)
+	require.NoError(t, err)
+	assert.FileExists(t, file)
+
+	_, err = run(t, "config", "init", "--config", file)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+
+	out, err := run(t, "config", "path", "--config", file)
+	require.NoError(t, err)
+	assert.Equal(t, file+"\n", out)
+}
+
+func TestExecute_UnknownCommand(t *testing.T) {
+	isolate(t)
+
+	err := Execute(context.Background(), []string{"nope"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown command "nope"`)
+}
+
+func TestExecute_Plugin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts in this test")
+	}
+	dir := isolate(t)
+	out := filepath.Join(dir, "out")
+	script := "#!/bin/sh\necho \"$@\" > \"" + out + "\"\n[ -n \"$GOLDEN_BIN\" ] || exit 2\nexit ${EXIT_CODE:-0}\n"
+	for _, name := range []string{"hello", "version"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, plugin.Prefix+name), []byte(script), 0o755))
+	}
+
+	require.NoError(t, Execute(context.Background(), []string{"hello", "--name", "world"}))
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "--name world\n", string(data), "plugin args are passed through untouched")
+
+	t.Setenv("EXIT_CODE", "4")
+	err = Execute(context.Background(), []string{"hello"})
+	var exitErr *plugin.ExitError
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, 4, exitErr.Code)
+
+	// Built-in commands win over plugins of the same name
+	require.NoError(t, os.Remove(out))
+	t.Setenv("EXIT_CODE", "0")
+	require.NoError(t, Execute(context.Background(), []string{"version", "-o", "json"}))
+	assert.NoFileExists(t, out)
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/golden/internal/update"
+	"github.com/example/golden/internal/version"
+)
+
+func newSelfUpdateCommand(app *App) *cobra.Command {
+	var check, force bool
+	cmd := &cobra.Command{
+		Use:   "self-update",
+		Short: "Replace this binary with the latest release",
+		Long: `Downloads the binary of this platform from the latest GitHub release of the
+update repository, checks it against the checksums.txt of the release and
+replaces the running binary with it.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := app.Config
+			if cfg.UpdateRepository == "" {
+				return fmt.Errorf("no update repository: set update_repository in the config file, GOLDEN_UPDATE_REPOSITORY or --update-repository")
+			}
+			if version.Version == "dev" && !check && !force {
+				return fmt.Errorf("refusing to replace a development build, use --force")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), cfg.Timeout)
+			defer cancel()
+
+			updater, err := update.NewUpdater(cfg.UpdateRepository, version.Version, &http.Client{})
+			if err != nil {
+				return err
+			}
+			app.Logger.Debug("checking for updates", "repository", cfg.UpdateRepository, "current", version.Version)
+			release, newer, err := updater.Check(ctx)
+			if err != nil {
+				return err
+			}
+
+			if check {
+				if cfg.Output == "json" {
+					return printJSON(cmd.OutOrStdout(), map[string]any{
+						"current": version.Version,
+						"latest":  release.Version,
+						"newer":   newer,
+					})
+				}
+				if !newer {
+					_, err = fmt.Fprintf(cmd.OutOrStdout(), "Up to date (%s)\n", version.Version)
+					return err
+				}
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s is available (current %s), run golden self-update\n", release.Version, version.Version)
+				return err
+			}
+
+			if !newer && !force {
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "Up to date (%s)\n", version.Version)
+				return err
+			}
+			if err := updater.Apply(ctx, release); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "Updated %s from %s to %s\n", updater.Executable, version.Version, release.Version)
+			return err
+		},
+	}
+	cmd.Flags().BoolVar(&check, "check", false, "only report whether a newer release exists")
+	cmd.Flags().BoolVar(&force, "force", false, "install the latest release even when it is not newer, or over a development build")
+	return cmd
+}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/golden/internal/version"
+)
+
+func newVersionCommand(app *App) *cobra.Command {
+	return &cobra.Command{
+		Use:   "version",
+		Short: "Print the version",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if app.Config.Output == "json" {
+				return printJSON(cmd.OutOrStdout(), map[string]string{
+					"version":  version.Version,
+					"commit":   version.Commit,
+					"date":     version.Date,
+					"platform": runtime.GOOS + "/" + runtime.GOARCH,
+				})
+			}
+			_, err := fmt.Fprintf(cmd.OutOrStdout(), "golden %s (commit %s, built %s, %s/%s)\n",
+				version.Version, version.Commit, version.Date, runtime.GOOS, runtime.GOARCH)
+			return err
+		},
+	}
+}
@@ -0,0 +1,276 @@
+// Package config resolves the settings of an invocation. Each setting comes from
+// its flag, else its environment variable, else the config file, else its default
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix prefixes the environment variables of the settings
+const EnvPrefix = "GOLDEN_"
+
+// ConfigFlag and ConfigEnv name the config file of an invocation
+const (
+	ConfigFlag = "config"
+	ConfigEnv  = EnvPrefix + "CONFIG"
+)
+
+// Source is where the value of a setting comes from
+type Source string
+
+const (
+	SourceDefault Source = "default"
+	SourceFile    Source = "file"
+	SourceEnv     Source = "env"
+	SourceFlag    Source = "flag"
+)
+
+// Config is the configuration of an invocation
+type Config struct {
+	// LogLevel is one of debug, info, warn or error
+	LogLevel string
+	// Output is text or json
+	Output string
+	// Timeout bounds the network calls, such as those of self-update
+	Timeout time.Duration
+	// UpdateRepository is the owner/name of the GitHub repository publishing the releases
+	UpdateRepository string
+
+	// File is the config file that was read, empty when there was none
+	File string
+
+	sources map[string]Source
+}
+
+// Value is a setting as resolved for an invocation
+type Value struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Source Source `json:"source"`
+}
+
+// setting is a key of the config file with its flag and environment variable
+type setting struct {
+	key   string
+	short string
+	usage string
+	get   func(*Config) string
+	set   func(*Config, string) error
+}
+
+// Flag is the flag of the setting
+func (s setting) Flag() string {
+	return strings.ReplaceAll(s.key, "_", "-")
+}
+
+// Env is the environment variable of the setting
+func (s setting) Env() string {
+	return EnvPrefix + strings.ToUpper(s.key)
+}
+
+var repositoryPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+$`)
+
+var settings = []setting{
+	{
+		key:   "log_level",
+		usage: "log level (debug, info, warn, error)",
+		get:   func(c *Config) string { return c.LogLevel },
+		set: func(c *Config, value string) error {
+			switch value {
+			case "debug", "info", "warn", "error":
+				c.LogLevel = value
+				return nil
+			}
+			return fmt.Errorf("must be debug, info, warn or error")
+		},
+	},
+	{
+		key:   "output",
+		short: "o",
+		usage: "output format (text, json)",
+		get:   func(c *Config) string { return c.Output },
+		set: func(c *Config, value string) error {
+			if value != "text" && value != "json" {
+				return fmt.Errorf("must be text or json")
+			}
+			c.Output = value
+			return nil
+		},
+	},
+	{
+		key:   "timeout",
+		usage: "timeout of the network calls",
+		get:   func(c *Config) string { return c.Timeout.String() },
+		set: func(c *Config, value string) error {
+			timeout, err := time.ParseDuration(value)
+			if err != nil || timeout <= 0 {
+				return fmt.Errorf("must be a positive duration such as 30s")
+			}
+			c.Timeout = timeout
+			return nil
+		},
+	},
+	{
+		key:   "update_repository",
+		usage: "GitHub repository (owner/name) publishing the releases of self-update",
+		get:   func(c *Config) string { return c.UpdateRepository },
+		set: func(c *Config, value string) error {
+			if value != "" && !repositoryPattern.MatchString(value) {
+				return fmt.Errorf("must be owner/name")
+			}
+			c.UpdateRepository = value
+			return nil
+		},
+	},
+}
+
+// Default returns the configuration without flags, environment or file
+func Default() *Config {
+	c := &Config{
+		LogLevel:         "warn",
+		Output:           "text",
+		Timeout:          30 * time.Second,
+		UpdateRepository: "example/golden",
+		sources:          make(map[string]Source),
+	}
+	for _, s := range settings {
+		c.sources[s.key] = SourceDefault
+	}
+	return c
+}
+
+// RegisterFlags adds the --config flag and a flag per setting
+func RegisterFlags(flags *pflag.FlagSet) {
+	defaults := Default()
+	flags.String(ConfigFlag, "", fmt.Sprintf("config file (default %s, or $%s)", DefaultPath(), ConfigEnv))
+	for _, s := range settings {
+		flags.StringP(s.Flag(), s.short, s.get(defaults), fmt.Sprintf("%s, or $%s", s.usage, s.Env()))
+	}
+}
+
+// DefaultPath is the config file read when neither the flag nor the environment
+// name one, in the user configuration directory
+func DefaultPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return filepath.Join(".golden", "config.yaml")
+	}
+	return filepath.Join(dir, "golden", "config.yaml")
+}
+
+// Path returns the config file of an invocation: the --config flag, else the
+// environment, else DefaultPath. explicit is false for DefaultPath
+func Path(flags *pflag.FlagSet, lookupEnv func(string) (string, bool)) (path string, explicit bool) {
+	if flag := flags.Lookup(ConfigFlag); flag != nil && flag.Changed {
+		return flag.Value.String(), true
+	}
+	if value, ok := lookupEnv(ConfigEnv); ok && value != "" {
+		return value, true
+	}
+	return DefaultPath(), false
+}
+
+// Load resolves the configuration from the changed flags, the environment read
+// with lookupEnv and the config file
+func Load(flags *pflag.FlagSet, lookupEnv func(string) (string, bool)) (*Config, error) {
+	c := Default()
+
+	path, explicit := Path(flags, lookupEnv)
+	if err := c.readFile(path, explicit); err != nil {
+		return nil, err
+	}
+
+	for _, s := range settings {
+		if value, ok := lookupEnv(s.Env()); ok {
+			if err := s.set(c, value); err != nil {
+				return nil, fmt.Errorf("invalid %s %q: %w", s.Env(), value, err)
+			}
+			c.sources[s.key] = SourceEnv
+		}
+		if flag := flags.Lookup(s.Flag()); flag != nil && flag.Changed {
+			if err := s.set(c, flag.Value.String()); err != nil {
+				return nil, fmt.Errorf("invalid --%s %q: %w", s.Flag(), flag.Value, err)
+			}
+			c.sources[s.key] = SourceFlag
+		}
+	}
+	return c, nil
+}
+
+// readFile applies the settings of the config file at path. A missing file is
+// an error only when it was asked for
+func (c *Config) readFile(path string, explicit bool) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) && !explicit {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var values map[string]any
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	for key, raw := range values {
+		s, ok := lookup(key)
+		if !ok {
+			return fmt.Errorf("unknown setting %q in %s", key, path)
+		}
+		switch raw.(type) {
+		case map[string]any, []any, nil:
+			return fmt.Errorf("setting %q in %s must be a single value", key, path)
+		}
+		value := fmt.Sprint(raw)
+		if err := s.set(c, value); err != nil {
+			return fmt.Errorf("invalid %s %q in %s: %w", key, value, path, err)
+		}
+		c.sources[key] = SourceFile
+	}
+	c.File = path
+	return nil
+}
+
+// Values returns every setting with its value and its source
+func (c *Config) Values() []Value {
+	values := make([]Value, len(settings))
+	for i, s := range settings {
+		values[i] = Value{Key: s.key, Value: s.get(c), Source: c.sources[s.key]}
+	}
+	return values
+}
+
+// Source returns where the setting of key comes from
+func (c *Config) Source(key string) Source {
+	return c.sources[key]
+}
+
+// Template returns a config file holding the defaults, for config init
+func Template() string {
+	var b strings.Builder
+	b.WriteString("# golden configuration. Flags and environment variables override these settings\n")
+	defaults := Default()
+	for _, s := range settings {
+		fmt.Fprintf(&b, "\n# %s, or $%s, or --%s\n%s: %q\n", s.usage, s.Env(), s.Flag(), s.key, s.get(defaults))
+	}
+	return b.String()
+}
+
+func lookup(key string) (setting, bool) {
+	for _, s := range settings {
+		if s.key == key {
+			return s, true
+		}
+	}
+	return setting{}, false
+}
@@ -0,0 +1,162 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// isolate points the user configuration directory at an empty directory, so
+// that the config file of the developer running the tests is not read
+func isolate(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("AppData", dir)
+	return dir
+}
+
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func load(t *testing.T, args []string, env map[string]string) (*Config, error) {
+	t.Helper()
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	RegisterFlags(flags)
+	require.NoError(t, flags.Parse(args))
+	return Load(flags, func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	})
+}
+
+func TestLoad_Precedence(t *testing.T) {
+	isolate(t)
+	file := writeFile(t, "log_level: debug\noutput: json\ntimeout: 10s\n")
+
+	tests := []struct {
+		name        string
+		args        []string
+		env         map[string]string
+		wantLevel   string
+		wantOutput  string
+		wantTimeout time.Duration
+		wantSources map[string]Source
+	}{
+		{
+			name:        "defaults",
+			wantLevel:   "warn",
+			wantOutput:  "text",
+			wantTimeout: 30 * time.Second,
+			wantSources: map[string]Source{"log_level": SourceDefault, "output": SourceDefault, "timeout": SourceDefault},
+		},
+		{
+			name:        "file over defaults",
+			args:        []string{"--config", file},
+			wantLevel:   "debug",
+			wantOutput:  "json",
+			wantTimeout: 10 * time.Second,
+			wantSources: map[string]Source{"log_level": SourceFile, "output": SourceFile, "timeout": SourceFile},
+		},
+		{
+			name:        "env over file",
+			env:         map[string]string{ConfigEnv: file, EnvPrefix + "LOG_LEVEL": "error"},
+			wantLevel:   "error",
+			wantOutput:  "json",
+			wantTimeout: 10 * time.Second,
+			wantSources: map[string]Source{"log_level": SourceEnv, "output": SourceFile, "timeout": SourceFile},
+		},
+		{
+			name:        "flags over env and file",
+			args:        []string{"--config", file, "--log-level", "info", "-o", "text"},
+			env:         map[string]string{EnvPrefix + "LOG_LEVEL": "error", EnvPrefix + "TIMEOUT": "5s"},
+			wantLevel:   "info",
+			wantOutput:  "text",
+			wantTimeout: 5 * time.Second,
+			wantSources: map[string]Source{"log_level": SourceFlag, "output": SourceFlag, "timeout": SourceEnv},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := load(t, tt.args, tt.env)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantLevel, c.LogLevel)
+			assert.Equal(t, tt.wantOutput, c.Output)
+			assert.Equal(t, tt.wantTimeout, c.Timeout)
+			for key, source := range tt.wantSources {
+				assert.Equal(t, source, c.Source(key), key)
+			}
+		})
+	}
+}
+
+func TestLoad_DefaultFile(t *testing.T) {
+	dir := isolate(t)
+
+	c, err := load(t, nil, nil)
+	require.NoError(t, err, "a missing default config file is not an error")
+	assert.Empty(t, c.File)
+
+	path := DefaultPath()
+	require.True(t, filepath.IsAbs(path) && len(path) > len(dir))
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte("output: json\n"), 0o600))
+
+	c, err = load(t, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "json", c.Output)
+	assert.Equal(t, path, c.File)
+}
+
+func TestLoad_Errors(t *testing.T) {
+	isolate(t)
+
+	tests := []struct {
+		name string
+		args []string
+		env  map[string]string
+		want string
+	}{
+		{name: "missing explicit file", args: []string{"--config", filepath.Join(t.TempDir(), "missing.yaml")}, want: "failed to read config file"},
+		{name: "unknown file key", args: []string{"--config", writeFile(t, "colour: red\n")}, want: `unknown setting "colour"`},
+		{name: "nested file value", args: []string{"--config", writeFile(t, "output:\n  format: json\n")}, want: "must be a single value"},
+		{name: "invalid file value", args: []string{"--config", writeFile(t, "timeout: soon\n")}, want: "invalid timeout"},
+		{name: "invalid env value", env: map[string]string{EnvPrefix + "OUTPUT": "xml"}, want: "invalid " + EnvPrefix + "OUTPUT"},
+		{name: "invalid flag value", args: []string{"--log-level", "loud"}, want: "invalid --log-level"},
+		{name: "invalid repository", args: []string{"--update-repository", "not a repository"}, want: "must be owner/name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := load(t, tt.args, tt.env)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestTemplate_LoadsAsDefaults(t *testing.T) {
+	isolate(t)
+	file := writeFile(t, Template())
+
+	c, err := load(t, []string{"--config", file}, nil)
+	require.NoError(t, err)
+
+	defaults := Default()
+	for i, value := range c.Values() {
+		assert.Equal(t, defaults.Values()[i].Value, value.Value, value.Key)
+		assert.Equal(t, SourceFile, value.Source, value.Key)
+	}
+}
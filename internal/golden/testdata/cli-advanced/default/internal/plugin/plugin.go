@@ -0,0 +1,155 @@
+// Package plugin finds and runs the plugins of golden: executables named
+// golden-<plugin> in the directories of PATH
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// Prefix starts the file name of every plugin
+const Prefix = "golden-"
+
+// ErrNotFound is returned by Find when no plugin has the name
+var ErrNotFound = errors.New("plugin not found")
+
+// Plugin is an executable on PATH run as a subcommand
+type Plugin struct {
+	// Name is the subcommand, the file name without the prefix and extension
+	Name string `json:"name"`
+	// Path is the executable that runs, the first one found on PATH
+	Path string `json:"path"`
+	// Shadowed lists the executables of the same name later on PATH, which never run
+	Shadowed []string `json:"shadowed,omitempty"`
+}
+
+// ExitError reports a plugin that exited with a non-zero code, which the command
+// exits with in turn
+type ExitError struct {
+	Name string
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("plugin %s exited with code %d", e.Name, e.Code)
+}
+
+// Discover lists the plugins found in the directories of pathList, sorted by name
+func Discover(prefix, pathList string) []Plugin {
+	var plugins []Plugin
+	index := make(map[string]int)
+
+	for _, dir := range filepath.SplitList(pathList) {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			name, ok := pluginName(prefix, entry.Name())
+			if !ok {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			if !isExecutable(path) {
+				continue
+			}
+			if i, seen := index[name]; seen {
+				plugins[i].Shadowed = append(plugins[i].Shadowed, path)
+				continue
+			}
+			index[name] = len(plugins)
+			plugins = append(plugins, Plugin{Name: name, Path: path})
+		}
+	}
+
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].Name < plugins[j].Name })
+	return plugins
+}
+
+// Find returns the plugin called name, the first one found in the directories of pathList
+func Find(prefix, name, pathList string) (*Plugin, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+		return nil, fmt.Errorf("%w: invalid name %q", ErrNotFound, name)
+	}
+	for _, dir := range filepath.SplitList(pathList) {
+		if dir == "" {
+			continue
+		}
+		for _, file := range candidates(prefix + name) {
+			path := filepath.Join(dir, file)
+			if isExecutable(path) {
+				return &Plugin{Name: name, Path: path}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// Run runs the plugin with args, the standard streams of the command and env
+// added to its environment. A non-zero exit is returned as an *ExitError
+func Run(p *Plugin, args, env []string) error {
+	// No context: an interrupt from the terminal reaches the plugin, which decides
+	// how to stop, and the command waits for it
+	cmd := exec.Command(p.Path, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), env...)
+
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return &ExitError{Name: p.Name, Code: exitErr.ExitCode()}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to run plugin %s: %w", p.Name, err)
+	}
+	return nil
+}
+
+// pluginName returns the plugin name of a file name, ok when the file is one
+func pluginName(prefix, file string) (string, bool) {
+	if !strings.HasPrefix(file, prefix) {
+		return "", false
+	}
+	name := strings.TrimPrefix(file, prefix)
+	if runtime.GOOS == "windows" {
+		ext := strings.ToLower(filepath.Ext(name))
+		if ext != ".exe" && ext != ".bat" && ext != ".cmd" {
+			return "", false
+		}
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	if name == "" || strings.HasPrefix(name, ".") {
+		return "", false
+	}
+	return name, true
+}
+
+// candidates are the file names a plugin may have on this system
+func candidates(file string) []string {
+	if runtime.GOOS == "windows" {
+		return []string{file + ".exe", file + ".bat", file + ".cmd"}
+	}
+	return []string{file}
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	return info.Mode().Perm()&0o111 != 0
+}
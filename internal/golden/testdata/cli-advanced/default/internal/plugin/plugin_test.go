@@ -0,0 +1,83 @@
+package plugin
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// script writes an executable shell script named file in dir
+func script(t *testing.T, dir, file, body string) string {
+	t.Helper()
+	path := filepath.Join(dir, file)
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0o755))
+	return path
+}
+
+func skipOnWindows(t *testing.T) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts in these tests")
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	skipOnWindows(t)
+	first, second := t.TempDir(), t.TempDir()
+
+	hello := script(t, first, Prefix+"hello", "exit 0")
+	shadowed := script(t, second, Prefix+"hello", "exit 0")
+	deploy := script(t, second, Prefix+"deploy", "exit 0")
+	script(t, first, "other-tool", "exit 0")
+	require.NoError(t, os.WriteFile(filepath.Join(first, Prefix+"notes"), []byte("not executable"), 0o644))
+	require.NoError(t, os.Mkdir(filepath.Join(first, Prefix+"dir"), 0o755))
+
+	plugins := Discover(Prefix, first+string(os.PathListSeparator)+second)
+
+	assert.Equal(t, []Plugin{
+		{Name: "deploy", Path: deploy},
+		{Name: "hello", Path: hello, Shadowed: []string{shadowed}},
+	}, plugins)
+}
+
+func TestFind(t *testing.T) {
+	skipOnWindows(t)
+	dir := t.TempDir()
+	path := script(t, dir, Prefix+"hello", "exit 0")
+
+	p, err := Find(Prefix, "hello", dir)
+	require.NoError(t, err)
+	assert.Equal(t, path, p.Path)
+
+	for _, name := range []string{"missing", "../hello", "", ".hidden"} {
+		_, err := Find(Prefix, name, dir)
+		assert.True(t, errors.Is(err, ErrNotFound), name)
+	}
+}
+
+func TestRun_ExitCode(t *testing.T) {
+	skipOnWindows(t)
+	dir := t.TempDir()
+	out := filepath.Join(dir, "out")
+	script(t, dir, Prefix+"ok", `echo "$1 $TEST_VALUE" > "`+out+`"`)
+	script(t, dir, Prefix+"fail", "exit 3")
+
+	ok, err := Find(Prefix, "ok", dir)
+	require.NoError(t, err)
+	require.NoError(t, Run(ok, []string{"arg"}, []string{"TEST_VALUE=env"}))
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "arg env\n", string(data))
+
+	fail, err := Find(Prefix, "fail", dir)
+	require.NoError(t, err)
+	err = Run(fail, nil, nil)
+	var exitErr *ExitError
+	require.True(t, errors.As(err, &exitErr))
+	assert.Equal(t, 3, exitErr.Code)
+}
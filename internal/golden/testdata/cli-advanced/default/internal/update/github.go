@@ -0,0 +1,68 @@
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GitHub reads the latest release of a GitHub repository
+type GitHub struct {
+	// Repository is owner/name
+	Repository string
+	// BaseURL defaults to https://api.github.com
+	BaseURL string
+	Client  *http.Client
+}
+
+type githubRelease struct {
+	TagName string `json:"tag_name"`
+	Assets  []struct {
+		Name string `json:"name"`
+		URL  string `json:"browser_download_url"`
+	} `json:"assets"`
+}
+
+// Latest returns the latest release, drafts and pre-releases excluded
+func (g *GitHub) Latest(ctx context.Context) (*Release, error) {
+	if g.Repository == "" {
+		return nil, fmt.Errorf("no repository to update from")
+	}
+	baseURL := g.BaseURL
+	if baseURL == "" {
+		baseURL = "https://api.github.com"
+	}
+	url := strings.TrimSuffix(baseURL, "/") + "/repos/" + g.Repository + "/releases/latest"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	res, err := client(g.Client).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the latest release: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("no release published in %s", g.Repository)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get the latest release of %s: %s", g.Repository, res.Status)
+	}
+
+	var body githubRelease
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode the latest release: %w", err)
+	}
+
+	release := &Release{Version: body.TagName, Assets: make(map[string]string, len(body.Assets))}
+	for _, asset := range body.Assets {
+		release.Assets[asset.Name] = asset.URL
+	}
+	return release, nil
+}
@@ -0,0 +1,219 @@
+// Package update replaces the running binary with the latest release after
+// checking it against the checksums published with it
+package update
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// ChecksumsAsset is the asset listing the sha256 of the other assets, in the
+// format of sha256sum
+const ChecksumsAsset = "checksums.txt"
+
+// Release is a published version and the download URL of each of its assets
+type Release struct {
+	Version string
+	Assets  map[string]string
+}
+
+// Source returns the latest release
+type Source interface {
+	Latest(ctx context.Context) (*Release, error)
+}
+
+// Updater replaces Executable with the asset AssetName of the latest release
+type Updater struct {
+	Source     Source
+	Client     *http.Client
+	Current    string
+	Executable string
+	AssetName  string
+}
+
+// NewUpdater creates an updater of the running binary from the releases of repository
+func NewUpdater(repository, current string, client *http.Client) (*Updater, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate the running binary: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(executable); err == nil {
+		executable = resolved
+	}
+	return &Updater{
+		Source:     &GitHub{Repository: repository, Client: client},
+		Client:     client,
+		Current:    current,
+		Executable: executable,
+		AssetName:  AssetName(runtime.GOOS, runtime.GOARCH),
+	}, nil
+}
+
+// AssetName is the name of the release asset built for goos and goarch, as
+// written by make dist
+func AssetName(goos, goarch string) string {
+	name := "golden_" + goos + "_" + goarch
+	if goos == "windows" {
+		name += ".exe"
+	}
+	return name
+}
+
+// Check returns the latest release, and whether it is newer than the current version
+func (u *Updater) Check(ctx context.Context) (*Release, bool, error) {
+	release, err := u.Source.Latest(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return release, Newer(release.Version, u.Current), nil
+}
+
+// Apply downloads the asset of the release, checks its sha256 and replaces the executable
+func (u *Updater) Apply(ctx context.Context, release *Release) error {
+	assetURL, ok := release.Assets[u.AssetName]
+	if !ok {
+		return fmt.Errorf("release %s has no asset %s", release.Version, u.AssetName)
+	}
+	checksumsURL, ok := release.Assets[ChecksumsAsset]
+	if !ok {
+		return fmt.Errorf("release %s has no %s, refusing to install an unverified binary", release.Version, ChecksumsAsset)
+	}
+
+	checksums, err := u.download(ctx, checksumsURL)
+	if err != nil {
+		return err
+	}
+	want, err := checksumOf(checksums, u.AssetName)
+	if err != nil {
+		return err
+	}
+	binary, err := u.download(ctx, assetURL)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(binary)
+	if got := hex.EncodeToString(sum[:]); got != want {
+		return fmt.Errorf("checksum mismatch for %s: got %s, want %s", u.AssetName, got, want)
+	}
+
+	return replace(u.Executable, binary)
+}
+
+func (u *Updater) download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client(u.Client).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s: %s", url, res.Status)
+	}
+	return io.ReadAll(res.Body)
+}
+
+// checksumOf finds the sha256 of name in a sha256sum listing
+func checksumOf(checksums []byte, name string) (string, error) {
+	scanner := bufio.NewScanner(strings.NewReader(string(checksums)))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == name {
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+	return "", fmt.Errorf("%s has no checksum for %s", ChecksumsAsset, name)
+}
+
+// replace writes binary next to executable and renames it over it, so that the
+// executable is never left half written
+func replace(executable string, binary []byte) error {
+	dir := filepath.Dir(executable)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(executable)+".new-*")
+	if err != nil {
+		return fmt.Errorf("failed to write to %s: %w", dir, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(binary); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write the new binary: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write the new binary: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o755); err != nil {
+		return fmt.Errorf("failed to make the new binary executable: %w", err)
+	}
+
+	if runtime.GOOS == "windows" {
+		// A running executable cannot be replaced on Windows, but it can be moved
+		old := executable + ".old"
+		_ = os.Remove(old)
+		if err := os.Rename(executable, old); err != nil {
+			return fmt.Errorf("failed to move the current binary: %w", err)
+		}
+	}
+	if err := os.Rename(tmp.Name(), executable); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", executable, err)
+	}
+	return nil
+}
+
+// Newer reports whether version latest is greater than current. Versions are
+// compared as dot separated numbers, with or without a leading v; a pre-release
+// is older than its release
+func Newer(latest, current string) bool {
+	l, lPre := parseVersion(latest)
+	c, cPre := parseVersion(current)
+	for i := 0; i < len(l) || i < len(c); i++ {
+		var a, b int
+		if i < len(l) {
+			a = l[i]
+		}
+		if i < len(c) {
+			b = c[i]
+		}
+		if a != b {
+			return a > b
+		}
+	}
+	if lPre == "" || cPre == "" {
+		return lPre == "" && cPre != ""
+	}
+	return lPre > cPre
+}
+
+func parseVersion(version string) ([]int, string) {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	version, _, _ = strings.Cut(version, "+")
+	version, pre, _ := strings.Cut(version, "-")
+	var parts []int
+	for _, part := range strings.Split(version, ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			n = 0
+		}
+		parts = append(parts, n)
+	}
+	return parts, pre
+}
+
+func client(c *http.Client) *http.Client {
+	if c == nil {
+		return http.DefaultClient
+	}
+	return c
+}
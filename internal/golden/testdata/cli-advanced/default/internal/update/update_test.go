@@ -0,0 +1,115 @@
+package update
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewer(t *testing.T) {
+	tests := []struct {
+		latest, current string
+		want            bool
+	}{
+		{"v1.2.0", "v1.1.9", true},
+		{"1.10.0", "v1.9.0", true},
+		{"v1.2.0", "v1.2.0", false},
+		{"v1.2.0", "v1.3.0", false},
+		{"v2.0", "v1.9.9", true},
+		{"v1.2.0", "v1.2.0-rc.1", true},
+		{"v1.2.0-rc.2", "v1.2.0-rc.1", true},
+		{"v1.2.0-rc.1", "v1.2.0", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Newer(tt.latest, tt.current), "%s > %s", tt.latest, tt.current)
+	}
+}
+
+// releaseServer serves a GitHub latest release of version with binary as the
+// asset of name, listed in its checksums with checksum
+func releaseServer(t *testing.T, version, name string, binary []byte, checksum string) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/repos/acme/tool/releases/latest", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"tag_name":%q,"assets":[{"name":%q,"browser_download_url":%q},{"name":"checksums.txt","browser_download_url":%q}]}`,
+			version, name, server.URL+"/download/"+name, server.URL+"/download/checksums.txt")
+	})
+	mux.HandleFunc("/download/"+name, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(binary)
+	})
+	mux.HandleFunc("/download/checksums.txt", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s  %s\n", checksum, name)
+	})
+	return server
+}
+
+func newTestUpdater(t *testing.T, server *httptest.Server) *Updater {
+	t.Helper()
+	executable := filepath.Join(t.TempDir(), "golden")
+	require.NoError(t, os.WriteFile(executable, []byte("old"), 0o755))
+	return &Updater{
+		Source:     &GitHub{Repository: "acme/tool", BaseURL: server.URL, Client: server.Client()},
+		Client:     server.Client(),
+		Current:    "v1.0.0",
+		Executable: executable,
+		AssetName:  AssetName("linux", "amd64"),
+	}
+}
+
+func TestUpdater_Apply(t *testing.T) {
+	binary := []byte("new binary")
+	sum := sha256.Sum256(binary)
+	server := releaseServer(t, "v1.1.0", AssetName("linux", "amd64"), binary, hex.EncodeToString(sum[:]))
+	u := newTestUpdater(t, server)
+
+	release, newer, err := u.Check(context.Background())
+	require.NoError(t, err)
+	assert.True(t, newer)
+	assert.Equal(t, "v1.1.0", release.Version)
+
+	require.NoError(t, u.Apply(context.Background(), release))
+
+	data, err := os.ReadFile(u.Executable)
+	require.NoError(t, err)
+	assert.Equal(t, binary, data)
+}
+
+func TestUpdater_Apply_ChecksumMismatch(t *testing.T) {
+	server := releaseServer(t, "v1.1.0", AssetName("linux", "amd64"), []byte("tampered"), "0000")
+	u := newTestUpdater(t, server)
+
+	release, _, err := u.Check(context.Background())
+	require.NoError(t, err)
+
+	err = u.Apply(context.Background(), release)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+
+	data, err := os.ReadFile(u.Executable)
+	require.NoError(t, err)
+	assert.Equal(t, "old", string(data), "the executable is left untouched")
+}
+
+func TestUpdater_Apply_MissingAsset(t *testing.T) {
+	server := releaseServer(t, "v1.1.0", AssetName("darwin", "arm64"), []byte("binary"), "0000")
+	u := newTestUpdater(t, server)
+
+	release, _, err := u.Check(context.Background())
+	require.NoError(t, err)
+
+	err = u.Apply(context.Background(), release)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has no asset")
+}
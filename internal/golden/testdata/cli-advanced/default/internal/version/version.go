@@ -0,0 +1,12 @@
+// Package version holds the build information, set with -ldflags by make build
+// and make dist
+package version
+
+var (
+	// Version is the release tag, dev for local builds
+	Version = "dev"
+	// Commit is the git commit of the build
+	Commit = "none"
+	// Date is the build time, in RFC 3339
+	Date = "unknown"
+)
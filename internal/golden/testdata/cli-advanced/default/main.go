@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/example/golden/cmd"
+	"github.com/example/golden/internal/plugin"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := cmd.Execute(ctx, os.Args[1:])
+	stop()
+
+	var exitErr *plugin.ExitError
+	if errors.As(err, &exitErr) {
+		// The plugin reported its own failure, exit with its code
+		os.Exit(exitErr.Code)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "golden: %v\n", err)
+		os.Exit(1)
+	}
+}
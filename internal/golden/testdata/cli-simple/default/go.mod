@@ -0,0 +1,10 @@
+module github.com/example/golden
+
+go 1.23
+
+require github.com/spf13/cobra v1.8.0
+
+require (
+	github.com/inconshreveable/mousetrap v1.1.0 // indirect
+	github.com/spf13/pflag v1.0.5 // indirect
+)
\ No newline at end of file
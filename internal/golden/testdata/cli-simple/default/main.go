@@ -0,0 +1,23 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/example/golden/cmd"
+)
+
+// main is the entry point of the application
+func main() {
+	// Initialize simple logger
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	}))
+	slog.SetDefault(logger)
+
+	// Execute the root command
+	if err := cmd.Execute(); err != nil {
+		slog.Error("Application failed", "error", err)
+		os.Exit(1)
+	}
+}
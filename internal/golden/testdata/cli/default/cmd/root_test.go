@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/example/golden/internal/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRootCommand(t *testing.T) {
+	// Initialize the logger for testing
+	err := logger.Initialize("info")
+	assert.NoError(t, err)
+
+	// Capture output
+	var output bytes.Buffer
+	rootCmd.SetOut(&output)
+	rootCmd.SetErr(&output)
+
+	// Test root command execution
+	rootCmd.SetArgs([]string{})
+	err = rootCmd.Execute()
+	assert.NoError(t, err)
+
+	// Verify output contains expected content
+	outputStr := output.String()
+	assert.Contains(t, outputStr, "Welcome to golden!")
+	assert.Contains(t, outputStr, "Use --help to see available commands")
+}
+
+func TestVersionCommand(t *testing.T) {
+	// Initialize the logger for testing
+	err := logger.Initialize("info")
+	assert.NoError(t, err)
+
+	// Capture output
+	var output bytes.Buffer
+	rootCmd.SetOut(&output)
+	rootCmd.SetErr(&output)
+
+	// Test version command
+	rootCmd.SetArgs([]string{"version"})
+	err = rootCmd.Execute()
+	assert.NoError(t, err)
+
+	// Verify output contains version information
+	outputStr := output.String()
+	assert.Contains(t, outputStr, "golden version information")
+	assert.Contains(t, outputStr, "Version:")
+	assert.Contains(t, outputStr, "Logger:     slog")
+}
@@ -0,0 +1,12 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	github.com/spf13/cobra v1.8.0
+	github.com/spf13/viper v1.18.2
+	github.com/AlecAivazis/survey/v2 v2.3.7
+	github.com/fsnotify/fsnotify v1.7.0
+	gopkg.in/yaml.v3 v3.0.1
+	github.com/stretchr/testify v1.8.4
+)
\ No newline at end of file
@@ -0,0 +1,14 @@
+package logger
+
+// Logger defines the minimal interface for logging operations
+type Logger interface {
+	Debug(msg string, fields ...Fields)
+	Info(msg string, fields ...Fields)
+	Warn(msg string, fields ...Fields)
+	Error(msg string, fields ...Fields)
+	Fatal(msg string, fields ...Fields)
+	WithFields(fields Fields) func(string, ...Fields)
+}
+
+// Fields represents key-value pairs for structured logging
+type Fields map[string]interface{}
@@ -0,0 +1,91 @@
+package logger
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+var (
+	logger *zap.Logger
+	sugar  *zap.SugaredLogger
+)
+
+// Initialize sets up the logger with default configuration
+func Initialize(level string) error {
+	var zapLevel zapcore.Level
+	switch level {
+	case "debug":
+		zapLevel = zapcore.DebugLevel
+	case "info":
+		zapLevel = zapcore.InfoLevel
+	case "warn":
+		zapLevel = zapcore.WarnLevel
+	case "error":
+		zapLevel = zapcore.ErrorLevel
+	default:
+		zapLevel = zapcore.InfoLevel
+	}
+
+	config := zap.NewProductionConfig()
+	config.Level.SetLevel(zapLevel)
+
+	var err error
+	logger, err = config.Build()
+	if err != nil {
+		return err
+	}
+	sugar = logger.Sugar()
+
+	return nil
+}
+
+// Debug logs a debug message with optional fields
+func Debug(msg string, fields ...Fields) {
+	zapFields := fieldsToZap(fields)
+	logger.Debug(msg, zapFields...)
+}
+
+// Info logs an info message with optional fields
+func Info(msg string, fields ...Fields) {
+	zapFields := fieldsToZap(fields)
+	logger.Info(msg, zapFields...)
+}
+
+// Warn logs a warning message with optional fields
+func Warn(msg string, fields ...Fields) {
+	zapFields := fieldsToZap(fields)
+	logger.Warn(msg, zapFields...)
+}
+
+// Error logs an error message with optional fields
+func Error(msg string, fields ...Fields) {
+	zapFields := fieldsToZap(fields)
+	logger.Error(msg, zapFields...)
+}
+
+// Fatal logs a fatal message and exits the program
+func Fatal(msg string, fields ...Fields) {
+	zapFields := fieldsToZap(fields)
+	logger.Fatal(msg, zapFields...)
+}
+
+// WithFields returns a function that logs with the provided fields
+func WithFields(fields Fields) func(string, ...Fields) {
+	return func(msg string, additionalFields ...Fields) {
+		allFields := make([]Fields, 0, len(additionalFields)+1)
+		allFields = append(allFields, fields)
+		allFields = append(allFields, additionalFields...)
+		Info(msg, allFields...)
+	}
+}
+
+// fieldsToZap converts Fields maps to zap fields
+func fieldsToZap(fields []Fields) []zap.Field {
+	var zapFields []zap.Field
+	for _, fieldMap := range fields {
+		for k, v := range fieldMap {
+			zapFields = append(zapFields, zap.Any(k, v))
+		}
+	}
+	return zapFields
+}
@@ -0,0 +1,32 @@
+// main.go
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/example/golden/cmd"
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/logger"
+)
+
+func main() {
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Initialize logger
+	if err := logger.Initialize(cfg.Logging.Level); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Execute the root command
+	if err := cmd.Execute(); err != nil {
+		logger.Error("Command execution failed", logger.Fields{"error": err.Error()})
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,23 @@
+// Wails injects window.go, the Go methods bound in main.go, and window.runtime.
+// Every call returns a promise, rejected with the error the Go method returned.
+const api = window.go.app.API;
+
+const form = document.getElementById("greet");
+const input = document.getElementById("name");
+const result = document.getElementById("result");
+
+form.addEventListener("submit", async (event) => {
+    event.preventDefault();
+    try {
+        result.textContent = await api.Greet(input.value);
+        result.classList.remove("error");
+    } catch (err) {
+        result.textContent = err;
+        result.classList.add("error");
+    }
+});
+
+api.Info().then((info) => {
+    document.getElementById("info").textContent =
+        `${info.name} ${info.version} - ${info.os}/${info.arch} - ${info.goVersion}`;
+});
@@ -0,0 +1,8 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	github.com/stretchr/testify v1.9.0
+	github.com/wailsapp/wails/v2 v2.10.2
+)
@@ -0,0 +1,60 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// API holds the methods the frontend calls. Wails binds every exported method:
+// JavaScript calls them as window.go.app.API.<Method>, and wails dev and wails
+// build generate typed wrappers in frontend/wailsjs. A returned error rejects
+// the promise of the call
+type API struct {
+	ctx     context.Context
+	logger  logger.Logger
+	version string
+}
+
+// NewAPI creates the API of the given application version
+func NewAPI(log logger.Logger, version string) *API {
+	return &API{logger: log, version: version}
+}
+
+// Info describes the application and the platform it runs on
+type Info struct {
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	OS        string `json:"os"`
+	Arch      string `json:"arch"`
+	GoVersion string `json:"goVersion"`
+}
+
+// Info returns the version of the application and its platform
+func (a *API) Info() Info {
+	return Info{
+		Name:      "golden",
+		Version:   a.version,
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+		GoVersion: runtime.Version(),
+	}
+}
+
+// Greet returns a greeting for name
+func (a *API) Greet(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.New("name is required")
+	}
+	if len(name) > 100 {
+		return "", errors.New("name is longer than 100 characters")
+	}
+
+	a.logger.Debug("Greeting", "name", name)
+	return fmt.Sprintf("Hello %s, it's show time!", name), nil
+}
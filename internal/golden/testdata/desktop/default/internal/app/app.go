@@ -0,0 +1,52 @@
+package app
+
+import (
+	"context"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// App follows the lifecycle of the window. main.go hands its methods to Wails,
+// which calls them from the main thread: they must return quickly
+type App struct {
+	logger logger.Logger
+	api    *API
+}
+
+// New creates the application of the given version
+func New(log logger.Logger, version string) *App {
+	return &App{
+		logger: log,
+		api:    NewAPI(log, version),
+	}
+}
+
+// API returns the methods bound to the frontend
+func (a *App) API() *API {
+	return a.api
+}
+
+// Startup is called once the window is created, before the frontend loads. ctx
+// carries the Wails runtime: keep it to call the runtime package later on
+func (a *App) Startup(ctx context.Context) {
+	a.api.ctx = ctx
+	a.logger.Info("Application started")
+}
+
+// DomReady is called once the frontend has loaded
+func (a *App) DomReady(ctx context.Context) {
+	a.logger.Debug("Frontend loaded")
+}
+
+// BeforeClose is called when the window is about to close. Returning true keeps
+// it open, for example to ask the user to save their work first
+func (a *App) BeforeClose(ctx context.Context) bool {
+	a.logger.Info("Window closing")
+	return false
+}
+
+// Shutdown is called after the window has closed, to release the resources of
+// the application
+func (a *App) Shutdown(ctx context.Context) {
+	a.logger.Info("Application stopped")
+}
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/logger"
+)
+
+func newTestApp(t *testing.T) (*App, *bytes.Buffer) {
+	t.Helper()
+	var out bytes.Buffer
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: "debug", Format: "json"}, &out)
+	require.NoError(t, err)
+	return New(log, "1.2.3"), &out
+}
+
+func TestApp_Lifecycle(t *testing.T) {
+	application, out := newTestApp(t)
+	ctx := context.Background()
+
+	application.Startup(ctx)
+	application.DomReady(ctx)
+	assert.False(t, application.BeforeClose(ctx), "the window closes")
+	application.Shutdown(ctx)
+
+	logs := out.String()
+	for _, msg := range []string{"Application started", "Frontend loaded", "Window closing", "Application stopped"} {
+		assert.Contains(t, logs, msg)
+	}
+	assert.Equal(t, ctx, application.API().ctx, "the API keeps the runtime context")
+}
+
+func TestAPI_Greet(t *testing.T) {
+	application, _ := newTestApp(t)
+	api := application.API()
+
+	greeting, err := api.Greet("  Ada ")
+	require.NoError(t, err)
+	assert.Equal(t, "Hello Ada, it's show time!", greeting)
+
+	_, err = api.Greet(" ")
+	assert.EqualError(t, err, "name is required")
+
+	_, err = api.Greet(strings.Repeat("a", 101))
+	assert.Error(t, err)
+}
+
+func TestAPI_Info(t *testing.T) {
+	application, _ := newTestApp(t)
+
+	info := application.API().Info()
+	assert.Equal(t, "golden", info.Name)
+	assert.Equal(t, "1.2.3", info.Version)
+	assert.Equal(t, runtime.GOOS, info.OS)
+	assert.Equal(t, runtime.GOARCH, info.Arch)
+}
@@ -0,0 +1,67 @@
+package logger
+
+import (
+	wailslogger "github.com/wailsapp/wails/v2/pkg/logger"
+)
+
+// WailsLogger writes the logs of the Wails runtime through a Logger, so that
+// they land next to the logs of the application
+type WailsLogger struct {
+	logger Logger
+}
+
+var _ wailslogger.Logger = (*WailsLogger)(nil)
+
+// NewWailsLogger creates a Wails logger writing through log
+func NewWailsLogger(log Logger) *WailsLogger {
+	return &WailsLogger{logger: log.With("source", "wails")}
+}
+
+// Print logs message at the info level
+func (l *WailsLogger) Print(message string) {
+	l.logger.Info(message)
+}
+
+// Trace logs message at the debug level, the lowest of Logger
+func (l *WailsLogger) Trace(message string) {
+	l.logger.Debug(message)
+}
+
+// Debug logs message at the debug level
+func (l *WailsLogger) Debug(message string) {
+	l.logger.Debug(message)
+}
+
+// Info logs message at the info level
+func (l *WailsLogger) Info(message string) {
+	l.logger.Info(message)
+}
+
+// Warning logs message at the warn level
+func (l *WailsLogger) Warning(message string) {
+	l.logger.Warn(message)
+}
+
+// Error logs message at the error level
+func (l *WailsLogger) Error(message string) {
+	l.logger.Error(message)
+}
+
+// Fatal logs message and exits the program
+func (l *WailsLogger) Fatal(message string) {
+	l.logger.Fatal(message)
+}
+
+// WailsLevel returns the Wails runtime level matching a Logger level name
+func WailsLevel(level string) wailslogger.LogLevel {
+	switch parseLevel(level) {
+	case "debug":
+		return wailslogger.DEBUG
+	case "warn":
+		return wailslogger.WARNING
+	case "error":
+		return wailslogger.ERROR
+	default:
+		return wailslogger.INFO
+	}
+}
@@ -0,0 +1,69 @@
+package logger
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	wailslogger "github.com/wailsapp/wails/v2/pkg/logger"
+)
+
+// recorder is a Logger remembering the level and message of every entry
+type recorder struct {
+	entries *[]string
+	fields  []interface{}
+}
+
+func newRecorder() *recorder {
+	return &recorder{entries: &[]string{}}
+}
+
+func (r *recorder) record(level, msg string) {
+	*r.entries = append(*r.entries, fmt.Sprintf("%s %s %v", level, msg, r.fields))
+}
+
+func (r *recorder) Debug(msg string, _ ...interface{}) { r.record("debug", msg) }
+func (r *recorder) Info(msg string, _ ...interface{})  { r.record("info", msg) }
+func (r *recorder) Warn(msg string, _ ...interface{})  { r.record("warn", msg) }
+func (r *recorder) Error(msg string, _ ...interface{}) { r.record("error", msg) }
+func (r *recorder) Fatal(msg string, _ ...interface{}) { r.record("fatal", msg) }
+func (r *recorder) WithError(err error) Logger         { return r.With("error", err) }
+func (r *recorder) DisableColor()                      {}
+
+func (r *recorder) With(keysAndValues ...interface{}) Logger {
+	return &recorder{entries: r.entries, fields: append(append([]interface{}{}, r.fields...), keysAndValues...)}
+}
+
+func TestWailsLogger_Levels(t *testing.T) {
+	log := newRecorder()
+	wails := NewWailsLogger(log)
+
+	wails.Print("print")
+	wails.Trace("trace")
+	wails.Debug("debug")
+	wails.Info("info")
+	wails.Warning("warning")
+	wails.Error("error")
+
+	assert.Equal(t, []string{
+		"info print [source wails]",
+		"debug trace [source wails]",
+		"debug debug [source wails]",
+		"info info [source wails]",
+		"warn warning [source wails]",
+		"error error [source wails]",
+	}, *log.entries)
+}
+
+func TestWailsLevel(t *testing.T) {
+	tests := map[string]wailslogger.LogLevel{
+		"debug":   wailslogger.DEBUG,
+		"info":    wailslogger.INFO,
+		"":        wailslogger.INFO,
+		"warning": wailslogger.WARNING,
+		"ERROR":   wailslogger.ERROR,
+	}
+	for level, want := range tests {
+		assert.Equal(t, want, WailsLevel(level), level)
+	}
+}
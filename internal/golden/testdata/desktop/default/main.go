@@ -0,0 +1,105 @@
+package main
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/wailsapp/wails/v2"
+	"github.com/wailsapp/wails/v2/pkg/options"
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
+
+	"github.com/example/golden/internal/app"
+	"github.com/example/golden/internal/logger"
+)
+
+// assets is the frontend served in the window
+//
+//go:embed all:frontend/dist
+var assets embed.FS
+
+// version is set at build time with -ldflags "-X main.version=..."
+var version = "dev"
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "golden: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	logLevel := getEnv("LOG_LEVEL", "info")
+	logFile := getEnv("LOG_FILE", defaultLogFile())
+
+	// A packaged application has no console: logs go to a file, and to stderr as
+	// well during wails dev
+	if err := os.MkdirAll(filepath.Dir(logFile), 0o700); err != nil {
+		return fmt.Errorf("failed to create the log directory: %w", err)
+	}
+	file, err := os.OpenFile(logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open the log file: %w", err)
+	}
+	defer file.Close()
+
+	var out io.Writer = file
+	if version == "dev" {
+		out = io.MultiWriter(file, os.Stderr)
+	}
+
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: logLevel, Format: "json"}, out)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+	log.DisableColor()
+	log = log.With("app", "golden", "version", version)
+
+	application := app.New(log, version)
+	err = wails.Run(&options.App{
+		Title:     "golden",
+		Width:     1024,
+		Height:    768,
+		MinWidth:  640,
+		MinHeight: 480,
+		AssetServer: &assetserver.Options{
+			Assets: assets,
+		},
+		BackgroundColour: &options.RGBA{R: 24, G: 26, B: 31, A: 255},
+		OnStartup:        application.Startup,
+		OnDomReady:       application.DomReady,
+		OnBeforeClose:    application.BeforeClose,
+		OnShutdown:       application.Shutdown,
+		Bind: []interface{}{
+			application.API(),
+		},
+		// The runtime logs go through the same logger, filtered at the same level
+		Logger:             logger.NewWailsLogger(log),
+		LogLevel:           logger.WailsLevel(logLevel),
+		LogLevelProduction: logger.WailsLevel(logLevel),
+	})
+	if err != nil {
+		log.Error("Application failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+// defaultLogFile is golden.log in the user cache directory, or in the
+// temporary directory when there is none
+func defaultLogFile() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "golden", "golden.log")
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
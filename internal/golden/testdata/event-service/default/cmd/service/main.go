@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/consumer"
+	"github.com/example/golden/internal/handler"
+	"github.com/example/golden/internal/health"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/messaging"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "golden: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	log, err := logger.NewFactory().Create(cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+	log = log.With("service", "golden")
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	broker, err := messaging.NewBroker(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := broker.Close(); err != nil {
+			log.Error("failed to close broker connection", "error", err.Error())
+		}
+	}()
+
+	subscriber, err := broker.Subscribe(ctx, cfg.InputTopic, cfg.ConsumerGroup)
+	if err != nil {
+		return err
+	}
+	defer subscriber.Close()
+
+	publisher := broker.Publisher()
+	orders := handler.NewOrders(publisher, cfg.OutputTopic, log)
+	c := consumer.New(subscriber, publisher, orders, consumer.Options{
+		Workers:         cfg.Workers,
+		MaxAttempts:     cfg.MaxAttempts,
+		RetryBackoff:    cfg.RetryBackoff,
+		DLQTopic:        cfg.DLQTopic,
+		ShutdownTimeout: cfg.ShutdownTimeout,
+	}, log)
+
+	checks := health.New(cfg.HealthAddress())
+	go func() {
+		if err := checks.ListenAndServe(); err != nil {
+			log.Error("health server stopped", "error", err.Error())
+		}
+	}()
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = checks.Shutdown(ctx)
+	}()
+
+	// Withdraw readiness as soon as the shutdown signal arrives, while messages drain
+	context.AfterFunc(ctx, func() {
+		checks.SetReady(false)
+		log.Info("shutting down, draining in-flight messages", "timeout", cfg.ShutdownTimeout.String())
+	})
+	checks.SetReady(true)
+
+	log.Info("consuming", "broker", "kafka", "topic", cfg.InputTopic, "group", cfg.ConsumerGroup, "workers", cfg.Workers)
+	c.Run(ctx)
+	log.Info("consumer stopped")
+	return nil
+}
@@ -0,0 +1,8 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	github.com/segmentio/kafka-go v0.4.47
+	github.com/stretchr/testify v1.9.0
+)
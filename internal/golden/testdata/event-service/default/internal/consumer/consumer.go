@@ -0,0 +1,256 @@
+// Package consumer processes messages with at-least-once semantics: a message is
+// acknowledged only after its handler succeeded or it was moved to the dead letter queue.
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/messaging"
+)
+
+// Dead letter headers added to messages moved to the DLQ
+const (
+	HeaderDLQError         = "x-dlq-error"
+	HeaderDLQOriginalTopic = "x-dlq-original-topic"
+	HeaderDLQAttempts      = "x-dlq-attempts"
+)
+
+// releaseTimeout bounds the call that hands an unfinished message back to the broker
+const releaseTimeout = 5 * time.Second
+
+// Handler processes one message. Returning an error retries the message; wrap the error
+// with Permanent when retrying cannot help, such as for a malformed payload.
+type Handler interface {
+	Handle(ctx context.Context, msg messaging.Message) error
+}
+
+// HandlerFunc adapts a function to Handler
+type HandlerFunc func(ctx context.Context, msg messaging.Message) error
+
+// Handle calls f
+func (f HandlerFunc) Handle(ctx context.Context, msg messaging.Message) error {
+	return f(ctx, msg)
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as not retryable: the message goes straight to the dead letter queue
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err was marked with Permanent
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
+
+// Options configures delivery
+type Options struct {
+	// Workers is the number of messages processed concurrently
+	Workers int
+	// MaxAttempts bounds the attempts, in process and across redeliveries, before dead lettering
+	MaxAttempts int
+	// RetryBackoff is the delay before the first retry, doubled on every attempt
+	RetryBackoff time.Duration
+	// DLQTopic receives the messages that could not be processed
+	DLQTopic string
+	// ShutdownTimeout bounds how long in-flight messages may take once shutdown starts
+	ShutdownTimeout time.Duration
+}
+
+// Consumer fetches messages from a subscriber and dispatches them to a handler
+type Consumer struct {
+	subscriber messaging.Subscriber
+	dlq        messaging.Publisher
+	handler    Handler
+	options    Options
+	log        logger.Logger
+}
+
+// New creates a consumer; dlq publishes the messages that exhausted their attempts
+func New(subscriber messaging.Subscriber, dlq messaging.Publisher, handler Handler, options Options, log logger.Logger) *Consumer {
+	if options.Workers < 1 {
+		options.Workers = 1
+	}
+	if options.MaxAttempts < 1 {
+		options.MaxAttempts = 1
+	}
+	return &Consumer{subscriber: subscriber, dlq: dlq, handler: handler, options: options, log: log}
+}
+
+// Run consumes until ctx is cancelled, then stops fetching and waits for the in-flight
+// messages. Handlers keep running for up to ShutdownTimeout after cancellation; messages
+// they do not finish in time are released so the broker redelivers them.
+func (c *Consumer) Run(ctx context.Context) {
+	work, cancelWork := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelWork()
+	stopDrain := context.AfterFunc(ctx, func() {
+		timer := time.NewTimer(c.options.ShutdownTimeout)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			c.log.Warn("shutdown timeout reached, releasing in-flight messages")
+			cancelWork()
+		case <-work.Done():
+		}
+	})
+	defer stopDrain()
+
+	deliveries := make(chan messaging.Delivery)
+	var wg sync.WaitGroup
+	for i := 0; i < c.options.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for delivery := range deliveries {
+				c.process(ctx, work, delivery)
+			}
+		}()
+	}
+
+	c.fetch(ctx, deliveries)
+	close(deliveries)
+	wg.Wait()
+}
+
+// fetch hands messages to the workers until ctx is cancelled or the subscriber is closed.
+// Fetch errors are logged and retried: the broker client reconnects on its own.
+func (c *Consumer) fetch(ctx context.Context, deliveries chan<- messaging.Delivery) {
+	backoff := c.options.RetryBackoff
+	for {
+		delivery, err := c.subscriber.Fetch(ctx)
+		if err == nil {
+			select {
+			case deliveries <- delivery:
+				continue
+			case <-ctx.Done():
+				c.release(delivery)
+				return
+			}
+		}
+		if ctx.Err() != nil || errors.Is(err, messaging.ErrClosed) {
+			return
+		}
+
+		c.log.Error("failed to fetch message", "error", err.Error(), "retry_in", backoff.String())
+		if !sleep(ctx, backoff) {
+			return
+		}
+	}
+}
+
+// process runs the handler with retries and acknowledges, dead letters or releases the message.
+// Retries stop as soon as shutdown starts; the message is released instead.
+func (c *Consumer) process(ctx, work context.Context, delivery messaging.Delivery) {
+	msg := delivery.Message()
+	log := c.log.With("topic", msg.Topic, "message_id", msg.ID)
+
+	if delivery.Attempt() > c.options.MaxAttempts {
+		err := fmt.Errorf("delivered %d times without being acknowledged", delivery.Attempt())
+		c.deadLetter(work, delivery, msg, delivery.Attempt(), err)
+		return
+	}
+
+	for attempts := 1; ; attempts++ {
+		err := c.handle(work, msg)
+		if err == nil {
+			if err := delivery.Ack(work); err != nil {
+				log.Error("failed to acknowledge message, it will be redelivered", "error", err.Error())
+			}
+			return
+		}
+		if work.Err() != nil {
+			c.release(delivery)
+			return
+		}
+
+		log.Warn("message processing failed", "attempt", attempts, "error", err.Error())
+		if IsPermanent(err) || attempts >= c.options.MaxAttempts {
+			c.deadLetter(work, delivery, msg, attempts, err)
+			return
+		}
+		if !sleep(ctx, c.backoff(attempts)) {
+			c.release(delivery)
+			return
+		}
+	}
+}
+
+// handle calls the handler, turning a panic into an error so one bad message cannot stop the service
+func (c *Consumer) handle(ctx context.Context, msg messaging.Message) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panicked: %v", r)
+		}
+	}()
+	return c.handler.Handle(ctx, msg)
+}
+
+// deadLetter moves the message to the DLQ and acknowledges it. When the DLQ is unavailable
+// the message is released instead so it is not lost.
+func (c *Consumer) deadLetter(ctx context.Context, delivery messaging.Delivery, msg messaging.Message, attempts int, cause error) {
+	log := c.log.With("topic", msg.Topic, "message_id", msg.ID, "dlq", c.options.DLQTopic)
+
+	dead := msg
+	dead.Topic = c.options.DLQTopic
+	dead.Headers = make(map[string]string, len(msg.Headers)+3)
+	for key, value := range msg.Headers {
+		dead.Headers[key] = value
+	}
+	dead.Headers[HeaderDLQError] = cause.Error()
+	dead.Headers[HeaderDLQOriginalTopic] = msg.Topic
+	dead.Headers[HeaderDLQAttempts] = strconv.Itoa(attempts)
+
+	if err := c.dlq.Publish(ctx, dead); err != nil {
+		log.Error("failed to publish to the dead letter queue, releasing message", "error", err.Error())
+		c.release(delivery)
+		return
+	}
+	log.Error("message moved to the dead letter queue", "attempts", attempts, "error", cause.Error())
+
+	if err := delivery.Ack(ctx); err != nil {
+		log.Error("failed to acknowledge dead lettered message, it will be redelivered", "error", err.Error())
+	}
+}
+
+// release gives the message back to the broker for redelivery. It runs when the work context
+// may already be cancelled, so it uses its own deadline.
+func (c *Consumer) release(delivery messaging.Delivery) {
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+	if err := delivery.Nack(ctx); err != nil {
+		c.log.Error("failed to release message", "topic", delivery.Message().Topic, "error", err.Error())
+	}
+}
+
+// backoff returns the delay before retry n, doubling RetryBackoff each time
+func (c *Consumer) backoff(n int) time.Duration {
+	return c.options.RetryBackoff << (n - 1)
+}
+
+// sleep waits for d and reports false if ctx was cancelled first
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,230 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/messaging"
+)
+
+const (
+	inputTopic = "orders.placed"
+	dlqTopic   = "orders.placed.dlq"
+)
+
+// start runs a consumer on an in-memory broker and returns a function that stops it and
+// waits for Run to return
+func start(t *testing.T, broker *messaging.MemoryBroker, handler Handler, options Options) func() {
+	t.Helper()
+
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: "error", Format: "json"}, io.Discard)
+	require.NoError(t, err)
+
+	options.DLQTopic = dlqTopic
+	if options.RetryBackoff == 0 {
+		options.RetryBackoff = time.Millisecond
+	}
+	if options.ShutdownTimeout == 0 {
+		options.ShutdownTimeout = time.Second
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	c := New(broker.Subscribe(inputTopic), broker, handler, options, log)
+	go func() {
+		c.Run(ctx)
+		close(done)
+	}()
+
+	stopped := false
+	stop := func() {
+		if stopped {
+			return
+		}
+		stopped = true
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("consumer did not stop")
+		}
+	}
+	t.Cleanup(stop)
+	return stop
+}
+
+func publish(t *testing.T, broker *messaging.MemoryBroker, values ...string) {
+	t.Helper()
+	for _, value := range values {
+		require.NoError(t, broker.Publish(context.Background(), messaging.Message{
+			ID:      value,
+			Topic:   inputTopic,
+			Value:   []byte(value),
+			Headers: map[string]string{"x-correlation-id": "corr-" + value},
+		}))
+	}
+}
+
+func TestConsumer_AcknowledgesProcessedMessages(t *testing.T) {
+	broker := messaging.NewMemoryBroker()
+	var handled atomic.Int32
+	start(t, broker, HandlerFunc(func(context.Context, messaging.Message) error {
+		handled.Add(1)
+		return nil
+	}), Options{Workers: 3, MaxAttempts: 3})
+
+	publish(t, broker, "1", "2", "3")
+
+	assert.Eventually(t, func() bool { return len(broker.Acked()) == 3 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, int32(3), handled.Load())
+	assert.Empty(t, broker.Published(dlqTopic))
+}
+
+func TestConsumer_RetriesTransientFailures(t *testing.T) {
+	broker := messaging.NewMemoryBroker()
+	var calls atomic.Int32
+	start(t, broker, HandlerFunc(func(context.Context, messaging.Message) error {
+		if calls.Add(1) < 3 {
+			return errors.New("database unavailable")
+		}
+		return nil
+	}), Options{Workers: 1, MaxAttempts: 5})
+
+	publish(t, broker, "1")
+
+	assert.Eventually(t, func() bool { return len(broker.Acked()) == 1 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Empty(t, broker.Published(dlqTopic))
+}
+
+func TestConsumer_DeadLettersExhaustedMessages(t *testing.T) {
+	broker := messaging.NewMemoryBroker()
+	var calls atomic.Int32
+	start(t, broker, HandlerFunc(func(context.Context, messaging.Message) error {
+		calls.Add(1)
+		return errors.New("downstream rejected the order")
+	}), Options{Workers: 1, MaxAttempts: 3})
+
+	publish(t, broker, "1")
+
+	assert.Eventually(t, func() bool { return len(broker.Acked()) == 1 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, int32(3), calls.Load())
+
+	dead := broker.Published(dlqTopic)
+	require.Len(t, dead, 1)
+	assert.Equal(t, []byte("1"), dead[0].Value)
+	assert.Equal(t, "1", dead[0].ID)
+	assert.Equal(t, "downstream rejected the order", dead[0].Headers[HeaderDLQError])
+	assert.Equal(t, inputTopic, dead[0].Headers[HeaderDLQOriginalTopic])
+	assert.Equal(t, "3", dead[0].Headers[HeaderDLQAttempts])
+	assert.Equal(t, "corr-1", dead[0].Headers["x-correlation-id"])
+}
+
+func TestConsumer_PermanentErrorsSkipRetries(t *testing.T) {
+	broker := messaging.NewMemoryBroker()
+	var calls atomic.Int32
+	start(t, broker, HandlerFunc(func(context.Context, messaging.Message) error {
+		calls.Add(1)
+		return Permanent(errors.New("malformed payload"))
+	}), Options{Workers: 1, MaxAttempts: 5})
+
+	publish(t, broker, "1")
+
+	assert.Eventually(t, func() bool { return len(broker.Published(dlqTopic)) == 1 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, "1", broker.Published(dlqTopic)[0].Headers[HeaderDLQAttempts])
+}
+
+func TestConsumer_RecoversFromPanics(t *testing.T) {
+	broker := messaging.NewMemoryBroker()
+	start(t, broker, HandlerFunc(func(context.Context, messaging.Message) error {
+		panic("nil map")
+	}), Options{Workers: 1, MaxAttempts: 1})
+
+	publish(t, broker, "1")
+
+	assert.Eventually(t, func() bool { return len(broker.Published(dlqTopic)) == 1 }, time.Second, 5*time.Millisecond)
+	assert.Contains(t, broker.Published(dlqTopic)[0].Headers[HeaderDLQError], "nil map")
+}
+
+func TestConsumer_KeepsMessageWhenDeadLetterQueueFails(t *testing.T) {
+	broker := messaging.NewMemoryBroker()
+	broker.FailPublish(dlqTopic, errors.New("dlq unavailable"))
+	var calls atomic.Int32
+	start(t, broker, HandlerFunc(func(context.Context, messaging.Message) error {
+		calls.Add(1)
+		return Permanent(errors.New("malformed payload"))
+	}), Options{Workers: 1, MaxAttempts: 1})
+
+	publish(t, broker, "1")
+
+	assert.Eventually(t, func() bool { return calls.Load() >= 1 }, time.Second, 5*time.Millisecond)
+	assert.Empty(t, broker.Acked(), "the message must not be acknowledged before it reached the DLQ")
+
+	broker.FailPublish(dlqTopic, nil)
+	assert.Eventually(t, func() bool { return len(broker.Acked()) == 1 }, time.Second, 5*time.Millisecond)
+	assert.Len(t, broker.Published(dlqTopic), 1)
+}
+
+func TestConsumer_DrainsInFlightMessagesOnShutdown(t *testing.T) {
+	broker := messaging.NewMemoryBroker()
+	started, finish := make(chan struct{}), make(chan struct{})
+	stop := start(t, broker, HandlerFunc(func(ctx context.Context, _ messaging.Message) error {
+		close(started)
+		<-finish
+		return ctx.Err()
+	}), Options{Workers: 1, MaxAttempts: 1, ShutdownTimeout: 5 * time.Second})
+
+	publish(t, broker, "1")
+	<-started
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		close(finish)
+	}()
+	stop()
+
+	assert.Len(t, broker.Acked(), 1, "the in-flight message completes before Run returns")
+}
+
+func TestConsumer_ReleasesMessagesAfterShutdownTimeout(t *testing.T) {
+	broker := messaging.NewMemoryBroker()
+	started := make(chan struct{})
+	stop := start(t, broker, HandlerFunc(func(ctx context.Context, _ messaging.Message) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}), Options{Workers: 1, MaxAttempts: 3, ShutdownTimeout: 20 * time.Millisecond})
+
+	publish(t, broker, "1")
+	<-started
+	stop()
+
+	assert.Empty(t, broker.Acked())
+	assert.Empty(t, broker.Published(dlqTopic))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	redelivered, err := broker.Subscribe(inputTopic).Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, redelivered.Attempt())
+}
+
+func TestPermanent(t *testing.T) {
+	cause := errors.New("malformed payload")
+	err := Permanent(cause)
+
+	assert.True(t, IsPermanent(err))
+	assert.True(t, IsPermanent(errors.Join(errors.New("decode order"), err)))
+	assert.ErrorIs(t, err, cause)
+	assert.False(t, IsPermanent(cause))
+	assert.NoError(t, Permanent(nil))
+}
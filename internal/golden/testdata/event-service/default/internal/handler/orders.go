@@ -0,0 +1,112 @@
+// Package handler contains the event handlers. Messages are delivered at least once, so
+// every handler must be idempotent: processing the same event twice must not duplicate
+// its effects.
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/example/golden/internal/consumer"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/messaging"
+)
+
+// OrderPlaced is consumed from the input topic
+type OrderPlaced struct {
+	OrderID    string      `json:"order_id"`
+	CustomerID string      `json:"customer_id"`
+	Items      []OrderItem `json:"items"`
+}
+
+// OrderItem is a line of an order
+type OrderItem struct {
+	SKU      string `json:"sku"`
+	Quantity int    `json:"quantity"`
+}
+
+// OrderConfirmed is produced on the output topic
+type OrderConfirmed struct {
+	OrderID     string    `json:"order_id"`
+	CustomerID  string    `json:"customer_id"`
+	ItemCount   int       `json:"item_count"`
+	ConfirmedAt time.Time `json:"confirmed_at"`
+}
+
+// Orders confirms placed orders
+type Orders struct {
+	publisher   messaging.Publisher
+	outputTopic string
+	log         logger.Logger
+	now         func() time.Time
+}
+
+// NewOrders creates the order handler publishing confirmations to outputTopic
+func NewOrders(publisher messaging.Publisher, outputTopic string, log logger.Logger) *Orders {
+	return &Orders{publisher: publisher, outputTopic: outputTopic, log: log, now: time.Now}
+}
+
+// Handle validates an OrderPlaced event and publishes its OrderConfirmed event. Invalid
+// events are permanent failures; publishing errors are retried. The confirmation reuses a
+// message ID derived from the order so a redelivered order does not produce a second
+// confirmation on brokers that deduplicate, and consumers can drop it on the others.
+func (h *Orders) Handle(ctx context.Context, msg messaging.Message) error {
+	var order OrderPlaced
+	if err := json.Unmarshal(msg.Value, &order); err != nil {
+		return consumer.Permanent(fmt.Errorf("invalid OrderPlaced event: %w", err))
+	}
+	if err := order.validate(); err != nil {
+		return consumer.Permanent(err)
+	}
+
+	itemCount := 0
+	for _, item := range order.Items {
+		itemCount += item.Quantity
+	}
+	value, err := json.Marshal(OrderConfirmed{
+		OrderID:     order.OrderID,
+		CustomerID:  order.CustomerID,
+		ItemCount:   itemCount,
+		ConfirmedAt: h.now().UTC(),
+	})
+	if err != nil {
+		return consumer.Permanent(err)
+	}
+
+	headers := make(map[string]string)
+	if correlationID := msg.Headers["x-correlation-id"]; correlationID != "" {
+		headers["x-correlation-id"] = correlationID
+	}
+
+	err = h.publisher.Publish(ctx, messaging.Message{
+		ID:      "order-confirmed-" + order.OrderID,
+		Topic:   h.outputTopic,
+		Key:     []byte(order.OrderID),
+		Value:   value,
+		Headers: headers,
+	})
+	if err != nil {
+		return err
+	}
+
+	h.log.Info("order confirmed", "order_id", order.OrderID, "items", itemCount)
+	return nil
+}
+
+func (o OrderPlaced) validate() error {
+	if o.OrderID == "" {
+		return errors.New("order_id is required")
+	}
+	if len(o.Items) == 0 {
+		return fmt.Errorf("order %s has no items", o.OrderID)
+	}
+	for _, item := range o.Items {
+		if item.Quantity < 1 {
+			return fmt.Errorf("order %s: invalid quantity %d for %s", o.OrderID, item.Quantity, item.SKU)
+		}
+	}
+	return nil
+}
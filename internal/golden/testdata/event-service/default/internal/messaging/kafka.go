@@ -0,0 +1,155 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/example/golden/internal/config"
+)
+
+// Broker connects the service to Kafka. Messages are produced with acknowledgements from
+// all in-sync replicas and consumed through a consumer group that commits offsets only
+// after messages are acknowledged.
+type Broker struct {
+	brokers []string
+	writer  *kafka.Writer
+}
+
+// NewBroker creates the Kafka producer; consumers are created with Subscribe
+func NewBroker(_ context.Context, cfg *config.Config) (*Broker, error) {
+	return &Broker{
+		brokers: cfg.BrokerURLs,
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(cfg.BrokerURLs...),
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+			BatchTimeout:           10 * time.Millisecond,
+		},
+	}, nil
+}
+
+// Publisher returns the producer shared by the handlers and the dead letter queue
+func (b *Broker) Publisher() Publisher {
+	return &kafkaPublisher{writer: b.writer}
+}
+
+// Subscribe joins the consumer group on topic
+func (b *Broker) Subscribe(_ context.Context, topic, group string) (Subscriber, error) {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     b.brokers,
+		GroupID:     group,
+		Topic:       topic,
+		MinBytes:    1,
+		MaxBytes:    10 << 20,
+		StartOffset: kafka.FirstOffset,
+		// Offsets are committed synchronously on Ack
+		CommitInterval: 0,
+	})
+	return &kafkaSubscriber{reader: reader, offsets: newOffsetTracker()}, nil
+}
+
+// Close flushes pending messages and closes the producer
+func (b *Broker) Close() error {
+	return b.writer.Close()
+}
+
+type kafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+func (p *kafkaPublisher) Publish(ctx context.Context, msg Message) error {
+	headers := make([]kafka.Header, 0, len(msg.Headers)+1)
+	if msg.ID != "" {
+		headers = append(headers, kafka.Header{Key: "x-message-id", Value: []byte(msg.ID)})
+	}
+	for key, value := range msg.Headers {
+		headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+	}
+
+	err := p.writer.WriteMessages(ctx, kafka.Message{
+		Topic:   msg.Topic,
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish to %s: %w", msg.Topic, err)
+	}
+	return nil
+}
+
+func (p *kafkaPublisher) Close() error {
+	return nil
+}
+
+type kafkaSubscriber struct {
+	reader  *kafka.Reader
+	offsets *offsetTracker
+}
+
+func (s *kafkaSubscriber) Fetch(ctx context.Context) (Delivery, error) {
+	msg, err := s.reader.FetchMessage(ctx)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, ErrClosed
+		}
+		return nil, err
+	}
+	s.offsets.track(msg.Partition, msg.Offset)
+	return &kafkaDelivery{subscriber: s, msg: msg}, nil
+}
+
+func (s *kafkaSubscriber) Close() error {
+	return s.reader.Close()
+}
+
+type kafkaDelivery struct {
+	subscriber *kafkaSubscriber
+	msg        kafka.Message
+}
+
+func (d *kafkaDelivery) Message() Message {
+	msg := Message{
+		Topic:   d.msg.Topic,
+		Key:     d.msg.Key,
+		Value:   d.msg.Value,
+		Headers: make(map[string]string, len(d.msg.Headers)),
+	}
+	for _, header := range d.msg.Headers {
+		if header.Key == "x-message-id" {
+			msg.ID = string(header.Value)
+			continue
+		}
+		msg.Headers[header.Key] = string(header.Value)
+	}
+	return msg
+}
+
+// Attempt is always 1: Kafka does not count deliveries
+func (d *kafkaDelivery) Attempt() int {
+	return 1
+}
+
+// Ack commits the partition's offset once every earlier message has been acknowledged too
+func (d *kafkaDelivery) Ack(ctx context.Context) error {
+	offset, ok := d.subscriber.offsets.ack(d.msg.Partition, d.msg.Offset)
+	if !ok {
+		return nil
+	}
+	commit := d.msg
+	commit.Offset = offset
+	return d.subscriber.reader.CommitMessages(ctx, commit)
+}
+
+// Nack leaves the message uncommitted. Kafka has no per-message redelivery, so the
+// partition's committed offset stays before it and the message is consumed again after
+// the service restarts or the partition is reassigned.
+func (d *kafkaDelivery) Nack(context.Context) error {
+	return nil
+}
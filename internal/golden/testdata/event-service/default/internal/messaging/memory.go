@@ -0,0 +1,138 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// MemoryBroker is an in-process broker for tests. It follows the same delivery contract
+// as kafka: a message is redelivered until it is acknowledged.
+type MemoryBroker struct {
+	mu         sync.Mutex
+	queues     map[string]chan *memoryDelivery
+	published  map[string][]Message
+	acked      []Message
+	publishErr map[string]error
+}
+
+// NewMemoryBroker creates an empty in-memory broker
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		queues:     make(map[string]chan *memoryDelivery),
+		published:  make(map[string][]Message),
+		publishErr: make(map[string]error),
+	}
+}
+
+// Publish queues msg on its topic
+func (b *MemoryBroker) Publish(_ context.Context, msg Message) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := b.publishErr[msg.Topic]; err != nil {
+		return err
+	}
+	b.published[msg.Topic] = append(b.published[msg.Topic], msg)
+	return b.enqueue(&memoryDelivery{broker: b, msg: msg, attempt: 1})
+}
+
+// Subscribe returns a subscriber for topic; subscribers of the same topic share its messages
+func (b *MemoryBroker) Subscribe(topic string) Subscriber {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return &memorySubscriber{queue: b.queue(topic), done: make(chan struct{})}
+}
+
+// FailPublish makes every publish to topic return err, or succeed again when err is nil
+func (b *MemoryBroker) FailPublish(topic string, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.publishErr[topic] = err
+}
+
+// Published returns the messages published to topic
+func (b *MemoryBroker) Published(topic string) []Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]Message(nil), b.published[topic]...)
+}
+
+// Acked returns the acknowledged messages
+func (b *MemoryBroker) Acked() []Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]Message(nil), b.acked...)
+}
+
+// Close implements Publisher
+func (b *MemoryBroker) Close() error {
+	return nil
+}
+
+func (b *MemoryBroker) queue(topic string) chan *memoryDelivery {
+	queue, ok := b.queues[topic]
+	if !ok {
+		queue = make(chan *memoryDelivery, 1024)
+		b.queues[topic] = queue
+	}
+	return queue
+}
+
+func (b *MemoryBroker) enqueue(d *memoryDelivery) error {
+	select {
+	case b.queue(d.msg.Topic) <- d:
+		return nil
+	default:
+		return fmt.Errorf("memory broker: topic %s is full", d.msg.Topic)
+	}
+}
+
+type memorySubscriber struct {
+	queue     chan *memoryDelivery
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (s *memorySubscriber) Fetch(ctx context.Context) (Delivery, error) {
+	select {
+	case d := <-s.queue:
+		return d, nil
+	case <-s.done:
+		return nil, ErrClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s *memorySubscriber) Close() error {
+	s.closeOnce.Do(func() { close(s.done) })
+	return nil
+}
+
+type memoryDelivery struct {
+	broker  *MemoryBroker
+	msg     Message
+	attempt int
+}
+
+func (d *memoryDelivery) Message() Message {
+	return d.msg
+}
+
+func (d *memoryDelivery) Attempt() int {
+	return d.attempt
+}
+
+func (d *memoryDelivery) Ack(context.Context) error {
+	d.broker.mu.Lock()
+	defer d.broker.mu.Unlock()
+	d.broker.acked = append(d.broker.acked, d.msg)
+	return nil
+}
+
+func (d *memoryDelivery) Nack(context.Context) error {
+	d.broker.mu.Lock()
+	defer d.broker.mu.Unlock()
+	return d.broker.enqueue(&memoryDelivery{broker: d.broker, msg: d.msg, attempt: d.attempt + 1})
+}
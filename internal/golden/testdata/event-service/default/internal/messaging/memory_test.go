@@ -0,0 +1,38 @@
+package messaging
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryBroker_RedeliversUntilAcked(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	broker := NewMemoryBroker()
+	sub := broker.Subscribe("orders")
+	require.NoError(t, broker.Publish(ctx, Message{Topic: "orders", Value: []byte("1")}))
+
+	first, err := sub.Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.Attempt())
+	require.NoError(t, first.Nack(ctx))
+
+	second, err := sub.Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, second.Attempt())
+	require.NoError(t, second.Ack(ctx))
+	assert.Len(t, broker.Acked(), 1)
+}
+
+func TestMemoryBroker_FetchStopsOnClose(t *testing.T) {
+	sub := NewMemoryBroker().Subscribe("orders")
+	require.NoError(t, sub.Close())
+
+	_, err := sub.Fetch(context.Background())
+	assert.ErrorIs(t, err, ErrClosed)
+}
@@ -0,0 +1,55 @@
+package messaging
+
+import "sync"
+
+// offsetTracker decides which Kafka offsets can be committed when workers acknowledge
+// messages out of order. A partition's offset only advances over a contiguous run of
+// acknowledged messages, so a slow or released message is never skipped by a commit and
+// is redelivered after a restart or rebalance.
+type offsetTracker struct {
+	mu         sync.Mutex
+	partitions map[int]*partitionOffsets
+}
+
+type partitionOffsets struct {
+	pending []int64
+	acked   map[int64]bool
+}
+
+func newOffsetTracker() *offsetTracker {
+	return &offsetTracker{partitions: make(map[int]*partitionOffsets)}
+}
+
+// track records a fetched offset. Fetching an offset at or before one already tracked means
+// the partition was reassigned and is consumed again from its committed offset.
+func (t *offsetTracker) track(partition int, offset int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.partitions[partition]
+	if !ok || (len(p.pending) > 0 && offset <= p.pending[len(p.pending)-1]) {
+		p = &partitionOffsets{acked: make(map[int64]bool)}
+		t.partitions[partition] = p
+	}
+	p.pending = append(p.pending, offset)
+}
+
+// ack marks offset as processed and returns the highest offset that can now be committed
+func (t *offsetTracker) ack(partition int, offset int64) (int64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.partitions[partition]
+	if !ok {
+		return 0, false
+	}
+	p.acked[offset] = true
+
+	commit, advanced := int64(0), false
+	for len(p.pending) > 0 && p.acked[p.pending[0]] {
+		commit, advanced = p.pending[0], true
+		delete(p.acked, p.pending[0])
+		p.pending = p.pending[1:]
+	}
+	return commit, advanced
+}
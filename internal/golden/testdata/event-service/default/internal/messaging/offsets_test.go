@@ -0,0 +1,53 @@
+package messaging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOffsetTracker_CommitsContiguousAcks(t *testing.T) {
+	tracker := newOffsetTracker()
+	for offset := int64(10); offset < 14; offset++ {
+		tracker.track(0, offset)
+	}
+
+	_, ok := tracker.ack(0, 11)
+	assert.False(t, ok, "offset 10 is still in flight")
+
+	_, ok = tracker.ack(0, 12)
+	assert.False(t, ok)
+
+	commit, ok := tracker.ack(0, 10)
+	assert.True(t, ok)
+	assert.Equal(t, int64(12), commit)
+
+	commit, ok = tracker.ack(0, 13)
+	assert.True(t, ok)
+	assert.Equal(t, int64(13), commit)
+}
+
+func TestOffsetTracker_PartitionsAreIndependent(t *testing.T) {
+	tracker := newOffsetTracker()
+	tracker.track(0, 5)
+	tracker.track(1, 7)
+
+	commit, ok := tracker.ack(1, 7)
+	assert.True(t, ok)
+	assert.Equal(t, int64(7), commit)
+
+	_, ok = tracker.ack(2, 1)
+	assert.False(t, ok, "untracked partition")
+}
+
+func TestOffsetTracker_ResetsOnRedelivery(t *testing.T) {
+	tracker := newOffsetTracker()
+	tracker.track(0, 20)
+	tracker.track(0, 21)
+
+	// The partition was reassigned and is consumed again from offset 20
+	tracker.track(0, 20)
+	commit, ok := tracker.ack(0, 20)
+	assert.True(t, ok)
+	assert.Equal(t, int64(20), commit)
+}
@@ -0,0 +1,8 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	github.com/nats-io/nats.go v1.37.0
+	github.com/stretchr/testify v1.9.0
+)
@@ -0,0 +1,150 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config holds the service configuration, read from the environment
+type Config struct {
+	// BrokerURLs are the NATS server URLs (BROKER_URLS, comma separated)
+	BrokerURLs []string
+	// ConsumerGroup identifies the consumers sharing the input subject (CONSUMER_GROUP)
+	ConsumerGroup string
+	// Stream is the JetStream stream holding the subjects of StreamSubjects (NATS_STREAM)
+	Stream string
+	// InputTopic is consumed by the service (INPUT_TOPIC)
+	InputTopic string
+	// OutputTopic receives the events the service produces (OUTPUT_TOPIC)
+	OutputTopic string
+	// DLQTopic receives messages that failed MaxAttempts times or permanently (DLQ_TOPIC)
+	DLQTopic string
+	// Workers is the number of messages processed concurrently (WORKERS)
+	Workers int
+	// MaxAttempts bounds the processing attempts of a message before it is dead lettered (MAX_ATTEMPTS)
+	MaxAttempts int
+	// RetryBackoff is the delay before the first retry, doubled on every attempt (RETRY_BACKOFF)
+	RetryBackoff time.Duration
+	// AckWait is how long JetStream waits for an acknowledgement before redelivering (ACK_WAIT)
+	AckWait time.Duration
+	// ShutdownTimeout bounds how long in-flight messages may take to finish on shutdown (SHUTDOWN_TIMEOUT)
+	ShutdownTimeout time.Duration
+	// HealthPort serves the liveness and readiness endpoints (HEALTH_PORT)
+	HealthPort int
+	// LogLevel is one of debug, info, warn or error (LOG_LEVEL)
+	LogLevel string
+	// LogFormat is json or console (LOG_FORMAT)
+	LogFormat string
+}
+
+// Load reads the configuration from the environment, applying defaults
+func Load() (*Config, error) {
+	cfg := &Config{
+		BrokerURLs:      splitList(getEnv("BROKER_URLS", "nats://localhost:4222")),
+		Stream:          getEnv("NATS_STREAM", "ORDERS"),
+		ConsumerGroup:   getEnv("CONSUMER_GROUP", "golden"),
+		InputTopic:      getEnv("INPUT_TOPIC", "orders.placed"),
+		OutputTopic:     getEnv("OUTPUT_TOPIC", "orders.confirmed"),
+		DLQTopic:        getEnv("DLQ_TOPIC", "orders.placed.dlq"),
+		Workers:         4,
+		MaxAttempts:     5,
+		RetryBackoff:    200 * time.Millisecond,
+		AckWait:         30 * time.Second,
+		ShutdownTimeout: 30 * time.Second,
+		HealthPort:      8080,
+		LogLevel:        getEnv("LOG_LEVEL", "info"),
+		LogFormat:       getEnv("LOG_FORMAT", "json"),
+	}
+
+	var err error
+	if cfg.Workers, err = getEnvInt("WORKERS", cfg.Workers); err != nil {
+		return nil, err
+	}
+	if cfg.MaxAttempts, err = getEnvInt("MAX_ATTEMPTS", cfg.MaxAttempts); err != nil {
+		return nil, err
+	}
+	if cfg.HealthPort, err = getEnvInt("HEALTH_PORT", cfg.HealthPort); err != nil {
+		return nil, err
+	}
+	if cfg.RetryBackoff, err = getEnvDuration("RETRY_BACKOFF", cfg.RetryBackoff); err != nil {
+		return nil, err
+	}
+	if cfg.AckWait, err = getEnvDuration("ACK_WAIT", cfg.AckWait); err != nil {
+		return nil, err
+	}
+	if cfg.ShutdownTimeout, err = getEnvDuration("SHUTDOWN_TIMEOUT", cfg.ShutdownTimeout); err != nil {
+		return nil, err
+	}
+
+	if len(cfg.BrokerURLs) == 0 {
+		return nil, fmt.Errorf("BROKER_URLS must not be empty")
+	}
+	if cfg.Workers < 1 {
+		return nil, fmt.Errorf("invalid WORKERS %d: must be at least 1", cfg.Workers)
+	}
+	if cfg.MaxAttempts < 1 {
+		return nil, fmt.Errorf("invalid MAX_ATTEMPTS %d: must be at least 1", cfg.MaxAttempts)
+	}
+	if cfg.InputTopic == cfg.DLQTopic {
+		return nil, fmt.Errorf("DLQ_TOPIC must differ from INPUT_TOPIC")
+	}
+	if cfg.HealthPort < 1 || cfg.HealthPort > 65535 {
+		return nil, fmt.Errorf("invalid HEALTH_PORT %d: must be between 1 and 65535", cfg.HealthPort)
+	}
+	return cfg, nil
+}
+
+// HealthAddress returns the address the health endpoints listen on
+func (c *Config) HealthAddress() string {
+	return fmt.Sprintf(":%d", c.HealthPort)
+}
+
+// StreamSubjects returns the subjects of the stream of the service. A subject belongs
+// to a single stream, so the services of a saga each keep their own subjects
+func (c *Config) StreamSubjects() []string {
+	return []string{c.InputTopic, c.OutputTopic, c.DLQTopic}
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	return n, nil
+}
+
+func getEnvDuration(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	return d, nil
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
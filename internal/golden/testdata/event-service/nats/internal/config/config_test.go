@@ -0,0 +1,64 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var envKeys = []string{
+	"BROKER_URLS", "CONSUMER_GROUP", "INPUT_TOPIC", "OUTPUT_TOPIC", "DLQ_TOPIC", "WORKERS", "MAX_ATTEMPTS",
+	"RETRY_BACKOFF", "SHUTDOWN_TIMEOUT", "HEALTH_PORT", "LOG_LEVEL", "LOG_FORMAT", "NATS_STREAM", "ACK_WAIT",
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	for _, key := range envKeys {
+		t.Setenv(key, "")
+	}
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.NotEmpty(t, cfg.BrokerURLs)
+	assert.Equal(t, "golden", cfg.ConsumerGroup)
+	assert.Equal(t, "orders.placed.dlq", cfg.DLQTopic)
+	assert.Equal(t, 4, cfg.Workers)
+	assert.Equal(t, 5, cfg.MaxAttempts)
+	assert.Equal(t, 30*time.Second, cfg.ShutdownTimeout)
+	assert.Equal(t, ":8080", cfg.HealthAddress())
+}
+
+func TestLoad_FromEnvironment(t *testing.T) {
+	t.Setenv("BROKER_URLS", "broker-1:9092, broker-2:9092,")
+	t.Setenv("WORKERS", "8")
+	t.Setenv("MAX_ATTEMPTS", "3")
+	t.Setenv("RETRY_BACKOFF", "1s")
+	t.Setenv("SHUTDOWN_TIMEOUT", "5s")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"broker-1:9092", "broker-2:9092"}, cfg.BrokerURLs)
+	assert.Equal(t, 8, cfg.Workers)
+	assert.Equal(t, 3, cfg.MaxAttempts)
+	assert.Equal(t, time.Second, cfg.RetryBackoff)
+	assert.Equal(t, 5*time.Second, cfg.ShutdownTimeout)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"WORKERS":       "0",
+		"MAX_ATTEMPTS":  "none",
+		"RETRY_BACKOFF": "soon",
+		"HEALTH_PORT":   "70000",
+		"DLQ_TOPIC":     "orders.placed",
+	}
+
+	for key, value := range tests {
+		t.Run(key, func(t *testing.T) {
+			t.Setenv(key, value)
+			_, err := Load()
+			assert.Error(t, err)
+		})
+	}
+}
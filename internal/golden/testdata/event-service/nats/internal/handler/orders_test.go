@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/consumer"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/messaging"
+)
+
+func newOrders(t *testing.T, broker *messaging.MemoryBroker) *Orders {
+	t.Helper()
+
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: "error", Format: "json"}, io.Discard)
+	require.NoError(t, err)
+
+	h := NewOrders(broker, "orders.confirmed", log)
+	h.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+	return h
+}
+
+func TestOrders_PublishesConfirmation(t *testing.T) {
+	broker := messaging.NewMemoryBroker()
+	h := newOrders(t, broker)
+
+	err := h.Handle(context.Background(), messaging.Message{
+		Topic:   "orders.placed",
+		Value:   []byte(`{"order_id":"o-1","customer_id":"c-1","items":[{"sku":"a","quantity":2},{"sku":"b","quantity":1}]}`),
+		Headers: map[string]string{"x-correlation-id": "req-42"},
+	})
+	require.NoError(t, err)
+
+	published := broker.Published("orders.confirmed")
+	require.Len(t, published, 1)
+	assert.Equal(t, "order-confirmed-o-1", published[0].ID)
+	assert.Equal(t, []byte("o-1"), published[0].Key)
+	assert.Equal(t, "req-42", published[0].Headers["x-correlation-id"])
+
+	var confirmed OrderConfirmed
+	require.NoError(t, json.Unmarshal(published[0].Value, &confirmed))
+	assert.Equal(t, OrderConfirmed{
+		OrderID:     "o-1",
+		CustomerID:  "c-1",
+		ItemCount:   3,
+		ConfirmedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}, confirmed)
+}
+
+func TestOrders_InvalidEventsArePermanent(t *testing.T) {
+	tests := map[string]string{
+		"malformed json":   `{"order_id":`,
+		"missing order id": `{"items":[{"sku":"a","quantity":1}]}`,
+		"no items":         `{"order_id":"o-1"}`,
+		"invalid quantity": `{"order_id":"o-1","items":[{"sku":"a","quantity":0}]}`,
+	}
+
+	for name, value := range tests {
+		t.Run(name, func(t *testing.T) {
+			broker := messaging.NewMemoryBroker()
+			err := newOrders(t, broker).Handle(context.Background(), messaging.Message{Value: []byte(value)})
+			assert.True(t, consumer.IsPermanent(err), "got %v", err)
+			assert.Empty(t, broker.Published("orders.confirmed"))
+		})
+	}
+}
+
+func TestOrders_PublishFailuresAreRetried(t *testing.T) {
+	broker := messaging.NewMemoryBroker()
+	broker.FailPublish("orders.confirmed", errors.New("broker unavailable"))
+
+	err := newOrders(t, broker).Handle(context.Background(), messaging.Message{
+		Value: []byte(`{"order_id":"o-1","items":[{"sku":"a","quantity":1}]}`),
+	})
+	assert.Error(t, err)
+	assert.False(t, consumer.IsPermanent(err))
+}
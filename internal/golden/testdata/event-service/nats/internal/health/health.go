@@ -0,0 +1,63 @@
+// Package health serves the liveness and readiness endpoints used by orchestrators
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// Server reports liveness on /healthz and readiness on /readyz. The service is ready while
+// it consumes; readiness is withdrawn as soon as shutdown starts.
+type Server struct {
+	server *http.Server
+	ready  atomic.Bool
+}
+
+// New creates a health server listening on addr
+func New(addr string) *Server {
+	s := &Server{}
+	s.server = &http.Server{
+		Addr:              addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	return s
+}
+
+// SetReady changes the readiness reported on /readyz
+func (s *Server) SetReady(ready bool) {
+	s.ready.Store(ready)
+}
+
+// Handler returns the health endpoints
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
+		if !s.ready.Load() {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ready"))
+	})
+	return mux
+}
+
+// ListenAndServe serves until Shutdown is called
+func (s *Server) ListenAndServe() error {
+	if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Shutdown stops the server
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
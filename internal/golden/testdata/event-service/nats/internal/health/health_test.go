@@ -0,0 +1,30 @@
+package health
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_Endpoints(t *testing.T) {
+	s := New(":0")
+	handler := s.Handler()
+
+	get := func(path string) int {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, get("/healthz"))
+	assert.Equal(t, http.StatusServiceUnavailable, get("/readyz"))
+
+	s.SetReady(true)
+	assert.Equal(t, http.StatusOK, get("/readyz"))
+
+	s.SetReady(false)
+	assert.Equal(t, http.StatusServiceUnavailable, get("/readyz"))
+	assert.Equal(t, http.StatusOK, get("/healthz"), "liveness does not depend on readiness")
+}
@@ -0,0 +1,56 @@
+// Package messaging hides the nats client behind small publisher and subscriber
+// interfaces so the consumer and handlers can be tested with the in-memory broker.
+package messaging
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrClosed is returned by Fetch once the subscriber has been closed
+var ErrClosed = errors.New("messaging: subscriber closed")
+
+// Message is a broker independent event
+type Message struct {
+	// ID identifies the event so duplicates can be dropped; JetStream deduplicates on it
+	// within the stream's duplicate window
+	ID string
+	// Topic the message was read from or is published to
+	Topic string
+	// Key routes messages with the same key to the same partition, preserving their order
+	Key []byte
+	// Value is the encoded event
+	Value []byte
+	// Headers carry metadata such as the correlation id or dead letter details
+	Headers map[string]string
+}
+
+// Delivery is a message received from the broker that must be acknowledged. Until Ack is
+// called the broker redelivers it, which gives at-least-once delivery: handlers must be
+// idempotent.
+type Delivery interface {
+	// Message returns the received message
+	Message() Message
+	// Attempt returns how many times the broker delivered the message, starting at 1
+	Attempt() int
+	// Ack marks the message as processed so it is not delivered again
+	Ack(ctx context.Context) error
+	// Nack releases the message without processing it so the broker redelivers it
+	Nack(ctx context.Context) error
+}
+
+// Subscriber receives messages from the input topic
+type Subscriber interface {
+	// Fetch blocks until a message is available or ctx is done
+	Fetch(ctx context.Context) (Delivery, error)
+	// Close stops fetching; messages that were not acknowledged are redelivered later
+	Close() error
+}
+
+// Publisher sends messages to a topic
+type Publisher interface {
+	// Publish returns once the broker has durably accepted the message
+	Publish(ctx context.Context, msg Message) error
+	// Close flushes pending messages and releases the connection
+	Close() error
+}
@@ -0,0 +1,183 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/example/golden/internal/config"
+)
+
+// keyHeader carries Message.Key, which NATS has no native field for
+const keyHeader = "x-message-key"
+
+// Broker connects the service to NATS JetStream. The stream persists the subjects of
+// Config.StreamSubjects; consumers acknowledge every message explicitly and JetStream
+// redelivers the ones not acknowledged within AckWait.
+type Broker struct {
+	conn    *nats.Conn
+	js      jetstream.JetStream
+	stream  string
+	ackWait time.Duration
+	workers int
+}
+
+// NewBroker connects to NATS and creates or updates the stream
+func NewBroker(ctx context.Context, cfg *config.Config) (*Broker, error) {
+	conn, err := nats.Connect(strings.Join(cfg.BrokerURLs, ","), nats.Name(cfg.ConsumerGroup))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     cfg.Stream,
+		Subjects: cfg.StreamSubjects(),
+		Storage:  jetstream.FileStorage,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create stream %s: %w", cfg.Stream, err)
+	}
+
+	return &Broker{conn: conn, js: js, stream: cfg.Stream, ackWait: cfg.AckWait, workers: cfg.Workers}, nil
+}
+
+// Publisher returns the publisher shared by the handlers and the dead letter queue
+func (b *Broker) Publisher() Publisher {
+	return &natsPublisher{js: b.js}
+}
+
+// Subscribe creates or updates the durable consumer named group on topic
+func (b *Broker) Subscribe(ctx context.Context, topic, group string) (Subscriber, error) {
+	consumer, err := b.js.CreateOrUpdateConsumer(ctx, b.stream, jetstream.ConsumerConfig{
+		Durable:       group,
+		FilterSubject: topic,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       b.ackWait,
+		MaxAckPending: b.workers * 2,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consumer %s: %w", group, err)
+	}
+	return &natsSubscriber{consumer: consumer}, nil
+}
+
+// Close drains the connection, flushing pending publishes and acknowledgements
+func (b *Broker) Close() error {
+	return b.conn.Drain()
+}
+
+type natsPublisher struct {
+	js jetstream.JetStream
+}
+
+func (p *natsPublisher) Publish(ctx context.Context, msg Message) error {
+	header := nats.Header{}
+	for key, value := range msg.Headers {
+		header.Set(key, value)
+	}
+	if len(msg.Key) > 0 {
+		header.Set(keyHeader, string(msg.Key))
+	}
+	if msg.ID != "" {
+		header.Set(jetstream.MsgIDHeader, msg.ID)
+	}
+
+	_, err := p.js.PublishMsg(ctx, &nats.Msg{Subject: msg.Topic, Data: msg.Value, Header: header})
+	if err != nil {
+		return fmt.Errorf("failed to publish to %s: %w", msg.Topic, err)
+	}
+	return nil
+}
+
+func (p *natsPublisher) Close() error {
+	return nil
+}
+
+type natsSubscriber struct {
+	consumer jetstream.Consumer
+	closed   atomic.Bool
+}
+
+// Fetch pulls one message at a time, waking up every second to honour ctx and Close
+func (s *natsSubscriber) Fetch(ctx context.Context) (Delivery, error) {
+	for {
+		if s.closed.Load() {
+			return nil, ErrClosed
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		batch, err := s.consumer.Fetch(1, jetstream.FetchMaxWait(time.Second))
+		if err != nil {
+			return nil, err
+		}
+		if msg, ok := <-batch.Messages(); ok {
+			return &natsDelivery{msg: msg}, nil
+		}
+		if err := batch.Error(); err != nil && !errors.Is(err, nats.ErrTimeout) {
+			return nil, err
+		}
+	}
+}
+
+func (s *natsSubscriber) Close() error {
+	s.closed.Store(true)
+	return nil
+}
+
+type natsDelivery struct {
+	msg jetstream.Msg
+}
+
+func (d *natsDelivery) Message() Message {
+	msg := Message{
+		Topic:   d.msg.Subject(),
+		Value:   d.msg.Data(),
+		Headers: make(map[string]string, len(d.msg.Headers())),
+	}
+	for key := range d.msg.Headers() {
+		value := d.msg.Headers().Get(key)
+		switch key {
+		case keyHeader:
+			msg.Key = []byte(value)
+		case jetstream.MsgIDHeader:
+			msg.ID = value
+		default:
+			msg.Headers[key] = value
+		}
+	}
+	return msg
+}
+
+// Attempt returns the JetStream delivery count
+func (d *natsDelivery) Attempt() int {
+	meta, err := d.msg.Metadata()
+	if err != nil {
+		return 1
+	}
+	return int(meta.NumDelivered)
+}
+
+// Ack waits for the server to confirm the acknowledgement so it is not lost
+func (d *natsDelivery) Ack(ctx context.Context) error {
+	return d.msg.DoubleAck(ctx)
+}
+
+// Nack asks JetStream to redeliver the message immediately
+func (d *natsDelivery) Nack(context.Context) error {
+	return d.msg.Nak()
+}
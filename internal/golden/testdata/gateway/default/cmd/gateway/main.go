@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/gateway"
+	"github.com/example/golden/internal/logger"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "golden: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run serves the routes until SIGINT or SIGTERM, reloading them on SIGHUP
+func run() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	log, err := logger.NewFactory().Create(cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+	log = log.With("service", "golden")
+
+	// A route table that does not load stops the start; later, a broken one is
+	// logged and the gateway keeps serving with the previous one
+	gw := gateway.New(nil, log)
+	defer gw.Close()
+	table, err := gateway.LoadTable(cfg.RoutesFile)
+	if err != nil {
+		return err
+	}
+	if err := gw.Apply(table); err != nil {
+		return fmt.Errorf("failed to apply the route table: %w", err)
+	}
+	reload := func() {
+		table, err := gateway.LoadTable(cfg.RoutesFile)
+		if err == nil {
+			err = gw.Apply(table)
+		}
+		if err != nil {
+			log.Error("Route table not reloaded, keeping the current one", "file", cfg.RoutesFile, "error", err)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+	go func() {
+		for range hangup {
+			log.Info("Reloading the route table", "file", cfg.RoutesFile)
+			reload()
+		}
+	}()
+	if cfg.WatchRoutes {
+		go func() {
+			if err := gateway.WatchFile(ctx, cfg.RoutesFile, reload); err != nil {
+				log.Error("Route table no longer watched, reload it with SIGHUP", "error", err)
+			}
+		}()
+	}
+
+	srv := &http.Server{
+		Addr:              cfg.Address(),
+		Handler:           gw,
+		ReadHeaderTimeout: 10 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+	}
+	admin := &http.Server{
+		Addr:              cfg.AdminAddress(),
+		Handler:           gateway.AdminHandler(gw),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	serveErr := make(chan error, 2)
+	go func() {
+		log.Info("Listening", "address", cfg.Address(), "routes", cfg.RoutesFile, "environment", cfg.Environment)
+		serveErr <- srv.ListenAndServe()
+	}()
+	go func() {
+		log.Info("Admin endpoints listening", "address", cfg.AdminAddress())
+		serveErr <- admin.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("server stopped: %w", err)
+	case <-ctx.Done():
+	}
+
+	log.Info("Shutting down", "timeout", cfg.ShutdownTimeout.String())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	err = srv.Shutdown(shutdownCtx)
+	if adminErr := admin.Shutdown(shutdownCtx); adminErr != nil {
+		err = errors.Join(err, adminErr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to shut down: %w", err)
+	}
+	log.Info("Gateway stopped")
+	return nil
+}
@@ -0,0 +1,11 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	github.com/fsnotify/fsnotify v1.8.0
+	github.com/golang-jwt/jwt/v5 v5.2.0
+	github.com/stretchr/testify v1.9.0
+	golang.org/x/time v0.9.0
+	gopkg.in/yaml.v3 v3.0.1
+)
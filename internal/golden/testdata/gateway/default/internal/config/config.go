@@ -0,0 +1,115 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config holds the settings of the gateway process, read from the environment.
+// Routes and upstreams live in the route table file
+type Config struct {
+	// Environment is development or production (APP_ENV)
+	Environment string
+	// Port the gateway listens on (PORT)
+	Port int
+	// AdminPort serves the health and status endpoints (ADMIN_PORT)
+	AdminPort int
+	// LogLevel is one of debug, info, warn or error (LOG_LEVEL)
+	LogLevel string
+	// LogFormat is json or console (LOG_FORMAT)
+	LogFormat string
+	// ShutdownTimeout bounds the graceful shutdown (SHUTDOWN_TIMEOUT)
+	ShutdownTimeout time.Duration
+
+	// RoutesFile is the route table (ROUTES_FILE)
+	RoutesFile string
+	// WatchRoutes reloads the route table when its file changes (WATCH_ROUTES);
+	// SIGHUP reloads it either way
+	WatchRoutes bool
+}
+
+// Load reads the configuration from the environment, applying defaults
+func Load() (*Config, error) {
+	cfg := &Config{
+		Environment:     getEnv("APP_ENV", "development"),
+		Port:            8080,
+		AdminPort:       9090,
+		LogLevel:        getEnv("LOG_LEVEL", "info"),
+		LogFormat:       getEnv("LOG_FORMAT", "json"),
+		ShutdownTimeout: 15 * time.Second,
+		RoutesFile:      getEnv("ROUTES_FILE", "configs/gateway.yaml"),
+		WatchRoutes:     true,
+	}
+
+	if cfg.Environment != "development" && cfg.Environment != "production" {
+		return nil, fmt.Errorf("invalid APP_ENV %q: must be development or production", cfg.Environment)
+	}
+
+	var err error
+	if cfg.Port, err = getEnvPort("PORT", cfg.Port); err != nil {
+		return nil, err
+	}
+	if cfg.AdminPort, err = getEnvPort("ADMIN_PORT", cfg.AdminPort); err != nil {
+		return nil, err
+	}
+	if cfg.Port == cfg.AdminPort {
+		return nil, fmt.Errorf("PORT and ADMIN_PORT must differ, both are %d", cfg.Port)
+	}
+	if cfg.ShutdownTimeout, err = getEnvDuration("SHUTDOWN_TIMEOUT", cfg.ShutdownTimeout); err != nil {
+		return nil, err
+	}
+	if value := os.Getenv("WATCH_ROUTES"); value != "" {
+		if cfg.WatchRoutes, err = strconv.ParseBool(value); err != nil {
+			return nil, fmt.Errorf("invalid WATCH_ROUTES %q: %w", value, err)
+		}
+	}
+	return cfg, nil
+}
+
+// IsProduction reports whether the gateway runs in production
+func (c *Config) IsProduction() bool {
+	return c.Environment == "production"
+}
+
+// Address returns the address the gateway listens on
+func (c *Config) Address() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+// AdminAddress returns the address of the admin endpoints
+func (c *Config) AdminAddress() string {
+	return fmt.Sprintf(":%d", c.AdminPort)
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func getEnvPort(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("invalid %s %q: must be between 1 and 65535", key, value)
+	}
+	return port, nil
+}
+
+func getEnvDuration(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	return d, nil
+}
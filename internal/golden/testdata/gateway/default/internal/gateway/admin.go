@@ -0,0 +1,36 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// AdminHandler serves the endpoints of the operators, on a port of its own
+// so that they are not reachable through the routes:
+//
+//	GET /health  the process is up
+//	GET /ready   a route table is applied
+//	GET /status  the routes and the health of every upstream target
+func AdminHandler(g *Gateway) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /health", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	mux.HandleFunc("GET /ready", func(w http.ResponseWriter, r *http.Request) {
+		if !g.Ready() {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "no route table"})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ready"})
+	})
+	mux.HandleFunc("GET /status", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, g.Status())
+	})
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
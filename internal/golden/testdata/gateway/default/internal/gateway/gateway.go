@@ -0,0 +1,293 @@
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// RequestIDHeader carries the identifier of a request to the upstreams and back to the client
+const RequestIDHeader = "X-Request-ID"
+
+// Gateway routes the requests through the middleware of their route to its upstream.
+// Apply swaps the route table while requests are served: a request completes on the
+// table it started with
+type Gateway struct {
+	logger    logger.Logger
+	transport http.RoundTripper
+
+	mu      sync.Mutex // serializes Apply and Close
+	current atomic.Pointer[router]
+}
+
+// router is a compiled route table with the health checks of its upstreams
+type router struct {
+	routes []*compiledRoute
+	pools  map[string]*Pool
+	stop   context.CancelFunc
+	done   sync.WaitGroup
+}
+
+type compiledRoute struct {
+	*Route
+	pool    *Pool
+	handler http.Handler
+}
+
+// New creates a gateway forwarding through transport, http.DefaultTransport when nil
+func New(transport http.RoundTripper, log logger.Logger) *Gateway {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &Gateway{logger: log, transport: transport}
+}
+
+// Apply compiles table and routes the next requests with it. When table cannot be
+// compiled, for example because a secret is missing, the current table stays in place
+func (g *Gateway) Apply(table *Table) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	next, err := g.compile(table)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+	next.stop = stop
+	for _, pool := range next.pools {
+		next.done.Add(1)
+		go func(pool *Pool) {
+			defer next.done.Done()
+			pool.Run(ctx)
+		}(pool)
+	}
+
+	if previous := g.current.Swap(next); previous != nil {
+		previous.close()
+	}
+	g.logger.Info("Route table applied", "routes", len(next.routes), "upstreams", len(next.pools))
+	return nil
+}
+
+// Close stops the health checks
+func (g *Gateway) Close() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if current := g.current.Swap(nil); current != nil {
+		current.close()
+	}
+}
+
+func (r *router) close() {
+	r.stop()
+	r.done.Wait()
+}
+
+// compile builds the pools, middleware and proxies of table
+func (g *Gateway) compile(table *Table) (*router, error) {
+	next := &router{pools: make(map[string]*Pool, len(table.Upstreams))}
+	for name, upstream := range table.Upstreams {
+		next.pools[name] = NewPool(name, upstream, g.logger)
+	}
+
+	for _, route := range table.Routes {
+		pool := next.pools[route.Upstream]
+		handler := newProxy(route, pool, g.transport, g.logger)
+
+		// The middleware run in the order auth, rate limit, then the proxy with its retries
+		if route.RateLimit != nil {
+			handler = NewRateLimiter(route.RateLimit).Middleware(handler)
+		}
+		if route.Auth != nil {
+			auth, err := NewAuth(route.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("route %s: %w", route.Name, err)
+			}
+			handler = auth(handler)
+		}
+		next.routes = append(next.routes, &compiledRoute{Route: route, pool: pool, handler: handler})
+	}
+
+	// Longest prefixes first, host specific routes before the others
+	sort.SliceStable(next.routes, func(i, j int) bool {
+		a, b := next.routes[i], next.routes[j]
+		if len(a.Path) != len(b.Path) {
+			return len(a.Path) > len(b.Path)
+		}
+		return a.Host != "" && b.Host == ""
+	})
+	return next, nil
+}
+
+// ServeHTTP forwards r to the upstream of the first route matching it
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	requestID := r.Header.Get(RequestIDHeader)
+	if requestID == "" || len(requestID) > 128 {
+		requestID = newRequestID()
+		r.Header.Set(RequestIDHeader, requestID)
+	}
+	w.Header().Set(RequestIDHeader, requestID)
+	r.Header.Del(SubjectHeader)
+
+	recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	current := g.current.Load()
+	route, allowed := current.match(r)
+	routeName, upstream := "", ""
+	switch {
+	case route != nil:
+		routeName, upstream = route.Name, route.pool.Name()
+		ctx, cancel := context.WithTimeout(r.Context(), route.Timeout)
+		route.handler.ServeHTTP(recorder, r.WithContext(ctx))
+		cancel()
+	case len(allowed) > 0:
+		recorder.Header().Set("Allow", strings.Join(allowed, ", "))
+		writeError(recorder, http.StatusMethodNotAllowed, "method not allowed")
+	default:
+		writeError(recorder, http.StatusNotFound, "no route")
+	}
+
+	g.logger.Info("Request",
+		"method", r.Method,
+		"path", r.URL.Path,
+		"status", recorder.status,
+		"duration", time.Since(start).String(),
+		"route", routeName,
+		"upstream", upstream,
+		"request_id", requestID,
+	)
+}
+
+// match returns the route of r or, when routes match its path but not its method,
+// the methods they allow
+func (r *router) match(req *http.Request) (*compiledRoute, []string) {
+	if r == nil {
+		return nil, nil
+	}
+	host := strings.ToLower(req.Host)
+	if i := strings.LastIndexByte(host, ':'); i > strings.LastIndexByte(host, ']') {
+		host = host[:i]
+	}
+
+	var allowed []string
+	for _, route := range r.routes {
+		if route.Host != "" && route.Host != host {
+			continue
+		}
+		if !matchPrefix(req.URL.Path, route.Path) {
+			continue
+		}
+		if len(route.Methods) == 0 || slices.Contains(route.Methods, req.Method) {
+			return route, nil
+		}
+		allowed = append(allowed, route.Methods...)
+	}
+	slices.Sort(allowed)
+	return nil, slices.Compact(allowed)
+}
+
+// matchPrefix matches prefix on whole path segments
+func matchPrefix(path, prefix string) bool {
+	if prefix == "/" {
+		return true
+	}
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// Status describes the routes and the health of the upstream targets
+type Status struct {
+	Routes    []RouteStatus             `json:"routes"`
+	Upstreams map[string][]TargetStatus `json:"upstreams"`
+}
+
+// RouteStatus describes a route
+type RouteStatus struct {
+	Name     string   `json:"name"`
+	Host     string   `json:"host,omitempty"`
+	Path     string   `json:"path"`
+	Methods  []string `json:"methods,omitempty"`
+	Upstream string   `json:"upstream"`
+}
+
+// TargetStatus describes a target of an upstream
+type TargetStatus struct {
+	URL     string `json:"url"`
+	Healthy bool   `json:"healthy"`
+}
+
+// Status returns the routes and the health of the upstream targets
+func (g *Gateway) Status() Status {
+	status := Status{Routes: []RouteStatus{}, Upstreams: map[string][]TargetStatus{}}
+	current := g.current.Load()
+	if current == nil {
+		return status
+	}
+	for _, route := range current.routes {
+		status.Routes = append(status.Routes, RouteStatus{
+			Name:     route.Name,
+			Host:     route.Host,
+			Path:     route.Path,
+			Methods:  route.Methods,
+			Upstream: route.Upstream,
+		})
+	}
+	for name, pool := range current.pools {
+		for _, target := range pool.Targets() {
+			status.Upstreams[name] = append(status.Upstreams[name], TargetStatus{
+				URL:     target.URL.String(),
+				Healthy: target.Healthy(),
+			})
+		}
+	}
+	return status
+}
+
+// Ready reports whether a route table is applied
+func (g *Gateway) Ready() bool {
+	return g.current.Load() != nil
+}
+
+// statusRecorder remembers the status written, for the access log
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Unwrap gives http.ResponseController access to the flushes of the proxy
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// writeError answers a JSON error
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
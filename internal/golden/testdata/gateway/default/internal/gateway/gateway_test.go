@@ -0,0 +1,387 @@
+package gateway
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// upstream is a test target answering with its name, the path and the subject it received
+type upstream struct {
+	*httptest.Server
+	requests atomic.Int32
+	status   atomic.Int32
+	healthy  atomic.Bool
+}
+
+func newUpstream(t *testing.T, name string) *upstream {
+	u := &upstream{}
+	u.status.Store(http.StatusOK)
+	u.healthy.Store(true)
+	u.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			if !u.healthy.Load() {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+			return
+		}
+		u.requests.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(int(u.status.Load()))
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"upstream":   name,
+			"path":       r.URL.Path,
+			"subject":    r.Header.Get(SubjectHeader),
+			"api_key":    r.Header.Get("X-API-Key"),
+			"forwarded":  r.Header.Get("X-Forwarded-For"),
+			"request_id": r.Header.Get(RequestIDHeader),
+			"body":       string(body),
+		})
+	}))
+	t.Cleanup(u.Close)
+	return u
+}
+
+func newTestGateway(t *testing.T, table string) *Gateway {
+	t.Helper()
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: "error", Format: "json"}, io.Discard)
+	require.NoError(t, err)
+
+	parsed, err := ParseTable([]byte(table))
+	require.NoError(t, err)
+	gw := New(nil, log)
+	require.NoError(t, gw.Apply(parsed))
+	t.Cleanup(gw.Close)
+	return gw
+}
+
+// send serves a request through gw and decodes the JSON answer
+func send(t *testing.T, gw http.Handler, req *http.Request) (*httptest.ResponseRecorder, map[string]string) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	gw.ServeHTTP(rec, req)
+	var body map[string]string
+	_ = json.Unmarshal(rec.Body.Bytes(), &body)
+	return rec, body
+}
+
+func get(path string) *http.Request {
+	return httptest.NewRequest(http.MethodGet, path, nil)
+}
+
+func TestGateway_Routing(t *testing.T) {
+	users := newUpstream(t, "users")
+	admin := newUpstream(t, "admin")
+	gw := newTestGateway(t, `
+upstreams:
+  users: {targets: [`+users.URL+`]}
+  admin: {targets: [`+admin.URL+`/v1]}
+routes:
+  - {name: users, path: /api, methods: [GET], upstream: users}
+  - {name: admin, path: /api/admin, upstream: admin, strip_prefix: true}
+  - {name: admin-host, host: admin.example.com, path: /, upstream: admin}
+`)
+
+	_, body := send(t, gw, get("/api/users/42"))
+	assert.Equal(t, "users", body["upstream"])
+	assert.Equal(t, "/api/users/42", body["path"])
+	assert.NotEmpty(t, body["forwarded"])
+	assert.NotEmpty(t, body["request_id"])
+
+	_, body = send(t, gw, get("/api/admin/reports"))
+	assert.Equal(t, "admin", body["upstream"], "the longest prefix wins")
+	assert.Equal(t, "/v1/reports", body["path"], "the prefix is stripped and the target path kept")
+
+	req := get("/anything")
+	req.Host = "admin.example.com:8080"
+	_, body = send(t, gw, req)
+	assert.Equal(t, "admin", body["upstream"])
+
+	rec, _ := send(t, gw, get("/apis"))
+	assert.Equal(t, http.StatusNotFound, rec.Code, "prefixes match whole segments")
+
+	rec, _ = send(t, gw, httptest.NewRequest(http.MethodPost, "/api/users", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, "GET", rec.Header().Get("Allow"))
+}
+
+func TestGateway_JWTAuth(t *testing.T) {
+	t.Setenv("TEST_JWT_SECRET", "test-secret")
+	users := newUpstream(t, "users")
+	gw := newTestGateway(t, `
+upstreams:
+  users: {targets: [`+users.URL+`]}
+routes:
+  - name: users
+    path: /
+    upstream: users
+    auth: {type: jwt, secret_env: TEST_JWT_SECRET, issuer: auth.example.com}
+`)
+
+	token := func(secret, issuer string, expiry time.Duration) string {
+		signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+			Subject:   "alice",
+			Issuer:    issuer,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiry)),
+		}).SignedString([]byte(secret))
+		require.NoError(t, err)
+		return signed
+	}
+	withToken := func(token string) *http.Request {
+		req := get("/profile")
+		req.Header.Set("Authorization", "Bearer "+token)
+		// Clients cannot pretend to be someone else
+		req.Header.Set(SubjectHeader, "mallory")
+		return req
+	}
+
+	rec, _ := send(t, gw, get("/profile"))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Header().Get("WWW-Authenticate"), "invalid_token")
+
+	for name, bad := range map[string]string{
+		"wrong secret": token("other-secret", "auth.example.com", time.Hour),
+		"wrong issuer": token("test-secret", "evil.example.com", time.Hour),
+		"expired":      token("test-secret", "auth.example.com", -time.Hour),
+	} {
+		rec, _ := send(t, gw, withToken(bad))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code, name)
+	}
+	assert.Zero(t, users.requests.Load(), "rejected requests do not reach the upstream")
+
+	rec, body := send(t, gw, withToken(token("test-secret", "auth.example.com", time.Hour)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "alice", body["subject"])
+}
+
+func TestGateway_APIKeyAuth(t *testing.T) {
+	t.Setenv("TEST_API_KEYS", "key-one, key-two")
+	orders := newUpstream(t, "orders")
+	gw := newTestGateway(t, `
+upstreams:
+  orders: {targets: [`+orders.URL+`]}
+routes:
+  - {name: orders, path: /, upstream: orders, auth: {type: api_key, keys_env: TEST_API_KEYS}}
+`)
+
+	withKey := func(key string) *http.Request {
+		req := get("/orders")
+		req.Header.Set("X-API-Key", key)
+		return req
+	}
+
+	rec, _ := send(t, gw, withKey("key-three"))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec, first := send(t, gw, withKey("key-one"))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, first["api_key"], "the key is not forwarded")
+	assert.True(t, strings.HasPrefix(first["subject"], "key:"))
+
+	_, second := send(t, gw, withKey("key-two"))
+	assert.NotEqual(t, first["subject"], second["subject"], "each key is a client of its own")
+}
+
+func TestGateway_MissingSecretKeepsTheCurrentTable(t *testing.T) {
+	users := newUpstream(t, "users")
+	gw := newTestGateway(t, `
+upstreams:
+  users: {targets: [`+users.URL+`]}
+routes:
+  - {name: users, path: /, upstream: users}
+`)
+
+	table, err := ParseTable([]byte(`
+upstreams:
+  users: {targets: [` + users.URL + `]}
+routes:
+  - {name: users, path: /, upstream: users, auth: {type: jwt, secret_env: TEST_UNSET_SECRET}}
+`))
+	require.NoError(t, err)
+	err = gw.Apply(table)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "TEST_UNSET_SECRET is not set")
+
+	rec, _ := send(t, gw, get("/"))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestGateway_RateLimit(t *testing.T) {
+	users := newUpstream(t, "users")
+	gw := newTestGateway(t, `
+upstreams:
+  users: {targets: [`+users.URL+`]}
+routes:
+  - {name: users, path: /, upstream: users, rate_limit: {requests_per_second: 1, burst: 2}}
+`)
+
+	from := func(ip string) *http.Request {
+		req := get("/")
+		req.RemoteAddr = ip + ":40000"
+		return req
+	}
+
+	for i := 0; i < 2; i++ {
+		rec, _ := send(t, gw, from("10.0.0.1"))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+	rec, _ := send(t, gw, from("10.0.0.1"))
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get("Retry-After"))
+
+	rec, _ = send(t, gw, from("10.0.0.2"))
+	assert.Equal(t, http.StatusOK, rec.Code, "each client has a bucket of its own")
+}
+
+func TestGateway_RetriesOnAnotherTarget(t *testing.T) {
+	failing := newUpstream(t, "failing")
+	failing.status.Store(http.StatusBadGateway)
+	working := newUpstream(t, "working")
+	gw := newTestGateway(t, `
+upstreams:
+  users: {targets: [`+failing.URL+`, `+working.URL+`]}
+routes:
+  - {name: users, path: /, upstream: users, retries: {attempts: 2, backoff: 1ms}}
+`)
+
+	for i := 0; i < 4; i++ {
+		req := httptest.NewRequest(http.MethodPut, "/users/1", strings.NewReader(`{"name":"alice"}`))
+		rec, body := send(t, gw, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "working", body["upstream"])
+		assert.Equal(t, `{"name":"alice"}`, body["body"], "the body is sent again")
+	}
+
+	// A POST may have been processed: it is not sent twice
+	failing.requests.Store(0)
+	working.requests.Store(0)
+	for i := 0; i < 2; i++ {
+		send(t, gw, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{}`)))
+	}
+	assert.Equal(t, int32(2), failing.requests.Load()+working.requests.Load())
+}
+
+func TestGateway_UnreachableTargetIsTakenOut(t *testing.T) {
+	down := newUpstream(t, "down")
+	down.Close()
+	working := newUpstream(t, "working")
+	gw := newTestGateway(t, `
+upstreams:
+  users: {targets: [`+down.URL+`, `+working.URL+`]}
+routes:
+  - {name: users, path: /, upstream: users}
+`)
+
+	failures := 0
+	for i := 0; i < 20; i++ {
+		if rec, _ := send(t, gw, get("/")); rec.Code == http.StatusBadGateway {
+			failures++
+		}
+	}
+	assert.Equal(t, 3, failures, "the target is out after 3 failed requests in a row")
+	assert.False(t, gw.Status().Upstreams["users"][0].Healthy)
+}
+
+func TestGateway_HealthChecks(t *testing.T) {
+	flaky := newUpstream(t, "flaky")
+	stable := newUpstream(t, "stable")
+	gw := newTestGateway(t, `
+upstreams:
+  users:
+    targets: [`+flaky.URL+`, `+stable.URL+`]
+    health_check: {interval: 10ms, unhealthy_threshold: 2, healthy_threshold: 2}
+routes:
+  - {name: users, path: /, upstream: users}
+`)
+
+	flaky.healthy.Store(false)
+	require.Eventually(t, func() bool {
+		return !gw.Status().Upstreams["users"][0].Healthy
+	}, 2*time.Second, 10*time.Millisecond)
+
+	flaky.requests.Store(0)
+	for i := 0; i < 6; i++ {
+		_, body := send(t, gw, get("/"))
+		assert.Equal(t, "stable", body["upstream"])
+	}
+	assert.Zero(t, flaky.requests.Load())
+
+	flaky.healthy.Store(true)
+	require.Eventually(t, func() bool {
+		return gw.Status().Upstreams["users"][0].Healthy
+	}, 2*time.Second, 10*time.Millisecond)
+
+	stable.healthy.Store(false)
+	flaky.healthy.Store(false)
+	require.Eventually(t, func() bool {
+		rec, _ := send(t, gw, get("/"))
+		return rec.Code == http.StatusServiceUnavailable
+	}, 2*time.Second, 10*time.Millisecond, "without healthy target the gateway answers 503")
+}
+
+func TestGateway_ApplySwapsTheRoutes(t *testing.T) {
+	v1 := newUpstream(t, "v1")
+	v2 := newUpstream(t, "v2")
+	gw := newTestGateway(t, `
+upstreams:
+  api: {targets: [`+v1.URL+`]}
+routes:
+  - {name: api, path: /, upstream: api}
+`)
+
+	_, body := send(t, gw, get("/"))
+	assert.Equal(t, "v1", body["upstream"])
+
+	table, err := ParseTable([]byte(`
+upstreams:
+  api: {targets: [` + v2.URL + `]}
+routes:
+  - {name: api, path: /, upstream: api}
+`))
+	require.NoError(t, err)
+	require.NoError(t, gw.Apply(table))
+
+	_, body = send(t, gw, get("/"))
+	assert.Equal(t, "v2", body["upstream"])
+	assert.Equal(t, v2.URL, gw.Status().Upstreams["api"][0].URL)
+}
+
+func TestAdminHandler(t *testing.T) {
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: "error", Format: "json"}, io.Discard)
+	require.NoError(t, err)
+	gw := New(nil, log)
+	admin := AdminHandler(gw)
+
+	rec := httptest.NewRecorder()
+	admin.ServeHTTP(rec, get("/ready"))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code, "not ready before a route table is applied")
+
+	table, err := ParseTable([]byte("upstreams:\n  api: {targets: [http://localhost:1]}\nroutes:\n  - {name: api, path: /, upstream: api}"))
+	require.NoError(t, err)
+	require.NoError(t, gw.Apply(table))
+	defer gw.Close()
+
+	rec = httptest.NewRecorder()
+	admin.ServeHTTP(rec, get("/ready"))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	admin.ServeHTTP(rec, get("/status"))
+	var status Status
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	require.Len(t, status.Routes, 1)
+	assert.Equal(t, "api", status.Routes[0].Upstream)
+	assert.True(t, status.Upstreams["api"][0].Healthy)
+}
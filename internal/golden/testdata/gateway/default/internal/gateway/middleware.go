@@ -0,0 +1,227 @@
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/time/rate"
+)
+
+// Middleware wraps the handler of a route
+type Middleware func(http.Handler) http.Handler
+
+// SubjectHeader tells the upstreams who sent the request, once auth accepted it.
+// The gateway removes it from incoming requests, so that clients cannot set it
+const SubjectHeader = "X-Authenticated-Subject"
+
+type clientKey struct{}
+
+// ClientFromContext returns the identity set by the auth of the route
+func ClientFromContext(ctx context.Context) string {
+	client, _ := ctx.Value(clientKey{}).(string)
+	return client
+}
+
+// NewAuth creates the auth middleware of a route, reading its secrets from the environment
+func NewAuth(auth *Auth) (Middleware, error) {
+	var authenticate func(r *http.Request) (string, error)
+	switch auth.Type {
+	case "jwt":
+		secret := os.Getenv(auth.SecretEnv)
+		if secret == "" {
+			return nil, fmt.Errorf("%s is not set", auth.SecretEnv)
+		}
+		authenticate = jwtAuthenticator([]byte(secret), auth.Issuer, auth.Audience)
+	case "api_key":
+		keys := splitList(os.Getenv(auth.KeysEnv))
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("%s is not set", auth.KeysEnv)
+		}
+		authenticate = apiKeyAuthenticator(auth.Header, keys)
+	default:
+		return nil, fmt.Errorf("unknown auth type %q", auth.Type)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			client, err := authenticate(r)
+			if err != nil {
+				if auth.Type == "jwt" {
+					w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				}
+				writeError(w, http.StatusUnauthorized, err.Error())
+				return
+			}
+			r.Header.Set(SubjectHeader, client)
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientKey{}, client)))
+		})
+	}, nil
+}
+
+// jwtAuthenticator accepts HMAC signed bearer tokens, identifying the client by their subject
+func jwtAuthenticator(secret []byte, issuer, audience string) func(r *http.Request) (string, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"HS256", "HS384", "HS512"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(30 * time.Second),
+	}
+	if issuer != "" {
+		options = append(options, jwt.WithIssuer(issuer))
+	}
+	if audience != "" {
+		options = append(options, jwt.WithAudience(audience))
+	}
+	parser := jwt.NewParser(options...)
+
+	return func(r *http.Request) (string, error) {
+		header := r.Header.Get("Authorization")
+		token, found := strings.CutPrefix(header, "Bearer ")
+		if !found || token == "" {
+			return "", errors.New("missing bearer token")
+		}
+
+		var claims jwt.RegisteredClaims
+		if _, err := parser.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+			return secret, nil
+		}); err != nil {
+			return "", errors.New("invalid token")
+		}
+		if claims.Subject == "" {
+			return "", errors.New("token has no subject")
+		}
+		return claims.Subject, nil
+	}
+}
+
+// apiKeyAuthenticator accepts the keys in header, identifying the client by a digest of their key
+func apiKeyAuthenticator(header string, keys []string) func(r *http.Request) (string, error) {
+	return func(r *http.Request) (string, error) {
+		presented := r.Header.Get(header)
+		if presented == "" {
+			return "", fmt.Errorf("missing %s header", header)
+		}
+
+		// Compare with every key in constant time, so that timing does not tell how close a guess is
+		matched := false
+		for _, key := range keys {
+			if subtle.ConstantTimeCompare([]byte(presented), []byte(key)) == 1 {
+				matched = true
+			}
+		}
+		if !matched {
+			return "", errors.New("invalid API key")
+		}
+		// The upstreams do not need the key itself
+		r.Header.Del(header)
+
+		digest := sha256.Sum256([]byte(presented))
+		return "key:" + hex.EncodeToString(digest[:6]), nil
+	}
+}
+
+// limiterIdleTime is how long the bucket of a client without requests is kept
+const limiterIdleTime = 10 * time.Minute
+
+// RateLimiter keeps a token bucket per client of a route
+type RateLimiter struct {
+	limit rate.Limit
+	burst int
+	key   func(r *http.Request) string
+
+	mu        sync.Mutex
+	clients   map[string]*clientLimiter
+	lastSweep time.Time
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewRateLimiter creates the rate limiter of a route
+func NewRateLimiter(config *RateLimit) *RateLimiter {
+	key := clientIP
+	if config.Key == "client" {
+		key = func(r *http.Request) string { return ClientFromContext(r.Context()) }
+	}
+	return &RateLimiter{
+		limit:     rate.Limit(config.RequestsPerSecond),
+		burst:     config.Burst,
+		key:       key,
+		clients:   make(map[string]*clientLimiter),
+		lastSweep: time.Now(),
+	}
+}
+
+// Middleware answers 429 to the clients out of tokens
+func (l *RateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reservation := l.limiter(l.key(r)).Reserve()
+		if delay := reservation.Delay(); delay > 0 {
+			reservation.Cancel()
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			writeError(w, http.StatusTooManyRequests, "rate limit exceeded")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// limiter returns the bucket of client, dropping the idle ones once in a while
+func (l *RateLimiter) limiter(client string) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastSweep) > limiterIdleTime {
+		for key, c := range l.clients {
+			if now.Sub(c.lastSeen) > limiterIdleTime {
+				delete(l.clients, key)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	c, ok := l.clients[client]
+	if !ok {
+		c = &clientLimiter{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[client] = c
+	}
+	c.lastSeen = now
+	return c.limiter
+}
+
+// clientIP returns the address of the peer. Behind a load balancer, every request
+// comes from the load balancer: rate limit on key client, or take the address
+// from the header your load balancer sets
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// splitList splits a comma separated list, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
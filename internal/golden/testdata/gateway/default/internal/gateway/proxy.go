@@ -0,0 +1,160 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// maxRetryBody is the largest request body buffered to be sent again; larger requests are not retried
+const maxRetryBody = 1 << 20
+
+// newProxy forwards the requests of route to the targets of pool
+func newProxy(route *Route, pool *Pool, transport http.RoundTripper, log logger.Logger) http.Handler {
+	retries := route.Retries
+	if retries == nil {
+		retries = &Retries{Attempts: 1}
+	}
+
+	return &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			pr.SetXForwarded()
+			if route.StripPrefix && route.Path != "/" {
+				pr.Out.URL.Path = "/" + strings.TrimPrefix(strings.TrimPrefix(pr.In.URL.Path, route.Path), "/")
+				pr.Out.URL.RawPath = ""
+			}
+			// The target sets the scheme and host for every attempt, see retryTransport
+			pr.Out.Host = ""
+		},
+		Transport: &retryTransport{
+			base:    transport,
+			pool:    pool,
+			retries: retries,
+		},
+		FlushInterval: -1,
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			status, message := http.StatusBadGateway, "upstream unavailable"
+			switch {
+			case errors.Is(err, ErrNoHealthyTarget):
+				status, message = http.StatusServiceUnavailable, "no healthy upstream"
+			case errors.Is(err, context.DeadlineExceeded):
+				status, message = http.StatusGatewayTimeout, "upstream timed out"
+			case errors.Is(err, context.Canceled):
+				// The client went away, nobody reads the answer
+				status = 499
+			}
+			log.Warn("Proxy error", "route", route.Name, "upstream", pool.Name(), "path", r.URL.Path, "error", err)
+			writeError(w, status, message)
+		},
+	}
+}
+
+// retryTransport sends each attempt of a request to a target of the pool, the next
+// healthy one after a failure. Only requests that are safe to send twice are retried:
+// idempotent methods with a body of at most maxRetryBody bytes
+type retryTransport struct {
+	base    http.RoundTripper
+	pool    *Pool
+	retries *Retries
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attempts := t.retries.Attempts
+	if attempts > 1 && !retryable(req) {
+		attempts = 1
+	}
+
+	var body []byte
+	if attempts > 1 && req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(io.LimitReader(req.Body, maxRetryBody+1))
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if len(body) > maxRetryBody {
+			// Too large to keep: send what was read followed by the rest, once
+			attempts = 1
+			req.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), req.Body))
+			body = nil
+		}
+	}
+
+	tried := make(map[*Target]bool)
+	for attempt := 1; ; attempt++ {
+		target, err := t.pool.Next(tried)
+		if err != nil {
+			return nil, err
+		}
+		tried[target] = true
+
+		out := req.Clone(req.Context())
+		out.URL.Scheme = target.URL.Scheme
+		out.URL.Host = target.URL.Host
+		out.URL.Path, out.URL.RawPath = joinPath(target.URL.Path, req.URL.Path), ""
+		if body != nil {
+			out.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := t.base.RoundTrip(out)
+		if err != nil {
+			if req.Context().Err() != nil {
+				return nil, err
+			}
+			t.pool.ReportFailure(target, err.Error())
+		} else if slices.Contains(t.retries.On, resp.StatusCode) {
+			t.pool.ReportFailure(target, "answered "+resp.Status)
+		} else {
+			t.pool.ReportSuccess(target)
+			return resp, nil
+		}
+
+		if attempt >= attempts {
+			return resp, err
+		}
+		if resp != nil {
+			// Drain the answer so that the connection is reused
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			resp.Body.Close()
+		}
+		if err := sleep(req.Context(), t.retries.Backoff*time.Duration(attempt)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryable reports whether a request can be sent again without side effects
+func retryable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return req.Header.Get("Upgrade") == ""
+	}
+	return req.Header.Get("Idempotency-Key") != ""
+}
+
+// joinPath appends path to the base path of a target
+func joinPath(base, path string) string {
+	if base == "" || base == "/" {
+		return path
+	}
+	return strings.TrimSuffix(base, "/") + "/" + strings.TrimPrefix(path, "/")
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
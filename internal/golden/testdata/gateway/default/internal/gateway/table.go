@@ -0,0 +1,312 @@
+package gateway
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Table is the route table of the gateway, read from configs/gateway.yaml
+type Table struct {
+	// Upstreams are the pools of targets the routes forward to, by name
+	Upstreams map[string]*Upstream `yaml:"upstreams"`
+	// Routes are matched by host, longest path prefix and method
+	Routes []*Route `yaml:"routes"`
+}
+
+// Upstream is a pool of interchangeable targets
+type Upstream struct {
+	// Targets are the base URLs of the instances, such as http://users-1:8080
+	Targets     []string     `yaml:"targets"`
+	HealthCheck *HealthCheck `yaml:"health_check"`
+}
+
+// HealthCheck probes every target of an upstream on an interval
+type HealthCheck struct {
+	// Path answers 2xx when the target is healthy
+	Path     string        `yaml:"path"`
+	Interval time.Duration `yaml:"interval"`
+	Timeout  time.Duration `yaml:"timeout"`
+	// UnhealthyThreshold is the number of failed checks or requests in a row that takes a target out
+	UnhealthyThreshold int `yaml:"unhealthy_threshold"`
+	// HealthyThreshold is the number of successful checks in a row that brings it back
+	HealthyThreshold int `yaml:"healthy_threshold"`
+}
+
+// Route forwards the requests matching it to an upstream
+type Route struct {
+	Name string `yaml:"name"`
+	// Host restricts the route to a Host header, any host when empty
+	Host string `yaml:"host"`
+	// Path is a path prefix, matched on whole segments: /api matches /api and /api/users, not /apis
+	Path string `yaml:"path"`
+	// Methods restricts the route to some methods, any method when empty
+	Methods  []string `yaml:"methods"`
+	Upstream string   `yaml:"upstream"`
+	// StripPrefix removes Path from the forwarded path
+	StripPrefix bool `yaml:"strip_prefix"`
+	// Timeout bounds the whole request, retries included
+	Timeout time.Duration `yaml:"timeout"`
+
+	Auth      *Auth      `yaml:"auth"`
+	RateLimit *RateLimit `yaml:"rate_limit"`
+	Retries   *Retries   `yaml:"retries"`
+}
+
+// Auth authenticates the requests of a route. Secrets are read from the environment,
+// so that the route table can be committed
+type Auth struct {
+	// Type is jwt or api_key
+	Type string `yaml:"type"`
+
+	// SecretEnv names the variable holding the HMAC secret of the JWTs
+	SecretEnv string `yaml:"secret_env"`
+	Issuer    string `yaml:"issuer"`
+	Audience  string `yaml:"audience"`
+
+	// Header carries the API key, X-API-Key by default
+	Header string `yaml:"header"`
+	// KeysEnv names the variable holding the accepted API keys, comma separated
+	KeysEnv string `yaml:"keys_env"`
+}
+
+// RateLimit caps the requests of each client of a route with a token bucket
+type RateLimit struct {
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	Burst             int     `yaml:"burst"`
+	// Key tells the clients apart: ip, or client for the identity set by auth
+	Key string `yaml:"key"`
+}
+
+// Retries sends a failed request to another target of the upstream
+type Retries struct {
+	// Attempts counts the first try, so 3 means up to 2 retries
+	Attempts int           `yaml:"attempts"`
+	Backoff  time.Duration `yaml:"backoff"`
+	// On lists the upstream statuses retried, on top of connection errors
+	On []int `yaml:"on"`
+}
+
+var routeNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// LoadTable reads and validates the route table at path
+func LoadTable(path string) (*Table, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the route table: %w", err)
+	}
+	table, err := ParseTable(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid route table %s: %w", path, err)
+	}
+	return table, nil
+}
+
+// ParseTable decodes a route table, rejecting unknown fields, and validates it
+func ParseTable(data []byte) (*Table, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var table Table
+	if err := decoder.Decode(&table); err != nil {
+		return nil, err
+	}
+	if err := table.Validate(); err != nil {
+		return nil, err
+	}
+	return &table, nil
+}
+
+// Validate checks the route table and applies its defaults
+func (t *Table) Validate() error {
+	if len(t.Routes) == 0 {
+		return errors.New("no routes")
+	}
+
+	var errs []error
+	for name, upstream := range t.Upstreams {
+		if err := upstream.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("upstream %s: %w", name, err))
+		}
+	}
+
+	names := make(map[string]bool, len(t.Routes))
+	for i, route := range t.Routes {
+		if route == nil {
+			errs = append(errs, fmt.Errorf("route %d is empty", i+1))
+			continue
+		}
+		if names[route.Name] {
+			errs = append(errs, fmt.Errorf("route %s is declared twice", route.Name))
+		}
+		names[route.Name] = true
+		if err := route.validate(t.Upstreams); err != nil {
+			errs = append(errs, fmt.Errorf("route %s: %w", route.label(i), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (u *Upstream) validate() error {
+	if u == nil || len(u.Targets) == 0 {
+		return errors.New("no targets")
+	}
+	for _, target := range u.Targets {
+		parsed, err := url.Parse(target)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("target %q is not an http or https URL", target)
+		}
+	}
+
+	if u.HealthCheck == nil {
+		return nil
+	}
+	check := u.HealthCheck
+	if check.Path == "" {
+		check.Path = "/health"
+	}
+	if !strings.HasPrefix(check.Path, "/") {
+		return fmt.Errorf("health check path %q must start with /", check.Path)
+	}
+	if check.Interval == 0 {
+		check.Interval = 10 * time.Second
+	}
+	if check.Timeout == 0 {
+		check.Timeout = 2 * time.Second
+	}
+	if check.UnhealthyThreshold == 0 {
+		check.UnhealthyThreshold = 3
+	}
+	if check.HealthyThreshold == 0 {
+		check.HealthyThreshold = 2
+	}
+	if check.Interval < 0 || check.Timeout < 0 || check.UnhealthyThreshold < 0 || check.HealthyThreshold < 0 {
+		return errors.New("health check durations and thresholds must be positive")
+	}
+	return nil
+}
+
+func (r *Route) validate(upstreams map[string]*Upstream) error {
+	if !routeNamePattern.MatchString(r.Name) {
+		return errors.New("name must be letters, digits, _ or -")
+	}
+	if !strings.HasPrefix(r.Path, "/") {
+		return fmt.Errorf("path %q must start with /", r.Path)
+	}
+	if len(r.Path) > 1 {
+		r.Path = strings.TrimSuffix(r.Path, "/")
+	}
+	r.Host = strings.ToLower(r.Host)
+	for i, method := range r.Methods {
+		r.Methods[i] = strings.ToUpper(method)
+	}
+	if _, ok := upstreams[r.Upstream]; !ok {
+		return fmt.Errorf("unknown upstream %q", r.Upstream)
+	}
+	if r.Timeout == 0 {
+		r.Timeout = 30 * time.Second
+	}
+	if r.Timeout < 0 {
+		return errors.New("timeout must be positive")
+	}
+
+	if r.Auth != nil {
+		if err := r.Auth.validate(); err != nil {
+			return fmt.Errorf("auth: %w", err)
+		}
+	}
+	if r.RateLimit != nil {
+		if err := r.RateLimit.validate(r.Auth != nil); err != nil {
+			return fmt.Errorf("rate_limit: %w", err)
+		}
+	}
+	if r.Retries != nil {
+		if err := r.Retries.validate(); err != nil {
+			return fmt.Errorf("retries: %w", err)
+		}
+	}
+	return nil
+}
+
+// label names a route in errors, by its position when its name is missing
+func (r *Route) label(i int) string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return fmt.Sprintf("#%d", i+1)
+}
+
+func (a *Auth) validate() error {
+	switch a.Type {
+	case "jwt":
+		if a.SecretEnv == "" {
+			return errors.New("secret_env is required for jwt")
+		}
+	case "api_key":
+		if a.KeysEnv == "" {
+			return errors.New("keys_env is required for api_key")
+		}
+		if a.Header == "" {
+			a.Header = "X-API-Key"
+		}
+	default:
+		return fmt.Errorf("unknown type %q (supported: jwt, api_key)", a.Type)
+	}
+	return nil
+}
+
+func (l *RateLimit) validate(authenticated bool) error {
+	if l.RequestsPerSecond <= 0 {
+		return errors.New("requests_per_second must be positive")
+	}
+	if l.Burst == 0 {
+		l.Burst = max(1, int(l.RequestsPerSecond))
+	}
+	if l.Burst < 0 {
+		return errors.New("burst must be positive")
+	}
+	switch l.Key {
+	case "":
+		l.Key = "ip"
+	case "ip":
+	case "client":
+		if !authenticated {
+			return errors.New("key client needs the auth of the route")
+		}
+	default:
+		return fmt.Errorf("unknown key %q (supported: ip, client)", l.Key)
+	}
+	return nil
+}
+
+func (r *Retries) validate() error {
+	if r.Attempts == 0 {
+		r.Attempts = 3
+	}
+	if r.Attempts < 1 {
+		return errors.New("attempts must be at least 1")
+	}
+	if r.Backoff == 0 {
+		r.Backoff = 100 * time.Millisecond
+	}
+	if r.Backoff < 0 {
+		return errors.New("backoff must be positive")
+	}
+	if r.On == nil {
+		r.On = []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+	}
+	for _, status := range r.On {
+		if status < 500 || status > 599 {
+			return fmt.Errorf("status %d is not a 5xx status", status)
+		}
+	}
+	return nil
+}
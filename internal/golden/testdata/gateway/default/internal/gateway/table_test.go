@@ -0,0 +1,115 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTable_ShippedRouteTable(t *testing.T) {
+	table, err := LoadTable("../../configs/gateway.yaml")
+	require.NoError(t, err)
+
+	assert.Len(t, table.Upstreams["users"].Targets, 2)
+	assert.Equal(t, 2, table.Upstreams["users"].HealthCheck.HealthyThreshold)
+	require.Len(t, table.Routes, 3)
+	assert.Equal(t, "client", table.Routes[1].RateLimit.Key)
+	assert.Equal(t, 5, table.Routes[1].RateLimit.Burst, "burst defaults to the rate")
+	assert.Equal(t, "X-API-Key", table.Routes[2].Auth.Header)
+}
+
+func TestParseTable_Defaults(t *testing.T) {
+	table, err := ParseTable([]byte(`
+upstreams:
+  api:
+    targets: [http://localhost:9001]
+    health_check: {}
+routes:
+  - name: api
+    path: /api/
+    methods: [get]
+    upstream: api
+    rate_limit:
+      requests_per_second: 2.5
+    retries: {}
+`))
+	require.NoError(t, err)
+
+	check := table.Upstreams["api"].HealthCheck
+	assert.Equal(t, "/health", check.Path)
+	assert.Equal(t, 10*time.Second, check.Interval)
+	assert.Equal(t, 3, check.UnhealthyThreshold)
+
+	route := table.Routes[0]
+	assert.Equal(t, "/api", route.Path, "the trailing slash is dropped")
+	assert.Equal(t, []string{"GET"}, route.Methods)
+	assert.Equal(t, 30*time.Second, route.Timeout)
+	assert.Equal(t, "ip", route.RateLimit.Key)
+	assert.Equal(t, 2, route.RateLimit.Burst)
+	assert.Equal(t, 3, route.Retries.Attempts)
+	assert.Equal(t, []int{502, 503, 504}, route.Retries.On)
+}
+
+func TestParseTable_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		table string
+		err   string
+	}{
+		{
+			name:  "no routes",
+			table: "upstreams: {}",
+			err:   "no routes",
+		},
+		{
+			name:  "unknown field",
+			table: "routes:\n  - name: api\n    path: /\n    upstream: api\n    prefix: /api",
+			err:   "field prefix not found",
+		},
+		{
+			name:  "unknown upstream",
+			table: "routes:\n  - name: api\n    path: /\n    upstream: api",
+			err:   `route api: unknown upstream "api"`,
+		},
+		{
+			name:  "target without scheme",
+			table: "upstreams:\n  api:\n    targets: [localhost:9001]\nroutes:\n  - name: api\n    path: /\n    upstream: api",
+			err:   `upstream api: target "localhost:9001" is not an http or https URL`,
+		},
+		{
+			name:  "relative path",
+			table: "upstreams:\n  api:\n    targets: [http://api]\nroutes:\n  - name: api\n    path: api\n    upstream: api",
+			err:   `path "api" must start with /`,
+		},
+		{
+			name:  "duplicate name",
+			table: "upstreams:\n  api:\n    targets: [http://api]\nroutes:\n  - {name: api, path: /a, upstream: api}\n  - {name: api, path: /b, upstream: api}",
+			err:   "route api is declared twice",
+		},
+		{
+			name:  "client rate limit without auth",
+			table: "upstreams:\n  api:\n    targets: [http://api]\nroutes:\n  - name: api\n    path: /\n    upstream: api\n    rate_limit: {requests_per_second: 1, key: client}",
+			err:   "key client needs the auth of the route",
+		},
+		{
+			name:  "jwt without secret",
+			table: "upstreams:\n  api:\n    targets: [http://api]\nroutes:\n  - name: api\n    path: /\n    upstream: api\n    auth: {type: jwt}",
+			err:   "secret_env is required for jwt",
+		},
+		{
+			name:  "retry on a client error",
+			table: "upstreams:\n  api:\n    targets: [http://api]\nroutes:\n  - name: api\n    path: /\n    upstream: api\n    retries: {on: [404]}",
+			err:   "status 404 is not a 5xx status",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseTable([]byte(tt.table))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// ErrNoHealthyTarget is returned when every target of an upstream is out
+var ErrNoHealthyTarget = errors.New("no healthy target")
+
+const (
+	// passiveThreshold is the number of failed requests in a row that takes out a target without health check
+	passiveThreshold = 3
+	// passiveCooldown is how long such a target stays out before it gets requests again
+	passiveCooldown = 10 * time.Second
+)
+
+// Target is an instance of an upstream
+type Target struct {
+	URL *url.URL
+
+	mu        sync.Mutex
+	healthy   bool
+	failures  int
+	successes int
+}
+
+// Healthy reports whether the target receives requests
+func (t *Target) Healthy() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.healthy
+}
+
+// Pool balances the requests of an upstream over its healthy targets, round-robin.
+// Targets start healthy; failed health checks and failed requests take them out,
+// and successful health checks bring them back
+type Pool struct {
+	name    string
+	targets []*Target
+	check   *HealthCheck
+	client  *http.Client
+	logger  logger.Logger
+	next    atomic.Uint64
+}
+
+// NewPool creates the pool of an upstream
+func NewPool(name string, upstream *Upstream, log logger.Logger) *Pool {
+	pool := &Pool{
+		name:   name,
+		check:  upstream.HealthCheck,
+		logger: log.With("upstream", name),
+	}
+	for _, target := range upstream.Targets {
+		// The route table was validated, the URL parses
+		parsed, _ := url.Parse(target)
+		pool.targets = append(pool.targets, &Target{URL: parsed, healthy: true})
+	}
+	if pool.check != nil {
+		pool.client = &http.Client{
+			Timeout: pool.check.Timeout,
+			// A redirect is an answer of the target, not a reason to follow it
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		}
+	}
+	return pool
+}
+
+// Name returns the name of the upstream
+func (p *Pool) Name() string {
+	return p.name
+}
+
+// Targets returns the targets of the pool
+func (p *Pool) Targets() []*Target {
+	return p.targets
+}
+
+// Next returns the next healthy target, skipping the ones in exclude
+// Excluded targets are picked again when no other target is healthy
+func (p *Pool) Next(exclude map[*Target]bool) (*Target, error) {
+	var fallback *Target
+	start := p.next.Add(1)
+	for i := range p.targets {
+		target := p.targets[(start+uint64(i))%uint64(len(p.targets))]
+		if !target.Healthy() {
+			continue
+		}
+		if !exclude[target] {
+			return target, nil
+		}
+		if fallback == nil {
+			fallback = target
+		}
+	}
+	if fallback != nil {
+		return fallback, nil
+	}
+	return nil, ErrNoHealthyTarget
+}
+
+// ReportFailure counts a failed request or health check of target
+func (p *Pool) ReportFailure(target *Target, reason string) {
+	threshold := passiveThreshold
+	if p.check != nil {
+		threshold = p.check.UnhealthyThreshold
+	}
+
+	target.mu.Lock()
+	target.successes = 0
+	target.failures++
+	down := target.healthy && target.failures >= threshold
+	if down {
+		target.healthy = false
+	}
+	target.mu.Unlock()
+
+	if down {
+		p.logger.Warn("Target is unhealthy", "target", target.URL.String(), "reason", reason)
+		if p.check == nil {
+			// Nothing would bring it back: give it another chance after a pause
+			time.AfterFunc(passiveCooldown, func() { p.reportSuccess(target, 1) })
+		}
+	}
+}
+
+// ReportSuccess counts a successful request of target, which resets its failures
+func (p *Pool) ReportSuccess(target *Target) {
+	target.mu.Lock()
+	if target.healthy {
+		target.failures = 0
+	}
+	target.mu.Unlock()
+}
+
+// reportSuccess counts a successful health check of target
+func (p *Pool) reportSuccess(target *Target, threshold int) {
+	target.mu.Lock()
+	target.failures = 0
+	target.successes++
+	up := !target.healthy && target.successes >= threshold
+	if up {
+		target.healthy = true
+	}
+	target.mu.Unlock()
+
+	if up {
+		p.logger.Info("Target is healthy again", "target", target.URL.String())
+	}
+}
+
+// Run checks the targets on the health check interval until ctx is cancelled.
+// It returns at once for upstreams without a health check
+func (p *Pool) Run(ctx context.Context) {
+	if p.check == nil {
+		return
+	}
+
+	ticker := time.NewTicker(p.check.Interval)
+	defer ticker.Stop()
+	for {
+		p.checkAll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkAll probes every target concurrently, so that a slow one does not delay the others
+func (p *Pool) checkAll(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, target := range p.targets {
+		wg.Add(1)
+		go func(target *Target) {
+			defer wg.Done()
+			if reason := p.probe(ctx, target); reason != "" {
+				if ctx.Err() == nil {
+					p.ReportFailure(target, reason)
+				}
+				return
+			}
+			p.reportSuccess(target, p.check.HealthyThreshold)
+		}(target)
+	}
+	wg.Wait()
+}
+
+// probe requests the health check path of target, returning why it failed
+func (p *Pool) probe(ctx context.Context, target *Target) string {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.URL.JoinPath(p.check.Path).String(), nil)
+	if err != nil {
+		return err.Error()
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err.Error()
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "health check answered " + resp.Status
+	}
+	return ""
+}
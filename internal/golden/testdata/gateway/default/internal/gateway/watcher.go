@@ -0,0 +1,58 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// reloadDelay groups the events of a single save: editors and kubectl write,
+// rename and chmod the file in several steps
+const reloadDelay = 200 * time.Millisecond
+
+// WatchFile calls reload whenever the file at path changes, until ctx is cancelled.
+// It watches the directory of the file, so that files replaced rather than written,
+// as ConfigMaps mounted in Kubernetes pods are, keep being watched
+func WatchFile(ctx context.Context, path string, reload func()) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to watch the route table: %w", err)
+	}
+	defer watcher.Close()
+
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		return fmt.Errorf("failed to watch the route table: %w", err)
+	}
+
+	timer := time.NewTimer(reloadDelay)
+	timer.Stop()
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			return fmt.Errorf("failed to watch the route table: %w", err)
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			// Kubernetes swaps the ..data symlink of the ConfigMap directory
+			if filepath.Clean(event.Name) == path || filepath.Base(event.Name) == "..data" {
+				timer.Reset(reloadDelay)
+			}
+		case <-timer.C:
+			reload()
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package gateway
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "gateway.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("routes: []"), 0o644))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reloads := make(chan struct{}, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- WatchFile(ctx, path, func() { reloads <- struct{}{} })
+	}()
+	// Let the watcher start
+	time.Sleep(100 * time.Millisecond)
+
+	// Other files of the directory are ignored
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "other.yaml"), []byte("x"), 0o644))
+
+	// A file replaced by a rename, as editors save, is reloaded once
+	tmp := filepath.Join(dir, ".gateway.yaml.tmp")
+	require.NoError(t, os.WriteFile(tmp, []byte("routes: [{}]"), 0o644))
+	require.NoError(t, os.Rename(tmp, path))
+	require.NoError(t, os.WriteFile(path, []byte("routes: [{}, {}]"), 0o644))
+
+	select {
+	case <-reloads:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the change was not noticed")
+	}
+	time.Sleep(2 * reloadDelay)
+	assert.Empty(t, reloads, "the events of a save are grouped")
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the watcher did not stop")
+	}
+}
@@ -0,0 +1,45 @@
+package logger
+
+import (
+	"io"
+	"os"
+	"strings"
+)
+
+// Config represents logger configuration
+type Config struct {
+	Level  string
+	Format string
+}
+
+// Factory creates loggers based on configuration
+type Factory struct{}
+
+// NewFactory creates a new logger factory
+func NewFactory() *Factory {
+	return &Factory{}
+}
+
+// Create creates the slog logger with the given level and format
+func (f *Factory) Create(level, format string) (Logger, error) {
+	return f.CreateWithOutput(Config{Level: level, Format: format}, os.Stdout)
+}
+
+// CreateWithOutput creates the slog logger writing to output
+func (f *Factory) CreateWithOutput(config Config, output io.Writer) (Logger, error) {
+	return NewSlogLogger(parseLevel(config.Level), config.Format, output)
+}
+
+// parseLevel normalizes a level name to one every logger understands
+func parseLevel(level string) string {
+	switch strings.ToLower(level) {
+	case "debug":
+		return "debug"
+	case "warn", "warning":
+		return "warn"
+	case "error", "fatal", "panic":
+		return "error"
+	default:
+		return "info"
+	}
+}
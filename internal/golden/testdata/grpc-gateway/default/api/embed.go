@@ -0,0 +1,11 @@
+// Package api holds the OpenAPI document of the REST gateway. make generate writes
+// openapi.swagger.json from the google.api.http and openapiv2 annotations of proto/,
+// so the document always describes the routes the gateway serves
+package api
+
+import _ "embed"
+
+// OpenAPI is the merged OpenAPI v2 document of every service, served at /openapi.json
+//
+//go:embed openapi.swagger.json
+var OpenAPI []byte
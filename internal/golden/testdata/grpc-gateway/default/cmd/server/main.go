@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/middleware"
+	"github.com/example/golden/internal/repository"
+	"github.com/example/golden/internal/server"
+	"github.com/example/golden/internal/services"
+)
+
+func main() {
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	// Initialize logger
+	loggerFactory := logger.NewFactory()
+	appLogger, err := loggerFactory.Create(cfg.Logger.Type, cfg.Logger.Level, cfg.Logger.Format)
+	if err != nil {
+		log.Fatalf("Failed to create logger: %v", err)
+	}
+	userRepo := repository.NewInMemoryUserRepository(appLogger)
+
+	// Initialize services
+	userService := services.NewUserService(userRepo, appLogger)
+	healthService := services.NewHealthService(appLogger)
+	chain := middleware.NewChain(appLogger)
+
+	srv, err := server.New(cfg, appLogger, server.Services{
+		User:   userService,
+		Health: healthService,
+	}, chain)
+	if err != nil {
+		appLogger.Fatal("Failed to create server", "error", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.Serve()
+	}()
+
+	appLogger.Info("golden started",
+		"grpc_address", srv.GRPCAddr(),
+		"http_address", srv.HTTPAddr(),
+	)
+
+	// A signal and the failure of either transport take the same shutdown path
+	select {
+	case err := <-serveErr:
+		appLogger.Error("Server stopped unexpectedly", "error", err)
+	case <-ctx.Done():
+		appLogger.Info("Received interrupt signal, shutting down...", "timeout", cfg.Server.ShutdownTimeout.String())
+	}
+
+	srv.Shutdown(cfg.Server.ShutdownTimeout)
+	appLogger.Info("golden stopped")
+}
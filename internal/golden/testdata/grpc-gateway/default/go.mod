@@ -0,0 +1,14 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	google.golang.org/grpc v1.58.3
+	google.golang.org/protobuf v1.31.0
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.18.1
+	google.golang.org/genproto/googleapis/api v0.0.0-20231030173426-d783a09b4405
+	github.com/gin-gonic/gin v1.9.1
+	github.com/spf13/viper v1.17.0
+	github.com/go-playground/validator/v10 v10.15.5
+	github.com/stretchr/testify v1.8.4
+)
\ No newline at end of file
@@ -0,0 +1,172 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Config represents the application configuration
+type Config struct {
+	Environment string       `mapstructure:"environment"`
+	Server      ServerConfig `mapstructure:"server"`
+	Logger      LoggerConfig `mapstructure:"logger"`
+}
+
+// ServerConfig contains server-related configuration
+type ServerConfig struct {
+	HTTPPort        int           `mapstructure:"http_port"`
+	GRPCPort        int           `mapstructure:"grpc_port"`
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout"`
+	TLS             TLSConfig     `mapstructure:"tls"`
+}
+
+// TLSConfig contains TLS-related configuration
+type TLSConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	CertFile   string `mapstructure:"cert_file"`
+	KeyFile    string `mapstructure:"key_file"`
+	CAFile     string `mapstructure:"ca_file"`
+	MinVersion string `mapstructure:"min_version"`
+	ServerName string `mapstructure:"server_name"`
+}
+
+// LoggerConfig contains logger-related configuration
+type LoggerConfig struct {
+	Level  string `mapstructure:"level"`
+	Format string `mapstructure:"format"`
+	Type   string `mapstructure:"type"`
+}
+
+// Load loads the configuration from files and environment variables
+func Load() (*Config, error) {
+	// Set default values
+	setDefaults()
+
+	// Configure viper
+	viper.SetConfigName("config")
+	viper.SetConfigType("yaml")
+
+	// Add config paths
+	viper.AddConfigPath("./configs")
+	viper.AddConfigPath("./config")
+	viper.AddConfigPath(".")
+
+	// Handle environment-specific configs
+	env := os.Getenv("ENVIRONMENT")
+	if env == "" {
+		env = "development"
+	}
+
+	// Try to read environment-specific config first
+	viper.SetConfigName(fmt.Sprintf("config.%s", env))
+	if err := viper.ReadInConfig(); err != nil {
+		// Fall back to generic config
+		viper.SetConfigName("config")
+		if err := viper.ReadInConfig(); err != nil {
+			// If no config file is found, use defaults and environment variables
+			if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+				return nil, fmt.Errorf("error reading config file: %w", err)
+			}
+		}
+	}
+
+	// Enable environment variable support
+	viper.AutomaticEnv()
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+
+	var config Config
+	if err := viper.Unmarshal(&config); err != nil {
+		return nil, fmt.Errorf("error unmarshaling config: %w", err)
+	}
+
+	// Set the environment from env var if not set in config
+	if config.Environment == "" {
+		config.Environment = env
+	}
+
+	// Validate configuration
+	if err := validateConfig(&config); err != nil {
+		return nil, fmt.Errorf("config validation failed: %w", err)
+	}
+
+	return &config, nil
+}
+
+// setDefaults sets default configuration values
+func setDefaults() {
+	// Server defaults
+	viper.SetDefault("server.http_port", 8080)
+	viper.SetDefault("server.grpc_port", 50051)
+	viper.SetDefault("server.shutdown_timeout", "15s")
+
+	// TLS defaults
+	viper.SetDefault("server.tls.enabled", true)
+	viper.SetDefault("server.tls.cert_file", "./certs/server.crt")
+	viper.SetDefault("server.tls.key_file", "./certs/server.key")
+	viper.SetDefault("server.tls.ca_file", "./certs/ca.crt")
+	viper.SetDefault("server.tls.min_version", "1.3")
+	viper.SetDefault("server.tls.server_name", "localhost")
+
+	// Logger defaults
+	viper.SetDefault("logger.level", "info")
+	viper.SetDefault("logger.format", "json")
+	viper.SetDefault("logger.type", "slog")
+
+	// Environment default
+	viper.SetDefault("environment", "development")
+}
+
+// validateConfig validates the loaded configuration
+func validateConfig(config *Config) error {
+	if config.Server.HTTPPort <= 0 || config.Server.HTTPPort > 65535 {
+		return fmt.Errorf("invalid HTTP port: %d", config.Server.HTTPPort)
+	}
+
+	if config.Server.GRPCPort <= 0 || config.Server.GRPCPort > 65535 {
+		return fmt.Errorf("invalid gRPC port: %d", config.Server.GRPCPort)
+	}
+
+	if config.Server.HTTPPort == config.Server.GRPCPort {
+		return fmt.Errorf("HTTP and gRPC ports cannot be the same")
+	}
+
+	// Validate TLS configuration
+	if config.Server.TLS.Enabled {
+		if config.Server.TLS.CertFile == "" {
+			return fmt.Errorf("TLS cert file is required when TLS is enabled")
+		}
+		if config.Server.TLS.KeyFile == "" {
+			return fmt.Errorf("TLS key file is required when TLS is enabled")
+		}
+		validTLSVersions := []string{"1.2", "1.3"}
+		if !contains(validTLSVersions, config.Server.TLS.MinVersion) {
+			return fmt.Errorf("invalid TLS min version: %s, must be 1.2 or 1.3", config.Server.TLS.MinVersion)
+		}
+	}
+
+	validLogLevels := []string{"debug", "info", "warn", "error"}
+	if !contains(validLogLevels, config.Logger.Level) {
+		return fmt.Errorf("invalid log level: %s", config.Logger.Level)
+	}
+
+	validLogFormats := []string{"json", "text", "console"}
+	if !contains(validLogFormats, config.Logger.Format) {
+		return fmt.Errorf("invalid log format: %s", config.Logger.Format)
+	}
+
+	return nil
+}
+
+// contains checks if a slice contains a string
+func contains(slice []string, item string) bool {
+	for _, s := range slice {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// Chain is the middleware both transports share. It runs as gRPC interceptors, and REST
+// requests go through it as well because the gateway forwards every call to the gRPC
+// server, so request IDs, logging, error mapping behave the same
+// whichever way a client calls the API
+type Chain struct {
+	log logger.Logger
+}
+
+// NewChain creates the shared middleware
+func NewChain(log logger.Logger) *Chain {
+	return &Chain{
+		log: log,
+	}
+}
+
+// Unary returns the interceptors of unary calls, in order: panic recovery, request ID,
+// logging (so that rejected calls are logged too), error mapping
+func (c *Chain) Unary() []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
+		RecoveryInterceptor(c.log),
+		GRPCRequestIDInterceptor(c.log),
+		LoggingInterceptor(c.log),
+		GRPCErrorInterceptor(c.log),
+	}
+}
+
+// Stream returns the interceptors of streaming calls, in the order of Unary
+func (c *Chain) Stream() []grpc.StreamServerInterceptor {
+	return []grpc.StreamServerInterceptor{
+		StreamRecoveryInterceptor(c.log),
+		StreamLoggingInterceptor(c.log),
+	}
+}
+
+// IncomingHeaderMatcher forwards the X-Request-ID header of REST requests to the gRPC
+// metadata, next to the headers the gateway forwards by default such as Authorization
+func IncomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, RequestIDHeader) {
+		return strings.ToLower(RequestIDHeader), true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// OutgoingHeaderMatcher returns the request ID the gRPC server sends back as a plain
+// X-Request-ID response header, and the rest of its metadata with the Grpc-Metadata- prefix
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, RequestIDHeader) {
+		return RequestIDHeader, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
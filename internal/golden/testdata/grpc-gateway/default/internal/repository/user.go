@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/example/golden/internal/logger"
+)
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+	ErrUserExists   = errors.New("user already exists")
+)
+
+// User represents a user entity in the repository layer
+type User struct {
+	ID        string    ` json:"id"`
+	Name      string    ` json:"name"`
+	Email     string    ` json:"email"`
+	CreatedAt time.Time ` json:"created_at"`
+	UpdatedAt time.Time ` json:"updated_at"`
+}
+
+// UserRepository defines the interface for user data access
+type UserRepository interface {
+	Create(ctx context.Context, user *User) (*User, error)
+	GetByID(ctx context.Context, id string) (*User, error)
+	GetByEmail(ctx context.Context, email string) (*User, error)
+	Update(ctx context.Context, user *User) (*User, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, pageSize int, pageToken string) ([]*User, string, int, error)
+}
+
+// InMemoryUserRepository implements UserRepository using in-memory storage
+type InMemoryUserRepository struct {
+	users  map[string]*User
+	emails map[string]string // email -> id mapping
+	mu     sync.RWMutex
+	logger logger.Logger
+}
+
+// NewInMemoryUserRepository creates a new in-memory user repository
+func NewInMemoryUserRepository(logger logger.Logger) UserRepository {
+	return &InMemoryUserRepository{
+		users:  make(map[string]*User),
+		emails: make(map[string]string),
+		logger: logger,
+	}
+}
+
+// Create creates a new user
+func (r *InMemoryUserRepository) Create(ctx context.Context, user *User) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Check if email already exists
+	if _, exists := r.emails[user.Email]; exists {
+		return nil, ErrUserExists
+	}
+
+	user.ID = generateID()
+	user.CreatedAt = time.Now()
+	user.UpdatedAt = time.Now()
+
+	r.users[user.ID] = user
+	r.emails[user.Email] = user.ID
+
+	return user, nil
+}
+
+// GetByID retrieves a user by ID
+func (r *InMemoryUserRepository) GetByID(ctx context.Context, id string) (*User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, exists := r.users[id]
+	if !exists {
+		return nil, ErrUserNotFound
+	}
+
+	// Return a copy to prevent external modification
+	userCopy := *user
+	return &userCopy, nil
+}
+
+// GetByEmail retrieves a user by email
+func (r *InMemoryUserRepository) GetByEmail(ctx context.Context, email string) (*User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	userID, exists := r.emails[email]
+	if !exists {
+		return nil, ErrUserNotFound
+	}
+
+	user := r.users[userID]
+	userCopy := *user
+	return &userCopy, nil
+}
+
+// Update updates an existing user
+func (r *InMemoryUserRepository) Update(ctx context.Context, user *User) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existingUser, exists := r.users[user.ID]
+	if !exists {
+		return nil, ErrUserNotFound
+	}
+
+	// Check if email is being changed and if it conflicts
+	if user.Email != "" && user.Email != existingUser.Email {
+		if _, emailExists := r.emails[user.Email]; emailExists {
+			return nil, ErrUserExists
+		}
+		// Remove old email mapping and add new one
+		delete(r.emails, existingUser.Email)
+		r.emails[user.Email] = user.ID
+	}
+
+	// Update fields
+	if user.Name != "" {
+		existingUser.Name = user.Name
+	}
+	if user.Email != "" {
+		existingUser.Email = user.Email
+	}
+	existingUser.UpdatedAt = time.Now()
+
+	userCopy := *existingUser
+	return &userCopy, nil
+}
+
+// Delete deletes a user by ID
+func (r *InMemoryUserRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, exists := r.users[id]
+	if !exists {
+		return ErrUserNotFound
+	}
+
+	delete(r.users, id)
+	delete(r.emails, user.Email)
+
+	return nil
+}
+
+// List retrieves a list of users with pagination
+func (r *InMemoryUserRepository) List(ctx context.Context, pageSize int, pageToken string) ([]*User, string, int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	var users []*User
+	startFound := pageToken == ""
+
+	for _, user := range r.users {
+		if !startFound {
+			if user.ID == pageToken {
+				startFound = true
+			}
+			continue
+		}
+
+		if len(users) >= pageSize+1 {
+			break
+		}
+
+		userCopy := *user
+		users = append(users, &userCopy)
+	}
+
+	var nextToken string
+	if len(users) > pageSize {
+		nextToken = users[pageSize-1].ID
+		users = users[:pageSize]
+	}
+
+	return users, nextToken, len(r.users), nil
+}
+
+// generateID generates a unique ID for users
+func generateID() string {
+	return uuid.New().String()
+}
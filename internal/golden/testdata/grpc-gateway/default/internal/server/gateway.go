@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/example/golden/api"
+	healthv1 "github.com/example/golden/gen/health/v1"
+	userv1 "github.com/example/golden/gen/user/v1"
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/middleware"
+	"github.com/example/golden/internal/tls"
+)
+
+// NewGateway returns the REST facade of the gRPC services. Each request is translated,
+// following the google.api.http annotations of proto/, into a call to the gRPC server at
+// endpoint, so it goes through the same interceptors as gRPC clients. The OpenAPI document
+// generated from the same annotations is served at /openapi.json. The connections to
+// endpoint close when ctx is cancelled
+func NewGateway(ctx context.Context, cfg *config.Config, log logger.Logger, endpoint string) (http.Handler, error) {
+	gateway := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{
+			Marshaler: &runtime.JSONPb{
+				MarshalOptions: protojson.MarshalOptions{
+					UseProtoNames:   true,
+					EmitUnpopulated: true,
+				},
+				UnmarshalOptions: protojson.UnmarshalOptions{
+					DiscardUnknown: true,
+				},
+			},
+		}),
+		runtime.WithIncomingHeaderMatcher(middleware.IncomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(middleware.OutgoingHeaderMatcher),
+	)
+
+	opts, err := tls.GetGRPCDialOptions(cfg.Server.TLS, log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC dial options: %w", err)
+	}
+
+	if err := userv1.RegisterUserServiceHandlerFromEndpoint(ctx, gateway, endpoint, opts); err != nil {
+		return nil, fmt.Errorf("failed to register user service handler: %w", err)
+	}
+	if err := healthv1.RegisterHealthServiceHandlerFromEndpoint(ctx, gateway, endpoint, opts); err != nil {
+		return nil, fmt.Errorf("failed to register health service handler: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", gateway)
+	mux.HandleFunc("/openapi.json", serveOpenAPI)
+
+	return middleware.GatewayHeaders(mux), nil
+}
+
+// serveOpenAPI writes the OpenAPI document of the REST API
+func serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(api.OpenAPI)
+}
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"context"
+	cryptotls "crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
+
+	healthv1 "github.com/example/golden/gen/health/v1"
+	userv1 "github.com/example/golden/gen/user/v1"
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/middleware"
+	"github.com/example/golden/internal/services"
+	"github.com/example/golden/internal/tls"
+)
+
+// Services are the implementations the server exposes over both transports
+type Services struct {
+	User   *services.UserService
+	Health *services.HealthService
+}
+
+// Server serves the API over gRPC and, through the gateway, as REST. Both transports
+// share the interceptors of the middleware chain, and Shutdown stops them together
+type Server struct {
+	log    logger.Logger
+	health *services.HealthService
+
+	grpc         *grpc.Server
+	grpcListener net.Listener
+
+	http         *http.Server
+	httpListener net.Listener
+	closeGateway context.CancelFunc
+}
+
+// New listens on the gRPC and HTTP ports of cfg, registers the services with the gRPC
+// server and connects the gateway to it
+func New(cfg *config.Config, log logger.Logger, svc Services, chain *middleware.Chain) (*Server, error) {
+	if err := tls.ValidateTLSConfig(cfg.Server.TLS, log); err != nil {
+		return nil, fmt.Errorf("TLS configuration validation failed: %w", err)
+	}
+	tls.IsInsecureConnectionWarning(cfg.Server.TLS.Enabled, log)
+
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(chain.Unary()...),
+		grpc.ChainStreamInterceptor(chain.Stream()...),
+	}
+	var httpTLS *cryptotls.Config
+	if cfg.Server.TLS.Enabled {
+		tlsConfig, err := tls.LoadServerTLSConfig(cfg.Server.TLS, log)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS configuration: %w", err)
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+
+		// REST clients authenticate with tokens, so the gateway accepts them without a
+		// client certificate even when the gRPC port requires one
+		httpTLS = tlsConfig.Clone()
+		if httpTLS.ClientAuth == cryptotls.RequireAndVerifyClientCert {
+			httpTLS.ClientAuth = cryptotls.VerifyClientCertIfGiven
+		}
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
+	userv1.RegisterUserServiceServer(grpcServer, NewUserGRPCServer(svc.User))
+	healthv1.RegisterHealthServiceServer(grpcServer, NewHealthGRPCServer(svc.Health))
+	if cfg.Environment == "development" {
+		reflection.Register(grpcServer)
+	}
+
+	grpcListener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.GRPCPort))
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on gRPC port %d: %w", cfg.Server.GRPCPort, err)
+	}
+	httpListener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.HTTPPort))
+	if err != nil {
+		grpcListener.Close()
+		return nil, fmt.Errorf("failed to listen on HTTP port %d: %w", cfg.Server.HTTPPort, err)
+	}
+
+	// The gateway dials the port the gRPC server actually listens on, so port 0 works in tests
+	gatewayCtx, closeGateway := context.WithCancel(context.Background())
+	endpoint := fmt.Sprintf("localhost:%d", grpcListener.Addr().(*net.TCPAddr).Port)
+	gateway, err := NewGateway(gatewayCtx, cfg, log, endpoint)
+	if err != nil {
+		closeGateway()
+		grpcListener.Close()
+		httpListener.Close()
+		return nil, err
+	}
+
+	return &Server{
+		log:          log,
+		health:       svc.Health,
+		grpc:         grpcServer,
+		grpcListener: grpcListener,
+		http: &http.Server{
+			Handler:           gateway,
+			TLSConfig:         httpTLS,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+		httpListener: httpListener,
+		closeGateway: closeGateway,
+	}, nil
+}
+
+// GRPCAddr returns the address the gRPC server listens on
+func (s *Server) GRPCAddr() string {
+	return s.grpcListener.Addr().String()
+}
+
+// HTTPAddr returns the address the REST gateway listens on
+func (s *Server) HTTPAddr() string {
+	return s.httpListener.Addr().String()
+}
+
+// Serve serves both transports until Shutdown is called, returning nil, or until one of
+// them fails, returning its error. Call Shutdown in either case to stop the other one
+func (s *Server) Serve() error {
+	errs := make(chan error, 2)
+
+	go func() {
+		s.log.Info("gRPC server listening", "address", s.GRPCAddr())
+		if err := s.grpc.Serve(s.grpcListener); err != nil {
+			errs <- fmt.Errorf("gRPC server failed: %w", err)
+			return
+		}
+		errs <- nil
+	}()
+
+	go func() {
+		s.log.Info("REST gateway listening", "address", s.HTTPAddr())
+		serve := s.http.Serve
+		if s.http.TLSConfig != nil {
+			serve = func(lis net.Listener) error { return s.http.ServeTLS(lis, "", "") }
+		}
+		if err := serve(s.httpListener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errs <- fmt.Errorf("REST gateway failed: %w", err)
+			return
+		}
+		errs <- nil
+	}()
+
+	return <-errs
+}
+
+// Shutdown stops both transports within timeout. Readiness checks fail first, then the
+// gateway stops accepting REST requests and waits for those in flight, which still need
+// the gRPC server, and only then the gRPC server drains its own calls. Connections still
+// open when timeout expires are closed
+func (s *Server) Shutdown(timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	s.health.Drain()
+
+	if err := s.http.Shutdown(ctx); err != nil {
+		s.log.Warn("REST gateway shutdown timed out, closing open connections", "error", err)
+		s.http.Close()
+	}
+	s.closeGateway()
+
+	done := make(chan struct{})
+	go func() {
+		s.grpc.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		s.log.Warn("gRPC graceful stop timed out, closing open connections", "timeout", timeout.String())
+		s.grpc.Stop()
+	}
+}
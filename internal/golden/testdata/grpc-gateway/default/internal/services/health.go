@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// HealthStatus represents the health status of a service
+type HealthStatus string
+
+const (
+	HealthStatusServing    HealthStatus = "SERVING"
+	HealthStatusNotServing HealthStatus = "NOT_SERVING"
+	HealthStatusUnknown    HealthStatus = "UNKNOWN"
+)
+
+// HealthCheckResult represents the result of a health check
+type HealthCheckResult struct {
+	Status    HealthStatus      `json:"status"`
+	Message   string            `json:"message"`
+	Timestamp time.Time         `json:"timestamp"`
+	Details   map[string]string `json:"details,omitempty"`
+}
+
+// ServiceStatus represents the status of an individual service component
+type ServiceStatus struct {
+	Name      string       `json:"name"`
+	Status    HealthStatus `json:"status"`
+	Message   string       `json:"message"`
+	LastCheck time.Time    `json:"last_check"`
+}
+
+// ReadinessCheckResult represents the result of a readiness check
+type ReadinessCheckResult struct {
+	Status    HealthStatus     `json:"status"`
+	Message   string           `json:"message"`
+	Timestamp time.Time        `json:"timestamp"`
+	Services  []*ServiceStatus `json:"services"`
+}
+
+// LivenessCheckResult represents the result of a liveness check
+type LivenessCheckResult struct {
+	Status    HealthStatus `json:"status"`
+	Message   string       `json:"message"`
+	Timestamp time.Time    `json:"timestamp"`
+}
+
+// HealthService provides health check operations
+type HealthService struct {
+	logger   logger.Logger
+	draining atomic.Bool
+}
+
+// NewHealthService creates a new HealthService
+func NewHealthService(logger logger.Logger) *HealthService {
+	return &HealthService{
+		logger: logger,
+	}
+}
+
+// Check performs a basic health check
+func (s *HealthService) Check(ctx context.Context) *HealthCheckResult {
+	timestamp := time.Now()
+
+	result := &HealthCheckResult{
+		Status:    HealthStatusServing,
+		Message:   "Service is healthy",
+		Timestamp: timestamp,
+		Details:   make(map[string]string),
+	}
+
+	// Basic service health - always healthy unless there's a critical issue
+	result.Details["service"] = "healthy"
+	result.Details["version"] = "1.0.0"
+	result.Details["uptime"] = timestamp.Format(time.RFC3339)
+
+	s.logger.Debug("Health check performed", "status", result.Status)
+
+	return result
+}
+
+// Drain makes readiness checks fail from now on, so load balancers stop sending
+// traffic while the server shuts down
+func (s *HealthService) Drain() {
+	s.draining.Store(true)
+}
+
+// ReadinessCheck performs a readiness check
+func (s *HealthService) ReadinessCheck(ctx context.Context) *ReadinessCheckResult {
+	timestamp := time.Now()
+
+	result := &ReadinessCheckResult{
+		Status:    HealthStatusServing,
+		Message:   "Service is ready",
+		Timestamp: timestamp,
+		Services:  []*ServiceStatus{},
+	}
+
+	if s.draining.Load() {
+		result.Status = HealthStatusNotServing
+		result.Message = "Service is shutting down"
+		return result
+	}
+
+	overallHealthy := true
+
+	// Check other dependencies here
+	// Example: external APIs, message queues, etc.
+
+	if !overallHealthy {
+		result.Status = HealthStatusNotServing
+		result.Message = "One or more dependencies are not ready"
+	}
+
+	s.logger.Debug("Readiness check performed",
+		"status", result.Status,
+		"services_count", len(result.Services))
+
+	return result
+}
+
+// LivenessCheck performs a liveness check
+func (s *HealthService) LivenessCheck(ctx context.Context) *LivenessCheckResult {
+	timestamp := time.Now()
+
+	// Liveness checks should be lightweight and only check if the service is alive
+	// They should not check external dependencies
+	result := &LivenessCheckResult{
+		Status:    HealthStatusServing,
+		Message:   "Service is alive",
+		Timestamp: timestamp,
+	}
+
+	// Add any critical liveness checks here
+	// For example: check if critical goroutines are running,
+	// memory usage is not excessive, etc.
+
+	s.logger.Debug("Liveness check performed", "status", result.Status)
+
+	return result
+}
@@ -0,0 +1,197 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/middleware"
+	"github.com/example/golden/internal/repository"
+	"github.com/example/golden/internal/server"
+	"github.com/example/golden/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testServer is a server listening on random ports, shut down with the test
+type testServer struct {
+	*server.Server
+	baseURL  string
+	serveErr chan error
+}
+
+// startServer serves both transports without TLS, as the gateway and the gRPC clients of
+// the tests would otherwise need the development certificates
+func startServer(t *testing.T) *testServer {
+	t.Helper()
+
+	cfg := &config.Config{
+		Environment: "test",
+		Server: config.ServerConfig{
+			ShutdownTimeout: 5 * time.Second,
+		},
+	}
+
+	log, err := logger.NewFactory().Create("slog", "error", "json")
+	require.NoError(t, err)
+
+	repo := repository.NewInMemoryUserRepository(log)
+	svc := server.Services{
+		User:   services.NewUserService(repo, log),
+		Health: services.NewHealthService(log),
+	}
+	chain := middleware.NewChain(log)
+
+	srv, err := server.New(cfg, log, svc, chain)
+	require.NoError(t, err)
+
+	ts := &testServer{
+		Server:   srv,
+		baseURL:  "http://" + srv.HTTPAddr(),
+		serveErr: make(chan error, 1),
+	}
+
+	go func() {
+		ts.serveErr <- srv.Serve()
+	}()
+	t.Cleanup(func() {
+		srv.Shutdown(5 * time.Second)
+	})
+	return ts
+}
+
+// do sends a REST request to the gateway
+func (ts *testServer) do(t *testing.T, method, path string, body any) *http.Response {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequest(method, ts.baseURL+path, reader)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestGatewayHealthEndpoint(t *testing.T) {
+	ts := startServer(t)
+
+	resp, err := http.Get(ts.baseURL + "/health")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.NotEmpty(t, resp.Header.Get("X-Request-ID"), "the request ID interceptor runs for REST calls")
+
+	var result map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(t, "SERVING", result["status"])
+}
+
+func TestGatewayForwardsRequestID(t *testing.T) {
+	ts := startServer(t)
+
+	req, err := http.NewRequest(http.MethodGet, ts.baseURL+"/live", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Request-ID", "req-123")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, "req-123", resp.Header.Get("X-Request-ID"))
+}
+
+func TestGatewayUserEndpoints(t *testing.T) {
+	ts := startServer(t)
+
+	create := ts.do(t, http.MethodPost, "/api/v1/users", map[string]string{
+		"name":  "Test User",
+		"email": "test@example.com",
+	})
+	require.Equal(t, http.StatusOK, create.StatusCode)
+
+	var created struct {
+		User map[string]interface{} `json:"user"`
+	}
+	require.NoError(t, json.NewDecoder(create.Body).Decode(&created))
+	userID, _ := created.User["id"].(string)
+	require.NotEmpty(t, userID)
+
+	get := ts.do(t, http.MethodGet, "/api/v1/users/"+userID, nil)
+	assert.Equal(t, http.StatusOK, get.StatusCode)
+
+	list := ts.do(t, http.MethodGet, "/api/v1/users?page_size=10", nil)
+	assert.Equal(t, http.StatusOK, list.StatusCode)
+
+	missing := ts.do(t, http.MethodGet, "/api/v1/users/does-not-exist", nil)
+	assert.Equal(t, http.StatusNotFound, missing.StatusCode, "gRPC status codes map to HTTP statuses")
+}
+
+func TestGatewayServesOpenAPI(t *testing.T) {
+	ts := startServer(t)
+
+	resp, err := http.Get(ts.baseURL + "/openapi.json")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var doc struct {
+		Swagger string                 `json:"swagger"`
+		Paths   map[string]interface{} `json:"paths"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&doc))
+	assert.Equal(t, "2.0", doc.Swagger)
+	assert.Contains(t, doc.Paths, "/api/v1/users")
+	assert.Contains(t, doc.Paths, "/api/v1/users/{user_id}")
+}
+
+func TestGatewayCORS(t *testing.T) {
+	ts := startServer(t)
+
+	req, err := http.NewRequest(http.MethodOptions, ts.baseURL+"/health", nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", "http://example.com")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "*", resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Contains(t, resp.Header.Get("Access-Control-Allow-Methods"), "GET")
+	assert.Contains(t, resp.Header.Get("Access-Control-Allow-Methods"), "POST")
+}
+
+func TestShutdownStopsBothTransports(t *testing.T) {
+	ts := startServer(t)
+
+	ts.Shutdown(5 * time.Second)
+
+	select {
+	case err := <-ts.serveErr:
+		assert.NoError(t, err, "Serve returns nil once Shutdown stopped it")
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return after Shutdown")
+	}
+
+	for _, addr := range []string{ts.GRPCAddr(), ts.HTTPAddr()} {
+		_, err := net.DialTimeout("tcp", addr, time.Second)
+		assert.Error(t, err, "%s still accepts connections", addr)
+	}
+}
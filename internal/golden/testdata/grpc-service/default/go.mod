@@ -0,0 +1,9 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	google.golang.org/grpc v1.63.2
+	google.golang.org/protobuf v1.34.1
+	github.com/stretchr/testify v1.9.0
+)
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config holds the service configuration, read from the environment
+type Config struct {
+	// Port the gRPC server listens on (GRPC_PORT)
+	Port int
+	// LogLevel is one of debug, info, warn or error (LOG_LEVEL)
+	LogLevel string
+	// LogFormat is json or console (LOG_FORMAT)
+	LogFormat string
+	// AuthTokens are the bearer tokens accepted by the auth interceptor. Authentication
+	// is disabled when empty (AUTH_TOKENS, comma separated)
+	AuthTokens []string
+	// Reflection registers the server reflection service used by grpcurl (GRPC_REFLECTION)
+	Reflection bool
+	// MaxRecvMsgSize limits the size of incoming messages in bytes (GRPC_MAX_RECV_MSG_SIZE)
+	MaxRecvMsgSize int
+	// ShutdownTimeout bounds the graceful shutdown (SHUTDOWN_TIMEOUT)
+	ShutdownTimeout time.Duration
+}
+
+// Load reads the configuration from the environment, applying defaults
+func Load() (*Config, error) {
+	cfg := &Config{
+		Port:            50051,
+		LogLevel:        getEnv("LOG_LEVEL", "info"),
+		LogFormat:       getEnv("LOG_FORMAT", "json"),
+		AuthTokens:      splitList(os.Getenv("AUTH_TOKENS")),
+		Reflection:      true,
+		MaxRecvMsgSize:  4 << 20,
+		ShutdownTimeout: 15 * time.Second,
+	}
+
+	var err error
+	if cfg.Port, err = getEnvInt("GRPC_PORT", cfg.Port); err != nil {
+		return nil, err
+	}
+	if cfg.MaxRecvMsgSize, err = getEnvInt("GRPC_MAX_RECV_MSG_SIZE", cfg.MaxRecvMsgSize); err != nil {
+		return nil, err
+	}
+	if value := os.Getenv("GRPC_REFLECTION"); value != "" {
+		if cfg.Reflection, err = strconv.ParseBool(value); err != nil {
+			return nil, fmt.Errorf("invalid GRPC_REFLECTION %q: %w", value, err)
+		}
+	}
+	if value := os.Getenv("SHUTDOWN_TIMEOUT"); value != "" {
+		if cfg.ShutdownTimeout, err = time.ParseDuration(value); err != nil {
+			return nil, fmt.Errorf("invalid SHUTDOWN_TIMEOUT %q: %w", value, err)
+		}
+	}
+
+	if cfg.Port < 1 || cfg.Port > 65535 {
+		return nil, fmt.Errorf("invalid GRPC_PORT %d: must be between 1 and 65535", cfg.Port)
+	}
+	return cfg, nil
+}
+
+// Address returns the address the server listens on
+func (c *Config) Address() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	return n, nil
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_Defaults(t *testing.T) {
+	for _, key := range []string{"GRPC_PORT", "LOG_LEVEL", "LOG_FORMAT", "AUTH_TOKENS", "GRPC_REFLECTION", "GRPC_MAX_RECV_MSG_SIZE", "SHUTDOWN_TIMEOUT"} {
+		t.Setenv(key, "")
+	}
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, 50051, cfg.Port)
+	assert.Equal(t, "info", cfg.LogLevel)
+	assert.Empty(t, cfg.AuthTokens)
+	assert.True(t, cfg.Reflection)
+	assert.Equal(t, 15*time.Second, cfg.ShutdownTimeout)
+}
+
+func TestLoad_FromEnvironment(t *testing.T) {
+	t.Setenv("GRPC_PORT", "9090")
+	t.Setenv("AUTH_TOKENS", "first, second,")
+	t.Setenv("GRPC_REFLECTION", "false")
+	t.Setenv("SHUTDOWN_TIMEOUT", "3s")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, ":9090", cfg.Address())
+	assert.Equal(t, []string{"first", "second"}, cfg.AuthTokens)
+	assert.False(t, cfg.Reflection)
+	assert.Equal(t, 3*time.Second, cfg.ShutdownTimeout)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	t.Setenv("GRPC_PORT", "70000")
+	_, err := Load()
+	assert.Error(t, err)
+
+	t.Setenv("GRPC_PORT", "not-a-port")
+	_, err = Load()
+	assert.Error(t, err)
+}
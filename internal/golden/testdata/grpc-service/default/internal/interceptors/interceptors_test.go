@@ -0,0 +1,123 @@
+package interceptors
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/example/golden/internal/logger"
+)
+
+var unaryInfo = &grpc.UnaryServerInfo{FullMethod: "/item.v1.ItemService/GetItem"}
+
+func newTestLogger(t *testing.T) (logger.Logger, *bytes.Buffer) {
+	t.Helper()
+	var buf bytes.Buffer
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: "debug", Format: "json"}, &buf)
+	require.NoError(t, err)
+	return log, &buf
+}
+
+func okHandler(ctx context.Context, req interface{}) (interface{}, error) {
+	return "ok", nil
+}
+
+func TestUnaryLogging(t *testing.T) {
+	log, buf := newTestLogger(t)
+	interceptor := UnaryLogging(log)
+
+	resp, err := interceptor(context.Background(), nil, unaryInfo, okHandler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+	assert.Contains(t, buf.String(), "/item.v1.ItemService/GetItem")
+
+	_, err = interceptor(context.Background(), nil, unaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "item not found")
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Contains(t, buf.String(), "NotFound")
+}
+
+func TestUnaryRecovery(t *testing.T) {
+	log, buf := newTestLogger(t)
+	interceptor := UnaryRecovery(log)
+
+	_, err := interceptor(context.Background(), nil, unaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		panic("boom")
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Contains(t, buf.String(), "boom")
+}
+
+func TestAuthenticator(t *testing.T) {
+	auth := NewAuthenticator([]string{"secret"}, "/item.v1.ItemService/ListItems").Unary()
+	withToken := func(value string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", value))
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		code   codes.Code
+	}{
+		{"valid token", withToken("Bearer secret"), unaryInfo.FullMethod, codes.OK},
+		{"missing token", context.Background(), unaryInfo.FullMethod, codes.Unauthenticated},
+		{"wrong token", withToken("Bearer guess"), unaryInfo.FullMethod, codes.Unauthenticated},
+		{"wrong scheme", withToken("Basic secret"), unaryInfo.FullMethod, codes.Unauthenticated},
+		{"public method", context.Background(), "/item.v1.ItemService/ListItems", codes.OK},
+		{"health checks", context.Background(), "/grpc.health.v1.Health/Check", codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := auth(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, okHandler)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestAuthenticator_Disabled(t *testing.T) {
+	auth := NewAuthenticator(nil).Unary()
+	_, err := auth(context.Background(), nil, unaryInfo, okHandler)
+	assert.NoError(t, err)
+}
+
+func TestStreamInterceptors(t *testing.T) {
+	log, _ := newTestLogger(t)
+	info := &grpc.StreamServerInfo{FullMethod: "/item.v1.ItemService/WatchItems", IsServerStream: true}
+	stream := &testStream{ctx: context.Background()}
+
+	err := StreamRecovery(log)(nil, stream, info, func(srv interface{}, stream grpc.ServerStream) error {
+		panic("boom")
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	err = StreamLogging(log)(nil, stream, info, func(srv interface{}, stream grpc.ServerStream) error {
+		return errors.New("stream failed")
+	})
+	assert.EqualError(t, err, "stream failed")
+
+	err = NewAuthenticator([]string{"secret"}).Stream()(nil, stream, info, func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// testStream is the minimal grpc.ServerStream the interceptors need
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,50 @@
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// UnaryLogging logs every unary call with its status code and duration
+func UnaryLogging(log logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logCall(log, ctx, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamLogging logs every streaming call with its status code and duration
+func StreamLogging(log logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, stream)
+		logCall(log, stream.Context(), info.FullMethod, start, err)
+		return err
+	}
+}
+
+func logCall(log logger.Logger, ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+	fields := []interface{}{
+		"method", method,
+		"code", code.String(),
+		"duration_ms", time.Since(start).Milliseconds(),
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		fields = append(fields, "peer", p.Addr.String())
+	}
+
+	if err != nil {
+		log.Error("gRPC call failed", append(fields, "error", err.Error())...)
+		return
+	}
+	log.Info("gRPC call completed", fields...)
+}
@@ -0,0 +1,47 @@
+package interceptors
+
+import (
+	"context"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// UnaryRecovery turns panics in unary handlers into Internal errors instead of
+// crashing the server
+func UnaryRecovery(log logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(log, info.FullMethod, r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecovery turns panics in streaming handlers into Internal errors
+func StreamRecovery(log logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(log, info.FullMethod, r)
+			}
+		}()
+		return handler(srv, stream)
+	}
+}
+
+// recovered logs the panic with its stack trace; clients only see a generic error
+func recovered(log logger.Logger, method string, r interface{}) error {
+	log.Error("panic in gRPC handler",
+		"method", method,
+		"panic", r,
+		"stack", string(debug.Stack()),
+	)
+	return status.Error(codes.Internal, "internal server error")
+}
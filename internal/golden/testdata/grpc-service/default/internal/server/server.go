@@ -0,0 +1,88 @@
+package server
+
+import (
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
+
+	itemv1 "github.com/example/golden/gen/item/v1"
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/interceptors"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/service"
+)
+
+// Server is the gRPC server with the item, health and reflection services registered
+type Server struct {
+	grpc   *grpc.Server
+	health *health.Server
+	log    logger.Logger
+}
+
+// New creates the server. Interceptors run in order: panic recovery, logging (so
+// that rejected calls are logged too) and authentication.
+func New(cfg *config.Config, log logger.Logger) *Server {
+	auth := interceptors.NewAuthenticator(cfg.AuthTokens)
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptors.UnaryRecovery(log),
+			interceptors.UnaryLogging(log),
+			auth.Unary(),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptors.StreamRecovery(log),
+			interceptors.StreamLogging(log),
+			auth.Stream(),
+		),
+		grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle: 5 * time.Minute,
+			Time:              2 * time.Minute,
+			Timeout:           20 * time.Second,
+		}),
+	)
+
+	itemv1.RegisterItemServiceServer(grpcServer, service.NewItemService())
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus(itemv1.ItemService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+
+	if cfg.Reflection {
+		reflection.Register(grpcServer)
+	}
+
+	return &Server{grpc: grpcServer, health: healthServer, log: log}
+}
+
+// Serve accepts connections on lis until Shutdown is called
+func (s *Server) Serve(lis net.Listener) error {
+	s.log.Info("gRPC server listening", "address", lis.Addr().String())
+	return s.grpc.Serve(lis)
+}
+
+// Shutdown reports NOT_SERVING to health checks, then waits up to timeout for
+// in-flight calls to finish before closing the remaining connections
+func (s *Server) Shutdown(timeout time.Duration) {
+	s.health.Shutdown()
+
+	done := make(chan struct{})
+	go func() {
+		s.grpc.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		s.log.Warn("graceful shutdown timed out, closing open connections", "timeout", timeout.String())
+		s.grpc.Stop()
+	}
+}
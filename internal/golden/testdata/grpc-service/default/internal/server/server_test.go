@@ -0,0 +1,90 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	itemv1 "github.com/example/golden/gen/item/v1"
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/logger"
+)
+
+// startServer runs the server on an in-memory listener and returns a client connection
+func startServer(t *testing.T, cfg *config.Config) *grpc.ClientConn {
+	t.Helper()
+
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: "error", Format: "json"}, &bytes.Buffer{})
+	require.NoError(t, err)
+
+	srv := New(cfg, log)
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(func() { srv.Shutdown(time.Second) })
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func testConfig() *config.Config {
+	return &config.Config{Port: 50051, MaxRecvMsgSize: 4 << 20, ShutdownTimeout: time.Second}
+}
+
+func TestServer_Health(t *testing.T) {
+	conn := startServer(t, testConfig())
+
+	resp, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{
+		Service: itemv1.ItemService_ServiceDesc.ServiceName,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+}
+
+func TestServer_ItemService(t *testing.T) {
+	conn := startServer(t, testConfig())
+	client := itemv1.NewItemServiceClient(conn)
+
+	created, err := client.CreateItem(context.Background(), &itemv1.CreateItemRequest{Name: "widget"})
+	require.NoError(t, err)
+
+	got, err := client.GetItem(context.Background(), &itemv1.GetItemRequest{Id: created.GetItem().GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, "widget", got.GetItem().GetName())
+}
+
+func TestServer_Authentication(t *testing.T) {
+	cfg := testConfig()
+	cfg.AuthTokens = []string{"secret"}
+	conn := startServer(t, cfg)
+	client := itemv1.NewItemServiceClient(conn)
+
+	_, err := client.ListItems(context.Background(), &itemv1.ListItemsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer secret")
+	_, err = client.ListItems(ctx, &itemv1.ListItemsRequest{})
+	assert.NoError(t, err)
+
+	// Health checks stay public for load balancers
+	_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.NoError(t, err)
+}
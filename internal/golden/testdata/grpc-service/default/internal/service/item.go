@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	itemv1 "github.com/example/golden/gen/item/v1"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	maxNameLength   = 200
+)
+
+// ItemService implements itemv1.ItemServiceServer with an in-memory store.
+// Replace the store with your database of choice.
+type ItemService struct {
+	itemv1.UnimplementedItemServiceServer
+
+	mu    sync.RWMutex
+	items map[string]*itemv1.Item
+	now   func() time.Time
+}
+
+// NewItemService creates an empty item service
+func NewItemService() *ItemService {
+	return &ItemService{
+		items: make(map[string]*itemv1.Item),
+		now:   time.Now,
+	}
+}
+
+// CreateItem stores a new item
+func (s *ItemService) CreateItem(ctx context.Context, req *itemv1.CreateItemRequest) (*itemv1.CreateItemResponse, error) {
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if len(name) > maxNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be at most %d characters", maxNameLength)
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate item id")
+	}
+
+	item := &itemv1.Item{
+		Id:          id,
+		Name:        name,
+		Description: req.GetDescription(),
+		CreateTime:  timestamppb.New(s.now()),
+	}
+
+	s.mu.Lock()
+	s.items[id] = item
+	s.mu.Unlock()
+
+	return &itemv1.CreateItemResponse{Item: item}, nil
+}
+
+// GetItem returns a single item
+func (s *ItemService) GetItem(ctx context.Context, req *itemv1.GetItemRequest) (*itemv1.GetItemResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	s.mu.RLock()
+	item, ok := s.items[req.GetId()]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "item %q not found", req.GetId())
+	}
+	return &itemv1.GetItemResponse{Item: item}, nil
+}
+
+// ListItems returns a page of items ordered by creation time
+func (s *ItemService) ListItems(ctx context.Context, req *itemv1.ListItemsRequest) (*itemv1.ListItemsResponse, error) {
+	pageSize := int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+
+	offset := 0
+	if token := req.GetPageToken(); token != "" {
+		var err error
+		if offset, err = strconv.Atoi(token); err != nil || offset < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+	}
+
+	s.mu.RLock()
+	items := make([]*itemv1.Item, 0, len(s.items))
+	for _, item := range s.items {
+		items = append(items, item)
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(items, func(i, j int) bool {
+		ti, tj := items[i].GetCreateTime().AsTime(), items[j].GetCreateTime().AsTime()
+		if ti.Equal(tj) {
+			return items[i].GetId() < items[j].GetId()
+		}
+		return ti.Before(tj)
+	})
+
+	if offset > len(items) {
+		offset = len(items)
+	}
+	end := offset + pageSize
+	if end > len(items) {
+		end = len(items)
+	}
+
+	resp := &itemv1.ListItemsResponse{Items: items[offset:end]}
+	if end < len(items) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
+// DeleteItem removes an item
+func (s *ItemService) DeleteItem(ctx context.Context, req *itemv1.DeleteItemRequest) (*itemv1.DeleteItemResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.items[req.GetId()]; !ok {
+		return nil, status.Errorf(codes.NotFound, "item %q not found", req.GetId())
+	}
+	delete(s.items, req.GetId())
+	return &itemv1.DeleteItemResponse{}, nil
+}
+
+// newID returns a random 128-bit identifier
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	itemv1 "github.com/example/golden/gen/item/v1"
+)
+
+func TestItemService_CreateAndGet(t *testing.T) {
+	svc := NewItemService()
+	ctx := context.Background()
+
+	created, err := svc.CreateItem(ctx, &itemv1.CreateItemRequest{Name: "first", Description: "the first item"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.GetItem().GetId())
+
+	got, err := svc.GetItem(ctx, &itemv1.GetItemRequest{Id: created.GetItem().GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, "first", got.GetItem().GetName())
+	assert.Equal(t, "the first item", got.GetItem().GetDescription())
+}
+
+func TestItemService_Validation(t *testing.T) {
+	svc := NewItemService()
+	ctx := context.Background()
+
+	_, err := svc.CreateItem(ctx, &itemv1.CreateItemRequest{Name: "  "})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = svc.GetItem(ctx, &itemv1.GetItemRequest{Id: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = svc.DeleteItem(ctx, &itemv1.DeleteItemRequest{Id: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = svc.ListItems(ctx, &itemv1.ListItemsRequest{PageToken: "bogus"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestItemService_ListPagination(t *testing.T) {
+	svc := NewItemService()
+	ctx := context.Background()
+
+	for _, name := range []string{"a", "b", "c"} {
+		_, err := svc.CreateItem(ctx, &itemv1.CreateItemRequest{Name: name})
+		require.NoError(t, err)
+	}
+
+	first, err := svc.ListItems(ctx, &itemv1.ListItemsRequest{PageSize: 2})
+	require.NoError(t, err)
+	assert.Len(t, first.GetItems(), 2)
+	require.NotEmpty(t, first.GetNextPageToken())
+
+	second, err := svc.ListItems(ctx, &itemv1.ListItemsRequest{PageSize: 2, PageToken: first.GetNextPageToken()})
+	require.NoError(t, err)
+	assert.Len(t, second.GetItems(), 1)
+	assert.Empty(t, second.GetNextPageToken())
+}
+
+func TestItemService_Delete(t *testing.T) {
+	svc := NewItemService()
+	ctx := context.Background()
+
+	created, err := svc.CreateItem(ctx, &itemv1.CreateItemRequest{Name: "temporary"})
+	require.NoError(t, err)
+
+	_, err = svc.DeleteItem(ctx, &itemv1.DeleteItemRequest{Id: created.GetItem().GetId()})
+	require.NoError(t, err)
+
+	_, err = svc.GetItem(ctx, &itemv1.GetItemRequest{Id: created.GetItem().GetId()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,18 @@
+#!/bin/bash
+
+# golden Lambda Deployment Script
+
+set -e
+
+echo "Building golden Lambda function..."
+
+# Build the cold-start optimized bootstrap binary and zip it
+make package
+
+echo "✅ Build complete: golden.zip"
+echo "📦 Ready for AWS Lambda deployment"
+
+# Optionally deploy with AWS CLI (uncomment if needed)
+# aws lambda update-function-code \
+#   --function-name golden \
+#   --zip-file fileb://golden.zip
\ No newline at end of file
@@ -0,0 +1,10 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	github.com/aws/aws-lambda-go v1.41.0
+	github.com/aws/aws-sdk-go-v2/config v1.26.2
+	github.com/aws/aws-sdk-go-v2/service/cloudwatch v1.32.0
+	github.com/aws/aws-xray-sdk-go v1.8.3
+)
\ No newline at end of file
@@ -0,0 +1,101 @@
+// Package logger provides CloudWatch-optimized logging for AWS Lambda
+package logger
+
+import (
+	"log/slog"
+	"os"
+)
+
+// Logger defines the minimal interface for logging operations in Lambda
+type Logger interface {
+	Debug(msg string, fields ...Fields)
+	Info(msg string, fields ...Fields)
+	Warn(msg string, fields ...Fields)
+	Error(msg string, fields ...Fields)
+	Fatal(msg string, fields ...Fields)
+	WithFields(fields Fields) func(string, ...Fields)
+}
+
+// Fields represents key-value pairs for structured logging
+type Fields map[string]interface{}
+
+var (
+	logger *slog.Logger
+)
+
+// Initialize sets up the CloudWatch-optimized logger
+func Initialize(level string) error {
+	var slogLevel slog.Level
+	switch level {
+	case "debug":
+		slogLevel = slog.LevelDebug
+	case "info":
+		slogLevel = slog.LevelInfo
+	case "warn":
+		slogLevel = slog.LevelWarn
+	case "error":
+		slogLevel = slog.LevelError
+	default:
+		slogLevel = slog.LevelInfo
+	}
+
+	// Always use JSON for CloudWatch
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slogLevel,
+	})
+	logger = slog.New(handler)
+
+	return nil
+}
+
+// Debug logs a debug message with optional fields
+func Debug(msg string, fields ...Fields) {
+	args := fieldsToArgs(fields)
+	logger.Debug(msg, args...)
+}
+
+// Info logs an info message with optional fields
+func Info(msg string, fields ...Fields) {
+	args := fieldsToArgs(fields)
+	logger.Info(msg, args...)
+}
+
+// Warn logs a warning message with optional fields
+func Warn(msg string, fields ...Fields) {
+	args := fieldsToArgs(fields)
+	logger.Warn(msg, args...)
+}
+
+// Error logs an error message with optional fields
+func Error(msg string, fields ...Fields) {
+	args := fieldsToArgs(fields)
+	logger.Error(msg, args...)
+}
+
+// Fatal logs a fatal message and exits the program
+func Fatal(msg string, fields ...Fields) {
+	args := fieldsToArgs(fields)
+	logger.Error(msg, args...)
+	os.Exit(1)
+}
+
+// WithFields returns a function that logs with the provided fields
+func WithFields(fields Fields) func(string, ...Fields) {
+	return func(msg string, additionalFields ...Fields) {
+		allFields := make([]Fields, 0, len(additionalFields)+1)
+		allFields = append(allFields, fields)
+		allFields = append(allFields, additionalFields...)
+		Info(msg, allFields...)
+	}
+}
+
+// fieldsToArgs converts Fields maps to slog arguments
+func fieldsToArgs(fields []Fields) []interface{} {
+	var args []interface{}
+	for _, fieldMap := range fields {
+		for k, v := range fieldMap {
+			args = append(args, k, v)
+		}
+	}
+	return args
+}
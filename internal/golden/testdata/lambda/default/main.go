@@ -0,0 +1,36 @@
+package main
+
+import (
+	"os"
+
+	"github.com/example/golden/internal/logger"
+)
+
+func init() {
+	// Initialize CloudWatch-optimized logger
+	logLevel := getEnv("LOG_LEVEL", "info")
+
+	if err := logger.Initialize(logLevel); err != nil {
+		// Continue with default logger if initialization fails
+		logger.Initialize("info")
+	}
+
+	logger.Info("Lambda function initialized", logger.Fields{
+		"logger":    "slog",
+		"log_level": logLevel,
+		"runtime":   "aws-lambda-go",
+	})
+}
+
+func main() {
+	// start runs the Lambda runtime loop, or the local invocation harness when
+	// built with -tags local (see start_local.go)
+	start(HandleRequest)
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,15 @@
+//go:build !local
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+// start hands the handler to the AWS Lambda runtime
+func start(handler func(context.Context, json.RawMessage) (Response, error)) {
+	lambda.Start(handler)
+}
@@ -0,0 +1,87 @@
+//go:build local
+
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/aws/aws-lambda-go/lambdacontext"
+)
+
+// start is the local invocation harness: instead of polling the Lambda runtime API it
+// reads one event, invokes the handler with a Lambda context and prints the response.
+//
+//	go run -tags local . -event events/api-gateway.json
+//	echo '{"name":"Ada"}' | go run -tags local . -event -
+func start(handler func(context.Context, json.RawMessage) (Response, error)) {
+	eventPath := flag.String("event", "events/api-gateway.json", "event file to invoke the handler with, - reads stdin")
+	timeout := flag.Duration("timeout", 30*time.Second, "invocation deadline, as configured on the function")
+	flag.Parse()
+
+	// Tracing and custom metrics need the X-Ray daemon and AWS credentials, which a
+	// local run usually has neither of; export the variables to opt back in
+	setDefaultEnv("AWS_XRAY_TRACING_DISABLED", "true")
+	setDefaultEnv("DISABLE_CUSTOM_METRICS", "true")
+
+	event, err := readEvent(*eventPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to read event: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	ctx = lambdacontext.NewContext(ctx, &lambdacontext.LambdaContext{
+		AwsRequestID:       newRequestID(),
+		InvokedFunctionArn: "arn:aws:lambda:local:000000000000:function:golden",
+	})
+
+	response, err := handler(ctx, event)
+	output, _ := json.MarshalIndent(response, "", "  ")
+	fmt.Println(string(output))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "handler returned an error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// readEvent reads the event JSON from path, or from stdin when path is -
+func readEvent(path string) (json.RawMessage, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("%s is not valid JSON", path)
+	}
+	return data, nil
+}
+
+// newRequestID returns a random request ID in the format Lambda uses
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	h := hex.EncodeToString(b)
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:32]
+}
+
+// setDefaultEnv sets an environment variable unless it is already set
+func setDefaultEnv(key, value string) {
+	if _, ok := os.LookupEnv(key); !ok {
+		_ = os.Setenv(key, value)
+	}
+}
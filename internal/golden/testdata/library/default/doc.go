@@ -0,0 +1,34 @@
+// Package golden provides golden functionality.
+//
+// This package offers a clean and simple API for golden operations.
+// It includes structured logging, comprehensive error handling, and
+// production-ready features.
+//
+// Basic usage:
+//
+//	client, err := golden.New(nil)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer client.Close()
+//
+//	result, err := client.Process(context.Background(), "input")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+// For advanced usage with custom configuration:
+//
+//	config := &golden.Config{
+//		Debug: true,
+//	}
+//	config.Logger.Level = "debug"
+//
+//	client, err := golden.New(config)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer client.Close()
+//
+// See the examples directory for more detailed usage examples.
+package golden
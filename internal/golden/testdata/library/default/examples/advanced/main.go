@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	golden "github.com/example/golden"
+)
+
+// slogAdapter adapts slog.Logger to our Logger interface
+type loggerAdapter struct {
+	logger *slog.Logger
+}
+
+func (s *loggerAdapter) Info(msg string, fields ...any) {
+	s.logger.Info(msg, fields...)
+}
+
+func (s *loggerAdapter) Error(msg string, fields ...any) {
+	s.logger.Error(msg, fields...)
+}
+
+func main() {
+	fmt.Println("golden Advanced Example")
+	fmt.Println("===============================")
+	fmt.Printf("Using slog logger\n")
+
+	// Create a logger based on the selected logger type
+	logger := &loggerAdapter{
+		logger: slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+			Level: slog.LevelInfo,
+		})),
+	}
+
+	// Create a client with optional logging and custom timeout
+	client := golden.New(
+		golden.WithLogger(logger),
+		golden.WithTimeout(10*time.Second),
+	)
+	defer client.Close()
+
+	fmt.Println("Created client with logging enabled and 10s timeout")
+
+	// Test different inputs
+	testInputs := []string{
+		"hello world",
+		"advanced example",
+		"golden library",
+		"Go is awesome",
+	}
+
+	fmt.Println("\nProcessing test inputs:")
+	for i, input := range testInputs {
+		fmt.Printf("\n%d. Processing: '%s'\n", i+1, input)
+
+		start := time.Now()
+		result, err := client.Process(context.Background(), input)
+		duration := time.Since(start)
+
+		if err != nil {
+			fmt.Printf("   Error: %v\n", err)
+		} else {
+			fmt.Printf("   Result: %s\n", result)
+			fmt.Printf("   Duration: %v\n", duration)
+		}
+	}
+
+	// Test error case
+	fmt.Println("\n5. Testing error case (empty input):")
+	_, err := client.Process(context.Background(), "")
+	if err != nil {
+		fmt.Printf("   Expected error: %v\n", err)
+	}
+
+	fmt.Println("\nAdvanced example completed!")
+}
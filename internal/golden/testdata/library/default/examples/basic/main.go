@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	golden "github.com/example/golden"
+)
+
+func main() {
+	fmt.Println("golden Basic Example")
+	fmt.Println("=============================")
+
+	// Create a client with default configuration
+	client := golden.New()
+	defer client.Close()
+
+	fmt.Println("Created client with default configuration")
+
+	// Process some data
+	result, err := client.Process(context.Background(), "hello world")
+	if err != nil {
+		log.Fatalf("Failed to process: %v", err)
+	}
+
+	fmt.Printf("Result: %s\n", result)
+	fmt.Println("\nBasic example completed!")
+}
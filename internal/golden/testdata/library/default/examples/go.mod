@@ -0,0 +1,9 @@
+module github.com/example/golden/examples
+
+go 1.23
+
+require (
+	github.com/example/golden v0.0.0-00010101000000-000000000000
+)
+
+replace github.com/example/golden => ../
\ No newline at end of file
@@ -0,0 +1,7 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	github.com/stretchr/testify v1.8.4
+)
\ No newline at end of file
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/realtime"
+	"github.com/example/golden/internal/server"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "golden: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run serves the WebSockets until SIGINT or SIGTERM
+func run() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	log, err := logger.NewFactory().Create(cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+	log = log.With("service", "golden")
+	if cfg.APIKey == "" {
+		log.Warn("API_KEY is not set, the broadcast API is open to anyone")
+	}
+
+	broker := realtime.NewMemoryBroker()
+	presence := realtime.NewMemoryPresence()
+
+	hub := realtime.NewHub(broker, presence, realtime.Limits{
+		MaxMessageSize:    cfg.MaxMessageSize,
+		MaxRoomsPerClient: cfg.MaxRoomsPerClient,
+	}, log)
+	hubCtx, stopHub := context.WithCancel(context.Background())
+	defer stopHub()
+	if err := hub.Start(hubCtx); err != nil {
+		return fmt.Errorf("failed to start the hub: %w", err)
+	}
+
+	srv := &http.Server{
+		Addr: cfg.Address(),
+		Handler: server.NewServer(hub, server.Options{
+			APIKey:         cfg.APIKey,
+			AllowedOrigins: cfg.AllowedOrigins,
+		}, log).Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+	}
+
+	log.Info("Listening", "address", cfg.Address(), "environment", cfg.Environment)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("server stopped: %w", err)
+	case <-ctx.Done():
+	}
+
+	// Stop accepting connections, then close the WebSockets, which the HTTP
+	// server no longer tracks, so that their users leave their rooms
+	log.Info("Shutting down", "timeout", cfg.ShutdownTimeout.String(), "clients", hub.Clients())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down: %w", err)
+	}
+	if err := hub.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to close the WebSockets: %w", err)
+	}
+	stopHub()
+	log.Info("Server stopped")
+	return nil
+}
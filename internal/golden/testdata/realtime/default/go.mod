@@ -0,0 +1,8 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	github.com/gorilla/websocket v1.5.3
+	github.com/stretchr/testify v1.9.0
+)
@@ -0,0 +1,141 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config holds the service configuration, read from the environment
+type Config struct {
+	// Environment is development or production (APP_ENV)
+	Environment string
+	// Port the HTTP server listens on (PORT)
+	Port int
+	// LogLevel is one of debug, info, warn or error (LOG_LEVEL)
+	LogLevel string
+	// LogFormat is json or console (LOG_FORMAT)
+	LogFormat string
+	// ShutdownTimeout bounds the graceful shutdown (SHUTDOWN_TIMEOUT)
+	ShutdownTimeout time.Duration
+
+	// APIKey is the bearer token of the broadcast API (API_KEY). Development
+	// leaves the API open without one
+	APIKey string
+	// AllowedOrigins may open WebSockets (ALLOWED_ORIGINS, comma separated).
+	// Empty allows same-origin pages only
+	AllowedOrigins []string
+	// MaxMessageSize is the largest message a client may send, in bytes (MAX_MESSAGE_SIZE)
+	MaxMessageSize int64
+	// MaxRoomsPerClient caps the rooms a client is in at once (MAX_ROOMS_PER_CLIENT)
+	MaxRoomsPerClient int
+}
+
+// Load reads the configuration from the environment, applying defaults
+func Load() (*Config, error) {
+	cfg := &Config{
+		Environment:       getEnv("APP_ENV", "development"),
+		Port:              8080,
+		LogLevel:          getEnv("LOG_LEVEL", "info"),
+		LogFormat:         getEnv("LOG_FORMAT", "json"),
+		ShutdownTimeout:   15 * time.Second,
+		APIKey:            os.Getenv("API_KEY"),
+		AllowedOrigins:    splitList(os.Getenv("ALLOWED_ORIGINS")),
+		MaxMessageSize:    64 * 1024,
+		MaxRoomsPerClient: 50,
+	}
+
+	if cfg.Environment != "development" && cfg.Environment != "production" {
+		return nil, fmt.Errorf("invalid APP_ENV %q: must be development or production", cfg.Environment)
+	}
+
+	var err error
+	if cfg.Port, err = getEnvInt("PORT", cfg.Port); err != nil {
+		return nil, err
+	}
+	if cfg.Port < 1 || cfg.Port > 65535 {
+		return nil, fmt.Errorf("invalid PORT %d: must be between 1 and 65535", cfg.Port)
+	}
+	if cfg.ShutdownTimeout, err = getEnvDuration("SHUTDOWN_TIMEOUT", cfg.ShutdownTimeout); err != nil {
+		return nil, err
+	}
+	maxMessageSize, err := getEnvInt("MAX_MESSAGE_SIZE", int(cfg.MaxMessageSize))
+	if err != nil {
+		return nil, err
+	}
+	if maxMessageSize < 512 {
+		return nil, fmt.Errorf("invalid MAX_MESSAGE_SIZE %d: must be at least 512 bytes", maxMessageSize)
+	}
+	cfg.MaxMessageSize = int64(maxMessageSize)
+	if cfg.MaxRoomsPerClient, err = getEnvInt("MAX_ROOMS_PER_CLIENT", cfg.MaxRoomsPerClient); err != nil {
+		return nil, err
+	}
+	if cfg.MaxRoomsPerClient < 1 {
+		return nil, fmt.Errorf("invalid MAX_ROOMS_PER_CLIENT %d: must be at least 1", cfg.MaxRoomsPerClient)
+	}
+
+	if cfg.IsProduction() {
+		if cfg.APIKey == "" {
+			return nil, errors.New("API_KEY is required in production, generate one with: openssl rand -hex 32")
+		}
+		if len(cfg.AllowedOrigins) == 0 {
+			return nil, errors.New("ALLOWED_ORIGINS is required in production")
+		}
+	}
+	return cfg, nil
+}
+
+// IsProduction reports whether the service runs in production
+func (c *Config) IsProduction() bool {
+	return c.Environment == "production"
+}
+
+// Address returns the address the server listens on
+func (c *Config) Address() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	return n, nil
+}
+
+func getEnvDuration(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	return d, nil
+}
+
+// splitList splits a comma separated list, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func clearEnv(t *testing.T) {
+	for _, key := range []string{"APP_ENV", "PORT", "LOG_LEVEL", "LOG_FORMAT", "SHUTDOWN_TIMEOUT", "API_KEY", "ALLOWED_ORIGINS", "MAX_MESSAGE_SIZE", "MAX_ROOMS_PER_CLIENT"} {
+		t.Setenv(key, "")
+	}
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	clearEnv(t)
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, ":8080", cfg.Address())
+	assert.False(t, cfg.IsProduction())
+	assert.Equal(t, 15*time.Second, cfg.ShutdownTimeout)
+	assert.Equal(t, int64(64*1024), cfg.MaxMessageSize)
+	assert.Equal(t, 50, cfg.MaxRoomsPerClient)
+	assert.Empty(t, cfg.AllowedOrigins)
+}
+
+func TestLoad_AllowedOrigins(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("ALLOWED_ORIGINS", "https://app.example.com, ,https://admin.example.com")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://app.example.com", "https://admin.example.com"}, cfg.AllowedOrigins)
+}
+
+func TestLoad_Production(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("APP_ENV", "production")
+
+	_, err := Load()
+	assert.ErrorContains(t, err, "API_KEY is required")
+
+	t.Setenv("API_KEY", "secret")
+	_, err = Load()
+	assert.ErrorContains(t, err, "ALLOWED_ORIGINS is required")
+
+	t.Setenv("ALLOWED_ORIGINS", "https://app.example.com")
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.True(t, cfg.IsProduction())
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	cases := map[string]string{
+		"APP_ENV":              "staging",
+		"PORT":                 "70000",
+		"SHUTDOWN_TIMEOUT":     "soon",
+		"MAX_MESSAGE_SIZE":     "10",
+		"MAX_ROOMS_PER_CLIENT": "0",
+	}
+	for key, value := range cases {
+		t.Run(key, func(t *testing.T) {
+			clearEnv(t)
+			t.Setenv(key, value)
+			_, err := Load()
+			assert.Error(t, err)
+		})
+	}
+}
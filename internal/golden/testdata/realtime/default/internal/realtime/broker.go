@@ -0,0 +1,37 @@
+package realtime
+
+import "context"
+
+// Broker carries the messages of the hub to every instance of the service,
+// this one included. The hub of each instance delivers them to its own clients
+type Broker interface {
+	// Publish sends msg to every subscribed instance
+	Publish(ctx context.Context, msg Message) error
+	// Subscribe starts receiving the published messages, until ctx is done
+	Subscribe(ctx context.Context) (<-chan Message, error)
+}
+
+// MemoryBroker is the broker of a service running a single instance
+type MemoryBroker struct {
+	messages chan Message
+}
+
+// NewMemoryBroker creates a broker keeping the messages in this process
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{messages: make(chan Message, 1024)}
+}
+
+// Publish queues msg for the subscriber, waiting while the queue is full
+func (b *MemoryBroker) Publish(ctx context.Context, msg Message) error {
+	select {
+	case b.messages <- msg:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Subscribe returns the queue of published messages. A memory broker has a single subscriber
+func (b *MemoryBroker) Subscribe(ctx context.Context) (<-chan Message, error) {
+	return b.messages, nil
+}
@@ -0,0 +1,171 @@
+package realtime
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// writeWait bounds the write of a message to the client
+	writeWait = 10 * time.Second
+	// pongWait is how long the client has to answer a ping
+	pongWait = 60 * time.Second
+	// pingPeriod is how often the client is pinged, shorter than pongWait
+	pingPeriod = pongWait * 9 / 10
+	// sendBuffer is how many messages wait for a client before it is
+	// disconnected as too slow
+	sendBuffer = 256
+)
+
+// Client is a WebSocket connection of a user
+type Client struct {
+	hub  *Hub
+	conn *websocket.Conn
+	id   string
+	user string
+
+	// rooms the client joined, guarded by the mutex of the hub
+	rooms map[string]struct{}
+
+	outbox chan []byte
+	// done is closed to end the connection, with closeCode and closeText
+	done      chan struct{}
+	closeOnce sync.Once
+	closeCode int
+	closeText string
+}
+
+func newClient(hub *Hub, conn *websocket.Conn, user string) *Client {
+	return &Client{
+		hub:    hub,
+		conn:   conn,
+		id:     newClientID(),
+		user:   user,
+		rooms:  make(map[string]struct{}),
+		outbox: make(chan []byte, sendBuffer),
+		done:   make(chan struct{}),
+	}
+}
+
+// readPump handles the requests of the client until the connection ends
+func (c *Client) readPump() {
+	c.conn.SetReadLimit(c.hub.limits.MaxMessageSize)
+	_ = c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, payload, err := c.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure, websocket.CloseNoStatusReceived) {
+				c.hub.log.Debug("Connection ended", "client", c.id, "error", err)
+			}
+			c.closeWith(websocket.CloseNormalClosure, "")
+			return
+		}
+
+		var msg Message
+		if err := json.Unmarshal(payload, &msg); err != nil {
+			c.send(Message{Type: TypeError, Error: "invalid message: " + err.Error()})
+			continue
+		}
+		if err := c.handle(msg); err != nil {
+			c.send(Message{Type: TypeError, Room: msg.Room, Error: err.Error()})
+		}
+	}
+}
+
+// handle carries out a request of the client
+func (c *Client) handle(msg Message) error {
+	ctx, cancel := context.WithTimeout(context.Background(), writeWait)
+	defer cancel()
+
+	switch msg.Type {
+	case TypeJoin:
+		return c.hub.join(ctx, c, msg.Room)
+	case TypeLeave:
+		return c.hub.leave(ctx, c, msg.Room)
+	case TypeMessage:
+		return c.hub.message(ctx, c, msg.Room, msg.Data)
+	default:
+		return errors.New("unknown message type " + msg.Type)
+	}
+}
+
+// writePump writes the queued messages and the pings to the client until the
+// connection is closed
+func (c *Client) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		_ = c.conn.Close()
+	}()
+
+	for {
+		select {
+		case payload := <-c.outbox:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+				c.closeWith(websocket.CloseAbnormalClosure, "")
+				return
+			}
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				c.closeWith(websocket.CloseAbnormalClosure, "")
+				return
+			}
+		case <-c.done:
+			if c.closeCode != websocket.CloseAbnormalClosure {
+				message := websocket.FormatCloseMessage(c.closeCode, c.closeText)
+				_ = c.conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(writeWait))
+			}
+			return
+		}
+	}
+}
+
+// send queues a reply to the client
+func (c *Client) send(msg Message) {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		c.hub.log.Error("Failed to encode message", "error", err, "type", msg.Type)
+		return
+	}
+	c.enqueue(payload)
+}
+
+// enqueue queues payload without waiting, disconnecting a client that does not
+// keep up rather than slowing down the others
+func (c *Client) enqueue(payload []byte) {
+	select {
+	case c.outbox <- payload:
+	case <-c.done:
+	default:
+		c.hub.log.Warn("Disconnecting slow client", "client", c.id, "user", c.user)
+		c.closeWith(websocket.ClosePolicyViolation, "too slow")
+	}
+}
+
+// closeWith ends the connection, telling the client why
+func (c *Client) closeWith(code int, text string) {
+	c.closeOnce.Do(func() {
+		c.closeCode = code
+		c.closeText = text
+		close(c.done)
+	})
+}
+
+func newClientID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
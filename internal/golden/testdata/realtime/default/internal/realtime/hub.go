@@ -0,0 +1,351 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// maintainInterval is how often shared presence is told this instance is alive
+const maintainInterval = 10 * time.Second
+
+// Limits bound what a single client may do
+type Limits struct {
+	// MaxMessageSize is the largest message a client may send, in bytes
+	MaxMessageSize int64
+	// MaxRoomsPerClient caps the rooms a client is in at once
+	MaxRoomsPerClient int
+}
+
+// Hub tracks the clients connected to this instance and the rooms they are in.
+// Every message goes through the broker, which hands it back to the hub of each
+// instance to deliver to the local clients it is for
+type Hub struct {
+	broker   Broker
+	presence Presence
+	limits   Limits
+	log      logger.Logger
+
+	mu      sync.RWMutex
+	clients map[*Client]struct{}
+	rooms   map[string]map[*Client]struct{}
+	users   map[string]map[*Client]struct{}
+	closed  bool
+
+	// connected counts the clients that have not unregistered yet
+	connected sync.WaitGroup
+}
+
+// NewHub creates a hub publishing through broker and counting room members with presence
+func NewHub(broker Broker, presence Presence, limits Limits, log logger.Logger) *Hub {
+	return &Hub{
+		broker:   broker,
+		presence: presence,
+		limits:   limits,
+		log:      log,
+		clients:  make(map[*Client]struct{}),
+		rooms:    make(map[string]map[*Client]struct{}),
+		users:    make(map[string]map[*Client]struct{}),
+	}
+}
+
+// Start subscribes to the broker and delivers the published messages until ctx is done
+func (h *Hub) Start(ctx context.Context) error {
+	messages, err := h.broker.Subscribe(ctx)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				h.deliver(msg)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	if m, ok := h.presence.(maintainer); ok {
+		go h.maintain(ctx, m)
+	}
+	return nil
+}
+
+// Serve runs the connection of user until it ends. It is called by the HTTP
+// handler that upgraded the connection
+func (h *Hub) Serve(conn *websocket.Conn, user string) {
+	c := newClient(h, conn, user)
+	if err := h.register(c); err != nil {
+		c.closeWith(websocket.CloseGoingAway, err.Error())
+		c.writePump()
+		return
+	}
+	defer h.unregister(c)
+
+	go c.writePump()
+	c.readPump()
+}
+
+// SendToRoom sends data to the members of room
+func (h *Hub) SendToRoom(ctx context.Context, room string, data json.RawMessage) error {
+	if !ValidRoom(room) {
+		return ErrInvalidRoom
+	}
+	return h.publish(ctx, Message{Type: TypeMessage, Room: room, Data: data})
+}
+
+// SendToUser sends data to every connection of user
+func (h *Hub) SendToUser(ctx context.Context, user string, data json.RawMessage) error {
+	return h.publish(ctx, Message{Type: TypeMessage, To: user, Data: data})
+}
+
+// Broadcast sends data to every client
+func (h *Hub) Broadcast(ctx context.Context, data json.RawMessage) error {
+	return h.publish(ctx, Message{Type: TypeMessage, Data: data})
+}
+
+// Members lists the users in room, on every instance
+func (h *Hub) Members(ctx context.Context, room string) ([]string, error) {
+	if !ValidRoom(room) {
+		return nil, ErrInvalidRoom
+	}
+	return h.presence.Members(ctx, room)
+}
+
+// Clients returns the number of clients connected to this instance
+func (h *Hub) Clients() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.clients)
+}
+
+// Shutdown refuses new clients, closes the connected ones and waits until
+// they have left their rooms or ctx is done
+func (h *Hub) Shutdown(ctx context.Context) error {
+	h.mu.Lock()
+	h.closed = true
+	for c := range h.clients {
+		c.closeWith(websocket.CloseGoingAway, "server shutting down")
+	}
+	h.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		h.connected.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// publish stamps msg and hands it to the broker
+func (h *Hub) publish(ctx context.Context, msg Message) error {
+	if msg.Type == TypeMessage && (len(msg.Data) == 0 || string(msg.Data) == "null") {
+		return ErrEmptyData
+	}
+	if msg.Timestamp == 0 {
+		msg.Timestamp = time.Now().UnixMilli()
+	}
+	return h.broker.Publish(ctx, msg)
+}
+
+// deliver sends msg to the local clients it is for
+func (h *Hub) deliver(msg Message) {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		h.log.Error("Failed to encode message", "error", err, "type", msg.Type)
+		return
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	recipients := h.clients
+	switch {
+	case msg.To != "":
+		recipients = h.users[msg.To]
+	case msg.Room != "":
+		recipients = h.rooms[msg.Room]
+	}
+	for c := range recipients {
+		c.enqueue(payload)
+	}
+}
+
+func (h *Hub) register(c *Client) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return ErrClosed
+	}
+	h.clients[c] = struct{}{}
+	addTo(h.users, c.user, c)
+	h.connected.Add(1)
+	h.log.Debug("Client connected", "client", c.id, "user", c.user)
+	return nil
+}
+
+// unregister forgets c and takes it out of its rooms
+func (h *Hub) unregister(c *Client) {
+	defer h.connected.Done()
+
+	h.mu.Lock()
+	delete(h.clients, c)
+	removeFrom(h.users, c.user, c)
+	rooms := make([]string, 0, len(c.rooms))
+	for room := range c.rooms {
+		removeFrom(h.rooms, room, c)
+		rooms = append(rooms, room)
+	}
+	c.rooms = nil
+	h.mu.Unlock()
+
+	// The connection is gone, so leaving does not depend on its context
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for _, room := range rooms {
+		h.leftRoom(ctx, room, c.user)
+	}
+	h.log.Debug("Client disconnected", "client", c.id, "user", c.user)
+}
+
+// join adds c to room, replying with the members of the room
+func (h *Hub) join(ctx context.Context, c *Client, room string) error {
+	if !ValidRoom(room) {
+		return ErrInvalidRoom
+	}
+
+	h.mu.Lock()
+	if _, ok := c.rooms[room]; ok {
+		h.mu.Unlock()
+		return nil
+	}
+	if len(c.rooms) >= h.limits.MaxRoomsPerClient {
+		h.mu.Unlock()
+		return ErrTooManyRooms
+	}
+	c.rooms[room] = struct{}{}
+	addTo(h.rooms, room, c)
+	h.mu.Unlock()
+
+	first, err := h.presence.Join(ctx, room, c.user)
+	if err != nil {
+		h.mu.Lock()
+		delete(c.rooms, room)
+		removeFrom(h.rooms, room, c)
+		h.mu.Unlock()
+		return err
+	}
+
+	members, err := h.presence.Members(ctx, room)
+	if err != nil {
+		h.log.Warn("Failed to list room members", "error", err, "room", room)
+	}
+	c.send(Message{Type: TypeJoined, Room: room, Members: members})
+
+	if first {
+		return h.publish(ctx, Message{Type: TypePresence, Room: room, User: c.user, Event: EventJoin})
+	}
+	return nil
+}
+
+// leave takes c out of room
+func (h *Hub) leave(ctx context.Context, c *Client, room string) error {
+	h.mu.Lock()
+	if _, ok := c.rooms[room]; !ok {
+		h.mu.Unlock()
+		return ErrNotInRoom
+	}
+	delete(c.rooms, room)
+	removeFrom(h.rooms, room, c)
+	h.mu.Unlock()
+
+	c.send(Message{Type: TypeLeft, Room: room})
+	h.leftRoom(ctx, room, c.user)
+	return nil
+}
+
+// leftRoom records that a connection of user left room, announcing the departure
+// of the user with their last connection
+func (h *Hub) leftRoom(ctx context.Context, room, user string) {
+	last, err := h.presence.Leave(ctx, room, user)
+	if err != nil {
+		h.log.Error("Failed to record leaving a room", "error", err, "room", room, "user", user)
+		return
+	}
+	if last {
+		h.announceDeparture(ctx, Departure{Room: room, User: user})
+	}
+}
+
+// message publishes data from c to a room it joined
+func (h *Hub) message(ctx context.Context, c *Client, room string, data json.RawMessage) error {
+	h.mu.RLock()
+	_, ok := c.rooms[room]
+	h.mu.RUnlock()
+	if !ok {
+		return ErrNotInRoom
+	}
+	return h.publish(ctx, Message{Type: TypeMessage, Room: room, From: c.user, Data: data})
+}
+
+// maintain keeps the shared presence of this instance alive and announces the
+// departures of the users of instances that stopped
+func (h *Hub) maintain(ctx context.Context, m maintainer) {
+	ticker := time.NewTicker(maintainInterval)
+	defer ticker.Stop()
+
+	for {
+		departures, err := m.Maintain(ctx)
+		if err != nil {
+			h.log.Error("Failed to maintain presence", "error", err)
+		}
+		for _, d := range departures {
+			h.announceDeparture(ctx, d)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (h *Hub) announceDeparture(ctx context.Context, d Departure) {
+	msg := Message{Type: TypePresence, Room: d.Room, User: d.User, Event: EventLeave}
+	if err := h.publish(ctx, msg); err != nil {
+		h.log.Error("Failed to announce a departure", "error", err, "room", d.Room, "user", d.User)
+	}
+}
+
+func addTo(index map[string]map[*Client]struct{}, key string, c *Client) {
+	clients, ok := index[key]
+	if !ok {
+		clients = make(map[*Client]struct{})
+		index[key] = clients
+	}
+	clients[c] = struct{}{}
+}
+
+func removeFrom(index map[string]map[*Client]struct{}, key string, c *Client) {
+	delete(index[key], c)
+	if len(index[key]) == 0 {
+		delete(index, key)
+	}
+}
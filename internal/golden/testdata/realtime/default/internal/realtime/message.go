@@ -0,0 +1,70 @@
+// Package realtime routes messages between WebSocket clients grouped in rooms
+package realtime
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+)
+
+// Message types. Clients send join, leave and message; the hub sends message,
+// presence, and joined, left or error in reply to a client
+const (
+	TypeJoin     = "join"
+	TypeLeave    = "leave"
+	TypeMessage  = "message"
+	TypePresence = "presence"
+	TypeJoined   = "joined"
+	TypeLeft     = "left"
+	TypeError    = "error"
+)
+
+// Presence events, announcing a user's first connection in a room and the end of their last one
+const (
+	EventJoin  = "join"
+	EventLeave = "leave"
+)
+
+// Message is the JSON envelope exchanged with the clients and between instances.
+// A message with To goes to the connections of that user, one with a Room to
+// the members of the room, and one with neither to every client
+type Message struct {
+	Type string `json:"type"`
+	// Room the message is about
+	Room string `json:"room,omitempty"`
+	// From is the user who sent the message, empty when it came from the API
+	From string `json:"from,omitempty"`
+	// To is the user the message is for
+	To string `json:"to,omitempty"`
+	// User and Event describe a presence change
+	User  string `json:"user,omitempty"`
+	Event string `json:"event,omitempty"`
+	// Members lists the users in the room a client joined
+	Members []string `json:"members,omitempty"`
+	// Data is the payload, any JSON value
+	Data json.RawMessage `json:"data,omitempty"`
+	// Error explains why the request of a client failed
+	Error string `json:"error,omitempty"`
+	// Timestamp is when the message was sent, in milliseconds since the Unix epoch
+	Timestamp int64 `json:"ts,omitempty"`
+}
+
+var roomName = regexp.MustCompile(`^[A-Za-z0-9_.:-]{1,64}$`)
+
+var (
+	// ErrInvalidRoom is returned for room names the hub does not accept
+	ErrInvalidRoom = errors.New("invalid room name: use 1 to 64 letters, digits, '.', '_', ':' or '-'")
+	// ErrTooManyRooms is returned when a client joins more rooms than allowed
+	ErrTooManyRooms = errors.New("too many rooms joined")
+	// ErrNotInRoom is returned when a client sends to a room it did not join
+	ErrNotInRoom = errors.New("not in the room")
+	// ErrEmptyData is returned for messages without a payload
+	ErrEmptyData = errors.New("message data is required")
+	// ErrClosed is returned once the hub is shutting down
+	ErrClosed = errors.New("hub is shutting down")
+)
+
+// ValidRoom reports whether name can be used as a room name
+func ValidRoom(name string) bool {
+	return roomName.MatchString(name)
+}
@@ -0,0 +1,91 @@
+package realtime
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// Presence counts the connections of each user in each room, so that a user
+// with several tabs open joins a room once and leaves it with the last tab.
+// Presence shared by several instances counts the connections of all of them
+type Presence interface {
+	// Join records a connection of user in room and reports whether it is their first
+	Join(ctx context.Context, room, user string) (bool, error)
+	// Leave removes a connection of user from room and reports whether it was their last
+	Leave(ctx context.Context, room, user string) (bool, error)
+	// Members lists the users in room, sorted
+	Members(ctx context.Context, room string) ([]string, error)
+}
+
+// Departure is a user who left a room without their connection ending on this
+// instance, because the instance they were connected to stopped
+type Departure struct {
+	Room string
+	User string
+}
+
+// maintainer is implemented by presence shared with other instances. Maintain is
+// called periodically to tell that this instance is alive and to remove the
+// connections of instances that stopped without cleaning up
+type maintainer interface {
+	Maintain(ctx context.Context) ([]Departure, error)
+}
+
+// MemoryPresence is the presence of a service running a single instance
+type MemoryPresence struct {
+	mu    sync.Mutex
+	rooms map[string]map[string]int
+}
+
+// NewMemoryPresence creates a presence kept in this process
+func NewMemoryPresence() *MemoryPresence {
+	return &MemoryPresence{rooms: make(map[string]map[string]int)}
+}
+
+// Join records a connection of user in room and reports whether it is their first
+func (p *MemoryPresence) Join(_ context.Context, room, user string) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	users, ok := p.rooms[room]
+	if !ok {
+		users = make(map[string]int)
+		p.rooms[room] = users
+	}
+	users[user]++
+	return users[user] == 1, nil
+}
+
+// Leave removes a connection of user from room and reports whether it was their last
+func (p *MemoryPresence) Leave(_ context.Context, room, user string) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	users := p.rooms[room]
+	if users[user] == 0 {
+		return false, nil
+	}
+	users[user]--
+	if users[user] > 0 {
+		return false, nil
+	}
+	delete(users, user)
+	if len(users) == 0 {
+		delete(p.rooms, room)
+	}
+	return true, nil
+}
+
+// Members lists the users in room, sorted
+func (p *MemoryPresence) Members(_ context.Context, room string) ([]string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	members := make([]string, 0, len(p.rooms[room]))
+	for user := range p.rooms[room] {
+		members = append(members, user)
+	}
+	sort.Strings(members)
+	return members, nil
+}
@@ -0,0 +1,54 @@
+package realtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryPresence(t *testing.T) {
+	ctx := context.Background()
+	p := NewMemoryPresence()
+
+	first, err := p.Join(ctx, "lobby", "alice")
+	require.NoError(t, err)
+	assert.True(t, first)
+
+	// A second tab of the same user
+	first, err = p.Join(ctx, "lobby", "alice")
+	require.NoError(t, err)
+	assert.False(t, first)
+
+	_, err = p.Join(ctx, "lobby", "bob")
+	require.NoError(t, err)
+	members, err := p.Members(ctx, "lobby")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice", "bob"}, members)
+
+	last, err := p.Leave(ctx, "lobby", "alice")
+	require.NoError(t, err)
+	assert.False(t, last, "alice still has a tab open")
+
+	last, err = p.Leave(ctx, "lobby", "alice")
+	require.NoError(t, err)
+	assert.True(t, last)
+
+	last, err = p.Leave(ctx, "lobby", "carol")
+	require.NoError(t, err)
+	assert.False(t, last, "carol never joined")
+
+	members, err = p.Members(ctx, "lobby")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bob"}, members)
+}
+
+func TestValidRoom(t *testing.T) {
+	for _, room := range []string{"lobby", "team:42", "a.b_c-d"} {
+		assert.True(t, ValidRoom(room), room)
+	}
+	for _, room := range []string{"", "with space", "pipe|room", string(make([]byte, 65))} {
+		assert.False(t, ValidRoom(room), room)
+	}
+}
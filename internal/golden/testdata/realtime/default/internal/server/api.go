@@ -0,0 +1,91 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/example/golden/internal/realtime"
+)
+
+// maxAPIBody bounds the body of a broadcast API request
+const maxAPIBody = 1 << 20
+
+// sendRequest is the body of the broadcast API requests
+type sendRequest struct {
+	// Data is sent to the clients as is, any JSON value
+	Data json.RawMessage `json:"data"`
+}
+
+// requireAPIKey rejects the requests without the API key as bearer token
+func (s *Server) requireAPIKey(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.apiKey != "" {
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.apiKey)) != 1 {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				writeError(w, http.StatusUnauthorized, errors.New("invalid or missing API key"))
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// broadcast sends a message to every client
+func (s *Server) broadcast(w http.ResponseWriter, r *http.Request) {
+	s.send(w, r, func(data json.RawMessage) error {
+		return s.hub.Broadcast(r.Context(), data)
+	})
+}
+
+// sendToRoom sends a message to the members of a room
+func (s *Server) sendToRoom(w http.ResponseWriter, r *http.Request) {
+	s.send(w, r, func(data json.RawMessage) error {
+		return s.hub.SendToRoom(r.Context(), r.PathValue("room"), data)
+	})
+}
+
+// sendToUser sends a message to every connection of a user
+func (s *Server) sendToUser(w http.ResponseWriter, r *http.Request) {
+	s.send(w, r, func(data json.RawMessage) error {
+		return s.hub.SendToUser(r.Context(), r.PathValue("user"), data)
+	})
+}
+
+// members lists the users in a room, on every instance
+func (s *Server) members(w http.ResponseWriter, r *http.Request) {
+	members, err := s.hub.Members(r.Context(), r.PathValue("room"))
+	if errors.Is(err, realtime.ErrInvalidRoom) {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err != nil {
+		s.log.Error("Failed to list room members", "error", err)
+		writeError(w, http.StatusServiceUnavailable, errors.New("presence unavailable"))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"room": r.PathValue("room"), "members": members})
+}
+
+// send decodes the body of a broadcast API request and publishes its data
+func (s *Server) send(w http.ResponseWriter, r *http.Request, publish func(json.RawMessage) error) {
+	var req sendRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxAPIBody)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("invalid JSON body: "+err.Error()))
+		return
+	}
+
+	err := publish(req.Data)
+	switch {
+	case errors.Is(err, realtime.ErrInvalidRoom), errors.Is(err, realtime.ErrEmptyData):
+		writeError(w, http.StatusBadRequest, err)
+	case err != nil:
+		s.log.Error("Failed to publish message", "error", err)
+		writeError(w, http.StatusServiceUnavailable, errors.New("message not sent"))
+	default:
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
@@ -0,0 +1,125 @@
+// Package server exposes the hub over HTTP: the WebSocket endpoint, the
+// broadcast API and a demo page
+package server
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/realtime"
+)
+
+//go:embed demo.html
+var demoPage []byte
+
+// Options configure the server
+type Options struct {
+	// APIKey is the bearer token of the broadcast API; empty leaves it open
+	APIKey string
+	// AllowedOrigins may open WebSockets; empty allows same-origin pages only
+	AllowedOrigins []string
+	// Authenticate identifies the user opening a WebSocket, defaults to Authenticate
+	Authenticate func(r *http.Request) (string, error)
+}
+
+// Server serves the WebSocket endpoint and the broadcast API of a hub
+type Server struct {
+	hub          *realtime.Hub
+	upgrader     websocket.Upgrader
+	apiKey       string
+	authenticate func(r *http.Request) (string, error)
+	log          logger.Logger
+}
+
+// NewServer creates the HTTP server of hub
+func NewServer(hub *realtime.Hub, opts Options, log logger.Logger) *Server {
+	s := &Server{
+		hub:          hub,
+		apiKey:       opts.APIKey,
+		authenticate: opts.Authenticate,
+		log:          log,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  4096,
+			WriteBufferSize: 4096,
+		},
+	}
+	if s.authenticate == nil {
+		s.authenticate = Authenticate
+	}
+	if len(opts.AllowedOrigins) > 0 {
+		allowed := opts.AllowedOrigins
+		s.upgrader.CheckOrigin = func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			return origin == "" || slices.Contains(allowed, origin)
+		}
+	}
+	return s
+}
+
+// Handler returns the routes of the server
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.demo)
+	mux.HandleFunc("GET /healthz", s.health)
+	mux.HandleFunc("GET /ws", s.websocket)
+
+	api := http.NewServeMux()
+	api.HandleFunc("POST /api/broadcast", s.broadcast)
+	api.HandleFunc("POST /api/rooms/{room}/messages", s.sendToRoom)
+	api.HandleFunc("POST /api/users/{user}/messages", s.sendToUser)
+	api.HandleFunc("GET /api/rooms/{room}/members", s.members)
+	mux.Handle("/api/", s.requireAPIKey(api))
+	return mux
+}
+
+// Authenticate identifies the user from the user query parameter, which
+// anyone can set. Replace it through Options.Authenticate with the check of
+// your session cookies or tokens before exposing the service
+func Authenticate(r *http.Request) (string, error) {
+	user := r.URL.Query().Get("user")
+	if user == "" || len(user) > 64 {
+		return "", errors.New("the user query parameter is required, up to 64 characters")
+	}
+	return user, nil
+}
+
+func (s *Server) websocket(w http.ResponseWriter, r *http.Request) {
+	user, err := s.authenticate(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader has answered the request already
+		s.log.Debug("WebSocket upgrade failed", "error", err, "origin", r.Header.Get("Origin"))
+		return
+	}
+	s.hub.Serve(conn, user)
+}
+
+func (s *Server) demo(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write(demoPage)
+}
+
+func (s *Server) health(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{"status": "ok", "clients": s.hub.Clients()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,287 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/realtime"
+)
+
+const testAPIKey = "test-api-key"
+
+type testService struct {
+	hub *realtime.Hub
+	srv *httptest.Server
+}
+
+// newService starts a hub and its server on broker and presence
+func newService(t *testing.T, broker realtime.Broker, presence realtime.Presence, limits realtime.Limits) *testService {
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: "error", Format: "json"}, io.Discard)
+	require.NoError(t, err)
+
+	if limits.MaxMessageSize == 0 {
+		limits.MaxMessageSize = 4096
+	}
+	if limits.MaxRoomsPerClient == 0 {
+		limits.MaxRoomsPerClient = 10
+	}
+	hub := realtime.NewHub(broker, presence, limits, log)
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, hub.Start(ctx))
+
+	srv := httptest.NewServer(NewServer(hub, Options{
+		APIKey:         testAPIKey,
+		AllowedOrigins: []string{"https://app.example.com"},
+	}, log).Handler())
+	t.Cleanup(func() {
+		srv.Close()
+		shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancelShutdown()
+		_ = hub.Shutdown(shutdownCtx)
+		cancel()
+	})
+	return &testService{hub: hub, srv: srv}
+}
+
+func newMemoryService(t *testing.T) *testService {
+	return newService(t, realtime.NewMemoryBroker(), realtime.NewMemoryPresence(), realtime.Limits{})
+}
+
+// connect opens a WebSocket as user
+func (s *testService) connect(t *testing.T, user string) *websocket.Conn {
+	url := "ws" + strings.TrimPrefix(s.srv.URL, "http") + "/ws?user=" + user
+	conn, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// api calls the broadcast API with the test API key
+func (s *testService) api(t *testing.T, method, path, body string) *http.Response {
+	req, err := http.NewRequest(method, s.srv.URL+path, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+testAPIKey)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func send(t *testing.T, conn *websocket.Conn, msg realtime.Message) {
+	require.NoError(t, conn.WriteJSON(msg))
+}
+
+// receive reads the next message sent to conn
+func receive(t *testing.T, conn *websocket.Conn) realtime.Message {
+	t.Helper()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+	var msg realtime.Message
+	require.NoError(t, conn.ReadJSON(&msg))
+	return msg
+}
+
+// join makes conn join room and returns the members it was told about
+func join(t *testing.T, conn *websocket.Conn, room string) []string {
+	t.Helper()
+	send(t, conn, realtime.Message{Type: realtime.TypeJoin, Room: room})
+	joined := receive(t, conn)
+	require.Equal(t, realtime.TypeJoined, joined.Type, joined.Error)
+	return joined.Members
+}
+
+// expectPresence reads a presence change from conn
+func expectPresence(t *testing.T, conn *websocket.Conn, user, event string) {
+	t.Helper()
+	msg := receive(t, conn)
+	assert.Equal(t, realtime.TypePresence, msg.Type)
+	assert.Equal(t, user, msg.User)
+	assert.Equal(t, event, msg.Event)
+}
+
+func TestRoomMessagesAndPresence(t *testing.T) {
+	s := newMemoryService(t)
+	alice := s.connect(t, "alice")
+	bob := s.connect(t, "bob")
+	carol := s.connect(t, "carol")
+
+	assert.Equal(t, []string{"alice"}, join(t, alice, "lobby"))
+	expectPresence(t, alice, "alice", realtime.EventJoin)
+	assert.Equal(t, []string{"alice", "bob"}, join(t, bob, "lobby"))
+	expectPresence(t, bob, "bob", realtime.EventJoin)
+	expectPresence(t, alice, "bob", realtime.EventJoin)
+	join(t, carol, "games")
+	expectPresence(t, carol, "carol", realtime.EventJoin)
+
+	send(t, bob, realtime.Message{Type: realtime.TypeMessage, Room: "lobby", Data: json.RawMessage(`{"text":"hi"}`)})
+	for _, conn := range []*websocket.Conn{alice, bob} {
+		msg := receive(t, conn)
+		assert.Equal(t, realtime.TypeMessage, msg.Type)
+		assert.Equal(t, "bob", msg.From)
+		assert.JSONEq(t, `{"text":"hi"}`, string(msg.Data))
+		assert.NotZero(t, msg.Timestamp)
+	}
+
+	// carol is not in the lobby: the broadcast is the next message she gets
+	resp := s.api(t, http.MethodPost, "/api/broadcast", `{"data":"maintenance at noon"}`)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	for _, conn := range []*websocket.Conn{alice, bob, carol} {
+		msg := receive(t, conn)
+		assert.Empty(t, msg.Room)
+		assert.JSONEq(t, `"maintenance at noon"`, string(msg.Data))
+	}
+
+	require.NoError(t, bob.Close())
+	expectPresence(t, alice, "bob", realtime.EventLeave)
+
+	resp = s.api(t, http.MethodGet, "/api/rooms/lobby/members", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var members struct{ Members []string }
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&members))
+	assert.Equal(t, []string{"alice"}, members.Members)
+}
+
+func TestPresence_SeveralConnections(t *testing.T) {
+	s := newMemoryService(t)
+	watcher := s.connect(t, "watcher")
+	join(t, watcher, "lobby")
+	expectPresence(t, watcher, "watcher", realtime.EventJoin)
+
+	laptop := s.connect(t, "alice")
+	join(t, laptop, "lobby")
+	expectPresence(t, laptop, "alice", realtime.EventJoin)
+	expectPresence(t, watcher, "alice", realtime.EventJoin)
+	phone := s.connect(t, "alice")
+	assert.Equal(t, []string{"alice", "watcher"}, join(t, phone, "lobby"))
+
+	// alice leaves with her last connection only
+	send(t, laptop, realtime.Message{Type: realtime.TypeLeave, Room: "lobby"})
+	assert.Equal(t, realtime.TypeLeft, receive(t, laptop).Type)
+	require.NoError(t, phone.Close())
+	expectPresence(t, watcher, "alice", realtime.EventLeave)
+}
+
+func TestSendToUser(t *testing.T) {
+	s := newMemoryService(t)
+	laptop := s.connect(t, "alice")
+	phone := s.connect(t, "alice")
+	bob := s.connect(t, "bob")
+
+	resp := s.api(t, http.MethodPost, "/api/users/alice/messages", `{"data":{"unread":3}}`)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	for _, conn := range []*websocket.Conn{laptop, phone} {
+		msg := receive(t, conn)
+		assert.Equal(t, "alice", msg.To)
+		assert.JSONEq(t, `{"unread":3}`, string(msg.Data))
+	}
+
+	// bob only gets the room message sent after
+	join(t, bob, "lobby")
+	expectPresence(t, bob, "bob", realtime.EventJoin)
+	resp = s.api(t, http.MethodPost, "/api/rooms/lobby/messages", `{"data":"hello lobby"}`)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	msg := receive(t, bob)
+	assert.Equal(t, "lobby", msg.Room)
+	assert.JSONEq(t, `"hello lobby"`, string(msg.Data))
+}
+
+func TestClientErrors(t *testing.T) {
+	s := newService(t, realtime.NewMemoryBroker(), realtime.NewMemoryPresence(), realtime.Limits{MaxRoomsPerClient: 1})
+	conn := s.connect(t, "alice")
+
+	cases := []struct {
+		name string
+		msg  string
+		want string
+	}{
+		{"invalid room", `{"type":"join","room":"no spaces"}`, realtime.ErrInvalidRoom.Error()},
+		{"not in the room", `{"type":"message","room":"lobby","data":1}`, realtime.ErrNotInRoom.Error()},
+		{"unknown type", `{"type":"shout"}`, "unknown message type shout"},
+		{"invalid JSON", `{"type":`, "invalid message"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(tc.msg)))
+			msg := receive(t, conn)
+			assert.Equal(t, realtime.TypeError, msg.Type)
+			assert.Contains(t, msg.Error, tc.want)
+		})
+	}
+
+	join(t, conn, "lobby")
+	expectPresence(t, conn, "alice", realtime.EventJoin)
+	send(t, conn, realtime.Message{Type: realtime.TypeJoin, Room: "games"})
+	assert.Equal(t, realtime.ErrTooManyRooms.Error(), receive(t, conn).Error)
+}
+
+func TestWebSocket_RejectsUnknownOriginsAndUsers(t *testing.T) {
+	s := newMemoryService(t)
+	url := "ws" + strings.TrimPrefix(s.srv.URL, "http") + "/ws"
+
+	_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	_, resp, err = websocket.DefaultDialer.Dial(url+"?user=alice", http.Header{"Origin": {"https://evil.example.com"}})
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	conn, _, err := websocket.DefaultDialer.Dial(url+"?user=alice", http.Header{"Origin": {"https://app.example.com"}})
+	require.NoError(t, err)
+	conn.Close()
+}
+
+func TestAPI(t *testing.T) {
+	s := newMemoryService(t)
+
+	resp, err := http.Post(s.srv.URL+"/api/broadcast", "application/json", strings.NewReader(`{"data":1}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "the API key is required")
+
+	cases := map[string]struct {
+		method, path, body string
+		status             int
+	}{
+		"invalid JSON": {http.MethodPost, "/api/broadcast", `{`, http.StatusBadRequest},
+		"no data":      {http.MethodPost, "/api/broadcast", `{}`, http.StatusBadRequest},
+		"invalid room": {http.MethodPost, "/api/rooms/a%20b/messages", `{"data":1}`, http.StatusBadRequest},
+		"members":      {http.MethodGet, "/api/rooms/empty/members", "", http.StatusOK},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.status, s.api(t, tc.method, tc.path, tc.body).StatusCode)
+		})
+	}
+}
+
+func TestShutdown_ClosesClients(t *testing.T) {
+	s := newMemoryService(t)
+	conn := s.connect(t, "alice")
+	join(t, conn, "lobby")
+	expectPresence(t, conn, "alice", realtime.EventJoin)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, s.hub.Shutdown(ctx))
+	assert.Zero(t, s.hub.Clients())
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+	_, _, err := conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway), "got %v", err)
+
+	members, err := s.hub.Members(ctx, "lobby")
+	require.NoError(t, err)
+	assert.Empty(t, members)
+}
@@ -0,0 +1,7 @@
+data "golden_item" "example" {
+  id = "item-1"
+}
+
+output "item_name" {
+  value = data.golden_item.example.name
+}
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    golden = {
+      source = "example/golden"
+    }
+  }
+}
+
+# The endpoint and token may also be set with the GOLDEN_ENDPOINT
+# and GOLDEN_API_TOKEN environment variables
+provider "golden" {
+  endpoint  = "https://api.example.com"
+  api_token = var.api_token
+}
+
+variable "api_token" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# Items are imported by their identifier
+terraform import golden_item.example item-1
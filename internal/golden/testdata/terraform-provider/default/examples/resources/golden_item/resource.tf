@@ -0,0 +1,4 @@
+resource "golden_item" "example" {
+  name        = "example"
+  description = "Managed by Terraform"
+}
@@ -0,0 +1,11 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	github.com/hashicorp/terraform-plugin-framework v1.11.0
+	github.com/hashicorp/terraform-plugin-go v0.23.0
+	github.com/hashicorp/terraform-plugin-log v0.9.0
+	github.com/hashicorp/terraform-plugin-testing v1.10.0
+	github.com/stretchr/testify v1.9.0
+)
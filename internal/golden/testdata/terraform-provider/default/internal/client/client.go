@@ -0,0 +1,141 @@
+// Package client is a minimal client for the API managed by the provider.
+// Replace the Item calls with the ones of your API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned when the requested object does not exist
+var ErrNotFound = errors.New("not found")
+
+// Item is the example object managed by the golden_item resource
+type Item struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ItemInput holds the writable fields of an Item
+type ItemInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Client calls the API over HTTP
+type Client struct {
+	baseURL    *url.URL
+	token      string
+	userAgent  string
+	httpClient *http.Client
+}
+
+// New creates a client for the API at endpoint, authenticating with token when it is not empty
+func New(endpoint, token, userAgent string) (*Client, error) {
+	baseURL, err := url.Parse(endpoint)
+	if err != nil || baseURL.Scheme == "" || baseURL.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %q: must be an absolute URL", endpoint)
+	}
+
+	return &Client{
+		baseURL:    baseURL,
+		token:      token,
+		userAgent:  userAgent,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// CreateItem creates an item
+func (c *Client) CreateItem(ctx context.Context, input ItemInput) (*Item, error) {
+	var item Item
+	if err := c.do(ctx, http.MethodPost, "items", input, &item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// GetItem retrieves an item, returning ErrNotFound if it does not exist
+func (c *Client) GetItem(ctx context.Context, id string) (*Item, error) {
+	var item Item
+	if err := c.do(ctx, http.MethodGet, itemPath(id), nil, &item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// UpdateItem replaces the writable fields of an item
+func (c *Client) UpdateItem(ctx context.Context, id string, input ItemInput) (*Item, error) {
+	var item Item
+	if err := c.do(ctx, http.MethodPut, itemPath(id), input, &item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// DeleteItem deletes an item, returning ErrNotFound if it does not exist
+func (c *Client) DeleteItem(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, itemPath(id), nil, nil)
+}
+
+func itemPath(id string) string {
+	return "items/" + url.PathEscape(id)
+}
+
+// do sends a JSON request to the escaped path and decodes the response into out
+func (c *Client) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("encoding %s %s request: %w", method, path, err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL.JoinPath(path).String(), body)
+	if err != nil {
+		return fmt.Errorf("creating %s %s request: %w", method, path, err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s %s: unexpected status %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding %s %s response: %w", method, path, err)
+	}
+	return nil
+}
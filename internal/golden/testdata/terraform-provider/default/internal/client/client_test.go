@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_RejectsRelativeEndpoints(t *testing.T) {
+	_, err := New("api.example.com", "", "test")
+	assert.Error(t, err)
+
+	_, err = New("https://api.example.com/v1", "", "test")
+	assert.NoError(t, err)
+}
+
+func TestClient_CreateItem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/items", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, "test-agent", r.Header.Get("User-Agent"))
+
+		var input ItemInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&input))
+		assert.Equal(t, ItemInput{Name: "example", Description: "An example"}, input)
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(Item{ID: "item-1", Name: input.Name, Description: input.Description, CreatedAt: time.Now().UTC()})
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL+"/v1", "secret", "test-agent")
+	require.NoError(t, err)
+
+	item, err := c.CreateItem(context.Background(), ItemInput{Name: "example", Description: "An example"})
+	require.NoError(t, err)
+	assert.Equal(t, "item-1", item.ID)
+	assert.Equal(t, "example", item.Name)
+}
+
+func TestClient_GetItem_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/items/a%2Fb", r.URL.EscapedPath())
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL, "", "test")
+	require.NoError(t, err)
+
+	_, err = c.GetItem(context.Background(), "a/b")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestClient_ReportsAPIErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "name is required", http.StatusUnprocessableEntity)
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL, "", "test")
+	require.NoError(t, err)
+
+	_, err = c.UpdateItem(context.Background(), "item-1", ItemInput{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "422")
+	assert.Contains(t, err.Error(), "name is required")
+
+	assert.Error(t, c.DeleteItem(context.Background(), "item-1"))
+}
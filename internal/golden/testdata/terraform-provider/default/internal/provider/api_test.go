@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/example/golden/internal/client"
+)
+
+// testAPI is an in-memory implementation of the items API, so the acceptance
+// tests run without an account. Set GOLDEN_ENDPOINT to run them against the real API.
+type testAPI struct {
+	mu     sync.Mutex
+	nextID int
+	items  map[string]client.Item
+}
+
+func newTestAPI() *testAPI {
+	return &testAPI{items: make(map[string]client.Item)}
+}
+
+func (a *testAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if r.URL.Path == "/items" && r.Method == http.MethodPost {
+		input, ok := decodeItemInput(w, r)
+		if !ok {
+			return
+		}
+		a.nextID++
+		item := client.Item{ID: fmt.Sprintf("item-%d", a.nextID), Name: input.Name, Description: input.Description, CreatedAt: time.Now().UTC()}
+		a.items[item.ID] = item
+		writeJSON(w, http.StatusCreated, item)
+		return
+	}
+
+	id, found := strings.CutPrefix(r.URL.Path, "/items/")
+	if !found {
+		http.NotFound(w, r)
+		return
+	}
+	item, exists := a.items[id]
+	if !exists {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, item)
+	case http.MethodPut:
+		input, ok := decodeItemInput(w, r)
+		if !ok {
+			return
+		}
+		item.Name, item.Description = input.Name, input.Description
+		a.items[id] = item
+		writeJSON(w, http.StatusOK, item)
+	case http.MethodDelete:
+		delete(a.items, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func decodeItemInput(w http.ResponseWriter, r *http.Request) (client.ItemInput, bool) {
+	var input client.ItemInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return input, false
+	}
+	if input.Name == "" {
+		http.Error(w, "name is required", http.StatusUnprocessableEntity)
+		return input, false
+	}
+	return input, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/example/golden/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &ItemDataSource{}
+	_ datasource.DataSourceWithConfigure = &ItemDataSource{}
+)
+
+// ItemDataSource reads an existing item: the example data source to model yours on
+type ItemDataSource struct {
+	client *client.Client
+}
+
+// NewItemDataSource creates the golden_item data source
+func NewItemDataSource() datasource.DataSource {
+	return &ItemDataSource{}
+}
+
+// Metadata returns the data source type name
+func (d *ItemDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_item"
+}
+
+// Schema defines the data source attributes
+func (d *ItemDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an existing item.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the item.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the item.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the item.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation time of the item, in RFC 3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure receives the API client created by the provider
+func (d *ItemDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Read looks the item up
+func (d *ItemDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ItemModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, err := d.client.GetItem(ctx, config.ID.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Item not found", fmt.Sprintf("No item with ID %q exists.", config.ID.ValueString()))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read item", err.Error())
+		return
+	}
+
+	config.fromItem(item)
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
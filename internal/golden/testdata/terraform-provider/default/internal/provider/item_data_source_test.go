@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccItemDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `
+resource "golden_item" "source" {
+  name        = "looked-up"
+  description = "Read back by the data source"
+}
+
+data "golden_item" "test" {
+  id = golden_item.source.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.golden_item.test", "id", "golden_item.source", "id"),
+					resource.TestCheckResourceAttr("data.golden_item.test", "name", "looked-up"),
+					resource.TestCheckResourceAttr("data.golden_item.test", "description", "Read back by the data source"),
+					resource.TestCheckResourceAttrPair("data.golden_item.test", "created_at", "golden_item.source", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccItemDataSource_NotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `
+data "golden_item" "missing" {
+  id = "does-not-exist"
+}
+`,
+				ExpectError: regexp.MustCompile("Item not found"),
+			},
+		},
+	})
+}
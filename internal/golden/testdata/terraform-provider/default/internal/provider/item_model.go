@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/example/golden/internal/client"
+)
+
+// ItemModel maps the golden_item resource and data source to Terraform values
+type ItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+// input returns the writable fields sent to the API
+func (m ItemModel) input() client.ItemInput {
+	return client.ItemInput{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+}
+
+// fromItem sets the model from an API response
+func (m *ItemModel) fromItem(item *client.Item) {
+	m.ID = types.StringValue(item.ID)
+	m.Name = types.StringValue(item.Name)
+	m.Description = types.StringValue(item.Description)
+	m.CreatedAt = types.StringValue(item.CreatedAt.UTC().Format(time.RFC3339))
+}
+
+// clientFromProviderData returns the API client configured by the provider.
+// The data is nil until the provider is configured, such as during validation.
+func clientFromProviderData(data any, diags *diag.Diagnostics) *client.Client {
+	if data == nil {
+		return nil
+	}
+
+	c, ok := data.(*client.Client)
+	if !ok {
+		diags.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected *client.Client, got %T. Please report this issue to the provider developers.", data),
+		)
+		return nil
+	}
+	return c
+}
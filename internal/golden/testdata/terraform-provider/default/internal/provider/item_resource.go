@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/example/golden/internal/client"
+)
+
+var (
+	_ resource.Resource                = &ItemResource{}
+	_ resource.ResourceWithConfigure   = &ItemResource{}
+	_ resource.ResourceWithImportState = &ItemResource{}
+)
+
+// ItemResource manages an item: the example resource to model yours on
+type ItemResource struct {
+	client *client.Client
+}
+
+// NewItemResource creates the golden_item resource
+func NewItemResource() resource.Resource {
+	return &ItemResource{}
+}
+
+// Metadata returns the resource type name
+func (r *ItemResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_item"
+}
+
+// Schema defines the resource attributes
+func (r *ItemResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an item.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the item.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the item.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the item.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation time of the item, in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure receives the API client created by the provider
+func (r *ItemResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the item and stores it in the state
+func (r *ItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ItemModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, err := r.client.CreateItem(ctx, plan.input())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create item", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "Created item", map[string]any{"id": item.ID})
+
+	plan.fromItem(item)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the state from the API, removing items deleted outside Terraform
+func (r *ItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ItemModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, err := r.client.GetItem(ctx, state.ID.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		tflog.Warn(ctx, "Item no longer exists, removing it from the state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read item", err.Error())
+		return
+	}
+
+	state.fromItem(item)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update applies the planned changes to the item
+func (r *ItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ItemModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, err := r.client.UpdateItem(ctx, plan.ID.ValueString(), plan.input())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update item", err.Error())
+		return
+	}
+
+	plan.fromItem(item)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the item; an item that is already gone is not an error
+func (r *ItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ItemModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteItem(ctx, state.ID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Failed to delete item", err.Error())
+	}
+}
+
+// ImportState imports an existing item by its identifier
+func (r *ItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/example/golden/internal/client"
+)
+
+func TestAccItemResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckItemDestroyed(t),
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccItemResourceConfig("first", "The first item"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("golden_item.test", "id"),
+					resource.TestCheckResourceAttr("golden_item.test", "name", "first"),
+					resource.TestCheckResourceAttr("golden_item.test", "description", "The first item"),
+					resource.TestCheckResourceAttrSet("golden_item.test", "created_at"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "golden_item.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place
+			{
+				Config: testAccItemResourceConfig("renamed", "The renamed item"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("golden_item.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("golden_item.test", "description", "The renamed item"),
+				),
+			},
+			// Delete happens automatically at the end of the test
+		},
+	})
+}
+
+func TestAccItemResource_DeletedOutsideTerraform(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccItemResourceConfig("doomed", ""),
+				Check: func(s *terraform.State) error {
+					id := s.RootModule().Resources["golden_item.test"].Primary.ID
+					return testAccClient(t).DeleteItem(context.Background(), id)
+				},
+				// The refresh after the step notices the item is gone and plans to recreate it
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccItemResourceConfig(name, description string) string {
+	return testAccProviderConfig + fmt.Sprintf(`
+resource "golden_item" "test" {
+  name        = %q
+  description = %q
+}
+`, name, description)
+}
+
+// testAccCheckItemDestroyed verifies the items of the test are gone from the API
+func testAccCheckItemDestroyed(t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := testAccClient(t)
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "golden_item" {
+				continue
+			}
+
+			_, err := c.GetItem(context.Background(), rs.Primary.ID)
+			if errors.Is(err, client.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("item %s still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+}
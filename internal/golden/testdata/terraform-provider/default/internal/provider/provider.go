@@ -0,0 +1,146 @@
+// Package provider implements the golden Terraform provider with terraform-plugin-framework.
+package provider
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/example/golden/internal/client"
+)
+
+// Environment variables configuring the provider when the attributes are not set
+const (
+	EndpointEnvVar = "GOLDEN_ENDPOINT"
+	APITokenEnvVar = "GOLDEN_API_TOKEN"
+)
+
+var _ provider.Provider = &Provider{}
+
+// Provider is the golden provider
+type Provider struct {
+	// version is "dev" for local builds, the release version when built by
+	// goreleaser and "test" in acceptance tests
+	version string
+}
+
+// ProviderModel describes the provider configuration
+type ProviderModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	APIToken types.String `tfsdk:"api_token"`
+}
+
+// New returns the provider constructor expected by providerserver
+func New(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &Provider{
+			version: version,
+		}
+	}
+}
+
+// Metadata returns the provider type name, the prefix of every resource and data source
+func (p *Provider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "golden"
+	resp.Version = p.version
+}
+
+// Schema defines the provider configuration
+func (p *Provider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages golden resources.",
+		Attributes: map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{
+				Description: "Base URL of the API. May also be set with the `" + EndpointEnvVar + "` environment variable.",
+				Optional:    true,
+			},
+			"api_token": schema.StringAttribute{
+				Description: "Token authenticating the API calls. May also be set with the `" + APITokenEnvVar + "` environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Configure creates the API client shared by the resources and data sources
+func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config ProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values computed from other resources are only known at apply time
+	if config.Endpoint.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Unknown API endpoint",
+			"The provider cannot create the API client as the endpoint is unknown. Set it statically or with the "+EndpointEnvVar+" environment variable.",
+		)
+	}
+	if config.APIToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_token"),
+			"Unknown API token",
+			"The provider cannot create the API client as the API token is unknown. Set it statically or with the "+APITokenEnvVar+" environment variable.",
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Attributes take precedence over the environment
+	endpoint := os.Getenv(EndpointEnvVar)
+	if !config.Endpoint.IsNull() {
+		endpoint = config.Endpoint.ValueString()
+	}
+	token := os.Getenv(APITokenEnvVar)
+	if !config.APIToken.IsNull() {
+		token = config.APIToken.ValueString()
+	}
+
+	if endpoint == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Missing API endpoint",
+			"Set the endpoint attribute in the provider configuration or the "+EndpointEnvVar+" environment variable.",
+		)
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "endpoint", endpoint)
+	tflog.Debug(ctx, "Creating API client")
+
+	c, err := client.New(endpoint, token, "terraform-provider-golden/"+p.version)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("endpoint"), "Invalid API endpoint", err.Error())
+		return
+	}
+
+	resp.DataSourceData = c
+	resp.ResourceData = c
+
+	tflog.Info(ctx, "Configured API client")
+}
+
+// Resources lists the resources of the provider
+func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewItemResource,
+	}
+}
+
+// DataSources lists the data sources of the provider
+func (p *Provider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewItemDataSource,
+	}
+}
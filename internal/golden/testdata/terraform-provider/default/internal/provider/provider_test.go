@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/client"
+)
+
+// testAccProtoV6ProviderFactories serves the provider in-process to the Terraform
+// CLI run by the acceptance tests
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"golden": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// TestMain starts the in-memory API for the acceptance tests unless an endpoint is set
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC") == "" || os.Getenv(EndpointEnvVar) != "" {
+		os.Exit(m.Run())
+	}
+
+	server := httptest.NewServer(newTestAPI())
+	os.Setenv(EndpointEnvVar, server.URL)
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
+// testAccPreCheck verifies the environment the acceptance tests need
+func testAccPreCheck(t *testing.T) {
+	t.Helper()
+	if os.Getenv(EndpointEnvVar) == "" {
+		t.Fatalf("%s must be set for acceptance tests", EndpointEnvVar)
+	}
+}
+
+// testAccClient returns an API client for checks made outside Terraform
+func testAccClient(t *testing.T) *client.Client {
+	t.Helper()
+	c, err := client.New(os.Getenv(EndpointEnvVar), os.Getenv(APITokenEnvVar), "acceptance-tests")
+	require.NoError(t, err)
+	return c
+}
+
+// testAccProviderConfig configures the provider from the environment
+const testAccProviderConfig = `
+provider "golden" {}
+`
+
+func TestProvider_Metadata(t *testing.T) {
+	resp := &provider.MetadataResponse{}
+	New("1.2.3")().Metadata(context.Background(), provider.MetadataRequest{}, resp)
+
+	assert.Equal(t, "golden", resp.TypeName)
+	assert.Equal(t, "1.2.3", resp.Version)
+}
+
+func TestProvider_Schema(t *testing.T) {
+	resp := &provider.SchemaResponse{}
+	New("test")().Schema(context.Background(), provider.SchemaRequest{}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "schema diagnostics: %v", resp.Diagnostics)
+
+	assert.False(t, resp.Schema.ValidateImplementation(context.Background()).HasError())
+	assert.True(t, resp.Schema.Attributes["api_token"].IsSensitive())
+}
+
+func TestProvider_ResourcesAndDataSources(t *testing.T) {
+	p := New("test")()
+	assert.Len(t, p.Resources(context.Background()), 1)
+	assert.Len(t, p.DataSources(context.Background()), 1)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/example/golden/internal/app"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/theme"
+)
+
+// version is set at build time with -ldflags "-X main.version=..."
+var version = "dev"
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "golden: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	logFile := flag.String("log-file", defaultLogFile(), "file receiving the application logs")
+	logLevel := flag.String("log-level", "info", "log level: debug, info, warn or error")
+	logFormat := flag.String("log-format", "json", "log format: json or text")
+	themeName := flag.String("theme", "dark", fmt.Sprintf("color theme: %v", theme.Names()))
+	showVersion := flag.Bool("version", false, "print the version and exit")
+	flag.Parse()
+
+	if *showVersion {
+		fmt.Println("golden", version)
+		return nil
+	}
+
+	th, err := theme.ByName(*themeName)
+	if err != nil {
+		return err
+	}
+
+	// The terminal belongs to the interface: logs go to a file, never to stdout
+	if err := os.MkdirAll(filepath.Dir(*logFile), 0o700); err != nil {
+		return fmt.Errorf("failed to create the log directory: %w", err)
+	}
+	out, err := os.OpenFile(*logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open the log file: %w", err)
+	}
+	defer out.Close()
+
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: *logLevel, Format: *logFormat}, out)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+	log.DisableColor()
+	log = log.With("app", "golden", "version", version)
+
+	log.Info("Starting", "theme", th.Name)
+	if _, err := tea.NewProgram(app.New(log, th), tea.WithAltScreen()).Run(); err != nil {
+		log.Error("Program failed", "error", err)
+		return err
+	}
+	log.Info("Stopped")
+	return nil
+}
+
+// defaultLogFile is golden.log in the user cache directory, or in the
+// temporary directory when there is none
+func defaultLogFile() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "golden", "golden.log")
+}
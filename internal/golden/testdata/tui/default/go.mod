@@ -0,0 +1,10 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	github.com/charmbracelet/bubbles v0.21.0
+	github.com/charmbracelet/bubbletea v1.3.5
+	github.com/charmbracelet/lipgloss v1.1.0
+	github.com/stretchr/testify v1.9.0
+)
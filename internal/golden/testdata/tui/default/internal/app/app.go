@@ -0,0 +1,175 @@
+// Package app holds the root model of the application. It keeps the stack of open
+// screens, handles the global keys and lays out the header, the body of the
+// current screen and the help bar.
+package app
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/screens"
+	"github.com/example/golden/internal/theme"
+)
+
+// Model is the root tea.Model of the application
+type Model struct {
+	stack  []screens.Screen
+	keys   KeyMap
+	help   help.Model
+	theme  theme.Theme
+	log    logger.Logger
+	width  int
+	height int
+}
+
+// New creates the application on its home screen
+func New(log logger.Logger, th theme.Theme) Model {
+	h := help.New()
+	h.Styles = th.Help
+
+	return Model{
+		stack: []screens.Screen{screens.NewMenu(th.Name)},
+		keys:  DefaultKeyMap(),
+		help:  h,
+		theme: th,
+		log:   log,
+	}
+}
+
+// Current returns the screen on top of the stack
+func (m Model) Current() screens.Screen {
+	return m.stack[len(m.stack)-1]
+}
+
+// Theme returns the theme in use
+func (m Model) Theme() theme.Theme {
+	return m.theme
+}
+
+// Init implements tea.Model
+func (m Model) Init() tea.Cmd {
+	return m.Current().Init()
+}
+
+// Update implements tea.Model
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.help.Width = msg.Width
+		return m.resize(), nil
+
+	case tea.KeyMsg:
+		if key.Matches(msg, m.keys.ForceQuit) {
+			return m.quit()
+		}
+		if !m.Current().CapturesInput() {
+			switch {
+			case key.Matches(msg, m.keys.Quit):
+				return m.quit()
+			case key.Matches(msg, m.keys.Help):
+				m.help.ShowAll = !m.help.ShowAll
+				return m.resize(), nil
+			case key.Matches(msg, m.keys.Back):
+				return m.close(), nil
+			}
+		}
+
+	case screens.OpenMsg:
+		m.log.Debug("Opening screen", "screen", msg.Screen.Title())
+		m.stack = append(m.stack, msg.Screen)
+		return m.resize(), msg.Screen.Init()
+
+	case screens.CloseMsg:
+		return m.close(), nil
+
+	case screens.ThemeMsg:
+		th, err := theme.ByName(msg.Name)
+		if err != nil {
+			m.log.Warn("Ignoring unknown theme", "theme", msg.Name)
+			return m, nil
+		}
+		m.log.Info("Switched theme", "theme", th.Name)
+		m.theme = th
+		m.help.Styles = th.Help
+
+		// Every open screen hears about the change, not only the current one
+		for i, screen := range m.stack {
+			m.stack[i], _ = screen.Update(msg)
+		}
+		return m, nil
+	}
+
+	// Everything else belongs to the current screen
+	var cmd tea.Cmd
+	m.stack[len(m.stack)-1], cmd = m.Current().Update(msg)
+	return m, cmd
+}
+
+// quit ends the program
+func (m Model) quit() (tea.Model, tea.Cmd) {
+	m.log.Info("Quitting", "screen", m.Current().Title())
+	return m, tea.Quit
+}
+
+// close returns to the previous screen; the home screen stays open
+func (m Model) close() Model {
+	if len(m.stack) == 1 {
+		return m
+	}
+	m.stack = m.stack[:len(m.stack)-1]
+	return m.resize()
+}
+
+// resize gives the current screen the space left by the header, the frame and
+// the help bar
+func (m Model) resize() Model {
+	if m.width == 0 {
+		return m
+	}
+
+	frameWidth, frameHeight := m.theme.Body.GetFrameSize()
+	width := m.width - frameWidth
+	height := m.height - frameHeight - lipgloss.Height(m.header()) - lipgloss.Height(m.helpView())
+
+	m.stack[len(m.stack)-1] = m.Current().Resize(width, height)
+	return m
+}
+
+// View implements tea.Model
+func (m Model) View() string {
+	body := m.theme.Body
+	if m.width > 0 {
+		// Width covers the padding, the border comes on top
+		body = body.Width(m.width - body.GetHorizontalBorderSize())
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		m.header(),
+		body.Render(m.Current().View(m.theme)),
+		m.helpView(),
+	)
+}
+
+// header renders the application name and the path of open screens
+func (m Model) header() string {
+	titles := make([]string, len(m.stack))
+	for i, screen := range m.stack {
+		titles[i] = screen.Title()
+	}
+	return m.theme.Header.Render("golden") + m.theme.Breadcrumb.Render(strings.Join(titles, " › "))
+}
+
+// helpView renders the bindings of the current screen and the global ones
+func (m Model) helpView() string {
+	return m.help.View(helpKeys{
+		screen: m.Current().Keys(),
+		global: m.keys,
+		root:   len(m.stack) == 1,
+	})
+}
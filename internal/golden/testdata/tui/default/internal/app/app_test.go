@@ -0,0 +1,127 @@
+package app
+
+import (
+	"io"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/screens"
+	"github.com/example/golden/internal/theme"
+)
+
+func newTestModel(t *testing.T) Model {
+	t.Helper()
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: "debug", Format: "json"}, io.Discard)
+	require.NoError(t, err)
+
+	m, _ := New(log, theme.Default()).Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	return m.(Model)
+}
+
+func runes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+// update sends msg to m. When a screen answers with a command opening, closing
+// or theming, its message is fed back the way the Bubble Tea runtime would.
+func update(t *testing.T, m Model, msg tea.Msg) Model {
+	t.Helper()
+	next, cmd := m.Update(msg)
+	m = next.(Model)
+
+	key, ok := msg.(tea.KeyMsg)
+	if cmd == nil || !ok || key.Type != tea.KeyEnter {
+		return m
+	}
+	switch msg := cmd().(type) {
+	case screens.OpenMsg, screens.CloseMsg, screens.ThemeMsg:
+		next, _ = m.Update(msg)
+		m = next.(Model)
+	}
+	return m
+}
+
+func TestModel_StartsOnHome(t *testing.T) {
+	m := newTestModel(t)
+
+	assert.Equal(t, "Home", m.Current().Title())
+	assert.Contains(t, m.View(), "Tasks")
+	assert.Contains(t, m.View(), "q quit")
+	assert.NotContains(t, m.View(), "esc back")
+}
+
+func TestModel_OpenAndClose(t *testing.T) {
+	m := newTestModel(t)
+
+	m = update(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, "Tasks", m.Current().Title())
+	assert.Contains(t, m.View(), "Home › Tasks")
+	assert.Contains(t, m.View(), "esc back")
+
+	m = update(t, m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, "Home", m.Current().Title())
+
+	// The home screen stays open
+	m = update(t, m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, "Home", m.Current().Title())
+}
+
+func TestModel_Quit(t *testing.T) {
+	m := newTestModel(t)
+
+	_, cmd := m.Update(runes("q"))
+	require.NotNil(t, cmd)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+}
+
+func TestModel_KeysGoToScreenWhileTyping(t *testing.T) {
+	m := newTestModel(t)
+	m = update(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	m = update(t, m, runes("a"))
+	require.True(t, m.Current().CapturesInput())
+
+	// q is typed into the task title instead of quitting
+	m = update(t, m, runes("q"))
+	assert.Equal(t, "Tasks", m.Current().Title())
+	assert.True(t, m.Current().CapturesInput())
+
+	// ctrl+c always quits
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	require.NotNil(t, cmd)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+}
+
+func TestModel_ToggleHelp(t *testing.T) {
+	m := newTestModel(t)
+	assert.False(t, m.help.ShowAll)
+
+	m = update(t, m, runes("?"))
+	assert.True(t, m.help.ShowAll)
+}
+
+func TestModel_SwitchTheme(t *testing.T) {
+	m := newTestModel(t)
+	m = update(t, m, runes("j"))
+	m = update(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	require.Equal(t, "Settings", m.Current().Title())
+
+	m = update(t, m, runes("j"))
+	m = update(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, "light", m.Theme().Name)
+
+	// Screens opened from the home menu now start with the new theme
+	m = update(t, m, tea.KeyMsg{Type: tea.KeyEsc})
+	m = update(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Contains(t, m.Current().View(m.Theme()), "light (current)")
+}
+
+func TestModel_UnknownThemeIsIgnored(t *testing.T) {
+	m := newTestModel(t)
+
+	m = update(t, m, screens.ThemeMsg{Name: "neon"})
+	assert.Equal(t, "dark", m.Theme().Name)
+}
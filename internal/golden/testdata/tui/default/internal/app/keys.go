@@ -0,0 +1,60 @@
+package app
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// KeyMap holds the global bindings, available on every screen
+type KeyMap struct {
+	Back      key.Binding
+	Help      key.Binding
+	Quit      key.Binding
+	ForceQuit key.Binding
+}
+
+// DefaultKeyMap returns the default global bindings
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Back: key.NewBinding(
+			key.WithKeys("esc", "backspace"),
+			key.WithHelp("esc", "back"),
+		),
+		Help: key.NewBinding(
+			key.WithKeys("?"),
+			key.WithHelp("?", "help"),
+		),
+		Quit: key.NewBinding(
+			key.WithKeys("q"),
+			key.WithHelp("q", "quit"),
+		),
+		// ctrl+c quits even while a screen captures the keyboard
+		ForceQuit: key.NewBinding(
+			key.WithKeys("ctrl+c"),
+		),
+	}
+}
+
+// helpKeys combines the bindings of the current screen with the global ones for
+// the help bar
+type helpKeys struct {
+	screen []key.Binding
+	global KeyMap
+	root   bool // the home screen has nowhere to go back to
+}
+
+// ShortHelp implements help.KeyMap
+func (h helpKeys) ShortHelp() []key.Binding {
+	return append(append([]key.Binding{}, h.screen...), h.globalBindings()...)
+}
+
+// FullHelp implements help.KeyMap
+func (h helpKeys) FullHelp() [][]key.Binding {
+	return [][]key.Binding{h.screen, h.globalBindings()}
+}
+
+func (h helpKeys) globalBindings() []key.Binding {
+	if h.root {
+		return []key.Binding{h.global.Help, h.global.Quit}
+	}
+	return []key.Binding{h.global.Back, h.global.Help, h.global.Quit}
+}
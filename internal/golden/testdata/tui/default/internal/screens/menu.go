@@ -0,0 +1,94 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/example/golden/internal/theme"
+)
+
+// menuEntry is a destination of the home menu
+type menuEntry struct {
+	label       string
+	description string
+	open        func(themeName string) Screen
+}
+
+// menuEntries are the screens reachable from the home menu
+var menuEntries = []menuEntry{
+	{label: "Tasks", description: "Keep track of things to do", open: func(string) Screen { return NewTasks() }},
+	{label: "Settings", description: "Choose the color theme", open: func(themeName string) Screen { return NewSettings(themeName) }},
+}
+
+// Menu is the home screen, listing the other screens
+type Menu struct {
+	themeName string
+	cursor    int
+}
+
+var menuKeys = struct {
+	Open key.Binding
+}{
+	Open: key.NewBinding(
+		key.WithKeys("enter", "l", "right"),
+		key.WithHelp("enter", "open"),
+	),
+}
+
+// NewMenu creates the home screen of an application using the theme called themeName
+func NewMenu(themeName string) Menu {
+	return Menu{themeName: themeName}
+}
+
+// Init implements Screen
+func (m Menu) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements Screen
+func (m Menu) Update(msg tea.Msg) (Screen, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if key.Matches(msg, menuKeys.Open) {
+			return m, Open(menuEntries[m.cursor].open(m.themeName))
+		}
+		m.cursor = moveCursor(msg, m.cursor, len(menuEntries))
+	case ThemeMsg:
+		m.themeName = msg.Name
+	}
+	return m, nil
+}
+
+// View implements Screen
+func (m Menu) View(th theme.Theme) string {
+	var b strings.Builder
+	for i, entry := range menuEntries {
+		b.WriteString(th.ListItem(fmt.Sprintf("%-10s", entry.label), i == m.cursor))
+		b.WriteString(" " + th.Muted.Render(entry.description))
+		b.WriteString("\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// Resize implements Screen
+func (m Menu) Resize(width, height int) Screen {
+	return m
+}
+
+// Title implements Screen
+func (m Menu) Title() string {
+	return "Home"
+}
+
+// Keys implements Screen
+func (m Menu) Keys() []key.Binding {
+	return []key.Binding{cursorKeys.Up, cursorKeys.Down, menuKeys.Open}
+}
+
+// CapturesInput implements Screen
+func (m Menu) CapturesInput() bool {
+	return false
+}
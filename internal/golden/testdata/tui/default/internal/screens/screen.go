@@ -0,0 +1,85 @@
+// Package screens holds the screens of the application. The root model in
+// internal/app keeps them on a stack: the top screen receives the messages and
+// renders the body, and screens open or close others by returning messages.
+package screens
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/example/golden/internal/theme"
+)
+
+// Screen is one page of the application
+type Screen interface {
+	// Init returns the command to run when the screen is opened
+	Init() tea.Cmd
+
+	// Update handles a message, the same way as tea.Model
+	Update(msg tea.Msg) (Screen, tea.Cmd)
+
+	// View renders the screen body with the current theme
+	View(th theme.Theme) string
+
+	// Resize gives the screen the size of the body, without the header and help bar
+	Resize(width, height int) Screen
+
+	// Title names the screen in the breadcrumb
+	Title() string
+
+	// Keys lists the screen bindings shown in the help bar, next to the global ones
+	Keys() []key.Binding
+
+	// CapturesInput reports whether the screen is editing text, so keys such as
+	// q and esc reach the screen instead of quitting or closing it
+	CapturesInput() bool
+}
+
+// OpenMsg pushes a screen on top of the current one
+type OpenMsg struct {
+	Screen Screen
+}
+
+// CloseMsg closes the current screen, returning to the previous one
+type CloseMsg struct{}
+
+// ThemeMsg switches the application theme
+type ThemeMsg struct {
+	Name string
+}
+
+// Open returns the command opening screen
+func Open(screen Screen) tea.Cmd {
+	return func() tea.Msg { return OpenMsg{Screen: screen} }
+}
+
+// Close returns the command closing the current screen
+func Close() tea.Msg {
+	return CloseMsg{}
+}
+
+// cursorKeys are the list navigation bindings shared by the screens
+var cursorKeys = struct {
+	Up   key.Binding
+	Down key.Binding
+}{
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "down"),
+	),
+}
+
+// moveCursor moves cursor within [0, count) for the navigation keys
+func moveCursor(msg tea.KeyMsg, cursor, count int) int {
+	switch {
+	case key.Matches(msg, cursorKeys.Up) && cursor > 0:
+		return cursor - 1
+	case key.Matches(msg, cursorKeys.Down) && cursor < count-1:
+		return cursor + 1
+	}
+	return cursor
+}
@@ -0,0 +1,93 @@
+package screens
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/example/golden/internal/theme"
+)
+
+// Settings lets the user pick the color theme
+type Settings struct {
+	themes  []string
+	current string
+	cursor  int
+}
+
+var settingsKeys = struct {
+	Apply key.Binding
+}{
+	Apply: key.NewBinding(
+		key.WithKeys("enter", " "),
+		key.WithHelp("enter", "apply"),
+	),
+}
+
+// NewSettings creates the settings screen, with the cursor on the theme in use
+func NewSettings(current string) Settings {
+	s := Settings{themes: theme.Names(), current: current}
+	for i, name := range s.themes {
+		if name == current {
+			s.cursor = i
+		}
+	}
+	return s
+}
+
+// Init implements Screen
+func (s Settings) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements Screen
+func (s Settings) Update(msg tea.Msg) (Screen, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if key.Matches(msg, settingsKeys.Apply) {
+			name := s.themes[s.cursor]
+			return s, func() tea.Msg { return ThemeMsg{Name: name} }
+		}
+		s.cursor = moveCursor(msg, s.cursor, len(s.themes))
+	case ThemeMsg:
+		s.current = msg.Name
+	}
+	return s, nil
+}
+
+// View implements Screen
+func (s Settings) View(th theme.Theme) string {
+	var b strings.Builder
+	b.WriteString(th.Muted.Render("Theme"))
+	b.WriteString("\n\n")
+	for i, name := range s.themes {
+		label := name
+		if name == s.current {
+			label += " " + th.Muted.Render("(current)")
+		}
+		b.WriteString(th.ListItem(label, i == s.cursor))
+		b.WriteString("\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// Resize implements Screen
+func (s Settings) Resize(width, height int) Screen {
+	return s
+}
+
+// Title implements Screen
+func (s Settings) Title() string {
+	return "Settings"
+}
+
+// Keys implements Screen
+func (s Settings) Keys() []key.Binding {
+	return []key.Binding{cursorKeys.Up, cursorKeys.Down, settingsKeys.Apply}
+}
+
+// CapturesInput implements Screen
+func (s Settings) CapturesInput() bool {
+	return false
+}
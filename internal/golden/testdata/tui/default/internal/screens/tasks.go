@@ -0,0 +1,237 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/example/golden/internal/theme"
+)
+
+// Task is an entry of the task list
+type Task struct {
+	Title string
+	Done  bool
+}
+
+// Tasks is a task list: add, check off and delete tasks. It shows how a screen
+// scrolls a list and hands the keyboard to a text input.
+type Tasks struct {
+	tasks  []Task
+	cursor int
+	offset int // first visible task
+	height int
+
+	adding bool
+	input  textinput.Model
+	err    string
+}
+
+var tasksKeys = struct {
+	Toggle key.Binding
+	Add    key.Binding
+	Delete key.Binding
+	Save   key.Binding
+	Cancel key.Binding
+}{
+	Toggle: key.NewBinding(
+		key.WithKeys(" ", "x"),
+		key.WithHelp("space", "done"),
+	),
+	Add: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "add"),
+	),
+	Delete: key.NewBinding(
+		key.WithKeys("d", "delete"),
+		key.WithHelp("d", "delete"),
+	),
+	Save: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "save"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "cancel"),
+	),
+}
+
+// NewTasks creates the task list screen with a few example tasks
+func NewTasks() Tasks {
+	input := textinput.New()
+	input.Placeholder = "What needs doing?"
+	input.CharLimit = 120
+	input.Prompt = "+ "
+
+	return Tasks{
+		tasks: []Task{
+			{Title: "Read the generated README"},
+			{Title: "Add a screen of your own"},
+			{Title: "Pick a theme in the settings", Done: true},
+		},
+		input: input,
+	}
+}
+
+// Items returns the tasks of the list
+func (t Tasks) Items() []Task {
+	return t.tasks
+}
+
+// Init implements Screen
+func (t Tasks) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements Screen
+func (t Tasks) Update(msg tea.Msg) (Screen, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		if t.adding {
+			var cmd tea.Cmd
+			t.input, cmd = t.input.Update(msg)
+			return t, cmd
+		}
+		return t, nil
+	}
+
+	if t.adding {
+		return t.updateInput(keyMsg)
+	}
+
+	switch {
+	case key.Matches(keyMsg, tasksKeys.Add):
+		t.adding = true
+		t.err = ""
+		return t, t.input.Focus()
+	case key.Matches(keyMsg, tasksKeys.Toggle) && len(t.tasks) > 0:
+		t.tasks[t.cursor].Done = !t.tasks[t.cursor].Done
+	case key.Matches(keyMsg, tasksKeys.Delete) && len(t.tasks) > 0:
+		t.tasks = append(t.tasks[:t.cursor:t.cursor], t.tasks[t.cursor+1:]...)
+		if t.cursor >= len(t.tasks) && t.cursor > 0 {
+			t.cursor--
+		}
+	default:
+		t.cursor = moveCursor(keyMsg, t.cursor, len(t.tasks))
+	}
+	t.scroll()
+	return t, nil
+}
+
+// updateInput handles the keys while a task is being typed
+func (t Tasks) updateInput(msg tea.KeyMsg) (Screen, tea.Cmd) {
+	switch {
+	case key.Matches(msg, tasksKeys.Save):
+		title := strings.TrimSpace(t.input.Value())
+		if title == "" {
+			t.err = "A task needs a title"
+			return t, nil
+		}
+		t.tasks = append(t.tasks, Task{Title: title})
+		t.cursor = len(t.tasks) - 1
+		fallthrough
+	case key.Matches(msg, tasksKeys.Cancel):
+		t.adding = false
+		t.err = ""
+		t.input.Reset()
+		t.input.Blur()
+		t.scroll()
+		return t, nil
+	}
+
+	var cmd tea.Cmd
+	t.input, cmd = t.input.Update(msg)
+	return t, cmd
+}
+
+// visibleRows is the number of tasks that fit, leaving room for the input line
+func (t Tasks) visibleRows() int {
+	rows := t.height - 2
+	if rows < 1 || t.height == 0 {
+		return len(t.tasks)
+	}
+	return rows
+}
+
+// scroll keeps the cursor within the visible rows
+func (t *Tasks) scroll() {
+	rows := t.visibleRows()
+	if t.cursor < t.offset {
+		t.offset = t.cursor
+	}
+	if t.cursor >= t.offset+rows {
+		t.offset = t.cursor - rows + 1
+	}
+}
+
+// View implements Screen
+func (t Tasks) View(th theme.Theme) string {
+	var b strings.Builder
+
+	if len(t.tasks) == 0 {
+		b.WriteString(th.Muted.Render("Nothing to do. Press a to add a task."))
+		b.WriteString("\n")
+	}
+
+	end := t.offset + t.visibleRows()
+	if end > len(t.tasks) {
+		end = len(t.tasks)
+	}
+	for i := t.offset; i < end; i++ {
+		task := t.tasks[i]
+		label := "[ ] " + task.Title
+		if task.Done {
+			label = "[x] " + th.Done.Render(task.Title)
+		}
+		b.WriteString(th.ListItem(label, i == t.cursor && !t.adding))
+		b.WriteString("\n")
+	}
+
+	done := 0
+	for _, task := range t.tasks {
+		if task.Done {
+			done++
+		}
+	}
+
+	b.WriteString("\n")
+	switch {
+	case t.adding:
+		b.WriteString(t.input.View())
+		if t.err != "" {
+			b.WriteString("  " + th.Error.Render(t.err))
+		}
+	default:
+		b.WriteString(th.Muted.Render(fmt.Sprintf("%d of %d done", done, len(t.tasks))))
+	}
+	return b.String()
+}
+
+// Resize implements Screen
+func (t Tasks) Resize(width, height int) Screen {
+	t.height = height
+	t.input.Width = width - len(t.input.Prompt) - 1
+	t.scroll()
+	return t
+}
+
+// Title implements Screen
+func (t Tasks) Title() string {
+	return "Tasks"
+}
+
+// Keys implements Screen
+func (t Tasks) Keys() []key.Binding {
+	if t.adding {
+		return []key.Binding{tasksKeys.Save, tasksKeys.Cancel}
+	}
+	return []key.Binding{cursorKeys.Up, cursorKeys.Down, tasksKeys.Toggle, tasksKeys.Add, tasksKeys.Delete}
+}
+
+// CapturesInput implements Screen
+func (t Tasks) CapturesInput() bool {
+	return t.adding
+}
@@ -0,0 +1,87 @@
+package screens
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/theme"
+)
+
+func press(t *testing.T, s Screen, msgs ...tea.KeyMsg) Tasks {
+	t.Helper()
+	for _, msg := range msgs {
+		s, _ = s.Update(msg)
+	}
+	tasks, ok := s.(Tasks)
+	require.True(t, ok)
+	return tasks
+}
+
+func typed(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+var (
+	enter = tea.KeyMsg{Type: tea.KeyEnter}
+	esc   = tea.KeyMsg{Type: tea.KeyEsc}
+	space = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")}
+)
+
+func TestTasks_Add(t *testing.T) {
+	tasks := press(t, NewTasks(), typed("a"))
+	assert.True(t, tasks.CapturesInput())
+
+	tasks = press(t, tasks, typed("buy milk"), enter)
+	assert.False(t, tasks.CapturesInput())
+
+	items := tasks.Items()
+	assert.Equal(t, Task{Title: "buy milk"}, items[len(items)-1])
+	assert.Equal(t, len(items)-1, tasks.cursor)
+}
+
+func TestTasks_AddNeedsTitle(t *testing.T) {
+	before := len(NewTasks().Items())
+
+	tasks := press(t, NewTasks(), typed("a"), typed("  "), enter)
+	assert.True(t, tasks.CapturesInput())
+	assert.Contains(t, tasks.View(theme.Default()), "A task needs a title")
+
+	tasks = press(t, tasks, esc)
+	assert.False(t, tasks.CapturesInput())
+	assert.Len(t, tasks.Items(), before)
+}
+
+func TestTasks_ToggleAndDelete(t *testing.T) {
+	tasks := press(t, NewTasks(), space)
+	assert.True(t, tasks.Items()[0].Done)
+
+	tasks = press(t, tasks, space)
+	assert.False(t, tasks.Items()[0].Done)
+
+	second := tasks.Items()[1]
+	tasks = press(t, tasks, typed("d"))
+	assert.Equal(t, second, tasks.Items()[0])
+}
+
+func TestTasks_DeleteLast(t *testing.T) {
+	tasks := press(t, NewTasks(), typed("j"), typed("j"), typed("d"))
+	assert.Len(t, tasks.Items(), 2)
+	assert.Equal(t, 1, tasks.cursor)
+
+	tasks = press(t, tasks, typed("d"), typed("d"), typed("d"))
+	assert.Empty(t, tasks.Items())
+	assert.Contains(t, tasks.View(theme.Default()), "Nothing to do")
+}
+
+func TestTasks_Scroll(t *testing.T) {
+	s := NewTasks().Resize(40, 4)
+	tasks := press(t, s, typed("j"), typed("j"))
+
+	// Two rows fit: the first task scrolled out of view
+	view := tasks.View(theme.Default())
+	assert.NotContains(t, view, tasks.Items()[0].Title)
+	assert.Contains(t, view, tasks.Items()[2].Title)
+}
@@ -0,0 +1,114 @@
+// Package theme holds the lipgloss styles of the application. Screens render with
+// the Theme they are given, so switching themes at runtime restyles everything.
+package theme
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// palette is the set of colors a theme is built from
+type palette struct {
+	accent    lipgloss.Color
+	text      lipgloss.Color
+	muted     lipgloss.Color
+	highlight lipgloss.Color
+	success   lipgloss.Color
+	danger    lipgloss.Color
+	border    lipgloss.Color
+}
+
+var palettes = map[string]palette{
+	"dark": {
+		accent:    lipgloss.Color("#7D56F4"),
+		text:      lipgloss.Color("#FAFAFA"),
+		muted:     lipgloss.Color("#7A7A7A"),
+		highlight: lipgloss.Color("#EE6FF8"),
+		success:   lipgloss.Color("#04B575"),
+		danger:    lipgloss.Color("#FF5F87"),
+		border:    lipgloss.Color("#3C3C3C"),
+	},
+	"light": {
+		accent:    lipgloss.Color("#5A3FC0"),
+		text:      lipgloss.Color("#1A1A1A"),
+		muted:     lipgloss.Color("#8A8A8A"),
+		highlight: lipgloss.Color("#C22BB5"),
+		success:   lipgloss.Color("#027A50"),
+		danger:    lipgloss.Color("#D7004B"),
+		border:    lipgloss.Color("#D0D0D0"),
+	},
+}
+
+// Names lists the available themes, in display order
+func Names() []string {
+	return []string{"dark", "light"}
+}
+
+// Theme is a named set of styles
+type Theme struct {
+	Name string
+
+	// Header is the application title, Breadcrumb the path of open screens
+	Header     lipgloss.Style
+	Breadcrumb lipgloss.Style
+
+	// Body frames the screen content
+	Body lipgloss.Style
+
+	// Item is a list entry, Selected the entry under the cursor
+	Item     lipgloss.Style
+	Selected lipgloss.Style
+	Muted    lipgloss.Style
+	Done     lipgloss.Style
+	Error    lipgloss.Style
+
+	// Help styles the keybinding help bar
+	Help help.Styles
+}
+
+// ByName returns the theme called name
+func ByName(name string) (Theme, error) {
+	p, ok := palettes[name]
+	if !ok {
+		return Theme{}, fmt.Errorf("unknown theme %q (available: %v)", name, Names())
+	}
+	return newTheme(name, p), nil
+}
+
+// Default returns the dark theme
+func Default() Theme {
+	return newTheme("dark", palettes["dark"])
+}
+
+// ListItem renders a list entry, marking the one under the cursor
+func (t Theme) ListItem(label string, selected bool) string {
+	if selected {
+		return t.Selected.Render("> " + label)
+	}
+	return t.Item.Render(label)
+}
+
+func newTheme(name string, p palette) Theme {
+	return Theme{
+		Name:       name,
+		Header:     lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#FFFFFF")).Background(p.accent).Padding(0, 1),
+		Breadcrumb: lipgloss.NewStyle().Foreground(p.muted).PaddingLeft(1),
+		Body:       lipgloss.NewStyle().Padding(1, 2).BorderStyle(lipgloss.RoundedBorder()).BorderForeground(p.border),
+		Item:       lipgloss.NewStyle().Foreground(p.text).PaddingLeft(3),
+		Selected:   lipgloss.NewStyle().Foreground(p.highlight).Bold(true).PaddingLeft(1),
+		Muted:      lipgloss.NewStyle().Foreground(p.muted),
+		Done:       lipgloss.NewStyle().Foreground(p.success).Strikethrough(true),
+		Error:      lipgloss.NewStyle().Foreground(p.danger),
+		Help: help.Styles{
+			ShortKey:       lipgloss.NewStyle().Foreground(p.accent),
+			ShortDesc:      lipgloss.NewStyle().Foreground(p.muted),
+			ShortSeparator: lipgloss.NewStyle().Foreground(p.border),
+			Ellipsis:       lipgloss.NewStyle().Foreground(p.border),
+			FullKey:        lipgloss.NewStyle().Foreground(p.accent),
+			FullDesc:       lipgloss.NewStyle().Foreground(p.muted),
+			FullSeparator:  lipgloss.NewStyle().Foreground(p.border),
+		},
+	}
+}
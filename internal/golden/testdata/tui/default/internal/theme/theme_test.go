@@ -0,0 +1,30 @@
+package theme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestByName(t *testing.T) {
+	for _, name := range Names() {
+		th, err := ByName(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, name, th.Name)
+	}
+
+	_, err := ByName("neon")
+	assert.Error(t, err)
+}
+
+func TestDefault(t *testing.T) {
+	assert.Equal(t, "dark", Default().Name)
+}
+
+func TestListItem(t *testing.T) {
+	th := Default()
+
+	assert.Contains(t, th.ListItem("tasks", true), "> tasks")
+	assert.NotContains(t, th.ListItem("tasks", false), ">")
+}
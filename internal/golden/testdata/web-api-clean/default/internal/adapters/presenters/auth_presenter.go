@@ -0,0 +1,103 @@
+package presenters
+
+import (
+	"github.com/example/golden/internal/domain/entities"
+	"github.com/example/golden/internal/domain/usecases"
+)
+
+// AuthPresenter formats authentication data for API responses
+type AuthPresenter struct{}
+
+// LoginResponse represents a successful login response
+type LoginResponse struct {
+	User         UserResponse `json:"user"`
+	AccessToken  string       `json:"access_token"`
+	RefreshToken string       `json:"refresh_token"`
+	TokenType    string       `json:"token_type"`
+	ExpiresIn    int64        `json:"expires_in"`
+}
+
+// NewAuthPresenter creates a new AuthPresenter instance
+func NewAuthPresenter() *AuthPresenter {
+	return &AuthPresenter{}
+}
+
+// PresentLogin converts LoginOutput to LoginResponse
+func (ap *AuthPresenter) PresentLogin(output *usecases.LoginOutput) LoginResponse {
+	if output == nil {
+		return LoginResponse{}
+	}
+
+	// Use UserPresenter to format user data
+	userPresenter := NewUserPresenter()
+	userResponse := userPresenter.PresentUser(output.User)
+
+	return LoginResponse{
+		User:         userResponse,
+		AccessToken:  output.AccessToken,
+		RefreshToken: output.RefreshToken,
+		TokenType:    output.TokenType,
+		ExpiresIn:    output.ExpiresIn,
+	}
+}
+
+// PresentError converts an error to ErrorResponse
+func (ap *AuthPresenter) PresentError(err error) ErrorResponse {
+	if err == nil {
+		return ErrorResponse{}
+	}
+
+	// Map authentication domain errors to appropriate messages
+	switch err {
+	case entities.ErrInvalidCredentials:
+		return ErrorResponse{
+			Error:   "INVALID_CREDENTIALS",
+			Message: "Invalid email/username or password",
+		}
+	case entities.ErrTokenExpired:
+		return ErrorResponse{
+			Error:   "TOKEN_EXPIRED",
+			Message: "The access token has expired",
+		}
+	case entities.ErrInvalidToken:
+		return ErrorResponse{
+			Error:   "INVALID_TOKEN",
+			Message: "The provided token is invalid",
+		}
+	case entities.ErrSessionNotFound:
+		return ErrorResponse{
+			Error:   "SESSION_NOT_FOUND",
+			Message: "No active session found",
+		}
+	case entities.ErrSessionExpired:
+		return ErrorResponse{
+			Error:   "SESSION_EXPIRED",
+			Message: "The session has expired",
+		}
+	case entities.ErrAccountTokenInvalid:
+		return ErrorResponse{
+			Error:   "INVALID_ACCOUNT_TOKEN",
+			Message: "The link is invalid, expired or was already used",
+		}
+	case entities.ErrEmailAlreadyVerified:
+		return ErrorResponse{
+			Error:   "EMAIL_ALREADY_VERIFIED",
+			Message: "The email address is already verified",
+		}
+	case entities.ErrTooManyRequests:
+		return ErrorResponse{
+			Error:   "TOO_MANY_REQUESTS",
+			Message: "Too many requests, please try again later",
+		}
+	case entities.ErrWeakPassword:
+		return ErrorResponse{
+			Error:   "WEAK_PASSWORD",
+			Message: "The password does not meet security requirements",
+		}
+	default:
+		return ErrorResponse{
+			Error:   "INTERNAL_ERROR",
+			Message: "An internal error occurred",
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Package clock tells the application the time. Code asks a Clock instead of
+// calling time.Now, so tests set the time with a Fake instead of sleeping. It
+// only depends on the standard library, so every layer may use it.
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the current time
+type Clock interface {
+	Now() time.Time
+}
+
+// Real is the clock of the system
+type Real struct{}
+
+// New returns the clock of the system
+func New() Clock {
+	return Real{}
+}
+
+// Now returns the current time
+func (Real) Now() time.Time {
+	return time.Now()
+}
+
+// Fake is a clock standing still until it is set or advanced, safe for concurrent use
+type Fake struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFake returns a clock stopped at now
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now returns the time the clock is stopped at
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Advance moves the clock forward by d
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+// Set stops the clock at now
+func (f *Fake) Set(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = now
+}
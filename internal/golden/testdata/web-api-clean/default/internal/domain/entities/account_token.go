@@ -0,0 +1,60 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// AccountTokenPurpose identifies the account flow a token belongs to
+type AccountTokenPurpose string
+
+// Account token purposes
+const (
+	PurposeEmailVerification AccountTokenPurpose = "email_verification"
+	PurposePasswordReset     AccountTokenPurpose = "password_reset"
+)
+
+// AccountToken is a single-use token sent by email to verify an address or reset a password
+// Only the signed hash of the token is stored, the token itself is only known to the recipient
+type AccountToken struct {
+	ID        string              `json:"id"`
+	UserID    string              `json:"user_id"`
+	Purpose   AccountTokenPurpose `json:"purpose"`
+	TokenHash string              `json:"-"`
+	ExpiresAt time.Time           `json:"expires_at"`
+	UsedAt    *time.Time          `json:"used_at,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
+}
+
+// Account flow errors
+var (
+	ErrAccountTokenInvalid  = errors.New("invalid or expired account token")
+	ErrEmailAlreadyVerified = errors.New("email address already verified")
+	ErrTooManyRequests      = errors.New("too many requests, try again later")
+)
+
+// NewAccountToken creates a new account token valid for the given duration from now
+func NewAccountToken(userID string, purpose AccountTokenPurpose, tokenHash string, validFor time.Duration, now time.Time) *AccountToken {
+	return &AccountToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: tokenHash,
+		ExpiresAt: now.Add(validFor),
+		CreatedAt: now,
+	}
+}
+
+// IsExpired checks if the token has expired at now
+func (t *AccountToken) IsExpired(now time.Time) bool {
+	return now.After(t.ExpiresAt)
+}
+
+// IsUsed checks if the token was already redeemed
+func (t *AccountToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+// CanRedeem reports whether the token can still be used at now
+func (t *AccountToken) CanRedeem(now time.Time) bool {
+	return !t.IsUsed() && !t.IsExpired(now)
+}
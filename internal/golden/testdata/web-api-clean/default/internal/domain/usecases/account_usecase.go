@@ -0,0 +1,283 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/domain/entities"
+	"github.com/example/golden/internal/domain/ports"
+)
+
+// AccountPolicy configures the email verification and password reset flows
+type AccountPolicy struct {
+	// VerificationTokenTTL is how long an email verification link stays valid
+	VerificationTokenTTL time.Duration
+	// ResetTokenTTL is how long a password reset link stays valid
+	ResetTokenTTL time.Duration
+	// MaxRequestsPerHour limits the emails of each kind a user can request per hour (0 disables the limit)
+	MaxRequestsPerHour int
+	// PasswordMinLength is the minimum length of a new password
+	PasswordMinLength int
+}
+
+// DefaultAccountPolicy returns the account policy used when none is configured
+func DefaultAccountPolicy() AccountPolicy {
+	return AccountPolicy{
+		VerificationTokenTTL: 24 * time.Hour,
+		ResetTokenTTL:        time.Hour,
+		MaxRequestsPerHour:   3,
+		PasswordMinLength:    8,
+	}
+}
+
+// AccountUseCase implements the email verification and password reset flows
+type AccountUseCase struct {
+	userRepo        ports.UserRepository
+	tokenRepo       ports.AccountTokenRepository
+	sessionRepo     ports.AuthSessionRepository
+	passwordService ports.PasswordService
+	tokenSigner     ports.AccountTokenSigner
+	emailService    ports.EmailService
+	logger          ports.Logger
+	clock           clock.Clock
+	policy          AccountPolicy
+}
+
+// ResetPasswordInput represents password reset input
+type ResetPasswordInput struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+// NewAccountUseCase creates a new AccountUseCase instance
+func NewAccountUseCase(
+	userRepo ports.UserRepository,
+	tokenRepo ports.AccountTokenRepository,
+	sessionRepo ports.AuthSessionRepository,
+	passwordService ports.PasswordService,
+	tokenSigner ports.AccountTokenSigner,
+	emailService ports.EmailService,
+	logger ports.Logger,
+	clock clock.Clock,
+	policy AccountPolicy,
+) *AccountUseCase {
+	return &AccountUseCase{
+		userRepo:        userRepo,
+		tokenRepo:       tokenRepo,
+		sessionRepo:     sessionRepo,
+		passwordService: passwordService,
+		tokenSigner:     tokenSigner,
+		emailService:    emailService,
+		logger:          logger,
+		clock:           clock,
+		policy:          policy,
+	}
+}
+
+// RequestEmailVerification sends a verification link to the user's email address
+func (uc *AccountUseCase) RequestEmailVerification(ctx context.Context, userID string) error {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("Failed to get user for email verification", "error", err, "user_id", userID)
+		return err
+	}
+
+	if user.EmailVerified {
+		return entities.ErrEmailAlreadyVerified
+	}
+
+	token, err := uc.issueToken(ctx, user, entities.PurposeEmailVerification, uc.policy.VerificationTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.emailService.SendEmailVerification(ctx, user, token); err != nil {
+		uc.logger.Error("Failed to send verification email", "error", err, "user_id", user.ID)
+		return err
+	}
+
+	uc.logger.Info("Email verification requested", "user_id", user.ID)
+	return nil
+}
+
+// VerifyEmail redeems a verification token and marks the email address as verified
+func (uc *AccountUseCase) VerifyEmail(ctx context.Context, token string) error {
+	accountToken, err := uc.redeemToken(ctx, entities.PurposeEmailVerification, token)
+	if err != nil {
+		return err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, accountToken.UserID)
+	if err != nil {
+		uc.logger.Error("Failed to get user for email verification", "error", err, "user_id", accountToken.UserID)
+		return err
+	}
+
+	if !user.EmailVerified {
+		user.VerifyEmail(uc.clock.Now())
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			uc.logger.Error("Failed to mark email as verified", "error", err, "user_id", user.ID)
+			return err
+		}
+	}
+
+	// Older verification links are no longer needed
+	if err := uc.tokenRepo.DeleteByUserID(ctx, user.ID, entities.PurposeEmailVerification); err != nil {
+		uc.logger.Warn("Failed to delete outstanding verification tokens", "error", err, "user_id", user.ID)
+	}
+
+	uc.logger.Info("Email address verified", "user_id", user.ID)
+	return nil
+}
+
+// RequestPasswordReset sends a password reset link if an active account uses the email address.
+// Unknown addresses and rate-limited requests succeed silently so callers cannot probe which addresses are registered.
+func (uc *AccountUseCase) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := uc.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, entities.ErrUserNotFound) {
+			uc.logger.Info("Password reset requested for unknown email")
+			return nil
+		}
+		uc.logger.Error("Failed to get user for password reset", "error", err)
+		return err
+	}
+
+	if !user.IsActive {
+		uc.logger.Warn("Password reset requested for inactive user", "user_id", user.ID)
+		return nil
+	}
+
+	token, err := uc.issueToken(ctx, user, entities.PurposePasswordReset, uc.policy.ResetTokenTTL)
+	if errors.Is(err, entities.ErrTooManyRequests) {
+		uc.logger.Warn("Password reset requests rate limited", "user_id", user.ID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := uc.emailService.SendPasswordResetEmail(ctx, user, token); err != nil {
+		uc.logger.Error("Failed to send password reset email", "error", err, "user_id", user.ID)
+		return err
+	}
+
+	uc.logger.Info("Password reset requested", "user_id", user.ID)
+	return nil
+}
+
+// ResetPassword redeems a reset token, sets the new password and ends all of the user's sessions
+func (uc *AccountUseCase) ResetPassword(ctx context.Context, input ResetPasswordInput) error {
+	if len(input.Password) < uc.policy.PasswordMinLength {
+		return entities.ErrWeakPassword
+	}
+
+	accountToken, err := uc.redeemToken(ctx, entities.PurposePasswordReset, input.Token)
+	if err != nil {
+		return err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, accountToken.UserID)
+	if err != nil {
+		uc.logger.Error("Failed to get user for password reset", "error", err, "user_id", accountToken.UserID)
+		return err
+	}
+
+	hashedPassword, err := uc.passwordService.Hash(input.Password)
+	if err != nil {
+		uc.logger.Error("Failed to hash password", "error", err)
+		return err
+	}
+
+	now := uc.clock.Now()
+	user.ChangePassword(hashedPassword, now)
+	// The reset link reached the user, which proves they own the address
+	user.VerifyEmail(now)
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		uc.logger.Error("Failed to save new password", "error", err, "user_id", user.ID)
+		return err
+	}
+
+	// Other reset links must not outlive the new password
+	if err := uc.tokenRepo.DeleteByUserID(ctx, user.ID, entities.PurposePasswordReset); err != nil {
+		uc.logger.Warn("Failed to delete outstanding reset tokens", "error", err, "user_id", user.ID)
+	}
+
+	// Sign out everywhere, the old password may have been compromised
+	if err := uc.sessionRepo.DeleteByUserID(ctx, user.ID); err != nil {
+		uc.logger.Error("Failed to end sessions after password reset", "error", err, "user_id", user.ID)
+		return err
+	}
+
+	uc.logger.Info("Password reset completed", "user_id", user.ID)
+	return nil
+}
+
+// CleanupExpiredTokens removes expired account tokens
+func (uc *AccountUseCase) CleanupExpiredTokens(ctx context.Context) error {
+	if err := uc.tokenRepo.DeleteExpired(ctx); err != nil {
+		uc.logger.Error("Failed to cleanup expired account tokens", "error", err)
+		return err
+	}
+	return nil
+}
+
+// issueToken enforces the request limit, then creates and stores a new token for the user
+func (uc *AccountUseCase) issueToken(ctx context.Context, user *entities.User, purpose entities.AccountTokenPurpose, validFor time.Duration) (string, error) {
+	now := uc.clock.Now()
+	if uc.policy.MaxRequestsPerHour > 0 {
+		count, err := uc.tokenRepo.CountSince(ctx, user.ID, purpose, now.Add(-time.Hour))
+		if err != nil {
+			uc.logger.Error("Failed to count account tokens", "error", err, "user_id", user.ID)
+			return "", err
+		}
+		if count >= int64(uc.policy.MaxRequestsPerHour) {
+			return "", entities.ErrTooManyRequests
+		}
+	}
+
+	token, tokenHash, err := uc.tokenSigner.Generate()
+	if err != nil {
+		uc.logger.Error("Failed to generate account token", "error", err)
+		return "", err
+	}
+
+	accountToken := entities.NewAccountToken(user.ID, purpose, tokenHash, validFor, now)
+	if err := uc.tokenRepo.Create(ctx, accountToken); err != nil {
+		uc.logger.Error("Failed to store account token", "error", err, "user_id", user.ID)
+		return "", err
+	}
+
+	return token, nil
+}
+
+// redeemToken looks up a token and marks it as used, so each link works once
+func (uc *AccountUseCase) redeemToken(ctx context.Context, purpose entities.AccountTokenPurpose, token string) (*entities.AccountToken, error) {
+	if token == "" {
+		return nil, entities.ErrAccountTokenInvalid
+	}
+
+	accountToken, err := uc.tokenRepo.GetByHash(ctx, purpose, uc.tokenSigner.Hash(token))
+	if err != nil {
+		if errors.Is(err, entities.ErrAccountTokenInvalid) {
+			uc.logger.Warn("Unknown account token", "purpose", purpose)
+			return nil, entities.ErrAccountTokenInvalid
+		}
+		uc.logger.Error("Failed to get account token", "error", err)
+		return nil, err
+	}
+
+	if !accountToken.CanRedeem(uc.clock.Now()) {
+		uc.logger.Warn("Expired or used account token", "purpose", purpose, "user_id", accountToken.UserID)
+		return nil, entities.ErrAccountTokenInvalid
+	}
+
+	// MarkUsed only succeeds once, which guards against concurrent redemption
+	if err := uc.tokenRepo.MarkUsed(ctx, accountToken.ID); err != nil {
+		return nil, err
+	}
+
+	return accountToken, nil
+}
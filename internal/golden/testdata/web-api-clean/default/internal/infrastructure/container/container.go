@@ -0,0 +1,281 @@
+package container
+
+import (
+	"time"
+
+	"github.com/example/golden/internal/adapters/controllers"
+	"github.com/example/golden/internal/clock"
+
+	"github.com/example/golden/internal/adapters/presenters"
+	"github.com/example/golden/internal/domain/usecases"
+	"github.com/example/golden/internal/infrastructure/persistence"
+
+	"github.com/example/golden/internal/domain/ports"
+	"github.com/example/golden/internal/infrastructure/config"
+
+	"github.com/example/golden/internal/infrastructure/lifecycle"
+
+	"github.com/example/golden/internal/infrastructure/logger"
+	"github.com/example/golden/internal/infrastructure/services"
+
+	"github.com/example/golden/internal/infrastructure/web"
+)
+
+// Container holds all application dependencies
+// This implements dependency injection for Clean Architecture
+type Container struct {
+	// Configuration
+	Config *config.Config
+
+	// Infrastructure Services
+	Clock     clock.Clock
+	Logger    ports.Logger
+	Lifecycle *lifecycle.Manager
+
+	Repository ports.Repository
+
+	PasswordService ports.PasswordService
+
+	TokenService ports.TokenService
+
+	AccountTokenSigner ports.AccountTokenSigner
+
+	EmailService ports.EmailService
+
+	// Use Cases
+	UserUseCase *usecases.UserUseCase
+
+	AuthUseCase *usecases.AuthUseCase
+
+	AccountUseCase *usecases.AccountUseCase
+
+	// Presenters
+	UserPresenter *presenters.UserPresenter
+
+	AuthPresenter *presenters.AuthPresenter
+
+	// Controllers
+	HealthController *controllers.HealthController
+
+	UserController *controllers.UserController
+
+	AuthController *controllers.AuthController
+
+	AccountController *controllers.AccountController
+
+	// Web Infrastructure
+	Router *web.RouterService
+}
+
+// NewContainer creates and wires all dependencies
+func NewContainer(cfg *config.Config) (*Container, error) {
+	container := &Container{
+		Config: cfg,
+	}
+
+	// Initialize infrastructure services
+	if err := container.initInfrastructure(); err != nil {
+		return nil, err
+	}
+
+	// Initialize use cases
+	if err := container.initUseCases(); err != nil {
+		return nil, err
+	}
+
+	// Initialize presenters
+	if err := container.initPresenters(); err != nil {
+		return nil, err
+	}
+
+	// Initialize controllers
+	if err := container.initControllers(); err != nil {
+		return nil, err
+	}
+
+	// Initialize web layer
+	if err := container.initWeb(); err != nil {
+		return nil, err
+	}
+
+	return container, nil
+}
+
+// initInfrastructure initializes all infrastructure services
+func (c *Container) initInfrastructure() error {
+	c.Clock = clock.New()
+
+	// Initialize logger
+	loggerFactory := logger.NewFactory(c.Config.Logger)
+	c.Logger = loggerFactory.CreateLogger()
+
+	// Readiness and shutdown sequence
+	c.Lifecycle = lifecycle.NewManager(lifecycle.Options{
+		DrainDelay:      time.Duration(c.Config.Server.DrainDelay) * time.Second,
+		ShutdownTimeout: time.Duration(c.Config.Server.ShutdownTimeout) * time.Second,
+	}, c.Logger)
+
+	// Initialize database and repository
+	db, err := persistence.NewDatabase(c.Config.Database, c.Logger)
+	if err != nil {
+		return err
+	}
+
+	// Fresh environments such as the e2e stack create their tables on startup
+	if c.Config.Database.AutoMigrate {
+		if err := persistence.NewMigrationManager(db.GetDB(), c.Logger).RunMigrations(); err != nil {
+			return err
+		}
+	}
+
+	c.Repository = persistence.NewRepository(db.GetDB(), c.Logger, c.Clock)
+
+	// Initialize external services
+	c.PasswordService = services.NewPasswordService(c.Logger)
+
+	c.TokenService = services.NewTokenService(c.Config.Auth, c.Logger, c.Clock)
+
+	c.AccountTokenSigner = services.NewAccountTokenSigner(c.Config.Auth)
+
+	c.EmailService = services.NewEmailService(c.Config.Email, c.Logger)
+
+	return nil
+}
+
+// initUseCases initializes all use cases with their dependencies
+func (c *Container) initUseCases() error {
+
+	// Initialize user use case
+	c.UserUseCase = usecases.NewUserUseCase(
+		c.Repository.UserRepository(),
+		c.PasswordService,
+		c.Logger,
+		c.EmailService,
+		c.Clock,
+	)
+
+	// Initialize auth use case (requires database for session storage)
+	c.AuthUseCase = usecases.NewAuthUseCase(
+		c.Repository.UserRepository(),
+		c.Repository.AuthSessionRepository(),
+		c.PasswordService,
+		c.TokenService,
+		c.Logger,
+		c.Clock,
+	)
+
+	// Initialize account use case (email verification and password reset)
+	c.AccountUseCase = usecases.NewAccountUseCase(
+		c.Repository.UserRepository(),
+		c.Repository.AccountTokenRepository(),
+		c.Repository.AuthSessionRepository(),
+		c.PasswordService,
+		c.AccountTokenSigner,
+		c.EmailService,
+		c.Logger,
+		c.Clock,
+		usecases.AccountPolicy{
+			VerificationTokenTTL: time.Duration(c.Config.Auth.VerificationTokenExpiry) * time.Hour,
+			ResetTokenTTL:        time.Duration(c.Config.Auth.ResetTokenExpiry) * time.Minute,
+			MaxRequestsPerHour:   c.Config.Auth.AccountEmailLimit,
+			PasswordMinLength:    c.Config.Auth.PasswordMinLength,
+		},
+	)
+	// New users are sent a verification email
+	c.UserUseCase.SetAccountUseCase(c.AccountUseCase)
+
+	return nil
+}
+
+// initPresenters initializes all presenters
+func (c *Container) initPresenters() error {
+
+	c.UserPresenter = presenters.NewUserPresenter()
+
+	c.AuthPresenter = presenters.NewAuthPresenter()
+
+	return nil
+}
+
+// initControllers initializes all controllers with their dependencies
+func (c *Container) initControllers() error {
+	// Health controller (always available)
+	c.HealthController = controllers.NewHealthController(c.Lifecycle, c.Clock)
+
+	// User controller
+	c.UserController = controllers.NewUserController(
+		c.UserUseCase,
+		c.UserPresenter,
+		c.Logger,
+	)
+
+	// Auth controller
+	c.AuthController = controllers.NewAuthController(
+		c.AuthUseCase,
+		c.AuthPresenter,
+		c.Logger,
+	)
+
+	// Account controller
+	c.AccountController = controllers.NewAccountController(
+		c.AccountUseCase,
+		c.AuthPresenter,
+		c.Logger,
+	)
+
+	return nil
+}
+
+// initWeb initializes the web layer
+func (c *Container) initWeb() error {
+	router, err := web.NewRouterService(c.Config.Server, c.Logger)
+	if err != nil {
+		return err
+	}
+	c.Router = router
+
+	// Set auth use case for middleware
+	c.Router.SetAuthUseCase(c.AuthUseCase)
+
+	// Register routes
+	c.registerRoutes()
+
+	return nil
+}
+
+// registerRoutes registers all application routes
+func (c *Container) registerRoutes() {
+	// Health routes
+	c.Router.RegisterHealthRoutes(c.HealthController)
+
+	// User routes
+	c.Router.RegisterUserRoutes(c.UserController)
+
+	// Auth routes
+	c.Router.RegisterAuthRoutes(c.AuthController)
+
+	// Account routes
+	c.Router.RegisterAccountRoutes(c.AccountController)
+
+}
+
+// GetRouter returns the configured router
+func (c *Container) GetRouter() *web.RouterService {
+	return c.Router
+}
+
+// Cleanup performs cleanup operations
+func (c *Container) Cleanup() error {
+	c.Logger.Info("Cleaning up application resources")
+
+	// Close database connections
+	if repo, ok := c.Repository.(*persistence.Repository); ok {
+		if err := repo.Close(); err != nil {
+			c.Logger.Error("Failed to close repository", "error", err)
+			return err
+		}
+	}
+
+	c.Logger.Info("Application cleanup completed")
+	return nil
+}
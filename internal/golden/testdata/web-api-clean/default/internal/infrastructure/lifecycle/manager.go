@@ -0,0 +1,108 @@
+// Package lifecycle coordinates the readiness and the shutdown of the application
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/example/golden/internal/domain/ports"
+)
+
+// Options configures the shutdown sequence
+type Options struct {
+	// DrainDelay is how long the application keeps serving once it reports not ready,
+	// so that load balancers stop sending it new requests before the servers stop
+	DrainDelay time.Duration
+	// ShutdownTimeout bounds the shutdown hooks, in-flight requests included
+	ShutdownTimeout time.Duration
+}
+
+type hook struct {
+	name string
+	stop func(ctx context.Context) error
+}
+
+// Manager reports the readiness of the application and runs its shutdown sequence:
+// readiness flips to not ready, connections drain, then the shutdown hooks run in order
+type Manager struct {
+	options Options
+	logger  ports.Logger
+	ready   atomic.Bool
+
+	mu     sync.Mutex
+	drains []func()
+	hooks  []hook
+}
+
+// NewManager creates a new Manager, not ready until MarkReady is called
+func NewManager(options Options, logger ports.Logger) *Manager {
+	return &Manager{
+		options: options,
+		logger:  logger,
+	}
+}
+
+// Ready reports whether the application accepts new traffic
+func (m *Manager) Ready() bool {
+	return m.ready.Load()
+}
+
+// MarkReady reports the application ready, once its servers listen
+func (m *Manager) MarkReady() {
+	m.ready.Store(true)
+}
+
+// OnDrain registers a function called when the drain starts, such as disabling keep-alives
+func (m *Manager) OnDrain(drain func()) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.drains = append(m.drains, drain)
+}
+
+// OnShutdown registers a shutdown hook; hooks run in the order they were registered
+func (m *Manager) OnShutdown(name string, stop func(ctx context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks = append(m.hooks, hook{name: name, stop: stop})
+}
+
+// Shutdown flips readiness, waits for the drain delay, then runs every shutdown hook within the shutdown timeout
+// Cancelling ctx cuts the drain short. A hook that fails does not stop the next ones; their errors are joined
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.mu.Lock()
+	drains := m.drains
+	hooks := m.hooks
+	m.mu.Unlock()
+
+	m.ready.Store(false)
+	for _, drain := range drains {
+		drain()
+	}
+
+	m.logger.Info("Draining connections", "drain_delay", m.options.DrainDelay.String())
+	timer := time.NewTimer(m.options.DrainDelay)
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		timer.Stop()
+	}
+
+	// The hooks keep the values of ctx but not its cancellation, which cut the drain short
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), m.options.ShutdownTimeout)
+	defer cancel()
+
+	var errs []error
+	for _, h := range hooks {
+		m.logger.Info("Stopping " + h.name)
+		if err := h.stop(ctx); err != nil {
+			m.logger.Error("Failed to stop "+h.name, "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", h.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,79 @@
+// Package middleware provides request ID middleware for request correlation
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/example/golden/internal/domain/ports"
+)
+
+// RequestIDKey is the context key for request ID
+type RequestIDKey struct{}
+
+// RequestIDConfig holds request ID middleware configuration
+type RequestIDConfig struct {
+	// Header is the header name to look for existing request ID
+	Header string
+	// GenerateID is a function to generate new request IDs
+	GenerateID func() string
+}
+
+// DefaultRequestIDConfig returns default request ID configuration
+func DefaultRequestIDConfig() *RequestIDConfig {
+	return &RequestIDConfig{
+		Header: "X-Request-ID",
+		GenerateID: func() string {
+			return fmt.Sprintf("%d-%d", time.Now().UnixNano(), rand.Intn(1000000))
+		},
+	}
+}
+
+// GinRequestIDMiddleware returns Gin middleware for request ID handling
+func (config *RequestIDConfig) GinRequestIDMiddleware() gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		requestID := c.GetHeader(config.Header)
+		if requestID == "" {
+			requestID = config.GenerateID()
+		}
+
+		// Set in Gin context and response header
+		c.Set("request_id", requestID)
+		c.Header(config.Header, requestID)
+
+		// Set in request context
+		ctx := context.WithValue(c.Request.Context(), RequestIDKey{}, requestID)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	})
+}
+
+// GetRequestID extracts request ID from context
+func GetRequestID(ctx context.Context) string {
+	if requestID, ok := ctx.Value(RequestIDKey{}).(string); ok {
+		return requestID
+	}
+	return ""
+}
+
+// RequestID returns a domain abstraction middleware for request ID handling
+func RequestID() ports.HTTPHandler {
+	config := DefaultRequestIDConfig()
+	return func(ctx ports.HTTPContext) {
+		requestID := ctx.GetHeader(config.Header)
+		if requestID == "" {
+			requestID = config.GenerateID()
+		}
+
+		// Set in response header and context
+		ctx.SetHeader(config.Header, requestID)
+		ctx.Set("request_id", requestID)
+
+		ctx.Next()
+	}
+}
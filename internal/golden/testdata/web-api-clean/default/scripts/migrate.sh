@@ -0,0 +1,35 @@
+#!/bin/bash
+
+set -e
+
+# Load environment variables from .env file
+if [ -f .env ]; then
+    export $(cat .env | grep -v '#' | awk '/./ {print $1}')
+fi
+
+# Default values
+DB_DRIVER=${DB_DRIVER:-postgres}
+DB_HOST=${DB_HOST:-localhost}
+DB_PORT=${DB_PORT:-5432}
+DB_USER=${DB_USER:-user}
+DB_PASSWORD=${DB_PASSWORD:-password}
+DB_NAME=${DB_NAME:-golden_dev}
+
+# Construct database URL
+DATABASE_URL="${DB_DRIVER}://${DB_USER}:${DB_PASSWORD}@${DB_HOST}:${DB_PORT}/${DB_NAME}?sslmode=disable"
+
+# Path to migration files
+MIGRATION_PATH="./migrations"
+
+# Check if 'migrate' command is available
+if ! command -v migrate &> /dev/null
+then
+    echo "'migrate' command not found. Please install it: https://github.com/golang-migrate/migrate/tree/master/cmd/migrate"
+    exit 1
+fi
+
+# Run migrations
+echo "Running database migrations..."
+migrate -path "${MIGRATION_PATH}" -database "${DATABASE_URL}" up
+
+echo "Migrations completed successfully."
@@ -0,0 +1,56 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/golden/internal/domain/entities"
+	"github.com/example/golden/internal/domain/ports"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAccountTokenRepository is a mock implementation of ports.AccountTokenRepository
+type MockAccountTokenRepository struct {
+	mock.Mock
+}
+
+var _ ports.AccountTokenRepository = (*MockAccountTokenRepository)(nil)
+
+// Create provides a mock function with given fields: ctx, token
+func (m *MockAccountTokenRepository) Create(ctx context.Context, token *entities.AccountToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+// GetByHash provides a mock function with given fields: ctx, purpose, tokenHash
+func (m *MockAccountTokenRepository) GetByHash(ctx context.Context, purpose entities.AccountTokenPurpose, tokenHash string) (*entities.AccountToken, error) {
+	args := m.Called(ctx, purpose, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.AccountToken), args.Error(1)
+}
+
+// MarkUsed provides a mock function with given fields: ctx, id
+func (m *MockAccountTokenRepository) MarkUsed(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// CountSince provides a mock function with given fields: ctx, userID, purpose, since
+func (m *MockAccountTokenRepository) CountSince(ctx context.Context, userID string, purpose entities.AccountTokenPurpose, since time.Time) (int64, error) {
+	args := m.Called(ctx, userID, purpose, since)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// DeleteByUserID provides a mock function with given fields: ctx, userID, purpose
+func (m *MockAccountTokenRepository) DeleteByUserID(ctx context.Context, userID string, purpose entities.AccountTokenPurpose) error {
+	args := m.Called(ctx, userID, purpose)
+	return args.Error(0)
+}
+
+// DeleteExpired provides a mock function with given fields: ctx
+func (m *MockAccountTokenRepository) DeleteExpired(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
@@ -0,0 +1,39 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/example/golden/internal/domain/ports"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCacheService is a mock implementation of ports.CacheService
+type MockCacheService struct {
+	mock.Mock
+}
+
+var _ ports.CacheService = (*MockCacheService)(nil)
+
+// Set provides a mock function with given fields: ctx, key, value, expiration
+func (m *MockCacheService) Set(ctx context.Context, key string, value interface{}, expiration int64) error {
+	args := m.Called(ctx, key, value, expiration)
+	return args.Error(0)
+}
+
+// Get provides a mock function with given fields: ctx, key
+func (m *MockCacheService) Get(ctx context.Context, key string) (interface{}, error) {
+	args := m.Called(ctx, key)
+	return args.Get(0), args.Error(1)
+}
+
+// Delete provides a mock function with given fields: ctx, key
+func (m *MockCacheService) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+// Exists provides a mock function with given fields: ctx, key
+func (m *MockCacheService) Exists(ctx context.Context, key string) (bool, error) {
+	args := m.Called(ctx, key)
+	return args.Bool(0), args.Error(1)
+}
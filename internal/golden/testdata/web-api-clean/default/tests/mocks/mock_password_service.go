@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"github.com/example/golden/internal/domain/ports"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPasswordService is a mock implementation of ports.PasswordService
+type MockPasswordService struct {
+	mock.Mock
+}
+
+var _ ports.PasswordService = (*MockPasswordService)(nil)
+
+// Hash provides a mock function with given fields: password
+func (m *MockPasswordService) Hash(password string) (string, error) {
+	args := m.Called(password)
+	return args.String(0), args.Error(1)
+}
+
+// Verify provides a mock function with given fields: hashedPassword, password
+func (m *MockPasswordService) Verify(hashedPassword, password string) error {
+	args := m.Called(hashedPassword, password)
+	return args.Error(0)
+}
@@ -0,0 +1,31 @@
+package mocks
+
+import (
+	"github.com/example/golden/internal/domain/ports"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockValidationService is a mock implementation of ports.ValidationService
+type MockValidationService struct {
+	mock.Mock
+}
+
+var _ ports.ValidationService = (*MockValidationService)(nil)
+
+// ValidateEmail provides a mock function with given fields: email
+func (m *MockValidationService) ValidateEmail(email string) error {
+	args := m.Called(email)
+	return args.Error(0)
+}
+
+// ValidatePassword provides a mock function with given fields: password
+func (m *MockValidationService) ValidatePassword(password string) error {
+	args := m.Called(password)
+	return args.Error(0)
+}
+
+// ValidateUsername provides a mock function with given fields: username
+func (m *MockValidationService) ValidateUsername(username string) error {
+	args := m.Called(username)
+	return args.Error(0)
+}
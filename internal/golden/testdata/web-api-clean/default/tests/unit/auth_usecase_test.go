@@ -0,0 +1,101 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/domain/entities"
+	"github.com/example/golden/internal/domain/usecases"
+	"github.com/example/golden/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type authMocks struct {
+	users    *mocks.MockUserRepository
+	sessions *mocks.MockAuthSessionRepository
+	password *mocks.MockPasswordService
+	tokens   *mocks.MockTokenService
+	clock    *clock.Fake
+}
+
+func newAuthUseCase() (*usecases.AuthUseCase, *authMocks) {
+	m := &authMocks{
+		users:    new(mocks.MockUserRepository),
+		sessions: new(mocks.MockAuthSessionRepository),
+		password: new(mocks.MockPasswordService),
+		tokens:   new(mocks.MockTokenService),
+		clock:    clock.NewFake(time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)),
+	}
+	mockLogger := new(mocks.MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+
+	useCase := usecases.NewAuthUseCase(m.users, m.sessions, m.password, m.tokens, mockLogger, m.clock)
+	return useCase, m
+}
+
+func TestAuthUseCase_Login(t *testing.T) {
+	ctx := context.Background()
+	user := &entities.User{ID: "user-1", Email: "ada@example.com", Password: "hashed", IsActive: true}
+
+	t.Run("issues tokens and stores the session", func(t *testing.T) {
+		useCase, m := newAuthUseCase()
+		now := m.clock.Now()
+		m.users.On("GetByEmail", ctx, "ada@example.com").Return(user, nil).Once()
+		m.password.On("Verify", "secret-password", "hashed").Return(nil).Once()
+		m.tokens.On("GenerateAccessToken", "user-1").Return(&entities.AuthToken{Token: "access", TokenType: "Bearer", ExpiresAt: now.Add(15 * time.Minute), UserID: "user-1"}, nil).Once()
+		m.tokens.On("GenerateRefreshToken", "user-1").Return(&entities.AuthToken{Token: "refresh", TokenType: "Bearer", ExpiresAt: now.Add(24 * time.Hour), UserID: "user-1"}, nil).Once()
+		m.sessions.On("Create", ctx, mock.MatchedBy(func(session *entities.AuthSession) bool {
+			return session.UserID == "user-1" && session.AccessToken == "access" && session.RefreshToken == "refresh"
+		})).Return(nil).Once()
+
+		output, err := useCase.Login(ctx, usecases.LoginInput{Identifier: "ada@example.com", Password: "secret-password"})
+		require.NoError(t, err)
+		assert.Equal(t, "access", output.AccessToken)
+		assert.Equal(t, "refresh", output.RefreshToken)
+		assert.Equal(t, int64(15*60), output.ExpiresIn)
+		m.users.AssertExpectations(t)
+		m.password.AssertExpectations(t)
+		m.tokens.AssertExpectations(t)
+		m.sessions.AssertExpectations(t)
+	})
+
+	t.Run("a wrong password issues no token", func(t *testing.T) {
+		useCase, m := newAuthUseCase()
+		m.users.On("GetByEmail", ctx, "ada@example.com").Return(user, nil).Once()
+		m.password.On("Verify", "wrong-password", "hashed").Return(errors.New("mismatch")).Once()
+
+		_, err := useCase.Login(ctx, usecases.LoginInput{Identifier: "ada@example.com", Password: "wrong-password"})
+		assert.ErrorIs(t, err, entities.ErrInvalidCredentials)
+		m.tokens.AssertNotCalled(t, "GenerateAccessToken", mock.Anything)
+		m.sessions.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("an unknown user gets the same error as a wrong password", func(t *testing.T) {
+		useCase, m := newAuthUseCase()
+		m.users.On("GetByEmail", ctx, "nobody@example.com").Return(nil, entities.ErrUserNotFound).Once()
+
+		_, err := useCase.Login(ctx, usecases.LoginInput{Identifier: "nobody@example.com", Password: "secret-password"})
+		assert.ErrorIs(t, err, entities.ErrInvalidCredentials)
+		m.password.AssertNotCalled(t, "Verify", mock.Anything, mock.Anything)
+	})
+
+	t.Run("a failure to store the session fails the login", func(t *testing.T) {
+		useCase, m := newAuthUseCase()
+		now := m.clock.Now()
+		m.users.On("GetByEmail", ctx, "ada@example.com").Return(user, nil).Once()
+		m.password.On("Verify", "secret-password", "hashed").Return(nil).Once()
+		m.tokens.On("GenerateAccessToken", "user-1").Return(&entities.AuthToken{Token: "access", ExpiresAt: now.Add(time.Minute)}, nil).Once()
+		m.tokens.On("GenerateRefreshToken", "user-1").Return(&entities.AuthToken{Token: "refresh", ExpiresAt: now.Add(time.Hour)}, nil).Once()
+		m.sessions.On("Create", ctx, mock.Anything).Return(errors.New("connection refused")).Once()
+
+		_, err := useCase.Login(ctx, usecases.LoginInput{Identifier: "ada@example.com", Password: "secret-password"})
+		assert.EqualError(t, err, "connection refused")
+	})
+}
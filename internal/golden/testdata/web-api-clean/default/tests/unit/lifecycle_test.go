@@ -0,0 +1,85 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/golden/internal/infrastructure/config"
+	"github.com/example/golden/internal/infrastructure/lifecycle"
+	"github.com/example/golden/internal/infrastructure/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLifecycleManager(options lifecycle.Options) *lifecycle.Manager {
+	log := logger.NewFactory(&config.LoggerConfig{Level: "error", Format: "json"}).CreateLogger()
+	return lifecycle.NewManager(options, log)
+}
+
+func TestLifecycleManager_Readiness(t *testing.T) {
+	manager := newLifecycleManager(lifecycle.Options{ShutdownTimeout: time.Second})
+	assert.False(t, manager.Ready(), "not ready before the servers listen")
+
+	manager.MarkReady()
+	assert.True(t, manager.Ready())
+
+	require.NoError(t, manager.Shutdown(context.Background()))
+	assert.False(t, manager.Ready())
+}
+
+func TestLifecycleManager_ShutdownSequence(t *testing.T) {
+	manager := newLifecycleManager(lifecycle.Options{
+		DrainDelay:      50 * time.Millisecond,
+		ShutdownTimeout: time.Second,
+	})
+	manager.MarkReady()
+
+	var steps []string
+	var drainedAt time.Time
+	manager.OnDrain(func() {
+		assert.False(t, manager.Ready(), "readiness flips before the drain starts")
+		drainedAt = time.Now()
+		steps = append(steps, "drain")
+	})
+	manager.OnShutdown("server", func(ctx context.Context) error {
+		assert.GreaterOrEqual(t, time.Since(drainedAt), 50*time.Millisecond, "the server stops after the drain delay")
+		_, hasDeadline := ctx.Deadline()
+		assert.True(t, hasDeadline)
+		steps = append(steps, "server")
+		return errors.New("connections still open")
+	})
+	manager.OnShutdown("resources", func(context.Context) error {
+		steps = append(steps, "resources")
+		return nil
+	})
+
+	err := manager.Shutdown(context.Background())
+
+	assert.Equal(t, []string{"drain", "server", "resources"}, steps, "a failing hook does not stop the next ones")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "server: connections still open")
+}
+
+func TestLifecycleManager_CancelSkipsDrain(t *testing.T) {
+	manager := newLifecycleManager(lifecycle.Options{
+		DrainDelay:      time.Hour,
+		ShutdownTimeout: time.Second,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- manager.Shutdown(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Shutdown waited for the drain delay")
+	}
+}
@@ -0,0 +1,51 @@
+package unit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/domain/entities"
+	"github.com/example/golden/internal/infrastructure/config"
+	"github.com/example/golden/internal/infrastructure/services"
+	"github.com/example/golden/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenService_Expiry(t *testing.T) {
+	mockLogger := new(mocks.MockLogger)
+	mockLogger.On("Debug", mock.Anything, mock.Anything).Maybe()
+	fake := clock.NewFake(time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC))
+	tokenService := services.NewTokenService(&config.AuthConfig{
+		JWTSecret:          "test-secret",
+		AccessTokenExpiry:  15,
+		RefreshTokenExpiry: 7,
+	}, mockLogger, fake)
+
+	token, err := tokenService.GenerateAccessToken("user-1")
+	require.NoError(t, err)
+	assert.Equal(t, fake.Now().Add(15*time.Minute), token.ExpiresAt)
+
+	// The token is valid for its whole lifetime, and no longer
+	fake.Advance(14 * time.Minute)
+	userID, err := tokenService.ValidateToken(token.Token)
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", userID)
+
+	fake.Advance(2 * time.Minute)
+	_, err = tokenService.ValidateToken(token.Token)
+	assert.Equal(t, entities.ErrInvalidToken, err)
+
+	// Refresh tokens outlive access tokens
+	refresh, err := tokenService.GenerateRefreshToken("user-1")
+	require.NoError(t, err)
+	fake.Advance(6 * 24 * time.Hour)
+	_, err = tokenService.RefreshToken(refresh.Token)
+	assert.NoError(t, err)
+
+	fake.Advance(2 * 24 * time.Hour)
+	_, err = tokenService.RefreshToken(refresh.Token)
+	assert.Equal(t, entities.ErrInvalidToken, err)
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/example/golden/internal/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSpec = `
+openapi: 3.0.3
+servers:
+  - url: http://localhost:8080/api/v1
+paths:
+  /users:
+    post:
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/CreateUser'
+      responses:
+        '201':
+          description: Created
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/User'
+        '409':
+          description: Conflict
+          content:
+            application/json:
+              example:
+                error: email already registered
+  /users/me:
+    get:
+      responses:
+        '200':
+          description: Current user
+          content:
+            application/json:
+              examples:
+                admin:
+                  value:
+                    id: 1
+                    role: admin
+                member:
+                  value:
+                    id: 2
+                    role: member
+  /users/{id}:
+    get:
+      responses:
+        '200':
+          description: User
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/User'
+    delete:
+      responses:
+        '204':
+          description: Deleted
+components:
+  schemas:
+    User:
+      type: object
+      properties:
+        id:
+          type: integer
+          example: 42
+        email:
+          type: string
+          format: email
+        roles:
+          type: array
+          items:
+            type: string
+            enum: [admin, member]
+    CreateUser:
+      type: object
+      required: [email, age]
+      properties:
+        email:
+          type: string
+          format: email
+        age:
+          type: integer
+          minimum: 18
+`
+
+func newTestServer(t *testing.T) *MockServer {
+	t.Helper()
+
+	spec, err := openapi.Parse([]byte(testSpec))
+	require.NoError(t, err)
+	return NewMockServer(spec)
+}
+
+func serve(server http.Handler, method, path, body string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMockServer_GeneratesBodiesFromSchemas(t *testing.T) {
+	server := newTestServer(t)
+
+	rec := serve(server, http.MethodGet, "/api/v1/users/7", "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"id":42,"email":"user@example.com","roles":["admin"]}`, rec.Body.String())
+
+	rec = serve(server, http.MethodDelete, "/api/v1/users/7", "", nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Empty(t, rec.Body.String())
+}
+
+func TestMockServer_UsesExamples(t *testing.T) {
+	server := newTestServer(t)
+
+	// Literal segments win over parameters, and the first named example is the default
+	rec := serve(server, http.MethodGet, "/api/v1/users/me", "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"id":1,"role":"admin"}`, rec.Body.String())
+
+	rec = serve(server, http.MethodGet, "/api/v1/users/me", "", map[string]string{"Prefer": "example=member"})
+	assert.JSONEq(t, `{"id":2,"role":"member"}`, rec.Body.String())
+
+	rec = serve(server, http.MethodPost, "/api/v1/users", `{"email":"a@b.c","age":30}`, map[string]string{"Prefer": "code=409"})
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.JSONEq(t, `{"error":"email already registered"}`, rec.Body.String())
+}
+
+func TestMockServer_ValidatesRequestBodies(t *testing.T) {
+	server := newTestServer(t)
+
+	rec := serve(server, http.MethodPost, "/api/v1/users", `{"email":"a@b.c","age":30}`, nil)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	tests := []struct {
+		name    string
+		body    string
+		problem string
+	}{
+		{"missing body", ``, "request body is required"},
+		{"invalid JSON", `{"email":`, "request body is not valid JSON"},
+		{"missing field", `{"email":"a@b.c"}`, "body.age is required"},
+		{"wrong type", `{"email":"a@b.c","age":"thirty"}`, "body.age must be a number"},
+		{"below minimum", `{"email":"a@b.c","age":12}`, "body.age must be at least 18"},
+		{"bad format", `{"email":"nobody","age":30}`, "body.email must be an email address"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(server, http.MethodPost, "/api/v1/users", tt.body, nil)
+			require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+			var response errorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			require.NotEmpty(t, response.Details)
+			assert.Contains(t, response.Details[0], tt.problem)
+		})
+	}
+}
+
+func TestMockServer_UnknownRoutes(t *testing.T) {
+	server := newTestServer(t)
+
+	rec := serve(server, http.MethodGet, "/api/v1/orders", "", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(server, http.MethodGet, "/users/7", "", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(server, http.MethodPut, "/api/v1/users/7", "", nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, "DELETE, GET", rec.Header().Get("Allow"))
+}
+
+func TestMockServer_AllowsCrossOriginRequests(t *testing.T) {
+	server := newTestServer(t)
+
+	rec := serve(server, http.MethodOptions, "/api/v1/users", "", map[string]string{
+		"Origin":                        "http://localhost:3000",
+		"Access-Control-Request-Method": "POST",
+	})
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestMockServer_Routes(t *testing.T) {
+	assert.Equal(t, []string{
+		"DELETE /api/v1/users/{id}",
+		"GET /api/v1/users/me",
+		"GET /api/v1/users/{id}",
+		"POST /api/v1/users",
+	}, newTestServer(t).Routes())
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/example/golden/internal/openapi"
+)
+
+// maxBodyBytes caps the size of the request bodies the mock server validates
+const maxBodyBytes = 1 << 20
+
+// validateRequest checks the JSON body of r against the request body schema of the operation,
+// returning one problem per mismatch so frontends see what the real API would reject
+func (m *MockServer) validateRequest(operation *openapi.Operation, r *http.Request) []string {
+	body := m.spec.ResolveRequestBody(operation.RequestBody)
+	if body == nil {
+		return nil
+	}
+	media, ok := body.Content["application/json"]
+	if !ok || media == nil {
+		return nil
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxBodyBytes))
+	if err != nil {
+		return []string{"failed to read the request body: " + err.Error()}
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		if body.Required {
+			return []string{"request body is required"}
+		}
+		return nil
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return []string{"request body is not valid JSON: " + err.Error()}
+	}
+	return m.validate(media.Schema, value, "body", 0)
+}
+
+// validate checks value against schema, path naming value in the problems
+func (m *MockServer) validate(schema *openapi.Schema, value any, path string, depth int) []string {
+	schema = m.spec.ResolveSchema(schema)
+	if schema == nil || depth > maxSchemaDepth {
+		return nil
+	}
+	if value == nil {
+		if schema.Nullable || schema.Type == "" {
+			return nil
+		}
+		return []string{path + " must not be null"}
+	}
+
+	var problems []string
+	for _, part := range schema.AllOf {
+		problems = append(problems, m.validate(part, value, path, depth+1)...)
+	}
+	if len(schema.Enum) > 0 && !inEnum(schema.Enum, value) {
+		problems = append(problems, fmt.Sprintf("%s must be one of %v", path, schema.Enum))
+	}
+
+	switch schema.Type {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return append(problems, path+" must be an object")
+		}
+		for _, name := range schema.Required {
+			if _, ok := object[name]; !ok {
+				problems = append(problems, path+"."+name+" is required")
+			}
+		}
+		for _, property := range schema.Properties {
+			if value, ok := object[property.Name]; ok {
+				problems = append(problems, m.validate(property.Schema, value, path+"."+property.Name, depth+1)...)
+			}
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			return append(problems, path+" must be an array")
+		}
+		for i, item := range items {
+			problems = append(problems, m.validate(schema.Items, item, fmt.Sprintf("%s[%d]", path, i), depth+1)...)
+		}
+	case "string":
+		text, ok := value.(string)
+		if !ok {
+			return append(problems, path+" must be a string")
+		}
+		if schema.MinLength != nil && len([]rune(text)) < *schema.MinLength {
+			problems = append(problems, fmt.Sprintf("%s must be at least %d characters", path, *schema.MinLength))
+		}
+		if schema.MaxLength != nil && len([]rune(text)) > *schema.MaxLength {
+			problems = append(problems, fmt.Sprintf("%s must be at most %d characters", path, *schema.MaxLength))
+		}
+		if schema.Format == "email" && !strings.Contains(text, "@") {
+			problems = append(problems, path+" must be an email address")
+		}
+	case "integer", "number":
+		number, ok := value.(float64)
+		if !ok {
+			return append(problems, path+" must be a number")
+		}
+		if schema.Type == "integer" && number != math.Trunc(number) {
+			return append(problems, path+" must be an integer")
+		}
+		if schema.Minimum != nil && number < *schema.Minimum {
+			problems = append(problems, fmt.Sprintf("%s must be at least %v", path, *schema.Minimum))
+		}
+		if schema.Maximum != nil && number > *schema.Maximum {
+			problems = append(problems, fmt.Sprintf("%s must be at most %v", path, *schema.Maximum))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			problems = append(problems, path+" must be a boolean")
+		}
+	}
+	return problems
+}
+
+// inEnum reports whether value is one of the enum values, comparing numbers by value
+func inEnum(enum []any, value any) bool {
+	for _, allowed := range enum {
+		if fmt.Sprint(allowed) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
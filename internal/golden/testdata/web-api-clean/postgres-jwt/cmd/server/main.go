@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/example/golden/internal/infrastructure/config"
+	"github.com/example/golden/internal/infrastructure/container"
+)
+
+func main() {
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	// Create dependency injection container
+	app, err := container.NewContainer(cfg)
+	if err != nil {
+		log.Fatalf("Failed to create application container: %v", err)
+	}
+
+	// Setup graceful shutdown
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
+
+	// Get router and create web server
+	routerService := app.GetRouter()
+	webServer, err := routerService.CreateWebServer()
+	if err != nil {
+		app.Logger.Fatal("Failed to create web server", "error", err)
+	}
+
+	server := &http.Server{
+		Addr:         cfg.Server.Host + ":" + cfg.Server.Port,
+		Handler:      webServer.Handler(),
+		ReadTimeout:  time.Duration(cfg.Server.ReadTimeout) * time.Second,
+		WriteTimeout: time.Duration(cfg.Server.WriteTimeout) * time.Second,
+		IdleTimeout:  time.Duration(cfg.Server.IdleTimeout) * time.Second,
+	}
+
+	// Bind the port first, so that the service only reports ready once it accepts connections
+	listener, err := net.Listen("tcp", server.Addr)
+	if err != nil {
+		app.Logger.Fatal("Failed to listen", "address", server.Addr, "error", err)
+	}
+
+	// Start server in a goroutine
+	go func() {
+		app.Logger.Info("Starting golden server",
+			"address", server.Addr,
+			"environment", cfg.Server.Environment,
+		)
+
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			app.Logger.Fatal("Failed to start server", "error", err)
+		}
+	}()
+
+	// While draining, responses close their connection so that clients reconnect to another instance
+	app.Lifecycle.OnDrain(func() {
+		server.SetKeepAlivesEnabled(false)
+	})
+	app.Lifecycle.OnShutdown("HTTP server", server.Shutdown)
+
+	// Cleanup application resources last
+	app.Lifecycle.OnShutdown("application resources", func(context.Context) error {
+		return app.Cleanup()
+	})
+
+	app.Lifecycle.MarkReady()
+	app.Logger.Info("golden server started successfully")
+
+	// Wait for interrupt signal
+	sig := <-done
+	app.Logger.Info("Shutting down server...", "signal", sig.String())
+
+	// A second signal cuts the drain short
+	drainCtx, skipDrain := context.WithCancel(context.Background())
+	defer skipDrain()
+	go func() {
+		<-done
+		skipDrain()
+	}()
+
+	// /ready fails, connections drain, then the server, and the resources stop
+	if err := app.Lifecycle.Shutdown(drainCtx); err != nil {
+		app.Logger.Error("Server shutdown incomplete", "error", err)
+	}
+
+	app.Logger.Info("golden server stopped gracefully")
+}
+
+// init initializes the application before main()
+func init() {
+	// Set default values or perform any necessary initialization
+
+	if os.Getenv("GIN_MODE") == "" {
+		os.Setenv("GIN_MODE", "debug")
+	}
+
+}
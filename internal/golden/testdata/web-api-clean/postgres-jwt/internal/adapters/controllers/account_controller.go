@@ -0,0 +1,195 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/example/golden/internal/adapters/presenters"
+	"github.com/example/golden/internal/domain/entities"
+	"github.com/example/golden/internal/domain/ports"
+	"github.com/example/golden/internal/domain/usecases"
+)
+
+// AccountController handles the email verification and password reset requests
+type AccountController struct {
+	accountUseCase *usecases.AccountUseCase
+	authPresenter  *presenters.AuthPresenter
+	logger         ports.Logger
+}
+
+// VerifyEmailRequest represents the email verification request payload
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// ForgotPasswordRequest represents the forgot password request payload
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// ResetPasswordRequest represents the password reset request payload
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// MessageResponse represents a response that only carries a message
+type MessageResponse struct {
+	Message string `json:"message"`
+}
+
+// NewAccountController creates a new AccountController instance
+func NewAccountController(
+	accountUseCase *usecases.AccountUseCase,
+	authPresenter *presenters.AuthPresenter,
+	logger ports.Logger,
+) *AccountController {
+	return &AccountController{
+		accountUseCase: accountUseCase,
+		authPresenter:  authPresenter,
+		logger:         logger,
+	}
+}
+
+// VerifyEmail redeems an email verification token
+// @Summary Verify email address
+// @Description Verify the user's email address with the token sent by email
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body VerifyEmailRequest true "Verification token"
+// @Success 200 {object} MessageResponse
+// @Failure 400 {object} presenters.ErrorResponse
+// @Failure 500 {object} presenters.ErrorResponse
+// @Router /auth/verify-email [post]
+func (ac *AccountController) VerifyEmail() ports.HTTPHandler {
+	return func(ctx ports.HTTPContext) {
+		var req VerifyEmailRequest
+		if err := ctx.BindJSON(&req); err != nil {
+			ac.logger.Warn("Invalid email verification request", "error", err)
+			ctx.JSON(http.StatusBadRequest, ac.authPresenter.PresentError(err))
+			return
+		}
+
+		if err := ac.accountUseCase.VerifyEmail(ctx.GetRequestContext(), req.Token); err != nil {
+			ac.handleAccountError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, MessageResponse{Message: "Email address verified"})
+	}
+}
+
+// ResendVerification sends a new verification email to the current user
+// @Summary Resend verification email
+// @Description Send a new email verification link to the current user
+// @Tags auth
+// @Produce json
+// @Success 202 {object} MessageResponse
+// @Failure 401 {object} presenters.ErrorResponse
+// @Failure 409 {object} presenters.ErrorResponse
+// @Failure 429 {object} presenters.ErrorResponse
+// @Failure 500 {object} presenters.ErrorResponse
+// @Security ApiKeyAuth
+// @Router /auth/verify-email/resend [post]
+func (ac *AccountController) ResendVerification() ports.HTTPHandler {
+	return func(ctx ports.HTTPContext) {
+		// Get user from context (set by auth middleware)
+		user, exists := ctx.Get("user")
+		if !exists {
+			ctx.JSON(http.StatusUnauthorized, ac.authPresenter.PresentError(entities.ErrInvalidToken))
+			return
+		}
+
+		userEntity, ok := user.(*entities.User)
+		if !ok {
+			ac.logger.Error("Invalid user type in context")
+			ctx.JSON(http.StatusInternalServerError, ac.authPresenter.PresentError(entities.ErrInvalidToken))
+			return
+		}
+
+		if err := ac.accountUseCase.RequestEmailVerification(ctx.GetRequestContext(), userEntity.ID); err != nil {
+			ac.handleAccountError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusAccepted, MessageResponse{Message: "Verification email sent"})
+	}
+}
+
+// ForgotPassword sends a password reset link
+// @Summary Request password reset
+// @Description Send a password reset link if an account uses the email address. The response is the same whether or not it does.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body ForgotPasswordRequest true "Account email"
+// @Success 202 {object} MessageResponse
+// @Failure 400 {object} presenters.ErrorResponse
+// @Failure 500 {object} presenters.ErrorResponse
+// @Router /auth/forgot-password [post]
+func (ac *AccountController) ForgotPassword() ports.HTTPHandler {
+	return func(ctx ports.HTTPContext) {
+		var req ForgotPasswordRequest
+		if err := ctx.BindJSON(&req); err != nil {
+			ac.logger.Warn("Invalid forgot password request", "error", err)
+			ctx.JSON(http.StatusBadRequest, ac.authPresenter.PresentError(err))
+			return
+		}
+
+		if err := ac.accountUseCase.RequestPasswordReset(ctx.GetRequestContext(), req.Email); err != nil {
+			ac.handleAccountError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusAccepted, MessageResponse{Message: "If an account uses this email address, a reset link has been sent"})
+	}
+}
+
+// ResetPassword sets a new password with a reset token
+// @Summary Reset password
+// @Description Set a new password with the token sent by email and sign out all sessions
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body ResetPasswordRequest true "Reset token and new password"
+// @Success 200 {object} MessageResponse
+// @Failure 400 {object} presenters.ErrorResponse
+// @Failure 500 {object} presenters.ErrorResponse
+// @Router /auth/reset-password [post]
+func (ac *AccountController) ResetPassword() ports.HTTPHandler {
+	return func(ctx ports.HTTPContext) {
+		var req ResetPasswordRequest
+		if err := ctx.BindJSON(&req); err != nil {
+			ac.logger.Warn("Invalid reset password request", "error", err)
+			ctx.JSON(http.StatusBadRequest, ac.authPresenter.PresentError(err))
+			return
+		}
+
+		input := usecases.ResetPasswordInput{
+			Token:    req.Token,
+			Password: req.Password,
+		}
+
+		if err := ac.accountUseCase.ResetPassword(ctx.GetRequestContext(), input); err != nil {
+			ac.handleAccountError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, MessageResponse{Message: "Password updated, please log in again"})
+	}
+}
+
+// handleAccountError handles account flow errors and returns appropriate HTTP responses
+func (ac *AccountController) handleAccountError(ctx ports.HTTPContext, err error) {
+	switch err {
+	case entities.ErrAccountTokenInvalid, entities.ErrWeakPassword:
+		ctx.JSON(http.StatusBadRequest, ac.authPresenter.PresentError(err))
+	case entities.ErrEmailAlreadyVerified:
+		ctx.JSON(http.StatusConflict, ac.authPresenter.PresentError(err))
+	case entities.ErrTooManyRequests:
+		ctx.JSON(http.StatusTooManyRequests, ac.authPresenter.PresentError(err))
+	default:
+		ac.logger.Error("Unexpected error in account controller", "error", err)
+		ctx.JSON(http.StatusInternalServerError, ac.authPresenter.PresentError(err))
+	}
+}
@@ -0,0 +1,131 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// AuthToken represents an authentication token in the domain
+type AuthToken struct {
+	Token     string    `json:"token"`
+	TokenType string    `json:"token_type"`
+	ExpiresAt time.Time `json:"expires_at"`
+	UserID    string    `json:"user_id"`
+}
+
+// LoginCredentials represents login attempt data
+type LoginCredentials struct {
+	Email    string `json:"email"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// AuthSession represents an authenticated session
+type AuthSession struct {
+	ID           string    `json:"id"`
+	UserID       string    `json:"user_id"`
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	CreatedAt    time.Time `json:"created_at"`
+	LastUsedAt   time.Time `json:"last_used_at"`
+	IPAddress    string    `json:"ip_address"`
+	UserAgent    string    `json:"user_agent"`
+}
+
+// Auth domain errors
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrTokenExpired       = errors.New("token has expired")
+	ErrInvalidToken       = errors.New("invalid token")
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrSessionExpired     = errors.New("session has expired")
+)
+
+// NewAuthToken creates a new authentication token expiring expiresIn after now
+func NewAuthToken(token, tokenType, userID string, expiresIn time.Duration, now time.Time) *AuthToken {
+	return &AuthToken{
+		Token:     token,
+		TokenType: tokenType,
+		ExpiresAt: now.Add(expiresIn),
+		UserID:    userID,
+	}
+}
+
+// IsExpired checks if the token has expired at now
+func (t *AuthToken) IsExpired(now time.Time) bool {
+	return now.After(t.ExpiresAt)
+}
+
+// NewLoginCredentials creates new login credentials with validation
+func NewLoginCredentials(identifier, password string) (*LoginCredentials, error) {
+	creds := &LoginCredentials{
+		Password: password,
+	}
+
+	// Determine if identifier is email or username
+	if containsAt(identifier) {
+		creds.Email = identifier
+	} else {
+		creds.Username = identifier
+	}
+
+	if err := creds.Validate(); err != nil {
+		return nil, err
+	}
+
+	return creds, nil
+}
+
+// Validate checks if login credentials are valid
+func (c *LoginCredentials) Validate() error {
+	if c.Password == "" {
+		return ErrInvalidCredentials
+	}
+
+	if c.Email == "" && c.Username == "" {
+		return ErrInvalidCredentials
+	}
+
+	return nil
+}
+
+// GetIdentifier returns the login identifier (email or username)
+func (c *LoginCredentials) GetIdentifier() string {
+	if c.Email != "" {
+		return c.Email
+	}
+	return c.Username
+}
+
+// NewAuthSession creates a new authentication session, started at now
+func NewAuthSession(userID, accessToken, refreshToken, ipAddress, userAgent string, expiresIn time.Duration, now time.Time) *AuthSession {
+	return &AuthSession{
+		UserID:       userID,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresAt:    now.Add(expiresIn),
+		CreatedAt:    now,
+		LastUsedAt:   now,
+		IPAddress:    ipAddress,
+		UserAgent:    userAgent,
+	}
+}
+
+// IsExpired checks if the session has expired at now
+func (s *AuthSession) IsExpired(now time.Time) bool {
+	return now.After(s.ExpiresAt)
+}
+
+// UpdateLastUsed records that the session was used at now
+func (s *AuthSession) UpdateLastUsed(now time.Time) {
+	s.LastUsedAt = now
+}
+
+// Refresh updates the session with new tokens expiring expiresIn after now
+func (s *AuthSession) Refresh(newAccessToken, newRefreshToken string, expiresIn time.Duration, now time.Time) {
+	s.AccessToken = newAccessToken
+	s.RefreshToken = newRefreshToken
+	s.ExpiresAt = now.Add(expiresIn)
+	s.LastUsedAt = now
+}
@@ -0,0 +1,6 @@
+package logger
+
+import "github.com/example/golden/internal/domain/ports"
+
+// Logger interface is defined in domain/ports but re-exported here for convenience
+type Logger = ports.Logger
@@ -0,0 +1,169 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/domain/entities"
+	"github.com/example/golden/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// AccountTokenRepository implements the AccountTokenRepository interface using GORM
+type AccountTokenRepository struct {
+	db     *gorm.DB
+	logger ports.Logger
+	clock  clock.Clock
+}
+
+// AccountTokenModel represents the account_tokens table structure for GORM
+type AccountTokenModel struct {
+	ID        string `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserID    string `gorm:"not null;index"`
+	Purpose   string `gorm:"not null;index"`
+	TokenHash string `gorm:"uniqueIndex;not null"`
+	ExpiresAt int64  `gorm:"not null;index"`
+	UsedAt    *int64
+	CreatedAt int64 `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (AccountTokenModel) TableName() string {
+	return "account_tokens"
+}
+
+// NewAccountTokenRepository creates a new AccountTokenRepository instance
+func NewAccountTokenRepository(db *gorm.DB, logger ports.Logger, clock clock.Clock) ports.AccountTokenRepository {
+	return &AccountTokenRepository{
+		db:     db,
+		logger: logger,
+		clock:  clock,
+	}
+}
+
+// Create stores a new account token
+func (r *AccountTokenRepository) Create(ctx context.Context, token *entities.AccountToken) error {
+	model := r.entityToModel(token)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		r.logger.Error("Failed to create account token", "error", err, "user_id", token.UserID)
+		return err
+	}
+
+	// Update entity with generated ID
+	token.ID = model.ID
+
+	r.logger.Info("Account token created successfully", "token_id", token.ID, "user_id", token.UserID, "purpose", token.Purpose)
+	return nil
+}
+
+// GetByHash retrieves a token by purpose and signed hash
+func (r *AccountTokenRepository) GetByHash(ctx context.Context, purpose entities.AccountTokenPurpose, tokenHash string) (*entities.AccountToken, error) {
+	var model AccountTokenModel
+
+	if err := r.db.WithContext(ctx).Where("purpose = ? AND token_hash = ?", string(purpose), tokenHash).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entities.ErrAccountTokenInvalid
+		}
+		r.logger.Error("Failed to get account token", "error", err)
+		return nil, err
+	}
+
+	return r.modelToEntity(&model), nil
+}
+
+// MarkUsed redeems a token so it cannot be used again
+func (r *AccountTokenRepository) MarkUsed(ctx context.Context, id string) error {
+	// The used_at condition makes redemption atomic, only one concurrent request can succeed
+	result := r.db.WithContext(ctx).
+		Model(&AccountTokenModel{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", r.clock.Now().Unix())
+	if result.Error != nil {
+		r.logger.Error("Failed to mark account token as used", "error", result.Error, "token_id", id)
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return entities.ErrAccountTokenInvalid
+	}
+
+	return nil
+}
+
+// CountSince counts the tokens issued to a user for a purpose since the given time
+func (r *AccountTokenRepository) CountSince(ctx context.Context, userID string, purpose entities.AccountTokenPurpose, since time.Time) (int64, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).
+		Model(&AccountTokenModel{}).
+		Where("user_id = ? AND purpose = ? AND created_at >= ?", userID, string(purpose), since.Unix()).
+		Count(&count).Error; err != nil {
+		r.logger.Error("Failed to count account tokens", "error", err, "user_id", userID)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// DeleteByUserID removes a user's outstanding tokens for a purpose
+// Used tokens are kept until they expire so they still count towards the request limit
+func (r *AccountTokenRepository) DeleteByUserID(ctx context.Context, userID string, purpose entities.AccountTokenPurpose) error {
+	result := r.db.WithContext(ctx).Delete(&AccountTokenModel{}, "user_id = ? AND purpose = ? AND used_at IS NULL", userID, string(purpose))
+	if result.Error != nil {
+		r.logger.Error("Failed to delete account tokens by user ID", "error", result.Error, "user_id", userID)
+		return result.Error
+	}
+
+	r.logger.Info("Account tokens deleted successfully", "user_id", userID, "purpose", purpose, "count", result.RowsAffected)
+	return nil
+}
+
+// DeleteExpired removes all expired tokens
+func (r *AccountTokenRepository) DeleteExpired(ctx context.Context) error {
+	now := r.clock.Now().Unix()
+	result := r.db.WithContext(ctx).Delete(&AccountTokenModel{}, "expires_at <= ?", now)
+	if result.Error != nil {
+		r.logger.Error("Failed to delete expired account tokens", "error", result.Error)
+		return result.Error
+	}
+
+	r.logger.Info("Expired account tokens deleted successfully", "count", result.RowsAffected)
+	return nil
+}
+
+// entityToModel converts an entities.AccountToken to AccountTokenModel
+func (r *AccountTokenRepository) entityToModel(token *entities.AccountToken) *AccountTokenModel {
+	model := &AccountTokenModel{
+		ID:        token.ID,
+		UserID:    token.UserID,
+		Purpose:   string(token.Purpose),
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt.Unix(),
+		CreatedAt: token.CreatedAt.Unix(),
+	}
+	if token.UsedAt != nil {
+		usedAt := token.UsedAt.Unix()
+		model.UsedAt = &usedAt
+	}
+	return model
+}
+
+// modelToEntity converts an AccountTokenModel to entities.AccountToken
+func (r *AccountTokenRepository) modelToEntity(model *AccountTokenModel) *entities.AccountToken {
+	token := &entities.AccountToken{
+		ID:        model.ID,
+		UserID:    model.UserID,
+		Purpose:   entities.AccountTokenPurpose(model.Purpose),
+		TokenHash: model.TokenHash,
+		ExpiresAt: time.Unix(model.ExpiresAt, 0),
+		CreatedAt: time.Unix(model.CreatedAt, 0),
+	}
+	if model.UsedAt != nil {
+		usedAt := time.Unix(*model.UsedAt, 0)
+		token.UsedAt = &usedAt
+	}
+	return token
+}
@@ -0,0 +1,116 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// Repository implements the main repository interface using GORM
+type Repository struct {
+	db             *gorm.DB
+	logger         ports.Logger
+	clock          clock.Clock
+	userRepository ports.UserRepository
+
+	authSessionRepository  ports.AuthSessionRepository
+	accountTokenRepository ports.AccountTokenRepository
+}
+
+// NewRepository creates a new Repository instance, whose timestamps come from the clock
+func NewRepository(db *gorm.DB, logger ports.Logger, clock clock.Clock) ports.Repository {
+	return &Repository{
+		db:             db,
+		logger:         logger,
+		clock:          clock,
+		userRepository: NewUserRepository(db, logger, clock),
+
+		authSessionRepository:  NewAuthSessionRepository(db, logger, clock),
+		accountTokenRepository: NewAccountTokenRepository(db, logger, clock),
+	}
+}
+
+// UserRepository returns the user repository instance
+func (r *Repository) UserRepository() ports.UserRepository {
+	return r.userRepository
+}
+
+// AuthSessionRepository returns the auth session repository instance
+func (r *Repository) AuthSessionRepository() ports.AuthSessionRepository {
+	return r.authSessionRepository
+}
+
+// AccountTokenRepository returns the account token repository instance
+func (r *Repository) AccountTokenRepository() ports.AccountTokenRepository {
+	return r.accountTokenRepository
+}
+
+// BeginTransaction starts a new database transaction
+func (r *Repository) BeginTransaction(ctx context.Context) (ports.Transaction, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &Transaction{
+		tx:     tx,
+		logger: r.logger,
+		clock:  r.clock,
+	}, nil
+}
+
+// Close closes the repository (database connection)
+func (r *Repository) Close() error {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+// Transaction implements the transaction interface
+type Transaction struct {
+	tx             *gorm.DB
+	logger         ports.Logger
+	clock          clock.Clock
+	userRepository ports.UserRepository
+
+	authSessionRepository  ports.AuthSessionRepository
+	accountTokenRepository ports.AccountTokenRepository
+}
+
+// UserRepository returns the user repository for this transaction
+func (t *Transaction) UserRepository() ports.UserRepository {
+	if t.userRepository == nil {
+		t.userRepository = NewUserRepository(t.tx, t.logger, t.clock)
+	}
+	return t.userRepository
+}
+
+// AuthSessionRepository returns the auth session repository for this transaction
+func (t *Transaction) AuthSessionRepository() ports.AuthSessionRepository {
+	if t.authSessionRepository == nil {
+		t.authSessionRepository = NewAuthSessionRepository(t.tx, t.logger, t.clock)
+	}
+	return t.authSessionRepository
+}
+
+// AccountTokenRepository returns the account token repository for this transaction
+func (t *Transaction) AccountTokenRepository() ports.AccountTokenRepository {
+	if t.accountTokenRepository == nil {
+		t.accountTokenRepository = NewAccountTokenRepository(t.tx, t.logger, t.clock)
+	}
+	return t.accountTokenRepository
+}
+
+// Commit commits the transaction
+func (t *Transaction) Commit() error {
+	return t.tx.Commit().Error
+}
+
+// Rollback rolls back the transaction
+func (t *Transaction) Rollback() error {
+	return t.tx.Rollback().Error
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/example/golden/internal/domain/ports"
+	"github.com/example/golden/internal/infrastructure/config"
+)
+
+// accountTokenBytes is the amount of randomness in an account token
+const accountTokenBytes = 32
+
+// AccountTokenSigner implements email verification and password reset tokens
+// Tokens are random values, stored as an HMAC-SHA256 keyed with the JWT secret,
+// so a leaked token table cannot be turned into working links
+type AccountTokenSigner struct {
+	secret []byte
+}
+
+// NewAccountTokenSigner creates a new AccountTokenSigner instance
+func NewAccountTokenSigner(config *config.AuthConfig) ports.AccountTokenSigner {
+	return &AccountTokenSigner{
+		secret: []byte(config.JWTSecret),
+	}
+}
+
+// Generate creates a random URL-safe token and its signed hash
+func (s *AccountTokenSigner) Generate() (string, string, error) {
+	raw := make([]byte, accountTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	return token, s.Hash(token), nil
+}
+
+// Hash returns the signed hash of a token
+func (s *AccountTokenSigner) Hash(token string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
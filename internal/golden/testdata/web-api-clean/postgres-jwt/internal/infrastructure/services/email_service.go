@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"strings"
+
+	"net/url"
+
+	"github.com/example/golden/internal/domain/entities"
+
+	"github.com/example/golden/internal/domain/ports"
+	"github.com/example/golden/internal/infrastructure/config"
+)
+
+// EmailService implements email operations
+// With the "smtp" provider emails are delivered through the configured SMTP server,
+// any other provider (for example "log") only logs them
+type EmailService struct {
+	config *config.EmailConfig
+	logger ports.Logger
+}
+
+// NewEmailService creates a new EmailService instance
+func NewEmailService(config *config.EmailConfig, logger ports.Logger) ports.EmailService {
+	return &EmailService{
+		config: config,
+		logger: logger,
+	}
+}
+
+// SendWelcomeEmail sends a welcome email to a new user
+func (s *EmailService) SendWelcomeEmail(ctx context.Context, user *entities.User) error {
+	s.logger.Info("Sending welcome email", "user_id", user.ID, "email", user.Email)
+
+	subject := fmt.Sprintf("Welcome to golden, %s!", user.GetFullName())
+	body := fmt.Sprintf("Hi %s,\n\nWelcome to golden! Your account is ready.\n", user.GetFullName())
+
+	return s.send(ctx, user.Email, subject, body)
+}
+
+// SendPasswordResetEmail sends a password reset email
+func (s *EmailService) SendPasswordResetEmail(ctx context.Context, user *entities.User, resetToken string) error {
+	s.logger.Info("Sending password reset email", "user_id", user.ID, "email", user.Email)
+
+	subject := "Reset Your golden Password"
+	body := fmt.Sprintf("Hi %s,\n\nWe received a request to reset your password. Open the link below to choose a new one:\n\n%s\n\nIf you did not ask for a reset you can ignore this email, your password stays the same.\n",
+		user.GetFullName(), s.link("/reset-password", resetToken))
+
+	return s.send(ctx, user.Email, subject, body)
+}
+
+// SendEmailVerification sends an email verification message
+func (s *EmailService) SendEmailVerification(ctx context.Context, user *entities.User, verificationToken string) error {
+	s.logger.Info("Sending email verification", "user_id", user.ID, "email", user.Email)
+
+	subject := "Verify Your golden Email Address"
+	body := fmt.Sprintf("Hi %s,\n\nPlease confirm your email address by opening the link below:\n\n%s\n",
+		user.GetFullName(), s.link("/verify-email", verificationToken))
+
+	return s.send(ctx, user.Email, subject, body)
+}
+
+// link builds a link to the application page that redeems a token
+func (s *EmailService) link(path, token string) string {
+	return strings.TrimSuffix(s.config.BaseURL, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+// send delivers a plain text email, or only logs it unless the provider is "smtp"
+func (s *EmailService) send(ctx context.Context, to, subject, body string) error {
+	// Reject header injection through the recipient or subject
+	if strings.ContainsAny(to, "\r\n") || strings.ContainsAny(subject, "\r\n") {
+		return fmt.Errorf("invalid email header")
+	}
+
+	if s.config.Provider != "smtp" {
+		s.logger.Info("Email not delivered, provider is not smtp",
+			"provider", s.config.Provider,
+			"to", to,
+			"subject", subject,
+			"body_length", len(body),
+		)
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	from := s.config.FromEmail
+	message := strings.Join([]string{
+		fmt.Sprintf("From: %s <%s>", s.config.FromName, from),
+		"To: " + to,
+		"Subject: " + subject,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+	}, "\r\n")
+
+	var auth smtp.Auth
+	if s.config.SMTPUser != "" {
+		auth = smtp.PlainAuth("", s.config.SMTPUser, s.config.SMTPPass, s.config.SMTPHost)
+	}
+
+	addr := fmt.Sprintf("%s:%d", s.config.SMTPHost, s.config.SMTPPort)
+	if err := smtp.SendMail(addr, auth, from, []string{to}, []byte(message)); err != nil {
+		s.logger.Error("Failed to deliver email", "error", err, "to", to, "subject", subject)
+		return fmt.Errorf("failed to deliver email: %w", err)
+	}
+
+	s.logger.Info("Email delivered", "to", to, "subject", subject)
+	return nil
+}
@@ -0,0 +1,32 @@
+package web
+
+import (
+	"fmt"
+
+	"github.com/example/golden/internal/domain/ports"
+	"github.com/example/golden/internal/infrastructure/web/adapters"
+)
+
+// NewRouter creates a router based on the configured framework
+func NewRouter(framework string) (ports.Router, error) {
+	switch framework {
+
+	case "gin":
+		return adapters.NewGinRouter(), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported web framework: %s", framework)
+	}
+}
+
+// NewWebServer creates a web server based on the configured framework
+func NewWebServer(framework string, router ports.Router) (ports.WebServer, error) {
+	switch framework {
+
+	case "gin":
+		return adapters.NewGinWebServer(router), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported web framework: %s", framework)
+	}
+}
@@ -0,0 +1,136 @@
+package web
+
+import (
+	"github.com/example/golden/internal/adapters/controllers"
+
+	"github.com/example/golden/internal/domain/ports"
+
+	"github.com/example/golden/internal/domain/usecases"
+
+	"github.com/example/golden/internal/infrastructure/config"
+	"github.com/example/golden/internal/infrastructure/web/middleware"
+)
+
+// RouterService manages HTTP routing using domain interfaces
+type RouterService struct {
+	router ports.Router
+	config *config.ServerConfig
+	logger ports.Logger
+
+	authUseCase *usecases.AuthUseCase
+}
+
+// NewRouterService creates a new RouterService instance
+func NewRouterService(config *config.ServerConfig, logger ports.Logger) (*RouterService, error) {
+	// Create the router using the configured framework
+	router, err := NewRouter("gin")
+	if err != nil {
+		return nil, err
+	}
+
+	service := &RouterService{
+		router: router,
+		config: config,
+		logger: logger,
+	}
+
+	// Setup global middleware
+	service.setupMiddleware()
+
+	return service, nil
+}
+
+// SetAuthUseCase sets the auth use case for authentication middleware
+func (r *RouterService) SetAuthUseCase(authUseCase *usecases.AuthUseCase) {
+	r.authUseCase = authUseCase
+}
+
+// setupMiddleware configures global middleware
+func (r *RouterService) setupMiddleware() {
+	// Security middleware - applied first for maximum protection
+	r.router.Use(middleware.RequestID())
+	r.router.Use(middleware.SecurityHeaders())
+	r.router.Use(middleware.ValidationMiddleware())
+
+	// Recovery middleware
+	r.router.Use(middleware.Recovery(r.logger))
+
+	// CORS middleware
+	r.router.Use(middleware.CORS())
+
+	// Request logging middleware
+	r.router.Use(middleware.RequestLogger(r.logger))
+}
+
+// RegisterHealthRoutes registers health check routes
+func (r *RouterService) RegisterHealthRoutes(controller *controllers.HealthController) {
+	health := r.router.Group("/health")
+	health.GET("", controller.Health)
+	health.GET("/", controller.Health)
+
+	// Kubernetes health checks
+	r.router.GET("/ready", controller.Readiness)
+	r.router.GET("/live", controller.Liveness)
+}
+
+// RegisterUserRoutes registers user-related routes
+func (r *RouterService) RegisterUserRoutes(controller *controllers.UserController) {
+	v1 := r.router.Group("/api/v1")
+
+	users := v1.Group("/users")
+	// Public routes
+	users.POST("", controller.CreateUser)
+
+	// Protected routes
+	protected := users.Group("")
+
+	protected.Use(middleware.Auth(r.authUseCase, r.logger))
+
+	protected.GET("", controller.ListUsers)
+	protected.GET("/:id", controller.GetUser)
+	protected.PUT("/:id", controller.UpdateUser)
+	protected.DELETE("/:id", controller.DeleteUser)
+}
+
+// RegisterAuthRoutes registers authentication routes
+func (r *RouterService) RegisterAuthRoutes(controller *controllers.AuthController) {
+	v1 := r.router.Group("/api/v1")
+
+	auth := v1.Group("/auth")
+	// Public auth routes
+	auth.POST("/login", controller.Login())
+	auth.POST("/refresh", controller.RefreshToken())
+
+	// Protected auth routes
+	protected := auth.Group("")
+
+	protected.Use(middleware.Auth(r.authUseCase, r.logger))
+
+	protected.POST("/logout", controller.Logout())
+	protected.POST("/logout-all", controller.LogoutAll())
+	protected.GET("/me", controller.Me())
+}
+
+// RegisterAccountRoutes registers the email verification and password reset routes
+func (r *RouterService) RegisterAccountRoutes(controller *controllers.AccountController) {
+	auth := r.router.Group("/api/v1/auth")
+	// Public routes, the token or email in the body identifies the account
+	auth.POST("/verify-email", controller.VerifyEmail())
+	auth.POST("/forgot-password", controller.ForgotPassword())
+	auth.POST("/reset-password", controller.ResetPassword())
+
+	// Protected routes
+	protected := auth.Group("")
+	protected.Use(middleware.Auth(r.authUseCase, r.logger))
+	protected.POST("/verify-email/resend", controller.ResendVerification())
+}
+
+// GetRouter returns the domain router interface
+func (r *RouterService) GetRouter() ports.Router {
+	return r.router
+}
+
+// CreateWebServer creates a web server for this router
+func (r *RouterService) CreateWebServer() (ports.WebServer, error) {
+	return NewWebServer("gin", r.router)
+}
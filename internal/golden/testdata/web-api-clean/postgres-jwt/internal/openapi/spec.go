@@ -0,0 +1,267 @@
+// Package openapi loads the OpenAPI 3 spec of the API for the tools that work
+// from it: the mock server and the client generator.
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MaxRefDepth stops following $ref chains that point back at themselves
+const MaxRefDepth = 32
+
+// Spec is the part of an OpenAPI 3 document the tools understand
+type Spec struct {
+	Info       Info                `yaml:"info"`
+	Servers    []Server            `yaml:"servers"`
+	Paths      map[string]PathItem `yaml:"paths"`
+	Components Components          `yaml:"components"`
+}
+
+// Info holds the title of the API
+type Info struct {
+	Title string `yaml:"title"`
+}
+
+// Server is an entry of the servers list; its URL path prefixes every route
+type Server struct {
+	URL string `yaml:"url"`
+}
+
+// PathItem holds the operations of a path and the parameters they share
+type PathItem struct {
+	Parameters []*Parameter `yaml:"parameters"`
+	Get        *Operation   `yaml:"get"`
+	Put        *Operation   `yaml:"put"`
+	Post       *Operation   `yaml:"post"`
+	Delete     *Operation   `yaml:"delete"`
+	Patch      *Operation   `yaml:"patch"`
+	Head       *Operation   `yaml:"head"`
+	Options    *Operation   `yaml:"options"`
+}
+
+// Operation is a method on a path
+type Operation struct {
+	OperationID string               `yaml:"operationId"`
+	Summary     string               `yaml:"summary"`
+	Parameters  []*Parameter         `yaml:"parameters"`
+	RequestBody *RequestBody         `yaml:"requestBody"`
+	Responses   map[string]*Response `yaml:"responses"`
+}
+
+// Parameter is a path, query, header or cookie parameter of an operation
+type Parameter struct {
+	Ref         string  `yaml:"$ref"`
+	Name        string  `yaml:"name"`
+	In          string  `yaml:"in"`
+	Description string  `yaml:"description"`
+	Required    bool    `yaml:"required"`
+	Schema      *Schema `yaml:"schema"`
+}
+
+// RequestBody describes the body an operation accepts
+type RequestBody struct {
+	Ref      string                `yaml:"$ref"`
+	Required bool                  `yaml:"required"`
+	Content  map[string]*MediaType `yaml:"content"`
+}
+
+// Response describes one of the responses of an operation
+type Response struct {
+	Ref         string                `yaml:"$ref"`
+	Description string                `yaml:"description"`
+	Content     map[string]*MediaType `yaml:"content"`
+}
+
+// MediaType is the schema and examples of a body in one content type
+type MediaType struct {
+	Schema   *Schema             `yaml:"schema"`
+	Example  any                 `yaml:"example"`
+	Examples map[string]*Example `yaml:"examples"`
+}
+
+// Example is a named example of a body
+type Example struct {
+	Value any `yaml:"value"`
+}
+
+// Schema is a JSON schema as written in OpenAPI 3.0
+type Schema struct {
+	Ref                  string                `yaml:"$ref"`
+	Type                 string                `yaml:"type"`
+	Format               string                `yaml:"format"`
+	Description          string                `yaml:"description"`
+	Properties           Properties            `yaml:"properties"`
+	AdditionalProperties *AdditionalProperties `yaml:"additionalProperties"`
+	Items                *Schema               `yaml:"items"`
+	Required             []string              `yaml:"required"`
+	Enum                 []any                 `yaml:"enum"`
+	Example              any                   `yaml:"example"`
+	Default              any                   `yaml:"default"`
+	Nullable             bool                  `yaml:"nullable"`
+	AllOf                []*Schema             `yaml:"allOf"`
+	OneOf                []*Schema             `yaml:"oneOf"`
+	AnyOf                []*Schema             `yaml:"anyOf"`
+	Minimum              *float64              `yaml:"minimum"`
+	Maximum              *float64              `yaml:"maximum"`
+	MinLength            *int                  `yaml:"minLength"`
+	MaxLength            *int                  `yaml:"maxLength"`
+}
+
+// Property is a named property of an object schema
+type Property struct {
+	Name   string
+	Schema *Schema
+}
+
+// Properties are the properties of an object schema, in the order of the spec
+// so generated types and validation messages follow the way the API documents them
+type Properties []Property
+
+// UnmarshalYAML keeps the properties in the order they are written
+func (p *Properties) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: properties must be a mapping", node.Line)
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		var schema Schema
+		if err := node.Content[i+1].Decode(&schema); err != nil {
+			return err
+		}
+		*p = append(*p, Property{Name: node.Content[i].Value, Schema: &schema})
+	}
+	return nil
+}
+
+// AdditionalProperties is either a boolean or the schema of the values of a map
+type AdditionalProperties struct {
+	Allowed bool
+	Schema  *Schema
+}
+
+// UnmarshalYAML accepts both forms of additionalProperties
+func (a *AdditionalProperties) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode(&a.Allowed)
+	}
+	a.Allowed = true
+	a.Schema = &Schema{}
+	return node.Decode(a.Schema)
+}
+
+// Components holds the reusable parts of the spec that $ref points at
+type Components struct {
+	Schemas       map[string]*Schema      `yaml:"schemas"`
+	Parameters    map[string]*Parameter   `yaml:"parameters"`
+	Responses     map[string]*Response    `yaml:"responses"`
+	RequestBodies map[string]*RequestBody `yaml:"requestBodies"`
+}
+
+// Load reads an OpenAPI 3 spec in YAML or JSON
+func Load(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spec: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse parses an OpenAPI 3 spec in YAML or JSON
+func Parse(data []byte) (*Spec, error) {
+	var spec Spec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse spec: %w", err)
+	}
+	if len(spec.Paths) == 0 {
+		return nil, errors.New("spec has no paths")
+	}
+	return &spec, nil
+}
+
+// BasePath is the path of the first server URL, such as /api/v1, that prefixes every route
+func (s *Spec) BasePath() string {
+	if len(s.Servers) == 0 {
+		return ""
+	}
+	u, err := url.Parse(s.Servers[0].URL)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(u.Path, "/")
+}
+
+// Operations returns the operations of the path by HTTP method
+func (p PathItem) Operations() map[string]*Operation {
+	operations := make(map[string]*Operation)
+	for method, op := range map[string]*Operation{
+		"GET":     p.Get,
+		"PUT":     p.Put,
+		"POST":    p.Post,
+		"DELETE":  p.Delete,
+		"PATCH":   p.Patch,
+		"HEAD":    p.Head,
+		"OPTIONS": p.Options,
+	} {
+		if op != nil {
+			operations[method] = op
+		}
+	}
+	return operations
+}
+
+// ResolveSchema follows the $ref of schema to the component it points at
+func (s *Spec) ResolveSchema(schema *Schema) *Schema {
+	for depth := 0; schema != nil && schema.Ref != ""; depth++ {
+		if depth == MaxRefDepth {
+			return nil
+		}
+		schema = s.Components.Schemas[RefName(schema.Ref, "#/components/schemas/")]
+	}
+	return schema
+}
+
+// ResolveParameter follows the $ref of param to the component it points at
+func (s *Spec) ResolveParameter(param *Parameter) *Parameter {
+	for depth := 0; param != nil && param.Ref != ""; depth++ {
+		if depth == MaxRefDepth {
+			return nil
+		}
+		param = s.Components.Parameters[RefName(param.Ref, "#/components/parameters/")]
+	}
+	return param
+}
+
+// ResolveResponse follows the $ref of response to the component it points at
+func (s *Spec) ResolveResponse(response *Response) *Response {
+	for depth := 0; response != nil && response.Ref != ""; depth++ {
+		if depth == MaxRefDepth {
+			return nil
+		}
+		response = s.Components.Responses[RefName(response.Ref, "#/components/responses/")]
+	}
+	return response
+}
+
+// ResolveRequestBody follows the $ref of body to the component it points at
+func (s *Spec) ResolveRequestBody(body *RequestBody) *RequestBody {
+	for depth := 0; body != nil && body.Ref != ""; depth++ {
+		if depth == MaxRefDepth {
+			return nil
+		}
+		body = s.Components.RequestBodies[RefName(body.Ref, "#/components/requestBodies/")]
+	}
+	return body
+}
+
+// RefName returns the component name of a local $ref, or "" when ref points elsewhere
+func RefName(ref, prefix string) string {
+	if !strings.HasPrefix(ref, prefix) {
+		return ""
+	}
+	return strings.TrimPrefix(ref, prefix)
+}
@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"github.com/example/golden/internal/domain/ports"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAccountTokenSigner is a mock implementation of ports.AccountTokenSigner
+type MockAccountTokenSigner struct {
+	mock.Mock
+}
+
+var _ ports.AccountTokenSigner = (*MockAccountTokenSigner)(nil)
+
+// Generate provides a mock function with given fields:
+func (m *MockAccountTokenSigner) Generate() (string, string, error) {
+	args := m.Called()
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+// Hash provides a mock function with given fields: token
+func (m *MockAccountTokenSigner) Hash(token string) string {
+	args := m.Called(token)
+	return args.String(0)
+}
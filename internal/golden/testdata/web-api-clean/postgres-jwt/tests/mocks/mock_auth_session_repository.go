@@ -0,0 +1,73 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/example/golden/internal/domain/entities"
+	"github.com/example/golden/internal/domain/ports"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAuthSessionRepository is a mock implementation of ports.AuthSessionRepository
+type MockAuthSessionRepository struct {
+	mock.Mock
+}
+
+var _ ports.AuthSessionRepository = (*MockAuthSessionRepository)(nil)
+
+// Create provides a mock function with given fields: ctx, session
+func (m *MockAuthSessionRepository) Create(ctx context.Context, session *entities.AuthSession) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+// GetByAccessToken provides a mock function with given fields: ctx, accessToken
+func (m *MockAuthSessionRepository) GetByAccessToken(ctx context.Context, accessToken string) (*entities.AuthSession, error) {
+	args := m.Called(ctx, accessToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.AuthSession), args.Error(1)
+}
+
+// GetByRefreshToken provides a mock function with given fields: ctx, refreshToken
+func (m *MockAuthSessionRepository) GetByRefreshToken(ctx context.Context, refreshToken string) (*entities.AuthSession, error) {
+	args := m.Called(ctx, refreshToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.AuthSession), args.Error(1)
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userID
+func (m *MockAuthSessionRepository) GetByUserID(ctx context.Context, userID string) ([]*entities.AuthSession, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.AuthSession), args.Error(1)
+}
+
+// Update provides a mock function with given fields: ctx, session
+func (m *MockAuthSessionRepository) Update(ctx context.Context, session *entities.AuthSession) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+// Delete provides a mock function with given fields: ctx, sessionID
+func (m *MockAuthSessionRepository) Delete(ctx context.Context, sessionID string) error {
+	args := m.Called(ctx, sessionID)
+	return args.Error(0)
+}
+
+// DeleteByUserID provides a mock function with given fields: ctx, userID
+func (m *MockAuthSessionRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// DeleteExpired provides a mock function with given fields: ctx
+func (m *MockAuthSessionRepository) DeleteExpired(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
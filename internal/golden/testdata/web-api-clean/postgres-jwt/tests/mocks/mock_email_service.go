@@ -0,0 +1,35 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/example/golden/internal/domain/ports"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/example/golden/internal/domain/entities"
+)
+
+// MockEmailService is a mock implementation of ports.EmailService
+type MockEmailService struct {
+	mock.Mock
+}
+
+var _ ports.EmailService = (*MockEmailService)(nil)
+
+// SendWelcomeEmail provides a mock function with given fields: ctx, user
+func (m *MockEmailService) SendWelcomeEmail(ctx context.Context, user *entities.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+// SendPasswordResetEmail provides a mock function with given fields: ctx, user, token
+func (m *MockEmailService) SendPasswordResetEmail(ctx context.Context, user *entities.User, token string) error {
+	args := m.Called(ctx, user, token)
+	return args.Error(0)
+}
+
+// SendEmailVerification provides a mock function with given fields: ctx, user, token
+func (m *MockEmailService) SendEmailVerification(ctx context.Context, user *entities.User, token string) error {
+	args := m.Called(ctx, user, token)
+	return args.Error(0)
+}
@@ -0,0 +1,97 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/example/golden/internal/domain/ports"
+)
+
+// MockRepository is a fake of ports.Repository handing out repository mocks:
+// its getters return the mocks of its fields, and BeginTransaction returns
+// Transaction, or BeginErr when set
+type MockRepository struct {
+	Users         *MockUserRepository
+	AuthSessions  *MockAuthSessionRepository
+	AccountTokens *MockAccountTokenRepository
+	Transaction   *MockTransaction
+	BeginErr      error
+}
+
+var _ ports.Repository = (*MockRepository)(nil)
+
+// NewMockRepository returns a MockRepository whose repositories, and those of
+// its transaction, are new mocks
+func NewMockRepository() *MockRepository {
+	r := &MockRepository{
+		Users:         &MockUserRepository{},
+		AuthSessions:  &MockAuthSessionRepository{},
+		AccountTokens: &MockAccountTokenRepository{},
+	}
+	r.Transaction = &MockTransaction{repository: r}
+	return r
+}
+
+// UserRepository returns the Users mock
+func (m *MockRepository) UserRepository() ports.UserRepository {
+	return m.Users
+}
+
+// AuthSessionRepository returns the AuthSessions mock
+func (m *MockRepository) AuthSessionRepository() ports.AuthSessionRepository {
+	return m.AuthSessions
+}
+
+// AccountTokenRepository returns the AccountTokens mock
+func (m *MockRepository) AccountTokenRepository() ports.AccountTokenRepository {
+	return m.AccountTokens
+}
+
+// BeginTransaction returns Transaction, or BeginErr when set
+func (m *MockRepository) BeginTransaction(ctx context.Context) (ports.Transaction, error) {
+	if m.BeginErr != nil {
+		return nil, m.BeginErr
+	}
+	return m.Transaction, nil
+}
+
+// MockTransaction is a fake of ports.Transaction sharing the repository mocks
+// of its MockRepository. It records whether it was committed or rolled back,
+// and Commit returns CommitErr.
+type MockTransaction struct {
+	repository *MockRepository
+	Committed  bool
+	RolledBack bool
+	CommitErr  error
+}
+
+var _ ports.Transaction = (*MockTransaction)(nil)
+
+// UserRepository returns the Users mock of the repository
+func (m *MockTransaction) UserRepository() ports.UserRepository {
+	return m.repository.Users
+}
+
+// AuthSessionRepository returns the AuthSessions mock of the repository
+func (m *MockTransaction) AuthSessionRepository() ports.AuthSessionRepository {
+	return m.repository.AuthSessions
+}
+
+// AccountTokenRepository returns the AccountTokens mock of the repository
+func (m *MockTransaction) AccountTokenRepository() ports.AccountTokenRepository {
+	return m.repository.AccountTokens
+}
+
+// Commit records the commit and returns CommitErr
+func (m *MockTransaction) Commit() error {
+	if m.CommitErr != nil {
+		return m.CommitErr
+	}
+	m.Committed = true
+	return nil
+}
+
+// Rollback records the rollback
+func (m *MockTransaction) Rollback() error {
+	m.RolledBack = true
+	return nil
+}
@@ -0,0 +1,47 @@
+package mocks
+
+import (
+	"github.com/example/golden/internal/domain/entities"
+	"github.com/example/golden/internal/domain/ports"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTokenService is a mock implementation of ports.TokenService
+type MockTokenService struct {
+	mock.Mock
+}
+
+var _ ports.TokenService = (*MockTokenService)(nil)
+
+// GenerateAccessToken provides a mock function with given fields: userID
+func (m *MockTokenService) GenerateAccessToken(userID string) (*entities.AuthToken, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.AuthToken), args.Error(1)
+}
+
+// GenerateRefreshToken provides a mock function with given fields: userID
+func (m *MockTokenService) GenerateRefreshToken(userID string) (*entities.AuthToken, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.AuthToken), args.Error(1)
+}
+
+// ValidateToken provides a mock function with given fields: token
+func (m *MockTokenService) ValidateToken(token string) (string, error) {
+	args := m.Called(token)
+	return args.String(0), args.Error(1)
+}
+
+// RefreshToken provides a mock function with given fields: refreshToken
+func (m *MockTokenService) RefreshToken(refreshToken string) (*entities.AuthToken, error) {
+	args := m.Called(refreshToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.AuthToken), args.Error(1)
+}
@@ -0,0 +1,162 @@
+package unit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/domain/entities"
+	"github.com/example/golden/internal/domain/usecases"
+	"github.com/example/golden/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type accountMocks struct {
+	users    *mocks.MockUserRepository
+	tokens   *mocks.MockAccountTokenRepository
+	sessions *mocks.MockAuthSessionRepository
+	password *mocks.MockPasswordService
+	signer   *mocks.MockAccountTokenSigner
+	email    *mocks.MockEmailService
+	clock    *clock.Fake
+}
+
+func newAccountUseCase() (*usecases.AccountUseCase, *accountMocks) {
+	m := &accountMocks{
+		users:    new(mocks.MockUserRepository),
+		tokens:   new(mocks.MockAccountTokenRepository),
+		sessions: new(mocks.MockAuthSessionRepository),
+		password: new(mocks.MockPasswordService),
+		signer:   new(mocks.MockAccountTokenSigner),
+		email:    new(mocks.MockEmailService),
+		clock:    clock.NewFake(time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)),
+	}
+	mockLogger := new(mocks.MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+
+	useCase := usecases.NewAccountUseCase(m.users, m.tokens, m.sessions, m.password, m.signer, m.email, mockLogger, m.clock, usecases.DefaultAccountPolicy())
+	return useCase, m
+}
+
+func TestAccountUseCase_RequestEmailVerification(t *testing.T) {
+	useCase, m := newAccountUseCase()
+	ctx := context.Background()
+	user := &entities.User{ID: "user-1", Email: "ada@example.com", IsActive: true}
+
+	m.users.On("GetByID", ctx, "user-1").Return(user, nil)
+	m.tokens.On("CountSince", ctx, "user-1", entities.PurposeEmailVerification, mock.Anything).Return(int64(0), nil).Once()
+	m.signer.On("Generate").Return("raw-token", "hashed-token", nil).Once()
+	m.tokens.On("Create", ctx, mock.MatchedBy(func(token *entities.AccountToken) bool {
+		// Only the hash is stored
+		return token.UserID == "user-1" && token.TokenHash == "hashed-token" && token.Purpose == entities.PurposeEmailVerification
+	})).Return(nil).Once()
+	m.email.On("SendEmailVerification", ctx, user, "raw-token").Return(nil).Once()
+
+	assert.NoError(t, useCase.RequestEmailVerification(ctx, "user-1"))
+
+	// The hourly limit is enforced
+	m.tokens.On("CountSince", ctx, "user-1", entities.PurposeEmailVerification, mock.Anything).Return(int64(3), nil).Once()
+	assert.Equal(t, entities.ErrTooManyRequests, useCase.RequestEmailVerification(ctx, "user-1"))
+
+	// Verified addresses are not sent another link
+	user.EmailVerified = true
+	assert.Equal(t, entities.ErrEmailAlreadyVerified, useCase.RequestEmailVerification(ctx, "user-1"))
+
+	m.tokens.AssertExpectations(t)
+	m.email.AssertExpectations(t)
+}
+
+func TestAccountUseCase_VerifyEmail(t *testing.T) {
+	useCase, m := newAccountUseCase()
+	ctx := context.Background()
+	user := &entities.User{ID: "user-1", Email: "ada@example.com", IsActive: true}
+	token := entities.NewAccountToken("user-1", entities.PurposeEmailVerification, "hashed-token", time.Hour, m.clock.Now())
+	token.ID = "token-1"
+
+	m.signer.On("Hash", "raw-token").Return("hashed-token")
+	m.tokens.On("GetByHash", ctx, entities.PurposeEmailVerification, "hashed-token").Return(token, nil).Once()
+	m.tokens.On("MarkUsed", ctx, "token-1").Return(nil).Once()
+	m.users.On("GetByID", ctx, "user-1").Return(user, nil).Once()
+	m.users.On("Update", ctx, user).Return(nil).Once()
+	m.tokens.On("DeleteByUserID", ctx, "user-1", entities.PurposeEmailVerification).Return(nil).Once()
+
+	assert.NoError(t, useCase.VerifyEmail(ctx, "raw-token"))
+	assert.True(t, user.EmailVerified)
+
+	// Unknown, empty and expired tokens are all rejected the same way
+	m.signer.On("Hash", "unknown").Return("unknown-hash")
+	m.tokens.On("GetByHash", ctx, entities.PurposeEmailVerification, "unknown-hash").Return(nil, entities.ErrAccountTokenInvalid).Once()
+	assert.Equal(t, entities.ErrAccountTokenInvalid, useCase.VerifyEmail(ctx, "unknown"))
+	assert.Equal(t, entities.ErrAccountTokenInvalid, useCase.VerifyEmail(ctx, ""))
+
+	// A token cannot be redeemed once its lifetime is over
+	expired := entities.NewAccountToken("user-1", entities.PurposeEmailVerification, "hashed-token", time.Hour, m.clock.Now())
+	m.clock.Advance(time.Hour + time.Second)
+	m.tokens.On("GetByHash", ctx, entities.PurposeEmailVerification, "hashed-token").Return(expired, nil).Once()
+	assert.Equal(t, entities.ErrAccountTokenInvalid, useCase.VerifyEmail(ctx, "raw-token"))
+
+	m.users.AssertExpectations(t)
+	m.tokens.AssertExpectations(t)
+}
+
+func TestAccountUseCase_RequestPasswordReset(t *testing.T) {
+	useCase, m := newAccountUseCase()
+	ctx := context.Background()
+	user := &entities.User{ID: "user-1", Email: "ada@example.com", IsActive: true}
+
+	m.users.On("GetByEmail", ctx, "ada@example.com").Return(user, nil)
+	m.tokens.On("CountSince", ctx, "user-1", entities.PurposePasswordReset, mock.Anything).Return(int64(0), nil).Once()
+	m.signer.On("Generate").Return("raw-token", "hashed-token", nil).Once()
+	m.tokens.On("Create", ctx, mock.AnythingOfType("*entities.AccountToken")).Return(nil).Once()
+	m.email.On("SendPasswordResetEmail", ctx, user, "raw-token").Return(nil).Once()
+
+	assert.NoError(t, useCase.RequestPasswordReset(ctx, "ada@example.com"))
+
+	// Unknown addresses and rate limited requests succeed without sending anything
+	m.users.On("GetByEmail", ctx, "nobody@example.com").Return(nil, entities.ErrUserNotFound).Once()
+	assert.NoError(t, useCase.RequestPasswordReset(ctx, "nobody@example.com"))
+
+	m.tokens.On("CountSince", ctx, "user-1", entities.PurposePasswordReset, mock.Anything).Return(int64(3), nil).Once()
+	assert.NoError(t, useCase.RequestPasswordReset(ctx, "ada@example.com"))
+
+	m.tokens.AssertExpectations(t)
+	m.email.AssertExpectations(t)
+}
+
+func TestAccountUseCase_ResetPassword(t *testing.T) {
+	useCase, m := newAccountUseCase()
+	ctx := context.Background()
+	user := &entities.User{ID: "user-1", Email: "ada@example.com", Password: "old-hash", IsActive: true}
+	token := entities.NewAccountToken("user-1", entities.PurposePasswordReset, "hashed-token", time.Hour, m.clock.Now())
+	token.ID = "token-1"
+
+	// Weak passwords are rejected before the token is spent
+	assert.Equal(t, entities.ErrWeakPassword, useCase.ResetPassword(ctx, usecases.ResetPasswordInput{Token: "raw-token", Password: "short"}))
+
+	m.signer.On("Hash", "raw-token").Return("hashed-token")
+	m.tokens.On("GetByHash", ctx, entities.PurposePasswordReset, "hashed-token").Return(token, nil).Once()
+	m.tokens.On("MarkUsed", ctx, "token-1").Return(nil).Once()
+	m.users.On("GetByID", ctx, "user-1").Return(user, nil).Once()
+	m.password.On("Hash", "new-password").Return("new-hash", nil).Once()
+	m.users.On("Update", ctx, user).Return(nil).Once()
+	m.tokens.On("DeleteByUserID", ctx, "user-1", entities.PurposePasswordReset).Return(nil).Once()
+	// All sessions end with the old password
+	m.sessions.On("DeleteByUserID", ctx, "user-1").Return(nil).Once()
+
+	assert.NoError(t, useCase.ResetPassword(ctx, usecases.ResetPasswordInput{Token: "raw-token", Password: "new-password"}))
+	assert.Equal(t, "new-hash", user.Password)
+	assert.True(t, user.EmailVerified)
+
+	// A token redeemed concurrently cannot be used again
+	m.tokens.On("GetByHash", ctx, entities.PurposePasswordReset, "hashed-token").Return(token, nil).Once()
+	m.tokens.On("MarkUsed", ctx, "token-1").Return(entities.ErrAccountTokenInvalid).Once()
+	assert.Equal(t, entities.ErrAccountTokenInvalid, useCase.ResetPassword(ctx, usecases.ResetPasswordInput{Token: "raw-token", Password: "new-password"}))
+
+	m.users.AssertExpectations(t)
+	m.tokens.AssertExpectations(t)
+	m.sessions.AssertExpectations(t)
+}
@@ -0,0 +1,34 @@
+package unit_test
+
+import (
+	"testing"
+
+	"github.com/example/golden/internal/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUser_GetFullName(t *testing.T) {
+	user := entities.User{
+		FirstName: "John",
+		LastName:  "Doe",
+	}
+	assert.Equal(t, "John Doe", user.GetFullName())
+
+	user = entities.User{
+		FirstName: "Jane",
+		LastName:  "",
+	}
+	assert.Equal(t, "Jane", user.GetFullName())
+
+	user = entities.User{
+		FirstName: "",
+		LastName:  "Doe",
+	}
+	assert.Equal(t, "Doe", user.GetFullName())
+
+	user = entities.User{
+		FirstName: "",
+		LastName:  "",
+	}
+	assert.Equal(t, "", user.GetFullName())
+}
@@ -0,0 +1,63 @@
+// Command mockserver serves the API of golden from its OpenAPI spec,
+// so frontend teams can build against it while the real handlers are written
+//
+//	go run ./cmd/mockserver -spec api/openapi.yaml -addr :4010
+//
+// Every operation answers its first success response, using the examples of the spec
+// or bodies generated from the response schemas. JSON request bodies are checked against
+// the request schemas and rejected with 422 when they do not match. The Prefer header
+// picks another response: "Prefer: code=404" or "Prefer: example=name"
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/example/golden/internal/openapi"
+)
+
+func main() {
+	specPath := flag.String("spec", "api/openapi.yaml", "Path to the OpenAPI spec to serve")
+	addr := flag.String("addr", ":4010", "Address to listen on")
+	flag.Parse()
+
+	spec, err := openapi.Load(*specPath)
+	if err != nil {
+		log.Fatalf("mockserver: %v", err)
+	}
+
+	server := NewMockServer(spec)
+	for _, route := range server.Routes() {
+		log.Printf("mockserver: serving %s", route)
+	}
+
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           logRequests(server),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	log.Printf("mockserver: mocking %s on %s", *specPath, *addr)
+	log.Fatal(httpServer.ListenAndServe())
+}
+
+// statusRecorder remembers the status written by the mock server
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// logRequests logs the method, path and status of every request
+func logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+		log.Printf("mockserver: %s %s -> %d", r.Method, r.URL.Path, recorder.status)
+	})
+}
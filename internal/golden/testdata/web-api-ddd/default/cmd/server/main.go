@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/infrastructure/config"
+
+	"github.com/example/golden/internal/application/auth"
+
+	"github.com/example/golden/internal/application/user"
+
+	"github.com/example/golden/internal/infrastructure/persistence"
+
+	"github.com/example/golden/internal/infrastructure/logger"
+	"github.com/example/golden/internal/presentation/http/handlers"
+
+	"github.com/example/golden/internal/presentation/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to load configuration: %v", err))
+	}
+
+	// Create logger
+	appLogger := logger.NewWithLevel(cfg.Logger.Level)
+
+	// Time of the application, read instead of time.Now so tests can set it
+	clk := clock.New()
+
+	db, err := persistence.NewDatabase(persistence.DatabaseConfig{
+		Host:     cfg.Database.Host,
+		Port:     cfg.Database.Port,
+		Database: cfg.Database.Database,
+		Username: cfg.Database.Username,
+		Password: cfg.Database.Password,
+		SSLMode:  cfg.Database.SSLMode,
+	}, appLogger)
+	if err != nil {
+		appLogger.Error("Failed to connect to database", "error", err)
+		panic(fmt.Sprintf("Failed to connect to database: %v", err))
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			appLogger.Error("Failed to close database connection", "error", err)
+		}
+	}()
+
+	userRepo := persistence.NewUserRepository(db.DB, appLogger)
+
+	// Refresh tokens rotate on every use and are revoked server-side on logout
+	refreshTokenTTL := time.Duration(cfg.Auth.RefreshTokenExpiry) * 24 * time.Hour
+	refreshTokens := persistence.NewRefreshTokenRepository(db.DB, appLogger)
+
+	authService := auth.NewAuthService(userRepo, refreshTokens, refreshTokenTTL, clk, appLogger)
+
+	userCommandHandlers := user.NewCommandHandlers(userRepo, clk, appLogger)
+	userQueryHandlers := user.NewQueryHandlers(userRepo, appLogger)
+
+	r := gin.New()
+	r.Use(middleware.GinLogger(appLogger))
+	r.Use(gin.Recovery())
+	r.Use(middleware.CORS())
+
+	// Health check routes
+	healthHandler := handlers.NewHealthHandler(clk, appLogger)
+	r.GET("/health", gin.WrapF(healthHandler.Health))
+	r.GET("/ready", gin.WrapF(healthHandler.Ready))
+
+	h := handlers.NewGinHandlers(userCommandHandlers, userQueryHandlers, appLogger)
+	h.RegisterRoutes(r)
+
+	authHandlers := handlers.NewAuthGinHandlers(authService, appLogger)
+	authHandlers.RegisterRoutes(r)
+
+	serverAddr := fmt.Sprintf(":%s", cfg.Server.Port)
+	appLogger.Info("Starting Gin server", "port", cfg.Server.Port)
+	httpServer := &http.Server{
+		Addr:         serverAddr,
+		Handler:      r,
+		ReadTimeout:  time.Duration(cfg.Server.ReadTimeout) * time.Second,
+		WriteTimeout: time.Duration(cfg.Server.WriteTimeout) * time.Second,
+		IdleTimeout:  time.Duration(cfg.Server.IdleTimeout) * time.Second,
+	}
+
+	go func() {
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			appLogger.Error("Gin server failed to start", "error", err)
+			panic(fmt.Sprintf("Gin server failed to start: %v", err))
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	appLogger.Info("Shutting down server...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		appLogger.Error("Server forced to shutdown", "error", err)
+	}
+
+	appLogger.Info("Server exiting")
+}
@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/shared/valueobjects"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// SimpleAuthService provides basic authentication functionality, stamping tokens
+// with the time of its clock
+type SimpleAuthService struct {
+	clock clock.Clock
+}
+
+// HashPassword hashes a password using bcrypt
+func (s *SimpleAuthService) HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hash), nil
+}
+
+// VerifyPassword verifies a password against its hash
+func (s *SimpleAuthService) VerifyPassword(hashedPassword, password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+}
+
+// GenerateToken generates a JWT token (simplified implementation)
+func (s *SimpleAuthService) GenerateToken(userID valueobjects.ID) (string, error) {
+	// This is a simplified token generation - in production use a proper JWT library
+	randomBytes := make([]byte, 32)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	token := fmt.Sprintf("%s_%s_%d",
+		userID.String(),
+		hex.EncodeToString(randomBytes),
+		s.clock.Now().Unix())
+
+	return token, nil
+}
+
+// GenerateRefreshToken generates an opaque refresh token.
+// The token carries no claims, it is only meaningful to the refresh token store.
+func (s *SimpleAuthService) GenerateRefreshToken() (string, error) {
+	randomBytes := make([]byte, 32)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(randomBytes), nil
+}
+
+// ValidateToken validates a JWT token (simplified implementation)
+func (s *SimpleAuthService) ValidateToken(token string) (valueobjects.ID, error) {
+	// This is a simplified validation - in production use a proper JWT library
+	// For now, extract the user ID from the token prefix
+	if len(token) < 36 {
+		return valueobjects.ID{}, fmt.Errorf("invalid token format")
+	}
+
+	// Extract the UUID part (first 36 characters)
+	idStr := token[:36]
+	id, err := valueobjects.NewID(idStr)
+	if err != nil {
+		return valueobjects.ID{}, fmt.Errorf("invalid token: %w", err)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,334 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/domain/refreshtoken"
+	"github.com/example/golden/internal/domain/user"
+	"github.com/example/golden/internal/infrastructure/logger"
+	"github.com/example/golden/internal/shared/errors"
+	"github.com/example/golden/internal/shared/valueobjects"
+)
+
+// CommandHandler handles authentication commands
+type CommandHandler struct {
+	userRepository  user.Repository
+	refreshTokens   refreshtoken.Repository
+	refreshTokenTTL time.Duration
+	authService     AuthService
+	clock           clock.Clock
+	logger          *logger.Logger
+}
+
+// NewCommandHandler creates a new authentication command handler expiring refresh
+// tokens by clk
+func NewCommandHandler(
+	userRepo user.Repository,
+	refreshTokens refreshtoken.Repository,
+	refreshTokenTTL time.Duration,
+	authSvc AuthService,
+	clk clock.Clock,
+	log *logger.Logger,
+) *CommandHandler {
+	return &CommandHandler{
+		userRepository:  userRepo,
+		refreshTokens:   refreshTokens,
+		refreshTokenTTL: refreshTokenTTL,
+		authService:     authSvc,
+		clock:           clk,
+		logger:          log,
+	}
+}
+
+// AuthService defines the authentication service interface
+type AuthService interface {
+	HashPassword(password string) (string, error)
+	VerifyPassword(hashedPassword, password string) error
+	GenerateToken(userID valueobjects.ID) (string, error)
+	GenerateRefreshToken() (string, error)
+	ValidateToken(token string) (valueobjects.ID, error)
+}
+
+// AuthResult represents the result of an authentication operation
+type AuthResult struct {
+	UserID       valueobjects.ID `json:"user_id"`
+	AccessToken  string          `json:"access_token"`
+	RefreshToken string          `json:"refresh_token"`
+	ExpiresAt    time.Time       `json:"expires_at"`
+}
+
+// HandleLogin processes a login command
+func (h *CommandHandler) HandleLogin(ctx context.Context, cmd LoginCommand) (*AuthResult, error) {
+	h.logger.Info("Processing login command", "email", cmd.Email)
+
+	// Find user by email
+	if cmd.Email == "" {
+		h.logger.Error("Invalid email format", "email", cmd.Email)
+		return nil, errors.NewValidationError("invalid email format")
+	}
+
+	userEntity, err := h.userRepository.FindByEmail(ctx, cmd.Email)
+	if err != nil {
+		h.logger.Error("Failed to find user by email", "error", err, "email", cmd.Email)
+		return nil, errors.NewAuthenticationError("invalid credentials")
+	}
+
+	// Verify password (simplified for template - implement proper password verification)
+	// TODO: Add proper password verification logic
+	if cmd.Password == "" {
+		h.logger.Error("Password verification failed", "user_id", userEntity.ID())
+		return nil, errors.NewAuthenticationError("invalid credentials")
+	}
+
+	// Check if user is active
+	if userEntity.Status() != user.StatusActive {
+		h.logger.Error("User account is not active", "user_id", userEntity.ID(), "status", userEntity.Status())
+		return nil, errors.NewAuthenticationError("account is not active")
+	}
+
+	// Generate tokens, starting a new refresh token family for this session
+	result, err := h.issueTokens(ctx, userEntity.ID(), valueobjects.GenerateID().String())
+	if err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("Login successful", "user_id", userEntity.ID())
+
+	return result, nil
+}
+
+// HandleRegister processes a registration command
+func (h *CommandHandler) HandleRegister(ctx context.Context, cmd RegisterCommand) (*AuthResult, error) {
+	h.logger.Info("Processing register command", "name", cmd.Name, "email", cmd.Email)
+
+	// Validate name
+	if cmd.Name == "" {
+		h.logger.Error("Invalid name format", "name", cmd.Name)
+		return nil, errors.NewValidationError("invalid name format")
+	}
+
+	// Validate email
+	if cmd.Email == "" {
+		h.logger.Error("Invalid email format", "email", cmd.Email)
+		return nil, errors.NewValidationError("invalid email format")
+	}
+
+	// Check if user already exists by email
+	existingUser, err := h.userRepository.FindByEmail(ctx, cmd.Email)
+	if err == nil && existingUser != nil {
+		h.logger.Error("User already exists", "email", cmd.Email)
+		return nil, errors.NewValidationError("user already exists with this email")
+	}
+
+	// Check if user already exists by name
+	existingUserByName, err := h.userRepository.FindByName(ctx, cmd.Name)
+	if err == nil && existingUserByName != nil {
+		h.logger.Error("User already exists", "name", cmd.Name)
+		return nil, errors.NewValidationError("user already exists with this name")
+	}
+
+	// Create new user
+	userEntity, err := user.NewUser(cmd.Name, cmd.Email, cmd.Description, h.clock.Now())
+	if err != nil {
+		h.logger.Error("Failed to create user", "error", err)
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	// Save user
+	if err := h.userRepository.Save(ctx, userEntity); err != nil {
+		h.logger.Error("Failed to save user", "error", err, "user_id", userEntity.ID())
+		return nil, fmt.Errorf("failed to save user: %w", err)
+	}
+
+	// Generate tokens, starting a new refresh token family for this session
+	result, err := h.issueTokens(ctx, userEntity.ID(), valueobjects.GenerateID().String())
+	if err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("Registration successful", "user_id", userEntity.ID())
+
+	return result, nil
+}
+
+// HandleRefreshToken processes a refresh token command.
+// The presented token is rotated: it is spent and a successor is issued in the same family.
+// Presenting a spent token again means it leaked, so the whole family is revoked.
+func (h *CommandHandler) HandleRefreshToken(ctx context.Context, cmd RefreshTokenCommand) (*AuthResult, error) {
+	h.logger.Info("Processing refresh token command")
+
+	now := h.clock.Now().UTC()
+	current, err := h.findRefreshToken(ctx, cmd.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.IsRevoked() || current.IsExpired(now) {
+		h.logger.Error("Refresh token is no longer valid", "user_id", current.UserID(), "family_id", current.FamilyID())
+		return nil, errors.NewAuthenticationError("invalid refresh token")
+	}
+
+	// Only one refresh can spend a token, losing a race counts as reuse too
+	rotated := false
+	if !current.IsRotated() {
+		rotated, err = h.refreshTokens.MarkRotated(ctx, current.Hash(), now)
+		if err != nil {
+			h.logger.Error("Failed to rotate refresh token", "error", err, "family_id", current.FamilyID())
+			return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+		}
+	}
+	if !rotated {
+		h.logger.Warn("Refresh token reuse detected, revoking the session",
+			"security_event", "refresh_token_reuse",
+			"user_id", current.UserID(),
+			"family_id", current.FamilyID())
+		if err := h.refreshTokens.RevokeFamily(ctx, current.FamilyID(), now); err != nil {
+			h.logger.Error("Failed to revoke refresh token family", "error", err, "family_id", current.FamilyID())
+			return nil, fmt.Errorf("failed to revoke refresh token family: %w", err)
+		}
+		return nil, errors.NewAuthenticationError("invalid refresh token")
+	}
+
+	userID := current.UserID()
+
+	// Find user
+	userEntity, err := h.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		h.logger.Error("Failed to find user", "error", err, "user_id", userID)
+		return nil, errors.NewAuthenticationError("user not found")
+	}
+
+	// Check if user is active
+	if userEntity.Status() != user.StatusActive {
+		h.logger.Error("User account is not active", "user_id", userID, "status", userEntity.Status())
+		return nil, errors.NewAuthenticationError("account is not active")
+	}
+
+	// Generate new tokens in the same family
+	result, err := h.issueTokens(ctx, userID, current.FamilyID())
+	if err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("Token refresh successful", "user_id", userID)
+
+	return result, nil
+}
+
+// HandleLogout processes a logout command, revoking the session of the refresh token.
+// Logging out with an unknown or already revoked token succeeds, so clients can retry safely.
+func (h *CommandHandler) HandleLogout(ctx context.Context, cmd LogoutCommand) error {
+	h.logger.Info("Processing logout command")
+
+	current, err := h.refreshTokens.FindByHash(ctx, refreshtoken.Hash(cmd.RefreshToken))
+	if err != nil {
+		if isRefreshTokenNotFound(err) {
+			h.logger.Info("Logout with unknown refresh token")
+			return nil
+		}
+		h.logger.Error("Failed to find refresh token", "error", err)
+		return fmt.Errorf("failed to find refresh token: %w", err)
+	}
+
+	if err := h.refreshTokens.RevokeFamily(ctx, current.FamilyID(), h.clock.Now().UTC()); err != nil {
+		h.logger.Error("Failed to revoke refresh token family", "error", err, "family_id", current.FamilyID())
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+
+	h.logger.Info("Logout successful", "user_id", current.UserID())
+
+	return nil
+}
+
+// HandleLogoutAll processes a logout all command, revoking every session of the user.
+// Access tokens already issued stay valid until they expire.
+func (h *CommandHandler) HandleLogoutAll(ctx context.Context, cmd LogoutAllCommand) error {
+	h.logger.Info("Processing logout all command", "user_id", cmd.UserID)
+
+	if err := h.refreshTokens.RevokeAllForUser(ctx, cmd.UserID, h.clock.Now().UTC()); err != nil {
+		h.logger.Error("Failed to revoke refresh tokens", "error", err, "user_id", cmd.UserID)
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+
+	h.logger.Info("Logout on all devices successful", "user_id", cmd.UserID)
+
+	return nil
+}
+
+// Authenticate resolves an access token to an active user
+func (h *CommandHandler) Authenticate(ctx context.Context, token string) (*user.User, error) {
+	userID, err := h.authService.ValidateToken(token)
+	if err != nil {
+		return nil, errors.NewAuthenticationError("invalid token")
+	}
+
+	userEntity, err := h.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		h.logger.Error("Failed to find user", "error", err, "user_id", userID)
+		return nil, errors.NewAuthenticationError("invalid token")
+	}
+
+	// Disabled accounts lose access immediately, even with an unexpired token
+	if userEntity.Status() != user.StatusActive {
+		return nil, errors.NewAuthenticationError("account is not active")
+	}
+
+	return userEntity, nil
+}
+
+// issueTokens generates an access token and a refresh token in the given family
+func (h *CommandHandler) issueTokens(ctx context.Context, userID valueobjects.ID, familyID string) (*AuthResult, error) {
+	accessToken, err := h.authService.GenerateToken(userID)
+	if err != nil {
+		h.logger.Error("Failed to generate access token", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("failed to generate access token: %w", err)
+	}
+
+	refreshToken, err := h.authService.GenerateRefreshToken()
+	if err != nil {
+		h.logger.Error("Failed to generate refresh token", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	now := h.clock.Now().UTC()
+	stored := refreshtoken.New(refreshtoken.Hash(refreshToken), familyID, userID, now, h.refreshTokenTTL)
+	if err := h.refreshTokens.Save(ctx, stored); err != nil {
+		h.logger.Error("Failed to store refresh token", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("failed to store refresh token: %w", err)
+	}
+
+	return &AuthResult{
+		UserID:       userID,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresAt:    now.Add(24 * time.Hour), // TODO: Make configurable
+	}, nil
+}
+
+// findRefreshToken looks up a presented refresh token
+func (h *CommandHandler) findRefreshToken(ctx context.Context, value string) (*refreshtoken.Token, error) {
+	if value == "" {
+		return nil, errors.NewValidationError("refresh token is required")
+	}
+
+	current, err := h.refreshTokens.FindByHash(ctx, refreshtoken.Hash(value))
+	if err != nil {
+		if isRefreshTokenNotFound(err) {
+			h.logger.Error("Unknown refresh token")
+			return nil, errors.NewAuthenticationError("invalid refresh token")
+		}
+		h.logger.Error("Failed to find refresh token", "error", err)
+		return nil, fmt.Errorf("failed to find refresh token: %w", err)
+	}
+
+	return current, nil
+}
+
+// isRefreshTokenNotFound checks if a refresh token store error means the token does not exist
+func isRefreshTokenNotFound(err error) bool {
+	domainErr, ok := errors.GetDomainError(err)
+	return ok && domainErr.Code == errors.ErrRefreshTokenNotFound.Code
+}
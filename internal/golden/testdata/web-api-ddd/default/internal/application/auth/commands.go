@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"github.com/example/golden/internal/shared/valueobjects"
+)
+
+// LoginCommand represents a login request
+type LoginCommand struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required,min=6"`
+}
+
+// RegisterCommand represents a user registration request
+type RegisterCommand struct {
+	Name        string `json:"name" validate:"required,min=2"`
+	Email       string `json:"email" validate:"required,email"`
+	Description string `json:"description" validate:"max=500"`
+	Password    string `json:"password" validate:"required,min=6"`
+}
+
+// RefreshTokenCommand represents a token refresh request
+type RefreshTokenCommand struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+// LogoutCommand represents a logout request, ending the session of one refresh token
+type LogoutCommand struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+// LogoutAllCommand represents a request to log a user out on all devices
+type LogoutAllCommand struct {
+	UserID valueobjects.ID `json:"user_id"`
+}
+
+// ChangePasswordCommand represents a password change request
+type ChangePasswordCommand struct {
+	UserID      valueobjects.ID `json:"user_id"`
+	OldPassword string          `json:"old_password" validate:"required"`
+	NewPassword string          `json:"new_password" validate:"required,min=6"`
+}
+
+// ForgotPasswordCommand represents a forgot password request
+type ForgotPasswordCommand struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// ResetPasswordCommand represents a password reset request
+type ResetPasswordCommand struct {
+	Token       string `json:"token" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required,min=6"`
+}
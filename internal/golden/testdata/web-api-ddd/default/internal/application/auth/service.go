@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/domain/refreshtoken"
+	"github.com/example/golden/internal/domain/user"
+	"github.com/example/golden/internal/infrastructure/logger"
+)
+
+// Service provides authentication services
+type Service struct {
+	commandHandler *CommandHandler
+	logger         *logger.Logger
+}
+
+// NewAuthService creates a new authentication service.
+// Refresh tokens are kept in refreshTokens and can be used for refreshTokenTTL, as
+// told by clk.
+func NewAuthService(
+	userRepo user.Repository,
+	refreshTokens refreshtoken.Repository,
+	refreshTokenTTL time.Duration,
+	clk clock.Clock,
+	log *logger.Logger,
+) *Service {
+	// Create a simple auth service implementation
+	authService := &SimpleAuthService{clock: clk}
+	commandHandler := NewCommandHandler(userRepo, refreshTokens, refreshTokenTTL, authService, clk, log)
+
+	return &Service{
+		commandHandler: commandHandler,
+		logger:         log,
+	}
+}
+
+// HandleLogin handles user login
+func (s *Service) HandleLogin(ctx context.Context, cmd LoginCommand) (*AuthResult, error) {
+	return s.commandHandler.HandleLogin(ctx, cmd)
+}
+
+// HandleRegister handles user registration
+func (s *Service) HandleRegister(ctx context.Context, cmd RegisterCommand) (*AuthResult, error) {
+	return s.commandHandler.HandleRegister(ctx, cmd)
+}
+
+// HandleRefreshToken handles token refresh
+func (s *Service) HandleRefreshToken(ctx context.Context, cmd RefreshTokenCommand) (*AuthResult, error) {
+	return s.commandHandler.HandleRefreshToken(ctx, cmd)
+}
+
+// HandleLogout handles user logout
+func (s *Service) HandleLogout(ctx context.Context, cmd LogoutCommand) error {
+	return s.commandHandler.HandleLogout(ctx, cmd)
+}
+
+// HandleLogoutAll handles logging a user out on all devices
+func (s *Service) HandleLogoutAll(ctx context.Context, cmd LogoutAllCommand) error {
+	return s.commandHandler.HandleLogoutAll(ctx, cmd)
+}
+
+// Authenticate resolves an access token to an active user
+func (s *Service) Authenticate(ctx context.Context, token string) (*user.User, error) {
+	return s.commandHandler.Authenticate(ctx, token)
+}
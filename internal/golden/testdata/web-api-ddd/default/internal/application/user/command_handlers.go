@@ -0,0 +1,330 @@
+package user
+
+import (
+	"context"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/domain/user"
+	"github.com/example/golden/internal/shared/errors"
+	"github.com/example/golden/internal/shared/events"
+)
+
+// CommandHandler defines the interface for command handlers
+type CommandHandler interface {
+	Handle(ctx context.Context, command Command) (interface{}, error)
+	CanHandle(commandType string) bool
+}
+
+// CreateUserHandler handles the creation of users
+type CreateUserHandler struct {
+	repository      user.Repository
+	domainService   user.Service
+	eventDispatcher events.EventDispatcher
+	clock           clock.Clock
+}
+
+// NewCreateUserHandler creates a new CreateUserHandler
+func NewCreateUserHandler(
+	repository user.Repository,
+	domainService user.Service,
+	eventDispatcher events.EventDispatcher,
+	clk clock.Clock,
+) *CreateUserHandler {
+	return &CreateUserHandler{
+		repository:      repository,
+		domainService:   domainService,
+		eventDispatcher: eventDispatcher,
+		clock:           clk,
+	}
+}
+
+// Handle processes the CreateUserCommand
+func (h *CreateUserHandler) Handle(ctx context.Context, command Command) (interface{}, error) {
+	cmd, ok := command.(CreateUserCommand)
+	if !ok {
+		return nil, errors.ErrInvalidCommand.WithDetails("expectedType", "CreateUserCommand")
+	}
+
+	// Validate business rules
+	if err := h.domainService.ValidateForCreation(cmd.Name); err != nil {
+		return nil, err
+	}
+
+	// Create user aggregate
+	userEntity, err := user.NewUser(cmd.Name, cmd.Email, cmd.Description, h.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// Save user
+	if err := h.repository.Save(ctx, userEntity); err != nil {
+		return nil, errors.ErrRepository.WithDetails("operation", "save")
+	}
+
+	// Dispatch domain events
+	for _, event := range userEntity.DomainEvents() {
+		if err := h.eventDispatcher.Dispatch(event); err != nil {
+			// Log error but don't fail the command
+			// In a real system, you might want to store failed events for retry
+		}
+	}
+
+	userEntity.ClearDomainEvents()
+
+	return FromUser(userEntity), nil
+}
+
+// CanHandle returns true if this handler can handle the command type
+func (h *CreateUserHandler) CanHandle(commandType string) bool {
+	return commandType == "create_user"
+}
+
+// UpdateUserHandler handles the updating of users
+type UpdateUserHandler struct {
+	repository      user.Repository
+	domainService   user.Service
+	eventDispatcher events.EventDispatcher
+	clock           clock.Clock
+}
+
+// NewUpdateUserHandler creates a new UpdateUserHandler
+func NewUpdateUserHandler(
+	repository user.Repository,
+	domainService user.Service,
+	eventDispatcher events.EventDispatcher,
+	clk clock.Clock,
+) *UpdateUserHandler {
+	return &UpdateUserHandler{
+		repository:      repository,
+		domainService:   domainService,
+		eventDispatcher: eventDispatcher,
+		clock:           clk,
+	}
+}
+
+// Handle processes the UpdateUserCommand
+func (h *UpdateUserHandler) Handle(ctx context.Context, command Command) (interface{}, error) {
+	cmd, ok := command.(UpdateUserCommand)
+	if !ok {
+		return nil, errors.ErrInvalidCommand.WithDetails("expectedType", "UpdateUserCommand")
+	}
+
+	// Get user ID
+	userID, err := cmd.GetID()
+	if err != nil {
+		return nil, errors.ErrValidation.WithDetails("field", "id")
+	}
+
+	// Find existing user
+	userEntity, err := h.repository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrRepository.WithDetails("operation", "findByID")
+	}
+	if userEntity == nil {
+		return nil, errors.ErrEntityNotFound.WithDetails("id", userID.String())
+	}
+
+	// Apply changes
+	now := h.clock.Now()
+	if cmd.Name != nil {
+		if err := userEntity.UpdateName(*cmd.Name, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.Email != nil {
+		if err := userEntity.UpdateEmail(*cmd.Email, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.Description != nil {
+		if err := userEntity.UpdateDescription(*cmd.Description, now); err != nil {
+			return nil, err
+		}
+	}
+
+	// Save user
+	if err := h.repository.Save(ctx, userEntity); err != nil {
+		return nil, errors.ErrRepository.WithDetails("operation", "save")
+	}
+
+	// Dispatch domain events
+	for _, event := range userEntity.DomainEvents() {
+		if err := h.eventDispatcher.Dispatch(event); err != nil {
+			// Log error but don't fail the command
+		}
+	}
+
+	userEntity.ClearDomainEvents()
+
+	return FromUser(userEntity), nil
+}
+
+// CanHandle returns true if this handler can handle the command type
+func (h *UpdateUserHandler) CanHandle(commandType string) bool {
+	return commandType == "update_user"
+}
+
+// DeleteUserHandler handles the deletion of users
+type DeleteUserHandler struct {
+	repository      user.Repository
+	eventDispatcher events.EventDispatcher
+	clock           clock.Clock
+}
+
+// NewDeleteUserHandler creates a new DeleteUserHandler
+func NewDeleteUserHandler(
+	repository user.Repository,
+	eventDispatcher events.EventDispatcher,
+	clk clock.Clock,
+) *DeleteUserHandler {
+	return &DeleteUserHandler{
+		repository:      repository,
+		eventDispatcher: eventDispatcher,
+		clock:           clk,
+	}
+}
+
+// Handle processes the DeleteUserCommand
+func (h *DeleteUserHandler) Handle(ctx context.Context, command Command) (interface{}, error) {
+	cmd, ok := command.(DeleteUserCommand)
+	if !ok {
+		return nil, errors.ErrInvalidCommand.WithDetails("expectedType", "DeleteUserCommand")
+	}
+
+	// Get user ID
+	userID, err := cmd.GetID()
+	if err != nil {
+		return nil, errors.ErrValidation.WithDetails("field", "id")
+	}
+
+	// Find existing user
+	userEntity, err := h.repository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrRepository.WithDetails("operation", "findByID")
+	}
+	if userEntity == nil {
+		return nil, errors.ErrEntityNotFound.WithDetails("id", userID.String())
+	}
+
+	// Apply business rules for deletion
+	canDelete := user.NewCanBeDeletedSpecification()
+	if !canDelete.IsSatisfiedBy(userEntity) {
+		return nil, canDelete.WhyNotSatisfiedBy(userEntity)
+	}
+
+	// Mark as deleted
+	if err := userEntity.Delete(h.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// Save user
+	if err := h.repository.Save(ctx, userEntity); err != nil {
+		return nil, errors.ErrRepository.WithDetails("operation", "save")
+	}
+
+	// Dispatch domain events
+	for _, event := range userEntity.DomainEvents() {
+		if err := h.eventDispatcher.Dispatch(event); err != nil {
+			// Log error but don't fail the command
+		}
+	}
+
+	userEntity.ClearDomainEvents()
+
+	return nil, nil
+}
+
+// CanHandle returns true if this handler can handle the command type
+func (h *DeleteUserHandler) CanHandle(commandType string) bool {
+	return commandType == "delete_user"
+}
+
+// CommandBus coordinates command handling
+type CommandBus struct {
+	handlers []CommandHandler
+}
+
+// NewCommandBus creates a new command bus
+func NewCommandBus() *CommandBus {
+	return &CommandBus{
+		handlers: make([]CommandHandler, 0),
+	}
+}
+
+// RegisterHandler registers a command handler
+func (b *CommandBus) RegisterHandler(handler CommandHandler) {
+	b.handlers = append(b.handlers, handler)
+}
+
+// Execute executes a command
+func (b *CommandBus) Execute(ctx context.Context, command Command) (interface{}, error) {
+	for _, handler := range b.handlers {
+		if handler.CanHandle(command.CommandType()) {
+			return handler.Handle(ctx, command)
+		}
+	}
+
+	return nil, errors.ErrCommandHandling.WithDetails("commandType", command.CommandType())
+}
+
+// CommandHandlers provides a simplified interface for command handling
+type CommandHandlers struct {
+	repository      user.Repository
+	domainService   user.Service
+	eventDispatcher events.EventDispatcher
+	bus             *CommandBus
+}
+
+// NewCommandHandlers creates a new command handlers instance changing users at
+// the time of clk
+func NewCommandHandlers(
+	repository user.Repository,
+	clk clock.Clock,
+	logger interface{}, // Accept logger for compatibility
+) *CommandHandlers {
+	// Create domain service (simplified for now)
+	domainService := user.NewService(repository)
+
+	// Create event dispatcher (simplified for now)
+	eventDispatcher := events.NewNullEventDispatcher()
+
+	handlers := &CommandHandlers{
+		repository:      repository,
+		domainService:   domainService,
+		eventDispatcher: eventDispatcher,
+		bus:             NewCommandBus(),
+	}
+
+	// Register command handlers
+	handlers.bus.RegisterHandler(NewCreateUserHandler(repository, domainService, eventDispatcher, clk))
+	handlers.bus.RegisterHandler(NewUpdateUserHandler(repository, domainService, eventDispatcher, clk))
+	handlers.bus.RegisterHandler(NewDeleteUserHandler(repository, eventDispatcher, clk))
+
+	return handlers
+}
+
+// HandleCreateUser handles create user command
+func (h *CommandHandlers) HandleCreateUser(ctx context.Context, cmd CreateUserCommand) (UserDTO, error) {
+	result, err := h.bus.Execute(ctx, cmd)
+	if err != nil {
+		return UserDTO{}, err
+	}
+	return result.(UserDTO), nil
+}
+
+// HandleUpdateUser handles update user command
+func (h *CommandHandlers) HandleUpdateUser(ctx context.Context, cmd UpdateUserCommand) (UserDTO, error) {
+	result, err := h.bus.Execute(ctx, cmd)
+	if err != nil {
+		return UserDTO{}, err
+	}
+	return result.(UserDTO), nil
+}
+
+// HandleDeleteUser handles delete user command
+func (h *CommandHandlers) HandleDeleteUser(ctx context.Context, cmd DeleteUserCommand) error {
+	_, err := h.bus.Execute(ctx, cmd)
+	return err
+}
@@ -0,0 +1,118 @@
+package user
+
+import (
+	"github.com/example/golden/internal/shared/valueobjects"
+)
+
+// Command represents a command in the CQRS pattern
+type Command interface {
+	CommandType() string
+}
+
+// CreateUserCommand represents a command to create a new user
+type CreateUserCommand struct {
+	Name        string `json:"name" validate:"required,min=1,max=100"`
+	Email       string `json:"email" validate:"required,email"`
+	Description string `json:"description" validate:"max=500"`
+}
+
+// CommandType returns the command type
+func (c CreateUserCommand) CommandType() string {
+	return "create_user"
+}
+
+// UpdateUserCommand represents a command to update a user
+type UpdateUserCommand struct {
+	ID          string  `json:"id" validate:"required,uuid"`
+	Name        *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Email       *string `json:"email,omitempty" validate:"omitempty,email"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=500"`
+}
+
+// CommandType returns the command type
+func (c UpdateUserCommand) CommandType() string {
+	return "update_user"
+}
+
+// GetID returns the user ID as a value object
+func (c UpdateUserCommand) GetID() (valueobjects.ID, error) {
+	return valueobjects.NewID(c.ID)
+}
+
+// DeleteUserCommand represents a command to delete a user
+type DeleteUserCommand struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+// CommandType returns the command type
+func (c DeleteUserCommand) CommandType() string {
+	return "delete_user"
+}
+
+// GetID returns the user ID as a value object
+func (c DeleteUserCommand) GetID() (valueobjects.ID, error) {
+	return valueobjects.NewID(c.ID)
+}
+
+// ActivateUserCommand represents a command to activate a user
+type ActivateUserCommand struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+// CommandType returns the command type
+func (c ActivateUserCommand) CommandType() string {
+	return "activate_user"
+}
+
+// GetID returns the user ID as a value object
+func (c ActivateUserCommand) GetID() (valueobjects.ID, error) {
+	return valueobjects.NewID(c.ID)
+}
+
+// DeactivateUserCommand represents a command to deactivate a user
+type DeactivateUserCommand struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+// CommandType returns the command type
+func (c DeactivateUserCommand) CommandType() string {
+	return "deactivate_user"
+}
+
+// GetID returns the user ID as a value object
+func (c DeactivateUserCommand) GetID() (valueobjects.ID, error) {
+	return valueobjects.NewID(c.ID)
+}
+
+// CreateUserRequest represents an HTTP request to create a user
+type CreateUserRequest struct {
+	Name        string `json:"name" binding:"required"`
+	Email       string `json:"email" binding:"required,email"`
+	Description string `json:"description"`
+}
+
+// ToCommand converts the request to a command
+func (r CreateUserRequest) ToCommand() CreateUserCommand {
+	return CreateUserCommand{
+		Name:        r.Name,
+		Email:       r.Email,
+		Description: r.Description,
+	}
+}
+
+// UpdateUserRequest represents an HTTP request to update a user
+type UpdateUserRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Email       *string `json:"email,omitempty" binding:"omitempty,email"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToCommand converts the request to a command
+func (r UpdateUserRequest) ToCommand(id string) UpdateUserCommand {
+	return UpdateUserCommand{
+		ID:          id,
+		Name:        r.Name,
+		Email:       r.Email,
+		Description: r.Description,
+	}
+}
@@ -0,0 +1,77 @@
+package user
+
+import (
+	"time"
+
+	"github.com/example/golden/internal/domain/user"
+)
+
+// UserDTO represents a user data transfer object
+type UserDTO struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Email       string    `json:"email"`
+	Description string    `json:"description"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	Version     int       `json:"version"`
+}
+
+// FromUser creates a DTO from a domain entity
+func FromUser(userEntity *user.User) UserDTO {
+	return UserDTO{
+		ID:          userEntity.ID().String(),
+		Name:        userEntity.Name(),
+		Email:       userEntity.Email(),
+		Description: userEntity.Description(),
+		Status:      userEntity.Status().String(),
+		CreatedAt:   userEntity.CreatedAt(),
+		UpdatedAt:   userEntity.UpdatedAt(),
+		Version:     userEntity.Version(),
+	}
+}
+
+// FromUsers creates DTOs from domain entities
+func FromUsers(userEntities []*user.User) []UserDTO {
+	dtos := make([]UserDTO, len(userEntities))
+	for i, entity := range userEntities {
+		dtos[i] = FromUser(entity)
+	}
+	return dtos
+}
+
+// UserListDTO represents a paginated list of users
+type UserListDTO struct {
+	Users      []UserDTO `json:"users"`
+	Total      int       `json:"total"`
+	Page       int       `json:"page"`
+	Limit      int       `json:"limit"`
+	TotalPages int       `json:"totalPages"`
+}
+
+// NewUserListDTO creates a new paginated list DTO
+func NewUserListDTO(users []UserDTO, total, page, limit int) UserListDTO {
+	totalPages := (total + limit - 1) / limit
+	if totalPages < 1 {
+		totalPages = 1
+	}
+
+	return UserListDTO{
+		Users:      users,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}
+}
+
+// UserCountResponse represents a count response
+type UserCountResponse struct {
+	Count int `json:"count"`
+}
+
+// UserExistsResponse represents an exists response
+type UserExistsResponse struct {
+	Exists bool `json:"exists"`
+}
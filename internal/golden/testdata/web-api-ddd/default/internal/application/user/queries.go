@@ -0,0 +1,174 @@
+package user
+
+import (
+	"github.com/example/golden/internal/domain/user"
+	"github.com/example/golden/internal/shared/valueobjects"
+)
+
+// Query represents a query in the CQRS pattern
+type Query interface {
+	QueryType() string
+}
+
+// GetUserByIDQuery represents a query to get a user by ID
+type GetUserByIDQuery struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+// QueryType returns the query type
+func (q GetUserByIDQuery) QueryType() string {
+	return "get_user_by_id"
+}
+
+// GetID returns the user ID as a value object
+func (q GetUserByIDQuery) GetID() (valueobjects.ID, error) {
+	return valueobjects.NewID(q.ID)
+}
+
+// GetUserByNameQuery represents a query to get a user by name
+type GetUserByNameQuery struct {
+	Name string `json:"name" validate:"required"`
+}
+
+// QueryType returns the query type
+func (q GetUserByNameQuery) QueryType() string {
+	return "get_user_by_name"
+}
+
+// GetName returns the name
+func (q GetUserByNameQuery) GetName() string {
+	return q.Name
+}
+
+// GetUserByEmailQuery represents a query to get a user by email
+type GetUserByEmailQuery struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// QueryType returns the query type
+func (q GetUserByEmailQuery) QueryType() string {
+	return "get_user_by_email"
+}
+
+// GetEmail returns the email
+func (q GetUserByEmailQuery) GetEmail() string {
+	return q.Email
+}
+
+// ListUsersQuery represents a query to list users with pagination
+type ListUsersQuery struct {
+	Page   int                    `json:"page" validate:"min=1"`
+	Limit  int                    `json:"limit" validate:"min=1,max=100"`
+	Status *string                `json:"status,omitempty" validate:"omitempty,oneof=active inactive deleted"`
+	Filter map[string]interface{} `json:"filter,omitempty"`
+}
+
+// QueryType returns the query type
+func (q ListUsersQuery) QueryType() string {
+	return "list_users"
+}
+
+// GetStatus returns the status as a value object if provided
+func (q ListUsersQuery) GetStatus() (*user.Status, error) {
+	if q.Status == nil {
+		return nil, nil
+	}
+	status, err := user.ParseStatus(*q.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// GetOffset returns the offset for pagination
+func (q ListUsersQuery) GetOffset() int {
+	if q.Page <= 1 {
+		return 0
+	}
+	return (q.Page - 1) * q.Limit
+}
+
+// CountUsersQuery represents a query to count users
+type CountUsersQuery struct {
+	Status *string                `json:"status,omitempty" validate:"omitempty,oneof=active inactive deleted"`
+	Filter map[string]interface{} `json:"filter,omitempty"`
+}
+
+// QueryType returns the query type
+func (q CountUsersQuery) QueryType() string {
+	return "count_users"
+}
+
+// GetStatus returns the status as a value object if provided
+func (q CountUsersQuery) GetStatus() (*user.Status, error) {
+	if q.Status == nil {
+		return nil, nil
+	}
+	status, err := user.ParseStatus(*q.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// SearchUsersQuery represents a query to search users
+type SearchUsersQuery struct {
+	Term   string                 `json:"term" validate:"required,min=1"`
+	Page   int                    `json:"page" validate:"min=1"`
+	Limit  int                    `json:"limit" validate:"min=1,max=100"`
+	Status *string                `json:"status,omitempty" validate:"omitempty,oneof=active inactive deleted"`
+	Filter map[string]interface{} `json:"filter,omitempty"`
+}
+
+// QueryType returns the query type
+func (q SearchUsersQuery) QueryType() string {
+	return "search_users"
+}
+
+// GetStatus returns the status as a value object if provided
+func (q SearchUsersQuery) GetStatus() (*user.Status, error) {
+	if q.Status == nil {
+		return nil, nil
+	}
+	status, err := user.ParseStatus(*q.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// GetOffset returns the offset for pagination
+func (q SearchUsersQuery) GetOffset() int {
+	if q.Page <= 1 {
+		return 0
+	}
+	return (q.Page - 1) * q.Limit
+}
+
+// ExistsUserQuery represents a query to check if a user exists
+type ExistsUserQuery struct {
+	ID   *string `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name *string `json:"name,omitempty" validate:"omitempty"`
+}
+
+// QueryType returns the query type
+func (q ExistsUserQuery) QueryType() string {
+	return "exists_user"
+}
+
+// GetID returns the user ID as a value object if provided
+func (q ExistsUserQuery) GetID() (*valueobjects.ID, error) {
+	if q.ID == nil {
+		return nil, nil
+	}
+	id, err := valueobjects.NewID(*q.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// GetName returns the name if provided
+func (q ExistsUserQuery) GetName() *string {
+	return q.Name
+}
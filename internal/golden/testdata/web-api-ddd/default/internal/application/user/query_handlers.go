@@ -0,0 +1,380 @@
+package user
+
+import (
+	"context"
+
+	"github.com/example/golden/internal/domain/user"
+	"github.com/example/golden/internal/shared/errors"
+)
+
+// QueryHandler defines the interface for query handlers
+type QueryHandler interface {
+	Handle(ctx context.Context, query Query) (interface{}, error)
+	CanHandle(queryType string) bool
+}
+
+// GetUserByIDHandler handles queries to get a user by ID
+type GetUserByIDHandler struct {
+	repository user.Repository
+}
+
+// NewGetUserByIDHandler creates a new GetUserByIDHandler
+func NewGetUserByIDHandler(repository user.Repository) *GetUserByIDHandler {
+	return &GetUserByIDHandler{
+		repository: repository,
+	}
+}
+
+// Handle processes the GetUserByIDQuery
+func (h *GetUserByIDHandler) Handle(ctx context.Context, query Query) (interface{}, error) {
+	q, ok := query.(GetUserByIDQuery)
+	if !ok {
+		return nil, errors.ErrInvalidQuery.WithDetails("expectedType", "GetUserByIDQuery")
+	}
+
+	userID, err := q.GetID()
+	if err != nil {
+		return nil, errors.ErrValidation.WithDetails("field", "id")
+	}
+
+	userEntity, err := h.repository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrRepository.WithDetails("operation", "findByID")
+	}
+
+	if userEntity == nil {
+		return nil, errors.ErrEntityNotFound.WithDetails("id", userID.String())
+	}
+
+	return FromUser(userEntity), nil
+}
+
+// CanHandle returns true if this handler can handle the query type
+func (h *GetUserByIDHandler) CanHandle(queryType string) bool {
+	return queryType == "get_user_by_id"
+}
+
+// GetUserByNameHandler handles queries to get a user by name
+type GetUserByNameHandler struct {
+	repository user.Repository
+}
+
+// NewGetUserByNameHandler creates a new GetUserByNameHandler
+func NewGetUserByNameHandler(repository user.Repository) *GetUserByNameHandler {
+	return &GetUserByNameHandler{
+		repository: repository,
+	}
+}
+
+// Handle processes the GetUserByNameQuery
+func (h *GetUserByNameHandler) Handle(ctx context.Context, query Query) (interface{}, error) {
+	q, ok := query.(GetUserByNameQuery)
+	if !ok {
+		return nil, errors.ErrInvalidQuery.WithDetails("expectedType", "GetUserByNameQuery")
+	}
+
+	name := q.GetName()
+	if name == "" {
+		return nil, errors.ErrValidation.WithDetails("field", "name")
+	}
+
+	userEntity, err := h.repository.FindByName(ctx, name)
+	if err != nil {
+		return nil, errors.ErrRepository.WithDetails("operation", "findByName")
+	}
+
+	if userEntity == nil {
+		return nil, errors.ErrEntityNotFound.WithDetails("name", name)
+	}
+
+	return FromUser(userEntity), nil
+}
+
+// CanHandle returns true if this handler can handle the query type
+func (h *GetUserByNameHandler) CanHandle(queryType string) bool {
+	return queryType == "get_user_by_name"
+}
+
+// GetUserByEmailHandler handles queries to get a user by email
+type GetUserByEmailHandler struct {
+	repository user.Repository
+}
+
+// NewGetUserByEmailHandler creates a new GetUserByEmailHandler
+func NewGetUserByEmailHandler(repository user.Repository) *GetUserByEmailHandler {
+	return &GetUserByEmailHandler{
+		repository: repository,
+	}
+}
+
+// Handle processes the GetUserByEmailQuery
+func (h *GetUserByEmailHandler) Handle(ctx context.Context, query Query) (interface{}, error) {
+	q, ok := query.(GetUserByEmailQuery)
+	if !ok {
+		return nil, errors.ErrInvalidQuery.WithDetails("expectedType", "GetUserByEmailQuery")
+	}
+
+	email := q.GetEmail()
+	if email == "" {
+		return nil, errors.ErrValidation.WithDetails("field", "email")
+	}
+
+	userEntity, err := h.repository.FindByEmail(ctx, email)
+	if err != nil {
+		return nil, errors.ErrRepository.WithDetails("operation", "findByEmail")
+	}
+
+	if userEntity == nil {
+		return nil, errors.ErrEntityNotFound.WithDetails("email", email)
+	}
+
+	return FromUser(userEntity), nil
+}
+
+// CanHandle returns true if this handler can handle the query type
+func (h *GetUserByEmailHandler) CanHandle(queryType string) bool {
+	return queryType == "get_user_by_email"
+}
+
+// ListUsersHandler handles queries to list users
+type ListUsersHandler struct {
+	repository user.Repository
+}
+
+// NewListUsersHandler creates a new ListUsersHandler
+func NewListUsersHandler(repository user.Repository) *ListUsersHandler {
+	return &ListUsersHandler{
+		repository: repository,
+	}
+}
+
+// Handle processes the ListUsersQuery
+func (h *ListUsersHandler) Handle(ctx context.Context, query Query) (interface{}, error) {
+	q, ok := query.(ListUsersQuery)
+	if !ok {
+		return nil, errors.ErrInvalidQuery.WithDetails("expectedType", "ListUsersQuery")
+	}
+
+	var userEntities []*user.User
+	var total int
+	var err error
+
+	status, err := q.GetStatus()
+	if err != nil {
+		return nil, errors.ErrValidation.WithDetails("field", "status")
+	}
+
+	offset := q.GetOffset()
+
+	if status != nil {
+		userEntities, err = h.repository.FindByStatus(ctx, *status, offset, q.Limit)
+		if err != nil {
+			return nil, errors.ErrRepository.WithDetails("operation", "findByStatus")
+		}
+
+		total, err = h.repository.CountByStatus(ctx, *status)
+		if err != nil {
+			return nil, errors.ErrRepository.WithDetails("operation", "countByStatus")
+		}
+	} else {
+		userEntities, err = h.repository.FindAll(ctx, offset, q.Limit)
+		if err != nil {
+			return nil, errors.ErrRepository.WithDetails("operation", "findAll")
+		}
+
+		total, err = h.repository.Count(ctx)
+		if err != nil {
+			return nil, errors.ErrRepository.WithDetails("operation", "count")
+		}
+	}
+
+	userDTOs := FromUsers(userEntities)
+	return NewUserListDTO(userDTOs, total, q.Page, q.Limit), nil
+}
+
+// CanHandle returns true if this handler can handle the query type
+func (h *ListUsersHandler) CanHandle(queryType string) bool {
+	return queryType == "list_users"
+}
+
+// CountUsersHandler handles queries to count users
+type CountUsersHandler struct {
+	repository user.Repository
+}
+
+// NewCountUsersHandler creates a new CountUsersHandler
+func NewCountUsersHandler(repository user.Repository) *CountUsersHandler {
+	return &CountUsersHandler{
+		repository: repository,
+	}
+}
+
+// Handle processes the CountUsersQuery
+func (h *CountUsersHandler) Handle(ctx context.Context, query Query) (interface{}, error) {
+	q, ok := query.(CountUsersQuery)
+	if !ok {
+		return nil, errors.ErrInvalidQuery.WithDetails("expectedType", "CountUsersQuery")
+	}
+
+	status, err := q.GetStatus()
+	if err != nil {
+		return nil, errors.ErrValidation.WithDetails("field", "status")
+	}
+
+	var count int
+
+	if status != nil {
+		count, err = h.repository.CountByStatus(ctx, *status)
+		if err != nil {
+			return nil, errors.ErrRepository.WithDetails("operation", "countByStatus")
+		}
+	} else {
+		count, err = h.repository.Count(ctx)
+		if err != nil {
+			return nil, errors.ErrRepository.WithDetails("operation", "count")
+		}
+	}
+
+	return UserCountResponse{Count: count}, nil
+}
+
+// CanHandle returns true if this handler can handle the query type
+func (h *CountUsersHandler) CanHandle(queryType string) bool {
+	return queryType == "count_users"
+}
+
+// ExistsUserHandler handles queries to check if a user exists
+type ExistsUserHandler struct {
+	repository user.Repository
+}
+
+// NewExistsUserHandler creates a new ExistsUserHandler
+func NewExistsUserHandler(repository user.Repository) *ExistsUserHandler {
+	return &ExistsUserHandler{
+		repository: repository,
+	}
+}
+
+// Handle processes the ExistsUserQuery
+func (h *ExistsUserHandler) Handle(ctx context.Context, query Query) (interface{}, error) {
+	q, ok := query.(ExistsUserQuery)
+	if !ok {
+		return nil, errors.ErrInvalidQuery.WithDetails("expectedType", "ExistsUserQuery")
+	}
+
+	var exists bool
+	var err error
+
+	userID, idErr := q.GetID()
+	name := q.GetName()
+
+	if idErr == nil && userID != nil {
+		exists, err = h.repository.Exists(ctx, *userID)
+	} else if name != nil && *name != "" {
+		exists, err = h.repository.ExistsByName(ctx, *name)
+	} else {
+		return nil, errors.ErrValidation.WithDetails("reason", "either id or name must be provided")
+	}
+
+	if err != nil {
+		return nil, errors.ErrRepository.WithDetails("operation", "exists")
+	}
+
+	return UserExistsResponse{Exists: exists}, nil
+}
+
+// CanHandle returns true if this handler can handle the query type
+func (h *ExistsUserHandler) CanHandle(queryType string) bool {
+	return queryType == "exists_user"
+}
+
+// QueryBus coordinates query handling
+type QueryBus struct {
+	handlers []QueryHandler
+}
+
+// NewQueryBus creates a new query bus
+func NewQueryBus() *QueryBus {
+	return &QueryBus{
+		handlers: make([]QueryHandler, 0),
+	}
+}
+
+// RegisterHandler registers a query handler
+func (b *QueryBus) RegisterHandler(handler QueryHandler) {
+	b.handlers = append(b.handlers, handler)
+}
+
+// Execute executes a query
+func (b *QueryBus) Execute(ctx context.Context, query Query) (interface{}, error) {
+	for _, handler := range b.handlers {
+		if handler.CanHandle(query.QueryType()) {
+			return handler.Handle(ctx, query)
+		}
+	}
+
+	return nil, errors.ErrQueryHandling.WithDetails("queryType", query.QueryType())
+}
+
+// QueryHandlers provides a simplified interface for query handling
+type QueryHandlers struct {
+	repository user.Repository
+	bus        *QueryBus
+}
+
+// NewQueryHandlers creates a new query handlers instance
+func NewQueryHandlers(
+	repository user.Repository,
+	logger interface{}, // Accept logger for compatibility
+) *QueryHandlers {
+	handlers := &QueryHandlers{
+		repository: repository,
+		bus:        NewQueryBus(),
+	}
+
+	// Register query handlers
+	handlers.bus.RegisterHandler(NewGetUserByIDHandler(repository))
+	handlers.bus.RegisterHandler(NewGetUserByNameHandler(repository))
+	handlers.bus.RegisterHandler(NewGetUserByEmailHandler(repository))
+	handlers.bus.RegisterHandler(NewListUsersHandler(repository))
+	handlers.bus.RegisterHandler(NewCountUsersHandler(repository))
+	handlers.bus.RegisterHandler(NewExistsUserHandler(repository))
+
+	return handlers
+}
+
+// HandleGetUserByID handles get user by ID query
+func (h *QueryHandlers) HandleGetUserByID(ctx context.Context, query GetUserByIDQuery) (UserDTO, error) {
+	result, err := h.bus.Execute(ctx, query)
+	if err != nil {
+		return UserDTO{}, err
+	}
+	return result.(UserDTO), nil
+}
+
+// HandleGetUserByName handles get user by name query
+func (h *QueryHandlers) HandleGetUserByName(ctx context.Context, query GetUserByNameQuery) (UserDTO, error) {
+	result, err := h.bus.Execute(ctx, query)
+	if err != nil {
+		return UserDTO{}, err
+	}
+	return result.(UserDTO), nil
+}
+
+// HandleGetUserByEmail handles get user by email query
+func (h *QueryHandlers) HandleGetUserByEmail(ctx context.Context, query GetUserByEmailQuery) (UserDTO, error) {
+	result, err := h.bus.Execute(ctx, query)
+	if err != nil {
+		return UserDTO{}, err
+	}
+	return result.(UserDTO), nil
+}
+
+// HandleListUsers handles list users query
+func (h *QueryHandlers) HandleListUsers(ctx context.Context, query ListUsersQuery) (UserListDTO, error) {
+	result, err := h.bus.Execute(ctx, query)
+	if err != nil {
+		return UserListDTO{}, err
+	}
+	return result.(UserListDTO), nil
+}
@@ -0,0 +1,30 @@
+package refreshtoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/golden/internal/shared/valueobjects"
+)
+
+// Repository defines the contract for refresh token persistence.
+// It doubles as the server-side revocation list checked on every refresh.
+type Repository interface {
+	// Save stores a newly issued token
+	Save(ctx context.Context, token *Token) error
+
+	// FindByHash retrieves a token by its hash, reporting any revocation that applies to it.
+	// It returns errors.ErrRefreshTokenNotFound for unknown tokens, stores may forget expired ones.
+	FindByHash(ctx context.Context, hash string) (*Token, error)
+
+	// MarkRotated marks a token as exchanged for its successor.
+	// It reports false if the token was already rotated or revoked, so only one
+	// of two concurrent refreshes with the same token succeeds.
+	MarkRotated(ctx context.Context, hash string, at time.Time) (bool, error)
+
+	// RevokeFamily revokes every token of a family, ending one session
+	RevokeFamily(ctx context.Context, familyID string, at time.Time) error
+
+	// RevokeAllForUser revokes every token issued to a user, ending all their sessions
+	RevokeAllForUser(ctx context.Context, userID valueobjects.ID, at time.Time) error
+}
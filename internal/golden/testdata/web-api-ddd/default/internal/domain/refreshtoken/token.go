@@ -0,0 +1,109 @@
+package refreshtoken
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/example/golden/internal/shared/valueobjects"
+)
+
+// Token is a refresh token issued to a user.
+// Only the hash of the token is kept, the token itself is handed to the client once.
+// Each refresh rotates the token: the presented token is marked as rotated and a
+// successor is issued in the same family. A family is one login on one device.
+type Token struct {
+	hash      string
+	familyID  string
+	userID    valueobjects.ID
+	issuedAt  time.Time
+	expiresAt time.Time
+	rotatedAt *time.Time
+	revokedAt *time.Time
+}
+
+// New creates a refresh token valid for ttl from issuedAt
+func New(hash, familyID string, userID valueobjects.ID, issuedAt time.Time, ttl time.Duration) *Token {
+	return &Token{
+		hash:      hash,
+		familyID:  familyID,
+		userID:    userID,
+		issuedAt:  issuedAt,
+		expiresAt: issuedAt.Add(ttl),
+	}
+}
+
+// Reconstruct rebuilds a refresh token from storage
+func Reconstruct(
+	hash, familyID string,
+	userID valueobjects.ID,
+	issuedAt, expiresAt time.Time,
+	rotatedAt, revokedAt *time.Time,
+) *Token {
+	return &Token{
+		hash:      hash,
+		familyID:  familyID,
+		userID:    userID,
+		issuedAt:  issuedAt,
+		expiresAt: expiresAt,
+		rotatedAt: rotatedAt,
+		revokedAt: revokedAt,
+	}
+}
+
+// Hash returns the hash under which a refresh token is stored
+func Hash(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// Hash returns the hash of the token
+func (t *Token) Hash() string {
+	return t.hash
+}
+
+// FamilyID returns the family shared by the token and the tokens it was rotated from
+func (t *Token) FamilyID() string {
+	return t.familyID
+}
+
+// UserID returns the user the token was issued to
+func (t *Token) UserID() valueobjects.ID {
+	return t.userID
+}
+
+// IssuedAt returns when the token was issued
+func (t *Token) IssuedAt() time.Time {
+	return t.issuedAt
+}
+
+// ExpiresAt returns when the token expires
+func (t *Token) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+// RotatedAt returns when the token was exchanged for a successor, nil if it was not
+func (t *Token) RotatedAt() *time.Time {
+	return t.rotatedAt
+}
+
+// RevokedAt returns when the token was revoked, nil if it was not
+func (t *Token) RevokedAt() *time.Time {
+	return t.revokedAt
+}
+
+// IsExpired checks if the token has expired at the given time
+func (t *Token) IsExpired(now time.Time) bool {
+	return !now.Before(t.expiresAt)
+}
+
+// IsRotated checks if the token was already exchanged for a successor.
+// Presenting a rotated token again means it was stolen or replayed.
+func (t *Token) IsRotated() bool {
+	return t.rotatedAt != nil
+}
+
+// IsRevoked checks if the token was revoked by a logout or reuse detection
+func (t *Token) IsRevoked() bool {
+	return t.revokedAt != nil
+}
@@ -0,0 +1,540 @@
+package user
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/example/golden/internal/shared/errors"
+	"github.com/example/golden/internal/shared/events"
+	"github.com/example/golden/internal/shared/valueobjects"
+)
+
+// User represents the user aggregate root with rich domain behavior
+type User struct {
+	id           valueobjects.ID
+	name         UserName        // Rich value object with validation and behavior
+	email        EmailAddress    // Rich email value object
+	description  UserDescription // Rich description value object
+	status       Status          // Current status with business rules
+	profile      UserProfile     // Rich profile information
+	preferences  UserPreferences // User preferences and settings
+	createdAt    time.Time
+	updatedAt    time.Time
+	lastLoginAt  *time.Time // Track user engagement
+	version      int        // For optimistic locking
+	domainEvents []events.DomainEvent
+}
+
+// NewUser creates a new user aggregate with rich domain validation,
+// created at now
+func NewUser(nameStr string, emailStr string, descriptionStr string, now time.Time) (*User, error) {
+	// Create rich value objects with built-in validation
+	name, err := NewUserName(nameStr)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := NewEmailAddress(emailStr)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := NewUserDescription(descriptionStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Business rule: Email must be unique (checked by repository)
+	// Business rule: Professional emails get different default settings
+	defaultPreferences := NewDefaultUserPreferences()
+	if email.IsBusinessEmail() {
+		defaultPreferences = NewBusinessUserPreferences()
+	}
+
+	now = now.UTC()
+	user := &User{
+		id:           valueobjects.GenerateID(),
+		name:         name,
+		email:        email,
+		description:  description,
+		status:       StatusActive,
+		profile:      NewUserProfile(email.GetProviderType()),
+		preferences:  defaultPreferences,
+		createdAt:    now,
+		updatedAt:    now,
+		lastLoginAt:  nil, // Not logged in yet
+		version:      1,
+		domainEvents: make([]events.DomainEvent, 0),
+	}
+
+	// Raise domain event with rich information
+	event := NewUserCreatedEvent(
+		user.id,
+		user.name.Value(),
+		user.email.Value(),
+		user.email.GetProviderType(),
+		now,
+	)
+	user.addDomainEvent(event)
+
+	return user, nil
+}
+
+// ReconstructUser reconstructs a user from persistence
+func ReconstructUser(
+	id valueobjects.ID,
+	name string,
+	email string,
+	description string,
+	status Status,
+	createdAt, updatedAt time.Time,
+	version int,
+) *User {
+	// Create value objects with validation
+	userName, err := NewUserName(name)
+	if err != nil {
+		return nil // Consider returning error in production code
+	}
+
+	userEmail, err := NewEmailAddress(email)
+	if err != nil {
+		return nil // Consider returning error in production code
+	}
+
+	userDesc, err := NewUserDescription(description)
+	if err != nil {
+		return nil // Consider returning error in production code
+	}
+
+	return &User{
+		id:           id,
+		name:         userName,
+		email:        userEmail,
+		description:  userDesc,
+		status:       status,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+		version:      version,
+		domainEvents: make([]events.DomainEvent, 0),
+	}
+}
+
+// ID returns the user ID
+func (e *User) ID() valueobjects.ID {
+	return e.id
+}
+
+// Name returns the user name value object
+func (e *User) Name() UserName {
+	return e.name
+}
+
+// Email returns the user email value object
+func (e *User) Email() EmailAddress {
+	return e.email
+}
+
+// Description returns the user description value object
+func (e *User) Description() UserDescription {
+	return e.description
+}
+
+// Profile returns the user profile
+func (e *User) Profile() UserProfile {
+	return e.profile
+}
+
+// Preferences returns the user preferences
+func (e *User) Preferences() UserPreferences {
+	return e.preferences
+}
+
+// LastLoginAt returns when the user last logged in
+func (e *User) LastLoginAt() *time.Time {
+	return e.lastLoginAt
+}
+
+// DisplayName returns the formatted display name
+func (e *User) DisplayName() string {
+	return e.name.DisplayName()
+}
+
+// FullContactInfo returns full contact information
+func (e *User) FullContactInfo() string {
+	return fmt.Sprintf("%s <%s>", e.name.DisplayName(), e.email.Value())
+}
+
+// Status returns the user status
+func (e *User) Status() Status {
+	return e.status
+}
+
+// CreatedAt returns when the user was created
+func (e *User) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+// UpdatedAt returns when the user was last updated
+func (e *User) UpdatedAt() time.Time {
+	return e.updatedAt
+}
+
+// Version returns the user version for optimistic locking
+func (e *User) Version() int {
+	return e.version
+}
+
+// UpdateName updates the user's name with business validation
+func (e *User) UpdateName(nameStr string, now time.Time) error {
+	// Business rule: Cannot update name if user is deleted
+	if e.IsDeleted() {
+		return errors.ErrInvalidEntityState.WithDetails("reason", "cannot update deleted user name")
+	}
+
+	newName, err := NewUserName(nameStr)
+	if err != nil {
+		return err
+	}
+
+	if e.name.Equals(newName) {
+		return nil // No change needed
+	}
+
+	// Business rule: Cannot change name too frequently (e.g., once per month)
+	if e.hasRecentNameChange(now) {
+		return errors.ErrBusinessRuleViolation.WithDetails("reason", "name can only be changed once per month")
+	}
+
+	oldName := e.name.Value()
+	e.name = newName
+	e.updatedAt = now.UTC()
+	e.version++
+
+	// Update profile with name change
+	e.profile = e.profile.WithNameChange(now)
+
+	// Raise domain event
+	event := NewUserNameChangedEvent(e.id, oldName, newName.Value(), now)
+	e.addDomainEvent(event)
+
+	return nil
+}
+
+// UpdateEmail updates the user's email with security validation
+func (e *User) UpdateEmail(emailStr string, now time.Time) error {
+	// Business rule: Cannot update email if user is deleted
+	if e.IsDeleted() {
+		return errors.ErrInvalidEntityState.WithDetails("reason", "cannot update deleted user email")
+	}
+
+	newEmail, err := NewEmailAddress(emailStr)
+	if err != nil {
+		return err
+	}
+
+	if e.email.Equals(newEmail) {
+		return nil // No change needed
+	}
+
+	// Business rule: Email changes require verification (would be handled by application service)
+	// Business rule: Cannot change email too frequently
+	if e.hasRecentEmailChange(now) {
+		return errors.ErrBusinessRuleViolation.WithDetails("reason", "email can only be changed once per week")
+	}
+
+	oldEmail := e.email.Value()
+	e.email = newEmail
+	e.updatedAt = now.UTC()
+	e.version++
+
+	// Update preferences if switching between business/personal email
+	if oldEmailObj, _ := NewEmailAddress(oldEmail); oldEmailObj.IsBusinessEmail() != newEmail.IsBusinessEmail() {
+		if newEmail.IsBusinessEmail() {
+			e.preferences = e.preferences.ToBusinessDefaults()
+		} else {
+			e.preferences = e.preferences.ToPersonalDefaults()
+		}
+	}
+
+	// Raise domain event
+	event := NewUserEmailChangedEvent(e.id, oldEmail, newEmail.Value(), newEmail.GetProviderType(), now)
+	e.addDomainEvent(event)
+
+	return nil
+}
+
+// UpdateDescription updates the user's description with content validation
+func (e *User) UpdateDescription(descriptionStr string, now time.Time) error {
+	// Business rule: Cannot update description if user is deleted
+	if e.IsDeleted() {
+		return errors.ErrInvalidEntityState.WithDetails("reason", "cannot update deleted user description")
+	}
+
+	newDescription, err := NewUserDescription(descriptionStr)
+	if err != nil {
+		return err
+	}
+
+	if e.description.Value() == newDescription.Value() {
+		return nil // No change needed
+	}
+
+	oldDescription := e.description.Value()
+	e.description = newDescription
+	e.updatedAt = now.UTC()
+	e.version++
+
+	// Update profile completeness
+	e.profile = e.profile.WithDescriptionUpdate(newDescription)
+
+	// Raise domain event
+	event := NewUserDescriptionUpdatedEvent(e.id, oldDescription, newDescription.Value(), now)
+	e.addDomainEvent(event)
+
+	return nil
+}
+
+// Activate activates the user
+func (e *User) Activate(now time.Time) error {
+	if e.status == StatusActive {
+		return nil // Already active
+	}
+
+	if e.status == StatusDeleted {
+		return errors.ErrInvalidEntityState.WithDetails("reason", "cannot activate deleted user")
+	}
+
+	e.status = StatusActive
+	e.updatedAt = now.UTC()
+	e.version++
+
+	// Raise domain event
+	event := NewUserStatusChangedEvent(e.id, StatusInactive, StatusActive, now)
+	e.addDomainEvent(event)
+
+	return nil
+}
+
+// Deactivate deactivates the user
+func (e *User) Deactivate(now time.Time) error {
+	if e.status == StatusInactive {
+		return nil // Already inactive
+	}
+
+	if e.status == StatusDeleted {
+		return errors.ErrInvalidEntityState.WithDetails("reason", "cannot deactivate deleted user")
+	}
+
+	e.status = StatusInactive
+	e.updatedAt = now.UTC()
+	e.version++
+
+	// Raise domain event
+	event := NewUserStatusChangedEvent(e.id, StatusActive, StatusInactive, now)
+	e.addDomainEvent(event)
+
+	return nil
+}
+
+// Delete marks the user as deleted (soft delete)
+func (e *User) Delete(now time.Time) error {
+	if e.status == StatusDeleted {
+		return nil // Already deleted
+	}
+
+	oldStatus := e.status
+	e.status = StatusDeleted
+	e.updatedAt = now.UTC()
+	e.version++
+
+	// Raise domain event
+	event := NewUserStatusChangedEvent(e.id, oldStatus, StatusDeleted, now)
+	e.addDomainEvent(event)
+
+	return nil
+}
+
+// IsActive checks if the user is active
+func (e *User) IsActive() bool {
+	return e.status == StatusActive
+}
+
+// IsInactive checks if the user is inactive
+func (e *User) IsInactive() bool {
+	return e.status == StatusInactive
+}
+
+// IsDeleted checks if the user is deleted
+func (e *User) IsDeleted() bool {
+	return e.status == StatusDeleted
+}
+
+// DomainEvents returns the domain events
+func (e *User) DomainEvents() []events.DomainEvent {
+	return e.domainEvents
+}
+
+// ClearDomainEvents clears the domain events
+func (e *User) ClearDomainEvents() {
+	e.domainEvents = make([]events.DomainEvent, 0)
+}
+
+// addDomainEvent adds a domain event
+func (e *User) addDomainEvent(event events.DomainEvent) {
+	e.domainEvents = append(e.domainEvents, event)
+}
+
+// RecordLogin records a successful login with business logic
+func (e *User) RecordLogin(now time.Time) error {
+	// Business rule: Cannot login if deleted or inactive
+	if e.IsDeleted() {
+		return errors.ErrInvalidEntityState.WithDetails("reason", "deleted user cannot login")
+	}
+
+	if e.IsInactive() {
+		return errors.ErrInvalidEntityState.WithDetails("reason", "inactive user cannot login")
+	}
+
+	now = now.UTC()
+	e.lastLoginAt = &now
+	e.updatedAt = now
+
+	// Update profile with login activity
+	e.profile = e.profile.WithLoginActivity(now)
+
+	// Raise domain event
+	event := NewUserLoggedInEvent(e.id, e.email.Value(), now)
+	e.addDomainEvent(event)
+
+	return nil
+}
+
+// UpdatePreferences updates user preferences with validation
+func (e *User) UpdatePreferences(newPreferences UserPreferences, now time.Time) error {
+	if e.IsDeleted() {
+		return errors.ErrInvalidEntityState.WithDetails("reason", "cannot update deleted user preferences")
+	}
+
+	// Business rule: Validate preferences compatibility
+	if err := newPreferences.ValidateCompatibility(e.email.IsBusinessEmail()); err != nil {
+		return err
+	}
+
+	oldPreferences := e.preferences
+	e.preferences = newPreferences
+	e.updatedAt = now.UTC()
+	e.version++
+
+	// Raise domain event
+	event := NewUserPreferencesUpdatedEvent(e.id, oldPreferences, newPreferences, now)
+	e.addDomainEvent(event)
+
+	return nil
+}
+
+// CanPerformAction checks if user can perform a specific action
+func (e *User) CanPerformAction(action string) bool {
+	// Business rules for action permissions
+	if e.IsDeleted() {
+		return false
+	}
+
+	if e.IsInactive() {
+		// Inactive users can only perform limited actions
+		allowedActions := []string{"login", "activate", "view_profile"}
+		for _, allowed := range allowedActions {
+			if action == allowed {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Active users can perform most actions
+	return true
+}
+
+// GetEngagementLevel returns the user's engagement level based on activity until now
+func (e *User) GetEngagementLevel(now time.Time) string {
+	if e.lastLoginAt == nil {
+		return "new"
+	}
+
+	daysSinceLogin := now.Sub(*e.lastLoginAt).Hours() / 24
+
+	switch {
+	case daysSinceLogin <= 1:
+		return "highly_active"
+	case daysSinceLogin <= 7:
+		return "active"
+	case daysSinceLogin <= 30:
+		return "somewhat_active"
+	case daysSinceLogin <= 90:
+		return "dormant"
+	default:
+		return "inactive"
+	}
+}
+
+// GetAccountAge returns the age of the account at now
+func (e *User) GetAccountAge(now time.Time) time.Duration {
+	return now.Sub(e.createdAt)
+}
+
+// IsNewAccount checks if this is a new account (less than 7 days old at now)
+func (e *User) IsNewAccount(now time.Time) bool {
+	return e.GetAccountAge(now) < 7*24*time.Hour
+}
+
+// RequiresOnboarding checks if user needs onboarding
+func (e *User) RequiresOnboarding(now time.Time) bool {
+	return e.IsNewAccount(now) && !e.profile.IsComplete()
+}
+
+// GetContactSummary returns a summary of contact information at now
+func (e *User) GetContactSummary(now time.Time) ContactSummary {
+	return ContactSummary{
+		DisplayName:     e.name.DisplayName(),
+		Email:           e.email.Value(),
+		EmailProvider:   e.email.GetProviderType(),
+		IsBusinessEmail: e.email.IsBusinessEmail(),
+		ProfileComplete: e.profile.IsComplete(),
+		LastSeen:        e.lastLoginAt,
+		AccountAge:      e.GetAccountAge(now),
+		EngagementLevel: e.GetEngagementLevel(now),
+	}
+}
+
+// hasRecentNameChange checks if name was changed recently (business rule helper)
+func (e *User) hasRecentNameChange(now time.Time) bool {
+	// In a real implementation, this would check a change history
+	// For now, we'll use a simple heuristic based on update time
+	return now.Sub(e.updatedAt) < 30*24*time.Hour // 30 days
+}
+
+// hasRecentEmailChange checks if email was changed recently (business rule helper)
+func (e *User) hasRecentEmailChange(now time.Time) bool {
+	// In a real implementation, this would check a change history
+	// For now, we'll use a simple heuristic based on update time
+	return now.Sub(e.updatedAt) < 7*24*time.Hour // 7 days
+}
+
+// Equals checks if two users are equal
+func (e *User) Equals(other *User) bool {
+	if other == nil {
+		return false
+	}
+	return e.id.Equals(other.id)
+}
+
+// ContactSummary represents a summary of user contact information
+type ContactSummary struct {
+	DisplayName     string        `json:"displayName"`
+	Email           string        `json:"email"`
+	EmailProvider   string        `json:"emailProvider"`
+	IsBusinessEmail bool          `json:"isBusinessEmail"`
+	ProfileComplete bool          `json:"profileComplete"`
+	LastSeen        *time.Time    `json:"lastSeen,omitempty"`
+	AccountAge      time.Duration `json:"accountAge"`
+	EngagementLevel string        `json:"engagementLevel"`
+}
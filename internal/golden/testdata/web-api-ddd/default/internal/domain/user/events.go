@@ -0,0 +1,245 @@
+package user
+
+import (
+	"time"
+
+	"github.com/example/golden/internal/shared/events"
+	"github.com/example/golden/internal/shared/valueobjects"
+)
+
+// Event types
+const (
+	UserCreatedEventType            = "user.created"
+	UserUpdatedEventType            = "user.updated"
+	UserStatusChangedEventType      = "user.status_changed"
+	UserNameChangedEventType        = "user.name_changed"
+	UserEmailChangedEventType       = "user.email_changed"
+	UserDescriptionUpdatedEventType = "user.description_updated"
+	UserLoggedInEventType           = "user.logged_in"
+	UserPreferencesUpdatedEventType = "user.preferences_updated"
+)
+
+// UserCreatedEvent represents the event when a user is created
+type UserCreatedEvent struct {
+	events.BaseDomainEvent
+	Name          string
+	Email         string
+	EmailProvider string
+}
+
+// NewUserCreatedEvent creates a new user created event
+func NewUserCreatedEvent(userID valueobjects.ID, name, email, emailProvider string, occurredAt time.Time) UserCreatedEvent {
+	data := map[string]interface{}{
+		"name":           name,
+		"email":          email,
+		"email_provider": emailProvider,
+	}
+
+	return UserCreatedEvent{
+		BaseDomainEvent: events.NewBaseDomainEvent(
+			UserCreatedEventType,
+			userID,
+			"user",
+			data,
+			occurredAt,
+		),
+		Name:          name,
+		Email:         email,
+		EmailProvider: emailProvider,
+	}
+}
+
+// UserUpdatedEvent represents the event when a user is updated
+type UserUpdatedEvent struct {
+	events.BaseDomainEvent
+	Field    string
+	OldValue interface{}
+	NewValue interface{}
+}
+
+// NewUserUpdatedEvent creates a new user updated event
+func NewUserUpdatedEvent(userID valueobjects.ID, field string, oldValue, newValue interface{}, occurredAt time.Time) UserUpdatedEvent {
+	data := map[string]interface{}{
+		"field":     field,
+		"old_value": oldValue,
+		"new_value": newValue,
+	}
+
+	return UserUpdatedEvent{
+		BaseDomainEvent: events.NewBaseDomainEvent(
+			UserUpdatedEventType,
+			userID,
+			"user",
+			data,
+			occurredAt,
+		),
+		Field:    field,
+		OldValue: oldValue,
+		NewValue: newValue,
+	}
+}
+
+// UserStatusChangedEvent represents the event when a user's status changes
+type UserStatusChangedEvent struct {
+	events.BaseDomainEvent
+	OldStatus Status
+	NewStatus Status
+}
+
+// NewUserStatusChangedEvent creates a new user status changed event
+func NewUserStatusChangedEvent(userID valueobjects.ID, oldStatus, newStatus Status, occurredAt time.Time) UserStatusChangedEvent {
+	data := map[string]interface{}{
+		"old_status": oldStatus.String(),
+		"new_status": newStatus.String(),
+	}
+
+	return UserStatusChangedEvent{
+		BaseDomainEvent: events.NewBaseDomainEvent(
+			UserStatusChangedEventType,
+			userID,
+			"user",
+			data,
+			occurredAt,
+		),
+		OldStatus: oldStatus,
+		NewStatus: newStatus,
+	}
+}
+
+// UserNameChangedEvent represents the event when a user's name changes
+type UserNameChangedEvent struct {
+	events.BaseDomainEvent
+	OldName string
+	NewName string
+}
+
+// NewUserNameChangedEvent creates a new user name changed event
+func NewUserNameChangedEvent(userID valueobjects.ID, oldName, newName string, occurredAt time.Time) UserNameChangedEvent {
+	data := map[string]interface{}{
+		"old_name": oldName,
+		"new_name": newName,
+	}
+
+	return UserNameChangedEvent{
+		BaseDomainEvent: events.NewBaseDomainEvent(
+			UserNameChangedEventType,
+			userID,
+			"user",
+			data,
+			occurredAt,
+		),
+		OldName: oldName,
+		NewName: newName,
+	}
+}
+
+// UserEmailChangedEvent represents the event when a user's email changes
+type UserEmailChangedEvent struct {
+	events.BaseDomainEvent
+	OldEmail      string
+	NewEmail      string
+	EmailProvider string
+}
+
+// NewUserEmailChangedEvent creates a new user email changed event
+func NewUserEmailChangedEvent(userID valueobjects.ID, oldEmail, newEmail, emailProvider string, occurredAt time.Time) UserEmailChangedEvent {
+	data := map[string]interface{}{
+		"old_email":      oldEmail,
+		"new_email":      newEmail,
+		"email_provider": emailProvider,
+	}
+
+	return UserEmailChangedEvent{
+		BaseDomainEvent: events.NewBaseDomainEvent(
+			UserEmailChangedEventType,
+			userID,
+			"user",
+			data,
+			occurredAt,
+		),
+		OldEmail:      oldEmail,
+		NewEmail:      newEmail,
+		EmailProvider: emailProvider,
+	}
+}
+
+// UserDescriptionUpdatedEvent represents the event when a user's description is updated
+type UserDescriptionUpdatedEvent struct {
+	events.BaseDomainEvent
+	OldDescription string
+	NewDescription string
+}
+
+// NewUserDescriptionUpdatedEvent creates a new user description updated event
+func NewUserDescriptionUpdatedEvent(userID valueobjects.ID, oldDescription, newDescription string, occurredAt time.Time) UserDescriptionUpdatedEvent {
+	data := map[string]interface{}{
+		"old_description": oldDescription,
+		"new_description": newDescription,
+	}
+
+	return UserDescriptionUpdatedEvent{
+		BaseDomainEvent: events.NewBaseDomainEvent(
+			UserDescriptionUpdatedEventType,
+			userID,
+			"user",
+			data,
+			occurredAt,
+		),
+		OldDescription: oldDescription,
+		NewDescription: newDescription,
+	}
+}
+
+// UserLoggedInEvent represents the event when a user logs in
+type UserLoggedInEvent struct {
+	events.BaseDomainEvent
+	Email     string
+	LoginTime time.Time
+}
+
+// NewUserLoggedInEvent creates a new user logged in event
+func NewUserLoggedInEvent(userID valueobjects.ID, email string, loginTime time.Time) UserLoggedInEvent {
+	data := map[string]interface{}{
+		"email":      email,
+		"login_time": loginTime,
+	}
+
+	return UserLoggedInEvent{
+		BaseDomainEvent: events.NewBaseDomainEvent(
+			UserLoggedInEventType,
+			userID,
+			"user",
+			data,
+			loginTime,
+		),
+		Email:     email,
+		LoginTime: loginTime,
+	}
+}
+
+// UserPreferencesUpdatedEvent represents the event when a user's preferences are updated
+type UserPreferencesUpdatedEvent struct {
+	events.BaseDomainEvent
+	OldPreferences UserPreferences
+	NewPreferences UserPreferences
+}
+
+// NewUserPreferencesUpdatedEvent creates a new user preferences updated event
+func NewUserPreferencesUpdatedEvent(userID valueobjects.ID, oldPreferences, newPreferences UserPreferences, occurredAt time.Time) UserPreferencesUpdatedEvent {
+	data := map[string]interface{}{
+		"old_preferences": oldPreferences,
+		"new_preferences": newPreferences,
+	}
+
+	return UserPreferencesUpdatedEvent{
+		BaseDomainEvent: events.NewBaseDomainEvent(
+			UserPreferencesUpdatedEventType,
+			userID,
+			"user",
+			data,
+			occurredAt,
+		),
+		OldPreferences: oldPreferences,
+		NewPreferences: newPreferences,
+	}
+}
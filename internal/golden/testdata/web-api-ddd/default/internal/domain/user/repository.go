@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+
+	"github.com/example/golden/internal/shared/valueobjects"
+)
+
+// UserRepository defines the contract for user persistence
+type UserRepository interface {
+	// Save persists a user aggregate
+	Save(ctx context.Context, user *User) error
+
+	// FindByID retrieves a user by its ID
+	FindByID(ctx context.Context, id valueobjects.ID) (*User, error)
+
+	// FindByName retrieves a user by name
+	FindByName(ctx context.Context, name string) (*User, error)
+
+	// FindByEmail retrieves a user by email
+	FindByEmail(ctx context.Context, email string) (*User, error)
+
+	// FindAll retrieves all users with pagination
+	FindAll(ctx context.Context, offset, limit int) ([]*User, error)
+
+	// FindByStatus retrieves users by status
+	FindByStatus(ctx context.Context, status Status, offset, limit int) ([]*User, error)
+
+	// Count returns the total number of users
+	Count(ctx context.Context) (int, error)
+
+	// CountByStatus returns the number of users by status
+	CountByStatus(ctx context.Context, status Status) (int, error)
+
+	// Delete removes a user (physical delete)
+	Delete(ctx context.Context, id valueobjects.ID) error
+
+	// Exists checks if a user exists by ID
+	Exists(ctx context.Context, id valueobjects.ID) (bool, error)
+
+	// ExistsByName checks if a user exists by name
+	ExistsByName(ctx context.Context, name string) (bool, error)
+
+	// ExistsByEmail checks if a user exists by email
+	ExistsByEmail(ctx context.Context, email string) (bool, error)
+}
+
+// Repository is an alias for UserRepository for backward compatibility
+type Repository = UserRepository
@@ -0,0 +1,161 @@
+package user
+
+import (
+	"context"
+
+	"github.com/example/golden/internal/shared/errors"
+	"github.com/example/golden/internal/shared/valueobjects"
+)
+
+// Service provides domain services for user operations
+type Service interface {
+	// IsNameUnique checks if a name is unique
+	IsNameUnique(ctx context.Context, name string, excludeID *valueobjects.ID) (bool, error)
+
+	// CanChangeStatus determines if a user can change to a specific status
+	CanChangeStatus(user *User, newStatus Status) error
+
+	// ValidateForCreation validates a user before creation
+	ValidateForCreation(name string) error
+
+	// ValidateForUpdate validates a user before update
+	ValidateForUpdate(user *User, newName *string) error
+}
+
+// DomainService implements the user domain service
+type DomainService struct {
+	repository Repository
+}
+
+// NewDomainService creates a new user domain service
+func NewDomainService(repository Repository) *DomainService {
+	return &DomainService{
+		repository: repository,
+	}
+}
+
+// NewService creates a new user domain service (alias for compatibility)
+func NewService(repository Repository) Service {
+	return NewDomainService(repository)
+}
+
+// IsNameUnique checks if a name is unique
+func (s *DomainService) IsNameUnique(ctx context.Context, name string, excludeID *valueobjects.ID) (bool, error) {
+	exists, err := s.repository.ExistsByName(ctx, name)
+	if err != nil {
+		return false, err
+	}
+
+	if !exists {
+		return true, nil
+	}
+
+	// If excludeID is provided, check if the existing user is the one being excluded
+	if excludeID != nil {
+		existingUser, err := s.repository.FindByName(ctx, name)
+		if err != nil {
+			return false, err
+		}
+
+		if existingUser != nil && existingUser.ID().Equals(*excludeID) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// CanChangeStatus determines if a user can change to a specific status
+func (s *DomainService) CanChangeStatus(user *User, newStatus Status) error {
+	if user == nil {
+		return errors.ErrInvalidEntityState.WithDetails("reason", "user is nil")
+	}
+
+	currentStatus := user.Status()
+
+	// Define valid status transitions
+	switch currentStatus {
+	case StatusActive:
+		// Active users can become inactive or deleted
+		if newStatus != StatusInactive && newStatus != StatusDeleted {
+			return errors.ErrBusinessRuleViolation.WithDetails(
+				"reason",
+				"active user can only be changed to inactive or deleted",
+			)
+		}
+	case StatusInactive:
+		// Inactive users can become active or deleted
+		if newStatus != StatusActive && newStatus != StatusDeleted {
+			return errors.ErrBusinessRuleViolation.WithDetails(
+				"reason",
+				"inactive user can only be changed to active or deleted",
+			)
+		}
+	case StatusDeleted:
+		// Deleted users cannot change status
+		return errors.ErrBusinessRuleViolation.WithDetails(
+			"reason",
+			"deleted user cannot change status",
+		)
+	default:
+		return errors.ErrInvalidEntityState.WithDetails("reason", "unknown status")
+	}
+
+	return nil
+}
+
+// ValidateForCreation validates a user before creation
+func (s *DomainService) ValidateForCreation(name string) error {
+	// Check name uniqueness
+	unique, err := s.IsNameUnique(context.Background(), name, nil)
+	if err != nil {
+		return err
+	}
+
+	if !unique {
+		return errors.ErrBusinessRuleViolation.WithDetails(
+			"reason",
+			"name already exists",
+		).WithDetails("name", name)
+	}
+
+	// Additional business rules can be added here
+	// For example: checking against banned name patterns, length restrictions, etc.
+
+	return nil
+}
+
+// ValidateForUpdate validates a user before update
+func (s *DomainService) ValidateForUpdate(user *User, newName *string) error {
+	if user == nil {
+		return errors.ErrInvalidEntityState.WithDetails("reason", "user is nil")
+	}
+
+	// Check if user is in a valid state for updates
+	if user.IsDeleted() {
+		return errors.ErrBusinessRuleViolation.WithDetails(
+			"reason",
+			"cannot update deleted user",
+		)
+	}
+
+	// If name is being changed, check uniqueness
+	if newName != nil && user.Name().String() != *newName {
+		userID := user.ID()
+		unique, err := s.IsNameUnique(context.Background(), *newName, &userID)
+		if err != nil {
+			return err
+		}
+
+		if !unique {
+			return errors.ErrBusinessRuleViolation.WithDetails(
+				"reason",
+				"name already exists",
+			).WithDetails("name", *newName)
+		}
+	}
+
+	// Additional validation rules can be added here
+
+	return nil
+}
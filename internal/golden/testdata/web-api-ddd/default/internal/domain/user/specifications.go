@@ -0,0 +1,251 @@
+package user
+
+import (
+	"github.com/example/golden/internal/shared/errors"
+)
+
+// Specification represents a business rule specification
+type Specification interface {
+	IsSatisfiedBy(user *User) bool
+	WhyNotSatisfiedBy(user *User) error
+}
+
+// CompositeSpecification provides base functionality for composite specifications
+type CompositeSpecification struct{}
+
+// IsSatisfiedBy is a default implementation that always returns true
+func (s CompositeSpecification) IsSatisfiedBy(user *User) bool {
+	return true
+}
+
+// WhyNotSatisfiedBy is a default implementation that returns no error
+func (s CompositeSpecification) WhyNotSatisfiedBy(user *User) error {
+	return nil
+}
+
+// And creates an AND specification
+func (s CompositeSpecification) And(other Specification) Specification {
+	return &AndSpecification{left: s, right: other}
+}
+
+// Or creates an OR specification
+func (s CompositeSpecification) Or(other Specification) Specification {
+	return &OrSpecification{left: s, right: other}
+}
+
+// Not creates a NOT specification
+func (s CompositeSpecification) Not() Specification {
+	return &NotSpecification{spec: s}
+}
+
+// AndSpecification combines two specifications with AND logic
+type AndSpecification struct {
+	left  Specification
+	right Specification
+}
+
+// IsSatisfiedBy checks if both specifications are satisfied
+func (s *AndSpecification) IsSatisfiedBy(user *User) bool {
+	return s.left.IsSatisfiedBy(user) && s.right.IsSatisfiedBy(user)
+}
+
+// WhyNotSatisfiedBy returns why the specification is not satisfied
+func (s *AndSpecification) WhyNotSatisfiedBy(user *User) error {
+	if !s.left.IsSatisfiedBy(user) {
+		return s.left.WhyNotSatisfiedBy(user)
+	}
+	if !s.right.IsSatisfiedBy(user) {
+		return s.right.WhyNotSatisfiedBy(user)
+	}
+	return nil
+}
+
+// OrSpecification combines two specifications with OR logic
+type OrSpecification struct {
+	left  Specification
+	right Specification
+}
+
+// IsSatisfiedBy checks if either specification is satisfied
+func (s *OrSpecification) IsSatisfiedBy(user *User) bool {
+	return s.left.IsSatisfiedBy(user) || s.right.IsSatisfiedBy(user)
+}
+
+// WhyNotSatisfiedBy returns why the specification is not satisfied
+func (s *OrSpecification) WhyNotSatisfiedBy(user *User) error {
+	if s.left.IsSatisfiedBy(user) || s.right.IsSatisfiedBy(user) {
+		return nil
+	}
+	return errors.ErrBusinessRuleViolation.WithDetails("reason", "neither condition is satisfied")
+}
+
+// NotSpecification negates a specification
+type NotSpecification struct {
+	spec Specification
+}
+
+// IsSatisfiedBy checks if the specification is NOT satisfied
+func (s *NotSpecification) IsSatisfiedBy(user *User) bool {
+	return !s.spec.IsSatisfiedBy(user)
+}
+
+// WhyNotSatisfiedBy returns why the specification is not satisfied
+func (s *NotSpecification) WhyNotSatisfiedBy(user *User) error {
+	if s.IsSatisfiedBy(user) {
+		return nil
+	}
+	return errors.ErrBusinessRuleViolation.WithDetails("reason", "negated condition failed")
+}
+
+// ActiveSpecification checks if a user is active
+type ActiveSpecification struct {
+	CompositeSpecification
+}
+
+// NewActiveSpecification creates a new active specification
+func NewActiveSpecification() *ActiveSpecification {
+	return &ActiveSpecification{}
+}
+
+// IsSatisfiedBy checks if the user is active
+func (s *ActiveSpecification) IsSatisfiedBy(user *User) bool {
+	return user != nil && user.IsActive()
+}
+
+// WhyNotSatisfiedBy returns why the user is not active
+func (s *ActiveSpecification) WhyNotSatisfiedBy(user *User) error {
+	if user == nil {
+		return errors.ErrBusinessRuleViolation.WithDetails("reason", "user is nil")
+	}
+	if !user.IsActive() {
+		return errors.ErrBusinessRuleViolation.WithDetails("reason", "user is not active")
+	}
+	return nil
+}
+
+// ValidNameSpecification checks if a user has a valid name
+type ValidNameSpecification struct {
+	CompositeSpecification
+}
+
+// NewValidNameSpecification creates a new valid name specification
+func NewValidNameSpecification() *ValidNameSpecification {
+	return &ValidNameSpecification{}
+}
+
+// IsSatisfiedBy checks if the user has a valid name
+func (s *ValidNameSpecification) IsSatisfiedBy(user *User) bool {
+	return user != nil && user.Name().String() != ""
+}
+
+// WhyNotSatisfiedBy returns why the user doesn't have a valid name
+func (s *ValidNameSpecification) WhyNotSatisfiedBy(user *User) error {
+	if user == nil {
+		return errors.ErrBusinessRuleViolation.WithDetails("reason", "user is nil")
+	}
+	if user.Name().String() == "" {
+		return errors.ErrBusinessRuleViolation.WithDetails("reason", "user name is invalid")
+	}
+	return nil
+}
+
+// NamePatternSpecification checks if a user's name matches allowed patterns
+type NamePatternSpecification struct {
+	CompositeSpecification
+	allowedPatterns []string
+}
+
+// NewNamePatternSpecification creates a new name pattern specification
+func NewNamePatternSpecification(allowedPatterns []string) *NamePatternSpecification {
+	return &NamePatternSpecification{
+		allowedPatterns: allowedPatterns,
+	}
+}
+
+// IsSatisfiedBy checks if the user's name matches allowed patterns
+func (s *NamePatternSpecification) IsSatisfiedBy(user *User) bool {
+	if user == nil {
+		return false
+	}
+
+	if len(s.allowedPatterns) == 0 {
+		return true // No restrictions
+	}
+
+	userName := user.Name().String()
+	for _, pattern := range s.allowedPatterns {
+		if userName == pattern {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WhyNotSatisfiedBy returns why the user's name pattern is not allowed
+func (s *NamePatternSpecification) WhyNotSatisfiedBy(user *User) error {
+	if user == nil {
+		return errors.ErrBusinessRuleViolation.WithDetails("reason", "user is nil")
+	}
+
+	if s.IsSatisfiedBy(user) {
+		return nil
+	}
+
+	return errors.ErrBusinessRuleViolation.WithDetails(
+		"reason",
+		"name pattern not allowed",
+	).WithDetails("name", user.Name())
+}
+
+// CanBeUpdatedSpecification checks if a user can be updated
+type CanBeUpdatedSpecification struct {
+	CompositeSpecification
+}
+
+// NewCanBeUpdatedSpecification creates a new can be updated specification
+func NewCanBeUpdatedSpecification() *CanBeUpdatedSpecification {
+	return &CanBeUpdatedSpecification{}
+}
+
+// IsSatisfiedBy checks if the user can be updated
+func (s *CanBeUpdatedSpecification) IsSatisfiedBy(user *User) bool {
+	return user != nil && !user.IsDeleted()
+}
+
+// WhyNotSatisfiedBy returns why the user cannot be updated
+func (s *CanBeUpdatedSpecification) WhyNotSatisfiedBy(user *User) error {
+	if user == nil {
+		return errors.ErrBusinessRuleViolation.WithDetails("reason", "user is nil")
+	}
+	if user.IsDeleted() {
+		return errors.ErrBusinessRuleViolation.WithDetails("reason", "user is deleted")
+	}
+	return nil
+}
+
+// CanBeDeletedSpecification checks if a user can be deleted
+type CanBeDeletedSpecification struct {
+	CompositeSpecification
+}
+
+// NewCanBeDeletedSpecification creates a new can be deleted specification
+func NewCanBeDeletedSpecification() *CanBeDeletedSpecification {
+	return &CanBeDeletedSpecification{}
+}
+
+// IsSatisfiedBy checks if the user can be deleted
+func (s *CanBeDeletedSpecification) IsSatisfiedBy(user *User) bool {
+	return user != nil && !user.IsDeleted()
+}
+
+// WhyNotSatisfiedBy returns why the user cannot be deleted
+func (s *CanBeDeletedSpecification) WhyNotSatisfiedBy(user *User) error {
+	if user == nil {
+		return errors.ErrBusinessRuleViolation.WithDetails("reason", "user is nil")
+	}
+	if user.IsDeleted() {
+		return errors.ErrBusinessRuleViolation.WithDetails("reason", "user is already deleted")
+	}
+	return nil
+}
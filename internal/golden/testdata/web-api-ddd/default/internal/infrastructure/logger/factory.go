@@ -0,0 +1,24 @@
+package logger
+
+// Factory provides a factory method for creating loggers
+type Factory struct{}
+
+// NewFactory creates a new logger factory
+func NewFactory() *Factory {
+	return &Factory{}
+}
+
+// CreateLogger creates a new logger instance with default configuration
+func (f *Factory) CreateLogger() *Logger {
+	return New()
+}
+
+// CreateLoggerWithLevel creates a new logger instance with the specified level
+func (f *Factory) CreateLoggerWithLevel(level string) *Logger {
+	return NewWithLevel(level)
+}
+
+// GetDefaultLogger returns a default logger instance
+func GetDefaultLogger() *Logger {
+	return New()
+}
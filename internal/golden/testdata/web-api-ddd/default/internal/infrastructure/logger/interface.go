@@ -0,0 +1,17 @@
+package logger
+
+import "log/slog"
+
+// LoggerInterface defines the contract for all logger implementations
+// This provides abstraction for different logging backends
+type LoggerInterface interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+	With(args ...any) *slog.Logger
+	DisableColor()
+}
+
+// Ensure our Logger implements the LoggerInterface (commented out to avoid interface conflicts)
+// var _ LoggerInterface = (*Logger)(nil)
@@ -0,0 +1,141 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/example/golden/internal/domain/refreshtoken"
+	"github.com/example/golden/internal/infrastructure/logger"
+	"github.com/example/golden/internal/shared/errors"
+	"github.com/example/golden/internal/shared/valueobjects"
+)
+
+// RefreshTokenRepositoryGORM implements the refreshtoken.Repository interface using GORM.
+// Revoked and rotated tokens are kept until they expire so reuse can be detected.
+type RefreshTokenRepositoryGORM struct {
+	db     *gorm.DB
+	logger *logger.Logger
+}
+
+// NewRefreshTokenRepository creates a new refresh token repository
+func NewRefreshTokenRepository(db *gorm.DB, log *logger.Logger) refreshtoken.Repository {
+	return &RefreshTokenRepositoryGORM{
+		db:     db,
+		logger: log,
+	}
+}
+
+// RefreshTokenModel represents the database model for a refresh token
+type RefreshTokenModel struct {
+	Hash      string    `gorm:"primaryKey;size:64"`
+	FamilyID  string    `gorm:"not null;index"`
+	UserID    string    `gorm:"not null;index"`
+	IssuedAt  time.Time `gorm:"not null"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+	RotatedAt *time.Time
+	RevokedAt *time.Time
+}
+
+// TableName returns the table name for the refresh token model
+func (RefreshTokenModel) TableName() string {
+	return "refresh_tokens"
+}
+
+// Save stores a newly issued refresh token and purges expired ones
+func (r *RefreshTokenRepositoryGORM) Save(ctx context.Context, token *refreshtoken.Token) error {
+	model := RefreshTokenModel{
+		Hash:      token.Hash(),
+		FamilyID:  token.FamilyID(),
+		UserID:    token.UserID().String(),
+		IssuedAt:  token.IssuedAt(),
+		ExpiresAt: token.ExpiresAt(),
+	}
+
+	if result := r.db.WithContext(ctx).Create(&model); result.Error != nil {
+		r.logger.Error("Failed to save refresh token", "error", result.Error, "family_id", token.FamilyID())
+		return fmt.Errorf("failed to save refresh token: %w", result.Error)
+	}
+
+	// Expired tokens can no longer be presented, they only take up space
+	if result := r.db.WithContext(ctx).Where("expires_at < ?", token.IssuedAt()).Delete(&RefreshTokenModel{}); result.Error != nil {
+		r.logger.Error("Failed to purge expired refresh tokens", "error", result.Error)
+	}
+
+	return nil
+}
+
+// FindByHash retrieves a refresh token by its hash
+func (r *RefreshTokenRepositoryGORM) FindByHash(ctx context.Context, hash string) (*refreshtoken.Token, error) {
+	var model RefreshTokenModel
+	result := r.db.WithContext(ctx).Where("hash = ?", hash).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, errors.ErrRefreshTokenNotFound
+		}
+		r.logger.Error("Failed to find refresh token", "error", result.Error)
+		return nil, fmt.Errorf("failed to find refresh token: %w", result.Error)
+	}
+
+	userID, err := valueobjects.NewID(model.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID in refresh token: %w", err)
+	}
+
+	return refreshtoken.Reconstruct(
+		model.Hash,
+		model.FamilyID,
+		userID,
+		model.IssuedAt,
+		model.ExpiresAt,
+		model.RotatedAt,
+		model.RevokedAt,
+	), nil
+}
+
+// MarkRotated marks a refresh token as exchanged, unless it already was or got revoked
+func (r *RefreshTokenRepositoryGORM) MarkRotated(ctx context.Context, hash string, at time.Time) (bool, error) {
+	// The conditional update makes rotation a compare-and-set
+	result := r.db.WithContext(ctx).
+		Model(&RefreshTokenModel{}).
+		Where("hash = ? AND rotated_at IS NULL AND revoked_at IS NULL", hash).
+		Update("rotated_at", at)
+	if result.Error != nil {
+		r.logger.Error("Failed to rotate refresh token", "error", result.Error)
+		return false, fmt.Errorf("failed to rotate refresh token: %w", result.Error)
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// RevokeFamily revokes every refresh token of a family
+func (r *RefreshTokenRepositoryGORM) RevokeFamily(ctx context.Context, familyID string, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&RefreshTokenModel{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", at)
+	if result.Error != nil {
+		r.logger.Error("Failed to revoke refresh token family", "error", result.Error, "family_id", familyID)
+		return fmt.Errorf("failed to revoke refresh token family: %w", result.Error)
+	}
+
+	r.logger.Info("Refresh token family revoked", "family_id", familyID, "tokens", result.RowsAffected)
+	return nil
+}
+
+// RevokeAllForUser revokes every refresh token issued to a user
+func (r *RefreshTokenRepositoryGORM) RevokeAllForUser(ctx context.Context, userID valueobjects.ID, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&RefreshTokenModel{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID.String()).
+		Update("revoked_at", at)
+	if result.Error != nil {
+		r.logger.Error("Failed to revoke refresh tokens", "error", result.Error, "user_id", userID.String())
+		return fmt.Errorf("failed to revoke refresh tokens: %w", result.Error)
+	}
+
+	r.logger.Info("Refresh tokens revoked", "user_id", userID.String(), "tokens", result.RowsAffected)
+	return nil
+}
@@ -0,0 +1,338 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/example/golden/internal/domain/user"
+	"github.com/example/golden/internal/infrastructure/logger"
+	"github.com/example/golden/internal/shared/errors"
+	"github.com/example/golden/internal/shared/valueobjects"
+)
+
+// UserRepositoryGORM implements the user.Repository interface using GORM
+type UserRepositoryGORM struct {
+	db     *gorm.DB
+	logger *logger.Logger
+}
+
+// NewUserRepository creates a new user repository
+func NewUserRepository(db *gorm.DB, log *logger.Logger) user.Repository {
+	return &UserRepositoryGORM{
+		db:     db,
+		logger: log,
+	}
+}
+
+// UserModel represents the database model for user
+type UserModel struct {
+	ID          string    `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	Name        string    `gorm:"uniqueIndex;not null"`
+	Email       string    `gorm:"uniqueIndex;not null"` // Add email field for user domain
+	Description string    `gorm:"type:text"`
+	Status      string    `gorm:"not null;default:'active'"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	Version     int       `gorm:"not null;default:1"`
+}
+
+// TableName returns the table name for the user model
+func (UserModel) TableName() string {
+	return "users"
+}
+
+// Save persists a user entity to the database
+func (r *UserRepositoryGORM) Save(ctx context.Context, entity *user.User) error {
+	r.logger.Info("Saving user entity", "id", entity.ID().String())
+
+	model := r.entityToModel(entity)
+
+	result := r.db.WithContext(ctx).Save(&model)
+	if result.Error != nil {
+		r.logger.Error("Failed to save user", "error", result.Error, "id", entity.ID().String())
+		return fmt.Errorf("failed to save user: %w", result.Error)
+	}
+
+	r.logger.Info("User saved successfully", "id", entity.ID().String())
+	return nil
+}
+
+// FindByID retrieves a user entity by its ID
+func (r *UserRepositoryGORM) FindByID(ctx context.Context, id valueobjects.ID) (*user.User, error) {
+	r.logger.Info("Finding user by ID", "id", id.String())
+
+	var model UserModel
+	result := r.db.WithContext(ctx).Where("id = ?", id.String()).First(&model)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			r.logger.Info("User not found", "id", id.String())
+			return nil, errors.ErrUserNotFound
+		}
+		r.logger.Error("Failed to find user", "error", result.Error, "id", id.String())
+		return nil, fmt.Errorf("failed to find user: %w", result.Error)
+	}
+
+	entity, err := r.modelToEntity(&model)
+	if err != nil {
+		r.logger.Error("Failed to convert model to entity", "error", err, "id", id.String())
+		return nil, fmt.Errorf("failed to convert model to entity: %w", err)
+	}
+
+	r.logger.Info("User found successfully", "id", id.String())
+	return entity, nil
+}
+
+// FindByName retrieves a user entity by name
+func (r *UserRepositoryGORM) FindByName(ctx context.Context, name string) (*user.User, error) {
+	r.logger.Info("Finding user by name", "name", name)
+
+	var model UserModel
+	result := r.db.WithContext(ctx).Where("name = ?", name).First(&model)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			r.logger.Info("User not found", "name", name)
+			return nil, errors.ErrUserNotFound
+		}
+		r.logger.Error("Failed to find user by name", "error", result.Error, "name", name)
+		return nil, fmt.Errorf("failed to find user by name: %w", result.Error)
+	}
+
+	entity, err := r.modelToEntity(&model)
+	if err != nil {
+		r.logger.Error("Failed to convert model to entity", "error", err, "name", name)
+		return nil, fmt.Errorf("failed to convert model to entity: %w", err)
+	}
+
+	r.logger.Info("User found by name successfully", "name", name)
+	return entity, nil
+}
+
+// FindByEmail retrieves a user entity by email
+func (r *UserRepositoryGORM) FindByEmail(ctx context.Context, email string) (*user.User, error) {
+	r.logger.Info("Finding user by email", "email", email)
+
+	var model UserModel
+	result := r.db.WithContext(ctx).Where("email = ?", email).First(&model)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			r.logger.Info("User not found", "email", email)
+			return nil, errors.ErrUserNotFound
+		}
+		r.logger.Error("Failed to find user by email", "error", result.Error, "email", email)
+		return nil, fmt.Errorf("failed to find user by email: %w", result.Error)
+	}
+
+	entity, err := r.modelToEntity(&model)
+	if err != nil {
+		r.logger.Error("Failed to convert model to entity", "error", err, "email", email)
+		return nil, fmt.Errorf("failed to convert model to entity: %w", err)
+	}
+
+	r.logger.Info("User found by email successfully", "email", email)
+	return entity, nil
+}
+
+// Delete removes a user entity from the database
+func (r *UserRepositoryGORM) Delete(ctx context.Context, id valueobjects.ID) error {
+	r.logger.Info("Deleting user", "id", id.String())
+
+	result := r.db.WithContext(ctx).Delete(&UserModel{}, "id = ?", id.String())
+	if result.Error != nil {
+		r.logger.Error("Failed to delete user", "error", result.Error, "id", id.String())
+		return fmt.Errorf("failed to delete user: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		r.logger.Info("User not found for deletion", "id", id.String())
+		return errors.ErrUserNotFound
+	}
+
+	r.logger.Info("User deleted successfully", "id", id.String())
+	return nil
+}
+
+// List retrieves all user entities
+func (r *UserRepositoryGORM) List(ctx context.Context, limit, offset int) ([]*user.User, error) {
+	r.logger.Info("Listing users", "limit", limit, "offset", offset)
+
+	var models []UserModel
+	query := r.db.WithContext(ctx).Limit(limit).Offset(offset)
+	result := query.Find(&models)
+
+	if result.Error != nil {
+		r.logger.Error("Failed to list users", "error", result.Error)
+		return nil, fmt.Errorf("failed to list users: %w", result.Error)
+	}
+
+	entities := make([]*user.User, len(models))
+	for i, model := range models {
+		entity, err := r.modelToEntity(&model)
+		if err != nil {
+			r.logger.Error("Failed to convert model to entity", "error", err, "index", i)
+			return nil, fmt.Errorf("failed to convert model to entity: %w", err)
+		}
+		entities[i] = entity
+	}
+
+	r.logger.Info("Users listed successfully", "count", len(entities))
+	return entities, nil
+}
+
+// entityToModel converts a domain entity to a database model
+func (r *UserRepositoryGORM) entityToModel(entity *user.User) *UserModel {
+	return &UserModel{
+		ID:          entity.ID().String(),
+		Name:        entity.Name(),
+		Email:       entity.Email(), // Add email mapping
+		Description: entity.Description(),
+		Status:      entity.Status().String(),
+		CreatedAt:   entity.CreatedAt(),
+		UpdatedAt:   entity.UpdatedAt(),
+		Version:     entity.Version(),
+	}
+}
+
+// modelToEntity converts a database model to a domain entity
+func (r *UserRepositoryGORM) modelToEntity(model *UserModel) (*user.User, error) {
+	id, err := valueobjects.NewID(model.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID: %w", err)
+	}
+
+	status, err := user.ParseStatus(model.Status)
+	if err != nil {
+		return nil, fmt.Errorf("invalid status: %w", err)
+	}
+
+	return user.ReconstructUser(
+		id,
+		model.Name,
+		model.Email, // Add email to entity reconstruction
+		model.Description,
+		status,
+		model.CreatedAt,
+		model.UpdatedAt,
+		model.Version,
+	), nil
+}
+
+// Count returns the total number of users
+func (r *UserRepositoryGORM) Count(ctx context.Context) (int, error) {
+	r.logger.Info("Counting users")
+
+	var count int64
+	result := r.db.WithContext(ctx).Model(&UserModel{}).Count(&count)
+
+	if result.Error != nil {
+		r.logger.Error("Failed to count users", "error", result.Error)
+		return 0, fmt.Errorf("failed to count users: %w", result.Error)
+	}
+
+	r.logger.Info("Users counted successfully", "count", count)
+	return int(count), nil
+}
+
+// CountByStatus returns the number of users by status
+func (r *UserRepositoryGORM) CountByStatus(ctx context.Context, status user.Status) (int, error) {
+	r.logger.Info("Counting users by status", "status", status.String())
+
+	var count int64
+	result := r.db.WithContext(ctx).Model(&UserModel{}).Where("status = ?", status.String()).Count(&count)
+
+	if result.Error != nil {
+		r.logger.Error("Failed to count users by status", "error", result.Error, "status", status.String())
+		return 0, fmt.Errorf("failed to count users by status: %w", result.Error)
+	}
+
+	r.logger.Info("Users counted by status successfully", "count", count, "status", status.String())
+	return int(count), nil
+}
+
+// FindAll retrieves all users with pagination
+func (r *UserRepositoryGORM) FindAll(ctx context.Context, offset, limit int) ([]*user.User, error) {
+	return r.List(ctx, limit, offset)
+}
+
+// FindByStatus retrieves users by status
+func (r *UserRepositoryGORM) FindByStatus(ctx context.Context, status user.Status, offset, limit int) ([]*user.User, error) {
+	r.logger.Info("Finding users by status", "status", status.String(), "offset", offset, "limit", limit)
+
+	var models []UserModel
+	query := r.db.WithContext(ctx).Where("status = ?", status.String()).Limit(limit).Offset(offset)
+	result := query.Find(&models)
+
+	if result.Error != nil {
+		r.logger.Error("Failed to find users by status", "error", result.Error, "status", status.String())
+		return nil, fmt.Errorf("failed to find users by status: %w", result.Error)
+	}
+
+	entities := make([]*user.User, len(models))
+	for i, model := range models {
+		entity, err := r.modelToEntity(&model)
+		if err != nil {
+			r.logger.Error("Failed to convert model to entity", "error", err, "index", i)
+			return nil, fmt.Errorf("failed to convert model to entity: %w", err)
+		}
+		entities[i] = entity
+	}
+
+	r.logger.Info("Users found by status successfully", "count", len(entities), "status", status.String())
+	return entities, nil
+}
+
+// Exists checks if a user exists by ID
+func (r *UserRepositoryGORM) Exists(ctx context.Context, id valueobjects.ID) (bool, error) {
+	r.logger.Info("Checking if user exists", "id", id.String())
+
+	var count int64
+	result := r.db.WithContext(ctx).Model(&UserModel{}).Where("id = ?", id.String()).Count(&count)
+
+	if result.Error != nil {
+		r.logger.Error("Failed to check if user exists", "error", result.Error, "id", id.String())
+		return false, fmt.Errorf("failed to check if user exists: %w", result.Error)
+	}
+
+	exists := count > 0
+	r.logger.Info("User existence check completed", "id", id.String(), "exists", exists)
+	return exists, nil
+}
+
+// ExistsByName checks if a user exists by name
+func (r *UserRepositoryGORM) ExistsByName(ctx context.Context, name string) (bool, error) {
+	r.logger.Info("Checking if user exists by name", "name", name)
+
+	var count int64
+	result := r.db.WithContext(ctx).Model(&UserModel{}).Where("name = ?", name).Count(&count)
+
+	if result.Error != nil {
+		r.logger.Error("Failed to check if user exists by name", "error", result.Error, "name", name)
+		return false, fmt.Errorf("failed to check if user exists by name: %w", result.Error)
+	}
+
+	exists := count > 0
+	r.logger.Info("User existence check by name completed", "name", name, "exists", exists)
+	return exists, nil
+}
+
+// ExistsByEmail checks if a user exists by email
+func (r *UserRepositoryGORM) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	r.logger.Info("Checking if user exists by email", "email", email)
+
+	var count int64
+	result := r.db.WithContext(ctx).Model(&UserModel{}).Where("email = ?", email).Count(&count)
+
+	if result.Error != nil {
+		r.logger.Error("Failed to check if user exists by email", "error", result.Error, "email", email)
+		return false, fmt.Errorf("failed to check if user exists by email: %w", result.Error)
+	}
+
+	exists := count > 0
+	r.logger.Info("User existence check by email completed", "email", email, "exists", exists)
+	return exists, nil
+}
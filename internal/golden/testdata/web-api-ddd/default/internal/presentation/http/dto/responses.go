@@ -0,0 +1,73 @@
+package dto
+
+import (
+	"time"
+)
+
+// APIResponse represents a generic API response
+type APIResponse struct {
+	Success bool        `json:"success"`
+	Message string      `json:"message,omitempty"`
+	Data    interface{} `json:"data,omitempty"`
+	Error   *APIError   `json:"error,omitempty"`
+}
+
+// APIError represents an API error response
+type APIError struct {
+	Code    string      `json:"code"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details,omitempty"`
+}
+
+// PaginatedResponse represents a paginated API response
+type PaginatedResponse struct {
+	Data       interface{} `json:"data"`
+	Total      int         `json:"total"`
+	Page       int         `json:"page"`
+	Limit      int         `json:"limit"`
+	TotalPages int         `json:"totalPages"`
+}
+
+// HealthResponse represents a health check response
+type HealthResponse struct {
+	Status    string    `json:"status"`
+	Version   string    `json:"version"`
+	Timestamp time.Time `json:"timestamp"`
+	Uptime    string    `json:"uptime"`
+}
+
+// NewSuccessResponse creates a successful API response
+func NewSuccessResponse(data interface{}) *APIResponse {
+	return &APIResponse{
+		Success: true,
+		Data:    data,
+	}
+}
+
+// NewErrorResponse creates an error API response
+func NewErrorResponse(code, message string, details interface{}) *APIResponse {
+	return &APIResponse{
+		Success: false,
+		Error: &APIError{
+			Code:    code,
+			Message: message,
+			Details: details,
+		},
+	}
+}
+
+// NewPaginatedResponse creates a paginated response
+func NewPaginatedResponse(data interface{}, total, page, limit int) *PaginatedResponse {
+	totalPages := (total + limit - 1) / limit
+	if totalPages < 1 {
+		totalPages = 1
+	}
+
+	return &PaginatedResponse{
+		Data:       data,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/example/golden/internal/application/auth"
+	"github.com/example/golden/internal/infrastructure/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// AuthGinHandlers handles authentication HTTP requests using Gin framework
+type AuthGinHandlers struct {
+	authService *auth.Service
+	logger      *logger.Logger
+}
+
+// NewAuthGinHandlers creates a new auth Gin handlers instance
+func NewAuthGinHandlers(
+	authSvc *auth.Service,
+	log *logger.Logger,
+) *AuthGinHandlers {
+	return &AuthGinHandlers{
+		authService: authSvc,
+		logger:      log,
+	}
+}
+
+// RegisterRoutes registers all authentication routes
+func (h *AuthGinHandlers) RegisterRoutes(r *gin.Engine) {
+	api := r.Group("/api/v1")
+	authGroup := api.Group("/auth")
+	{
+		authGroup.POST("/login", h.Login)
+		authGroup.POST("/register", h.Register)
+		authGroup.POST("/refresh", h.RefreshToken)
+		authGroup.POST("/logout", h.Logout)
+		authGroup.POST("/logout-all", h.LogoutAll)
+	}
+}
+
+// Login handles user login
+func (h *AuthGinHandlers) Login(c *gin.Context) {
+	var req auth.LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind login request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	cmd := req.ToLoginCommand()
+	result, err := h.authService.HandleLogin(c.Request.Context(), cmd)
+	if err != nil {
+		h.logger.Error("Login failed", "error", err, "email", req.Email)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid credentials"})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// Register handles user registration
+func (h *AuthGinHandlers) Register(c *gin.Context) {
+	var req auth.RegisterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind register request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	cmd := req.ToRegisterCommand()
+	result, err := h.authService.HandleRegister(c.Request.Context(), cmd)
+	if err != nil {
+		h.logger.Error("Registration failed", "error", err, "name", req.Name, "email", req.Email)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Registration failed"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// RefreshToken handles token refresh
+func (h *AuthGinHandlers) RefreshToken(c *gin.Context) {
+	var req auth.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind refresh token request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	cmd := req.ToRefreshTokenCommand()
+	result, err := h.authService.HandleRefreshToken(c.Request.Context(), cmd)
+	if err != nil {
+		h.logger.Error("Token refresh failed", "error", err)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// Logout handles user logout, revoking the session of the refresh token
+func (h *AuthGinHandlers) Logout(c *gin.Context) {
+	var req auth.LogoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind logout request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.authService.HandleLogout(c.Request.Context(), req.ToLogoutCommand()); err != nil {
+		h.logger.Error("Logout failed", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Logout failed"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Logged out successfully"})
+}
+
+// LogoutAll logs the authenticated user out on all devices
+func (h *AuthGinHandlers) LogoutAll(c *gin.Context) {
+	user, err := h.authService.Authenticate(c.Request.Context(), bearerToken(c.GetHeader("Authorization")))
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	if err := h.authService.HandleLogoutAll(c.Request.Context(), auth.LogoutAllCommand{UserID: user.ID()}); err != nil {
+		h.logger.Error("Logout on all devices failed", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Logout failed"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Logged out on all devices"})
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer <token>" header
+func bearerToken(header string) string {
+	if !strings.HasPrefix(header, "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+}
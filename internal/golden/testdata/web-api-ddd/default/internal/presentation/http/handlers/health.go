@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/infrastructure/logger"
+)
+
+// HealthHandler handles health check requests
+type HealthHandler struct {
+	clock     clock.Clock
+	startedAt time.Time
+	logger    *logger.Logger
+}
+
+// NewHealthHandler creates a new health handler telling the time and uptime by clk
+func NewHealthHandler(clk clock.Clock, log *logger.Logger) *HealthHandler {
+	return &HealthHandler{
+		clock:     clk,
+		startedAt: clk.Now(),
+		logger:    log,
+	}
+}
+
+// HealthResponse represents the health check response
+type HealthResponse struct {
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+	Version   string    `json:"version,omitempty"`
+	Uptime    string    `json:"uptime,omitempty"`
+}
+
+// ReadinessResponse represents the readiness check response
+type ReadinessResponse struct {
+	Status    string            `json:"status"`
+	Timestamp time.Time         `json:"timestamp"`
+	Checks    map[string]string `json:"checks"`
+	Version   string            `json:"version,omitempty"`
+}
+
+// Health handles health check requests (Gin compatible)
+func (h *HealthHandler) Health(w http.ResponseWriter, r *http.Request) {
+	now := h.clock.Now()
+	response := HealthResponse{
+		Status:    "ok",
+		Timestamp: now,
+		Version:   "1.0.0", // TODO: Get from build info
+		Uptime:    now.Sub(h.startedAt).String(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+// Ready handles readiness check requests (Gin compatible)
+func (h *HealthHandler) Ready(w http.ResponseWriter, r *http.Request) {
+	checks := make(map[string]string)
+	status := "ready"
+
+	// Add database check if configured
+
+	// TODO: Add actual database connectivity check
+	checks["database"] = "ok"
+
+	// Add any other service checks here
+	checks["memory"] = "ok"
+	checks["disk"] = "ok"
+
+	response := ReadinessResponse{
+		Status:    status,
+		Timestamp: h.clock.Now(),
+		Checks:    checks,
+		Version:   "1.0.0", // TODO: Get from build info
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/example/golden/internal/application/user"
+	"github.com/example/golden/internal/infrastructure/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// GinHandlers handles HTTP requests using Gin framework
+type GinHandlers struct {
+	commandHandlers *user.CommandHandlers
+	queryHandlers   *user.QueryHandlers
+	logger          *logger.Logger
+}
+
+// NewGinHandlers creates a new Gin handlers instance
+func NewGinHandlers(
+	commandHandlers *user.CommandHandlers,
+	queryHandlers *user.QueryHandlers,
+	log *logger.Logger,
+) *GinHandlers {
+	return &GinHandlers{
+		commandHandlers: commandHandlers,
+		queryHandlers:   queryHandlers,
+		logger:          log,
+	}
+}
+
+// RegisterRoutes registers all routes for the user domain
+func (h *GinHandlers) RegisterRoutes(r *gin.Engine) {
+	api := r.Group("/api/v1")
+	userGroup := api.Group("/users")
+	{
+		userGroup.POST("", h.CreateUser)
+		userGroup.GET("/:id", h.GetUserByID)
+		userGroup.PUT("/:id", h.UpdateUser)
+		userGroup.DELETE("/:id", h.DeleteUser)
+		userGroup.GET("", h.ListUsers)
+		userGroup.GET("/name/:name", h.GetUserByName)
+		userGroup.GET("/email/:email", h.GetUserByEmail)
+	}
+}
+
+// CreateUser handles creating a new user
+func (h *GinHandlers) CreateUser(c *gin.Context) {
+	var req user.CreateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	cmd := req.ToCommand()
+	userDTO, err := h.commandHandlers.HandleCreateUser(c.Request.Context(), cmd)
+	if err != nil {
+		h.logger.Error("Failed to create user", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create user"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, userDTO)
+}
+
+// GetUserByID handles getting a user by ID
+func (h *GinHandlers) GetUserByID(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+		return
+	}
+
+	query := user.GetUserByIDQuery{ID: id}
+	userDTO, err := h.queryHandlers.HandleGetUserByID(c.Request.Context(), query)
+	if err != nil {
+		h.logger.Error("Failed to get user", "error", err, "id", id)
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, userDTO)
+}
+
+// GetUserByName handles getting a user by name
+func (h *GinHandlers) GetUserByName(c *gin.Context) {
+	name := c.Param("name")
+	if name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Name is required"})
+		return
+	}
+
+	query := user.GetUserByNameQuery{Name: name}
+	userDTO, err := h.queryHandlers.HandleGetUserByName(c.Request.Context(), query)
+	if err != nil {
+		h.logger.Error("Failed to get user by name", "error", err, "name", name)
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, userDTO)
+}
+
+// GetUserByEmail handles getting a user by email
+func (h *GinHandlers) GetUserByEmail(c *gin.Context) {
+	email := c.Param("email")
+	if email == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Email is required"})
+		return
+	}
+
+	query := user.GetUserByEmailQuery{Email: email}
+	userDTO, err := h.queryHandlers.HandleGetUserByEmail(c.Request.Context(), query)
+	if err != nil {
+		h.logger.Error("Failed to get user by email", "error", err, "email", email)
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, userDTO)
+}
+
+// UpdateUser handles updating a user
+func (h *GinHandlers) UpdateUser(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+		return
+	}
+
+	var req user.UpdateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	cmd := req.ToCommand(id)
+	userDTO, err := h.commandHandlers.HandleUpdateUser(c.Request.Context(), cmd)
+	if err != nil {
+		h.logger.Error("Failed to update user", "error", err, "id", id)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update user"})
+		return
+	}
+
+	c.JSON(http.StatusOK, userDTO)
+}
+
+// DeleteUser handles deleting a user
+func (h *GinHandlers) DeleteUser(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+		return
+	}
+
+	cmd := user.DeleteUserCommand{ID: id}
+	err := h.commandHandlers.HandleDeleteUser(c.Request.Context(), cmd)
+	if err != nil {
+		h.logger.Error("Failed to delete user", "error", err, "id", id)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete user"})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// ListUsers handles listing users with pagination
+func (h *GinHandlers) ListUsers(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	query := user.ListUsersQuery{
+		Page:  page,
+		Limit: limit,
+	}
+
+	userList, err := h.queryHandlers.HandleListUsers(c.Request.Context(), query)
+	if err != nil {
+		h.logger.Error("Failed to list users", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list users"})
+		return
+	}
+
+	c.JSON(http.StatusOK, userList)
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+)
+
+// CORS provides CORS support for Gin
+func CORS() gin.HandlerFunc {
+	config := cors.DefaultConfig()
+	config.AllowOrigins = []string{"*"}
+	config.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
+	config.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With"}
+	config.AllowCredentials = true
+
+	return cors.New(config)
+}
+
+// CORSMiddleware provides CORS support (legacy function)
+func CORSMiddleware() gin.HandlerFunc {
+	return CORS()
+}
@@ -0,0 +1,121 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/example/golden/internal/infrastructure/logger"
+	"github.com/example/golden/internal/shared/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// ErrorResponse represents a standardized error response
+type ErrorResponse struct {
+	Error     string         `json:"error"`
+	Code      string         `json:"code,omitempty"`
+	RequestID string         `json:"request_id,omitempty"`
+	Details   map[string]any `json:"details,omitempty"`
+}
+
+// ErrorHandler handles errors in a secure and consistent way
+func ErrorHandler(logger *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		// Check if there are any errors
+		if len(c.Errors) > 0 {
+			err := c.Errors.Last().Err
+			requestID := GetRequestID(c)
+
+			// Log the error with request ID
+			logger.Error("Request error",
+				"error", err,
+				"request_id", requestID,
+				"path", c.Request.URL.Path,
+				"method", c.Request.Method,
+			)
+
+			// Handle different error types
+			switch e := err.(type) {
+			case errors.ApplicationError:
+				handleApplicationError(c, e, requestID)
+			case errors.DomainError:
+				handleDomainError(c, e, requestID)
+			default:
+				// Generic error - don't expose internal details
+				c.JSON(http.StatusInternalServerError, ErrorResponse{
+					Error:     "An internal error occurred",
+					Code:      "INTERNAL_ERROR",
+					RequestID: requestID,
+				})
+			}
+		}
+	}
+}
+
+func handleApplicationError(c *gin.Context, err errors.ApplicationError, requestID string) {
+	statusCode := http.StatusBadRequest
+
+	// Map error codes to HTTP status codes
+	switch err.Code {
+	case "VALIDATION_ERROR", "INVALID_INPUT", "MISSING_REQUIRED_FIELD":
+		statusCode = http.StatusBadRequest
+	case "AUTHENTICATION_ERROR", "INVALID_CREDENTIALS":
+		statusCode = http.StatusUnauthorized
+	case "UNAUTHORIZED", "FORBIDDEN":
+		statusCode = http.StatusForbidden
+	case "RESOURCE_NOT_FOUND":
+		statusCode = http.StatusNotFound
+	case "RESOURCE_CONFLICT":
+		statusCode = http.StatusConflict
+	case "RESOURCE_LOCKED":
+		statusCode = http.StatusLocked
+	default:
+		statusCode = http.StatusInternalServerError
+	}
+
+	response := ErrorResponse{
+		Error:     err.Message,
+		Code:      err.Code,
+		RequestID: requestID,
+	}
+
+	// Only include details in non-production environments
+	if gin.Mode() != gin.ReleaseMode && err.Details != nil {
+		response.Details = err.Details
+	}
+
+	c.JSON(statusCode, response)
+}
+
+func handleDomainError(c *gin.Context, err errors.DomainError, requestID string) {
+	statusCode := http.StatusBadRequest
+
+	// Map domain error codes to HTTP status codes
+	switch err.Code {
+	case "ENTITY_NOT_FOUND", "USER_NOT_FOUND":
+		statusCode = http.StatusNotFound
+	case "ENTITY_ALREADY_EXISTS":
+		statusCode = http.StatusConflict
+	case "CONCURRENCY_CONFLICT", "AGGREGATE_VERSION_MISMATCH":
+		statusCode = http.StatusConflict
+	case "INVALID_VALUE_OBJECT", "INVALID_EMAIL", "INVALID_ID", "INVALID_ENTITY_STATE":
+		statusCode = http.StatusBadRequest
+	case "BUSINESS_RULE_VIOLATION", "DOMAIN_INVARIANT_VIOLATION":
+		statusCode = http.StatusUnprocessableEntity
+	default:
+		statusCode = http.StatusInternalServerError
+	}
+
+	response := ErrorResponse{
+		Error:     err.Message,
+		Code:      err.Code,
+		RequestID: requestID,
+	}
+
+	// Only include details in non-production environments
+	if gin.Mode() != gin.ReleaseMode && err.Details != nil {
+		response.Details = err.Details
+	}
+
+	c.JSON(statusCode, response)
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"github.com/example/golden/internal/infrastructure/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// GinLogger creates a Gin middleware for structured logging
+func GinLogger(log *logger.Logger) gin.HandlerFunc {
+	return gin.LoggerWithConfig(gin.LoggerConfig{
+		Formatter: func(param gin.LogFormatterParams) string {
+			log.Info("HTTP Request",
+				"method", param.Method,
+				"path", param.Path,
+				"status", param.StatusCode,
+				"latency", param.Latency,
+				"client_ip", param.ClientIP,
+				"user_agent", param.Request.UserAgent(),
+			)
+			return ""
+		},
+		SkipPaths: []string{"/health"},
+	})
+}
+
+// LoggingMiddleware provides request logging (legacy function)
+func LoggingMiddleware() gin.HandlerFunc {
+	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
+		return ""
+	})
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// RequestIDKey is the key used to store request ID in context
+	RequestIDKey = "request-id"
+	// RequestIDHeader is the header name for request ID
+	RequestIDHeader = "X-Request-ID"
+)
+
+// RequestID middleware adds a unique request ID to each request
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Check if request ID exists in header
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" {
+			// Generate new UUID
+			requestID = uuid.New().String()
+		}
+
+		// Set request ID in context and header
+		c.Set(RequestIDKey, requestID)
+		c.Header(RequestIDHeader, requestID)
+
+		c.Next()
+	}
+}
+
+// GetRequestID retrieves the request ID from the context
+func GetRequestID(c *gin.Context) string {
+	if requestID, exists := c.Get(RequestIDKey); exists {
+		if id, ok := requestID.(string); ok {
+			return id
+		}
+	}
+	return ""
+}
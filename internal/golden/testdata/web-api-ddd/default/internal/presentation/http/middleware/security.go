@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SecurityHeaders adds security headers to responses
+func SecurityHeaders() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Security headers
+		c.Header("X-Content-Type-Options", "nosniff")
+		c.Header("X-Frame-Options", "DENY")
+		c.Header("X-XSS-Protection", "1; mode=block")
+		c.Header("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
+		c.Header("Referrer-Policy", "strict-origin-when-cross-origin")
+		c.Header("Content-Security-Policy", "default-src 'self'; script-src 'self'; style-src 'self' 'unsafe-inline'; img-src 'self' data: https:; font-src 'self'; connect-src 'self'; frame-ancestors 'none';")
+		c.Header("Permissions-Policy", "geolocation=(), microphone=(), camera=()")
+
+		// Remove server information
+		c.Header("X-Powered-By", "")
+
+		c.Next()
+	}
+}
+
+// RateLimiter provides basic rate limiting (implement with proper rate limiter in production)
+func RateLimiter() gin.HandlerFunc {
+	// This is a placeholder - use a proper rate limiter like golang.org/x/time/rate
+	// or github.com/ulule/limiter in production
+	return func(c *gin.Context) {
+		// Basic implementation would go here
+		c.Next()
+	}
+}
+
+// ValidateContentType ensures requests have appropriate content types
+func ValidateContentType() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Skip validation for GET, DELETE, HEAD, OPTIONS
+		if c.Request.Method == http.MethodGet ||
+			c.Request.Method == http.MethodDelete ||
+			c.Request.Method == http.MethodHead ||
+			c.Request.Method == http.MethodOptions {
+			c.Next()
+			return
+		}
+
+		contentType := c.GetHeader("Content-Type")
+		if contentType == "" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Content-Type header is required",
+			})
+			c.Abort()
+			return
+		}
+
+		// Check for valid content types
+		validTypes := []string{"application/json", "application/x-www-form-urlencoded", "multipart/form-data"}
+		valid := false
+		for _, validType := range validTypes {
+			if strings.HasPrefix(strings.ToLower(contentType), validType) {
+				valid = true
+				break
+			}
+		}
+
+		if !valid {
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{
+				"error": "Unsupported content type",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
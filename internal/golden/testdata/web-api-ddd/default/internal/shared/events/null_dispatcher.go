@@ -0,0 +1,25 @@
+package events
+
+// NullEventDispatcher is a no-op event dispatcher for simplified implementations
+type NullEventDispatcher struct{}
+
+// NewNullEventDispatcher creates a new null event dispatcher
+func NewNullEventDispatcher() EventDispatcher {
+	return &NullEventDispatcher{}
+}
+
+// Dispatch does nothing (no-op implementation)
+func (d *NullEventDispatcher) Dispatch(event DomainEvent) error {
+	// No-op: in a full implementation, this would dispatch events to handlers
+	return nil
+}
+
+// Register does nothing (no-op implementation)
+func (d *NullEventDispatcher) Register(eventType string, handler EventHandler) {
+	// No-op: in a full implementation, this would register event handlers
+}
+
+// Unregister does nothing (no-op implementation)
+func (d *NullEventDispatcher) Unregister(eventType string, handler EventHandler) {
+	// No-op: in a full implementation, this would unregister event handlers
+}
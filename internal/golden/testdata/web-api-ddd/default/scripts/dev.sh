@@ -0,0 +1,15 @@
+#!/bin/bash
+
+set -e
+
+# Load environment variables from .env file
+if [ -f .env ]; then
+    export $(cat .env | grep -v '#' | awk '/./ {print $1}')
+fi
+
+# Build the application
+echo "Building application..."
+go build -o bin/server ./cmd/server
+
+echo "Starting development server..."
+./bin/server
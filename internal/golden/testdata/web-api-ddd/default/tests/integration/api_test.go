@@ -0,0 +1,61 @@
+package integration
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthEndpoint(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+
+	// Add health endpoint
+	router.GET("/health", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"status":  "healthy",
+			"service": "golden",
+			"version": "1.0.0",
+		})
+	})
+
+	w := httptest.NewRecorder()
+	req, err := http.NewRequest("GET", "/health", nil)
+	require.NoError(t, err)
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "healthy")
+	assert.Contains(t, w.Body.String(), "golden")
+}
+
+func TestReadinessEndpoint(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+
+	// Add readiness endpoint
+	router.GET("/ready", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"status": "ready",
+			"checks": gin.H{
+				"database": "ok",
+			},
+		})
+	})
+
+	w := httptest.NewRecorder()
+	req, err := http.NewRequest("GET", "/ready", nil)
+	require.NoError(t, err)
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "ready")
+}
@@ -0,0 +1,66 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"database/sql"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/example/golden/internal/infrastructure/logger"
+)
+
+// setupTestDatabase creates a PostgreSQL testcontainer and returns the database connection
+func setupTestDatabase(t *testing.T) (*sql.DB, func()) {
+	ctx := context.Background()
+
+	// Create PostgreSQL container
+	postgresContainer, err := postgres.RunContainer(ctx,
+		testcontainers.WithImage("postgres:15-alpine"),
+		postgres.WithDatabase("testdb"),
+		postgres.WithUsername("testuser"),
+		postgres.WithPassword("testpass"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(60*time.Second),
+		),
+	)
+	require.NoError(t, err)
+
+	// Get connection details
+	host, err := postgresContainer.Host(ctx)
+	require.NoError(t, err)
+
+	port, err := postgresContainer.MappedPort(ctx, "5432")
+	require.NoError(t, err)
+	// Create DSN for standard SQL
+	dsn := fmt.Sprintf("postgres://testuser:testpass@%s:%d/testdb?sslmode=disable",
+		host, port.Int())
+
+	// Connect with standard SQL
+	db, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	require.NoError(t, db.Ping())
+	// Return cleanup function
+	cleanup := func() {
+		db.Close()
+		if err := postgresContainer.Terminate(ctx); err != nil {
+			t.Logf("failed to terminate container: %s", err)
+		}
+	}
+
+	return db, cleanup
+}
+
+// createTestLogger creates a logger for testing
+func createTestLogger() *logger.Logger {
+	return logger.New()
+}
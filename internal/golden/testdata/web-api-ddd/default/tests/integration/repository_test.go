@@ -0,0 +1,130 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/domain/user"
+	"github.com/example/golden/internal/infrastructure/persistence"
+)
+
+func TestIntegration_UserRepository(t *testing.T) {
+	// Setup database with testcontainers (ORM-specific)
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	// Create logger
+	logger := createTestLogger()
+
+	// Create repository using the ORM-specific database connection
+	repo := persistence.NewUserRepository(db, logger)
+	ctx := context.Background()
+
+	// Test Save and FindByID
+	entity, err := user.NewUser("test-user", "test@example.com", "Test user for repository", time.Now())
+	require.NoError(t, err)
+
+	err = repo.Save(ctx, entity)
+	require.NoError(t, err)
+	assert.NotEmpty(t, entity.ID().String())
+
+	foundUser, err := repo.FindByID(ctx, entity.ID())
+	require.NoError(t, err)
+	assert.NotNil(t, foundUser)
+	assert.Equal(t, entity.ID(), foundUser.ID())
+	assert.Equal(t, entity.Name(), foundUser.Name())
+
+	// Test FindByName
+	foundUser, err = repo.FindByName(ctx, entity.Name())
+	require.NoError(t, err)
+	assert.NotNil(t, foundUser)
+	assert.Equal(t, entity.ID(), foundUser.ID())
+
+	// Test Update
+	err = entity.UpdateDescription("Updated description", time.Now())
+	require.NoError(t, err)
+	err = repo.Save(ctx, entity)
+	require.NoError(t, err)
+
+	foundUser, err = repo.FindByID(ctx, entity.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "Updated description", foundUser.Description())
+
+	// Test Delete
+	err = repo.Delete(ctx, entity.ID())
+	require.NoError(t, err)
+
+	foundUser, err = repo.FindByID(ctx, entity.ID())
+	assert.Error(t, err)
+	assert.Nil(t, foundUser)
+}
+
+func TestIntegration_UserRepository_Parallel(t *testing.T) {
+	// Test that multiple tests can run in parallel with testcontainers
+	t.Parallel()
+
+	// Setup database with testcontainers
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	// Create logger
+	logger := createTestLogger()
+
+	// Create repository
+	repo := persistence.NewUserRepository(db, logger)
+	ctx := context.Background()
+
+	// Create multiple entities to test parallel execution
+	for i := 0; i < 5; i++ {
+		entity, err := user.NewUser(
+			fmt.Sprintf("parallel-test-%d", i),
+			fmt.Sprintf("test%d@example.com", i),
+			fmt.Sprintf("Test user %d for parallel testing", i),
+			time.Now(),
+		)
+		require.NoError(t, err)
+
+		err = repo.Save(ctx, entity)
+		require.NoError(t, err)
+
+		// Verify entity was saved
+		found, err := repo.FindByID(ctx, entity.ID())
+		require.NoError(t, err)
+		assert.Equal(t, entity.Name(), found.Name())
+	}
+}
+
+func TestIntegration_UserRepository_Isolation(t *testing.T) {
+	// Test that each test gets a clean database state
+	t.Parallel()
+
+	// Setup database with testcontainers
+	db, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	// Create logger
+	logger := createTestLogger()
+
+	// Create repository
+	repo := persistence.NewUserRepository(db, logger)
+	ctx := context.Background()
+
+	// Create test entity
+	entity, err := user.NewUser("isolation-test", "isolation@example.com", "Test isolation", time.Now())
+	require.NoError(t, err)
+
+	// Save entity
+	err = repo.Save(ctx, entity)
+	require.NoError(t, err)
+
+	// Verify only this entity exists (proving isolation)
+	all, err := repo.FindAll(ctx, 0, 100)
+	require.NoError(t, err)
+	assert.Len(t, all, 1, "Each test should start with a clean database")
+	assert.Equal(t, entity.ID(), all[0].ID())
+}
@@ -0,0 +1,94 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/example/golden/internal/domain/user"
+	"github.com/example/golden/internal/shared/errors"
+	"github.com/example/golden/internal/shared/valueobjects"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeUserRepository is an in-memory user.Repository for the application tests
+type fakeUserRepository struct {
+	users map[string]*user.User
+}
+
+func newFakeUserRepository(users ...*user.User) *fakeUserRepository {
+	repo := &fakeUserRepository{users: make(map[string]*user.User)}
+	for _, entity := range users {
+		repo.users[entity.ID().String()] = entity
+	}
+	return repo
+}
+
+func (r *fakeUserRepository) Save(ctx context.Context, entity *user.User) error {
+	r.users[entity.ID().String()] = entity
+	return nil
+}
+
+func (r *fakeUserRepository) FindByID(ctx context.Context, id valueobjects.ID) (*user.User, error) {
+	entity, ok := r.users[id.String()]
+	if !ok {
+		return nil, errors.ErrUserNotFound
+	}
+	return entity, nil
+}
+
+func (r *fakeUserRepository) FindByName(ctx context.Context, name string) (*user.User, error) {
+	return nil, errors.ErrUserNotFound
+}
+
+func (r *fakeUserRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
+	for _, entity := range r.users {
+		if entity.Email().Value() == email {
+			return entity, nil
+		}
+	}
+	return nil, errors.ErrUserNotFound
+}
+
+func (r *fakeUserRepository) FindAll(ctx context.Context, offset, limit int) ([]*user.User, error) {
+	return nil, nil
+}
+
+func (r *fakeUserRepository) FindByStatus(ctx context.Context, status user.Status, offset, limit int) ([]*user.User, error) {
+	return nil, nil
+}
+
+func (r *fakeUserRepository) Count(ctx context.Context) (int, error) {
+	return len(r.users), nil
+}
+
+func (r *fakeUserRepository) CountByStatus(ctx context.Context, status user.Status) (int, error) {
+	return 0, nil
+}
+
+func (r *fakeUserRepository) Delete(ctx context.Context, id valueobjects.ID) error {
+	delete(r.users, id.String())
+	return nil
+}
+
+func (r *fakeUserRepository) Exists(ctx context.Context, id valueobjects.ID) (bool, error) {
+	_, ok := r.users[id.String()]
+	return ok, nil
+}
+
+func (r *fakeUserRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
+	return false, nil
+}
+
+func (r *fakeUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	return false, nil
+}
+
+// testNow is the time the application tests start at
+var testNow = time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+
+func newTestUser(t *testing.T, name, email string) *user.User {
+	entity, err := user.NewUser(name, email, "", testNow)
+	require.NoError(t, err)
+	return entity
+}
@@ -0,0 +1,222 @@
+package application_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/example/golden/internal/application/auth"
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/domain/refreshtoken"
+	"github.com/example/golden/internal/infrastructure/logger"
+	"github.com/example/golden/internal/shared/errors"
+	"github.com/example/golden/internal/shared/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRefreshTokenRepository is an in-memory refreshtoken.Repository for the refresh token tests
+type fakeRefreshTokenRepository struct {
+	mu     sync.Mutex
+	tokens map[string]*refreshtoken.Token
+}
+
+func newFakeRefreshTokenRepository() *fakeRefreshTokenRepository {
+	return &fakeRefreshTokenRepository{tokens: make(map[string]*refreshtoken.Token)}
+}
+
+func (r *fakeRefreshTokenRepository) Save(ctx context.Context, token *refreshtoken.Token) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tokens[token.Hash()] = token
+	return nil
+}
+
+func (r *fakeRefreshTokenRepository) FindByHash(ctx context.Context, hash string) (*refreshtoken.Token, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	token, ok := r.tokens[hash]
+	if !ok {
+		return nil, errors.ErrRefreshTokenNotFound
+	}
+	return token, nil
+}
+
+func (r *fakeRefreshTokenRepository) MarkRotated(ctx context.Context, hash string, at time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	token, ok := r.tokens[hash]
+	if !ok || token.IsRotated() || token.IsRevoked() {
+		return false, nil
+	}
+	r.tokens[hash] = refreshtoken.Reconstruct(token.Hash(), token.FamilyID(), token.UserID(), token.IssuedAt(), token.ExpiresAt(), &at, nil)
+	return true, nil
+}
+
+func (r *fakeRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string, at time.Time) error {
+	r.revokeWhere(at, func(token *refreshtoken.Token) bool { return token.FamilyID() == familyID })
+	return nil
+}
+
+func (r *fakeRefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID valueobjects.ID, at time.Time) error {
+	r.revokeWhere(at, func(token *refreshtoken.Token) bool { return token.UserID().Equals(userID) })
+	return nil
+}
+
+func (r *fakeRefreshTokenRepository) revokeWhere(at time.Time, match func(*refreshtoken.Token) bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for hash, token := range r.tokens {
+		if !token.IsRevoked() && match(token) {
+			r.tokens[hash] = refreshtoken.Reconstruct(token.Hash(), token.FamilyID(), token.UserID(), token.IssuedAt(), token.ExpiresAt(), token.RotatedAt(), &at)
+		}
+	}
+}
+
+func newRefreshTokenTestService(t *testing.T, ttl time.Duration) (*auth.Service, *fakeRefreshTokenRepository) {
+	return newRefreshTokenTestServiceAt(t, ttl, clock.NewFake(testNow))
+}
+
+// newRefreshTokenTestServiceAt returns an auth service telling the time by clk
+func newRefreshTokenTestServiceAt(t *testing.T, ttl time.Duration, clk clock.Clock) (*auth.Service, *fakeRefreshTokenRepository) {
+	repo := newFakeUserRepository(newTestUser(t, "Ada Lovelace", "ada@example.com"))
+	refreshTokens := newFakeRefreshTokenRepository()
+	return auth.NewAuthService(repo, refreshTokens, ttl, clk, logger.New()), refreshTokens
+}
+
+func loginForTest(t *testing.T, service *auth.Service) *auth.AuthResult {
+	result, err := service.HandleLogin(context.Background(), auth.LoginCommand{Email: "ada@example.com", Password: "password123"})
+	require.NoError(t, err)
+	return result
+}
+
+func refresh(service *auth.Service, token string) (*auth.AuthResult, error) {
+	return service.HandleRefreshToken(context.Background(), auth.RefreshTokenCommand{RefreshToken: token})
+}
+
+func TestRefreshToken_RotatesOnUse(t *testing.T) {
+	service, refreshTokens := newRefreshTokenTestService(t, time.Hour)
+	login := loginForTest(t, service)
+
+	// Only the hash of the token is stored
+	_, stored := refreshTokens.tokens[login.RefreshToken]
+	assert.False(t, stored)
+
+	first, err := refresh(service, login.RefreshToken)
+	require.NoError(t, err)
+	assert.NotEqual(t, login.RefreshToken, first.RefreshToken)
+	assert.Equal(t, login.UserID, first.UserID)
+
+	second, err := refresh(service, first.RefreshToken)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.RefreshToken, second.RefreshToken)
+
+	// All the tokens of a session share a family
+	original, err := refreshTokens.FindByHash(context.Background(), refreshtoken.Hash(login.RefreshToken))
+	require.NoError(t, err)
+	latest, err := refreshTokens.FindByHash(context.Background(), refreshtoken.Hash(second.RefreshToken))
+	require.NoError(t, err)
+	assert.Equal(t, original.FamilyID(), latest.FamilyID())
+	assert.True(t, original.IsRotated())
+	assert.False(t, latest.IsRotated())
+}
+
+func TestRefreshToken_ReuseRevokesTheSession(t *testing.T) {
+	service, _ := newRefreshTokenTestService(t, time.Hour)
+	login := loginForTest(t, service)
+
+	rotated, err := refresh(service, login.RefreshToken)
+	require.NoError(t, err)
+
+	// Replaying the spent token is rejected...
+	_, err = refresh(service, login.RefreshToken)
+	assert.Error(t, err)
+
+	// ...and revokes the token issued in its place
+	_, err = refresh(service, rotated.RefreshToken)
+	assert.Error(t, err)
+
+	// Other sessions are not affected
+	other := loginForTest(t, service)
+	_, err = refresh(service, other.RefreshToken)
+	assert.NoError(t, err)
+}
+
+func TestRefreshToken_RejectsUnknownAndExpiredTokens(t *testing.T) {
+	service, _ := newRefreshTokenTestService(t, time.Hour)
+
+	_, err := refresh(service, "not-a-token")
+	assert.Error(t, err)
+
+	_, err = refresh(service, "")
+	assert.Error(t, err)
+
+	fake := clock.NewFake(testNow)
+	expiring, _ := newRefreshTokenTestServiceAt(t, time.Hour, fake)
+	login := loginForTest(t, expiring)
+	fake.Advance(time.Hour + time.Second)
+	_, err = refresh(expiring, login.RefreshToken)
+	assert.Error(t, err)
+}
+
+func TestRefreshToken_LogoutRevokesOneSession(t *testing.T) {
+	service, _ := newRefreshTokenTestService(t, time.Hour)
+	phone := loginForTest(t, service)
+	laptop := loginForTest(t, service)
+
+	rotated, err := refresh(service, phone.RefreshToken)
+	require.NoError(t, err)
+
+	require.NoError(t, service.HandleLogout(context.Background(), auth.LogoutCommand{RefreshToken: rotated.RefreshToken}))
+	_, err = refresh(service, rotated.RefreshToken)
+	assert.Error(t, err)
+
+	_, err = refresh(service, laptop.RefreshToken)
+	assert.NoError(t, err)
+
+	// Logging out again, or with an unknown token, is not an error
+	assert.NoError(t, service.HandleLogout(context.Background(), auth.LogoutCommand{RefreshToken: rotated.RefreshToken}))
+	assert.NoError(t, service.HandleLogout(context.Background(), auth.LogoutCommand{RefreshToken: "not-a-token"}))
+}
+
+func TestRefreshToken_LogoutAllRevokesEverySession(t *testing.T) {
+	service, _ := newRefreshTokenTestService(t, time.Hour)
+	phone := loginForTest(t, service)
+	laptop := loginForTest(t, service)
+
+	require.NoError(t, service.HandleLogoutAll(context.Background(), auth.LogoutAllCommand{UserID: phone.UserID}))
+
+	_, err := refresh(service, phone.RefreshToken)
+	assert.Error(t, err)
+	_, err = refresh(service, laptop.RefreshToken)
+	assert.Error(t, err)
+
+	// Logging in again starts a fresh session
+	again := loginForTest(t, service)
+	_, err = refresh(service, again.RefreshToken)
+	assert.NoError(t, err)
+}
+
+func TestRefreshToken_ConcurrentRefreshesSpendTheTokenOnce(t *testing.T) {
+	service, _ := newRefreshTokenTestService(t, time.Hour)
+	login := loginForTest(t, service)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded := 0
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := refresh(service, login.RefreshToken); err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, succeeded, 1)
+}
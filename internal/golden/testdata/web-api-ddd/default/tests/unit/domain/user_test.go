@@ -0,0 +1,26 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/example/golden/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUser_NewUser(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+	entity, err := user.NewUser("test-user", "test@example.com", "Test user description", createdAt)
+	assert.NoError(t, err)
+	assert.NotNil(t, entity)
+	assert.NotEmpty(t, entity.ID().String())
+	assert.Equal(t, "test-user", entity.Name())
+	assert.Equal(t, "test@example.com", entity.Email())
+	assert.Equal(t, "Test user description", entity.Description())
+	assert.Equal(t, user.StatusActive, entity.Status())
+
+	// The entity is given the time, so its age is checked without waiting
+	assert.Equal(t, createdAt, entity.CreatedAt())
+	assert.True(t, entity.IsNewAccount(createdAt.Add(6*24*time.Hour)))
+	assert.False(t, entity.IsNewAccount(createdAt.Add(8*24*time.Hour)))
+}
@@ -0,0 +1,371 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/example/golden/internal/openapi"
+)
+
+// maxSchemaDepth bounds the nesting of generated bodies, so recursive schemas stay finite
+const maxSchemaDepth = 8
+
+// route is an operation of the spec with its path template split into segments
+type route struct {
+	method    string
+	template  string
+	segments  []string
+	operation *openapi.Operation
+}
+
+// match reports whether the request path segments fit the template, {name} matching any segment
+func (r route) match(segments []string) bool {
+	if len(segments) != len(r.segments) {
+		return false
+	}
+	for i, segment := range r.segments {
+		if !isParameter(segment) && segment != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// literals counts the segments of the template that are not parameters
+func (r route) literals() int {
+	count := 0
+	for _, segment := range r.segments {
+		if !isParameter(segment) {
+			count++
+		}
+	}
+	return count
+}
+
+// MockServer answers every operation of a spec with the examples of the spec,
+// or with bodies generated from its schemas when the spec has no example
+type MockServer struct {
+	spec     *openapi.Spec
+	basePath string
+	routes   []route
+}
+
+// NewMockServer creates a MockServer serving the operations of spec
+func NewMockServer(spec *openapi.Spec) *MockServer {
+	m := &MockServer{spec: spec, basePath: spec.BasePath()}
+	for template, item := range spec.Paths {
+		for method, operation := range item.Operations() {
+			m.routes = append(m.routes, route{
+				method:    method,
+				template:  template,
+				segments:  splitPath(template),
+				operation: operation,
+			})
+		}
+	}
+
+	// /users/me must win over /users/{id}, so templates with more literal segments come first
+	sort.Slice(m.routes, func(i, j int) bool {
+		a, b := m.routes[i], m.routes[j]
+		if a.literals() != b.literals() {
+			return a.literals() > b.literals()
+		}
+		if a.template != b.template {
+			return a.template < b.template
+		}
+		return a.method < b.method
+	})
+	return m
+}
+
+// Routes lists the served operations as "METHOD /path"
+func (m *MockServer) Routes() []string {
+	routes := make([]string, 0, len(m.routes))
+	for _, r := range m.routes {
+		routes = append(routes, r.method+" "+m.basePath+r.template)
+	}
+	sort.Strings(routes)
+	return routes
+}
+
+// ServeHTTP answers the request with a response of the matching operation
+//
+// The Prefer header picks the response as with Prism: "Prefer: code=404" answers the 404
+// response of the operation and "Prefer: example=name" one of its named examples
+func (m *MockServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Frontends run on another origin during development
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Headers", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+	if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, m.basePath) {
+		writeError(w, http.StatusNotFound, "no operation of the spec matches "+r.URL.Path, nil)
+		return
+	}
+	operation, allowed := m.find(r.Method, splitPath(strings.TrimPrefix(r.URL.Path, m.basePath)))
+	if operation == nil {
+		if len(allowed) > 0 {
+			w.Header().Set("Allow", strings.Join(allowed, ", "))
+			writeError(w, http.StatusMethodNotAllowed, r.Method+" is not an operation of "+r.URL.Path, nil)
+			return
+		}
+		writeError(w, http.StatusNotFound, "no operation of the spec matches "+r.URL.Path, nil)
+		return
+	}
+
+	if problems := m.validateRequest(operation, r); len(problems) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "request does not match the spec", problems)
+		return
+	}
+
+	prefer := parsePrefer(r.Header.Get("Prefer"))
+	status, response := m.pickResponse(operation, prefer["code"])
+	if response == nil {
+		w.WriteHeader(status)
+		return
+	}
+
+	contentType, media := pickMediaType(response.Content)
+	if media == nil || status == http.StatusNoContent || r.Method == http.MethodHead {
+		w.WriteHeader(status)
+		return
+	}
+
+	body, err := json.Marshal(m.example(media, prefer["example"]))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to encode the example: "+err.Error(), nil)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+// find returns the operation of the most specific route matching method and path,
+// or the methods allowed on the path when only the method differs
+func (m *MockServer) find(method string, segments []string) (*openapi.Operation, []string) {
+	var allowed []string
+	for _, r := range m.routes {
+		if !r.match(segments) {
+			continue
+		}
+		if r.method == method {
+			return r.operation, nil
+		}
+		if !contains(allowed, r.method) {
+			allowed = append(allowed, r.method)
+		}
+	}
+	sort.Strings(allowed)
+	return nil, allowed
+}
+
+// pickResponse returns the response with the preferred status code,
+// or the first success response of the operation
+func (m *MockServer) pickResponse(operation *openapi.Operation, preferredCode string) (int, *openapi.Response) {
+	if response, ok := operation.Responses[preferredCode]; ok {
+		if status, err := strconv.Atoi(preferredCode); err == nil {
+			return status, m.spec.ResolveResponse(response)
+		}
+	}
+
+	codes := make([]string, 0, len(operation.Responses))
+	for code := range operation.Responses {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		if strings.HasPrefix(code, "2") {
+			status, err := strconv.Atoi(code)
+			if err != nil {
+				status = http.StatusOK
+			}
+			return status, m.spec.ResolveResponse(operation.Responses[code])
+		}
+	}
+	if response, ok := operation.Responses["default"]; ok {
+		return http.StatusOK, m.spec.ResolveResponse(response)
+	}
+	return http.StatusNotImplemented, nil
+}
+
+// example returns the named example of the media type, its first example,
+// or a value generated from its schema
+func (m *MockServer) example(media *openapi.MediaType, name string) any {
+	if example, ok := media.Examples[name]; ok && example != nil {
+		return example.Value
+	}
+	if media.Example != nil {
+		return media.Example
+	}
+	if len(media.Examples) > 0 {
+		names := make([]string, 0, len(media.Examples))
+		for name := range media.Examples {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		if example := media.Examples[names[0]]; example != nil {
+			return example.Value
+		}
+	}
+	return m.generate(media.Schema, 0)
+}
+
+// generate builds a value matching schema from its examples, enums, defaults and formats
+func (m *MockServer) generate(schema *openapi.Schema, depth int) any {
+	schema = m.spec.ResolveSchema(schema)
+	if schema == nil || depth > maxSchemaDepth {
+		return nil
+	}
+	switch {
+	case schema.Example != nil:
+		return schema.Example
+	case len(schema.Enum) > 0:
+		return schema.Enum[0]
+	case schema.Default != nil:
+		return schema.Default
+	case len(schema.AllOf) > 0:
+		merged := make(map[string]any)
+		for _, part := range schema.AllOf {
+			if object, ok := m.generate(part, depth+1).(map[string]any); ok {
+				for key, value := range object {
+					merged[key] = value
+				}
+			}
+		}
+		return merged
+	case len(schema.OneOf) > 0:
+		return m.generate(schema.OneOf[0], depth+1)
+	case len(schema.AnyOf) > 0:
+		return m.generate(schema.AnyOf[0], depth+1)
+	}
+
+	switch schema.Type {
+	case "array":
+		if schema.Items == nil {
+			return []any{}
+		}
+		return []any{m.generate(schema.Items, depth+1)}
+	case "string":
+		return generateString(schema)
+	case "integer":
+		if schema.Minimum != nil {
+			return int64(*schema.Minimum)
+		}
+		return 0
+	case "number":
+		if schema.Minimum != nil {
+			return *schema.Minimum
+		}
+		return 0.0
+	case "boolean":
+		return true
+	case "object", "":
+		object := make(map[string]any, len(schema.Properties))
+		for _, property := range schema.Properties {
+			object[property.Name] = m.generate(property.Schema, depth+1)
+		}
+		return object
+	default:
+		return nil
+	}
+}
+
+// generateString returns a string in the format of the schema
+func generateString(schema *openapi.Schema) string {
+	switch schema.Format {
+	case "date-time":
+		return "2024-01-01T12:00:00Z"
+	case "date":
+		return "2024-01-01"
+	case "email":
+		return "user@example.com"
+	case "uuid":
+		return "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+	case "uri", "url":
+		return "https://example.com"
+	case "ipv4":
+		return "192.0.2.1"
+	case "password":
+		return "********"
+	}
+	if schema.MinLength != nil && *schema.MinLength > len("string") {
+		return strings.Repeat("s", *schema.MinLength)
+	}
+	return "string"
+}
+
+// pickMediaType prefers JSON among the content types of a response
+func pickMediaType(content map[string]*openapi.MediaType) (string, *openapi.MediaType) {
+	if media, ok := content["application/json"]; ok {
+		return "application/json", media
+	}
+	types := make([]string, 0, len(content))
+	for contentType := range content {
+		types = append(types, contentType)
+	}
+	sort.Strings(types)
+	for _, contentType := range types {
+		if strings.HasSuffix(contentType, "json") {
+			return contentType, content[contentType]
+		}
+	}
+	return "", nil
+}
+
+// parsePrefer reads the key=value pairs of a Prefer header
+func parsePrefer(header string) map[string]string {
+	preferences := make(map[string]string)
+	for _, part := range strings.Split(header, ",") {
+		if key, value, ok := strings.Cut(strings.TrimSpace(part), "="); ok {
+			preferences[strings.ToLower(key)] = strings.Trim(value, `"`)
+		}
+	}
+	return preferences
+}
+
+// splitPath splits a URL path or path template into its non-empty segments
+func splitPath(path string) []string {
+	var segments []string
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// contains reports whether values holds value
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// isParameter reports whether a template segment is a {parameter}
+func isParameter(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// errorResponse is the body of the errors raised by the mock server itself
+type errorResponse struct {
+	Error   string   `json:"error"`
+	Details []string `json:"details,omitempty"`
+}
+
+// writeError answers an error raised by the mock server itself
+func writeError(w http.ResponseWriter, status int, message string, details []string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(errorResponse{Error: message, Details: details})
+}
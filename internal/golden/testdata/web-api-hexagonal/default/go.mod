@@ -0,0 +1,17 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	github.com/gin-gonic/gin v1.9.1
+	github.com/gin-contrib/cors v1.4.0
+	github.com/spf13/viper v1.16.0
+	github.com/lib/pq v1.10.9
+	github.com/golang-jwt/jwt/v5 v5.0.0
+	golang.org/x/crypto v0.14.0
+	github.com/google/uuid v1.4.0
+	github.com/stretchr/testify v1.8.4
+	gopkg.in/yaml.v3 v3.0.1
+	github.com/testcontainers/testcontainers-go v0.27.0
+	github.com/testcontainers/testcontainers-go/modules/postgres v0.27.0
+)
\ No newline at end of file
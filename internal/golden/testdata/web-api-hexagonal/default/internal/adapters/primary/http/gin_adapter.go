@@ -0,0 +1,158 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/example/golden/internal/adapters/primary/http/middleware"
+	"github.com/example/golden/internal/application/ports/input"
+	"github.com/example/golden/internal/application/ports/output"
+)
+
+// GinAdapter implements the HTTP server using Gin framework
+type GinAdapter struct {
+	router     *gin.Engine
+	healthPort input.HealthPort
+	userPort   input.UserPort
+	authPort   input.AuthPort
+	logger     output.LoggerPort
+}
+
+// NewGinAdapter creates a new Gin HTTP adapter
+func NewGinAdapter(
+	healthPort input.HealthPort,
+	userPort input.UserPort,
+	authPort input.AuthPort,
+	logger output.LoggerPort,
+) *GinAdapter {
+	// Set Gin to release mode for production
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.New()
+
+	// Add recovery middleware
+	router.Use(gin.Recovery())
+
+	adapter := &GinAdapter{
+		router:     router,
+		healthPort: healthPort,
+		userPort:   userPort,
+		authPort:   authPort,
+		logger:     logger,
+	}
+
+	adapter.setupMiddleware()
+	adapter.setupRoutes()
+
+	return adapter
+}
+
+// setupMiddleware configures the middleware chain
+func (g *GinAdapter) setupMiddleware() {
+	// Request ID middleware
+	g.router.Use(wrapMiddleware(middleware.RequestID()))
+
+	// Security headers middleware
+	securityConfig := middleware.DefaultSecurityConfig()
+	g.router.Use(wrapMiddleware(middleware.Security(securityConfig)))
+
+	// CORS middleware
+	corsConfig := middleware.DefaultCORSConfig()
+	g.router.Use(wrapMiddleware(middleware.CORS(corsConfig)))
+
+	// Content type validation middleware
+	g.router.Use(wrapMiddleware(middleware.ContentTypeValidation()))
+
+	// Logging middleware
+	loggingConfig := middleware.LoggingConfig{
+		SkipPaths: []string{"/health", "/metrics"},
+		Logger:    g.logger,
+	}
+	g.router.Use(wrapMiddleware(middleware.Logging(loggingConfig)))
+
+	// Error handler middleware
+	g.router.Use(wrapMiddleware(middleware.ErrorHandler(g.logger)))
+}
+
+// wrapMiddleware wraps a standard http.Handler middleware for use with Gin
+func wrapMiddleware(middleware func(http.Handler) http.Handler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			c.Next()
+		})).ServeHTTP(c.Writer, c.Request)
+	}
+}
+
+// setupRoutes configures all the HTTP routes
+func (g *GinAdapter) setupRoutes() {
+	// Health check routes
+	healthHandler := NewHealthHandler(g.healthPort, g.logger)
+	g.router.GET("/health", g.adaptHandler(healthHandler.HandleHealth))
+	g.router.GET("/health/ready", g.adaptHandler(healthHandler.HandleReadiness))
+
+	// API routes
+	api := g.router.Group("/api")
+	// User routes
+	userHandler := NewUserHandler(g.userPort, g.logger)
+	userRoutes := api.Group("/users")
+	{
+		userRoutes.POST("", g.adaptHandler(userHandler.HandleCreate))
+		userRoutes.GET("/:id", g.adaptHandler(userHandler.HandleGetByID))
+		userRoutes.PUT("/:id", g.adaptHandler(userHandler.HandleUpdate))
+		userRoutes.DELETE("/:id", g.adaptHandler(userHandler.HandleDelete))
+		userRoutes.GET("", g.adaptHandler(userHandler.HandleList))
+	}
+	// Authentication routes
+	authHandler := NewAuthHandler(g.authPort, g.logger)
+	authRoutes := api.Group("/auth")
+	{
+		authRoutes.POST("/login", g.adaptHandler(authHandler.HandleLogin))
+		authRoutes.POST("/register", g.adaptHandler(authHandler.HandleRegister))
+		authRoutes.POST("/refresh", g.adaptHandler(authHandler.HandleRefreshToken))
+		authRoutes.POST("/logout", g.adaptHandler(authHandler.HandleLogout))
+		authRoutes.POST("/change-password", g.adaptHandler(authHandler.HandleChangePassword))
+		authRoutes.POST("/reset-password", g.adaptHandler(authHandler.HandleResetPassword))
+		authRoutes.POST("/confirm-reset", g.adaptHandler(authHandler.HandleConfirmPasswordReset))
+	}
+
+	// Protected routes that require authentication
+	authConfig := middleware.DefaultAuthConfig(g.authPort, g.logger)
+	protected := api.Group("")
+	protected.Use(gin.WrapH(middleware.Auth(authConfig)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// This will be replaced by the actual handler
+	}))))
+	// Protected user routes
+	protectedUserRoutes := protected.Group("/users")
+	{
+		protectedUserRoutes.GET("/me", g.adaptHandler(userHandler.HandleGetProfile))
+		protectedUserRoutes.PUT("/me", g.adaptHandler(userHandler.HandleUpdateProfile))
+		// Alias routes for compatibility with tests
+		protectedUserRoutes.GET("/profile", g.adaptHandler(userHandler.HandleGetProfile))
+		protectedUserRoutes.PUT("/profile", g.adaptHandler(userHandler.HandleUpdateProfile))
+	}
+}
+
+// adaptHandler adapts a standard HTTP handler to Gin handler
+func (g *GinAdapter) adaptHandler(handler http.HandlerFunc) gin.HandlerFunc {
+	return gin.WrapF(handler)
+}
+
+// Start starts the HTTP server
+func (g *GinAdapter) Start(addr string) error {
+	g.logger.Info(nil, "Starting Gin HTTP server", output.String("address", addr))
+	return g.router.Run(addr)
+}
+
+// Stop stops the HTTP server gracefully
+func (g *GinAdapter) Stop() error {
+	g.logger.Info(nil, "Stopping Gin HTTP server")
+	// Gin doesn't have a built-in graceful shutdown, so we'll just log
+	// In a real implementation, you might want to use http.Server with context
+	return nil
+}
+
+// Handler returns the underlying HTTP handler
+func (g *GinAdapter) Handler() http.Handler {
+	return g.router
+}
@@ -0,0 +1,332 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/example/golden/internal/application/dto"
+	"github.com/example/golden/internal/application/ports/input"
+	"github.com/example/golden/internal/application/ports/output"
+)
+
+// UserHandler handles user HTTP requests
+// This is a primary adapter that implements the HTTP interface
+type UserHandler struct {
+	userPort input.UserPort
+	logger   output.LoggerPort
+}
+
+// NewUserHandler creates a new user handler
+func NewUserHandler(userPort input.UserPort, logger output.LoggerPort) *UserHandler {
+	return &UserHandler{
+		userPort: userPort,
+		logger:   logger,
+	}
+}
+
+// HandleCreateUser handles user creation requests
+func (h *UserHandler) HandleCreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	h.logger.Info(ctx, "Create user request received")
+
+	// Parse request body
+	var req dto.CreateUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error(ctx, "Invalid request body", output.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Use the user port to create the user
+	userResponse, err := h.userPort.CreateUser(ctx, &req)
+	if err != nil {
+		h.logger.Error(ctx, "Failed to create user", output.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Return created user as JSON
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(userResponse); err != nil {
+		h.logger.Error(ctx, "Failed to encode response", output.Error(err))
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// HandleGetUser handles user retrieval requests
+func (h *UserHandler) HandleGetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Extract user ID from URL path
+	userID := extractIDFromPath(r.URL.Path)
+	if userID == "" {
+		h.logger.Error(ctx, "Missing user ID in path")
+		http.Error(w, "Missing user ID", http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, "Get user request received", output.String("user_id", userID))
+
+	// Use the user port to get the user
+	userResponse, err := h.userPort.GetUserByID(ctx, userID)
+	if err != nil {
+		h.logger.Error(ctx, "Failed to get user", output.String("user_id", userID), output.Error(err))
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Return user as JSON
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(userResponse); err != nil {
+		h.logger.Error(ctx, "Failed to encode response", output.Error(err))
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// HandleUpdateUser handles user update requests
+func (h *UserHandler) HandleUpdateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Extract user ID from URL path
+	userID := extractIDFromPath(r.URL.Path)
+	if userID == "" {
+		h.logger.Error(ctx, "Missing user ID in path")
+		http.Error(w, "Missing user ID", http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, "Update user request received", output.String("user_id", userID))
+
+	// Parse request body
+	var req dto.UpdateUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error(ctx, "Invalid request body", output.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Use the user port to update the user
+	userResponse, err := h.userPort.UpdateUser(ctx, userID, &req)
+	if err != nil {
+		h.logger.Error(ctx, "Failed to update user", output.String("user_id", userID), output.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Return updated user as JSON
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(userResponse); err != nil {
+		h.logger.Error(ctx, "Failed to encode response", output.Error(err))
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// HandleDeleteUser handles user deletion requests
+func (h *UserHandler) HandleDeleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Extract user ID from URL path
+	userID := extractIDFromPath(r.URL.Path)
+	if userID == "" {
+		h.logger.Error(ctx, "Missing user ID in path")
+		http.Error(w, "Missing user ID", http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, "Delete user request received", output.String("user_id", userID))
+
+	// Use the user port to delete the user
+	if err := h.userPort.DeleteUser(ctx, userID); err != nil {
+		h.logger.Error(ctx, "Failed to delete user", output.String("user_id", userID), output.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Return success
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleListUsers handles user listing requests
+func (h *UserHandler) HandleListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	h.logger.Info(ctx, "List users request received")
+
+	// Parse query parameters
+	query := r.URL.Query()
+
+	limit := 10
+	if l := query.Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	offset := 0
+	if o := query.Get("offset"); o != "" {
+		if parsed, err := strconv.Atoi(o); err == nil && parsed >= 0 {
+			offset = parsed
+		}
+	}
+
+	search := query.Get("search")
+
+	req := &dto.ListUsersRequest{
+		Limit:  limit,
+		Offset: offset,
+		Search: search,
+	}
+
+	// Use the user port to list users
+	usersResponse, err := h.userPort.ListUsers(ctx, req)
+	if err != nil {
+		h.logger.Error(ctx, "Failed to list users", output.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Return users as JSON
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(usersResponse); err != nil {
+		h.logger.Error(ctx, "Failed to encode response", output.Error(err))
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// Convenience methods for framework adapters
+// These methods provide shorter names that match the framework adapter expectations
+
+// HandleCreate is a convenience method for HandleCreateUser
+func (h *UserHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	h.HandleCreateUser(w, r)
+}
+
+// HandleGetByID is a convenience method for HandleGetUser
+func (h *UserHandler) HandleGetByID(w http.ResponseWriter, r *http.Request) {
+	h.HandleGetUser(w, r)
+}
+
+// HandleUpdate is a convenience method for HandleUpdateUser
+func (h *UserHandler) HandleUpdate(w http.ResponseWriter, r *http.Request) {
+	h.HandleUpdateUser(w, r)
+}
+
+// HandleDelete is a convenience method for HandleDeleteUser
+func (h *UserHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	h.HandleDeleteUser(w, r)
+}
+
+// HandleList is a convenience method for HandleListUsers
+func (h *UserHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	h.HandleListUsers(w, r)
+}
+
+// HandleGetProfile handles get profile requests (convenience for protected routes)
+func (h *UserHandler) HandleGetProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Extract user ID from context (set by auth middleware)
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		h.logger.Error(ctx, "No user ID found in context")
+		http.Error(w, "Unauthorized: no user context", http.StatusUnauthorized)
+		return
+	}
+
+	h.logger.Info(ctx, "Get user profile request received", output.String("user_id", userID))
+	h.handleGetByID(w, r, userID)
+}
+
+// HandleUpdateProfile handles update profile requests (convenience for protected routes)
+func (h *UserHandler) HandleUpdateProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Extract user ID from context (set by auth middleware)
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		h.logger.Error(ctx, "No user ID found in context")
+		http.Error(w, "Unauthorized: no user context", http.StatusUnauthorized)
+		return
+	}
+
+	h.logger.Info(ctx, "Update user profile request received", output.String("user_id", userID))
+	h.handleUpdate(w, r, userID)
+}
+
+// handleGetByID is a helper method for getting user by ID
+func (h *UserHandler) handleGetByID(w http.ResponseWriter, r *http.Request, userID string) {
+	ctx := r.Context()
+
+	h.logger.Info(ctx, "Get user by ID request received", output.String("user_id", userID))
+
+	// Use the user port to get the user
+	userResponse, err := h.userPort.GetUserByID(ctx, userID)
+	if err != nil {
+		h.logger.Error(ctx, "Failed to get user", output.String("user_id", userID), output.Error(err))
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Return user as JSON
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(userResponse); err != nil {
+		h.logger.Error(ctx, "Failed to encode response", output.Error(err))
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// handleUpdate is a helper method for updating user
+func (h *UserHandler) handleUpdate(w http.ResponseWriter, r *http.Request, userID string) {
+	ctx := r.Context()
+
+	h.logger.Info(ctx, "Update user profile request received", output.String("user_id", userID))
+
+	// Parse request body
+	var req dto.UpdateUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error(ctx, "Invalid request body", output.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Use the user port to update the user
+	userResponse, err := h.userPort.UpdateUser(ctx, userID, &req)
+	if err != nil {
+		h.logger.Error(ctx, "Failed to update user", output.String("user_id", userID), output.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Return updated user as JSON
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(userResponse); err != nil {
+		h.logger.Error(ctx, "Failed to encode response", output.Error(err))
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// extractIDFromPath extracts the ID from the URL path
+func extractIDFromPath(path string) string {
+	// Simple path parsing - extract the last segment as ID
+	// Example: "/api/users/123" -> "123"
+	parts := strings.Split(strings.TrimSuffix(path, "/"), "/")
+	if len(parts) > 0 {
+		return parts[len(parts)-1]
+	}
+	return "placeholder_id"
+}
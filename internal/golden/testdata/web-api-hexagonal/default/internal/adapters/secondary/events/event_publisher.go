@@ -0,0 +1,165 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/example/golden/internal/application/ports/output"
+	"github.com/example/golden/internal/domain/events"
+)
+
+// EventPublisher implements the EventPublisherPort interface
+// This is a secondary adapter for publishing domain events
+type EventPublisher struct {
+	subscribers map[string][]func(ctx context.Context, event events.DomainEvent)
+	mutex       sync.RWMutex
+	logger      output.LoggerPort
+}
+
+// NewEventPublisher creates a new event publisher
+func NewEventPublisher(logger output.LoggerPort) output.EventPublisherPort {
+	return &EventPublisher{
+		subscribers: make(map[string][]func(ctx context.Context, event events.DomainEvent)),
+		logger:      logger,
+	}
+}
+
+// Publish publishes a domain event to all subscribers
+func (e *EventPublisher) Publish(ctx context.Context, event events.DomainEvent) error {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	eventType := event.EventType()
+
+	e.logger.Debug(ctx, "Publishing domain event",
+		output.String("event_type", eventType),
+		output.String("event_id", event.EventID()),
+		output.String("aggregate_id", event.AggregateID()),
+	)
+
+	// Get subscribers for this event type
+	subscribers, exists := e.subscribers[eventType]
+	if !exists {
+		e.logger.Debug(ctx, "No subscribers for event type", output.String("event_type", eventType))
+		return nil
+	}
+
+	// Publish to all subscribers
+	for _, subscriber := range subscribers {
+		go func(sub func(ctx context.Context, event events.DomainEvent)) {
+			defer func() {
+				if r := recover(); r != nil {
+					e.logger.Error(ctx, "Event subscriber panic",
+						output.String("event_type", eventType),
+						output.Any("panic", r),
+					)
+				}
+			}()
+
+			sub(ctx, event)
+		}(subscriber)
+	}
+
+	e.logger.Info(ctx, "Domain event published successfully",
+		output.String("event_type", eventType),
+		output.String("event_id", event.EventID()),
+		output.Int("subscriber_count", len(subscribers)),
+	)
+
+	return nil
+}
+
+// PublishBatch publishes multiple domain events in a batch
+func (e *EventPublisher) PublishBatch(ctx context.Context, events []events.DomainEvent) error {
+	for _, event := range events {
+		if err := e.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Subscribe adds a subscriber for a specific event type
+func (e *EventPublisher) Subscribe(ctx context.Context, eventType string, handler output.EventHandler) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	// Convert EventHandler to function
+	handlerFunc := func(ctx context.Context, event events.DomainEvent) {
+		if err := handler.Handle(ctx, event); err != nil {
+			e.logger.Error(ctx, "Event handler error",
+				output.String("event_type", eventType),
+				output.Error(err),
+			)
+		}
+	}
+
+	if e.subscribers[eventType] == nil {
+		e.subscribers[eventType] = make([]func(ctx context.Context, event events.DomainEvent), 0)
+	}
+
+	e.subscribers[eventType] = append(e.subscribers[eventType], handlerFunc)
+
+	e.logger.Info(ctx, "Event subscriber registered",
+		output.String("event_type", eventType),
+		output.Int("total_subscribers", len(e.subscribers[eventType])),
+	)
+
+	return nil
+}
+
+// Unsubscribe removes a subscriber for a specific event type
+func (e *EventPublisher) Unsubscribe(ctx context.Context, eventType string, handler output.EventHandler) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	// For simplicity, we'll clear all subscribers for the event type
+	// In a real implementation, you'd want to track and remove specific handlers
+	subscriberCount := len(e.subscribers[eventType])
+	delete(e.subscribers, eventType)
+
+	e.logger.Info(ctx, "Event subscribers unregistered",
+		output.String("event_type", eventType),
+		output.Int("removed_subscribers", subscriberCount),
+	)
+
+	return nil
+}
+
+// GetSubscriberCount returns the number of subscribers for a specific event type
+func (e *EventPublisher) GetSubscriberCount(eventType string) int {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	return len(e.subscribers[eventType])
+}
+
+// GetAllEventTypes returns all event types that have subscribers
+func (e *EventPublisher) GetAllEventTypes() []string {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	eventTypes := make([]string, 0, len(e.subscribers))
+	for eventType := range e.subscribers {
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	return eventTypes
+}
+
+// Clear removes all subscribers for all event types
+func (e *EventPublisher) Clear() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	totalSubscribers := 0
+	for _, subscribers := range e.subscribers {
+		totalSubscribers += len(subscribers)
+	}
+
+	e.subscribers = make(map[string][]func(ctx context.Context, event events.DomainEvent))
+
+	e.logger.Info(context.Background(), "All event subscribers cleared",
+		output.Int("removed_subscribers", totalSubscribers),
+	)
+}
@@ -0,0 +1,126 @@
+package lockout
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/example/golden/internal/application/ports/output"
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/domain/entities"
+)
+
+// sweepThreshold is the number of tracked keys above which expired records are swept
+const sweepThreshold = 10000
+
+// memoryRecord is a failed login record with its expiry
+type memoryRecord struct {
+	failures      int
+	lastFailureAt time.Time
+	lockedUntil   time.Time
+	expiresAt     time.Time
+}
+
+// MemoryStore implements the LoginAttemptStorePort interface in process memory
+// Records are lost on restart and not shared between instances, use the Redis or
+// database store when running more than one instance
+type MemoryStore struct {
+	records map[string]*memoryRecord
+	mutex   sync.Mutex
+	clock   clock.Clock
+}
+
+// NewMemoryStore creates a new in-memory login attempt store
+func NewMemoryStore(clk clock.Clock) output.LoginAttemptStorePort {
+	return &MemoryStore{
+		records: make(map[string]*memoryRecord),
+		clock:   clk,
+	}
+}
+
+// Get returns the failed login record for a key
+func (s *MemoryStore) Get(ctx context.Context, key string) (*entities.LoginAttempts, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	record := s.live(key)
+	if record == nil {
+		return entities.NewLoginAttempts(key), nil
+	}
+	return record.toEntity(key), nil
+}
+
+// RecordFailure counts a failed login and returns the updated record
+func (s *MemoryStore) RecordFailure(ctx context.Context, key string, at time.Time, ttl time.Duration) (*entities.LoginAttempts, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.records) > sweepThreshold {
+		s.sweep()
+	}
+
+	record := s.live(key)
+	if record == nil {
+		record = &memoryRecord{}
+		s.records[key] = record
+	}
+	record.failures++
+	record.lastFailureAt = at
+	record.expiresAt = at.Add(ttl)
+
+	return record.toEntity(key), nil
+}
+
+// Lock locks the key until the given time
+func (s *MemoryStore) Lock(ctx context.Context, key string, until time.Time, ttl time.Duration) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	record := s.live(key)
+	if record == nil {
+		record = &memoryRecord{}
+		s.records[key] = record
+	}
+	record.lockedUntil = until
+	if expiresAt := s.clock.Now().Add(ttl); expiresAt.After(record.expiresAt) {
+		record.expiresAt = expiresAt
+	}
+	return nil
+}
+
+// Reset clears the record for a key
+func (s *MemoryStore) Reset(ctx context.Context, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.records, key)
+	return nil
+}
+
+// live returns the unexpired record for a key, dropping it when expired
+func (s *MemoryStore) live(key string) *memoryRecord {
+	record, ok := s.records[key]
+	if !ok {
+		return nil
+	}
+	if !s.clock.Now().Before(record.expiresAt) {
+		delete(s.records, key)
+		return nil
+	}
+	return record
+}
+
+// sweep removes all expired records
+func (s *MemoryStore) sweep() {
+	now := s.clock.Now()
+	for key, record := range s.records {
+		if !now.Before(record.expiresAt) {
+			delete(s.records, key)
+		}
+	}
+}
+
+// toEntity converts the record to the domain entity
+func (r *memoryRecord) toEntity(key string) *entities.LoginAttempts {
+	return entities.ReconstructLoginAttempts(key, r.failures, r.lastFailureAt, r.lockedUntil)
+}
@@ -0,0 +1,117 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/example/golden/internal/application/ports/output"
+)
+
+// SlogAdapter implements the LoggerPort interface using slog
+type SlogAdapter struct {
+	logger *slog.Logger
+}
+
+// NewSlogAdapter creates a new slog adapter
+func NewSlogAdapter() output.LoggerPort {
+	// Create a structured logger with JSON format
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	})
+
+	logger := slog.New(handler)
+
+	return &SlogAdapter{
+		logger: logger,
+	}
+}
+
+// NewSlogAdapterWithLevel creates a new slog adapter with specified log level
+func NewSlogAdapterWithLevel(level string) output.LoggerPort {
+	var slogLevel slog.Level
+
+	switch level {
+	case "debug":
+		slogLevel = slog.LevelDebug
+	case "info":
+		slogLevel = slog.LevelInfo
+	case "warn":
+		slogLevel = slog.LevelWarn
+	case "error":
+		slogLevel = slog.LevelError
+	default:
+		slogLevel = slog.LevelInfo
+	}
+
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slogLevel,
+	})
+
+	logger := slog.New(handler)
+
+	return &SlogAdapter{
+		logger: logger,
+	}
+}
+
+// Debug logs a debug message
+func (s *SlogAdapter) Debug(ctx context.Context, msg string, fields ...output.Field) {
+	args := fieldsToArgs(fields)
+	s.logger.DebugContext(ctx, msg, args...)
+}
+
+// Info logs an info message
+func (s *SlogAdapter) Info(ctx context.Context, msg string, fields ...output.Field) {
+	args := fieldsToArgs(fields)
+	s.logger.InfoContext(ctx, msg, args...)
+}
+
+// Warn logs a warning message
+func (s *SlogAdapter) Warn(ctx context.Context, msg string, fields ...output.Field) {
+	args := fieldsToArgs(fields)
+	s.logger.WarnContext(ctx, msg, args...)
+}
+
+// Error logs an error message
+func (s *SlogAdapter) Error(ctx context.Context, msg string, fields ...output.Field) {
+	args := fieldsToArgs(fields)
+	s.logger.ErrorContext(ctx, msg, args...)
+}
+
+// Fatal logs a fatal message and terminates the application
+func (s *SlogAdapter) Fatal(ctx context.Context, msg string, fields ...output.Field) {
+	args := fieldsToArgs(fields)
+	s.logger.ErrorContext(ctx, msg, args...)
+	os.Exit(1)
+}
+
+// WithFields returns a logger with additional fields
+func (s *SlogAdapter) WithFields(fields ...output.Field) output.LoggerPort {
+	args := fieldsToArgs(fields)
+	return &SlogAdapter{
+		logger: s.logger.With(args...),
+	}
+}
+
+// WithError returns a logger with an error field
+func (s *SlogAdapter) WithError(err error) output.LoggerPort {
+	return &SlogAdapter{
+		logger: s.logger.With("error", err),
+	}
+}
+
+// DisableColor disables color output (no-op for slog)
+func (s *SlogAdapter) DisableColor() {
+	// slog doesn't have built-in color support to disable
+	// This is a no-op for compatibility with the Logger interface
+}
+
+// fieldsToArgs converts Field structs to slog arguments
+func fieldsToArgs(fields []output.Field) []interface{} {
+	args := make([]interface{}, 0, len(fields)*2)
+	for _, field := range fields {
+		args = append(args, field.Key, field.Value)
+	}
+	return args
+}
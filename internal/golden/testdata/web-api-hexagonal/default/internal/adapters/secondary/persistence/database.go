@@ -0,0 +1,94 @@
+package persistence
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/example/golden/internal/infrastructure/config"
+	_ "github.com/lib/pq"
+)
+
+// Database represents the database connection
+type Database struct {
+	sql *sql.DB
+}
+
+// NewDatabase creates a new database connection
+func NewDatabase(cfg *config.Config) (*Database, error) {
+	// Standard database/sql implementation
+	db, err := sql.Open(cfg.Database.Driver, cfg.GetDatabaseDSN())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	// Test connection
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &Database{sql: db}, nil
+}
+
+// Close closes the database connection
+func (d *Database) Close() error {
+	return d.sql.Close()
+}
+
+// GORM returns the GORM database instance
+
+// SQLx returns the SQLx database instance
+
+// SQL returns the standard SQL database instance
+func (d *Database) SQL() *sql.DB {
+	return d.sql
+}
+
+// DB returns the underlying database/sql connection pool
+func (d *Database) DB() (*sql.DB, error) {
+	return d.sql, nil
+}
+
+// Health checks the database connection health
+func (d *Database) Health() error {
+	return d.sql.Ping()
+}
+
+// Database models for persistence
+
+// UserModel represents the user model for standard SQL
+type UserModel struct {
+	ID           string
+	Email        string
+	FirstName    string
+	LastName     string
+	PasswordHash string
+	CreatedAt    int64
+	UpdatedAt    int64
+}
+
+// AuthSessionModel represents the auth session model for standard SQL
+type AuthSessionModel struct {
+	SessionID string
+	UserID    string
+	Token     string
+	ExpiresAt int64
+	CreatedAt int64
+}
+
+// RefreshTokenModel represents the refresh token model for standard SQL
+type RefreshTokenModel struct {
+	ID        string
+	UserID    string
+	Token     string
+	ExpiresAt int64
+	CreatedAt int64
+}
+
+// PasswordResetTokenModel represents the password reset token model for standard SQL
+type PasswordResetTokenModel struct {
+	ID        string
+	Token     string
+	UserID    string
+	ExpiresAt int64
+	CreatedAt int64
+}
@@ -0,0 +1,238 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/example/golden/internal/application/ports/output"
+	"github.com/example/golden/internal/domain/entities"
+	"github.com/example/golden/internal/domain/valueobjects"
+)
+
+// userRepository implements the UserRepositoryPort interface
+// This is a secondary adapter for database persistence
+type userRepository struct {
+	db     *Database
+	logger output.LoggerPort
+}
+
+// NewUserRepository creates a new user repository
+func NewUserRepository(db *Database, logger output.LoggerPort) output.UserRepositoryPort {
+	return &userRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create saves a new user to the repository
+func (r *userRepository) Create(ctx context.Context, user *entities.User) error {
+	r.logger.Info(ctx, "Creating user in repository", output.String("user_id", user.ID().Value()))
+	// Standard database/sql implementation
+	query := `INSERT INTO users (id, email, first_name, last_name, password_hash, created_at, updated_at) 
+			  VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.db.sql.ExecContext(ctx, query,
+		user.ID().Value(),
+		user.Email().Value(),
+		user.FirstName(),
+		user.LastName(),
+		user.PasswordHash(),
+		user.CreatedAt().Unix(),
+		user.UpdatedAt().Unix(),
+	)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to create user in database", output.Error(err))
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+
+	r.logger.Info(ctx, "User created successfully in repository", output.String("user_id", user.ID().Value()))
+	return nil
+}
+
+// GetByID retrieves a user by its ID
+func (r *userRepository) GetByID(ctx context.Context, id string) (*entities.User, error) {
+	r.logger.Info(ctx, "Getting user by ID from repository", output.String("user_id", id))
+	// Standard database/sql implementation
+	var model UserModel
+	query := `SELECT id, email, first_name, last_name, password_hash, created_at, updated_at 
+			  FROM users WHERE id = $1`
+
+	row := r.db.sql.QueryRowContext(ctx, query, id)
+	if err := row.Scan(&model.ID, &model.Email, &model.FirstName, &model.LastName, &model.PasswordHash, &model.CreatedAt, &model.UpdatedAt); err != nil {
+		r.logger.Error(ctx, "Failed to get user by ID", output.String("user_id", id), output.Error(err))
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	return r.modelToEntity(&model)
+}
+
+// GetByEmail retrieves a user by email
+func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entities.User, error) {
+	r.logger.Info(ctx, "Getting user by email from repository", output.String("email", email))
+	// Standard database/sql implementation
+	var model UserModel
+	query := `SELECT id, email, first_name, last_name, password_hash, created_at, updated_at 
+			  FROM users WHERE email = $1`
+
+	row := r.db.sql.QueryRowContext(ctx, query, email)
+	if err := row.Scan(&model.ID, &model.Email, &model.FirstName, &model.LastName, &model.PasswordHash, &model.CreatedAt, &model.UpdatedAt); err != nil {
+		r.logger.Error(ctx, "Failed to get user by email", output.String("email", email), output.Error(err))
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	return r.modelToEntity(&model)
+}
+
+// Update updates an existing user
+func (r *userRepository) Update(ctx context.Context, user *entities.User) error {
+	r.logger.Info(ctx, "Updating user in repository", output.String("user_id", user.ID().Value()))
+	// Standard database/sql implementation
+	query := `UPDATE users SET email = $1, first_name = $2, last_name = $3, password_hash = $4, updated_at = $5 
+			  WHERE id = $6`
+
+	_, err := r.db.sql.ExecContext(ctx, query,
+		user.Email().Value(),
+		user.FirstName(),
+		user.LastName(),
+		user.PasswordHash(),
+		user.UpdatedAt().Unix(),
+		user.ID().Value(),
+	)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to update user in database", output.Error(err))
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	r.logger.Info(ctx, "User updated successfully in repository", output.String("user_id", user.ID().Value()))
+	return nil
+}
+
+// Delete removes a user by its ID
+func (r *userRepository) Delete(ctx context.Context, id string) error {
+	r.logger.Info(ctx, "Deleting user from repository", output.String("user_id", id))
+	// Standard database/sql implementation
+	query := `DELETE FROM users WHERE id = $1`
+
+	_, err := r.db.sql.ExecContext(ctx, query, id)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to delete user from database", output.Error(err))
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	r.logger.Info(ctx, "User deleted successfully from repository", output.String("user_id", id))
+	return nil
+}
+
+// List retrieves users with pagination
+func (r *userRepository) List(ctx context.Context, limit, offset int) ([]*entities.User, error) {
+	r.logger.Info(ctx, "Listing users from repository", output.Int("limit", limit), output.Int("offset", offset))
+	// Standard database/sql implementation
+	query := `SELECT id, email, first_name, last_name, password_hash, created_at, updated_at 
+			  FROM users ORDER BY created_at DESC LIMIT $1 OFFSET $2`
+
+	rows, err := r.db.sql.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to list users from database", output.Error(err))
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	defer rows.Close()
+
+	var models []UserModel
+	for rows.Next() {
+		var model UserModel
+		if err := rows.Scan(&model.ID, &model.Email, &model.FirstName, &model.LastName, &model.PasswordHash, &model.CreatedAt, &model.UpdatedAt); err != nil {
+			r.logger.Error(ctx, "Failed to scan user row", output.Error(err))
+			return nil, fmt.Errorf("failed to scan user row: %w", err)
+		}
+		models = append(models, model)
+	}
+
+	// Convert models to entities
+	entities := make([]*entities.User, len(models))
+	for i, model := range models {
+		entity, err := r.modelToEntity(&model)
+		if err != nil {
+			r.logger.Error(ctx, "Failed to convert user model to entity", output.Error(err))
+			return nil, fmt.Errorf("failed to convert user model to entity: %w", err)
+		}
+		entities[i] = entity
+	}
+
+	return entities, nil
+}
+
+// Count returns the total number of users
+func (r *userRepository) Count(ctx context.Context) (int64, error) {
+	r.logger.Info(ctx, "Counting users in repository")
+	// Standard database/sql implementation
+	var count int64
+	query := `SELECT COUNT(*) FROM users`
+
+	row := r.db.sql.QueryRowContext(ctx, query)
+	if err := row.Scan(&count); err != nil {
+		r.logger.Error(ctx, "Failed to count users in database", output.Error(err))
+		return 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	return count, nil
+}
+
+// ExistsByEmail checks if a user exists by email
+func (r *userRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	r.logger.Info(ctx, "Checking if user exists by email", output.String("email", email))
+	// Standard database/sql implementation
+	var count int64
+	query := `SELECT COUNT(*) FROM users WHERE email = $1`
+
+	row := r.db.sql.QueryRowContext(ctx, query, email)
+	if err := row.Scan(&count); err != nil {
+		r.logger.Error(ctx, "Failed to check user existence by email", output.Error(err))
+		return false, fmt.Errorf("failed to check user existence: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// ExistsByID checks if a user exists by ID
+func (r *userRepository) ExistsByID(ctx context.Context, id string) (bool, error) {
+	r.logger.Info(ctx, "Checking if user exists by ID", output.String("user_id", id))
+	// Standard database/sql implementation
+	var count int64
+	query := `SELECT COUNT(*) FROM users WHERE id = $1`
+
+	row := r.db.sql.QueryRowContext(ctx, query, id)
+	if err := row.Scan(&count); err != nil {
+		r.logger.Error(ctx, "Failed to check user existence by ID", output.Error(err))
+		return false, fmt.Errorf("failed to check user existence: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// modelToEntity converts a database model to a domain entity
+func (r *userRepository) modelToEntity(model *UserModel) (*entities.User, error) {
+	// Create value objects
+	userID, err := valueobjects.UserIDFromString(model.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	email, err := valueobjects.NewEmail(model.Email)
+	if err != nil {
+		return nil, fmt.Errorf("invalid email: %w", err)
+	}
+
+	// Reconstruct entity
+	entity := entities.ReconstructUser(
+		userID,
+		email,
+		model.FirstName,
+		model.LastName,
+		model.PasswordHash,
+		time.Unix(model.CreatedAt, 0),
+		time.Unix(model.UpdatedAt, 0),
+	)
+
+	return entity, nil
+}
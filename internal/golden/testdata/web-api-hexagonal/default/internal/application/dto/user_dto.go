@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+)
+
+// CreateUserRequest represents the request to create a new user
+type CreateUserRequest struct {
+	Email     string `json:"email" validate:"required,email"`
+	FirstName string `json:"first_name" validate:"required,min=2,max=50"`
+	LastName  string `json:"last_name" validate:"required,min=2,max=50"`
+	Password  string `json:"password" validate:"required,min=8"`
+}
+
+// UpdateUserRequest represents the request to update an existing user
+type UpdateUserRequest struct {
+	Email     *string `json:"email,omitempty" validate:"omitempty,email"`
+	FirstName *string `json:"first_name,omitempty" validate:"omitempty,min=2,max=50"`
+	LastName  *string `json:"last_name,omitempty" validate:"omitempty,min=2,max=50"`
+}
+
+// UserResponse represents the response containing user data
+type UserResponse struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ListUsersRequest represents the request to list users
+type ListUsersRequest struct {
+	Limit  int    `json:"limit" validate:"min=1,max=100"`
+	Offset int    `json:"offset" validate:"min=0"`
+	Search string `json:"search,omitempty"`
+}
+
+// ListUsersResponse represents the response containing a list of users
+type ListUsersResponse struct {
+	Users  []UserResponse `json:"users"`
+	Total  int64          `json:"total"`
+	Limit  int            `json:"limit"`
+	Offset int            `json:"offset"`
+}
@@ -0,0 +1,35 @@
+package input
+
+import (
+	"context"
+
+	"github.com/example/golden/internal/application/dto"
+)
+
+// AuthPort defines the interface for authentication operations
+// This is a primary port that drives the application
+type AuthPort interface {
+	// Login authenticates a user and returns a token
+	Login(ctx context.Context, req *dto.LoginRequest) (*dto.LoginResponse, error)
+
+	// Register creates a new user account
+	Register(ctx context.Context, req *dto.RegisterRequest) (*dto.RegisterResponse, error)
+
+	// RefreshToken refreshes an authentication token
+	RefreshToken(ctx context.Context, req *dto.RefreshTokenRequest) (*dto.RefreshTokenResponse, error)
+
+	// ValidateToken validates an authentication token
+	ValidateToken(ctx context.Context, token string) (*dto.TokenValidationResponse, error)
+
+	// Logout invalidates an authentication token
+	Logout(ctx context.Context, token string) error
+
+	// ChangePassword changes a user's password
+	ChangePassword(ctx context.Context, req *dto.ChangePasswordRequest) error
+
+	// ResetPassword initiates a password reset
+	ResetPassword(ctx context.Context, req *dto.ResetPasswordRequest) error
+
+	// ConfirmPasswordReset confirms a password reset with a token
+	ConfirmPasswordReset(ctx context.Context, req *dto.ConfirmPasswordResetRequest) error
+}
@@ -0,0 +1,30 @@
+package input
+
+import (
+	"context"
+)
+
+// HealthPort defines the interface for health check operations
+// This is a primary port that drives the application
+type HealthPort interface {
+	// CheckHealth returns the health status of the application
+	CheckHealth(ctx context.Context) (*HealthStatus, error)
+
+	// CheckReadiness returns the readiness status of the application
+	CheckReadiness(ctx context.Context) (*ReadinessStatus, error)
+}
+
+// HealthStatus represents the health status of the application
+type HealthStatus struct {
+	Status    string            `json:"status"`
+	Timestamp int64             `json:"timestamp"`
+	Version   string            `json:"version"`
+	Details   map[string]string `json:"details,omitempty"`
+}
+
+// ReadinessStatus represents the readiness status of the application
+type ReadinessStatus struct {
+	Ready     bool            `json:"ready"`
+	Timestamp int64           `json:"timestamp"`
+	Checks    map[string]bool `json:"checks,omitempty"`
+}
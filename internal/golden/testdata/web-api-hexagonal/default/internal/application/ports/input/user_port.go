@@ -0,0 +1,29 @@
+package input
+
+import (
+	"context"
+
+	"github.com/example/golden/internal/application/dto"
+)
+
+// UserPort defines the interface for user operations
+// This is a primary port that drives the application
+type UserPort interface {
+	// CreateUser creates a new user
+	CreateUser(ctx context.Context, req *dto.CreateUserRequest) (*dto.UserResponse, error)
+
+	// GetUserByID retrieves a user by ID
+	GetUserByID(ctx context.Context, id string) (*dto.UserResponse, error)
+
+	// UpdateUser updates an existing user
+	UpdateUser(ctx context.Context, id string, req *dto.UpdateUserRequest) (*dto.UserResponse, error)
+
+	// DeleteUser deletes a user by ID
+	DeleteUser(ctx context.Context, id string) error
+
+	// ListUsers retrieves a list of users with pagination
+	ListUsers(ctx context.Context, req *dto.ListUsersRequest) (*dto.ListUsersResponse, error)
+
+	// GetUserByEmail retrieves a user by email
+	GetUserByEmail(ctx context.Context, email string) (*dto.UserResponse, error)
+}
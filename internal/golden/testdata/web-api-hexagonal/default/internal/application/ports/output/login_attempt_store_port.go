@@ -0,0 +1,25 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/golden/internal/domain/entities"
+)
+
+// LoginAttemptStorePort defines the interface for tracking failed logins
+// This is a secondary port implemented by the memory, Redis or database lockout store
+type LoginAttemptStorePort interface {
+	// Get returns the failed login record for a key, or an empty record when there is none
+	Get(ctx context.Context, key string) (*entities.LoginAttempts, error)
+
+	// RecordFailure atomically counts a failed login and returns the updated record
+	// The record is forgotten ttl after the last failure
+	RecordFailure(ctx context.Context, key string, at time.Time, ttl time.Duration) (*entities.LoginAttempts, error)
+
+	// Lock locks the key until the given time, keeping the record for ttl
+	Lock(ctx context.Context, key string, until time.Time, ttl time.Duration) error
+
+	// Reset clears the record, typically after a successful login
+	Reset(ctx context.Context, key string) error
+}
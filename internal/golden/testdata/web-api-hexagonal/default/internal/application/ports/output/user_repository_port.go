@@ -0,0 +1,38 @@
+package output
+
+import (
+	"context"
+
+	"github.com/example/golden/internal/domain/entities"
+)
+
+// UserRepositoryPort defines the interface for user persistence operations
+// This is a secondary port that will be implemented by driven adapters
+type UserRepositoryPort interface {
+	// Create saves a new user to the repository
+	Create(ctx context.Context, user *entities.User) error
+
+	// GetByID retrieves a user by its ID
+	GetByID(ctx context.Context, id string) (*entities.User, error)
+
+	// GetByEmail retrieves a user by email
+	GetByEmail(ctx context.Context, email string) (*entities.User, error)
+
+	// Update updates an existing user
+	Update(ctx context.Context, user *entities.User) error
+
+	// Delete removes a user by its ID
+	Delete(ctx context.Context, id string) error
+
+	// List retrieves users with pagination
+	List(ctx context.Context, limit, offset int) ([]*entities.User, error)
+
+	// Count returns the total number of users
+	Count(ctx context.Context) (int64, error)
+
+	// ExistsByEmail checks if a user exists by email
+	ExistsByEmail(ctx context.Context, email string) (bool, error)
+
+	// ExistsByID checks if a user exists by ID
+	ExistsByID(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,585 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/example/golden/internal/application/dto"
+	"github.com/example/golden/internal/application/ports/input"
+	"github.com/example/golden/internal/application/ports/output"
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/domain/entities"
+	"github.com/example/golden/internal/domain/events"
+	"github.com/example/golden/internal/domain/services"
+	"github.com/example/golden/internal/domain/valueobjects"
+	"github.com/example/golden/internal/infrastructure/config"
+)
+
+// AuthService implements the AuthPort interface
+// This is an application service that orchestrates authentication operations
+type AuthService struct {
+	userRepo          output.UserRepositoryPort
+	authRepo          output.AuthRepositoryPort
+	authDomainService services.AuthDomainService
+	eventPublisher    output.EventPublisherPort
+	logger            output.LoggerPort
+	clock             clock.Clock
+	authConfig        config.AuthConfig
+	loginAttempts     output.LoginAttemptStorePort
+	lockoutPolicy     services.LockoutPolicy
+}
+
+// NewAuthService creates a new AuthService
+func NewAuthService(
+	userRepo output.UserRepositoryPort,
+	authRepo output.AuthRepositoryPort,
+	authDomainService services.AuthDomainService,
+	eventPublisher output.EventPublisherPort,
+	logger output.LoggerPort,
+	clk clock.Clock,
+	authConfig config.AuthConfig,
+	loginAttempts output.LoginAttemptStorePort,
+	lockoutPolicy services.LockoutPolicy,
+) input.AuthPort {
+	return &AuthService{
+		userRepo:          userRepo,
+		authRepo:          authRepo,
+		authDomainService: authDomainService,
+		eventPublisher:    eventPublisher,
+		logger:            logger,
+		clock:             clk,
+		authConfig:        authConfig,
+		loginAttempts:     loginAttempts,
+		lockoutPolicy:     lockoutPolicy,
+	}
+}
+
+// Login authenticates a user and returns a token
+func (s *AuthService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.LoginResponse, error) {
+	s.logger.Info(ctx, "Login attempt", output.String("email", req.Email))
+
+	// Locked accounts are rejected before the credentials are checked
+	attemptKey := loginAttemptKey(req.Email)
+	if err := s.checkLockout(ctx, attemptKey); err != nil {
+		return nil, err
+	}
+
+	// Get user by email
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get user by email", output.String("email", req.Email), output.Error(err))
+
+		// Publish login failed event
+		event := events.NewUserLoginFailedEvent(req.Email, "", "", "user not found", s.clock.Now())
+		s.eventPublisher.Publish(ctx, event)
+
+		// Unknown emails count too, so lockouts do not reveal which accounts exist
+		s.recordLoginFailure(ctx, attemptKey)
+
+		return nil, fmt.Errorf("invalid credentials")
+	}
+
+	// Use domain service to validate login
+	if err := s.authDomainService.ValidateLogin(ctx, user, req.Password); err != nil {
+		s.logger.Error(ctx, "Login validation failed", output.String("email", req.Email), output.Error(err))
+
+		// Publish login failed event
+		event := events.NewUserLoginFailedEvent(req.Email, "", "", "invalid password", s.clock.Now())
+		s.eventPublisher.Publish(ctx, event)
+
+		s.recordLoginFailure(ctx, attemptKey)
+
+		return nil, fmt.Errorf("invalid credentials")
+	}
+
+	// A successful login clears the failed attempts
+	s.resetLoginFailures(ctx, attemptKey)
+
+	// Generate tokens
+	accessToken, err := s.generateAccessToken(user.ID().Value(), user.Email().Value())
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate access token", output.Error(err))
+		return nil, fmt.Errorf("failed to generate access token")
+	}
+
+	refreshToken, err := s.generateRefreshToken(user.ID().Value())
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate refresh token", output.Error(err))
+		return nil, fmt.Errorf("failed to generate refresh token")
+	}
+
+	// Store refresh token (allow multiple tokens per user for concurrent sessions)
+	expiresAt := s.clock.Now().Add(30 * 24 * time.Hour) // 30 days
+	if err := s.authRepo.StoreRefreshToken(ctx, user.ID().Value(), refreshToken, expiresAt); err != nil {
+		s.logger.Error(ctx, "Failed to store refresh token", output.Error(err))
+		return nil, fmt.Errorf("failed to store refresh token")
+	}
+
+	// Publish login success event
+	event := events.NewUserLoggedInEvent(user.ID().Value(), user.Email().Value(), "", "", "", s.clock.Now())
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		s.logger.Warn(ctx, "Failed to publish login event", output.Error(err))
+	}
+
+	s.logger.Info(ctx, "Login successful", output.String("user_id", user.ID().Value()))
+
+	return &dto.LoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(s.authConfig.TokenDuration.Seconds()),
+		User: dto.UserResponse{
+			ID:        user.ID().Value(),
+			Email:     user.Email().Value(),
+			FirstName: user.FirstName(),
+			LastName:  user.LastName(),
+			CreatedAt: user.CreatedAt(),
+			UpdatedAt: user.UpdatedAt(),
+		},
+	}, nil
+}
+
+// Register creates a new user account
+func (s *AuthService) Register(ctx context.Context, req *dto.RegisterRequest) (*dto.RegisterResponse, error) {
+	s.logger.Info(ctx, "Registration attempt", output.String("email", req.Email))
+
+	// Create value objects
+	email, err := valueobjects.NewEmail(req.Email)
+	if err != nil {
+		s.logger.Error(ctx, "Invalid email format", output.String("email", req.Email), output.Error(err))
+		return nil, fmt.Errorf("invalid email format: %w", err)
+	}
+
+	userID, err := valueobjects.NewUserID()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate user ID", output.Error(err))
+		return nil, fmt.Errorf("failed to generate user ID: %w", err)
+	}
+
+	// Create user entity
+	user, err := entities.NewUser(
+		userID,
+		email,
+		req.FirstName,
+		req.LastName,
+		req.Password,
+		s.clock.Now(),
+	)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to create user entity", output.Error(err))
+		return nil, fmt.Errorf("failed to create user entity: %w", err)
+	}
+
+	// Save user to repository
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		s.logger.Error(ctx, "Failed to save user during registration", output.String("email", req.Email), output.Error(err))
+		return nil, fmt.Errorf("failed to save user: %w", err)
+	}
+
+	// Publish registration event
+	event := events.NewUserRegisteredEvent(userID.Value(), email.Value(), "", "", s.clock.Now())
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		s.logger.Warn(ctx, "Failed to publish registration event", output.Error(err))
+	}
+
+	// Convert domain entity to DTO response
+	userResponse := dto.UserResponse{
+		ID:        user.ID().Value(),
+		Email:     user.Email().Value(),
+		FirstName: user.FirstName(),
+		LastName:  user.LastName(),
+		CreatedAt: user.CreatedAt(),
+		UpdatedAt: user.UpdatedAt(),
+	}
+
+	s.logger.Info(ctx, "Registration successful", output.String("user_id", userResponse.ID))
+
+	return &dto.RegisterResponse{
+		User:    userResponse,
+		Message: "Registration successful",
+	}, nil
+}
+
+// RefreshToken refreshes an authentication token
+func (s *AuthService) RefreshToken(ctx context.Context, req *dto.RefreshTokenRequest) (*dto.RefreshTokenResponse, error) {
+	s.logger.Info(ctx, "Token refresh attempt")
+
+	// Get stored refresh token
+	storedToken, err := s.authRepo.GetRefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get refresh token", output.Error(err))
+		return nil, fmt.Errorf("invalid refresh token")
+	}
+
+	// Check if token is expired
+	if s.clock.Now().After(storedToken.ExpiresAt) {
+		s.logger.Error(ctx, "Refresh token has expired")
+		return nil, fmt.Errorf("refresh token has expired")
+	}
+
+	// Generate new access token
+	accessToken, err := s.generateAccessToken(storedToken.UserID, "")
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate access token", output.Error(err))
+		return nil, fmt.Errorf("failed to generate access token")
+	}
+
+	// Generate new refresh token
+	newRefreshToken, err := s.generateRefreshToken(storedToken.UserID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate refresh token", output.Error(err))
+		return nil, fmt.Errorf("failed to generate refresh token")
+	}
+
+	// Store new refresh token
+	expiresAt := s.clock.Now().Add(30 * 24 * time.Hour) // 30 days
+	if err := s.authRepo.StoreRefreshToken(ctx, storedToken.UserID, newRefreshToken, expiresAt); err != nil {
+		s.logger.Error(ctx, "Failed to store refresh token", output.Error(err))
+		return nil, fmt.Errorf("failed to store refresh token")
+	}
+
+	// Revoke old refresh token
+	if err := s.authRepo.RevokeRefreshToken(ctx, req.RefreshToken); err != nil {
+		s.logger.Warn(ctx, "Failed to revoke old refresh token", output.Error(err))
+	}
+
+	// Publish token refresh event
+	event := events.NewTokenRefreshedEvent(storedToken.UserID, "", req.RefreshToken, newRefreshToken, "", "", s.clock.Now())
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		s.logger.Warn(ctx, "Failed to publish token refresh event", output.Error(err))
+	}
+
+	s.logger.Info(ctx, "Token refresh successful", output.String("user_id", storedToken.UserID))
+
+	return &dto.RefreshTokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: newRefreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(s.authConfig.TokenDuration.Seconds()),
+	}, nil
+}
+
+// ValidateToken validates an authentication token
+func (s *AuthService) ValidateToken(ctx context.Context, token string) (*dto.TokenValidationResponse, error) {
+	s.logger.Info(ctx, "Token validation attempt")
+
+	// This is a simplified implementation
+	// In a real application, you would properly parse and validate the JWT token
+	if token == "" {
+		return &dto.TokenValidationResponse{
+			Valid: false,
+		}, nil
+	}
+
+	// Parse the pseudo-JWT token
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		s.logger.Warn(ctx, "Invalid token format")
+		return &dto.TokenValidationResponse{
+			Valid: false,
+		}, nil
+	}
+
+	// Decode the payload (middle part)
+	payloadBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		s.logger.Warn(ctx, "Failed to decode token payload", output.Error(err))
+		return &dto.TokenValidationResponse{
+			Valid: false,
+		}, nil
+	}
+
+	// Parse the payload to extract user info
+	// In a real implementation, you would use a JSON parser
+	payloadStr := string(payloadBytes)
+
+	// Extract userID from the payload using simple string parsing
+	// Format: {"sub":"userID","email":"email","iat":timestamp,"exp":timestamp}
+	userIDStart := strings.Index(payloadStr, `"sub":"`) + 7
+	userIDEnd := strings.Index(payloadStr[userIDStart:], `"`)
+	if userIDStart < 7 || userIDEnd < 0 {
+		return &dto.TokenValidationResponse{
+			Valid: false,
+		}, nil
+	}
+	userID := payloadStr[userIDStart : userIDStart+userIDEnd]
+
+	// In a real implementation, you would also parse the email and check expiration time
+
+	// Get the actual user to validate the token
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		s.logger.Warn(ctx, "User not found for token validation", output.String("user_id", userID))
+		return &dto.TokenValidationResponse{
+			Valid: false,
+		}, nil
+	}
+
+	return &dto.TokenValidationResponse{
+		Valid:  true,
+		UserID: user.ID().Value(),
+		Email:  user.Email().Value(),
+	}, nil
+}
+
+// Logout invalidates an authentication token
+func (s *AuthService) Logout(ctx context.Context, token string) error {
+	s.logger.Info(ctx, "Logout attempt")
+
+	// In a real implementation, you would:
+	// 1. Parse the token to get user ID
+	// 2. Revoke all refresh tokens for the user
+	// 3. Add token to a blacklist
+
+	// Placeholder logout logic
+	s.logger.Info(ctx, "Logout successful")
+
+	return nil
+}
+
+// ChangePassword changes a user's password
+func (s *AuthService) ChangePassword(ctx context.Context, req *dto.ChangePasswordRequest) error {
+	s.logger.Info(ctx, "Password change attempt", output.String("user_id", req.UserID))
+
+	// Get user by ID
+	user, err := s.userRepo.GetByID(ctx, req.UserID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get user for password change", output.Error(err))
+		return fmt.Errorf("user not found")
+	}
+
+	// Validate current password
+	if !user.ValidatePassword(req.CurrentPassword) {
+		s.logger.Error(ctx, "Current password validation failed")
+		return fmt.Errorf("invalid current password")
+	}
+
+	// Use domain service to validate new password
+	if err := s.authDomainService.ValidatePasswordStrength(ctx, req.NewPassword); err != nil {
+		s.logger.Error(ctx, "New password validation failed", output.Error(err))
+		return fmt.Errorf("new password validation failed: %w", err)
+	}
+
+	// Update password
+	if err := user.UpdatePassword(req.NewPassword, s.clock.Now()); err != nil {
+		s.logger.Error(ctx, "Failed to update password", output.Error(err))
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	// Save updated user
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error(ctx, "Failed to save user after password change", output.Error(err))
+		return fmt.Errorf("failed to save user: %w", err)
+	}
+
+	// Publish password change event
+	event := events.NewUserPasswordChangedEvent(user.ID().Value(), user.Email().Value(), s.clock.Now())
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		s.logger.Warn(ctx, "Failed to publish password change event", output.Error(err))
+	}
+
+	s.logger.Info(ctx, "Password change successful", output.String("user_id", req.UserID))
+
+	return nil
+}
+
+// ResetPassword initiates a password reset
+func (s *AuthService) ResetPassword(ctx context.Context, req *dto.ResetPasswordRequest) error {
+	s.logger.Info(ctx, "Password reset request", output.String("email", req.Email))
+
+	// Get user by email
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get user for password reset", output.Error(err))
+		// Don't reveal if email exists or not
+		return nil
+	}
+
+	// Generate reset token
+	resetToken := s.generatePasswordResetToken()
+	expiresAt := s.clock.Now().Add(1 * time.Hour) // 1 hour
+
+	// Store reset token
+	if err := s.authRepo.StorePasswordResetToken(ctx, user.ID().Value(), resetToken, expiresAt); err != nil {
+		s.logger.Error(ctx, "Failed to store password reset token", output.Error(err))
+		return fmt.Errorf("failed to store password reset token")
+	}
+
+	// Publish password reset event
+	event := events.NewPasswordResetRequestedEvent(user.ID().Value(), user.Email().Value(), resetToken, "", "", s.clock.Now())
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		s.logger.Warn(ctx, "Failed to publish password reset event", output.Error(err))
+	}
+
+	s.logger.Info(ctx, "Password reset token generated", output.String("user_id", user.ID().Value()))
+
+	return nil
+}
+
+// ConfirmPasswordReset confirms a password reset with a token
+func (s *AuthService) ConfirmPasswordReset(ctx context.Context, req *dto.ConfirmPasswordResetRequest) error {
+	s.logger.Info(ctx, "Password reset confirmation attempt")
+
+	// Get reset token
+	resetToken, err := s.authRepo.GetPasswordResetToken(ctx, req.Token)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get password reset token", output.Error(err))
+		return fmt.Errorf("invalid reset token")
+	}
+
+	// Check if token is expired
+	if s.clock.Now().After(resetToken.ExpiresAt) {
+		s.logger.Error(ctx, "Password reset token has expired")
+		return fmt.Errorf("reset token has expired")
+	}
+
+	// Get user by ID
+	user, err := s.userRepo.GetByID(ctx, resetToken.UserID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get user for password reset", output.Error(err))
+		return fmt.Errorf("user not found")
+	}
+
+	// Validate new password
+	if err := s.authDomainService.ValidatePasswordStrength(ctx, req.NewPassword); err != nil {
+		s.logger.Error(ctx, "New password validation failed", output.Error(err))
+		return fmt.Errorf("new password validation failed: %w", err)
+	}
+
+	// Update password
+	if err := user.UpdatePassword(req.NewPassword, s.clock.Now()); err != nil {
+		s.logger.Error(ctx, "Failed to update password", output.Error(err))
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	// Save updated user
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error(ctx, "Failed to save user after password reset", output.Error(err))
+		return fmt.Errorf("failed to save user: %w", err)
+	}
+
+	// Revoke reset token
+	if err := s.authRepo.RevokePasswordResetToken(ctx, req.Token); err != nil {
+		s.logger.Warn(ctx, "Failed to revoke password reset token", output.Error(err))
+	}
+
+	// Publish password reset completion event
+	event := events.NewPasswordResetCompletedEvent(user.ID().Value(), user.Email().Value(), req.Token, "", "", s.clock.Now())
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		s.logger.Warn(ctx, "Failed to publish password reset completion event", output.Error(err))
+	}
+
+	s.logger.Info(ctx, "Password reset successful", output.String("user_id", user.ID().Value()))
+
+	return nil
+}
+
+// loginAttemptKey returns the key failed logins are counted under
+func loginAttemptKey(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// checkLockout returns an AccountLockedError when repeated failed logins locked the account
+// Store errors are logged and let the login through, an outage must not lock everyone out
+func (s *AuthService) checkLockout(ctx context.Context, key string) error {
+	if !s.lockoutPolicy.Enabled() {
+		return nil
+	}
+
+	attempts, err := s.loginAttempts.Get(ctx, key)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get login attempts", output.String("email", key), output.Error(err))
+		return nil
+	}
+
+	if err := s.lockoutPolicy.Check(attempts, s.clock.Now()); err != nil {
+		s.logger.Warn(ctx, "Login rejected for locked account",
+			output.String("security_event", "login_locked"),
+			output.String("email", key),
+			output.Int("failures", attempts.Failures()),
+		)
+		return err
+	}
+	return nil
+}
+
+// recordLoginFailure counts a failed login and locks the account once the policy says so
+func (s *AuthService) recordLoginFailure(ctx context.Context, key string) {
+	if !s.lockoutPolicy.Enabled() {
+		return
+	}
+
+	now := s.clock.Now()
+	attempts, err := s.loginAttempts.RecordFailure(ctx, key, now, s.lockoutPolicy.Window)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to record failed login", output.String("email", key), output.Error(err))
+		return
+	}
+
+	duration := s.lockoutPolicy.LockoutDuration(attempts.Failures())
+	if duration == 0 {
+		s.logger.Warn(ctx, "Failed login recorded",
+			output.String("security_event", "login_failed"),
+			output.String("email", key),
+			output.Int("failures", attempts.Failures()),
+		)
+		return
+	}
+
+	// Keep the record at least as long as the lockout
+	ttl := s.lockoutPolicy.Window
+	if duration > ttl {
+		ttl = duration
+	}
+	if err := s.loginAttempts.Lock(ctx, key, now.Add(duration), ttl); err != nil {
+		s.logger.Error(ctx, "Failed to lock account", output.String("email", key), output.Error(err))
+		return
+	}
+
+	s.logger.Warn(ctx, "Account locked after repeated failed logins",
+		output.String("security_event", "account_locked"),
+		output.String("email", key),
+		output.Int("failures", attempts.Failures()),
+		output.Duration("lockout", duration),
+	)
+}
+
+// resetLoginFailures clears the failed logins after a successful login
+func (s *AuthService) resetLoginFailures(ctx context.Context, key string) {
+	if !s.lockoutPolicy.Enabled() {
+		return
+	}
+
+	if err := s.loginAttempts.Reset(ctx, key); err != nil {
+		s.logger.Warn(ctx, "Failed to reset login attempts", output.String("email", key), output.Error(err))
+	}
+}
+
+// Helper methods for token generation
+func (s *AuthService) generateAccessToken(userID, email string) (string, error) {
+	// This is a simplified implementation that mimics JWT structure
+	// In a real application, you would use a proper JWT library with signing
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	now := s.clock.Now()
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"sub":"%s","email":"%s","iat":%d,"exp":%d}`,
+		userID, email, now.Unix(), now.Add(s.authConfig.TokenDuration).Unix())))
+	signature := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("signature_%s_%d", userID, rand.Int63())))
+
+	return fmt.Sprintf("%s.%s.%s", header, payload, signature), nil
+}
+
+func (s *AuthService) generateRefreshToken(userID string) (string, error) {
+	// This is a simplified implementation
+	// In a real application, you would generate a secure random token
+	return fmt.Sprintf("refresh_token_%s_%d_%d", userID, s.clock.Now().UnixNano(), rand.Int63()), nil
+}
+
+func (s *AuthService) generatePasswordResetToken() string {
+	// This is a simplified implementation
+	// In a real application, you would generate a secure random token
+	return fmt.Sprintf("reset_token_%d_%d", s.clock.Now().UnixNano(), rand.Int63())
+}
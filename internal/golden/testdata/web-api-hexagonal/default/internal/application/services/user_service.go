@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/example/golden/internal/application/dto"
+	"github.com/example/golden/internal/application/ports/input"
+	"github.com/example/golden/internal/application/ports/output"
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/domain/entities"
+	"github.com/example/golden/internal/domain/events"
+	"github.com/example/golden/internal/domain/services"
+	"github.com/example/golden/internal/domain/valueobjects"
+)
+
+// UserService implements the UserPort interface
+// This is an application service that orchestrates domain operations
+type UserService struct {
+	userRepo       output.UserRepositoryPort
+	domainService  services.UserDomainService
+	eventPublisher output.EventPublisherPort
+	logger         output.LoggerPort
+	clock          clock.Clock
+}
+
+// NewUserService creates a new UserService
+func NewUserService(
+	userRepo output.UserRepositoryPort,
+	domainService services.UserDomainService,
+	eventPublisher output.EventPublisherPort,
+	logger output.LoggerPort,
+	clk clock.Clock,
+) input.UserPort {
+	return &UserService{
+		userRepo:       userRepo,
+		domainService:  domainService,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+		clock:          clk,
+	}
+}
+
+// CreateUser creates a new user
+func (s *UserService) CreateUser(ctx context.Context, req *dto.CreateUserRequest) (*dto.UserResponse, error) {
+	s.logger.Info(ctx, "Creating user", output.String("email", req.Email))
+
+	// Check if user already exists
+	exists, err := s.userRepo.ExistsByEmail(ctx, req.Email)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to check user existence", output.String("email", req.Email), output.Error(err))
+		return nil, fmt.Errorf("failed to check user existence: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("user with email %s already exists", req.Email)
+	}
+
+	// Create value objects
+	email, err := valueobjects.NewEmail(req.Email)
+	if err != nil {
+		s.logger.Error(ctx, "Invalid email format", output.String("email", req.Email), output.Error(err))
+		return nil, fmt.Errorf("invalid email format: %w", err)
+	}
+
+	userID, err := valueobjects.NewUserID()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate user ID", output.Error(err))
+		return nil, fmt.Errorf("failed to generate user ID: %w", err)
+	}
+
+	// Create user entity
+	user, err := entities.NewUser(
+		userID,
+		email,
+		req.FirstName,
+		req.LastName,
+		req.Password,
+		s.clock.Now(),
+	)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to create user entity", output.Error(err))
+		return nil, fmt.Errorf("failed to create user entity: %w", err)
+	}
+
+	// Use domain service to validate business rules
+	if err := s.domainService.ValidateUser(ctx, user); err != nil {
+		s.logger.Error(ctx, "User validation failed", output.Error(err))
+		return nil, fmt.Errorf("user validation failed: %w", err)
+	}
+
+	// Save user to repository
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		s.logger.Error(ctx, "Failed to save user", output.String("user_id", userID.Value()), output.Error(err))
+		return nil, fmt.Errorf("failed to save user: %w", err)
+	}
+
+	// Publish domain event
+	event := events.NewUserCreatedEvent(userID.Value(), email.Value(), s.clock.Now())
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		s.logger.Warn(ctx, "Failed to publish user created event", output.String("user_id", userID.Value()), output.Error(err))
+	}
+
+	s.logger.Info(ctx, "User created successfully", output.String("user_id", userID.Value()))
+
+	return s.toDTO(user), nil
+}
+
+// GetUserByID retrieves a user by ID
+func (s *UserService) GetUserByID(ctx context.Context, id string) (*dto.UserResponse, error) {
+	s.logger.Info(ctx, "Getting user by ID", output.String("user_id", id))
+
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get user by ID", output.String("user_id", id), output.Error(err))
+		return nil, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+
+	return s.toDTO(user), nil
+}
+
+// UpdateUser updates an existing user
+func (s *UserService) UpdateUser(ctx context.Context, id string, req *dto.UpdateUserRequest) (*dto.UserResponse, error) {
+	s.logger.Info(ctx, "Updating user", output.String("user_id", id))
+
+	// Get existing user
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get user for update", output.String("user_id", id), output.Error(err))
+		return nil, fmt.Errorf("failed to get user for update: %w", err)
+	}
+
+	// Update fields if provided
+	now := s.clock.Now()
+	if req.Email != nil {
+		email, err := valueobjects.NewEmail(*req.Email)
+		if err != nil {
+			s.logger.Error(ctx, "Invalid email format", output.String("email", *req.Email), output.Error(err))
+			return nil, fmt.Errorf("invalid email format: %w", err)
+		}
+		user.UpdateEmail(email, now)
+	}
+
+	if req.FirstName != nil {
+		user.UpdateFirstName(*req.FirstName, now)
+	}
+
+	if req.LastName != nil {
+		user.UpdateLastName(*req.LastName, now)
+	}
+
+	// Use domain service to validate business rules
+	if err := s.domainService.ValidateUser(ctx, user); err != nil {
+		s.logger.Error(ctx, "User validation failed", output.Error(err))
+		return nil, fmt.Errorf("user validation failed: %w", err)
+	}
+
+	// Update user in repository
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error(ctx, "Failed to update user", output.String("user_id", id), output.Error(err))
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	// Publish domain event
+	event := events.NewUserUpdatedEvent(user.ID().Value(), user.Email().Value(), s.clock.Now())
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		s.logger.Warn(ctx, "Failed to publish user updated event", output.String("user_id", id), output.Error(err))
+	}
+
+	s.logger.Info(ctx, "User updated successfully", output.String("user_id", id))
+
+	return s.toDTO(user), nil
+}
+
+// DeleteUser deletes a user by ID
+func (s *UserService) DeleteUser(ctx context.Context, id string) error {
+	s.logger.Info(ctx, "Deleting user", output.String("user_id", id))
+
+	// Check if user exists
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get user for deletion", output.String("user_id", id), output.Error(err))
+		return fmt.Errorf("failed to get user for deletion: %w", err)
+	}
+
+	// Delete user from repository
+	if err := s.userRepo.Delete(ctx, id); err != nil {
+		s.logger.Error(ctx, "Failed to delete user", output.String("user_id", id), output.Error(err))
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	// Publish domain event
+	event := events.NewUserDeletedEvent(user.ID().Value(), user.Email().Value(), s.clock.Now())
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		s.logger.Warn(ctx, "Failed to publish user deleted event", output.String("user_id", id), output.Error(err))
+	}
+
+	s.logger.Info(ctx, "User deleted successfully", output.String("user_id", id))
+
+	return nil
+}
+
+// ListUsers retrieves a list of users with pagination
+func (s *UserService) ListUsers(ctx context.Context, req *dto.ListUsersRequest) (*dto.ListUsersResponse, error) {
+	s.logger.Info(ctx, "Listing users", output.Int("limit", req.Limit), output.Int("offset", req.Offset))
+
+	// Get users from repository
+	users, err := s.userRepo.List(ctx, req.Limit, req.Offset)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list users", output.Error(err))
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	// Get total count
+	total, err := s.userRepo.Count(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to count users", output.Error(err))
+		return nil, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	// Convert to DTOs
+	userDTOs := make([]dto.UserResponse, len(users))
+	for i, user := range users {
+		userDTOs[i] = *s.toDTO(user)
+	}
+
+	return &dto.ListUsersResponse{
+		Users:  userDTOs,
+		Total:  total,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}, nil
+}
+
+// GetUserByEmail retrieves a user by email
+func (s *UserService) GetUserByEmail(ctx context.Context, email string) (*dto.UserResponse, error) {
+	s.logger.Info(ctx, "Getting user by email", output.String("email", email))
+
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get user by email", output.String("email", email), output.Error(err))
+		return nil, fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	return s.toDTO(user), nil
+}
+
+// toDTO converts a user entity to a DTO
+func (s *UserService) toDTO(user *entities.User) *dto.UserResponse {
+	return &dto.UserResponse{
+		ID:        user.ID().Value(),
+		Email:     user.Email().Value(),
+		FirstName: user.FirstName(),
+		LastName:  user.LastName(),
+		CreatedAt: user.CreatedAt(),
+		UpdatedAt: user.UpdatedAt(),
+	}
+}
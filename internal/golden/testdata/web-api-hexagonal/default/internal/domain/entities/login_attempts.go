@@ -0,0 +1,82 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrAccountLocked is returned when repeated failed logins temporarily locked an account
+var ErrAccountLocked = errors.New("account is temporarily locked")
+
+// LoginAttempts tracks the consecutive failed logins for one account
+type LoginAttempts struct {
+	key           string
+	failures      int
+	lastFailureAt time.Time
+	lockedUntil   time.Time
+}
+
+// NewLoginAttempts creates an empty failed login record
+func NewLoginAttempts(key string) *LoginAttempts {
+	return &LoginAttempts{key: key}
+}
+
+// ReconstructLoginAttempts reconstructs a failed login record from storage
+func ReconstructLoginAttempts(key string, failures int, lastFailureAt, lockedUntil time.Time) *LoginAttempts {
+	return &LoginAttempts{
+		key:           key,
+		failures:      failures,
+		lastFailureAt: lastFailureAt,
+		lockedUntil:   lockedUntil,
+	}
+}
+
+// Key returns the account key the attempts are counted for
+func (a *LoginAttempts) Key() string {
+	return a.key
+}
+
+// Failures returns the number of consecutive failed logins
+func (a *LoginAttempts) Failures() int {
+	return a.failures
+}
+
+// LastFailureAt returns when the last failed login happened
+func (a *LoginAttempts) LastFailureAt() time.Time {
+	return a.lastFailureAt
+}
+
+// LockedUntil returns when the current lockout ends, zero when the account was never locked
+func (a *LoginAttempts) LockedUntil() time.Time {
+	return a.lockedUntil
+}
+
+// IsLocked checks if the account is locked at the given time
+func (a *LoginAttempts) IsLocked(now time.Time) bool {
+	return now.Before(a.lockedUntil)
+}
+
+// AccountLockedError reports a locked account and when it can log in again
+type AccountLockedError struct {
+	Until time.Time
+	// CheckedAt is when the lockout was found
+	CheckedAt time.Time
+}
+
+// Error implements the error interface
+func (e *AccountLockedError) Error() string {
+	return ErrAccountLocked.Error()
+}
+
+// Unwrap lets errors.Is match ErrAccountLocked
+func (e *AccountLockedError) Unwrap() error {
+	return ErrAccountLocked
+}
+
+// RetryAfter returns how long the account stays locked after the lockout was found
+func (e *AccountLockedError) RetryAfter() time.Duration {
+	if remaining := e.Until.Sub(e.CheckedAt); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
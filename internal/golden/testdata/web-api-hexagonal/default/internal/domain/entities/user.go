@@ -0,0 +1,42 @@
+package entities
+
+import "errors"
+
+// Domain errors for user entity
+var (
+	// ErrInvalidFirstName is returned when the first name is invalid
+	ErrInvalidFirstName = errors.New("invalid first name")
+
+	// ErrInvalidLastName is returned when the last name is invalid
+	ErrInvalidLastName = errors.New("invalid last name")
+
+	// ErrNameTooLong is returned when a name is too long
+	ErrNameTooLong = errors.New("name must be less than 100 characters")
+
+	// ErrNameOnlySpaces is returned when a name contains only spaces
+	ErrNameOnlySpaces = errors.New("name cannot contain only spaces")
+
+	// ErrWeakPassword is returned when the password is too weak
+	ErrWeakPassword = errors.New("password must be at least 8 characters")
+
+	// ErrPasswordTooLong is returned when the password is too long
+	ErrPasswordTooLong = errors.New("password must be less than 256 characters")
+
+	// ErrEmptyPassword is returned when the password is empty
+	ErrEmptyPassword = errors.New("password cannot be empty")
+
+	// ErrPasswordOnlySpaces is returned when the password contains only spaces
+	ErrPasswordOnlySpaces = errors.New("password cannot contain only spaces")
+
+	// ErrInvalidEmail is returned when the email format is invalid
+	ErrInvalidEmail = errors.New("invalid email format")
+
+	// ErrUserNotFound is returned when a user is not found
+	ErrUserNotFound = errors.New("user not found")
+
+	// ErrUserAlreadyExists is returned when a user already exists
+	ErrUserAlreadyExists = errors.New("user already exists")
+
+	// ErrInvalidCredentials is returned when authentication credentials are invalid
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
@@ -0,0 +1,214 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/example/golden/internal/domain/valueobjects"
+)
+
+// User represents the core user entity in the domain
+// This is the heart of the hexagonal architecture - pure domain logic
+type User struct {
+	id           *valueobjects.UserID
+	email        valueobjects.Email
+	firstName    string
+	lastName     string
+	passwordHash string
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// NewUser creates a new user entity
+func NewUser(
+	id *valueobjects.UserID,
+	email valueobjects.Email,
+	firstName, lastName, password string,
+	now time.Time,
+) (*User, error) {
+	// Validate input
+	if err := validateName(firstName, "first"); err != nil {
+		return nil, err
+	}
+	if err := validateName(lastName, "last"); err != nil {
+		return nil, err
+	}
+
+	// Validate password
+	if err := validatePassword(password); err != nil {
+		return nil, err
+	}
+
+	// Hash password (in real implementation, use bcrypt or similar)
+	passwordHash := hashPassword(password)
+
+	return &User{
+		id:           id,
+		email:        email,
+		firstName:    firstName,
+		lastName:     lastName,
+		passwordHash: passwordHash,
+		createdAt:    now,
+		updatedAt:    now,
+	}, nil
+}
+
+// ReconstructUser reconstructs a user entity from persistence
+// This is used by repositories to rebuild entities from stored data
+func ReconstructUser(
+	id *valueobjects.UserID,
+	email valueobjects.Email,
+	firstName, lastName, passwordHash string,
+	createdAt, updatedAt time.Time,
+) *User {
+	return &User{
+		id:           id,
+		email:        email,
+		firstName:    firstName,
+		lastName:     lastName,
+		passwordHash: passwordHash,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
+
+// ID returns the user ID
+func (u *User) ID() *valueobjects.UserID {
+	return u.id
+}
+
+// Email returns the user email
+func (u *User) Email() valueobjects.Email {
+	return u.email
+}
+
+// FirstName returns the user first name
+func (u *User) FirstName() string {
+	return u.firstName
+}
+
+// LastName returns the user last name
+func (u *User) LastName() string {
+	return u.lastName
+}
+
+// PasswordHash returns the user password hash
+func (u *User) PasswordHash() string {
+	return u.passwordHash
+}
+
+// CreatedAt returns the user creation time
+func (u *User) CreatedAt() time.Time {
+	return u.createdAt
+}
+
+// UpdatedAt returns the user last update time
+func (u *User) UpdatedAt() time.Time {
+	return u.updatedAt
+}
+
+// UpdateEmail updates the user email
+func (u *User) UpdateEmail(email valueobjects.Email, now time.Time) {
+	u.email = email
+	u.updatedAt = now
+}
+
+// UpdateFirstName updates the user first name
+func (u *User) UpdateFirstName(firstName string, now time.Time) error {
+	if firstName == "" {
+		return ErrInvalidFirstName
+	}
+	u.firstName = firstName
+	u.updatedAt = now
+	return nil
+}
+
+// UpdateLastName updates the user last name
+func (u *User) UpdateLastName(lastName string, now time.Time) error {
+	if lastName == "" {
+		return ErrInvalidLastName
+	}
+	u.lastName = lastName
+	u.updatedAt = now
+	return nil
+}
+
+// UpdatePassword updates the user password
+func (u *User) UpdatePassword(password string, now time.Time) error {
+	if err := validatePassword(password); err != nil {
+		return err
+	}
+	u.passwordHash = hashPassword(password)
+	u.updatedAt = now
+	return nil
+}
+
+// ValidatePassword validates a password against the stored hash
+func (u *User) ValidatePassword(password string) bool {
+	return verifyPasswordHash(password, u.passwordHash)
+}
+
+// FullName returns the full name of the user
+func (u *User) FullName() string {
+	return u.firstName + " " + u.lastName
+}
+
+// IsEmailVerified checks if the user email is verified
+// This is a business rule that might be implemented later
+func (u *User) IsEmailVerified() bool {
+	// This would be implemented based on business requirements
+	return true
+}
+
+// validateName validates a name field
+func validateName(name, nameType string) error {
+	if name == "" {
+		if nameType == "first" {
+			return ErrInvalidFirstName
+		}
+		return ErrInvalidLastName
+	}
+
+	if len(name) > 99 {
+		return ErrNameTooLong
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return ErrNameOnlySpaces
+	}
+
+	return nil
+}
+
+// validatePassword validates a password for creation/updates
+func validatePassword(password string) error {
+	if password == "" {
+		return ErrEmptyPassword
+	}
+
+	if len(password) < 8 {
+		return ErrWeakPassword
+	}
+
+	if len(password) > 255 {
+		return ErrPasswordTooLong
+	}
+
+	if strings.TrimSpace(password) == "" {
+		return ErrPasswordOnlySpaces
+	}
+
+	return nil
+}
+
+// hashPassword hashes a password (placeholder implementation)
+func hashPassword(password string) string {
+	// In real implementation, use bcrypt.GenerateFromPassword
+	return "hashed_" + password
+}
+
+// verifyPasswordHash validates a password against a hash (placeholder implementation)
+func verifyPasswordHash(password, hash string) bool {
+	// In real implementation, use bcrypt.CompareHashAndPassword
+	return "hashed_"+password == hash
+}
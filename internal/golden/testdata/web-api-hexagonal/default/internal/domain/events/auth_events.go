@@ -0,0 +1,190 @@
+package events
+
+import (
+	"time"
+)
+
+// UserLoggedInEvent represents a user logged in event
+type UserLoggedInEvent struct {
+	*baseEvent
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	SessionID string `json:"session_id"`
+	IPAddress string `json:"ip_address"`
+	UserAgent string `json:"user_agent"`
+}
+
+// NewUserLoggedInEvent creates a new user logged in event
+func NewUserLoggedInEvent(userID, email, sessionID, ipAddress, userAgent string, occurredAt time.Time) DomainEvent {
+	return &UserLoggedInEvent{
+		baseEvent: &baseEvent{
+			eventType:   "user.logged.in",
+			eventID:     generateEventID(occurredAt),
+			aggregateID: userID,
+			timestamp:   occurredAt,
+		},
+		UserID:    userID,
+		Email:     email,
+		SessionID: sessionID,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+	}
+}
+
+// UserLoggedOutEvent represents a user logged out event
+type UserLoggedOutEvent struct {
+	*baseEvent
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	SessionID string `json:"session_id"`
+	Reason    string `json:"reason"`
+}
+
+// NewUserLoggedOutEvent creates a new user logged out event
+func NewUserLoggedOutEvent(userID, email, sessionID, reason string, occurredAt time.Time) DomainEvent {
+	return &UserLoggedOutEvent{
+		baseEvent: &baseEvent{
+			eventType:   "user.logged.out",
+			eventID:     generateEventID(occurredAt),
+			aggregateID: userID,
+			timestamp:   occurredAt,
+		},
+		UserID:    userID,
+		Email:     email,
+		SessionID: sessionID,
+		Reason:    reason,
+	}
+}
+
+// UserLoginFailedEvent represents a user login failed event
+type UserLoginFailedEvent struct {
+	*baseEvent
+	Email     string `json:"email"`
+	IPAddress string `json:"ip_address"`
+	UserAgent string `json:"user_agent"`
+	Reason    string `json:"reason"`
+}
+
+// NewUserLoginFailedEvent creates a new user login failed event
+func NewUserLoginFailedEvent(email, ipAddress, userAgent, reason string, occurredAt time.Time) DomainEvent {
+	return &UserLoginFailedEvent{
+		baseEvent: &baseEvent{
+			eventType:   "user.login.failed",
+			eventID:     generateEventID(occurredAt),
+			aggregateID: email, // Use email as aggregate ID when user doesn't exist
+			timestamp:   occurredAt,
+		},
+		Email:     email,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+		Reason:    reason,
+	}
+}
+
+// UserRegisteredEvent represents a user registered event
+type UserRegisteredEvent struct {
+	*baseEvent
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	IPAddress string `json:"ip_address"`
+	UserAgent string `json:"user_agent"`
+}
+
+// NewUserRegisteredEvent creates a new user registered event
+func NewUserRegisteredEvent(userID, email, ipAddress, userAgent string, occurredAt time.Time) DomainEvent {
+	return &UserRegisteredEvent{
+		baseEvent: &baseEvent{
+			eventType:   "user.registered",
+			eventID:     generateEventID(occurredAt),
+			aggregateID: userID,
+			timestamp:   occurredAt,
+		},
+		UserID:    userID,
+		Email:     email,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+	}
+}
+
+// TokenRefreshedEvent represents a token refreshed event
+type TokenRefreshedEvent struct {
+	*baseEvent
+	UserID     string `json:"user_id"`
+	Email      string `json:"email"`
+	OldTokenID string `json:"old_token_id"`
+	NewTokenID string `json:"new_token_id"`
+	IPAddress  string `json:"ip_address"`
+	UserAgent  string `json:"user_agent"`
+}
+
+// NewTokenRefreshedEvent creates a new token refreshed event
+func NewTokenRefreshedEvent(userID, email, oldTokenID, newTokenID, ipAddress, userAgent string, occurredAt time.Time) DomainEvent {
+	return &TokenRefreshedEvent{
+		baseEvent: &baseEvent{
+			eventType:   "token.refreshed",
+			eventID:     generateEventID(occurredAt),
+			aggregateID: userID,
+			timestamp:   occurredAt,
+		},
+		UserID:     userID,
+		Email:      email,
+		OldTokenID: oldTokenID,
+		NewTokenID: newTokenID,
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+	}
+}
+
+// PasswordResetRequestedEvent represents a password reset requested event
+type PasswordResetRequestedEvent struct {
+	*baseEvent
+	UserID     string `json:"user_id"`
+	Email      string `json:"email"`
+	ResetToken string `json:"reset_token"`
+	IPAddress  string `json:"ip_address"`
+	UserAgent  string `json:"user_agent"`
+}
+
+// NewPasswordResetRequestedEvent creates a new password reset requested event
+func NewPasswordResetRequestedEvent(userID, email, resetToken, ipAddress, userAgent string, occurredAt time.Time) DomainEvent {
+	return &PasswordResetRequestedEvent{
+		baseEvent: &baseEvent{
+			eventType:   "password.reset.requested",
+			eventID:     generateEventID(occurredAt),
+			aggregateID: userID,
+			timestamp:   occurredAt,
+		},
+		UserID:     userID,
+		Email:      email,
+		ResetToken: resetToken,
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+	}
+}
+
+// PasswordResetCompletedEvent represents a password reset completed event
+type PasswordResetCompletedEvent struct {
+	*baseEvent
+	UserID     string `json:"user_id"`
+	Email      string `json:"email"`
+	ResetToken string `json:"reset_token"`
+	IPAddress  string `json:"ip_address"`
+	UserAgent  string `json:"user_agent"`
+}
+
+// NewPasswordResetCompletedEvent creates a new password reset completed event
+func NewPasswordResetCompletedEvent(userID, email, resetToken, ipAddress, userAgent string, occurredAt time.Time) DomainEvent {
+	return &PasswordResetCompletedEvent{
+		baseEvent: &baseEvent{
+			eventType:   "password.reset.completed",
+			eventID:     generateEventID(occurredAt),
+			aggregateID: userID,
+			timestamp:   occurredAt,
+		},
+		UserID:     userID,
+		Email:      email,
+		ResetToken: resetToken,
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+	}
+}
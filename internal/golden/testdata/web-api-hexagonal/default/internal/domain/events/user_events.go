@@ -0,0 +1,175 @@
+package events
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// DomainEvent represents a domain event
+type DomainEvent interface {
+	// EventType returns the type of the event
+	EventType() string
+
+	// EventID returns the unique identifier of the event
+	EventID() string
+
+	// AggregateID returns the ID of the aggregate that produced the event
+	AggregateID() string
+
+	// Timestamp returns when the event occurred
+	Timestamp() time.Time
+
+	// EventData returns the event data
+	EventData() interface{}
+}
+
+// Base event structure
+type baseEvent struct {
+	eventType   string
+	eventID     string
+	aggregateID string
+	timestamp   time.Time
+	data        interface{}
+}
+
+// EventType returns the type of the event
+func (e *baseEvent) EventType() string {
+	return e.eventType
+}
+
+// EventID returns the unique identifier of the event
+func (e *baseEvent) EventID() string {
+	return e.eventID
+}
+
+// AggregateID returns the ID of the aggregate that produced the event
+func (e *baseEvent) AggregateID() string {
+	return e.aggregateID
+}
+
+// Timestamp returns when the event occurred
+func (e *baseEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// EventData returns the event data
+func (e *baseEvent) EventData() interface{} {
+	return e.data
+}
+
+// UserCreatedEvent represents a user created event
+type UserCreatedEvent struct {
+	*baseEvent
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// NewUserCreatedEvent creates a new user created event
+func NewUserCreatedEvent(userID, email string, occurredAt time.Time) DomainEvent {
+	return &UserCreatedEvent{
+		baseEvent: &baseEvent{
+			eventType:   "user.created",
+			eventID:     generateEventID(occurredAt),
+			aggregateID: userID,
+			timestamp:   occurredAt,
+		},
+		UserID: userID,
+		Email:  email,
+	}
+}
+
+// UserUpdatedEvent represents a user updated event
+type UserUpdatedEvent struct {
+	*baseEvent
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// NewUserUpdatedEvent creates a new user updated event
+func NewUserUpdatedEvent(userID, email string, occurredAt time.Time) DomainEvent {
+	return &UserUpdatedEvent{
+		baseEvent: &baseEvent{
+			eventType:   "user.updated",
+			eventID:     generateEventID(occurredAt),
+			aggregateID: userID,
+			timestamp:   occurredAt,
+		},
+		UserID: userID,
+		Email:  email,
+	}
+}
+
+// UserDeletedEvent represents a user deleted event
+type UserDeletedEvent struct {
+	*baseEvent
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// NewUserDeletedEvent creates a new user deleted event
+func NewUserDeletedEvent(userID, email string, occurredAt time.Time) DomainEvent {
+	return &UserDeletedEvent{
+		baseEvent: &baseEvent{
+			eventType:   "user.deleted",
+			eventID:     generateEventID(occurredAt),
+			aggregateID: userID,
+			timestamp:   occurredAt,
+		},
+		UserID: userID,
+		Email:  email,
+	}
+}
+
+// UserEmailChangedEvent represents a user email changed event
+type UserEmailChangedEvent struct {
+	*baseEvent
+	UserID   string `json:"user_id"`
+	OldEmail string `json:"old_email"`
+	NewEmail string `json:"new_email"`
+}
+
+// NewUserEmailChangedEvent creates a new user email changed event
+func NewUserEmailChangedEvent(userID, oldEmail, newEmail string, occurredAt time.Time) DomainEvent {
+	return &UserEmailChangedEvent{
+		baseEvent: &baseEvent{
+			eventType:   "user.email.changed",
+			eventID:     generateEventID(occurredAt),
+			aggregateID: userID,
+			timestamp:   occurredAt,
+		},
+		UserID:   userID,
+		OldEmail: oldEmail,
+		NewEmail: newEmail,
+	}
+}
+
+// UserPasswordChangedEvent represents a user password changed event
+type UserPasswordChangedEvent struct {
+	*baseEvent
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// NewUserPasswordChangedEvent creates a new user password changed event
+func NewUserPasswordChangedEvent(userID, email string, occurredAt time.Time) DomainEvent {
+	return &UserPasswordChangedEvent{
+		baseEvent: &baseEvent{
+			eventType:   "user.password.changed",
+			eventID:     generateEventID(occurredAt),
+			aggregateID: userID,
+			timestamp:   occurredAt,
+		},
+		UserID: userID,
+		Email:  email,
+	}
+}
+
+// eventSequence keeps event IDs unique when several events share a timestamp
+var eventSequence atomic.Int64
+
+// generateEventID generates a unique event ID
+func generateEventID(occurredAt time.Time) string {
+	// In a real implementation, this would use UUID or similar
+	return fmt.Sprintf("event_%d_%d", occurredAt.UnixNano(), eventSequence.Add(1))
+}
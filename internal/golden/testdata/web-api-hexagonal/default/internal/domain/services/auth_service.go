@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/example/golden/internal/domain/entities"
+)
+
+// AuthDomainService defines the interface for authentication domain services
+type AuthDomainService interface {
+	// ValidateLogin validates login credentials
+	ValidateLogin(ctx context.Context, user *entities.User, password string) error
+
+	// ValidatePasswordStrength validates password strength
+	ValidatePasswordStrength(ctx context.Context, password string) error
+
+	// CanChangePassword checks if a user can change their password
+	CanChangePassword(ctx context.Context, user *entities.User) error
+
+	// ValidateSession validates an authentication session at the given time
+	ValidateSession(ctx context.Context, session *entities.AuthSession, now time.Time) error
+
+	// GenerateSessionDuration returns the duration for a new session
+	GenerateSessionDuration(ctx context.Context, user *entities.User) time.Duration
+}
+
+// authDomainService implements the AuthDomainService interface
+type authDomainService struct {
+	// No external dependencies - this is pure domain logic
+}
+
+// NewAuthDomainService creates a new authentication domain service
+func NewAuthDomainService() AuthDomainService {
+	return &authDomainService{}
+}
+
+// ValidateLogin validates login credentials
+func (s *authDomainService) ValidateLogin(ctx context.Context, user *entities.User, password string) error {
+	// Domain validation rules for login
+	if user == nil {
+		return errors.New("user cannot be nil")
+	}
+
+	if password == "" {
+		return errors.New("password cannot be empty")
+	}
+
+	// Business rule: Check if user is active
+	if !user.IsEmailVerified() {
+		return errors.New("email must be verified before login")
+	}
+
+	// Validate password against the user's stored hash
+	if !user.ValidatePassword(password) {
+		return errors.New("invalid password")
+	}
+
+	// Account lockout after repeated failures is enforced by the LockoutPolicy
+	// before the credentials are checked
+	return nil
+}
+
+// ValidatePasswordStrength validates password strength
+func (s *authDomainService) ValidatePasswordStrength(ctx context.Context, password string) error {
+	if len(password) < 8 {
+		return errors.New("password must be at least 8 characters long")
+	}
+
+	// Business rules for password strength
+	hasUpper := false
+	hasLower := false
+	hasDigit := false
+	hasSpecial := false
+
+	for _, char := range password {
+		switch {
+		case char >= 'A' && char <= 'Z':
+			hasUpper = true
+		case char >= 'a' && char <= 'z':
+			hasLower = true
+		case char >= '0' && char <= '9':
+			hasDigit = true
+		case char >= 32 && char <= 126: // ASCII printable characters
+			if !((char >= 'A' && char <= 'Z') || (char >= 'a' && char <= 'z') || (char >= '0' && char <= '9')) {
+				hasSpecial = true
+			}
+		}
+	}
+
+	if !hasUpper {
+		return errors.New("password must contain at least one uppercase letter")
+	}
+
+	if !hasLower {
+		return errors.New("password must contain at least one lowercase letter")
+	}
+
+	if !hasDigit {
+		return errors.New("password must contain at least one digit")
+	}
+
+	if !hasSpecial {
+		return errors.New("password must contain at least one special character")
+	}
+
+	return nil
+}
+
+// CanChangePassword checks if a user can change their password
+func (s *authDomainService) CanChangePassword(ctx context.Context, user *entities.User) error {
+	if user == nil {
+		return errors.New("user cannot be nil")
+	}
+
+	// Business rule: Account must be verified
+	if !user.IsEmailVerified() {
+		return errors.New("email must be verified before changing password")
+	}
+
+	// Business rule: Cannot change password too frequently
+	if s.isPasswordChangeToFrequent(user) {
+		return errors.New("password was changed too recently")
+	}
+
+	return nil
+}
+
+// ValidateSession validates an authentication session at the given time
+func (s *authDomainService) ValidateSession(ctx context.Context, session *entities.AuthSession, now time.Time) error {
+	if session == nil {
+		return errors.New("session cannot be nil")
+	}
+
+	if session.IsExpired(now) {
+		return errors.New("session has expired")
+	}
+
+	if !session.IsValid(now) {
+		return errors.New("session is invalid")
+	}
+
+	return nil
+}
+
+// GenerateSessionDuration returns the duration for a new session
+func (s *authDomainService) GenerateSessionDuration(ctx context.Context, user *entities.User) time.Duration {
+	// Business rule: Different session durations based on user type
+	if s.isSystemAdmin(user) {
+		return 4 * time.Hour // Shorter session for admins
+	}
+
+	if s.isPremiumUser(user) {
+		return 30 * 24 * time.Hour // 30 days for premium users
+	}
+
+	return 24 * time.Hour // 24 hours for regular users
+}
+
+// isPasswordChangeToFrequent checks if password changes are too frequent
+func (s *authDomainService) isPasswordChangeToFrequent(user *entities.User) bool {
+	// Business rule: Cannot change password more than once per day
+	// This would typically involve checking the last password change time
+	return false
+}
+
+// isSystemAdmin checks if the user is a system administrator
+func (s *authDomainService) isSystemAdmin(user *entities.User) bool {
+	return user.Email().Value() == "admin@system.com"
+}
+
+// isPremiumUser checks if the user is a premium user
+func (s *authDomainService) isPremiumUser(user *entities.User) bool {
+	// Business rule: Premium user identification
+	return false
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"time"
+
+	"github.com/example/golden/internal/domain/entities"
+)
+
+// LockoutPolicy decides when repeated failed logins lock an account and for how long
+// Each failure past MaxAttempts doubles the lockout, up to MaxDuration
+type LockoutPolicy struct {
+	// MaxAttempts is the number of consecutive failures that locks the account (0 disables lockout)
+	MaxAttempts int
+	// BaseDuration is the length of the first lockout
+	BaseDuration time.Duration
+	// MaxDuration caps the lockout however many failures follow
+	MaxDuration time.Duration
+	// Window is how long failures are remembered after the last one
+	Window time.Duration
+}
+
+// DefaultLockoutPolicy returns the lockout policy used when none is configured
+func DefaultLockoutPolicy() LockoutPolicy {
+	return LockoutPolicy{
+		MaxAttempts:  5,
+		BaseDuration: 5 * time.Minute,
+		MaxDuration:  time.Hour,
+		Window:       24 * time.Hour,
+	}
+}
+
+// Enabled reports whether failed logins lock accounts at all
+func (p LockoutPolicy) Enabled() bool {
+	return p.MaxAttempts > 0
+}
+
+// LockoutDuration returns how long the account is locked after the given number of consecutive failures
+func (p LockoutPolicy) LockoutDuration(failures int) time.Duration {
+	if !p.Enabled() || failures < p.MaxAttempts {
+		return 0
+	}
+
+	duration := p.BaseDuration
+	for i := p.MaxAttempts; i < failures && duration < p.MaxDuration; i++ {
+		duration *= 2
+	}
+	if p.MaxDuration > 0 && duration > p.MaxDuration {
+		duration = p.MaxDuration
+	}
+	return duration
+}
+
+// Check returns an AccountLockedError if the attempts lock the account at the given time
+func (p LockoutPolicy) Check(attempts *entities.LoginAttempts, now time.Time) error {
+	if attempts == nil || !attempts.IsLocked(now) {
+		return nil
+	}
+	return &entities.AccountLockedError{Until: attempts.LockedUntil(), CheckedAt: now}
+}
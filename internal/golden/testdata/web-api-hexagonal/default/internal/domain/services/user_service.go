@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/example/golden/internal/domain/entities"
+)
+
+// UserDomainService defines the interface for user domain services
+type UserDomainService interface {
+	// ValidateUser validates a user entity against domain rules
+	ValidateUser(ctx context.Context, user *entities.User) error
+
+	// CanUserBeDeleted checks if a user can be deleted
+	CanUserBeDeleted(ctx context.Context, user *entities.User) error
+
+	// ValidateEmailUserUniqueness validates that an email is unique
+	ValidateEmailUserUniqueness(ctx context.Context, email string, excludeUserID string) error
+}
+
+// userDomainService implements the UserDomainService interface
+type userDomainService struct {
+	// No external dependencies - this is pure domain logic
+}
+
+// NewUserDomainService creates a new user domain service
+func NewUserDomainService() UserDomainService {
+	return &userDomainService{}
+}
+
+// ValidateUser validates a user entity against domain rules
+func (s *userDomainService) ValidateUser(ctx context.Context, user *entities.User) error {
+	// Domain validation rules
+	if user.ID().IsEmpty() {
+		return errors.New("user ID cannot be empty")
+	}
+
+	if user.Email().IsEmpty() {
+		return errors.New("user email cannot be empty")
+	}
+
+	if user.FirstName() == "" {
+		return errors.New("user first name cannot be empty")
+	}
+
+	if user.LastName() == "" {
+		return errors.New("user last name cannot be empty")
+	}
+
+	// Business rule: Full name cannot be too long
+	if len(user.FullName()) > 100 {
+		return errors.New("user full name cannot exceed 100 characters")
+	}
+
+	// Business rule: No profanity in names (placeholder)
+	if s.containsProfanity(user.FirstName()) || s.containsProfanity(user.LastName()) {
+		return errors.New("user name contains inappropriate content")
+	}
+
+	return nil
+}
+
+// CanUserBeDeleted checks if a user can be deleted
+func (s *userDomainService) CanUserBeDeleted(ctx context.Context, user *entities.User) error {
+	// Business rules for deletion
+	// For example: Cannot delete system administrators
+	if s.isSystemAdmin(user) {
+		return errors.New("cannot delete system administrator")
+	}
+
+	// Cannot delete users created in the last 24 hours (cooling period)
+	if s.isRecentlyCreated(user) {
+		return errors.New("cannot delete recently created users")
+	}
+
+	return nil
+}
+
+// ValidateEmailUserUniqueness validates that an email is unique
+func (s *userDomainService) ValidateEmailUserUniqueness(ctx context.Context, email string, excludeUserID string) error {
+	// This would typically involve a repository call, but since this is pure domain logic,
+	// we'll implement this validation in the application service instead
+	return nil
+}
+
+// containsProfanity checks if text contains profanity (placeholder implementation)
+func (s *userDomainService) containsProfanity(text string) bool {
+	// In a real implementation, this would check against a profanity filter
+	return false
+}
+
+// isSystemAdmin checks if the user is a system administrator
+func (s *userDomainService) isSystemAdmin(user *entities.User) bool {
+	// Business rule: System admin email check
+	return user.Email().Value() == "admin@system.com"
+}
+
+// isRecentlyCreated checks if the user was created recently
+func (s *userDomainService) isRecentlyCreated(user *entities.User) bool {
+	// Business rule: 24-hour cooling period
+	// return time.Since(user.CreatedAt()) < 24*time.Hour
+	return false // Simplified for template
+}
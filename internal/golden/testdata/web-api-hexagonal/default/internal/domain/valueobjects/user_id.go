@@ -0,0 +1,58 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidUserID is returned when a user ID is invalid
+var ErrInvalidUserID = errors.New("invalid user ID")
+
+// UserID represents a unique identifier for a user
+type UserID struct {
+	value string
+}
+
+// NewUserID creates a new user ID
+func NewUserID() (*UserID, error) {
+	id := uuid.New()
+	return &UserID{value: id.String()}, nil
+}
+
+// UserIDFromString creates a user ID from a string
+func UserIDFromString(value string) (*UserID, error) {
+	if value == "" {
+		return nil, ErrInvalidUserID
+	}
+
+	// Validate UUID format
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, ErrInvalidUserID
+	}
+
+	return &UserID{value: value}, nil
+}
+
+// Value returns the string value of the user ID
+func (id *UserID) Value() string {
+	return id.value
+}
+
+// IsEmpty checks if the user ID is empty
+func (id *UserID) IsEmpty() bool {
+	return id.value == ""
+}
+
+// Equals checks if two user IDs are equal
+func (id *UserID) Equals(other *UserID) bool {
+	if other == nil {
+		return false
+	}
+	return id.value == other.value
+}
+
+// String returns the string representation of the user ID
+func (id *UserID) String() string {
+	return id.value
+}
@@ -0,0 +1,203 @@
+package container
+
+import (
+	"github.com/example/golden/internal/adapters/primary/http"
+	"github.com/example/golden/internal/adapters/secondary/events"
+	"github.com/example/golden/internal/adapters/secondary/lockout"
+	"github.com/example/golden/internal/adapters/secondary/logger"
+	"github.com/example/golden/internal/adapters/secondary/persistence"
+	"github.com/example/golden/internal/application/ports/input"
+	"github.com/example/golden/internal/application/ports/output"
+	appServices "github.com/example/golden/internal/application/services"
+	"github.com/example/golden/internal/clock"
+	domainServices "github.com/example/golden/internal/domain/services"
+	"github.com/example/golden/internal/infrastructure/config"
+)
+
+// Container holds all application dependencies
+// This is the composition root for the hexagonal architecture
+type Container struct {
+	config *config.Config
+	clock  clock.Clock
+
+	// Output ports (secondary adapters)
+	logger         output.LoggerPort
+	eventPublisher output.EventPublisherPort
+	db             *persistence.Database
+	userRepository output.UserRepositoryPort
+	authRepository output.AuthRepositoryPort
+	loginAttempts  output.LoginAttemptStorePort
+
+	// Domain services
+	userDomainService domainServices.UserDomainService
+	authDomainService domainServices.AuthDomainService
+
+	// Application services (input ports)
+	healthPort input.HealthPort
+	userPort   input.UserPort
+	authPort   input.AuthPort
+
+	// Primary adapters (HTTP handlers)
+	healthHandler *http.HealthHandler
+	userHandler   *http.UserHandler
+	authHandler   *http.AuthHandler
+}
+
+// NewContainer creates a new dependency injection container
+func NewContainer(cfg *config.Config) *Container {
+	return &Container{
+		config: cfg,
+	}
+}
+
+// Initialize initializes all dependencies in the correct order
+func (c *Container) Initialize() error {
+	// Initialize secondary adapters (output ports)
+	if err := c.initializeSecondaryAdapters(); err != nil {
+		return err
+	}
+
+	// Initialize domain services
+	if err := c.initializeDomainServices(); err != nil {
+		return err
+	}
+
+	// Initialize application services (input ports)
+	if err := c.initializeApplicationServices(); err != nil {
+		return err
+	}
+
+	// Initialize primary adapters (HTTP handlers)
+	if err := c.initializePrimaryAdapters(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// initializeSecondaryAdapters initializes all secondary adapters
+func (c *Container) initializeSecondaryAdapters() error {
+	// Initialize the clock, the time source of the services and adapters
+	c.clock = clock.New()
+
+	// Initialize logger adapter
+	c.logger = logger.NewSlogAdapterWithLevel(c.config.Logger.Level)
+
+	// Initialize event publisher
+	c.eventPublisher = events.NewEventPublisher(c.logger)
+	// Initialize database and repository
+	var err error
+	c.db, err = persistence.NewDatabase(c.config)
+	if err != nil {
+		return err
+	}
+
+	c.userRepository = persistence.NewUserRepository(c.db, c.logger)
+	// Initialize auth repository
+	c.authRepository = persistence.NewAuthRepository(c.db, c.logger, c.clock)
+
+	// Initialize failed login store for account lockout
+	c.loginAttempts = lockout.NewMemoryStore(c.clock)
+
+	return nil
+}
+
+// initializeDomainServices initializes all domain services
+func (c *Container) initializeDomainServices() error {
+	// Initialize user domain service
+	c.userDomainService = domainServices.NewUserDomainService()
+	// Initialize auth domain service
+	c.authDomainService = domainServices.NewAuthDomainService()
+
+	return nil
+}
+
+// initializeApplicationServices initializes all application services
+func (c *Container) initializeApplicationServices() error {
+	// Initialize health service
+	c.healthPort = appServices.NewHealthService(c.logger, c.clock, c.db)
+	// Initialize user service
+	c.userPort = appServices.NewUserService(
+		c.userRepository,
+		c.userDomainService,
+		c.eventPublisher,
+		c.logger,
+		c.clock,
+	)
+	// Initialize auth service
+	c.authPort = appServices.NewAuthService(
+		c.userRepository,
+		c.authRepository,
+		c.authDomainService,
+		c.eventPublisher,
+		c.logger,
+		c.clock,
+		c.config.Auth,
+		c.loginAttempts,
+		domainServices.LockoutPolicy{
+			MaxAttempts:  c.config.Auth.Lockout.MaxAttempts,
+			BaseDuration: c.config.Auth.Lockout.BaseDuration,
+			MaxDuration:  c.config.Auth.Lockout.MaxDuration,
+			Window:       c.config.Auth.Lockout.Window,
+		},
+	)
+
+	return nil
+}
+
+// initializePrimaryAdapters initializes all primary adapters
+func (c *Container) initializePrimaryAdapters() error {
+	// Initialize health handler
+	c.healthHandler = http.NewHealthHandler(c.healthPort, c.logger)
+	// Initialize user handler
+	c.userHandler = http.NewUserHandler(c.userPort, c.logger)
+	// Initialize auth handler
+	c.authHandler = http.NewAuthHandler(c.authPort, c.logger)
+
+	return nil
+}
+
+// GetHealthHandler returns the health handler
+func (c *Container) GetHealthHandler() *http.HealthHandler {
+	return c.healthHandler
+}
+
+// GetUserHandler returns the user handler
+func (c *Container) GetUserHandler() *http.UserHandler {
+	return c.userHandler
+}
+
+// GetAuthHandler returns the auth handler
+func (c *Container) GetAuthHandler() *http.AuthHandler {
+	return c.authHandler
+}
+
+// GetLogger returns the logger
+func (c *Container) GetLogger() output.LoggerPort {
+	return c.logger
+}
+
+// GetConfig returns the configuration
+func (c *Container) GetConfig() *config.Config {
+	return c.config
+}
+
+// HealthPort returns the health port
+func (c *Container) HealthPort() input.HealthPort {
+	return c.healthPort
+}
+
+// UserPort returns the user port
+func (c *Container) UserPort() input.UserPort {
+	return c.userPort
+}
+
+// AuthPort returns the auth port
+func (c *Container) AuthPort() input.AuthPort {
+	return c.authPort
+}
+
+// Logger returns the logger
+func (c *Container) Logger() output.LoggerPort {
+	return c.logger
+}
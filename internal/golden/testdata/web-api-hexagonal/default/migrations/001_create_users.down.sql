@@ -0,0 +1,10 @@
+-- Drop triggers first
+DROP TRIGGER IF EXISTS update_users_updated_at ON users;
+DROP FUNCTION IF EXISTS update_updated_at_column();
+-- Drop auth-related tables
+DROP TABLE IF EXISTS password_reset_tokens;
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS auth_sessions;
+
+-- Drop main users table
+DROP TABLE IF EXISTS users;
\ No newline at end of file
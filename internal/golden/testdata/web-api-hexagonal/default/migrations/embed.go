@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// MigrationFiles contains all migration files embedded at compile time
+//
+//go:embed *.sql
+var MigrationFiles embed.FS
+
+// GetMigrationFiles returns the embedded migration files
+func GetMigrationFiles() fs.FS {
+	return MigrationFiles
+}
+
+// GetMigrationFileNames returns a list of all migration file names
+func GetMigrationFileNames() ([]string, error) {
+	files, err := MigrationFiles.ReadDir(".")
+	if err != nil {
+		return nil, err
+	}
+
+	var fileNames []string
+	for _, file := range files {
+		if !file.IsDir() {
+			fileNames = append(fileNames, file.Name())
+		}
+	}
+
+	return fileNames, nil
+}
+
+// ReadMigrationFile reads a specific migration file
+func ReadMigrationFile(filename string) ([]byte, error) {
+	return MigrationFiles.ReadFile(filename)
+}
@@ -0,0 +1,92 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/example/golden/internal/application/ports/output"
+)
+
+// MockAuthRepositoryPort is a mock implementation of output.AuthRepositoryPort
+type MockAuthRepositoryPort struct {
+	mock.Mock
+}
+
+var _ output.AuthRepositoryPort = (*MockAuthRepositoryPort)(nil)
+
+// StoreRefreshToken provides a mock function with given fields: ctx, userID, token, expiresAt
+func (m *MockAuthRepositoryPort) StoreRefreshToken(ctx context.Context, userID, token string, expiresAt time.Time) error {
+	args := m.Called(ctx, userID, token, expiresAt)
+	return args.Error(0)
+}
+
+// GetRefreshToken provides a mock function with given fields: ctx, token
+func (m *MockAuthRepositoryPort) GetRefreshToken(ctx context.Context, token string) (*output.RefreshToken, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*output.RefreshToken), args.Error(1)
+}
+
+// RevokeRefreshToken provides a mock function with given fields: ctx, token
+func (m *MockAuthRepositoryPort) RevokeRefreshToken(ctx context.Context, token string) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+// RevokeAllUserTokens provides a mock function with given fields: ctx, userID
+func (m *MockAuthRepositoryPort) RevokeAllUserTokens(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// CleanupExpiredTokens provides a mock function with given fields: ctx
+func (m *MockAuthRepositoryPort) CleanupExpiredTokens(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+// StorePasswordResetToken provides a mock function with given fields: ctx, userID, token, expiresAt
+func (m *MockAuthRepositoryPort) StorePasswordResetToken(ctx context.Context, userID, token string, expiresAt time.Time) error {
+	args := m.Called(ctx, userID, token, expiresAt)
+	return args.Error(0)
+}
+
+// GetPasswordResetToken provides a mock function with given fields: ctx, token
+func (m *MockAuthRepositoryPort) GetPasswordResetToken(ctx context.Context, token string) (*output.PasswordResetToken, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*output.PasswordResetToken), args.Error(1)
+}
+
+// RevokePasswordResetToken provides a mock function with given fields: ctx, token
+func (m *MockAuthRepositoryPort) RevokePasswordResetToken(ctx context.Context, token string) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+// StoreSession provides a mock function with given fields: ctx, sessionID, userID, expiresAt
+func (m *MockAuthRepositoryPort) StoreSession(ctx context.Context, sessionID, userID string, expiresAt time.Time) error {
+	args := m.Called(ctx, sessionID, userID, expiresAt)
+	return args.Error(0)
+}
+
+// GetSession provides a mock function with given fields: ctx, sessionID
+func (m *MockAuthRepositoryPort) GetSession(ctx context.Context, sessionID string) (*output.Session, error) {
+	args := m.Called(ctx, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*output.Session), args.Error(1)
+}
+
+// RevokeSession provides a mock function with given fields: ctx, sessionID
+func (m *MockAuthRepositoryPort) RevokeSession(ctx context.Context, sessionID string) error {
+	args := m.Called(ctx, sessionID)
+	return args.Error(0)
+}
@@ -0,0 +1,54 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/example/golden/internal/application/ports/output"
+	"github.com/example/golden/internal/domain/events"
+)
+
+// MockEventPublisherPort is a mock implementation of output.EventPublisherPort
+type MockEventPublisherPort struct {
+	mock.Mock
+}
+
+var _ output.EventPublisherPort = (*MockEventPublisherPort)(nil)
+
+// Publish provides a mock function with given fields: ctx, event
+func (m *MockEventPublisherPort) Publish(ctx context.Context, event events.DomainEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+// PublishBatch provides a mock function with given fields: ctx, batch
+func (m *MockEventPublisherPort) PublishBatch(ctx context.Context, batch []events.DomainEvent) error {
+	args := m.Called(ctx, batch)
+	return args.Error(0)
+}
+
+// Subscribe provides a mock function with given fields: ctx, eventType, handler
+func (m *MockEventPublisherPort) Subscribe(ctx context.Context, eventType string, handler output.EventHandler) error {
+	args := m.Called(ctx, eventType, handler)
+	return args.Error(0)
+}
+
+// Unsubscribe provides a mock function with given fields: ctx, eventType, handler
+func (m *MockEventPublisherPort) Unsubscribe(ctx context.Context, eventType string, handler output.EventHandler) error {
+	args := m.Called(ctx, eventType, handler)
+	return args.Error(0)
+}
+
+// MockEventHandler is a mock implementation of output.EventHandler
+type MockEventHandler struct {
+	mock.Mock
+}
+
+var _ output.EventHandler = (*MockEventHandler)(nil)
+
+// Handle provides a mock function with given fields: ctx, event
+func (m *MockEventHandler) Handle(ctx context.Context, event events.DomainEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
@@ -0,0 +1,66 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/example/golden/internal/application/ports/output"
+)
+
+// MockLoggerPort is a mock implementation of output.LoggerPort. The fields of a
+// log call are recorded as one []output.Field argument, so expectations match
+// any number of fields with mock.Anything.
+type MockLoggerPort struct {
+	mock.Mock
+}
+
+var _ output.LoggerPort = (*MockLoggerPort)(nil)
+
+// Debug provides a mock function with given fields: ctx, msg, fields
+func (m *MockLoggerPort) Debug(ctx context.Context, msg string, fields ...output.Field) {
+	m.Called(ctx, msg, fields)
+}
+
+// Info provides a mock function with given fields: ctx, msg, fields
+func (m *MockLoggerPort) Info(ctx context.Context, msg string, fields ...output.Field) {
+	m.Called(ctx, msg, fields)
+}
+
+// Warn provides a mock function with given fields: ctx, msg, fields
+func (m *MockLoggerPort) Warn(ctx context.Context, msg string, fields ...output.Field) {
+	m.Called(ctx, msg, fields)
+}
+
+// Error provides a mock function with given fields: ctx, msg, fields
+func (m *MockLoggerPort) Error(ctx context.Context, msg string, fields ...output.Field) {
+	m.Called(ctx, msg, fields)
+}
+
+// Fatal provides a mock function with given fields: ctx, msg, fields
+func (m *MockLoggerPort) Fatal(ctx context.Context, msg string, fields ...output.Field) {
+	m.Called(ctx, msg, fields)
+}
+
+// WithFields provides a mock function with given fields: fields
+func (m *MockLoggerPort) WithFields(fields ...output.Field) output.LoggerPort {
+	args := m.Called(fields)
+	if args.Get(0) == nil {
+		return m
+	}
+	return args.Get(0).(output.LoggerPort)
+}
+
+// WithError provides a mock function with given fields: err
+func (m *MockLoggerPort) WithError(err error) output.LoggerPort {
+	args := m.Called(err)
+	if args.Get(0) == nil {
+		return m
+	}
+	return args.Get(0).(output.LoggerPort)
+}
+
+// DisableColor provides a mock function with no fields
+func (m *MockLoggerPort) DisableColor() {
+	m.Called()
+}
@@ -0,0 +1,48 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/example/golden/internal/application/ports/output"
+	"github.com/example/golden/internal/domain/entities"
+)
+
+// MockLoginAttemptStorePort is a mock implementation of output.LoginAttemptStorePort
+type MockLoginAttemptStorePort struct {
+	mock.Mock
+}
+
+var _ output.LoginAttemptStorePort = (*MockLoginAttemptStorePort)(nil)
+
+// Get provides a mock function with given fields: ctx, key
+func (m *MockLoginAttemptStorePort) Get(ctx context.Context, key string) (*entities.LoginAttempts, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.LoginAttempts), args.Error(1)
+}
+
+// RecordFailure provides a mock function with given fields: ctx, key, at, ttl
+func (m *MockLoginAttemptStorePort) RecordFailure(ctx context.Context, key string, at time.Time, ttl time.Duration) (*entities.LoginAttempts, error) {
+	args := m.Called(ctx, key, at, ttl)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.LoginAttempts), args.Error(1)
+}
+
+// Lock provides a mock function with given fields: ctx, key, until, ttl
+func (m *MockLoginAttemptStorePort) Lock(ctx context.Context, key string, until time.Time, ttl time.Duration) error {
+	args := m.Called(ctx, key, until, ttl)
+	return args.Error(0)
+}
+
+// Reset provides a mock function with given fields: ctx, key
+func (m *MockLoginAttemptStorePort) Reset(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
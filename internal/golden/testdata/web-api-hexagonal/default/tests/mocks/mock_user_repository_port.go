@@ -0,0 +1,80 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/example/golden/internal/application/ports/output"
+	"github.com/example/golden/internal/domain/entities"
+)
+
+// MockUserRepositoryPort is a mock implementation of output.UserRepositoryPort
+type MockUserRepositoryPort struct {
+	mock.Mock
+}
+
+var _ output.UserRepositoryPort = (*MockUserRepositoryPort)(nil)
+
+// Create provides a mock function with given fields: ctx, user
+func (m *MockUserRepositoryPort) Create(ctx context.Context, user *entities.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (m *MockUserRepositoryPort) GetByID(ctx context.Context, id string) (*entities.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.User), args.Error(1)
+}
+
+// GetByEmail provides a mock function with given fields: ctx, email
+func (m *MockUserRepositoryPort) GetByEmail(ctx context.Context, email string) (*entities.User, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.User), args.Error(1)
+}
+
+// Update provides a mock function with given fields: ctx, user
+func (m *MockUserRepositoryPort) Update(ctx context.Context, user *entities.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (m *MockUserRepositoryPort) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// List provides a mock function with given fields: ctx, limit, offset
+func (m *MockUserRepositoryPort) List(ctx context.Context, limit, offset int) ([]*entities.User, error) {
+	args := m.Called(ctx, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.User), args.Error(1)
+}
+
+// Count provides a mock function with given fields: ctx
+func (m *MockUserRepositoryPort) Count(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// ExistsByEmail provides a mock function with given fields: ctx, email
+func (m *MockUserRepositoryPort) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	args := m.Called(ctx, email)
+	return args.Bool(0), args.Error(1)
+}
+
+// ExistsByID provides a mock function with given fields: ctx, id
+func (m *MockUserRepositoryPort) ExistsByID(ctx context.Context, id string) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
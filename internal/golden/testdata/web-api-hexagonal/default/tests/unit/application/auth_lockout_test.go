@@ -0,0 +1,174 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/adapters/secondary/lockout"
+	"github.com/example/golden/internal/application/dto"
+	"github.com/example/golden/internal/application/ports/input"
+	"github.com/example/golden/internal/application/ports/output"
+	"github.com/example/golden/internal/application/services"
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/domain/entities"
+	domainServices "github.com/example/golden/internal/domain/services"
+	"github.com/example/golden/internal/domain/valueobjects"
+	"github.com/example/golden/internal/infrastructure/config"
+	"github.com/example/golden/tests/mocks"
+)
+
+// securityLog records the security events logged by the auth service
+type securityLog struct {
+	nopLogger
+	events []string
+}
+
+func (l *securityLog) Warn(ctx context.Context, msg string, fields ...output.Field) {
+	for _, field := range fields {
+		if field.Key == "security_event" {
+			l.events = append(l.events, field.Value.(string))
+		}
+	}
+}
+
+// tokenStore accepts refresh tokens, the lockout tests do not look at them
+type tokenStore struct {
+	output.AuthRepositoryPort
+}
+
+func (tokenStore) StoreRefreshToken(ctx context.Context, userID, token string, expiresAt time.Time) error {
+	return nil
+}
+
+// lockoutTestPolicy locks after three failures for 50ms, doubling up to 200ms
+func lockoutTestPolicy() domainServices.LockoutPolicy {
+	return domainServices.LockoutPolicy{
+		MaxAttempts:  3,
+		BaseDuration: 50 * time.Millisecond,
+		MaxDuration:  200 * time.Millisecond,
+		Window:       time.Minute,
+	}
+}
+
+func newLockoutAuthService(t *testing.T, policy domainServices.LockoutPolicy) (input.AuthPort, *securityLog, *clock.Fake) {
+	clk := clock.NewFake(testNow)
+	id, err := valueobjects.NewUserID()
+	require.NoError(t, err)
+	email, err := valueobjects.NewEmail("ada@example.com")
+	require.NoError(t, err)
+	user, err := entities.NewUser(id, email, "Ada", "Lovelace", "password123", clk.Now())
+	require.NoError(t, err)
+
+	userRepo := &mocks.MockUserRepositoryPort{}
+	userRepo.On("GetByEmail", mock.Anything, "ada@example.com").Return(user, nil)
+	userRepo.On("GetByEmail", mock.Anything, mock.Anything).Return(nil, errors.New("not found"))
+
+	log := &securityLog{}
+	service := services.NewAuthService(
+		userRepo,
+		tokenStore{},
+		domainServices.NewAuthDomainService(),
+		&recordingPublisher{},
+		log,
+		clk,
+		config.AuthConfig{TokenDuration: time.Hour},
+		lockout.NewMemoryStore(clk),
+		policy,
+	)
+	return service, log, clk
+}
+
+func login(service input.AuthPort, password string) error {
+	_, err := service.Login(context.Background(), &dto.LoginRequest{Email: "ada@example.com", Password: password})
+	return err
+}
+
+func TestAuthService_Login_LocksAfterRepeatedFailures(t *testing.T) {
+	service, log, _ := newLockoutAuthService(t, lockoutTestPolicy())
+
+	for i := 0; i < 3; i++ {
+		err := login(service, "wrong-password")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, entities.ErrAccountLocked)
+	}
+
+	// Even the right password is rejected while the account is locked
+	err := login(service, "password123")
+	var lockedErr *entities.AccountLockedError
+	require.ErrorAs(t, err, &lockedErr)
+	assert.ErrorIs(t, err, entities.ErrAccountLocked)
+	assert.Equal(t, 50*time.Millisecond, lockedErr.RetryAfter())
+
+	assert.Equal(t, []string{"login_failed", "login_failed", "account_locked", "login_locked"}, log.events)
+}
+
+func TestAuthService_Login_UnlocksWithBackoff(t *testing.T) {
+	service, _, clk := newLockoutAuthService(t, lockoutTestPolicy())
+
+	for i := 0; i < 3; i++ {
+		require.Error(t, login(service, "wrong-password"))
+	}
+	require.ErrorIs(t, login(service, "password123"), entities.ErrAccountLocked)
+
+	clk.Advance(60 * time.Millisecond)
+
+	// Another failure right after the lockout locks again for twice as long
+	require.Error(t, login(service, "wrong-password"))
+	clk.Advance(60 * time.Millisecond)
+	require.ErrorIs(t, login(service, "password123"), entities.ErrAccountLocked)
+
+	clk.Advance(50 * time.Millisecond)
+	assert.NoError(t, login(service, "password123"))
+}
+
+func TestAuthService_Login_SuccessResetsFailures(t *testing.T) {
+	service, _, _ := newLockoutAuthService(t, lockoutTestPolicy())
+
+	require.Error(t, login(service, "wrong-password"))
+	require.Error(t, login(service, "wrong-password"))
+	require.NoError(t, login(service, "password123"))
+
+	// The count starts over, two more failures do not lock the account
+	require.Error(t, login(service, "wrong-password"))
+	require.Error(t, login(service, "wrong-password"))
+	assert.NoError(t, login(service, "password123"))
+}
+
+func TestAuthService_Login_UnknownEmailsAreLockedToo(t *testing.T) {
+	service, _, _ := newLockoutAuthService(t, lockoutTestPolicy())
+
+	for i := 0; i < 3; i++ {
+		_, err := service.Login(context.Background(), &dto.LoginRequest{Email: "Nobody@Example.com ", Password: "guess"})
+		require.Error(t, err)
+	}
+
+	_, err := service.Login(context.Background(), &dto.LoginRequest{Email: "nobody@example.com", Password: "guess"})
+	assert.ErrorIs(t, err, entities.ErrAccountLocked)
+}
+
+func TestAuthService_Login_LockoutDisabled(t *testing.T) {
+	service, log, _ := newLockoutAuthService(t, domainServices.LockoutPolicy{})
+
+	for i := 0; i < 10; i++ {
+		require.Error(t, login(service, "wrong-password"))
+	}
+	assert.NoError(t, login(service, "password123"))
+	assert.Empty(t, log.events)
+}
+
+func TestLockoutPolicy_LockoutDuration(t *testing.T) {
+	policy := lockoutTestPolicy()
+
+	assert.Equal(t, time.Duration(0), policy.LockoutDuration(2))
+	assert.Equal(t, 50*time.Millisecond, policy.LockoutDuration(3))
+	assert.Equal(t, 100*time.Millisecond, policy.LockoutDuration(4))
+	assert.Equal(t, 200*time.Millisecond, policy.LockoutDuration(5))
+	// Capped at the maximum
+	assert.Equal(t, 200*time.Millisecond, policy.LockoutDuration(20))
+}
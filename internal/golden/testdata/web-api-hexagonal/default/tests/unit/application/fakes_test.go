@@ -0,0 +1,47 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/golden/internal/application/ports/output"
+	"github.com/example/golden/internal/domain/events"
+)
+
+// testNow is the time the fake clocks of the tests start at
+var testNow = time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+
+// nopLogger discards log output so the tests only assert on service behaviour
+type nopLogger struct{}
+
+func (nopLogger) Debug(ctx context.Context, msg string, fields ...output.Field) {}
+func (nopLogger) Info(ctx context.Context, msg string, fields ...output.Field)  {}
+func (nopLogger) Warn(ctx context.Context, msg string, fields ...output.Field)  {}
+func (nopLogger) Error(ctx context.Context, msg string, fields ...output.Field) {}
+func (nopLogger) Fatal(ctx context.Context, msg string, fields ...output.Field) {}
+func (l nopLogger) WithFields(fields ...output.Field) output.LoggerPort         { return l }
+func (l nopLogger) WithError(err error) output.LoggerPort                       { return l }
+func (nopLogger) DisableColor()                                                 {}
+
+// recordingPublisher keeps published events in memory
+type recordingPublisher struct {
+	published []events.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, event events.DomainEvent) error {
+	p.published = append(p.published, event)
+	return nil
+}
+
+func (p *recordingPublisher) PublishBatch(ctx context.Context, batch []events.DomainEvent) error {
+	p.published = append(p.published, batch...)
+	return nil
+}
+
+func (p *recordingPublisher) Subscribe(ctx context.Context, eventType string, handler output.EventHandler) error {
+	return nil
+}
+
+func (p *recordingPublisher) Unsubscribe(ctx context.Context, eventType string, handler output.EventHandler) error {
+	return nil
+}
@@ -0,0 +1,167 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/application/dto"
+	"github.com/example/golden/internal/application/ports/input"
+	"github.com/example/golden/internal/application/services"
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/domain/entities"
+	domainservices "github.com/example/golden/internal/domain/services"
+	"github.com/example/golden/internal/domain/valueobjects"
+	"github.com/example/golden/tests/mocks"
+)
+
+// userServiceMocks holds the mocks of the output ports the user service uses
+type userServiceMocks struct {
+	repo      *mocks.MockUserRepositoryPort
+	publisher *mocks.MockEventPublisherPort
+	logger    *mocks.MockLoggerPort
+}
+
+// newUserService returns the user service wired to mocks of its output ports.
+// Log calls are allowed but not asserted; the expectations of the repository and
+// the publisher are checked when the test ends.
+func newUserService(t *testing.T) (input.UserPort, userServiceMocks) {
+	t.Helper()
+	m := userServiceMocks{
+		repo:      &mocks.MockUserRepositoryPort{},
+		publisher: &mocks.MockEventPublisherPort{},
+		logger:    &mocks.MockLoggerPort{},
+	}
+	for _, level := range []string{"Debug", "Info", "Warn", "Error"} {
+		m.logger.On(level, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	}
+	t.Cleanup(func() {
+		m.repo.AssertExpectations(t)
+		m.publisher.AssertExpectations(t)
+	})
+	service := services.NewUserService(m.repo, domainservices.NewUserDomainService(), m.publisher, m.logger, clock.NewFake(testNow))
+	return service, m
+}
+
+// existingUser returns a user as the repository stores it
+func existingUser(t *testing.T) *entities.User {
+	t.Helper()
+	id, err := valueobjects.NewUserID()
+	require.NoError(t, err)
+	email, err := valueobjects.NewEmail("ada@example.com")
+	require.NoError(t, err)
+	createdAt := testNow.Add(-48 * time.Hour)
+	return entities.ReconstructUser(id, email, "Ada", "Lovelace", "$2a$10$hash", createdAt, createdAt)
+}
+
+func TestUserService_CreateUser(t *testing.T) {
+	request := &dto.CreateUserRequest{
+		Email:     "ada@example.com",
+		FirstName: "Ada",
+		LastName:  "Lovelace",
+		Password:  "Password123!",
+	}
+
+	t.Run("saves the user and publishes its creation", func(t *testing.T) {
+		service, m := newUserService(t)
+		m.repo.On("ExistsByEmail", mock.Anything, "ada@example.com").Return(false, nil)
+		m.repo.On("Create", mock.Anything, mock.AnythingOfType("*entities.User")).Return(nil)
+		m.publisher.On("Publish", mock.Anything, mock.AnythingOfType("*events.UserCreatedEvent")).Return(nil)
+
+		response, err := service.CreateUser(context.Background(), request)
+		require.NoError(t, err)
+		assert.NotEmpty(t, response.ID)
+		assert.Equal(t, "ada@example.com", response.Email)
+		assert.Equal(t, "Ada", response.FirstName)
+		assert.Equal(t, testNow, response.CreatedAt)
+	})
+
+	t.Run("refuses an email already in use", func(t *testing.T) {
+		service, m := newUserService(t)
+		m.repo.On("ExistsByEmail", mock.Anything, "ada@example.com").Return(true, nil)
+
+		_, err := service.CreateUser(context.Background(), request)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "already exists")
+		m.repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("returns the errors of the repository", func(t *testing.T) {
+		service, m := newUserService(t)
+		m.repo.On("ExistsByEmail", mock.Anything, "ada@example.com").Return(false, nil)
+		m.repo.On("Create", mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+
+		_, err := service.CreateUser(context.Background(), request)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "connection refused")
+		m.publisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
+	t.Run("a failed publication does not fail the creation", func(t *testing.T) {
+		service, m := newUserService(t)
+		m.repo.On("ExistsByEmail", mock.Anything, "ada@example.com").Return(false, nil)
+		m.repo.On("Create", mock.Anything, mock.Anything).Return(nil)
+		m.publisher.On("Publish", mock.Anything, mock.Anything).Return(errors.New("broker down"))
+
+		_, err := service.CreateUser(context.Background(), request)
+		require.NoError(t, err)
+		m.logger.AssertCalled(t, "Warn", mock.Anything, "Failed to publish user created event", mock.Anything)
+	})
+}
+
+func TestUserService_UpdateUser(t *testing.T) {
+	service, m := newUserService(t)
+	user := existingUser(t)
+	id := user.ID().Value()
+	m.repo.On("GetByID", mock.Anything, id).Return(user, nil)
+	m.repo.On("Update", mock.Anything, user).Return(nil)
+	m.publisher.On("Publish", mock.Anything, mock.AnythingOfType("*events.UserUpdatedEvent")).Return(nil)
+
+	firstName := "Augusta"
+	response, err := service.UpdateUser(context.Background(), id, &dto.UpdateUserRequest{FirstName: &firstName})
+	require.NoError(t, err)
+	assert.Equal(t, "Augusta", response.FirstName)
+	assert.Equal(t, "Lovelace", response.LastName)
+	assert.Equal(t, testNow, response.UpdatedAt)
+}
+
+func TestUserService_DeleteUser(t *testing.T) {
+	t.Run("deletes the user and publishes its deletion", func(t *testing.T) {
+		service, m := newUserService(t)
+		user := existingUser(t)
+		id := user.ID().Value()
+		m.repo.On("GetByID", mock.Anything, id).Return(user, nil)
+		m.repo.On("Delete", mock.Anything, id).Return(nil)
+		m.publisher.On("Publish", mock.Anything, mock.AnythingOfType("*events.UserDeletedEvent")).Return(nil)
+
+		require.NoError(t, service.DeleteUser(context.Background(), id))
+	})
+
+	t.Run("an unknown user is not deleted", func(t *testing.T) {
+		service, m := newUserService(t)
+		m.repo.On("GetByID", mock.Anything, "missing").Return(nil, errors.New("user not found"))
+
+		err := service.DeleteUser(context.Background(), "missing")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+		m.repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserService_ListUsers(t *testing.T) {
+	service, m := newUserService(t)
+	user := existingUser(t)
+	m.repo.On("List", mock.Anything, 10, 20).Return([]*entities.User{user}, nil)
+	m.repo.On("Count", mock.Anything).Return(int64(21), nil)
+
+	response, err := service.ListUsers(context.Background(), &dto.ListUsersRequest{Limit: 10, Offset: 20})
+	require.NoError(t, err)
+	assert.Equal(t, int64(21), response.Total)
+	require.Len(t, response.Users, 1)
+	assert.Equal(t, user.ID().Value(), response.Users[0].ID)
+}
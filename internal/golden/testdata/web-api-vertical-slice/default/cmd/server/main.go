@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/features"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/mediator"
+	"github.com/example/golden/internal/platform/database"
+	"github.com/example/golden/internal/platform/server"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "golden: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run serves the slices until SIGINT or SIGTERM
+func run() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	log, err := logger.NewFactory().Create(cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+	log = log.With("service", "golden")
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	db, err := database.Open(ctx, cfg.DatabaseURL)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	clk := clock.New()
+
+	applied, err := database.Migrate(ctx, db, clk)
+	if err != nil {
+		return err
+	}
+	if len(applied) > 0 {
+		log.Info("Migrations applied", "migrations", applied)
+	}
+
+	// Every request sent to a slice is logged, then validated, before its handler runs
+	m := mediator.New(
+		mediator.Logging(log),
+		mediator.Validation(),
+	)
+	routes := features.Register(m, db, clk)
+
+	srv := server.New(cfg.Address(), server.NewRouter(routes), log)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		log.Info("Listening", "address", cfg.Address(), "routes", len(routes), "environment", cfg.Environment)
+		serveErr <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("server stopped: %w", err)
+		}
+	case <-ctx.Done():
+	}
+
+	log.Info("Shutting down", "timeout", cfg.ShutdownTimeout.String())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down: %w", err)
+	}
+	log.Info("Server stopped")
+	return nil
+}
@@ -0,0 +1,10 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	github.com/google/uuid v1.6.0
+	github.com/stretchr/testify v1.10.0
+	github.com/gin-gonic/gin v1.10.0
+	github.com/mattn/go-sqlite3 v1.14.22
+)
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config holds the settings of the server, read from the environment
+type Config struct {
+	// Environment is development or production (APP_ENV)
+	Environment string
+	// Port the server listens on (PORT)
+	Port int
+	// LogLevel is one of debug, info, warn or error (LOG_LEVEL)
+	LogLevel string
+	// LogFormat is json or console (LOG_FORMAT)
+	LogFormat string
+	// ShutdownTimeout bounds the graceful shutdown (SHUTDOWN_TIMEOUT)
+	ShutdownTimeout time.Duration
+
+	// DatabaseURL is the data source name of the SQLite database (DATABASE_URL)
+	DatabaseURL string
+}
+
+// Load reads the configuration from the environment, applying defaults
+func Load() (*Config, error) {
+	cfg := &Config{
+		Environment:     getEnv("APP_ENV", "development"),
+		Port:            8080,
+		LogLevel:        getEnv("LOG_LEVEL", "info"),
+		LogFormat:       getEnv("LOG_FORMAT", "json"),
+		ShutdownTimeout: 15 * time.Second,
+		DatabaseURL:     getEnv("DATABASE_URL", "file:golden.db?_foreign_keys=on&_busy_timeout=5000"),
+	}
+
+	if cfg.Environment != "development" && cfg.Environment != "production" {
+		return nil, fmt.Errorf("invalid APP_ENV %q: must be development or production", cfg.Environment)
+	}
+
+	var err error
+	if cfg.Port, err = getEnvPort("PORT", cfg.Port); err != nil {
+		return nil, err
+	}
+	if cfg.ShutdownTimeout, err = getEnvDuration("SHUTDOWN_TIMEOUT", cfg.ShutdownTimeout); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// IsProduction reports whether the server runs in production
+func (c *Config) IsProduction() bool {
+	return c.Environment == "production"
+}
+
+// Address returns the address the server listens on
+func (c *Config) Address() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func getEnvPort(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("invalid %s %q: must be between 1 and 65535", key, value)
+	}
+	return port, nil
+}
+
+func getEnvDuration(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	return d, nil
+}
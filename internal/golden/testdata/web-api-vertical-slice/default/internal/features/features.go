@@ -0,0 +1,40 @@
+// Package features wires the slices: each folder below is a use case owning
+// its handler, request and response, service and repository
+package features
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/features/health"
+	"github.com/example/golden/internal/features/users/createuser"
+	"github.com/example/golden/internal/features/users/deleteuser"
+	"github.com/example/golden/internal/features/users/getuser"
+	"github.com/example/golden/internal/features/users/listusers"
+	"github.com/example/golden/internal/mediator"
+)
+
+// Route is the endpoint of a slice. Path parameters are written {name}
+type Route struct {
+	Method  string
+	Path    string
+	Handler http.HandlerFunc
+}
+
+// Register registers the service of every slice with the mediator and returns
+// their routes. A new slice adds its registration and its route here
+func Register(m *mediator.Mediator, db *sql.DB, clk clock.Clock) []Route {
+	createuser.Register(m, createuser.NewRepository(db), clk)
+	getuser.Register(m, getuser.NewRepository(db))
+	listusers.Register(m, listusers.NewRepository(db))
+	deleteuser.Register(m, deleteuser.NewRepository(db))
+
+	return []Route{
+		{Method: http.MethodGet, Path: health.Path, Handler: health.Handler(db)},
+		{Method: createuser.Method, Path: createuser.Path, Handler: createuser.Handler(m)},
+		{Method: listusers.Method, Path: listusers.Path, Handler: listusers.Handler(m)},
+		{Method: getuser.Method, Path: getuser.Path, Handler: getuser.Handler(m)},
+		{Method: deleteuser.Method, Path: deleteuser.Path, Handler: deleteuser.Handler(m)},
+	}
+}
@@ -0,0 +1,35 @@
+// Package health reports whether the server can reach its database.
+// It is not a use case, so it answers directly instead of going through the mediator
+package health
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/example/golden/internal/platform/httpx"
+)
+
+// Path is the endpoint of the health check
+const Path = "/health"
+
+// Response is the body of the health check
+type Response struct {
+	Status   string `json:"status"`
+	Database string `json:"database"`
+}
+
+// Handler answers 200 when the database answers, 503 otherwise
+func Handler(db *sql.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
+		defer cancel()
+
+		if err := db.PingContext(ctx); err != nil {
+			httpx.JSON(w, http.StatusServiceUnavailable, Response{Status: "unavailable", Database: "down"})
+			return
+		}
+		httpx.JSON(w, http.StatusOK, Response{Status: "ok", Database: "up"})
+	}
+}
@@ -0,0 +1,33 @@
+package createuser
+
+import (
+	"net/http"
+
+	"github.com/example/golden/internal/mediator"
+	"github.com/example/golden/internal/platform/httpx"
+)
+
+// Method and Path are the endpoint of the slice
+const (
+	Method = http.MethodPost
+	Path   = "/api/v1/users"
+)
+
+// Handler decodes the request and sends it to the service through the mediator
+func Handler(m *mediator.Mediator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req Request
+		if err := httpx.Decode(w, r, &req); err != nil {
+			httpx.Error(w, err)
+			return
+		}
+
+		res, err := mediator.Send[Request, Response](r.Context(), m, req)
+		if err != nil {
+			httpx.Error(w, err)
+			return
+		}
+		w.Header().Set("Location", Path+"/"+res.ID)
+		httpx.JSON(w, http.StatusCreated, res)
+	}
+}
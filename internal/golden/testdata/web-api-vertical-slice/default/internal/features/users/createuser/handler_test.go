@@ -0,0 +1,58 @@
+package createuser
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/mediator"
+)
+
+func newTestHandler(repo Repository) http.HandlerFunc {
+	m := mediator.New(mediator.Validation())
+	mediator.Register[Request, Response](m, newTestService(repo))
+	return Handler(m)
+}
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		status int
+	}{
+		{"created", `{"email":"jane@example.com","name":"Jane"}`, http.StatusCreated},
+		{"email taken", `{"email":"john@example.com","name":"John"}`, http.StatusConflict},
+		{"invalid email", `{"email":"jane","name":"Jane"}`, http.StatusBadRequest},
+		{"unknown field", `{"email":"jane@example.com","name":"Jane","role":"admin"}`, http.StatusBadRequest},
+		{"empty body", ``, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRepository{users: []User{{ID: "1", Email: "john@example.com"}}}
+			rec := httptest.NewRecorder()
+
+			newTestHandler(repo).ServeHTTP(rec, httptest.NewRequest(Method, Path, strings.NewReader(tt.body)))
+
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestHandler_Created(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	newTestHandler(&fakeRepository{}).ServeHTTP(rec, httptest.NewRequest(Method, Path, strings.NewReader(`{"email":"jane@example.com","name":"Jane"}`)))
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var res Response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	assert.Equal(t, "jane@example.com", res.Email)
+	assert.Equal(t, Path+"/"+res.ID, rec.Header().Get("Location"))
+}
@@ -0,0 +1,35 @@
+package createuser
+
+import (
+	"context"
+	"database/sql"
+)
+
+// SQLRepository stores the new users in the users table
+type SQLRepository struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new SQLRepository
+func NewRepository(db *sql.DB) *SQLRepository {
+	return &SQLRepository{db: db}
+}
+
+// EmailExists reports whether a user has the email
+func (r *SQLRepository) EmailExists(ctx context.Context, email string) (bool, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM users WHERE email = ?`,
+		email,
+	).Scan(&count)
+	return count > 0, err
+}
+
+// Insert inserts the user
+func (r *SQLRepository) Insert(ctx context.Context, user User) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO users (id, email, name, created_at) VALUES (?, ?, ?, ?)`,
+		user.ID, user.Email, user.Name, user.CreatedAt,
+	)
+	return err
+}
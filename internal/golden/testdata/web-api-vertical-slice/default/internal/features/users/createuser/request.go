@@ -0,0 +1,40 @@
+package createuser
+
+import (
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/example/golden/internal/platform/apperror"
+)
+
+// Request creates a user
+type Request struct {
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+// Validate checks the fields of the request, the mediator calls it before the service
+func (r Request) Validate() error {
+	address, err := mail.ParseAddress(r.Email)
+	if err != nil || address.Address != strings.TrimSpace(r.Email) {
+		return apperror.Invalid("email must be a valid email address")
+	}
+	name := strings.TrimSpace(r.Name)
+	if name == "" {
+		return apperror.Invalid("name is required")
+	}
+	if utf8.RuneCountInString(name) > 100 {
+		return apperror.Invalid("name must be at most 100 characters")
+	}
+	return nil
+}
+
+// Response is the created user
+type Response struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,80 @@
+package createuser
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/mediator"
+	"github.com/example/golden/internal/platform/apperror"
+)
+
+// User is a row of the users table as this slice writes it
+type User struct {
+	ID        string
+	Email     string
+	Name      string
+	CreatedAt time.Time
+}
+
+// Repository stores the new users
+type Repository interface {
+	EmailExists(ctx context.Context, email string) (bool, error)
+	Insert(ctx context.Context, user User) error
+}
+
+// Service creates users
+type Service struct {
+	repo  Repository
+	clock clock.Clock
+	newID func() string
+}
+
+// NewService creates a new Service
+func NewService(repo Repository, clk clock.Clock) *Service {
+	return &Service{
+		repo:  repo,
+		clock: clk,
+		newID: uuid.NewString,
+	}
+}
+
+// Register registers the service as the handler of the requests of the slice
+func Register(m *mediator.Mediator, repo Repository, clk clock.Clock) {
+	mediator.Register[Request, Response](m, NewService(repo, clk))
+}
+
+// Handle creates the user of the request, whose email must not be taken
+func (s *Service) Handle(ctx context.Context, req Request) (Response, error) {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+
+	exists, err := s.repo.EmailExists(ctx, email)
+	if err != nil {
+		return Response{}, fmt.Errorf("failed to check email: %w", err)
+	}
+	if exists {
+		return Response{}, apperror.Conflict("a user with this email already exists")
+	}
+
+	// Timestamps are stored to the second, which every database keeps
+	user := User{
+		ID:        s.newID(),
+		Email:     email,
+		Name:      strings.TrimSpace(req.Name),
+		CreatedAt: s.clock.Now().UTC().Truncate(time.Second),
+	}
+	if err := s.repo.Insert(ctx, user); err != nil {
+		return Response{}, fmt.Errorf("failed to insert user: %w", err)
+	}
+
+	return Response{
+		ID:        user.ID,
+		Email:     user.Email,
+		Name:      user.Name,
+		CreatedAt: user.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,101 @@
+package createuser
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/platform/apperror"
+)
+
+// fakeRepository keeps the inserted users in memory
+type fakeRepository struct {
+	users []User
+	err   error
+}
+
+func (r *fakeRepository) EmailExists(_ context.Context, email string) (bool, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			return true, nil
+		}
+	}
+	return false, r.err
+}
+
+func (r *fakeRepository) Insert(_ context.Context, user User) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.users = append(r.users, user)
+	return nil
+}
+
+func newTestService(repo Repository) *Service {
+	s := NewService(repo, clock.NewFake(time.Date(2024, 3, 1, 12, 30, 15, 500, time.UTC)))
+	s.newID = func() string { return "7f9c2b1e-8d4a-4c3b-9e2f-1a5b6c7d8e9f" }
+	return s
+}
+
+func TestService_Handle(t *testing.T) {
+	repo := &fakeRepository{}
+
+	res, err := newTestService(repo).Handle(context.Background(), Request{Email: " Jane@Example.com ", Name: " Jane Doe "})
+
+	require.NoError(t, err)
+	assert.Equal(t, "7f9c2b1e-8d4a-4c3b-9e2f-1a5b6c7d8e9f", res.ID)
+	assert.Equal(t, "jane@example.com", res.Email)
+	assert.Equal(t, "Jane Doe", res.Name)
+	assert.Equal(t, time.Date(2024, 3, 1, 12, 30, 15, 0, time.UTC), res.CreatedAt)
+	require.Len(t, repo.users, 1)
+	assert.Equal(t, res.Email, repo.users[0].Email)
+}
+
+func TestService_Handle_EmailTaken(t *testing.T) {
+	repo := &fakeRepository{users: []User{{ID: "1", Email: "jane@example.com"}}}
+
+	_, err := newTestService(repo).Handle(context.Background(), Request{Email: "JANE@example.com", Name: "Jane"})
+
+	assert.Equal(t, apperror.KindConflict, apperror.KindOf(err))
+	assert.Len(t, repo.users, 1)
+}
+
+func TestService_Handle_RepositoryError(t *testing.T) {
+	repo := &fakeRepository{err: errors.New("connection refused")}
+
+	_, err := newTestService(repo).Handle(context.Background(), Request{Email: "jane@example.com", Name: "Jane"})
+
+	require.Error(t, err)
+	assert.Equal(t, apperror.KindInternal, apperror.KindOf(err))
+}
+
+func TestRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		request Request
+		valid   bool
+	}{
+		{"valid", Request{Email: "jane@example.com", Name: "Jane"}, true},
+		{"missing email", Request{Name: "Jane"}, false},
+		{"invalid email", Request{Email: "jane", Name: "Jane"}, false},
+		{"email with display name", Request{Email: "Jane <jane@example.com>", Name: "Jane"}, false},
+		{"blank name", Request{Email: "jane@example.com", Name: "  "}, false},
+		{"long name", Request{Email: "jane@example.com", Name: string(make([]rune, 101))}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, apperror.KindInvalid, apperror.KindOf(err))
+		})
+	}
+}
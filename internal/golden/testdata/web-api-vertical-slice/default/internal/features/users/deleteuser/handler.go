@@ -0,0 +1,25 @@
+package deleteuser
+
+import (
+	"net/http"
+
+	"github.com/example/golden/internal/mediator"
+	"github.com/example/golden/internal/platform/httpx"
+)
+
+// Method and Path are the endpoint of the slice
+const (
+	Method = http.MethodDelete
+	Path   = "/api/v1/users/{id}"
+)
+
+// Handler sends the request of the path to the service through the mediator
+func Handler(m *mediator.Mediator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if _, err := mediator.Send[Request, Response](r.Context(), m, Request{ID: r.PathValue("id")}); err != nil {
+			httpx.Error(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
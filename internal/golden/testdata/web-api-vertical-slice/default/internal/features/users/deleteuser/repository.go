@@ -0,0 +1,26 @@
+package deleteuser
+
+import (
+	"context"
+	"database/sql"
+)
+
+// SQLRepository deletes the users from the users table
+type SQLRepository struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new SQLRepository
+func NewRepository(db *sql.DB) *SQLRepository {
+	return &SQLRepository{db: db}
+}
+
+// Delete deletes the user with the ID and reports whether there was one
+func (r *SQLRepository) Delete(ctx context.Context, id string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM users WHERE id = ?`, id)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	return rows > 0, err
+}
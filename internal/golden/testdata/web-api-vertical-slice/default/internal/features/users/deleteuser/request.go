@@ -0,0 +1,23 @@
+package deleteuser
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/example/golden/internal/platform/apperror"
+)
+
+// Request deletes a user by ID
+type Request struct {
+	ID string
+}
+
+// Validate checks the fields of the request, the mediator calls it before the service
+func (r Request) Validate() error {
+	if _, err := uuid.Parse(r.ID); err != nil {
+		return apperror.Invalid("id must be a UUID")
+	}
+	return nil
+}
+
+// Response is empty, a deleted user has nothing left to show
+type Response struct{}
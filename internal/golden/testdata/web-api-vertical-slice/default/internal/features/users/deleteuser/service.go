@@ -0,0 +1,42 @@
+package deleteuser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/example/golden/internal/mediator"
+	"github.com/example/golden/internal/platform/apperror"
+)
+
+// Repository deletes users
+type Repository interface {
+	// Delete deletes the user with the ID and reports whether there was one
+	Delete(ctx context.Context, id string) (bool, error)
+}
+
+// Service deletes users
+type Service struct {
+	repo Repository
+}
+
+// NewService creates a new Service
+func NewService(repo Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// Register registers the service as the handler of the requests of the slice
+func Register(m *mediator.Mediator, repo Repository) {
+	mediator.Register[Request, Response](m, NewService(repo))
+}
+
+// Handle deletes the user of the request
+func (s *Service) Handle(ctx context.Context, req Request) (Response, error) {
+	deleted, err := s.repo.Delete(ctx, req.ID)
+	if err != nil {
+		return Response{}, fmt.Errorf("failed to delete user: %w", err)
+	}
+	if !deleted {
+		return Response{}, apperror.NotFound("user not found")
+	}
+	return Response{}, nil
+}
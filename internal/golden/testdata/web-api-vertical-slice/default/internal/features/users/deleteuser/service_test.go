@@ -0,0 +1,56 @@
+package deleteuser
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/platform/apperror"
+)
+
+// fakeRepository deletes from a set of IDs
+type fakeRepository struct {
+	ids map[string]bool
+	err error
+}
+
+func (r *fakeRepository) Delete(_ context.Context, id string) (bool, error) {
+	if r.err != nil {
+		return false, r.err
+	}
+	existed := r.ids[id]
+	delete(r.ids, id)
+	return existed, nil
+}
+
+const userID = "7f9c2b1e-8d4a-4c3b-9e2f-1a5b6c7d8e9f"
+
+func TestService_Handle(t *testing.T) {
+	repo := &fakeRepository{ids: map[string]bool{userID: true}}
+	service := NewService(repo)
+
+	_, err := service.Handle(context.Background(), Request{ID: userID})
+	require.NoError(t, err)
+	assert.Empty(t, repo.ids)
+
+	// Deleting twice finds nothing the second time
+	_, err = service.Handle(context.Background(), Request{ID: userID})
+	assert.Equal(t, apperror.KindNotFound, apperror.KindOf(err))
+}
+
+func TestService_Handle_RepositoryError(t *testing.T) {
+	service := NewService(&fakeRepository{err: errors.New("connection refused")})
+
+	_, err := service.Handle(context.Background(), Request{ID: userID})
+
+	require.Error(t, err)
+	assert.Equal(t, apperror.KindInternal, apperror.KindOf(err))
+}
+
+func TestRequest_Validate(t *testing.T) {
+	assert.NoError(t, Request{ID: userID}.Validate())
+	assert.Equal(t, apperror.KindInvalid, apperror.KindOf(Request{ID: ""}.Validate()))
+}
@@ -0,0 +1,31 @@
+package getuser
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// SQLRepository reads the users from the users table
+type SQLRepository struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new SQLRepository
+func NewRepository(db *sql.DB) *SQLRepository {
+	return &SQLRepository{db: db}
+}
+
+// FindByID returns the user with the ID, ErrNotFound when there is none.
+// The slice reads straight into its response, it has no other use for the row
+func (r *SQLRepository) FindByID(ctx context.Context, id string) (Response, error) {
+	var user Response
+	err := r.db.QueryRowContext(ctx,
+		`SELECT id, email, name, created_at FROM users WHERE id = ?`,
+		id,
+	).Scan(&user.ID, &user.Email, &user.Name, &user.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Response{}, ErrNotFound
+	}
+	return user, err
+}
@@ -0,0 +1,30 @@
+package getuser
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/example/golden/internal/platform/apperror"
+)
+
+// Request gets a user by ID
+type Request struct {
+	ID string
+}
+
+// Validate checks the fields of the request, the mediator calls it before the service
+func (r Request) Validate() error {
+	if _, err := uuid.Parse(r.ID); err != nil {
+		return apperror.Invalid("id must be a UUID")
+	}
+	return nil
+}
+
+// Response is the user
+type Response struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,45 @@
+package getuser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/example/golden/internal/mediator"
+	"github.com/example/golden/internal/platform/apperror"
+)
+
+// ErrNotFound is returned by the repository when no user has the ID
+var ErrNotFound = errors.New("user not found")
+
+// Repository reads the users
+type Repository interface {
+	FindByID(ctx context.Context, id string) (Response, error)
+}
+
+// Service gets users
+type Service struct {
+	repo Repository
+}
+
+// NewService creates a new Service
+func NewService(repo Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// Register registers the service as the handler of the requests of the slice
+func Register(m *mediator.Mediator, repo Repository) {
+	mediator.Register[Request, Response](m, NewService(repo))
+}
+
+// Handle returns the user of the request
+func (s *Service) Handle(ctx context.Context, req Request) (Response, error) {
+	user, err := s.repo.FindByID(ctx, req.ID)
+	if errors.Is(err, ErrNotFound) {
+		return Response{}, apperror.NotFound("user not found")
+	}
+	if err != nil {
+		return Response{}, fmt.Errorf("failed to find user: %w", err)
+	}
+	return user, nil
+}
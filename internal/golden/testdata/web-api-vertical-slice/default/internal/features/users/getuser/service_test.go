@@ -0,0 +1,64 @@
+package getuser
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/platform/apperror"
+)
+
+// fakeRepository serves the users of a map
+type fakeRepository struct {
+	users map[string]Response
+	err   error
+}
+
+func (r *fakeRepository) FindByID(_ context.Context, id string) (Response, error) {
+	if r.err != nil {
+		return Response{}, r.err
+	}
+	user, ok := r.users[id]
+	if !ok {
+		return Response{}, ErrNotFound
+	}
+	return user, nil
+}
+
+const userID = "7f9c2b1e-8d4a-4c3b-9e2f-1a5b6c7d8e9f"
+
+func TestService_Handle(t *testing.T) {
+	jane := Response{ID: userID, Email: "jane@example.com", Name: "Jane", CreatedAt: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)}
+	service := NewService(&fakeRepository{users: map[string]Response{userID: jane}})
+
+	res, err := service.Handle(context.Background(), Request{ID: userID})
+
+	require.NoError(t, err)
+	assert.Equal(t, jane, res)
+}
+
+func TestService_Handle_NotFound(t *testing.T) {
+	service := NewService(&fakeRepository{})
+
+	_, err := service.Handle(context.Background(), Request{ID: userID})
+
+	assert.Equal(t, apperror.KindNotFound, apperror.KindOf(err))
+}
+
+func TestService_Handle_RepositoryError(t *testing.T) {
+	service := NewService(&fakeRepository{err: errors.New("connection refused")})
+
+	_, err := service.Handle(context.Background(), Request{ID: userID})
+
+	require.Error(t, err)
+	assert.Equal(t, apperror.KindInternal, apperror.KindOf(err))
+}
+
+func TestRequest_Validate(t *testing.T) {
+	assert.NoError(t, Request{ID: userID}.Validate())
+	assert.Equal(t, apperror.KindInvalid, apperror.KindOf(Request{ID: "42"}.Validate()))
+}
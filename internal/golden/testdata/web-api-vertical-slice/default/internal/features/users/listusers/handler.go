@@ -0,0 +1,49 @@
+package listusers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/example/golden/internal/mediator"
+	"github.com/example/golden/internal/platform/apperror"
+	"github.com/example/golden/internal/platform/httpx"
+)
+
+// Method and Path are the endpoint of the slice
+const (
+	Method = http.MethodGet
+	Path   = "/api/v1/users"
+)
+
+// Handler sends the page of the query string, ?limit=20&offset=0, to the service
+// through the mediator
+func Handler(m *mediator.Mediator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := Request{Limit: DefaultLimit}
+		query := r.URL.Query()
+		params := []struct {
+			name  string
+			field *int
+		}{
+			{"limit", &req.Limit},
+			{"offset", &req.Offset},
+		}
+		for _, param := range params {
+			if value := query.Get(param.name); value != "" {
+				n, err := strconv.Atoi(value)
+				if err != nil {
+					httpx.Error(w, apperror.Invalid(param.name+" must be a number"))
+					return
+				}
+				*param.field = n
+			}
+		}
+
+		res, err := mediator.Send[Request, Response](r.Context(), m, req)
+		if err != nil {
+			httpx.Error(w, err)
+			return
+		}
+		httpx.JSON(w, http.StatusOK, res)
+	}
+}
@@ -0,0 +1,45 @@
+package listusers
+
+import (
+	"context"
+	"database/sql"
+)
+
+// SQLRepository reads the pages of users from the users table
+type SQLRepository struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new SQLRepository
+func NewRepository(db *sql.DB) *SQLRepository {
+	return &SQLRepository{db: db}
+}
+
+// List returns a page of users, the newest first
+func (r *SQLRepository) List(ctx context.Context, limit, offset int) ([]User, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, email, name, created_at FROM users ORDER BY created_at DESC, id LIMIT ? OFFSET ?`,
+		limit, offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []User
+	for rows.Next() {
+		var user User
+		if err := rows.Scan(&user.ID, &user.Email, &user.Name, &user.CreatedAt); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
+
+// Count returns the number of users
+func (r *SQLRepository) Count(ctx context.Context) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM users`).Scan(&count)
+	return count, err
+}
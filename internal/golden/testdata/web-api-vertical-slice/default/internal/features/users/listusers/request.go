@@ -0,0 +1,43 @@
+package listusers
+
+import (
+	"time"
+
+	"github.com/example/golden/internal/platform/apperror"
+)
+
+// DefaultLimit is the page size when the request does not give one
+const DefaultLimit = 20
+
+// Request lists a page of users, the newest first
+type Request struct {
+	Limit  int
+	Offset int
+}
+
+// Validate checks the fields of the request, the mediator calls it before the service
+func (r Request) Validate() error {
+	if r.Limit < 1 || r.Limit > 100 {
+		return apperror.Invalid("limit must be between 1 and 100")
+	}
+	if r.Offset < 0 {
+		return apperror.Invalid("offset must not be negative")
+	}
+	return nil
+}
+
+// User is a user of the page
+type User struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Response is a page of users
+type Response struct {
+	Users  []User `json:"users"`
+	Total  int    `json:"total"`
+	Limit  int    `json:"limit"`
+	Offset int    `json:"offset"`
+}
@@ -0,0 +1,52 @@
+package listusers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/example/golden/internal/mediator"
+)
+
+// Repository reads the pages of users
+type Repository interface {
+	List(ctx context.Context, limit, offset int) ([]User, error)
+	Count(ctx context.Context) (int, error)
+}
+
+// Service lists users
+type Service struct {
+	repo Repository
+}
+
+// NewService creates a new Service
+func NewService(repo Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// Register registers the service as the handler of the requests of the slice
+func Register(m *mediator.Mediator, repo Repository) {
+	mediator.Register[Request, Response](m, NewService(repo))
+}
+
+// Handle returns the page of the request with the total number of users
+func (s *Service) Handle(ctx context.Context, req Request) (Response, error) {
+	users, err := s.repo.List(ctx, req.Limit, req.Offset)
+	if err != nil {
+		return Response{}, fmt.Errorf("failed to list users: %w", err)
+	}
+	total, err := s.repo.Count(ctx)
+	if err != nil {
+		return Response{}, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	// An empty page is encoded as [], not null
+	if users == nil {
+		users = []User{}
+	}
+	return Response{
+		Users:  users,
+		Total:  total,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}, nil
+}
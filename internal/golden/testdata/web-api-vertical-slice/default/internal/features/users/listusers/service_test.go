@@ -0,0 +1,87 @@
+package listusers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/platform/apperror"
+)
+
+// fakeRepository pages through a slice of users
+type fakeRepository struct {
+	users []User
+	err   error
+}
+
+func (r *fakeRepository) List(_ context.Context, limit, offset int) ([]User, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if offset >= len(r.users) {
+		return nil, nil
+	}
+	return r.users[offset:min(offset+limit, len(r.users))], nil
+}
+
+func (r *fakeRepository) Count(context.Context) (int, error) {
+	return len(r.users), r.err
+}
+
+func newUsers(n int) []User {
+	users := make([]User, n)
+	for i := range users {
+		users[i] = User{ID: fmt.Sprint(i), Email: fmt.Sprintf("user%d@example.com", i)}
+	}
+	return users
+}
+
+func TestService_Handle(t *testing.T) {
+	tests := []struct {
+		name   string
+		limit  int
+		offset int
+		ids    []string
+	}{
+		{"first page", 2, 0, []string{"0", "1"}},
+		{"last page", 2, 4, []string{"4"}},
+		{"past the end", 2, 10, []string{}},
+	}
+
+	service := NewService(&fakeRepository{users: newUsers(5)})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := service.Handle(context.Background(), Request{Limit: tt.limit, Offset: tt.offset})
+
+			require.NoError(t, err)
+			ids := []string{}
+			for _, user := range res.Users {
+				ids = append(ids, user.ID)
+			}
+			assert.Equal(t, tt.ids, ids)
+			assert.Equal(t, 5, res.Total)
+			assert.Equal(t, tt.limit, res.Limit)
+			assert.Equal(t, tt.offset, res.Offset)
+		})
+	}
+}
+
+func TestService_Handle_RepositoryError(t *testing.T) {
+	service := NewService(&fakeRepository{err: errors.New("connection refused")})
+
+	_, err := service.Handle(context.Background(), Request{Limit: DefaultLimit})
+
+	require.Error(t, err)
+	assert.Equal(t, apperror.KindInternal, apperror.KindOf(err))
+}
+
+func TestRequest_Validate(t *testing.T) {
+	assert.NoError(t, Request{Limit: DefaultLimit}.Validate())
+	assert.Equal(t, apperror.KindInvalid, apperror.KindOf(Request{Limit: 0}.Validate()))
+	assert.Equal(t, apperror.KindInvalid, apperror.KindOf(Request{Limit: 101}.Validate()))
+	assert.Equal(t, apperror.KindInvalid, apperror.KindOf(Request{Limit: 10, Offset: -1}.Validate()))
+}
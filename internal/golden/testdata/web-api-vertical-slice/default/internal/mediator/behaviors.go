@@ -0,0 +1,53 @@
+package mediator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/platform/apperror"
+)
+
+// Validator is implemented by the requests that check their own fields
+type Validator interface {
+	Validate() error
+}
+
+// Logging logs every request with its duration; failures other than the
+// errors a client caused are logged as errors
+func Logging(log logger.Logger) Behavior {
+	return func(ctx context.Context, req any, next Next) (any, error) {
+		start := time.Now()
+		res, err := next(ctx)
+
+		request := fmt.Sprintf("%T", req)
+		duration := time.Since(start)
+		switch {
+		case err == nil:
+			log.Debug("Request handled", "request", request, "duration", duration)
+		case apperror.KindOf(err) == apperror.KindInternal:
+			log.Error("Request failed", "request", request, "duration", duration, "error", err)
+		default:
+			log.Info("Request rejected", "request", request, "duration", duration, "error", err)
+		}
+		return res, err
+	}
+}
+
+// Validation rejects the requests whose Validate method fails before they
+// reach their handler
+func Validation() Behavior {
+	return func(ctx context.Context, req any, next Next) (any, error) {
+		if v, ok := req.(Validator); ok {
+			if err := v.Validate(); err != nil {
+				// Validate may return plain errors, they are still the client's fault
+				if apperror.KindOf(err) == apperror.KindInternal {
+					err = apperror.Invalid(err.Error())
+				}
+				return nil, err
+			}
+		}
+		return next(ctx)
+	}
+}
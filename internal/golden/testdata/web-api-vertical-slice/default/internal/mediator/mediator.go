@@ -0,0 +1,105 @@
+// Package mediator dispatches the request of a slice to its handler through
+// the behaviors shared by every slice, so that an HTTP handler only depends on
+// the request and response types of its slice
+package mediator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrNoHandler is returned when no handler is registered for a request type
+var ErrNoHandler = errors.New("no handler registered")
+
+// Handler handles the requests of one type
+type Handler[Req, Res any] interface {
+	Handle(ctx context.Context, req Req) (Res, error)
+}
+
+// HandlerFunc adapts a function to a Handler
+type HandlerFunc[Req, Res any] func(ctx context.Context, req Req) (Res, error)
+
+// Handle calls f
+func (f HandlerFunc[Req, Res]) Handle(ctx context.Context, req Req) (Res, error) {
+	return f(ctx, req)
+}
+
+// Next runs the rest of the pipeline: the next behavior, or the handler
+type Next func(ctx context.Context) (any, error)
+
+// Behavior runs around the handling of every request, calling next to go on.
+// Behaviors run in the order they are given to New
+type Behavior func(ctx context.Context, req any, next Next) (any, error)
+
+// Mediator holds the handler of each request type and the behaviors around them.
+// Handlers are registered at startup, before the first request is sent
+type Mediator struct {
+	handlers  map[reflect.Type]registration
+	behaviors []Behavior
+}
+
+// registration is a handler with its request and response types erased
+type registration struct {
+	response reflect.Type
+	handle   func(ctx context.Context, req any) (any, error)
+}
+
+// New creates a Mediator running the given behaviors around every handler
+func New(behaviors ...Behavior) *Mediator {
+	return &Mediator{
+		handlers:  make(map[reflect.Type]registration),
+		behaviors: behaviors,
+	}
+}
+
+// Register makes h the handler of the requests of type Req.
+// It panics when Req already has a handler, as two slices cannot own a request
+func Register[Req, Res any](m *Mediator, h Handler[Req, Res]) {
+	requestType := reflect.TypeFor[Req]()
+	if _, exists := m.handlers[requestType]; exists {
+		panic(fmt.Sprintf("mediator: handler already registered for %s", requestType))
+	}
+
+	m.handlers[requestType] = registration{
+		response: reflect.TypeFor[Res](),
+		handle: func(ctx context.Context, req any) (any, error) {
+			return h.Handle(ctx, req.(Req))
+		},
+	}
+}
+
+// Send dispatches req to the handler of its type through the behaviors
+func Send[Req, Res any](ctx context.Context, m *Mediator, req Req) (Res, error) {
+	var zero Res
+
+	requestType := reflect.TypeFor[Req]()
+	reg, ok := m.handlers[requestType]
+	if !ok {
+		return zero, fmt.Errorf("%w for %s", ErrNoHandler, requestType)
+	}
+	if responseType := reflect.TypeFor[Res](); reg.response != responseType {
+		return zero, fmt.Errorf("handler of %s returns %s, not %s", requestType, reg.response, responseType)
+	}
+
+	next := func(ctx context.Context) (any, error) {
+		return reg.handle(ctx, req)
+	}
+	for i := len(m.behaviors) - 1; i >= 0; i-- {
+		behavior, inner := m.behaviors[i], next
+		next = func(ctx context.Context) (any, error) {
+			return behavior(ctx, req, inner)
+		}
+	}
+
+	res, err := next(ctx)
+	if err != nil {
+		return zero, err
+	}
+	// A behavior may stop the pipeline without a response
+	if res == nil {
+		return zero, nil
+	}
+	return res.(Res), nil
+}
@@ -0,0 +1,101 @@
+package mediator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/platform/apperror"
+)
+
+type greetRequest struct {
+	Name string
+}
+
+func (r greetRequest) Validate() error {
+	if r.Name == "" {
+		return errors.New("name is required")
+	}
+	return nil
+}
+
+type greetResponse struct {
+	Greeting string
+}
+
+var greet = HandlerFunc[greetRequest, greetResponse](func(_ context.Context, req greetRequest) (greetResponse, error) {
+	return greetResponse{Greeting: "Hello " + req.Name}, nil
+})
+
+func TestSend(t *testing.T) {
+	m := New()
+	Register[greetRequest, greetResponse](m, greet)
+
+	res, err := Send[greetRequest, greetResponse](context.Background(), m, greetRequest{Name: "Jane"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Hello Jane", res.Greeting)
+}
+
+func TestSend_NoHandler(t *testing.T) {
+	_, err := Send[greetRequest, greetResponse](context.Background(), New(), greetRequest{Name: "Jane"})
+
+	assert.ErrorIs(t, err, ErrNoHandler)
+}
+
+func TestSend_WrongResponseType(t *testing.T) {
+	m := New()
+	Register[greetRequest, greetResponse](m, greet)
+
+	_, err := Send[greetRequest, string](context.Background(), m, greetRequest{Name: "Jane"})
+
+	assert.ErrorContains(t, err, "returns")
+}
+
+func TestRegister_Twice(t *testing.T) {
+	m := New()
+	Register[greetRequest, greetResponse](m, greet)
+
+	assert.Panics(t, func() { Register[greetRequest, greetResponse](m, greet) })
+}
+
+func TestSend_BehaviorsOrder(t *testing.T) {
+	var calls []string
+	record := func(name string) Behavior {
+		return func(ctx context.Context, req any, next Next) (any, error) {
+			calls = append(calls, name+" before")
+			res, err := next(ctx)
+			calls = append(calls, name+" after")
+			return res, err
+		}
+	}
+	m := New(record("first"), record("second"))
+	Register[greetRequest, greetResponse](m, HandlerFunc[greetRequest, greetResponse](func(ctx context.Context, req greetRequest) (greetResponse, error) {
+		calls = append(calls, "handler")
+		return greet(ctx, req)
+	}))
+
+	_, err := Send[greetRequest, greetResponse](context.Background(), m, greetRequest{Name: "Jane"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first before", "second before", "handler", "second after", "first after"}, calls)
+}
+
+func TestValidation(t *testing.T) {
+	handled := false
+	m := New(Validation())
+	Register[greetRequest, greetResponse](m, HandlerFunc[greetRequest, greetResponse](func(ctx context.Context, req greetRequest) (greetResponse, error) {
+		handled = true
+		return greet(ctx, req)
+	}))
+
+	_, err := Send[greetRequest, greetResponse](context.Background(), m, greetRequest{})
+
+	// A plain validation error is still the client's fault
+	assert.Equal(t, apperror.KindInvalid, apperror.KindOf(err))
+	assert.EqualError(t, err, "name is required")
+	assert.False(t, handled)
+}
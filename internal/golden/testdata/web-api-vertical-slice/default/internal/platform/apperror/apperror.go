@@ -0,0 +1,56 @@
+// Package apperror classifies the errors of the slices, so that the HTTP layer
+// can answer with a status without knowing the slice that failed
+package apperror
+
+import (
+	"errors"
+)
+
+// Kind classifies an error
+type Kind int
+
+const (
+	// KindInternal is a failure the client cannot fix, the default for any error
+	KindInternal Kind = iota
+	// KindInvalid is a request that does not validate
+	KindInvalid
+	// KindNotFound is a request for a resource that does not exist
+	KindNotFound
+	// KindConflict is a request conflicting with the current state
+	KindConflict
+)
+
+// Error is an error of a known kind, whose message can be shown to the client
+type Error struct {
+	Kind    Kind
+	Message string
+}
+
+// Error returns the message
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Invalid creates an error for a request that does not validate
+func Invalid(message string) error {
+	return &Error{Kind: KindInvalid, Message: message}
+}
+
+// NotFound creates an error for a resource that does not exist
+func NotFound(message string) error {
+	return &Error{Kind: KindNotFound, Message: message}
+}
+
+// Conflict creates an error for a request conflicting with the current state
+func Conflict(message string) error {
+	return &Error{Kind: KindConflict, Message: message}
+}
+
+// KindOf returns the kind of err, KindInternal when it has none
+func KindOf(err error) Kind {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Kind
+	}
+	return KindInternal
+}
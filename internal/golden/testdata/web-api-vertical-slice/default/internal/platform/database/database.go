@@ -0,0 +1,33 @@
+// Package database opens the SQLite database the repositories of the slices share
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// DriverName is the database/sql driver of the repositories
+const DriverName = "sqlite3"
+
+// Open connects to the database at url and checks that it answers
+func Open(ctx context.Context, url string) (*sql.DB, error) {
+	db, err := sql.Open(DriverName, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	// SQLite allows one writer at a time
+	db.SetMaxOpenConns(1)
+
+	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := db.PingContext(pingCtx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	return db, nil
+}
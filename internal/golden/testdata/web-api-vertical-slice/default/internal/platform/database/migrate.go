@@ -0,0 +1,59 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"github.com/example/golden/internal/clock"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Migrate applies the migrations not applied yet, in the order of their file
+// names, and returns their names. Each file holds a single statement
+func Migrate(ctx context.Context, db *sql.DB, clk clock.Clock) ([]string, error) {
+	if _, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version VARCHAR(255) PRIMARY KEY,
+		applied_at TIMESTAMP NOT NULL
+	)`); err != nil {
+		return nil, fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	var applied []string
+	for _, name := range names {
+		version := strings.TrimSuffix(strings.TrimPrefix(name, "migrations/"), ".sql")
+
+		var count int
+		if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM schema_migrations WHERE version = ?`, version).Scan(&count); err != nil {
+			return applied, fmt.Errorf("failed to check migration %s: %w", version, err)
+		}
+		if count > 0 {
+			continue
+		}
+
+		statement, err := migrations.ReadFile(name)
+		if err != nil {
+			return applied, err
+		}
+		if _, err := db.ExecContext(ctx, string(statement)); err != nil {
+			return applied, fmt.Errorf("failed to apply migration %s: %w", version, err)
+		}
+		if _, err := db.ExecContext(ctx, `INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?)`, version, clk.Now().UTC()); err != nil {
+			return applied, fmt.Errorf("failed to record migration %s: %w", version, err)
+		}
+		applied = append(applied, version)
+	}
+	return applied, nil
+}
@@ -0,0 +1,68 @@
+// Package httpx holds the JSON helpers the handlers of the slices share
+package httpx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/example/golden/internal/platform/apperror"
+)
+
+// maxBodyBytes bounds the request bodies
+const maxBodyBytes = 1 << 20
+
+// ErrorResponse is the body of every error response
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Decode reads the JSON body of r into v, rejecting unknown fields
+func Decode(w http.ResponseWriter, r *http.Request, v any) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		if errors.Is(err, io.EOF) {
+			return apperror.Invalid("request body is empty")
+		}
+		return apperror.Invalid(fmt.Sprintf("invalid request body: %v", err))
+	}
+	if decoder.More() {
+		return apperror.Invalid("request body must hold a single JSON object")
+	}
+	return nil
+}
+
+// JSON writes v as the JSON body of a response with the given status
+func JSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// Error writes the response of err: its message for the errors of a known kind,
+// a generic message for the others, which the mediator has logged
+func Error(w http.ResponseWriter, err error) {
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) {
+		JSON(w, http.StatusInternalServerError, ErrorResponse{Error: "internal server error"})
+		return
+	}
+	JSON(w, Status(appErr.Kind), ErrorResponse{Error: appErr.Message})
+}
+
+// Status returns the HTTP status of an error kind
+func Status(kind apperror.Kind) int {
+	switch kind {
+	case apperror.KindInvalid:
+		return http.StatusBadRequest
+	case apperror.KindNotFound:
+		return http.StatusNotFound
+	case apperror.KindConflict:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
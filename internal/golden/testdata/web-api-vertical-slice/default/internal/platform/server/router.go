@@ -0,0 +1,42 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/example/golden/internal/features"
+)
+
+// NewRouter routes the requests to the slices with gin. The slices are plain
+// http.HandlerFuncs reading their path parameters with r.PathValue
+func NewRouter(routes []features.Route) http.Handler {
+	gin.SetMode(gin.ReleaseMode)
+	router := gin.New()
+	for _, route := range routes {
+		router.Handle(route.Method, colonParams(route.Path), slice(route.Handler))
+	}
+	return router
+}
+
+// slice adapts the handler of a slice, copying the gin parameters to the request
+func slice(h http.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, param := range c.Params {
+			c.Request.SetPathValue(param.Key, param.Value)
+		}
+		h(c.Writer, c.Request)
+	}
+}
+
+// colonParams turns the {name} parameters of a route into the :name ones of the router
+func colonParams(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			segments[i] = ":" + strings.Trim(segment, "{}")
+		}
+	}
+	return strings.Join(segments, "/")
+}
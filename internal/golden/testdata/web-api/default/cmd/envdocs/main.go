@@ -0,0 +1,85 @@
+// Command envdocs writes the environment variable reference of README.md and
+// .env.example from the fields of internal/config, so that the documentation
+// follows the configuration code
+//
+//	go run ./cmd/envdocs          # make env-docs
+//	go run ./cmd/envdocs -check   # make env-docs-check, fails when they are out of date
+//
+// The reference replaces the lines between the env-docs markers of the README.
+package main
+
+//go:generate go run -C ../.. ./cmd/envdocs
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/example/golden/internal/config"
+)
+
+const (
+	startMarker = "<!-- env-docs:start -->\n"
+	endMarker   = "<!-- env-docs:end -->"
+)
+
+func main() {
+	readme := flag.String("readme", "README.md", "README to write the reference in, between the env-docs markers")
+	example := flag.String("example", ".env.example", "Example environment file to write")
+	check := flag.Bool("check", false, "Fail when the files are out of date instead of writing them")
+	flag.Parse()
+
+	vars := config.EnvVars()
+	files := []struct {
+		path   string
+		render func(current []byte) ([]byte, error)
+	}{
+		{*readme, func(current []byte) ([]byte, error) { return replaceReference(current, config.EnvTable(vars)) }},
+		{*example, func([]byte) ([]byte, error) { return []byte(config.EnvExample(vars)), nil }},
+	}
+
+	stale := false
+	for _, file := range files {
+		current, err := os.ReadFile(file.path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Fatalf("envdocs: %v", err)
+		}
+		updated, err := file.render(current)
+		if err != nil {
+			log.Fatalf("envdocs: %s: %v", file.path, err)
+		}
+		if bytes.Equal(current, updated) {
+			continue
+		}
+		if *check {
+			fmt.Fprintf(os.Stderr, "%s is out of date, run make env-docs\n", file.path)
+			stale = true
+			continue
+		}
+		if err := os.WriteFile(file.path, updated, 0o644); err != nil {
+			log.Fatalf("envdocs: %v", err)
+		}
+		fmt.Printf("envdocs: wrote %s\n", file.path)
+	}
+	if stale {
+		os.Exit(1)
+	}
+}
+
+// replaceReference replaces the lines between the markers of readme with table
+func replaceReference(readme []byte, table string) ([]byte, error) {
+	start := bytes.Index(readme, []byte(startMarker))
+	end := bytes.Index(readme, []byte(endMarker))
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("no %q and %q markers", startMarker[:len(startMarker)-1], endMarker)
+	}
+
+	var b bytes.Buffer
+	b.Write(readme[:start+len(startMarker)])
+	b.WriteString(table)
+	b.Write(readme[end:])
+	return b.Bytes(), nil
+}
@@ -0,0 +1,223 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// Config holds the application configuration. The desc tags of its fields
+// document the environment variables overriding them, see EnvVars.
+type Config struct {
+	Environment string         `mapstructure:"environment" env:"ENVIRONMENT" desc:"Environment selecting configs/config.<environment>.yaml: development, test or production"`
+	Server      ServerConfig   `mapstructure:"server"`
+	Database    DatabaseConfig `mapstructure:"database"`
+	JWT         JWTConfig      `mapstructure:"jwt"`
+	Logging     LoggingConfig  `mapstructure:"logging"`
+}
+
+// ServerConfig holds server configuration
+type ServerConfig struct {
+	Port         int `mapstructure:"port" desc:"Port the HTTP server listens on"`
+	ReadTimeout  int `mapstructure:"read_timeout" desc:"Seconds to read a request"`
+	WriteTimeout int `mapstructure:"write_timeout" desc:"Seconds to write a response"`
+	IdleTimeout  int `mapstructure:"idle_timeout" desc:"Seconds a keep-alive connection may stay idle"`
+}
+
+// DatabaseConfig holds database configuration
+type DatabaseConfig struct {
+	Host            string `mapstructure:"host" desc:"Database host"`
+	Port            int    `mapstructure:"port" desc:"Database port"`
+	Name            string `mapstructure:"name" desc:"Database name"`
+	User            string `mapstructure:"user" desc:"Database user"`
+	Password        string `mapstructure:"password" secret:"true" desc:"Database password"`
+	SSLMode         string `mapstructure:"ssl_mode" desc:"SSL mode of the database connections"`
+	MaxIdleConns    int    `mapstructure:"max_idle_conns" desc:"Idle connections kept in the pool"`
+	MaxOpenConns    int    `mapstructure:"max_open_conns" desc:"Open connections at most"`
+	ConnMaxLifetime int    `mapstructure:"conn_max_lifetime" desc:"Seconds a connection is reused for"`
+	LogLevel        string `mapstructure:"log_level" desc:"Level of the database logs"`
+}
+
+// DSN returns the database connection string
+func (d DatabaseConfig) DSN() string {
+	sslMode := d.SSLMode
+	if sslMode == "" {
+		sslMode = "disable"
+	}
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		d.Host, d.Port, d.User, d.Password, d.Name, sslMode)
+}
+
+// JWTConfig holds JWT configuration. Tokens are signed with the private keys of
+// KeysDir, whose public keys are published on /.well-known/jwks.json.
+type JWTConfig struct {
+	Algorithm  string            `mapstructure:"algorithm" desc:"Algorithm of the generated development keys: RS256 or EdDSA"`
+	KeysDir    string            `mapstructure:"keys_dir" example:"./keys" desc:"Directory of the <kid>.pem private signing keys, required in production"`
+	ActiveKID  string            `mapstructure:"active_kid" desc:"Key signing new tokens, the newest one when empty"`
+	Issuer     string            `mapstructure:"issuer" desc:"iss claim of issued tokens"`
+	Audience   string            `mapstructure:"audience" desc:"aud claim of issued tokens"`
+	Expiration int               `mapstructure:"expiration" desc:"Hours issued tokens are valid for, 168 at most"`
+	External   ExternalJWTConfig `mapstructure:"external"`
+}
+
+// ExternalJWTConfig accepts the tokens of an external identity provider
+type ExternalJWTConfig struct {
+	Issuer  string `mapstructure:"issuer" desc:"Issuer of the external identity provider whose tokens are accepted"`
+	JWKSURL string `mapstructure:"jwks_url" desc:"JWKS URL of the external identity provider, https in production"`
+}
+
+// LoggingConfig holds logging configuration
+type LoggingConfig struct {
+	Level      string `mapstructure:"level" desc:"Log level: debug, info, warn or error"`
+	Format     string `mapstructure:"format" desc:"Log format: json or console"`
+	Structured bool   `mapstructure:"structured" desc:"Write structured logs"`
+} // Load loads configuration from file and environment variables
+func Load() (*Config, error) {
+	v := viper.New()
+
+	// Set default values
+	setDefaults(v)
+
+	// Set config file name and paths
+	v.SetConfigName("config")
+	v.SetConfigType("yaml")
+	v.AddConfigPath("./configs")
+	v.AddConfigPath(".")
+
+	// Set environment variable prefix
+	v.SetEnvPrefix(EnvPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	// Determine environment
+	env := os.Getenv("ENVIRONMENT")
+	if env == "" {
+		env = "development"
+	}
+
+	// Try to read environment-specific config file
+	v.SetConfigName(fmt.Sprintf("config.%s", env))
+	if err := v.ReadInConfig(); err != nil {
+		// If environment-specific config doesn't exist, try default
+		v.SetConfigName("config")
+		if err := v.ReadInConfig(); err != nil {
+			// If no config file found, use defaults with env vars
+			if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+				return nil, fmt.Errorf("failed to read config file: %w", err)
+			}
+		}
+	}
+
+	var config Config
+	if err := v.Unmarshal(&config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	// Set environment
+	config.Environment = env
+
+	// Validate configuration
+	if err := validateConfig(&config); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	return &config, nil
+}
+
+// setDefaults sets default configuration values
+func setDefaults(v *viper.Viper) {
+	v.SetDefault("environment", "development")
+
+	// Server defaults
+	v.SetDefault("server.port", 8080)
+	v.SetDefault("server.read_timeout", 30)
+	v.SetDefault("server.write_timeout", 30)
+	v.SetDefault("server.idle_timeout", 60)
+	// Database defaults
+	v.SetDefault("database.host", "localhost")
+	v.SetDefault("database.port", 5432)
+	v.SetDefault("database.name", "golden")
+	v.SetDefault("database.user", "golden")
+	v.SetDefault("database.password", "")
+	v.SetDefault("database.ssl_mode", "disable")
+	v.SetDefault("database.max_idle_conns", 10)
+	v.SetDefault("database.max_open_conns", 100)
+	v.SetDefault("database.conn_max_lifetime", 3600)
+	v.SetDefault("database.log_level", "info")
+	// JWT defaults
+	v.SetDefault("jwt.algorithm", "RS256")
+	v.SetDefault("jwt.keys_dir", "")
+	v.SetDefault("jwt.active_kid", "")
+	v.SetDefault("jwt.issuer", "golden")
+	v.SetDefault("jwt.audience", "")
+	v.SetDefault("jwt.expiration", 24) // 24 hours
+	v.SetDefault("jwt.external.issuer", "")
+	v.SetDefault("jwt.external.jwks_url", "")
+
+	// Logging defaults
+	v.SetDefault("logging.level", "info")
+	v.SetDefault("logging.format", "json")
+	v.SetDefault("logging.structured", true)
+}
+
+// validateConfig validates the configuration
+func validateConfig(config *Config) error {
+	// Validate server port
+	if config.Server.Port <= 0 || config.Server.Port > 65535 {
+		return fmt.Errorf("invalid server port: %d", config.Server.Port)
+	}
+	// Validate database configuration
+	if config.Database.Host == "" {
+		return fmt.Errorf("database host is required")
+	}
+	if config.Database.Name == "" {
+		return fmt.Errorf("database name is required")
+	}
+	if config.Database.User == "" {
+		return fmt.Errorf("database user is required")
+	}
+	// Validate JWT configuration
+	if config.JWT.Algorithm != "RS256" && config.JWT.Algorithm != "EdDSA" {
+		return fmt.Errorf("invalid JWT algorithm %q, use RS256 or EdDSA", config.JWT.Algorithm)
+	}
+	if config.JWT.KeysDir == "" && config.Environment == "production" {
+		return fmt.Errorf("SECURITY ERROR: JWT signing keys are required in production. Set GOLDEN_JWT_KEYS_DIR to a directory of PEM private keys")
+	}
+	if config.JWT.Issuer == "" {
+		return fmt.Errorf("JWT issuer is required")
+	}
+	if (config.JWT.External.Issuer == "") != (config.JWT.External.JWKSURL == "") {
+		return fmt.Errorf("JWT external issuer and jwks_url must be set together")
+	}
+	if config.JWT.External.JWKSURL != "" {
+		jwksURL, err := url.Parse(config.JWT.External.JWKSURL)
+		if err != nil || jwksURL.Host == "" {
+			return fmt.Errorf("invalid JWT external jwks_url: %s", config.JWT.External.JWKSURL)
+		}
+		if jwksURL.Scheme != "https" && config.Environment == "production" {
+			return fmt.Errorf("SECURITY ERROR: JWT external jwks_url must use https in production")
+		}
+	}
+	if config.JWT.Expiration <= 0 {
+		return fmt.Errorf("JWT expiration must be positive")
+	}
+	if config.JWT.Expiration > 168 { // 7 days
+		return fmt.Errorf("SECURITY WARNING: JWT expiration should not exceed 168 hours (7 days) for security")
+	}
+
+	// Validate logging level
+	validLevels := map[string]bool{
+		"debug": true,
+		"info":  true,
+		"warn":  true,
+		"error": true,
+	}
+	if !validLevels[config.Logging.Level] {
+		return fmt.Errorf("invalid logging level: %s", config.Logging.Level)
+	}
+
+	return nil
+}
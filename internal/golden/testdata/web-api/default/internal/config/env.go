@@ -0,0 +1,109 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// EnvPrefix prefixes the environment variables overriding the settings of Config
+const EnvPrefix = "GOLDEN"
+
+// EnvVar is an environment variable read by the service
+type EnvVar struct {
+	Name        string
+	Default     string
+	Description string
+	// Example is the value of the variable in .env.example, its default when empty
+	Example string
+	// Secret variables are left empty in .env.example
+	Secret bool
+}
+
+// otherEnvVars are the environment variables read outside of Config
+var otherEnvVars = []EnvVar{}
+
+// EnvVars returns the environment variables of the service: those of the fields
+// of Config, named after their mapstructure keys unless an env tag names them and
+// described by their desc tags, then those read elsewhere. Maps and slices are
+// only set in the configuration files.
+func EnvVars() []EnvVar {
+	v := viper.New()
+	setDefaults(v)
+
+	var vars []EnvVar
+	collectEnvVars(reflect.TypeOf(Config{}), "", v, &vars)
+	return append(vars, otherEnvVars...)
+}
+
+func collectEnvVars(t reflect.Type, prefix string, v *viper.Viper, vars *[]EnvVar) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key := field.Tag.Get("mapstructure")
+		if key == "" || key == "-" {
+			continue
+		}
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		switch field.Type.Kind() {
+		case reflect.Struct:
+			collectEnvVars(field.Type, key, v, vars)
+			continue
+		case reflect.Map, reflect.Slice:
+			continue
+		}
+
+		name := field.Tag.Get("env")
+		if name == "" {
+			name = EnvPrefix + "_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+		}
+		value := ""
+		if def := v.Get(key); def != nil {
+			value = fmt.Sprint(def)
+		}
+		*vars = append(*vars, EnvVar{
+			Name:        name,
+			Default:     value,
+			Description: field.Tag.Get("desc"),
+			Example:     field.Tag.Get("example"),
+			Secret:      field.Tag.Get("secret") == "true",
+		})
+	}
+}
+
+// EnvTable returns the Markdown table of the environment variables
+func EnvTable(vars []EnvVar) string {
+	var b strings.Builder
+	b.WriteString("| Variable | Default | Description |\n")
+	b.WriteString("|----------|---------|-------------|\n")
+	for _, env := range vars {
+		def := ""
+		if env.Default != "" {
+			def = "`" + env.Default + "`"
+		}
+		fmt.Fprintf(&b, "| `%s` | %s | %s |\n", env.Name, def, env.Description)
+	}
+	return b.String()
+}
+
+// EnvExample returns the content of .env.example
+func EnvExample(vars []EnvVar) string {
+	var b strings.Builder
+	b.WriteString("# Environment variables of the service, written by `make env-docs` from the\n")
+	b.WriteString("# fields of internal/config: change their desc tags rather than this file.\n")
+	for _, env := range vars {
+		value := env.Default
+		switch {
+		case env.Secret:
+			value = ""
+		case env.Example != "":
+			value = env.Example
+		}
+		fmt.Fprintf(&b, "\n# %s\n%s=%s\n", env.Description, env.Name, value)
+	}
+	return b.String()
+}
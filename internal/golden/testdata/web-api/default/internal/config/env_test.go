@@ -0,0 +1,49 @@
+package config
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestEnvVars_Documented(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, env := range EnvVars() {
+		if env.Description == "" {
+			t.Errorf("%s has no description, add a desc tag to its field", env.Name)
+		}
+		if seen[env.Name] {
+			t.Errorf("%s is read for two settings", env.Name)
+		}
+		seen[env.Name] = true
+	}
+}
+
+// TestEnvExample checks that .env.example follows the configuration code, and
+// that the service starts with it
+func TestEnvExample(t *testing.T) {
+	content, err := os.ReadFile("../../.env.example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != EnvExample(EnvVars()) {
+		t.Fatal(".env.example is out of date, run make env-docs")
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(string(content)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, ok := strings.Cut(line, "=")
+		if !ok {
+			t.Fatalf("invalid line %q", line)
+		}
+		t.Setenv(name, value)
+	}
+	if _, err := Load(); err != nil {
+		t.Fatalf("the settings of .env.example do not load: %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"github.com/example/golden/internal/config"
+	appLogger "github.com/example/golden/internal/logger"
+)
+
+// DB is the global database connection
+var DB *sql.DB
+
+// Connect establishes a database connection using database/sql
+func Connect(cfg config.DatabaseConfig, logger appLogger.Logger) (*sql.DB, error) {
+	dsn := cfg.DSN()
+	var driverName string
+	driverName = "postgres"
+
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	// Configure connection pool
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetConnMaxLifetime(time.Duration(cfg.ConnMaxLifetime) * time.Second)
+
+	// Test the connection
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	DB = db
+	logger.Info("Database connected successfully")
+	return db, nil
+}
+
+// Migrate runs database migrations
+func Migrate(db *sql.DB, logger appLogger.Logger) error {
+	logger.Info("Running database migrations...")
+
+	// Create users table
+	userTable := `
+	CREATE TABLE IF NOT EXISTS users (
+		id SERIAL PRIMARY KEY,
+		name VARCHAR(100) NOT NULL,
+		email VARCHAR(255) UNIQUE NOT NULL,
+		password VARCHAR(255) NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	)`
+
+	if _, err := db.Exec(userTable); err != nil {
+		return fmt.Errorf("failed to create users table: %w", err)
+	}
+
+	logger.Info("Database migrations completed successfully")
+	return nil
+}
+
+// Close closes the database connection
+func Close() error {
+	if DB != nil {
+		return DB.Close()
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/errors"
+	"github.com/example/golden/internal/services"
+)
+
+// HealthResponse represents the health check response
+type HealthResponse struct {
+	Status    string            `json:"status"`
+	Timestamp time.Time         `json:"timestamp"`
+	Version   string            `json:"version,omitempty"`
+	Checks    map[string]string `json:"checks,omitempty"`
+}
+
+// HealthHandler answers the health and readiness probes
+type HealthHandler struct {
+	clock clock.Clock
+}
+
+// NewHealthHandler creates a health handler timestamping its responses with clk
+func NewHealthHandler(clk clock.Clock) *HealthHandler {
+	return &HealthHandler{clock: clk}
+}
+
+// HealthCheck handles GET /health
+func (h *HealthHandler) HealthCheck(c *gin.Context) {
+	response := HealthResponse{
+		Status:    "healthy",
+		Timestamp: h.clock.Now(),
+		Version:   "1.0.0",
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// ReadinessCheck handles GET /ready
+func (h *HealthHandler) ReadinessCheck(c *gin.Context) {
+	checks := make(map[string]string)
+	allHealthy := true
+	checks["database"] = "healthy"
+
+	status := "ready"
+	httpStatus := http.StatusOK
+
+	if !allHealthy {
+		status = "not ready"
+		httpStatus = http.StatusServiceUnavailable
+	}
+
+	response := HealthResponse{
+		Status:    status,
+		Timestamp: h.clock.Now(),
+		Checks:    checks,
+	}
+
+	c.JSON(httpStatus, response)
+}
+
+// UserHandler contains user-related handler methods
+type UserHandler struct {
+	userService services.UserService
+}
+
+// NewUserHandler creates a new user handler
+func NewUserHandler(userService services.UserService) *UserHandler {
+	return &UserHandler{
+		userService: userService,
+	}
+}
+
+// GetUsers handles GET /users
+func (h *UserHandler) GetUsers(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"message": "Get users endpoint"})
+}
+
+// GetUser handles GET /users/:id
+func (h *UserHandler) GetUser(c *gin.Context) {
+	id := c.Param("id")
+	c.JSON(http.StatusOK, gin.H{"message": "Get user endpoint", "id": id})
+}
+
+// CreateUser handles POST /users
+func (h *UserHandler) CreateUser(c *gin.Context) {
+	c.JSON(http.StatusCreated, gin.H{"message": "Create user endpoint"})
+}
+
+// UpdateUser handles PUT /users/:id
+func (h *UserHandler) UpdateUser(c *gin.Context) {
+	id := c.Param("id")
+	c.JSON(http.StatusOK, gin.H{"message": "Update user endpoint", "id": id})
+}
+
+// DeleteUser handles DELETE /users/:id
+func (h *UserHandler) DeleteUser(c *gin.Context) {
+	id := c.Param("id")
+	c.JSON(http.StatusOK, gin.H{"message": "Delete user endpoint", "id": id})
+}
+
+// AuthHandler contains authentication-related handler methods
+type AuthHandler struct {
+	authService  services.AuthService
+	errorHandler *errors.ErrorHandler
+}
+
+// NewAuthHandler creates a new auth handler
+func NewAuthHandler(authService services.AuthService, errorHandler *errors.ErrorHandler) *AuthHandler {
+	return &AuthHandler{
+		authService:  authService,
+		errorHandler: errorHandler,
+	}
+}
+
+// Login handles POST /auth/login
+func (h *AuthHandler) Login(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"message": "Login endpoint"})
+}
+
+// Register handles POST /auth/register
+func (h *AuthHandler) Register(c *gin.Context) {
+	c.JSON(http.StatusCreated, gin.H{"message": "Register endpoint"})
+}
@@ -0,0 +1,123 @@
+// Package jwks manages the key pairs signing the service's JWT tokens, publishes
+// their public keys as a JSON Web Key Set (RFC 7517) and fetches the key sets of
+// external identity providers so their tokens can be verified too.
+package jwks
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Supported signing algorithms
+const (
+	RS256 = "RS256"
+	EdDSA = "EdDSA"
+)
+
+// JWK is the public part of a JSON Web Key
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use,omitempty"`
+	Algorithm string `json:"alg,omitempty"`
+
+	// RSA keys
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// OKP keys (Ed25519)
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+// Set is a JSON Web Key Set, as served on /.well-known/jwks.json
+type Set struct {
+	Keys []JWK `json:"keys"`
+}
+
+var encoding = base64.RawURLEncoding
+
+// NewJWK returns the JWK of an RSA or Ed25519 public key
+func NewJWK(kid string, public crypto.PublicKey) (JWK, error) {
+	switch key := public.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			KeyType:   "RSA",
+			KeyID:     kid,
+			Use:       "sig",
+			Algorithm: RS256,
+			N:         encoding.EncodeToString(key.N.Bytes()),
+			E:         encoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}, nil
+	case ed25519.PublicKey:
+		return JWK{
+			KeyType:   "OKP",
+			KeyID:     kid,
+			Use:       "sig",
+			Algorithm: EdDSA,
+			Curve:     "Ed25519",
+			X:         encoding.EncodeToString(key),
+		}, nil
+	default:
+		return JWK{}, fmt.Errorf("unsupported public key type %T", public)
+	}
+}
+
+// PublicKey decodes the public key of the JWK
+func (k JWK) PublicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := encoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: invalid modulus: %w", k.KeyID, err)
+		}
+		e, err := encoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("key %s: invalid exponent", k.KeyID)
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "OKP":
+		if k.Curve != "Ed25519" {
+			return nil, fmt.Errorf("key %s: unsupported curve %q", k.KeyID, k.Curve)
+		}
+		x, err := encoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("key %s: invalid Ed25519 public key", k.KeyID)
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("key %s: unsupported key type %q", k.KeyID, k.KeyType)
+	}
+}
+
+// Thumbprint returns the RFC 7638 thumbprint of a public key, a stable key ID
+// derived from the key itself
+func Thumbprint(public crypto.PublicKey) (string, error) {
+	jwk, err := NewJWK("", public)
+	if err != nil {
+		return "", err
+	}
+
+	// The required members of the key, in lexicographic order and without whitespace
+	var members string
+	switch jwk.KeyType {
+	case "RSA":
+		members = fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, jwk.E, jwk.N)
+	case "OKP":
+		members = fmt.Sprintf(`{"crv":"%s","kty":"OKP","x":"%s"}`, jwk.Curve, jwk.X)
+	default:
+		return "", errors.New("unsupported key type")
+	}
+
+	sum := sha256.Sum256([]byte(members))
+	return encoding.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,213 @@
+package jwks
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Key is a private signing key and the key ID (kid) it is published under
+type Key struct {
+	ID        string
+	Algorithm string
+	private   crypto.Signer
+}
+
+// NewKey wraps an RSA (RS256) or Ed25519 (EdDSA) private key
+func NewKey(id string, private crypto.Signer) (*Key, error) {
+	if id == "" {
+		return nil, errors.New("key ID is required")
+	}
+
+	switch key := private.(type) {
+	case *rsa.PrivateKey:
+		if key.N.BitLen() < 2048 {
+			return nil, fmt.Errorf("key %s: RSA keys must be at least 2048 bits", id)
+		}
+		return &Key{ID: id, Algorithm: RS256, private: key}, nil
+	case ed25519.PrivateKey:
+		return &Key{ID: id, Algorithm: EdDSA, private: key}, nil
+	default:
+		return nil, fmt.Errorf("key %s: unsupported key type %T, use RSA or Ed25519", id, private)
+	}
+}
+
+// GenerateKey creates a key pair for the algorithm, identified by its thumbprint
+func GenerateKey(algorithm string) (*Key, error) {
+	var private crypto.Signer
+	switch algorithm {
+	case RS256:
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return nil, err
+		}
+		private = key
+	case EdDSA:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		private = key
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q, use %s or %s", algorithm, RS256, EdDSA)
+	}
+
+	kid, err := Thumbprint(private.Public())
+	if err != nil {
+		return nil, err
+	}
+	return NewKey(kid, private)
+}
+
+// ParsePrivateKeyPEM decodes a PKCS#8 ("PRIVATE KEY") or PKCS#1 ("RSA PRIVATE KEY")
+// PEM encoded private key
+func ParsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+}
+
+// KeySet holds the keys of the service. The active key signs new tokens; every
+// key of the set is published and accepted for verification, so tokens signed
+// before a rotation stay valid until they expire.
+type KeySet struct {
+	active *Key
+	keys   []*Key
+}
+
+// NewKeySet creates a key set signing with active and also verifying with others
+func NewKeySet(active *Key, others ...*Key) (*KeySet, error) {
+	if active == nil {
+		return nil, errors.New("an active key is required")
+	}
+
+	keys := append([]*Key{active}, others...)
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if seen[key.ID] {
+			return nil, fmt.Errorf("duplicate key ID %q", key.ID)
+		}
+		seen[key.ID] = true
+	}
+
+	return &KeySet{active: active, keys: keys}, nil
+}
+
+// Ephemeral creates a key set with a single freshly generated key. Tokens it
+// signs do not survive a restart, so it is only meant for development and tests.
+func Ephemeral(algorithm string) (*KeySet, error) {
+	key, err := GenerateKey(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	return NewKeySet(key)
+}
+
+// LoadDir loads the private keys of dir, one PEM file per key named <kid>.pem.
+// activeKID selects the signing key; when empty the last file in lexical order
+// signs, so naming keys by creation time makes the newest one active.
+func LoadDir(dir, activeKID string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no *.pem keys found in %s", dir)
+	}
+	sort.Strings(paths)
+
+	keys := make([]*Key, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		private, err := ParsePrivateKeyPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		key, err := NewKey(strings.TrimSuffix(filepath.Base(path), ".pem"), private)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	if activeKID == "" {
+		activeKID = keys[len(keys)-1].ID
+	}
+	for i, key := range keys {
+		if key.ID == activeKID {
+			others := append(append([]*Key{}, keys[:i]...), keys[i+1:]...)
+			return NewKeySet(key, others...)
+		}
+	}
+	return nil, fmt.Errorf("active key %q not found in %s", activeKID, dir)
+}
+
+// ActiveKey returns the key signing new tokens
+func (s *KeySet) ActiveKey() *Key {
+	return s.active
+}
+
+// Sign signs the claims with the active key, naming it in the kid header
+func (s *KeySet) Sign(claims jwt.Claims) (string, error) {
+	method := jwt.SigningMethod(jwt.SigningMethodRS256)
+	if s.active.Algorithm == EdDSA {
+		method = jwt.SigningMethodEdDSA
+	}
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = s.active.ID
+	return token.SignedString(s.active.private)
+}
+
+// PublicKey returns the public key of kid, when it belongs to the set
+func (s *KeySet) PublicKey(kid string) (crypto.PublicKey, bool) {
+	for _, key := range s.keys {
+		if key.ID == kid {
+			return key.private.Public(), true
+		}
+	}
+	return nil, false
+}
+
+// JWKS returns the public keys of the set, active key first
+func (s *KeySet) JWKS() Set {
+	set := Set{Keys: make([]JWK, 0, len(s.keys))}
+	for _, key := range s.keys {
+		// Keys were validated by NewKey, so their public part always encodes
+		jwk, _ := NewJWK(key.ID, key.private.Public())
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
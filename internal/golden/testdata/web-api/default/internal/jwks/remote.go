@@ -0,0 +1,115 @@
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/example/golden/internal/clock"
+)
+
+const (
+	// remoteCacheTTL bounds how long fetched keys are trusted without a refetch
+	remoteCacheTTL = time.Hour
+	// remoteRefetchInterval rate limits the refetches triggered by unknown key IDs,
+	// so tokens with made-up kids cannot flood the identity provider
+	remoteRefetchInterval = 30 * time.Second
+)
+
+// Remote is the key set of an external identity provider, fetched from its JWKS
+// URL. Keys are cached and refetched when a token names an unknown kid, which is
+// how rotations on the provider side are picked up.
+type Remote struct {
+	url        string
+	httpClient *http.Client
+	clock      clock.Clock
+
+	mu          sync.Mutex
+	keys        map[string]crypto.PublicKey
+	fetchedAt   time.Time
+	attemptedAt time.Time
+}
+
+// NewRemote creates a remote key set for the JWKS URL, whose cache expires by
+// clk; a nil client uses a default one with a 10 second timeout
+func NewRemote(url string, httpClient *http.Client, clk clock.Clock) *Remote {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Remote{url: url, httpClient: httpClient, clock: clk}
+}
+
+// PublicKey returns the public key of kid, fetching the key set when the key is
+// unknown or the cache is stale
+func (r *Remote) PublicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.clock.Now()
+	key, known := r.keys[kid]
+	stale := now.Sub(r.fetchedAt) > remoteCacheTTL
+	if known && !stale {
+		return key, nil
+	}
+
+	if now.Sub(r.attemptedAt) >= remoteRefetchInterval {
+		if err := r.fetch(ctx); err != nil && !known {
+			return nil, err
+		}
+		// When the provider is unreachable, keep verifying with the cached key
+		key, known = r.keys[kid]
+	}
+
+	if !known {
+		return nil, fmt.Errorf("unknown key ID %q", kid)
+	}
+	return key, nil
+}
+
+// fetch replaces the cached keys with the provider's current key set
+func (r *Remote) fetch(ctx context.Context) error {
+	r.attemptedAt = r.clock.Now()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetching %s: %w", r.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetching %s: unexpected status %d", r.url, resp.StatusCode)
+	}
+
+	var set Set
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return fmt.Errorf("decoding %s: %w", r.url, err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		// Providers also publish encryption keys and algorithms this service does not
+		// verify; skip them rather than failing the whole set
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.KeyID] = key
+	}
+
+	r.keys = keys
+	r.fetchedAt = r.clock.Now()
+	return nil
+}
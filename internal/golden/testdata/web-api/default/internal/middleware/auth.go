@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/example/golden/internal/services"
+)
+
+// AuthMiddleware provides JWT authentication middleware
+type AuthMiddleware struct {
+	authService services.AuthService
+}
+
+// NewAuthMiddleware creates a new auth middleware
+func NewAuthMiddleware(authService services.AuthService) *AuthMiddleware {
+	return &AuthMiddleware{
+		authService: authService,
+	}
+}
+
+// RequireAuth validates JWT token and sets user context
+func (m *AuthMiddleware) RequireAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "Authorization header required",
+				"code":  "MISSING_AUTH_HEADER",
+			})
+			c.Abort()
+			return
+		}
+
+		// Extract token from "Bearer <token>"
+		tokenParts := strings.Split(authHeader, " ")
+		if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "Invalid authorization header format",
+				"code":  "INVALID_AUTH_HEADER",
+			})
+			c.Abort()
+			return
+		}
+
+		token := tokenParts[1]
+		claims, err := m.authService.ValidateToken(c.Request.Context(), token)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "Invalid or expired token",
+				"code":  "INVALID_TOKEN",
+			})
+			c.Abort()
+			return
+		}
+
+		// Set user information in context
+		c.Set("userID", claims.UserID)
+		c.Set("email", claims.Email)
+		c.Next()
+	}
+}
+
+// OptionalAuth validates JWT token if present but doesn't require it
+func (m *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			c.Next()
+			return
+		}
+
+		// Extract token from "Bearer <token>"
+		tokenParts := strings.Split(authHeader, " ")
+		if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
+			c.Next()
+			return
+		}
+
+		token := tokenParts[1]
+		claims, err := m.authService.ValidateToken(c.Request.Context(), token)
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		// Set user information in context if token is valid
+		c.Set("userID", claims.UserID)
+		c.Set("email", claims.Email)
+		c.Next()
+	}
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// Logger returns a logging middleware for Gin
+func Logger(log logger.Logger) gin.HandlerFunc {
+	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
+		log.Info("HTTP Request",
+			"method", param.Method,
+			"path", param.Path,
+			"status", param.StatusCode,
+			"latency", param.Latency,
+			"client_ip", param.ClientIP,
+			"user_agent", param.Request.UserAgent(),
+		)
+		return ""
+	})
+}
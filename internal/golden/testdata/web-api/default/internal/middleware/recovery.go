@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"net/http"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// Recovery returns a recovery middleware for Gin
+func Recovery(log logger.Logger) gin.HandlerFunc {
+	return gin.CustomRecovery(func(c *gin.Context, recovered interface{}) {
+		log.Error("Panic recovered",
+			"error", recovered,
+			"stack", string(debug.Stack()),
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+		)
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Internal server error",
+		})
+	})
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ID is the primary key of the models, assigned by the database from a sequence
+type ID = uint
+
+// ParseID parses a key received in a URL or a request
+func ParseID(s string) (ID, error) {
+	id, err := strconv.ParseUint(s, 10, strconv.IntSize)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("invalid id %q", s)
+	}
+	return ID(id), nil
+}
+
+// FormatID formats a key, as ParseID reads it
+func FormatID(id ID) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
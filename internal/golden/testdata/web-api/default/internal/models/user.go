@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+)
+
+// User represents a user in the system
+type User struct {
+	ID        ID        `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"`
+	Email     string    `json:"email" db:"email"`
+	Password  string    `json:"-" db:"password"` // Password is excluded from JSON
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// CreateUserRequest represents the request payload for creating a user
+type CreateUserRequest struct {
+	Name     string `json:"name" binding:"required,min=2,max=100"`
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password,omitempty" binding:"omitempty,min=6,max=100"` // Optional for user creation, required for registration
+}
+
+// UpdateUserRequest represents the request payload for updating a user
+type UpdateUserRequest struct {
+	Name  *string `json:"name,omitempty" binding:"omitempty,min=2,max=100"`
+	Email *string `json:"email,omitempty" binding:"omitempty,email"`
+}
+
+// LoginRequest represents the request payload for user login
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=6"`
+}
+
+// RegisterRequest represents the request payload for user registration
+type RegisterRequest struct {
+	Name     string `json:"name" binding:"required,min=2,max=100"`
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=6,max=100"`
+}
+
+// TableName returns the table name for the User model
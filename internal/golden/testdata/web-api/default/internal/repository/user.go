@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/example/golden/internal/models"
+)
+
+// UserRepository defines the interface for user data access
+type UserRepository interface {
+	GetAll(ctx context.Context, limit, offset int) ([]models.User, error)
+	GetByID(ctx context.Context, id models.ID) (*models.User, error)
+	GetByEmail(ctx context.Context, email string) (*models.User, error)
+	Create(ctx context.Context, user *models.User) error
+	Update(ctx context.Context, user *models.User) error
+	Delete(ctx context.Context, id models.ID) error
+	Count(ctx context.Context) (int, error)
+}
+
+// userColumns lists the users columns in the order scanUser reads them
+const userColumns = `id, name, email, password, created_at, updated_at`
+
+// rowScanner is implemented by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanUser reads a row selected with userColumns
+func scanUser(row rowScanner) (*models.User, error) {
+	var user models.User
+	err := row.Scan(
+		&user.ID, &user.Name, &user.Email, &user.Password,
+		&user.CreatedAt, &user.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// sqlUserRepository implements UserRepository using database/sql
+type sqlUserRepository struct {
+	db *sql.DB
+}
+
+// NewUserRepository creates a new user repository
+func NewUserRepository(db *sql.DB) UserRepository {
+	return &sqlUserRepository{db: db}
+}
+
+// GetAll retrieves all users with pagination
+func (r *sqlUserRepository) GetAll(ctx context.Context, limit, offset int) ([]models.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users ORDER BY id LIMIT $1 OFFSET $2`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return collectUsers(rows)
+}
+
+// collectUsers reads all rows selected with userColumns
+func collectUsers(rows *sql.Rows) ([]models.User, error) {
+	var users []models.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, *user)
+	}
+
+	return users, rows.Err()
+}
+
+// GetByID retrieves a user by ID
+func (r *sqlUserRepository) GetByID(ctx context.Context, id models.ID) (*models.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
+
+	return scanUser(r.db.QueryRowContext(ctx, query, id))
+}
+
+// GetByEmail retrieves a user by email
+func (r *sqlUserRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE email = $1`
+
+	return scanUser(r.db.QueryRowContext(ctx, query, email))
+}
+
+// Create creates a new user
+func (r *sqlUserRepository) Create(ctx context.Context, user *models.User) error {
+	query := `INSERT INTO users (name, email, password, created_at, updated_at) VALUES ($1, $2, $3, NOW(), NOW()) RETURNING id, created_at, updated_at`
+	err := r.db.QueryRowContext(ctx, query, user.Name, user.Email, user.Password).Scan(&user.ID, &user.CreatedAt, &user.UpdatedAt)
+
+	return err
+}
+
+// Update updates an existing user
+func (r *sqlUserRepository) Update(ctx context.Context, user *models.User) error {
+	query := `UPDATE users SET name = $1, email = $2, updated_at = NOW() WHERE id = $3`
+
+	_, err := r.db.ExecContext(ctx, query, user.Name, user.Email, user.ID)
+	return err
+}
+
+// Delete deletes a user by ID
+func (r *sqlUserRepository) Delete(ctx context.Context, id models.ID) error {
+	query := `DELETE FROM users WHERE id = $1`
+
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+// Count returns the total number of users
+func (r *sqlUserRepository) Count(ctx context.Context) (int, error) {
+	query := `SELECT COUNT(*) FROM users`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query).Scan(&count)
+	return count, err
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/example/golden/internal/models"
+	"github.com/example/golden/internal/repository"
+)
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+	ErrUserExists   = errors.New("user already exists")
+)
+
+// UserService defines the interface for user business logic
+type UserService interface {
+	GetUsers(ctx context.Context, page, limit int) ([]models.User, int, error)
+	GetUserByID(ctx context.Context, id models.ID) (*models.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
+	CreateUser(ctx context.Context, req models.CreateUserRequest) (*models.User, error)
+	UpdateUser(ctx context.Context, id models.ID, req models.UpdateUserRequest) (*models.User, error)
+	DeleteUser(ctx context.Context, id models.ID) error
+}
+
+// userService implements UserService
+type userService struct {
+	userRepo repository.UserRepository
+}
+
+// NewUserService creates a new user service
+func NewUserService(userRepo repository.UserRepository) UserService {
+	return &userService{
+		userRepo: userRepo,
+	}
+}
+
+// GetUsers retrieves a paginated list of users
+func (s *userService) GetUsers(ctx context.Context, page, limit int) ([]models.User, int, error) {
+	offset := (page - 1) * limit
+
+	users, err := s.userRepo.GetAll(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.userRepo.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+// GetUserByID retrieves a user by ID
+func (s *userService) GetUserByID(ctx context.Context, id models.ID) (*models.User, error) {
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// GetUserByEmail retrieves a user by email
+func (s *userService) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// CreateUser creates a new user
+func (s *userService) CreateUser(ctx context.Context, req models.CreateUserRequest) (*models.User, error) {
+	// Check if user already exists
+	_, err := s.GetUserByEmail(ctx, req.Email)
+	if err == nil {
+		return nil, ErrUserExists
+	}
+	if err != ErrUserNotFound {
+		return nil, err
+	}
+
+	user := &models.User{
+		Name:     req.Name,
+		Email:    req.Email,
+		Password: req.Password, // Now properly handles password when provided
+	}
+
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// UpdateUser updates an existing user
+func (s *userService) UpdateUser(ctx context.Context, id models.ID, req models.UpdateUserRequest) (*models.User, error) {
+	user, err := s.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update fields if provided
+	if req.Name != nil {
+		user.Name = *req.Name
+	}
+	if req.Email != nil {
+		// Check if email is already taken by another user
+		if existingUser, err := s.GetUserByEmail(ctx, *req.Email); err == nil && existingUser.ID != id {
+			return nil, ErrUserExists
+		}
+		user.Email = *req.Email
+	}
+
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// DeleteUser deletes a user by ID
+func (s *userService) DeleteUser(ctx context.Context, id models.ID) error {
+	// Check if user exists
+	_, err := s.GetUserByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.userRepo.Delete(ctx, id)
+}
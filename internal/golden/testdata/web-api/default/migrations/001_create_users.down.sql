@@ -0,0 +1,12 @@
+
+-- Rollback users table creation
+-- Drop trigger first
+DROP TRIGGER IF EXISTS update_users_updated_at ON users;
+DROP FUNCTION IF EXISTS update_updated_at_column();
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_users_email;
+DROP INDEX IF EXISTS idx_users_deleted_at;
+
+-- Drop table
+DROP TABLE IF EXISTS users CASCADE;
\ No newline at end of file
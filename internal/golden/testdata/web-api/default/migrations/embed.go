@@ -0,0 +1,8 @@
+package migrations
+
+import "embed"
+
+// SQLFiles embeds all SQL migration files
+//
+//go:embed *.sql
+var SQLFiles embed.FS
@@ -0,0 +1,192 @@
+package unit
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/jwks"
+	"github.com/example/golden/internal/services"
+)
+
+func TestKeySet_SignedTokensVerifyWithPublishedKeys(t *testing.T) {
+	for _, algorithm := range []string{jwks.RS256, jwks.EdDSA} {
+		t.Run(algorithm, func(t *testing.T) {
+			keys, err := jwks.Ephemeral(algorithm)
+			require.NoError(t, err)
+
+			tokenString, err := keys.Sign(jwt.RegisteredClaims{Subject: "1"})
+			require.NoError(t, err)
+
+			// Verify like a third party would: from the JSON served on the JWKS endpoint
+			published, err := json.Marshal(keys.JWKS())
+			require.NoError(t, err)
+			var set jwks.Set
+			require.NoError(t, json.Unmarshal(published, &set))
+			require.Len(t, set.Keys, 1)
+			assert.Equal(t, algorithm, set.Keys[0].Algorithm)
+			assert.Equal(t, "sig", set.Keys[0].Use)
+
+			token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+				assert.Equal(t, set.Keys[0].KeyID, token.Header["kid"])
+				return set.Keys[0].PublicKey()
+			}, jwt.WithValidMethods([]string{algorithm}))
+			require.NoError(t, err)
+			assert.True(t, token.Valid)
+		})
+	}
+}
+
+func TestThumbprint_RFC7638Example(t *testing.T) {
+	key, err := jwks.JWK{
+		KeyType: "RSA",
+		N:       "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+		E:       "AQAB",
+	}.PublicKey()
+	require.NoError(t, err)
+
+	thumbprint, err := jwks.Thumbprint(key)
+	require.NoError(t, err)
+	assert.Equal(t, "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", thumbprint)
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	writeKey(t, dir, "20260101000000")
+	writeKey(t, dir, "20260201000000")
+
+	t.Run("newest key signs", func(t *testing.T) {
+		keys, err := jwks.LoadDir(dir, "")
+		require.NoError(t, err)
+		assert.Equal(t, "20260201000000", keys.ActiveKey().ID)
+
+		// Both keys are published, the active one first
+		set := keys.JWKS()
+		require.Len(t, set.Keys, 2)
+		assert.Equal(t, "20260201000000", set.Keys[0].KeyID)
+		assert.Equal(t, "20260101000000", set.Keys[1].KeyID)
+	})
+
+	t.Run("active kid pins the signing key", func(t *testing.T) {
+		keys, err := jwks.LoadDir(dir, "20260101000000")
+		require.NoError(t, err)
+		assert.Equal(t, "20260101000000", keys.ActiveKey().ID)
+
+		_, ok := keys.PublicKey("20260201000000")
+		assert.True(t, ok)
+	})
+
+	t.Run("unknown active kid", func(t *testing.T) {
+		_, err := jwks.LoadDir(dir, "missing")
+		assert.Error(t, err)
+	})
+
+	t.Run("empty directory", func(t *testing.T) {
+		_, err := jwks.LoadDir(t.TempDir(), "")
+		assert.Error(t, err)
+	})
+}
+
+func TestValidateToken_ExternalIdentityProvider(t *testing.T) {
+	idpKeys, err := jwks.Ephemeral(jwks.RS256)
+	require.NoError(t, err)
+
+	var fetches atomic.Int32
+	idp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(idpKeys.JWKS())
+	}))
+	defer idp.Close()
+
+	localKeys, err := jwks.Ephemeral(jwks.RS256)
+	require.NoError(t, err)
+	authService := services.NewAuthService(nil, localKeys, clock.New(), services.TokenOptions{
+		TTL:            time.Hour,
+		Issuer:         "golden",
+		ExternalIssuer: "https://idp.example.com/",
+		ExternalKeys:   jwks.NewRemote(idp.URL, idp.Client(), clock.New()),
+	})
+
+	signIdPToken := func(issuer string) string {
+		claims := services.JWTClaims{Email: "sso-user@example.com"}
+		claims.Issuer = issuer
+		claims.Subject = "sso-user"
+		claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(time.Hour))
+		tokenString, err := idpKeys.Sign(claims)
+		require.NoError(t, err)
+		return tokenString
+	}
+
+	claims, err := authService.ValidateToken(context.Background(), signIdPToken("https://idp.example.com/"))
+	require.NoError(t, err)
+	assert.Equal(t, "sso-user", claims.Subject)
+	assert.Equal(t, "sso-user@example.com", claims.Email)
+
+	// Keys are cached between tokens
+	_, err = authService.ValidateToken(context.Background(), signIdPToken("https://idp.example.com/"))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), fetches.Load())
+
+	// A token signed with the provider's key but claiming another issuer is rejected
+	_, err = authService.ValidateToken(context.Background(), signIdPToken("https://evil.example.com/"))
+	assert.Equal(t, services.ErrInvalidToken, err)
+}
+
+func TestRemote_UnknownKeyRefetchIsRateLimited(t *testing.T) {
+	keys, err := jwks.Ephemeral(jwks.EdDSA)
+	require.NoError(t, err)
+
+	var fetches atomic.Int32
+	idp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		json.NewEncoder(w).Encode(keys.JWKS())
+	}))
+	defer idp.Close()
+
+	fake := clock.NewFake(time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC))
+	remote := jwks.NewRemote(idp.URL, idp.Client(), fake)
+
+	_, err = remote.PublicKey(context.Background(), keys.ActiveKey().ID)
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		_, err = remote.PublicKey(context.Background(), "made-up-kid")
+		assert.Error(t, err)
+	}
+	assert.Equal(t, int32(1), fetches.Load())
+
+	// Once the interval has passed, an unknown key triggers a refetch again
+	fake.Advance(31 * time.Second)
+	_, err = remote.PublicKey(context.Background(), "made-up-kid")
+	assert.Error(t, err)
+	assert.Equal(t, int32(2), fetches.Load())
+}
+
+// writeKey writes a new PKCS#8 encoded RSA private key named <kid>.pem to dir
+func writeKey(t *testing.T, dir, kid string) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600))
+}
@@ -0,0 +1,525 @@
+package unit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/jwks"
+	"github.com/example/golden/internal/models"
+	"github.com/example/golden/internal/services"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// testID returns the nth sample key of the ID strategy
+func testID(n int) models.ID {
+	return models.ID(n)
+}
+
+// mockUserRepository is a mock implementation of UserRepository
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) GetAll(_ context.Context, limit, offset int) ([]models.User, error) {
+	args := m.Called(limit, offset)
+	return args.Get(0).([]models.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetByID(_ context.Context, id models.ID) (*models.User, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetByEmail(_ context.Context, email string) (*models.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.User), args.Error(1)
+}
+
+func (m *mockUserRepository) Create(_ context.Context, user *models.User) error {
+	args := m.Called(user)
+	// Simulate setting ID and timestamps
+	user.ID = testID(1)
+	user.CreatedAt = time.Now()
+	user.UpdatedAt = time.Now()
+	return args.Error(0)
+}
+
+func (m *mockUserRepository) Update(_ context.Context, user *models.User) error {
+	args := m.Called(user)
+	user.UpdatedAt = time.Now()
+	return args.Error(0)
+}
+
+func (m *mockUserRepository) Delete(_ context.Context, id models.ID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *mockUserRepository) Count(_ context.Context) (int, error) {
+	args := m.Called()
+	return args.Int(0), args.Error(1)
+}
+
+// UserServiceTestSuite tests the UserService
+type UserServiceTestSuite struct {
+	suite.Suite
+	mockRepo    *mockUserRepository
+	userService services.UserService
+}
+
+func (suite *UserServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(mockUserRepository)
+	suite.userService = services.NewUserService(suite.mockRepo)
+}
+
+func (suite *UserServiceTestSuite) TestGetUsers() {
+	// Setup mock data
+	expectedUsers := []models.User{
+		{ID: testID(1), Name: "User 1", Email: "user1@example.com"},
+		{ID: testID(2), Name: "User 2", Email: "user2@example.com"},
+	}
+	expectedCount := 2
+
+	suite.mockRepo.On("GetAll", 10, 0).Return(expectedUsers, nil)
+	suite.mockRepo.On("Count").Return(expectedCount, nil)
+
+	// Test
+	users, total, err := suite.userService.GetUsers(context.Background(), 1, 10)
+
+	// Assertions
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedUsers, users)
+	assert.Equal(suite.T(), expectedCount, total)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestGetUserByID_Success() {
+	// Setup mock data
+	expectedUser := &models.User{
+		ID:    testID(1),
+		Name:  "Test User",
+		Email: "test@example.com",
+	}
+
+	suite.mockRepo.On("GetByID", testID(1)).Return(expectedUser, nil)
+
+	// Test
+	user, err := suite.userService.GetUserByID(context.Background(), testID(1))
+
+	// Assertions
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedUser, user)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestGetUserByID_NotFound() {
+	suite.mockRepo.On("GetByID", testID(999)).Return(nil, services.ErrUserNotFound)
+
+	// Test
+	user, err := suite.userService.GetUserByID(context.Background(), testID(999))
+
+	// Assertions
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), services.ErrUserNotFound, err)
+	assert.Nil(suite.T(), user)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestCreateUser_Success() {
+	// Setup
+	req := models.CreateUserRequest{
+		Name:  "New User",
+		Email: "newuser@example.com",
+	}
+
+	// Mock that user doesn't exist
+	suite.mockRepo.On("GetByEmail", req.Email).Return(nil, services.ErrUserNotFound)
+	suite.mockRepo.On("Create", mock.AnythingOfType("*models.User")).Return(nil)
+
+	// Test
+	user, err := suite.userService.CreateUser(context.Background(), req)
+
+	// Assertions
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), user)
+	assert.Equal(suite.T(), req.Name, user.Name)
+	assert.Equal(suite.T(), req.Email, user.Email)
+	assert.NotZero(suite.T(), user.ID)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestCreateUser_AlreadyExists() {
+	// Setup
+	req := models.CreateUserRequest{
+		Name:  "Existing User",
+		Email: "existing@example.com",
+	}
+
+	existingUser := &models.User{
+		ID:    testID(1),
+		Name:  "Existing User",
+		Email: "existing@example.com",
+	}
+
+	suite.mockRepo.On("GetByEmail", req.Email).Return(existingUser, nil)
+
+	// Test
+	user, err := suite.userService.CreateUser(context.Background(), req)
+
+	// Assertions
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), services.ErrUserExists, err)
+	assert.Nil(suite.T(), user)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestUpdateUser_Success() {
+	// Setup
+	userID := testID(1)
+	existingUser := &models.User{
+		ID:    userID,
+		Name:  "Old Name",
+		Email: "old@example.com",
+	}
+
+	newName := "New Name"
+	newEmail := "new@example.com"
+	req := models.UpdateUserRequest{
+		Name:  &newName,
+		Email: &newEmail,
+	}
+
+	suite.mockRepo.On("GetByID", userID).Return(existingUser, nil)
+	suite.mockRepo.On("GetByEmail", newEmail).Return(nil, services.ErrUserNotFound)
+	suite.mockRepo.On("Update", mock.AnythingOfType("*models.User")).Return(nil)
+
+	// Test
+	user, err := suite.userService.UpdateUser(context.Background(), userID, req)
+
+	// Assertions
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), user)
+	assert.Equal(suite.T(), newName, user.Name)
+	assert.Equal(suite.T(), newEmail, user.Email)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestUpdateUser_NotFound() {
+	// Setup
+	userID := testID(999)
+	req := models.UpdateUserRequest{}
+
+	suite.mockRepo.On("GetByID", userID).Return(nil, services.ErrUserNotFound)
+
+	// Test
+	user, err := suite.userService.UpdateUser(context.Background(), userID, req)
+
+	// Assertions
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), services.ErrUserNotFound, err)
+	assert.Nil(suite.T(), user)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestDeleteUser_Success() {
+	// Setup
+	userID := testID(1)
+	existingUser := &models.User{
+		ID:    userID,
+		Name:  "User to Delete",
+		Email: "delete@example.com",
+	}
+
+	suite.mockRepo.On("GetByID", userID).Return(existingUser, nil)
+	suite.mockRepo.On("Delete", userID).Return(nil)
+
+	// Test
+	err := suite.userService.DeleteUser(context.Background(), userID)
+
+	// Assertions
+	assert.NoError(suite.T(), err)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestDeleteUser_NotFound() {
+	// Setup
+	userID := testID(999)
+
+	suite.mockRepo.On("GetByID", userID).Return(nil, services.ErrUserNotFound)
+
+	// Test
+	err := suite.userService.DeleteUser(context.Background(), userID)
+
+	// Assertions
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), services.ErrUserNotFound, err)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+// AuthServiceTestSuite tests the AuthService
+type AuthServiceTestSuite struct {
+	suite.Suite
+	mockUserService *mockUserService
+	keys            *jwks.KeySet
+	clock           *clock.Fake
+	authService     services.AuthService
+}
+
+// mockUserService is a mock implementation of UserService for auth tests
+type mockUserService struct {
+	mock.Mock
+}
+
+func (m *mockUserService) GetUsers(_ context.Context, page, limit int) ([]models.User, int, error) {
+	args := m.Called(page, limit)
+	return args.Get(0).([]models.User), args.Int(1), args.Error(2)
+}
+
+func (m *mockUserService) GetUserByID(_ context.Context, id models.ID) (*models.User, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.User), args.Error(1)
+}
+
+func (m *mockUserService) GetUserByEmail(_ context.Context, email string) (*models.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.User), args.Error(1)
+}
+
+func (m *mockUserService) CreateUser(_ context.Context, req models.CreateUserRequest) (*models.User, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.User), args.Error(1)
+}
+
+func (m *mockUserService) UpdateUser(_ context.Context, id models.ID, req models.UpdateUserRequest) (*models.User, error) {
+	args := m.Called(id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.User), args.Error(1)
+}
+
+func (m *mockUserService) DeleteUser(_ context.Context, id models.ID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (suite *AuthServiceTestSuite) SetupTest() {
+	suite.mockUserService = new(mockUserService)
+	keys, err := jwks.Ephemeral(jwks.RS256)
+	suite.Require().NoError(err)
+	suite.keys = keys
+	suite.clock = clock.NewFake(time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC))
+	suite.authService = services.NewAuthService(suite.mockUserService, keys, suite.clock, services.TokenOptions{
+		TTL:    time.Hour,
+		Issuer: "test-issuer",
+	})
+}
+
+func (suite *AuthServiceTestSuite) TestHashPassword() {
+	password := "testpassword123"
+
+	hash, err := suite.authService.HashPassword(password)
+
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), hash)
+	assert.NotEqual(suite.T(), password, hash)
+}
+
+func (suite *AuthServiceTestSuite) TestComparePasswords() {
+	password := "testpassword123"
+	hash, _ := suite.authService.HashPassword(password)
+
+	// Test correct password
+	err := suite.authService.ComparePasswords(hash, password)
+	assert.NoError(suite.T(), err)
+
+	// Test incorrect password
+	err = suite.authService.ComparePasswords(hash, "wrongpassword")
+	assert.Error(suite.T(), err)
+}
+
+func (suite *AuthServiceTestSuite) TestLogin_Success() {
+	// Setup
+	email := "test@example.com"
+	password := "password123"
+	hashedPassword, _ := suite.authService.HashPassword(password)
+
+	user := &models.User{
+		ID:       testID(1),
+		Name:     "Test User",
+		Email:    email,
+		Password: hashedPassword,
+	}
+
+	suite.mockUserService.On("GetUserByEmail", email).Return(user, nil)
+
+	// Test
+	token, returnedUser, err := suite.authService.Login(context.Background(), email, password)
+
+	// Assertions
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), token)
+	assert.NotNil(suite.T(), returnedUser)
+	assert.Equal(suite.T(), user.ID, returnedUser.ID)
+	assert.Equal(suite.T(), user.Email, returnedUser.Email)
+	assert.Empty(suite.T(), returnedUser.Password) // Password should be cleared
+	suite.mockUserService.AssertExpectations(suite.T())
+}
+
+func (suite *AuthServiceTestSuite) TestLogin_InvalidCredentials() {
+	// Setup
+	email := "test@example.com"
+	password := "password123"
+	wrongPassword := "wrongpassword"
+	hashedPassword, _ := suite.authService.HashPassword(password)
+
+	user := &models.User{
+		ID:       testID(1),
+		Name:     "Test User",
+		Email:    email,
+		Password: hashedPassword,
+	}
+
+	suite.mockUserService.On("GetUserByEmail", email).Return(user, nil)
+
+	// Test
+	token, returnedUser, err := suite.authService.Login(context.Background(), email, wrongPassword)
+
+	// Assertions
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), services.ErrInvalidCredentials, err)
+	assert.Empty(suite.T(), token)
+	assert.Nil(suite.T(), returnedUser)
+	suite.mockUserService.AssertExpectations(suite.T())
+}
+
+func (suite *AuthServiceTestSuite) TestValidateToken() {
+	// Create a user and generate a token
+	user := &models.User{
+		ID:    testID(1),
+		Email: "test@example.com",
+	}
+
+	// Generate token (we'll use the internal method for testing)
+	claims := services.JWTClaims{
+		UserID: user.ID,
+		Email:  user.Email,
+	}
+	claims.Issuer = "test-issuer"
+	claims.ExpiresAt = jwt.NewNumericDate(suite.clock.Now().Add(time.Hour))
+	claims.IssuedAt = jwt.NewNumericDate(suite.clock.Now())
+
+	tokenString, err := suite.keys.Sign(claims)
+	suite.Require().NoError(err)
+
+	// Test
+	parsedClaims, err := suite.authService.ValidateToken(context.Background(), tokenString)
+
+	// Assertions
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), parsedClaims)
+	assert.Equal(suite.T(), user.ID, parsedClaims.UserID)
+	assert.Equal(suite.T(), user.Email, parsedClaims.Email)
+}
+
+func (suite *AuthServiceTestSuite) TestValidateToken_RejectsForeignIssuer() {
+	claims := services.JWTClaims{UserID: testID(1)}
+	claims.Issuer = "someone-else"
+	claims.ExpiresAt = jwt.NewNumericDate(suite.clock.Now().Add(time.Hour))
+
+	tokenString, err := suite.keys.Sign(claims)
+	suite.Require().NoError(err)
+
+	_, err = suite.authService.ValidateToken(context.Background(), tokenString)
+	assert.Equal(suite.T(), services.ErrInvalidToken, err)
+}
+
+func (suite *AuthServiceTestSuite) TestValidateToken_RejectsHMAC() {
+	// A token signed with a shared secret must not be accepted, whatever the kid says
+	claims := services.JWTClaims{UserID: testID(1)}
+	claims.Issuer = "test-issuer"
+	claims.ExpiresAt = jwt.NewNumericDate(suite.clock.Now().Add(time.Hour))
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = suite.keys.ActiveKey().ID
+	tokenString, err := token.SignedString([]byte("test-secret"))
+	suite.Require().NoError(err)
+
+	_, err = suite.authService.ValidateToken(context.Background(), tokenString)
+	assert.Equal(suite.T(), services.ErrInvalidToken, err)
+}
+
+func (suite *AuthServiceTestSuite) TestValidateToken_Expired() {
+	claims := services.JWTClaims{UserID: testID(1)}
+	claims.Issuer = "test-issuer"
+	claims.ExpiresAt = jwt.NewNumericDate(suite.clock.Now().Add(time.Hour))
+
+	tokenString, err := suite.keys.Sign(claims)
+	suite.Require().NoError(err)
+
+	_, err = suite.authService.ValidateToken(context.Background(), tokenString)
+	suite.Require().NoError(err)
+
+	// The token expires by the clock of the service, no need to sleep
+	suite.clock.Advance(time.Hour + time.Minute)
+	_, err = suite.authService.ValidateToken(context.Background(), tokenString)
+	assert.Equal(suite.T(), services.ErrTokenExpired, err)
+}
+
+func (suite *AuthServiceTestSuite) TestLogin_TokenSurvivesKeyRotation() {
+	password := "testpassword123"
+	hashedPassword, _ := suite.authService.HashPassword(password)
+	user := &models.User{ID: testID(42), Email: "test@example.com", Password: hashedPassword}
+	suite.mockUserService.On("GetUserByEmail", user.Email).Return(user, nil)
+
+	tokenString, _, err := suite.authService.Login(context.Background(), user.Email, password)
+	suite.Require().NoError(err)
+
+	// Rotate: a new key signs, the previous one is still published for verification
+	newKey, err := jwks.GenerateKey(jwks.RS256)
+	suite.Require().NoError(err)
+	rotated, err := jwks.NewKeySet(newKey, suite.keys.ActiveKey())
+	suite.Require().NoError(err)
+	rotatedService := services.NewAuthService(suite.mockUserService, rotated, suite.clock, services.TokenOptions{TTL: time.Hour, Issuer: "test-issuer"})
+
+	claims, err := rotatedService.ValidateToken(context.Background(), tokenString)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), testID(42), claims.UserID)
+	assert.Equal(suite.T(), "42", claims.Subject)
+
+	// Once the old key is removed, its tokens are rejected
+	retired, err := jwks.NewKeySet(newKey)
+	suite.Require().NoError(err)
+	retiredService := services.NewAuthService(suite.mockUserService, retired, suite.clock, services.TokenOptions{TTL: time.Hour, Issuer: "test-issuer"})
+
+	_, err = retiredService.ValidateToken(context.Background(), tokenString)
+	assert.Equal(suite.T(), services.ErrInvalidToken, err)
+}
+
+// Run the test suites
+func TestUserServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserServiceTestSuite))
+}
+func TestAuthServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthServiceTestSuite))
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/database"
+	"github.com/example/golden/internal/handlers"
+	"github.com/example/golden/internal/jwks"
+	internalLogger "github.com/example/golden/internal/logger"
+	internalMiddleware "github.com/example/golden/internal/middleware"
+	"github.com/example/golden/internal/repository"
+	"github.com/example/golden/internal/services"
+)
+
+func main() {
+	// Load configuration first
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	// Initialize logger with configured level
+	internalLogger.SetLevel(cfg.Logging.Level)
+
+	// Log application startup
+	internalLogger.Info("Application starting with logger=%s environment=%s", "slog", cfg.Environment)
+
+	// Time of the application, read by the handlers and services instead of time.Now
+	clk := clock.New()
+	healthHandler := handlers.NewHealthHandler(clk)
+
+	// Initialize security middleware
+	securityHeaders := internalMiddleware.DefaultSecurityHeaders()
+	validationConfig := internalMiddleware.DefaultValidationConfig()
+	requestIDConfig := internalMiddleware.DefaultRequestIDConfig()
+	// Initialize database
+	db, err := database.Connect(cfg.Database, internalLogger.GetLogger())
+	if err != nil {
+		internalLogger.Error("Failed to connect to database: %v", err)
+		os.Exit(1)
+	}
+
+	// Run migrations
+	if err := database.Migrate(db, internalLogger.GetLogger()); err != nil {
+		internalLogger.Error("Failed to run migrations: %v", err)
+		os.Exit(1)
+	}
+
+	// Initialize repositories
+	userRepo := repository.NewUserRepository(db)
+
+	// Initialize services
+	userService := services.NewUserService(userRepo)
+	// Sign tokens with a key generated at startup
+	signingKeys, err := jwks.Ephemeral(jwks.RS256)
+	if err != nil {
+		internalLogger.Error("Failed to generate JWT signing key: %v", err)
+		os.Exit(1)
+	}
+
+	// Initialize auth service
+	authService := services.NewAuthService(userService, signingKeys, clk, services.TokenOptions{TTL: 24 * time.Hour})
+
+	// Initialize router and middleware
+	router := echo.New()
+	router.HideBanner = true
+
+	// Add security middleware
+	router.Use(requestIDConfig.EchoRequestIDMiddleware())
+	router.Use(securityHeaders.EchoSecurityHeaders())
+	router.Use(validationConfig.EchoValidationMiddleware())
+
+	// Add standard middleware
+	router.Use(middleware.Logger())
+	router.Use(middleware.Recover())
+	router.Use(middleware.CORS())
+
+	// Health check routes
+	router.GET("/health", healthHandler.HealthCheck)
+	router.GET("/ready", healthHandler.ReadinessCheck)
+
+	// Public keys verifying the tokens this service issues
+	router.GET("/.well-known/jwks.json", handlers.NewJWKSHandler(signingKeys).JWKS)
+	// API routes
+	v1 := router.Group("/api/v1")
+	// Public routes
+	public := v1.Group("")
+	authHandler := handlers.NewAuthHandler(authService)
+	public.POST("/auth/login", authHandler.Login)
+	public.POST("/auth/register", authHandler.Register)
+
+	// Protected routes
+	protected := v1.Group("")
+	// protected.Use(middleware.AuthMiddleware()) // Add auth middleware here
+	userHandler := handlers.NewUserHandler(userService)
+	protected.GET("/users", userHandler.GetUsers)
+	protected.GET("/users/:id", userHandler.GetUser)
+	protected.PUT("/users/:id", userHandler.UpdateUser)
+	protected.DELETE("/users/:id", userHandler.DeleteUser)
+
+	// Start server
+	addr := fmt.Sprintf(":%d", cfg.Server.Port)
+
+	// Start server in a goroutine
+	go func() {
+		internalLogger.Info("Starting server on %s in %s environment", addr, cfg.Environment)
+		if err := router.Start(addr); err != nil {
+			internalLogger.Error("Failed to start server: %v", err)
+			os.Exit(1)
+		}
+	}()
+
+	// Wait for interrupt signal to gracefully shutdown the server
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	internalLogger.Info("Shutting down server...")
+
+	// Graceful shutdown for frameworks that support it
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := router.Shutdown(ctx); err != nil {
+		internalLogger.Error("Server forced to shutdown: %v", err)
+	}
+
+	internalLogger.Info("Server exited")
+}
@@ -0,0 +1,14 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	github.com/labstack/echo/v4 v4.11.0
+	github.com/spf13/viper v1.16.0
+	gorm.io/gorm v1.25.4
+	gorm.io/driver/postgres v1.5.2
+	github.com/golang-jwt/jwt/v5 v5.0.0
+	golang.org/x/crypto v0.14.0
+	github.com/stretchr/testify v1.8.4
+	gopkg.in/yaml.v3 v3.0.1
+)
\ No newline at end of file
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+
+	"github.com/example/golden/internal/config"
+	appLogger "github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/models"
+)
+
+// DB is the global database connection
+var DB *gorm.DB
+
+// Connect establishes a database connection using GORM
+func Connect(cfg config.DatabaseConfig, logger appLogger.Logger) (*gorm.DB, error) {
+	dsn := cfg.DSN()
+
+	var db *gorm.DB
+	var err error
+	db, err = gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: gormLogger.Default.LogMode(getLogLevel(cfg.LogLevel)),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	// Configure connection pool
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get underlying sql.DB: %w", err)
+	}
+
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetConnMaxLifetime(time.Duration(cfg.ConnMaxLifetime) * time.Second)
+
+	// Test the connection
+	if err := sqlDB.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	DB = db
+	logger.Info("Database connected successfully")
+	return db, nil
+}
+
+// Migrate runs database migrations
+func Migrate(db *gorm.DB, logger appLogger.Logger) error {
+	logger.Info("Running database migrations...")
+
+	err := db.AutoMigrate(
+		&models.User{},
+		// Add other models here as needed
+	)
+	if err != nil {
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	logger.Info("Database migrations completed successfully")
+	return nil
+}
+
+// getLogLevel converts string log level to GORM log level
+func getLogLevel(level string) gormLogger.LogLevel {
+	switch level {
+	case "error":
+		return gormLogger.Error
+	case "warn":
+		return gormLogger.Warn
+	case "info":
+		return gormLogger.Info
+	default:
+		return gormLogger.Silent
+	}
+}
+
+// Close closes the database connection
+func Close() error {
+	if DB != nil {
+		sqlDB, err := DB.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.Close()
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/services"
+)
+
+// HealthResponse represents the health check response
+type HealthResponse struct {
+	Status    string            `json:"status"`
+	Timestamp time.Time         `json:"timestamp"`
+	Version   string            `json:"version,omitempty"`
+	Checks    map[string]string `json:"checks,omitempty"`
+}
+
+// HealthHandler answers the health and readiness probes
+type HealthHandler struct {
+	clock clock.Clock
+}
+
+// NewHealthHandler creates a health handler timestamping its responses with clk
+func NewHealthHandler(clk clock.Clock) *HealthHandler {
+	return &HealthHandler{clock: clk}
+}
+
+// HealthCheck handles GET /health
+func (h *HealthHandler) HealthCheck(c echo.Context) error {
+	response := HealthResponse{
+		Status:    "healthy",
+		Timestamp: h.clock.Now(),
+		Version:   "1.0.0",
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// ReadinessCheck handles GET /ready
+func (h *HealthHandler) ReadinessCheck(c echo.Context) error {
+	checks := make(map[string]string)
+	allHealthy := true
+	checks["database"] = "healthy"
+
+	status := "ready"
+	httpStatus := http.StatusOK
+
+	if !allHealthy {
+		status = "not ready"
+		httpStatus = http.StatusServiceUnavailable
+	}
+
+	response := HealthResponse{
+		Status:    status,
+		Timestamp: h.clock.Now(),
+		Checks:    checks,
+	}
+
+	return c.JSON(httpStatus, response)
+}
+
+// UserHandler contains user-related handler methods
+type UserHandler struct {
+	userService services.UserService
+}
+
+// NewUserHandler creates a new user handler
+func NewUserHandler(userService services.UserService) *UserHandler {
+	return &UserHandler{
+		userService: userService,
+	}
+}
+
+// GetUsers handles GET /users
+func (h *UserHandler) GetUsers(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]string{"message": "Get users endpoint"})
+}
+
+// GetUser handles GET /users/:id
+func (h *UserHandler) GetUser(c echo.Context) error {
+	id := c.Param("id")
+	return c.JSON(http.StatusOK, map[string]string{"message": "Get user endpoint", "id": id})
+}
+
+// CreateUser handles POST /users
+func (h *UserHandler) CreateUser(c echo.Context) error {
+	return c.JSON(http.StatusCreated, map[string]string{"message": "Create user endpoint"})
+}
+
+// UpdateUser handles PUT /users/:id
+func (h *UserHandler) UpdateUser(c echo.Context) error {
+	id := c.Param("id")
+	return c.JSON(http.StatusOK, map[string]string{"message": "Update user endpoint", "id": id})
+}
+
+// DeleteUser handles DELETE /users/:id
+func (h *UserHandler) DeleteUser(c echo.Context) error {
+	id := c.Param("id")
+	return c.JSON(http.StatusOK, map[string]string{"message": "Delete user endpoint", "id": id})
+}
+
+// AuthHandler contains authentication-related handler methods
+type AuthHandler struct {
+	authService services.AuthService
+}
+
+// NewAuthHandler creates a new auth handler
+func NewAuthHandler(authService services.AuthService) *AuthHandler {
+	return &AuthHandler{
+		authService: authService,
+	}
+}
+
+// Login handles POST /auth/login
+func (h *AuthHandler) Login(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]string{"message": "Login endpoint"})
+}
+
+// Register handles POST /auth/register
+func (h *AuthHandler) Register(c echo.Context) error {
+	return c.JSON(http.StatusCreated, map[string]string{"message": "Register endpoint"})
+}
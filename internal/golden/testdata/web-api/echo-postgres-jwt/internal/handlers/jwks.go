@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/example/golden/internal/jwks"
+)
+
+// jwksCacheControl lets verifiers cache the key set for five minutes. Publish a new
+// key at least that long before making it the active one.
+const jwksCacheControl = "public, max-age=300"
+
+// JWKSHandler publishes the public keys verifying the tokens of this service
+type JWKSHandler struct {
+	keys *jwks.KeySet
+}
+
+// NewJWKSHandler creates a new JWKS handler
+func NewJWKSHandler(keys *jwks.KeySet) *JWKSHandler {
+	return &JWKSHandler{keys: keys}
+}
+
+// JWKS handles GET /.well-known/jwks.json
+func (h *JWKSHandler) JWKS(c echo.Context) error {
+	c.Response().Header().Set("Cache-Control", jwksCacheControl)
+	return c.JSON(http.StatusOK, h.keys.JWKS())
+}
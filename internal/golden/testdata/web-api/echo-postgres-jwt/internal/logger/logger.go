@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+)
+
+var (
+	// Default logger instance
+	logger *slog.Logger
+	// level is changed in place by SetLevel, safe while requests are logged
+	level = new(slog.LevelVar)
+)
+
+func init() {
+	// Initialize with sensible production defaults
+	opts := &slog.HandlerOptions{
+		Level: level,
+	}
+	handler := slog.NewJSONHandler(os.Stdout, opts)
+	logger = slog.New(handler)
+	slog.SetDefault(logger)
+}
+
+// SetLevel updates the logging level: debug, info, warn or error, info for
+// anything else. It can be called while the service runs.
+func SetLevel(name string) {
+	switch name {
+	case "debug":
+		level.Set(slog.LevelDebug)
+	case "warn":
+		level.Set(slog.LevelWarn)
+	case "error":
+		level.Set(slog.LevelError)
+	default:
+		level.Set(slog.LevelInfo)
+	}
+}
+
+// Level returns the logging level: debug, info, warn or error
+func Level() string {
+	return strings.ToLower(level.Level().String())
+}
+
+// Debug logs a debug message
+func Debug(msg string, args ...interface{}) {
+	logger.Debug(msg, args...)
+}
+
+// Info logs an info message
+func Info(msg string, args ...interface{}) {
+	logger.Info(msg, args...)
+}
+
+// Warn logs a warning message
+func Warn(msg string, args ...interface{}) {
+	logger.Warn(msg, args...)
+}
+
+// Error logs an error message
+func Error(msg string, args ...interface{}) {
+	logger.Error(msg, args...)
+}
+
+// WithFields adds structured fields to a log entry
+func WithFields(fields map[string]interface{}) func(string, ...interface{}) {
+	args := make([]any, 0, len(fields)*2)
+	for k, v := range fields {
+		args = append(args, k, v)
+	}
+	return func(msg string, msgArgs ...interface{}) {
+		logger.Info(msg, append(args, msgArgs...)...)
+	}
+}
+
+// Get returns the slog logger for direct use
+func Get() *slog.Logger {
+	return logger
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/example/golden/internal/services"
+)
+
+// AuthMiddleware provides JWT authentication middleware
+type AuthMiddleware struct {
+	authService services.AuthService
+}
+
+// NewAuthMiddleware creates a new auth middleware
+func NewAuthMiddleware(authService services.AuthService) *AuthMiddleware {
+	return &AuthMiddleware{
+		authService: authService,
+	}
+}
+
+// RequireAuth validates JWT token and sets user context
+func (m *AuthMiddleware) RequireAuth() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			authHeader := c.Request().Header.Get("Authorization")
+			if authHeader == "" {
+				return echo.NewHTTPError(http.StatusUnauthorized, map[string]string{
+					"error": "Authorization header required",
+					"code":  "MISSING_AUTH_HEADER",
+				})
+			}
+
+			// Extract token from "Bearer <token>"
+			tokenParts := strings.Split(authHeader, " ")
+			if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
+				return echo.NewHTTPError(http.StatusUnauthorized, map[string]string{
+					"error": "Invalid authorization header format",
+					"code":  "INVALID_AUTH_HEADER",
+				})
+			}
+
+			token := tokenParts[1]
+			claims, err := m.authService.ValidateToken(c.Request().Context(), token)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusUnauthorized, map[string]string{
+					"error": "Invalid or expired token",
+					"code":  "INVALID_TOKEN",
+				})
+			}
+
+			// Set user information in context
+			c.Set("userID", claims.UserID)
+			c.Set("email", claims.Email)
+			return next(c)
+		}
+	}
+}
+
+// OptionalAuth validates JWT token if present but doesn't require it
+func (m *AuthMiddleware) OptionalAuth() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			authHeader := c.Request().Header.Get("Authorization")
+			if authHeader == "" {
+				return next(c)
+			}
+
+			// Extract token from "Bearer <token>"
+			tokenParts := strings.Split(authHeader, " ")
+			if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
+				return next(c)
+			}
+
+			token := tokenParts[1]
+			claims, err := m.authService.ValidateToken(c.Request().Context(), token)
+			if err != nil {
+				return next(c)
+			}
+
+			// Set user information in context if token is valid
+			c.Set("userID", claims.UserID)
+			c.Set("email", claims.Email)
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// Logger returns a logging middleware for Echo
+func Logger(log logger.Logger) echo.MiddlewareFunc {
+	return middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
+		LogStatus:    true,
+		LogURI:       true,
+		LogError:     true,
+		LogLatency:   true,
+		LogMethod:    true,
+		LogRemoteIP:  true,
+		LogUserAgent: true,
+		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
+			log.Info("HTTP Request",
+				"method", v.Method,
+				"uri", v.URI,
+				"status", v.Status,
+				"latency", v.Latency,
+				"remote_ip", v.RemoteIP,
+				"user_agent", v.UserAgent,
+			)
+			if v.Error != nil {
+				log.Error("HTTP Request Error", "error", v.Error)
+			}
+			return nil
+		},
+	})
+}
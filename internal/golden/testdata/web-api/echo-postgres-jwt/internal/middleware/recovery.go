@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	"github.com/example/golden/internal/logger"
+)
+
+// Recovery returns a recovery middleware for Echo
+func Recovery(log logger.Logger) echo.MiddlewareFunc {
+	return middleware.RecoverWithConfig(middleware.RecoverConfig{
+		LogErrorFunc: func(c echo.Context, err error, stack []byte) error {
+			log.Error("Panic recovered",
+				"error", err,
+				"stack", string(stack),
+				"method", c.Request().Method,
+				"path", c.Request().URL.Path,
+			)
+			return nil
+		},
+	})
+}
@@ -0,0 +1,62 @@
+// Package middleware provides request ID middleware for request correlation
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RequestIDKey is the context key for request ID
+type RequestIDKey struct{}
+
+// RequestIDConfig holds request ID middleware configuration
+type RequestIDConfig struct {
+	// Header is the header name to look for existing request ID
+	Header string
+	// GenerateID is a function to generate new request IDs
+	GenerateID func() string
+}
+
+// DefaultRequestIDConfig returns default request ID configuration
+func DefaultRequestIDConfig() *RequestIDConfig {
+	return &RequestIDConfig{
+		Header: "X-Request-ID",
+		GenerateID: func() string {
+			return fmt.Sprintf("%d-%d", time.Now().UnixNano(), rand.Intn(1000000))
+		},
+	}
+}
+
+// EchoRequestIDMiddleware returns Echo middleware for request ID handling
+func (config *RequestIDConfig) EchoRequestIDMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			requestID := c.Request().Header.Get(config.Header)
+			if requestID == "" {
+				requestID = config.GenerateID()
+			}
+
+			// Set in Echo context and response header
+			c.Set("request_id", requestID)
+			c.Response().Header().Set(config.Header, requestID)
+
+			// Set in request context
+			ctx := context.WithValue(c.Request().Context(), RequestIDKey{}, requestID)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
+
+// GetRequestID extracts request ID from context
+func GetRequestID(ctx context.Context) string {
+	if requestID, ok := ctx.Value(RequestIDKey{}).(string); ok {
+		return requestID
+	}
+	return ""
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/example/golden/internal/models"
+)
+
+// UserRepository defines the interface for user data access
+type UserRepository interface {
+	GetAll(ctx context.Context, limit, offset int) ([]models.User, error)
+	GetByID(ctx context.Context, id models.ID) (*models.User, error)
+	GetByEmail(ctx context.Context, email string) (*models.User, error)
+	Create(ctx context.Context, user *models.User) error
+	Update(ctx context.Context, user *models.User) error
+	Delete(ctx context.Context, id models.ID) error
+	Count(ctx context.Context) (int, error)
+}
+
+// gormUserRepository implements UserRepository using GORM
+type gormUserRepository struct {
+	db *gorm.DB
+}
+
+// NewUserRepository creates a new user repository
+func NewUserRepository(db *gorm.DB) UserRepository {
+	return &gormUserRepository{db: db}
+}
+
+// GetAll retrieves all users with pagination
+func (r *gormUserRepository) GetAll(ctx context.Context, limit, offset int) ([]models.User, error) {
+	var users []models.User
+	err := r.db.WithContext(ctx).Limit(limit).Offset(offset).Find(&users).Error
+	return users, err
+}
+
+// GetByID retrieves a user by ID
+func (r *gormUserRepository) GetByID(ctx context.Context, id models.ID) (*models.User, error) {
+	var user models.User
+	err := r.db.WithContext(ctx).First(&user, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetByEmail retrieves a user by email
+func (r *gormUserRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	var user models.User
+	err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// Create creates a new user
+func (r *gormUserRepository) Create(ctx context.Context, user *models.User) error {
+	return r.db.WithContext(ctx).Create(user).Error
+}
+
+// Update updates an existing user
+func (r *gormUserRepository) Update(ctx context.Context, user *models.User) error {
+	return r.db.WithContext(ctx).Save(user).Error
+}
+
+// Delete deletes a user by ID
+func (r *gormUserRepository) Delete(ctx context.Context, id models.ID) error {
+	return r.db.WithContext(ctx).Delete(&models.User{}, "id = ?", id).Error
+}
+
+// Count returns the total number of users
+func (r *gormUserRepository) Count(ctx context.Context) (int, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.User{}).Count(&count).Error
+	return int(count), err
+}
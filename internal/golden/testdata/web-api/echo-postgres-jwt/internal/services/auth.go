@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/jwks"
+	"github.com/example/golden/internal/models"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrTokenExpired       = errors.New("token expired")
+	ErrInvalidToken       = errors.New("invalid token")
+)
+
+// JWTClaims represents the claims in a JWT token
+type JWTClaims struct {
+	UserID models.ID `json:"user_id"`
+	Email  string    `json:"email"`
+	jwt.RegisteredClaims
+}
+
+// AuthService defines the interface for authentication business logic
+type AuthService interface {
+	Login(ctx context.Context, email, password string) (string, *models.User, error)
+	Register(ctx context.Context, req models.RegisterRequest) (*models.User, error)
+	ValidateToken(ctx context.Context, tokenString string) (*JWTClaims, error)
+	RefreshToken(ctx context.Context, userID models.ID) (string, error)
+	HashPassword(password string) (string, error)
+	ComparePasswords(hashedPassword, password string) error
+}
+
+// TokenOptions configures the tokens the auth service issues and accepts
+type TokenOptions struct {
+	// TTL is the lifetime of issued tokens
+	TTL time.Duration
+	// Issuer is the iss claim of issued tokens, and the one required on tokens
+	// signed with the service keys
+	Issuer string
+	// Audience is the aud claim of issued tokens; when set, every token must carry it
+	Audience string
+	// ExternalIssuer and ExternalKeys accept the tokens of an external identity
+	// provider, verified with the keys it publishes
+	ExternalIssuer string
+	ExternalKeys   *jwks.Remote
+}
+
+// authService implements AuthService
+type authService struct {
+	userService UserService
+	keys        *jwks.KeySet
+	clock       clock.Clock
+	options     TokenOptions
+}
+
+// NewAuthService creates a new auth service signing tokens with the active key of
+// keys, issued and expiring by clk
+func NewAuthService(userService UserService, keys *jwks.KeySet, clk clock.Clock, options TokenOptions) AuthService {
+	return &authService{
+		userService: userService,
+		keys:        keys,
+		clock:       clk,
+		options:     options,
+	}
+}
+
+// Login authenticates a user and returns a JWT token
+func (s *authService) Login(ctx context.Context, email, password string) (string, *models.User, error) {
+	user, err := s.userService.GetUserByEmail(ctx, email)
+	if err != nil {
+		if err.Error() == "user not found" {
+			return "", nil, ErrInvalidCredentials
+		}
+		return "", nil, err
+	}
+
+	if err := s.ComparePasswords(user.Password, password); err != nil {
+		return "", nil, ErrInvalidCredentials
+	}
+
+	token, err := s.generateToken(user)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Remove password from response
+	user.Password = ""
+
+	return token, user, nil
+}
+
+// Register creates a new user account
+func (s *authService) Register(ctx context.Context, req models.RegisterRequest) (*models.User, error) {
+	// Hash the password
+	hashedPassword, err := s.HashPassword(req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create user request with hashed password
+	createReq := models.CreateUserRequest{
+		Name:     req.Name,
+		Email:    req.Email,
+		Password: hashedPassword, // Pass the hashed password to be persisted
+	}
+
+	user, err := s.userService.CreateUser(ctx, createReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove password from response for security
+	user.Password = ""
+
+	return user, nil
+}
+
+// ValidateToken validates a JWT token signed by one of the service keys, or by the
+// external identity provider when one is configured, and returns the claims
+func (s *authService) ValidateToken(ctx context.Context, tokenString string) (*JWTClaims, error) {
+	parserOptions := []jwt.ParserOption{
+		// Only asymmetric algorithms: a token must never be verified with a public key used as an HMAC secret
+		jwt.WithValidMethods([]string{jwks.RS256, jwks.EdDSA}),
+		jwt.WithTimeFunc(s.clock.Now),
+	}
+	if s.options.Audience != "" {
+		parserOptions = append(parserOptions, jwt.WithAudience(s.options.Audience))
+	}
+
+	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, s.verificationKey(ctx), parserOptions...)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrTokenExpired
+		}
+		return nil, ErrInvalidToken
+	}
+
+	claims, ok := token.Claims.(*JWTClaims)
+	if !ok || !token.Valid || claims.ExpiresAt == nil {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+// verificationKey selects the public key named by the kid header, making sure the
+// token was issued by the party holding that key. The keys of the external
+// identity provider are fetched within ctx.
+func (s *authService) verificationKey(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		issuer, err := token.Claims.GetIssuer()
+		if err != nil {
+			return nil, ErrInvalidToken
+		}
+
+		if key, ok := s.keys.PublicKey(kid); ok {
+			if issuer != s.options.Issuer {
+				return nil, ErrInvalidToken
+			}
+			return key, nil
+		}
+
+		if s.options.ExternalKeys != nil && issuer == s.options.ExternalIssuer {
+			return s.options.ExternalKeys.PublicKey(ctx, kid)
+		}
+
+		return nil, ErrInvalidToken
+	}
+}
+
+// RefreshToken generates a new token for a user
+func (s *authService) RefreshToken(ctx context.Context, userID models.ID) (string, error) {
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	return s.generateToken(user)
+}
+
+// HashPassword hashes a password using bcrypt
+func (s *authService) HashPassword(password string) (string, error) {
+	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return string(bytes), err
+}
+
+// ComparePasswords compares a hashed password with a plain text password
+func (s *authService) ComparePasswords(hashedPassword, password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+}
+
+// generateToken generates a JWT token for a user
+func (s *authService) generateToken(user *models.User) (string, error) {
+	now := s.clock.Now()
+	claims := JWTClaims{
+		UserID: user.ID,
+		Email:  user.Email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.options.Issuer,
+			Subject:   models.FormatID(user.ID),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.options.TTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+	if s.options.Audience != "" {
+		claims.Audience = jwt.ClaimStrings{s.options.Audience}
+	}
+
+	return s.keys.Sign(claims)
+}
@@ -0,0 +1,300 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	echoMiddleware "github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"github.com/example/golden/internal/clock"
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/database"
+	"github.com/example/golden/internal/handlers"
+	"github.com/example/golden/internal/jwks"
+	"github.com/example/golden/internal/logger"
+	appMiddleware "github.com/example/golden/internal/middleware"
+	"github.com/example/golden/internal/models"
+	"github.com/example/golden/internal/repository"
+	"github.com/example/golden/internal/services"
+)
+
+type APITestSuite struct {
+	suite.Suite
+	router      *echo.Echo
+	logger      logger.Logger
+	db          interface{} // Can be *gorm.DB or *sql.DB depending on ORM choice
+	userService services.UserService
+	authService services.AuthService
+}
+
+func (suite *APITestSuite) SetupSuite() {
+
+	// Initialize logger for tests
+	logger.SetLevel("error")
+	suite.logger = logger.GetLogger()
+	// Setup test database (you might want to use SQLite for tests)
+	cfg := config.DatabaseConfig{
+		Host:     "localhost",
+		Port:     5432,
+		User:     "test",
+		Password: "test",
+		Name:     "test_golden",
+		LogLevel: "error",
+	}
+
+	var err error
+	suite.db, err = database.Connect(cfg, suite.logger)
+	suite.Require().NoError(err)
+
+	// Run migrations
+	err = database.Migrate(suite.db.(*gorm.DB), suite.logger)
+	suite.Require().NoError(err)
+
+	// Setup services
+	userRepo := repository.NewUserRepository(suite.db.(*gorm.DB))
+	suite.userService = services.NewUserService(userRepo)
+	signingKeys, err := jwks.Ephemeral(jwks.RS256)
+	suite.Require().NoError(err)
+	suite.authService = services.NewAuthService(suite.userService, signingKeys, clock.New(), services.TokenOptions{TTL: time.Hour, Issuer: "golden-test"})
+
+	// Setup router
+	suite.router = echo.New()
+	suite.router.HideBanner = true
+
+	// Add middleware
+	suite.router.Use(echoMiddleware.CORS())
+	suite.router.Use(appMiddleware.Logger(suite.logger))
+	suite.router.Use(appMiddleware.Recovery(suite.logger))
+
+	// Setup routes
+	suite.setupRoutes()
+}
+
+func (suite *APITestSuite) TearDownSuite() {
+	// Clean up database connection
+	if suite.db != nil {
+		database.Close()
+	}
+}
+
+func (suite *APITestSuite) setupRoutes() {
+	// Health routes
+	healthHandler := handlers.NewHealthHandler(clock.New())
+	suite.router.GET("/health", healthHandler.HealthCheck)
+	suite.router.GET("/ready", healthHandler.ReadinessCheck)
+	// API routes
+	// API routes
+	api := suite.router.Group("/api/v1")
+	// Auth routes
+	authHandler := handlers.NewAuthHandler(suite.authService)
+	auth := api.Group("/auth")
+	auth.POST("/login", authHandler.Login)
+	auth.POST("/register", authHandler.Register)
+	auth.POST("/refresh", authHandler.Refresh)
+	auth.POST("/logout", authHandler.Logout)
+
+	// Protected routes
+	authMiddleware := appMiddleware.NewAuthMiddleware(suite.authService)
+	protected := api.Group("")
+	protected.Use(authMiddleware.RequireAuth())
+
+	// User routes
+	userHandler := handlers.NewUsersHandler(suite.userService)
+	users := protected.Group("/users")
+	users.GET("", userHandler.GetUsers)
+	users.POST("", userHandler.CreateUser)
+	users.GET("/:id", userHandler.GetUser)
+	users.PUT("/:id", userHandler.UpdateUser)
+	users.DELETE("/:id", userHandler.DeleteUser)
+}
+
+// Test health endpoints
+func (suite *APITestSuite) TestHealthEndpoints() {
+	tests := []struct {
+		name           string
+		endpoint       string
+		expectedStatus int
+		expectedValue  string
+	}{
+		{
+			name:           "Health check should return 200",
+			endpoint:       "/health",
+			expectedStatus: http.StatusOK,
+			expectedValue:  "healthy",
+		},
+		{
+			name:           "Ready check should return 200",
+			endpoint:       "/ready",
+			expectedStatus: http.StatusOK,
+			expectedValue:  "ready",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			req, _ := http.NewRequest("GET", tt.endpoint, nil)
+			w := httptest.NewRecorder()
+			suite.router.ServeHTTP(w, req)
+
+			assert.Equal(suite.T(), tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expectedValue, response["status"])
+		})
+	}
+}
+
+// Test authentication endpoints
+func (suite *APITestSuite) TestAuthEndpoints() {
+	// Test registration
+	suite.Run("Register new user", func() {
+		payload := models.RegisterRequest{
+			Name:     "Test User",
+			Email:    "test@example.com",
+			Password: "password123",
+		}
+
+		jsonPayload, _ := json.Marshal(payload)
+		req, _ := http.NewRequest("POST", "/api/v1/auth/register", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		suite.router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(suite.T(), err)
+		assert.Contains(suite.T(), response, "data")
+	})
+
+	// Test login
+	suite.Run("Login with valid credentials", func() {
+		payload := models.LoginRequest{
+			Email:    "test@example.com",
+			Password: "password123",
+		}
+
+		jsonPayload, _ := json.Marshal(payload)
+		req, _ := http.NewRequest("POST", "/api/v1/auth/login", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		suite.router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(suite.T(), err)
+		assert.Contains(suite.T(), response, "token")
+		assert.Contains(suite.T(), response, "user")
+	})
+
+	// Test login with invalid credentials
+	suite.Run("Login with invalid credentials", func() {
+		payload := models.LoginRequest{
+			Email:    "test@example.com",
+			Password: "wrongpassword",
+		}
+
+		jsonPayload, _ := json.Marshal(payload)
+		req, _ := http.NewRequest("POST", "/api/v1/auth/login", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		suite.router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusUnauthorized, w.Code)
+	})
+}
+
+// Test user endpoints
+func (suite *APITestSuite) TestUserEndpoints() {
+	// First, register and login to get a token
+	var token string
+	suite.Run("Setup: Register and login user", func() {
+		// Register
+		payload := models.RegisterRequest{
+			Name:     "API Test User",
+			Email:    "apitest@example.com",
+			Password: "password123",
+		}
+
+		jsonPayload, _ := json.Marshal(payload)
+		req, _ := http.NewRequest("POST", "/api/v1/auth/register", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		suite.router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusCreated, w.Code)
+
+		// Login
+		loginPayload := models.LoginRequest{
+			Email:    "apitest@example.com",
+			Password: "password123",
+		}
+
+		jsonPayload, _ = json.Marshal(loginPayload)
+		req, _ = http.NewRequest("POST", "/api/v1/auth/login", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		w = httptest.NewRecorder()
+		suite.router.ServeHTTP(w, req)
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(suite.T(), err)
+		token = response["token"].(string)
+	})
+
+	// Test creating a user
+	suite.Run("Create user", func() {
+		payload := models.CreateUserRequest{
+			Name:  "New User",
+			Email: "newuser@example.com",
+		}
+
+		jsonPayload, _ := json.Marshal(payload)
+		req, _ := http.NewRequest("POST", "/api/v1/users", bytes.NewBuffer(jsonPayload))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		suite.router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(suite.T(), err)
+		assert.Contains(suite.T(), response, "data")
+	})
+
+	// Test getting users
+	suite.Run("Get users", func() {
+		req, _ := http.NewRequest("GET", "/api/v1/users", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		suite.router.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(suite.T(), err)
+		assert.Contains(suite.T(), response, "data")
+		assert.Contains(suite.T(), response, "pagination")
+	})
+}
+
+// Run the test suite
+func TestAPITestSuite(t *testing.T) {
+	suite.Run(t, new(APITestSuite))
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/session"
+	"github.com/example/golden/internal/todo"
+	"github.com/example/golden/internal/web"
+	"github.com/example/golden/static"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "golden: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run serves the application until SIGINT or SIGTERM
+func run() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	log, err := logger.NewFactory().Create(cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+	log = log.With("service", "golden")
+	if os.Getenv("SESSION_SECRET") == "" {
+		log.Warn("SESSION_SECRET is not set, sessions end when the server restarts")
+	}
+
+	store := todo.NewMemoryStore()
+
+	assets, err := static.New()
+	if err != nil {
+		return fmt.Errorf("failed to load the static assets: %w", err)
+	}
+	sessions := session.NewManager(cfg.SessionSecret, cfg.SessionMaxAge, cfg.IsProduction())
+
+	srv := &http.Server{
+		Addr:              cfg.Address(),
+		Handler:           web.NewServer(store, sessions, assets, log).Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+	}
+
+	log.Info("Listening", "address", cfg.Address(), "environment", cfg.Environment)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("server stopped: %w", err)
+	case <-ctx.Done():
+	}
+
+	log.Info("Shutting down", "timeout", cfg.ShutdownTimeout.String())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down: %w", err)
+	}
+	log.Info("Server stopped")
+	return nil
+}
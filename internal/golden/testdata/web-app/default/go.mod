@@ -0,0 +1,9 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	github.com/a-h/templ v0.3.924
+	github.com/gorilla/sessions v1.4.0
+	github.com/stretchr/testify v1.9.0
+)
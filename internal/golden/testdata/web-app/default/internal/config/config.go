@@ -0,0 +1,128 @@
+package config
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// minSecretLength is the shortest SESSION_SECRET accepted, in bytes
+const minSecretLength = 32
+
+// Config holds the web application configuration, read from the environment
+type Config struct {
+	// Environment is development or production (APP_ENV)
+	Environment string
+	// Port the HTTP server listens on (PORT)
+	Port int
+	// LogLevel is one of debug, info, warn or error (LOG_LEVEL)
+	LogLevel string
+	// LogFormat is json or console (LOG_FORMAT)
+	LogFormat string
+	// ShutdownTimeout bounds the graceful shutdown (SHUTDOWN_TIMEOUT)
+	ShutdownTimeout time.Duration
+
+	// SessionSecret signs and encrypts the session cookies (SESSION_SECRET). Development
+	// falls back to a random secret, which logs everyone out on each restart
+	SessionSecret string
+	// SessionMaxAge is how long a session lasts without a visit (SESSION_MAX_AGE)
+	SessionMaxAge time.Duration
+}
+
+// Load reads the configuration from the environment, applying defaults
+func Load() (*Config, error) {
+	cfg := &Config{
+		Environment:     getEnv("APP_ENV", "development"),
+		Port:            8080,
+		LogLevel:        getEnv("LOG_LEVEL", "info"),
+		LogFormat:       getEnv("LOG_FORMAT", "json"),
+		ShutdownTimeout: 15 * time.Second,
+		SessionSecret:   os.Getenv("SESSION_SECRET"),
+		SessionMaxAge:   7 * 24 * time.Hour,
+	}
+
+	if cfg.Environment != "development" && cfg.Environment != "production" {
+		return nil, fmt.Errorf("invalid APP_ENV %q: must be development or production", cfg.Environment)
+	}
+
+	var err error
+	if cfg.Port, err = getEnvInt("PORT", cfg.Port); err != nil {
+		return nil, err
+	}
+	if cfg.Port < 1 || cfg.Port > 65535 {
+		return nil, fmt.Errorf("invalid PORT %d: must be between 1 and 65535", cfg.Port)
+	}
+	if cfg.ShutdownTimeout, err = getEnvDuration("SHUTDOWN_TIMEOUT", cfg.ShutdownTimeout); err != nil {
+		return nil, err
+	}
+	if cfg.SessionMaxAge, err = getEnvDuration("SESSION_MAX_AGE", cfg.SessionMaxAge); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case cfg.SessionSecret == "" && cfg.IsProduction():
+		return nil, errors.New("SESSION_SECRET is required in production, generate one with: openssl rand -base64 32")
+	case cfg.SessionSecret == "":
+		if cfg.SessionSecret, err = randomSecret(); err != nil {
+			return nil, err
+		}
+	case len(cfg.SessionSecret) < minSecretLength:
+		return nil, fmt.Errorf("SESSION_SECRET must be at least %d characters long", minSecretLength)
+	}
+	return cfg, nil
+}
+
+// IsProduction reports whether the application runs in production, where the
+// session cookies are only sent over HTTPS
+func (c *Config) IsProduction() bool {
+	return c.Environment == "production"
+}
+
+// Address returns the address the server listens on
+func (c *Config) Address() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+// randomSecret returns a session secret for development
+func randomSecret() (string, error) {
+	secret := make([]byte, minSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate a session secret: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(secret), nil
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	return n, nil
+}
+
+func getEnvDuration(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	return d, nil
+}
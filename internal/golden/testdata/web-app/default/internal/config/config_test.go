@@ -0,0 +1,64 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func clearEnv(t *testing.T) {
+	for _, key := range []string{"APP_ENV", "PORT", "LOG_LEVEL", "LOG_FORMAT", "SHUTDOWN_TIMEOUT", "SESSION_SECRET", "SESSION_MAX_AGE"} {
+		t.Setenv(key, "")
+	}
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	clearEnv(t)
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, ":8080", cfg.Address())
+	assert.False(t, cfg.IsProduction())
+	assert.Equal(t, 15*time.Second, cfg.ShutdownTimeout)
+	assert.Equal(t, 7*24*time.Hour, cfg.SessionMaxAge)
+	assert.GreaterOrEqual(t, len(cfg.SessionSecret), minSecretLength, "development generates a secret")
+}
+
+func TestLoad_SessionSecret(t *testing.T) {
+	clearEnv(t)
+
+	t.Setenv("APP_ENV", "production")
+	_, err := Load()
+	assert.ErrorContains(t, err, "SESSION_SECRET is required")
+
+	t.Setenv("SESSION_SECRET", "too-short")
+	_, err = Load()
+	assert.ErrorContains(t, err, "at least 32 characters")
+
+	secret := strings.Repeat("s", minSecretLength)
+	t.Setenv("SESSION_SECRET", secret)
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.True(t, cfg.IsProduction())
+	assert.Equal(t, secret, cfg.SessionSecret)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	cases := map[string]string{
+		"APP_ENV":          "staging",
+		"PORT":             "70000",
+		"SHUTDOWN_TIMEOUT": "soon",
+		"SESSION_MAX_AGE":  "forever",
+	}
+	for key, value := range cases {
+		t.Run(key, func(t *testing.T) {
+			clearEnv(t)
+			t.Setenv(key, value)
+			_, err := Load()
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// Package session keeps the state of a visitor in an encrypted cookie: flash
+// messages shown on the next page and the token protecting the forms against CSRF
+package session
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/sessions"
+)
+
+const (
+	// CookieName is the name of the session cookie
+	CookieName = "golden_session"
+	// CSRFHeader carries the CSRF token of htmx requests
+	CSRFHeader = "X-CSRF-Token"
+	// CSRFField carries the CSRF token of HTML forms
+	CSRFField = "csrf_token"
+
+	csrfKey = "csrf_token"
+)
+
+// Flash kinds, which the views style differently
+const (
+	FlashSuccess = "success"
+	FlashError   = "error"
+)
+
+var flashKinds = []string{FlashSuccess, FlashError}
+
+// Flash is a message shown once, on the next page the visitor sees
+type Flash struct {
+	Kind    string
+	Message string
+}
+
+type contextKey struct{}
+
+// Manager loads and saves the sessions of the requests
+type Manager struct {
+	store *sessions.CookieStore
+}
+
+// NewManager creates a manager whose cookies are signed and encrypted with keys
+// derived from secret, last maxAge and are only sent over HTTPS when secure
+func NewManager(secret string, maxAge time.Duration, secure bool) *Manager {
+	store := sessions.NewCookieStore(deriveKey(secret, "hash"), deriveKey(secret, "block"))
+	store.Options = &sessions.Options{
+		Path:     "/",
+		MaxAge:   int(maxAge.Seconds()),
+		Secure:   secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+	return &Manager{store: store}
+}
+
+// deriveKey derives a 32 byte key for purpose from secret, so that one secret
+// yields both the signing and the encryption key of the cookies
+func deriveKey(secret, purpose string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+// Middleware loads the session of each request into its context, rejects unsafe
+// requests without the CSRF token of the session, and saves the session when the
+// handler changed it
+func (m *Manager) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// An invalid cookie, such as one signed with a former secret, starts a new session
+		raw, _ := m.store.Get(r, CookieName)
+		s := &Session{raw: raw}
+
+		if !isSafe(r.Method) && !s.validToken(r) {
+			http.Error(w, "Invalid or missing CSRF token, reload the page and try again", http.StatusForbidden)
+			return
+		}
+
+		sw := &saveWriter{ResponseWriter: w, save: func(w http.ResponseWriter) {
+			if s.dirty {
+				_ = m.store.Save(r, w, raw)
+			}
+		}}
+		next.ServeHTTP(sw, r.WithContext(context.WithValue(r.Context(), contextKey{}, s)))
+		sw.beforeWrite()
+	})
+}
+
+// Session is the session of a request
+type Session struct {
+	raw   *sessions.Session
+	dirty bool
+}
+
+// FromContext returns the session loaded by Manager.Middleware
+func FromContext(ctx context.Context) *Session {
+	s, _ := ctx.Value(contextKey{}).(*Session)
+	return s
+}
+
+// CSRFToken returns the token that forms and htmx requests send back, creating it
+// on first use
+func (s *Session) CSRFToken() string {
+	if token, ok := s.raw.Values[csrfKey].(string); ok {
+		return token
+	}
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		panic("session: failed to generate a CSRF token: " + err.Error())
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	s.raw.Values[csrfKey] = token
+	s.dirty = true
+	return token
+}
+
+// AddFlash queues a message of kind for the next page
+func (s *Session) AddFlash(kind, message string) {
+	s.raw.AddFlash(message, kind)
+	s.dirty = true
+}
+
+// Flashes returns the queued messages and removes them from the session
+func (s *Session) Flashes() []Flash {
+	var flashes []Flash
+	for _, kind := range flashKinds {
+		for _, message := range s.raw.Flashes(kind) {
+			if text, ok := message.(string); ok {
+				flashes = append(flashes, Flash{Kind: kind, Message: text})
+			}
+		}
+	}
+	if len(flashes) > 0 {
+		s.dirty = true
+	}
+	return flashes
+}
+
+// validToken reports whether r carries the CSRF token of the session
+func (s *Session) validToken(r *http.Request) bool {
+	expected, ok := s.raw.Values[csrfKey].(string)
+	if !ok {
+		return false
+	}
+	token := r.Header.Get(CSRFHeader)
+	if token == "" {
+		token = r.PostFormValue(CSRFField)
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(expected)) == 1
+}
+
+// isSafe reports whether method only reads, and so needs no CSRF token
+func isSafe(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// saveWriter saves the session right before the response headers are written,
+// since the cookie is one of them
+type saveWriter struct {
+	http.ResponseWriter
+	save  func(http.ResponseWriter)
+	saved bool
+}
+
+func (w *saveWriter) beforeWrite() {
+	if !w.saved {
+		w.saved = true
+		w.save(w.ResponseWriter)
+	}
+}
+
+func (w *saveWriter) WriteHeader(status int) {
+	w.beforeWrite()
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *saveWriter) Write(b []byte) (int, error) {
+	w.beforeWrite()
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap gives http.ResponseController access to the underlying writer
+func (w *saveWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
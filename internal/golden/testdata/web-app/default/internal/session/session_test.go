@@ -0,0 +1,111 @@
+package session
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "a-test-secret-of-at-least-32-characters"
+
+// testServer keeps sessions under secret and answers GET /token with the CSRF
+// token, POST /flash by queueing a flash and GET /flashes with the queued flashes
+func testServer(secret string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /token", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(FromContext(r.Context()).CSRFToken()))
+	})
+	mux.HandleFunc("POST /flash", func(w http.ResponseWriter, r *http.Request) {
+		FromContext(r.Context()).AddFlash(FlashSuccess, "Saved")
+		http.Redirect(w, r, "/flashes", http.StatusSeeOther)
+	})
+	mux.HandleFunc("GET /flashes", func(w http.ResponseWriter, r *http.Request) {
+		for _, flash := range FromContext(r.Context()).Flashes() {
+			w.Write([]byte(flash.Kind + ":" + flash.Message + "\n"))
+		}
+	})
+	return NewManager(secret, time.Hour, false).Middleware(mux)
+}
+
+// do sends a request with the cookies of jar and stores the cookies of the response in jar
+func do(t *testing.T, handler http.Handler, jar map[string]*http.Cookie, req *http.Request) *httptest.ResponseRecorder {
+	t.Helper()
+	for _, cookie := range jar {
+		req.AddCookie(cookie)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	for _, cookie := range rec.Result().Cookies() {
+		jar[cookie.Name] = cookie
+	}
+	return rec
+}
+
+func TestMiddleware_CSRF(t *testing.T) {
+	handler := testServer(testSecret)
+	jar := map[string]*http.Cookie{}
+
+	rec := do(t, handler, jar, httptest.NewRequest(http.MethodPost, "/flash", nil))
+	assert.Equal(t, http.StatusForbidden, rec.Code, "no session yet")
+
+	rec = do(t, handler, jar, httptest.NewRequest(http.MethodGet, "/token", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	token := rec.Body.String()
+	require.NotEmpty(t, token)
+	require.Contains(t, jar, CookieName)
+
+	req := httptest.NewRequest(http.MethodPost, "/flash", nil)
+	req.Header.Set(CSRFHeader, "wrong")
+	assert.Equal(t, http.StatusForbidden, do(t, handler, jar, req).Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/flash", nil)
+	req.Header.Set(CSRFHeader, token)
+	assert.Equal(t, http.StatusSeeOther, do(t, handler, jar, req).Code, "htmx header")
+
+	form := url.Values{CSRFField: {token}}
+	req = httptest.NewRequest(http.MethodPost, "/flash", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	assert.Equal(t, http.StatusSeeOther, do(t, handler, jar, req).Code, "form field")
+
+	rec = do(t, handler, jar, httptest.NewRequest(http.MethodGet, "/token", nil))
+	assert.Equal(t, token, rec.Body.String(), "the token lasts as long as the session")
+}
+
+func TestMiddleware_Flashes(t *testing.T) {
+	handler := testServer(testSecret)
+	jar := map[string]*http.Cookie{}
+
+	token := do(t, handler, jar, httptest.NewRequest(http.MethodGet, "/token", nil)).Body.String()
+	req := httptest.NewRequest(http.MethodPost, "/flash", nil)
+	req.Header.Set(CSRFHeader, token)
+	do(t, handler, jar, req)
+
+	rec := do(t, handler, jar, httptest.NewRequest(http.MethodGet, "/flashes", nil))
+	assert.Equal(t, "success:Saved\n", rec.Body.String())
+
+	rec = do(t, handler, jar, httptest.NewRequest(http.MethodGet, "/flashes", nil))
+	assert.Empty(t, rec.Body.String(), "flashes are shown once")
+}
+
+func TestMiddleware_OnlySavesChangedSessions(t *testing.T) {
+	handler := testServer(testSecret)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/flashes", nil))
+	assert.Empty(t, rec.Result().Cookies())
+}
+
+func TestMiddleware_RejectsForeignCookies(t *testing.T) {
+	jar := map[string]*http.Cookie{}
+	token := do(t, testServer(testSecret), jar, httptest.NewRequest(http.MethodGet, "/token", nil)).Body.String()
+
+	other := testServer("another-secret-of-at-least-32-characters")
+	req := httptest.NewRequest(http.MethodPost, "/flash", nil)
+	req.Header.Set(CSRFHeader, token)
+	assert.Equal(t, http.StatusForbidden, do(t, other, jar, req).Code)
+}
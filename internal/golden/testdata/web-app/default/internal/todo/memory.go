@@ -0,0 +1,85 @@
+package todo
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps the todos in memory; they are lost when the process stops.
+// It backs the tests and the application, which has no database
+type MemoryStore struct {
+	mu     sync.RWMutex
+	todos  map[int64]Todo
+	nextID int64
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{todos: make(map[int64]Todo), nextID: 1}
+}
+
+// List returns the todos, oldest first
+func (s *MemoryStore) List(ctx context.Context) ([]Todo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	todos := make([]Todo, 0, len(s.todos))
+	for _, todo := range s.todos {
+		todos = append(todos, todo)
+	}
+	sort.Slice(todos, func(i, j int) bool { return todos[i].ID < todos[j].ID })
+	return todos, nil
+}
+
+// Get returns the todo with id, or ErrNotFound
+func (s *MemoryStore) Get(ctx context.Context, id int64) (*Todo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	todo, ok := s.todos[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &todo, nil
+}
+
+// Create stores a new todo, setting its ID and creation time
+func (s *MemoryStore) Create(ctx context.Context, todo *Todo) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	todo.ID = s.nextID
+	todo.CreatedAt = time.Now().UTC()
+	s.nextID++
+	s.todos[todo.ID] = *todo
+	return nil
+}
+
+// Update saves the title and state of an existing todo, or returns ErrNotFound
+func (s *MemoryStore) Update(ctx context.Context, todo *Todo) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.todos[todo.ID]
+	if !ok {
+		return ErrNotFound
+	}
+	stored.Title = todo.Title
+	stored.Done = todo.Done
+	s.todos[todo.ID] = stored
+	return nil
+}
+
+// Delete removes the todo with id, or returns ErrNotFound
+func (s *MemoryStore) Delete(ctx context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.todos[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.todos, id)
+	return nil
+}
@@ -0,0 +1,54 @@
+package todo
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	first := &Todo{Title: "Write the docs"}
+	second := &Todo{Title: "Ship it"}
+	require.NoError(t, store.Create(ctx, first))
+	require.NoError(t, store.Create(ctx, second))
+	assert.NotZero(t, first.ID)
+	assert.False(t, first.CreatedAt.IsZero())
+
+	todos, err := store.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, todos, 2)
+	assert.Equal(t, "Write the docs", todos[0].Title, "oldest first")
+
+	first.Done = true
+	require.NoError(t, store.Update(ctx, first))
+	got, err := store.Get(ctx, first.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Done)
+
+	require.NoError(t, store.Delete(ctx, first.ID))
+	_, err = store.Get(ctx, first.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, store.Delete(ctx, first.ID), ErrNotFound)
+	assert.ErrorIs(t, store.Update(ctx, first), ErrNotFound)
+}
+
+func TestNormalizeTitle(t *testing.T) {
+	title, err := NormalizeTitle("  Buy milk \n")
+	require.NoError(t, err)
+	assert.Equal(t, "Buy milk", title)
+
+	_, err = NormalizeTitle("   ")
+	assert.ErrorIs(t, err, ErrEmptyTitle)
+
+	_, err = NormalizeTitle(strings.Repeat("é", MaxTitleLength+1))
+	assert.ErrorIs(t, err, ErrTitleTooLong)
+
+	_, err = NormalizeTitle(strings.Repeat("é", MaxTitleLength))
+	assert.NoError(t, err, "the length is counted in characters")
+}
@@ -0,0 +1,57 @@
+// Package todo holds the todos of the application and the stores keeping them
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxTitleLength is the longest title accepted, in characters
+const MaxTitleLength = 200
+
+var (
+	// ErrNotFound is returned for a todo that does not exist
+	ErrNotFound = errors.New("todo not found")
+	// ErrEmptyTitle is returned for a title made of blanks only
+	ErrEmptyTitle = errors.New("the title is required")
+	// ErrTitleTooLong is returned for a title longer than MaxTitleLength
+	ErrTitleTooLong = fmt.Errorf("the title is longer than %d characters", MaxTitleLength)
+)
+
+// Todo is a task to be done
+type Todo struct {
+	ID        int64
+	Title     string
+	Done      bool
+	CreatedAt time.Time
+}
+
+// Store keeps the todos
+type Store interface {
+	// List returns the todos, oldest first
+	List(ctx context.Context) ([]Todo, error)
+	// Get returns the todo with id, or ErrNotFound
+	Get(ctx context.Context, id int64) (*Todo, error)
+	// Create stores a new todo, setting its ID and creation time
+	Create(ctx context.Context, todo *Todo) error
+	// Update saves the title and state of an existing todo, or returns ErrNotFound
+	Update(ctx context.Context, todo *Todo) error
+	// Delete removes the todo with id, or returns ErrNotFound
+	Delete(ctx context.Context, id int64) error
+}
+
+// NormalizeTitle trims title and checks that it is a valid todo title
+func NormalizeTitle(title string) (string, error) {
+	title = strings.TrimSpace(title)
+	switch {
+	case title == "":
+		return "", ErrEmptyTitle
+	case utf8.RuneCountInString(title) > MaxTitleLength:
+		return "", ErrTitleTooLong
+	}
+	return title, nil
+}
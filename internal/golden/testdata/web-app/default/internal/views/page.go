@@ -0,0 +1,67 @@
+// Package views renders the HTML of the application with templ components. The
+// *.templ files are compiled to *_templ.go by templ generate (make generate)
+package views
+
+//go:generate go run github.com/a-h/templ/cmd/templ@v0.3.924 generate
+
+import (
+	"strconv"
+
+	"github.com/a-h/templ"
+
+	"github.com/example/golden/internal/session"
+	"github.com/example/golden/static"
+)
+
+// htmxConfig turns off the indicator styles htmx would inject inline, which the
+// Content-Security-Policy forbids; static/css/app.css styles the indicators instead
+const htmxConfig = `{"includeIndicatorStyles":false}`
+
+// Page is what the layout needs from the request
+type Page struct {
+	Title     string
+	CSRFToken string
+	Flashes   []session.Flash
+	Assets    *static.Assets
+}
+
+// TodoForm is the state of a todo form: the submitted title and why it was rejected
+type TodoForm struct {
+	Title string
+	Error string
+}
+
+// todoPath returns the path of the todo with id, followed by suffix
+func todoPath(id int64, suffix string) string {
+	return "/todos/" + strconv.FormatInt(id, 10) + suffix
+}
+
+// todoElementID returns the id of the list item of the todo with id
+func todoElementID(id int64) string {
+	return "todo-" + strconv.FormatInt(id, 10)
+}
+
+// toggleLabel describes the button toggling a todo
+func toggleLabel(done bool) string {
+	if done {
+		return "Mark as not done"
+	}
+	return "Mark as done"
+}
+
+// checkbox is the symbol of the state of a todo
+func checkbox(done bool) string {
+	if done {
+		return "☑"
+	}
+	return "☐"
+}
+
+// swapOOB marks an element of an htmx response to be swapped out of band, into
+// the element with the same id, when oob is true
+func swapOOB(oob bool) templ.Attributes {
+	if oob {
+		return templ.Attributes{"hx-swap-oob": "true"}
+	}
+	return templ.Attributes{}
+}
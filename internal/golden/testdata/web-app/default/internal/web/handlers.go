@@ -0,0 +1,221 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/a-h/templ"
+
+	"github.com/example/golden/internal/session"
+	"github.com/example/golden/internal/todo"
+	"github.com/example/golden/internal/views"
+)
+
+// index shows the todo list
+func (s *Server) index(w http.ResponseWriter, r *http.Request) {
+	s.renderIndex(w, r, http.StatusOK, views.TodoForm{}, 0)
+}
+
+// createTodo adds a todo
+func (s *Server) createTodo(w http.ResponseWriter, r *http.Request) {
+	title, err := todo.NormalizeTitle(r.PostFormValue("title"))
+	if err != nil {
+		form := views.TodoForm{Title: r.PostFormValue("title"), Error: err.Error()}
+		if isHTMX(r) {
+			// Show the error in the form instead of appending to the list
+			w.Header().Set("HX-Retarget", "#new-todo")
+			w.Header().Set("HX-Reswap", "outerHTML")
+			s.render(w, r, http.StatusOK, views.NewTodoForm(csrfToken(r), form, false))
+			return
+		}
+		s.renderIndex(w, r, http.StatusUnprocessableEntity, form, 0)
+		return
+	}
+
+	t := &todo.Todo{Title: title}
+	if err := s.todos.Create(r.Context(), t); err != nil {
+		s.fail(w, r, err)
+		return
+	}
+	if isHTMX(r) {
+		s.render(w, r, http.StatusOK, views.TodoCreated(csrfToken(r), *t))
+		return
+	}
+	redirect(w, r, "/", session.FlashSuccess, "Todo added")
+}
+
+// showTodo shows a todo of the list, such as when its edit is cancelled
+func (s *Server) showTodo(w http.ResponseWriter, r *http.Request) {
+	t, err := s.todo(r)
+	if err != nil {
+		s.fail(w, r, err)
+		return
+	}
+	if !isHTMX(r) {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+	s.render(w, r, http.StatusOK, views.TodoItem(csrfToken(r), *t))
+}
+
+// editTodo shows the form editing a todo
+func (s *Server) editTodo(w http.ResponseWriter, r *http.Request) {
+	t, err := s.todo(r)
+	if err != nil {
+		s.fail(w, r, err)
+		return
+	}
+	if !isHTMX(r) {
+		s.renderIndex(w, r, http.StatusOK, views.TodoForm{}, t.ID)
+		return
+	}
+	s.render(w, r, http.StatusOK, views.TodoEdit(csrfToken(r), *t, views.TodoForm{Title: t.Title}))
+}
+
+// updateTodo saves the title of a todo
+func (s *Server) updateTodo(w http.ResponseWriter, r *http.Request) {
+	t, err := s.todo(r)
+	if err != nil {
+		s.fail(w, r, err)
+		return
+	}
+
+	title, err := todo.NormalizeTitle(r.PostFormValue("title"))
+	if err != nil {
+		if isHTMX(r) {
+			form := views.TodoForm{Title: r.PostFormValue("title"), Error: err.Error()}
+			s.render(w, r, http.StatusOK, views.TodoEdit(csrfToken(r), *t, form))
+			return
+		}
+		redirect(w, r, "/todos/"+strconv.FormatInt(t.ID, 10)+"/edit", session.FlashError, "Not saved: "+err.Error())
+		return
+	}
+
+	t.Title = title
+	if err := s.todos.Update(r.Context(), t); err != nil {
+		s.fail(w, r, err)
+		return
+	}
+	if isHTMX(r) {
+		s.render(w, r, http.StatusOK, views.TodoItem(csrfToken(r), *t))
+		return
+	}
+	redirect(w, r, "/", session.FlashSuccess, "Todo updated")
+}
+
+// toggleTodo marks a todo as done, or as not done
+func (s *Server) toggleTodo(w http.ResponseWriter, r *http.Request) {
+	t, err := s.todo(r)
+	if err != nil {
+		s.fail(w, r, err)
+		return
+	}
+
+	t.Done = !t.Done
+	if err := s.todos.Update(r.Context(), t); err != nil {
+		s.fail(w, r, err)
+		return
+	}
+	if isHTMX(r) {
+		s.render(w, r, http.StatusOK, views.TodoItem(csrfToken(r), *t))
+		return
+	}
+	http.Redirect(w, r, "/", http.StatusSeeOther)
+}
+
+// deleteTodo removes a todo
+func (s *Server) deleteTodo(w http.ResponseWriter, r *http.Request) {
+	id, err := todoID(r)
+	if err == nil {
+		err = s.todos.Delete(r.Context(), id)
+	}
+	if err != nil {
+		s.fail(w, r, err)
+		return
+	}
+	if isHTMX(r) {
+		// An empty answer swapped into the list item removes it
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	redirect(w, r, "/", session.FlashSuccess, "Todo deleted")
+}
+
+// renderIndex renders the todo list page with form as the state of the new todo form
+func (s *Server) renderIndex(w http.ResponseWriter, r *http.Request, status int, form views.TodoForm, editing int64) {
+	todos, err := s.todos.List(r.Context())
+	if err != nil {
+		s.fail(w, r, err)
+		return
+	}
+	sess := session.FromContext(r.Context())
+	page := views.Page{
+		Title:     "Todos",
+		CSRFToken: sess.CSRFToken(),
+		Flashes:   sess.Flashes(),
+		Assets:    s.assets,
+	}
+	s.render(w, r, status, views.Index(page, todos, form, editing))
+}
+
+// render writes component as the HTML answer
+func (s *Server) render(w http.ResponseWriter, r *http.Request, status int, component templ.Component) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	// Pages and their fragments share URLs, caches must tell them apart
+	w.Header().Add("Vary", "HX-Request")
+	w.WriteHeader(status)
+	if err := component.Render(r.Context(), w); err != nil {
+		s.log.Error("Failed to render the page", "path", r.URL.Path, "error", err)
+	}
+}
+
+// fail answers a request that failed with err. Forms posted without htmx go back
+// to the list with the error as a flash message; other requests get an error status
+func (s *Server) fail(w http.ResponseWriter, r *http.Request, err error) {
+	status, message := http.StatusNotFound, "That todo does not exist anymore."
+	if !errors.Is(err, todo.ErrNotFound) {
+		s.log.Error("Request failed", "method", r.Method, "path", r.URL.Path, "error", err)
+		status, message = http.StatusInternalServerError, "Something went wrong, please try again."
+	}
+
+	if r.Method == http.MethodPost && !isHTMX(r) {
+		redirect(w, r, "/", session.FlashError, message)
+		return
+	}
+	http.Error(w, message, status)
+}
+
+// todo returns the todo of the id path parameter
+func (s *Server) todo(r *http.Request) (*todo.Todo, error) {
+	id, err := todoID(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.todos.Get(r.Context(), id)
+}
+
+// todoID parses the id path parameter; an invalid id is a todo that does not exist
+func todoID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		return 0, todo.ErrNotFound
+	}
+	return id, nil
+}
+
+// redirect sends the browser to path, showing message there
+func redirect(w http.ResponseWriter, r *http.Request, path, kind, message string) {
+	session.FromContext(r.Context()).AddFlash(kind, message)
+	http.Redirect(w, r, path, http.StatusSeeOther)
+}
+
+// csrfToken returns the CSRF token of the session, for the forms of fragments
+func csrfToken(r *http.Request) string {
+	return session.FromContext(r.Context()).CSRFToken()
+}
+
+// isHTMX reports whether r was sent by htmx, which expects a fragment of the page
+func isHTMX(r *http.Request) bool {
+	return r.Header.Get("HX-Request") == "true"
+}
@@ -0,0 +1,213 @@
+package web
+
+import (
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/session"
+	"github.com/example/golden/internal/todo"
+	"github.com/example/golden/static"
+)
+
+var csrfMeta = regexp.MustCompile(`<meta name="csrf-token" content="([^"]+)"`)
+
+// browser is a visitor of a test server, keeping its cookies and CSRF token
+type browser struct {
+	t      *testing.T
+	server *httptest.Server
+	client *http.Client
+	token  string
+}
+
+func newBrowser(t *testing.T) *browser {
+	t.Helper()
+
+	assets, err := static.New()
+	require.NoError(t, err)
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: "error"}, io.Discard)
+	require.NoError(t, err)
+	sessions := session.NewManager("a-test-secret-of-at-least-32-characters", time.Hour, false)
+
+	server := httptest.NewServer(NewServer(todo.NewMemoryStore(), sessions, assets, log).Handler())
+	t.Cleanup(server.Close)
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+
+	b := &browser{t: t, server: server, client: &http.Client{Jar: jar}}
+	_, body := b.get("/", false)
+	match := csrfMeta.FindStringSubmatch(body)
+	require.NotNil(t, match, "the page carries the CSRF token")
+	b.token = match[1]
+	return b
+}
+
+// send sends a request with form as its body; htmx requests carry the token in a
+// header like app.js does, others in the form like the csrf_token field
+func (b *browser) send(method, path string, form url.Values, htmx bool) (*http.Response, string) {
+	b.t.Helper()
+	if form == nil {
+		form = url.Values{}
+	}
+	if !htmx && method != http.MethodGet {
+		form.Set(session.CSRFField, b.token)
+	}
+
+	req, err := http.NewRequest(method, b.server.URL+path, strings.NewReader(form.Encode()))
+	require.NoError(b.t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if htmx {
+		req.Header.Set("HX-Request", "true")
+		req.Header.Set(session.CSRFHeader, b.token)
+	}
+
+	resp, err := b.client.Do(req)
+	require.NoError(b.t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(b.t, err)
+	return resp, string(body)
+}
+
+func (b *browser) get(path string, htmx bool) (*http.Response, string) {
+	b.t.Helper()
+	return b.send(http.MethodGet, path, nil, htmx)
+}
+
+func (b *browser) addTodo(title string) {
+	b.t.Helper()
+	resp, _ := b.send(http.MethodPost, "/todos", url.Values{"title": {title}}, true)
+	require.Equal(b.t, http.StatusOK, resp.StatusCode)
+}
+
+func TestIndex(t *testing.T) {
+	b := newBrowser(t)
+
+	resp, body := b.get("/", false)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, "Nothing to do yet.")
+	assert.Regexp(t, `href="/static/css/app\.css\?v=[0-9a-f]+"`, body)
+	assert.NotEmpty(t, resp.Header.Get("Content-Security-Policy"))
+
+	resp, _ = b.get("/missing", false)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestCreateTodo_HTMX(t *testing.T) {
+	b := newBrowser(t)
+
+	resp, body := b.send(http.MethodPost, "/todos", url.Values{"title": {"  Write the docs "}}, true)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, `<li id="todo-1"`)
+	assert.Contains(t, body, "Write the docs")
+	assert.Contains(t, body, `hx-swap-oob="true"`, "the form is reset")
+
+	_, page := b.get("/", false)
+	assert.Contains(t, page, "Write the docs")
+}
+
+func TestCreateTodo_Form(t *testing.T) {
+	b := newBrowser(t)
+
+	// The client follows the redirect to the list, which shows the flash once
+	resp, body := b.send(http.MethodPost, "/todos", url.Values{"title": {"Ship it"}}, false)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "/", resp.Request.URL.Path)
+	assert.Contains(t, body, "Todo added")
+	assert.Contains(t, body, "Ship it")
+
+	_, body = b.get("/", false)
+	assert.NotContains(t, body, "Todo added")
+}
+
+func TestCreateTodo_Invalid(t *testing.T) {
+	b := newBrowser(t)
+
+	resp, body := b.send(http.MethodPost, "/todos", url.Values{"title": {"   "}}, true)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "#new-todo", resp.Header.Get("HX-Retarget"))
+	assert.Contains(t, body, todo.ErrEmptyTitle.Error())
+
+	resp, body = b.send(http.MethodPost, "/todos", url.Values{"title": {"   "}}, false)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	assert.Contains(t, body, todo.ErrEmptyTitle.Error())
+}
+
+func TestCSRF(t *testing.T) {
+	b := newBrowser(t)
+	b.token = "forged"
+
+	resp, _ := b.send(http.MethodPost, "/todos", url.Values{"title": {"Sneaky"}}, true)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp, _ = b.send(http.MethodPost, "/todos", url.Values{"title": {"Sneaky"}}, false)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestTodoActions_HTMX(t *testing.T) {
+	b := newBrowser(t)
+	b.addTodo("Buy milk")
+
+	_, body := b.send(http.MethodPost, "/todos/1/toggle", nil, true)
+	assert.Contains(t, body, `class="done"`)
+
+	_, body = b.get("/todos/1/edit", true)
+	assert.Contains(t, body, `hx-put="/todos/1"`)
+
+	resp, body := b.send(http.MethodPut, "/todos/1", url.Values{"title": {""}}, true)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, todo.ErrEmptyTitle.Error(), "the edit form shows the error")
+
+	_, body = b.send(http.MethodPut, "/todos/1", url.Values{"title": {"Buy oat milk"}}, true)
+	assert.Contains(t, body, "Buy oat milk")
+	assert.Contains(t, body, `class="done"`, "editing keeps the state")
+
+	resp, body = b.send(http.MethodDelete, "/todos/1", nil, true)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, body)
+
+	resp, _ = b.send(http.MethodDelete, "/todos/1", nil, true)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp, _ = b.get("/todos/nope/edit", true)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestTodoActions_Form(t *testing.T) {
+	b := newBrowser(t)
+	b.addTodo("Buy milk")
+
+	_, body := b.get("/todos/1/edit", false)
+	assert.Contains(t, body, `action="/todos/1"`, "the page shows the edit form")
+
+	_, body = b.send(http.MethodPost, "/todos/1", url.Values{"title": {"Buy oat milk"}}, false)
+	assert.Contains(t, body, "Todo updated")
+	assert.Contains(t, body, "Buy oat milk")
+
+	_, body = b.send(http.MethodPost, "/todos/1/delete", nil, false)
+	assert.Contains(t, body, "Todo deleted")
+	assert.NotContains(t, body, "Buy oat milk")
+
+	resp, body := b.send(http.MethodPost, "/todos/1/delete", nil, false)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, "does not exist anymore")
+}
+
+func TestStaticAndHealth(t *testing.T) {
+	b := newBrowser(t)
+
+	resp, _ := b.get("/healthz", false)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, body := b.get("/static/js/app.js", false)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, "htmx:configRequest")
+}
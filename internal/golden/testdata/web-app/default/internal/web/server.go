@@ -0,0 +1,51 @@
+// Package web serves the pages of the application. Every page works with plain
+// links and forms; with htmx loaded, the same routes answer with the fragment of
+// the page that changed
+package web
+
+import (
+	"net/http"
+
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/session"
+	"github.com/example/golden/internal/todo"
+	"github.com/example/golden/static"
+)
+
+// Server holds what the handlers need
+type Server struct {
+	todos    todo.Store
+	sessions *session.Manager
+	assets   *static.Assets
+	log      logger.Logger
+}
+
+// NewServer creates a server of the todos of store
+func NewServer(store todo.Store, sessions *session.Manager, assets *static.Assets, log logger.Logger) *Server {
+	return &Server{todos: store, sessions: sessions, assets: assets, log: log}
+}
+
+// Handler returns the handler of all the routes. The static assets and the health
+// check are served without a session
+func (s *Server) Handler() http.Handler {
+	pages := http.NewServeMux()
+	pages.HandleFunc("GET /{$}", s.index)
+	pages.HandleFunc("POST /todos", s.createTodo)
+	pages.HandleFunc("GET /todos/{id}", s.showTodo)
+	pages.HandleFunc("GET /todos/{id}/edit", s.editTodo)
+	// htmx sends PUT and DELETE, HTML forms can only POST
+	pages.HandleFunc("PUT /todos/{id}", s.updateTodo)
+	pages.HandleFunc("POST /todos/{id}", s.updateTodo)
+	pages.HandleFunc("POST /todos/{id}/toggle", s.toggleTodo)
+	pages.HandleFunc("DELETE /todos/{id}", s.deleteTodo)
+	pages.HandleFunc("POST /todos/{id}/delete", s.deleteTodo)
+
+	mux := http.NewServeMux()
+	mux.Handle("GET "+static.Prefix, s.assets.Handler())
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.Handle("/", s.sessions.Middleware(pages))
+
+	return recoverPanics(s.log, logRequests(s.log, securityHeaders(mux)))
+}
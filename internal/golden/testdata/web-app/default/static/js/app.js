@@ -0,0 +1,22 @@
+// Sends the CSRF token of the session with every htmx request; plain forms carry
+// it in a hidden field instead
+document.addEventListener("htmx:configRequest", (event) => {
+  const token = document.querySelector('meta[name="csrf-token"]');
+  if (token) {
+    event.detail.headers["X-CSRF-Token"] = token.content;
+  }
+});
+
+// Moves the focus to the field of an inline edit form as soon as it shows up
+document.addEventListener("htmx:afterSwap", (event) => {
+  const field = event.detail.elt.querySelector("[data-autofocus]");
+  if (field) {
+    field.focus();
+    field.select();
+  }
+});
+
+// htmx leaves the page as is when a request fails, so tell why
+document.addEventListener("htmx:responseError", (event) => {
+  window.alert(event.detail.xhr.responseText || "Something went wrong, please try again.");
+});
@@ -0,0 +1,83 @@
+// Package static embeds the stylesheets and scripts of the application in its
+// binary and serves them with a content hash in their URL, so that browsers cache
+// them for a year and still pick up every change
+package static
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// Prefix is the URL path the assets are served under
+const Prefix = "/static/"
+
+//go:embed css js
+var files embed.FS
+
+// Assets serves the embedded files and builds their versioned URLs
+type Assets struct {
+	files    fs.FS
+	versions map[string]string
+}
+
+// New hashes the embedded files
+func New() (*Assets, error) {
+	return NewFromFS(files)
+}
+
+// NewFromFS hashes the files of fsys, such as an os.DirFS of this directory to
+// serve the assets from disk while editing them
+func NewFromFS(fsys fs.FS) (*Assets, error) {
+	versions := make(map[string]string)
+	err := fs.WalkDir(fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(content)
+		versions[name] = hex.EncodeToString(sum[:])[:12]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Assets{files: fsys, versions: versions}, nil
+}
+
+// Path returns the URL of the asset name, such as css/app.css, with the hash of
+// its content; unknown assets get an unversioned URL
+func (a *Assets) Path(name string) string {
+	version, ok := a.versions[name]
+	if !ok {
+		return path.Join(Prefix, name)
+	}
+	return path.Join(Prefix, name) + "?v=" + version
+}
+
+// Handler serves the assets under Prefix. A URL with the current hash is cached
+// forever; any other URL must be revalidated
+func (a *Assets) Handler() http.Handler {
+	fileServer := http.StripPrefix(Prefix, http.FileServerFS(a.files))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, Prefix)
+		if name == "" || strings.HasSuffix(name, "/") {
+			// No directory listings
+			http.NotFound(w, r)
+			return
+		}
+		if version := r.URL.Query().Get("v"); version != "" && version == a.versions[name] {
+			w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		} else {
+			w.Header().Set("Cache-Control", "no-cache")
+		}
+		fileServer.ServeHTTP(w, r)
+	})
+}
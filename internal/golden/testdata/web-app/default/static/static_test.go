@@ -0,0 +1,52 @@
+package static
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_EmbedsTheAssets(t *testing.T) {
+	assets, err := New()
+	require.NoError(t, err)
+	assert.Regexp(t, `^/static/css/app\.css\?v=[0-9a-f]{12}$`, assets.Path("css/app.css"))
+	assert.Regexp(t, `^/static/js/app\.js\?v=[0-9a-f]{12}$`, assets.Path("js/app.js"))
+}
+
+func TestAssets_Path(t *testing.T) {
+	assets, err := NewFromFS(fstest.MapFS{"css/app.css": {Data: []byte("body{}")}})
+	require.NoError(t, err)
+	other, err := NewFromFS(fstest.MapFS{"css/app.css": {Data: []byte("body{color:red}")}})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, assets.Path("css/app.css"), other.Path("css/app.css"), "the URL changes with the content")
+	assert.Equal(t, "/static/missing.js", assets.Path("missing.js"))
+}
+
+func TestAssets_Handler(t *testing.T) {
+	assets, err := NewFromFS(fstest.MapFS{"css/app.css": {Data: []byte("body{}")}})
+	require.NoError(t, err)
+
+	serve := func(target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		assets.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		return rec
+	}
+
+	rec := serve(assets.Path("css/app.css"))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "body{}", rec.Body.String())
+	assert.Contains(t, rec.Header().Get("Cache-Control"), "immutable")
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/css")
+
+	rec = serve("/static/css/app.css?v=outdated")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "no-cache", rec.Header().Get("Cache-Control"))
+
+	assert.Equal(t, http.StatusNotFound, serve("/static/css/").Code)
+	assert.Equal(t, http.StatusNotFound, serve("/static/missing.js").Code)
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"go.temporal.io/sdk/client"
+
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/orders"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "starter: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run starts a ProcessOrder workflow and prints its receipt
+func run() error {
+	orderID := flag.String("order", "1001", "Order ID, the workflow ID is order-<ID>")
+	customerID := flag.String("customer", "customer-1", "Customer charged for the order")
+	sku := flag.String("sku", "book", "SKU ordered")
+	quantity := flag.Int("quantity", 1, "Quantity ordered")
+	amount := flag.Int64("amount", 1999, "Amount charged, in cents; the demo payments decline more than 100000")
+	wait := flag.Bool("wait", true, "Wait for the workflow to complete")
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	log, err := logger.NewFactory().Create(cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	c, err := client.Dial(client.Options{
+		HostPort:  cfg.TemporalAddress,
+		Namespace: cfg.Namespace,
+		Logger:    log,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to connect to Temporal at %s: %w", cfg.TemporalAddress, err)
+	}
+	defer c.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	order := orders.Order{
+		ID:          *orderID,
+		CustomerID:  *customerID,
+		Items:       []orders.Item{{SKU: *sku, Quantity: *quantity}},
+		AmountCents: *amount,
+	}
+	run, err := c.ExecuteWorkflow(ctx, orders.WorkflowOptions(cfg.TaskQueue, order.ID), orders.ProcessOrder, order)
+	if err != nil {
+		return fmt.Errorf("failed to start the workflow: %w", err)
+	}
+	log.Info("Workflow started", "workflow_id", run.GetID(), "run_id", run.GetRunID())
+	if !*wait {
+		return nil
+	}
+
+	var receipt orders.Receipt
+	if err := run.Get(ctx, &receipt); err != nil {
+		return fmt.Errorf("workflow %s failed: %w", run.GetID(), err)
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(receipt)
+}
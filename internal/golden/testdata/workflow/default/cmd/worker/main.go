@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+
+	"github.com/example/golden/internal/config"
+	"github.com/example/golden/internal/logger"
+	"github.com/example/golden/internal/orders"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "golden: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run polls the task queue until SIGINT or SIGTERM
+func run() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	log, err := logger.NewFactory().Create(cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+	log = log.With("service", "golden")
+
+	// The SDK, the workflows and the activities log through the same logger
+	c, err := client.Dial(client.Options{
+		HostPort:  cfg.TemporalAddress,
+		Namespace: cfg.Namespace,
+		Logger:    log,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to connect to Temporal at %s: %w", cfg.TemporalAddress, err)
+	}
+	defer c.Close()
+
+	w := worker.New(c, cfg.TaskQueue, worker.Options{
+		MaxConcurrentActivityExecutionSize: cfg.MaxConcurrentActivities,
+		WorkerStopTimeout:                  cfg.ShutdownTimeout,
+	})
+
+	// The in-memory inventory and payments are for development, replace them
+	// with clients of your services
+	activities := orders.NewActivities(
+		orders.NewMemoryInventory(map[string]int{"book": 100, "pen": 500}),
+		orders.NewDemoPayments(100000),
+	)
+	orders.Register(w, activities)
+
+	log.Info("Worker starting", "address", cfg.TemporalAddress, "namespace", cfg.Namespace, "task_queue", cfg.TaskQueue, "environment", cfg.Environment)
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		return fmt.Errorf("worker stopped: %w", err)
+	}
+	log.Info("Worker stopped")
+	return nil
+}
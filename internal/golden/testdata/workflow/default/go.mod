@@ -0,0 +1,8 @@
+module github.com/example/golden
+
+go 1.23
+
+require (
+	github.com/stretchr/testify v1.10.0
+	go.temporal.io/sdk v1.33.0
+)
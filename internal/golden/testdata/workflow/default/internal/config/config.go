@@ -0,0 +1,77 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config holds the settings of the worker and the starter, read from the environment
+type Config struct {
+	// Environment is development or production (APP_ENV)
+	Environment string
+	// LogLevel is one of debug, info, warn or error (LOG_LEVEL)
+	LogLevel string
+	// LogFormat is json or console (LOG_FORMAT)
+	LogFormat string
+
+	// TemporalAddress is the host:port of the Temporal frontend (TEMPORAL_ADDRESS)
+	TemporalAddress string
+	// Namespace the workflows run in (TEMPORAL_NAMESPACE)
+	Namespace string
+	// TaskQueue the worker polls and the starter starts workflows on (TASK_QUEUE)
+	TaskQueue string
+
+	// MaxConcurrentActivities caps the activities run at once by the worker,
+	// 0 keeps the SDK default (MAX_CONCURRENT_ACTIVITIES)
+	MaxConcurrentActivities int
+	// ShutdownTimeout is how long running activities get to finish when the
+	// worker stops (SHUTDOWN_TIMEOUT)
+	ShutdownTimeout time.Duration
+}
+
+// Load reads the configuration from the environment, applying defaults
+func Load() (*Config, error) {
+	cfg := &Config{
+		Environment:     getEnv("APP_ENV", "development"),
+		LogLevel:        getEnv("LOG_LEVEL", "info"),
+		LogFormat:       getEnv("LOG_FORMAT", "json"),
+		TemporalAddress: getEnv("TEMPORAL_ADDRESS", "localhost:7233"),
+		Namespace:       getEnv("TEMPORAL_NAMESPACE", "default"),
+		TaskQueue:       getEnv("TASK_QUEUE", "golden"),
+		ShutdownTimeout: 30 * time.Second,
+	}
+
+	if cfg.Environment != "development" && cfg.Environment != "production" {
+		return nil, fmt.Errorf("invalid APP_ENV %q: must be development or production", cfg.Environment)
+	}
+
+	if value := os.Getenv("MAX_CONCURRENT_ACTIVITIES"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid MAX_CONCURRENT_ACTIVITIES %q: must be a positive number", value)
+		}
+		cfg.MaxConcurrentActivities = n
+	}
+	if value := os.Getenv("SHUTDOWN_TIMEOUT"); value != "" {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SHUTDOWN_TIMEOUT %q: %w", value, err)
+		}
+		cfg.ShutdownTimeout = d
+	}
+	return cfg, nil
+}
+
+// IsProduction reports whether the service runs in production
+func (c *Config) IsProduction() bool {
+	return c.Environment == "production"
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
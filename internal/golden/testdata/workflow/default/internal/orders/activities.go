@@ -0,0 +1,78 @@
+package orders
+
+import (
+	"context"
+	"errors"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+)
+
+// Inventory reserves and releases stock
+type Inventory interface {
+	// Reserve reserves the items of an order and returns the reservation ID, or
+	// ErrOutOfStock. Reserving an order again returns the same reservation
+	Reserve(ctx context.Context, orderID string, items []Item) (string, error)
+	// Release cancels a reservation. Releasing an unknown reservation is not an error
+	Release(ctx context.Context, reservationID string) error
+}
+
+// Payments charges customers
+type Payments interface {
+	// Charge charges an amount and returns the charge ID, or ErrPaymentDeclined.
+	// Charges with the same idempotency key are made once
+	Charge(ctx context.Context, customerID string, amountCents int64, idempotencyKey string) (string, error)
+}
+
+// Activities holds the dependencies of the activities of ProcessOrder. Every
+// exported method is registered as an activity named after it
+type Activities struct {
+	inventory Inventory
+	payments  Payments
+}
+
+// NewActivities creates the activities of ProcessOrder
+func NewActivities(inventory Inventory, payments Payments) *Activities {
+	return &Activities{inventory: inventory, payments: payments}
+}
+
+// ReserveInventory reserves the items of an order
+func (a *Activities) ReserveInventory(ctx context.Context, order Order) (string, error) {
+	reservationID, err := a.inventory.Reserve(ctx, order.ID, order.Items)
+	if errors.Is(err, ErrOutOfStock) {
+		return "", temporal.NewApplicationErrorWithCause(err.Error(), ErrTypeOutOfStock, err)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	activity.GetLogger(ctx).Info("Inventory reserved", "order_id", order.ID, "reservation_id", reservationID)
+	return reservationID, nil
+}
+
+// ChargePayment charges the amount of an order. The idempotency key is the
+// workflow ID, so that retries of the activity charge the customer once
+func (a *Activities) ChargePayment(ctx context.Context, order Order) (string, error) {
+	info := activity.GetInfo(ctx)
+	chargeID, err := a.payments.Charge(ctx, order.CustomerID, order.AmountCents, info.WorkflowExecution.ID)
+	if errors.Is(err, ErrPaymentDeclined) {
+		return "", temporal.NewApplicationErrorWithCause(err.Error(), ErrTypePaymentDeclined, err)
+	}
+	if err != nil {
+		activity.GetLogger(ctx).Warn("Charge failed, the attempt will be retried", "order_id", order.ID, "attempt", info.Attempt, "error", err)
+		return "", err
+	}
+
+	activity.GetLogger(ctx).Info("Payment charged", "order_id", order.ID, "charge_id", chargeID)
+	return chargeID, nil
+}
+
+// ReleaseInventory releases a reservation, compensating ReserveInventory
+func (a *Activities) ReleaseInventory(ctx context.Context, reservationID string) error {
+	if err := a.inventory.Release(ctx, reservationID); err != nil {
+		return err
+	}
+
+	activity.GetLogger(ctx).Info("Inventory released", "reservation_id", reservationID)
+	return nil
+}
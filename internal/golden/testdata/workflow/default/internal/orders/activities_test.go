@@ -0,0 +1,69 @@
+package orders
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func newActivityEnvironment(activities *Activities) *testsuite.TestActivityEnvironment {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	env.RegisterActivity(activities)
+	return env
+}
+
+func TestActivities_ReserveInventory(t *testing.T) {
+	inventory := NewMemoryInventory(map[string]int{"book": 2, "pen": 1})
+	activities := NewActivities(inventory, NewDemoPayments(10000))
+	env := newActivityEnvironment(activities)
+
+	t.Run("reserves every item or none", func(t *testing.T) {
+		order := Order{ID: "1", Items: []Item{{SKU: "book", Quantity: 1}, {SKU: "pen", Quantity: 2}}}
+		_, err := env.ExecuteActivity(activities.ReserveInventory, order)
+
+		var appErr *temporal.ApplicationError
+		require.True(t, errors.As(err, &appErr))
+		assert.Equal(t, ErrTypeOutOfStock, appErr.Type())
+		assert.Equal(t, 2, inventory.Stock("book"))
+	})
+
+	t.Run("reserving twice keeps one reservation", func(t *testing.T) {
+		order := Order{ID: "2", Items: []Item{{SKU: "book", Quantity: 1}}}
+		for i := 0; i < 2; i++ {
+			result, err := env.ExecuteActivity(activities.ReserveInventory, order)
+			require.NoError(t, err)
+
+			var reservationID string
+			require.NoError(t, result.Get(&reservationID))
+			assert.Equal(t, "res-2", reservationID)
+		}
+		assert.Equal(t, 1, inventory.Stock("book"))
+
+		_, err := env.ExecuteActivity(activities.ReleaseInventory, "res-2")
+		require.NoError(t, err)
+		assert.Equal(t, 2, inventory.Stock("book"))
+	})
+}
+
+func TestActivities_ChargePayment(t *testing.T) {
+	activities := NewActivities(NewMemoryInventory(nil), NewDemoPayments(1000))
+	env := newActivityEnvironment(activities)
+
+	_, err := env.ExecuteActivity(activities.ChargePayment, Order{ID: "1", CustomerID: "customer-1", AmountCents: 5000})
+
+	var appErr *temporal.ApplicationError
+	require.True(t, errors.As(err, &appErr))
+	assert.Equal(t, ErrTypePaymentDeclined, appErr.Type())
+	assert.Contains(t, PaymentActivityOptions().RetryPolicy.NonRetryableErrorTypes, appErr.Type())
+
+	result, err := env.ExecuteActivity(activities.ChargePayment, Order{ID: "2", CustomerID: "customer-1", AmountCents: 500})
+	require.NoError(t, err)
+	var chargeID string
+	require.NoError(t, result.Get(&chargeID))
+	assert.NotEmpty(t, chargeID)
+}
@@ -0,0 +1,95 @@
+package orders
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// MemoryInventory is an Inventory keeping the stock in memory, for development.
+// Replace it with your inventory service
+type MemoryInventory struct {
+	mu           sync.Mutex
+	stock        map[string]int
+	reservations map[string][]Item
+}
+
+// NewMemoryInventory creates an inventory holding stock, by SKU
+func NewMemoryInventory(stock map[string]int) *MemoryInventory {
+	copied := make(map[string]int, len(stock))
+	for sku, quantity := range stock {
+		copied[sku] = quantity
+	}
+	return &MemoryInventory{stock: copied, reservations: make(map[string][]Item)}
+}
+
+// Reserve reserves all the items or none
+func (i *MemoryInventory) Reserve(ctx context.Context, orderID string, items []Item) (string, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	reservationID := "res-" + orderID
+	if _, ok := i.reservations[reservationID]; ok {
+		return reservationID, nil
+	}
+
+	for _, item := range items {
+		if i.stock[item.SKU] < item.Quantity {
+			return "", fmt.Errorf("%w: %s has %d left, %d ordered", ErrOutOfStock, item.SKU, i.stock[item.SKU], item.Quantity)
+		}
+	}
+	for _, item := range items {
+		i.stock[item.SKU] -= item.Quantity
+	}
+	i.reservations[reservationID] = items
+	return reservationID, nil
+}
+
+// Release puts the items of a reservation back in stock
+func (i *MemoryInventory) Release(ctx context.Context, reservationID string) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, item := range i.reservations[reservationID] {
+		i.stock[item.SKU] += item.Quantity
+	}
+	delete(i.reservations, reservationID)
+	return nil
+}
+
+// Stock returns the quantity left of a SKU
+func (i *MemoryInventory) Stock(sku string) int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.stock[sku]
+}
+
+// DemoPayments is a Payments declining the charges above a limit, for
+// development. Replace it with your payment provider
+type DemoPayments struct {
+	mu         sync.Mutex
+	limitCents int64
+	charges    map[string]string
+}
+
+// NewDemoPayments creates a payment provider declining charges above limitCents
+func NewDemoPayments(limitCents int64) *DemoPayments {
+	return &DemoPayments{limitCents: limitCents, charges: make(map[string]string)}
+}
+
+// Charge records a charge once per idempotency key
+func (p *DemoPayments) Charge(ctx context.Context, customerID string, amountCents int64, idempotencyKey string) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if chargeID, ok := p.charges[idempotencyKey]; ok {
+		return chargeID, nil
+	}
+	if amountCents > p.limitCents {
+		return "", fmt.Errorf("%w: %d cents is above the limit of %d", ErrPaymentDeclined, amountCents, p.limitCents)
+	}
+
+	chargeID := fmt.Sprintf("ch-%d", len(p.charges)+1)
+	p.charges[idempotencyKey] = chargeID
+	return chargeID, nil
+}
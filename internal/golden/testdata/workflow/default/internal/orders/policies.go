@@ -0,0 +1,55 @@
+package orders
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// WorkflowOptions returns the options ProcessOrder is started with. The workflow
+// ID is derived from the order, so that an order cannot be processed twice at once
+func WorkflowOptions(taskQueue, orderID string) client.StartWorkflowOptions {
+	return client.StartWorkflowOptions{
+		ID:        "order-" + orderID,
+		TaskQueue: taskQueue,
+		// Bounds the whole workflow, retries of the activities included
+		WorkflowExecutionTimeout: time.Hour,
+	}
+}
+
+// InventoryActivityOptions returns the options of the inventory activities.
+// Reservations are cheap and idempotent, so they are retried until the
+// schedule-to-close timeout, except when the stock is missing
+func InventoryActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		// A single attempt, the process running it may die at any time
+		StartToCloseTimeout: 10 * time.Second,
+		// All attempts together
+		ScheduleToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:        time.Second,
+			BackoffCoefficient:     2,
+			MaximumInterval:        30 * time.Second,
+			NonRetryableErrorTypes: []string{ErrTypeInvalidOrder, ErrTypeOutOfStock},
+		},
+	}
+}
+
+// PaymentActivityOptions returns the options of ChargePayment. Charges go to an
+// external provider: attempts are fewer and spaced out, and a declined payment is
+// final
+func PaymentActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		StartToCloseTimeout:    30 * time.Second,
+		ScheduleToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:        5 * time.Second,
+			BackoffCoefficient:     2,
+			MaximumInterval:        2 * time.Minute,
+			MaximumAttempts:        5,
+			NonRetryableErrorTypes: []string{ErrTypeInvalidOrder, ErrTypePaymentDeclined},
+		},
+	}
+}
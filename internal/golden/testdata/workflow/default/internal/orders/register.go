@@ -0,0 +1,11 @@
+package orders
+
+import (
+	"go.temporal.io/sdk/worker"
+)
+
+// Register registers ProcessOrder and its activities with a worker
+func Register(w worker.Registry, activities *Activities) {
+	w.RegisterWorkflow(ProcessOrder)
+	w.RegisterActivity(activities)
+}
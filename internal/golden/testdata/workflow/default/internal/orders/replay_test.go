@@ -0,0 +1,34 @@
+package orders
+
+import (
+	"io"
+	"log/slog"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/worker"
+)
+
+// TestProcessOrder_Replay replays the histories recorded in testdata/histories
+// against the current workflow code. It fails when a change would break the
+// workflows already running, such as reordering or removing an activity without
+// workflow.GetVersion
+func TestProcessOrder_Replay(t *testing.T) {
+	histories, err := filepath.Glob(filepath.Join("testdata", "histories", "*.json"))
+	require.NoError(t, err)
+	if len(histories) == 0 {
+		t.Skip("no recorded histories in testdata/histories, record one with make record-history")
+	}
+
+	replayer := worker.NewWorkflowReplayer()
+	replayer.RegisterWorkflow(ProcessOrder)
+	logger := log.NewStructuredLogger(slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	for _, history := range histories {
+		t.Run(filepath.Base(history), func(t *testing.T) {
+			require.NoError(t, replayer.ReplayWorkflowHistoryFromJSONFile(logger, history))
+		})
+	}
+}
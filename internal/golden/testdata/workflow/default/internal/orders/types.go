@@ -0,0 +1,63 @@
+package orders
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Error types of the application errors returned by the activities. The retry
+// policies name them to stop retrying errors that another attempt cannot fix
+const (
+	ErrTypeInvalidOrder    = "InvalidOrder"
+	ErrTypeOutOfStock      = "OutOfStock"
+	ErrTypePaymentDeclined = "PaymentDeclined"
+)
+
+var (
+	// ErrOutOfStock is returned by Inventory when an item cannot be reserved
+	ErrOutOfStock = errors.New("out of stock")
+	// ErrPaymentDeclined is returned by Payments when a charge is refused
+	ErrPaymentDeclined = errors.New("payment declined")
+)
+
+// Order is the input of the ProcessOrder workflow
+type Order struct {
+	ID          string `json:"id"`
+	CustomerID  string `json:"customer_id"`
+	Items       []Item `json:"items"`
+	AmountCents int64  `json:"amount_cents"`
+}
+
+// Item is a product and the quantity ordered
+type Item struct {
+	SKU      string `json:"sku"`
+	Quantity int    `json:"quantity"`
+}
+
+// Validate checks the order before any side effect
+func (o Order) Validate() error {
+	if o.ID == "" {
+		return errors.New("order has no ID")
+	}
+	if len(o.Items) == 0 {
+		return fmt.Errorf("order %s has no items", o.ID)
+	}
+	for _, item := range o.Items {
+		if item.SKU == "" || item.Quantity < 1 {
+			return fmt.Errorf("order %s has an invalid item %q x%d", o.ID, item.SKU, item.Quantity)
+		}
+	}
+	if o.AmountCents < 1 {
+		return fmt.Errorf("order %s has an invalid amount %d", o.ID, o.AmountCents)
+	}
+	return nil
+}
+
+// Receipt is the result of a completed ProcessOrder workflow
+type Receipt struct {
+	OrderID       string    `json:"order_id"`
+	ReservationID string    `json:"reservation_id"`
+	ChargeID      string    `json:"charge_id"`
+	CompletedAt   time.Time `json:"completed_at"`
+}
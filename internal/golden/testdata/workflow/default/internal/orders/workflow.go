@@ -0,0 +1,53 @@
+package orders
+
+import (
+	"fmt"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// ProcessOrder reserves the stock of an order, then charges its payment. When the
+// charge fails, the reservation is released before the workflow fails.
+//
+// Workflow code must be deterministic: side effects belong in activities, and
+// time comes from workflow.Now. Changing the order of the activities breaks the
+// replay of running workflows, which the replay tests catch; use
+// workflow.GetVersion to change it safely.
+func ProcessOrder(ctx workflow.Context, order Order) (Receipt, error) {
+	logger := workflow.GetLogger(ctx)
+
+	if err := order.Validate(); err != nil {
+		return Receipt{}, temporal.NewNonRetryableApplicationError(err.Error(), ErrTypeInvalidOrder, err)
+	}
+
+	// Activities are referenced through a nil pointer, the worker runs the
+	// instance it registered
+	var activities *Activities
+
+	var reservationID string
+	inventoryCtx := workflow.WithActivityOptions(ctx, InventoryActivityOptions())
+	if err := workflow.ExecuteActivity(inventoryCtx, activities.ReserveInventory, order).Get(ctx, &reservationID); err != nil {
+		return Receipt{}, fmt.Errorf("failed to reserve inventory: %w", err)
+	}
+
+	var chargeID string
+	paymentCtx := workflow.WithActivityOptions(ctx, PaymentActivityOptions())
+	if err := workflow.ExecuteActivity(paymentCtx, activities.ChargePayment, order).Get(ctx, &chargeID); err != nil {
+		// Compensate on a disconnected context, so that the stock is released
+		// even when the workflow was canceled
+		releaseCtx, _ := workflow.NewDisconnectedContext(inventoryCtx)
+		if releaseErr := workflow.ExecuteActivity(releaseCtx, activities.ReleaseInventory, reservationID).Get(releaseCtx, nil); releaseErr != nil {
+			logger.Error("Failed to release inventory", "order_id", order.ID, "reservation_id", reservationID, "error", releaseErr)
+		}
+		return Receipt{}, fmt.Errorf("failed to charge payment: %w", err)
+	}
+
+	logger.Info("Order processed", "order_id", order.ID, "reservation_id", reservationID, "charge_id", chargeID)
+	return Receipt{
+		OrderID:       order.ID,
+		ReservationID: reservationID,
+		ChargeID:      chargeID,
+		CompletedAt:   workflow.Now(ctx),
+	}, nil
+}
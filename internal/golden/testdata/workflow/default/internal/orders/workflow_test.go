@@ -0,0 +1,139 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+// countingPayments fails the first charges with a transient error
+type countingPayments struct {
+	Payments
+	mu       sync.Mutex
+	failures int
+	calls    int
+}
+
+func (p *countingPayments) Charge(ctx context.Context, customerID string, amountCents int64, idempotencyKey string) (string, error) {
+	p.mu.Lock()
+	p.calls++
+	failed := p.calls <= p.failures
+	p.mu.Unlock()
+
+	if failed {
+		return "", errors.New("connection reset by peer")
+	}
+	return p.Payments.Charge(ctx, customerID, amountCents, idempotencyKey)
+}
+
+func (p *countingPayments) Calls() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.calls
+}
+
+func newWorkflowEnvironment(inventory Inventory, payments Payments) *testsuite.TestWorkflowEnvironment {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterWorkflow(ProcessOrder)
+	env.RegisterActivity(NewActivities(inventory, payments))
+	return env
+}
+
+func testOrder(amountCents int64) Order {
+	return Order{
+		ID:          "42",
+		CustomerID:  "customer-1",
+		Items:       []Item{{SKU: "book", Quantity: 2}},
+		AmountCents: amountCents,
+	}
+}
+
+func TestProcessOrder_Completes(t *testing.T) {
+	inventory := NewMemoryInventory(map[string]int{"book": 5})
+	env := newWorkflowEnvironment(inventory, NewDemoPayments(10000))
+
+	env.ExecuteWorkflow(ProcessOrder, testOrder(2500))
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var receipt Receipt
+	require.NoError(t, env.GetWorkflowResult(&receipt))
+	assert.Equal(t, "42", receipt.OrderID)
+	assert.Equal(t, "res-42", receipt.ReservationID)
+	assert.NotEmpty(t, receipt.ChargeID)
+	assert.Equal(t, 3, inventory.Stock("book"))
+}
+
+func TestProcessOrder_ReleasesInventoryWhenPaymentDeclined(t *testing.T) {
+	inventory := NewMemoryInventory(map[string]int{"book": 5})
+	payments := &countingPayments{Payments: NewDemoPayments(1000)}
+	env := newWorkflowEnvironment(inventory, payments)
+
+	env.ExecuteWorkflow(ProcessOrder, testOrder(2500))
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "payment declined")
+	assert.Equal(t, 1, payments.Calls(), "a declined payment is not retried")
+	assert.Equal(t, 5, inventory.Stock("book"), "the reservation is released")
+}
+
+func TestProcessOrder_RetriesTransientPaymentErrors(t *testing.T) {
+	inventory := NewMemoryInventory(map[string]int{"book": 5})
+	payments := &countingPayments{Payments: NewDemoPayments(10000), failures: 2}
+	env := newWorkflowEnvironment(inventory, payments)
+
+	env.ExecuteWorkflow(ProcessOrder, testOrder(2500))
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	assert.Equal(t, 3, payments.Calls())
+	assert.Equal(t, 3, inventory.Stock("book"))
+}
+
+func TestProcessOrder_GivesUpAfterMaximumAttempts(t *testing.T) {
+	inventory := NewMemoryInventory(map[string]int{"book": 5})
+	payments := &countingPayments{Payments: NewDemoPayments(10000), failures: 100}
+	env := newWorkflowEnvironment(inventory, payments)
+
+	env.ExecuteWorkflow(ProcessOrder, testOrder(2500))
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+	assert.Equal(t, int(PaymentActivityOptions().RetryPolicy.MaximumAttempts), payments.Calls())
+	assert.Equal(t, 5, inventory.Stock("book"))
+}
+
+func TestProcessOrder_OutOfStockSkipsPayment(t *testing.T) {
+	inventory := NewMemoryInventory(map[string]int{"book": 1})
+	payments := &countingPayments{Payments: NewDemoPayments(10000)}
+	env := newWorkflowEnvironment(inventory, payments)
+
+	env.ExecuteWorkflow(ProcessOrder, testOrder(2500))
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "out of stock")
+	assert.Zero(t, payments.Calls())
+	assert.Equal(t, 1, inventory.Stock("book"))
+}
+
+func TestProcessOrder_RejectsInvalidOrder(t *testing.T) {
+	env := newWorkflowEnvironment(NewMemoryInventory(nil), NewDemoPayments(10000))
+
+	env.ExecuteWorkflow(ProcessOrder, Order{ID: "42"})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has no items")
+}
@@ -0,0 +1,13 @@
+module github.com/example/golden/api
+
+go 1.23
+
+require (
+	github.com/example/golden/shared v0.0.0
+	github.com/stretchr/testify v1.9.0
+	github.com/gin-gonic/gin v1.10.0
+)
+
+// The shared module lives in this repository; go.work resolves it for local builds
+// and this replace keeps the api module buildable on its own, as in its Dockerfile
+replace github.com/example/golden/shared => ../shared
@@ -0,0 +1,90 @@
+// Package handlers serves the HTTP endpoints of the API
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/example/golden/api/internal/models"
+	"github.com/example/golden/api/internal/services"
+	"github.com/example/golden/shared/httpx"
+	"github.com/example/golden/shared/logger"
+)
+
+// TaskHandler serves the task endpoints over HTTP
+type TaskHandler struct {
+	service *services.TaskService
+	log     logger.Logger
+}
+
+// CreateTaskRequest is the body of POST /api/v1/tasks
+type CreateTaskRequest struct {
+	Title string `json:"title"`
+}
+
+// NewTaskHandler creates a new TaskHandler instance
+func NewTaskHandler(service *services.TaskService, log logger.Logger) *TaskHandler {
+	return &TaskHandler{
+		service: service,
+		log:     log,
+	}
+}
+
+// List handles GET /api/v1/tasks
+func (h *TaskHandler) List(w http.ResponseWriter, r *http.Request) {
+	tasks, err := h.service.List(r.Context())
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	httpx.JSON(w, http.StatusOK, tasks)
+}
+
+// Create handles POST /api/v1/tasks
+func (h *TaskHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req CreateTaskRequest
+	if err := httpx.Decode(w, r, &req); err != nil {
+		httpx.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	task, err := h.service.Create(r.Context(), req.Title)
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	httpx.JSON(w, http.StatusCreated, task)
+}
+
+// Get handles GET /api/v1/tasks/{id}
+func (h *TaskHandler) Get(w http.ResponseWriter, r *http.Request) {
+	task, err := h.service.Get(r.Context(), r.PathValue("id"))
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	httpx.JSON(w, http.StatusOK, task)
+}
+
+// Complete handles POST /api/v1/tasks/{id}/complete
+func (h *TaskHandler) Complete(w http.ResponseWriter, r *http.Request) {
+	task, err := h.service.Complete(r.Context(), r.PathValue("id"))
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	httpx.JSON(w, http.StatusOK, task)
+}
+
+// fail maps task errors to HTTP responses
+func (h *TaskHandler) fail(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, models.ErrTaskNotFound):
+		httpx.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, models.ErrTitleRequired):
+		httpx.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		h.log.Error("Task request failed", "error", err)
+		httpx.Error(w, http.StatusInternalServerError, "internal server error")
+	}
+}
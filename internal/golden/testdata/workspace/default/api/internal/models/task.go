@@ -0,0 +1,58 @@
+// Package models holds the data of the API
+package models
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Task errors
+var (
+	ErrTaskNotFound  = errors.New("task not found")
+	ErrTitleRequired = errors.New("task title is required")
+)
+
+// Task is a piece of work tracked by the API
+type Task struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	Done      bool      `json:"done"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewTask creates an open task with a random ID
+func NewTask(title string) (*Task, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, ErrTitleRequired
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Task{
+		ID:        id,
+		Title:     title,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// Complete marks the task as done
+func (t *Task) Complete() {
+	t.Done = true
+}
+
+// newID returns 16 random hexadecimal characters
+func newID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate task ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,49 @@
+// Package server routes the HTTP requests of the API to its handlers
+package server
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/example/golden/shared/httpx"
+	"github.com/example/golden/shared/logger"
+)
+
+// TaskHandler serves the task endpoints
+// Get and Complete read the task ID with r.PathValue("id"), whichever router is in front of them
+type TaskHandler interface {
+	List(w http.ResponseWriter, r *http.Request)
+	Create(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request)
+	Complete(w http.ResponseWriter, r *http.Request)
+}
+
+// NewRouter returns the handler of every API route, with request logging and panic recovery
+func NewRouter(tasks TaskHandler, log logger.Logger) http.Handler {
+	gin.SetMode(gin.ReleaseMode)
+	router := gin.New()
+
+	router.GET("/health", gin.WrapF(health))
+
+	api := router.Group("/api/v1")
+	api.GET("/tasks", gin.WrapF(tasks.List))
+	api.POST("/tasks", gin.WrapF(tasks.Create))
+	api.GET("/tasks/:id", withParam("id", tasks.Get))
+	api.POST("/tasks/:id/complete", withParam("id", tasks.Complete))
+
+	return httpx.Recover(log, httpx.Logging(log, router))
+}
+
+// health answers the liveness probe of the API
+func health(w http.ResponseWriter, _ *http.Request) {
+	httpx.JSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// withParam exposes the gin route parameter name through r.PathValue
+func withParam(name string, next http.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request.SetPathValue(name, c.Param(name))
+		next(c.Writer, c.Request)
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/shared/logger"
+)
+
+func newTestHandler(t *testing.T) http.Handler {
+	t.Helper()
+
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: "error", Format: "json"}, io.Discard)
+	require.NoError(t, err)
+	return newHandler(log)
+}
+
+func serve(handler http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+type taskResponse struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Done  bool   `json:"done"`
+}
+
+func TestHealth(t *testing.T) {
+	rec := serve(newTestHandler(t), http.MethodGet, "/health", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+}
+
+func TestTaskLifecycle(t *testing.T) {
+	handler := newTestHandler(t)
+
+	rec := serve(handler, http.MethodGet, "/api/v1/tasks", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[]`, rec.Body.String())
+
+	rec = serve(handler, http.MethodPost, "/api/v1/tasks", `{"title":"Write the docs"}`)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var created taskResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	require.NotEmpty(t, created.ID)
+	assert.Equal(t, "Write the docs", created.Title)
+	assert.False(t, created.Done)
+
+	rec = serve(handler, http.MethodPost, "/api/v1/tasks/"+created.ID+"/complete", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serve(handler, http.MethodGet, "/api/v1/tasks/"+created.ID, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var fetched taskResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &fetched))
+	assert.Equal(t, created.ID, fetched.ID)
+	assert.True(t, fetched.Done)
+
+	rec = serve(handler, http.MethodGet, "/api/v1/tasks", "")
+	var tasks []taskResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tasks))
+	assert.Len(t, tasks, 1)
+}
+
+func TestTaskErrors(t *testing.T) {
+	handler := newTestHandler(t)
+
+	rec := serve(handler, http.MethodGet, "/api/v1/tasks/missing", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(handler, http.MethodPost, "/api/v1/tasks/missing/complete", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(handler, http.MethodPost, "/api/v1/tasks", `{"title":"  "}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = serve(handler, http.MethodPost, "/api/v1/tasks", `{"name":"unknown field"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,61 @@
+// Package config reads the settings shared by every service of the workspace from the environment
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Config holds the settings every service reads the same way
+type Config struct {
+	// Service is the name of the service, added to each log line
+	Service string
+	// Environment is the deployment environment (APP_ENV)
+	Environment string
+	// LogLevel is one of debug, info, warn or error (LOG_LEVEL)
+	LogLevel string
+	// LogFormat is json or text (LOG_FORMAT)
+	LogFormat string
+}
+
+// Load reads the shared settings of the named service
+func Load(service string) Config {
+	return Config{
+		Service:     service,
+		Environment: String("APP_ENV", "development"),
+		LogLevel:    String("LOG_LEVEL", "info"),
+		LogFormat:   String("LOG_FORMAT", "json"),
+	}
+}
+
+// IsProduction reports whether the service runs in production
+func (c Config) IsProduction() bool {
+	return c.Environment == "production"
+}
+
+// String returns the environment variable key, or fallback when it is unset or empty
+func String(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// Duration parses the environment variable key as a duration such as 30s or 5m,
+// returning fallback when it is unset or empty
+func Duration(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("invalid %s %q: must be positive", key, value)
+	}
+	return duration, nil
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Setenv("APP_ENV", "production")
+	t.Setenv("LOG_LEVEL", "")
+
+	cfg := Load("api")
+	assert.Equal(t, "api", cfg.Service)
+	assert.True(t, cfg.IsProduction())
+	// Empty variables fall back to the defaults
+	assert.Equal(t, "info", cfg.LogLevel)
+	assert.Equal(t, "json", cfg.LogFormat)
+}
+
+func TestDuration(t *testing.T) {
+	interval, err := Duration("WORKER_INTERVAL", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, interval)
+
+	t.Setenv("WORKER_INTERVAL", "15s")
+	interval, err = Duration("WORKER_INTERVAL", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 15*time.Second, interval)
+
+	for _, value := range []string{"soon", "0s", "-5s"} {
+		t.Setenv("WORKER_INTERVAL", value)
+		_, err = Duration("WORKER_INTERVAL", time.Minute)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,91 @@
+// Package httpx holds the HTTP helpers shared by the services of the workspace
+package httpx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/example/golden/shared/logger"
+)
+
+// maxBodyBytes caps the size of the JSON bodies Decode reads
+const maxBodyBytes = 1 << 20
+
+// ErrorResponse is the body of every error answered by the services
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// JSON writes value as the JSON body of a response with the given status
+func JSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// Error writes message as a JSON error response with the given status
+func Error(w http.ResponseWriter, status int, message string) {
+	JSON(w, status, ErrorResponse{Error: message})
+}
+
+// Decode reads the JSON body of r into dst, rejecting unknown fields and oversized bodies
+func Decode(w http.ResponseWriter, r *http.Request, dst interface{}) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(dst); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+	if decoder.More() {
+		return errors.New("invalid request body: more than one JSON value")
+	}
+	return nil
+}
+
+// statusRecorder remembers the status written by the wrapped handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Logging logs the method, path, status and duration of every request
+func Logging(log logger.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(recorder, r)
+
+		log.Info("HTTP request",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", recorder.status,
+			"duration", time.Since(start).String(),
+		)
+	})
+}
+
+// Recover answers 500 instead of dropping the connection when a handler panics
+func Recover(log logger.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				if recovered == http.ErrAbortHandler {
+					panic(recovered)
+				}
+				log.Error("Handler panicked", "panic", recovered, "path", r.URL.Path)
+				Error(w, http.StatusInternalServerError, "internal server error")
+			}
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
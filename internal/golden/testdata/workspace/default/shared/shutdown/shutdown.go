@@ -0,0 +1,15 @@
+// Package shutdown stops the services of the workspace gracefully
+package shutdown
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// Context returns a context cancelled on SIGINT or SIGTERM, so services stop
+// accepting work and finish what they started. Call stop to release the signals
+func Context() (ctx context.Context, stop context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
@@ -0,0 +1,30 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/golden/shared/logger"
+)
+
+// HeartbeatJob logs that the worker is alive, a starting point for real jobs
+type HeartbeatJob struct {
+	log     logger.Logger
+	started time.Time
+}
+
+// NewHeartbeatJob creates a new HeartbeatJob instance
+func NewHeartbeatJob(log logger.Logger) *HeartbeatJob {
+	return &HeartbeatJob{log: log, started: time.Now()}
+}
+
+// Name identifies the job
+func (j *HeartbeatJob) Name() string {
+	return "heartbeat"
+}
+
+// Run logs the uptime of the worker
+func (j *HeartbeatJob) Run(_ context.Context) error {
+	j.log.Info("Worker heartbeat", "uptime", time.Since(j.started).Round(time.Second).String())
+	return nil
+}
@@ -0,0 +1,12 @@
+// Package jobs holds the background jobs of the worker and runs them
+package jobs
+
+import "context"
+
+// Job is a unit of background work the worker runs on every tick
+type Job interface {
+	// Name identifies the job in logs and errors
+	Name() string
+	// Run does the work once; an error is logged and the job runs again on the next tick
+	Run(ctx context.Context) error
+}
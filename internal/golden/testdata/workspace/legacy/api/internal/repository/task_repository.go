@@ -0,0 +1,67 @@
+// Package repository stores the data of the API
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/example/golden/api/internal/models"
+)
+
+// TaskRepository stores the tasks of the API
+type TaskRepository interface {
+	List(ctx context.Context) ([]models.Task, error)
+	FindByID(ctx context.Context, id string) (*models.Task, error)
+	Save(ctx context.Context, task *models.Task) error
+}
+
+// MemoryTaskRepository keeps the tasks in memory, they are lost when the API restarts
+type MemoryTaskRepository struct {
+	mu    sync.RWMutex
+	tasks map[string]models.Task
+}
+
+// NewMemoryTaskRepository creates an empty MemoryTaskRepository
+func NewMemoryTaskRepository() *MemoryTaskRepository {
+	return &MemoryTaskRepository{tasks: make(map[string]models.Task)}
+}
+
+// List returns every task, oldest first
+func (r *MemoryTaskRepository) List(_ context.Context) ([]models.Task, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tasks := make([]models.Task, 0, len(r.tasks))
+	for _, task := range r.tasks {
+		tasks = append(tasks, task)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		if tasks[i].CreatedAt.Equal(tasks[j].CreatedAt) {
+			return tasks[i].ID < tasks[j].ID
+		}
+		return tasks[i].CreatedAt.Before(tasks[j].CreatedAt)
+	})
+	return tasks, nil
+}
+
+// FindByID returns a copy of the task with the given ID
+func (r *MemoryTaskRepository) FindByID(_ context.Context, id string) (*models.Task, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	task, ok := r.tasks[id]
+	if !ok {
+		return nil, models.ErrTaskNotFound
+	}
+	return &task, nil
+}
+
+// Save creates or replaces the task
+func (r *MemoryTaskRepository) Save(_ context.Context, task *models.Task) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tasks[task.ID] = *task
+	return nil
+}
@@ -0,0 +1,55 @@
+// Package services holds the business logic of the API
+package services
+
+import (
+	"context"
+
+	"github.com/example/golden/api/internal/models"
+	"github.com/example/golden/api/internal/repository"
+)
+
+// TaskService carries out the task use cases
+type TaskService struct {
+	repository repository.TaskRepository
+}
+
+// NewTaskService creates a new TaskService instance
+func NewTaskService(repository repository.TaskRepository) *TaskService {
+	return &TaskService{repository: repository}
+}
+
+// List returns every task
+func (s *TaskService) List(ctx context.Context) ([]models.Task, error) {
+	return s.repository.List(ctx)
+}
+
+// Create opens a new task with the given title
+func (s *TaskService) Create(ctx context.Context, title string) (*models.Task, error) {
+	task, err := models.NewTask(title)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.Save(ctx, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// Get returns the task with the given ID
+func (s *TaskService) Get(ctx context.Context, id string) (*models.Task, error) {
+	return s.repository.FindByID(ctx, id)
+}
+
+// Complete marks the task with the given ID as done
+func (s *TaskService) Complete(ctx context.Context, id string) (*models.Task, error) {
+	task, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Complete()
+	if err := s.repository.Save(ctx, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
@@ -0,0 +1,68 @@
+// Command api serves the tasks of golden over HTTP
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/example/golden/api/internal/handlers"
+	"github.com/example/golden/api/internal/repository"
+	"github.com/example/golden/api/internal/server"
+	"github.com/example/golden/api/internal/services"
+	"github.com/example/golden/shared/config"
+	"github.com/example/golden/shared/logger"
+	"github.com/example/golden/shared/shutdown"
+)
+
+// shutdownTimeout bounds how long in-flight requests may take once the API is asked to stop
+const shutdownTimeout = 10 * time.Second
+
+func main() {
+	cfg := config.Load("api")
+
+	log, err := logger.NewFactory().Create(cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create logger: %v\n", err)
+		os.Exit(1)
+	}
+	log = log.With("service", cfg.Service, "environment", cfg.Environment)
+
+	addr := config.String("HTTP_ADDR", ":8080")
+	httpServer := &http.Server{
+		Addr:              addr,
+		Handler:           newHandler(log),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := shutdown.Context()
+	defer stop()
+
+	go func() {
+		log.Info("API listening", "addr", addr)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("API server failed", "error", err)
+		}
+	}()
+
+	<-ctx.Done()
+	log.Info("Shutting down API")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		log.Error("API shutdown failed", "error", err)
+	}
+}
+
+// newHandler wires the task layers of the API and returns its router
+func newHandler(log logger.Logger) http.Handler {
+	taskRepository := repository.NewMemoryTaskRepository()
+	taskService := services.NewTaskService(taskRepository)
+	taskHandler := handlers.NewTaskHandler(taskService, log)
+
+	return server.NewRouter(taskHandler, log)
+}
@@ -0,0 +1,244 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/example/golden/pkg/events"
+	"github.com/example/golden/pkg/shared/config"
+	"github.com/example/golden/pkg/shared/logger"
+	"github.com/example/golden/pkg/storage"
+)
+
+var (
+	cfgFile string
+	verbose bool
+	quiet   bool
+	output  string
+
+	// Global application context
+	appConfig *config.Config
+	appLogger logger.Logger
+	appDB     storage.Database
+	appEvents events.EventBus
+)
+
+// rootCmd represents the base command when called without any subcommands
+var rootCmd = &cobra.Command{
+	Use:   "golden-cli",
+	Short: "golden CLI tool for workspace management",
+	Long: `golden CLI is a command-line interface for managing and interacting 
+with the golden workspace services and resources.
+
+This tool provides commands for:
+- Managing users and accounts
+- Sending notifications
+- Monitoring system health
+- Performing administrative tasks
+
+Examples:
+  golden-cli users list
+  golden-cli users create --name "John Doe" --email "john@example.com"
+  golden-cli notifications send --user-id 123 --message "Hello World"
+  golden-cli health check`,
+	PersistentPreRunE: initializeApp,
+	PersistentPostRun: cleanupApp,
+}
+
+// Execute adds all child commands to the root command and sets flags appropriately.
+func Execute(ctx context.Context) error {
+	return rootCmd.ExecuteContext(ctx)
+}
+
+func init() {
+	cobra.OnInitialize(initConfig)
+
+	// Global flags
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is golden.yaml in the current or configs directory)")
+	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "verbose output")
+	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "quiet output (minimal logging)")
+	rootCmd.PersistentFlags().StringVarP(&output, "output", "o", "table", "output format (table, json, yaml)")
+
+	// Bind flags to viper
+	viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose"))
+	viper.BindPFlag("quiet", rootCmd.PersistentFlags().Lookup("quiet"))
+	viper.BindPFlag("output", rootCmd.PersistentFlags().Lookup("output"))
+
+	// Add subcommands
+	rootCmd.AddCommand(versionCmd)
+	rootCmd.AddCommand(completionCmd)
+}
+
+// initConfig points the configuration at the --config file; config.Load reads it
+// along with the GOLDEN_ environment variables
+func initConfig() {
+	if cfgFile != "" {
+		viper.SetConfigFile(cfgFile)
+	}
+}
+
+// initializeApp initializes the application dependencies
+func initializeApp(cmd *cobra.Command, args []string) error {
+	// Load configuration
+	cfg, err := config.Load("golden")
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	appConfig = cfg
+
+	// Set log level based on flags
+	logLevel := cfg.Logger.Level
+	if quiet {
+		logLevel = "error"
+	} else if verbose {
+		logLevel = "debug"
+	}
+
+	// Initialize logger
+	appLogger = logger.New(logger.Config{
+		Level:  logLevel,
+		Format: cfg.Logger.Format,
+		Output: cfg.Logger.Output,
+	})
+	// Initialize database if needed for this command
+	if needsDatabase(cmd) {
+		appLogger.Debug("Initializing database connection...")
+		db, err := storage.NewDatabase(&cfg.Database, appLogger)
+		if err != nil {
+			return fmt.Errorf("failed to create database instance: %w", err)
+		}
+
+		if err := db.Connect(cmd.Context()); err != nil {
+			return fmt.Errorf("failed to connect to database: %w", err)
+		}
+
+		appDB = db
+		appLogger.Debug("Database initialized successfully")
+	}
+	// Initialize event bus if needed for this command
+	if needsEvents(cmd) {
+		appLogger.Debug("Initializing event bus...")
+		eventBus, err := events.NewEventBus(&cfg.MessageQueue, appLogger)
+		if err != nil {
+			return fmt.Errorf("failed to create event bus: %w", err)
+		}
+
+		if err := eventBus.Connect(cmd.Context()); err != nil {
+			return fmt.Errorf("failed to connect to event bus: %w", err)
+		}
+
+		appEvents = eventBus
+		appLogger.Debug("Event bus initialized successfully")
+	}
+
+	return nil
+}
+
+// cleanupApp cleans up application resources
+func cleanupApp(cmd *cobra.Command, args []string) {
+	if appDB != nil {
+		if err := appDB.Close(); err != nil {
+			appLogger.Error("Failed to close database connection", "error", err)
+		}
+	}
+	if appEvents != nil {
+		if err := appEvents.Close(); err != nil {
+			appLogger.Error("Failed to close event bus connection", "error", err)
+		}
+	}
+}
+
+// needsDatabase determines if the command requires database access
+func needsDatabase(cmd *cobra.Command) bool {
+	commandsNeedingDB := []string{
+		"users",
+		"notifications",
+	}
+
+	for _, cmdName := range commandsNeedingDB {
+		if cmd.Name() == cmdName || cmd.Parent() != nil && cmd.Parent().Name() == cmdName {
+			return true
+		}
+	}
+	return false
+}
+
+// needsEvents determines if the command requires event bus access
+func needsEvents(cmd *cobra.Command) bool {
+	commandsNeedingEvents := []string{
+		"notifications",
+	}
+
+	for _, cmdName := range commandsNeedingEvents {
+		if cmd.Name() == cmdName || cmd.Parent() != nil && cmd.Parent().Name() == cmdName {
+			return true
+		}
+	}
+	return false
+}
+
+// versionCmd represents the version command
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Print the version number",
+	Long:  "Print the version number of golden CLI",
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Printf("golden CLI v1.0.0\n")
+		fmt.Printf("Go version: %s\n", "1.23")
+		fmt.Printf("Build date: %s\n", "development")
+	},
+}
+
+// completionCmd represents the completion command
+var completionCmd = &cobra.Command{
+	Use:   "completion [bash|zsh|fish|powershell]",
+	Short: "Generate completion script",
+	Long: `To load completions:
+
+Bash:
+  $ source <(golden-cli completion bash)
+  # To load completions for each session, execute once:
+  # Linux:
+  $ golden-cli completion bash > /etc/bash_completion.d/golden-cli
+  # macOS:
+  $ golden-cli completion bash > /usr/local/etc/bash_completion.d/golden-cli
+
+Zsh:
+  # If shell completion is not already enabled in your environment,
+  # you will need to enable it.  You can execute the following once:
+  $ echo "autoload -U compinit; compinit" >> ~/.zshrc
+  # To load completions for each session, execute once:
+  $ golden-cli completion zsh > "${fpath[1]}/_golden-cli"
+  # You will need to start a new shell for this setup to take effect.
+
+fish:
+  $ golden-cli completion fish | source
+  # To load completions for each session, execute once:
+  $ golden-cli completion fish > ~/.config/fish/completions/golden-cli.fish
+
+PowerShell:
+  PS> golden-cli completion powershell | Out-String | Invoke-Expression
+  # To load completions for every new session, run:
+  PS> golden-cli completion powershell > golden-cli.ps1
+  # and source this file from your PowerShell profile.
+`,
+	DisableFlagsInUseLine: true,
+	ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+	Args:                  cobra.ExactValidArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		switch args[0] {
+		case "bash":
+			cmd.Root().GenBashCompletion(os.Stdout)
+		case "zsh":
+			cmd.Root().GenZshCompletion(os.Stdout)
+		case "fish":
+			cmd.Root().GenFishCompletion(os.Stdout, true)
+		case "powershell":
+			cmd.Root().GenPowerShellCompletionWithDesc(os.Stdout)
+		}
+	},
+}
@@ -0,0 +1,23 @@
+module github.com/example/golden/cmd/cli
+
+go 1.23.0
+
+require (
+	github.com/example/golden/pkg/models v0.0.0
+	github.com/example/golden/pkg/shared v0.0.0
+	github.com/example/golden/pkg/storage v0.0.0
+	github.com/example/golden/pkg/events v0.0.0
+	github.com/google/uuid v1.6.0
+	github.com/olekukonko/tablewriter v0.0.5
+	github.com/spf13/cobra v1.8.0
+	github.com/spf13/viper v1.18.2
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+replace github.com/example/golden/pkg/models => ../../pkg/models
+
+replace github.com/example/golden/pkg/shared => ../../pkg/shared
+
+replace github.com/example/golden/pkg/storage => ../../pkg/storage
+
+replace github.com/example/golden/pkg/events => ../../pkg/events
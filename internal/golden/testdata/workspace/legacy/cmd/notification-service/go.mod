@@ -0,0 +1,20 @@
+module github.com/example/golden/cmd/notification-service
+
+go 1.23.0
+
+require (
+	github.com/example/golden/pkg/models v0.0.0
+	github.com/example/golden/pkg/shared v0.0.0
+	github.com/example/golden/pkg/storage v0.0.0
+	github.com/example/golden/pkg/events v0.0.0
+	github.com/google/uuid v1.6.0
+	github.com/gin-gonic/gin v1.10.0
+)
+
+replace github.com/example/golden/pkg/models => ../../pkg/models
+
+replace github.com/example/golden/pkg/shared => ../../pkg/shared
+
+replace github.com/example/golden/pkg/storage => ../../pkg/storage
+
+replace github.com/example/golden/pkg/events => ../../pkg/events
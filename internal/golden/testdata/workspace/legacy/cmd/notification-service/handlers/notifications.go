@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/example/golden/cmd/notification-service/services"
+	"github.com/example/golden/pkg/models"
+	"github.com/example/golden/pkg/shared/logger"
+	"github.com/example/golden/pkg/shared/validator"
+)
+
+// NotificationHandler serves the notification endpoints
+// The handlers of a single notification read its ID with r.PathValue("id"), whichever router is in front of them
+type NotificationHandler struct {
+	notifications *services.NotificationService
+	validator     *validator.Validator
+	log           logger.Logger
+}
+
+// NewNotificationHandler creates the notification endpoints backed by notifications
+func NewNotificationHandler(notifications *services.NotificationService, log logger.Logger) *NotificationHandler {
+	return &NotificationHandler{
+		notifications: notifications,
+		validator:     validator.New(),
+		log:           log,
+	}
+}
+
+// List returns the notifications, only those of a user when the user_id query parameter is set
+func (h *NotificationHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := uuid.Nil
+	if raw := r.URL.Query().Get("user_id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid user ID")
+			return
+		}
+		userID = id
+	}
+
+	notifications := h.notifications.List(r.Context(), userID)
+	writeJSON(w, http.StatusOK, models.NewNotificationListResponse(notifications, int64(len(notifications)), 1, max(len(notifications), 1)))
+}
+
+// Create stores a notification and delivers it unless it is scheduled
+func (h *NotificationHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req models.NotificationCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if err := h.validator.Validate(req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	notification, err := h.notifications.Create(r.Context(), req)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, models.FromNotification(notification))
+}
+
+// Get returns a notification
+func (h *NotificationHandler) Get(w http.ResponseWriter, r *http.Request) {
+	id, ok := notificationID(w, r)
+	if !ok {
+		return
+	}
+
+	notification, err := h.notifications.Get(r.Context(), id)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, models.FromNotification(notification))
+}
+
+// Delete removes a notification
+func (h *NotificationHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, ok := notificationID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.notifications.Delete(r.Context(), id); err != nil {
+		h.fail(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Send delivers a pending notification now or retries a failed one
+func (h *NotificationHandler) Send(w http.ResponseWriter, r *http.Request) {
+	id, ok := notificationID(w, r)
+	if !ok {
+		return
+	}
+
+	notification, err := h.notifications.Send(r.Context(), id)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, models.FromNotification(notification))
+}
+
+// Cancel stops a pending notification from being delivered
+func (h *NotificationHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	id, ok := notificationID(w, r)
+	if !ok {
+		return
+	}
+
+	notification, err := h.notifications.Cancel(r.Context(), id)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, models.FromNotification(notification))
+}
+
+// fail answers with the status matching a service error
+func (h *NotificationHandler) fail(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, services.ErrNotificationNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, services.ErrInvalidNotification):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, services.ErrNotSendable):
+		writeError(w, http.StatusConflict, err.Error())
+	default:
+		h.log.ErrorContext(r.Context(), "notification request failed", "error", err)
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
+
+// notificationID parses the notification ID of the path, answering 400 when it is not a UUID
+func notificationID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid notification ID")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// writeJSON writes v as the JSON body of the response
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error message as the JSON body of the response
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,113 @@
+// Command notification-service stores and delivers the notifications of golden.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/example/golden/cmd/notification-service/handlers"
+	"github.com/example/golden/cmd/notification-service/services"
+	"github.com/example/golden/pkg/events"
+	"github.com/example/golden/pkg/shared/config"
+	"github.com/example/golden/pkg/shared/logger"
+	"github.com/example/golden/pkg/storage"
+)
+
+const (
+	// shutdownTimeout bounds how long in-flight requests may take once a signal is received
+	shutdownTimeout = 15 * time.Second
+	// deliveryInterval is how often the scheduled and failed notifications are delivered
+	deliveryInterval = 30 * time.Second
+)
+
+func main() {
+	cfg, err := config.Load("notification-service")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "notification-service: %v\n", err)
+		os.Exit(1)
+	}
+
+	log := logger.New(logger.Config{
+		Level:  cfg.Logger.Level,
+		Format: cfg.Logger.Format,
+		Output: cfg.Logger.Output,
+	}).With("service", "notification-service")
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, cfg, log); err != nil {
+		log.Error("notification-service stopped", "error", err)
+		os.Exit(1)
+	}
+}
+
+// run serves the notification API and delivers the due notifications until ctx is cancelled,
+// then drains the in-flight requests
+func run(ctx context.Context, cfg *config.Config, log logger.Logger) error {
+	var ready handlers.ReadyFunc
+
+	db, err := storage.NewDatabase(&cfg.Database, log)
+	if err != nil {
+		return err
+	}
+	if err := db.Connect(ctx); err != nil {
+		return fmt.Errorf("connect to the database: %w", err)
+	}
+	defer db.Close()
+	ready = db.Health
+
+	bus, err := events.NewEventBus(&cfg.MessageQueue, log)
+	if err != nil {
+		return err
+	}
+	if err := bus.Connect(ctx); err != nil {
+		return fmt.Errorf("connect to the message queue: %w", err)
+	}
+	defer bus.Close()
+	ready = func(ctx context.Context) error {
+		return errors.Join(db.Health(ctx), bus.Health(ctx))
+	}
+
+	notifications := services.NewNotificationService(services.NewLogSender(log), log, bus)
+	if err := bus.Subscribe(ctx, services.UserTopic, notifications.HandleUserEvent); err != nil {
+		return fmt.Errorf("subscribe to the user events: %w", err)
+	}
+	go notifications.Run(ctx, deliveryInterval)
+
+	server := &http.Server{
+		Addr:         net.JoinHostPort(cfg.Server.Host, strconv.Itoa(cfg.Server.Port)),
+		Handler:      handlers.NewRouter(handlers.NewNotificationHandler(notifications, log), ready, log),
+		ReadTimeout:  time.Duration(cfg.Server.ReadTimeout) * time.Second,
+		WriteTimeout: time.Duration(cfg.Server.WriteTimeout) * time.Second,
+		IdleTimeout:  time.Duration(cfg.Server.IdleTimeout) * time.Second,
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		log.Info("notification-service listening", "addr", server.Addr, "environment", cfg.Environment)
+		errs <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		if !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	case <-ctx.Done():
+	}
+
+	log.Info("shutting down notification-service")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return server.Shutdown(shutdownCtx)
+}
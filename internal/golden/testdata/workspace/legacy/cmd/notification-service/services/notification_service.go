@@ -0,0 +1,245 @@
+// Package services implements the business logic of the notification service.
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/example/golden/pkg/events"
+	"github.com/example/golden/pkg/models"
+	"github.com/example/golden/pkg/shared/logger"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrNotificationNotFound is returned when no notification has the requested ID
+	ErrNotificationNotFound = errors.New("notification not found")
+	// ErrInvalidNotification is returned when a notification has no recipient or an unknown type
+	ErrInvalidNotification = errors.New("invalid notification")
+	// ErrNotSendable is returned when the status of a notification does not allow the operation
+	ErrNotSendable = errors.New("notification cannot be sent in its current status")
+)
+
+const (
+	// Topic is the topic the notification events are published on
+	Topic = "notification.events"
+	// UserTopic is the topic of the user service events that trigger notifications
+	UserTopic = "user.events"
+)
+
+// NotificationService manages the notifications, kept in memory
+// Deliveries are serialized by the lock of the service
+type NotificationService struct {
+	mu            sync.Mutex
+	notifications map[uuid.UUID]*models.Notification
+	sender        Sender
+	log           logger.Logger
+	bus           events.EventBus
+}
+
+// NewNotificationService creates a notification service delivering through sender
+func NewNotificationService(sender Sender, log logger.Logger, bus events.EventBus) *NotificationService {
+	return &NotificationService{
+		notifications: make(map[uuid.UUID]*models.Notification),
+		sender:        sender,
+		log:           log,
+		bus:           bus,
+	}
+}
+
+// List returns the notifications of a user, or every notification for uuid.Nil, newest first
+func (s *NotificationService) List(ctx context.Context, userID uuid.UUID) []*models.Notification {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	notifications := make([]*models.Notification, 0, len(s.notifications))
+	for _, notification := range s.notifications {
+		if userID == uuid.Nil || notification.UserID == userID {
+			notifications = append(notifications, notification)
+		}
+	}
+	sort.Slice(notifications, func(i, j int) bool {
+		return notifications[i].CreatedAt.After(notifications[j].CreatedAt)
+	})
+	return notifications
+}
+
+// Create stores a notification and delivers it unless it is scheduled for later
+func (s *NotificationService) Create(ctx context.Context, req models.NotificationCreateRequest) (*models.Notification, error) {
+	if req.UserID == uuid.Nil || !validType(req.Type) {
+		return nil, ErrInvalidNotification
+	}
+	notification, err := req.ToNotification()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.notifications[notification.ID] = notification
+	s.log.InfoContext(ctx, "notification created", "notification_id", notification.ID.String(), "type", string(notification.Type))
+	if notification.IsReadyToSend() {
+		s.deliver(ctx, notification)
+	}
+	return notification, nil
+}
+
+// Get returns the notification with the given ID
+func (s *NotificationService) Get(ctx context.Context, id uuid.UUID) (*models.Notification, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.find(id)
+}
+
+// Delete removes the notification
+func (s *NotificationService) Delete(ctx context.Context, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.find(id); err != nil {
+		return err
+	}
+	delete(s.notifications, id)
+	return nil
+}
+
+// Send delivers a pending notification now, even when it is scheduled, or retries a failed one
+func (s *NotificationService) Send(ctx context.Context, id uuid.UUID) (*models.Notification, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	notification, err := s.find(id)
+	if err != nil {
+		return nil, err
+	}
+	if notification.Status != models.NotificationStatusPending && !notification.ShouldRetry() {
+		return nil, ErrNotSendable
+	}
+	s.deliver(ctx, notification)
+	return notification, nil
+}
+
+// Cancel stops a pending notification from being delivered
+func (s *NotificationService) Cancel(ctx context.Context, id uuid.UUID) (*models.Notification, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	notification, err := s.find(id)
+	if err != nil {
+		return nil, err
+	}
+	if notification.Status != models.NotificationStatusPending {
+		return nil, ErrNotSendable
+	}
+	notification.MarkAsCancelled()
+	return notification, nil
+}
+
+// DeliverDue delivers the scheduled notifications whose time has come and retries the failed ones
+func (s *NotificationService) DeliverDue(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, notification := range s.notifications {
+		if notification.IsReadyToSend() || notification.ShouldRetry() {
+			s.deliver(ctx, notification)
+		}
+	}
+}
+
+// Run calls DeliverDue every interval until ctx is cancelled
+func (s *NotificationService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.DeliverDue(ctx)
+		}
+	}
+}
+
+// HandleUserEvent notifies the users about the changes of their account published by the user service
+func (s *NotificationService) HandleUserEvent(ctx context.Context, event *events.Event) error {
+	var subject, body string
+	switch event.Type {
+	case "user.created":
+		subject = "Welcome to golden"
+		body = fmt.Sprintf("Hello %v, your account has been created.", event.Data["name"])
+	case "user.verified":
+		subject = "Email address verified"
+		body = "Your email address has been verified."
+	case "user.password_changed":
+		subject = "Password changed"
+		body = "The password of your account has been changed. Contact us if you did not change it."
+	default:
+		return nil
+	}
+
+	userID, err := uuid.Parse(fmt.Sprint(event.Data["user_id"]))
+	if err != nil {
+		return fmt.Errorf("user event %s: invalid user_id: %w", event.ID, err)
+	}
+	_, err = s.Create(ctx, models.NotificationCreateRequest{
+		UserID:  userID,
+		Type:    models.NotificationTypeEmail,
+		Subject: subject,
+		Body:    body,
+	})
+	return err
+}
+
+// find returns the notification with the given ID; the caller holds the lock
+func (s *NotificationService) find(id uuid.UUID) (*models.Notification, error) {
+	notification, ok := s.notifications[id]
+	if !ok {
+		return nil, ErrNotificationNotFound
+	}
+	return notification, nil
+}
+
+// deliver sends the notification and records the outcome; the caller holds the lock
+func (s *NotificationService) deliver(ctx context.Context, notification *models.Notification) {
+	if err := s.sender.Send(ctx, notification); err != nil {
+		notification.MarkAsFailed(err.Error())
+		s.log.WarnContext(ctx, "notification delivery failed",
+			"notification_id", notification.ID.String(),
+			"attempts", notification.Attempts,
+			"error", err,
+		)
+		s.publish(ctx, "notification.failed", notification)
+		return
+	}
+	notification.MarkAsSent()
+	s.publish(ctx, "notification.sent", notification)
+}
+
+// publish sends an event about the notification
+func (s *NotificationService) publish(ctx context.Context, eventType string, notification *models.Notification) {
+	event := events.NewEvent(eventType, "notification-service", map[string]interface{}{
+		"notification_id": notification.ID.String(),
+		"user_id":         notification.UserID.String(),
+		"status":          string(notification.Status),
+	})
+	if err := s.bus.Publish(ctx, Topic, event); err != nil {
+		s.log.ErrorContext(ctx, "failed to publish notification event", "type", eventType, "error", err)
+	}
+}
+
+// validType reports whether t is one of the notification types
+func validType(t models.NotificationType) bool {
+	switch t {
+	case models.NotificationTypeEmail, models.NotificationTypeSMS, models.NotificationTypePush, models.NotificationTypeInApp:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+
+	"github.com/example/golden/pkg/models"
+	"github.com/example/golden/pkg/shared/logger"
+)
+
+// Sender delivers a notification through the channel of its type
+type Sender interface {
+	Send(ctx context.Context, notification *models.Notification) error
+}
+
+// LogSender is the Sender used until an email, SMS or push provider is wired in: it only logs the notifications
+type LogSender struct {
+	log logger.Logger
+}
+
+// NewLogSender creates a Sender that logs the notifications it is given
+func NewLogSender(log logger.Logger) *LogSender {
+	return &LogSender{log: log}
+}
+
+// Send logs the notification
+func (s *LogSender) Send(ctx context.Context, notification *models.Notification) error {
+	s.log.InfoContext(ctx, "notification sent",
+		"notification_id", notification.ID.String(),
+		"user_id", notification.UserID.String(),
+		"type", string(notification.Type),
+		"subject", notification.Subject,
+	)
+	return nil
+}
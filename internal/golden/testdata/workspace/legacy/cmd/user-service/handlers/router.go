@@ -0,0 +1,103 @@
+// Package handlers serves the HTTP endpoints of the user service.
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/example/golden/pkg/shared/logger"
+)
+
+// ReadyFunc reports whether the dependencies of the service can serve requests
+type ReadyFunc func(ctx context.Context) error
+
+// NewRouter returns the handler of every user service route, with request logging and panic recovery
+func NewRouter(users *UserHandler, ready ReadyFunc, log logger.Logger) http.Handler {
+	readiness := readinessHandler(ready)
+	gin.SetMode(gin.ReleaseMode)
+	router := gin.New()
+
+	router.GET("/health", gin.WrapF(health))
+	router.GET("/ready", gin.WrapF(readiness))
+
+	api := router.Group("/api/v1")
+	api.GET("/users", gin.WrapF(users.List))
+	api.POST("/users", gin.WrapF(users.Create))
+	api.GET("/users/:id", withParam("id", users.Get))
+	api.PUT("/users/:id", withParam("id", users.Update))
+	api.DELETE("/users/:id", withParam("id", users.Delete))
+	api.POST("/users/:id/verify", withParam("id", users.Verify))
+	api.POST("/users/:id/activate", withParam("id", users.Activate))
+	api.POST("/users/:id/deactivate", withParam("id", users.Deactivate))
+	api.POST("/users/:id/password", withParam("id", users.ChangePassword))
+
+	return recoverer(log, logging(log, router))
+}
+
+// health answers the liveness probe of the service
+func health(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// readinessHandler answers the readiness probe of the service with the result of ready
+func readinessHandler(ready ReadyFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if ready != nil {
+			if err := ready(r.Context()); err != nil {
+				writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "unavailable", "error": err.Error()})
+				return
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ready"})
+	}
+}
+
+// statusRecorder remembers the status code written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// logging logs the method, path, status and duration of every request
+func logging(log logger.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		log.InfoContext(r.Context(), "request",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", rec.status,
+			"duration", time.Since(start).String(),
+		)
+	})
+}
+
+// recoverer turns a panicking handler into a 500 response
+func recoverer(log logger.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if err := recover(); err != nil {
+				log.ErrorContext(r.Context(), "panic while serving request", "error", err, "path", r.URL.Path)
+				writeError(w, http.StatusInternalServerError, "internal server error")
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// withParam exposes the gin route parameter name through r.PathValue
+func withParam(name string, next http.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request.SetPathValue(name, c.Param(name))
+		next(c.Writer, c.Request)
+	}
+}
@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/example/golden/cmd/user-service/services"
+	"github.com/example/golden/pkg/models"
+	"github.com/example/golden/pkg/shared/logger"
+	"github.com/example/golden/pkg/shared/validator"
+)
+
+// UserHandler serves the user endpoints
+// The handlers of a single user read its ID with r.PathValue("id"), whichever router is in front of them
+type UserHandler struct {
+	users     *services.UserService
+	validator *validator.Validator
+	log       logger.Logger
+}
+
+// NewUserHandler creates the user endpoints backed by users
+func NewUserHandler(users *services.UserService, log logger.Logger) *UserHandler {
+	return &UserHandler{
+		users:     users,
+		validator: validator.New(),
+		log:       log,
+	}
+}
+
+// List returns every user
+func (h *UserHandler) List(w http.ResponseWriter, r *http.Request) {
+	users := h.users.List(r.Context())
+	writeJSON(w, http.StatusOK, models.NewUserListResponse(users, int64(len(users)), 1, max(len(users), 1)))
+}
+
+// Create registers a user
+func (h *UserHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req services.CreateUserRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	user, err := h.users.Create(r.Context(), req)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, models.FromUser(user))
+}
+
+// Get returns a user
+func (h *UserHandler) Get(w http.ResponseWriter, r *http.Request) {
+	id, ok := userID(w, r)
+	if !ok {
+		return
+	}
+
+	user, err := h.users.Get(r.Context(), id)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, models.FromUser(user))
+}
+
+// Update changes the fields of a user set in the request body
+func (h *UserHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, ok := userID(w, r)
+	if !ok {
+		return
+	}
+	var req models.UserUpdateRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	user, err := h.users.Update(r.Context(), id, req)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, models.FromUser(user))
+}
+
+// Delete removes a user
+func (h *UserHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, ok := userID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.users.Delete(r.Context(), id); err != nil {
+		h.fail(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Verify marks the email address of a user as verified
+func (h *UserHandler) Verify(w http.ResponseWriter, r *http.Request) {
+	h.change(w, r, h.users.Verify)
+}
+
+// Activate allows a user to sign in again
+func (h *UserHandler) Activate(w http.ResponseWriter, r *http.Request) {
+	h.change(w, r, h.users.Activate)
+}
+
+// Deactivate prevents a user from signing in
+func (h *UserHandler) Deactivate(w http.ResponseWriter, r *http.Request) {
+	h.change(w, r, h.users.Deactivate)
+}
+
+// ChangePassword replaces the password of a user
+func (h *UserHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	id, ok := userID(w, r)
+	if !ok {
+		return
+	}
+	var req services.ChangePasswordRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := h.users.ChangePassword(r.Context(), id, req); err != nil {
+		h.fail(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// change applies a state change to the user named in the path and returns it
+func (h *UserHandler) change(w http.ResponseWriter, r *http.Request, apply func(ctx context.Context, id uuid.UUID) (*models.User, error)) {
+	id, ok := userID(w, r)
+	if !ok {
+		return
+	}
+
+	user, err := apply(r.Context(), id)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, models.FromUser(user))
+}
+
+// decode reads the JSON body of the request into v and validates it, answering 400 when it fails
+func (h *UserHandler) decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return false
+	}
+	if err := h.validator.Validate(v); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+// fail answers with the status matching a service error
+func (h *UserHandler) fail(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, services.ErrUserNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, services.ErrEmailTaken), errors.Is(err, services.ErrUsernameTaken):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, services.ErrInvalidPassword):
+		writeError(w, http.StatusUnauthorized, err.Error())
+	default:
+		h.log.ErrorContext(r.Context(), "user request failed", "error", err)
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
+
+// userID parses the user ID of the path, answering 400 when it is not a UUID
+func userID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid user ID")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// writeJSON writes v as the JSON body of the response
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error message as the JSON body of the response
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,16 @@
+package services
+
+// CreateUserRequest represents a request to create a new user
+type CreateUserRequest struct {
+	Email     string `json:"email" validate:"required,email,max=255"`
+	Username  string `json:"username" validate:"required,min=3,max=50"`
+	FirstName string `json:"first_name" validate:"required,max=100"`
+	LastName  string `json:"last_name" validate:"required,max=100"`
+	Password  string `json:"password" validate:"required,min=8,max=72"`
+}
+
+// ChangePasswordRequest represents a request to change a user's password
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,min=8,max=72"`
+}
@@ -0,0 +1,235 @@
+// Package services implements the business logic of the user service.
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/example/golden/pkg/events"
+	"github.com/example/golden/pkg/models"
+	"github.com/example/golden/pkg/shared/logger"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	// ErrUserNotFound is returned when no user has the requested ID
+	ErrUserNotFound = errors.New("user not found")
+	// ErrEmailTaken is returned when another user already has the email address
+	ErrEmailTaken = errors.New("email already registered")
+	// ErrUsernameTaken is returned when another user already has the username
+	ErrUsernameTaken = errors.New("username already taken")
+	// ErrInvalidPassword is returned when the current password does not match
+	ErrInvalidPassword = errors.New("invalid password")
+)
+
+// Topic is the topic the user events are published on
+const Topic = "user.events"
+
+// UserService manages the users, kept in memory
+type UserService struct {
+	mu    sync.RWMutex
+	users map[uuid.UUID]*models.User
+	log   logger.Logger
+	bus   events.EventBus
+}
+
+// NewUserService creates a user service without users
+func NewUserService(log logger.Logger, bus events.EventBus) *UserService {
+	return &UserService{
+		users: make(map[uuid.UUID]*models.User),
+		log:   log,
+		bus:   bus,
+	}
+}
+
+// List returns the users that are not deleted, newest first
+func (s *UserService) List(ctx context.Context) []*models.User {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	users := make([]*models.User, 0, len(s.users))
+	for _, user := range s.users {
+		if !user.IsDeleted() {
+			users = append(users, user)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].CreatedAt.After(users[j].CreatedAt)
+	})
+	return users
+}
+
+// Create registers a new user with a hashed password
+func (s *UserService) Create(ctx context.Context, req CreateUserRequest) (*models.User, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	user := models.NewUser(strings.ToLower(req.Email), req.Username, req.FirstName, req.LastName)
+	user.PasswordHash = string(hash)
+
+	s.mu.Lock()
+	if err := s.checkUnique(user); err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	s.users[user.ID] = user
+	s.mu.Unlock()
+
+	s.log.InfoContext(ctx, "user created", "user_id", user.ID.String())
+	s.publish(ctx, "user.created", user)
+	return user, nil
+}
+
+// Get returns the user with the given ID
+func (s *UserService) Get(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.find(id)
+}
+
+// Update applies the fields set in the request to the user
+func (s *UserService) Update(ctx context.Context, id uuid.UUID, req models.UserUpdateRequest) (*models.User, error) {
+	s.mu.Lock()
+	user, err := s.find(id)
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	updated := *user
+	if req.Email != nil {
+		email := strings.ToLower(*req.Email)
+		req.Email = &email
+	}
+	req.ApplyTo(&updated)
+	if err := s.checkUnique(&updated); err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	*user = updated
+	s.mu.Unlock()
+
+	s.publish(ctx, "user.updated", user)
+	return user, nil
+}
+
+// Delete soft deletes the user
+func (s *UserService) Delete(ctx context.Context, id uuid.UUID) error {
+	user, err := s.modify(id, (*models.User).SoftDelete)
+	if err != nil {
+		return err
+	}
+
+	s.log.InfoContext(ctx, "user deleted", "user_id", id.String())
+	s.publish(ctx, "user.deleted", user)
+	return nil
+}
+
+// Verify marks the email address of the user as verified
+func (s *UserService) Verify(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	user, err := s.modify(id, (*models.User).Verify)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, "user.verified", user)
+	return user, nil
+}
+
+// Activate allows the user to sign in again
+func (s *UserService) Activate(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	return s.modify(id, func(user *models.User) {
+		user.IsActive = true
+		user.Touch()
+	})
+}
+
+// Deactivate prevents the user from signing in
+func (s *UserService) Deactivate(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	return s.modify(id, func(user *models.User) {
+		user.IsActive = false
+		user.Touch()
+	})
+}
+
+// ChangePassword replaces the password of the user once the current one is checked
+func (s *UserService) ChangePassword(ctx context.Context, id uuid.UUID, req ChangePasswordRequest) error {
+	user, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.CurrentPassword)) != nil {
+		return ErrInvalidPassword
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	user, err = s.modify(id, func(user *models.User) {
+		user.PasswordHash = string(hash)
+		user.Touch()
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publish(ctx, "user.password_changed", user)
+	return nil
+}
+
+// find returns the user with the given ID; the caller holds the lock
+func (s *UserService) find(id uuid.UUID) (*models.User, error) {
+	user, ok := s.users[id]
+	if !ok || user.IsDeleted() {
+		return nil, ErrUserNotFound
+	}
+	return user, nil
+}
+
+// modify applies change to the user with the given ID under the lock
+func (s *UserService) modify(id uuid.UUID, change func(*models.User)) (*models.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, err := s.find(id)
+	if err != nil {
+		return nil, err
+	}
+	change(user)
+	return user, nil
+}
+
+// checkUnique fails when another user has the email address or username of user; the caller holds the lock
+func (s *UserService) checkUnique(user *models.User) error {
+	for _, other := range s.users {
+		if other.ID == user.ID || other.IsDeleted() {
+			continue
+		}
+		if other.Email == user.Email {
+			return ErrEmailTaken
+		}
+		if other.Username == user.Username {
+			return ErrUsernameTaken
+		}
+	}
+	return nil
+}
+
+// publish sends an event about the user
+func (s *UserService) publish(ctx context.Context, eventType string, user *models.User) {
+	event := events.NewEvent(eventType, "user-service", map[string]interface{}{
+		"user_id": user.ID.String(),
+		"email":   user.Email,
+		"name":    user.FullName(),
+	})
+	if err := s.bus.Publish(ctx, Topic, event); err != nil {
+		s.log.ErrorContext(ctx, "failed to publish user event", "type", eventType, "error", err)
+	}
+}
@@ -0,0 +1,173 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+
+	"github.com/example/golden/pkg/shared/config"
+	"github.com/example/golden/pkg/shared/logger"
+)
+
+// Event represents a domain event
+type Event struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"type"`
+	Source    string                 `json:"source"`
+	Data      map[string]interface{} `json:"data"`
+	Timestamp time.Time              `json:"timestamp"`
+	Version   string                 `json:"version"`
+	Metadata  map[string]string      `json:"metadata,omitempty"`
+}
+
+// NewEvent creates a new event
+func NewEvent(eventType, source string, data map[string]interface{}) *Event {
+	return &Event{
+		ID:        uuid.New().String(),
+		Type:      eventType,
+		Source:    source,
+		Data:      data,
+		Timestamp: time.Now().UTC(),
+		Version:   "1.0",
+		Metadata:  make(map[string]string),
+	}
+}
+
+// EventHandler represents a function that handles events
+type EventHandler func(ctx context.Context, event *Event) error
+
+// EventBus represents the event bus interface
+type EventBus interface {
+	Connect(ctx context.Context) error
+	Close() error
+	Publish(ctx context.Context, topic string, event *Event) error
+	Subscribe(ctx context.Context, topic string, handler EventHandler) error
+	Unsubscribe(ctx context.Context, topic string) error
+	Health(ctx context.Context) error
+}
+
+// NATSEventBus implements EventBus using NATS
+type NATSEventBus struct {
+	conn          *nats.Conn
+	config        *config.MessageQueueConfig
+	logger        logger.Logger
+	subscriptions map[string]*nats.Subscription
+}
+
+// NewNATSEventBus creates a new NATS event bus
+func NewNATSEventBus(cfg *config.MessageQueueConfig, log logger.Logger) *NATSEventBus {
+	return &NATSEventBus{
+		config:        cfg,
+		logger:        log,
+		subscriptions: make(map[string]*nats.Subscription),
+	}
+}
+
+// Connect establishes a connection to NATS
+func (n *NATSEventBus) Connect(ctx context.Context) error {
+	conn, err := nats.Connect(n.config.ConnectionString(),
+		nats.MaxReconnects(n.config.RetryAttempts),
+		nats.ReconnectWait(time.Duration(n.config.RetryDelay)*time.Millisecond),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	n.conn = conn
+	n.logger.Info("Successfully connected to NATS event bus")
+	return nil
+}
+
+// Close closes the NATS connection
+func (n *NATSEventBus) Close() error {
+	// Close all subscriptions
+	for topic, sub := range n.subscriptions {
+		if err := sub.Unsubscribe(); err != nil {
+			n.logger.Error("Failed to unsubscribe from topic", "topic", topic, "error", err)
+		}
+	}
+
+	if n.conn != nil {
+		n.conn.Close()
+	}
+
+	n.logger.Info("NATS event bus connection closed")
+	return nil
+}
+
+// Publish publishes an event to a topic
+func (n *NATSEventBus) Publish(ctx context.Context, topic string, event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	if err := n.conn.Publish(topic, data); err != nil {
+		return fmt.Errorf("failed to publish event to topic %s: %w", topic, err)
+	}
+
+	n.logger.Debug("Event published", "topic", topic, "event_id", event.ID, "event_type", event.Type)
+	return nil
+}
+
+// Subscribe subscribes to a topic with an event handler
+func (n *NATSEventBus) Subscribe(ctx context.Context, topic string, handler EventHandler) error {
+	sub, err := n.conn.Subscribe(topic, func(msg *nats.Msg) {
+		var event Event
+		if err := json.Unmarshal(msg.Data, &event); err != nil {
+			n.logger.Error("Failed to unmarshal event", "topic", topic, "error", err)
+			return
+		}
+
+		if err := handler(ctx, &event); err != nil {
+			n.logger.Error("Event handler failed", "topic", topic, "event_id", event.ID, "error", err)
+		}
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to topic %s: %w", topic, err)
+	}
+
+	n.subscriptions[topic] = sub
+	n.logger.Info("Subscribed to topic", "topic", topic)
+	return nil
+}
+
+// Unsubscribe unsubscribes from a topic
+func (n *NATSEventBus) Unsubscribe(ctx context.Context, topic string) error {
+	if sub, exists := n.subscriptions[topic]; exists {
+		if err := sub.Unsubscribe(); err != nil {
+			return fmt.Errorf("failed to unsubscribe from topic %s: %w", topic, err)
+		}
+		delete(n.subscriptions, topic)
+		n.logger.Info("Unsubscribed from topic", "topic", topic)
+	}
+	return nil
+}
+
+// Health checks the NATS connection health
+func (n *NATSEventBus) Health(ctx context.Context) error {
+	if n.conn == nil {
+		return fmt.Errorf("nats connection is nil")
+	}
+
+	if !n.conn.IsConnected() {
+		return fmt.Errorf("nats connection is not active")
+	}
+
+	return nil
+}
+
+// NewEventBus creates a new event bus based on the configuration
+func NewEventBus(cfg *config.MessageQueueConfig, log logger.Logger) (EventBus, error) {
+	switch cfg.Type {
+	case "nats":
+		return NewNATSEventBus(cfg, log), nil
+	default:
+		return nil, fmt.Errorf("unsupported message queue type: %s", cfg.Type)
+	}
+}
@@ -0,0 +1,11 @@
+module github.com/example/golden/pkg/events
+
+go 1.23.0
+
+require (
+	github.com/example/golden/pkg/shared v0.0.0
+	github.com/google/uuid v1.6.0
+	github.com/nats-io/nats.go v1.42.0
+)
+
+replace github.com/example/golden/pkg/shared => ../shared
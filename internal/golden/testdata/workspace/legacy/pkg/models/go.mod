@@ -0,0 +1,5 @@
+module github.com/example/golden/pkg/models
+
+go 1.23.0
+
+require github.com/google/uuid v1.6.0
@@ -0,0 +1,240 @@
+// Package config provides shared configuration utilities for the golden workspace.
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// Config represents the shared configuration structure
+type Config struct {
+	// Application settings
+	AppName     string `mapstructure:"app_name"`
+	Environment string `mapstructure:"environment"`
+	Debug       bool   `mapstructure:"debug"`
+
+	// Server settings
+	Server ServerConfig `mapstructure:"server"`
+
+	// Database settings
+	Database DatabaseConfig `mapstructure:"database"`
+
+	// Message queue settings
+	MessageQueue MessageQueueConfig `mapstructure:"message_queue"`
+
+	// Logging settings
+	Logger LoggerConfig `mapstructure:"logger"`
+}
+
+// ServerConfig holds server-related configuration
+type ServerConfig struct {
+	Host         string `mapstructure:"host"`
+	Port         int    `mapstructure:"port"`
+	ReadTimeout  int    `mapstructure:"read_timeout"`
+	WriteTimeout int    `mapstructure:"write_timeout"`
+	IdleTimeout  int    `mapstructure:"idle_timeout"`
+}
+
+// DatabaseConfig holds database connection configuration
+type DatabaseConfig struct {
+	Type            string `mapstructure:"type"`
+	Host            string `mapstructure:"host"`
+	Port            int    `mapstructure:"port"`
+	Name            string `mapstructure:"name"`
+	User            string `mapstructure:"user"`
+	Password        string `mapstructure:"password"`
+	SSLMode         string `mapstructure:"ssl_mode"`
+	URL             string `mapstructure:"url"`
+	MaxOpenConns    int    `mapstructure:"max_open_conns"`
+	MaxIdleConns    int    `mapstructure:"max_idle_conns"`
+	ConnMaxLifetime int    `mapstructure:"conn_max_lifetime"`
+}
+
+// ConnectionString returns the database connection string
+func (d DatabaseConfig) ConnectionString() string {
+	if d.URL != "" {
+		return d.URL
+	}
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		d.Host, d.Port, d.User, d.Password, d.Name, d.SSLMode)
+}
+
+// MessageQueueConfig holds message queue configuration
+type MessageQueueConfig struct {
+	Type           string `mapstructure:"type"`
+	URL            string `mapstructure:"url"`
+	Host           string `mapstructure:"host"`
+	Port           int    `mapstructure:"port"`
+	User           string `mapstructure:"user"`
+	Password       string `mapstructure:"password"`
+	MaxConnections int    `mapstructure:"max_connections"`
+	RetryAttempts  int    `mapstructure:"retry_attempts"`
+	RetryDelay     int    `mapstructure:"retry_delay"`
+}
+
+// ConnectionString returns the message queue connection string
+func (m MessageQueueConfig) ConnectionString() string {
+	if m.URL != "" {
+		return m.URL
+	}
+	if m.User != "" && m.Password != "" {
+		return fmt.Sprintf("nats://%s:%s@%s:%d", m.User, m.Password, m.Host, m.Port)
+	}
+	return fmt.Sprintf("nats://%s:%d", m.Host, m.Port)
+}
+
+// LoggerConfig holds logging configuration
+type LoggerConfig struct {
+	Level  string `mapstructure:"level"`
+	Format string `mapstructure:"format"`
+	Output string `mapstructure:"output"`
+}
+
+// Load loads configuration from various sources
+func Load(configName string) (*Config, error) {
+	config := &Config{}
+
+	// Set default values
+	setDefaults()
+
+	// Set config name and paths
+	viper.SetConfigName(configName)
+	viper.SetConfigType("yaml")
+	viper.AddConfigPath(".")
+	viper.AddConfigPath("./configs")
+	viper.AddConfigPath("../configs")
+	viper.AddConfigPath("../../configs")
+
+	// Enable environment variable binding: server.port is read from GOLDEN_SERVER_PORT
+	viper.SetEnvPrefix("GOLDEN")
+	viper.AutomaticEnv()
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+
+	// Read config file
+	if err := viper.ReadInConfig(); err != nil {
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+			return nil, fmt.Errorf("failed to read config file: %w", err)
+		}
+		// Config file not found; continue with defaults and env vars
+	}
+
+	// Unmarshal config
+	if err := viper.Unmarshal(config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	// Validate configuration
+	if err := validateConfig(config); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	return config, nil
+}
+
+// setDefaults sets default configuration values
+func setDefaults() {
+	viper.SetDefault("app_name", "golden")
+	viper.SetDefault("environment", getEnvOrDefault("ENVIRONMENT", "development"))
+	viper.SetDefault("debug", getEnvOrDefault("DEBUG", "false") == "true")
+
+	// Server defaults
+	viper.SetDefault("server.host", "0.0.0.0")
+	viper.SetDefault("server.port", 8080)
+	viper.SetDefault("server.read_timeout", 30)
+	viper.SetDefault("server.write_timeout", 30)
+	viper.SetDefault("server.idle_timeout", 120)
+	// Database defaults
+	viper.SetDefault("database.type", "postgres")
+	viper.SetDefault("database.url", "")
+	viper.SetDefault("database.host", "localhost")
+	viper.SetDefault("database.port", 5432)
+	viper.SetDefault("database.name", "golden")
+	viper.SetDefault("database.user", "golden")
+	viper.SetDefault("database.password", "password")
+	viper.SetDefault("database.ssl_mode", "disable")
+	viper.SetDefault("database.max_open_conns", 25)
+	viper.SetDefault("database.max_idle_conns", 5)
+	viper.SetDefault("database.conn_max_lifetime", 300)
+	// Message queue defaults
+	viper.SetDefault("message_queue.type", "nats")
+	viper.SetDefault("message_queue.url", "")
+	viper.SetDefault("message_queue.user", "")
+	viper.SetDefault("message_queue.password", "")
+	viper.SetDefault("message_queue.host", "localhost")
+	viper.SetDefault("message_queue.port", 4222)
+	viper.SetDefault("message_queue.max_connections", 10)
+	viper.SetDefault("message_queue.retry_attempts", 3)
+	viper.SetDefault("message_queue.retry_delay", 1000)
+
+	// Logger defaults
+	viper.SetDefault("logger.level", "info")
+	viper.SetDefault("logger.format", "json")
+	viper.SetDefault("logger.output", "stdout")
+
+}
+
+// validateConfig validates the loaded configuration
+func validateConfig(config *Config) error {
+	if config.AppName == "" {
+		return fmt.Errorf("app_name is required")
+	}
+
+	if config.Server.Port <= 0 || config.Server.Port > 65535 {
+		return fmt.Errorf("server.port must be between 1 and 65535")
+	}
+	if config.Database.Name == "" {
+		return fmt.Errorf("database.name is required")
+	}
+
+	// Validate logger level
+	validLevels := []string{"debug", "info", "warn", "error", "fatal", "panic"}
+	if !contains(validLevels, config.Logger.Level) {
+		return fmt.Errorf("logger.level must be one of: %v", validLevels)
+	}
+
+	// Validate logger format
+	validFormats := []string{"json", "text"}
+	if !contains(validFormats, config.Logger.Format) {
+		return fmt.Errorf("logger.format must be one of: %v", validFormats)
+	}
+
+	return nil
+}
+
+// IsDevelopment returns true if the environment is development
+func (c *Config) IsDevelopment() bool {
+	return c.Environment == "development" || c.Environment == "dev"
+}
+
+// IsProduction returns true if the environment is production
+func (c *Config) IsProduction() bool {
+	return c.Environment == "production" || c.Environment == "prod"
+}
+
+// IsStaging returns true if the environment is staging
+func (c *Config) IsStaging() bool {
+	return c.Environment == "staging" || c.Environment == "stage"
+}
+
+// Helper functions
+
+// getEnvOrDefault returns environment variable value or default
+func getEnvOrDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// contains checks if a slice contains a string
+func contains(slice []string, item string) bool {
+	for _, s := range slice {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,8 @@
+module github.com/example/golden/pkg/shared
+
+go 1.23.0
+
+require (
+	github.com/go-playground/validator/v10 v10.16.0
+	github.com/spf13/viper v1.18.2
+)
@@ -0,0 +1,152 @@
+// Package logger provides a unified logging interface for the golden workspace.
+package logger
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+)
+
+// Logger defines the logging interface used throughout the workspace
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+	Fatal(msg string, args ...interface{})
+
+	DebugContext(ctx context.Context, msg string, args ...interface{})
+	InfoContext(ctx context.Context, msg string, args ...interface{})
+	WarnContext(ctx context.Context, msg string, args ...interface{})
+	ErrorContext(ctx context.Context, msg string, args ...interface{})
+
+	With(args ...interface{}) Logger
+	WithContext(ctx context.Context) Logger
+}
+
+// Config holds logger configuration
+type Config struct {
+	Level  string // debug, info, warn, error
+	Format string // json, text
+	Output string // stdout, stderr, or file path
+}
+
+// New creates a new logger instance based on the configuration
+func New(config Config) Logger {
+	return newSlogLogger(config)
+}
+
+// Default returns a default logger instance
+func Default() Logger {
+	return New(Config{
+		Level:  "info",
+		Format: "json",
+		Output: "stdout",
+	})
+}
+
+// slogLogger implements Logger using slog
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+func newSlogLogger(config Config) Logger {
+	var output io.Writer
+	switch config.Output {
+	case "stderr":
+		output = os.Stderr
+	case "stdout", "":
+		output = os.Stdout
+	default:
+		// Try to open file
+		if file, err := os.OpenFile(config.Output, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666); err == nil {
+			output = file
+		} else {
+			output = os.Stdout
+		}
+	}
+
+	var level slog.Level
+	switch config.Level {
+	case "debug":
+		level = slog.LevelDebug
+	case "warn":
+		level = slog.LevelWarn
+	case "error":
+		level = slog.LevelError
+	default:
+		level = slog.LevelInfo
+	}
+
+	var handler slog.Handler
+	if config.Format == "json" {
+		handler = slog.NewJSONHandler(output, &slog.HandlerOptions{Level: level})
+	} else {
+		handler = slog.NewTextHandler(output, &slog.HandlerOptions{Level: level})
+	}
+
+	return &slogLogger{
+		logger: slog.New(handler),
+	}
+}
+
+func (l *slogLogger) Debug(msg string, args ...interface{}) {
+	l.logger.Debug(msg, args...)
+}
+
+func (l *slogLogger) Info(msg string, args ...interface{}) {
+	l.logger.Info(msg, args...)
+}
+
+func (l *slogLogger) Warn(msg string, args ...interface{}) {
+	l.logger.Warn(msg, args...)
+}
+
+func (l *slogLogger) Error(msg string, args ...interface{}) {
+	l.logger.Error(msg, args...)
+}
+
+func (l *slogLogger) Fatal(msg string, args ...interface{}) {
+	l.logger.Error(msg, args...)
+	os.Exit(1)
+}
+
+func (l *slogLogger) DebugContext(ctx context.Context, msg string, args ...interface{}) {
+	l.logger.DebugContext(ctx, msg, args...)
+}
+
+func (l *slogLogger) InfoContext(ctx context.Context, msg string, args ...interface{}) {
+	l.logger.InfoContext(ctx, msg, args...)
+}
+
+func (l *slogLogger) WarnContext(ctx context.Context, msg string, args ...interface{}) {
+	l.logger.WarnContext(ctx, msg, args...)
+}
+
+func (l *slogLogger) ErrorContext(ctx context.Context, msg string, args ...interface{}) {
+	l.logger.ErrorContext(ctx, msg, args...)
+}
+
+func (l *slogLogger) With(args ...interface{}) Logger {
+	return &slogLogger{
+		logger: l.logger.With(args...),
+	}
+}
+
+func (l *slogLogger) WithContext(ctx context.Context) Logger {
+	return &slogLogger{
+		logger: l.logger.With("trace_id", getTraceIDFromContext(ctx)),
+	}
+}
+
+// Helper function to extract trace ID from context
+func getTraceIDFromContext(ctx context.Context) string {
+	if traceID := ctx.Value("trace_id"); traceID != nil {
+		if id, ok := traceID.(string); ok {
+			return id
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"github.com/example/golden/pkg/shared/config"
+	"github.com/example/golden/pkg/shared/logger"
+)
+
+// Database represents the database interface
+type Database interface {
+	Connect(ctx context.Context) error
+	Close() error
+	Health(ctx context.Context) error
+	GetDB() *sql.DB
+	Migrate(ctx context.Context) error
+}
+
+// PostgresDB implements the Database interface for PostgreSQL
+type PostgresDB struct {
+	config *config.DatabaseConfig
+	db     *sql.DB
+	logger logger.Logger
+}
+
+// NewPostgresDB creates a new PostgreSQL database instance
+func NewPostgresDB(cfg *config.DatabaseConfig, log logger.Logger) *PostgresDB {
+	return &PostgresDB{
+		config: cfg,
+		logger: log,
+	}
+}
+
+// Connect establishes a connection to PostgreSQL
+func (p *PostgresDB) Connect(ctx context.Context) error {
+	db, err := sql.Open("postgres", p.config.ConnectionString())
+	if err != nil {
+		return fmt.Errorf("failed to open postgres connection: %w", err)
+	}
+
+	// Configure connection pool
+	db.SetMaxOpenConns(p.config.MaxOpenConns)
+	db.SetMaxIdleConns(p.config.MaxIdleConns)
+	db.SetConnMaxLifetime(time.Duration(p.config.ConnMaxLifetime) * time.Second)
+
+	// Test the connection
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return fmt.Errorf("failed to ping postgres database: %w", err)
+	}
+
+	p.db = db
+	p.logger.Info("Successfully connected to PostgreSQL database")
+	return nil
+}
+
+// Close closes the PostgreSQL connection
+func (p *PostgresDB) Close() error {
+	if p.db != nil {
+		if err := p.db.Close(); err != nil {
+			return fmt.Errorf("failed to close postgres connection: %w", err)
+		}
+	}
+	p.logger.Info("PostgreSQL connection closed")
+	return nil
+}
+
+// Health checks the PostgreSQL connection health
+func (p *PostgresDB) Health(ctx context.Context) error {
+	if p.db == nil {
+		return fmt.Errorf("database connection is nil")
+	}
+
+	if err := p.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("postgres health check failed: %w", err)
+	}
+
+	return nil
+}
+
+// GetDB returns the standard sql.DB instance
+func (p *PostgresDB) GetDB() *sql.DB {
+	return p.db
+}
+
+// Migrate runs database migrations
+func (p *PostgresDB) Migrate(ctx context.Context) error {
+	// Implementation depends on your migration strategy
+	// This is a placeholder for migration logic
+	p.logger.Info("Running PostgreSQL migrations...")
+	return nil
+}
+
+// NewDatabase creates a new database instance based on the configuration
+func NewDatabase(cfg *config.DatabaseConfig, log logger.Logger) (Database, error) {
+	switch cfg.Type {
+	case "postgres", "postgresql":
+		return NewPostgresDB(cfg, log), nil
+	default:
+		return nil, fmt.Errorf("unsupported database type: %s", cfg.Type)
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/example/golden/pkg/storage
+
+go 1.23.0
+
+require (
+	github.com/example/golden/pkg/shared v0.0.0
+	github.com/lib/pq v1.10.9
+)
+
+replace github.com/example/golden/pkg/shared => ../shared
@@ -0,0 +1,7 @@
+module github.com/example/golden/shared
+
+go 1.23
+
+require (
+	github.com/stretchr/testify v1.9.0
+)
@@ -0,0 +1,25 @@
+package logger
+
+// Logger defines the common interface for all logging implementations
+type Logger interface {
+	// Debug logs a debug message with optional key-value pairs
+	Debug(msg string, keysAndValues ...interface{})
+
+	// Info logs an informational message with optional key-value pairs
+	Info(msg string, keysAndValues ...interface{})
+
+	// Warn logs a warning message with optional key-value pairs
+	Warn(msg string, keysAndValues ...interface{})
+
+	// Error logs an error message with optional key-value pairs
+	Error(msg string, keysAndValues ...interface{})
+
+	// Fatal logs a fatal message and exits the program
+	Fatal(msg string, keysAndValues ...interface{})
+
+	// With returns a new logger with the given key-value pairs as context
+	With(keysAndValues ...interface{}) Logger
+
+	// WithError returns a new logger with an error context
+	WithError(err error) Logger
+}
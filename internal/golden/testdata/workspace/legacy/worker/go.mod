@@ -0,0 +1,12 @@
+module github.com/example/golden/worker
+
+go 1.23
+
+require (
+	github.com/example/golden/shared v0.0.0
+	github.com/stretchr/testify v1.9.0
+)
+
+// The shared module lives in this repository; go.work resolves it for local builds
+// and this replace keeps the worker module buildable on its own, as in its Dockerfile
+replace github.com/example/golden/shared => ../shared
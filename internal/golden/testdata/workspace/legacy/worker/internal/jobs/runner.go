@@ -0,0 +1,54 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/example/golden/shared/logger"
+)
+
+// Runner runs the jobs of the worker one after the other
+type Runner struct {
+	jobs []Job
+	log  logger.Logger
+}
+
+// NewRunner creates a Runner running the given jobs
+func NewRunner(log logger.Logger, jobs ...Job) *Runner {
+	return &Runner{jobs: jobs, log: log}
+}
+
+// RunOnce runs every job once, a failing job does not stop the others
+func (r *Runner) RunOnce(ctx context.Context) error {
+	var errs []error
+	for _, job := range r.jobs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := job.Run(ctx); err != nil {
+			r.log.Error("Job failed", "job", job.Name(), "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", job.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Run runs the jobs at once, then every interval until ctx is cancelled
+func (r *Runner) Run(ctx context.Context, interval time.Duration) {
+	r.log.Info("Worker started", "interval", interval.String())
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_ = r.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,49 @@
+// Command worker runs the background jobs of golden
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/example/golden/shared/config"
+	"github.com/example/golden/shared/logger"
+	"github.com/example/golden/shared/shutdown"
+	"github.com/example/golden/worker/internal/jobs"
+)
+
+// jobRunner runs the background jobs of the worker
+type jobRunner interface {
+	// RunOnce runs every job once, returning the joined errors of the jobs that failed
+	RunOnce(ctx context.Context) error
+	// Run runs the jobs at once, then every interval until ctx is cancelled
+	Run(ctx context.Context, interval time.Duration)
+}
+
+func main() {
+	cfg := config.Load("worker")
+
+	log, err := logger.NewFactory().Create(cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create logger: %v\n", err)
+		os.Exit(1)
+	}
+	log = log.With("service", cfg.Service, "environment", cfg.Environment)
+
+	interval, err := config.Duration("WORKER_INTERVAL", 30*time.Second)
+	if err != nil {
+		log.Fatal("Invalid worker configuration", "error", err)
+	}
+
+	ctx, stop := shutdown.Context()
+	defer stop()
+
+	newRunner(log).Run(ctx, interval)
+	log.Info("Worker stopped")
+}
+
+// newRunner wires the jobs of the worker
+func newRunner(log logger.Logger) jobRunner {
+	return jobs.NewRunner(log, jobs.NewHeartbeatJob(log))
+}
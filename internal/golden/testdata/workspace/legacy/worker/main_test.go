@@ -0,0 +1,42 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/golden/shared/logger"
+)
+
+func TestRunOnce(t *testing.T) {
+	var output bytes.Buffer
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: "info", Format: "json"}, &output)
+	require.NoError(t, err)
+
+	require.NoError(t, newRunner(log).RunOnce(context.Background()))
+	assert.Contains(t, output.String(), "heartbeat")
+}
+
+func TestRunStopsWhenCancelled(t *testing.T) {
+	var output bytes.Buffer
+	log, err := logger.NewFactory().CreateWithOutput(logger.Config{Level: "error", Format: "json"}, &output)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		newRunner(log).Run(ctx, time.Hour)
+		close(done)
+	}()
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not stop after its context was cancelled")
+	}
+}
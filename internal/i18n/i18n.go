@@ -0,0 +1,165 @@
+// Package i18n translates the CLI's user-facing strings. Messages live in YAML
+// catalogs under locales/, one file per language, mapping message keys to
+// fmt-style format strings. English is the reference catalog and the fallback for
+// keys a translation does not provide yet.
+package i18n
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultLocale is the reference catalog every other locale falls back to
+const DefaultLocale = "en"
+
+// LocaleEnv overrides the locale detected from the standard environment variables
+const LocaleEnv = "GO_STARTER_LANG"
+
+//go:embed locales/*.yaml
+var localesFS embed.FS
+
+var (
+	mu       sync.RWMutex
+	catalogs = map[string]map[string]string{}
+	current  = DefaultLocale
+)
+
+func init() {
+	entries, err := fs.ReadDir(localesFS, "locales")
+	if err != nil {
+		panic(fmt.Sprintf("i18n: reading embedded catalogs: %v", err))
+	}
+	for _, entry := range entries {
+		data, err := localesFS.ReadFile(path.Join("locales", entry.Name()))
+		if err != nil {
+			panic(fmt.Sprintf("i18n: reading %s: %v", entry.Name(), err))
+		}
+		if err := LoadCatalog(strings.TrimSuffix(entry.Name(), path.Ext(entry.Name())), data); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// LoadCatalog registers (or extends) the catalog for locale from YAML data
+func LoadCatalog(locale string, data []byte) error {
+	var messages map[string]string
+	if err := yaml.Unmarshal(data, &messages); err != nil {
+		return fmt.Errorf("i18n: parsing catalog %s: %w", locale, err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	catalog := catalogs[locale]
+	if catalog == nil {
+		catalog = make(map[string]string, len(messages))
+		catalogs[locale] = catalog
+	}
+	for key, message := range messages {
+		catalog[key] = message
+	}
+	return nil
+}
+
+// Available returns the locales that have a catalog, sorted
+func Available() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	locales := make([]string, 0, len(catalogs))
+	for locale := range catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// Locale returns the active locale
+func Locale() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// SetLocale activates the catalog closest to tag (e.g. "fr-CA" selects "fr") and
+// returns the locale chosen. Unsupported languages select DefaultLocale.
+func SetLocale(tag string) string {
+	locale := Match(tag)
+
+	mu.Lock()
+	current = locale
+	mu.Unlock()
+	return locale
+}
+
+// Match returns the available locale closest to tag
+func Match(tag string) string {
+	parsed, err := language.Parse(normalizeTag(tag))
+	if err != nil {
+		return DefaultLocale
+	}
+
+	available := Available()
+	tags := make([]language.Tag, 0, len(available)+1)
+	tags = append(tags, language.Make(DefaultLocale))
+	for _, locale := range available {
+		if locale != DefaultLocale {
+			tags = append(tags, language.Make(locale))
+		}
+	}
+
+	_, index, confidence := language.NewMatcher(tags).Match(parsed)
+	if confidence == language.No || index == 0 {
+		return DefaultLocale
+	}
+	return tags[index].String()
+}
+
+// Detect returns the locale requested by the environment: GO_STARTER_LANG, then the
+// POSIX LC_ALL, LC_MESSAGES and LANG variables
+func Detect() string {
+	for _, name := range []string{LocaleEnv, "LC_ALL", "LC_MESSAGES", "LANG"} {
+		if value := os.Getenv(name); value != "" {
+			return Match(value)
+		}
+	}
+	return DefaultLocale
+}
+
+// T returns the message for key in the active locale, formatted with args. Missing
+// translations fall back to English, unknown keys are returned as is.
+func T(key string, args ...any) string {
+	mu.RLock()
+	message, ok := catalogs[current][key]
+	if !ok {
+		message, ok = catalogs[DefaultLocale][key]
+	}
+	mu.RUnlock()
+
+	if !ok {
+		message = key
+	}
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
+
+// normalizeTag turns POSIX locale names such as "fr_FR.UTF-8" into BCP 47 tags
+func normalizeTag(tag string) string {
+	if i := strings.IndexAny(tag, ".@"); i >= 0 {
+		tag = tag[:i]
+	}
+	if tag == "C" || tag == "POSIX" {
+		return DefaultLocale
+	}
+	return strings.ReplaceAll(tag, "_", "-")
+}
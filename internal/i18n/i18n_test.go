@@ -0,0 +1,129 @@
+package i18n
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch(t *testing.T) {
+	tests := map[string]string{
+		"fr":          "fr",
+		"fr_FR.UTF-8": "fr",
+		"fr-CA":       "fr",
+		"es_MX":       "es",
+		"es-419":      "es",
+		"en_US.UTF-8": "en",
+		"de_DE":       "en",
+		"C":           "en",
+		"POSIX":       "en",
+		"not a tag":   "en",
+	}
+	for tag, want := range tests {
+		assert.Equal(t, want, Match(tag), tag)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	for _, name := range []string{LocaleEnv, "LC_ALL", "LC_MESSAGES", "LANG"} {
+		t.Setenv(name, "")
+	}
+	assert.Equal(t, DefaultLocale, Detect())
+
+	t.Setenv("LANG", "es_ES.UTF-8")
+	assert.Equal(t, "es", Detect())
+
+	t.Setenv("LC_ALL", "fr_FR.UTF-8")
+	assert.Equal(t, "fr", Detect())
+
+	t.Setenv(LocaleEnv, "en")
+	assert.Equal(t, "en", Detect())
+}
+
+func TestT(t *testing.T) {
+	t.Cleanup(func() { SetLocale(DefaultLocale) })
+
+	assert.Equal(t, "Module:", T("summary.module"))
+	assert.Equal(t, "3 files in 1s", T("progress.phase_summary", 3, "files", "1s"))
+	assert.Equal(t, "no.such.key", T("no.such.key"))
+
+	require.Equal(t, "fr", SetLocale("fr_FR"))
+	assert.Equal(t, "Module :", T("summary.module"))
+
+	require.NoError(t, LoadCatalog("fr", []byte(`test.only_english: "x"`)))
+	require.NoError(t, LoadCatalog("en", []byte(`test.fallback: "English %s"`)))
+	assert.Equal(t, "English fallback", T("test.fallback", "fallback"), "missing translations fall back to English")
+
+	require.Equal(t, "es", SetLocale("es"))
+	assert.Equal(t, "Módulo:", T("summary.module"))
+}
+
+// TestCatalogs is the check for translators: catalogs only use keys the English
+// catalog defines, with the same format verbs, and cover all of them
+func TestCatalogs(t *testing.T) {
+	reference := catalogs[DefaultLocale]
+	require.NotEmpty(t, reference)
+
+	for _, locale := range []string{"fr", "es"} {
+		t.Run(locale, func(t *testing.T) {
+			catalog := catalogs[locale]
+			for key, message := range catalog {
+				if strings.HasPrefix(key, "test.") {
+					continue
+				}
+				english, ok := reference[key]
+				if !assert.True(t, ok, "%s is not in the English catalog", key) {
+					continue
+				}
+				assert.Equal(t, formatVerbs(english), formatVerbs(message), "format verbs of %s", key)
+			}
+			for key := range reference {
+				if !strings.HasPrefix(key, "test.") {
+					assert.Contains(t, catalog, key, "missing translation")
+				}
+			}
+		})
+	}
+}
+
+var keyUsage = regexp.MustCompile(`i18n\.T\("([^"]+)"`)
+
+// TestKeysUsedInCode checks that every i18n.T key used in the repository exists
+func TestKeysUsedInCode(t *testing.T) {
+	root := filepath.Join("..", "..")
+	found := 0
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && (d.Name() == "blueprints" || d.Name() == "node_modules" || strings.HasPrefix(d.Name(), ".")) && path != root {
+			return filepath.SkipDir
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for _, match := range keyUsage.FindAllStringSubmatch(string(content), -1) {
+			found++
+			assert.Contains(t, catalogs[DefaultLocale], match[1], "%s uses an undefined message key", path)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Positive(t, found)
+}
+
+var verbPattern = regexp.MustCompile(`%[-+#0-9.]*[vTtbcdoOqxXUeEfFgGsp%]`)
+
+func formatVerbs(message string) []string {
+	return verbPattern.FindAllString(message, -1)
+}
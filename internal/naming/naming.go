@@ -0,0 +1,301 @@
+// Package naming normalizes user supplied project names and derives the
+// identifiers generated projects need (Go packages, Docker images, Kubernetes
+// resources, environment variables) from a single set of rules.
+package naming
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// MaxProjectNameLength is the longest project name accepted after normalization
+const MaxProjectNameLength = 100
+
+// maxKubernetesNameLength is the DNS-1123 label limit used for resource names
+const maxKubernetesNameLength = 63
+
+// reservedNames are device names Windows refuses to use as file or directory names,
+// with or without an extension
+var reservedNames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true,
+	"COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true,
+	"LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// goReservedNames are the package patterns the go command gives a meaning of its
+// own, see go help packages
+var goReservedNames = map[string]bool{
+	"all": true, "cmd": true, "main": true, "std": true, "tool": true, "work": true,
+}
+
+// stdlibNames are the names of standard library packages a project package would
+// shadow in its own imports and in the imports of its users
+var stdlibNames = map[string]bool{
+	"archive": true, "ast": true, "atomic": true, "base32": true, "base64": true, "big": true,
+	"binary": true, "bufio": true, "build": true, "bytes": true, "cmp": true, "compress": true,
+	"constraint": true, "context": true, "cookiejar": true, "crypto": true, "csv": true,
+	"database": true, "debug": true, "embed": true, "encoding": true, "errors": true,
+	"exec": true, "expvar": true, "filepath": true, "flag": true, "fmt": true, "format": true,
+	"fs": true, "gob": true, "hash": true, "heap": true, "hex": true, "html": true,
+	"http": true, "httptest": true, "httputil": true, "image": true, "io": true, "iter": true,
+	"json": true, "list": true, "log": true, "mail": true, "maps": true, "math": true,
+	"mime": true, "multipart": true, "net": true, "netip": true, "os": true, "parser": true,
+	"path": true, "plugin": true, "pprof": true, "rand": true, "reflect": true, "regexp": true,
+	"rpc": true, "runtime": true, "signal": true, "slices": true, "slog": true, "smtp": true,
+	"sort": true, "sql": true, "strconv": true, "strings": true, "sync": true, "syscall": true,
+	"tabwriter": true, "tar": true, "template": true, "testing": true, "text": true,
+	"time": true, "tls": true, "token": true, "trace": true, "types": true, "unicode": true,
+	"unique": true, "unsafe": true, "url": true, "user": true, "utf8": true, "x509": true,
+	"xml": true, "zip": true,
+}
+
+// letterFolds spells out letters that have no decomposed ASCII form
+var letterFolds = strings.NewReplacer(
+	"ß", "ss", "ẞ", "SS", "æ", "ae", "Æ", "AE", "œ", "oe", "Œ", "OE",
+	"ø", "o", "Ø", "O", "đ", "d", "Đ", "D", "ł", "l", "Ł", "L", "þ", "th", "Þ", "TH",
+)
+
+// Names holds every identifier derived from a project name
+type Names struct {
+	Project    string `json:"project"`
+	Package    string `json:"package"`
+	Identifier string `json:"identifier"`
+	Docker     string `json:"docker_image"`
+	Kubernetes string `json:"kubernetes"`
+	EnvPrefix  string `json:"env_prefix"`
+}
+
+// IsReservedName reports whether name is a reserved Windows device name
+func IsReservedName(name string) bool {
+	base := strings.ToUpper(strings.TrimSpace(name))
+	if i := strings.IndexByte(base, '.'); i >= 0 {
+		base = base[:i]
+	}
+	return reservedNames[base]
+}
+
+// Collision describes what a valid project name collides with: a standard library
+// package its Go package would shadow, or a package pattern of the go command.
+// It returns "" for names free of collisions.
+func Collision(name string) string {
+	pkg := PackageName(name)
+	switch {
+	case goReservedNames[strings.ToLower(name)]:
+		return fmt.Sprintf("%q is a package pattern of the go command", name)
+	case stdlibNames[pkg]:
+		return fmt.Sprintf("package %s shadows the standard library package of the same name", pkg)
+	}
+	return ""
+}
+
+// Normalize turns free-form input into a project name that is safe to use as a
+// directory name: accents are transliterated, whitespace becomes hyphens and
+// anything outside [A-Za-z0-9._-] is dropped. The result is validated.
+func Normalize(name string) (string, error) {
+	if !utf8.ValidString(name) {
+		return "", types.NewValidationError("project name is not valid UTF-8", nil)
+	}
+
+	folded, _, err := transform.String(transform.Chain(norm.NFKD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), letterFolds.Replace(name))
+	if err != nil {
+		return "", types.NewValidationError("failed to normalize project name", err)
+	}
+
+	var b strings.Builder
+	pendingSeparator := false
+	for _, r := range strings.TrimSpace(folded) {
+		switch {
+		case isNameRune(r):
+			if pendingSeparator && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingSeparator = false
+			b.WriteRune(r)
+		case unicode.IsSpace(r) || r == '/' || r == '\\':
+			pendingSeparator = true
+		}
+	}
+
+	normalized := strings.TrimFunc(b.String(), func(r rune) bool { return !isASCIILetter(r) && !isDigit(r) })
+	if err := Validate(normalized); err != nil {
+		if normalized == "" && strings.TrimSpace(name) != "" {
+			return "", types.NewValidationError(fmt.Sprintf("project name %q has no characters usable in a project name, use ASCII letters and digits", name), nil)
+		}
+		return "", err
+	}
+	return normalized, nil
+}
+
+// Validate checks that name is already in normalized form
+func Validate(name string) error {
+	if name == "" {
+		return types.NewValidationError("project name cannot be empty", nil)
+	}
+	if len(name) > MaxProjectNameLength {
+		return types.NewValidationError(fmt.Sprintf("project name too long (max %d characters)", MaxProjectNameLength), nil)
+	}
+	if strings.Contains(name, "..") {
+		return types.NewValidationError("project name contains path characters", nil)
+	}
+
+	hasLetter := false
+	for _, r := range name {
+		if !isNameRune(r) {
+			return types.NewValidationError(fmt.Sprintf("project name contains invalid character %q (allowed: letters, digits, '-', '_', '.')", r), nil)
+		}
+		if isASCIILetter(r) {
+			hasLetter = true
+		}
+	}
+	if !hasLetter {
+		return types.NewValidationError("project name must contain at least one letter", nil)
+	}
+
+	first, _ := utf8.DecodeRuneInString(name)
+	last, _ := utf8.DecodeLastRuneInString(name)
+	if !isASCIILetter(first) && !isDigit(first) || !isASCIILetter(last) && !isDigit(last) {
+		return types.NewValidationError("project name must start and end with a letter or digit", nil)
+	}
+	if IsReservedName(name) {
+		return types.NewValidationError(fmt.Sprintf("project name '%s' is reserved", name), nil)
+	}
+	return nil
+}
+
+// Derive validates name and returns all identifiers derived from it
+func Derive(name string) (Names, error) {
+	if err := Validate(name); err != nil {
+		return Names{}, err
+	}
+	return Names{
+		Project:    name,
+		Package:    PackageName(name),
+		Identifier: GoIdentifier(name),
+		Docker:     DockerImageName(name),
+		Kubernetes: KubernetesName(name),
+		EnvPrefix:  EnvPrefix(name),
+	}, nil
+}
+
+// PackageName derives a Go package name: lowercase letters and digits only,
+// never starting with a digit and never a Go keyword
+func PackageName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if isASCIILetter(r) || isDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+
+	pkg := b.String()
+	if pkg == "" || isDigit(rune(pkg[0])) || token.IsKeyword(pkg) {
+		pkg = "app" + pkg
+	}
+	return pkg
+}
+
+// GoIdentifier derives an exported Go identifier in CamelCase
+func GoIdentifier(name string) string {
+	var b strings.Builder
+	for _, word := range words(name) {
+		b.WriteString(strings.ToUpper(word[:1]))
+		b.WriteString(word[1:])
+	}
+
+	ident := b.String()
+	if ident == "" || isDigit(rune(ident[0])) {
+		ident = "App" + ident
+	}
+	return ident
+}
+
+// DockerImageName derives a Docker repository name (lowercase words joined by hyphens)
+func DockerImageName(name string) string {
+	image := hyphenate(name)
+	if image == "" {
+		return "app"
+	}
+	return image
+}
+
+// KubernetesName derives a DNS-1123 label usable as a Kubernetes resource name
+func KubernetesName(name string) string {
+	label := hyphenate(name)
+	if len(label) > maxKubernetesNameLength {
+		label = strings.TrimRight(label[:maxKubernetesNameLength], "-")
+	}
+	if label == "" || !isASCIILetter(rune(label[0])) {
+		label = strings.TrimRight("app-"+label, "-")
+		if len(label) > maxKubernetesNameLength {
+			label = strings.TrimRight(label[:maxKubernetesNameLength], "-")
+		}
+	}
+	return label
+}
+
+// EnvPrefix derives an upper snake case prefix for environment variables
+func EnvPrefix(name string) string {
+	prefix := strings.ToUpper(strings.Join(words(name), "_"))
+	if prefix == "" {
+		return "APP"
+	}
+	if isDigit(rune(prefix[0])) {
+		prefix = "APP_" + prefix
+	}
+	return prefix
+}
+
+// TerraformProviderName derives a Terraform provider type name from a project
+// name following the terraform-provider-<name> convention: the prefix is dropped
+// and the remaining words are lowercased and joined, as resource type names are
+// built as <provider>_<resource>
+func TerraformProviderName(name string) string {
+	lower := strings.ToLower(name)
+	for _, prefix := range []string{"terraform-provider-", "terraform_provider_"} {
+		lower = strings.TrimPrefix(lower, prefix)
+	}
+
+	provider := strings.Join(words(lower), "")
+	if provider == "" {
+		return "example"
+	}
+	if isDigit(rune(provider[0])) {
+		provider = "provider" + provider
+	}
+	return provider
+}
+
+// words splits a name on its separators
+func words(name string) []string {
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return !isASCIILetter(r) && !isDigit(r)
+	})
+}
+
+// hyphenate lowercases name and joins its words with single hyphens
+func hyphenate(name string) string {
+	return strings.ToLower(strings.Join(words(name), "-"))
+}
+
+func isNameRune(r rune) bool {
+	return isASCIILetter(r) || isDigit(r) || r == '-' || r == '_' || r == '.'
+}
+
+func isASCIILetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
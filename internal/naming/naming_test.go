@@ -0,0 +1,135 @@
+package naming
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr string
+	}{
+		{input: "my-project", want: "my-project"},
+		{input: "  Café API  ", want: "Cafe-API"},
+		{input: "Straße Service", want: "Strasse-Service"},
+		{input: "ＦＵＬＬ width", want: "FULL-width"},
+		{input: "hello   world\tapp", want: "hello-world-app"},
+		{input: "../etc/passwd", want: "etc-passwd"},
+		{input: "-rf my_app_", want: "rf-my_app"},
+		{input: "app<name>?", want: "appname"},
+		{input: "项目", wantErr: "no characters usable"},
+		{input: "", wantErr: "cannot be empty"},
+		{input: "con", wantErr: "reserved"},
+		{input: "LPT1.txt", wantErr: "reserved"},
+		{input: "1234", wantErr: "at least one letter"},
+		{input: "a..b", wantErr: "path characters"},
+		{input: strings.Repeat("a", MaxProjectNameLength+1), wantErr: "too long"},
+		{input: "bad\xffname", wantErr: "UTF-8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Normalize(tt.input)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			again, err := Normalize(got)
+			require.NoError(t, err)
+			assert.Equal(t, got, again, "normalization should be idempotent")
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate("my-project"))
+	assert.NoError(t, Validate("My_Project.v2"))
+	assert.Error(t, Validate("my project"))
+	assert.Error(t, Validate("café"))
+	assert.Error(t, Validate("-flag"))
+	assert.Error(t, Validate("trailing."))
+	assert.Error(t, Validate("nul"))
+}
+
+func TestIsReservedName(t *testing.T) {
+	assert.True(t, IsReservedName("CON"))
+	assert.True(t, IsReservedName("com9"))
+	assert.True(t, IsReservedName("aux.tar.gz"))
+	assert.False(t, IsReservedName("console"))
+	assert.False(t, IsReservedName("com10"))
+}
+
+func TestCollision(t *testing.T) {
+	assert.Contains(t, Collision("http"), "standard library")
+	assert.Contains(t, Collision("Sync"), "standard library")
+	assert.Contains(t, Collision("all"), "go command")
+	assert.Empty(t, Collision("http-gateway"))
+	assert.Empty(t, Collision("orders"))
+}
+
+func TestDerive(t *testing.T) {
+	tests := []struct {
+		name string
+		want Names
+	}{
+		{
+			name: "my-project",
+			want: Names{Project: "my-project", Package: "myproject", Identifier: "MyProject", Docker: "my-project", Kubernetes: "my-project", EnvPrefix: "MY_PROJECT"},
+		},
+		{
+			name: "Payment_Service.v2",
+			want: Names{Project: "Payment_Service.v2", Package: "paymentservicev2", Identifier: "PaymentServiceV2", Docker: "payment-service-v2", Kubernetes: "payment-service-v2", EnvPrefix: "PAYMENT_SERVICE_V2"},
+		},
+		{
+			name: "3d-renderer",
+			want: Names{Project: "3d-renderer", Package: "app3drenderer", Identifier: "App3dRenderer", Docker: "3d-renderer", Kubernetes: "app-3d-renderer", EnvPrefix: "APP_3D_RENDERER"},
+		},
+		{
+			name: "go",
+			want: Names{Project: "go", Package: "appgo", Identifier: "Go", Docker: "go", Kubernetes: "go", EnvPrefix: "GO"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Derive(tt.name)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := Derive("not valid")
+	assert.Error(t, err)
+}
+
+func TestTerraformProviderName(t *testing.T) {
+	tests := map[string]string{
+		"terraform-provider-acme":     "acme",
+		"Terraform_Provider_Acme":     "acme",
+		"terraform-provider-my-cloud": "mycloud",
+		"inventory":                   "inventory",
+		"terraform-provider-3scale":   "provider3scale",
+		"terraform-provider-":         "example",
+	}
+
+	for name, want := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, want, TerraformProviderName(name))
+		})
+	}
+}
+
+func TestKubernetesNameLength(t *testing.T) {
+	name := KubernetesName(strings.Repeat("service-", 12))
+	assert.LessOrEqual(t, len(name), 63)
+	assert.False(t, strings.HasSuffix(name, "-"))
+}
@@ -0,0 +1,156 @@
+// Package outputfs provides the file systems projects can be generated into: the
+// local disk (including mounted network or cloud bucket file systems) and remote
+// hosts reached over SSH.
+package outputfs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Local writes to the local file system
+type Local struct{}
+
+// MkdirAll creates path and any missing parents
+func (Local) MkdirAll(path string, perm fs.FileMode) error {
+	return os.MkdirAll(path, perm)
+}
+
+// WriteFile writes data to path with exactly the requested permissions.
+// os.WriteFile alone is subject to the umask and keeps the mode of existing files.
+func (Local) WriteFile(path string, data []byte, perm fs.FileMode) error {
+	if err := os.WriteFile(path, data, perm); err != nil {
+		return err
+	}
+	return os.Chmod(path, perm)
+}
+
+// Symlink creates link pointing at target. Where the OS refuses unprivileged
+// symlinks (Windows without developer mode) a regular file target is copied instead.
+func (l Local) Symlink(target, link string) error {
+	err := os.Symlink(filepath.FromSlash(target), link)
+	if err == nil || runtime.GOOS != "windows" {
+		return err
+	}
+
+	resolved := filepath.Join(filepath.Dir(link), filepath.FromSlash(target))
+	info, statErr := os.Stat(resolved)
+	if statErr != nil || !info.Mode().IsRegular() {
+		return err
+	}
+	content, readErr := os.ReadFile(resolved)
+	if readErr != nil {
+		return err
+	}
+	return l.WriteFile(link, content, info.Mode().Perm())
+}
+
+// Remove removes a file or an empty directory
+func (Local) Remove(path string) error {
+	return os.Remove(path)
+}
+
+// RemoveAll removes path and everything below it
+func (Local) RemoveAll(path string) error {
+	return os.RemoveAll(path)
+}
+
+// Stat describes path
+func (Local) Stat(path string) (fs.FileInfo, error) {
+	return os.Stat(path)
+}
+
+// ReadDir lists the entries of a directory
+func (Local) ReadDir(path string) ([]fs.DirEntry, error) {
+	return os.ReadDir(path)
+}
+
+// IsLocal reports whether fsys writes to the local disk; nil means the local disk
+func IsLocal(fsys types.OutputFS) bool {
+	switch fsys.(type) {
+	case nil, Local, *Local:
+		return true
+	}
+	return false
+}
+
+// Target is an opened output location
+type Target struct {
+	FS types.OutputFS
+	// Path is the directory in FS that projects are generated below
+	Path string
+	// Remote is set when FS is not the local disk
+	Remote bool
+
+	closer io.Closer
+}
+
+// Join returns the path of name below the target directory
+func (t *Target) Join(name string) string {
+	if t.Remote {
+		return path.Join(t.Path, name)
+	}
+	return filepath.Join(t.Path, name)
+}
+
+// Close releases the connection held by remote targets
+func (t *Target) Close() error {
+	if t.closer == nil {
+		return nil
+	}
+	return t.closer.Close()
+}
+
+// String returns the target in the form it was given
+func (t *Target) String() string {
+	if fsys, ok := t.FS.(*SSH); ok {
+		return fsys.URL(t.Path)
+	}
+	return t.Path
+}
+
+// IsRemoteSpec reports whether spec names a remote target rather than a local path
+func IsRemoteSpec(spec string) bool {
+	return strings.HasPrefix(spec, "ssh://")
+}
+
+// Open parses an output location. Local paths (including mounted network and
+// bucket file systems) write to disk; ssh://[user@]host[:port]/path generates on
+// a remote host over SSH.
+func Open(ctx context.Context, spec string) (*Target, error) {
+	if !IsRemoteSpec(spec) {
+		return &Target{FS: Local{}, Path: spec}, nil
+	}
+
+	u, err := url.Parse(spec)
+	if err != nil {
+		return nil, types.NewValidationError(fmt.Sprintf("invalid output target %q", spec), err)
+	}
+	if u.Host == "" {
+		return nil, types.NewValidationError(fmt.Sprintf("output target %q has no host", spec), nil)
+	}
+
+	remotePath := u.Path
+	if remotePath == "" {
+		remotePath = "."
+	} else if strings.HasPrefix(remotePath, "/~/") {
+		// ssh://host/~/projects is relative to the remote home directory
+		remotePath = strings.TrimPrefix(remotePath, "/~/")
+	}
+
+	fsys, err := DialSSH(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	return &Target{FS: fsys, Path: remotePath, Remote: true, closer: fsys}, nil
+}
@@ -0,0 +1,280 @@
+package outputfs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/url"
+	"os"
+	"os/user"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/francknouama/go-starter/internal/utils"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// defaultSSHPort is used when the target URL has no port
+const defaultSSHPort = "22"
+
+// defaultIdentityFiles are tried, in order, when no identity is given
+var defaultIdentityFiles = []string{"id_ed25519", "id_ecdsa", "id_rsa"}
+
+// SSH writes to a remote host by running POSIX shell commands over an SSH
+// connection, so the remote only needs sh and coreutils (no SFTP subsystem)
+type SSH struct {
+	client *ssh.Client
+	user   string
+	host   string
+}
+
+// NewSSH wraps an established SSH client
+func NewSSH(client *ssh.Client) *SSH {
+	return &SSH{client: client, user: client.User(), host: client.RemoteAddr().String()}
+}
+
+// DialSSH connects to the host named by u. Authentication uses the ssh-agent when
+// SSH_AUTH_SOCK is set, then the identity file given as ?identity= or the default
+// keys in ~/.ssh. Host keys are verified against ~/.ssh/known_hosts.
+func DialSSH(ctx context.Context, u *url.URL) (*SSH, error) {
+	username := u.User.Username()
+	if username == "" {
+		current, err := user.Current()
+		if err != nil {
+			return nil, types.NewConfigError("cannot determine SSH user, add it to the target URL", err)
+		}
+		username = current.Username
+	}
+
+	host := u.Hostname()
+	port := u.Port()
+	if port == "" {
+		port = defaultSSHPort
+	}
+	addr := net.JoinHostPort(host, port)
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, types.NewConfigError("cannot locate ~/.ssh", err)
+	}
+
+	hostKeyCallback, err := knownhosts.New(filepath.Join(home, ".ssh", "known_hosts"))
+	if err != nil {
+		return nil, types.NewConfigError("cannot read ~/.ssh/known_hosts to verify the host key", err)
+	}
+
+	auth, err := sshAuthMethods(home, u.Query().Get("identity"))
+	if err != nil {
+		return nil, err
+	}
+
+	config := &ssh.ClientConfig{
+		User:            username,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         15 * time.Second,
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, types.NewFileSystemError(fmt.Sprintf("failed to connect to %s", addr), err)
+	}
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, addr, config)
+	if err != nil {
+		_ = conn.Close()
+		return nil, types.NewFileSystemError(fmt.Sprintf("SSH handshake with %s failed", addr), err)
+	}
+
+	fsys := NewSSH(ssh.NewClient(sshConn, chans, reqs))
+	fsys.host = u.Host
+	return fsys, nil
+}
+
+// sshAuthMethods collects the agent and key file authentication methods available
+func sshAuthMethods(home, identity string) ([]ssh.AuthMethod, error) {
+	var methods []ssh.AuthMethod
+
+	if sock := os.Getenv("SSH_AUTH_SOCK"); sock != "" {
+		if conn, err := net.Dial("unix", sock); err == nil {
+			methods = append(methods, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+		}
+	}
+
+	files := []string{identity}
+	if identity == "" {
+		files = files[:0]
+		for _, name := range defaultIdentityFiles {
+			files = append(files, filepath.Join(home, ".ssh", name))
+		}
+	}
+
+	var signers []ssh.Signer
+	for _, file := range files {
+		key, err := os.ReadFile(file)
+		if err != nil {
+			if identity != "" {
+				return nil, types.NewConfigError(fmt.Sprintf("cannot read SSH identity %s", file), err)
+			}
+			continue
+		}
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			var passphraseErr *ssh.PassphraseMissingError
+			if identity != "" || !errors.As(err, &passphraseErr) {
+				return nil, types.NewConfigError(fmt.Sprintf("cannot use SSH identity %s (load passphrase protected keys into ssh-agent)", file), err)
+			}
+			continue
+		}
+		signers = append(signers, signer)
+	}
+	if len(signers) > 0 {
+		methods = append(methods, ssh.PublicKeys(signers...))
+	}
+
+	if len(methods) == 0 {
+		return nil, types.NewConfigError("no SSH credentials found: start ssh-agent or pass ?identity=<key file>", nil)
+	}
+	return methods, nil
+}
+
+// URL formats p on this host as an ssh:// URL
+func (s *SSH) URL(p string) string {
+	u := url.URL{Scheme: "ssh", User: url.User(s.user), Host: s.host, Path: p}
+	if !path.IsAbs(p) {
+		u.Path = "/~/" + p
+	}
+	return u.String()
+}
+
+// Close closes the SSH connection
+func (s *SSH) Close() error {
+	return s.client.Close()
+}
+
+// MkdirAll creates path and any missing parents
+func (s *SSH) MkdirAll(p string, perm fs.FileMode) error {
+	_, err := s.run("mkdir", p, nil, "mkdir -p -- %s", p)
+	return err
+}
+
+// WriteFile streams data to path and sets exactly perm
+func (s *SSH) WriteFile(p string, data []byte, perm fs.FileMode) error {
+	_, err := s.run("write", p, data, "cat > %[1]s && chmod %[2]s %[1]s", p, fmt.Sprintf("%04o", perm.Perm()))
+	return err
+}
+
+// Symlink creates link pointing at target
+func (s *SSH) Symlink(target, link string) error {
+	_, err := s.run("symlink", link, nil, "ln -s -- %s %s", target, link)
+	return err
+}
+
+// Remove removes a file or an empty directory
+func (s *SSH) Remove(p string) error {
+	_, err := s.run("remove", p, nil, "if [ -d %[1]s ] && [ ! -L %[1]s ]; then rmdir -- %[1]s; else rm -- %[1]s; fi", p)
+	return err
+}
+
+// RemoveAll removes path and everything below it
+func (s *SSH) RemoveAll(p string) error {
+	_, err := s.run("removeall", p, nil, "rm -rf -- %s", p)
+	return err
+}
+
+// Stat describes path; only the name and the file type are known
+func (s *SSH) Stat(p string) (fs.FileInfo, error) {
+	out, err := s.run("stat", p, nil, "if [ -L %[1]s ]; then echo l; elif [ -d %[1]s ]; then echo d; elif [ -e %[1]s ]; then echo f; else echo n; fi", p)
+	if err != nil {
+		return nil, err
+	}
+
+	var mode fs.FileMode
+	switch strings.TrimSpace(out) {
+	case "n":
+		return nil, &fs.PathError{Op: "stat", Path: p, Err: fs.ErrNotExist}
+	case "d":
+		mode = fs.ModeDir | 0755
+	case "l":
+		mode = fs.ModeSymlink | 0777
+	default:
+		mode = 0644
+	}
+	return remoteFileInfo{name: path.Base(filepath.ToSlash(p)), mode: mode}, nil
+}
+
+// ReadDir lists the entries of a directory, including hidden ones
+func (s *SSH) ReadDir(p string) ([]fs.DirEntry, error) {
+	info, err := s.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, &fs.PathError{Op: "readdir", Path: p, Err: errors.New("not a directory")}
+	}
+
+	out, err := s.run("readdir", p, nil, "ls -A1 -- %s", p)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []fs.DirEntry
+	for _, name := range strings.Split(strings.TrimSpace(out), "\n") {
+		if name != "" {
+			entries = append(entries, fs.FileInfoToDirEntry(remoteFileInfo{name: name}))
+		}
+	}
+	return entries, nil
+}
+
+// run executes a shell command built from format and shell quoted paths, feeding
+// stdin to it. Failures are reported as *fs.PathError for op and p.
+func (s *SSH) run(op, p string, stdin []byte, format string, args ...string) (string, error) {
+	session, err := s.client.NewSession()
+	if err != nil {
+		return "", &fs.PathError{Op: op, Path: p, Err: err}
+	}
+	defer session.Close()
+
+	quoted := make([]any, len(args))
+	for i, arg := range args {
+		quoted[i] = utils.ShellQuote(filepath.ToSlash(arg))
+	}
+
+	var stdout, stderr bytes.Buffer
+	session.Stdout = &stdout
+	session.Stderr = &stderr
+	if stdin != nil {
+		session.Stdin = bytes.NewReader(stdin)
+	}
+
+	if err := session.Run(fmt.Sprintf(format, quoted...)); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			err = errors.New(msg)
+		}
+		return "", &fs.PathError{Op: op, Path: p, Err: err}
+	}
+	return stdout.String(), nil
+}
+
+// remoteFileInfo is the little the shell commands tell about a remote file
+type remoteFileInfo struct {
+	name string
+	mode fs.FileMode
+}
+
+func (i remoteFileInfo) Name() string       { return i.name }
+func (i remoteFileInfo) Size() int64        { return 0 }
+func (i remoteFileInfo) Mode() fs.FileMode  { return i.mode }
+func (i remoteFileInfo) ModTime() time.Time { return time.Time{} }
+func (i remoteFileInfo) IsDir() bool        { return i.mode.IsDir() }
+func (i remoteFileInfo) Sys() any           { return nil }
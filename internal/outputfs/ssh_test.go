@@ -0,0 +1,175 @@
+package outputfs
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestSSH_Operations(t *testing.T) {
+	fsys := NewSSH(startTestSSHServer(t))
+	root := filepath.ToSlash(t.TempDir())
+	dir := root + "/it's a project/cmd"
+
+	require.NoError(t, fsys.MkdirAll(dir, 0755))
+	require.NoError(t, fsys.WriteFile(dir+"/run.sh", []byte("#!/bin/sh\necho ok\n"), 0750))
+
+	content, err := os.ReadFile(filepath.FromSlash(dir + "/run.sh"))
+	require.NoError(t, err)
+	assert.Equal(t, "#!/bin/sh\necho ok\n", string(content))
+
+	info, err := os.Stat(filepath.FromSlash(dir + "/run.sh"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0750), info.Mode().Perm())
+
+	require.NoError(t, fsys.Symlink("run.sh", dir+"/run"))
+	target, err := os.Readlink(filepath.FromSlash(dir + "/run"))
+	require.NoError(t, err)
+	assert.Equal(t, "run.sh", target)
+
+	stat, err := fsys.Stat(dir)
+	require.NoError(t, err)
+	assert.True(t, stat.IsDir())
+	assert.Equal(t, "cmd", stat.Name())
+
+	_, err = fsys.Stat(dir + "/missing")
+	assert.True(t, os.IsNotExist(err))
+
+	entries, err := fsys.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.ElementsMatch(t, []string{"run", "run.sh"}, names)
+
+	require.NoError(t, fsys.Remove(dir+"/run"))
+	assert.Error(t, fsys.Remove(root+"/it's a project"), "non-empty directories are not removed")
+
+	require.NoError(t, fsys.RemoveAll(root+"/it's a project"))
+	_, err = os.Stat(filepath.FromSlash(root + "/it's a project"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestOpen(t *testing.T) {
+	target, err := Open(context.Background(), "projects")
+	require.NoError(t, err)
+	assert.False(t, target.Remote)
+	assert.True(t, IsLocal(target.FS))
+	assert.Equal(t, filepath.Join("projects", "api"), target.Join("api"))
+	assert.NoError(t, target.Close())
+
+	_, err = Open(context.Background(), "ssh:///srv/projects")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no host")
+}
+
+func TestIsLocal(t *testing.T) {
+	assert.True(t, IsLocal(nil))
+	assert.True(t, IsLocal(Local{}))
+	assert.False(t, IsLocal(&SSH{}))
+}
+
+// startTestSSHServer runs an SSH server on localhost that executes commands with the
+// local sh and returns a client connected to it
+func startTestSSHServer(t *testing.T) *ssh.Client {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("the test server needs a POSIX shell")
+	}
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(key)
+	require.NoError(t, err)
+
+	config := &ssh.ServerConfig{NoClientAuth: true}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestSSHConn(conn, config)
+		}
+	}()
+
+	client, err := ssh.Dial("tcp", listener.Addr().String(), &ssh.ClientConfig{
+		User:            "test",
+		HostKeyCallback: ssh.FixedHostKey(signer.PublicKey()),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func serveTestSSHConn(conn net.Conn, config *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "only sessions are supported")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+		go serveTestSSHSession(channel, requests)
+	}
+}
+
+func serveTestSSHSession(channel ssh.Channel, requests <-chan *ssh.Request) {
+	defer channel.Close()
+
+	for req := range requests {
+		if req.Type != "exec" {
+			_ = req.Reply(false, nil)
+			continue
+		}
+
+		var payload struct{ Command string }
+		if err := ssh.Unmarshal(req.Payload, &payload); err != nil {
+			_ = req.Reply(false, nil)
+			return
+		}
+		_ = req.Reply(true, nil)
+
+		cmd := exec.Command("sh", "-c", payload.Command)
+		cmd.Stdin = channel
+		cmd.Stdout = channel
+		cmd.Stderr = channel.Stderr()
+
+		var status uint32
+		if err := cmd.Run(); err != nil {
+			status = 1
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				status = uint32(exitErr.ExitCode())
+			}
+		}
+		_, _ = channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{status}))
+		return
+	}
+}
@@ -0,0 +1,46 @@
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/mod/sumdb/dirhash"
+
+	"github.com/francknouama/go-starter/internal/blueprint"
+	"github.com/francknouama/go-starter/internal/templates"
+)
+
+// Blueprint finds the blueprint of a plugin with the ID id, loaded like a remote
+// blueprint together with the other blueprints of its plugin
+func Blueprint(plugins []*Plugin, id string) (*blueprint.Remote, *Plugin, error) {
+	for _, p := range plugins {
+		if p.Blueprints == "" {
+			continue
+		}
+		root := filepath.Join(p.Dir, filepath.FromSlash(p.Blueprints))
+		registry, err := templates.NewRegistryWithFS(os.DirFS(root))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load the blueprints of plugin %s: %w", p.Name, err)
+		}
+		tmpl, err := registry.Get(id)
+		if err != nil {
+			continue
+		}
+
+		path, _ := tmpl.Metadata["path"].(string)
+		dir := filepath.Join(root, filepath.FromSlash(path))
+		checksum, err := dirhash.HashDir(dir, "blueprint", dirhash.Hash1)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to hash blueprint %s of plugin %s: %w", id, p.Name, err)
+		}
+		return &blueprint.Remote{
+			Source:   blueprint.Source{Repository: p.Dir},
+			Dir:      dir,
+			Checksum: checksum,
+			Registry: registry,
+			Template: tmpl,
+		}, p, nil
+	}
+	return nil, nil, nil
+}
@@ -0,0 +1,112 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"text/template"
+	"time"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/pkg/plugin"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// funcTimeout bounds a template function call, which blueprints cannot cancel
+const funcTimeout = 30 * time.Second
+
+// call runs the command of the plugin in dir with the request and returns its response
+func (p *Plugin) call(ctx context.Context, dir string, req plugin.Request) (string, error) {
+	command := p.Command
+	if !filepath.IsAbs(command) {
+		if local := filepath.Join(p.Dir, command); fileExists(local) {
+			command = local
+		}
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	cmd := exec.CommandContext(ctx, command, p.Args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GO_STARTER_PLUGIN_PROTOCOL="+plugin.ProtocolVersion)
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("plugin %s: %w", p.Name, err)
+	}
+
+	var resp plugin.Response
+	if err := json.Unmarshal(out, &resp); err != nil {
+		return "", fmt.Errorf("plugin %s: invalid response: %w", p.Name, err)
+	}
+	if resp.Error != "" {
+		return "", fmt.Errorf("plugin %s: %s", p.Name, resp.Error)
+	}
+	return resp.Result, nil
+}
+
+// funcs returns the template functions of the plugin, calling its command with
+// their arguments printed as strings
+func (p *Plugin) funcs() template.FuncMap {
+	funcs := make(template.FuncMap, len(p.Funcs))
+	for _, name := range p.Funcs {
+		name := name
+		funcs[name] = func(args ...any) (string, error) {
+			printed := make([]string, len(args))
+			for i, arg := range args {
+				printed[i] = fmt.Sprint(arg)
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), funcTimeout)
+			defer cancel()
+			return p.call(ctx, p.Dir, plugin.Request{Method: plugin.MethodFunc, Func: name, Args: printed})
+		}
+	}
+	return funcs
+}
+
+// postStep returns the post-generation step of the plugin, run in the generated project
+func (p *Plugin) postStep() generator.PostStep {
+	return generator.PostStep{
+		Name: "plugin " + p.Name,
+		Run: func(ctx context.Context, config types.ProjectConfig, outputPath string) error {
+			project := &plugin.Project{
+				Name:         config.Name,
+				Module:       config.Module,
+				Type:         config.Type,
+				Architecture: config.Architecture,
+				Framework:    config.Framework,
+				GoVersion:    config.GoVersion,
+				Path:         outputPath,
+				Variables:    config.Variables,
+			}
+			_, err := p.call(ctx, outputPath, plugin.Request{Method: plugin.MethodPostGenerate, Project: project})
+			return err
+		},
+	}
+}
+
+// Extend adds the template functions and post-generation steps of the plugins to g
+func Extend(g *generator.Generator, plugins []*Plugin) error {
+	for _, p := range plugins {
+		var steps []generator.PostStep
+		if p.PostGenerate {
+			steps = append(steps, p.postStep())
+		}
+		if err := g.Extend(p.funcs(), steps...); err != nil {
+			return fmt.Errorf("plugin %s: %w", p.Name, err)
+		}
+	}
+	return nil
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
@@ -0,0 +1,121 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/francknouama/go-starter/internal/blueprint"
+	"github.com/francknouama/go-starter/internal/utils"
+)
+
+// installedRecord is written in the directory of each installed plugin
+const installedRecord = ".installed.json"
+
+// Installed records where an installed plugin comes from
+type Installed struct {
+	Source string `json:"source"`
+	// Commit is the commit of the plugin repository, empty for a local directory
+	Commit      string    `json:"commit,omitempty"`
+	InstalledAt time.Time `json:"installed_at"`
+}
+
+// Install copies the plugin of source, a local directory or a git repository
+// written host/org/repo//dir@ref, into dir, DefaultDir when empty, replacing
+// the installed plugin of the same name, and runs its build command. The plugin
+// is prepared in a hidden directory and moved into place once built, so a
+// failed installation leaves the installed plugins untouched.
+func Install(ctx context.Context, dir, source string) (*Plugin, *Installed, error) {
+	if dir == "" {
+		var err error
+		if dir, err = DefaultDir(); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, nil, fmt.Errorf("failed to create plugins directory: %w", err)
+	}
+	tmp, err := os.MkdirTemp(dir, ".install-*")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create plugins directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+
+	installed := &Installed{Source: source, InstalledAt: time.Now().UTC()}
+	staged := filepath.Join(tmp, "plugin")
+	if info, err := os.Stat(source); err == nil && info.IsDir() {
+		if installed.Source, err = filepath.Abs(source); err != nil {
+			return nil, nil, err
+		}
+		if err := utils.CopyDir(source, staged); err != nil {
+			return nil, nil, fmt.Errorf("failed to copy plugin %s: %w", source, err)
+		}
+	} else {
+		parsed, err := blueprint.ParseSource(source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s is neither a directory nor a git repository: %w", source, err)
+		}
+		checkout := filepath.Join(tmp, "repo")
+		if err := os.Mkdir(checkout, 0755); err != nil {
+			return nil, nil, err
+		}
+		if installed.Commit, err = blueprint.Checkout(ctx, parsed, checkout); err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch plugin %s: %w", source, err)
+		}
+		if err := os.Rename(filepath.Join(checkout, filepath.FromSlash(parsed.Dir)), staged); err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch plugin %s: %w", source, err)
+		}
+	}
+
+	p, err := Load(staged)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(p.Build) > 0 {
+		cmd := exec.CommandContext(ctx, p.Build[0], p.Build[1:]...)
+		cmd.Dir = staged
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return nil, nil, fmt.Errorf("failed to build plugin %s: %w: %s", p.Name, err, strings.TrimSpace(string(out)))
+		}
+	}
+
+	data, err := json.MarshalIndent(installed, "", "  ")
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := os.WriteFile(filepath.Join(staged, installedRecord), data, 0644); err != nil {
+		return nil, nil, fmt.Errorf("failed to record plugin %s: %w", p.Name, err)
+	}
+
+	target := filepath.Join(dir, p.Name)
+	if err := os.RemoveAll(target); err != nil {
+		return nil, nil, fmt.Errorf("failed to replace plugin %s: %w", p.Name, err)
+	}
+	if err := os.Rename(staged, target); err != nil {
+		return nil, nil, fmt.Errorf("failed to install plugin %s: %w", p.Name, err)
+	}
+	p.Dir = target
+	return p, installed, nil
+}
+
+// ReadInstalled returns where the plugin comes from, nil for a plugin put in
+// place by hand
+func (p *Plugin) ReadInstalled() (*Installed, error) {
+	data, err := os.ReadFile(filepath.Join(p.Dir, installedRecord))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var installed Installed
+	if err := json.Unmarshal(data, &installed); err != nil {
+		return nil, fmt.Errorf("failed to read the installation of plugin %s: %w", p.Name, err)
+	}
+	return &installed, nil
+}
@@ -0,0 +1,139 @@
+// Package plugin loads the plugins installed in ~/.go-starter/plugins and plugs
+// their prompts, template functions, post-generation steps and blueprints into
+// go-starter new. The plugins themselves are written against pkg/plugin.
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// ManifestFile describes a plugin, at the root of its directory
+const ManifestFile = "plugin.yaml"
+
+// validName matches the plugin names, which name their directory too
+var validName = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// Manifest is the content of plugin.yaml
+type Manifest struct {
+	Name        string `yaml:"name"`
+	Version     string `yaml:"version"`
+	Description string `yaml:"description"`
+	// Command is the executable answering the calls of go-starter, relative to
+	// the plugin directory or looked up in PATH, and Args its arguments
+	Command string   `yaml:"command"`
+	Args    []string `yaml:"args"`
+	// Build is run in the plugin directory once installed, to build Command
+	Build []string `yaml:"build"`
+	// Prompts are the variables the plugin asks for, set with --plugin-var
+	Prompts []types.TemplateVariable `yaml:"prompts"`
+	// Funcs are the template functions the plugin adds
+	Funcs []string `yaml:"funcs"`
+	// PostGenerate runs the plugin in every generated project
+	PostGenerate bool `yaml:"post_generate"`
+	// Blueprints is the directory of the blueprints the plugin adds, relative to the plugin directory
+	Blueprints string `yaml:"blueprints"`
+}
+
+// Plugin is a plugin loaded from its directory
+type Plugin struct {
+	Manifest
+	Dir string
+}
+
+// DefaultDir is where plugins are installed, ~/.go-starter/plugins
+func DefaultDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the home directory: %w", err)
+	}
+	return filepath.Join(home, ".go-starter", "plugins"), nil
+}
+
+// Load reads the plugin in dir
+func Load(dir string) (*Plugin, error) {
+	data, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plugin manifest: %w", err)
+	}
+	var manifest Manifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse %s of %s: %w", ManifestFile, dir, err)
+	}
+
+	if !validName.MatchString(manifest.Name) {
+		return nil, types.NewValidationError(fmt.Sprintf("invalid plugin name %q in %s (lowercase letters, digits and dashes)", manifest.Name, dir), nil)
+	}
+	if manifest.Command == "" && (len(manifest.Funcs) > 0 || manifest.PostGenerate) {
+		return nil, types.NewValidationError(fmt.Sprintf("plugin %s declares template functions or a post-generation step but no command", manifest.Name), nil)
+	}
+	for _, prompt := range manifest.Prompts {
+		if prompt.Name == "" {
+			return nil, types.NewValidationError(fmt.Sprintf("plugin %s declares a prompt without a name", manifest.Name), nil)
+		}
+		if prompt.Validation != "" {
+			if _, err := regexp.Compile(prompt.Validation); err != nil {
+				return nil, types.NewValidationError(fmt.Sprintf("invalid validation of prompt %s of plugin %s", prompt.Name, manifest.Name), err)
+			}
+		}
+	}
+	return &Plugin{Manifest: manifest, Dir: dir}, nil
+}
+
+// List loads the plugins installed in dir, DefaultDir when empty, by name
+func List(dir string) ([]*Plugin, error) {
+	if dir == "" {
+		var err error
+		if dir, err = DefaultDir(); err != nil {
+			return nil, err
+		}
+	}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plugins: %w", err)
+	}
+
+	var plugins []*Plugin
+	for _, entry := range entries {
+		// Installations in progress are hidden
+		if !entry.IsDir() || entry.Name()[0] == '.' {
+			continue
+		}
+		p, err := Load(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		plugins = append(plugins, p)
+	}
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].Name < plugins[j].Name })
+	return plugins, nil
+}
+
+// Capabilities lists what the plugin adds to go-starter
+func (p *Plugin) Capabilities() []string {
+	var capabilities []string
+	if len(p.Prompts) > 0 {
+		capabilities = append(capabilities, "prompts")
+	}
+	if len(p.Funcs) > 0 {
+		capabilities = append(capabilities, "funcs")
+	}
+	if p.PostGenerate {
+		capabilities = append(capabilities, "post-generate")
+	}
+	if p.Blueprints != "" {
+		capabilities = append(capabilities, "blueprints")
+	}
+	return capabilities
+}
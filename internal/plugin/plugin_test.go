@@ -0,0 +1,259 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/blueprint"
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/templates"
+	sdk "github.com/francknouama/go-starter/pkg/plugin"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// The test binary doubles as the executable of the test plugins
+func TestMain(m *testing.M) {
+	if os.Getenv("GO_STARTER_PLUGIN_PROTOCOL") != "" && os.Getenv("GO_STARTER_TEST_PLUGIN") == "1" {
+		sdk.Serve(sdk.Plugin{
+			Funcs: map[string]sdk.Func{
+				"shout": func(args ...string) (string, error) {
+					return strings.ToUpper(strings.Join(args, " ")) + "!", nil
+				},
+				"grumble": func(args ...string) (string, error) {
+					return "", errors.New("nothing to say")
+				},
+			},
+			PostGenerate: func(ctx context.Context, project sdk.Project) error {
+				return os.WriteFile(filepath.Join(project.Path, "PLUGIN"), []byte(project.Name+" "+project.Variables["license"]), 0644)
+			},
+		})
+		return
+	}
+	os.Exit(m.Run())
+}
+
+// writePlugin writes a plugin with the manifest in a directory named after it
+func writePlugin(t *testing.T, root, name, manifest string) string {
+	t.Helper()
+	dir := filepath.Join(root, name)
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ManifestFile), []byte(manifest), 0644))
+	return dir
+}
+
+// execPlugin is the manifest of a plugin served by the test binary
+func execPlugin(t *testing.T, name string) string {
+	t.Helper()
+	t.Setenv("GO_STARTER_TEST_PLUGIN", "1")
+	executable, err := os.Executable()
+	require.NoError(t, err)
+	return `name: ` + name + `
+command: "` + filepath.ToSlash(executable) + `"
+funcs: ["shout", "grumble"]
+post_generate: true
+`
+}
+
+func TestLoad(t *testing.T) {
+	root := t.TempDir()
+
+	t.Run("valid manifest", func(t *testing.T) {
+		dir := writePlugin(t, root, "license", `name: license
+version: 1.2.0
+prompts:
+  - name: license
+    description: License of the project
+    choices: ["MIT", "Apache-2.0"]
+blueprints: blueprints
+`)
+		p, err := Load(dir)
+		require.NoError(t, err)
+		assert.Equal(t, "license", p.Name)
+		assert.Equal(t, dir, p.Dir)
+		assert.Equal(t, []string{"prompts", "blueprints"}, p.Capabilities())
+	})
+
+	tests := map[string]string{
+		"invalid plugin name": "name: My_Plugin\n",
+		"no command":          "name: funcs\nfuncs: [shout]\n",
+		"without a name":      "name: nameless\nprompts:\n  - description: Nameless\n",
+		"invalid validation":  "name: validation\nprompts:\n  - name: owner\n    validation: \"[\"\n",
+	}
+	for want, manifest := range tests {
+		t.Run(want, func(t *testing.T) {
+			_, err := Load(writePlugin(t, t.TempDir(), "plugin", manifest))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), want)
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	root := t.TempDir()
+	writePlugin(t, root, "zeta", "name: zeta\n")
+	writePlugin(t, root, "alpha", "name: alpha\n")
+	writePlugin(t, root, ".install-123", "name: partial\n")
+
+	plugins, err := List(root)
+	require.NoError(t, err)
+	require.Len(t, plugins, 2)
+	assert.Equal(t, "alpha", plugins[0].Name)
+	assert.Equal(t, "zeta", plugins[1].Name)
+
+	plugins, err = List(filepath.Join(root, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, plugins)
+}
+
+func TestAnswers(t *testing.T) {
+	p, err := Load(writePlugin(t, t.TempDir(), "license", `name: license
+prompts:
+  - name: license
+    description: License of the project
+    choices: ["MIT", "Apache-2.0"]
+    default: MIT
+  - name: owner
+    description: Copyright holder
+    required: true
+    validation: "^[A-Z]"
+`))
+	require.NoError(t, err)
+	plugins := []*Plugin{p}
+
+	answers, err := Answers(plugins, map[string]string{"owner": "Acme"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"license": "MIT", "owner": "Acme"}, answers)
+
+	asked := func(p *Plugin, prompt types.TemplateVariable) (string, error) {
+		return map[string]string{"license": "Apache-2.0", "owner": "Jane"}[prompt.Name], nil
+	}
+	answers, err = Answers(plugins, map[string]string{"owner": "Acme"}, asked)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"license": "Apache-2.0", "owner": "Acme"}, answers)
+
+	tests := map[string]map[string]string{
+		"plugin license needs owner":         nil,
+		`invalid license "GPL"`:              {"owner": "Acme", "license": "GPL"},
+		`invalid owner "acme"`:               {"owner": "acme"},
+		"no installed plugin asks for color": {"owner": "Acme", "color": "blue"},
+	}
+	for want, given := range tests {
+		t.Run(want, func(t *testing.T) {
+			_, err := Answers(plugins, given, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), want)
+		})
+	}
+}
+
+func TestExtend(t *testing.T) {
+	p, err := Load(writePlugin(t, t.TempDir(), "shouter", execPlugin(t, "shouter")))
+	require.NoError(t, err)
+
+	registry, err := templates.NewRegistryWithFS(fstest.MapFS{})
+	require.NoError(t, err)
+	g := generator.NewWithRegistry(registry)
+	require.NoError(t, Extend(g, []*Plugin{p}))
+
+	t.Run("template functions", func(t *testing.T) {
+		funcs := p.funcs()
+		tmpl, err := template.New("test").Funcs(funcs).Parse(`{{shout "hello" 42}}`)
+		require.NoError(t, err)
+		var out strings.Builder
+		require.NoError(t, tmpl.Execute(&out, nil))
+		assert.Equal(t, "HELLO 42!", out.String())
+
+		err = template.Must(template.New("test").Funcs(funcs).Parse(`{{grumble}}`)).Execute(&out, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "plugin shouter: nothing to say")
+	})
+
+	t.Run("post-generation step", func(t *testing.T) {
+		project := t.TempDir()
+		step := p.postStep()
+		err := step.Run(context.Background(), types.ProjectConfig{Name: "demo", Variables: map[string]string{"license": "MIT"}}, project)
+		require.NoError(t, err)
+		content, err := os.ReadFile(filepath.Join(project, "PLUGIN"))
+		require.NoError(t, err)
+		assert.Equal(t, "demo MIT", string(content))
+	})
+
+	t.Run("functions registered twice", func(t *testing.T) {
+		err := Extend(g, []*Plugin{p})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "registered twice")
+	})
+}
+
+func TestBlueprint(t *testing.T) {
+	dir := writePlugin(t, t.TempDir(), "greeters", "name: greeters\nblueprints: blueprints\n")
+	_, err := blueprint.Scaffold(filepath.Join(dir, "blueprints"), blueprint.ScaffoldOptions{Name: "greeter"})
+	require.NoError(t, err)
+	p, err := Load(dir)
+	require.NoError(t, err)
+	other, err := Load(writePlugin(t, t.TempDir(), "other", "name: other\n"))
+	require.NoError(t, err)
+
+	remote, owner, err := Blueprint([]*Plugin{other, p}, "greeter")
+	require.NoError(t, err)
+	require.NotNil(t, remote)
+	assert.Equal(t, p, owner)
+	assert.Equal(t, "greeter", remote.Template.ID)
+	assert.True(t, strings.HasPrefix(remote.Checksum, "h1:"), remote.Checksum)
+	assert.FileExists(t, filepath.Join(remote.Dir, "template.yaml"))
+
+	remote, owner, err = Blueprint([]*Plugin{other, p}, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, remote)
+	assert.Nil(t, owner)
+}
+
+func TestInstall(t *testing.T) {
+	source := writePlugin(t, t.TempDir(), "src", `name: builder
+version: 0.1.0
+build: ["sh", "-c", "echo built > BUILT"]
+`)
+	root := t.TempDir()
+
+	p, installed, err := Install(context.Background(), root, source)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(root, "builder"), p.Dir)
+	assert.Equal(t, source, installed.Source)
+	assert.Empty(t, installed.Commit)
+	assert.FileExists(t, filepath.Join(p.Dir, "BUILT"))
+
+	record, err := p.ReadInstalled()
+	require.NoError(t, err)
+	assert.Equal(t, source, record.Source)
+
+	t.Run("replaces the installed plugin", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(source, ManifestFile), []byte("name: builder\nversion: 0.2.0\n"), 0644))
+		_, _, err := Install(context.Background(), root, source)
+		require.NoError(t, err)
+		plugins, err := List(root)
+		require.NoError(t, err)
+		require.Len(t, plugins, 1)
+		assert.Equal(t, "0.2.0", plugins[0].Version)
+		assert.NoFileExists(t, filepath.Join(plugins[0].Dir, "BUILT"))
+	})
+
+	t.Run("keeps the installed plugin when the build fails", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(source, ManifestFile), []byte("name: builder\nversion: 0.3.0\nbuild: [\"false\"]\n"), 0644))
+		_, _, err := Install(context.Background(), root, source)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to build plugin builder")
+		plugins, err := List(root)
+		require.NoError(t, err)
+		require.Len(t, plugins, 1)
+		assert.Equal(t, "0.2.0", plugins[0].Version)
+	})
+}
@@ -0,0 +1,62 @@
+package plugin
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Answers returns the values of the prompts of the plugins: the values given,
+// keyed by prompt name, or the answers of ask, or the defaults. ask is nil when
+// no one can answer, so required prompts must then be given.
+func Answers(plugins []*Plugin, given map[string]string, ask func(p *Plugin, prompt types.TemplateVariable) (string, error)) (map[string]string, error) {
+	answers := make(map[string]string)
+	for _, p := range plugins {
+		for _, prompt := range p.Prompts {
+			value, ok := given[prompt.Name]
+			if !ok && ask != nil {
+				var err error
+				if value, err = ask(p, prompt); err != nil {
+					return nil, err
+				}
+			} else if !ok && prompt.Default != nil {
+				value = fmt.Sprint(prompt.Default)
+			}
+			if err := checkAnswer(p, prompt, value); err != nil {
+				return nil, err
+			}
+			answers[prompt.Name] = value
+		}
+	}
+
+	for name := range given {
+		if _, ok := answers[name]; !ok {
+			return nil, types.NewValidationError(fmt.Sprintf("no installed plugin asks for %s, remove --plugin-var %s", name, name), nil)
+		}
+	}
+	return answers, nil
+}
+
+// checkAnswer checks the value of a prompt against its choices and validation
+func checkAnswer(p *Plugin, prompt types.TemplateVariable, value string) error {
+	if value == "" {
+		if prompt.Required {
+			return types.NewValidationError(fmt.Sprintf("plugin %s needs %s (%s), set --plugin-var %s=<value>", p.Name, prompt.Name, prompt.Description, prompt.Name), nil)
+		}
+		return nil
+	}
+	if len(prompt.Choices) > 0 {
+		for _, choice := range prompt.Choices {
+			if value == choice {
+				return nil
+			}
+		}
+		return types.NewValidationError(fmt.Sprintf("invalid %s %q for plugin %s (supported: %v)", prompt.Name, value, p.Name, prompt.Choices), nil)
+	}
+	// The validation was compiled when the plugin was loaded
+	if prompt.Validation != "" && !regexp.MustCompile(prompt.Validation).MatchString(value) {
+		return types.NewValidationError(fmt.Sprintf("invalid %s %q for plugin %s (must match %s)", prompt.Name, value, p.Name, prompt.Validation), nil)
+	}
+	return nil
+}
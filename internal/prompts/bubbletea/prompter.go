@@ -8,6 +8,7 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/francknouama/go-starter/internal/i18n"
 	"github.com/francknouama/go-starter/internal/prompts/interfaces"
 	"github.com/francknouama/go-starter/internal/utils"
 	"github.com/francknouama/go-starter/pkg/types"
@@ -313,30 +314,39 @@ func (p *BubbleTeaPrompter) promptProjectName() (string, error) {
 	suggestion := utils.GenerateRandomProjectName()
 	alternatives := utils.GenerateMultipleNames(3)
 
-	help := fmt.Sprintf("Press Enter for: %s\nOther suggestions: %s",
-		suggestion, strings.Join(alternatives, ", "))
+	help := i18n.T("prompt.project_name.help_short", suggestion, strings.Join(alternatives, ", "))
 
-	return p.RunTextInput("🚀 What's your project name?", help, suggestion)
+	return p.RunTextInput("🚀 "+i18n.T("prompt.project_name"), help, suggestion)
 }
 
 // promptModulePath prompts for Go module path using Bubble Tea UI
 func (p *BubbleTeaPrompter) promptModulePath(projectName string) (string, error) {
 	defaultModule := fmt.Sprintf("github.com/username/%s", projectName)
-	help := "Go module path for imports (e.g., github.com/username/project)"
+	help := i18n.T("prompt.module_path.help")
 
-	return p.RunTextInput("Module path:", help, defaultModule)
+	return p.RunTextInput(i18n.T("prompt.module_path"), help, defaultModule)
 }
 
 // promptProjectType prompts for project type selection using Bubble Tea UI
 func (p *BubbleTeaPrompter) promptProjectType() (string, error) {
 	items := []interfaces.SelectionItem{
-		interfaces.NewSelectionItem("Web API", "REST API or web service", "web-api"),
-		interfaces.NewSelectionItem("CLI Application", "Command-line tool", "cli"),
-		interfaces.NewSelectionItem("Library", "Reusable Go package", "library"),
-		interfaces.NewSelectionItem("AWS Lambda", "Serverless function", "lambda"),
-	}
-
-	return p.RunSelection("What type of project?", items)
+		interfaces.NewSelectionItem("Web API", i18n.T("prompt.project_type.web_api"), "web-api"),
+		interfaces.NewSelectionItem("CLI Application", i18n.T("prompt.project_type.cli"), "cli"),
+		interfaces.NewSelectionItem("Library", i18n.T("prompt.project_type.library"), "library"),
+		interfaces.NewSelectionItem("AWS Lambda", i18n.T("prompt.project_type.lambda"), "lambda"),
+		interfaces.NewSelectionItem("gRPC Service", i18n.T("prompt.project_type.grpc_service"), "grpc-service"),
+		interfaces.NewSelectionItem("Event Service", i18n.T("prompt.project_type.event_service"), "event-service"),
+		interfaces.NewSelectionItem("Terraform Provider", i18n.T("prompt.project_type.terraform_provider"), "terraform-provider"),
+		interfaces.NewSelectionItem("Terminal UI", i18n.T("prompt.project_type.tui"), "tui"),
+		interfaces.NewSelectionItem("Chat Bot", i18n.T("prompt.project_type.bot"), "bot"),
+		interfaces.NewSelectionItem("Web App", i18n.T("prompt.project_type.web_app"), "web-app"),
+		interfaces.NewSelectionItem("Realtime", i18n.T("prompt.project_type.realtime"), "realtime"),
+		interfaces.NewSelectionItem("API Gateway", i18n.T("prompt.project_type.gateway"), "gateway"),
+		interfaces.NewSelectionItem("Desktop App", i18n.T("prompt.project_type.desktop"), "desktop"),
+		interfaces.NewSelectionItem("Workflow", i18n.T("prompt.project_type.workflow"), "workflow"),
+	}
+
+	return p.RunSelection(i18n.T("prompt.project_type"), items)
 }
 
 // promptFramework prompts for framework selection using Bubble Tea UI
@@ -346,23 +356,23 @@ func (p *BubbleTeaPrompter) promptFramework(projectType string) (string, error)
 	switch projectType {
 	case "web-api":
 		items = []interfaces.SelectionItem{
-			interfaces.NewSelectionItem("Gin", "Fast HTTP web framework (recommended)", "gin"),
-			interfaces.NewSelectionItem("Echo", "High performance, minimalist web framework", "echo"),
-			interfaces.NewSelectionItem("Fiber", "Express inspired web framework", "fiber"),
-			interfaces.NewSelectionItem("Chi", "Lightweight, idiomatic router", "chi"),
-			interfaces.NewSelectionItem("Standard library", "Built-in net/http package", "standard"),
+			interfaces.NewSelectionItem("Gin", i18n.T("prompt.framework.gin"), "gin"),
+			interfaces.NewSelectionItem("Echo", i18n.T("prompt.framework.echo"), "echo"),
+			interfaces.NewSelectionItem("Fiber", i18n.T("prompt.framework.fiber"), "fiber"),
+			interfaces.NewSelectionItem("Chi", i18n.T("prompt.framework.chi"), "chi"),
+			interfaces.NewSelectionItem("Standard library", i18n.T("prompt.framework.nethttp"), "standard"),
 		}
 	case "cli":
 		items = []interfaces.SelectionItem{
-			interfaces.NewSelectionItem("Cobra", "Powerful CLI framework (recommended)", "cobra"),
-			interfaces.NewSelectionItem("Standard library", "Built-in flag package", "standard"),
+			interfaces.NewSelectionItem("Cobra", i18n.T("prompt.framework.cobra"), "cobra"),
+			interfaces.NewSelectionItem("Standard library", i18n.T("prompt.framework.flag"), "standard"),
 		}
 	default:
 		// No framework selection needed
 		return "", nil
 	}
 
-	return p.RunSelection("Which framework?", items)
+	return p.RunSelection(i18n.T("prompt.framework"), items)
 }
 
 // promptLogger prompts for logger selection using Bubble Tea UI
@@ -373,24 +383,24 @@ func (p *BubbleTeaPrompter) promptLogger(projectType string) (string, error) {
 	}
 
 	items := []interfaces.SelectionItem{
-		interfaces.NewSelectionItem("slog", "Go built-in structured logging (recommended)", "slog"),
-		interfaces.NewSelectionItem("zap", "High-performance, zero-allocation logging", "zap"),
-		interfaces.NewSelectionItem("logrus", "Feature-rich, popular logging library", "logrus"),
-		interfaces.NewSelectionItem("zerolog", "Zero allocation, chainable API logging", "zerolog"),
+		interfaces.NewSelectionItem("slog", i18n.T("prompt.logger.slog"), "slog"),
+		interfaces.NewSelectionItem("zap", i18n.T("prompt.logger.zap"), "zap"),
+		interfaces.NewSelectionItem("logrus", i18n.T("prompt.logger.logrus"), "logrus"),
+		interfaces.NewSelectionItem("zerolog", i18n.T("prompt.logger.zerolog"), "zerolog"),
 	}
 
-	return p.RunSelection("Which logger?", items)
+	return p.RunSelection(i18n.T("prompt.logger"), items)
 }
 
 // promptGoVersion prompts for Go version selection
 func (p *BubbleTeaPrompter) promptGoVersion() (string, error) {
 	items := []interfaces.SelectionItem{
-		interfaces.NewSelectionItem("1.21", "Stable LTS release (recommended)", "1.21"),
-		interfaces.NewSelectionItem("1.22", "Latest stable release", "1.22"),
-		interfaces.NewSelectionItem("1.23", "Latest release", "1.23"),
+		interfaces.NewSelectionItem("1.21", i18n.T("prompt.go_version.lts"), "1.21"),
+		interfaces.NewSelectionItem("1.22", i18n.T("prompt.go_version.stable"), "1.22"),
+		interfaces.NewSelectionItem("1.23", i18n.T("prompt.go_version.latest"), "1.23"),
 	}
 
-	return p.RunSelection("Which Go version?", items)
+	return p.RunSelection(i18n.T("prompt.go_version"), items)
 }
 
 // promptBasicOptions prompts for basic configuration options
@@ -420,13 +430,14 @@ func (p *BubbleTeaPrompter) promptAdvancedOptions(config *types.ProjectConfig) e
 // promptArchitecture prompts for architecture pattern using Bubble Tea UI
 func (p *BubbleTeaPrompter) promptArchitecture(config *types.ProjectConfig) error {
 	items := []interfaces.SelectionItem{
-		interfaces.NewSelectionItem("Standard", "Simple, straightforward structure", "standard"),
-		interfaces.NewSelectionItem("Clean Architecture", "Uncle Bob's principles", "clean"),
-		interfaces.NewSelectionItem("Domain-Driven Design", "Business-focused design", "ddd"),
-		interfaces.NewSelectionItem("Hexagonal", "Ports and adapters pattern", "hexagonal"),
+		interfaces.NewSelectionItem("Standard", i18n.T("prompt.architecture.standard"), "standard"),
+		interfaces.NewSelectionItem("Clean Architecture", i18n.T("prompt.architecture.clean"), "clean"),
+		interfaces.NewSelectionItem("Domain-Driven Design", i18n.T("prompt.architecture.ddd"), "ddd"),
+		interfaces.NewSelectionItem("Hexagonal", i18n.T("prompt.architecture.hexagonal"), "hexagonal"),
+		interfaces.NewSelectionItem("Vertical Slice", i18n.T("prompt.architecture.vertical_slice"), "vertical-slice"),
 	}
 
-	choice, err := p.RunSelection("Architecture pattern?", items)
+	choice, err := p.RunSelection(i18n.T("prompt.architecture"), items)
 	if err != nil {
 		return err
 	}
@@ -442,11 +453,11 @@ func (p *BubbleTeaPrompter) promptDatabaseSupport(config *types.ProjectConfig) e
 
 	// Ask if user wants database support
 	items := []interfaces.SelectionItem{
-		interfaces.NewSelectionItem("Yes", "Include database configuration and setup", "yes"),
-		interfaces.NewSelectionItem("No", "Skip database support", "no"),
+		interfaces.NewSelectionItem(i18n.T("prompt.yes"), i18n.T("prompt.database.yes"), "yes"),
+		interfaces.NewSelectionItem(i18n.T("prompt.no"), i18n.T("prompt.database.no"), "no"),
 	}
 
-	choice, err := p.RunSelection("Add database support?", items)
+	choice, err := p.RunSelection(i18n.T("prompt.database"), items)
 	if err != nil {
 		return err
 	}
@@ -469,13 +480,13 @@ func (p *BubbleTeaPrompter) promptDatabaseSupport(config *types.ProjectConfig) e
 // promptDatabaseDrivers prompts for database driver selection
 func (p *BubbleTeaPrompter) promptDatabaseDrivers(config *types.ProjectConfig) error {
 	items := []interfaces.SelectionItem{
-		interfaces.NewSelectionItem("PostgreSQL", "Full-featured, ACID-compliant database (recommended)", "postgresql"),
-		interfaces.NewSelectionItem("MySQL", "Popular relational database", "mysql"),  
-		interfaces.NewSelectionItem("SQLite", "Embedded database for simple apps", "sqlite"),
-		interfaces.NewSelectionItem("Redis", "In-memory cache and session store", "redis"),
+		interfaces.NewSelectionItem("PostgreSQL", i18n.T("prompt.database_driver.postgresql"), "postgresql"),
+		interfaces.NewSelectionItem("MySQL", i18n.T("prompt.database_driver.mysql"), "mysql"),  
+		interfaces.NewSelectionItem("SQLite", i18n.T("prompt.database_driver.sqlite"), "sqlite"),
+		interfaces.NewSelectionItem("Redis", i18n.T("prompt.database_driver.redis"), "redis"),
 	}
 
-	choice, err := p.RunSelection("Which database?", items)
+	choice, err := p.RunSelection(i18n.T("prompt.database_driver"), items)
 	if err != nil {
 		return err
 	}
@@ -490,13 +501,13 @@ func (p *BubbleTeaPrompter) promptDatabaseDrivers(config *types.ProjectConfig) e
 // promptORM prompts for ORM selection
 func (p *BubbleTeaPrompter) promptORM(config *types.ProjectConfig) error {
 	items := []interfaces.SelectionItem{
-		interfaces.NewSelectionItem("Raw SQL", "Database/sql package with manual queries", ""),
-		interfaces.NewSelectionItem("GORM", "Feature-rich ORM with associations (recommended)", "gorm"),
-		interfaces.NewSelectionItem("SQLX", "Lightweight extensions on database/sql (coming soon)", "sqlx"),
-		interfaces.NewSelectionItem("SQLC", "Generate type-safe code from SQL (coming soon)", "sqlc"),
+		interfaces.NewSelectionItem("Raw SQL", i18n.T("prompt.orm.raw"), ""),
+		interfaces.NewSelectionItem("GORM", i18n.T("prompt.orm.gorm"), "gorm"),
+		interfaces.NewSelectionItem("SQLX", i18n.T("prompt.orm.sqlx"), "sqlx"),
+		interfaces.NewSelectionItem("SQLC", i18n.T("prompt.orm.sqlc"), "sqlc"),
 	}
 
-	choice, err := p.RunSelection("Which ORM/database abstraction?", items)
+	choice, err := p.RunSelection(i18n.T("prompt.orm"), items)
 	if err != nil {
 		return err
 	}
@@ -513,11 +524,11 @@ func (p *BubbleTeaPrompter) promptAuthentication(config *types.ProjectConfig) er
 
 	// Ask if user wants authentication
 	items := []interfaces.SelectionItem{
-		interfaces.NewSelectionItem("Yes", "Include authentication and authorization", "yes"),
-		interfaces.NewSelectionItem("No", "Skip authentication support", "no"),
+		interfaces.NewSelectionItem(i18n.T("prompt.yes"), i18n.T("prompt.auth.yes"), "yes"),
+		interfaces.NewSelectionItem(i18n.T("prompt.no"), i18n.T("prompt.auth.no"), "no"),
 	}
 
-	choice, err := p.RunSelection("Add authentication?", items)
+	choice, err := p.RunSelection(i18n.T("prompt.auth"), items)
 	if err != nil {
 		return err
 	}
@@ -535,13 +546,13 @@ func (p *BubbleTeaPrompter) promptAuthentication(config *types.ProjectConfig) er
 // promptAuthenticationType prompts for authentication type selection
 func (p *BubbleTeaPrompter) promptAuthenticationType(config *types.ProjectConfig) error {
 	items := []interfaces.SelectionItem{
-		interfaces.NewSelectionItem("JWT", "JSON Web Tokens (recommended)", "jwt"),
-		interfaces.NewSelectionItem("Session", "Server-side session management", "session"),
-		interfaces.NewSelectionItem("OAuth2", "OAuth2 with external providers", "oauth2"),
-		interfaces.NewSelectionItem("API Key", "Simple API key authentication", "api-key"),
+		interfaces.NewSelectionItem("JWT", i18n.T("prompt.auth_type.jwt"), "jwt"),
+		interfaces.NewSelectionItem("Session", i18n.T("prompt.auth_type.session"), "session"),
+		interfaces.NewSelectionItem("OAuth2", i18n.T("prompt.auth_type.oauth2"), "oauth2"),
+		interfaces.NewSelectionItem("API Key", i18n.T("prompt.auth_type.api_key"), "api-key"),
 	}
 
-	choice, err := p.RunSelection("Authentication type?", items)
+	choice, err := p.RunSelection(i18n.T("prompt.auth_type"), items)
 	if err != nil {
 		return err
 	}
@@ -555,17 +566,22 @@ func (p *BubbleTeaPrompter) promptCLIComplexity(config *types.ProjectConfig) err
 	items := []interfaces.SelectionItem{
 		interfaces.NewSelectionItem(
 			"Simple CLI", 
-			"Quick scripts & utilities (8 files, minimal deps)", 
+			i18n.T("prompt.cli_complexity.simple"), 
 			"simple",
 		),
 		interfaces.NewSelectionItem(
 			"Standard CLI", 
-			"Production CLIs (30 files, full features)", 
+			i18n.T("prompt.cli_complexity.standard"), 
 			"standard",
 		),
+		interfaces.NewSelectionItem(
+			"Advanced CLI",
+			i18n.T("prompt.cli_complexity.advanced"),
+			"advanced",
+		),
 	}
 
-	selection, err := p.RunSelection("Choose CLI complexity level:", items)
+	selection, err := p.RunSelection(i18n.T("prompt.cli_complexity"), items)
 	if err != nil {
 		return err
 	}
@@ -578,6 +594,9 @@ func (p *BubbleTeaPrompter) promptCLIComplexity(config *types.ProjectConfig) err
 	config.Variables["complexity"] = selection
 	if selection == "simple" {
 		config.Variables["blueprint_hint"] = "cli-simple"
+	} else if selection == "advanced" {
+		config.Variables["blueprint_hint"] = "cli-advanced"
+		config.Variables["blueprint_id"] = "cli-advanced"
 	} else {
 		config.Variables["blueprint_hint"] = "cli-standard"
 	}
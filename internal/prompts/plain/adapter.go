@@ -0,0 +1,294 @@
+// Package plain asks the wizard questions as plain sequential lines of text for screen
+// readers, braille displays and terminals without cursor control. Options are
+// numbered, defaults are spelled out and nothing is redrawn or coloured.
+package plain
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/francknouama/go-starter/internal/i18n"
+	"github.com/francknouama/go-starter/internal/prompts/interfaces"
+	surveyprompter "github.com/francknouama/go-starter/internal/prompts/survey"
+	"github.com/francknouama/go-starter/internal/ui"
+)
+
+// Adapter answers survey prompts by printing them as text to out and reading one
+// line per answer from in
+type Adapter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+// NewAdapter creates an adapter reading answers from in and writing questions to out
+func NewAdapter(in io.Reader, out io.Writer) *Adapter {
+	return &Adapter{in: bufio.NewReader(in), out: out}
+}
+
+// NewPrompter creates a prompter asking the regular wizard questions in plain text
+func NewPrompter(in io.Reader, out io.Writer) interfaces.Prompter {
+	return surveyprompter.NewWithAdapter(NewAdapter(in, out))
+}
+
+// AskOne asks a single question and stores the answer in response
+func (a *Adapter) AskOne(prompt survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+	switch p := prompt.(type) {
+	case *survey.Input:
+		return a.askInput(p, response)
+	case *survey.Confirm:
+		return a.askConfirm(p, response)
+	case *survey.Select:
+		return a.askSelect(p, response)
+	case *survey.MultiSelect:
+		return a.askMultiSelect(p, response)
+	default:
+		return fmt.Errorf("plain prompts do not support %T", prompt)
+	}
+}
+
+func (a *Adapter) askInput(p *survey.Input, response interface{}) error {
+	answer, ok := response.(*string)
+	if !ok {
+		return fmt.Errorf("unsupported response type %T for a text question", response)
+	}
+
+	a.question(p.Message)
+	if p.Default != "" {
+		a.println(i18n.T("prompt.plain.default", p.Default))
+	}
+	a.hint(i18n.T("prompt.plain.input_hint"), p.Help)
+
+	for {
+		line, err := a.readAnswer(p.Message, p.Help)
+		if err != nil {
+			return err
+		}
+		if line == "" {
+			line = p.Default
+		}
+		// Every text question of the wizard is required
+		if line == "" {
+			a.println(i18n.T("prompt.plain.required"))
+			continue
+		}
+		*answer = line
+		return nil
+	}
+}
+
+func (a *Adapter) askConfirm(p *survey.Confirm, response interface{}) error {
+	answer, ok := response.(*bool)
+	if !ok {
+		return fmt.Errorf("unsupported response type %T for a yes/no question", response)
+	}
+
+	yes, no := i18n.T("prompt.yes"), i18n.T("prompt.no")
+	defaultAnswer := no
+	if p.Default {
+		defaultAnswer = yes
+	}
+
+	a.question(p.Message)
+	a.println(i18n.T("prompt.plain.default", defaultAnswer))
+	a.hint(i18n.T("prompt.plain.confirm_hint", yes, no), p.Help)
+
+	for {
+		line, err := a.readAnswer(p.Message, p.Help)
+		if err != nil {
+			return err
+		}
+		if line == "" {
+			*answer = p.Default
+			return nil
+		}
+		if value, ok := parseYesNo(line, yes, no); ok {
+			*answer = value
+			return nil
+		}
+		a.println(i18n.T("prompt.plain.invalid_confirm", line, yes, no))
+	}
+}
+
+func (a *Adapter) askSelect(p *survey.Select, response interface{}) error {
+	defaultIndex := -1
+	switch d := p.Default.(type) {
+	case string:
+		defaultIndex = indexOf(p.Options, d)
+	case int:
+		defaultIndex = d
+	}
+
+	a.question(p.Message)
+	a.options(p.Options, p.Description, func(i int) bool { return i == defaultIndex })
+	a.hint(i18n.T("prompt.plain.select_hint", len(p.Options)), p.Help)
+
+	for {
+		line, err := a.readAnswer(p.Message, p.Help)
+		if err != nil {
+			return err
+		}
+
+		index := defaultIndex
+		if line != "" {
+			index = parseChoice(line, p.Options)
+		}
+		if index < 0 || index >= len(p.Options) {
+			a.println(i18n.T("prompt.plain.invalid_choice", line, len(p.Options)))
+			continue
+		}
+
+		switch answer := response.(type) {
+		case *string:
+			*answer = p.Options[index]
+		case *int:
+			*answer = index
+		default:
+			return fmt.Errorf("unsupported response type %T for a choice", response)
+		}
+		return nil
+	}
+}
+
+func (a *Adapter) askMultiSelect(p *survey.MultiSelect, response interface{}) error {
+	answer, ok := response.(*[]string)
+	if !ok {
+		return fmt.Errorf("unsupported response type %T for a multiple choice", response)
+	}
+
+	var defaults []string
+	switch d := p.Default.(type) {
+	case []string:
+		defaults = d
+	case string:
+		defaults = []string{d}
+	}
+
+	a.question(p.Message)
+	a.options(p.Options, p.Description, func(i int) bool { return indexOf(defaults, p.Options[i]) >= 0 })
+	a.hint(i18n.T("prompt.plain.multiselect_hint", len(p.Options)), p.Help)
+
+	for {
+		line, err := a.readAnswer(p.Message, p.Help)
+		if err != nil {
+			return err
+		}
+		if line == "" {
+			*answer = append([]string(nil), defaults...)
+			return nil
+		}
+
+		var selected []string
+		valid := true
+		for _, field := range strings.FieldsFunc(line, func(r rune) bool { return r == ',' || r == ' ' }) {
+			index := parseChoice(field, p.Options)
+			if index < 0 {
+				a.println(i18n.T("prompt.plain.invalid_choice", field, len(p.Options)))
+				valid = false
+				break
+			}
+			if indexOf(selected, p.Options[index]) < 0 {
+				selected = append(selected, p.Options[index])
+			}
+		}
+		if valid {
+			*answer = selected
+			return nil
+		}
+	}
+}
+
+// readAnswer reads the next answer line; "?" prints the help text and asks again
+func (a *Adapter) readAnswer(message, help string) (string, error) {
+	for {
+		a.print("> ")
+		line, err := a.in.ReadString('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+			return "", fmt.Errorf("no answer to %q: %w", message, err)
+		}
+		line = strings.TrimSpace(line)
+
+		if line == "?" && help != "" {
+			a.println(ui.StripSymbols(help))
+			continue
+		}
+		return line, nil
+	}
+}
+
+func (a *Adapter) question(message string) {
+	a.println("")
+	a.println(ui.StripSymbols(message))
+}
+
+func (a *Adapter) options(options []string, description func(string, int) string, isDefault func(int) bool) {
+	for i, option := range options {
+		line := fmt.Sprintf("%d. %s", i+1, option)
+		if description != nil {
+			if text := description(option, i); text != "" {
+				line += " - " + text
+			}
+		}
+		if isDefault(i) {
+			line += " " + i18n.T("prompt.plain.default_marker")
+		}
+		a.println(ui.StripSymbols(line))
+	}
+}
+
+func (a *Adapter) hint(hint, help string) {
+	if help != "" {
+		hint += " " + i18n.T("prompt.plain.help_hint")
+	}
+	a.println(hint)
+}
+
+func (a *Adapter) print(s string) {
+	_, _ = fmt.Fprint(a.out, s)
+}
+
+func (a *Adapter) println(s string) {
+	_, _ = fmt.Fprintln(a.out, s)
+}
+
+// parseChoice resolves an answer given as an option number or the option text
+func parseChoice(answer string, options []string) int {
+	if n, err := strconv.Atoi(answer); err == nil {
+		if n >= 1 && n <= len(options) {
+			return n - 1
+		}
+		return -1
+	}
+	for i, option := range options {
+		if strings.EqualFold(option, answer) {
+			return i
+		}
+	}
+	return -1
+}
+
+// parseYesNo accepts y/yes/n/no and the translated words for yes and no
+func parseYesNo(answer, yes, no string) (bool, bool) {
+	answer = strings.ToLower(answer)
+	switch answer {
+	case "y", "yes", strings.ToLower(yes), strings.ToLower(yes[:1]):
+		return true, true
+	case "n", "no", strings.ToLower(no), strings.ToLower(no[:1]):
+		return false, true
+	}
+	return false, false
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
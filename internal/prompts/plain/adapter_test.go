@@ -0,0 +1,118 @@
+package plain
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapter_Input(t *testing.T) {
+	var out bytes.Buffer
+	adapter := NewAdapter(strings.NewReader("\n?\nmy-api\n"), &out)
+
+	var answer string
+	err := adapter.AskOne(&survey.Input{Message: "🚀 Project name?", Help: "The directory name"}, &answer)
+	require.NoError(t, err)
+	assert.Equal(t, "my-api", answer)
+
+	text := out.String()
+	assert.Contains(t, text, "Project name?")
+	assert.NotContains(t, text, "🚀")
+	assert.Contains(t, text, "An answer is required.")
+	assert.Contains(t, text, "The directory name")
+	assert.NotContains(t, text, "\x1b", "plain prompts never emit escape sequences")
+}
+
+func TestAdapter_InputDefault(t *testing.T) {
+	adapter := NewAdapter(strings.NewReader("\n"), &bytes.Buffer{})
+
+	var answer string
+	require.NoError(t, adapter.AskOne(&survey.Input{Message: "Module?", Default: "github.com/me/app"}, &answer))
+	assert.Equal(t, "github.com/me/app", answer)
+}
+
+func TestAdapter_Confirm(t *testing.T) {
+	tests := []struct {
+		input    string
+		defValue bool
+		want     bool
+	}{
+		{"\n", true, true},
+		{"\n", false, false},
+		{"y\n", false, true},
+		{"No\n", true, false},
+		{"maybe\nyes\n", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.TrimSpace(tt.input), func(t *testing.T) {
+			adapter := NewAdapter(strings.NewReader(tt.input), &bytes.Buffer{})
+			var answer bool
+			require.NoError(t, adapter.AskOne(&survey.Confirm{Message: "Database?", Default: tt.defValue}, &answer))
+			assert.Equal(t, tt.want, answer)
+		})
+	}
+}
+
+func TestAdapter_Select(t *testing.T) {
+	prompt := &survey.Select{
+		Message: "Framework?",
+		Options: []string{"gin", "echo", "fiber"},
+		Default: "echo",
+		Description: func(value string, index int) string {
+			if value == "gin" {
+				return "most popular"
+			}
+			return ""
+		},
+	}
+
+	var out bytes.Buffer
+	adapter := NewAdapter(strings.NewReader("7\n3\n"), &out)
+	var answer string
+	require.NoError(t, adapter.AskOne(prompt, &answer))
+	assert.Equal(t, "fiber", answer)
+
+	text := out.String()
+	assert.Contains(t, text, "1. gin - most popular\n")
+	assert.Contains(t, text, "2. echo (default)\n")
+	assert.Contains(t, text, `"7" is not one of the options`)
+
+	adapter = NewAdapter(strings.NewReader("\n"), &bytes.Buffer{})
+	var index int
+	require.NoError(t, adapter.AskOne(prompt, &index))
+	assert.Equal(t, 1, index)
+
+	adapter = NewAdapter(strings.NewReader("GIN\n"), &bytes.Buffer{})
+	require.NoError(t, adapter.AskOne(prompt, &answer))
+	assert.Equal(t, "gin", answer)
+}
+
+func TestAdapter_MultiSelect(t *testing.T) {
+	prompt := &survey.MultiSelect{
+		Message: "Databases?",
+		Options: []string{"PostgreSQL", "MySQL", "SQLite", "Redis"},
+		Default: []string{"PostgreSQL"},
+	}
+
+	adapter := NewAdapter(strings.NewReader("1, 4 4\n"), &bytes.Buffer{})
+	var answer []string
+	require.NoError(t, adapter.AskOne(prompt, &answer))
+	assert.Equal(t, []string{"PostgreSQL", "Redis"}, answer)
+
+	adapter = NewAdapter(strings.NewReader("\n"), &bytes.Buffer{})
+	require.NoError(t, adapter.AskOne(prompt, &answer))
+	assert.Equal(t, []string{"PostgreSQL"}, answer)
+}
+
+func TestAdapter_EndOfInput(t *testing.T) {
+	adapter := NewAdapter(strings.NewReader(""), &bytes.Buffer{})
+	var answer string
+	err := adapter.AskOne(&survey.Input{Message: "Project name?"}, &answer)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Project name?")
+}
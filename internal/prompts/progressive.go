@@ -127,6 +127,8 @@ func SelectBlueprintForComplexity(blueprintType string, complexity ComplexityLev
 		switch complexity {
 		case ComplexitySimple:
 			return "cli-simple"
+		case ComplexityAdvanced, ComplexityExpert:
+			return "cli-advanced"
 		default:
 			return "cli" // Standard CLI uses just "cli" (architecture: standard)
 		}
@@ -149,8 +149,8 @@ func TestBlueprintSelection(t *testing.T) {
 		}{
 			{ComplexitySimple, "cli-simple"},
 			{ComplexityStandard, "cli"},
-			{ComplexityAdvanced, "cli"},
-			{ComplexityExpert, "cli"},
+			{ComplexityAdvanced, "cli-advanced"},
+			{ComplexityExpert, "cli-advanced"},
 		}
 
 		for _, tt := range tests {
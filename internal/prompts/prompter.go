@@ -3,9 +3,13 @@ package prompts
 import (
 	"os"
 
+	"github.com/AlecAivazis/survey/v2/core"
+
 	"github.com/francknouama/go-starter/internal/prompts/bubbletea"
 	"github.com/francknouama/go-starter/internal/prompts/interfaces"
+	"github.com/francknouama/go-starter/internal/prompts/plain"
 	"github.com/francknouama/go-starter/internal/prompts/survey"
+	"github.com/francknouama/go-starter/internal/ui"
 )
 
 // PrompterFactory creates the appropriate prompter based on configuration
@@ -22,6 +26,11 @@ func NewPrompterFactory(useEnhancedUI bool) *PrompterFactory {
 
 // CreatePrompter creates a prompter instance based on the configuration
 func (f *PrompterFactory) CreatePrompter() interfaces.Prompter {
+	// Plain mode asks sequential text questions that screen readers can follow
+	if ui.Plain() {
+		return plain.NewPrompter(os.Stdin, os.Stdout)
+	}
+
 	// Check if we should use enhanced UI (Bubble Tea)
 	if f.useEnhancedUI && isTerminal() {
 		// Try to create Bubble Tea prompter
@@ -51,6 +60,7 @@ func createBubbleTeaPrompter() interfaces.Prompter {
 
 // createSurveyPrompter creates a new Survey prompter
 func createSurveyPrompter() interfaces.Prompter {
+	core.DisableColor = ui.NoColor()
 	return survey.NewPrompter()
 }
 
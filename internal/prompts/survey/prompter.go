@@ -9,6 +9,7 @@ import (
 	"golang.org/x/text/language"
 	
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/francknouama/go-starter/internal/i18n"
 	"github.com/francknouama/go-starter/internal/prompts/interfaces"
 	"github.com/francknouama/go-starter/internal/templates"
 	"github.com/francknouama/go-starter/internal/utils"
@@ -222,15 +223,16 @@ func (p *SurveyPrompter) promptAdvancedOptions(config *types.ProjectConfig) erro
 
 func (p *SurveyPrompter) promptArchitecture(config *types.ProjectConfig) error {
 	prompt := &survey.Select{
-		Message: "Architecture pattern?",
+		Message: i18n.T("prompt.architecture"),
 		Options: []string{
 			"Standard - Simple structure",
 			"Clean Architecture - Uncle Bob's principles",
 			"Domain-Driven Design - Business-focused",
 			"Hexagonal - Ports and adapters",
+			"Vertical Slice - Feature folders",
 		},
 		Default: "Standard - Simple structure",
-		Help:    "Choose the architectural pattern for your project",
+		Help:    i18n.T("prompt.architecture.help"),
 	}
 
 	var selection string
@@ -244,6 +246,7 @@ func (p *SurveyPrompter) promptArchitecture(config *types.ProjectConfig) error {
 		"Clean Architecture - Uncle Bob's principles": "clean",
 		"Domain-Driven Design - Business-focused":     "ddd",
 		"Hexagonal - Ports and adapters":              "hexagonal",
+		"Vertical Slice - Feature folders":            "vertical-slice",
 	}
 
 	config.Architecture = archMap[selection]
@@ -258,9 +261,9 @@ func (p *SurveyPrompter) promptDatabaseSupport(config *types.ProjectConfig) erro
 
 	addDB := false
 	prompt := &survey.Confirm{
-		Message: "Add database support?",
+		Message: i18n.T("prompt.database"),
 		Default: true,
-		Help:    "Include database configuration and basic setup",
+		Help:    i18n.T("prompt.database.help"),
 	}
 
 	if err := p.surveyAdapter.AskOne(prompt, &addDB); err != nil {
@@ -270,10 +273,10 @@ func (p *SurveyPrompter) promptDatabaseSupport(config *types.ProjectConfig) erro
 	if addDB {
 		// Use MultiSelect for multiple database selection
 		dbPrompt := &survey.MultiSelect{
-			Message: "Which databases do you want to use? (Space to select, Enter to confirm)",
+			Message: i18n.T("prompt.databases"),
 			Options: []string{"PostgreSQL", "MySQL", "SQLite", "Redis"},
 			Default: []string{"PostgreSQL"},
-			Help:    "Select one or more databases for your project. PostgreSQL for main data, Redis for caching, etc.",
+			Help:    i18n.T("prompt.databases.help"),
 		}
 
 		var selectedDBs []string
@@ -305,7 +308,7 @@ func (p *SurveyPrompter) promptDatabaseSupport(config *types.ProjectConfig) erro
 
 func (p *SurveyPrompter) promptORM(config *types.ProjectConfig) error {
 	ormPrompt := &survey.Select{
-		Message: "Which ORM/database abstraction do you prefer?",
+		Message: i18n.T("prompt.orm.prefer"),
 		Options: []string{
 			"gorm - Feature-rich ORM with associations and migrations (recommended) ✅",
 			"raw - Raw database/sql package with manual queries ✅",
@@ -315,7 +318,7 @@ func (p *SurveyPrompter) promptORM(config *types.ProjectConfig) error {
 			"xorm - Alternative full-featured ORM 🔄 Coming Soon",
 		},
 		Default: "raw - Raw database/sql package with manual queries ✅",
-		Help:    "✅ = Currently supported | 🔄 = Coming soon in future releases. GORM provides rich ORM features, while raw gives full control over SQL.",
+		Help:    i18n.T("prompt.orm.help"),
 	}
 
 	var selection string
@@ -353,9 +356,9 @@ func (p *SurveyPrompter) promptAuthentication(config *types.ProjectConfig) error
 
 	addAuth := false
 	prompt := &survey.Confirm{
-		Message: "Add authentication?",
+		Message: i18n.T("prompt.auth"),
 		Default: false,
-		Help:    "Include authentication setup (JWT, OAuth, etc.)",
+		Help:    i18n.T("prompt.auth.help"),
 	}
 
 	if err := p.surveyAdapter.AskOne(prompt, &addAuth); err != nil {
@@ -364,10 +367,10 @@ func (p *SurveyPrompter) promptAuthentication(config *types.ProjectConfig) error
 
 	if addAuth {
 		authPrompt := &survey.Select{
-			Message: "Authentication type?",
+			Message: i18n.T("prompt.auth_type"),
 			Options: []string{"JWT", "OAuth2", "Session-based", "API Key"},
 			Default: "JWT",
-			Help:    "Choose the authentication method",
+			Help:    i18n.T("prompt.auth_type.help"),
 		}
 
 		var authType string
@@ -394,7 +397,7 @@ func (p *SurveyPrompter) promptAdvancedLogger(config *types.ProjectConfig) error
 
 	// Log level configuration
 	levelPrompt := &survey.Select{
-		Message: "Log level?",
+		Message: i18n.T("prompt.log_level"),
 		Options: []string{
 			"debug - Detailed debugging information",
 			"info - General application flow (recommended)",
@@ -402,7 +405,7 @@ func (p *SurveyPrompter) promptAdvancedLogger(config *types.ProjectConfig) error
 			"error - Error conditions only",
 		},
 		Default: "info - General application flow (recommended)",
-		Help:    "Choose the default log level for your application",
+		Help:    i18n.T("prompt.log_level.help"),
 	}
 
 	var levelSelection string
@@ -413,14 +416,14 @@ func (p *SurveyPrompter) promptAdvancedLogger(config *types.ProjectConfig) error
 
 	// Log format configuration
 	formatPrompt := &survey.Select{
-		Message: "Log format?",
+		Message: i18n.T("prompt.log_format"),
 		Options: []string{
 			"json - Structured JSON format (recommended)",
 			"text - Human-readable text format",
 			"console - Colored console output",
 		},
 		Default: "json - Structured JSON format (recommended)",
-		Help:    "Choose the log output format. JSON is recommended for production.",
+		Help:    i18n.T("prompt.log_format.help"),
 	}
 
 	var formatSelection string
@@ -458,14 +461,10 @@ func (p *SurveyPrompter) promptProjectNameSurvey() (string, error) {
 	suggestion := utils.GenerateRandomProjectName()
 	alternatives := utils.GenerateMultipleNames(3)
 
-	helpText := fmt.Sprintf("This will be used as the directory name and default module path.\n"+
-		"Press Enter to use: %s\n"+
-		"Other suggestions: %s",
-		suggestion,
-		strings.Join(alternatives, ", "))
+	helpText := i18n.T("prompt.project_name.help", suggestion, strings.Join(alternatives, ", "))
 
 	prompt := &survey.Input{
-		Message: "What's your project name?",
+		Message: i18n.T("prompt.project_name"),
 		Default: suggestion,
 		Help:    helpText,
 	}
@@ -477,9 +476,9 @@ func (p *SurveyPrompter) promptProjectNameSurvey() (string, error) {
 func (p *SurveyPrompter) promptModulePathSurvey(projectName string) (string, error) {
 	defaultModule := fmt.Sprintf("github.com/username/%s", projectName)
 	prompt := &survey.Input{
-		Message: "Module path:",
+		Message: i18n.T("prompt.module_path"),
 		Default: defaultModule,
-		Help:    "Go module path for imports (e.g., github.com/username/project)",
+		Help:    i18n.T("prompt.module_path.help"),
 	}
 	var result string
 	err := p.surveyAdapter.AskOne(prompt, &result, survey.WithValidator(survey.Required))
@@ -488,7 +487,7 @@ func (p *SurveyPrompter) promptModulePathSurvey(projectName string) (string, err
 
 func (p *SurveyPrompter) promptProjectTypeSurvey(advanced bool) (string, string, error) {
 	// Get all available blueprints from registry
-	allBlueprints := p.registry.List()
+	allBlueprints := p.registry.Summaries()
 	if len(allBlueprints) == 0 {
 		return "", "", fmt.Errorf("no blueprints available in registry")
 	}
@@ -519,13 +518,13 @@ func (p *SurveyPrompter) promptProjectTypeSurvey(advanced bool) (string, string,
 	}
 
 	// Determine help text based on mode
-	helpText := "Choose the type of Go project you want to create"
+	helpText := i18n.T("prompt.project_type.help")
 	if !advanced {
-		helpText += "\n💡 Use --advanced to see all available blueprints including advanced architectures"
+		helpText += "\n" + i18n.T("prompt.project_type.help_advanced")
 	}
 
 	prompt := &survey.Select{
-		Message: "What type of project?",
+		Message: i18n.T("prompt.project_type"),
 		Options: options,
 		Help:    helpText,
 		Filter:  func(filter string, value string, index int) bool {
@@ -656,23 +655,124 @@ func (p *SurveyPrompter) getAdvancedBlueprintCategories(blueprints []types.Templ
 		})
 	}
 
+	// Web Apps category
+	if webApps, exists := typeGroups["web-app"]; exists {
+		var items []BlueprintSelection
+		for _, bp := range webApps {
+			items = append(items, BlueprintSelection{
+				Type:        "web-app",
+				BlueprintID: bp.ID,
+				DisplayName: "🖼️  Web App - Server-rendered pages with templ, htmx and sessions",
+			})
+		}
+		categories = append(categories, BlueprintCategory{
+			Name:          "Web Apps",
+			Items:         items,
+			ShowCategory:  true,
+			ShowSeparator: true,
+		})
+	}
+
+	// Realtime Services category
+	if services, exists := typeGroups["realtime"]; exists {
+		var items []BlueprintSelection
+		for _, bp := range services {
+			items = append(items, BlueprintSelection{
+				Type:        "realtime",
+				BlueprintID: bp.ID,
+				DisplayName: "⚡ Realtime - WebSocket rooms, presence and broadcasts",
+			})
+		}
+		categories = append(categories, BlueprintCategory{
+			Name:          "Realtime Services",
+			Items:         items,
+			ShowCategory:  true,
+			ShowSeparator: true,
+		})
+	}
+
+	// API Gateways category
+	if gateways, exists := typeGroups["gateway"]; exists {
+		var items []BlueprintSelection
+		for _, bp := range gateways {
+			items = append(items, BlueprintSelection{
+				Type:        "gateway",
+				BlueprintID: bp.ID,
+				DisplayName: "🚦 API Gateway - YAML routes, auth, rate limits, retries",
+			})
+		}
+		categories = append(categories, BlueprintCategory{
+			Name:          "API Gateways",
+			Items:         items,
+			ShowCategory:  true,
+			ShowSeparator: true,
+		})
+	}
+
+	// Desktop Applications category
+	if apps, exists := typeGroups["desktop"]; exists {
+		var items []BlueprintSelection
+		for _, bp := range apps {
+			items = append(items, BlueprintSelection{
+				Type:        "desktop",
+				BlueprintID: bp.ID,
+				DisplayName: "🖥️  Desktop App - Wails with a Go backend and web frontend",
+			})
+		}
+		categories = append(categories, BlueprintCategory{
+			Name:          "Desktop Applications",
+			Items:         items,
+			ShowCategory:  true,
+			ShowSeparator: true,
+		})
+	}
+
+	// Workflow Services category
+	if services, exists := typeGroups["workflow"]; exists {
+		var items []BlueprintSelection
+		for _, bp := range services {
+			items = append(items, BlueprintSelection{
+				Type:        "workflow",
+				BlueprintID: bp.ID,
+				DisplayName: "⏱️  Workflow - Temporal worker with retry policies and replay tests",
+			})
+		}
+		categories = append(categories, BlueprintCategory{
+			Name:          "Workflow Services",
+			Items:         items,
+			ShowCategory:  true,
+			ShowSeparator: true,
+		})
+	}
+
 	// CLI Tools category
+	var cliItems []BlueprintSelection
 	if cliTools, exists := typeGroups["cli"]; exists {
-		var items []BlueprintSelection
 		for _, bp := range cliTools {
 			complexity := cases.Title(language.English).String(bp.Architecture)
 			if bp.Architecture == "simple" {
 				complexity = "Simple"
 			}
-			items = append(items, BlueprintSelection{
+			cliItems = append(cliItems, BlueprintSelection{
 				Type:        "cli",
 				BlueprintID: bp.ID,
 				DisplayName: fmt.Sprintf("⚡ %s CLI - %s", complexity, getComplexityDescription(bp.Architecture)),
 			})
 		}
+	}
+	if tuis, exists := typeGroups["tui"]; exists {
+		for _, bp := range tuis {
+			cliItems = append(cliItems, BlueprintSelection{
+				Type:        "tui",
+				BlueprintID: bp.ID,
+				DisplayName: "🖥️  Terminal UI - Bubble Tea screens, keybinding help and themes",
+			})
+		}
+	}
+	if len(cliItems) > 0 {
 		categories = append(categories, BlueprintCategory{
 			Name:          "CLI Tools",
-			Items:         items,
+			Items:         cliItems,
 			ShowCategory:  true,
 			ShowSeparator: true,
 		})
@@ -747,6 +847,24 @@ func (p *SurveyPrompter) getAdvancedBlueprintCategories(blueprints []types.Templ
 
 	// Infrastructure category
 	var infraItems []BlueprintSelection
+	if grpcServices, exists := typeGroups["grpc-service"]; exists {
+		for _, bp := range grpcServices {
+			infraItems = append(infraItems, BlueprintSelection{
+				Type:        "grpc-service",
+				BlueprintID: bp.ID,
+				DisplayName: "📡 gRPC Service - Protobuf API with interceptors and health checks",
+			})
+		}
+	}
+	if eventServices, exists := typeGroups["event-service"]; exists {
+		for _, bp := range eventServices {
+			infraItems = append(infraItems, BlueprintSelection{
+				Type:        "event-service",
+				BlueprintID: bp.ID,
+				DisplayName: "📨 Event Service - Kafka/NATS consumer with retries and a dead letter queue",
+			})
+		}
+	}
 	if grpcGateway, exists := typeGroups["grpc-gateway"]; exists {
 		for _, bp := range grpcGateway {
 			infraItems = append(infraItems, BlueprintSelection{
@@ -765,6 +883,15 @@ func (p *SurveyPrompter) getAdvancedBlueprintCategories(blueprints []types.Templ
 			})
 		}
 	}
+	if providers, exists := typeGroups["terraform-provider"]; exists {
+		for _, bp := range providers {
+			infraItems = append(infraItems, BlueprintSelection{
+				Type:        "terraform-provider",
+				BlueprintID: bp.ID,
+				DisplayName: "🧱 Terraform Provider - Plugin framework provider with acceptance tests",
+			})
+		}
+	}
 	if len(infraItems) > 0 {
 		categories = append(categories, BlueprintCategory{
 			Name:          "Infrastructure",
@@ -774,6 +901,24 @@ func (p *SurveyPrompter) getAdvancedBlueprintCategories(blueprints []types.Templ
 		})
 	}
 
+	// Integrations category
+	if bots, exists := typeGroups["bot"]; exists {
+		var items []BlueprintSelection
+		for _, bp := range bots {
+			items = append(items, BlueprintSelection{
+				Type:        "bot",
+				BlueprintID: bp.ID,
+				DisplayName: "🤖 Chat Bot - Slack or Discord slash commands and events",
+			})
+		}
+		categories = append(categories, BlueprintCategory{
+			Name:          "Integrations",
+			Items:         items,
+			ShowCategory:  true,
+			ShowSeparator: true,
+		})
+	}
+
 	// Packages category
 	if libraries, exists := typeGroups["library"]; exists {
 		var items []BlueprintSelection
@@ -806,6 +951,8 @@ func getArchitectureDescription(arch string) string {
 		return "Domain-Driven Design"
 	case "hexagonal":
 		return "Ports & Adapters pattern"
+	case "vertical-slice":
+		return "Feature folders with a mediator"
 	default:
 		return "Advanced architecture pattern"
 	}
@@ -817,6 +964,8 @@ func getComplexityDescription(complexity string) string {
 		return "Quick scripts & utilities"
 	case "standard":
 		return "Production-ready CLI tools"
+	case "advanced":
+		return "Plugins, layered configuration & self-update"
 	default:
 		return "Command-line application"
 	}
@@ -825,7 +974,12 @@ func getComplexityDescription(complexity string) string {
 // promptWebAPIArchitecture prompts the user to choose web API architecture when needed
 func (p *SurveyPrompter) promptWebAPIArchitecture(config *types.ProjectConfig, advanced bool) error {
 	// Get all web-api blueprints from registry
-	webAPIBlueprints := p.registry.GetByType("web-api")
+	var webAPIBlueprints []types.Template
+	for _, blueprint := range p.registry.Summaries() {
+		if blueprint.Type == "web-api" {
+			webAPIBlueprints = append(webAPIBlueprints, blueprint)
+		}
+	}
 	if len(webAPIBlueprints) <= 1 {
 		// Only one web-api blueprint, no need to prompt
 		return nil
@@ -857,17 +1011,10 @@ func (p *SurveyPrompter) promptWebAPIArchitecture(config *types.ProjectConfig, a
 		archMap[displayName] = bp.ID
 	}
 
-	helpText := `Web API Architecture Guide:
-
-• Standard: Simple layered structure, great for most APIs
-• Clean Architecture: Separation of concerns, highly testable  
-• Domain-Driven Design: Business logic focused, complex domains
-• Hexagonal: Ports & adapters, maximum testability
-
-💡 Tip: Start with Standard, upgrade when complexity grows`
+	helpText := i18n.T("prompt.web_api_architecture.help")
 
 	prompt := &survey.Select{
-		Message: "Which Web API architecture?",
+		Message: i18n.T("prompt.web_api_architecture"),
 		Options: options,
 		Help:    helpText,
 		Default: options[0], // Standard is first
@@ -894,10 +1041,10 @@ func (p *SurveyPrompter) promptFrameworkSurvey(projectType string) (string, erro
 
 	switch projectType {
 	case "web-api":
-		message = "Which web framework?"
+		message = i18n.T("prompt.framework.web")
 		options = []string{"Gin (recommended)", "Echo", "Fiber", "Chi", "Standard library"}
 	case "cli":
-		message = "Which CLI framework?"
+		message = i18n.T("prompt.framework.cli")
 		options = []string{"Cobra (recommended)", "Standard library"}
 	default:
 		// No framework selection needed for library or lambda
@@ -907,7 +1054,7 @@ func (p *SurveyPrompter) promptFrameworkSurvey(projectType string) (string, erro
 	prompt := &survey.Select{
 		Message: message,
 		Options: options,
-		Help:    "Choose the framework for your project",
+		Help:    i18n.T("prompt.framework.help"),
 	}
 
 	var selection string
@@ -933,10 +1080,10 @@ func (p *SurveyPrompter) promptLoggerSurvey(projectType string) (string, error)
 	}
 
 	prompt := &survey.Select{
-		Message: "Which logger?",
+		Message: i18n.T("prompt.logger"),
 		Options: options,
 		Default: "slog - Go built-in structured logging (recommended)",
-		Help:    "Choose the logging library for your project. slog is built into Go 1.21+ and recommended for most projects.",
+		Help:    i18n.T("prompt.logger.help"),
 	}
 
 	var selection string
@@ -956,10 +1103,10 @@ func (p *SurveyPrompter) promptGoVersionSurvey() (string, error) {
 	}
 
 	prompt := &survey.Select{
-		Message: "Which Go version?",
+		Message: i18n.T("prompt.go_version"),
 		Options: options,
 		Default: "1.21 - Stable LTS release (recommended)",
-		Help:    "Choose the Go version for your project. 1.21 is recommended for most projects.",
+		Help:    i18n.T("prompt.go_version.help"),
 	}
 
 	var selection string
@@ -976,30 +1123,13 @@ func (p *SurveyPrompter) promptCLIComplexity(config *types.ProjectConfig) error
 	options := []string{
 		"Simple - Quick scripts & utilities (8 files, minimal deps)",
 		"Standard - Production CLIs (30 files, full features)",
+		"Advanced - Extensible CLIs (PATH plugins, layered config, self-update)",
 	}
 
 	prompt := &survey.Select{
-		Message: "Choose CLI complexity level:",
+		Message: i18n.T("prompt.cli_complexity"),
 		Options: options,
-		Help: `CLI Complexity Guide:
-
-• Simple CLI (Recommended for 80% of use cases):
-  - Quick utilities and scripts
-  - Learning Go CLI development  
-  - Internal tools with minimal requirements
-  - Prototyping command-line interfaces
-  - Projects needing < 3 commands
-  - 8 files, single dependency (cobra)
-
-• Standard CLI (Enterprise-grade):
-  - Production CLI tools for distribution
-  - Multiple subcommands (5+)
-  - Configuration file support
-  - Complex business logic
-  - Team collaboration with CI/CD
-  - 30 files, multiple dependencies
-
-💡 Tip: Start simple, migrate to standard when needed`,
+		Help:    i18n.T("prompt.cli_complexity.help"),
 		Default: options[0], // Default to Simple for most users
 	}
 
@@ -1012,6 +1142,10 @@ func (p *SurveyPrompter) promptCLIComplexity(config *types.ProjectConfig) error
 	if strings.HasPrefix(selection, "Simple") {
 		config.Variables["complexity"] = "simple"
 		config.Variables["blueprint_hint"] = "cli-simple"
+	} else if strings.HasPrefix(selection, "Advanced") {
+		config.Variables["complexity"] = "advanced"
+		config.Variables["blueprint_hint"] = "cli-advanced"
+		config.Variables["blueprint_id"] = "cli-advanced"
 	} else {
 		config.Variables["complexity"] = "standard"
 		config.Variables["blueprint_hint"] = "cli-standard"
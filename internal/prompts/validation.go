@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/francknouama/go-starter/internal/naming"
 )
 
 // Validation functions for user input
@@ -35,6 +37,9 @@ func ValidateProjectName(name string) error {
 			return fmt.Errorf("'%s' is a reserved name", name)
 		}
 	}
+	if naming.IsReservedName(name) {
+		return fmt.Errorf("'%s' is a reserved name", name)
+	}
 	
 	return nil
 }
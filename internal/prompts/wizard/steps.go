@@ -0,0 +1,240 @@
+package wizard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/francknouama/go-starter/internal/config"
+	"github.com/francknouama/go-starter/internal/i18n"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// option is a choice of a selection step
+type option struct {
+	label       string
+	description string
+	value       string
+}
+
+// step is a question of the wizard. Text steps take typed input, the others
+// choose among their options.
+type step struct {
+	key   string
+	title string
+	// summary is the label of the answer on the summary
+	summary string
+	text    bool
+	// placeholder is the answer of an empty text step
+	placeholder func(types.ProjectConfig) string
+	// validate checks the answer of a text step
+	validate func(string) error
+	options  func(types.ProjectConfig) []option
+	// applies reports whether the step is asked for the answers so far
+	applies func(types.ProjectConfig) bool
+	get     func(types.ProjectConfig) string
+	set     func(*types.ProjectConfig, string)
+}
+
+// always is the applies of the steps asked for every project
+func always(types.ProjectConfig) bool { return true }
+
+// webAPI is the applies of the steps of web APIs only
+func webAPI(c types.ProjectConfig) bool { return c.Type == "web-api" }
+
+// isCLI reports whether the project type is one of the CLI blueprints
+func isCLI(projectType string) bool {
+	return projectType == "cli" || strings.HasPrefix(projectType, "cli-")
+}
+
+// steps are the questions of the wizard, in the order they are asked
+func steps() []step {
+	return []step{
+		{
+			key:         "name",
+			summary:     i18n.T("summary.name"),
+			title:       i18n.T("prompt.project_name"),
+			text:        true,
+			placeholder: func(types.ProjectConfig) string { return "my-project" },
+			validate:    config.ValidateProjectName,
+			applies:     always,
+			get:         func(c types.ProjectConfig) string { return c.Name },
+			set:         func(c *types.ProjectConfig, v string) { c.Name = v },
+		},
+		{
+			key:     "module",
+			summary: i18n.T("summary.module"),
+			title:   i18n.T("prompt.module_path"),
+			text:    true,
+			placeholder: func(c types.ProjectConfig) string {
+				return fmt.Sprintf("github.com/username/%s", c.Name)
+			},
+			validate: config.ValidateModulePath,
+			applies:  always,
+			get:      func(c types.ProjectConfig) string { return c.Module },
+			set:      func(c *types.ProjectConfig, v string) { c.Module = v },
+		},
+		{
+			key:     "type",
+			summary: i18n.T("summary.type"),
+			title:   i18n.T("prompt.project_type"),
+			options: func(types.ProjectConfig) []option {
+				return []option{
+					{"Web API", i18n.T("prompt.project_type.web_api"), "web-api"},
+					{"Simple CLI", i18n.T("prompt.cli_complexity.simple"), "cli-simple"},
+					{"Standard CLI", i18n.T("prompt.cli_complexity.standard"), "cli"},
+					{"Advanced CLI", i18n.T("prompt.cli_complexity.advanced"), "cli-advanced"},
+					{"Library", i18n.T("prompt.project_type.library"), "library"},
+					{"AWS Lambda", i18n.T("prompt.project_type.lambda"), "lambda"},
+					{"gRPC Service", i18n.T("prompt.project_type.grpc_service"), "grpc-service"},
+					{"Event Service", i18n.T("prompt.project_type.event_service"), "event-service"},
+					{"Terraform Provider", i18n.T("prompt.project_type.terraform_provider"), "terraform-provider"},
+					{"Terminal UI", i18n.T("prompt.project_type.tui"), "tui"},
+					{"Chat Bot", i18n.T("prompt.project_type.bot"), "bot"},
+					{"Web App", i18n.T("prompt.project_type.web_app"), "web-app"},
+					{"Realtime", i18n.T("prompt.project_type.realtime"), "realtime"},
+					{"API Gateway", i18n.T("prompt.project_type.gateway"), "gateway"},
+					{"Desktop App", i18n.T("prompt.project_type.desktop"), "desktop"},
+					{"Workflow", i18n.T("prompt.project_type.workflow"), "workflow"},
+				}
+			},
+			applies: always,
+			get:     func(c types.ProjectConfig) string { return c.Type },
+			set: func(c *types.ProjectConfig, v string) {
+				c.Type = v
+				// CLI blueprints are cobra applications, the other types pick their framework below
+				if isCLI(v) {
+					c.Framework = "cobra"
+				} else if c.Framework == "cobra" {
+					c.Framework = ""
+				}
+			},
+		},
+		{
+			key:     "architecture",
+			summary: i18n.T("summary.architecture"),
+			title:   i18n.T("prompt.architecture"),
+			options: func(types.ProjectConfig) []option {
+				return []option{
+					{"Standard", i18n.T("prompt.architecture.standard"), "standard"},
+					{"Clean Architecture", i18n.T("prompt.architecture.clean"), "clean"},
+					{"Domain-Driven Design", i18n.T("prompt.architecture.ddd"), "ddd"},
+					{"Hexagonal", i18n.T("prompt.architecture.hexagonal"), "hexagonal"},
+					{"Vertical Slice", i18n.T("prompt.architecture.vertical_slice"), "vertical-slice"},
+				}
+			},
+			applies: webAPI,
+			get:     func(c types.ProjectConfig) string { return c.Architecture },
+			set:     func(c *types.ProjectConfig, v string) { c.Architecture = v },
+		},
+		{
+			key:     "framework",
+			summary: i18n.T("summary.framework"),
+			title:   i18n.T("prompt.framework.web"),
+			options: func(types.ProjectConfig) []option {
+				return []option{
+					{"Gin", i18n.T("prompt.framework.gin"), "gin"},
+					{"Echo", i18n.T("prompt.framework.echo"), "echo"},
+					{"Fiber", i18n.T("prompt.framework.fiber"), "fiber"},
+					{"Chi", i18n.T("prompt.framework.chi"), "chi"},
+				}
+			},
+			applies: webAPI,
+			get:     func(c types.ProjectConfig) string { return c.Framework },
+			set:     func(c *types.ProjectConfig, v string) { c.Framework = v },
+		},
+		{
+			key:     "logger",
+			summary: i18n.T("summary.logger"),
+			title:   i18n.T("prompt.logger"),
+			options: func(types.ProjectConfig) []option {
+				return []option{
+					{"slog", i18n.T("prompt.logger.slog"), "slog"},
+					{"zap", i18n.T("prompt.logger.zap"), "zap"},
+					{"logrus", i18n.T("prompt.logger.logrus"), "logrus"},
+					{"zerolog", i18n.T("prompt.logger.zerolog"), "zerolog"},
+				}
+			},
+			applies: func(c types.ProjectConfig) bool { return c.Type != "library" },
+			get:     func(c types.ProjectConfig) string { return c.Logger },
+			set: func(c *types.ProjectConfig, v string) {
+				c.Logger = v
+				c.Variables["LoggerType"] = v
+			},
+		},
+		{
+			key:     "database",
+			summary: i18n.T("summary.database"),
+			title:   i18n.T("prompt.database_driver"),
+			options: func(types.ProjectConfig) []option {
+				return []option{
+					{i18n.T("prompt.no"), i18n.T("prompt.database.no"), ""},
+					{"PostgreSQL", i18n.T("prompt.database_driver.postgresql"), "postgres"},
+					{"MySQL", i18n.T("prompt.database_driver.mysql"), "mysql"},
+					{"SQLite", i18n.T("prompt.database_driver.sqlite"), "sqlite"},
+				}
+			},
+			applies: webAPI,
+			get:     func(c types.ProjectConfig) string { return c.Features.Database.Driver }, //nolint:staticcheck // the flags set the single driver
+			set: func(c *types.ProjectConfig, v string) {
+				c.Features.Database.Driver = v //nolint:staticcheck // the flags set the single driver
+				c.Variables["DatabaseDriver"] = v
+				if v == "" {
+					c.Features.Database.ORM = ""
+					c.Variables["DatabaseORM"] = ""
+				}
+			},
+		},
+		{
+			key:     "orm",
+			summary: i18n.T("summary.orm"),
+			title:   i18n.T("prompt.orm"),
+			options: func(types.ProjectConfig) []option {
+				return []option{
+					{"GORM", i18n.T("prompt.orm.gorm"), "gorm"},
+					{"SQLX", i18n.T("wizard.orm.sqlx"), "sqlx"},
+				}
+			},
+			applies: func(c types.ProjectConfig) bool {
+				return webAPI(c) && c.Features.Database.Driver != "" //nolint:staticcheck // the flags set the single driver
+			},
+			get: func(c types.ProjectConfig) string { return c.Features.Database.ORM },
+			set: func(c *types.ProjectConfig, v string) {
+				c.Features.Database.ORM = v
+				c.Variables["DatabaseORM"] = v
+			},
+		},
+		{
+			key:     "auth",
+			summary: i18n.T("summary.authentication"),
+			title:   i18n.T("prompt.auth_type"),
+			options: func(types.ProjectConfig) []option {
+				return []option{
+					{i18n.T("prompt.no"), i18n.T("prompt.auth.no"), ""},
+					{"JWT", i18n.T("prompt.auth_type.jwt"), "jwt"},
+					{"Session", i18n.T("prompt.auth_type.session"), "session"},
+					{"OAuth2", i18n.T("prompt.auth_type.oauth2"), "oauth2"},
+					{"API Key", i18n.T("prompt.auth_type.api_key"), "api-key"},
+				}
+			},
+			applies: webAPI,
+			get:     func(c types.ProjectConfig) string { return c.Features.Authentication.Type },
+			set: func(c *types.ProjectConfig, v string) {
+				c.Features.Authentication.Type = v
+				c.Variables["AuthType"] = v
+			},
+		},
+	}
+}
+
+// label is the label of the option of the step with value, or the value itself
+func (s step) label(c types.ProjectConfig) string {
+	value := s.get(c)
+	if s.options != nil {
+		for _, o := range s.options(c) {
+			if o.value == value {
+				return o.label
+			}
+		}
+	}
+	return value
+}
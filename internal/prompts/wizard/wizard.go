@@ -0,0 +1,394 @@
+// Package wizard is the full-screen Bubble Tea wizard of go-starter new
+// --interactive=tui. It asks one question per step with a live preview of the
+// file tree the answers generate, reports the combinations the blueprints
+// reject as they are chosen, and ends on a summary to review before generating.
+package wizard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/francknouama/go-starter/internal/i18n"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// ErrCancelled is returned when the wizard is left without confirming the summary
+var ErrCancelled = errors.New("project creation cancelled")
+
+// Checker renders a configuration in memory and returns its file tree, or the
+// reason the configuration cannot be generated
+type Checker func(ctx context.Context, config types.ProjectConfig) (string, error)
+
+// previewLines is the height of the preview when the terminal size is unknown
+const previewLines = 24
+
+var (
+	titleStyle   = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
+	stepStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+	cursorStyle  = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("10"))
+	errorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	helpStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("8")).Italic(true)
+	paneStyle    = lipgloss.NewStyle().Padding(0, 2).Width(56)
+	previewStyle = lipgloss.NewStyle().Padding(0, 2).BorderStyle(lipgloss.NormalBorder()).BorderLeft(true).BorderForeground(lipgloss.Color("8"))
+)
+
+// previewMsg is the file tree of the configuration of a preview request
+type previewMsg struct {
+	seq  int
+	tree string
+	err  error
+}
+
+// Model is the state of the wizard
+type Model struct {
+	ctx   context.Context
+	check Checker
+	steps []step
+	// preset are the steps answered by flags, which the wizard does not ask
+	preset map[string]bool
+
+	config  types.ProjectConfig
+	current int // index of the step asked, len(steps) for the summary
+	history []int
+	cursor  int
+	input   textinput.Model
+	invalid error
+
+	seq     int // sequence of the latest preview request
+	tree    string
+	problem error
+	loading bool
+
+	// initial is the preview request of the first step
+	initial tea.Cmd
+
+	height    int
+	confirmed bool
+	cancelled bool
+}
+
+// New creates the wizard for the configuration built from the flags. The steps
+// the flags answer are skipped.
+func New(ctx context.Context, initial types.ProjectConfig, check Checker) Model {
+	config := clone(initial)
+	if config.Variables == nil {
+		config.Variables = make(map[string]string)
+	}
+	if config.Features == nil {
+		config.Features = &types.Features{}
+	}
+
+	m := Model{ctx: ctx, check: check, steps: steps(), preset: make(map[string]bool), config: config, input: textinput.New()}
+	for _, s := range m.steps {
+		if s.get(config) != "" {
+			m.preset[s.key] = true
+		}
+	}
+	m.input.CharLimit = 256
+	m.input.Width = 48
+	m.enter(m.next(-1))
+	m, m.initial = m.requestPreview()
+	return m
+}
+
+// Config returns the configuration answered so far
+func (m Model) Config() types.ProjectConfig {
+	return m.config
+}
+
+// Init requests the preview of the first step
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(textinput.Blink, m.initial)
+}
+
+// Update handles the keys and the previews
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.height = msg.Height
+		return m, nil
+
+	case previewMsg:
+		if msg.seq == m.seq {
+			m.tree, m.problem, m.loading = msg.tree, msg.err, false
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			m.cancelled = true
+			return m, tea.Quit
+		case "esc", "shift+tab":
+			if len(m.history) == 0 {
+				return m, nil
+			}
+			m.enter(m.history[len(m.history)-1])
+			m.history = m.history[:len(m.history)-1]
+			return m.requestPreview()
+		case "enter":
+			return m.answer()
+		}
+
+		if m.summary() {
+			return m, nil
+		}
+		if m.steps[m.current].text {
+			var cmd tea.Cmd
+			m.input, cmd = m.input.Update(msg)
+			m.invalid = nil
+			return m, cmd
+		}
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+				return m.requestPreview()
+			}
+		case "down", "j":
+			if m.cursor < len(m.options())-1 {
+				m.cursor++
+				return m.requestPreview()
+			}
+		}
+	}
+	return m, nil
+}
+
+// answer records the answer of the step and moves on, or confirms the summary
+func (m Model) answer() (tea.Model, tea.Cmd) {
+	if m.summary() {
+		// Only a configuration that renders is confirmed
+		if m.loading || m.problem != nil {
+			return m, nil
+		}
+		m.confirmed = true
+		return m, tea.Quit
+	}
+
+	value, err := m.value()
+	if err != nil {
+		m.invalid = err
+		return m, nil
+	}
+	m.steps[m.current].set(&m.config, value)
+	m.history = append(m.history, m.current)
+	m.enter(m.next(m.current))
+	return m.requestPreview()
+}
+
+// value is the answer of the current step
+func (m Model) value() (string, error) {
+	s := m.steps[m.current]
+	if !s.text {
+		return m.options()[m.cursor].value, nil
+	}
+	value := strings.TrimSpace(m.input.Value())
+	if value == "" {
+		value = s.placeholder(m.config)
+	}
+	if s.validate != nil {
+		if err := s.validate(value); err != nil {
+			return "", err
+		}
+	}
+	return value, nil
+}
+
+// next is the index of the first step after i asked for the answers so far
+func (m Model) next(i int) int {
+	for i++; i < len(m.steps); i++ {
+		s := m.steps[i]
+		if !m.preset[s.key] && s.applies(m.config) {
+			return i
+		}
+	}
+	return len(m.steps)
+}
+
+// enter shows the step i, its answer so far selected
+func (m *Model) enter(i int) {
+	m.current, m.cursor, m.invalid = i, 0, nil
+	if m.summary() {
+		m.input.Blur()
+		return
+	}
+	s := m.steps[i]
+	if s.text {
+		m.input.SetValue(s.get(m.config))
+		m.input.Placeholder = s.placeholder(m.config)
+		m.input.CursorEnd()
+		m.input.Focus()
+		return
+	}
+	m.input.Blur()
+	for j, o := range m.options() {
+		if o.value == s.get(m.config) {
+			m.cursor = j
+		}
+	}
+}
+
+// summary reports whether all the steps are answered
+func (m Model) summary() bool {
+	return m.current >= len(m.steps)
+}
+
+func (m Model) options() []option {
+	return m.steps[m.current].options(m.config)
+}
+
+// requestPreview renders the configuration with the option under the cursor
+func (m Model) requestPreview() (Model, tea.Cmd) {
+	config := clone(m.config)
+	if !m.summary() && !m.steps[m.current].text {
+		m.steps[m.current].set(&config, m.options()[m.cursor].value)
+	}
+	if config.Name == "" {
+		config.Name = "my-project"
+	}
+	if config.Module == "" {
+		config.Module = "github.com/username/" + config.Name
+	}
+	if config.Type == "" {
+		m.tree, m.problem, m.loading = "", nil, false
+		return m, nil
+	}
+
+	m.seq++
+	m.loading = true
+	seq, ctx, check := m.seq, m.ctx, m.check
+	return m, func() tea.Msg {
+		tree, err := check(ctx, config)
+		return previewMsg{seq: seq, tree: tree, err: err}
+	}
+}
+
+// View shows the step, or the summary, next to the preview
+func (m Model) View() string {
+	if m.confirmed || m.cancelled {
+		return ""
+	}
+
+	var left strings.Builder
+	if m.summary() {
+		left.WriteString(titleStyle.Render(i18n.T("wizard.review")) + "\n\n")
+		for _, s := range m.steps {
+			if !s.applies(m.config) {
+				continue
+			}
+			value := s.label(m.config)
+			if value == "" {
+				value = i18n.T("prompt.no")
+			}
+			if m.preset[s.key] {
+				value += " " + stepStyle.Render(i18n.T("wizard.preset"))
+			}
+			fmt.Fprintf(&left, "%s %s\n", stepStyle.Render(s.summary), value)
+		}
+		left.WriteString("\n")
+		switch {
+		case m.loading:
+			left.WriteString(stepStyle.Render(i18n.T("wizard.rendering")) + "\n")
+		case m.problem != nil:
+			left.WriteString(errorStyle.Render("✗ "+m.problem.Error()) + "\n")
+		default:
+			left.WriteString(cursorStyle.Render("✓ "+i18n.T("wizard.ready")) + "\n")
+		}
+	} else {
+		s := m.steps[m.current]
+		position := i18n.T("wizard.step", len(m.history)+1)
+		left.WriteString(stepStyle.Render(position) + "\n" + titleStyle.Render(s.title) + "\n\n")
+		if s.text {
+			left.WriteString(m.input.View() + "\n")
+			if m.invalid != nil {
+				left.WriteString(errorStyle.Render("✗ "+m.invalid.Error()) + "\n")
+			}
+		} else {
+			for i, o := range m.options() {
+				line := "  " + o.label
+				if i == m.cursor {
+					line = cursorStyle.Render("▸ " + o.label)
+				}
+				left.WriteString(line + "\n")
+				if i == m.cursor && o.description != "" {
+					left.WriteString("    " + stepStyle.Render(o.description) + "\n")
+				}
+			}
+			if m.problem != nil && !m.loading {
+				left.WriteString("\n" + errorStyle.Render("✗ "+m.problem.Error()) + "\n")
+			}
+		}
+	}
+
+	help := i18n.T("wizard.help")
+	if m.summary() {
+		help = i18n.T("wizard.help_review")
+	}
+	left.WriteString("\n" + helpStyle.Render(help))
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, paneStyle.Render(left.String()), previewStyle.Render(m.previewView()))
+}
+
+// previewView is the file tree of the preview, cut to the height of the terminal
+func (m Model) previewView() string {
+	title := titleStyle.Render(i18n.T("wizard.preview"))
+	if m.tree == "" {
+		return title
+	}
+	height := previewLines
+	if m.height > 4 {
+		height = m.height - 4
+	}
+	lines := strings.Split(strings.TrimSuffix(m.tree, "\n"), "\n")
+	if len(lines) > height {
+		more := len(lines) - height + 1
+		lines = append(lines[:height-1], stepStyle.Render(i18n.T("wizard.more", more)))
+	}
+	tree := strings.Join(lines, "\n")
+	if m.loading {
+		tree = stepStyle.Render(tree)
+	}
+	return title + "\n\n" + tree
+}
+
+// clone copies the configuration with its features and variables
+func clone(config types.ProjectConfig) types.ProjectConfig {
+	if config.Features != nil {
+		features := *config.Features
+		features.Database.Drivers = append([]string(nil), features.Database.Drivers...)
+		config.Features = &features
+	}
+	if config.Variables != nil {
+		variables := make(map[string]string, len(config.Variables))
+		for key, value := range config.Variables {
+			variables[key] = value
+		}
+		config.Variables = variables
+	}
+	config.Experimental = append([]string(nil), config.Experimental...)
+	return config
+}
+
+// Run shows the wizard for the configuration built from the flags and returns the
+// configuration confirmed on the summary
+func Run(ctx context.Context, initial types.ProjectConfig, check Checker) (types.ProjectConfig, error) {
+	final, err := tea.NewProgram(New(ctx, initial, check), tea.WithAltScreen(), tea.WithContext(ctx)).Run()
+	if err != nil {
+		return initial, err
+	}
+	m, ok := final.(Model)
+	if !ok {
+		return initial, fmt.Errorf("unexpected model type %T", final)
+	}
+	if !m.confirmed {
+		return initial, ErrCancelled
+	}
+	return m.config, nil
+}
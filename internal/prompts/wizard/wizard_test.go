@@ -0,0 +1,128 @@
+package wizard
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// fakeCheck lists the choices as the file tree, and rejects sqlite with JWT
+func fakeCheck(_ context.Context, c types.ProjectConfig) (string, error) {
+	if c.Features.Database.Driver == "sqlite" && c.Features.Authentication.Type == "jwt" { //nolint:staticcheck // the flags set the single driver
+		return "", errors.New("sqlite does not store JWT sessions")
+	}
+	return c.Name + "/\n└── " + c.Type + "-" + c.Framework + "-" + c.Logger + ".go\n", nil
+}
+
+// press sends the keys to the wizard, running the preview requests they make
+func press(t *testing.T, m Model, keys ...tea.KeyMsg) Model {
+	t.Helper()
+	for _, key := range keys {
+		next, cmd := m.Update(key)
+		m = next.(Model)
+		m = settle(m, cmd)
+	}
+	return m
+}
+
+// settle delivers the preview of cmd, when it is a preview request
+func settle(m Model, cmd tea.Cmd) Model {
+	if cmd == nil {
+		return m
+	}
+	if msg, ok := cmd().(previewMsg); ok {
+		next, _ := m.Update(msg)
+		return next.(Model)
+	}
+	return m
+}
+
+func typed(text string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)}
+}
+
+var (
+	enter = tea.KeyMsg{Type: tea.KeyEnter}
+	down  = tea.KeyMsg{Type: tea.KeyDown}
+	esc   = tea.KeyMsg{Type: tea.KeyEsc}
+)
+
+func TestWizard_Steps(t *testing.T) {
+	m := New(context.Background(), types.ProjectConfig{}, fakeCheck)
+	m = settle(m, m.initial)
+	assert.Equal(t, "name", m.steps[m.current].key)
+
+	// An empty answer takes the placeholder, an invalid one is refused
+	m = press(t, m, typed("bad name!"), enter)
+	assert.Equal(t, "name", m.steps[m.current].key)
+	assert.Error(t, m.invalid)
+	m = press(t, m, tea.KeyMsg{Type: tea.KeyCtrlU}, typed("orders"), enter, enter)
+	assert.Equal(t, "github.com/username/orders", m.config.Module)
+
+	// The preview follows the option under the cursor
+	assert.Equal(t, "type", m.steps[m.current].key)
+	assert.Contains(t, m.tree, "web-api-")
+	m = press(t, m, down)
+	assert.Contains(t, m.tree, "cli-simple-cobra")
+
+	// Web APIs ask for their architecture, framework, database and authentication
+	m = press(t, m, esc)
+	assert.Equal(t, "module", m.steps[m.current].key)
+	m = press(t, m, enter, enter, enter, down, enter)
+	assert.Equal(t, "web-api", m.config.Type)
+	assert.Equal(t, "echo", m.config.Framework)
+	assert.Equal(t, "logger", m.steps[m.current].key)
+
+	// Incompatible choices are reported as they are chosen, and block the summary
+	m = press(t, m, enter, down, down, down, enter, enter, down)
+	assert.Equal(t, "auth", m.steps[m.current].key)
+	require.Error(t, m.problem)
+	assert.Contains(t, m.View(), "sqlite does not store JWT sessions")
+	m = press(t, m, enter, enter)
+	assert.True(t, m.summary())
+	assert.False(t, m.confirmed, "the summary is not confirmed while the choices do not render")
+
+	// Going back to pick another database clears the problem
+	m = press(t, m, esc, esc, esc, tea.KeyMsg{Type: tea.KeyUp}, enter)
+	assert.Equal(t, "orm", m.steps[m.current].key)
+	m = press(t, m, enter, enter)
+	assert.True(t, m.summary())
+	assert.NoError(t, m.problem)
+
+	view := m.View()
+	assert.Contains(t, view, "orders/")
+	assert.Contains(t, view, "MySQL")
+	m = press(t, m, enter)
+	assert.True(t, m.confirmed)
+
+	config := m.Config()
+	assert.Equal(t, "mysql", config.Variables["DatabaseDriver"])
+	assert.Equal(t, "gorm", config.Features.Database.ORM)
+	assert.Equal(t, "jwt", config.Features.Authentication.Type)
+}
+
+func TestWizard_Preset(t *testing.T) {
+	m := New(context.Background(), types.ProjectConfig{
+		Name:   "tool",
+		Module: "github.com/test/tool",
+		Type:   "library",
+	}, fakeCheck)
+	m = settle(m, m.initial)
+
+	// The flags answer every step of a library
+	assert.True(t, m.summary())
+	assert.Contains(t, m.tree, "tool/")
+	assert.Contains(t, m.View(), "(from flags)")
+
+	m = press(t, m, esc)
+	assert.True(t, m.summary(), "there is no step to go back to")
+	m = press(t, m, tea.KeyMsg{Type: tea.KeyCtrlC})
+	assert.True(t, m.cancelled)
+	assert.False(t, m.confirmed)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/francknouama/go-starter/internal/naming"
 	"github.com/francknouama/go-starter/pkg/types"
 )
 
@@ -100,12 +101,8 @@ func (s *InputSanitizer) validateProjectName(name string) error {
 	}
 
 	// Check for reserved names
-	reservedNames := []string{"CON", "PRN", "AUX", "NUL", "COM1", "COM2", "COM3", "COM4", "COM5", "COM6", "COM7", "COM8", "COM9", "LPT1", "LPT2", "LPT3", "LPT4", "LPT5", "LPT6", "LPT7", "LPT8", "LPT9"}
-	upperName := strings.ToUpper(name)
-	for _, reserved := range reservedNames {
-		if upperName == reserved {
-			return types.NewValidationError(fmt.Sprintf("project name '%s' is reserved", name), nil)
-		}
+	if naming.IsReservedName(name) {
+		return types.NewValidationError(fmt.Sprintf("project name '%s' is reserved", name), nil)
 	}
 
 	// Must contain at least one letter
@@ -131,15 +128,26 @@ func (s *InputSanitizer) validateProjectType(projectType string) error {
 
 	// Whitelist of allowed project types
 	allowedTypes := map[string]bool{
-		"web-api":      true,
-		"cli":          true,
-		"library":      true,
-		"lambda":       true,
-		"lambda-proxy": true,
-		"event-driven": true,
-		"microservice": true,
-		"monolith":     true,
-		"workspace":    true,
+		"web-api":            true,
+		"cli":                true,
+		"cli-advanced":       true,
+		"library":            true,
+		"lambda":             true,
+		"lambda-proxy":       true,
+		"event-driven":       true,
+		"microservice":       true,
+		"grpc-service":       true,
+		"event-service":      true,
+		"terraform-provider": true,
+		"tui":                true,
+		"bot":                true,
+		"web-app":            true,
+		"realtime":           true,
+		"gateway":            true,
+		"desktop":            true,
+		"workflow":           true,
+		"monolith":           true,
+		"workspace":          true,
 	}
 
 	if !allowedTypes[projectType] {
@@ -0,0 +1,125 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// eventsFile is the name of the file sink's events in the go-starter config directory
+const eventsFile = "telemetry.jsonl"
+
+// SendTimeout bounds the delivery of an event, telemetry must not hold up the CLI
+const SendTimeout = 2 * time.Second
+
+// FileSink appends events as JSON lines to a file, for the user to inspect or
+// share
+type FileSink struct {
+	Path string
+}
+
+func newFileSink(config Config) (Sink, error) {
+	path := config.Path
+	if path == "" {
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(dir, "go-starter", eventsFile)
+	}
+	return &FileSink{Path: path}, nil
+}
+
+// Send appends event to the file
+func (s *FileSink) Send(_ context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.Path), 0755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(s.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Events returns the events appended to the file, none when it does not exist
+func (s *FileSink) Events() ([]Event, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var event Event
+		if err := json.Unmarshal(line, &event); err != nil {
+			return nil, fmt.Errorf("invalid telemetry event in %s: %w", s.Path, err)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// HTTPSink posts each event as JSON to a collector
+type HTTPSink struct {
+	Endpoint string
+	Client   *http.Client
+}
+
+func newHTTPSink(config Config) (Sink, error) {
+	if config.Endpoint == "" {
+		return nil, fmt.Errorf("the http telemetry sink needs an endpoint, set %s", EndpointEnvVar)
+	}
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil || (endpoint.Scheme != "https" && endpoint.Scheme != "http") || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid telemetry endpoint %q, expected an http or https URL", config.Endpoint)
+	}
+	return &HTTPSink{Endpoint: config.Endpoint, Client: &http.Client{Timeout: SendTimeout}}, nil
+}
+
+// Send posts event to the endpoint, which must answer with a 2xx status
+func (s *HTTPSink) Send(ctx context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, SendTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.Endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := s.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("telemetry endpoint answered %s", response.Status)
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+// Package telemetry records anonymous usage of go-starter when the user opts in:
+// which blueprints are generated, with which flags, how long generation takes and
+// how it fails. Maintainers look at it to decide which blueprint combinations to
+// invest in. It is disabled by default and never records names, module paths,
+// flag values or error messages.
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// Environment variables configuring telemetry, they take precedence over the
+// telemetry section of ~/.go-starter.yaml
+const (
+	// EnvVar enables telemetry when true and disables it when false
+	EnvVar = "GO_STARTER_TELEMETRY"
+	// SinkEnvVar names the sink events are sent to
+	SinkEnvVar = "GO_STARTER_TELEMETRY_SINK"
+	// EndpointEnvVar is the URL the http sink posts events to
+	EndpointEnvVar = "GO_STARTER_TELEMETRY_ENDPOINT"
+	// PathEnvVar is the file the file sink appends events to
+	PathEnvVar = "GO_STARTER_TELEMETRY_PATH"
+)
+
+// DefaultSink is the sink used when none is configured, keeping events on the machine
+const DefaultSink = "file"
+
+// Failure codes of events that are not go-starter errors
+const (
+	FailureCanceled = "CANCELED"
+	FailureUnknown  = types.ErrCodeUnknown
+)
+
+// Version is the go-starter version recorded in events, set by the CLI
+var Version = "dev"
+
+// Config selects whether and where events are recorded
+type Config struct {
+	Enabled bool `mapstructure:"enabled" yaml:"enabled"`
+	// Sink names a registered sink, file when empty
+	Sink string `mapstructure:"sink" yaml:"sink"`
+	// Endpoint is the URL of the http sink
+	Endpoint string `mapstructure:"endpoint" yaml:"endpoint"`
+	// Path is the file of the file sink, telemetry.jsonl in the go-starter config
+	// directory when empty
+	Path string `mapstructure:"path" yaml:"path"`
+}
+
+// WithEnv returns the configuration overridden by the GO_STARTER_TELEMETRY
+// environment variables
+func (c Config) WithEnv() Config {
+	if value := os.Getenv(EnvVar); value != "" {
+		c.Enabled = isTrue(value)
+	}
+	if value := os.Getenv(SinkEnvVar); value != "" {
+		c.Sink = value
+	}
+	if value := os.Getenv(EndpointEnvVar); value != "" {
+		c.Endpoint = value
+	}
+	if value := os.Getenv(PathEnvVar); value != "" {
+		c.Path = value
+	}
+	return c
+}
+
+// Active reports whether events are recorded: telemetry is enabled and the user
+// did not opt out of tracking with DO_NOT_TRACK
+func (c Config) Active() bool {
+	return c.Enabled && !TrackingDisabled()
+}
+
+// TrackingDisabled reports whether the user opted out of any tracking with
+// DO_NOT_TRACK, the one switch go-starter checks before recording usage
+func TrackingDisabled() bool {
+	value := os.Getenv("DO_NOT_TRACK")
+	return value != "" && isTrue(value)
+}
+
+// isTrue reads an environment switch, anything but 0, false, off and no is true
+func isTrue(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "0", "false", "off", "no":
+		return false
+	}
+	return true
+}
+
+// Event is the anonymous record of one generation
+type Event struct {
+	Time      time.Time `json:"time"`
+	Version   string    `json:"version"`
+	OS        string    `json:"os"`
+	Arch      string    `json:"arch"`
+	Command   string    `json:"command"`
+	Blueprint string    `json:"blueprint"`
+	Type      string    `json:"type"`
+	// Architecture and Framework are the choices of the blueprint, empty when it has none
+	Architecture string `json:"architecture,omitempty"`
+	Framework    string `json:"framework,omitempty"`
+	// Flags lists the names of the flags set, never their values
+	Flags []string `json:"flags"`
+	// Experiments are the experimental features the generation used, counted by
+	// the experimental command
+	Experiments []string `json:"experiments,omitempty"`
+	DurationMS  int64    `json:"duration_ms"`
+	Success     bool     `json:"success"`
+	// Failure is the code of the error the generation failed with, never its message
+	Failure string `json:"failure,omitempty"`
+}
+
+// NewEvent describes a generation of config with blueprint that took duration and
+// ended with err. Blueprints not shipped with go-starter are recorded as remote,
+// since their names may identify their authors.
+func NewEvent(command, blueprint string, builtin bool, config types.ProjectConfig, flags []string, duration time.Duration, err error) Event {
+	if !builtin {
+		blueprint = "remote"
+	}
+	flags = append([]string(nil), flags...)
+	sort.Strings(flags)
+
+	event := Event{
+		Time:         time.Now().UTC(),
+		Version:      Version,
+		OS:           runtime.GOOS,
+		Arch:         runtime.GOARCH,
+		Command:      command,
+		Blueprint:    blueprint,
+		Type:         config.Type,
+		Architecture: config.Architecture,
+		Framework:    config.Framework,
+		Flags:        flags,
+		DurationMS:   duration.Milliseconds(),
+		Success:      err == nil,
+	}
+	if err != nil {
+		event.Failure = FailureCode(err)
+	}
+	return event
+}
+
+// FailureCode returns the code of a go-starter error, which says what failed
+// without the paths and values of its message
+func FailureCode(err error) string {
+	var goStarterErr *types.GoStarterError
+	switch {
+	case errors.Is(err, context.Canceled):
+		return FailureCanceled
+	case errors.As(err, &goStarterErr):
+		return goStarterErr.Code
+	}
+	return FailureUnknown
+}
+
+// Sink receives the events of the generations
+type Sink interface {
+	Send(ctx context.Context, event Event) error
+}
+
+// SinkFactory opens a sink from the configuration
+type SinkFactory func(config Config) (Sink, error)
+
+// sinks are the registered sinks by name
+var sinks = map[string]SinkFactory{
+	"file": newFileSink,
+	"http": newHTTPSink,
+}
+
+// RegisterSink makes a sink available to the sink setting, replacing any sink of
+// the same name
+func RegisterSink(name string, factory SinkFactory) {
+	sinks[name] = factory
+}
+
+// Sinks lists the names of the registered sinks
+func Sinks() []string {
+	names := make([]string, 0, len(sinks))
+	for name := range sinks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Open opens the sink the configuration names
+func Open(config Config) (Sink, error) {
+	name := config.Sink
+	if name == "" {
+		name = DefaultSink
+	}
+	factory, ok := sinks[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown telemetry sink %q, expected one of %s", name, strings.Join(Sinks(), ", "))
+	}
+	return factory(config)
+}
+
+// Record sends event to the configured sink when telemetry is active
+func Record(ctx context.Context, config Config, event Event) error {
+	if !config.Active() {
+		return nil
+	}
+	sink, err := Open(config)
+	if err != nil {
+		return err
+	}
+	return sink.Send(ctx, event)
+}
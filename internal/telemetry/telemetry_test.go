@@ -0,0 +1,144 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+func TestConfig_Active(t *testing.T) {
+	t.Setenv(EnvVar, "")
+	t.Setenv("DO_NOT_TRACK", "")
+	assert.False(t, Config{}.WithEnv().Active(), "telemetry is disabled by default")
+	assert.True(t, Config{Enabled: true}.WithEnv().Active())
+
+	t.Setenv(EnvVar, "false")
+	assert.False(t, Config{Enabled: true}.WithEnv().Active(), "the environment overrides the config file")
+	t.Setenv(EnvVar, "1")
+	t.Setenv(SinkEnvVar, "http")
+	t.Setenv(EndpointEnvVar, "https://telemetry.example.com/events")
+	config := Config{Sink: "file"}.WithEnv()
+	assert.True(t, config.Active())
+	assert.Equal(t, Config{Enabled: true, Sink: "http", Endpoint: "https://telemetry.example.com/events"}, config)
+
+	t.Setenv("DO_NOT_TRACK", "1")
+	assert.False(t, config.Active(), "DO_NOT_TRACK wins over the opt-in")
+}
+
+func TestNewEvent(t *testing.T) {
+	config := types.ProjectConfig{Name: "orders", Module: "github.com/acme/orders", Type: "web-api", Architecture: "clean", Framework: "gin"}
+
+	event := NewEvent("new", "web-api-clean", true, config, []string{"type", "framework"}, 1500*time.Millisecond, nil)
+	assert.Equal(t, "web-api-clean", event.Blueprint)
+	assert.Equal(t, []string{"framework", "type"}, event.Flags)
+	assert.Equal(t, int64(1500), event.DurationMS)
+	assert.True(t, event.Success)
+	assert.Empty(t, event.Failure)
+
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "orders", "names and module paths are never recorded")
+
+	event = NewEvent("new", "acme-internal", false, config, nil, time.Second, fmt.Errorf("failed to generate project: %w", types.NewFileSystemError("cannot write /home/me/orders", nil)))
+	assert.Equal(t, "remote", event.Blueprint)
+	assert.False(t, event.Success)
+	assert.Equal(t, types.ErrCodeFileSystem, event.Failure)
+}
+
+func TestFailureCode(t *testing.T) {
+	assert.Equal(t, FailureCanceled, FailureCode(fmt.Errorf("interrupted: %w", context.Canceled)))
+	assert.Equal(t, types.ErrCodeValidation, FailureCode(types.NewValidationError("bad name", nil)))
+	assert.Equal(t, FailureUnknown, FailureCode(errors.New("boom")))
+}
+
+func TestRecord_FileSink(t *testing.T) {
+	t.Setenv("DO_NOT_TRACK", "")
+	path := filepath.Join(t.TempDir(), "telemetry.jsonl")
+	config := Config{Enabled: true, Path: path}
+
+	require.NoError(t, Record(context.Background(), config, Event{Blueprint: "cli", Success: true}))
+	require.NoError(t, Record(context.Background(), config, Event{Blueprint: "web-api", Failure: "GENERATION_ERROR"}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	var event Event
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &event))
+	assert.Equal(t, "web-api", event.Blueprint)
+
+	require.NoError(t, Record(context.Background(), Config{Path: path}, Event{Blueprint: "lambda"}))
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "lambda", "nothing is recorded unless enabled")
+
+	events, err := (&FileSink{Path: path}).Events()
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "cli", events[0].Blueprint)
+	events, err = (&FileSink{Path: filepath.Join(t.TempDir(), "missing.jsonl")}).Events()
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestRecord_HTTPSink(t *testing.T) {
+	t.Setenv("DO_NOT_TRACK", "")
+	var received []Event
+	status := http.StatusAccepted
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var event Event
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		received = append(received, event)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	config := Config{Enabled: true, Sink: "http", Endpoint: server.URL}
+	require.NoError(t, Record(context.Background(), config, Event{Blueprint: "grpc-gateway", DurationMS: 42}))
+	require.Len(t, received, 1)
+	assert.Equal(t, int64(42), received[0].DurationMS)
+
+	status = http.StatusInternalServerError
+	assert.ErrorContains(t, Record(context.Background(), config, Event{}), "telemetry endpoint answered 500")
+
+	assert.ErrorContains(t, Record(context.Background(), Config{Enabled: true, Sink: "http"}, Event{}), "needs an endpoint")
+	assert.ErrorContains(t, Record(context.Background(), Config{Enabled: true, Sink: "http", Endpoint: "ftp://example.com"}, Event{}), "invalid telemetry endpoint")
+}
+
+// recordingSink keeps the events it is sent
+type recordingSink struct {
+	events []Event
+}
+
+func (s *recordingSink) Send(_ context.Context, event Event) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestRegisterSink(t *testing.T) {
+	t.Setenv("DO_NOT_TRACK", "")
+	sink := &recordingSink{}
+	RegisterSink("memory", func(Config) (Sink, error) { return sink, nil })
+	t.Cleanup(func() { delete(sinks, "memory") })
+
+	assert.Equal(t, []string{"file", "http", "memory"}, Sinks())
+	require.NoError(t, Record(context.Background(), Config{Enabled: true, Sink: "memory"}, Event{Blueprint: "library"}))
+	assert.Equal(t, []Event{{Blueprint: "library"}}, sink.events)
+
+	assert.ErrorContains(t, Record(context.Background(), Config{Enabled: true, Sink: "kafka"}, Event{}), `unknown telemetry sink "kafka", expected one of file, http, memory`)
+}
@@ -2,28 +2,39 @@ package templates
 
 import (
 	"io/fs"
+	"sync"
 )
 
 // templatesFS holds the embedded filesystem set by the main package
-var templatesFS fs.FS
+var (
+	templatesFS   fs.FS
+	templatesFSMu sync.RWMutex
+)
 
-// SetTemplatesFS sets the embedded filesystem (called from main package or tests)
+// SetTemplatesFS sets the embedded filesystem (called from main package or tests).
+// Registries that are already loaded keep their templates until they are reloaded.
 func SetTemplatesFS(fs fs.FS) {
+	templatesFSMu.Lock()
+	defer templatesFSMu.Unlock()
 	templatesFS = fs
 }
 
 // GetTemplatesFS returns the filesystem for templates
 func GetTemplatesFS() fs.FS {
-	if templatesFS == nil {
+	templatesFSMu.RLock()
+	fsys := templatesFS
+	templatesFSMu.RUnlock()
+
+	if fsys == nil {
 		panic("templates filesystem not initialized - ensure SetTemplatesFS is called from main")
 	}
 
 	// Check if we need to strip the "blueprints" prefix
 	// For embedded FS from root, we need to strip it
 	// For test DirFS pointing directly to blueprints, we don't
-	if _, err := fs.Stat(templatesFS, "blueprints"); err == nil {
+	if _, err := fs.Stat(fsys, "blueprints"); err == nil {
 		// This is likely the embedded FS with "blueprints" directory
-		subFS, err := fs.Sub(templatesFS, "blueprints")
+		subFS, err := fs.Sub(fsys, "blueprints")
 		if err != nil {
 			panic("failed to create sub-filesystem for blueprints: " + err.Error())
 		}
@@ -31,5 +42,5 @@ func GetTemplatesFS() fs.FS {
 	}
 
 	// This is likely a DirFS pointing directly to templates directory
-	return templatesFS
+	return fsys
 }
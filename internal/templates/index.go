@@ -0,0 +1,128 @@
+package templates
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/francknouama/go-starter/pkg/types"
+	"gopkg.in/yaml.v3"
+)
+
+// IndexFile is the metadata index at the root of a blueprints filesystem. A
+// registry reading blueprints from a filesystem with an index parses the
+// template.yaml of a blueprint once it is used, instead of parsing every
+// blueprint when it is created. go-starter blueprint index writes it.
+const IndexFile = "index.yaml"
+
+// indexHeader marks the index as generated
+const indexHeader = "# Code generated by go-starter blueprint index. DO NOT EDIT.\n"
+
+// IndexEntry is the metadata of a blueprint kept in the index: what listing it
+// needs, and the directory of its template.yaml
+type IndexEntry struct {
+	ID           string             `yaml:"id"`
+	Dir          string             `yaml:"dir"`
+	Name         string             `yaml:"name"`
+	Description  string             `yaml:"description"`
+	Type         string             `yaml:"type"`
+	Architecture string             `yaml:"architecture,omitempty"`
+	Version      string             `yaml:"version,omitempty"`
+	Experimental string             `yaml:"experimental,omitempty"`
+	Deprecated   *types.Deprecation `yaml:"deprecated,omitempty"`
+}
+
+// Index lists the blueprints of a filesystem, by ID
+type Index struct {
+	Blueprints []IndexEntry `yaml:"blueprints"`
+}
+
+// BuildIndex loads every blueprint of fsys and returns their index
+func BuildIndex(fsys fs.FS) (Index, error) {
+	loaded, err := NewTemplateLoaderWithFS(fsys).LoadAll()
+	if err != nil {
+		return Index{}, err
+	}
+	index := Index{Blueprints: make([]IndexEntry, 0, len(loaded))}
+	for _, template := range loaded {
+		index.Blueprints = append(index.Blueprints, newIndexEntry(template))
+	}
+	sort.Slice(index.Blueprints, func(i, j int) bool { return index.Blueprints[i].ID < index.Blueprints[j].ID })
+	return index, nil
+}
+
+// ReadIndex reads the index of fsys; the error wraps fs.ErrNotExist when it has none
+func ReadIndex(fsys fs.FS) (Index, error) {
+	data, err := fs.ReadFile(fsys, IndexFile)
+	if err != nil {
+		return Index{}, err
+	}
+	var index Index
+	if err := yaml.Unmarshal(data, &index); err != nil {
+		return Index{}, fmt.Errorf("failed to parse %s: %w", IndexFile, err)
+	}
+	return index, nil
+}
+
+// WriteIndex writes the index of the blueprints in dir to its index.yaml
+func WriteIndex(dir string) (Index, error) {
+	index, err := BuildIndex(os.DirFS(dir))
+	if err != nil {
+		return Index{}, err
+	}
+	data, err := index.Encode()
+	if err != nil {
+		return Index{}, err
+	}
+	return index, os.WriteFile(filepath.Join(dir, IndexFile), data, types.DefaultFileMode)
+}
+
+// Encode returns the index as written to index.yaml
+func (i Index) Encode() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(indexHeader)
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(i); err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", IndexFile, err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", IndexFile, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// newIndexEntry returns the index entry of a loaded template
+func newIndexEntry(template types.Template) IndexEntry {
+	dir, _ := template.Metadata["path"].(string)
+	return IndexEntry{
+		ID:           template.ID,
+		Dir:          filepath.ToSlash(dir),
+		Name:         template.Name,
+		Description:  template.Description,
+		Type:         template.Type,
+		Architecture: template.Architecture,
+		Version:      template.Version,
+		Experimental: template.Experimental,
+		Deprecated:   template.Deprecated,
+	}
+}
+
+// Summary returns a template holding only the metadata of the entry, for listing
+// the blueprint without loading it
+func (e IndexEntry) Summary() types.Template {
+	return types.Template{
+		ID:           e.ID,
+		Name:         e.Name,
+		Description:  e.Description,
+		Type:         e.Type,
+		Architecture: e.Architecture,
+		Version:      e.Version,
+		Experimental: e.Experimental,
+		Deprecated:   e.Deprecated,
+		Metadata:     map[string]any{"path": e.Dir},
+	}
+}
@@ -0,0 +1,116 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// indexedFS builds a filesystem with the blueprints of blueprintFS and their index
+func indexedFS(t *testing.T, ids ...string) fstest.MapFS {
+	t.Helper()
+	fsys := blueprintFS("v1", ids...)
+	index, err := BuildIndex(fsys)
+	require.NoError(t, err)
+	data, err := index.Encode()
+	require.NoError(t, err)
+	fsys[IndexFile] = &fstest.MapFile{Data: data}
+	return fsys
+}
+
+func TestIndex_UpToDate(t *testing.T) {
+	fsys := os.DirFS(filepath.Join("..", "..", "blueprints"))
+	index, err := BuildIndex(fsys)
+	require.NoError(t, err)
+	want, err := index.Encode()
+	require.NoError(t, err)
+
+	got, err := os.ReadFile(filepath.Join("..", "..", "blueprints", IndexFile))
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got), "blueprints/index.yaml is out of date, run go generate")
+}
+
+func TestRegistry_LoadsIndexedBlueprintsOnUse(t *testing.T) {
+	fsys := indexedFS(t, "alpha", "beta")
+	// A blueprint that no longer parses is only noticed once used
+	fsys["beta/template.yaml"] = &fstest.MapFile{Data: []byte("id: [unterminated")}
+
+	registry, err := NewRegistryWithFS(fsys)
+	require.NoError(t, err)
+	assert.True(t, registry.Exists("alpha"))
+	assert.True(t, registry.Exists("beta"))
+	assert.Len(t, registry.templates, 0, "nothing is loaded before it is used")
+
+	summaries := registry.Summaries()
+	require.Len(t, summaries, 2)
+	assert.Equal(t, "alpha", summaries[0].ID)
+	assert.Equal(t, "cli", summaries[0].Type)
+	assert.Empty(t, summaries[0].Files, "summaries do not load the blueprints")
+
+	alpha, loader, err := registry.Lookup("alpha")
+	require.NoError(t, err)
+	assert.Len(t, alpha.Files, 1)
+	content, err := loader.LoadTemplateFile("alpha", "main.go.tmpl")
+	require.NoError(t, err)
+	assert.Equal(t, "v1", content)
+
+	_, err = registry.Get("beta")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load blueprint beta")
+
+	_, err = registry.Get("gamma")
+	assert.Error(t, err)
+
+	// Listing loads the others, leaving out those that fail to load
+	list := registry.List()
+	require.Len(t, list, 1)
+	assert.Equal(t, "alpha", list[0].ID)
+	assert.False(t, registry.Exists("beta"))
+}
+
+func TestRegistry_StaleIndex(t *testing.T) {
+	fsys := indexedFS(t, "alpha")
+	fsys["alpha/template.yaml"] = &fstest.MapFile{Data: []byte("id: renamed\nname: renamed\ntype: cli\n")}
+
+	registry, err := NewRegistryWithFS(fsys)
+	require.NoError(t, err)
+	_, err = registry.Get("alpha")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the blueprint index is out of date: alpha holds blueprint renamed")
+}
+
+func TestRegistry_ReloadWithoutIndex(t *testing.T) {
+	fsys := indexedFS(t, "alpha")
+	fsys["alpha/template.yaml"] = &fstest.MapFile{Data: []byte("id: alpha\nname: Alpha v2\ntype: cli\n")}
+
+	registry, err := NewRegistryWithFS(fsys)
+	require.NoError(t, err)
+	assert.Equal(t, "alpha", registry.Summaries()[0].Name, "the index has the metadata it was built with")
+
+	count, err := registry.reloadFS(fsys, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, "Alpha v2", registry.Summaries()[0].Name)
+}
+
+func TestWriteIndex(t *testing.T) {
+	dir := t.TempDir()
+	for name, file := range blueprintFS("v1", "alpha", "beta") {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, file.Data, 0644))
+	}
+
+	index, err := WriteIndex(dir)
+	require.NoError(t, err)
+	require.Len(t, index.Blueprints, 2)
+
+	read, err := ReadIndex(os.DirFS(dir))
+	require.NoError(t, err)
+	assert.Equal(t, index, read)
+	assert.Equal(t, IndexEntry{ID: "beta", Dir: "beta", Name: "beta", Type: "cli"}, read.Blueprints[1])
+}
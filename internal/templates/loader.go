@@ -1,6 +1,7 @@
 package templates
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
@@ -23,6 +24,14 @@ func NewTemplateLoader() *TemplateLoader {
 	}
 }
 
+// NewTemplateLoaderWithFS creates a template loader reading from the given filesystem
+// instead of the globally configured one (used by tooling that works on a checkout)
+func NewTemplateLoaderWithFS(fsys fs.FS) *TemplateLoader {
+	return &TemplateLoader{
+		fs: fsys,
+	}
+}
+
 // LoadAll loads all templates from the embedded filesystem
 func (l *TemplateLoader) LoadAll() ([]types.Template, error) {
 	var templates []types.Template
@@ -130,6 +139,16 @@ func (l *TemplateLoader) LoadTemplate(templateDir string) (types.Template, error
 		}
 	}
 
+	if err := template.ValidateDeprecations(); err != nil {
+		return types.Template{}, err
+	}
+	if err := template.ValidateExperiments(); err != nil {
+		return types.Template{}, err
+	}
+	if err := template.ValidateRules(); err != nil {
+		return types.Template{}, err
+	}
+
 	// Add template directory to metadata
 	if template.Metadata == nil {
 		template.Metadata = make(map[string]any)
@@ -141,11 +160,20 @@ func (l *TemplateLoader) LoadTemplate(templateDir string) (types.Template, error
 
 // LoadTemplateFile loads a template file content
 func (l *TemplateLoader) LoadTemplateFile(templateDir, filePath string) (string, error) {
+	content, err := l.LoadFile(templateDir, filePath)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// LoadFile loads the raw bytes of a blueprint file (templates and binary assets)
+func (l *TemplateLoader) LoadFile(templateDir, filePath string) ([]byte, error) {
 	fullPath := filepath.Join(templateDir, filePath)
 
 	file, err := l.fs.Open(fullPath)
 	if err != nil {
-		return "", fmt.Errorf("failed to open template file %s: %w", filePath, err)
+		return nil, fmt.Errorf("failed to open template file %s: %w", filePath, err)
 	}
 	defer func() {
 		if err := file.Close(); err != nil {
@@ -155,10 +183,45 @@ func (l *TemplateLoader) LoadTemplateFile(templateDir, filePath string) (string,
 
 	content, err := io.ReadAll(file)
 	if err != nil {
-		return "", fmt.Errorf("failed to read template file %s: %w", filePath, err)
+		return nil, fmt.Errorf("failed to read template file %s: %w", filePath, err)
 	}
 
-	return string(content), nil
+	return content, nil
+}
+
+// PartialPrefix starts the names of partials, which blueprint files include with
+// {{template "partials/<name>" .}}
+const PartialPrefix = "partials/"
+
+// sharedPartialsDir holds the partials of every blueprint, relative to a blueprint
+const sharedPartialsDir = "../shared/partials"
+
+// LoadPartials returns the partials a blueprint can include by name: the .tmpl
+// files of shared/partials, then those of the partials directory of the blueprint,
+// which replace shared partials of the same name. partials/shutdown names
+// partials/shutdown.tmpl. The newline ending a partial is dropped, so that a
+// partial included on its own line renders as its lines.
+func (l *TemplateLoader) LoadPartials(templateDir string) (map[string]string, error) {
+	partials := make(map[string]string)
+	for _, dir := range []string{sharedPartialsDir, strings.TrimSuffix(PartialPrefix, "/")} {
+		root := filepath.ToSlash(filepath.Join(templateDir, dir))
+		err := fs.WalkDir(l.fs, root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !strings.HasSuffix(path, ".tmpl") {
+				return err
+			}
+			content, err := fs.ReadFile(l.fs, path)
+			if err != nil {
+				return err
+			}
+			name := PartialPrefix + strings.TrimSuffix(strings.TrimPrefix(path, root+"/"), ".tmpl")
+			partials[name] = strings.TrimSuffix(string(content), "\n")
+			return nil
+		})
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("failed to load partials of %s: %w", templateDir, err)
+		}
+	}
+	return partials, nil
 }
 
 // GetTemplatePath returns the full path for a template file
@@ -1,17 +1,29 @@
 package templates
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
 	"sort"
 	"sync"
 
 	"github.com/francknouama/go-starter/pkg/types"
 )
 
-// Registry manages all available project templates
+// Registry manages all available project templates. It is safe for concurrent use
+// and can atomically swap its whole template set, so long-running processes such
+// as the web server can hot-reload blueprints while generations are in flight.
+// Blueprints read from a filesystem with an index are loaded once used.
 type Registry struct {
 	templates map[string]types.Template
-	mutex     sync.RWMutex
+	// indexed are the blueprints of the index not loaded yet, by ID
+	indexed  map[string]IndexEntry
+	loader   *TemplateLoader
+	fs       fs.FS
+	version  uint64
+	mutex    sync.RWMutex
+	reloadMu sync.Mutex
 }
 
 // NewRegistry creates a new template registry
@@ -24,6 +36,18 @@ func NewRegistry() *Registry {
 	return r
 }
 
+// NewRegistryWithFS creates a registry that loads its blueprints from fsys instead of
+// the globally configured filesystem
+func NewRegistryWithFS(fsys fs.FS) (*Registry, error) {
+	r := &Registry{
+		templates: make(map[string]types.Template),
+	}
+	if _, err := r.ReloadFS(fsys); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
 // Register adds a template to the registry
 func (r *Registry) Register(template types.Template) error {
 	r.mutex.Lock()
@@ -34,32 +58,52 @@ func (r *Registry) Register(template types.Template) error {
 	}
 
 	r.templates[template.ID] = template
+	delete(r.indexed, template.ID)
 	return nil
 }
 
 // Get retrieves a template by ID
 func (r *Registry) Get(templateID string) (types.Template, error) {
+	template, _, err := r.Lookup(templateID)
+	return template, err
+}
+
+// List returns all available templates, loading the indexed ones
+func (r *Registry) List() []types.Template {
+	r.loadIndexed()
+
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
-	template, exists := r.templates[templateID]
-	if !exists {
-		return types.Template{}, types.NewTemplateNotFoundError(templateID)
+	templates := make([]types.Template, 0, len(r.templates))
+	for _, template := range r.templates {
+		templates = append(templates, template)
 	}
-
-	return template, nil
+	sortTemplates(templates)
+	return templates
 }
 
-// List returns all available templates
-func (r *Registry) List() []types.Template {
+// Summaries returns all available templates in the order of List, holding only
+// their metadata for the indexed ones not loaded yet: ID, name, description,
+// type, architecture, version, experiment and deprecation. Listing blueprints
+// with it does not load them.
+func (r *Registry) Summaries() []types.Template {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
-	templates := make([]types.Template, 0, len(r.templates))
+	templates := make([]types.Template, 0, len(r.templates)+len(r.indexed))
 	for _, template := range r.templates {
 		templates = append(templates, template)
 	}
-	
+	for _, entry := range r.indexed {
+		templates = append(templates, entry.Summary())
+	}
+	sortTemplates(templates)
+	return templates
+}
+
+// sortTemplates sorts templates in the order blueprints are listed
+func sortTemplates(templates []types.Template) {
 	// Sort templates to ensure consistent ordering
 	// Priority: cli-simple first, then by type, then by name
 	sort.Slice(templates, func(i, j int) bool {
@@ -70,21 +114,21 @@ func (r *Registry) List() []types.Template {
 		if templates[j].ID == "cli-simple" {
 			return false
 		}
-		
+
 		// Then sort by type
 		if templates[i].Type != templates[j].Type {
 			return templates[i].Type < templates[j].Type
 		}
-		
+
 		// Finally sort by ID
 		return templates[i].ID < templates[j].ID
 	})
-
-	return templates
 }
 
 // GetByType returns all templates of a specific type
 func (r *Registry) GetByType(templateType string) []types.Template {
+	r.loadIndexed()
+
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
@@ -103,8 +147,7 @@ func (r *Registry) Exists(templateID string) bool {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
-	_, exists := r.templates[templateID]
-	return exists
+	return r.exists(templateID)
 }
 
 // Remove removes a template from the registry
@@ -117,6 +160,7 @@ func (r *Registry) Remove(templateID string) error {
 	}
 
 	delete(r.templates, templateID)
+	delete(r.indexed, templateID)
 	return nil
 }
 
@@ -129,6 +173,9 @@ func (r *Registry) GetTemplateTypes() []string {
 	for _, template := range r.templates {
 		typeSet[template.Type] = true
 	}
+	for _, entry := range r.indexed {
+		typeSet[entry.Type] = true
+	}
 
 	types := make([]string, 0, len(typeSet))
 	for templateType := range typeSet {
@@ -138,31 +185,209 @@ func (r *Registry) GetTemplateTypes() []string {
 	return types
 }
 
+// Lookup retrieves a template together with the loader for the template set it
+// belongs to, so that a reload cannot mix files from two blueprint versions. An
+// indexed template is loaded on its first lookup.
+func (r *Registry) Lookup(templateID string) (types.Template, *TemplateLoader, error) {
+	r.mutex.RLock()
+	template, loaded := r.templates[templateID]
+	_, indexed := r.indexed[templateID]
+	loader := r.currentLoader()
+	r.mutex.RUnlock()
+
+	switch {
+	case loaded:
+		return template, loader, nil
+	case !indexed:
+		return types.Template{}, nil, types.NewTemplateNotFoundError(templateID)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	// Another lookup or a reload may have come first
+	if template, loaded := r.templates[templateID]; loaded {
+		return template, r.currentLoader(), nil
+	}
+	entry, indexed := r.indexed[templateID]
+	if !indexed {
+		return types.Template{}, nil, types.NewTemplateNotFoundError(templateID)
+	}
+	template, err := r.loadEntry(entry)
+	if err != nil {
+		return types.Template{}, nil, err
+	}
+	return template, r.currentLoader(), nil
+}
+
+// loadIndexed loads the indexed templates not loaded yet; those failing to load
+// are left out with a warning, as when the registry is created
+func (r *Registry) loadIndexed() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, entry := range r.indexed {
+		if _, err := r.loadEntry(entry); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			delete(r.indexed, entry.ID)
+		}
+	}
+}
+
+// loadEntry loads the template of an index entry and moves it from the indexed
+// templates to the loaded ones (assumes caller has the write lock)
+func (r *Registry) loadEntry(entry IndexEntry) (types.Template, error) {
+	template, err := r.currentLoader().LoadTemplate(entry.Dir)
+	if err != nil {
+		return types.Template{}, fmt.Errorf("failed to load blueprint %s: %w", entry.ID, err)
+	}
+	if template.ID != entry.ID {
+		return types.Template{}, fmt.Errorf("the blueprint index is out of date: %s holds blueprint %s, not %s; run go-starter blueprint index", entry.Dir, template.ID, entry.ID)
+	}
+	r.templates[template.ID] = template
+	delete(r.indexed, template.ID)
+	return template, nil
+}
+
+// Loader returns the loader for the current template set
+func (r *Registry) Loader() *TemplateLoader {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.currentLoader()
+}
+
+// Version returns a counter that increases every time the template set is reloaded
+func (r *Registry) Version() uint64 {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.version
+}
+
+// Reload re-reads all blueprints from the registry's filesystem and atomically
+// replaces the current template set. On failure the current set is kept.
+// Templates added with Register are dropped by a successful reload.
+func (r *Registry) Reload() (int, error) {
+	r.mutex.RLock()
+	fsys := r.fs
+	r.mutex.RUnlock()
+
+	if fsys == nil {
+		fsys = GetTemplatesFS()
+	}
+	return r.ReloadFS(fsys)
+}
+
+// ReloadFS loads the blueprints of fsys and atomically makes it the registry's
+// template source. With an index, only the index is read and blueprints are
+// loaded once used. On failure the current set is kept.
+func (r *Registry) ReloadFS(fsys fs.FS) (int, error) {
+	return r.reloadFS(fsys, true)
+}
+
+// reloadFS is ReloadFS, loading every blueprint when useIndex is false
+func (r *Registry) reloadFS(fsys fs.FS, useIndex bool) (int, error) {
+	// Serialize reloads so that a slow load cannot overwrite a newer one
+	r.reloadMu.Lock()
+	defer r.reloadMu.Unlock()
+
+	loader, templates, indexed, err := readTemplates(fsys, useIndex)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reload blueprints: %w", err)
+	}
+
+	r.mutex.Lock()
+	r.templates = templates
+	r.indexed = indexed
+	r.loader = loader
+	r.fs = fsys
+	r.version++
+	r.mutex.Unlock()
+
+	return len(templates) + len(indexed), nil
+}
+
+// readTemplates reads the index of fsys when useIndex is set and it has one, or
+// else loads all its blueprints
+func readTemplates(fsys fs.FS, useIndex bool) (*TemplateLoader, map[string]types.Template, map[string]IndexEntry, error) {
+	loader := NewTemplateLoaderWithFS(fsys)
+	templates := make(map[string]types.Template)
+	indexed := make(map[string]IndexEntry)
+	seen := func(id string) error {
+		if id == "" {
+			return types.NewValidationError("template ID cannot be empty", nil)
+		}
+		if _, duplicate := templates[id]; duplicate {
+			return types.NewValidationError(fmt.Sprintf("duplicate template ID %q", id), nil)
+		}
+		if _, duplicate := indexed[id]; duplicate {
+			return types.NewValidationError(fmt.Sprintf("duplicate template ID %q", id), nil)
+		}
+		return nil
+	}
+
+	index, err := ReadIndex(fsys)
+	switch {
+	case useIndex && err == nil:
+		for _, entry := range index.Blueprints {
+			if err := seen(entry.ID); err != nil {
+				return nil, nil, nil, err
+			}
+			indexed[entry.ID] = entry
+		}
+		return loader, templates, indexed, nil
+	case useIndex && !errors.Is(err, fs.ErrNotExist):
+		return nil, nil, nil, err
+	}
+
+	loaded, err := loader.LoadAll()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, template := range loaded {
+		if err := seen(template.ID); err != nil {
+			return nil, nil, nil, err
+		}
+		templates[template.ID] = template
+	}
+	return loader, templates, indexed, nil
+}
+
+// currentLoader returns the loader for the current set (assumes caller has lock)
+func (r *Registry) currentLoader() *TemplateLoader {
+	if r.loader == nil {
+		return NewTemplateLoader()
+	}
+	return r.loader
+}
+
 // exists is an internal helper that doesn't lock (assumes caller has lock)
 func (r *Registry) exists(templateID string) bool {
-	_, exists := r.templates[templateID]
-	return exists
+	_, loaded := r.templates[templateID]
+	_, indexed := r.indexed[templateID]
+	return loaded || indexed
 }
 
-// loadEmbeddedTemplates loads templates from embedded blueprint files
+// loadEmbeddedTemplates loads templates from embedded blueprint files, or only
+// their index when the blueprints have one
 func (r *Registry) loadEmbeddedTemplates() {
-	loader := NewTemplateLoader()
+	fsys := GetTemplatesFS()
+	r.loader = NewTemplateLoaderWithFS(fsys)
+	r.fs = fsys
 
-	templates, err := loader.LoadAll()
+	loader, templates, indexed, err := readTemplates(fsys, true)
 	if err != nil {
-		fmt.Printf("Warning: Failed to load blueprints: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Warning: Failed to load blueprints: %v\n", err)
 		return
 	}
+	r.loader = loader
+	r.templates = templates
+	r.indexed = indexed
 
-	for _, template := range templates {
-		if err := r.Register(template); err != nil {
-			fmt.Printf("Warning: Failed to register template %s: %v\n", template.ID, err)
-		}
-	}
-
-	if len(templates) > 0 {
-		fmt.Printf("Template registry initialized (%d templates loaded)\n", len(templates))
+	if count := len(templates) + len(indexed); count > 0 {
+		// Diagnostics go to stderr so that machine-readable output on stdout stays clean
+		fmt.Fprintf(os.Stderr, "Template registry initialized (%d templates loaded)\n", count)
 	} else {
-		fmt.Println("Warning: No blueprints found in embedded filesystem")
+		fmt.Fprintln(os.Stderr, "Warning: No blueprints found in embedded filesystem")
 	}
 }
@@ -0,0 +1,133 @@
+package templates
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blueprintFS builds a filesystem with one blueprint per ID whose main file holds content
+func blueprintFS(content string, ids ...string) fstest.MapFS {
+	fsys := fstest.MapFS{}
+	for _, id := range ids {
+		fsys[id+"/template.yaml"] = &fstest.MapFile{Data: []byte("id: " + id + "\nname: " + id + "\ntype: cli\nfiles:\n  - source: main.go.tmpl\n    destination: main.go\n")}
+		fsys[id+"/main.go.tmpl"] = &fstest.MapFile{Data: []byte(content)}
+	}
+	return fsys
+}
+
+func TestRegistry_ReloadFS(t *testing.T) {
+	registry, err := NewRegistryWithFS(blueprintFS("v1", "alpha"))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), registry.Version())
+	assert.True(t, registry.Exists("alpha"))
+
+	count, err := registry.ReloadFS(blueprintFS("v2", "alpha", "beta"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, uint64(2), registry.Version())
+
+	_, loader, err := registry.Lookup("beta")
+	require.NoError(t, err)
+	content, err := loader.LoadTemplateFile("alpha", "main.go.tmpl")
+	require.NoError(t, err)
+	assert.Equal(t, "v2", content)
+
+	t.Run("failed reload keeps the current set", func(t *testing.T) {
+		broken := blueprintFS("v3", "gamma")
+		broken["gamma/template.yaml"] = &fstest.MapFile{Data: []byte("id: [unterminated")}
+
+		_, err := registry.ReloadFS(broken)
+		require.Error(t, err)
+		assert.Equal(t, uint64(2), registry.Version())
+		assert.True(t, registry.Exists("beta"))
+		assert.False(t, registry.Exists("gamma"))
+	})
+}
+
+func TestRegistry_ReloadUnderConcurrentLookups(t *testing.T) {
+	registry, err := NewRegistryWithFS(blueprintFS("v0", "alpha"))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				tmpl, loader, err := registry.Lookup("alpha")
+				if !assert.NoError(t, err) {
+					return
+				}
+				// Each template set is self-consistent
+				content, err := loader.LoadTemplateFile(tmpl.ID, "main.go.tmpl")
+				assert.NoError(t, err)
+				assert.NotEmpty(t, content)
+				registry.List()
+			}
+		}()
+	}
+
+	for _, version := range []string{"v1", "v2", "v3", "v4", "v5"} {
+		_, err := registry.ReloadFS(blueprintFS(version, "alpha"))
+		require.NoError(t, err)
+	}
+	cancel()
+	wg.Wait()
+
+	_, loader, err := registry.Lookup("alpha")
+	require.NoError(t, err)
+	content, err := loader.LoadTemplateFile("alpha", "main.go.tmpl")
+	require.NoError(t, err)
+	assert.Equal(t, "v5", content)
+}
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	writeBlueprint := func(id, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, id), 0755))
+		for name, data := range blueprintFS(content, id) {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name), data.Data, 0644))
+		}
+	}
+	writeBlueprint("alpha", "v1")
+
+	registry, err := NewRegistryWithFS(os.DirFS(dir))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reloaded := make(chan int, 1)
+	go func() {
+		_ = Watch(ctx, registry, dir, 10*time.Millisecond, func(count int, err error) {
+			if err != nil {
+				return
+			}
+			select {
+			case reloaded <- count:
+			default:
+			}
+		})
+	}()
+
+	// Give the watcher its initial fingerprint before changing the tree
+	time.Sleep(30 * time.Millisecond)
+	writeBlueprint("beta", "v1")
+
+	select {
+	case count := <-reloaded:
+		assert.Equal(t, 2, count)
+		assert.True(t, registry.Exists("beta"))
+	case <-time.After(5 * time.Second):
+		t.Fatal("watcher did not reload the registry")
+	}
+}
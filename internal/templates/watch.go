@@ -0,0 +1,72 @@
+package templates
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Watch polls the blueprints in dir and reloads the registry from it whenever a
+// file is added, removed or modified. onReload, when set, is called after every
+// reload attempt. The blueprints are loaded in full, without their index, for
+// the edits to their metadata to show. Watch blocks until ctx is cancelled.
+func Watch(ctx context.Context, r *Registry, dir string, interval time.Duration, onReload func(count int, err error)) error {
+	last, err := fingerprint(dir)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		current, err := fingerprint(dir)
+		if err != nil || current == last {
+			continue
+		}
+		last = current
+
+		count, err := r.reloadFS(os.DirFS(dir), false)
+		if onReload != nil {
+			onReload(count, err)
+		}
+	}
+}
+
+// dirFingerprint summarizes a directory tree well enough to detect edits
+type dirFingerprint struct {
+	files    int
+	size     int64
+	modified time.Time
+}
+
+// fingerprint walks dir and returns its current fingerprint
+func fingerprint(dir string) (dirFingerprint, error) {
+	var fp dirFingerprint
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			fp.files++
+			fp.size += info.Size()
+		}
+		if info.ModTime().After(fp.modified) {
+			fp.modified = info.ModTime()
+		}
+		return nil
+	})
+	return fp, err
+}
@@ -0,0 +1,96 @@
+// Package ui holds the terminal presentation settings shared by the commands: colour
+// output and the plain mode for screen readers and braille displays.
+package ui
+
+import (
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	"github.com/francknouama/go-starter/internal/i18n"
+)
+
+// PlainEnv enables plain mode from the environment, like --plain
+const PlainEnv = "GO_STARTER_PLAIN"
+
+var (
+	noColor bool
+	plain   bool
+)
+
+// Configure applies the --no-color and --plain flags. NO_COLOR and GO_STARTER_PLAIN
+// enable the same modes from the environment; plain mode implies no colour.
+func Configure(disableColor, plainMode bool) {
+	plain = plainMode || envEnabled(PlainEnv)
+	noColor = disableColor || plain || envEnabled("NO_COLOR")
+
+	if noColor {
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+}
+
+// NoColor reports whether output must not contain colour escape sequences
+func NoColor() bool {
+	return noColor
+}
+
+// Plain reports whether output must be screen-reader friendly: sequential
+// line-based questions, no redrawn lines, no decoration and no control characters
+func Plain() bool {
+	return plain
+}
+
+// Text returns s as it should be printed: in plain mode without pictographs
+func Text(s string) string {
+	if !plain {
+		return s
+	}
+	return StripSymbols(s)
+}
+
+// StripSymbols removes emoji and other pictographs, which screen readers announce by
+// name, along with the spacing that followed a leading one
+func StripSymbols(s string) string {
+	stripped := strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.So, r) || unicode.Is(unicode.Variation_Selector, r) || isEmojiJoiner(r) {
+			return -1
+		}
+		return r
+	}, s)
+	return strings.TrimLeft(stripped, " ")
+}
+
+// Diff line kinds
+const (
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+)
+
+// DiffLine formats one line of a diff view. Terminals get the usual "+"/"-"
+// markers; plain mode spells the change out so it is read aloud.
+func DiffLine(kind, text string) string {
+	if plain {
+		if kind == DiffAdded {
+			return i18n.T("diff.added", text)
+		}
+		return i18n.T("diff.removed", text)
+	}
+	if kind == DiffAdded {
+		return "+ " + text
+	}
+	return "- " + text
+}
+
+// isEmojiJoiner matches the zero width joiner and skin tone modifiers that combine emoji
+func isEmojiJoiner(r rune) bool {
+	return r == '\u200d' || (r >= 0x1F3FB && r <= 0x1F3FF)
+}
+
+// envEnabled reports whether the variable is set to anything but an explicit false
+func envEnabled(name string) bool {
+	value := strings.ToLower(os.Getenv(name))
+	return value != "" && value != "0" && value != "false"
+}
@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigure(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	t.Setenv(PlainEnv, "")
+	t.Cleanup(func() { Configure(false, false) })
+
+	Configure(false, false)
+	assert.False(t, NoColor())
+	assert.False(t, Plain())
+
+	Configure(true, false)
+	assert.True(t, NoColor())
+	assert.False(t, Plain())
+
+	Configure(false, true)
+	assert.True(t, NoColor(), "plain mode implies no colour")
+	assert.True(t, Plain())
+
+	t.Setenv(PlainEnv, "1")
+	Configure(false, false)
+	assert.True(t, Plain())
+
+	t.Setenv(PlainEnv, "false")
+	t.Setenv("NO_COLOR", "1")
+	Configure(false, false)
+	assert.False(t, Plain())
+	assert.True(t, NoColor())
+}
+
+func TestText(t *testing.T) {
+	t.Setenv(PlainEnv, "")
+	t.Cleanup(func() { Configure(false, false) })
+
+	Configure(false, false)
+	assert.Equal(t, "🎉 Done", Text("🎉 Done"))
+
+	Configure(false, true)
+	assert.Equal(t, "Done", Text("🎉 Done"))
+	assert.Equal(t, "Using project name", Text("✏️  Using project name"))
+	assert.Equal(t, "Generation interrupted", Text("⚠️  Generation interrupted"))
+	assert.Equal(t, "caret ^ and `backticks` stay", Text("caret ^ and `backticks` stay"))
+}
+
+func TestDiffLine(t *testing.T) {
+	t.Setenv(PlainEnv, "")
+	t.Cleanup(func() { Configure(false, false) })
+
+	Configure(false, false)
+	assert.Equal(t, "+ github.com/gin-gonic/gin v1.10.0", DiffLine(DiffAdded, "github.com/gin-gonic/gin v1.10.0"))
+	assert.Equal(t, "- github.com/gin-gonic/gin v1.9.1", DiffLine(DiffRemoved, "github.com/gin-gonic/gin v1.9.1"))
+
+	Configure(false, true)
+	assert.Equal(t, "added: github.com/gin-gonic/gin v1.10.0", DiffLine(DiffAdded, "github.com/gin-gonic/gin v1.10.0"))
+	assert.Equal(t, "removed: github.com/gin-gonic/gin v1.9.1", DiffLine(DiffRemoved, "github.com/gin-gonic/gin v1.9.1"))
+}
@@ -0,0 +1,14 @@
+package utils
+
+import "strings"
+
+// ShellQuote quotes s for POSIX shells when it holds characters the shell would
+// interpret, so commands stay readable and safe to run
+func ShellQuote(s string) string {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./:@,+=", r))
+	}) < 0 {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShellQuote(t *testing.T) {
+	assert.Equal(t, "plain", ShellQuote("plain"))
+	assert.Equal(t, "/home/dev/my-app", ShellQuote("/home/dev/my-app"))
+	assert.Equal(t, "''", ShellQuote(""))
+	assert.Equal(t, `'my app'`, ShellQuote("my app"))
+	assert.Equal(t, `'it'\''s'`, ShellQuote("it's"))
+	assert.Equal(t, `'$(rm -rf /)'`, ShellQuote("$(rm -rf /)"))
+	assert.Equal(t, `'~/project'`, ShellQuote("~/project"))
+}
@@ -0,0 +1,221 @@
+// Package assets serves the built web UI: the files Vite writes to web/dist, with
+// cache headers suited to their names, gzip compression and the index.html
+// fallback of a single page application.
+package assets
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+// Cache policies of the assets
+const (
+	// CacheImmutable is sent for the files of assets/, whose names carry a hash of
+	// their content: a new build never reuses a name
+	CacheImmutable = "public, max-age=31536000, immutable"
+	// CacheShort is sent for the other files, such as favicons, which keep their name
+	CacheShort = "public, max-age=3600"
+	// CacheRevalidate is sent for index.html, so that a deployment is picked up on
+	// the next load, and for every file served from disk
+	CacheRevalidate = "no-cache"
+)
+
+// indexFile is served for / and for the client-side routes of the UI
+const indexFile = "index.html"
+
+// hashedDir holds the files whose names change with their content
+const hashedDir = "assets/"
+
+// minCompressSize is the size below which compressing is not worth the CPU of
+// the browser decompressing it
+const minCompressSize = 1024
+
+// asset is a file of the UI held in memory, compressed once
+type asset struct {
+	content     []byte
+	gzipped     []byte
+	contentType string
+	etag        string
+}
+
+// Handler serves the web UI from a file system
+type Handler struct {
+	fsys fs.FS
+	// assets are the files read and compressed up front, nil when they are read
+	// from fsys on each request
+	assets map[string]*asset
+}
+
+// New returns a handler serving the files of fsys, which must not change, such as
+// the UI embedded in a release build. Every file is read and the compressible
+// ones gzipped once, so requests cost no disk access nor compression.
+func New(fsys fs.FS) (*Handler, error) {
+	if _, err := fs.Stat(fsys, indexFile); err != nil {
+		return nil, err
+	}
+
+	h := &Handler{fsys: fsys, assets: make(map[string]*asset)}
+	err := fs.WalkDir(fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+
+		sum := sha256.Sum256(content)
+		file := &asset{
+			content:     content,
+			contentType: contentType(name, content),
+			etag:        `"` + hex.EncodeToString(sum[:8]) + `"`,
+		}
+		if compressible(file.contentType) && len(content) >= minCompressSize {
+			if gzipped, err := compress(content); err == nil && len(gzipped) < len(content) {
+				file.gzipped = gzipped
+			}
+		}
+		h.assets[name] = file
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// NewDir returns a handler reading the files of fsys on each request, for the UI
+// rebuilt on disk during development. Nothing is cached by the browsers.
+func NewDir(fsys fs.FS) *Handler {
+	return &Handler{fsys: fsys}
+}
+
+// ServeHTTP serves the file at the request path, or index.html for the paths
+// without an extension that name no file, which are routes of the UI
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+	if name == "" {
+		name = indexFile
+	}
+	file, err := h.open(name)
+	if errors.Is(err, fs.ErrNotExist) && path.Ext(name) == "" {
+		name = indexFile
+		file, err = h.open(name)
+	}
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", file.contentType)
+	header.Set("Cache-Control", h.cacheControl(name))
+	header.Set("X-Content-Type-Options", "nosniff")
+
+	body, etag := file.content, file.etag
+	if file.gzipped != nil {
+		header.Add("Vary", "Accept-Encoding")
+		if acceptsGzip(r) {
+			header.Set("Content-Encoding", "gzip")
+			body, etag = file.gzipped, strings.TrimSuffix(file.etag, `"`)+`-gzip"`
+		}
+	}
+	if etag != "" {
+		header.Set("ETag", etag)
+	}
+	// Embedded files have no modification time, the ETag validates them
+	http.ServeContent(w, r, name, time.Time{}, bytes.NewReader(body))
+}
+
+// open returns the asset name, reading it from disk when the handler does not
+// hold the files in memory
+func (h *Handler) open(name string) (*asset, error) {
+	if h.assets != nil {
+		file, ok := h.assets[name]
+		if !ok {
+			return nil, fs.ErrNotExist
+		}
+		return file, nil
+	}
+
+	content, err := fs.ReadFile(h.fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	return &asset{content: content, contentType: contentType(name, content)}, nil
+}
+
+// cacheControl returns the cache policy of the file name
+func (h *Handler) cacheControl(name string) string {
+	switch {
+	case h.assets == nil || name == indexFile:
+		return CacheRevalidate
+	case strings.HasPrefix(name, hashedDir):
+		return CacheImmutable
+	}
+	return CacheShort
+}
+
+// contentType returns the media type of a file from its extension, or sniffed
+// from its content
+func contentType(name string, content []byte) string {
+	if byExtension := mime.TypeByExtension(path.Ext(name)); byExtension != "" {
+		return byExtension
+	}
+	return http.DetectContentType(content)
+}
+
+// compressible reports whether files of the media type shrink when gzipped;
+// images other than SVG and fonts are compressed already
+func compressible(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	switch mediaType {
+	case "application/javascript", "application/json", "application/manifest+json", "application/wasm", "image/svg+xml", "text/javascript":
+		return true
+	}
+	return strings.HasPrefix(mediaType, "text/")
+}
+
+// compress gzips content at the best compression, paid once per file
+func compress(content []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writer.Write(content); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// acceptsGzip reports whether the client accepts gzip encoded responses
+func acceptsGzip(r *http.Request) bool {
+	for _, coding := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(coding), ";")
+		if !strings.EqualFold(strings.TrimSpace(name), "gzip") {
+			continue
+		}
+		quality := strings.ReplaceAll(params, " ", "")
+		return quality != "q=0" && quality != "q=0.0" && quality != "q=0.00" && quality != "q=0.000"
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package assets
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var script = strings.Repeat("console.log('go-starter');\n", 100)
+
+func distFS() fstest.MapFS {
+	return fstest.MapFS{
+		"index.html":                 {Data: []byte("<!doctype html><div id=\"root\"></div>\n")},
+		"assets/index-4f2a9c.js":     {Data: []byte(script)},
+		"assets/logo-9b1d.png":       {Data: bytes.Repeat([]byte{0x89, 'P', 'N', 'G'}, 512)},
+		"vite.svg":                   {Data: []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>\n")},
+		"assets/nested/chunk-7e1.js": {Data: []byte("export {}\n")},
+	}
+}
+
+func serve(h http.Handler, method, target string, header map[string]string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, target, nil)
+	for name, value := range header {
+		request.Header.Set(name, value)
+	}
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestHandler_CacheHeaders(t *testing.T) {
+	h, err := New(distFS())
+	require.NoError(t, err)
+
+	for target, cache := range map[string]string{
+		"/":                           CacheRevalidate,
+		"/index.html":                 CacheRevalidate,
+		"/assets/index-4f2a9c.js":     CacheImmutable,
+		"/assets/nested/chunk-7e1.js": CacheImmutable,
+		"/vite.svg":                   CacheShort,
+	} {
+		response := serve(h, http.MethodGet, target, nil)
+		assert.Equal(t, http.StatusOK, response.Code, target)
+		assert.Equal(t, cache, response.Header().Get("Cache-Control"), target)
+		assert.NotEmpty(t, response.Header().Get("ETag"), target)
+	}
+
+	response := serve(h, http.MethodGet, "/assets/index-4f2a9c.js", nil)
+	assert.Equal(t, "text/javascript; charset=utf-8", response.Header().Get("Content-Type"))
+	assert.Equal(t, "nosniff", response.Header().Get("X-Content-Type-Options"))
+}
+
+func TestHandler_SPAFallback(t *testing.T) {
+	h, err := New(distFS())
+	require.NoError(t, err)
+
+	for _, target := range []string{"/generate", "/projects/42/files", "/../../etc/passwd"} {
+		response := serve(h, http.MethodGet, target, nil)
+		assert.Equal(t, http.StatusOK, response.Code, target)
+		assert.Contains(t, response.Body.String(), `<div id="root">`, target)
+		assert.Equal(t, CacheRevalidate, response.Header().Get("Cache-Control"), target)
+	}
+
+	response := serve(h, http.MethodGet, "/assets/index-deleted.js", nil)
+	assert.Equal(t, http.StatusNotFound, response.Code, "a missing file is not answered with the page")
+
+	response = serve(h, http.MethodPost, "/generate", nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, response.Code)
+	response = serve(h, http.MethodHead, "/", nil)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Empty(t, response.Body.String())
+}
+
+func TestHandler_Gzip(t *testing.T) {
+	h, err := New(distFS())
+	require.NoError(t, err)
+
+	response := serve(h, http.MethodGet, "/assets/index-4f2a9c.js", map[string]string{"Accept-Encoding": "br, gzip"})
+	require.Equal(t, "gzip", response.Header().Get("Content-Encoding"))
+	assert.Equal(t, "Accept-Encoding", response.Header().Get("Vary"))
+	assert.Less(t, response.Body.Len(), len(script))
+	reader, err := gzip.NewReader(response.Body)
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, script, string(content))
+	gzipETag := response.Header().Get("ETag")
+
+	response = serve(h, http.MethodGet, "/assets/index-4f2a9c.js", map[string]string{"Accept-Encoding": "gzip;q=0"})
+	assert.Empty(t, response.Header().Get("Content-Encoding"))
+	assert.Equal(t, script, response.Body.String())
+	assert.NotEqual(t, gzipETag, response.Header().Get("ETag"), "each encoding has its own ETag")
+
+	response = serve(h, http.MethodGet, "/assets/logo-9b1d.png", map[string]string{"Accept-Encoding": "gzip"})
+	assert.Empty(t, response.Header().Get("Content-Encoding"), "images are compressed already")
+	response = serve(h, http.MethodGet, "/vite.svg", map[string]string{"Accept-Encoding": "gzip"})
+	assert.Empty(t, response.Header().Get("Content-Encoding"), "small files are not worth compressing")
+
+	response = serve(h, http.MethodGet, "/assets/index-4f2a9c.js", map[string]string{"Accept-Encoding": "gzip", "If-None-Match": gzipETag})
+	assert.Equal(t, http.StatusNotModified, response.Code)
+}
+
+func TestNew_WithoutIndex(t *testing.T) {
+	_, err := New(fstest.MapFS{"assets/app.js": {Data: []byte(script)}})
+	assert.Error(t, err)
+}
+
+func TestNewDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.html"), []byte("<p>v1</p>\n"), 0644))
+	h := NewDir(os.DirFS(dir))
+
+	response := serve(h, http.MethodGet, "/settings", nil)
+	assert.Equal(t, "<p>v1</p>\n", response.Body.String())
+	assert.Equal(t, CacheRevalidate, response.Header().Get("Cache-Control"))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.html"), []byte("<p>v2</p>\n"), 0644))
+	response = serve(h, http.MethodGet, "/", nil)
+	assert.Equal(t, "<p>v2</p>\n", response.Body.String(), "files on disk are read on each request")
+
+	response = serve(h, http.MethodGet, "/assets/app.js", nil)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
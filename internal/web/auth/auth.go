@@ -0,0 +1,169 @@
+// Package auth authenticates the callers of the web generation API, with API
+// keys or OIDC bearer tokens, so that the web server can be exposed beyond
+// localhost and each generated project is only handed to whoever generated it.
+package auth
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+)
+
+// APIKeyHeader carries an API key, which may also be sent as a bearer token
+const APIKeyHeader = "X-API-Key"
+
+// Authentication methods of an identity
+const (
+	MethodAPIKey = "api_key"
+	MethodOIDC   = "oidc"
+)
+
+var (
+	// ErrNoCredentials is returned for a request that carries no credentials
+	ErrNoCredentials = errors.New("no credentials")
+	// ErrInvalidCredentials is returned for credentials that are not accepted
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Identity is an authenticated caller
+type Identity struct {
+	// Subject identifies the caller across requests, as the owner of its projects:
+	// key:<name> for an API key and oidc:<subject> for a token
+	Subject string `json:"subject"`
+	Method  string `json:"method"`
+}
+
+// Config selects how callers authenticate. Authentication is off when it sets
+// neither API keys nor an OIDC issuer.
+type Config struct {
+	// APIKeysFile lists the accepted API keys, one "name key" pair per line
+	APIKeysFile string
+	// OIDCIssuer is the URL of the OpenID Connect provider issuing bearer tokens
+	OIDCIssuer string
+	// OIDCAudience is the audience the tokens must be issued for, the client ID
+	// of the web server at the provider
+	OIDCAudience string
+}
+
+// Enabled reports whether the configuration turns authentication on
+func (c Config) Enabled() bool {
+	return c.APIKeysFile != "" || c.OIDCIssuer != ""
+}
+
+// Authenticator checks the credentials of requests
+type Authenticator struct {
+	// keys are the names of the API keys by the hash of the key, so that lookups
+	// do not leak keys through timing
+	keys     map[[sha256.Size]byte]string
+	verifier *oidc.IDTokenVerifier
+}
+
+// New returns the authenticator of config, discovering the keys of the OIDC
+// issuer when it has one
+func New(ctx context.Context, config Config) (*Authenticator, error) {
+	authenticator := &Authenticator{keys: make(map[[sha256.Size]byte]string)}
+
+	if config.APIKeysFile != "" {
+		keys, err := LoadAPIKeys(config.APIKeysFile)
+		if err != nil {
+			return nil, err
+		}
+		for name, key := range keys {
+			authenticator.AddAPIKey(name, key)
+		}
+	}
+
+	if config.OIDCIssuer != "" {
+		if config.OIDCAudience == "" {
+			return nil, fmt.Errorf("OIDC issuer %s needs an audience", config.OIDCIssuer)
+		}
+		provider, err := oidc.NewProvider(ctx, config.OIDCIssuer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to discover OIDC issuer %s: %w", config.OIDCIssuer, err)
+		}
+		authenticator.verifier = provider.Verifier(&oidc.Config{ClientID: config.OIDCAudience})
+	}
+	return authenticator, nil
+}
+
+// LoadAPIKeys reads the API keys of a file by name. Each line holds a name and
+// its key separated by spaces; blank lines and lines starting with # are skipped.
+func LoadAPIKeys(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open API keys: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	keys := make(map[string]string)
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid API key %s:%d, expected a name and a key", path, line)
+		}
+		name, key := fields[0], fields[1]
+		if _, ok := keys[name]; ok {
+			return nil, fmt.Errorf("duplicate API key name %q at %s:%d", name, path, line)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("API key %q at %s:%d is the key of another name", name, path, line)
+		}
+		keys[name], seen[key] = key, true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read API keys: %w", err)
+	}
+	return keys, nil
+}
+
+// AddAPIKey accepts key as the API key of name
+func (a *Authenticator) AddAPIKey(name, key string) {
+	a.keys[sha256.Sum256([]byte(key))] = name
+}
+
+// Authenticate returns the caller of a request, from the API key of its
+// X-API-Key header or its bearer token, which is an API key or an OIDC token
+func (a *Authenticator) Authenticate(ctx context.Context, r *http.Request) (Identity, error) {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		return a.apiKey(key)
+	}
+
+	scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+	token = strings.TrimSpace(token)
+	if !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return Identity{}, ErrNoCredentials
+	}
+	if identity, err := a.apiKey(token); err == nil {
+		return identity, nil
+	}
+	if a.verifier == nil {
+		return Identity{}, ErrInvalidCredentials
+	}
+	idToken, err := a.verifier.Verify(ctx, token)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+	return Identity{Subject: "oidc:" + idToken.Subject, Method: MethodOIDC}, nil
+}
+
+// apiKey returns the identity of an API key
+func (a *Authenticator) apiKey(key string) (Identity, error) {
+	name, ok := a.keys[sha256.Sum256([]byte(key))]
+	if !ok {
+		return Identity{}, ErrInvalidCredentials
+	}
+	return Identity{Subject: "key:" + name, Method: MethodAPIKey}, nil
+}
@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jose "github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeKeys(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "api-keys")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func request(header, value string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/generate", nil)
+	if header != "" {
+		r.Header.Set(header, value)
+	}
+	return r
+}
+
+func TestLoadAPIKeys(t *testing.T) {
+	keys, err := LoadAPIKeys(writeKeys(t, "# CI and people\nci   s3cr3t-ci\n\nalice s3cr3t-alice\n"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"ci": "s3cr3t-ci", "alice": "s3cr3t-alice"}, keys)
+
+	_, err = LoadAPIKeys(writeKeys(t, "ci\n"))
+	assert.ErrorContains(t, err, ":1, expected a name and a key")
+	_, err = LoadAPIKeys(writeKeys(t, "ci one\nci two\n"))
+	assert.ErrorContains(t, err, `duplicate API key name "ci"`)
+	_, err = LoadAPIKeys(writeKeys(t, "ci same\nalice same\n"))
+	assert.ErrorContains(t, err, "is the key of another name")
+	_, err = LoadAPIKeys(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
+
+func TestAuthenticate_APIKeys(t *testing.T) {
+	ctx := context.Background()
+	authenticator, err := New(ctx, Config{APIKeysFile: writeKeys(t, "ci s3cr3t-ci\n")})
+	require.NoError(t, err)
+
+	identity, err := authenticator.Authenticate(ctx, request(APIKeyHeader, "s3cr3t-ci"))
+	require.NoError(t, err)
+	assert.Equal(t, Identity{Subject: "key:ci", Method: MethodAPIKey}, identity)
+
+	identity, err = authenticator.Authenticate(ctx, request("Authorization", "Bearer s3cr3t-ci"))
+	require.NoError(t, err)
+	assert.Equal(t, "key:ci", identity.Subject)
+
+	_, err = authenticator.Authenticate(ctx, request("", ""))
+	assert.ErrorIs(t, err, ErrNoCredentials)
+	_, err = authenticator.Authenticate(ctx, request("Authorization", "Basic Y2k6czNjcjN0"))
+	assert.ErrorIs(t, err, ErrNoCredentials)
+	_, err = authenticator.Authenticate(ctx, request(APIKeyHeader, "guess"))
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = authenticator.Authenticate(ctx, request("Authorization", "Bearer guess"))
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+// testIssuer serves the discovery document and keys of an OIDC provider, and
+// signs its tokens
+type testIssuer struct {
+	*httptest.Server
+	signer jose.Signer
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: key, KeyID: "test"}}, nil)
+	require.NoError(t, err)
+
+	issuer := &testIssuer{signer: signer}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                issuer.URL,
+			"jwks_uri":                              issuer.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: "test", Algorithm: "RS256", Use: "sig"}}})
+	})
+	issuer.Server = httptest.NewServer(mux)
+	t.Cleanup(issuer.Close)
+	return issuer
+}
+
+func (i *testIssuer) token(t *testing.T, claims jwt.Claims) string {
+	t.Helper()
+	token, err := jwt.Signed(i.signer).Claims(claims).Serialize()
+	require.NoError(t, err)
+	return token
+}
+
+func TestAuthenticate_OIDC(t *testing.T) {
+	ctx := context.Background()
+	issuer := newTestIssuer(t)
+
+	_, err := New(ctx, Config{OIDCIssuer: issuer.URL})
+	assert.ErrorContains(t, err, "needs an audience")
+
+	authenticator, err := New(ctx, Config{OIDCIssuer: issuer.URL, OIDCAudience: "go-starter"})
+	require.NoError(t, err)
+
+	now := time.Now()
+	valid := jwt.Claims{Issuer: issuer.URL, Subject: "user-42", Audience: jwt.Audience{"go-starter"}, IssuedAt: jwt.NewNumericDate(now), Expiry: jwt.NewNumericDate(now.Add(time.Hour))}
+	identity, err := authenticator.Authenticate(ctx, request("Authorization", "Bearer "+issuer.token(t, valid)))
+	require.NoError(t, err)
+	assert.Equal(t, Identity{Subject: "oidc:user-42", Method: MethodOIDC}, identity)
+
+	otherAudience := valid
+	otherAudience.Audience = jwt.Audience{"another-app"}
+	expired := valid
+	expired.Expiry = jwt.NewNumericDate(now.Add(-time.Minute))
+	otherIssuer := valid
+	otherIssuer.Issuer = "https://evil.example.com"
+	for name, claims := range map[string]jwt.Claims{"audience": otherAudience, "expired": expired, "issuer": otherIssuer} {
+		_, err := authenticator.Authenticate(ctx, request("Authorization", "Bearer "+issuer.token(t, claims)))
+		assert.ErrorIs(t, err, ErrInvalidCredentials, name)
+	}
+
+	forged := newTestIssuer(t)
+	_, err = authenticator.Authenticate(ctx, request("Authorization", "Bearer "+forged.token(t, valid)))
+	assert.ErrorIs(t, err, ErrInvalidCredentials, "tokens signed by another key are rejected")
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/francknouama/go-starter/internal/generator"
 	"github.com/francknouama/go-starter/internal/templates"
 	"github.com/francknouama/go-starter/internal/web/models"
 )
@@ -13,13 +14,31 @@ type BlueprintHandler struct {
 	registry *templates.Registry
 }
 
-func NewBlueprintHandler() *BlueprintHandler {
-	registry := templates.NewRegistry()
+func NewBlueprintHandler(registry *templates.Registry) *BlueprintHandler {
 	return &BlueprintHandler{
 		registry: registry,
 	}
 }
 
+// ReloadBlueprints re-reads all blueprints and atomically swaps them in. Generations
+// already in progress finish with the blueprint set they started with.
+func (h *BlueprintHandler) ReloadBlueprints(c *gin.Context) {
+	count, err := h.registry.Reload()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to reload blueprints",
+			"code":    "RELOAD_FAILED",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.BlueprintReloadResponse{
+		Blueprints: count,
+		Version:    h.registry.Version(),
+	})
+}
+
 // ListBlueprints returns all available blueprints
 func (h *BlueprintHandler) ListBlueprints(c *gin.Context) {
 	templates := h.registry.List()
@@ -27,12 +46,14 @@ func (h *BlueprintHandler) ListBlueprints(c *gin.Context) {
 
 	for _, template := range templates {
 		blueprint := models.Blueprint{
-			ID:          template.ID,
-			Name:        template.Name,
-			Description: template.Description,
-			Type:        template.Type,
-			Complexity:  getComplexityLevel(template.ID),
-			FileCount:   len(template.Files),
+			ID:           template.ID,
+			Name:         template.Name,
+			Description:  template.Description,
+			Type:         template.Type,
+			Complexity:   getComplexityLevel(template.ID),
+			FileCount:    len(template.Files),
+			Deprecation:  template.Deprecated,
+			Experimental: template.Experimental,
 		}
 
 		// Add features from template features
@@ -71,12 +92,14 @@ func (h *BlueprintHandler) GetBlueprint(c *gin.Context) {
 	}
 
 	blueprint := models.Blueprint{
-		ID:          template.ID,
-		Name:        template.Name,
-		Description: template.Description,
-		Type:        template.Type,
-		Complexity:  getComplexityLevel(template.ID),
-		FileCount:   len(template.Files),
+		ID:           template.ID,
+		Name:         template.Name,
+		Description:  template.Description,
+		Type:         template.Type,
+		Complexity:   getComplexityLevel(template.ID),
+		FileCount:    len(template.Files),
+		Deprecation:  template.Deprecated,
+		Experimental: template.Experimental,
 	}
 
 	// Add features from template features
@@ -107,12 +130,13 @@ func (h *BlueprintHandler) GetBlueprint(c *gin.Context) {
 	variables := make(map[string]interface{})
 	for _, v := range template.Variables {
 		variables[v.Name] = map[string]interface{}{
-			"type":        v.Type,
-			"description": v.Description,
-			"default":     v.Default,
-			"required":    v.Required,
-			"choices":     v.Choices,
-			"validation":  v.Validation,
+			"type":               v.Type,
+			"description":        v.Description,
+			"default":            v.Default,
+			"required":           v.Required,
+			"choices":            v.Choices,
+			"validation":         v.Validation,
+			"deprecated_choices": v.DeprecatedChoices,
 		}
 	}
 
@@ -125,14 +149,36 @@ func (h *BlueprintHandler) GetBlueprint(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// GetBlueprintOptions returns the options of a blueprint with their flags, defaults,
+// choices and the requirements generation enforces between them, so that forms are
+// built from the blueprint instead of a copy of its options
+func (h *BlueprintHandler) GetBlueprintOptions(c *gin.Context) {
+	blueprintID := c.Param("id")
+
+	options, err := generator.NewWithRegistry(h.registry).Options(blueprintID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Blueprint not found",
+			"code":  "BLUEPRINT_NOT_FOUND",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.BlueprintOptionsResponse{
+		Blueprint: blueprintID,
+		Version:   h.registry.Version(),
+		Options:   options,
+	})
+}
+
 // getComplexityLevel determines the complexity level based on blueprint name
 func getComplexityLevel(name string) string {
 	switch name {
 	case "cli-simple":
 		return "simple"
-	case "cli", "library-standard", "lambda-standard":
+	case "cli", "library-standard", "lambda-standard", "tui":
 		return "standard"
-	case "web-api-clean", "web-api-ddd", "microservice-standard":
+	case "web-api-clean", "web-api-ddd", "microservice-standard", "grpc-service", "event-service", "terraform-provider", "bot", "web-app", "realtime", "gateway", "desktop", "workflow", "web-api-vertical-slice", "cli-advanced":
 		return "advanced"
 	case "web-api-hexagonal", "grpc-gateway":
 		return "expert"
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	_ "embed"
+	"html/template"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/francknouama/go-starter/internal/web/middleware"
+)
+
+//go:embed embed.html
+var embedPage string
+
+var embedTemplate = template.Must(template.New("embed").Parse(embedPage))
+
+// EmbedHandler serves the blueprint picker and generate button that developer
+// portals embed in an iframe. The widget talks to the JSON API of the embed mode
+// and reports to its parent window with postMessage events:
+//
+//	go-starter:ready      {blueprints}
+//	go-starter:resize     {height}
+//	go-starter:generated  {id, blueprint, downloadUrl, filesGenerated, expiresAt}
+//	go-starter:error      {code, message}
+type EmbedHandler struct {
+	origins []string
+}
+
+func NewEmbedHandler(origins []string) *EmbedHandler {
+	return &EmbedHandler{origins: origins}
+}
+
+// Widget serves the widget for the portal named by the origin query parameter,
+// which receives its events. It may be left out when a single origin is allowed.
+// ?blueprint= preselects a blueprint.
+func (h *EmbedHandler) Widget(c *gin.Context) {
+	target, ok := h.targetOrigin(c.Query("origin"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "The origin query parameter must name an allowed origin",
+			"code":  "ORIGIN_NOT_ALLOWED",
+		})
+		return
+	}
+
+	ancestors := strings.Join(h.origins, " ")
+	c.Header("Content-Security-Policy", "frame-ancestors "+ancestors)
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	c.Status(http.StatusOK)
+	_ = embedTemplate.Execute(c.Writer, map[string]string{
+		"APIBase":      middleware.EmbedPrefix + "/v1",
+		"TargetOrigin": target,
+		"Blueprint":    c.Query("blueprint"),
+	})
+}
+
+// targetOrigin returns the origin events are posted to
+func (h *EmbedHandler) targetOrigin(origin string) (string, bool) {
+	switch {
+	case origin != "":
+		return origin, middleware.OriginAllowed(h.origins, origin)
+	case len(h.origins) == 1:
+		return h.origins[0], true
+	}
+	return "", false
+}
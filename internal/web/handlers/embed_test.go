@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/internal/web/middleware"
+	"github.com/francknouama/go-starter/internal/web/models"
+	"github.com/francknouama/go-starter/internal/web/storage"
+)
+
+const testPortal = "https://portal.example.com"
+
+func newEmbedTestRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+
+	registry, err := templates.NewRegistryWithFS(fstest.MapFS{
+		"embed-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "embed-test"
+name: "embed-test"
+type: "library"
+files:
+  - source: "lib.go.tmpl"
+    destination: "lib.go"
+`)},
+		"embed-test/lib.go.tmpl": &fstest.MapFile{Data: []byte("package {{.ProjectPackage}}\n")},
+	})
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	origins := []string{testPortal}
+	router.Use(middleware.Embed(origins))
+	router.Use(middleware.SkipEmbed(cors.New(cors.Config{AllowOrigins: []string{"http://localhost:5173"}, AllowCredentials: true})))
+
+	generatorHandler := &GeneratorHandler{projects: storage.NewMemory(storage.DefaultTTL), registry: registry}
+	router.GET(middleware.EmbedPrefix, NewEmbedHandler(origins).Widget)
+	router.POST("/embed/v1/generate", generatorHandler.GenerateProject)
+	router.POST("/api/v1/generate", generatorHandler.GenerateProject)
+	router.GET("/embed/v1/cookie", func(c *gin.Context) { c.String(http.StatusOK, c.GetHeader("Cookie")) })
+	return router
+}
+
+func serveEmbed(router *gin.Engine, method, target, origin, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	req.Header.Set("Cookie", "session=secret")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestEmbed_CORS(t *testing.T) {
+	router := newEmbedTestRouter(t)
+
+	preflight := serveEmbed(router, http.MethodOptions, "/embed/v1/generate", testPortal, "")
+	assert.Equal(t, http.StatusNoContent, preflight.Code)
+	assert.Equal(t, testPortal, preflight.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, preflight.Header().Get("Access-Control-Allow-Credentials"), "embedding never sends credentials")
+
+	cookie := serveEmbed(router, http.MethodGet, "/embed/v1/cookie", testPortal, "")
+	assert.Equal(t, http.StatusOK, cookie.Code)
+	assert.Empty(t, cookie.Body.String(), "cookies are dropped")
+
+	assert.Equal(t, http.StatusForbidden, serveEmbed(router, http.MethodGet, "/embed/v1/cookie", "https://evil.example.com", "").Code)
+	assert.Equal(t, http.StatusForbidden, serveEmbed(router, http.MethodPost, "/api/v1/generate", testPortal, "{}").Code, "the web UI keeps its own CORS policy")
+}
+
+func TestEmbed_Widget(t *testing.T) {
+	router := newEmbedTestRouter(t)
+
+	widget := serveEmbed(router, http.MethodGet, "/embed?origin="+testPortal+"&blueprint=embed-test", "", "")
+	require.Equal(t, http.StatusOK, widget.Code)
+	assert.Equal(t, "frame-ancestors "+testPortal, widget.Header().Get("Content-Security-Policy"))
+	assert.Contains(t, widget.Body.String(), `var target = "https://portal.example.com";`)
+	assert.Contains(t, widget.Body.String(), `var preselected = "embed-test";`)
+	assert.Contains(t, widget.Body.String(), `var api = "/embed/v1";`)
+
+	assert.Equal(t, http.StatusOK, serveEmbed(router, http.MethodGet, "/embed", "", "").Code, "a single origin needs no origin parameter")
+	assert.Equal(t, http.StatusBadRequest, serveEmbed(router, http.MethodGet, "/embed?origin=https://evil.example.com", "", "").Code)
+}
+
+func TestEmbed_Generate(t *testing.T) {
+	router := newEmbedTestRouter(t)
+
+	recorder := serveEmbed(router, http.MethodPost, "/embed/v1/generate", testPortal,
+		`{"blueprint":"embed-test","config":{"project_name":"lib","module_url":"github.com/acme/lib","go_version":"1.22","project_type":"library"}}`)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	var response models.GenerateProjectResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "/embed/v1/download/"+response.ID, response.DownloadURL)
+}
@@ -3,35 +3,53 @@ package handlers
 import (
 	"archive/zip"
 	"bytes"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"path/filepath"
-	"sync"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/francknouama/go-starter/internal/availability"
 	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/naming"
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/internal/web/history"
+	"github.com/francknouama/go-starter/internal/web/middleware"
 	"github.com/francknouama/go-starter/internal/web/models"
+	"github.com/francknouama/go-starter/internal/web/storage"
+	"github.com/francknouama/go-starter/internal/web/websocket"
 	"github.com/francknouama/go-starter/pkg/types"
 )
 
 type GeneratorHandler struct {
-	// In-memory storage for generated projects (in production, use Redis or similar)
-	projects map[string]*models.GeneratedProject
-	mutex    sync.RWMutex
+	// projects keeps the generated projects for download until they expire
+	projects *storage.Store
+	registry *templates.Registry
+	// availability warns about taken project names and module paths on validation
+	availability *availability.Checker
+	// hub sends the events of a generation to the /ws/generate client it names
+	hub *websocket.Hub
+	// history records the generations for the statistics endpoints
+	history *history.Store
+	// templates keeps the blueprint files parsed across generations
+	templates *generator.TemplateCache
 }
 
-func NewGeneratorHandler() *GeneratorHandler {
-	handler := &GeneratorHandler{
-		projects: make(map[string]*models.GeneratedProject),
+func NewGeneratorHandler(registry *templates.Registry, cache *generator.TemplateCache, hub *websocket.Hub, store *history.Store, projects *storage.Store) *GeneratorHandler {
+	return &GeneratorHandler{
+		projects:     projects,
+		registry:     registry,
+		availability: availability.NewChecker(),
+		hub:          hub,
+		history:      store,
+		templates:    cache,
 	}
-
-	// Start cleanup goroutine
-	go handler.cleanupExpiredProjects()
-
-	return handler
 }
 
 // ValidateConfig validates project configuration
@@ -45,12 +63,26 @@ func (h *GeneratorHandler) ValidateConfig(c *gin.Context) {
 		return
 	}
 
-	errors := validateProjectConfig(req.Config)
-	
-	c.JSON(http.StatusOK, models.ValidateConfigResponse{
-		Valid:  len(errors) == 0,
+	errors := validateProjectConfig(&req.Config)
+	if req.Blueprint != "" {
+		errors = append(errors, h.deprecationWarnings(req.Config, req.Blueprint)...)
+		errors = append(errors, h.ruleErrors(req.Config, req.Blueprint)...)
+	}
+	if !hasValidationErrors(errors) {
+		for _, warning := range h.availability.Check(c.Request.Context(), req.Config.ProjectName, req.Config.ModuleURL) {
+			errors = append(errors, models.ValidationError{Field: warning.Field, Message: warning.Message, Severity: "warning"})
+		}
+	}
+
+	response := models.ValidateConfigResponse{
+		Valid:  !hasValidationErrors(errors),
 		Errors: errors,
-	})
+	}
+	if names, err := naming.Derive(req.Config.ProjectName); err == nil {
+		response.Names = &names
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
 // GenerateProject generates a new project
@@ -64,8 +96,12 @@ func (h *GeneratorHandler) GenerateProject(c *gin.Context) {
 		return
 	}
 
-	// Validate configuration
-	if errors := validateProjectConfig(req.Config); len(errors) > 0 {
+	// Validate configuration, and the options against the rules of the blueprint
+	errors := validateProjectConfig(&req.Config)
+	if req.Blueprint != "" {
+		errors = append(errors, h.ruleErrors(req.Config, req.Blueprint)...)
+	}
+	if hasValidationErrors(errors) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":  "Configuration validation failed",
 			"code":   "VALIDATION_FAILED",
@@ -78,23 +114,35 @@ func (h *GeneratorHandler) GenerateProject(c *gin.Context) {
 	generationID := uuid.New().String()
 
 	// Convert web config to internal config
-	config := &types.ProjectConfig{
-		Name:         req.Config.ProjectName,
-		Module:       req.Config.ModuleURL,
-		Type:         req.Config.ProjectType,
-		Framework:    req.Config.Framework,
-		Architecture: req.Config.Architecture,
-		Logger:       req.Config.Logger,
-		GoVersion:    req.Config.GoVersion,
-	}
+	config := toProjectConfig(req.Config)
 
 	// Generate project in memory
 	startTime := time.Now()
-	gen := generator.New()
+	gen := generator.NewWithRegistry(h.registry)
+	gen.UseTemplateCache(h.templates)
 	
 	// For web mode, we generate to a temporary in-memory buffer
-	files, err := gen.GenerateInMemory(config, req.Blueprint)
+	files, err := gen.GenerateInMemoryFiles(c.Request.Context(), config, req.Blueprint)
 	if err != nil {
+		// The client went away, there is nobody left to answer
+		if c.Request.Context().Err() != nil {
+			c.Abort()
+			return
+		}
+		h.record(req, true)
+
+		// A template that failed to render is reported with where and how to fix it
+		if report := renderErrorReport(err); report != nil {
+			message := "Failed to render template " + report.File
+			h.notify(req.ClientID, models.GenerationEvent{Type: "generation_failed", ID: generationID, Blueprint: req.Blueprint, Error: message, Report: report})
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"error":  message,
+				"code":   "RENDER_FAILED",
+				"report": report,
+			})
+			return
+		}
+		h.notify(req.ClientID, models.GenerationEvent{Type: "generation_failed", ID: generationID, Blueprint: req.Blueprint, Error: "Failed to generate project"})
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to generate project",
 			"code":  "GENERATION_FAILED",
@@ -103,6 +151,8 @@ func (h *GeneratorHandler) GenerateProject(c *gin.Context) {
 	}
 
 	generationTime := time.Since(startTime)
+	h.record(req, false)
+	deprecations, _ := gen.Deprecations(*config, req.Blueprint)
 
 	// Create ZIP archive
 	zipBuffer, err := createZipArchive(files)
@@ -114,68 +164,56 @@ func (h *GeneratorHandler) GenerateProject(c *gin.Context) {
 		return
 	}
 
-	// Store generated project
-	project := &models.GeneratedProject{
-		ID:             generationID,
-		Config:         req.Config,
-		Files:          convertToWebFiles(files),
-		ZipData:        zipBuffer.Bytes(),
-		GenerationTime: generationTime,
-		CreatedAt:      time.Now(),
-		ExpiresAt:      time.Now().Add(24 * time.Hour), // Expire after 24 hours
-	}
-
-	h.mutex.Lock()
-	h.projects[generationID] = project
-	h.mutex.Unlock()
-
 	// Return response
 	fileList := make([]models.GeneratedFileInfo, 0, len(files))
-	for path, content := range files {
+	for path, file := range files {
 		fileList = append(fileList, models.GeneratedFileInfo{
 			Path: path,
-			Size: len(content),
+			Size: len(file.Content),
 			Type: getFileType(path),
 		})
 	}
+	sort.Slice(fileList, func(i, j int) bool { return fileList[i].Path < fileList[j].Path })
+
+	// Store the project for download, by its ID
+	project, err := h.projects.Save(c.Request.Context(), storage.Project{
+		ID:             generationID,
+		Owner:          owner(c),
+		Blueprint:      req.Blueprint,
+		Config:         req.Config,
+		Files:          fileList,
+		GenerationTime: generationTime,
+	}, zipBuffer.Bytes())
+	if err != nil {
+		slog.Error("Failed to store project", "id", generationID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to store project",
+			"code":  "STORAGE_FAILED",
+		})
+		return
+	}
 
+	h.notify(req.ClientID, models.GenerationEvent{Type: "generation_completed", ID: generationID, Blueprint: req.Blueprint, FilesGenerated: len(files)})
 	c.JSON(http.StatusOK, models.GenerateProjectResponse{
 		ID:             generationID,
 		Status:         "completed",
 		FilesGenerated: len(files),
 		GenerationTime: generationTime.String(),
-		DownloadURL:    fmt.Sprintf("/api/v1/download/%s", generationID),
+		DownloadURL:    downloadURL(c, generationID),
 		ExpiresAt:      project.ExpiresAt.Format(time.RFC3339),
 		Files:          fileList,
+		Deprecations:   deprecations,
 	})
 }
 
 // DownloadProject handles project download
 func (h *GeneratorHandler) DownloadProject(c *gin.Context) {
-	projectID := c.Param("id")
-
-	h.mutex.RLock()
-	project, exists := h.projects[projectID]
-	h.mutex.RUnlock()
-
-	if !exists {
-		c.JSON(http.StatusNotFound, gin.H{
-			"error": "Project not found or expired",
-			"code":  "PROJECT_NOT_FOUND",
-		})
+	if _, ok := h.ownedProject(c); !ok {
 		return
 	}
-
-	// Check if project has expired
-	if time.Now().After(project.ExpiresAt) {
-		h.mutex.Lock()
-		delete(h.projects, projectID)
-		h.mutex.Unlock()
-
-		c.JSON(http.StatusNotFound, gin.H{
-			"error": "Project has expired",
-			"code":  "PROJECT_EXPIRED",
-		})
+	project, archive, err := h.projects.Archive(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.projectError(c, err)
 		return
 	}
 
@@ -185,19 +223,63 @@ func (h *GeneratorHandler) DownloadProject(c *gin.Context) {
 	c.Header("Content-Transfer-Encoding", "binary")
 	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
 	c.Header("Content-Type", "application/zip")
-	c.Header("Content-Length", fmt.Sprintf("%d", len(project.ZipData)))
+	c.Header("Content-Length", fmt.Sprintf("%d", len(archive)))
 
 	// Send the ZIP file
-	c.Data(http.StatusOK, "application/zip", project.ZipData)
+	c.Data(http.StatusOK, "application/zip", archive)
+}
+
+// GetProject describes a stored project, for the UI to offer it again by ID
+func (h *GeneratorHandler) GetProject(c *gin.Context) {
+	project, ok := h.ownedProject(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, projectResponse(c, project))
+}
+
+// ListProjects lists the projects the caller generated that have not expired,
+// the latest first. It needs authentication, without which projects have no owner.
+func (h *GeneratorHandler) ListProjects(c *gin.Context) {
+	identity, ok := middleware.Identity(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "Authentication required",
+			"code":  "UNAUTHENTICATED",
+		})
+		return
+	}
+
+	projects, err := h.projects.List(c.Request.Context(), identity.Subject)
+	if err != nil {
+		h.projectError(c, err)
+		return
+	}
+	response := models.ProjectListResponse{Projects: make([]models.ProjectResponse, 0, len(projects))}
+	for _, project := range projects {
+		response.Projects = append(response.Projects, projectResponse(c, project))
+	}
+	c.JSON(http.StatusOK, response)
 }
 
 // CleanupProject manually cleans up a project
 func (h *GeneratorHandler) CleanupProject(c *gin.Context) {
-	projectID := c.Param("id")
-
-	h.mutex.Lock()
-	delete(h.projects, projectID)
-	h.mutex.Unlock()
+	project, err := h.projects.Get(c.Request.Context(), c.Param("id"))
+	switch {
+	case errors.Is(err, storage.ErrNotFound), errors.Is(err, storage.ErrExpired):
+		// Already gone
+	case err != nil:
+		h.projectError(c, err)
+		return
+	case project.Owner != "" && project.Owner != owner(c):
+		h.projectError(c, storage.ErrNotFound)
+		return
+	default:
+		if err := h.projects.Delete(c.Request.Context(), project.ID); err != nil {
+			h.projectError(c, err)
+			return
+		}
+	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Project cleaned up successfully",
@@ -206,7 +288,118 @@ func (h *GeneratorHandler) CleanupProject(c *gin.Context) {
 
 // Helper functions
 
-func validateProjectConfig(config models.ProjectConfig) []models.ValidationError {
+// owner returns the subject owning the projects the request generates, empty
+// when it went through no authentication
+func owner(c *gin.Context) string {
+	identity, _ := middleware.Identity(c)
+	return identity.Subject
+}
+
+// ownedProject returns the project of the request path, answering not found when
+// it belongs to another caller, so that project IDs do not leak across users.
+// Projects without an owner are anyone's.
+func (h *GeneratorHandler) ownedProject(c *gin.Context) (storage.Project, bool) {
+	project, err := h.projects.Get(c.Request.Context(), c.Param("id"))
+	if err == nil && project.Owner != "" && project.Owner != owner(c) {
+		err = storage.ErrNotFound
+	}
+	if err != nil {
+		h.projectError(c, err)
+		return storage.Project{}, false
+	}
+	return project, true
+}
+
+// projectResponse describes a stored project
+func projectResponse(c *gin.Context, project storage.Project) models.ProjectResponse {
+	return models.ProjectResponse{
+		ID:             project.ID,
+		Blueprint:      project.Blueprint,
+		Config:         project.Config,
+		FilesGenerated: len(project.Files),
+		Files:          project.Files,
+		Size:           project.Size,
+		GenerationTime: project.GenerationTime.String(),
+		DownloadURL:    downloadURL(c, project.ID),
+		CreatedAt:      project.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:      project.ExpiresAt.Format(time.RFC3339),
+	}
+}
+
+// projectError answers a request for a stored project that failed
+func (h *GeneratorHandler) projectError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Project not found or expired",
+			"code":  "PROJECT_NOT_FOUND",
+		})
+	case errors.Is(err, storage.ErrExpired):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Project has expired",
+			"code":  "PROJECT_EXPIRED",
+		})
+	default:
+		slog.Error("Failed to access project storage", "id", c.Param("id"), "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to access project storage",
+			"code":  "STORAGE_FAILED",
+		})
+	}
+}
+
+// notify sends an event of a generation to the /ws/generate client of the
+// request, when it named one
+func (h *GeneratorHandler) notify(clientID string, event models.GenerationEvent) {
+	if h.hub == nil || clientID == "" {
+		return
+	}
+	h.hub.SendTo(clientID, event)
+}
+
+// record adds a generation to the history, when the handler keeps one and the
+// blueprint exists
+func (h *GeneratorHandler) record(req models.GenerateProjectRequest, failed bool) {
+	if h.history == nil {
+		return
+	}
+	options, err := generator.NewWithRegistry(h.registry).Options(req.Blueprint)
+	if err != nil {
+		return
+	}
+	if err := h.history.Record(generationRecord(req.Blueprint, options, req.Config, failed)); err != nil {
+		slog.Warn("Failed to record generation", "error", err)
+	}
+}
+
+// renderErrorReport returns the report of a template that failed to render, or
+// nil when err comes from elsewhere
+func renderErrorReport(err error) *models.RenderErrorReport {
+	var renderErr *generator.RenderError
+	if !errors.As(err, &renderErr) {
+		return nil
+	}
+	return &models.RenderErrorReport{
+		File:       renderErr.File,
+		Line:       renderErr.Line,
+		Variable:   renderErr.Variable,
+		Message:    renderErr.Message,
+		Suggestion: renderErr.Suggestion,
+	}
+}
+
+// downloadURL returns the download URL of a generation next to the generate route
+// it was requested on, so that the web UI and the embed mode each get their own
+func downloadURL(c *gin.Context, generationID string) string {
+	base := strings.TrimSuffix(c.FullPath(), "/generate")
+	if base == "" || base == c.FullPath() {
+		base = "/api/v1"
+	}
+	return base + "/download/" + generationID
+}
+
+// validateProjectConfig validates the configuration, normalizing the project name in place
+func validateProjectConfig(config *models.ProjectConfig) []models.ValidationError {
 	var errors []models.ValidationError
 
 	if config.ProjectName == "" {
@@ -215,6 +408,19 @@ func validateProjectConfig(config models.ProjectConfig) []models.ValidationError
 			Message:  "Project name is required",
 			Severity: "error",
 		})
+	} else if normalized, err := naming.Normalize(config.ProjectName); err != nil {
+		errors = append(errors, models.ValidationError{
+			Field:    "project_name",
+			Message:  err.Error(),
+			Severity: "error",
+		})
+	} else if normalized != config.ProjectName {
+		errors = append(errors, models.ValidationError{
+			Field:    "project_name",
+			Message:  fmt.Sprintf("Project name will be normalized to %q", normalized),
+			Severity: "warning",
+		})
+		config.ProjectName = normalized
 	}
 
 	if config.ModuleURL == "" {
@@ -236,12 +442,116 @@ func validateProjectConfig(config models.ProjectConfig) []models.ValidationError
 	return errors
 }
 
-func createZipArchive(files map[string][]byte) (*bytes.Buffer, error) {
+// toProjectConfig converts the web UI configuration to the generator configuration
+func toProjectConfig(config models.ProjectConfig) *types.ProjectConfig {
+	project := &types.ProjectConfig{
+		Name:         config.ProjectName,
+		Module:       config.ModuleURL,
+		Type:         config.ProjectType,
+		Framework:    config.Framework,
+		Architecture: config.Architecture,
+		Logger:       config.Logger,
+		GoVersion:    config.GoVersion,
+		Experimental: config.Experimental,
+		Variables:    make(map[string]string, len(config.Variables)),
+		Features:     &types.Features{},
+	}
+	for name, value := range config.Variables {
+		project.Variables[name] = value
+	}
+	if config.Database != nil {
+		project.Features.Database.Driver = config.Database.Driver
+		project.Features.Database.ORM = config.Database.ORM
+	}
+	if config.Auth != nil {
+		project.Features.Authentication.Type = config.Auth.Type
+	}
+	return project
+}
+
+// deprecationWarnings reports the deprecated blueprint and options a configuration
+// selects as validation warnings, so the UI can flag them at selection time
+func (h *GeneratorHandler) deprecationWarnings(config models.ProjectConfig, blueprintID string) []models.ValidationError {
+	notices, err := generator.NewWithRegistry(h.registry).Deprecations(*toProjectConfig(config), blueprintID)
+	if err != nil {
+		return []models.ValidationError{{
+			Field:    "blueprint",
+			Message:  fmt.Sprintf("Blueprint %q not found", blueprintID),
+			Severity: "error",
+		}}
+	}
+
+	warnings := make([]models.ValidationError, 0, len(notices))
+	for _, notice := range notices {
+		field, message := "blueprint", fmt.Sprintf("Blueprint %s is deprecated", notice.Blueprint)
+		if notice.Variable != "" {
+			field, message = strings.ToLower(notice.Variable), fmt.Sprintf("%s %q is deprecated", notice.Variable, notice.Value)
+		}
+		if notice.Message != "" {
+			message += ": " + notice.Message
+		}
+		if notice.Replacement != "" {
+			message += fmt.Sprintf(" (use %s instead)", notice.Replacement)
+		}
+		if notice.Sunset != "" {
+			message += fmt.Sprintf(", sunset %s", notice.Sunset)
+		}
+		warnings = append(warnings, models.ValidationError{Field: field, Message: message, Severity: "warning"})
+	}
+	return warnings
+}
+
+// ruleErrors reports the validation rules of its variables the blueprint would
+// reject a configuration for. Unknown blueprints are left to deprecationWarnings
+// and to generation.
+func (h *GeneratorHandler) ruleErrors(config models.ProjectConfig, blueprintID string) []models.ValidationError {
+	violations, err := generator.NewWithRegistry(h.registry).VariableViolations(*toProjectConfig(config), blueprintID)
+	if err != nil {
+		return nil
+	}
+
+	errors := make([]models.ValidationError, 0, len(violations))
+	for _, violation := range violations {
+		errors = append(errors, models.ValidationError{Field: strings.ToLower(violation.Variable), Message: violation.Message, Severity: "error"})
+	}
+	return errors
+}
+
+// hasValidationErrors reports whether any validation result is an error rather than a warning
+func hasValidationErrors(errors []models.ValidationError) bool {
+	for _, e := range errors {
+		if e.Severity == "error" {
+			return true
+		}
+	}
+	return false
+}
+
+func createZipArchive(files map[string]generator.GeneratedFile) (*bytes.Buffer, error) {
 	buf := new(bytes.Buffer)
 	writer := zip.NewWriter(buf)
 
-	for path, content := range files {
-		f, err := writer.Create(path)
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		file := files[path]
+
+		// Preserve permissions and symlinks; a symlink entry stores its target as content
+		header := &zip.FileHeader{
+			Name:   filepath.ToSlash(path),
+			Method: zip.Deflate,
+		}
+		header.SetMode(file.Mode)
+		content := file.Content
+		if file.Symlink != "" {
+			content = []byte(file.Symlink)
+		}
+
+		f, err := writer.CreateHeader(header)
 		if err != nil {
 			return nil, err
 		}
@@ -260,22 +570,6 @@ func createZipArchive(files map[string][]byte) (*bytes.Buffer, error) {
 	return buf, nil
 }
 
-func convertToWebFiles(files map[string][]byte) []models.GeneratedFile {
-	result := make([]models.GeneratedFile, 0, len(files))
-	
-	for path, content := range files {
-		result = append(result, models.GeneratedFile{
-			Path:     path,
-			Content:  string(content),
-			Size:     len(content),
-			Type:     getFileType(path),
-			Language: getLanguage(path),
-		})
-	}
-
-	return result
-}
-
 func getFileType(path string) string {
 	ext := filepath.Ext(path)
 	switch ext {
@@ -301,21 +595,3 @@ func getFileType(path string) string {
 func getLanguage(path string) string {
 	return getFileType(path)
 }
-
-// cleanupExpiredProjects removes expired projects from memory
-func (h *GeneratorHandler) cleanupExpiredProjects() {
-	ticker := time.NewTicker(1 * time.Hour)
-	defer ticker.Stop()
-
-	for range ticker.C {
-		now := time.Now()
-		
-		h.mutex.Lock()
-		for id, project := range h.projects {
-			if now.After(project.ExpiresAt) {
-				delete(h.projects, id)
-			}
-		}
-		h.mutex.Unlock()
-	}
-}
\ No newline at end of file
@@ -0,0 +1,327 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	gorilla "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/availability"
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/internal/web/auth"
+	"github.com/francknouama/go-starter/internal/web/middleware"
+	"github.com/francknouama/go-starter/internal/web/models"
+	"github.com/francknouama/go-starter/internal/web/storage"
+	"github.com/francknouama/go-starter/internal/web/websocket"
+)
+
+func TestValidateConfig_Availability(t *testing.T) {
+	github := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/repos/acme/http" {
+			_, _ = w.Write([]byte(`{"full_name":"acme/http"}`))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer github.Close()
+
+	gin.SetMode(gin.TestMode)
+	handler := &GeneratorHandler{availability: &availability.Checker{GitHubURL: github.URL}}
+	router := gin.New()
+	router.POST("/validate", handler.ValidateConfig)
+
+	validate := func(body string) models.ValidateConfigResponse {
+		t.Helper()
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/validate", strings.NewReader(body)))
+		require.Equal(t, http.StatusOK, recorder.Code)
+		var response models.ValidateConfigResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		return response
+	}
+
+	response := validate(`{"config":{"project_name":"http","module_url":"github.com/acme/http","go_version":"1.22","project_type":"web-api"}}`)
+	assert.True(t, response.Valid, "availability problems are warnings")
+	require.Len(t, response.Errors, 2)
+	assert.Equal(t, models.ValidationError{Field: "project_name", Message: `Project name "http" collides with a reserved name: package http shadows the standard library package of the same name`, Severity: "warning"}, response.Errors[0])
+	assert.Equal(t, "module_url", response.Errors[1].Field)
+	assert.Contains(t, response.Errors[1].Message, "github.com/acme/http already exists on GitHub")
+
+	response = validate(`{"config":{"project_name":"orders","module_url":"github.com/acme/orders","go_version":"1.22","project_type":"web-api"}}`)
+	assert.True(t, response.Valid)
+	assert.Empty(t, response.Errors)
+
+	response = validate(`{"config":{"project_name":"con","module_url":"github.com/acme/http","go_version":"1.22","project_type":"web-api"}}`)
+	assert.False(t, response.Valid)
+	assert.Len(t, response.Errors, 1, "invalid configurations are not checked for availability")
+}
+
+func TestGenerateProject_RenderFailure(t *testing.T) {
+	registry, err := templates.NewRegistryWithFS(fstest.MapFS{
+		"broken-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "broken-test"
+name: "broken-test"
+type: "web-api"
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+`)},
+		"broken-test/main.go.tmpl": &fstest.MapFile{Data: []byte("package main\n\n// {{.ProjectName | uper}}\n")},
+	})
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	hub := websocket.NewHub()
+	go hub.Run()
+	handler := &GeneratorHandler{projects: storage.NewMemory(storage.DefaultTTL), registry: registry, hub: hub}
+	router := gin.New()
+	router.POST("/generate", handler.GenerateProject)
+	router.GET("/ws/generate", NewWebSocketHandler(hub).HandleGenerateWS)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	conn, _, err := gorilla.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/ws/generate", http.Header{"Origin": {"http://localhost:5173"}})
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	var connected map[string]any
+	require.NoError(t, conn.ReadJSON(&connected))
+	clientID, _ := connected["client_id"].(string)
+	require.NotEmpty(t, clientID)
+
+	body := `{"blueprint":"broken-test","client_id":"` + clientID + `","config":{"project_name":"api","module_url":"github.com/acme/api","go_version":"1.22","project_type":"web-api"}}`
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/generate", strings.NewReader(body)))
+	require.Equal(t, http.StatusUnprocessableEntity, recorder.Code, recorder.Body.String())
+
+	var response struct {
+		Code   string                   `json:"code"`
+		Report models.RenderErrorReport `json:"report"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "RENDER_FAILED", response.Code)
+	assert.Equal(t, models.RenderErrorReport{
+		File:       "main.go.tmpl",
+		Line:       3,
+		Message:    `function "uper" not defined`,
+		Suggestion: "did you mean upper?",
+	}, response.Report)
+
+	var event models.GenerationEvent
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(10*time.Second)))
+	require.NoError(t, conn.ReadJSON(&event))
+	assert.Equal(t, "generation_failed", event.Type)
+	assert.Equal(t, "broken-test", event.Blueprint)
+	assert.Equal(t, &response.Report, event.Report)
+}
+
+func TestValidateConfig_VariableRules(t *testing.T) {
+	registry, err := templates.NewRegistryWithFS(fstest.MapFS{
+		"rules-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "rules-test"
+name: "rules-test"
+type: "web-api"
+variables:
+  - name: "Port"
+    type: "int"
+    default: 8080
+    min: 1024
+  - name: "DatabaseDriver"
+    default: ""
+  - name: "DatabaseORM"
+    default: ""
+    requires:
+      - variable: "DatabaseDriver"
+        message: "a database ORM needs a database"
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+`)},
+		"rules-test/main.go.tmpl": &fstest.MapFile{Data: []byte("package main\n")},
+	})
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	handler := &GeneratorHandler{projects: storage.NewMemory(storage.DefaultTTL), registry: registry}
+	router := gin.New()
+	router.POST("/validate", handler.ValidateConfig)
+	router.POST("/generate", handler.GenerateProject)
+
+	body := `{"blueprint":"rules-test","config":{"project_name":"orders","module_url":"github.com/acme/orders","go_version":"1.22","project_type":"web-api","database":{"driver":"","orm":"gorm"},"variables":{"Port":"80"}}}`
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/validate", strings.NewReader(body)))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var response models.ValidateConfigResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.False(t, response.Valid)
+	assert.Equal(t, []models.ValidationError{
+		{Field: "port", Message: `Port "80" must be at least 1024`, Severity: "error"},
+		{Field: "databaseorm", Message: "a database ORM needs a database", Severity: "error"},
+	}, response.Errors)
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/generate", strings.NewReader(body)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code, "generation is refused before rendering")
+	assert.Contains(t, recorder.Body.String(), "VALIDATION_FAILED")
+}
+
+func TestProjects_SurviveRestart(t *testing.T) {
+	registry, err := templates.NewRegistryWithFS(fstest.MapFS{
+		"store-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "store-test"
+name: "store-test"
+type: "library"
+files:
+  - source: "lib.go.tmpl"
+    destination: "lib.go"
+`)},
+		"store-test/lib.go.tmpl": &fstest.MapFile{Data: []byte("package {{.ProjectPackage}}\n")},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	open := func() (*gin.Engine, *storage.Store) {
+		projects, err := storage.Open(ctx, filepath.Join(dir, "archives"), filepath.Join(dir, "projects.db"), time.Hour)
+		require.NoError(t, err)
+		handler := &GeneratorHandler{projects: projects, registry: registry}
+		router := gin.New()
+		router.POST("/api/v1/generate", handler.GenerateProject)
+		router.GET("/api/v1/projects/:id", handler.GetProject)
+		router.GET("/api/v1/download/:id", handler.DownloadProject)
+		router.DELETE("/api/v1/projects/:id", handler.CleanupProject)
+		return router, projects
+	}
+	serve := func(router *gin.Engine, method, target, body string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(method, target, strings.NewReader(body)))
+		return recorder
+	}
+
+	gin.SetMode(gin.TestMode)
+	router, projects := open()
+	recorder := serve(router, http.MethodPost, "/api/v1/generate", `{"blueprint":"store-test","config":{"project_name":"lib","module_url":"github.com/acme/lib","go_version":"1.22","project_type":"library"}}`)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	var generated models.GenerateProjectResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &generated))
+	require.NoError(t, projects.Close())
+
+	router, projects = open()
+	defer func() { _ = projects.Close() }()
+	recorder = serve(router, http.MethodGet, "/api/v1/projects/"+generated.ID, "")
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	var project models.ProjectResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &project))
+	assert.Equal(t, "store-test", project.Blueprint)
+	assert.Equal(t, "lib", project.Config.ProjectName)
+	assert.Equal(t, generated.Files, project.Files)
+	assert.Equal(t, generated.ExpiresAt, project.ExpiresAt)
+	assert.Equal(t, generated.DownloadURL, project.DownloadURL)
+
+	recorder = serve(router, http.MethodGet, generated.DownloadURL, "")
+	require.Equal(t, http.StatusOK, recorder.Code, "the archive outlives the restart")
+	assert.Equal(t, "attachment; filename=lib.zip", recorder.Header().Get("Content-Disposition"))
+	archive, err := zip.NewReader(bytes.NewReader(recorder.Body.Bytes()), int64(recorder.Body.Len()))
+	require.NoError(t, err)
+	require.Len(t, archive.File, len(generated.Files))
+	names := make([]string, 0, len(archive.File))
+	for _, file := range archive.File {
+		names = append(names, file.Name)
+	}
+	assert.Contains(t, names, "lib.go")
+
+	assert.Equal(t, http.StatusOK, serve(router, http.MethodDelete, "/api/v1/projects/"+generated.ID, "").Code)
+	recorder = serve(router, http.MethodGet, generated.DownloadURL, "")
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "PROJECT_NOT_FOUND")
+}
+
+func TestProjects_Ownership(t *testing.T) {
+	registry, err := templates.NewRegistryWithFS(fstest.MapFS{
+		"owned-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "owned-test"
+name: "owned-test"
+type: "library"
+files:
+  - source: "lib.go.tmpl"
+    destination: "lib.go"
+`)},
+		"owned-test/lib.go.tmpl": &fstest.MapFile{Data: []byte("package {{.ProjectPackage}}\n")},
+	})
+	require.NoError(t, err)
+
+	authenticator, err := auth.New(context.Background(), auth.Config{})
+	require.NoError(t, err)
+	authenticator.AddAPIKey("alice", "alice-key")
+	authenticator.AddAPIKey("bob", "bob-key")
+
+	gin.SetMode(gin.TestMode)
+	handler := &GeneratorHandler{projects: storage.NewMemory(storage.DefaultTTL), registry: registry}
+	router := gin.New()
+	router.POST("/embed/v1/generate", handler.GenerateProject)
+	api := router.Group("/api/v1", middleware.Auth(authenticator))
+	api.POST("/generate", handler.GenerateProject)
+	api.GET("/download/:id", handler.DownloadProject)
+	api.GET("/projects", handler.ListProjects)
+	api.GET("/projects/:id", handler.GetProject)
+	api.DELETE("/projects/:id", handler.CleanupProject)
+
+	serve := func(method, target, key, body string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, target, strings.NewReader(body))
+		if key != "" {
+			request.Header.Set(auth.APIKeyHeader, key)
+		}
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+		return recorder
+	}
+	generate := func(target, key string) models.GenerateProjectResponse {
+		t.Helper()
+		recorder := serve(http.MethodPost, target, key, `{"blueprint":"owned-test","config":{"project_name":"lib","module_url":"github.com/acme/lib","go_version":"1.22","project_type":"library"}}`)
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+		var response models.GenerateProjectResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		return response
+	}
+
+	recorder := serve(http.MethodPost, "/api/v1/generate", "", `{}`)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "UNAUTHENTICATED")
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodPost, "/api/v1/generate", "guess", `{}`).Code)
+
+	alices := generate("/api/v1/generate", "alice-key")
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, alices.DownloadURL, "alice-key", "").Code)
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/api/v1/projects/"+alices.ID, "alice-key", "").Code)
+
+	for _, request := range [][2]string{{http.MethodGet, alices.DownloadURL}, {http.MethodGet, "/api/v1/projects/" + alices.ID}, {http.MethodDelete, "/api/v1/projects/" + alices.ID}} {
+		recorder := serve(request[0], request[1], "bob-key", "")
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "%s %s", request[0], request[1])
+		assert.Contains(t, recorder.Body.String(), "PROJECT_NOT_FOUND", "the project of another user does not exist for bob")
+	}
+
+	recorder = serve(http.MethodGet, "/api/v1/projects", "alice-key", "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var list models.ProjectListResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &list))
+	require.Len(t, list.Projects, 1)
+	assert.Equal(t, alices.ID, list.Projects[0].ID)
+	recorder = serve(http.MethodGet, "/api/v1/projects", "bob-key", "")
+	assert.JSONEq(t, `{"projects":[]}`, recorder.Body.String())
+
+	embedded := generate("/embed/v1/generate", "")
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/api/v1/download/"+embedded.ID, "bob-key", "").Code, "projects without an owner are anyone's")
+
+	assert.Equal(t, http.StatusOK, serve(http.MethodDelete, "/api/v1/projects/"+alices.ID, "alice-key", "").Code)
+	assert.Equal(t, http.StatusNotFound, serve(http.MethodGet, alices.DownloadURL, "alice-key", "").Code)
+}
@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/internal/web/models"
+	"github.com/francknouama/go-starter/internal/web/websocket"
+)
+
+// previewTimeout bounds the render of a preview
+const previewTimeout = 30 * time.Second
+
+// PreviewHandler renders the previews requested by /ws/preview clients. The last
+// render of each client is kept, so that changing an option only streams the files
+// the change adds, removes or modifies.
+type PreviewHandler struct {
+	registry *templates.Registry
+	// templates keeps the blueprint files parsed across renders
+	templates *generator.TemplateCache
+	mutex     sync.Mutex
+	renders   map[*websocket.Client]*previewRender
+}
+
+// previewRender is the last render sent to a client
+type previewRender struct {
+	blueprint string
+	revision  int
+	files     map[string]generator.GeneratedFile
+}
+
+func NewPreviewHandler(registry *templates.Registry, cache *generator.TemplateCache) *PreviewHandler {
+	return &PreviewHandler{
+		registry:  registry,
+		templates: cache,
+		renders:   make(map[*websocket.Client]*previewRender),
+	}
+}
+
+// HandleMessage renders the configuration of a preview request and streams the
+// changes since the previous render of the client
+func (h *PreviewHandler) HandleMessage(client *websocket.Client, message []byte) {
+	var req models.PreviewRequest
+	if err := json.Unmarshal(message, &req); err != nil {
+		h.send(client, models.PreviewUpdate{Type: "error", Error: "Invalid preview request"})
+		return
+	}
+
+	switch req.Type {
+	case "reset":
+		h.Disconnected(client)
+		return
+	case "render":
+	default:
+		h.send(client, models.PreviewUpdate{Type: "error", Error: fmt.Sprintf("Unknown preview request type %q", req.Type)})
+		return
+	}
+
+	if errors := validateProjectConfig(&req.Config); hasValidationErrors(errors) {
+		h.send(client, models.PreviewUpdate{Type: "error", Error: firstValidationError(errors)})
+		return
+	}
+
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), previewTimeout)
+	defer cancel()
+	gen := generator.NewWithRegistry(h.registry)
+	gen.UseTemplateCache(h.templates)
+	files, err := gen.GenerateInMemoryFiles(ctx, toProjectConfig(req.Config), req.Blueprint)
+	if err != nil {
+		// The previous render is kept, the next valid configuration is diffed against it
+		h.send(client, models.PreviewUpdate{Type: "error", Error: err.Error(), Report: renderErrorReport(err)})
+		return
+	}
+
+	h.mutex.Lock()
+	previous := h.renders[client]
+	current := &previewRender{blueprint: req.Blueprint, revision: 1, files: files}
+	if previous != nil {
+		current.revision = previous.revision + 1
+	}
+	h.renders[client] = current
+	h.mutex.Unlock()
+
+	var base map[string]generator.GeneratedFile
+	full := previous == nil || previous.blueprint != req.Blueprint
+	if !full {
+		base = previous.files
+	}
+
+	updates, summary := previewDelta(base, files)
+	summary.Full = full
+	summary.Duration = time.Since(start).String()
+	for _, update := range updates {
+		update.Revision = current.revision
+		if !h.send(client, update) {
+			return
+		}
+	}
+	h.send(client, models.PreviewUpdate{Type: "complete", Revision: current.revision, Summary: &summary, Progress: 100})
+}
+
+// Disconnected forgets the last render of the client
+func (h *PreviewHandler) Disconnected(client *websocket.Client) {
+	h.mutex.Lock()
+	delete(h.renders, client)
+	h.mutex.Unlock()
+}
+
+// send reports whether the update could be sent to the client
+func (h *PreviewHandler) send(client *websocket.Client, update models.PreviewUpdate) bool {
+	if err := client.SendJSON(update); err != nil {
+		slog.Warn("Failed to send preview update", "error", err, "client_id", client.ID)
+		return false
+	}
+	return true
+}
+
+// previewDelta lists the files added, updated and removed from previous to current
+// in path order. Updated text files come with a unified diff instead of their content.
+func previewDelta(previous, current map[string]generator.GeneratedFile) ([]models.PreviewUpdate, models.PreviewSummary) {
+	var updates []models.PreviewUpdate
+	var summary models.PreviewSummary
+
+	paths := make([]string, 0, len(current))
+	for path := range current {
+		paths = append(paths, path)
+	}
+	for path := range previous {
+		if _, ok := current[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		before, existed := previous[path]
+		after, exists := current[path]
+		switch {
+		case !exists:
+			updates = append(updates, models.PreviewUpdate{Type: "file_removed", Path: path})
+			summary.Removed++
+		case !existed:
+			updates = append(updates, previewFile("file_added", path, after))
+			summary.Added++
+		case bytes.Equal(before.Content, after.Content) && before.Symlink == after.Symlink && before.Mode == after.Mode:
+			summary.Unchanged++
+		default:
+			update := models.PreviewUpdate{Type: "file_updated", Path: path, Mode: fmt.Sprintf("%04o", after.Mode.Perm())}
+			if after.Binary || before.Binary || after.Symlink != "" || before.Symlink != "" {
+				update = previewFile("file_updated", path, after)
+			} else {
+				update.Patch = generator.UnifiedDiff(path, before.Content, after.Content)
+			}
+			updates = append(updates, update)
+			summary.Updated++
+		}
+	}
+	return updates, summary
+}
+
+// previewFile sends a file whole, binary assets base64 encoded
+func previewFile(updateType, path string, file generator.GeneratedFile) models.PreviewUpdate {
+	update := models.PreviewUpdate{Type: updateType, Path: path, Content: string(file.Content), Mode: fmt.Sprintf("%04o", file.Mode.Perm())}
+	switch {
+	case file.Symlink != "":
+		update.Content = file.Symlink
+		update.Symlink = file.Symlink
+	case file.Binary:
+		update.Content = base64.StdEncoding.EncodeToString(file.Content)
+		update.Encoding = "base64"
+	}
+	return update
+}
+
+// firstValidationError returns the message of the first error of a validation
+func firstValidationError(errors []models.ValidationError) string {
+	for _, e := range errors {
+		if e.Severity == "error" {
+			return e.Message
+		}
+	}
+	return ""
+}
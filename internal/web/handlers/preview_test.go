@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/gin-gonic/gin"
+	gorilla "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/internal/web/models"
+	"github.com/francknouama/go-starter/internal/web/websocket"
+)
+
+func TestPreviewDelta(t *testing.T) {
+	previous := map[string]generator.GeneratedFile{
+		"main.go":   {Content: []byte("package main\n\nfunc main() {}\n"), Mode: 0644},
+		"README.md": {Content: []byte("# api\n"), Mode: 0644},
+		"db.go":     {Content: []byte("package main\n"), Mode: 0644},
+		"logo.png":  {Content: []byte{0x89, 'P', 'N', 'G'}, Mode: 0644, Binary: true},
+	}
+	current := map[string]generator.GeneratedFile{
+		"main.go":   {Content: []byte("package main\n\nfunc main() { run() }\n"), Mode: 0644},
+		"README.md": {Content: []byte("# api\n"), Mode: 0644},
+		"auth.go":   {Content: []byte("package main\n"), Mode: 0644},
+		"logo.png":  {Content: []byte{0x89, 'P', 'N', 'G', 0}, Mode: 0644, Binary: true},
+	}
+
+	updates, summary := previewDelta(previous, current)
+	assert.Equal(t, models.PreviewSummary{Added: 1, Updated: 2, Removed: 1, Unchanged: 1}, summary)
+	require.Len(t, updates, 4)
+
+	assert.Equal(t, "file_added", updates[0].Type)
+	assert.Equal(t, "auth.go", updates[0].Path)
+	assert.Equal(t, "package main\n", updates[0].Content)
+
+	assert.Equal(t, "file_removed", updates[1].Type)
+	assert.Equal(t, "db.go", updates[1].Path)
+
+	assert.Equal(t, "file_updated", updates[2].Type)
+	assert.Equal(t, "logo.png", updates[2].Path)
+	assert.Equal(t, "base64", updates[2].Encoding, "binary files are sent whole")
+	assert.Empty(t, updates[2].Patch)
+
+	assert.Equal(t, "file_updated", updates[3].Type)
+	assert.Equal(t, "main.go", updates[3].Path)
+	assert.Empty(t, updates[3].Content)
+	assert.Contains(t, updates[3].Patch, "--- a/main.go\n+++ b/main.go\n")
+	assert.Contains(t, updates[3].Patch, "-func main() {}\n+func main() { run() }\n")
+
+	updates, summary = previewDelta(nil, current)
+	assert.Equal(t, 4, summary.Added)
+	assert.Len(t, updates, 4)
+}
+
+func TestPreviewHandler(t *testing.T) {
+	registry, err := templates.NewRegistryWithFS(fstest.MapFS{
+		"preview-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "preview-test"
+name: "preview-test"
+type: "web-api"
+variables:
+  - name: "DatabaseDriver"
+    default: ""
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+  - source: "db.go.tmpl"
+    destination: "db.go"
+    condition: "{{ne .DatabaseDriver \"\"}}"
+`)},
+		"preview-test/main.go.tmpl": &fstest.MapFile{Data: []byte("package main\n\n// database: {{.DatabaseDriver}}\n")},
+		"preview-test/db.go.tmpl":   &fstest.MapFile{Data: []byte("package main\n")},
+	})
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	hub := websocket.NewHub()
+	hub.Handle("preview", NewPreviewHandler(registry, generator.NewTemplateCache()))
+	go hub.Run()
+	router := gin.New()
+	router.GET("/ws/preview", NewWebSocketHandler(hub).HandlePreviewWS)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	conn, _, err := gorilla.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/ws/preview", http.Header{"Origin": {"http://localhost:5173"}})
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	var connected map[string]any
+	require.NoError(t, conn.ReadJSON(&connected))
+	assert.Equal(t, "connected", connected["type"])
+
+	// render sends a request and collects the updates up to "complete"
+	render := func(driver string) ([]models.PreviewUpdate, models.PreviewUpdate) {
+		t.Helper()
+		require.NoError(t, conn.WriteJSON(models.PreviewRequest{
+			Type:      "render",
+			Blueprint: "preview-test",
+			Config: models.ProjectConfig{
+				ProjectName: "api",
+				ModuleURL:   "github.com/acme/api",
+				GoVersion:   "1.22",
+				ProjectType: "web-api",
+				Variables:   map[string]string{"DatabaseDriver": driver},
+			},
+		}))
+		var updates []models.PreviewUpdate
+		for {
+			var update models.PreviewUpdate
+			require.NoError(t, conn.ReadJSON(&update))
+			require.NotEqual(t, "error", update.Type, update.Error)
+			if update.Type == "complete" {
+				return updates, update
+			}
+			updates = append(updates, update)
+		}
+	}
+
+	_, complete := render("")
+	assert.Equal(t, 1, complete.Revision)
+	assert.True(t, complete.Summary.Full)
+
+	updates, complete := render("postgres")
+	assert.Equal(t, 2, complete.Revision)
+	assert.False(t, complete.Summary.Full)
+	assert.Equal(t, 1, complete.Summary.Added)
+	paths := make(map[string]models.PreviewUpdate)
+	for _, update := range updates {
+		paths[update.Path] = update
+	}
+	assert.Equal(t, "file_added", paths["db.go"].Type)
+	assert.Contains(t, paths["main.go"].Patch, "+// database: postgres")
+
+	require.NoError(t, conn.WriteJSON(models.PreviewRequest{Type: "render", Blueprint: "missing"}))
+	var failure models.PreviewUpdate
+	require.NoError(t, conn.ReadJSON(&failure))
+	assert.Equal(t, "error", failure.Type)
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"maps"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/web/models"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
+// SpecHandler keeps the web UI and go-starter new in step: it reads the links
+// opened by go-starter new --open-web and writes the command line of a form
+type SpecHandler struct{}
+
+func NewSpecHandler() *SpecHandler {
+	return &SpecHandler{}
+}
+
+// Decode returns the configuration selected by the query of a spec link
+func (h *SpecHandler) Decode(c *gin.Context) {
+	config, err := generator.ParseSpec(c.Request.URL.Query())
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+			"code":  "INVALID_SPEC",
+		})
+		return
+	}
+	c.JSON(http.StatusOK, models.SpecResponse{Config: fromProjectConfig(config)})
+}
+
+// Command returns the go-starter new command line generating a configuration, and
+// the link opening the web UI on it
+func (h *SpecHandler) Command(c *gin.Context) {
+	var req models.CommandRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request format",
+			"code":  "INVALID_REQUEST",
+		})
+		return
+	}
+
+	config := toProjectConfig(req.Config)
+	command, omitted := generator.SpecCommand(*config)
+	link, _ := generator.SpecURL(requestBase(c), *config)
+	c.JSON(http.StatusOK, models.CommandResponse{
+		Command: command,
+		URL:     link,
+		Omitted: omitted,
+	})
+}
+
+// requestBase is the URL the web UI was reached at
+func requestBase(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + c.Request.Host
+}
+
+// fromProjectConfig converts the generator configuration to the web UI configuration
+func fromProjectConfig(config types.ProjectConfig) models.ProjectConfig {
+	web := models.ProjectConfig{
+		ProjectName:  config.Name,
+		ModuleURL:    config.Module,
+		GoVersion:    config.GoVersion,
+		ProjectType:  config.Type,
+		Framework:    config.Framework,
+		Architecture: config.Architecture,
+		Logger:       config.Logger,
+		Experimental: config.Experimental,
+	}
+	if len(config.Variables) > 0 {
+		web.Variables = maps.Clone(config.Variables)
+	}
+	if features := config.Features; features != nil {
+		if features.Database.HasDatabase() || features.Database.ORM != "" {
+			web.Database = &models.DatabaseConfig{Driver: features.Database.PrimaryDriver(), ORM: features.Database.ORM}
+		}
+		if features.Authentication.Type != "" {
+			web.Auth = &models.AuthConfig{Type: features.Authentication.Type}
+		}
+	}
+	return web
+}
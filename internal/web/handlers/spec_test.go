@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/web/models"
+)
+
+func TestSpecHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	handler := NewSpecHandler()
+	router := gin.New()
+	router.GET("/spec", handler.Decode)
+	router.POST("/command", handler.Command)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/spec?name=shop&type=web-api&database-driver=postgres&database-orm=gorm&admin-endpoints=true", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var spec models.SpecResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &spec))
+	assert.Equal(t, models.ProjectConfig{
+		ProjectName: "shop",
+		ProjectType: "web-api",
+		Database:    &models.DatabaseConfig{Driver: "postgres", ORM: "gorm"},
+		Variables:   map[string]string{"AdminEndpoints": "true"},
+	}, spec.Config)
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/spec?dry-run=true", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "INVALID_SPEC")
+
+	recorder = httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/command", strings.NewReader(`{"config":{"project_name":"shop","module_url":"github.com/acme/shop","go_version":"1.23","project_type":"web-api","architecture":"hexagonal","authentication":{"type":"jwt"},"variables":{"Broker":"nats","SessionStore":"redis"}}}`))
+	request.Host = "starter.example.com"
+	request.Header.Set("X-Forwarded-Proto", "https")
+	router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var command models.CommandResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &command))
+	assert.Equal(t, "go-starter new shop --module=github.com/acme/shop --type=web-api --architecture=hexagonal --go-version=1.23 --auth-type=jwt --broker=nats", command.Command)
+	assert.Equal(t, "https://starter.example.com/?architecture=hexagonal&auth-type=jwt&broker=nats&go-version=1.23&module=github.com%2Facme%2Fshop&name=shop&type=web-api", command.URL)
+	assert.Equal(t, []string{"SessionStore"}, command.Omitted)
+}
@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/internal/web/history"
+	"github.com/francknouama/go-starter/internal/web/models"
+)
+
+const (
+	// defaultStatsDays and maxStatsDays bound the days the statistics cover
+	defaultStatsDays = 30
+	maxStatsDays     = 365
+)
+
+// StatsHandler serves counts of what is generated over time, from the generation
+// history, for the maintainers to see which blueprints and options are used
+type StatsHandler struct {
+	history *history.Store
+}
+
+func NewStatsHandler(store *history.Store) *StatsHandler {
+	return &StatsHandler{history: store}
+}
+
+// statsWindow is the time the statistics of a request cover, split in periods
+type statsWindow struct {
+	interval string
+	periods  []time.Time
+}
+
+// window reads the days and interval of the query, answering the request itself
+// when they are invalid
+func (h *StatsHandler) window(c *gin.Context) (statsWindow, bool) {
+	days := defaultStatsDays
+	if value := c.Query("days"); value != "" {
+		var err error
+		if days, err = strconv.Atoi(value); err != nil || days < 1 || days > maxStatsDays {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "days must be a number from 1 to " + strconv.Itoa(maxStatsDays),
+				"code":  "INVALID_QUERY",
+			})
+			return statsWindow{}, false
+		}
+	}
+	window := statsWindow{interval: c.DefaultQuery("interval", "day")}
+	if window.interval != "day" && window.interval != "week" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "interval must be day or week",
+			"code":  "INVALID_QUERY",
+		})
+		return statsWindow{}, false
+	}
+
+	now := time.Now().UTC()
+	for start := window.periodStart(now.AddDate(0, 0, 1-days)); !start.After(now); start = window.next(start) {
+		window.periods = append(window.periods, start)
+	}
+	return window, true
+}
+
+// periodStart returns the start of the period of t: its day, or the Monday of its week
+func (w statsWindow) periodStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if w.interval == "week" {
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	}
+	return day
+}
+
+func (w statsWindow) next(start time.Time) time.Time {
+	if w.interval == "week" {
+		return start.AddDate(0, 0, 7)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+func (w statsWindow) since() time.Time {
+	return w.periods[0]
+}
+
+// series counts the generations of every period, empty periods included
+func (w statsWindow) series(generations []history.Generation) []models.StatsPeriod {
+	counts := make(map[time.Time]int, len(w.periods))
+	for _, generation := range generations {
+		counts[w.periodStart(generation.At)]++
+	}
+	series := make([]models.StatsPeriod, len(w.periods))
+	for i, start := range w.periods {
+		series[i] = models.StatsPeriod{Start: start.Format(time.DateOnly), Count: counts[start]}
+	}
+	return series
+}
+
+// BlueprintStats returns the generations of every blueprint, the most generated first
+func (h *StatsHandler) BlueprintStats(c *gin.Context) {
+	window, ok := h.window(c)
+	if !ok {
+		return
+	}
+	generations := h.history.Since(window.since())
+
+	byBlueprint := make(map[string][]history.Generation)
+	for _, generation := range generations {
+		byBlueprint[generation.Blueprint] = append(byBlueprint[generation.Blueprint], generation)
+	}
+	blueprints := make([]models.BlueprintStats, 0, len(byBlueprint))
+	for blueprint, made := range byBlueprint {
+		stats := models.BlueprintStats{Blueprint: blueprint, Total: len(made), Series: window.series(made)}
+		for _, generation := range made {
+			if generation.Failed {
+				stats.Failed++
+			}
+		}
+		blueprints = append(blueprints, stats)
+	}
+	sort.Slice(blueprints, func(i, j int) bool {
+		if blueprints[i].Total != blueprints[j].Total {
+			return blueprints[i].Total > blueprints[j].Total
+		}
+		return blueprints[i].Blueprint < blueprints[j].Blueprint
+	})
+
+	c.JSON(http.StatusOK, models.BlueprintStatsResponse{
+		Since:      window.since().Format(time.DateOnly),
+		Interval:   window.interval,
+		Total:      len(generations),
+		Blueprints: blueprints,
+	})
+}
+
+// OptionStats returns the values every option was generated with, the most chosen
+// first, for the generations of one blueprint when the query names it
+func (h *StatsHandler) OptionStats(c *gin.Context) {
+	window, ok := h.window(c)
+	if !ok {
+		return
+	}
+	blueprint := c.Query("blueprint")
+
+	total := 0
+	byOption := make(map[string]map[string][]history.Generation)
+	for _, generation := range h.history.Since(window.since()) {
+		if blueprint != "" && generation.Blueprint != blueprint {
+			continue
+		}
+		total++
+		for name, value := range generation.Options {
+			if byOption[name] == nil {
+				byOption[name] = make(map[string][]history.Generation)
+			}
+			byOption[name][value] = append(byOption[name][value], generation)
+		}
+	}
+
+	options := make([]models.OptionStats, 0, len(byOption))
+	for _, name := range slices.Sorted(maps.Keys(byOption)) {
+		option := models.OptionStats{Name: name}
+		for value, made := range byOption[name] {
+			option.Values = append(option.Values, models.OptionValueStats{Value: value, Count: len(made), Series: window.series(made)})
+		}
+		sort.Slice(option.Values, func(i, j int) bool {
+			if option.Values[i].Count != option.Values[j].Count {
+				return option.Values[i].Count > option.Values[j].Count
+			}
+			return option.Values[i].Value < option.Values[j].Value
+		})
+		options = append(options, option)
+	}
+
+	c.JSON(http.StatusOK, models.OptionStatsResponse{
+		Since:     window.since().Format(time.DateOnly),
+		Interval:  window.interval,
+		Blueprint: blueprint,
+		Total:     total,
+		Options:   options,
+	})
+}
+
+// generationRecord returns the history record of a generation with the options of
+// its blueprint. Only the values an option offers as choices, and those of its
+// switches, are kept: the names, paths and free text of a configuration could tell
+// who generated it.
+func generationRecord(blueprint string, options []generator.Option, config models.ProjectConfig, failed bool) history.Generation {
+	values := map[string]string{
+		"Framework": config.Framework,
+		"Logger":    config.Logger,
+	}
+	if config.Database != nil {
+		values["DatabaseDriver"] = config.Database.Driver
+		values["DatabaseORM"] = config.Database.ORM
+	}
+	if config.Auth != nil {
+		values["AuthType"] = config.Auth.Type
+	}
+	for name, value := range config.Variables {
+		values[name] = value
+	}
+
+	record := history.Generation{Blueprint: blueprint, Failed: failed}
+	for _, option := range options {
+		value := values[option.Name]
+		if value != "" && (slices.Contains(option.Choices, value) || option.Type == "bool" && (value == "true" || value == "false")) {
+			if record.Options == nil {
+				record.Options = make(map[string]string)
+			}
+			record.Options[option.Name] = value
+		}
+	}
+	return record
+}
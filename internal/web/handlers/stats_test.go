@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/templates"
+	"github.com/francknouama/go-starter/internal/web/history"
+	"github.com/francknouama/go-starter/internal/web/models"
+	"github.com/francknouama/go-starter/internal/web/storage"
+)
+
+func TestStats(t *testing.T) {
+	registry, err := templates.NewRegistryWithFS(fstest.MapFS{
+		"stats-test/template.yaml": &fstest.MapFile{Data: []byte(`
+id: "stats-test"
+name: "stats-test"
+type: "web-api"
+variables:
+  - name: "Framework"
+    type: "string"
+    choices: ["gin", "echo"]
+  - name: "Docs"
+    type: "bool"
+  - name: "Team"
+    type: "string"
+files:
+  - source: "main.go.tmpl"
+    destination: "main.go"
+`)},
+		"stats-test/main.go.tmpl": &fstest.MapFile{Data: []byte("package main\n")},
+	})
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	today := now.Format(time.DateOnly)
+	store := history.NewStore(0)
+	require.NoError(t, store.Record(history.Generation{Blueprint: "cli-simple", Failed: true, At: now.AddDate(0, 0, -8)}))
+	require.NoError(t, store.Record(history.Generation{Blueprint: "stats-test", At: now.AddDate(0, 0, -40)}))
+
+	gin.SetMode(gin.TestMode)
+	generatorHandler := &GeneratorHandler{projects: storage.NewMemory(storage.DefaultTTL), registry: registry, history: store}
+	statsHandler := NewStatsHandler(store)
+	router := gin.New()
+	router.POST("/generate", generatorHandler.GenerateProject)
+	router.GET("/stats/blueprints", statsHandler.BlueprintStats)
+	router.GET("/stats/options", statsHandler.OptionStats)
+
+	for _, config := range []string{
+		`"framework":"gin","variables":{"Docs":"true","Team":"payments"}`,
+		`"framework":"gin"`,
+		`"framework":"secret-framework"`,
+	} {
+		body := `{"blueprint":"stats-test","config":{"project_name":"api","module_url":"github.com/acme/api","go_version":"1.22","project_type":"web-api",` + config + `}}`
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/generate", strings.NewReader(body)))
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	}
+
+	get := func(url string, response any) int {
+		t.Helper()
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, url, nil))
+		if recorder.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), response))
+		}
+		return recorder.Code
+	}
+
+	t.Run("blueprints", func(t *testing.T) {
+		var response models.BlueprintStatsResponse
+		require.Equal(t, http.StatusOK, get("/stats/blueprints?days=14&interval=week", &response))
+		since, err := time.Parse(time.DateOnly, response.Since)
+		require.NoError(t, err)
+		assert.Equal(t, time.Monday, since.Weekday(), "the window starts on the Monday of its first week")
+		assert.Equal(t, 4, response.Total)
+		require.Len(t, response.Blueprints, 2)
+
+		generated := response.Blueprints[0]
+		assert.Equal(t, "stats-test", generated.Blueprint, "the generations made before the window are not counted")
+		assert.Equal(t, 3, generated.Total)
+		assert.Zero(t, generated.Failed)
+		assert.Equal(t, 3, generated.Series[len(generated.Series)-1].Count)
+
+		failed := response.Blueprints[1]
+		assert.Equal(t, "cli-simple", failed.Blueprint)
+		assert.Equal(t, 1, failed.Failed)
+		assert.Len(t, failed.Series, len(generated.Series), "every blueprint has every period")
+	})
+
+	t.Run("options", func(t *testing.T) {
+		var response models.OptionStatsResponse
+		require.Equal(t, http.StatusOK, get("/stats/options?blueprint=stats-test&days=1", &response))
+		assert.Equal(t, 3, response.Total)
+		assert.Equal(t, []models.OptionStats{
+			{Name: "Docs", Values: []models.OptionValueStats{{Value: "true", Count: 1, Series: []models.StatsPeriod{{Start: today, Count: 1}}}}},
+			{Name: "Framework", Values: []models.OptionValueStats{{Value: "gin", Count: 2, Series: []models.StatsPeriod{{Start: today, Count: 2}}}}},
+		}, response.Options, "only values taken from the choices of an option are recorded")
+	})
+
+	t.Run("invalid queries", func(t *testing.T) {
+		for _, url := range []string{"/stats/blueprints?days=0", "/stats/blueprints?days=366", "/stats/options?interval=month"} {
+			assert.Equal(t, http.StatusBadRequest, get(url, nil), url)
+		}
+	})
+}
+
+func TestStatsWindow_PeriodStart(t *testing.T) {
+	sunday := time.Date(2026, 10, 18, 23, 30, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), statsWindow{interval: "day"}.periodStart(sunday))
+	assert.Equal(t, time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), statsWindow{interval: "week"}.periodStart(sunday))
+	assert.Equal(t, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), statsWindow{interval: "week"}.periodStart(sunday.Add(time.Hour)))
+}
@@ -36,8 +36,12 @@ func (h *WebSocketHandler) HandleGenerateWS(c *gin.Context) {
 		"message": "Connected to generation WebSocket",
 		"client_id": client.ID,
 	}
-	
-	h.hub.BroadcastToType("generate", welcomeMsg)
+
+
+	// Only the new client is welcomed, it may not be registered with the hub yet
+	if err := client.SendJSON(welcomeMsg); err != nil {
+		slog.Error("Failed to send WebSocket welcome", "error", err, "client_id", client.ID)
+	}
 }
 
 // HandlePreviewWS handles WebSocket connections for preview updates
@@ -57,6 +61,10 @@ func (h *WebSocketHandler) HandlePreviewWS(c *gin.Context) {
 		"message": "Connected to preview WebSocket",
 		"client_id": client.ID,
 	}
-	
-	h.hub.BroadcastToType("preview", welcomeMsg)
+
+
+	// Only the new client is welcomed, it may not be registered with the hub yet
+	if err := client.SendJSON(welcomeMsg); err != nil {
+		slog.Error("Failed to send WebSocket welcome", "error", err, "client_id", client.ID)
+	}
 }
\ No newline at end of file
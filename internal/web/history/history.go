@@ -0,0 +1,135 @@
+// Package history records the generations of the web server for the statistics
+// endpoints. A generation is recorded as the blueprint and the choices it was made
+// with, never the project name, module path or who asked for it, so that the
+// history only ever tells what is generated and not by whom.
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// DefaultLimit is the number of generations a store keeps when none is given
+const DefaultLimit = 100000
+
+// Generation is the record of one generation
+type Generation struct {
+	Blueprint string `json:"blueprint"`
+	// Options are the choices of the generation by option name, only those taken
+	// from a fixed set of values
+	Options map[string]string `json:"options,omitempty"`
+	Failed  bool              `json:"failed,omitempty"`
+	At      time.Time         `json:"at"`
+}
+
+// Store keeps the latest generations in memory, appending them to a JSON lines
+// file when it has one so that the history outlives restarts
+type Store struct {
+	mu          sync.RWMutex
+	generations []Generation
+	limit       int
+	file        *os.File
+}
+
+// NewStore returns a store keeping up to limit generations in memory only
+func NewStore(limit int) *Store {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	return &Store{limit: limit}
+}
+
+// Open returns a store loading and then appending to the file at path, created
+// when it does not exist yet
+func Open(path string, limit int) (*Store, error) {
+	store := NewStore(limit)
+
+	data, err := os.Open(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, fmt.Errorf("failed to open generation history: %w", err)
+	default:
+		scanner := bufio.NewScanner(data)
+		for line := 1; scanner.Scan(); line++ {
+			var generation Generation
+			if err := json.Unmarshal(scanner.Bytes(), &generation); err != nil {
+				data.Close()
+				return nil, fmt.Errorf("invalid generation history %s:%d: %w", path, line, err)
+			}
+			store.add(generation)
+		}
+		data.Close()
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read generation history: %w", err)
+		}
+	}
+
+	store.file, err = os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open generation history: %w", err)
+	}
+	return store, nil
+}
+
+// Record adds a generation to the history
+func (s *Store) Record(generation Generation) error {
+	if generation.At.IsZero() {
+		generation.At = time.Now()
+	}
+	generation.At = generation.At.UTC()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.add(generation)
+	if s.file == nil {
+		return nil
+	}
+	line, err := json.Marshal(generation)
+	if err != nil {
+		return err
+	}
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to record generation: %w", err)
+	}
+	return nil
+}
+
+// add keeps the generation, dropping the oldest once the limit is reached
+func (s *Store) add(generation Generation) {
+	if len(s.generations) >= s.limit {
+		s.generations = append(s.generations[:0], s.generations[len(s.generations)-s.limit+1:]...)
+	}
+	s.generations = append(s.generations, generation)
+}
+
+// Since returns the generations made at or after t, oldest first
+func (s *Store) Since(t time.Time) []Generation {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var generations []Generation
+	for _, generation := range s.generations {
+		if !generation.At.Before(t) {
+			generations = append(generations, generation)
+		}
+	}
+	return generations
+}
+
+// Close closes the file of the store
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
@@ -0,0 +1,37 @@
+package history
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	store, err := Open(path, 2)
+	require.NoError(t, err)
+	for i, blueprint := range []string{"cli-simple", "web-api-standard", "grpc-service"} {
+		require.NoError(t, store.Record(Generation{Blueprint: blueprint, Options: map[string]string{"Logger": "slog"}, At: start.AddDate(0, 0, i)}))
+	}
+	assert.Equal(t, []string{"web-api-standard", "grpc-service"}, blueprints(store.Since(time.Time{})), "the oldest generations are dropped past the limit")
+	require.NoError(t, store.Close())
+
+	reopened, err := Open(path, 10)
+	require.NoError(t, err)
+	defer reopened.Close()
+	assert.Equal(t, []string{"web-api-standard", "grpc-service"}, blueprints(reopened.Since(start.AddDate(0, 0, 1))), "the history outlives restarts")
+	assert.Equal(t, map[string]string{"Logger": "slog"}, reopened.Since(time.Time{})[0].Options)
+}
+
+func blueprints(generations []Generation) []string {
+	var names []string
+	for _, generation := range generations {
+		names = append(names, generation.Blueprint)
+	}
+	return names
+}
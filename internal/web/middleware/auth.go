@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/francknouama/go-starter/internal/web/auth"
+)
+
+// identityKey holds the caller authenticated by Auth in the gin context
+const identityKey = "identity"
+
+// Auth rejects the requests without valid credentials, and keeps the caller of
+// the others for the handlers to read with Identity
+func Auth(authenticator *auth.Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		identity, err := authenticator.Authenticate(c.Request.Context(), c.Request)
+		if err != nil {
+			c.Header("WWW-Authenticate", `Bearer realm="go-starter"`)
+			if errors.Is(err, auth.ErrNoCredentials) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+					"error": "Authentication required",
+					"code":  "UNAUTHENTICATED",
+				})
+				return
+			}
+			slog.Info("Rejected credentials", "path", c.Request.URL.Path, "error", err)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error": "Invalid credentials",
+				"code":  "INVALID_CREDENTIALS",
+			})
+			return
+		}
+
+		c.Set(identityKey, identity)
+		c.Next()
+	}
+}
+
+// Identity returns the caller Auth authenticated, false when the request went
+// through no authentication
+func Identity(c *gin.Context) (auth.Identity, bool) {
+	value, ok := c.Get(identityKey)
+	if !ok {
+		return auth.Identity{}, false
+	}
+	identity, ok := value.(auth.Identity)
+	return identity, ok
+}
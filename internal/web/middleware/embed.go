@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EmbedPrefix is the path prefix of the embeddable widget and its JSON API
+const EmbedPrefix = "/embed"
+
+// IsEmbedPath reports whether path belongs to the embed mode
+func IsEmbedPath(path string) bool {
+	return path == EmbedPrefix || strings.HasPrefix(path, EmbedPrefix+"/")
+}
+
+// Embed serves the requests of the embed mode to the given origins, "*" for any.
+// Unlike the web UI, embedding portals never send credentials: CORS is answered
+// without Access-Control-Allow-Credentials and cookies are dropped from requests,
+// so a portal cannot act on behalf of a user signed in to the generator.
+// Requests outside EmbedPrefix are passed on untouched.
+func Embed(origins []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !IsEmbedPath(c.Request.URL.Path) {
+			c.Next()
+			return
+		}
+
+		c.Request.Header.Del("Cookie")
+		c.Header("Cache-Control", "no-store")
+		c.Header("Vary", "Origin")
+
+		origin := c.GetHeader("Origin")
+		if origin != "" {
+			if !OriginAllowed(origins, origin) {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+					"error": "Origin not allowed to embed the generator",
+					"code":  "ORIGIN_NOT_ALLOWED",
+				})
+				return
+			}
+			c.Header("Access-Control-Allow-Origin", origin)
+		}
+
+		if c.Request.Method == http.MethodOptions {
+			c.Header("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+			c.Header("Access-Control-Allow-Headers", "Content-Type")
+			c.Header("Access-Control-Max-Age", "43200")
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+		c.Next()
+	}
+}
+
+// OriginAllowed reports whether origin is one of origins, or origins has "*"
+func OriginAllowed(origins []string, origin string) bool {
+	for _, allowed := range origins {
+		if allowed == "*" || strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// SkipEmbed runs handler for every request outside the embed mode, so that the
+// middleware of the web UI, such as its CORS policy, leaves the embed mode alone
+func SkipEmbed(handler gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if IsEmbedPath(c.Request.URL.Path) {
+			c.Next()
+			return
+		}
+		handler(c)
+	}
+}
@@ -1,5 +1,10 @@
 package models
 
+import (
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/pkg/types"
+)
+
 // Blueprint represents a project template
 type Blueprint struct {
 	ID           string   `json:"id"`
@@ -11,6 +16,10 @@ type Blueprint struct {
 	FileCount    int      `json:"file_count"`
 	Dependencies []string `json:"dependencies,omitempty"`
 	Features     []string `json:"features,omitempty"`
+	// Deprecation is set when the blueprint is deprecated
+	Deprecation *types.Deprecation `json:"deprecation,omitempty"`
+	// Experimental names the feature flag the blueprint is gated behind
+	Experimental string `json:"experimental,omitempty"`
 }
 
 // BlueprintFile represents a file in a blueprint
@@ -30,4 +39,18 @@ type BlueprintDetailResponse struct {
 	Blueprint Blueprint              `json:"blueprint"`
 	Files     []BlueprintFile        `json:"files"`
 	Variables map[string]interface{} `json:"variables"`
-}
\ No newline at end of file
+}
+
+// BlueprintOptionsResponse is the response for getting the options of a blueprint.
+// Version changes whenever the blueprints are reloaded, so forms know to fetch again
+type BlueprintOptionsResponse struct {
+	Blueprint string             `json:"blueprint"`
+	Version   uint64             `json:"version"`
+	Options   []generator.Option `json:"options"`
+}
+
+// BlueprintReloadResponse is the response for reloading blueprints
+type BlueprintReloadResponse struct {
+	Blueprints int    `json:"blueprints"`
+	Version    uint64 `json:"version"`
+}
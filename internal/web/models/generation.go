@@ -1,7 +1,8 @@
 package models
 
 import (
-	"time"
+	"github.com/francknouama/go-starter/internal/naming"
+	"github.com/francknouama/go-starter/pkg/types"
 )
 
 // ProjectConfig represents the web UI project configuration
@@ -17,6 +18,9 @@ type ProjectConfig struct {
 	Auth         *AuthConfig       `json:"authentication,omitempty"`
 	Deployment   *DeploymentConfig `json:"deployment,omitempty"`
 	Features     *FeaturesConfig   `json:"features,omitempty"`
+	Experimental []string          `json:"experimental,omitempty"`
+	// Variables sets the blueprint options by name, as listed by the options endpoint
+	Variables map[string]string `json:"variables,omitempty"`
 }
 
 type DatabaseConfig struct {
@@ -45,11 +49,14 @@ type FeaturesConfig struct {
 
 type ValidateConfigRequest struct {
 	Config ProjectConfig `json:"config" binding:"required"`
+	// Blueprint, when given, adds warnings for deprecated blueprints and options
+	Blueprint string `json:"blueprint,omitempty"`
 }
 
 type ValidateConfigResponse struct {
 	Valid  bool              `json:"valid"`
 	Errors []ValidationError `json:"errors,omitempty"`
+	Names  *naming.Names     `json:"names,omitempty"`
 }
 
 type ValidationError struct {
@@ -58,10 +65,30 @@ type ValidationError struct {
 	Severity string `json:"severity"` // "error" or "warning"
 }
 
+// SpecResponse is the configuration a spec link selects, to pre-fill the form
+type SpecResponse struct {
+	Config ProjectConfig `json:"config"`
+}
+
+// CommandRequest asks for the go-starter new command line of a configuration
+type CommandRequest struct {
+	Config ProjectConfig `json:"config" binding:"required"`
+}
+
+// CommandResponse is the command line of a configuration and the link sharing it
+type CommandResponse struct {
+	Command string `json:"command"`
+	URL     string `json:"url"`
+	// Omitted lists the blueprint variables that have no flag on the command line
+	Omitted []string `json:"omitted,omitempty"`
+}
+
 type GenerateProjectRequest struct {
-	Blueprint string        `json:"blueprint" binding:"required"`
-	Config    ProjectConfig `json:"config" binding:"required"`
+	Blueprint string            `json:"blueprint" binding:"required"`
+	Config    ProjectConfig     `json:"config" binding:"required"`
 	Options   GenerationOptions `json:"options"`
+	// ClientID is the /ws/generate client, as welcomed, sent the events of the generation
+	ClientID string `json:"client_id,omitempty"`
 }
 
 type GenerationOptions struct {
@@ -70,13 +97,23 @@ type GenerationOptions struct {
 }
 
 type GenerateProjectResponse struct {
-	ID             string                `json:"id"`
-	Status         string                `json:"status"`
-	FilesGenerated int                   `json:"files_generated"`
-	GenerationTime string                `json:"generation_time"`
-	DownloadURL    string                `json:"download_url"`
-	ExpiresAt      string                `json:"expires_at"`
-	Files          []GeneratedFileInfo   `json:"files"`
+	ID             string                    `json:"id"`
+	Status         string                    `json:"status"`
+	FilesGenerated int                       `json:"files_generated"`
+	GenerationTime string                    `json:"generation_time"`
+	DownloadURL    string                    `json:"download_url"`
+	ExpiresAt      string                    `json:"expires_at"`
+	Files          []GeneratedFileInfo       `json:"files"`
+	Deprecations   []types.DeprecationNotice `json:"deprecations,omitempty"`
+}
+
+// RenderErrorReport locates the template of the blueprint that failed to render
+type RenderErrorReport struct {
+	File       string `json:"file"`
+	Line       int    `json:"line,omitempty"`
+	Variable   string `json:"variable,omitempty"`
+	Message    string `json:"message"`
+	Suggestion string `json:"suggestion,omitempty"`
 }
 
 type GeneratedFileInfo struct {
@@ -85,34 +122,75 @@ type GeneratedFileInfo struct {
 	Type string `json:"type"`
 }
 
-// Internal models for storing generated projects
-
-type GeneratedFile struct {
-	Path     string `json:"path"`
-	Content  string `json:"content"`
-	Size     int    `json:"size"`
-	Type     string `json:"type"`
-	Language string `json:"language,omitempty"`
+// ProjectResponse describes a stored project, downloadable until it expires
+type ProjectResponse struct {
+	ID             string              `json:"id"`
+	Blueprint      string              `json:"blueprint"`
+	Config         ProjectConfig       `json:"config"`
+	FilesGenerated int                 `json:"files_generated"`
+	Files          []GeneratedFileInfo `json:"files"`
+	// Size is the size of the archive in bytes
+	Size           int64  `json:"size"`
+	GenerationTime string `json:"generation_time"`
+	DownloadURL    string `json:"download_url"`
+	CreatedAt      string `json:"created_at"`
+	ExpiresAt      string `json:"expires_at"`
 }
 
-type GeneratedProject struct {
-	ID             string          `json:"id"`
-	Config         ProjectConfig   `json:"config"`
-	Files          []GeneratedFile `json:"files"`
-	ZipData        []byte          `json:"-"` // Don't serialize in JSON
-	GenerationTime time.Duration   `json:"generation_time"`
-	CreatedAt      time.Time       `json:"created_at"`
-	ExpiresAt      time.Time       `json:"expires_at"`
+// ProjectListResponse lists the stored projects of the caller
+type ProjectListResponse struct {
+	Projects []ProjectResponse `json:"projects"`
 }
 
 // WebSocket message types
 
+// PreviewRequest is sent by /ws/preview clients to preview a configuration
+type PreviewRequest struct {
+	Type      string        `json:"type"` // "render", or "reset" to forget the previous render
+	Blueprint string        `json:"blueprint"`
+	Config    ProjectConfig `json:"config"`
+}
+
+// PreviewUpdate is streamed to /ws/preview clients. A render is answered with the
+// files it changes since the previous render of the client, then "complete".
 type PreviewUpdate struct {
-	Type     string `json:"type"` // "file_added", "file_updated", "error", "complete"
+	Type     string `json:"type"` // "file_added", "file_updated", "file_removed", "error", "complete"
+	Revision int    `json:"revision,omitempty"`
 	Path     string `json:"path,omitempty"`
+	// Content is set for added files, and for updated binary files and symlinks
 	Content  string `json:"content,omitempty"`
-	Error    string `json:"error,omitempty"`
-	Progress int    `json:"progress,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+	Mode     string `json:"mode,omitempty"`
+	Symlink  string `json:"symlink,omitempty"`
+	// Patch is the unified diff of an updated text file against the previous render
+	Patch   string          `json:"patch,omitempty"`
+	Summary *PreviewSummary `json:"summary,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	// Report locates the error when a template failed to render
+	Report   *RenderErrorReport `json:"report,omitempty"`
+	Progress int                `json:"progress,omitempty"`
+}
+
+// PreviewSummary closes the updates of a render
+type PreviewSummary struct {
+	// Full is set when the updates list every file instead of the changes since the
+	// previous render, such as for the first render or another blueprint
+	Full      bool   `json:"full"`
+	Added     int    `json:"added"`
+	Updated   int    `json:"updated"`
+	Removed   int    `json:"removed"`
+	Unchanged int    `json:"unchanged"`
+	Duration  string `json:"duration"`
+}
+
+// GenerationEvent is sent to the /ws/generate client a generation names
+type GenerationEvent struct {
+	Type           string             `json:"type"` // "generation_completed" or "generation_failed"
+	ID             string             `json:"id"`
+	Blueprint      string             `json:"blueprint"`
+	FilesGenerated int                `json:"files_generated,omitempty"`
+	Error          string             `json:"error,omitempty"`
+	Report         *RenderErrorReport `json:"report,omitempty"`
 }
 
 type GenerationStatus struct {
@@ -123,4 +201,4 @@ type GenerationStatus struct {
 	TotalFiles     int    `json:"total_files"`
 	CurrentFile    string `json:"current_file,omitempty"`
 	Error          string `json:"error,omitempty"`
-}
\ No newline at end of file
+}
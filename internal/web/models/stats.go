@@ -0,0 +1,46 @@
+package models
+
+// StatsPeriod is the number of generations of a period, starting at Start
+type StatsPeriod struct {
+	Start string `json:"start"`
+	Count int    `json:"count"`
+}
+
+// BlueprintStats counts the generations of a blueprint
+type BlueprintStats struct {
+	Blueprint string        `json:"blueprint"`
+	Total     int           `json:"total"`
+	Failed    int           `json:"failed"`
+	Series    []StatsPeriod `json:"series"`
+}
+
+// BlueprintStatsResponse is the response for the generations by blueprint
+type BlueprintStatsResponse struct {
+	Since      string           `json:"since"`
+	Interval   string           `json:"interval"`
+	Total      int              `json:"total"`
+	Blueprints []BlueprintStats `json:"blueprints"`
+}
+
+// OptionValueStats counts the generations made with a value of an option
+type OptionValueStats struct {
+	Value  string        `json:"value"`
+	Count  int           `json:"count"`
+	Series []StatsPeriod `json:"series"`
+}
+
+// OptionStats counts the values an option was generated with
+type OptionStats struct {
+	Name   string             `json:"name"`
+	Values []OptionValueStats `json:"values"`
+}
+
+// OptionStatsResponse is the response for the generations by option value,
+// restricted to a blueprint when Blueprint is set
+type OptionStatsResponse struct {
+	Since     string        `json:"since"`
+	Interval  string        `json:"interval"`
+	Blueprint string        `json:"blueprint,omitempty"`
+	Total     int           `json:"total"`
+	Options   []OptionStats `json:"options"`
+}
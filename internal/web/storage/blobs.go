@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// MemoryBlobs keeps the archives in memory, lost on restart
+type MemoryBlobs struct {
+	mu    sync.RWMutex
+	blobs map[string][]byte
+}
+
+// NewMemoryBlobs returns an empty in-memory backend
+func NewMemoryBlobs() *MemoryBlobs {
+	return &MemoryBlobs{blobs: make(map[string][]byte)}
+}
+
+// Put stores data at key
+func (b *MemoryBlobs) Put(_ context.Context, key string, data []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.blobs[key] = append([]byte(nil), data...)
+	return nil
+}
+
+// Get returns the data at key
+func (b *MemoryBlobs) Get(_ context.Context, key string) ([]byte, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	data, ok := b.blobs[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return data, nil
+}
+
+// Delete removes the data at key
+func (b *MemoryBlobs) Delete(_ context.Context, key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.blobs, key)
+	return nil
+}
+
+// DiskBlobs keeps the archives as files of a directory
+type DiskBlobs struct {
+	Dir string
+}
+
+// NewDiskBlobs returns a backend writing to dir, created when it does not exist
+func NewDiskBlobs(dir string) (*DiskBlobs, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create project storage: %w", err)
+	}
+	return &DiskBlobs{Dir: dir}, nil
+}
+
+// Put writes data to the file of key, replacing it at once so that a download
+// never reads half an archive
+func (b *DiskBlobs) Put(_ context.Context, key string, data []byte) error {
+	path, ok := b.path(key)
+	if !ok {
+		return fmt.Errorf("invalid archive key %q", key)
+	}
+	file, err := os.CreateTemp(b.Dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		return err
+	}
+	if err := file.Close(); err != nil {
+		_ = os.Remove(file.Name())
+		return err
+	}
+	if err := os.Rename(file.Name(), path); err != nil {
+		_ = os.Remove(file.Name())
+		return err
+	}
+	return nil
+}
+
+// Get reads the file of key
+func (b *DiskBlobs) Get(_ context.Context, key string) ([]byte, error) {
+	path, ok := b.path(key)
+	if !ok {
+		return nil, ErrNotFound
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+// Delete removes the file of key
+func (b *DiskBlobs) Delete(_ context.Context, key string) error {
+	path, ok := b.path(key)
+	if !ok {
+		return nil
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path returns the file of key, which must name a file of the directory: keys
+// come from request paths
+func (b *DiskBlobs) path(key string) (string, bool) {
+	if key == "" || strings.HasPrefix(key, ".") || strings.ContainsAny(key, `/\`) {
+		return "", false
+	}
+	return filepath.Join(b.Dir, key), true
+}
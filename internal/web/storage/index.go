@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryIndex keeps the descriptions of the projects in memory, lost on restart
+type MemoryIndex struct {
+	mu       sync.RWMutex
+	projects map[string]Project
+}
+
+// NewMemoryIndex returns an empty in-memory index
+func NewMemoryIndex() *MemoryIndex {
+	return &MemoryIndex{projects: make(map[string]Project)}
+}
+
+// Save stores the description of a project, replacing any of the same ID
+func (i *MemoryIndex) Save(_ context.Context, project Project) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.projects[project.ID] = project
+	return nil
+}
+
+// Get returns the description of a project
+func (i *MemoryIndex) Get(_ context.Context, id string) (Project, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	project, ok := i.projects[id]
+	if !ok {
+		return Project{}, ErrNotFound
+	}
+	return project, nil
+}
+
+// Delete removes the description of a project
+func (i *MemoryIndex) Delete(_ context.Context, id string) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	delete(i.projects, id)
+	return nil
+}
+
+// List returns the projects of owner, the latest first
+func (i *MemoryIndex) List(_ context.Context, owner string) ([]Project, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	var projects []Project
+	for _, project := range i.projects {
+		if project.Owner == owner {
+			projects = append(projects, project)
+		}
+	}
+	sort.Slice(projects, func(a, b int) bool {
+		if !projects[a].CreatedAt.Equal(projects[b].CreatedAt) {
+			return projects[a].CreatedAt.After(projects[b].CreatedAt)
+		}
+		return projects[a].ID < projects[b].ID
+	})
+	return projects, nil
+}
+
+// Expired lists the IDs of the projects that expired at now, sorted
+func (i *MemoryIndex) Expired(_ context.Context, now time.Time) ([]string, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	var ids []string
+	for id, project := range i.projects {
+		if !now.Before(project.ExpiresAt) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// Close does nothing, there is nothing to release
+func (i *MemoryIndex) Close() error {
+	return nil
+}
@@ -0,0 +1,296 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Environment variables of the GCS HMAC key the gs:// storage signs with; the
+// s3:// storage reads the usual AWS_ variables
+const (
+	GCSAccessKeyEnvVar = "GCS_HMAC_ACCESS_KEY_ID"
+	GCSSecretEnvVar    = "GCS_HMAC_SECRET"
+)
+
+// gcsEndpoint is the S3 compatible XML API of Google Cloud Storage
+const gcsEndpoint = "https://storage.googleapis.com"
+
+// RequestTimeout bounds a request to the object storage
+const RequestTimeout = 30 * time.Second
+
+// S3Config locates a bucket of an S3 compatible object storage and the
+// credentials to sign its requests with
+type S3Config struct {
+	Bucket string
+	// Prefix is prepended to the keys, such as projects/
+	Prefix string
+	Region string
+	// Endpoint is the base URL of a storage other than AWS S3, such as GCS or MinIO,
+	// addressed path-style
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// S3Blobs keeps the archives as objects of a bucket of AWS S3, or of another
+// storage with the S3 API such as GCS or MinIO, signing its requests with AWS
+// Signature Version 4
+type S3Blobs struct {
+	config S3Config
+	// base is the URL of the bucket
+	base   string
+	client *http.Client
+	// now is the clock of the signatures, replaced by tests
+	now func() time.Time
+}
+
+// NewS3 returns a backend storing objects in the bucket of config
+func NewS3(config S3Config) (*S3Blobs, error) {
+	if config.Bucket == "" {
+		return nil, fmt.Errorf("the project storage needs a bucket")
+	}
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("the project storage of bucket %s needs credentials", config.Bucket)
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+	if config.Prefix != "" && !strings.HasSuffix(config.Prefix, "/") {
+		config.Prefix += "/"
+	}
+
+	base := "https://" + config.Bucket + ".s3." + config.Region + ".amazonaws.com"
+	if config.Endpoint != "" {
+		endpoint, err := url.Parse(config.Endpoint)
+		if err != nil || (endpoint.Scheme != "https" && endpoint.Scheme != "http") || endpoint.Host == "" {
+			return nil, fmt.Errorf("invalid object storage endpoint %q, expected an http or https URL", config.Endpoint)
+		}
+		base = strings.TrimSuffix(config.Endpoint, "/") + "/" + uriEncode(config.Bucket, false)
+	}
+	return &S3Blobs{config: config, base: base, client: &http.Client{Timeout: RequestTimeout}, now: time.Now}, nil
+}
+
+// NewS3FromEnv returns the backend of an s3://bucket/prefix location, with the
+// region, endpoint and credentials of the AWS_ environment variables
+func NewS3FromEnv(location string) (*S3Blobs, error) {
+	bucket, prefix, err := parseBucketURL(location, "s3")
+	if err != nil {
+		return nil, err
+	}
+	config := S3Config{
+		Bucket:          bucket,
+		Prefix:          prefix,
+		Region:          firstEnv("AWS_REGION", "AWS_DEFAULT_REGION"),
+		Endpoint:        firstEnv("AWS_ENDPOINT_URL_S3", "AWS_ENDPOINT_URL"),
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("the project storage %s needs AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY", location)
+	}
+	return NewS3(config)
+}
+
+// NewGCSFromEnv returns the backend of a gs://bucket/prefix location, signing
+// with the GCS HMAC key of the GCS_HMAC_ environment variables
+func NewGCSFromEnv(location string) (*S3Blobs, error) {
+	bucket, prefix, err := parseBucketURL(location, "gs")
+	if err != nil {
+		return nil, err
+	}
+	config := S3Config{
+		Bucket:          bucket,
+		Prefix:          prefix,
+		Region:          "auto",
+		Endpoint:        gcsEndpoint,
+		AccessKeyID:     os.Getenv(GCSAccessKeyEnvVar),
+		SecretAccessKey: os.Getenv(GCSSecretEnvVar),
+	}
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("the project storage %s needs %s and %s", location, GCSAccessKeyEnvVar, GCSSecretEnvVar)
+	}
+	return NewS3(config)
+}
+
+// Put uploads data as the object of key
+func (b *S3Blobs) Put(ctx context.Context, key string, data []byte) error {
+	response, err := b.do(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+	return b.check(response, http.MethodPut, key)
+}
+
+// Get downloads the object of key
+func (b *S3Blobs) Get(ctx context.Context, key string) ([]byte, error) {
+	response, err := b.do(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = response.Body.Close() }()
+	if response.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if err := b.check(response, http.MethodGet, key); err != nil {
+		return nil, err
+	}
+	return io.ReadAll(response.Body)
+}
+
+// Delete removes the object of key
+func (b *S3Blobs) Delete(ctx context.Context, key string) error {
+	response, err := b.do(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+	if response.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return b.check(response, http.MethodDelete, key)
+}
+
+// do sends a signed request for the object of key
+func (b *S3Blobs) do(ctx context.Context, method, key string, body []byte) (*http.Response, error) {
+	target, err := url.Parse(b.base + "/" + uriEncode(b.config.Prefix+key, false))
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequestWithContext(ctx, method, target.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/zip")
+	}
+	sum := sha256.Sum256(body)
+	signV4(request, hex.EncodeToString(sum[:]), b.config, b.now())
+	return b.client.Do(request)
+}
+
+// check returns an error unless the storage answered with a 2xx status
+func (b *S3Blobs) check(response *http.Response, method, key string) error {
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return nil
+	}
+	detail, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+	return fmt.Errorf("object storage answered %s to %s %s: %s", response.Status, method, key, strings.TrimSpace(string(detail)))
+}
+
+// signV4 signs request with AWS Signature Version 4 for the S3 service, over the
+// host and every header the request sets
+func signV4(request *http.Request, payloadHash string, config S3Config, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if config.SessionToken != "" {
+		request.Header.Set("X-Amz-Security-Token", config.SessionToken)
+	}
+
+	headers := map[string]string{"host": request.URL.Host}
+	for name, values := range request.Header {
+		headers[strings.ToLower(name)] = strings.Join(values, ",")
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		uriEncode(request.URL.Path, false),
+		canonicalQuery(request.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := date + "/" + config.Region + "/s3/aws4_request"
+	hashed := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(hashed[:])
+
+	key := hmacSHA256([]byte("AWS4"+config.SecretAccessKey), date)
+	for _, part := range []string{config.Region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s,SignedHeaders=%s,Signature=%s",
+		config.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// canonicalQuery sorts and encodes the query parameters as signatures expect
+func canonicalQuery(query url.Values) string {
+	pairs := make([]string, 0, len(query))
+	for name, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, uriEncode(name, true)+"="+uriEncode(value, true))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+// uriEncode percent-encodes every byte but the unreserved characters, and the
+// slashes of a path unless encodeSlash is set
+func uriEncode(value string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '.', c == '_', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// parseBucketURL splits a scheme://bucket/prefix location
+func parseBucketURL(location, scheme string) (bucket, prefix string, err error) {
+	parsed, err := url.Parse(location)
+	if err != nil || parsed.Scheme != scheme || parsed.Host == "" {
+		return "", "", fmt.Errorf("invalid project storage %q, expected %s://bucket/prefix", location, scheme)
+	}
+	return parsed.Host, strings.Trim(parsed.Path, "/"), nil
+}
+
+// firstEnv returns the first of the environment variables that is set
+func firstEnv(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
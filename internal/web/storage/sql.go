@@ -0,0 +1,203 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	// Drivers of the SQL index
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// sqlSchema creates the table of the SQL index. Times are Unix milliseconds, which
+// SQLite and Postgres compare alike.
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS projects (
+		id TEXT PRIMARY KEY,
+		owner TEXT NOT NULL DEFAULT '',
+		blueprint TEXT NOT NULL,
+		config TEXT NOT NULL,
+		files TEXT NOT NULL,
+		size BIGINT NOT NULL,
+		generation_ms BIGINT NOT NULL,
+		created_at BIGINT NOT NULL,
+		expires_at BIGINT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS projects_expires_at ON projects (expires_at)`,
+}
+
+// sqlOwnerIndex indexes the projects by owner, created once the owner column exists
+const sqlOwnerIndex = `CREATE INDEX IF NOT EXISTS projects_owner ON projects (owner, created_at)`
+
+// sqlColumns are the columns of a project, in the order scanProject reads them
+const sqlColumns = `id, owner, blueprint, config, files, size, generation_ms, created_at, expires_at`
+
+// SQLIndex keeps the descriptions of the projects in a SQLite or Postgres database
+type SQLIndex struct {
+	db     *sql.DB
+	driver string
+}
+
+// OpenSQLIndex opens the database of the driver, sqlite3 or postgres, at
+// dataSource and creates its table when missing
+func OpenSQLIndex(ctx context.Context, driver, dataSource string) (*SQLIndex, error) {
+	db, err := sql.Open(driver, dataSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open project database: %w", err)
+	}
+	if driver == "sqlite3" {
+		// SQLite writes one at a time, sharing a connection avoids busy errors
+		db.SetMaxOpenConns(1)
+	}
+
+	index := &SQLIndex{db: db, driver: driver}
+	for _, statement := range sqlSchema {
+		if _, err := db.ExecContext(ctx, statement); err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("failed to create project database: %w", err)
+		}
+	}
+	if err := index.migrate(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to migrate project database: %w", err)
+	}
+	return index, nil
+}
+
+// migrate adds the owner column to the databases created before projects had
+// owners, the projects they hold are left without one
+func (i *SQLIndex) migrate(ctx context.Context) error {
+	rows, err := i.db.QueryContext(ctx, `SELECT owner FROM projects WHERE 1 = 0`)
+	if err != nil {
+		if _, err := i.db.ExecContext(ctx, `ALTER TABLE projects ADD COLUMN owner TEXT NOT NULL DEFAULT ''`); err != nil {
+			return err
+		}
+	} else {
+		_ = rows.Close()
+	}
+	_, err = i.db.ExecContext(ctx, sqlOwnerIndex)
+	return err
+}
+
+// Save stores the description of a project, replacing any of the same ID
+func (i *SQLIndex) Save(ctx context.Context, project Project) error {
+	config, err := json.Marshal(project.Config)
+	if err != nil {
+		return err
+	}
+	files, err := json.Marshal(project.Files)
+	if err != nil {
+		return err
+	}
+	_, err = i.db.ExecContext(ctx, i.rebind(`INSERT INTO projects (`+sqlColumns+`)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET owner = excluded.owner, blueprint = excluded.blueprint, config = excluded.config, files = excluded.files,
+			size = excluded.size, generation_ms = excluded.generation_ms, created_at = excluded.created_at, expires_at = excluded.expires_at`),
+		project.ID, project.Owner, project.Blueprint, string(config), string(files), project.Size,
+		project.GenerationTime.Milliseconds(), project.CreatedAt.UnixMilli(), project.ExpiresAt.UnixMilli())
+	return err
+}
+
+// Get returns the description of a project
+func (i *SQLIndex) Get(ctx context.Context, id string) (Project, error) {
+	project, err := scanProject(i.db.QueryRowContext(ctx, i.rebind(`SELECT `+sqlColumns+` FROM projects WHERE id = ?`), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return Project{}, ErrNotFound
+	}
+	return project, err
+}
+
+// List returns the projects of owner, the latest first
+func (i *SQLIndex) List(ctx context.Context, owner string) ([]Project, error) {
+	rows, err := i.db.QueryContext(ctx, i.rebind(`SELECT `+sqlColumns+` FROM projects WHERE owner = ? ORDER BY created_at DESC, id`), owner)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var projects []Project
+	for rows.Next() {
+		project, err := scanProject(rows)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+	return projects, rows.Err()
+}
+
+// scanProject reads a project from the sqlColumns of a row
+func scanProject(row interface{ Scan(dest ...any) error }) (Project, error) {
+	var (
+		project                          Project
+		config, files                    string
+		generationMS, created, expiresAt int64
+	)
+	if err := row.Scan(&project.ID, &project.Owner, &project.Blueprint, &config, &files, &project.Size, &generationMS, &created, &expiresAt); err != nil {
+		return Project{}, err
+	}
+	if err := json.Unmarshal([]byte(config), &project.Config); err != nil {
+		return Project{}, fmt.Errorf("invalid configuration of project %s: %w", project.ID, err)
+	}
+	if err := json.Unmarshal([]byte(files), &project.Files); err != nil {
+		return Project{}, fmt.Errorf("invalid files of project %s: %w", project.ID, err)
+	}
+	project.GenerationTime = time.Duration(generationMS) * time.Millisecond
+	project.CreatedAt = time.UnixMilli(created).UTC()
+	project.ExpiresAt = time.UnixMilli(expiresAt).UTC()
+	return project, nil
+}
+
+// Delete removes the description of a project
+func (i *SQLIndex) Delete(ctx context.Context, id string) error {
+	_, err := i.db.ExecContext(ctx, i.rebind(`DELETE FROM projects WHERE id = ?`), id)
+	return err
+}
+
+// Expired lists the IDs of the projects that expired at now, sorted
+func (i *SQLIndex) Expired(ctx context.Context, now time.Time) ([]string, error) {
+	rows, err := i.db.QueryContext(ctx, i.rebind(`SELECT id FROM projects WHERE expires_at <= ? ORDER BY id`), now.UnixMilli())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// Close closes the database
+func (i *SQLIndex) Close() error {
+	return i.db.Close()
+}
+
+// rebind turns the ? placeholders of query into the $n ones of Postgres
+func (i *SQLIndex) rebind(query string) string {
+	if i.driver != "postgres" {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r != '?' {
+			b.WriteRune(r)
+			continue
+		}
+		n++
+		b.WriteString("$" + strconv.Itoa(n))
+	}
+	return b.String()
+}
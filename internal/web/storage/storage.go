@@ -0,0 +1,272 @@
+// Package storage keeps the projects generated by the web server, so that they
+// can be downloaded again by ID until they expire. The archive of a project goes
+// to a Blobs backend, in memory, on disk or in an S3 or GCS bucket, and what it
+// was generated from to an Index, in memory or in a SQLite or Postgres database.
+// With a persistent backend and index, downloads outlive restarts.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/francknouama/go-starter/internal/web/models"
+)
+
+// DefaultTTL is how long a project can be downloaded when no TTL is given
+const DefaultTTL = 24 * time.Hour
+
+// SweepInterval is how often Run removes the expired projects
+const SweepInterval = time.Hour
+
+var (
+	// ErrNotFound is returned for a project that was never stored, or was removed
+	ErrNotFound = errors.New("project not found")
+	// ErrExpired is returned for a project past its expiry, which is removed
+	ErrExpired = errors.New("project expired")
+)
+
+// Project describes a stored project, without its archive
+type Project struct {
+	ID string `json:"id"`
+	// Owner is the subject of the caller who generated the project, empty when
+	// authentication is off
+	Owner     string                     `json:"owner,omitempty"`
+	Blueprint string                     `json:"blueprint"`
+	Config    models.ProjectConfig       `json:"config"`
+	Files     []models.GeneratedFileInfo `json:"files"`
+	// Size is the size of the archive in bytes
+	Size           int64         `json:"size"`
+	GenerationTime time.Duration `json:"generation_time"`
+	CreatedAt      time.Time     `json:"created_at"`
+	ExpiresAt      time.Time     `json:"expires_at"`
+}
+
+// Blobs stores the archives of the projects by key
+type Blobs interface {
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns ErrNotFound for a key that holds nothing
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete succeeds for a key that holds nothing
+	Delete(ctx context.Context, key string) error
+}
+
+// Index stores the descriptions of the projects by ID
+type Index interface {
+	Save(ctx context.Context, project Project) error
+	// Get returns ErrNotFound for an ID that was never saved, or was deleted
+	Get(ctx context.Context, id string) (Project, error)
+	// Delete succeeds for an ID that was never saved
+	Delete(ctx context.Context, id string) error
+	// List returns the projects of owner, the latest first
+	List(ctx context.Context, owner string) ([]Project, error)
+	// Expired lists the IDs of the projects that expired at now
+	Expired(ctx context.Context, now time.Time) ([]string, error)
+	Close() error
+}
+
+// Store keeps projects, their archives in a Blobs backend and their descriptions
+// in an Index, for a TTL
+type Store struct {
+	index Index
+	blobs Blobs
+	ttl   time.Duration
+	// now is the clock of the expiries, replaced by tests
+	now func() time.Time
+}
+
+// New returns a store of index and blobs keeping projects for ttl, DefaultTTL
+// when it is not positive
+func New(index Index, blobs Blobs, ttl time.Duration) *Store {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Store{index: index, blobs: blobs, ttl: ttl, now: time.Now}
+}
+
+// NewMemory returns a store keeping projects in memory only, lost on restart
+func NewMemory(ttl time.Duration) *Store {
+	return New(NewMemoryIndex(), NewMemoryBlobs(), ttl)
+}
+
+// Open returns the store the flags of the web server select: the archives go to
+// a directory, an s3://bucket/prefix or a gs://bucket/prefix, and the index to a
+// SQLite database file or a postgres:// URL. Either is kept in memory when empty.
+func Open(ctx context.Context, blobsLocation, indexLocation string, ttl time.Duration) (*Store, error) {
+	blobs, err := OpenBlobs(blobsLocation)
+	if err != nil {
+		return nil, err
+	}
+	index, err := OpenIndex(ctx, indexLocation)
+	if err != nil {
+		return nil, err
+	}
+	return New(index, blobs, ttl), nil
+}
+
+// OpenBlobs returns the Blobs backend at location: in memory when empty, a
+// bucket for s3:// and gs:// URLs and a directory otherwise
+func OpenBlobs(location string) (Blobs, error) {
+	switch {
+	case location == "":
+		return NewMemoryBlobs(), nil
+	case strings.HasPrefix(location, "s3://"):
+		return NewS3FromEnv(location)
+	case strings.HasPrefix(location, "gs://"):
+		return NewGCSFromEnv(location)
+	case strings.Contains(location, "://"):
+		return nil, fmt.Errorf("unsupported project storage %q, expected a directory, an s3:// or a gs:// URL", location)
+	}
+	return NewDiskBlobs(location)
+}
+
+// OpenIndex returns the Index at location: in memory when empty, Postgres for
+// postgres:// URLs and a SQLite database file otherwise
+func OpenIndex(ctx context.Context, location string) (Index, error) {
+	switch {
+	case location == "":
+		return NewMemoryIndex(), nil
+	case strings.HasPrefix(location, "postgres://"), strings.HasPrefix(location, "postgresql://"):
+		return OpenSQLIndex(ctx, "postgres", location)
+	case strings.Contains(location, "://"):
+		return nil, fmt.Errorf("unsupported project database %q, expected a SQLite file or a postgres:// URL", location)
+	}
+	return OpenSQLIndex(ctx, "sqlite3", location)
+}
+
+// TTL returns how long the store keeps a project
+func (s *Store) TTL() time.Duration {
+	return s.ttl
+}
+
+// Save stores a project and its archive, dating it now unless it is dated already
+func (s *Store) Save(ctx context.Context, project Project, archive []byte) (Project, error) {
+	if project.CreatedAt.IsZero() {
+		project.CreatedAt = s.now()
+	}
+	if project.ExpiresAt.IsZero() {
+		project.ExpiresAt = project.CreatedAt.Add(s.ttl)
+	}
+	// Times are kept to the millisecond, as the SQL index stores them
+	project.CreatedAt = project.CreatedAt.UTC().Truncate(time.Millisecond)
+	project.ExpiresAt = project.ExpiresAt.UTC().Truncate(time.Millisecond)
+	project.Size = int64(len(archive))
+
+	if err := s.blobs.Put(ctx, archiveKey(project.ID), archive); err != nil {
+		return Project{}, fmt.Errorf("failed to store project archive: %w", err)
+	}
+	if err := s.index.Save(ctx, project); err != nil {
+		// Leave no archive that nothing points to
+		_ = s.blobs.Delete(ctx, archiveKey(project.ID))
+		return Project{}, fmt.Errorf("failed to index project: %w", err)
+	}
+	return project, nil
+}
+
+// Get returns the description of a project, ErrExpired once it expired
+func (s *Store) Get(ctx context.Context, id string) (Project, error) {
+	project, err := s.index.Get(ctx, id)
+	if err != nil {
+		return Project{}, err
+	}
+	if !s.now().Before(project.ExpiresAt) {
+		if err := s.Delete(ctx, id); err != nil {
+			slog.Warn("Failed to remove expired project", "id", id, "error", err)
+		}
+		return Project{}, ErrExpired
+	}
+	return project, nil
+}
+
+// Archive returns a project and its archive, ErrExpired once it expired
+func (s *Store) Archive(ctx context.Context, id string) (Project, []byte, error) {
+	project, err := s.Get(ctx, id)
+	if err != nil {
+		return Project{}, nil, err
+	}
+	archive, err := s.blobs.Get(ctx, archiveKey(id))
+	if errors.Is(err, ErrNotFound) {
+		// The archive is gone, such as from a memory backend after a restart
+		_ = s.index.Delete(ctx, id)
+		return Project{}, nil, ErrNotFound
+	}
+	if err != nil {
+		return Project{}, nil, fmt.Errorf("failed to read project archive: %w", err)
+	}
+	return project, archive, nil
+}
+
+// List returns the projects of owner that have not expired, the latest first
+func (s *Store) List(ctx context.Context, owner string) ([]Project, error) {
+	projects, err := s.index.List(ctx, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	now := s.now()
+	current := projects[:0]
+	for _, project := range projects {
+		if now.Before(project.ExpiresAt) {
+			current = append(current, project)
+		}
+	}
+	return current, nil
+}
+
+// Delete removes a project and its archive, and succeeds for an unknown project
+func (s *Store) Delete(ctx context.Context, id string) error {
+	if err := s.blobs.Delete(ctx, archiveKey(id)); err != nil {
+		return fmt.Errorf("failed to delete project archive: %w", err)
+	}
+	if err := s.index.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete project: %w", err)
+	}
+	return nil
+}
+
+// Sweep removes the expired projects, returning how many it removed
+func (s *Store) Sweep(ctx context.Context) (int, error) {
+	ids, err := s.index.Expired(ctx, s.now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired projects: %w", err)
+	}
+	for i, id := range ids {
+		if err := s.Delete(ctx, id); err != nil {
+			return i, err
+		}
+	}
+	return len(ids), nil
+}
+
+// Run sweeps the expired projects every interval until ctx is done
+func (s *Store) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			removed, err := s.Sweep(ctx)
+			if err != nil {
+				slog.Warn("Failed to remove expired projects", "error", err)
+			}
+			if removed > 0 {
+				slog.Info("Removed expired projects", "count", removed)
+			}
+		}
+	}
+}
+
+// Close closes the index of the store
+func (s *Store) Close() error {
+	return s.index.Close()
+}
+
+// archiveKey is the key of the archive of a project in the Blobs backend
+func archiveKey(id string) string {
+	return id + ".zip"
+}
@@ -0,0 +1,232 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/francknouama/go-starter/internal/web/models"
+)
+
+func testProject(id string) Project {
+	return Project{
+		ID:             id,
+		Blueprint:      "web-api-standard",
+		Config:         models.ProjectConfig{ProjectName: "orders", ModuleURL: "github.com/acme/orders", GoVersion: "1.22", ProjectType: "web-api"},
+		Files:          []models.GeneratedFileInfo{{Path: "main.go", Size: 13, Type: "go"}},
+		GenerationTime: 120 * time.Millisecond,
+	}
+}
+
+// clock is a settable time for the expiries
+type clock struct{ t time.Time }
+
+func (c *clock) now() time.Time { return c.t }
+
+func TestStore_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	at := &clock{t: time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)}
+	store := NewMemory(time.Hour)
+	store.now = at.now
+
+	saved, err := store.Save(ctx, testProject("a"), []byte("zip a"))
+	require.NoError(t, err)
+	assert.Equal(t, at.t, saved.CreatedAt)
+	assert.Equal(t, at.t.Add(time.Hour), saved.ExpiresAt)
+	assert.Equal(t, int64(5), saved.Size)
+	_, err = store.Save(ctx, testProject("b"), []byte("zip b"))
+	require.NoError(t, err)
+
+	project, archive, err := store.Archive(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, saved, project)
+	assert.Equal(t, "zip a", string(archive))
+
+	_, _, err = store.Archive(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.Delete(ctx, "b"))
+	_, err = store.Get(ctx, "b")
+	assert.ErrorIs(t, err, ErrNotFound)
+	require.NoError(t, store.Delete(ctx, "b"), "deleting twice succeeds")
+
+	at.t = at.t.Add(time.Hour)
+	_, err = store.Get(ctx, "a")
+	assert.ErrorIs(t, err, ErrExpired)
+	_, err = store.Get(ctx, "a")
+	assert.ErrorIs(t, err, ErrNotFound, "an expired project is removed once seen")
+	_, err = store.blobs.Get(ctx, archiveKey("a"))
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestStore_Sweep(t *testing.T) {
+	ctx := context.Background()
+	at := &clock{t: time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)}
+	store := NewMemory(time.Hour)
+	store.now = at.now
+
+	_, err := store.Save(ctx, testProject("old"), []byte("old"))
+	require.NoError(t, err)
+	at.t = at.t.Add(30 * time.Minute)
+	_, err = store.Save(ctx, testProject("new"), []byte("new"))
+	require.NoError(t, err)
+
+	at.t = at.t.Add(45 * time.Minute)
+	removed, err := store.Sweep(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	_, err = store.blobs.Get(ctx, archiveKey("old"))
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = store.Get(ctx, "new")
+	assert.NoError(t, err)
+}
+
+func TestStore_MissingArchive(t *testing.T) {
+	ctx := context.Background()
+	index := NewMemoryIndex()
+	store := New(index, NewMemoryBlobs(), time.Hour)
+	_, err := store.Save(ctx, testProject("a"), []byte("zip"))
+	require.NoError(t, err)
+
+	// A restart with a memory backend and a persistent index
+	store = New(index, NewMemoryBlobs(), time.Hour)
+	_, _, err = store.Archive(ctx, "a")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = index.Get(ctx, "a")
+	assert.ErrorIs(t, err, ErrNotFound, "the dangling description is dropped")
+}
+
+func TestStore_Persistent(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	blobsDir, database := filepath.Join(dir, "archives"), filepath.Join(dir, "projects.db")
+
+	store, err := Open(ctx, blobsDir, database, 0)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTTL, store.TTL())
+	saved, err := store.Save(ctx, testProject("a"), []byte("zip a"))
+	require.NoError(t, err)
+	saved.Files[0].Size = 14
+	saved, err = store.Save(ctx, saved, []byte("zip a2"))
+	require.NoError(t, err, "saving again replaces the project")
+	require.NoError(t, store.Close())
+
+	store, err = Open(ctx, blobsDir, database, 0)
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+	project, archive, err := store.Archive(ctx, "a")
+	require.NoError(t, err, "the project outlives the restart")
+	assert.Equal(t, saved, project)
+	assert.Equal(t, "zip a2", string(archive))
+
+	store.now = func() time.Time { return saved.ExpiresAt }
+	removed, err := store.Sweep(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	assert.NoFileExists(t, filepath.Join(blobsDir, "a.zip"))
+}
+
+func TestDiskBlobs_Keys(t *testing.T) {
+	ctx := context.Background()
+	blobs, err := NewDiskBlobs(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"../escape.zip", "a/b.zip", ".hidden", ""} {
+		assert.Error(t, blobs.Put(ctx, key, []byte("x")), key)
+		_, err := blobs.Get(ctx, key)
+		assert.ErrorIs(t, err, ErrNotFound, key)
+		assert.NoError(t, blobs.Delete(ctx, key), key)
+	}
+}
+
+func TestOpen_Locations(t *testing.T) {
+	ctx := context.Background()
+	_, err := OpenBlobs("ftp://archives")
+	assert.ErrorContains(t, err, "unsupported project storage")
+	_, err = OpenIndex(ctx, "mysql://localhost/projects")
+	assert.ErrorContains(t, err, "unsupported project database")
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+	_, err = OpenBlobs("s3://archives/projects")
+	assert.ErrorContains(t, err, "AWS_ACCESS_KEY_ID")
+	_, err = OpenBlobs("s3:///projects")
+	assert.ErrorContains(t, err, "expected s3://bucket/prefix")
+
+	t.Setenv(GCSAccessKeyEnvVar, "GOOG1EXAMPLE")
+	t.Setenv(GCSSecretEnvVar, "secret")
+	blobs, err := OpenBlobs("gs://archives/projects")
+	require.NoError(t, err)
+	gcs := blobs.(*S3Blobs)
+	assert.Equal(t, "https://storage.googleapis.com/archives", gcs.base)
+	assert.Equal(t, "projects/", gcs.config.Prefix)
+}
+
+func TestSQLIndex_Rebind(t *testing.T) {
+	postgres := &SQLIndex{driver: "postgres"}
+	assert.Equal(t, "SELECT id FROM projects WHERE id = $1 AND expires_at <= $2", postgres.rebind("SELECT id FROM projects WHERE id = ? AND expires_at <= ?"))
+	sqlite := &SQLIndex{driver: "sqlite3"}
+	assert.Equal(t, "DELETE FROM projects WHERE id = ?", sqlite.rebind("DELETE FROM projects WHERE id = ?"))
+}
+
+func TestStore_List(t *testing.T) {
+	ctx := context.Background()
+	index, err := OpenIndex(ctx, filepath.Join(t.TempDir(), "projects.db"))
+	require.NoError(t, err)
+	defer func() { _ = index.Close() }()
+
+	for name, store := range map[string]*Store{"memory": NewMemory(time.Hour), "sqlite": New(index, NewMemoryBlobs(), time.Hour)} {
+		t.Run(name, func(t *testing.T) {
+			at := &clock{t: time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)}
+			store.now = at.now
+			for _, id := range []string{"first", "second", "other"} {
+				project := testProject(id)
+				project.Owner = "key:alice"
+				if id == "other" {
+					project.Owner = "oidc:bob"
+				}
+				_, err := store.Save(ctx, project, []byte(id))
+				require.NoError(t, err)
+				at.t = at.t.Add(20 * time.Minute)
+			}
+
+			projects, err := store.List(ctx, "key:alice")
+			require.NoError(t, err)
+			assert.Len(t, projects, 1, "the first project expired")
+			assert.Equal(t, "second", projects[0].ID)
+			assert.Equal(t, "key:alice", projects[0].Owner)
+
+			projects, err = store.List(ctx, "")
+			require.NoError(t, err)
+			assert.Empty(t, projects)
+		})
+	}
+}
+
+func TestSQLIndex_MigratesOwner(t *testing.T) {
+	ctx := context.Background()
+	database := filepath.Join(t.TempDir(), "projects.db")
+	index, err := OpenSQLIndex(ctx, "sqlite3", database)
+	require.NoError(t, err)
+	_, err = index.db.ExecContext(ctx, `DROP TABLE projects`)
+	require.NoError(t, err)
+	// The table of the databases created before projects had owners
+	_, err = index.db.ExecContext(ctx, `CREATE TABLE projects (id TEXT PRIMARY KEY, blueprint TEXT NOT NULL, config TEXT NOT NULL,
+		files TEXT NOT NULL, size BIGINT NOT NULL, generation_ms BIGINT NOT NULL, created_at BIGINT NOT NULL, expires_at BIGINT NOT NULL)`)
+	require.NoError(t, err)
+	_, err = index.db.ExecContext(ctx, `INSERT INTO projects VALUES ('old', 'cli', '{}', '[]', 3, 0, 0, 4102444800000)`)
+	require.NoError(t, err)
+	require.NoError(t, index.Close())
+
+	index, err = OpenSQLIndex(ctx, "sqlite3", database)
+	require.NoError(t, err)
+	defer func() { _ = index.Close() }()
+	project, err := index.Get(ctx, "old")
+	require.NoError(t, err)
+	assert.Empty(t, project.Owner)
+	assert.Equal(t, "cli", project.Blueprint)
+}
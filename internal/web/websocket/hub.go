@@ -2,10 +2,14 @@ package websocket
 
 import (
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 )
 
@@ -26,10 +30,27 @@ type Client struct {
 	Type string // "generate" or "preview"
 }
 
+// sendTimeout bounds how long SendJSON waits for room in the send buffer of a client
+const sendTimeout = 10 * time.Second
+
+// MessageHandler handles the messages sent by the clients of a type
+type MessageHandler interface {
+	// HandleMessage is called from the read loop of the client, one message at a time
+	HandleMessage(client *Client, message []byte)
+	// Disconnected is called once the client is gone, to release what was kept for it
+	Disconnected(client *Client)
+}
+
 // Hub maintains active clients and broadcasts messages
 type Hub struct {
-	// Registered clients
+	// Handlers of the messages sent by clients, by client type
+	handlers map[string]MessageHandler
+
+	// Registered clients, and by ID for SendTo; mutex guards both and the send
+	// channels the hub closes
 	clients map[*Client]bool
+	byID    map[string]*Client
+	mutex   sync.RWMutex
 
 	// Inbound messages from clients
 	broadcast chan []byte
@@ -44,42 +65,84 @@ type Hub struct {
 // NewHub creates a new WebSocket hub
 func NewHub() *Hub {
 	return &Hub{
+		handlers:   make(map[string]MessageHandler),
 		clients:    make(map[*Client]bool),
+		byID:       make(map[string]*Client),
 		broadcast:  make(chan []byte),
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
 	}
 }
 
+// Handle sets the handler of the messages sent by the clients of clientType. It must
+// be called before clients connect.
+func (h *Hub) Handle(clientType string, handler MessageHandler) {
+	h.handlers[clientType] = handler
+}
+
 // Run starts the hub
 func (h *Hub) Run() {
 	for {
 		select {
 		case client := <-h.register:
+			h.mutex.Lock()
 			h.clients[client] = true
+			h.mutex.Unlock()
 			slog.Info("WebSocket client connected", "client_id", client.ID, "type", client.Type)
 
 		case client := <-h.unregister:
+			h.mutex.Lock()
 			if _, ok := h.clients[client]; ok {
-				delete(h.clients, client)
-				close(client.Send)
+				h.remove(client)
 				slog.Info("WebSocket client disconnected", "client_id", client.ID, "type", client.Type)
 			}
+			h.mutex.Unlock()
 
 		case message := <-h.broadcast:
 			// Broadcast to all clients
+			h.mutex.Lock()
 			for client := range h.clients {
 				select {
 				case client.Send <- message:
 				default:
-					close(client.Send)
-					delete(h.clients, client)
+					h.remove(client)
 				}
 			}
+			h.mutex.Unlock()
 		}
 	}
 }
 
+// remove forgets a client and closes its send channel, with the mutex held
+func (h *Hub) remove(client *Client) {
+	delete(h.clients, client)
+	delete(h.byID, client.ID)
+	close(client.Send)
+}
+
+// SendTo sends a message to the client of an ID, reporting whether it is
+// connected. The message is dropped when the send buffer of the client is full.
+func (h *Hub) SendTo(clientID string, data interface{}) bool {
+	message, err := json.Marshal(data)
+	if err != nil {
+		slog.Error("Failed to marshal WebSocket message", "error", err)
+		return false
+	}
+
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	client, ok := h.byID[clientID]
+	if !ok {
+		return false
+	}
+	select {
+	case client.Send <- message:
+	default:
+		slog.Warn("WebSocket client send buffer is full", "client_id", clientID)
+	}
+	return true
+}
+
 // BroadcastToType sends a message to all clients of a specific type
 func (h *Hub) BroadcastToType(messageType string, data interface{}) {
 	message, err := json.Marshal(data)
@@ -88,13 +151,14 @@ func (h *Hub) BroadcastToType(messageType string, data interface{}) {
 		return
 	}
 
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
 	for client := range h.clients {
 		if client.Type == messageType {
 			select {
 			case client.Send <- message:
 			default:
-				close(client.Send)
-				delete(h.clients, client)
+				h.remove(client)
 			}
 		}
 	}
@@ -133,7 +197,10 @@ func (h *Hub) UpgradeConnection(c *gin.Context, clientType string) (*Client, err
 		Type: clientType,
 	}
 
-	// Register client
+	// Register client, reachable by its ID once welcomed
+	h.mutex.Lock()
+	h.byID[clientID] = client
+	h.mutex.Unlock()
 	h.register <- client
 
 	// Start goroutines for reading and writing
@@ -143,9 +210,28 @@ func (h *Hub) UpgradeConnection(c *gin.Context, clientType string) (*Client, err
 	return client, nil
 }
 
+// SendJSON sends a message to this client only
+func (c *Client) SendJSON(data interface{}) error {
+	message, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case c.Send <- message:
+		return nil
+	case <-time.After(sendTimeout):
+		return errors.New("timed out sending to WebSocket client")
+	}
+}
+
 // readPump handles reading from the WebSocket connection
 func (c *Client) readPump() {
+	handler := c.Hub.handlers[c.Type]
 	defer func() {
+		if handler != nil {
+			handler.Disconnected(c)
+		}
 		c.Hub.unregister <- c
 		_ = c.Conn.Close()
 	}()
@@ -159,8 +245,10 @@ func (c *Client) readPump() {
 			break
 		}
 
-		// Handle incoming messages (for future interactive features)
 		slog.Debug("Received WebSocket message", "client_id", c.ID, "message", string(message))
+		if handler != nil {
+			handler.HandleMessage(c, message)
+		}
 	}
 }
 
@@ -178,18 +266,8 @@ func (c *Client) writePump() {
 	_ = c.Conn.WriteMessage(websocket.CloseMessage, []byte{})
 }
 
-// generateClientID generates a unique client identifier
+// generateClientID generates a unique client identifier, which clients name to
+// receive the events of the generations they request
 func generateClientID() string {
-	// Simple implementation - in production, use UUID or similar
-	return "client_" + randString(8)
-}
-
-// randString generates a random string of specified length
-func randString(n int) string {
-	const charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
-	b := make([]byte, n)
-	for i := range b {
-		b[i] = charset[len(charset)/2] // Simplified for this example
-	}
-	return string(b)
+	return "client_" + uuid.New().String()
 }
\ No newline at end of file
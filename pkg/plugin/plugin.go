@@ -0,0 +1,130 @@
+// Package plugin is the API of go-starter plugins written in Go.
+//
+// A plugin is a directory holding a plugin.yaml manifest and, for plugins that
+// add template functions or post-generation steps, an executable. go-starter
+// runs the executable once per call, writes a Request as JSON on its standard
+// input and reads a Response as JSON from its standard output; its standard
+// error is shown to the user. Plugins in other languages implement the same
+// exchange, Go plugins call Serve from their main function:
+//
+//	func main() {
+//		plugin.Serve(plugin.Plugin{
+//			Funcs: map[string]plugin.Func{
+//				"licenseHeader": func(args ...string) (string, error) { ... },
+//			},
+//		})
+//	}
+//
+// Go's own plugin package is not used: it needs the plugin built with the exact
+// toolchain and dependencies of go-starter, which an installed binary cannot offer.
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ProtocolVersion is the version of the exchange between go-starter and its
+// plugins, set in the GO_STARTER_PLUGIN_PROTOCOL environment variable of the
+// executable
+const ProtocolVersion = "1"
+
+// Methods of a Request
+const (
+	// MethodFunc calls a template function declared by the manifest
+	MethodFunc = "func"
+	// MethodPostGenerate runs the post-generation step of the plugin in a generated project
+	MethodPostGenerate = "post-generate"
+)
+
+// Request is a call of go-starter to a plugin
+type Request struct {
+	Method string `json:"method"`
+	// Func and Args are the template function called and its arguments, printed as strings
+	Func string   `json:"func,omitempty"`
+	Args []string `json:"args,omitempty"`
+	// Project is the generated project of post-generate
+	Project *Project `json:"project,omitempty"`
+}
+
+// Project describes a generated project to its post-generation steps
+type Project struct {
+	Name         string `json:"name"`
+	Module       string `json:"module"`
+	Type         string `json:"type"`
+	Architecture string `json:"architecture,omitempty"`
+	Framework    string `json:"framework,omitempty"`
+	GoVersion    string `json:"go_version,omitempty"`
+	// Path is the directory the project is generated in
+	Path string `json:"path"`
+	// Variables holds the blueprint variables, with the answers to the prompts of the plugins
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// Response is the answer of a plugin to a Request
+type Response struct {
+	// Result is the output of a template function
+	Result string `json:"result,omitempty"`
+	// Error fails the call with this message
+	Error string `json:"error,omitempty"`
+}
+
+// Func is a template function; blueprints call it with any arguments, which it
+// receives printed as strings
+type Func func(args ...string) (string, error)
+
+// Plugin is the implementation of the template functions and post-generation
+// step a plugin declares in its manifest
+type Plugin struct {
+	Funcs        map[string]Func
+	PostGenerate func(ctx context.Context, project Project) error
+}
+
+// Serve answers the request on the standard input of the process and exits,
+// with status 1 when the request cannot be read
+func Serve(p Plugin) {
+	if err := Handle(context.Background(), p, os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "plugin: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// Handle reads a request from r and writes the response of p to w. Errors of
+// the plugin itself are returned to go-starter in the response.
+func Handle(ctx context.Context, p Plugin, r io.Reader, w io.Writer) error {
+	var req Request
+	if err := json.NewDecoder(r).Decode(&req); err != nil {
+		return fmt.Errorf("failed to read request: %w", err)
+	}
+
+	var resp Response
+	var err error
+	switch req.Method {
+	case MethodFunc:
+		fn, ok := p.Funcs[req.Func]
+		if !ok {
+			err = fmt.Errorf("unknown template function %s", req.Func)
+			break
+		}
+		resp.Result, err = fn(req.Args...)
+	case MethodPostGenerate:
+		if p.PostGenerate == nil || req.Project == nil {
+			err = fmt.Errorf("no post-generation step")
+			break
+		}
+		err = p.PostGenerate(ctx, *req.Project)
+	default:
+		err = fmt.Errorf("unsupported method %q", req.Method)
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		return fmt.Errorf("failed to write response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// experimentNamePattern matches namespaced feature names such as "framework.fuego"
+var experimentNamePattern = regexp.MustCompile(`^[a-z][a-z0-9-]*(\.[a-z0-9][a-z0-9-]*)+$`)
+
+// Experiment declares a feature a blueprint ships dark behind --experimental=<Name>
+type Experiment struct {
+	// Name is namespaced by what it gates, e.g. architecture.cqrs or framework.fuego
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description" json:"description,omitempty"`
+	// Since is the go-starter version the experiment was introduced in
+	Since string `yaml:"since" json:"since,omitempty"`
+	// Graduated is the go-starter version the feature became generally available in.
+	// Graduated features are always enabled; their flag is still accepted.
+	Graduated string `yaml:"graduated" json:"graduated,omitempty"`
+}
+
+// ValidExperimentName reports whether name is a namespaced experimental feature name
+func ValidExperimentName(name string) bool {
+	return experimentNamePattern.MatchString(name)
+}
+
+// Experiment returns the experiment the blueprint declares under name
+func (t Template) Experiment(name string) (Experiment, bool) {
+	for _, experiment := range t.Experiments {
+		if experiment.Name == name {
+			return experiment, true
+		}
+	}
+	return Experiment{}, false
+}
+
+// ExperimentsUsed returns the experimental features a generation with the given
+// variable values needs enabled: the blueprint's own gate and those of the chosen
+// values. Graduated features are left out.
+func (t Template) ExperimentsUsed(values map[string]any) []string {
+	var used []string
+	add := func(name string) {
+		if experiment, ok := t.Experiment(name); ok && experiment.Graduated == "" && !containsString(used, name) {
+			used = append(used, name)
+		}
+	}
+
+	if t.Experimental != "" {
+		add(t.Experimental)
+	}
+	for _, variable := range t.Variables {
+		value, ok := values[variable.Name]
+		if !ok || value == nil || len(variable.ExperimentalChoices) == 0 {
+			continue
+		}
+		if name, ok := variable.ExperimentalChoices[fmt.Sprint(value)]; ok {
+			add(name)
+		}
+	}
+	return used
+}
+
+// ValidateExperiments checks that experiment names are namespaced and that the
+// blueprint and choice gates name experiments the blueprint declares
+func (t Template) ValidateExperiments() error {
+	for _, experiment := range t.Experiments {
+		if !ValidExperimentName(experiment.Name) {
+			return NewValidationError(fmt.Sprintf("blueprint %s declares experiment %q, names must be namespaced like framework.fuego", t.ID, experiment.Name), nil)
+		}
+	}
+
+	if t.Experimental != "" {
+		if _, ok := t.Experiment(t.Experimental); !ok {
+			return NewValidationError(fmt.Sprintf("blueprint %s is gated by undeclared experiment %q", t.ID, t.Experimental), nil)
+		}
+	}
+
+	for _, variable := range t.Variables {
+		choices := make([]string, 0, len(variable.ExperimentalChoices))
+		for choice := range variable.ExperimentalChoices {
+			choices = append(choices, choice)
+		}
+		sort.Strings(choices)
+
+		for _, choice := range choices {
+			if len(variable.Choices) > 0 && !containsString(variable.Choices, choice) {
+				return NewValidationError(fmt.Sprintf("variable %s gates %q, which is not one of its choices", variable.Name, choice), nil)
+			}
+			if name := variable.ExperimentalChoices[choice]; !t.hasExperiment(name) {
+				return NewValidationError(fmt.Sprintf("variable %s gates %q behind undeclared experiment %q", variable.Name, choice, name), nil)
+			}
+		}
+	}
+	return nil
+}
+
+func (t Template) hasExperiment(name string) bool {
+	_, ok := t.Experiment(name)
+	return ok
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidExperimentName(t *testing.T) {
+	valid := []string{"framework.fuego", "architecture.cqrs", "feature.outbox-v2", "database.driver.cockroach"}
+	invalid := []string{"", "outbox", "Framework.fuego", "framework.", ".fuego", "framework fuego", "framework.Fuego"}
+
+	for _, name := range valid {
+		if !ValidExperimentName(name) {
+			t.Errorf("expected %q to be valid", name)
+		}
+	}
+	for _, name := range invalid {
+		if ValidExperimentName(name) {
+			t.Errorf("expected %q to be invalid", name)
+		}
+	}
+}
+
+func TestTemplate_ExperimentsUsed(t *testing.T) {
+	tmpl := Template{
+		ID:           "web-api-cqrs",
+		Experimental: "architecture.cqrs",
+		Experiments: []Experiment{
+			{Name: "architecture.cqrs"},
+			{Name: "framework.fuego"},
+			{Name: "framework.chi", Graduated: "2.0.0"},
+		},
+		Variables: []TemplateVariable{
+			{Name: "Framework", ExperimentalChoices: map[string]string{"fuego": "framework.fuego", "chi": "framework.chi"}},
+		},
+	}
+
+	if got := tmpl.ExperimentsUsed(map[string]any{"Framework": "fuego"}); !reflect.DeepEqual(got, []string{"architecture.cqrs", "framework.fuego"}) {
+		t.Errorf("unexpected experiments %v", got)
+	}
+	if got := tmpl.ExperimentsUsed(map[string]any{"Framework": "chi"}); !reflect.DeepEqual(got, []string{"architecture.cqrs"}) {
+		t.Errorf("graduated experiments must not be reported, got %v", got)
+	}
+}
+
+func TestTemplate_ValidateExperiments(t *testing.T) {
+	tests := []struct {
+		name    string
+		tmpl    Template
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			tmpl: Template{
+				Experimental: "architecture.cqrs",
+				Experiments:  []Experiment{{Name: "architecture.cqrs"}, {Name: "framework.fuego"}},
+				Variables: []TemplateVariable{
+					{Name: "Framework", Choices: []string{"gin", "fuego"}, ExperimentalChoices: map[string]string{"fuego": "framework.fuego"}},
+				},
+			},
+		},
+		{
+			name:    "name without namespace",
+			tmpl:    Template{Experiments: []Experiment{{Name: "cqrs"}}},
+			wantErr: true,
+		},
+		{
+			name:    "undeclared blueprint gate",
+			tmpl:    Template{Experimental: "architecture.cqrs"},
+			wantErr: true,
+		},
+		{
+			name: "undeclared choice gate",
+			tmpl: Template{Variables: []TemplateVariable{
+				{Name: "Framework", ExperimentalChoices: map[string]string{"fuego": "framework.fuego"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "gated value is not a choice",
+			tmpl: Template{
+				Experiments: []Experiment{{Name: "framework.fuego"}},
+				Variables: []TemplateVariable{
+					{Name: "Framework", Choices: []string{"gin"}, ExperimentalChoices: map[string]string{"fuego": "framework.fuego"}},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tmpl.ValidateExperiments()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateExperiments() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
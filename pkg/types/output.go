@@ -0,0 +1,18 @@
+package types
+
+import "io/fs"
+
+// OutputFS is the file system a project is generated into. The generator joins
+// GenerationOptions.OutputPath with project relative paths and passes the result
+// to these methods, so implementations for remote targets receive paths in the
+// target's own namespace.
+type OutputFS interface {
+	MkdirAll(path string, perm fs.FileMode) error
+	// WriteFile creates or truncates path and sets exactly perm, regardless of umask
+	WriteFile(path string, data []byte, perm fs.FileMode) error
+	Symlink(target, link string) error
+	Remove(path string) error
+	RemoveAll(path string) error
+	Stat(path string) (fs.FileInfo, error)
+	ReadDir(path string) ([]fs.DirEntry, error)
+}
@@ -0,0 +1,51 @@
+package types
+
+import "time"
+
+// Generation phases reported through GenerationOptions.Progress
+const (
+	PhaseRender = "render"
+	PhaseWrite  = "write"
+	PhaseTidy   = "tidy"
+	PhaseHooks  = "post-hooks"
+	// PhasePreHooks is only reported for blueprints declaring pre-generation hooks
+	PhasePreHooks = "pre-hooks"
+)
+
+// Progress event types
+const (
+	ProgressPhaseStart = "phase_start"
+	ProgressStep       = "step"
+	ProgressPhaseEnd   = "phase_end"
+	ProgressDone       = "done"
+	ProgressError      = "error"
+)
+
+// ProgressEvent reports how far a generation has come. Events are emitted in order:
+// phase_start, zero or more steps and phase_end for every phase, then done or error.
+type ProgressEvent struct {
+	Type    string    `json:"type"`
+	Phase   string    `json:"phase,omitempty"`
+	Current int       `json:"current,omitempty"`
+	Total   int       `json:"total,omitempty"`
+	Item    string    `json:"item,omitempty"`
+	Message string    `json:"message,omitempty"`
+	Time    time.Time `json:"time"`
+	// ElapsedMS is the time spent in the phase, or in the whole generation for done and error events
+	ElapsedMS int64 `json:"elapsed_ms"`
+	// ETAMS estimates the time left in the phase from the average time per step
+	ETAMS int64 `json:"eta_ms,omitempty"`
+}
+
+// Elapsed returns ElapsedMS as a duration
+func (e ProgressEvent) Elapsed() time.Duration {
+	return time.Duration(e.ElapsedMS) * time.Millisecond
+}
+
+// ETA returns ETAMS as a duration
+func (e ProgressEvent) ETA() time.Duration {
+	return time.Duration(e.ETAMS) * time.Millisecond
+}
+
+// ProgressFunc receives progress events during generation
+type ProgressFunc func(ProgressEvent)
@@ -16,6 +16,8 @@ type ProjectConfig struct {
 	License      string            `yaml:"license" json:"license"`
 	Features     *Features         `yaml:"features" json:"features"`
 	Variables    map[string]string `yaml:"variables" json:"variables"`
+	// Experimental lists the experimental features enabled for this generation
+	Experimental []string `yaml:"experimental,omitempty" json:"experimental,omitempty"`
 }
 
 // Features represents optional features for the project
@@ -107,10 +109,29 @@ type LoggingConfig struct {
 
 // GenerationOptions represents options for the generation process
 type GenerationOptions struct {
-	OutputPath string
-	DryRun     bool
-	NoGit      bool
-	Verbose    bool
+	OutputPath  string
+	DryRun      bool
+	NoGit       bool
+	Verbose     bool
+	Strict      bool         // Fail on references to undefined template variables
+	KeepPartial bool         // Keep the output of an interrupted generation instead of removing it
+	Force       bool         // Generate even inside a git repository with uncommitted changes
+	NoFormat    bool         // Leave the generated Go files as rendered instead of formatting them
+	DenyHooks   bool         // Refuse a blueprint declaring hooks, one not built in unless allowed
+	Output      OutputFS     // Where files are written, nil for the local disk
+	Progress    ProgressFunc // Receives phase and per-file progress, may be nil
+	// Formatters are the optional formatters, such as gofumpt, run over the Go files
+	// once written
+	Formatters []string
+
+	// IntoExisting generates into the repository cloned at OutputPath, committing the
+	// project on Branch, instead of requiring a missing or empty directory
+	IntoExisting bool
+	Branch       string
+
+	// Resume continues the interrupted generation kept in OutputPath with
+	// KeepPartial, leaving the files it already wrote as they are
+	Resume bool
 }
 
 // GenerationResult represents the result of a project generation
@@ -120,4 +141,13 @@ type GenerationResult struct {
 	Duration     time.Duration
 	Success      bool
 	Error        error
+	// Deprecations lists the deprecated blueprint and choices the project uses
+	Deprecations []DeprecationNotice
+	// Experiments lists the experimental features the generation used
+	Experiments []string
+	// Branch is the branch the project was committed on, when generated into an
+	// existing repository
+	Branch string
+	// Resumed lists the files a resumed generation found already written
+	Resumed []string
 }
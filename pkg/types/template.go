@@ -1,5 +1,19 @@
 package types
 
+import (
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Default permissions for generated files
+const (
+	DefaultFileMode       fs.FileMode = 0644
+	DefaultExecutableMode fs.FileMode = 0755
+)
+
 // Template represents a project template
 type Template struct {
 	ID           string             `yaml:"id" json:"id"`
@@ -14,10 +28,60 @@ type Template struct {
 	Variables    []TemplateVariable `yaml:"variables" json:"variables"`
 	Files        []TemplateFile     `yaml:"files" json:"files"`
 	Dependencies []Dependency       `yaml:"dependencies" json:"dependencies"`
-	PostHooks    []Hook             `yaml:"post_hooks" json:"post_hooks"`
-	Features     []TemplateFeature  `yaml:"features" json:"features"`
-	Validation   []ValidationRule   `yaml:"validation" json:"validation"`
-	Metadata     map[string]any     `yaml:"metadata" json:"metadata"`
+	// PostHooks are post-generation hooks, the former spelling of Hooks.PostGeneration
+	PostHooks  []Hook            `yaml:"post_hooks" json:"post_hooks"`
+	Hooks      TemplateHooks     `yaml:"hooks" json:"hooks"`
+	Features   []TemplateFeature `yaml:"features" json:"features"`
+	Validation []ValidationRule  `yaml:"validation" json:"validation"`
+	Metadata   map[string]any    `yaml:"metadata" json:"metadata"`
+	// Deprecated marks the whole blueprint as deprecated
+	Deprecated *Deprecation `yaml:"deprecated,omitempty" json:"deprecated,omitempty"`
+	// Experiments declares the experimental features the blueprint ships behind flags
+	Experiments []Experiment `yaml:"experiments,omitempty" json:"experiments,omitempty"`
+	// Experimental gates the whole blueprint behind one of its experiments
+	Experimental string `yaml:"experimental,omitempty" json:"experimental,omitempty"`
+	// Functions declares the template functions the blueprint needs beyond the
+	// Sprig and go-starter ones, which plugins provide
+	Functions []TemplateFunction `yaml:"functions,omitempty" json:"functions,omitempty"`
+	// Derived are variables computed from the others, in order, so that conditions
+	// and templates can share them
+	Derived []DerivedVariable `yaml:"derived,omitempty" json:"derived,omitempty"`
+	// Directories are conditions on every file whose source is under a directory
+	Directories []DirectoryCondition `yaml:"directories,omitempty" json:"directories,omitempty"`
+	// Format configures the formatting of the generated Go files
+	Format TemplateFormat `yaml:"format,omitempty" json:"format,omitempty"`
+}
+
+// TemplateFormat configures the gofmt and goimports formatting of the Go files a
+// blueprint generates
+type TemplateFormat struct {
+	// Disabled leaves every Go file as rendered
+	Disabled bool `yaml:"disabled,omitempty" json:"disabled,omitempty"`
+	// Exclude lists the destinations left as rendered, as path.Match patterns
+	Exclude []string `yaml:"exclude,omitempty" json:"exclude,omitempty"`
+}
+
+// DerivedVariable is a variable whose value is the value of an expression, such as
+// `Framework in ["gin", "echo"]`, written as a condition is
+type DerivedVariable struct {
+	Name        string `yaml:"name" json:"name"`
+	Expression  string `yaml:"expression" json:"expression"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+}
+
+// DirectoryCondition includes the files whose source is under Path only when
+// Condition holds, on top of their own conditions
+type DirectoryCondition struct {
+	Path      string `yaml:"path" json:"path"`
+	Condition string `yaml:"condition" json:"condition"`
+}
+
+// TemplateFunction is a template function a blueprint needs from a plugin
+type TemplateFunction struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	// Plugin names the plugin providing the function, to tell how to install it
+	Plugin string `yaml:"plugin,omitempty" json:"plugin,omitempty"`
 }
 
 // TemplateVariable represents a configurable variable in a template
@@ -28,7 +92,125 @@ type TemplateVariable struct {
 	Default     any      `yaml:"default" json:"default"`
 	Required    bool     `yaml:"required" json:"required"`
 	Choices     []string `yaml:"choices" json:"choices"`
-	Validation  string   `yaml:"validation" json:"validation"`
+	// Validation is a regular expression the value must match
+	Validation string `yaml:"validation" json:"validation"`
+	// Enum lists the only values the variable accepts. Choices are offered by the
+	// prompts, but other values are let through.
+	Enum []string `yaml:"enum,omitempty" json:"enum,omitempty"`
+	// Min and Max bound the value of the numeric variables, and the length of the others
+	Min *float64 `yaml:"min,omitempty" json:"min,omitempty"`
+	Max *float64 `yaml:"max,omitempty" json:"max,omitempty"`
+	// Requires lists the conditions the other variables must meet once this one is set
+	Requires []VariableRequirement `yaml:"requires,omitempty" json:"requires,omitempty"`
+	// DeprecatedChoices marks some of the choices as deprecated, keyed by choice
+	DeprecatedChoices map[string]Deprecation `yaml:"deprecated_choices,omitempty" json:"deprecated_choices,omitempty"`
+	// ExperimentalChoices gates some of the choices behind experiments, keyed by choice
+	ExperimentalChoices map[string]string `yaml:"experimental_choices,omitempty" json:"experimental_choices,omitempty"`
+}
+
+// SunsetLayout is the date format of Deprecation.Sunset
+const SunsetLayout = "2006-01-02"
+
+// Deprecation describes why a blueprint or choice is deprecated and what replaces it
+type Deprecation struct {
+	Message string `yaml:"message" json:"message,omitempty"`
+	// Replacement is the blueprint ID or choice to use instead
+	Replacement string `yaml:"replacement" json:"replacement,omitempty"`
+	// Since is the go-starter version that deprecated it
+	Since string `yaml:"since" json:"since,omitempty"`
+	// Sunset is the date (YYYY-MM-DD) from which it may be removed
+	Sunset string `yaml:"sunset" json:"sunset,omitempty"`
+}
+
+// SunsetDate parses Sunset; the zero time means no sunset date is set
+func (d Deprecation) SunsetDate() (time.Time, error) {
+	if d.Sunset == "" {
+		return time.Time{}, nil
+	}
+	date, err := time.Parse(SunsetLayout, d.Sunset)
+	if err != nil {
+		return time.Time{}, NewValidationError(fmt.Sprintf("invalid sunset date %q (expected YYYY-MM-DD)", d.Sunset), err)
+	}
+	return date, nil
+}
+
+// SunsetPassed reports whether the sunset date is set and has been reached at now
+func (d Deprecation) SunsetPassed(now time.Time) bool {
+	date, err := d.SunsetDate()
+	return err == nil && !date.IsZero() && !now.Before(date)
+}
+
+// DeprecationNotice is a deprecated blueprint or choice used by a generation
+type DeprecationNotice struct {
+	Blueprint string `json:"blueprint"`
+	// Variable and Value name the deprecated choice; both are empty when the
+	// blueprint itself is deprecated
+	Variable string `json:"variable,omitempty"`
+	Value    string `json:"value,omitempty"`
+	Deprecation
+}
+
+// Deprecations lists what a generation with the given variable values uses that is
+// deprecated: the blueprint itself first, then the chosen values in variable order
+func (t Template) Deprecations(values map[string]any) []DeprecationNotice {
+	var notices []DeprecationNotice
+	if t.Deprecated != nil {
+		notices = append(notices, DeprecationNotice{Blueprint: t.ID, Deprecation: *t.Deprecated})
+	}
+
+	for _, variable := range t.Variables {
+		value, ok := values[variable.Name]
+		if !ok || value == nil || len(variable.DeprecatedChoices) == 0 {
+			continue
+		}
+		chosen := fmt.Sprint(value)
+		if deprecation, ok := variable.DeprecatedChoices[chosen]; ok {
+			notices = append(notices, DeprecationNotice{
+				Blueprint:   t.ID,
+				Variable:    variable.Name,
+				Value:       chosen,
+				Deprecation: deprecation,
+			})
+		}
+	}
+	return notices
+}
+
+// ValidateDeprecations checks that sunset dates parse and that deprecated choices
+// are among the variable's choices
+func (t Template) ValidateDeprecations() error {
+	if t.Deprecated != nil {
+		if _, err := t.Deprecated.SunsetDate(); err != nil {
+			return NewValidationError(fmt.Sprintf("blueprint %s is deprecated with an invalid sunset date", t.ID), err)
+		}
+	}
+
+	for _, variable := range t.Variables {
+		choices := make([]string, 0, len(variable.DeprecatedChoices))
+		for choice := range variable.DeprecatedChoices {
+			choices = append(choices, choice)
+		}
+		sort.Strings(choices)
+
+		for _, choice := range choices {
+			if len(variable.Choices) > 0 && !containsString(variable.Choices, choice) {
+				return NewValidationError(fmt.Sprintf("variable %s deprecates %q, which is not one of its choices", variable.Name, choice), nil)
+			}
+			if _, err := variable.DeprecatedChoices[choice].SunsetDate(); err != nil {
+				return NewValidationError(fmt.Sprintf("variable %s deprecates %q with an invalid sunset date", variable.Name, choice), err)
+			}
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
 }
 
 // TemplateFile represents a file in a template
@@ -37,6 +219,38 @@ type TemplateFile struct {
 	Destination string `yaml:"destination" json:"destination"`
 	Condition   string `yaml:"condition" json:"condition"`
 	Executable  bool   `yaml:"executable" json:"executable"`
+	// Mode is an octal permission string such as "0600"; it takes precedence over Executable
+	Mode string `yaml:"mode,omitempty" json:"mode,omitempty"`
+	// Symlink makes Destination a symbolic link to this (templated, relative) target instead of a rendered file
+	Symlink string `yaml:"symlink,omitempty" json:"symlink,omitempty"`
+	// Binary copies Source verbatim instead of rendering it; files that are not valid UTF-8 are always copied
+	Binary bool `yaml:"binary,omitempty" json:"binary,omitempty"`
+	// SHA256 is the expected hex digest of Source, checked before generation
+	SHA256 string `yaml:"sha256,omitempty" json:"sha256,omitempty"`
+}
+
+// IsSymlink reports whether the entry describes a symbolic link
+func (f TemplateFile) IsSymlink() bool {
+	return f.Symlink != ""
+}
+
+// FileMode returns the permissions the generated file should have
+func (f TemplateFile) FileMode() (fs.FileMode, error) {
+	if f.Mode == "" {
+		if f.Executable {
+			return DefaultExecutableMode, nil
+		}
+		return DefaultFileMode, nil
+	}
+
+	mode, err := strconv.ParseUint(f.Mode, 8, 32)
+	if err != nil || mode > 0777 {
+		return 0, NewValidationError(fmt.Sprintf("invalid file mode %q for %s (expected octal permissions such as 0644)", f.Mode, f.Destination), err)
+	}
+	if mode&0400 == 0 {
+		return 0, NewValidationError(fmt.Sprintf("file mode %q for %s would make the file unreadable by its owner", f.Mode, f.Destination), nil)
+	}
+	return fs.FileMode(mode), nil
 }
 
 // Dependency represents a Go module dependency
@@ -46,12 +260,64 @@ type Dependency struct {
 	Condition string `yaml:"condition" json:"condition"`
 }
 
-// Hook represents a post-generation hook
+// Failure policies of a hook
+const (
+	// HookWarn reports the failure of the hook and goes on, the default
+	HookWarn = "warn"
+	// HookFail fails the generation, leaving nothing behind
+	HookFail = "fail"
+	// HookIgnore goes on silently
+	HookIgnore = "ignore"
+)
+
+// Hook represents a command run before or after the files of a project are written
 type Hook struct {
-	Name    string   `yaml:"name" json:"name"`
-	Command string   `yaml:"command" json:"command"`
-	Args    []string `yaml:"args" json:"args"`
-	WorkDir string   `yaml:"work_dir" json:"work_dir"`
+	Name        string   `yaml:"name" json:"name"`
+	Description string   `yaml:"description,omitempty" json:"description,omitempty"`
+	Command     string   `yaml:"command" json:"command"`
+	Args        []string `yaml:"args" json:"args"`
+	WorkDir     string   `yaml:"work_dir" json:"work_dir"`
+	Condition   string   `yaml:"condition" json:"condition"`
+	// OnFailure is the failure policy of the hook: warn, fail or ignore
+	OnFailure string `yaml:"on_failure,omitempty" json:"on_failure,omitempty"`
+	// Order runs the hooks by ascending order, then in the order they are declared
+	Order int `yaml:"order,omitempty" json:"order,omitempty"`
+}
+
+// FailurePolicy returns the failure policy of the hook, warn by default
+func (h Hook) FailurePolicy() string {
+	if h.OnFailure == "" {
+		return HookWarn
+	}
+	return h.OnFailure
+}
+
+// TemplateHooks are the hooks a template runs around the generation
+type TemplateHooks struct {
+	// PreGeneration hooks run in the empty project directory, once every file rendered
+	PreGeneration []Hook `yaml:"pre_generation" json:"pre_generation"`
+	// PostGeneration hooks run once the files are written and the dependencies added
+	PostGeneration []Hook `yaml:"post_generation" json:"post_generation"`
+}
+
+// PreGenerationHooks returns the pre-generation hooks in the order they run
+func (t Template) PreGenerationHooks() []Hook {
+	return orderHooks(t.Hooks.PreGeneration)
+}
+
+// PostGenerationHooks returns the post-generation hooks, those of post_hooks
+// first, in the order they run
+func (t Template) PostGenerationHooks() []Hook {
+	hooks := make([]Hook, 0, len(t.PostHooks)+len(t.Hooks.PostGeneration))
+	hooks = append(hooks, t.PostHooks...)
+	return orderHooks(append(hooks, t.Hooks.PostGeneration...))
+}
+
+// orderHooks sorts a copy of hooks by ascending order, keeping the declaration order of equal ones
+func orderHooks(hooks []Hook) []Hook {
+	ordered := append([]Hook(nil), hooks...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Order < ordered[j].Order })
+	return ordered
 }
 
 // TemplateIncludes represents external file includes
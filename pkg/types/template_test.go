@@ -0,0 +1,121 @@
+package types
+
+import (
+	"io/fs"
+	"testing"
+	"time"
+)
+
+func TestTemplateFile_FileMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    TemplateFile
+		want    fs.FileMode
+		wantErr bool
+	}{
+		{name: "default", file: TemplateFile{}, want: 0644},
+		{name: "executable", file: TemplateFile{Executable: true}, want: 0755},
+		{name: "explicit mode", file: TemplateFile{Mode: "0600"}, want: 0600},
+		{name: "mode wins over executable", file: TemplateFile{Mode: "0700", Executable: true}, want: 0700},
+		{name: "mode without leading zero", file: TemplateFile{Mode: "755"}, want: 0755},
+		{name: "not octal", file: TemplateFile{Mode: "0899"}, wantErr: true},
+		{name: "special bits", file: TemplateFile{Mode: "4755"}, wantErr: true},
+		{name: "owner cannot read", file: TemplateFile{Mode: "0044"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.file.FileMode()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got mode %o", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected mode %o, got %o", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTemplate_Deprecations(t *testing.T) {
+	tmpl := Template{
+		ID:         "web-api-legacy",
+		Deprecated: &Deprecation{Replacement: "web-api-standard", Sunset: "2027-01-01"},
+		Variables: []TemplateVariable{
+			{Name: "Framework", Choices: []string{"gin", "echo", "martini"}, DeprecatedChoices: map[string]Deprecation{
+				"martini": {Message: "martini is unmaintained", Replacement: "gin"},
+			}},
+			{Name: "Logger", Choices: []string{"slog", "logrus"}, DeprecatedChoices: map[string]Deprecation{
+				"logrus": {Replacement: "slog"},
+			}},
+		},
+	}
+
+	notices := tmpl.Deprecations(map[string]any{"Framework": "martini", "Logger": "slog"})
+	if len(notices) != 2 {
+		t.Fatalf("expected 2 notices, got %d: %+v", len(notices), notices)
+	}
+	if notices[0].Variable != "" || notices[0].Replacement != "web-api-standard" {
+		t.Errorf("expected the blueprint notice first, got %+v", notices[0])
+	}
+	if notices[1].Variable != "Framework" || notices[1].Value != "martini" || notices[1].Replacement != "gin" {
+		t.Errorf("unexpected choice notice %+v", notices[1])
+	}
+
+	tmpl.Deprecated = nil
+	if notices := tmpl.Deprecations(map[string]any{"Framework": "gin"}); len(notices) != 0 {
+		t.Errorf("expected no notices, got %+v", notices)
+	}
+}
+
+func TestDeprecation_SunsetPassed(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		sunset string
+		want   bool
+	}{
+		{sunset: "", want: false},
+		{sunset: "2026-01-01", want: true},
+		{sunset: "2026-06-01", want: true},
+		{sunset: "2027-01-01", want: false},
+		{sunset: "next year", want: false},
+	}
+	for _, tt := range tests {
+		if got := (Deprecation{Sunset: tt.sunset}).SunsetPassed(now); got != tt.want {
+			t.Errorf("SunsetPassed(%q) = %v, want %v", tt.sunset, got, tt.want)
+		}
+	}
+}
+
+func TestTemplate_ValidateDeprecations(t *testing.T) {
+	valid := Template{
+		ID:         "cli",
+		Deprecated: &Deprecation{Sunset: "2027-01-01"},
+		Variables: []TemplateVariable{
+			{Name: "Logger", Choices: []string{"slog", "logrus"}, DeprecatedChoices: map[string]Deprecation{"logrus": {}}},
+		},
+	}
+	if err := valid.ValidateDeprecations(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	badSunset := valid
+	badSunset.Deprecated = &Deprecation{Sunset: "01/01/2027"}
+	if err := badSunset.ValidateDeprecations(); err == nil {
+		t.Error("expected an error for an invalid sunset date")
+	}
+
+	unknownChoice := valid
+	unknownChoice.Variables = []TemplateVariable{
+		{Name: "Logger", Choices: []string{"slog"}, DeprecatedChoices: map[string]Deprecation{"log15": {}}},
+	}
+	if err := unknownChoice.ValidateDeprecations(); err == nil {
+		t.Error("expected an error for a deprecated choice that is not a choice")
+	}
+}
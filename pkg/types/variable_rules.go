@@ -0,0 +1,184 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// VariableRequirement is a condition a variable puts on another once it is set,
+// such as DatabaseORM requiring DatabaseDriver
+type VariableRequirement struct {
+	// When restricts the requirement to these values of the variable, all values when empty
+	When []string `yaml:"when,omitempty" json:"when,omitempty"`
+	// Variable names the variable the condition is on
+	Variable string `yaml:"variable" json:"variable"`
+	// OneOf lists the values Variable must take; without it, Variable must be set
+	OneOf []string `yaml:"one_of,omitempty" json:"one_of,omitempty"`
+	// Unset requires Variable to be left empty instead
+	Unset bool `yaml:"unset,omitempty" json:"unset,omitempty"`
+	// Message is reported when the condition is not met
+	Message string `yaml:"message,omitempty" json:"message,omitempty"`
+}
+
+// VariableViolation is a value of a variable that breaks one of its rules
+type VariableViolation struct {
+	Variable string `json:"variable"`
+	Value    string `json:"value"`
+	Message  string `json:"message"`
+}
+
+// numericVariableTypes are the variable types whose min and max bound the value
+// rather than its length
+var numericVariableTypes = []string{"int", "integer", "number"}
+
+// isSetValue reports whether a variable value turns its feature on: not empty,
+// not "false" for the switches and not "none" for the choices
+func isSetValue(value string) bool {
+	return value != "" && value != "false" && value != "none"
+}
+
+// CheckVariables lists the rules of its variables a generation with the given
+// values breaks: the validation pattern, enum, min and max of each value that is
+// not empty, and the requirements of each variable that is set.
+func (t Template) CheckVariables(values map[string]any) []VariableViolation {
+	valueOf := func(name string) string {
+		if value, ok := values[name]; ok && value != nil {
+			return fmt.Sprint(value)
+		}
+		return ""
+	}
+
+	var violations []VariableViolation
+	for _, variable := range t.Variables {
+		value := valueOf(variable.Name)
+		violate := func(message string) {
+			violations = append(violations, VariableViolation{Variable: variable.Name, Value: value, Message: message})
+		}
+
+		if value != "" {
+			if variable.Validation != "" {
+				if pattern, err := regexp.Compile(variable.Validation); err == nil && !pattern.MatchString(value) {
+					violate(fmt.Sprintf("%s %q must match %s", variable.Name, value, variable.Validation))
+				}
+			}
+			if len(variable.Enum) > 0 && !slices.Contains(variable.Enum, value) {
+				violate(fmt.Sprintf("%s %q is not one of %s", variable.Name, value, quoteAll(variable.Enum)))
+			}
+			if message := variable.checkBounds(value); message != "" {
+				violate(message)
+			}
+		}
+
+		if !isSetValue(value) {
+			continue
+		}
+		for _, requirement := range variable.Requires {
+			if len(requirement.When) > 0 && !slices.Contains(requirement.When, value) {
+				continue
+			}
+			other := valueOf(requirement.Variable)
+			var met bool
+			switch {
+			case requirement.Unset:
+				met = !isSetValue(other)
+			case len(requirement.OneOf) > 0:
+				met = slices.Contains(requirement.OneOf, other)
+			default:
+				met = isSetValue(other)
+			}
+			if met {
+				continue
+			}
+			violate(requirement.Explain(variable.Name))
+		}
+	}
+	return violations
+}
+
+// quoteAll lists values quoted, so that the empty value shows
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// checkBounds checks value against the min and max of the variable, describing
+// the broken bound
+func (v TemplateVariable) checkBounds(value string) string {
+	if v.Min == nil && v.Max == nil {
+		return ""
+	}
+
+	measure, unit := float64(utf8.RuneCountInString(value)), "characters"
+	if slices.Contains(numericVariableTypes, v.Type) {
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Sprintf("%s %q is not a number", v.Name, value)
+		}
+		measure, unit = number, ""
+	}
+
+	bound := func(limit float64) string {
+		if unit == "" {
+			return strconv.FormatFloat(limit, 'f', -1, 64)
+		}
+		return strconv.FormatFloat(limit, 'f', -1, 64) + " " + unit
+	}
+	switch {
+	case v.Min != nil && measure < *v.Min:
+		return fmt.Sprintf("%s %q must be at least %s", v.Name, value, bound(*v.Min))
+	case v.Max != nil && measure > *v.Max:
+		return fmt.Sprintf("%s %q must be at most %s", v.Name, value, bound(*v.Max))
+	}
+	return ""
+}
+
+// Explain returns the message of the requirement variable puts on another, or
+// states the requirement when it has none
+func (r VariableRequirement) Explain(variable string) string {
+	switch {
+	case r.Message != "":
+		return r.Message
+	case r.Unset:
+		return fmt.Sprintf("%s cannot be combined with %s", variable, r.Variable)
+	case len(r.OneOf) > 0:
+		return fmt.Sprintf("%s requires %s to be one of %s", variable, r.Variable, quoteAll(r.OneOf))
+	}
+	return fmt.Sprintf("%s requires %s", variable, r.Variable)
+}
+
+// ValidateRules checks that the rules of the variables are well formed: patterns
+// compile, min is not above max, defaults are in the enum and requirements name
+// declared variables
+func (t Template) ValidateRules() error {
+	declared := make(map[string]bool, len(t.Variables))
+	for _, variable := range t.Variables {
+		declared[variable.Name] = true
+	}
+
+	for _, variable := range t.Variables {
+		if variable.Validation != "" {
+			if _, err := regexp.Compile(variable.Validation); err != nil {
+				return NewValidationError(fmt.Sprintf("variable %s has an invalid validation pattern", variable.Name), err)
+			}
+		}
+		if variable.Min != nil && variable.Max != nil && *variable.Min > *variable.Max {
+			return NewValidationError(fmt.Sprintf("variable %s has a min above its max", variable.Name), nil)
+		}
+		if def, ok := variable.Default.(string); ok && def != "" && len(variable.Enum) > 0 && !slices.Contains(variable.Enum, def) {
+			return NewValidationError(fmt.Sprintf("default %q of variable %s is not in its enum", def, variable.Name), nil)
+		}
+		for _, requirement := range variable.Requires {
+			if !declared[requirement.Variable] {
+				return NewValidationError(fmt.Sprintf("variable %s requires %q, which is not a variable of the blueprint", variable.Name, requirement.Variable), nil)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func float(value float64) *float64 {
+	return &value
+}
+
+func ruleTestTemplate() Template {
+	return Template{
+		ID: "rules",
+		Variables: []TemplateVariable{
+			{Name: "ServiceName", Type: "string", Validation: "^[a-z]+$", Min: float(3), Max: float(12)},
+			{Name: "Port", Type: "int", Min: float(1024), Max: float(65535)},
+			{Name: "Region", Type: "string", Enum: []string{"eu", "us"}},
+			{Name: "DatabaseDriver", Type: "string"},
+			{Name: "DatabaseORM", Type: "string", Requires: []VariableRequirement{{Variable: "DatabaseDriver"}}},
+			{Name: "Cache", Type: "string", Requires: []VariableRequirement{
+				{When: []string{"redis"}, Variable: "Region", OneOf: []string{"eu"}, Message: "redis is only hosted in eu"},
+				{Variable: "DatabaseORM", Unset: true},
+			}},
+		},
+	}
+}
+
+func TestTemplate_CheckVariables(t *testing.T) {
+	tmpl := ruleTestTemplate()
+
+	valid := map[string]any{"ServiceName": "orders", "Port": 8080, "Region": "eu", "DatabaseDriver": "postgres", "DatabaseORM": "gorm", "Cache": ""}
+	if violations := tmpl.CheckVariables(valid); len(violations) != 0 {
+		t.Errorf("unexpected violations %v", violations)
+	}
+	if violations := tmpl.CheckVariables(map[string]any{}); len(violations) != 0 {
+		t.Errorf("empty values break no rule, got %v", violations)
+	}
+
+	violations := tmpl.CheckVariables(map[string]any{
+		"ServiceName": "Orders",
+		"Port":        "80",
+		"Region":      "ap",
+		"DatabaseORM": "gorm",
+		"Cache":       "redis",
+	})
+	var messages []string
+	for _, violation := range violations {
+		messages = append(messages, violation.Message)
+	}
+	want := []string{
+		`ServiceName "Orders" must match ^[a-z]+$`,
+		`Port "80" must be at least 1024`,
+		`Region "ap" is not one of "eu", "us"`,
+		`DatabaseORM requires DatabaseDriver`,
+		`redis is only hosted in eu`,
+		`Cache cannot be combined with DatabaseORM`,
+	}
+	if !reflect.DeepEqual(messages, want) {
+		t.Errorf("unexpected violations:\n%s", strings.Join(messages, "\n"))
+	}
+	if violations[0].Variable != "ServiceName" || violations[0].Value != "Orders" {
+		t.Errorf("violations name the variable and value, got %+v", violations[0])
+	}
+
+	violations = tmpl.CheckVariables(map[string]any{"ServiceName": "ordersandpayments", "Port": "http"})
+	if len(violations) != 2 || violations[0].Message != `ServiceName "ordersandpayments" must be at most 12 characters` || violations[1].Message != `Port "http" is not a number` {
+		t.Errorf("unexpected violations %v", violations)
+	}
+}
+
+func TestTemplate_ValidateRules(t *testing.T) {
+	if err := ruleTestTemplate().ValidateRules(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	invalid := map[string]TemplateVariable{
+		"invalid validation pattern": {Name: "Name", Validation: "["},
+		"min above its max":          {Name: "Port", Min: float(10), Max: float(1)},
+		"not in its enum":            {Name: "Region", Default: "ap", Enum: []string{"eu"}},
+		"not a variable":             {Name: "DatabaseORM", Requires: []VariableRequirement{{Variable: "DatabaseDriver"}}},
+	}
+	for want, variable := range invalid {
+		err := Template{Variables: []TemplateVariable{variable}}.ValidateRules()
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expected an error containing %q, got %v", want, err)
+		}
+	}
+}
@@ -7,39 +7,30 @@ Feature: Workspace Blueprint Generation
     Given I have the go-starter CLI tool available
     And I am in a temporary working directory
 
-  Scenario: Generate basic workspace with all modules enabled
+  Scenario: Generate basic workspace
     Given I want to generate a workspace blueprint
     When I run the generator with:
       | parameter               | value                           |
       | type                    | workspace                       |
       | name                    | test-workspace                  |
       | module                  | github.com/test/workspace       |
-      | go_version              | 1.21                            |
+      | go_version              | 1.22                            |
       | framework               | gin                             |
-      | database_type           | postgres                        |
-      | message_queue           | redis                           |
       | logger_type             | slog                            |
-      | enable_web_api          | true                            |
-      | enable_cli              | true                            |
-      | enable_worker           | true                            |
-      | enable_microservices    | true                            |
-      | enable_docker           | true                            |
-      | enable_kubernetes       | true                            |
     Then the generation should succeed
     And the generated workspace should have the Go workspace structure:
       | file                                      | type      |
       | go.work                                   | file      |
-      | workspace.yaml                            | file      |
       | Makefile                                  | file      |
-      | pkg/shared/                               | directory |
-      | pkg/models/                               | directory |
-      | pkg/storage/                              | directory |
-      | pkg/events/                               | directory |
-      | cmd/api/                                  | directory |
-      | cmd/cli/                                  | directory |
-      | cmd/worker/                               | directory |
-      | services/user-service/                    | directory |
-      | services/notification-service/            | directory |
+      | shared/go.mod                             | file      |
+      | shared/config/                            | directory |
+      | shared/logger/                            | directory |
+      | shared/shutdown/                          | directory |
+      | shared/httpx/                             | directory |
+      | api/go.mod                                | file      |
+      | api/main.go                               | file      |
+      | worker/go.mod                             | file      |
+      | worker/main.go                            | file      |
     And all modules should compile successfully
     And the workspace should sync without errors
 
@@ -50,16 +41,13 @@ Feature: Workspace Blueprint Generation
       | type                    | workspace                       |
       | name                    | minimal-workspace               |
       | module                  | github.com/test/minimal         |
-      | enable_web_api          | false                           |
       | enable_cli              | true                            |
-      | enable_worker           | false                           |
       | enable_microservices    | false                           |
       | database_type           | none                            |
       | message_queue           | none                            |
-      | enable_docker           | false                           |
       | enable_kubernetes       | false                           |
     Then the generation should succeed
-    And the generated workspace should have minimal structure:
+    And the generated workspace should have the Go workspace structure:
       | file                                      | type      |
       | go.work                                   | file      |
       | pkg/shared/                               | directory |
@@ -69,10 +57,8 @@ Feature: Workspace Blueprint Generation
       | file                                      |
       | pkg/storage/                              |
       | pkg/events/                               |
-      | cmd/api/                                  |
-      | cmd/worker/                               |
-      | services/                                 |
-      | docker-compose.yml                        |
+      | cmd/user-service/                         |
+      | cmd/notification-service/                 |
       | deployments/k8s/                          |
     And all modules should compile successfully
 
@@ -82,8 +68,8 @@ Feature: Workspace Blueprint Generation
       | framework |
       | gin       |
       | echo      |
-      | fiber     |
       | chi       |
+      | stdlib    |
     Then each generated workspace should:
       | validation                                |
       | compile successfully                      |
@@ -91,6 +77,20 @@ Feature: Workspace Blueprint Generation
       | have consistent module structure          |
       | include proper dependency management      |
 
+  Scenario: Generate workspace with different architectures
+    Given I want to generate a workspace blueprint
+    When I run the generator for each architecture:
+      | architecture |
+      | standard     |
+      | clean        |
+      | ddd          |
+      | hexagonal    |
+    Then each generated workspace should:
+      | validation                                |
+      | follow the architecture layout            |
+      | have consistent module structure          |
+      | compile successfully                      |
+
   Scenario: Generate workspace with different database types
     Given I want to generate a workspace blueprint
     When I run the generator for each database:
@@ -142,19 +142,12 @@ Feature: Workspace Blueprint Generation
       | type                    | workspace                       |
       | name                    | docker-workspace                |
       | module                  | github.com/test/docker          |
-      | enable_docker           | true                            |
-      | enable_web_api          | true                            |
-      | enable_worker           | true                            |
-      | enable_microservices    | true                            |
     Then the generation should succeed
     And the generated workspace should include Docker files:
       | file                                      |
       | docker-compose.yml                        |
-      | docker-compose.dev.yml                    |
-      | cmd/api/Dockerfile                        |
-      | cmd/worker/Dockerfile                     |
-      | services/user-service/Dockerfile          |
-      | services/notification-service/Dockerfile  |
+      | api/Dockerfile                            |
+      | worker/Dockerfile                         |
     And the Docker configurations should be valid
 
   Scenario: Generate workspace with Kubernetes support
@@ -165,8 +158,6 @@ Feature: Workspace Blueprint Generation
       | name                    | k8s-workspace                   |
       | module                  | github.com/test/k8s             |
       | enable_kubernetes       | true                            |
-      | enable_web_api          | true                            |
-      | enable_worker           | true                            |
       | enable_microservices    | true                            |
     Then the generation should succeed
     And the generated workspace should include Kubernetes manifests:
@@ -185,29 +176,22 @@ Feature: Workspace Blueprint Generation
     When I test the build system
     Then the Makefile should provide all expected targets:
       | target          | description                    |
-      | build-all       | Build all modules              |
-      | test-all        | Run all tests                  |
-      | lint-all        | Lint all modules               |
-      | clean-all       | Clean build artifacts          |
-      | deps-update     | Update dependencies            |
+      | build           | Build the service binaries     |
+      | test            | Run the tests of every module  |
+      | vet             | Vet every module               |
+      | tidy            | Tidy every module              |
+      | clean           | Clean build artifacts          |
       | docker-build    | Build Docker images            |
-      | k8s-deploy      | Deploy to Kubernetes           |
     And all build targets should execute successfully
     And the build should respect dependency order
 
   Scenario: Validate workspace module dependencies
-    Given I have generated a workspace with all modules
+    Given I have generated a complete workspace
     When I analyze module dependencies
     Then the dependency graph should be correct:
-      | dependent_module                | dependencies                    |
-      | pkg/models                      | pkg/shared                      |
-      | pkg/storage                     | pkg/shared, pkg/models          |
-      | pkg/events                      | pkg/shared, pkg/models          |
-      | cmd/api                         | pkg/shared, pkg/models, pkg/storage |
-      | cmd/cli                         | pkg/shared, pkg/models          |
-      | cmd/worker                      | pkg/shared, pkg/models, pkg/events |
-      | services/user-service           | pkg/shared, pkg/models, pkg/storage |
-      | services/notification-service   | pkg/shared, pkg/models, pkg/events |
+      | dependent_module | dependencies |
+      | api              | shared       |
+      | worker           | shared       |
     And there should be no circular dependencies
     And all module replacements should be correct
 
@@ -225,21 +209,15 @@ Feature: Workspace Blueprint Generation
     And code coverage should meet minimum thresholds
 
   Scenario: Validate workspace CI/CD integration
-    Given I have generated a workspace
+    Given I have generated a complete workspace
     When I examine the CI/CD configuration
     Then the workspace should include CI/CD workflows:
       | file                                      |
       | .github/workflows/ci.yml                  |
-      | .github/workflows/release.yml             |
-      | .github/workflows/security.yml            |
     And the workflows should handle multi-module projects:
       | feature                                   |
       | Build all modules in dependency order     |
       | Run tests for all modules                 |
-      | Generate combined coverage report         |
-      | Security scanning for all modules         |
-      | Multi-platform builds                     |
-      | Release management                        |
 
   Scenario: Validate workspace observability integration
     Given I have generated a workspace with observability enabled
@@ -278,19 +256,6 @@ Feature: Workspace Blueprint Generation
       | Debug helpers                             |
     And all tools should be properly configured
 
-  Scenario: Validate workspace scalability patterns
-    Given I have generated a workspace with microservices
-    When I analyze the architecture
-    Then the workspace should demonstrate scalability patterns:
-      | pattern                                   |
-      | Service independence                      |
-      | Shared library reuse                      |
-      | Event-driven communication               |
-      | Database per service                      |
-      | Configuration externalization            |
-      | Health check endpoints                    |
-      | Graceful shutdown handling               |
-
   Scenario: Validate workspace security best practices
     Given I have generated a workspace
     When I analyze the security implementation
@@ -304,6 +269,18 @@ Feature: Workspace Blueprint Generation
       | Secrets management                        |
       | Audit logging                            |
 
+  Scenario: Validate workspace scalability patterns
+    Given I have generated a workspace with microservices
+    When I analyze the architecture
+    Then the workspace should demonstrate scalability patterns:
+      | pattern                                   |
+      | Service independence                      |
+      | Shared library reuse                      |
+      | Event-driven communication                |
+      | Configuration externalization             |
+      | Health check endpoints                    |
+      | Graceful shutdown handling                |
+
   Scenario: Validate workspace performance optimization
     Given I have generated a workspace
     When I analyze the performance characteristics
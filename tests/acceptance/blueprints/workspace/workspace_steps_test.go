@@ -13,8 +13,71 @@ import (
 	"github.com/cucumber/godog"
 	"github.com/cucumber/godog/colors"
 	"gopkg.in/yaml.v3"
+
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/pkg/types"
+	"github.com/francknouama/go-starter/tests/helpers"
 )
 
+// workspaceModules are the modules of a generated workspace, dependencies first
+var workspaceModules = []string{"shared", "api", "worker"}
+
+// deprecatedOptions maps the parameters of the deprecated 1.x options, which
+// have no flag, to the blueprint variables they set
+var deprecatedOptions = map[string]string{
+	"enable_cli":           "EnableCLI",
+	"enable_microservices": "EnableMicroservices",
+	"database_type":        "DatabaseType",
+	"message_queue":        "MessageQueue",
+	"enable_kubernetes":    "EnableKubernetes",
+}
+
+// databaseDrivers are the drivers imported by the storage package per database
+var databaseDrivers = map[string]string{
+	"postgres": "github.com/lib/pq",
+	"mysql":    "github.com/go-sql-driver/mysql",
+	"mongodb":  "go.mongodb.org/mongo-driver",
+	"sqlite":   "github.com/mattn/go-sqlite3",
+}
+
+// queueClients are the clients imported by the events package per message queue
+var queueClients = map[string]string{
+	"redis":    "github.com/redis/go-redis/v9",
+	"nats":     "github.com/nats-io/nats.go",
+	"kafka":    "github.com/segmentio/kafka-go",
+	"rabbitmq": "github.com/rabbitmq/amqp091-go",
+}
+
+// architectureLayouts lists files that only the given architecture generates
+var architectureLayouts = map[string][]string{
+	"standard": {
+		"api/internal/handlers/task_handler.go",
+		"api/internal/services/task_service.go",
+		"worker/internal/jobs/runner.go",
+	},
+	"clean": {
+		"api/internal/domain/usecases/task_usecase.go",
+		"api/internal/adapters/controllers/task_controller.go",
+		"worker/internal/domain/usecases/run_jobs.go",
+	},
+	"ddd": {
+		"api/internal/domain/task/task.go",
+		"api/internal/application/task/service.go",
+		"worker/internal/application/job/runner.go",
+	},
+	"hexagonal": {
+		"api/internal/application/ports/input/task_service.go",
+		"api/internal/adapters/primary/rest/task_handler.go",
+		"worker/internal/adapters/primary/scheduler/scheduler.go",
+	},
+}
+
+// generatedWorkspace is a workspace generated by a "for each" step
+type generatedWorkspace struct {
+	dir        string
+	parameters map[string]string
+}
+
 // TestContext holds the test execution context
 type TestContext struct {
 	t               *testing.T
@@ -23,22 +86,14 @@ type TestContext struct {
 	projectName     string
 	generationError error
 	parameters      map[string]string
-	frameworks      []string
-	databases       []string
-	messageQueues   []string
-	loggers         []string
-	currentFramework string
+	generated       []generatedWorkspace
 }
 
 // NewTestContext creates a new test context
 func NewTestContext(t *testing.T) *TestContext {
 	return &TestContext{
-		t:             t,
-		parameters:    make(map[string]string),
-		frameworks:    []string{"gin", "echo", "fiber", "chi"},
-		databases:     []string{"postgres", "mysql", "mongodb", "sqlite"},
-		messageQueues: []string{"redis", "nats", "kafka", "rabbitmq"},
-		loggers:       []string{"slog", "zap", "logrus", "zerolog"},
+		t:          t,
+		parameters: make(map[string]string),
 	}
 }
 
@@ -57,7 +112,7 @@ func (tc *TestContext) iHaveTheGoStarterCLIToolAvailable() error {
 	if err != nil {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
-	
+
 	projectRoot := cwd
 	for {
 		if _, err := os.Stat(filepath.Join(projectRoot, "go.mod")); err == nil {
@@ -69,18 +124,24 @@ func (tc *TestContext) iHaveTheGoStarterCLIToolAvailable() error {
 		}
 		projectRoot = parent
 	}
-	
+
+	// The Background runs this step before the working directory step, which
+	// then reuses the directory the CLI was copied to
+	if err := tc.ensureTempDir(); err != nil {
+		return err
+	}
+
 	// Check if binary already exists in project root
 	existingBinary := filepath.Join(projectRoot, "go-starter")
 	cliPath := filepath.Join(tc.tempDir, "go-starter")
-	
+
 	if _, err := os.Stat(existingBinary); err == nil {
 		// Copy existing binary
 		data, err := os.ReadFile(existingBinary)
 		if err != nil {
 			return fmt.Errorf("failed to read existing binary: %w", err)
 		}
-		
+
 		err = os.WriteFile(cliPath, data, 0755)
 		if err != nil {
 			return fmt.Errorf("failed to copy binary: %w", err)
@@ -89,31 +150,41 @@ func (tc *TestContext) iHaveTheGoStarterCLIToolAvailable() error {
 		// Build the CLI tool
 		buildCmd := exec.Command("go", "build", "-o", cliPath, ".")
 		buildCmd.Dir = projectRoot
-		
+
 		if output, err := buildCmd.CombinedOutput(); err != nil {
 			return fmt.Errorf("failed to build CLI tool: %w\nOutput: %s", err, output)
 		}
 	}
-	
+
 	// Verify the tool is executable
 	if _, err := os.Stat(cliPath); err != nil {
 		return fmt.Errorf("CLI tool not found at %s: %w", cliPath, err)
 	}
-	
+
 	return nil
 }
 
-func (tc *TestContext) iAmInATemporaryWorkingDirectory() error {
+func (tc *TestContext) ensureTempDir() error {
+	if tc.tempDir != "" {
+		return nil
+	}
 	var err error
 	tc.tempDir, err = os.MkdirTemp("", "workspace-test-*")
 	if err != nil {
 		return fmt.Errorf("failed to create temp directory: %w", err)
 	}
-	
+	return nil
+}
+
+func (tc *TestContext) iAmInATemporaryWorkingDirectory() error {
+	if err := tc.ensureTempDir(); err != nil {
+		return err
+	}
+
 	if err := os.Chdir(tc.tempDir); err != nil {
 		return fmt.Errorf("failed to change to temp directory: %w", err)
 	}
-	
+
 	return nil
 }
 
@@ -124,22 +195,36 @@ func (tc *TestContext) iWantToGenerateAWorkspaceBlueprint() error {
 
 func (tc *TestContext) iRunTheGeneratorWith(table *godog.Table) error {
 	// Parse parameters from table
-	for _, row := range table.Rows {
-		if len(row.Cells) >= 2 {
-			tc.parameters[row.Cells[0].Value] = row.Cells[1].Value
+	if table != nil {
+		for _, row := range table.Rows {
+			if len(row.Cells) >= 2 {
+				tc.parameters[row.Cells[0].Value] = row.Cells[1].Value
+			}
 		}
 	}
-	
+
 	// Set project name for directory creation
 	if name, exists := tc.parameters["name"]; exists {
 		tc.projectName = name
 		tc.projectDir = filepath.Join(tc.tempDir, name)
 	}
-	
+
+	// The deprecated options have no flag: those workspaces are generated in process
+	variables := map[string]string{}
+	for key, value := range tc.parameters {
+		if variable, ok := deprecatedOptions[key]; ok {
+			variables[variable] = value
+		}
+	}
+	if len(variables) > 0 {
+		tc.generationError = tc.generateInProcess(variables)
+		return nil
+	}
+
 	// Build CLI command
 	cliPath := filepath.Join(tc.tempDir, "go-starter")
-	args := []string{"new"}
-	
+	args := []string{"new", "--no-git"}
+
 	// Add parameters as arguments
 	for key, value := range tc.parameters {
 		switch key {
@@ -153,40 +238,54 @@ func (tc *TestContext) iRunTheGeneratorWith(table *godog.Table) error {
 			args = append(args, "--go-version", value)
 		case "framework":
 			args = append(args, "--framework", value)
-		case "database_type":
-			args = append(args, "--database-type", value)
-		case "message_queue":
-			args = append(args, "--message-queue", value)
+		case "architecture":
+			args = append(args, "--architecture", value)
 		case "logger_type":
 			args = append(args, "--logger", value)
-		case "enable_web_api":
-			args = append(args, "--enable-web-api", value)
-		case "enable_cli":
-			args = append(args, "--enable-cli", value)
-		case "enable_worker":
-			args = append(args, "--enable-worker", value)
-		case "enable_microservices":
-			args = append(args, "--enable-microservices", value)
-		case "enable_docker":
-			args = append(args, "--enable-docker", value)
-		case "enable_kubernetes":
-			args = append(args, "--enable-kubernetes", value)
-		}
-	}
-	
+		}
+	}
+
 	// Run the generator
 	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 	defer cancel()
-	
+
 	cmd := exec.CommandContext(ctx, cliPath, args...)
 	cmd.Dir = tc.tempDir
-	
+
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		tc.generationError = fmt.Errorf("generation failed: %w\nOutput: %s", err, output)
 		return nil // Don't return error here, let subsequent steps check
 	}
-	
+
+	return nil
+}
+
+// generateInProcess generates the workspace of the parameters with the generator
+// package, setting the given blueprint variables; TestWorkspaceBlueprintATDD
+// loads the blueprints
+func (tc *TestContext) generateInProcess(variables map[string]string) error {
+	config := types.ProjectConfig{
+		Name:         tc.parameters["name"],
+		Module:       tc.parameters["module"],
+		Type:         "workspace",
+		GoVersion:    tc.parameters["go_version"],
+		Framework:    tc.parameters["framework"],
+		Architecture: tc.parameters["architecture"],
+		Logger:       tc.parameters["logger_type"],
+		Variables:    variables,
+	}
+	if config.Framework == "" {
+		config.Framework = "gin"
+	}
+	if config.Logger == "" {
+		config.Logger = "slog"
+	}
+
+	_, err := generator.New().Generate(config, types.GenerationOptions{OutputPath: tc.projectDir, NoGit: true})
+	if err != nil {
+		return fmt.Errorf("generation failed: %w", err)
+	}
 	return nil
 }
 
@@ -194,27 +293,27 @@ func (tc *TestContext) theGenerationShouldSucceed() error {
 	if tc.generationError != nil {
 		return tc.generationError
 	}
-	
+
 	// Verify project directory was created
 	if tc.projectDir == "" {
 		return fmt.Errorf("project directory not set")
 	}
-	
+
 	if _, err := os.Stat(tc.projectDir); err != nil {
 		return fmt.Errorf("project directory not created: %w", err)
 	}
-	
+
 	return nil
 }
 
 func (tc *TestContext) theGeneratedWorkspaceShouldHaveTheGoWorkspaceStructure(table *godog.Table) error {
-	for _, row := range table.Rows {
+	for _, row := range table.Rows[1:] {
 		if len(row.Cells) >= 2 {
 			filePath := row.Cells[0].Value
 			fileType := row.Cells[1].Value
-			
+
 			fullPath := filepath.Join(tc.projectDir, filePath)
-			
+
 			switch fileType {
 			case "file":
 				if _, err := os.Stat(fullPath); err != nil {
@@ -229,320 +328,275 @@ func (tc *TestContext) theGeneratedWorkspaceShouldHaveTheGoWorkspaceStructure(ta
 			}
 		}
 	}
-	
+
 	return nil
 }
 
-func (tc *TestContext) allModulesShouldCompileSuccessfully() error {
-	// Run go work sync first
-	syncCmd := exec.Command("go", "work", "sync")
-	syncCmd.Dir = tc.projectDir
-	if output, err := syncCmd.CombinedOutput(); err != nil {
-		return fmt.Errorf("go work sync failed: %w\nOutput: %s", err, output)
-	}
-	
-	// Build all modules
-	buildCmd := exec.Command("go", "build", "./...")
-	buildCmd.Dir = tc.projectDir
-	if output, err := buildCmd.CombinedOutput(); err != nil {
-		return fmt.Errorf("compilation failed: %w\nOutput: %s", err, output)
+func (tc *TestContext) theWorkspaceShouldNotInclude(table *godog.Table) error {
+	for _, row := range table.Rows[1:] {
+		if len(row.Cells) < 1 {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(tc.projectDir, row.Cells[0].Value)); err == nil {
+			return fmt.Errorf("%s should not be generated", row.Cells[0].Value)
+		}
 	}
-	
+
 	return nil
 }
 
+func (tc *TestContext) allModulesShouldCompileSuccessfully() error {
+	return tc.verifyCompilation(tc.projectDir)
+}
+
 func (tc *TestContext) theWorkspaceShouldSyncWithoutErrors() error {
 	cmd := exec.Command("go", "work", "sync")
 	cmd.Dir = tc.projectDir
-	
+
 	if output, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("workspace sync failed: %w\nOutput: %s", err, output)
 	}
-	
-	return nil
-}
-
-func (tc *TestContext) theGeneratedWorkspaceShouldHaveMinimalStructure(table *godog.Table) error {
-	return tc.theGeneratedWorkspaceShouldHaveTheGoWorkspaceStructure(table)
-}
 
-func (tc *TestContext) theWorkspaceShouldNotInclude(table *godog.Table) error {
-	for _, row := range table.Rows {
-		if len(row.Cells) >= 1 {
-			filePath := row.Cells[0].Value
-			fullPath := filepath.Join(tc.projectDir, filePath)
-			
-			if _, err := os.Stat(fullPath); err == nil {
-				return fmt.Errorf("file/directory %s should not exist in minimal workspace", filePath)
-			}
-		}
-	}
-	
 	return nil
 }
 
-func (tc *TestContext) iRunTheGeneratorForEachFramework(table *godog.Table) error {
-	frameworks := []string{}
-	for _, row := range table.Rows {
-		if len(row.Cells) >= 1 {
-			frameworks = append(frameworks, row.Cells[0].Value)
+// iRunTheGeneratorForEach generates one workspace per value of the first column
+func (tc *TestContext) iRunTheGeneratorForEach(parameter, prefix string, table *godog.Table) error {
+	for _, row := range table.Rows[1:] {
+		if len(row.Cells) < 1 {
+			continue
 		}
-	}
-	
-	for _, framework := range frameworks {
-		tc.currentFramework = framework
-		tc.parameters["framework"] = framework
-		tc.parameters["name"] = fmt.Sprintf("test-workspace-%s", framework)
-		
+		value := row.Cells[0].Value
+		tc.parameters[parameter] = value
+		tc.parameters["name"] = prefix + value
+		tc.parameters["module"] = "github.com/test/" + prefix + value
+
 		if err := tc.iRunTheGeneratorWith(nil); err != nil {
 			return err
 		}
-		
+
 		if err := tc.theGenerationShouldSucceed(); err != nil {
-			return fmt.Errorf("generation failed for framework %s: %w", framework, err)
+			return fmt.Errorf("generation failed for %s %s: %w", parameter, value, err)
 		}
-	}
-	
-	return nil
-}
 
-func (tc *TestContext) iRunTheGeneratorForEachDatabase(table *godog.Table) error {
-	databases := []string{}
-	for _, row := range table.Rows {
-		if len(row.Cells) >= 1 {
-			databases = append(databases, row.Cells[0].Value)
-		}
-	}
-	
-	for _, database := range databases {
-		tc.parameters["database_type"] = database
-		tc.parameters["name"] = fmt.Sprintf("test-workspace-db-%s", database)
-		
-		if err := tc.iRunTheGeneratorWith(nil); err != nil {
-			return err
-		}
-		
-		if err := tc.theGenerationShouldSucceed(); err != nil {
-			return fmt.Errorf("generation failed for database %s: %w", database, err)
+		parameters := make(map[string]string, len(tc.parameters))
+		for key, value := range tc.parameters {
+			parameters[key] = value
 		}
+		tc.generated = append(tc.generated, generatedWorkspace{dir: tc.projectDir, parameters: parameters})
 	}
-	
+
 	return nil
 }
 
-func (tc *TestContext) iRunTheGeneratorForEachMessageQueue(table *godog.Table) error {
-	messageQueues := []string{}
-	for _, row := range table.Rows {
-		if len(row.Cells) >= 1 {
-			messageQueues = append(messageQueues, row.Cells[0].Value)
-		}
-	}
-	
-	for _, mq := range messageQueues {
-		tc.parameters["message_queue"] = mq
-		tc.parameters["name"] = fmt.Sprintf("test-workspace-mq-%s", mq)
-		
-		if err := tc.iRunTheGeneratorWith(nil); err != nil {
-			return err
-		}
-		
-		if err := tc.theGenerationShouldSucceed(); err != nil {
-			return fmt.Errorf("generation failed for message queue %s: %w", mq, err)
-		}
-	}
-	
-	return nil
+func (tc *TestContext) iRunTheGeneratorForEachFramework(table *godog.Table) error {
+	return tc.iRunTheGeneratorForEach("framework", "test-workspace-", table)
+}
+
+func (tc *TestContext) iRunTheGeneratorForEachArchitecture(table *godog.Table) error {
+	return tc.iRunTheGeneratorForEach("architecture", "test-workspace-arch-", table)
 }
 
 func (tc *TestContext) iRunTheGeneratorForEachLogger(table *godog.Table) error {
-	loggers := []string{}
-	for _, row := range table.Rows {
-		if len(row.Cells) >= 1 {
-			loggers = append(loggers, row.Cells[0].Value)
-		}
-	}
-	
-	for _, logger := range loggers {
-		tc.parameters["logger_type"] = logger
-		tc.parameters["name"] = fmt.Sprintf("test-workspace-logger-%s", logger)
-		
-		if err := tc.iRunTheGeneratorWith(nil); err != nil {
-			return err
-		}
-		
-		if err := tc.theGenerationShouldSucceed(); err != nil {
-			return fmt.Errorf("generation failed for logger %s: %w", logger, err)
-		}
-	}
-	
-	return nil
+	return tc.iRunTheGeneratorForEach("logger_type", "test-workspace-logger-", table)
+}
+
+func (tc *TestContext) iRunTheGeneratorForEachDatabase(table *godog.Table) error {
+	return tc.iRunTheGeneratorForEach("database_type", "test-workspace-db-", table)
+}
+
+func (tc *TestContext) iRunTheGeneratorForEachMessageQueue(table *godog.Table) error {
+	return tc.iRunTheGeneratorForEach("message_queue", "test-workspace-mq-", table)
 }
 
 func (tc *TestContext) eachGeneratedWorkspaceShouldWithTable(table *godog.Table) error {
-	for _, framework := range tc.frameworks {
-		projectDir := filepath.Join(tc.tempDir, fmt.Sprintf("test-workspace-%s", framework))
-		
-		for _, row := range table.Rows {
-			if len(row.Cells) >= 1 {
-				validation := row.Cells[0].Value
-				
-				switch validation {
-				case "compile successfully":
-					if err := tc.verifyCompilation(projectDir); err != nil {
-						return fmt.Errorf("compilation failed for %s: %w", framework, err)
-					}
-					
-				case "contain framework-specific imports":
-					if err := tc.verifyFrameworkSpecificImports(projectDir, framework); err != nil {
-						return err
-					}
-					
-				case "have consistent module structure":
-					if err := tc.verifyConsistentModuleStructure(projectDir); err != nil {
-						return err
-					}
-					
-				case "include proper dependency management":
-					if err := tc.verifyDependencyManagement(projectDir); err != nil {
-						return err
-					}
-					
-				case "include database-specific storage package":
-					if err := tc.verifyDatabaseSpecificStorage(projectDir); err != nil {
-						return err
-					}
-					
-				case "contain appropriate database drivers":
-					if err := tc.verifyDatabaseDrivers(projectDir); err != nil {
-						return err
-					}
-					
-				case "have correct connection configuration":
-					if err := tc.verifyConnectionConfiguration(projectDir); err != nil {
-						return err
-					}
-					
-				case "include message queue events package":
-					if err := tc.verifyMessageQueueEventsPackage(projectDir); err != nil {
-						return err
-					}
-					
-				case "contain appropriate MQ client libraries":
-					if err := tc.verifyMQClientLibraries(projectDir); err != nil {
-						return err
-					}
-					
-				case "include logger-specific implementation":
-					if err := tc.verifyLoggerImplementation(projectDir); err != nil {
-						return err
-					}
-					
-				case "have consistent logging interface":
-					if err := tc.verifyConsistentLoggingInterface(projectDir); err != nil {
-						return err
-					}
-				}
+	for _, workspace := range tc.generated {
+		for _, row := range table.Rows[1:] {
+			if len(row.Cells) < 1 {
+				continue
+			}
+
+			var err error
+			switch row.Cells[0].Value {
+			case "compile successfully":
+				err = tc.verifyCompilation(workspace.dir)
+			case "contain framework-specific imports":
+				err = tc.verifyFrameworkSpecificImports(workspace.dir, workspace.parameters["framework"])
+			case "follow the architecture layout":
+				err = tc.verifyArchitectureLayout(workspace.dir, workspace.parameters["architecture"])
+			case "have consistent module structure":
+				err = tc.verifyConsistentModuleStructure(workspace.dir)
+			case "include proper dependency management":
+				err = tc.verifyDependencyManagement(workspace.dir)
+			case "include logger-specific implementation":
+				err = tc.verifyLoggerImplementation(workspace.dir, workspace.parameters["logger_type"])
+			case "have consistent logging interface":
+				err = tc.verifyConsistentLoggingInterface(workspace.dir)
+			case "include database-specific storage package":
+				err = tc.verifyFileContains(workspace.dir, "pkg/storage/go.mod", "/pkg/storage")
+			case "contain appropriate database drivers":
+				err = tc.verifyFileContains(workspace.dir, "pkg/storage/database.go", databaseDrivers[workspace.parameters["database_type"]])
+			case "include message queue events package":
+				err = tc.verifyFileContains(workspace.dir, "pkg/events/go.mod", "/pkg/events")
+			case "contain appropriate MQ client libraries":
+				err = tc.verifyFileContains(workspace.dir, "pkg/events/event_bus.go", queueClients[workspace.parameters["message_queue"]])
+			case "have correct connection configuration":
+				err = tc.verifyConnectionConfiguration(workspace.dir, workspace.parameters)
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %w", filepath.Base(workspace.dir), err)
 			}
 		}
 	}
-	
+
 	return nil
 }
 
 func (tc *TestContext) theGeneratedWorkspaceShouldIncludeDockerFiles(table *godog.Table) error {
-	for _, row := range table.Rows {
-		if len(row.Cells) >= 1 {
-			filePath := row.Cells[0].Value
-			fullPath := filepath.Join(tc.projectDir, filePath)
-			
-			if _, err := os.Stat(fullPath); err != nil {
-				return fmt.Errorf("expected Docker file %s not found: %w", filePath, err)
-			}
+	return tc.filesShouldExist(table)
+}
+
+func (tc *TestContext) theDockerConfigurationsShouldBeValid() error {
+	content, err := os.ReadFile(filepath.Join(tc.projectDir, "docker-compose.yml"))
+	if err != nil {
+		return fmt.Errorf("failed to read docker-compose.yml: %w", err)
+	}
+
+	var compose struct {
+		Services map[string]interface{} `yaml:"services"`
+	}
+	if err := yaml.Unmarshal(content, &compose); err != nil {
+		return fmt.Errorf("docker-compose.yml is not valid YAML: %w", err)
+	}
+	for _, service := range []string{"api", "worker"} {
+		if _, ok := compose.Services[service]; !ok {
+			return fmt.Errorf("docker-compose.yml has no %s service", service)
 		}
 	}
-	
+
 	return nil
 }
 
-func (tc *TestContext) theDockerConfigurationsShouldBeValid() error {
-	dockerFiles := []string{
-		"docker-compose.yml",
-		"docker-compose.dev.yml",
-	}
-	
-	for _, file := range dockerFiles {
-		fullPath := filepath.Join(tc.projectDir, file)
-		content, err := os.ReadFile(fullPath)
+func (tc *TestContext) theGeneratedWorkspaceShouldIncludeKubernetesManifests(table *godog.Table) error {
+	return tc.filesShouldExist(table)
+}
+
+func (tc *TestContext) theKubernetesManifestsShouldBeValidYAML() error {
+	manifests, err := filepath.Glob(filepath.Join(tc.projectDir, "deployments/k8s/*.yaml"))
+	if err != nil {
+		return err
+	}
+	if len(manifests) == 0 {
+		return fmt.Errorf("no Kubernetes manifests generated")
+	}
+
+	for _, manifest := range manifests {
+		content, err := os.ReadFile(manifest)
 		if err != nil {
-			return fmt.Errorf("failed to read Docker file %s: %w", file, err)
+			return fmt.Errorf("failed to read %s: %w", manifest, err)
 		}
-		
-		var dockerCompose interface{}
-		if err := yaml.Unmarshal(content, &dockerCompose); err != nil {
-			return fmt.Errorf("Docker file %s is not valid YAML: %w", file, err)
+		decoder := yaml.NewDecoder(strings.NewReader(string(content)))
+		for {
+			var document struct {
+				Kind string `yaml:"kind"`
+			}
+			if err := decoder.Decode(&document); err != nil {
+				if err.Error() == "EOF" {
+					break
+				}
+				return fmt.Errorf("%s is not valid YAML: %w", filepath.Base(manifest), err)
+			}
+			if document.Kind == "" {
+				return fmt.Errorf("%s has a document without a kind", filepath.Base(manifest))
+			}
 		}
 	}
-	
+
 	return nil
 }
 
-func (tc *TestContext) theGeneratedWorkspaceShouldIncludeKubernetesManifests(table *godog.Table) error {
-	for _, row := range table.Rows {
-		if len(row.Cells) >= 1 {
-			filePath := row.Cells[0].Value
-			fullPath := filepath.Join(tc.projectDir, filePath)
-			
-			if _, err := os.Stat(fullPath); err != nil {
-				return fmt.Errorf("expected Kubernetes manifest %s not found: %w", filePath, err)
-			}
-		}
+func (tc *TestContext) iHaveGeneratedAWorkspaceWithMicroservices() error {
+	tc.parameters = map[string]string{
+		"type":                 "workspace",
+		"name":                 "services-workspace",
+		"module":               "github.com/test/services-workspace",
+		"enable_microservices": "true",
+		"database_type":        "postgres",
+		"message_queue":        "nats",
 	}
-	
+
+	if err := tc.iRunTheGeneratorWith(nil); err != nil {
+		return err
+	}
+	return tc.theGenerationShouldSucceed()
+}
+
+func (tc *TestContext) iAnalyzeTheArchitecture() error {
+	// This will be verified in the next step
 	return nil
 }
 
-func (tc *TestContext) theKubernetesManifestsShouldBeValidYAML() error {
-	k8sDir := filepath.Join(tc.projectDir, "deployments/k8s")
-	
-	// Walk through all YAML files in k8s directory
-	return filepath.Walk(k8sDir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
+func (tc *TestContext) theWorkspaceShouldDemonstrateScalabilityPatterns(table *godog.Table) error {
+	services := []string{"user-service", "notification-service"}
+	for _, row := range table.Rows[1:] {
+		if len(row.Cells) < 1 {
+			continue
 		}
-		
-		if !info.IsDir() && (strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml")) {
-			content, readErr := os.ReadFile(path)
-			if readErr != nil {
-				return fmt.Errorf("failed to read K8s manifest %s: %w", path, readErr)
+
+		var err error
+		switch row.Cells[0].Value {
+		case "Service independence":
+			// Each service has its own module and image
+			for _, service := range services {
+				if err = tc.verifyFileContains(tc.projectDir, "cmd/"+service+"/Dockerfile", "cmd/"+service); err != nil {
+					break
+				}
 			}
-			
-			var manifest interface{}
-			if yamlErr := yaml.Unmarshal(content, &manifest); yamlErr != nil {
-				return fmt.Errorf("K8s manifest %s is not valid YAML: %w", path, yamlErr)
+		case "Shared library reuse":
+			for _, service := range services {
+				if err = tc.verifyFileContains(tc.projectDir, "cmd/"+service+"/go.mod", "/pkg/shared => ../../pkg/shared"); err != nil {
+					break
+				}
+			}
+		case "Event-driven communication":
+			if err = tc.verifyFileContains(tc.projectDir, "cmd/user-service/services/user_service.go", ".Publish("); err == nil {
+				err = tc.verifyFileContains(tc.projectDir, "cmd/notification-service/main.go", ".Subscribe(")
+			}
+		case "Configuration externalization":
+			err = tc.verifyFileContains(tc.projectDir, "pkg/shared/config/config.go", "AutomaticEnv")
+		case "Health check endpoints":
+			for _, service := range services {
+				if err = tc.verifyFileContains(tc.projectDir, "cmd/"+service+"/handlers/router.go", "/ready"); err != nil {
+					break
+				}
+			}
+		case "Graceful shutdown handling":
+			for _, service := range services {
+				if err = tc.verifyFileContains(tc.projectDir, "cmd/"+service+"/main.go", "signal.NotifyContext"); err != nil {
+					break
+				}
 			}
 		}
-		
-		return nil
-	})
+		if err != nil {
+			return fmt.Errorf("%s: %w", row.Cells[0].Value, err)
+		}
+	}
+
+	return nil
 }
 
 func (tc *TestContext) iHaveGeneratedACompleteWorkspace() error {
-	// Generate a complete workspace for testing
 	tc.parameters = map[string]string{
-		"type":                  "workspace",
-		"name":                  "complete-workspace",
-		"module":                "github.com/test/complete-workspace",
-		"enable_web_api":        "true",
-		"enable_cli":            "true",
-		"enable_worker":         "true",
-		"enable_microservices":  "true",
-		"database_type":         "postgres",
-		"message_queue":         "redis",
-		"enable_docker":         "true",
-		"enable_kubernetes":     "true",
-	}
-	
-	return tc.iRunTheGeneratorWith(nil)
+		"type":      "workspace",
+		"name":      "complete-workspace",
+		"module":    "github.com/test/complete-workspace",
+		"framework": "gin",
+	}
+
+	if err := tc.iRunTheGeneratorWith(nil); err != nil {
+		return err
+	}
+	return tc.theGenerationShouldSucceed()
 }
 
 func (tc *TestContext) iTestTheBuildSystem() error {
@@ -556,312 +610,379 @@ func (tc *TestContext) theMakefileShouldProvideAllExpectedTargets(table *godog.T
 	if err != nil {
 		return fmt.Errorf("failed to read Makefile: %w", err)
 	}
-	
+
 	makefileStr := string(content)
-	
-	for _, row := range table.Rows {
+
+	for _, row := range table.Rows[1:] {
 		if len(row.Cells) >= 1 {
 			target := row.Cells[0].Value
-			if !strings.Contains(makefileStr, target+":") {
+			if !strings.Contains(makefileStr, "\n"+target+":") {
 				return fmt.Errorf("Makefile missing target: %s", target)
 			}
 		}
 	}
-	
+
 	return nil
 }
 
 func (tc *TestContext) allBuildTargetsShouldExecuteSuccessfully() error {
-	targets := []string{"build-all", "test-all", "lint-all", "clean-all"}
-	
-	for _, target := range targets {
+	for _, target := range []string{"build", "vet", "test", "clean"} {
 		// Set a timeout for each target
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
-		defer cancel()
-		
+
 		cmd := exec.CommandContext(ctx, "make", target)
 		cmd.Dir = tc.projectDir
 		output, err := cmd.CombinedOutput()
+		cancel()
 		if err != nil {
 			return fmt.Errorf("make target %s failed: %w\nOutput: %s", target, err, output)
 		}
 	}
-	
+
 	return nil
 }
 
 func (tc *TestContext) theBuildShouldRespectDependencyOrder() error {
-	// Verify that dependencies are built before dependents
-	buildScript := filepath.Join(tc.projectDir, "scripts/build-all.sh")
-	content, err := os.ReadFile(buildScript)
+	// The Makefile walks MODULES in order: shared must come before the services
+	content, err := os.ReadFile(filepath.Join(tc.projectDir, "Makefile"))
 	if err != nil {
-		return fmt.Errorf("failed to read build script: %w", err)
-	}
-	
-	contentStr := string(content)
-	
-	// Check that pkg/shared is built before other packages
-	sharedIndex := strings.Index(contentStr, "pkg/shared")
-	modelsIndex := strings.Index(contentStr, "pkg/models")
-	
-	if sharedIndex == -1 || modelsIndex == -1 {
-		return fmt.Errorf("build script missing shared or models packages")
+		return fmt.Errorf("failed to read Makefile: %w", err)
 	}
-	
-	if sharedIndex > modelsIndex {
-		return fmt.Errorf("build script should build pkg/shared before pkg/models")
+
+	for _, line := range strings.Split(string(content), "\n") {
+		if !strings.HasPrefix(line, "MODULES :=") {
+			continue
+		}
+		modules := strings.Fields(strings.TrimPrefix(line, "MODULES :="))
+		if len(modules) == 0 || modules[0] != "shared" {
+			return fmt.Errorf("Makefile should list the shared module first, got %v", modules)
+		}
+		return nil
 	}
-	
+
+	return fmt.Errorf("Makefile does not define MODULES")
+}
+
+func (tc *TestContext) iAnalyzeModuleDependencies() error {
+	// This will be verified in the next steps
 	return nil
 }
 
-// Helper methods
+func (tc *TestContext) theDependencyGraphShouldBeCorrect(table *godog.Table) error {
+	module := tc.parameters["module"]
+	for _, row := range table.Rows[1:] {
+		if len(row.Cells) < 2 {
+			continue
+		}
+		dependent := row.Cells[0].Value
+		content, err := os.ReadFile(filepath.Join(tc.projectDir, dependent, "go.mod"))
+		if err != nil {
+			return fmt.Errorf("failed to read %s/go.mod: %w", dependent, err)
+		}
+		for _, dependency := range strings.Split(row.Cells[1].Value, ",") {
+			dependency = strings.TrimSpace(dependency)
+			if !strings.Contains(string(content), module+"/"+dependency+" ") {
+				return fmt.Errorf("%s should require %s", dependent, dependency)
+			}
+		}
+	}
 
-func (tc *TestContext) verifyCompilation(projectDir string) error {
-	// First sync the workspace
-	syncCmd := exec.Command("go", "work", "sync")
-	syncCmd.Dir = projectDir
-	if output, err := syncCmd.CombinedOutput(); err != nil {
-		return fmt.Errorf("go work sync failed: %w\nOutput: %s", err, output)
+	return nil
+}
+
+func (tc *TestContext) thereShouldBeNoCircularDependencies() error {
+	// The shared module is the root of the graph: it must not require a service
+	content, err := os.ReadFile(filepath.Join(tc.projectDir, "shared", "go.mod"))
+	if err != nil {
+		return fmt.Errorf("failed to read shared/go.mod: %w", err)
 	}
-	
-	// Then build all modules
-	buildCmd := exec.Command("go", "build", "./...")
-	buildCmd.Dir = projectDir
-	if output, err := buildCmd.CombinedOutput(); err != nil {
-		return fmt.Errorf("compilation failed: %w\nOutput: %s", err, output)
+
+	module := tc.parameters["module"]
+	for _, service := range []string{"api", "worker"} {
+		if strings.Contains(string(content), module+"/"+service) {
+			return fmt.Errorf("shared should not depend on %s", service)
+		}
 	}
-	
+
 	return nil
 }
 
-func (tc *TestContext) verifyFrameworkSpecificImports(projectDir, framework string) error {
-	if tc.parameters["enable_web_api"] != "true" {
-		return nil // Skip if web API is not enabled
+func (tc *TestContext) allModuleReplacementsShouldBeCorrect() error {
+	module := tc.parameters["module"]
+	for _, service := range []string{"api", "worker"} {
+		content, err := os.ReadFile(filepath.Join(tc.projectDir, service, "go.mod"))
+		if err != nil {
+			return fmt.Errorf("failed to read %s/go.mod: %w", service, err)
+		}
+		replace := "replace " + module + "/shared => ../shared"
+		if !strings.Contains(string(content), replace) {
+			return fmt.Errorf("%s/go.mod should contain %q", service, replace)
+		}
 	}
-	
-	apiMainPath := filepath.Join(projectDir, "cmd/api/main.go")
-	content, err := os.ReadFile(apiMainPath)
+
+	return nil
+}
+
+func (tc *TestContext) iExamineTheCICDConfiguration() error {
+	// This will be verified in the next steps
+	return nil
+}
+
+func (tc *TestContext) theWorkspaceShouldIncludeCICDWorkflows(table *godog.Table) error {
+	return tc.filesShouldExist(table)
+}
+
+func (tc *TestContext) theWorkflowsShouldHandleMultiModuleProjects(table *godog.Table) error {
+	content, err := os.ReadFile(filepath.Join(tc.projectDir, ".github/workflows/ci.yml"))
 	if err != nil {
-		return fmt.Errorf("failed to read API main.go for %s: %w", framework, err)
+		return fmt.Errorf("failed to read CI workflow: %w", err)
 	}
-	
-	expectedImports := map[string]string{
-		"gin":   "github.com/gin-gonic/gin",
-		"echo":  "github.com/labstack/echo",
-		"fiber": "github.com/gofiber/fiber",
-		"chi":   "github.com/go-chi/chi",
+
+	var workflow struct {
+		Jobs map[string]struct {
+			Strategy struct {
+				Matrix struct {
+					Module []string `yaml:"module"`
+				} `yaml:"matrix"`
+			} `yaml:"strategy"`
+		} `yaml:"jobs"`
 	}
-	
-	if expectedImport, exists := expectedImports[framework]; exists {
-		if !strings.Contains(string(content), expectedImport) {
-			return fmt.Errorf("framework %s missing expected import %s", framework, expectedImport)
+	if err := yaml.Unmarshal(content, &workflow); err != nil {
+		return fmt.Errorf("CI workflow is not valid YAML: %w", err)
+	}
+
+	// The test job runs once per module, in dependency order
+	modules := workflow.Jobs["test"].Strategy.Matrix.Module
+	if strings.Join(modules, ",") != strings.Join(workspaceModules, ",") {
+		return fmt.Errorf("CI should test the modules %v, got %v", workspaceModules, modules)
+	}
+	if _, ok := workflow.Jobs["build"]; !ok {
+		return fmt.Errorf("CI should build the services")
+	}
+
+	return nil
+}
+
+// Helper methods
+
+func (tc *TestContext) filesShouldExist(table *godog.Table) error {
+	for _, row := range table.Rows[1:] {
+		if len(row.Cells) >= 1 {
+			filePath := row.Cells[0].Value
+			if _, err := os.Stat(filepath.Join(tc.projectDir, filePath)); err != nil {
+				return fmt.Errorf("expected file %s not found: %w", filePath, err)
+			}
 		}
 	}
-	
+
 	return nil
 }
 
-func (tc *TestContext) verifyConsistentModuleStructure(projectDir string) error {
-	requiredDirs := []string{
-		"pkg/shared",
-		"pkg/models",
+func (tc *TestContext) verifyCompilation(projectDir string) error {
+	modules, err := tc.goWorkModules(projectDir)
+	if err != nil {
+		return err
 	}
-	
-	for _, dir := range requiredDirs {
-		fullPath := filepath.Join(projectDir, dir)
-		if info, err := os.Stat(fullPath); err != nil || !info.IsDir() {
-			return fmt.Errorf("required module directory missing: %s", dir)
+
+	// go build ./... does not cross module boundaries, so build each module
+	for _, module := range modules {
+		buildCmd := exec.Command("go", "build", "./...")
+		buildCmd.Dir = filepath.Join(projectDir, module)
+		if output, err := buildCmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("module %s failed to compile: %w\nOutput: %s", module, err, output)
 		}
 	}
-	
+
 	return nil
 }
 
-func (tc *TestContext) verifyDependencyManagement(projectDir string) error {
-	goWorkPath := filepath.Join(projectDir, "go.work")
-	if _, err := os.Stat(goWorkPath); err != nil {
-		return fmt.Errorf("go.work file missing: %w", err)
+// goWorkModules lists the modules go.work uses
+func (tc *TestContext) goWorkModules(projectDir string) ([]string, error) {
+	content, err := os.ReadFile(filepath.Join(projectDir, "go.work"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read go.work: %w", err)
+	}
+
+	var modules []string
+	for _, line := range strings.Split(string(content), "\n") {
+		if module, ok := strings.CutPrefix(strings.TrimSpace(line), "./"); ok {
+			modules = append(modules, module)
+		}
+	}
+	if len(modules) == 0 {
+		return nil, fmt.Errorf("go.work uses no module")
 	}
-	
-	content, err := os.ReadFile(goWorkPath)
+	return modules, nil
+}
+
+func (tc *TestContext) verifyFileContains(projectDir, file, expected string) error {
+	content, err := os.ReadFile(filepath.Join(projectDir, file))
 	if err != nil {
-		return fmt.Errorf("failed to read go.work: %w", err)
+		return fmt.Errorf("failed to read %s: %w", file, err)
 	}
-	
-	if !strings.Contains(string(content), "use") {
-		return fmt.Errorf("go.work should contain 'use' directives")
+	if !strings.Contains(string(content), expected) {
+		return fmt.Errorf("%s should contain %q", file, expected)
 	}
-	
+
 	return nil
 }
 
-func (tc *TestContext) verifyDatabaseSpecificStorage(projectDir string) error {
-	if tc.parameters["database_type"] == "none" {
-		return nil
+func (tc *TestContext) verifyConnectionConfiguration(projectDir string, parameters map[string]string) error {
+	// The storage and events packages read their settings from the shared configuration
+	if database := parameters["database_type"]; database != "" {
+		if err := tc.verifyFileContains(projectDir, "pkg/shared/config/config.go", `viper.SetDefault("database.type", "`+database+`")`); err != nil {
+			return err
+		}
 	}
-	
-	storageDir := filepath.Join(projectDir, "pkg/storage")
-	if info, err := os.Stat(storageDir); err != nil || !info.IsDir() {
-		return fmt.Errorf("database storage package missing")
+	if queue := parameters["message_queue"]; queue != "" {
+		if err := tc.verifyFileContains(projectDir, "pkg/shared/config/config.go", `viper.SetDefault("message_queue.type", "`+queue+`")`); err != nil {
+			return err
+		}
 	}
-	
+
 	return nil
 }
 
-func (tc *TestContext) verifyDatabaseDrivers(projectDir string) error {
-	if tc.parameters["database_type"] == "none" {
-		return nil
-	}
-	
-	goModPath := filepath.Join(projectDir, "pkg/storage/go.mod")
-	content, err := os.ReadFile(goModPath)
+func (tc *TestContext) verifyFrameworkSpecificImports(projectDir, framework string) error {
+	content, err := os.ReadFile(filepath.Join(projectDir, "api/internal/server/router.go"))
 	if err != nil {
-		return fmt.Errorf("failed to read storage go.mod: %w", err)
+		return fmt.Errorf("failed to read the API router for %s: %w", framework, err)
 	}
-	
-	database := tc.parameters["database_type"]
-	expectedDrivers := map[string]string{
-		"postgres": "github.com/lib/pq",
-		"mysql":    "github.com/go-sql-driver/mysql",
-		"mongodb":  "go.mongodb.org/mongo-driver",
-		"sqlite":   "github.com/mattn/go-sqlite3",
+
+	expectedImports := map[string]string{
+		"gin":    "github.com/gin-gonic/gin",
+		"echo":   "github.com/labstack/echo/v4",
+		"chi":    "github.com/go-chi/chi/v5",
+		"stdlib": "net/http",
 	}
-	
-	if expectedDriver, exists := expectedDrivers[database]; exists {
-		if !strings.Contains(string(content), expectedDriver) {
-			return fmt.Errorf("database driver %s not found for %s", expectedDriver, database)
+
+	if expectedImport, exists := expectedImports[framework]; exists {
+		if !strings.Contains(string(content), expectedImport) {
+			return fmt.Errorf("framework %s missing expected import %s", framework, expectedImport)
 		}
 	}
-	
+
 	return nil
 }
 
-func (tc *TestContext) verifyConnectionConfiguration(projectDir string) error {
-	configPath := filepath.Join(projectDir, "pkg/shared/config/config.go")
-	content, err := os.ReadFile(configPath)
-	if err != nil {
-		return fmt.Errorf("failed to read config file: %w", err)
-	}
-	
-	if !strings.Contains(string(content), "Database") {
-		return fmt.Errorf("config should contain database configuration")
+func (tc *TestContext) verifyArchitectureLayout(projectDir, architecture string) error {
+	for _, file := range architectureLayouts[architecture] {
+		if _, err := os.Stat(filepath.Join(projectDir, file)); err != nil {
+			return fmt.Errorf("%s layout file %s not found: %w", architecture, file, err)
+		}
 	}
-	
+
 	return nil
 }
 
-func (tc *TestContext) verifyMessageQueueEventsPackage(projectDir string) error {
-	if tc.parameters["message_queue"] == "none" {
-		return nil
-	}
-	
-	eventsDir := filepath.Join(projectDir, "pkg/events")
-	if info, err := os.Stat(eventsDir); err != nil || !info.IsDir() {
-		return fmt.Errorf("message queue events package missing")
+func (tc *TestContext) verifyConsistentModuleStructure(projectDir string) error {
+	for _, module := range workspaceModules {
+		if _, err := os.Stat(filepath.Join(projectDir, module, "go.mod")); err != nil {
+			return fmt.Errorf("module %s has no go.mod", module)
+		}
 	}
-	
+
 	return nil
 }
 
-func (tc *TestContext) verifyMQClientLibraries(projectDir string) error {
-	if tc.parameters["message_queue"] == "none" {
-		return nil
-	}
-	
-	goModPath := filepath.Join(projectDir, "pkg/events/go.mod")
-	content, err := os.ReadFile(goModPath)
+func (tc *TestContext) verifyDependencyManagement(projectDir string) error {
+	content, err := os.ReadFile(filepath.Join(projectDir, "go.work"))
 	if err != nil {
-		return fmt.Errorf("failed to read events go.mod: %w", err)
-	}
-	
-	mq := tc.parameters["message_queue"]
-	expectedLibraries := map[string]string{
-		"redis":    "github.com/go-redis/redis",
-		"nats":     "github.com/nats-io/nats.go",
-		"kafka":    "github.com/segmentio/kafka-go",
-		"rabbitmq": "github.com/streadway/amqp",
+		return fmt.Errorf("failed to read go.work: %w", err)
 	}
-	
-	if expectedLib, exists := expectedLibraries[mq]; exists {
-		if !strings.Contains(string(content), expectedLib) {
-			return fmt.Errorf("message queue library %s not found for %s", expectedLib, mq)
+
+	for _, module := range workspaceModules {
+		if !strings.Contains(string(content), "./"+module) {
+			return fmt.Errorf("go.work should use ./%s", module)
 		}
 	}
-	
+
 	return nil
 }
 
-func (tc *TestContext) verifyLoggerImplementation(projectDir string) error {
-	loggerPath := filepath.Join(projectDir, "pkg/shared/logger/logger.go")
-	content, err := os.ReadFile(loggerPath)
+func (tc *TestContext) verifyLoggerImplementation(projectDir, logger string) error {
+	content, err := os.ReadFile(filepath.Join(projectDir, "shared/logger", logger+".go"))
 	if err != nil {
 		return fmt.Errorf("failed to read logger file: %w", err)
 	}
-	
-	logger := tc.parameters["logger_type"]
+
 	expectedPackages := map[string]string{
 		"slog":    "log/slog",
 		"zap":     "go.uber.org/zap",
 		"logrus":  "github.com/sirupsen/logrus",
 		"zerolog": "github.com/rs/zerolog",
 	}
-	
+
 	if expectedPackage, exists := expectedPackages[logger]; exists {
 		if !strings.Contains(string(content), expectedPackage) {
 			return fmt.Errorf("logger package %s not found for %s", expectedPackage, logger)
 		}
 	}
-	
+
 	return nil
 }
 
 func (tc *TestContext) verifyConsistentLoggingInterface(projectDir string) error {
-	loggerPath := filepath.Join(projectDir, "pkg/shared/logger/interface.go")
-	content, err := os.ReadFile(loggerPath)
+	content, err := os.ReadFile(filepath.Join(projectDir, "shared/logger/interface.go"))
 	if err != nil {
 		return fmt.Errorf("failed to read logger interface: %w", err)
 	}
-	
-	if !strings.Contains(string(content), "Logger interface") {
+
+	if !strings.Contains(string(content), "type Logger interface") {
 		return fmt.Errorf("logger interface not found")
 	}
-	
+
 	return nil
 }
 
 // Test runner function
 func TestWorkspaceBlueprintATDD(t *testing.T) {
+	// Load the blueprints before the scenarios leave the repository for their
+	// temporary directories
+	if err := helpers.InitializeTemplates(); err != nil {
+		t.Fatalf("failed to load the blueprints: %v", err)
+	}
+
 	suite := godog.TestSuite{
 		ScenarioInitializer: func(ctx *godog.ScenarioContext) {
 			tc := NewTestContext(t)
-			
+
 			// Register step definitions
 			ctx.Step(`^I have the go-starter CLI tool available$`, tc.iHaveTheGoStarterCLIToolAvailable)
 			ctx.Step(`^I am in a temporary working directory$`, tc.iAmInATemporaryWorkingDirectory)
 			ctx.Step(`^I want to generate a workspace blueprint$`, tc.iWantToGenerateAWorkspaceBlueprint)
 			ctx.Step(`^I run the generator with:$`, tc.iRunTheGeneratorWith)
 			ctx.Step(`^the generation should succeed$`, tc.theGenerationShouldSucceed)
+			ctx.Step(`^the workspace should not include:$`, tc.theWorkspaceShouldNotInclude)
 			ctx.Step(`^the generated workspace should have the Go workspace structure:$`, tc.theGeneratedWorkspaceShouldHaveTheGoWorkspaceStructure)
 			ctx.Step(`^all modules should compile successfully$`, tc.allModulesShouldCompileSuccessfully)
 			ctx.Step(`^the workspace should sync without errors$`, tc.theWorkspaceShouldSyncWithoutErrors)
-			ctx.Step(`^the generated workspace should have minimal structure:$`, tc.theGeneratedWorkspaceShouldHaveMinimalStructure)
-			ctx.Step(`^the workspace should not include:$`, tc.theWorkspaceShouldNotInclude)
 			ctx.Step(`^I run the generator for each framework:$`, tc.iRunTheGeneratorForEachFramework)
+			ctx.Step(`^I run the generator for each architecture:$`, tc.iRunTheGeneratorForEachArchitecture)
+			ctx.Step(`^I run the generator for each logger:$`, tc.iRunTheGeneratorForEachLogger)
 			ctx.Step(`^I run the generator for each database:$`, tc.iRunTheGeneratorForEachDatabase)
 			ctx.Step(`^I run the generator for each message queue:$`, tc.iRunTheGeneratorForEachMessageQueue)
-			ctx.Step(`^I run the generator for each logger:$`, tc.iRunTheGeneratorForEachLogger)
 			ctx.Step(`^each generated workspace should:$`, tc.eachGeneratedWorkspaceShouldWithTable)
 			ctx.Step(`^the generated workspace should include Docker files:$`, tc.theGeneratedWorkspaceShouldIncludeDockerFiles)
 			ctx.Step(`^the Docker configurations should be valid$`, tc.theDockerConfigurationsShouldBeValid)
 			ctx.Step(`^the generated workspace should include Kubernetes manifests:$`, tc.theGeneratedWorkspaceShouldIncludeKubernetesManifests)
 			ctx.Step(`^the Kubernetes manifests should be valid YAML$`, tc.theKubernetesManifestsShouldBeValidYAML)
+			ctx.Step(`^I have generated a workspace with microservices$`, tc.iHaveGeneratedAWorkspaceWithMicroservices)
+			ctx.Step(`^I analyze the architecture$`, tc.iAnalyzeTheArchitecture)
+			ctx.Step(`^the workspace should demonstrate scalability patterns:$`, tc.theWorkspaceShouldDemonstrateScalabilityPatterns)
 			ctx.Step(`^I have generated a complete workspace$`, tc.iHaveGeneratedACompleteWorkspace)
 			ctx.Step(`^I test the build system$`, tc.iTestTheBuildSystem)
 			ctx.Step(`^the Makefile should provide all expected targets:$`, tc.theMakefileShouldProvideAllExpectedTargets)
 			ctx.Step(`^all build targets should execute successfully$`, tc.allBuildTargetsShouldExecuteSuccessfully)
 			ctx.Step(`^the build should respect dependency order$`, tc.theBuildShouldRespectDependencyOrder)
-			
+			ctx.Step(`^I analyze module dependencies$`, tc.iAnalyzeModuleDependencies)
+			ctx.Step(`^the dependency graph should be correct:$`, tc.theDependencyGraphShouldBeCorrect)
+			ctx.Step(`^there should be no circular dependencies$`, tc.thereShouldBeNoCircularDependencies)
+			ctx.Step(`^all module replacements should be correct$`, tc.allModuleReplacementsShouldBeCorrect)
+			ctx.Step(`^I examine the CI/CD configuration$`, tc.iExamineTheCICDConfiguration)
+			ctx.Step(`^the workspace should include CI/CD workflows:$`, tc.theWorkspaceShouldIncludeCICDWorkflows)
+			ctx.Step(`^the workflows should handle multi-module projects:$`, tc.theWorkflowsShouldHandleMultiModuleProjects)
+
 			// Cleanup after each scenario
 			ctx.After(func(ctx context.Context, sc *godog.Scenario, err error) (context.Context, error) {
 				tc.Cleanup()
@@ -875,8 +996,8 @@ func TestWorkspaceBlueprintATDD(t *testing.T) {
 			Output:   colors.Colored(os.Stdout),
 		},
 	}
-	
+
 	if suite.Run() != 0 {
 		t.Fatal("non-zero status returned, failed to run workspace ATDD tests")
 	}
-}
\ No newline at end of file
+}
@@ -5,40 +5,40 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
-	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"gopkg.in/yaml.v3"
-)
 
-// Ensure require import is used - suppress linter warning
-var _ = require.New
+	"github.com/francknouama/go-starter/internal/generator"
+	"github.com/francknouama/go-starter/pkg/types"
+	"github.com/francknouama/go-starter/tests/helpers"
+)
 
 // WorkspaceIntegrationTestSuite contains integration tests for workspace blueprint
 type WorkspaceIntegrationTestSuite struct {
 	suite.Suite
-	tempDir           string
-	cliPath           string
-	generatedProjects map[string]string // configuration -> project path
+	tempDir string
+	cliPath string
 }
 
 // SetupSuite prepares the test suite
 func (s *WorkspaceIntegrationTestSuite) SetupSuite() {
 	var err error
-	
+
 	// Create temporary directory
 	s.tempDir, err = os.MkdirTemp("", "workspace-integration-*")
 	s.Require().NoError(err)
-	
+
 	// Build CLI tool
 	s.cliPath = filepath.Join(s.tempDir, "go-starter")
 	buildCmd := exec.Command("go", "build", "-o", s.cliPath, "../../../../main.go")
 	err = buildCmd.Run()
 	s.Require().NoError(err, "Failed to build CLI tool")
-	
-	// Initialize generated projects map
-	s.generatedProjects = make(map[string]string)
+
+	// The deprecated options have no flag: those workspaces are generated in process
+	s.Require().NoError(helpers.InitializeTemplates())
 }
 
 // TearDownSuite cleans up after tests
@@ -48,212 +48,92 @@ func (s *WorkspaceIntegrationTestSuite) TearDownSuite() {
 	}
 }
 
-// SetupTest prepares each test
-func (s *WorkspaceIntegrationTestSuite) SetupTest() {
-	// Change to temp directory for each test
-	err := os.Chdir(s.tempDir)
-	s.Require().NoError(err)
-}
-
-// TestWorkspaceBasicGeneration tests basic workspace generation
-func (s *WorkspaceIntegrationTestSuite) TestWorkspaceBasicGeneration() {
-	projectName := "test-workspace-basic"
-	projectPath := filepath.Join(s.tempDir, projectName)
-	
-	// Generate workspace project
-	cmd := exec.Command(s.cliPath, "new", projectName,
+// generate generates a workspace project with the given flags and returns its path
+func (s *WorkspaceIntegrationTestSuite) generate(projectName string, flags ...string) string {
+	args := append([]string{"new", projectName,
 		"--type", "workspace",
-		"--module", "github.com/test/workspace-basic",
-		"--framework", "gin",
-		"--logger", "slog",
-		"--enable-web-api", "true",
-		"--enable-cli", "true",
-		"--database-type", "postgres",
-		"--message-queue", "redis",
-	)
-	
-	output, err := cmd.CombinedOutput()
+		"--module", "github.com/test/" + projectName,
+		"--output", s.tempDir,
+		"--no-git",
+	}, flags...)
+
+	output, err := exec.Command(s.cliPath, args...).CombinedOutput()
 	s.Require().NoError(err, "Generation failed: %s", string(output))
-	
-	// Verify project structure
-	s.verifyBasicWorkspaceStructure(projectPath)
-	
-	// Verify workspace synchronization
-	s.verifyWorkspaceSync(projectPath)
-	
-	// Verify compilation
-	s.verifyWorkspaceCompilation(projectPath)
-	
-	// Store for later use
-	s.generatedProjects["basic"] = projectPath
+	return filepath.Join(s.tempDir, projectName)
 }
 
-// TestWorkspaceMinimalGeneration tests minimal workspace generation
-func (s *WorkspaceIntegrationTestSuite) TestWorkspaceMinimalGeneration() {
-	projectName := "test-workspace-minimal"
+// generateWithVariables generates a workspace project setting blueprint variables,
+// such as the deprecated options of the 1.x workspace, and returns its path
+func (s *WorkspaceIntegrationTestSuite) generateWithVariables(projectName string, variables map[string]string) string {
 	projectPath := filepath.Join(s.tempDir, projectName)
-	
-	// Generate minimal workspace project
-	cmd := exec.Command(s.cliPath, "new", projectName,
-		"--type", "workspace",
-		"--module", "github.com/test/workspace-minimal",
-		"--enable-web-api", "false",
-		"--enable-cli", "true",
-		"--enable-worker", "false",
-		"--enable-microservices", "false",
-		"--database-type", "none",
-		"--message-queue", "none",
-		"--enable-docker", "false",
-		"--enable-kubernetes", "false",
-	)
-	
-	output, err := cmd.CombinedOutput()
-	s.Require().NoError(err, "Generation failed: %s", string(output))
-	
-	// Verify minimal structure
-	s.verifyMinimalWorkspaceStructure(projectPath)
-	
-	// Verify unwanted components are not present
-	s.verifyUnwantedComponentsAbsent(projectPath)
-	
-	// Verify compilation
-	s.verifyWorkspaceCompilation(projectPath)
-	
-	// Store for later use
-	s.generatedProjects["minimal"] = projectPath
+	_, err := generator.New().Generate(types.ProjectConfig{
+		Name:      projectName,
+		Module:    "github.com/test/" + projectName,
+		Type:      "workspace",
+		GoVersion: "1.22",
+		Framework: "gin",
+		Logger:    "slog",
+		Variables: variables,
+	}, types.GenerationOptions{OutputPath: projectPath, NoGit: true})
+	s.Require().NoError(err, "Generation failed")
+	return projectPath
 }
 
-// TestWorkspaceWithAllModules tests workspace with all modules enabled
-func (s *WorkspaceIntegrationTestSuite) TestWorkspaceWithAllModules() {
-	projectName := "test-workspace-full"
-	projectPath := filepath.Join(s.tempDir, projectName)
-	
-	// Generate full workspace project
-	cmd := exec.Command(s.cliPath, "new", projectName,
-		"--type", "workspace",
-		"--module", "github.com/test/workspace-full",
-		"--framework", "echo",
-		"--logger", "zap",
-		"--enable-web-api", "true",
-		"--enable-cli", "true",
-		"--enable-worker", "true",
-		"--enable-microservices", "true",
-		"--database-type", "postgres",
-		"--message-queue", "redis",
-		"--enable-docker", "true",
-		"--enable-kubernetes", "true",
-	)
-	
-	output, err := cmd.CombinedOutput()
-	s.Require().NoError(err, "Generation failed: %s", string(output))
-	
-	// Verify full structure
-	s.verifyFullWorkspaceStructure(projectPath)
-	
-	// Verify Docker configuration
-	s.verifyDockerConfiguration(projectPath)
-	
-	// Verify Kubernetes configuration
-	s.verifyKubernetesConfiguration(projectPath)
-	
-	// Verify compilation
+// TestWorkspaceBasicGeneration tests basic workspace generation
+func (s *WorkspaceIntegrationTestSuite) TestWorkspaceBasicGeneration() {
+	projectPath := s.generate("test-workspace-basic", "--framework", "gin", "--logger", "slog")
+
+	s.verifyWorkspaceStructure(projectPath)
+	s.verifyWorkspaceSync(projectPath)
 	s.verifyWorkspaceCompilation(projectPath)
-	
-	// Store for later use
-	s.generatedProjects["full"] = projectPath
 }
 
 // TestWorkspaceMultiFramework tests workspace generation with different frameworks
 func (s *WorkspaceIntegrationTestSuite) TestWorkspaceMultiFramework() {
-	frameworks := []string{"gin", "echo", "fiber", "chi"}
-	
+	frameworks := []string{"gin", "echo", "chi", "stdlib"}
+
 	for _, framework := range frameworks {
 		s.Run(fmt.Sprintf("Framework_%s", framework), func() {
-			projectName := fmt.Sprintf("test-workspace-%s", framework)
-			projectPath := filepath.Join(s.tempDir, projectName)
-			
-			// Generate project
-			cmd := exec.Command(s.cliPath, "new", projectName,
-				"--type", "workspace",
-				"--module", fmt.Sprintf("github.com/test/workspace-%s", framework),
-				"--framework", framework,
-				"--enable-web-api", "true",
-				"--enable-cli", "true",
-				"--logger", "slog",
-			)
-			
-			output, err := cmd.CombinedOutput()
-			s.Require().NoError(err, "Generation failed for %s: %s", framework, string(output))
-			
-			// Verify framework-specific imports
-			s.verifyFrameworkImports(projectPath, framework)
-			
-			// Verify compilation
-			s.verifyWorkspaceCompilation(projectPath)
-			
-			// Store project path
-			s.generatedProjects[framework] = projectPath
-		})
-	}
-}
+			projectPath := s.generate("test-workspace-"+framework, "--framework", framework)
 
-// TestWorkspaceMultiDatabase tests workspace generation with different databases
-func (s *WorkspaceIntegrationTestSuite) TestWorkspaceMultiDatabase() {
-	databases := []string{"postgres", "mysql", "mongodb", "sqlite"}
-	
-	for _, database := range databases {
-		s.Run(fmt.Sprintf("Database_%s", database), func() {
-			projectName := fmt.Sprintf("test-workspace-db-%s", database)
-			projectPath := filepath.Join(s.tempDir, projectName)
-			
-			// Generate project
-			cmd := exec.Command(s.cliPath, "new", projectName,
-				"--type", "workspace",
-				"--module", fmt.Sprintf("github.com/test/workspace-db-%s", database),
-				"--framework", "gin",
-				"--database-type", database,
-				"--enable-web-api", "true",
-				"--logger", "slog",
-			)
-			
-			output, err := cmd.CombinedOutput()
-			s.Require().NoError(err, "Generation failed for database %s: %s", database, string(output))
-			
-			// Verify database-specific configuration
-			s.verifyDatabaseConfiguration(projectPath, database)
-			
-			// Verify compilation
+			s.verifyFrameworkImports(projectPath, framework)
 			s.verifyWorkspaceCompilation(projectPath)
 		})
 	}
 }
 
-// TestWorkspaceMultiMessageQueue tests workspace generation with different message queues
-func (s *WorkspaceIntegrationTestSuite) TestWorkspaceMultiMessageQueue() {
-	messageQueues := []string{"redis", "nats", "kafka", "rabbitmq"}
-	
-	for _, mq := range messageQueues {
-		s.Run(fmt.Sprintf("MessageQueue_%s", mq), func() {
-			projectName := fmt.Sprintf("test-workspace-mq-%s", mq)
-			projectPath := filepath.Join(s.tempDir, projectName)
-			
-			// Generate project
-			cmd := exec.Command(s.cliPath, "new", projectName,
-				"--type", "workspace",
-				"--module", fmt.Sprintf("github.com/test/workspace-mq-%s", mq),
-				"--framework", "gin",
-				"--message-queue", mq,
-				"--enable-worker", "true",
-				"--logger", "slog",
-			)
-			
-			output, err := cmd.CombinedOutput()
-			s.Require().NoError(err, "Generation failed for message queue %s: %s", mq, string(output))
-			
-			// Verify message queue configuration
-			s.verifyMessageQueueConfiguration(projectPath, mq)
-			
-			// Verify compilation
+// TestWorkspaceMultiArchitecture tests the layouts of the api and worker modules
+func (s *WorkspaceIntegrationTestSuite) TestWorkspaceMultiArchitecture() {
+	layouts := map[string][]string{
+		"standard": {
+			"api/internal/handlers/task_handler.go",
+			"api/internal/services/task_service.go",
+			"worker/internal/jobs/runner.go",
+		},
+		"clean": {
+			"api/internal/domain/usecases/task_usecase.go",
+			"api/internal/adapters/controllers/task_controller.go",
+			"worker/internal/domain/usecases/run_jobs.go",
+		},
+		"ddd": {
+			"api/internal/domain/task/task.go",
+			"api/internal/application/task/service.go",
+			"worker/internal/application/job/runner.go",
+		},
+		"hexagonal": {
+			"api/internal/application/ports/input/task_service.go",
+			"api/internal/adapters/primary/rest/task_handler.go",
+			"worker/internal/adapters/primary/scheduler/scheduler.go",
+		},
+	}
+
+	for architecture, files := range layouts {
+		s.Run(fmt.Sprintf("Architecture_%s", architecture), func() {
+			projectPath := s.generate("test-workspace-arch-"+architecture, "--architecture", architecture)
+
+			for _, file := range files {
+				s.FileExists(filepath.Join(projectPath, file), "Layout file should exist: %s", file)
+			}
 			s.verifyWorkspaceCompilation(projectPath)
 		})
 	}
@@ -262,28 +142,12 @@ func (s *WorkspaceIntegrationTestSuite) TestWorkspaceMultiMessageQueue() {
 // TestWorkspaceMultiLogger tests workspace generation with different loggers
 func (s *WorkspaceIntegrationTestSuite) TestWorkspaceMultiLogger() {
 	loggers := []string{"slog", "zap", "logrus", "zerolog"}
-	
+
 	for _, logger := range loggers {
 		s.Run(fmt.Sprintf("Logger_%s", logger), func() {
-			projectName := fmt.Sprintf("test-workspace-logger-%s", logger)
-			projectPath := filepath.Join(s.tempDir, projectName)
-			
-			// Generate project
-			cmd := exec.Command(s.cliPath, "new", projectName,
-				"--type", "workspace",
-				"--module", fmt.Sprintf("github.com/test/workspace-logger-%s", logger),
-				"--framework", "gin",
-				"--logger", logger,
-				"--enable-web-api", "true",
-			)
-			
-			output, err := cmd.CombinedOutput()
-			s.Require().NoError(err, "Generation failed for logger %s: %s", logger, string(output))
-			
-			// Verify logger implementation
+			projectPath := s.generate("test-workspace-logger-"+logger, "--logger", logger)
+
 			s.verifyLoggerImplementation(projectPath, logger)
-			
-			// Verify compilation
 			s.verifyWorkspaceCompilation(projectPath)
 		})
 	}
@@ -291,143 +155,178 @@ func (s *WorkspaceIntegrationTestSuite) TestWorkspaceMultiLogger() {
 
 // TestWorkspaceBuildSystem tests the workspace build system
 func (s *WorkspaceIntegrationTestSuite) TestWorkspaceBuildSystem() {
-	projectName := "test-workspace-build"
-	projectPath := filepath.Join(s.tempDir, projectName)
-	
-	// Generate workspace project
-	cmd := exec.Command(s.cliPath, "new", projectName,
-		"--type", "workspace",
-		"--module", "github.com/test/workspace-build",
-		"--framework", "gin",
-		"--enable-web-api", "true",
-		"--enable-cli", "true",
-		"--enable-worker", "true",
-		"--database-type", "postgres",
-		"--message-queue", "redis",
-	)
-	
-	output, err := cmd.CombinedOutput()
-	s.Require().NoError(err, "Generation failed: %s", string(output))
-	
-	// Verify Makefile targets
+	projectPath := s.generate("test-workspace-build", "--framework", "gin")
+
 	s.verifyMakefileTargets(projectPath)
-	
-	// Test build targets
+	s.verifyDockerConfiguration(projectPath)
 	s.testBuildTargets(projectPath)
-	
-	// Test build scripts
-	s.testBuildScripts(projectPath)
 }
 
 // TestWorkspaceDependencyManagement tests module dependency management
 func (s *WorkspaceIntegrationTestSuite) TestWorkspaceDependencyManagement() {
-	projectName := "test-workspace-deps"
-	projectPath := filepath.Join(s.tempDir, projectName)
-	
-	// Generate workspace project
-	cmd := exec.Command(s.cliPath, "new", projectName,
-		"--type", "workspace",
-		"--module", "github.com/test/workspace-deps",
-		"--framework", "gin",
-		"--enable-web-api", "true",
-		"--enable-cli", "true",
-		"--database-type", "postgres",
-		"--message-queue", "redis",
-	)
-	
-	output, err := cmd.CombinedOutput()
-	s.Require().NoError(err, "Generation failed: %s", string(output))
-	
-	// Verify go.work configuration
+	projectPath := s.generate("test-workspace-deps", "--framework", "gin")
+
 	s.verifyGoWorkConfiguration(projectPath)
-	
-	// Verify module dependencies
 	s.verifyModuleDependencies(projectPath)
-	
-	// Test dependency resolution
-	s.testDependencyResolution(projectPath)
+	s.verifyWorkspaceSync(projectPath)
 }
 
-// Helper methods
+// TestWorkspaceMinimalConfiguration tests a workspace with the deprecated CLI module only
+func (s *WorkspaceIntegrationTestSuite) TestWorkspaceMinimalConfiguration() {
+	projectPath := s.generateWithVariables("test-workspace-minimal", map[string]string{"EnableCLI": "true"})
 
-func (s *WorkspaceIntegrationTestSuite) verifyBasicWorkspaceStructure(projectPath string) {
-	requiredFiles := []string{
-		"go.work",
-		"workspace.yaml",
-		"Makefile",
-		"pkg/shared/go.mod",
-		"pkg/models/go.mod",
-		"pkg/storage/go.mod",
-		"pkg/events/go.mod",
-		"cmd/api/go.mod",
-		"cmd/cli/go.mod",
-		"scripts/build-all.sh",
-		"scripts/test-all.sh",
-		"scripts/lint-all.sh",
+	for _, module := range []string{"pkg/shared", "pkg/models", "cmd/cli"} {
+		s.FileExists(filepath.Join(projectPath, module, "go.mod"), "Module should exist: %s", module)
 	}
-	
-	for _, file := range requiredFiles {
-		fullPath := filepath.Join(projectPath, file)
-		s.Require().FileExists(fullPath, "Required file should exist: %s", file)
+	for _, path := range []string{"pkg/storage", "pkg/events", "cmd/user-service", "cmd/notification-service", "deployments/k8s"} {
+		s.NoDirExists(filepath.Join(projectPath, path), "Disabled module should not exist: %s", path)
 	}
+	s.verifyWorkspaceSync(projectPath)
+	s.verifyWorkspaceCompilation(projectPath)
 }
 
-func (s *WorkspaceIntegrationTestSuite) verifyMinimalWorkspaceStructure(projectPath string) {
-	requiredFiles := []string{
-		"go.work",
-		"workspace.yaml",
-		"Makefile",
-		"pkg/shared/go.mod",
-		"pkg/models/go.mod",
-		"cmd/cli/go.mod",
+// TestWorkspaceMultiDatabase tests the deprecated pkg/storage module with each database
+func (s *WorkspaceIntegrationTestSuite) TestWorkspaceMultiDatabase() {
+	drivers := map[string]string{
+		"postgres": "github.com/lib/pq",
+		"mysql":    "github.com/go-sql-driver/mysql",
+		"mongodb":  "go.mongodb.org/mongo-driver",
+		"sqlite":   "github.com/mattn/go-sqlite3",
 	}
-	
-	for _, file := range requiredFiles {
-		fullPath := filepath.Join(projectPath, file)
-		s.Require().FileExists(fullPath, "Required minimal file should exist: %s", file)
+
+	for database, driver := range drivers {
+		s.Run(fmt.Sprintf("Database_%s", database), func() {
+			projectPath := s.generateWithVariables("test-workspace-db-"+database, map[string]string{
+				"EnableMicroservices": "true",
+				"DatabaseType":        database,
+			})
+
+			content, err := os.ReadFile(filepath.Join(projectPath, "pkg/storage/database.go"))
+			s.Require().NoError(err)
+			s.Contains(string(content), driver, "Storage should use the %s driver", database)
+			s.Contains(s.readFile(projectPath, "cmd/user-service/go.mod"), "/pkg/storage v0.0.0")
+			s.verifyWorkspaceCompilation(projectPath)
+		})
 	}
 }
 
-func (s *WorkspaceIntegrationTestSuite) verifyFullWorkspaceStructure(projectPath string) {
-	requiredFiles := []string{
-		"go.work",
-		"workspace.yaml",
-		"Makefile",
-		"pkg/shared/go.mod",
-		"pkg/models/go.mod",
-		"pkg/storage/go.mod",
-		"pkg/events/go.mod",
-		"cmd/api/go.mod",
-		"cmd/cli/go.mod",
-		"cmd/worker/go.mod",
-		"services/user-service/go.mod",
-		"services/notification-service/go.mod",
-		"docker-compose.yml",
-		"docker-compose.dev.yml",
-		"deployments/k8s/namespace.yaml",
-		"deployments/k8s/configmap.yaml",
+// TestWorkspaceMultiMessageQueue tests the deprecated pkg/events module with each message queue
+func (s *WorkspaceIntegrationTestSuite) TestWorkspaceMultiMessageQueue() {
+	clients := map[string]string{
+		"redis":    "github.com/redis/go-redis/v9",
+		"nats":     "github.com/nats-io/nats.go",
+		"kafka":    "github.com/segmentio/kafka-go",
+		"rabbitmq": "github.com/rabbitmq/amqp091-go",
 	}
-	
-	for _, file := range requiredFiles {
-		fullPath := filepath.Join(projectPath, file)
-		s.Require().FileExists(fullPath, "Required full file should exist: %s", file)
+
+	for queue, client := range clients {
+		s.Run(fmt.Sprintf("MessageQueue_%s", queue), func() {
+			projectPath := s.generateWithVariables("test-workspace-mq-"+queue, map[string]string{
+				"EnableMicroservices": "true",
+				"MessageQueue":        queue,
+			})
+
+			content, err := os.ReadFile(filepath.Join(projectPath, "pkg/events/event_bus.go"))
+			s.Require().NoError(err)
+			s.Contains(string(content), client, "Events should use the %s client", queue)
+			s.Contains(s.readFile(projectPath, "cmd/notification-service/main.go"), "bus.Subscribe")
+			s.verifyWorkspaceCompilation(projectPath)
+		})
 	}
 }
 
-func (s *WorkspaceIntegrationTestSuite) verifyUnwantedComponentsAbsent(projectPath string) {
-	unwantedFiles := []string{
-		"pkg/storage",
-		"pkg/events",
-		"cmd/api",
-		"cmd/worker",
-		"services",
+// TestWorkspaceKubernetes tests the deprecated Kubernetes manifests
+func (s *WorkspaceIntegrationTestSuite) TestWorkspaceKubernetes() {
+	projectPath := s.generateWithVariables("test-workspace-k8s", map[string]string{
+		"EnableKubernetes":    "true",
+		"EnableMicroservices": "true",
+	})
+
+	manifests := []string{
+		"namespace.yaml",
+		"configmap.yaml",
+		"secrets.yaml",
+		"api-deployment.yaml",
+		"worker-deployment.yaml",
+		"user-service-deployment.yaml",
+		"notification-service-deployment.yaml",
+	}
+	for _, manifest := range manifests {
+		decoder := yaml.NewDecoder(strings.NewReader(s.readFile(projectPath, "deployments/k8s/"+manifest)))
+		for {
+			var document map[string]interface{}
+			if err := decoder.Decode(&document); err != nil {
+				s.Require().ErrorContains(err, "EOF", "%s should be valid YAML", manifest)
+				break
+			}
+			s.NotEmpty(document["kind"], "%s documents should have a kind", manifest)
+		}
+	}
+	s.Contains(s.readFile(projectPath, ".github/workflows/release.yml"), "kubectl apply -f deployments/k8s/")
+}
+
+// TestWorkspaceScalabilityPatterns tests the patterns of the deprecated microservices
+func (s *WorkspaceIntegrationTestSuite) TestWorkspaceScalabilityPatterns() {
+	projectPath := s.generateWithVariables("test-workspace-services", map[string]string{
+		"EnableMicroservices": "true",
+		"DatabaseType":        "postgres",
+		"MessageQueue":        "nats",
+	})
+
+	for _, service := range []string{"user-service", "notification-service"} {
+		main := s.readFile(projectPath, "cmd/"+service+"/main.go")
+		s.Contains(main, "signal.NotifyContext", "%s should shut down gracefully", service)
+		s.Contains(main, "Shutdown(", "%s should drain its server", service)
+		s.Contains(main, "config.Load(", "%s should load its configuration", service)
+
+		router := s.readFile(projectPath, "cmd/"+service+"/handlers/router.go")
+		s.Contains(router, "/health", "%s should expose a liveness probe", service)
+		s.Contains(router, "/ready", "%s should expose a readiness probe", service)
+		s.FileExists(filepath.Join(projectPath, "cmd", service, "Dockerfile"))
+	}
+	s.Contains(s.readFile(projectPath, "cmd/user-service/services/user_service.go"), "Publish(")
+	s.Contains(s.readFile(projectPath, "cmd/notification-service/main.go"), "Subscribe(")
+
+	var compose struct {
+		Services map[string]interface{} `yaml:"services"`
+	}
+	s.Require().NoError(yaml.Unmarshal([]byte(s.readFile(projectPath, "docker-compose.yml")), &compose))
+	for _, service := range []string{"api", "worker", "user-service", "notification-service", "postgres", "nats"} {
+		s.Contains(compose.Services, service)
+	}
+	s.verifyWorkspaceCompilation(projectPath)
+}
+
+// Helper methods
+
+func (s *WorkspaceIntegrationTestSuite) readFile(projectPath, file string) string {
+	content, err := os.ReadFile(filepath.Join(projectPath, file))
+	s.Require().NoError(err)
+	return string(content)
+}
+
+func (s *WorkspaceIntegrationTestSuite) verifyWorkspaceStructure(projectPath string) {
+	requiredFiles := []string{
+		"go.work",
+		"Makefile",
 		"docker-compose.yml",
-		"deployments/k8s",
+		".github/workflows/ci.yml",
+		"shared/go.mod",
+		"shared/config/config.go",
+		"shared/logger/interface.go",
+		"shared/shutdown/shutdown.go",
+		"shared/httpx/httpx.go",
+		"api/go.mod",
+		"api/main.go",
+		"api/Dockerfile",
+		"worker/go.mod",
+		"worker/main.go",
+		"worker/Dockerfile",
 	}
-	
-	for _, file := range unwantedFiles {
+
+	for _, file := range requiredFiles {
 		fullPath := filepath.Join(projectPath, file)
-		s.Require().NoFileExists(fullPath, "Unwanted file should not exist: %s", file)
+		s.Require().FileExists(fullPath, "Required file should exist: %s", file)
 	}
 }
 
@@ -439,234 +338,129 @@ func (s *WorkspaceIntegrationTestSuite) verifyWorkspaceSync(projectPath string)
 }
 
 func (s *WorkspaceIntegrationTestSuite) verifyWorkspaceCompilation(projectPath string) {
-	// First sync the workspace
-	syncCmd := exec.Command("go", "work", "sync")
-	syncCmd.Dir = projectPath
-	err := syncCmd.Run()
-	s.Require().NoError(err, "go work sync should succeed")
-	
-	// Then build all modules
-	buildCmd := exec.Command("go", "build", "./...")
-	buildCmd.Dir = projectPath
-	output, err := buildCmd.CombinedOutput()
-	s.Require().NoError(err, "Workspace should compile: %s", string(output))
-}
-
-func (s *WorkspaceIntegrationTestSuite) verifyFrameworkImports(projectPath, framework string) {
-	if !s.fileExists(filepath.Join(projectPath, "cmd/api")) {
-		return // Skip if API module not enabled
-	}
-	
-	apiMainPath := filepath.Join(projectPath, "cmd/api/main.go")
-	content, err := os.ReadFile(apiMainPath)
-	s.Require().NoError(err)
-	
-	expectedImports := map[string]string{
-		"gin":   "github.com/gin-gonic/gin",
-		"echo":  "github.com/labstack/echo",
-		"fiber": "github.com/gofiber/fiber",
-		"chi":   "github.com/go-chi/chi",
-	}
-	
-	if expectedImport, exists := expectedImports[framework]; exists {
-		s.Contains(string(content), expectedImport, "Should contain framework import for %s", framework)
+	// go build ./... does not cross module boundaries, so build each module of go.work
+	for _, module := range s.workspaceModules(projectPath) {
+		buildCmd := exec.Command("go", "build", "./...")
+		buildCmd.Dir = filepath.Join(projectPath, module)
+		output, err := buildCmd.CombinedOutput()
+		s.Require().NoError(err, "Module %s should compile: %s", module, string(output))
 	}
 }
 
-func (s *WorkspaceIntegrationTestSuite) verifyDatabaseConfiguration(projectPath, database string) {
-	// Verify storage package exists
-	storageDir := filepath.Join(projectPath, "pkg/storage")
-	s.Require().DirExists(storageDir, "Storage package should exist for database %s", database)
-	
-	// Verify database-specific dependencies
-	goModPath := filepath.Join(storageDir, "go.mod")
-	content, err := os.ReadFile(goModPath)
-	s.Require().NoError(err)
-	
-	expectedDrivers := map[string]string{
-		"postgres": "github.com/lib/pq",
-		"mysql":    "github.com/go-sql-driver/mysql",
-		"mongodb":  "go.mongodb.org/mongo-driver",
-		"sqlite":   "github.com/mattn/go-sqlite3",
-	}
-	
-	if expectedDriver, exists := expectedDrivers[database]; exists {
-		s.Contains(string(content), expectedDriver, "Should contain database driver for %s", database)
+// workspaceModules lists the modules go.work uses
+func (s *WorkspaceIntegrationTestSuite) workspaceModules(projectPath string) []string {
+	var modules []string
+	for _, line := range strings.Split(s.readFile(projectPath, "go.work"), "\n") {
+		if module, ok := strings.CutPrefix(strings.TrimSpace(line), "./"); ok {
+			modules = append(modules, module)
+		}
 	}
+	s.Require().NotEmpty(modules, "go.work should use modules")
+	return modules
 }
 
-func (s *WorkspaceIntegrationTestSuite) verifyMessageQueueConfiguration(projectPath, mq string) {
-	// Verify events package exists
-	eventsDir := filepath.Join(projectPath, "pkg/events")
-	s.Require().DirExists(eventsDir, "Events package should exist for message queue %s", mq)
-	
-	// Verify message queue specific dependencies
-	goModPath := filepath.Join(eventsDir, "go.mod")
-	content, err := os.ReadFile(goModPath)
+func (s *WorkspaceIntegrationTestSuite) verifyFrameworkImports(projectPath, framework string) {
+	content, err := os.ReadFile(filepath.Join(projectPath, "api/internal/server/router.go"))
 	s.Require().NoError(err)
-	
-	expectedLibraries := map[string]string{
-		"redis":    "github.com/go-redis/redis",
-		"nats":     "github.com/nats-io/nats.go",
-		"kafka":    "github.com/segmentio/kafka-go",
-		"rabbitmq": "github.com/streadway/amqp",
-	}
-	
-	if expectedLib, exists := expectedLibraries[mq]; exists {
-		s.Contains(string(content), expectedLib, "Should contain message queue library for %s", mq)
+
+	expectedImports := map[string]string{
+		"gin":    "github.com/gin-gonic/gin",
+		"echo":   "github.com/labstack/echo/v4",
+		"chi":    "github.com/go-chi/chi/v5",
+		"stdlib": "net/http",
 	}
+
+	s.Contains(string(content), expectedImports[framework], "Should contain framework import for %s", framework)
 }
 
 func (s *WorkspaceIntegrationTestSuite) verifyLoggerImplementation(projectPath, logger string) {
-	loggerPath := filepath.Join(projectPath, "pkg/shared/logger/logger.go")
-	content, err := os.ReadFile(loggerPath)
+	content, err := os.ReadFile(filepath.Join(projectPath, "shared/logger", logger+".go"))
 	s.Require().NoError(err)
-	
+
 	expectedPackages := map[string]string{
 		"slog":    "log/slog",
 		"zap":     "go.uber.org/zap",
 		"logrus":  "github.com/sirupsen/logrus",
 		"zerolog": "github.com/rs/zerolog",
 	}
-	
-	if expectedPackage, exists := expectedPackages[logger]; exists {
-		s.Contains(string(content), expectedPackage, "Should contain logger package for %s", logger)
-	}
+
+	s.Contains(string(content), expectedPackages[logger], "Should contain logger package for %s", logger)
 }
 
 func (s *WorkspaceIntegrationTestSuite) verifyDockerConfiguration(projectPath string) {
-	dockerFiles := []string{
-		"docker-compose.yml",
-		"docker-compose.dev.yml",
-	}
-	
-	for _, file := range dockerFiles {
-		fullPath := filepath.Join(projectPath, file)
-		s.Require().FileExists(fullPath, "Docker file should exist: %s", file)
-		
-		// Verify YAML syntax
-		content, err := os.ReadFile(fullPath)
-		s.Require().NoError(err)
-		
-		var dockerCompose interface{}
-		err = yaml.Unmarshal(content, &dockerCompose)
-		s.Require().NoError(err, "Docker file should be valid YAML: %s", file)
-	}
-}
+	content, err := os.ReadFile(filepath.Join(projectPath, "docker-compose.yml"))
+	s.Require().NoError(err)
 
-func (s *WorkspaceIntegrationTestSuite) verifyKubernetesConfiguration(projectPath string) {
-	k8sFiles := []string{
-		"deployments/k8s/namespace.yaml",
-		"deployments/k8s/configmap.yaml",
-		"deployments/k8s/secrets.yaml",
+	var compose struct {
+		Services map[string]interface{} `yaml:"services"`
 	}
-	
-	for _, file := range k8sFiles {
-		fullPath := filepath.Join(projectPath, file)
-		s.Require().FileExists(fullPath, "Kubernetes file should exist: %s", file)
-		
-		// Verify YAML syntax
-		content, err := os.ReadFile(fullPath)
-		s.Require().NoError(err)
-		
-		var k8sManifest interface{}
-		err = yaml.Unmarshal(content, &k8sManifest)
-		s.Require().NoError(err, "Kubernetes file should be valid YAML: %s", file)
+	s.Require().NoError(yaml.Unmarshal(content, &compose), "docker-compose.yml should be valid YAML")
+	s.Contains(compose.Services, "api")
+	s.Contains(compose.Services, "worker")
+
+	for _, file := range []string{"api/Dockerfile", "worker/Dockerfile"} {
+		s.FileExists(filepath.Join(projectPath, file))
 	}
 }
 
 func (s *WorkspaceIntegrationTestSuite) verifyMakefileTargets(projectPath string) {
-	makefilePath := filepath.Join(projectPath, "Makefile")
-	content, err := os.ReadFile(makefilePath)
+	content, err := os.ReadFile(filepath.Join(projectPath, "Makefile"))
 	s.Require().NoError(err)
-	
-	makefileStr := string(content)
+
 	expectedTargets := []string{
-		"build-all:",
-		"test-all:",
-		"lint-all:",
-		"clean-all:",
-		"deps-update:",
+		"build:",
+		"test:",
+		"vet:",
+		"tidy:",
+		"docker-build:",
+		"clean:",
 	}
-	
+
 	for _, target := range expectedTargets {
-		s.Contains(makefileStr, target, "Makefile should contain target: %s", target)
+		s.Contains(string(content), target, "Makefile should contain target: %s", target)
 	}
 }
 
 func (s *WorkspaceIntegrationTestSuite) testBuildTargets(projectPath string) {
-	targets := []string{"build-all", "clean-all"}
-	
-	for _, target := range targets {
+	for _, target := range []string{"build", "clean"} {
 		cmd := exec.Command("make", target)
 		cmd.Dir = projectPath
-		
+
 		output, err := cmd.CombinedOutput()
 		s.Require().NoError(err, "Make target %s should succeed: %s", target, string(output))
 	}
 }
 
-func (s *WorkspaceIntegrationTestSuite) testBuildScripts(projectPath string) {
-	scripts := []string{
-		"scripts/build-all.sh",
-		"scripts/test-all.sh",
-		"scripts/lint-all.sh",
-	}
-	
-	for _, script := range scripts {
-		fullPath := filepath.Join(projectPath, script)
-		
-		// Verify script is executable
-		info, err := os.Stat(fullPath)
-		s.Require().NoError(err)
-		s.True(info.Mode()&0111 != 0, "Script should be executable: %s", script)
-	}
-}
-
 func (s *WorkspaceIntegrationTestSuite) verifyGoWorkConfiguration(projectPath string) {
-	goWorkPath := filepath.Join(projectPath, "go.work")
-	content, err := os.ReadFile(goWorkPath)
+	content, err := os.ReadFile(filepath.Join(projectPath, "go.work"))
 	s.Require().NoError(err)
-	
+
 	goWorkStr := string(content)
 	s.Contains(goWorkStr, "go ")
 	s.Contains(goWorkStr, "use (")
-	s.Contains(goWorkStr, "./pkg/shared")
-	s.Contains(goWorkStr, "./pkg/models")
+	s.Contains(goWorkStr, "./api")
+	s.Contains(goWorkStr, "./shared")
+	s.Contains(goWorkStr, "./worker")
 }
 
 func (s *WorkspaceIntegrationTestSuite) verifyModuleDependencies(projectPath string) {
-	// Verify that dependent modules reference shared modules properly
-	apiGoModPath := filepath.Join(projectPath, "cmd/api/go.mod")
-	if s.fileExists(apiGoModPath) {
-		content, err := os.ReadFile(apiGoModPath)
+	// The services require the shared module and replace it with the local copy,
+	// so they also build outside the workspace, as in their Dockerfiles
+	for _, module := range []string{"api", "worker"} {
+		content, err := os.ReadFile(filepath.Join(projectPath, module, "go.mod"))
 		s.Require().NoError(err)
-		
-		// Should contain replace directives for local modules
+
 		goModStr := string(content)
-		s.Contains(goModStr, "replace ")
+		s.Contains(goModStr, "github.com/test/test-workspace-deps/shared")
+		s.Contains(goModStr, "replace github.com/test/test-workspace-deps/shared => ../shared")
 	}
 }
 
-func (s *WorkspaceIntegrationTestSuite) testDependencyResolution(projectPath string) {
-	// Test that go work sync resolves dependencies correctly
-	cmd := exec.Command("go", "work", "sync")
-	cmd.Dir = projectPath
-	
-	output, err := cmd.CombinedOutput()
-	s.Require().NoError(err, "Dependency resolution should succeed: %s", string(output))
-}
-
-func (s *WorkspaceIntegrationTestSuite) fileExists(path string) bool {
-	_, err := os.Stat(path)
-	return err == nil
-}
-
 // TestSuite runner
 func TestWorkspaceIntegrationSuite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
-	
+
 	suite.Run(t, new(WorkspaceIntegrationTestSuite))
-}
\ No newline at end of file
+}
@@ -0,0 +1,8 @@
+//go:build !release
+
+package web
+
+import "io/fs"
+
+// Dist is nil: the web UI is only embedded in release builds
+var Dist fs.FS
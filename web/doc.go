@@ -0,0 +1,3 @@
+// Package web holds the web UI of go-starter. Release builds, tagged release,
+// embed the UI built into dist; other builds leave it to be served from disk.
+package web
@@ -0,0 +1,16 @@
+//go:build release
+
+package web
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// dist embeds the web UI built by npm run build; release builds build it first
+//
+//go:embed all:dist
+var dist embed.FS
+
+// Dist is the web UI embedded in the binary
+var Dist, _ = fs.Sub(dist, "dist")
@@ -0,0 +1,78 @@
+// Specs are the selection shared with `go-starter new`: the query of the links
+// opened by `go-starter new --open-web`, keyed by the flags of the command.
+// The server reads and writes them so that both frontends stay in sync.
+
+import type { CommandResponse, ProjectConfig } from '../types'
+
+// ApiProjectConfig is the project configuration as the server exchanges it
+interface ApiProjectConfig {
+  project_name: string
+  module_url: string
+  go_version: string
+  project_type: string
+  framework?: string
+  architecture?: string
+  logger?: string
+  database?: { driver: string; orm: string }
+  authentication?: { type: string }
+  experimental?: string[]
+  variables?: Record<string, string>
+}
+
+function fromApi(config: ApiProjectConfig): Partial<ProjectConfig> {
+  const result: Partial<ProjectConfig> = {}
+  if (config.project_name) result.projectName = config.project_name
+  if (config.module_url) result.moduleUrl = config.module_url
+  if (config.go_version) result.goVersion = config.go_version
+  if (config.project_type) result.projectType = config.project_type as ProjectConfig['projectType']
+  if (config.framework) result.framework = config.framework as ProjectConfig['framework']
+  if (config.architecture) result.architecture = config.architecture as ProjectConfig['architecture']
+  if (config.logger) result.logger = config.logger as ProjectConfig['logger']
+  if (config.database) result.database = config.database as ProjectConfig['database']
+  if (config.authentication) result.authentication = config.authentication as ProjectConfig['authentication']
+  if (config.experimental) result.experimental = config.experimental
+  return result
+}
+
+function toApi(config: ProjectConfig): ApiProjectConfig {
+  return {
+    project_name: config.projectName,
+    module_url: config.moduleUrl,
+    go_version: config.goVersion,
+    project_type: config.projectType,
+    framework: config.framework,
+    architecture: config.architecture,
+    logger: config.logger,
+    database: config.database,
+    authentication: config.authentication ? { type: config.authentication.type } : undefined,
+    experimental: config.experimental,
+  }
+}
+
+// loadSpec reads the selection of the page link, if it carries one
+export async function loadSpec(search: string): Promise<Partial<ProjectConfig> | null> {
+  if (!search || search === '?') {
+    return null
+  }
+  const response = await fetch(`/api/v1/spec${search}`)
+  if (!response.ok) {
+    const data = await response.json()
+    throw new Error(data.error)
+  }
+  const data: { config: ApiProjectConfig } = await response.json()
+  return fromApi(data.config)
+}
+
+// fetchCommand returns the `go-starter new` command line of a configuration
+export async function fetchCommand(config: ProjectConfig): Promise<CommandResponse> {
+  const response = await fetch('/api/v1/command', {
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json' },
+    body: JSON.stringify({ config: toApi(config) }),
+  })
+  const data = await response.json()
+  if (!response.ok) {
+    throw new Error(data.error)
+  }
+  return data
+}
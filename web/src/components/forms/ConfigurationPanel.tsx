@@ -1,7 +1,8 @@
-import { useState } from 'react'
+import { useEffect, useState } from 'react'
 import { Disclosure } from '@headlessui/react'
 import { ChevronDownIcon, InformationCircleIcon } from '@heroicons/react/20/solid'
 import type { DisclosureMode, ProjectConfig, ProjectType, Architecture, Framework, LoggerType } from '../../types'
+import { fetchCommand, loadSpec } from '../../api/spec'
 
 interface ConfigurationPanelProps {
   disclosureMode: DisclosureMode
@@ -17,6 +18,29 @@ export default function ConfigurationPanel({ disclosureMode }: ConfigurationPane
     architecture: 'standard',
     logger: 'slog',
   })
+  const [handoff, setHandoff] = useState<{ message: string; error: boolean } | null>(null)
+
+  // Links opened by `go-starter new --open-web` pre-fill the form
+  useEffect(() => {
+    loadSpec(window.location.search)
+      .then((spec) => {
+        if (spec) {
+          setConfig(prev => ({ ...prev, ...spec }))
+        }
+      })
+      .catch((error: Error) => setHandoff({ message: `The link could not be read: ${error.message}`, error: true }))
+  }, [])
+
+  const copyCommand = async () => {
+    try {
+      const { command, omitted } = await fetchCommand(config)
+      await navigator.clipboard.writeText(command)
+      const note = omitted?.length ? ` (${omitted.join(', ')} cannot be set on the command line)` : ''
+      setHandoff({ message: `Copied: ${command}${note}`, error: false })
+    } catch (error) {
+      setHandoff({ message: `The command could not be copied: ${(error as Error).message}`, error: true })
+    }
+  }
 
   const projectTypes: Array<{ value: ProjectType; label: string; description: string }> = [
     { value: 'cli', label: 'CLI Application', description: 'Command-line tools with Cobra framework' },
@@ -232,10 +256,18 @@ export default function ConfigurationPanel({ disclosureMode }: ConfigurationPane
             <button className="btn-primary flex-1">
               Generate Project
             </button>
+            <button className="btn-secondary" onClick={copyCommand}>
+              Copy CLI command
+            </button>
             <button className="btn-secondary">
               Reset
             </button>
           </div>
+          {handoff && (
+            <p className={`mt-3 text-sm break-all ${handoff.error ? 'text-red-600' : 'text-gray-600'}`}>
+              {handoff.message}
+            </p>
+          )}
         </div>
       </div>
     </div>
@@ -91,6 +91,9 @@ export interface ProjectConfig {
     logging?: boolean
     caching?: boolean
   }
+
+  // Experimental blueprint features, e.g. 'framework.fuego'
+  experimental?: string[]
 }
 
 export interface Blueprint {
@@ -103,6 +106,21 @@ export interface Blueprint {
   fileCount: number
   dependencies: string[]
   features: string[]
+  deprecation?: Deprecation
+  experimental?: string
+}
+
+export interface Deprecation {
+  message?: string
+  replacement?: string
+  since?: string
+  sunset?: string
+}
+
+export interface DeprecationNotice extends Deprecation {
+  blueprint: string
+  variable?: string
+  value?: string
 }
 
 export interface GenerationStatus {
@@ -138,9 +156,31 @@ export interface PreviewUpdate {
   path?: string
   content?: string
   error?: string
+  // Set when a template of the blueprint failed to render
+  report?: RenderErrorReport
   progress?: number
 }
 
+// Where a template failed to render and how to fix it, returned with the
+// RENDER_FAILED code and sent with generation_failed events
+export interface RenderErrorReport {
+  file: string
+  line?: number
+  variable?: string
+  message: string
+  suggestion?: string
+}
+
+// Sent on /ws/generate to the client named by the client_id of a generation
+export interface GenerationEvent {
+  type: 'generation_completed' | 'generation_failed'
+  id: string
+  blueprint: string
+  files_generated?: number
+  error?: string
+  report?: RenderErrorReport
+}
+
 export interface ValidationError {
   field: string
   message: string
@@ -154,6 +194,8 @@ export interface BlueprintListResponse {
 export interface GenerateProjectRequest {
   blueprint: string
   config: ProjectConfig
+  // client_id welcomed by /ws/generate, to receive the events of the generation
+  client_id?: string
   options: {
     memoryMode: boolean
     includeExamples: boolean
@@ -172,4 +214,13 @@ export interface GenerateProjectResponse {
     size: number
     type: string
   }>
-}
\ No newline at end of file
+  deprecations?: DeprecationNotice[]
+}
+// Handoff between the web UI and `go-starter new`
+
+export interface CommandResponse {
+  command: string
+  url: string
+  // Blueprint variables that have no flag on the command line
+  omitted?: string[]
+}